@@ -39,6 +39,7 @@ type IntrospectionConfig struct {
 	MachineLock        machinelock.Lock
 	PrometheusGatherer prometheus.Gatherer
 	FlightRecorder     flightrecorder.FlightRecorder
+	LeaseStore         introspection.LeaseStore
 
 	Clock  clock.Clock
 	Logger logger.Logger
@@ -65,7 +66,7 @@ func StartIntrospection(cfg IntrospectionConfig) error {
 		MachineLock:        cfg.MachineLock,
 		PrometheusGatherer: cfg.PrometheusGatherer,
 		FlightRecorder:     cfg.FlightRecorder,
-		// TODO(leases) - add lease introspection
+		LeaseStore:         cfg.LeaseStore,
 	})
 	if err != nil {
 		return errors.Trace(err)
@@ -1524,36 +1524,46 @@ func (s *apiclientSuite) TestWatchDebugLogParamsEncoded(c *tc.C) {
 	s.PatchValue(&api.WebsocketDial, catcher.RecordLocation)
 
 	params := common.DebugLogParams{
-		IncludeEntity: []string{"a", "b"},
-		IncludeModule: []string{"c", "d"},
-		IncludeLabels: map[string]string{"e": "f"},
-		ExcludeEntity: []string{"g", "h"},
-		ExcludeModule: []string{"i", "j"},
-		ExcludeLabels: map[string]string{"k": "l"},
-		Limit:         100,
-		Backlog:       200,
-		Level:         loggo.ERROR,
-		Replay:        true,
-		NoTail:        true,
-		Firehose:      true,
-		StartTime:     time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
+		IncludeEntity:  []string{"a", "b"},
+		IncludeModule:  []string{"c", "d"},
+		IncludeLabels:  map[string]string{"e": "f"},
+		ExcludeEntity:  []string{"g", "h"},
+		ExcludeModule:  []string{"i", "j"},
+		ExcludeLabels:  map[string]string{"k": "l"},
+		Limit:          100,
+		Backlog:        200,
+		Level:          loggo.ERROR,
+		Replay:         true,
+		NoTail:         true,
+		Firehose:       true,
+		StartTime:      time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
+		EndTime:        time.Date(2016, 12, 1, 11, 48, 0, 0, time.UTC),
+		FromOffset:     "1480506480000000100-0badf00d",
+		IncludeMessage: []string{"conn.*"},
+		ExcludeMessage: []string{"refused"},
+		Labels:         []string{"charm=mysql", "http-request-id!=42"},
 	}
 
 	urlValues := url.Values{
-		"version":       []string{"2"},
-		"includeEntity": params.IncludeEntity,
-		"includeModule": params.IncludeModule,
-		"includeLabels": []string{"e=f"},
-		"excludeEntity": params.ExcludeEntity,
-		"excludeModule": params.ExcludeModule,
-		"excludeLabels": []string{"k=l"},
-		"maxLines":      {"100"},
-		"backlog":       {"200"},
-		"level":         {"ERROR"},
-		"replay":        {"true"},
-		"noTail":        {"true"},
-		"firehose":      {"true"},
-		"startTime":     {"2016-11-30T11:48:00.0000001Z"},
+		"version":        []string{"2"},
+		"includeEntity":  params.IncludeEntity,
+		"includeModule":  params.IncludeModule,
+		"includeLabels":  []string{"e=f"},
+		"excludeEntity":  params.ExcludeEntity,
+		"excludeModule":  params.ExcludeModule,
+		"excludeLabels":  []string{"k=l"},
+		"maxLines":       {"100"},
+		"backlog":        {"200"},
+		"level":          {"ERROR"},
+		"replay":         {"true"},
+		"noTail":         {"true"},
+		"firehose":       {"true"},
+		"startTime":      {"2016-11-30T11:48:00.0000001Z"},
+		"endTime":        {"2016-12-01T11:48:00Z"},
+		"fromOffset":     {"1480506480000000100-0badf00d"},
+		"includeMessage": {"conn.*"},
+		"excludeMessage": {"refused"},
+		"label":          {"charm=mysql", "http-request-id!=42"},
 	}
 
 	info := s.APIInfo()
@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// AddSchedule schedules an action to run repeatedly on the given units,
+// whenever the cron expression falls due.
+func (c *Client) AddSchedule(ctx context.Context, args ScheduleArgs) error {
+	if c.facade.BestAPIVersion() < 8 {
+		return errors.NotSupportedf("scheduled actions on this juju version")
+	}
+	receivers := make([]string, len(args.Receivers))
+	for i, receiver := range args.Receivers {
+		if strings.HasSuffix(receiver, "/leader") {
+			receivers[i] = receiver
+		} else {
+			receivers[i] = names.NewUnitTag(receiver).String()
+		}
+	}
+	arg := params.ScheduleActionsArgs{
+		Schedules: []params.ScheduleActionArg{{
+			Name:       args.Name,
+			Schedule:   args.Schedule,
+			Receivers:  receivers,
+			Action:     args.Action,
+			Parameters: args.Parameters,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "AddSchedules", arg, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// ListSchedules returns the scheduled actions of the model.
+func (c *Client) ListSchedules(ctx context.Context) ([]ScheduledAction, error) {
+	if c.facade.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("scheduled actions on this juju version")
+	}
+	var results params.ScheduledActionResults
+	if err := c.facade.FacadeCall(ctx, "ListSchedules", nil, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	schedules := make([]ScheduledAction, len(results.Results))
+	for i, result := range results.Results {
+		schedules[i] = unmarshallScheduledAction(result)
+	}
+	return schedules, nil
+}
+
+// RemoveSchedules removes the named scheduled actions.
+func (c *Client) RemoveSchedules(ctx context.Context, scheduleNames []string) error {
+	if c.facade.BestAPIVersion() < 8 {
+		return errors.NotSupportedf("scheduled actions on this juju version")
+	}
+	arg := params.RemoveScheduledActionsArgs{Names: scheduleNames}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RemoveSchedules", arg, &results); err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(scheduleNames) {
+		return errors.Errorf("expected %d results, got %d", len(scheduleNames), len(results.Results))
+	}
+	return results.Combine()
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/action"
+	"github.com/juju/juju/rpc/params"
+)
+
+type scheduleSuite struct{}
+
+func TestScheduleSuite(t *testing.T) {
+	tc.Run(t, &scheduleSuite{})
+}
+
+func (s *scheduleSuite) TestAddSchedule(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ScheduleActionsArgs{
+		Schedules: []params.ScheduleActionArg{{
+			Name:       "nightly",
+			Schedule:   "0 2 * * *",
+			Receivers:  []string{"unit-mysql-0", "mysql/leader"},
+			Action:     "backup",
+			Parameters: map[string]any{"target": "s3"},
+		}},
+	}
+	ress := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "AddSchedules", args, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(ress))
+		return nil
+	})
+	client := action.NewClientFromCaller(mockFacadeCaller)
+
+	err := client.AddSchedule(c.Context(), action.ScheduleArgs{
+		Name:       "nightly",
+		Schedule:   "0 2 * * *",
+		Receivers:  []string{"mysql/0", "mysql/leader"},
+		Action:     "backup",
+		Parameters: map[string]any{"target": "s3"},
+	})
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *scheduleSuite) TestAddScheduleNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	client := action.NewClientFromCaller(mockFacadeCaller)
+
+	err := client.AddSchedule(c.Context(), action.ScheduleArgs{Name: "nightly"})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *scheduleSuite) TestListSchedules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	nextRun := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	ress := params.ScheduledActionResults{
+		Results: []params.ScheduledAction{{
+			Name:             "nightly",
+			Schedule:         "0 2 * * *",
+			Receivers:        []string{"unit-mysql-0", "mysql/leader"},
+			Action:           "backup",
+			CreatedByTag:     "user-fred",
+			Created:          created,
+			NextRun:          nextRun,
+			LastOperationTag: "operation-42",
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "ListSchedules", nil, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(ress))
+		return nil
+	})
+	client := action.NewClientFromCaller(mockFacadeCaller)
+
+	result, err := client.ListSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []action.ScheduledAction{{
+		Name:            "nightly",
+		Schedule:        "0 2 * * *",
+		Receivers:       []string{"mysql/0", "mysql/leader"},
+		Action:          "backup",
+		CreatedBy:       "fred",
+		Created:         created,
+		NextRun:         nextRun,
+		LastOperationID: "42",
+	}})
+}
+
+func (s *scheduleSuite) TestRemoveSchedules(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RemoveScheduledActionsArgs{Names: []string{"nightly", "hourly"}}
+	ress := params.ErrorResults{
+		Results: []params.ErrorResult{{}, {Error: &params.Error{Message: `scheduled action "hourly" not found`}}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "RemoveSchedules", args, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(ress))
+		return nil
+	})
+	client := action.NewClientFromCaller(mockFacadeCaller)
+
+	err := client.RemoveSchedules(c.Context(), []string{"nightly", "hourly"})
+	c.Assert(err, tc.ErrorMatches, `scheduled action "hourly" not found`)
+}
@@ -30,6 +30,14 @@ type Operation struct {
 	Status    string
 	Actions   []ActionResult
 	Error     error
+
+	// Schedule is the name of the scheduled action which started the
+	// operation, if any.
+	Schedule string
+
+	// ScheduledBy is the name of the user on whose behalf the scheduled
+	// action started the operation, if any.
+	ScheduledBy string
 }
 
 // ActionMessage represents a logged message on an action.
@@ -98,6 +106,37 @@ type RunParams struct {
 	ExecutionGroup *string
 }
 
+// ScheduleArgs holds the arguments for scheduling an action to run
+// repeatedly.
+type ScheduleArgs struct {
+	// Name uniquely identifies the scheduled action in the model.
+	Name string
+	// Schedule is the cron expression saying when the action runs.
+	Schedule string
+	// Receivers are the unit names, or leader syntax of the form
+	// <application>/leader, of the units to run the action on.
+	Receivers  []string
+	Action     string
+	Parameters map[string]any
+}
+
+// ScheduledAction is an action which runs repeatedly on a cron schedule.
+type ScheduledAction struct {
+	Name       string
+	Schedule   string
+	Receivers  []string
+	Action     string
+	Parameters map[string]any
+	CreatedBy  string
+	Created    time.Time
+	NextRun    time.Time
+	LastRun    time.Time
+
+	// LastOperationID is the ID of the operation started by the most
+	// recent run, if any.
+	LastOperationID string
+}
+
 func unmarshallEnqueuedActions(in params.EnqueuedActions) (EnqueuedActions, error) {
 	tag, err := names.ParseOperationTag(in.OperationTag)
 	if err != nil {
@@ -176,6 +215,10 @@ func unmarshallOperation(in params.OperationResult) Operation {
 		Started:   in.Started,
 		Completed: in.Completed,
 		Status:    in.Status,
+		Schedule:  in.Schedule,
+	}
+	if tag, err := names.ParseUserTag(in.ScheduledByTag); err == nil {
+		result.ScheduledBy = tag.Id()
 	}
 	if in.Error != nil {
 		result.Error = in.Error
@@ -206,3 +249,29 @@ func unmarshallActionSpecs(in map[string]params.ActionSpec) map[string]ActionSpe
 	}
 	return result
 }
+
+func unmarshallScheduledAction(in params.ScheduledAction) ScheduledAction {
+	result := ScheduledAction{
+		Name:       in.Name,
+		Schedule:   in.Schedule,
+		Receivers:  make([]string, len(in.Receivers)),
+		Action:     in.Action,
+		Parameters: in.Parameters,
+		Created:    in.Created,
+		NextRun:    in.NextRun,
+		LastRun:    in.LastRun,
+	}
+	for i, receiver := range in.Receivers {
+		result.Receivers[i] = receiver
+		if tag, err := names.ParseUnitTag(receiver); err == nil {
+			result.Receivers[i] = tag.Id()
+		}
+	}
+	if tag, err := names.ParseUserTag(in.CreatedByTag); err == nil {
+		result.CreatedBy = tag.Id()
+	}
+	if tag, err := names.ParseOperationTag(in.LastOperationTag); err == nil {
+		result.LastOperationID = tag.Id()
+	}
+	return result
+}
@@ -298,6 +298,30 @@ type SetCharmConfig struct {
 	// EndpointBindings is a map of operator-defined endpoint names to
 	// space names to be merged with any existing endpoint bindings.
 	EndpointBindings map[string]string
+
+	// Rollout, if set, refreshes the units of the application a batch at a
+	// time rather than all at once. This field is only understood by
+	// Application facade version 23 and greater.
+	Rollout *RefreshRollout
+}
+
+// RefreshRollout describes how the refresh of an application is staged
+// across its units.
+type RefreshRollout struct {
+	// BatchSize is the number of units refreshed in each batch.
+	BatchSize int
+
+	// BatchPercent is the percentage of the application's units refreshed
+	// in each batch. It is only used if BatchSize is zero.
+	BatchPercent int
+
+	// BatchTimeout is how long the units of a batch have to become active
+	// before the refresh is considered failed.
+	BatchTimeout time.Duration
+
+	// RollbackOnError reverts the application to its previous charm when the
+	// refresh fails, rather than pausing it.
+	RollbackOnError bool
 }
 
 // SetCharm sets the charm for a given application.
@@ -339,9 +363,38 @@ func (c *Client) SetCharm(ctx context.Context, cfg SetCharmConfig) error {
 		StorageDirectives:  storageDirectives,
 		EndpointBindings:   cfg.EndpointBindings,
 	}
+	if cfg.Rollout != nil {
+		if c.BestAPIVersion() < 23 {
+			// Staged refreshes were introduced in ApplicationAPIV23.
+			return errors.NotSupportedf("staged refresh on this version of Juju")
+		}
+		args.Rollout = &params.RefreshRolloutArgs{
+			BatchSize:       cfg.Rollout.BatchSize,
+			BatchPercent:    cfg.Rollout.BatchPercent,
+			BatchTimeout:    cfg.Rollout.BatchTimeout,
+			RollbackOnError: cfg.Rollout.RollbackOnError,
+		}
+	}
 	return c.facade.FacadeCall(ctx, "SetCharm", args, nil)
 }
 
+// ContinueRefresh resumes the paused staged refresh of the named
+// application.
+func (c *Client) ContinueRefresh(ctx context.Context, appName string) error {
+	if c.BestAPIVersion() < 23 {
+		// Staged refreshes were introduced in ApplicationAPIV23.
+		return errors.NotSupportedf("staged refresh on this version of Juju")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(appName).String()}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "ContinueRefresh", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // AddUnitsParams contains parameters for the AddUnits API method.
 type AddUnitsParams struct {
 	// ApplicationName is the name of the application to which units
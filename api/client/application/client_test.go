@@ -311,6 +311,100 @@ func (s *applicationSuite) TestSetCharm(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *applicationSuite) TestSetCharmRollout(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ApplicationSetCharmV2{
+		ApplicationName: "application",
+		CharmURL:        "ch:application-1",
+		CharmOrigin: &params.CharmOrigin{
+			Source: "charm-hub",
+			Risk:   "edge",
+		},
+		Channel: "edge",
+		Rollout: &params.RefreshRolloutArgs{
+			BatchPercent:    20,
+			BatchTimeout:    5 * time.Minute,
+			RollbackOnError: true,
+		},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetCharm", args, nil).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.SetCharm(c.Context(), application.SetCharmConfig{
+		ApplicationName: "application",
+		CharmID: application.CharmID{
+			URL: "ch:application-1",
+			Origin: apicharm.Origin{
+				Source: "charm-hub",
+				Risk:   "edge",
+			},
+		},
+		Rollout: &application.RefreshRollout{
+			BatchPercent:    20,
+			BatchTimeout:    5 * time.Minute,
+			RollbackOnError: true,
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestSetCharmRolloutNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(22).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.SetCharm(c.Context(), application.SetCharmConfig{
+		ApplicationName: "application",
+		CharmID: application.CharmID{
+			URL: "ch:application-1",
+		},
+		Rollout: &application.RefreshRollout{
+			BatchSize:    1,
+			BatchTimeout: time.Minute,
+		},
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *applicationSuite) TestContinueRefresh(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: "application-foo"}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ContinueRefresh", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, result any) error {
+			reflect.ValueOf(result).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.ContinueRefresh(c.Context(), "foo")
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
 func (s *applicationSuite) TestDestroyApplications(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
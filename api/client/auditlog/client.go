@@ -0,0 +1,41 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client provides access to the AuditLog facade, used to query the audit
+// log of API requests recorded by the controller.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient returns a new AuditLog client.
+func NewClient(caller base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(caller, "AuditLog", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// Query returns the audit log entries matching the given filter, ordered
+// by the time their request was made.
+func (c *Client) Query(ctx context.Context, args params.AuditLogQueryArgs) ([]params.AuditLogEntry, error) {
+	var result params.AuditLogQueryResult
+	if err := c.facade.FacadeCall(ctx, "Query", args, &result); err != nil {
+		return nil, err
+	}
+	return result.Entries, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/auditlog"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) TestQuery(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AuditLogQueryArgs{User: "alice", Outcome: "failed", Limit: 10}
+	entries := []params.AuditLogEntry{{
+		ConversationID: "0123456789abcdef",
+		Who:            "alice",
+		Facade:         "Application",
+		Method:         "Deploy",
+		When:           time.Now(),
+		Outcome:        "failed",
+	}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "Query", args, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		*(result.(*params.AuditLogQueryResult)) = params.AuditLogQueryResult{Entries: entries}
+		return nil
+	})
+	client := auditlog.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	result, err := client.Query(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, entries)
+}
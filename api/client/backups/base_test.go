@@ -18,14 +18,17 @@ import (
 type baseSuite struct {
 	testhelpers.IsolationSuite
 
-	facade    *mocks.MockFacadeCaller
-	apiCaller *mocks.MockAPICallCloser
+	facade       *mocks.MockFacadeCaller
+	clientFacade *mocks.MockClientFacade
+	apiCaller    *mocks.MockAPICallCloser
 }
 
 func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.facade = mocks.NewMockFacadeCaller(ctrl)
+	s.clientFacade = mocks.NewMockClientFacade(ctrl)
+	s.clientFacade.EXPECT().BestAPIVersion().Return(4).AnyTimes()
 	s.apiCaller = mocks.NewMockAPICallCloser(ctrl)
 
 	return ctrl
@@ -33,8 +36,9 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 func (s *baseSuite) newClient() *Client {
 	return &Client{
-		facade: s.facade,
-		st:     s.apiCaller,
+		ClientFacade: s.clientFacade,
+		facade:       s.facade,
+		st:           s.apiCaller,
 	}
 }
 
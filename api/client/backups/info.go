@@ -0,0 +1,26 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Info returns the metadata of the backup with the given ID.
+func (c *Client) Info(ctx context.Context, id string) (*params.BackupsMetadataResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, notSupported
+	}
+
+	var result params.BackupsMetadataResult
+	args := params.BackupsInfoArgs{ID: id}
+	if err := c.facade.FacadeCall(ctx, "Info", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+var notSupported = errors.NotSupportedf("managing stored backups on this juju version")
+
+// List returns the metadata of all the backups stored on the controller.
+func (c *Client) List(ctx context.Context) (*params.BackupsListResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, notSupported
+	}
+
+	var result params.BackupsListResult
+	if err := c.facade.FacadeCall(ctx, "List", params.BackupsListArgs{}, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
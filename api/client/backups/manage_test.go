@@ -0,0 +1,149 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/base/mocks"
+	backupstesting "github.com/juju/juju/core/backups/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type manageSuite struct {
+	baseSuite
+}
+
+func TestManageSuite(t *testing.T) {
+	tc.Run(t, &manageSuite{})
+}
+
+func (s *manageSuite) TestList(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := backupstesting.NewMetadata()
+	result := params.BackupsListResult{
+		List: []params.BackupsMetadataResult{params.CreateResult(meta, meta.ID())},
+	}
+	s.facade.EXPECT().FacadeCall(
+		gomock.Any(), "List", params.BackupsListArgs{}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(result))
+		return nil
+	})
+
+	client := s.newClient()
+	got, err := client.List(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.List, tc.HasLen, 1)
+	s.checkMetadataResult(c, &got.List[0], meta)
+}
+
+func (s *manageSuite) TestInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := backupstesting.NewMetadata()
+	result := params.CreateResult(meta, meta.ID())
+	s.facade.EXPECT().FacadeCall(
+		gomock.Any(), "Info", params.BackupsInfoArgs{ID: meta.ID()}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(result))
+		return nil
+	})
+
+	client := s.newClient()
+	got, err := client.Info(c.Context(), meta.ID())
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkMetadataResult(c, got, meta)
+}
+
+func (s *manageSuite) TestRemove(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	result := params.ErrorResults{Results: []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: "not found", Code: params.CodeNotFound}},
+	}}
+	s.facade.EXPECT().FacadeCall(
+		gomock.Any(), "Remove", params.BackupsRemoveArgs{IDs: []string{"one", "two"}}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(result))
+		return nil
+	})
+
+	client := s.newClient()
+	got, err := client.Remove(c.Context(), "one", "two")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, result.Results)
+}
+
+func (s *manageSuite) TestRestore(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := backupstesting.NewMetadata()
+	result := params.CreateResult(meta, meta.ID())
+	s.facade.EXPECT().FacadeCall(
+		gomock.Any(), "Restore", params.RestoreArgs{BackupID: meta.ID()}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(result))
+		return nil
+	})
+
+	client := s.newClient()
+	got, err := client.Restore(c.Context(), meta.ID())
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkMetadataResult(c, got, meta)
+}
+
+func (s *manageSuite) TestUpload(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, http.MethodPut)
+		c.Check(r.URL.String(), tc.Equals, "/backups")
+		data, err := io.ReadAll(r.Body)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(string(data), tc.Equals, "archive")
+
+		w.Header().Set("Content-Type", params.ContentTypeJSON)
+		_, err = w.Write([]byte(`{"id":"juju-backup-20261017-100405.tar.gz"}`))
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+	httpClient := &httprequest.Client{BaseURL: srv.URL}
+
+	s.apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeModel).Return(httpClient, nil)
+
+	client := s.newClient()
+	id, err := client.Upload(c.Context(), strings.NewReader("archive"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, "juju-backup-20261017-100405.tar.gz")
+}
+
+func (s *manageSuite) TestNotSupported(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	clientFacade := mocks.NewMockClientFacade(ctrl)
+	clientFacade.EXPECT().BestAPIVersion().Return(3).AnyTimes()
+
+	client := s.newClient()
+	client.ClientFacade = clientFacade
+	_, err := client.List(c.Context())
+	c.Check(err, tc.Satisfies, errors.IsNotSupported)
+	_, err = client.Restore(c.Context(), "juju-backup-20261017-100405.tar.gz")
+	c.Check(err, tc.Satisfies, errors.IsNotSupported)
+}
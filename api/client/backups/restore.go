@@ -0,0 +1,27 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Restore restores the backup with the given ID, stored on the controller,
+// onto the controller.
+func (c *Client) Restore(ctx context.Context, id string) (*params.BackupsMetadataResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, notSupported
+	}
+
+	var result params.BackupsMetadataResult
+	args := params.RestoreArgs{BackupID: id}
+	if err := c.facade.FacadeCall(ctx, "Restore", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Upload stores the backup archive read from the reader on the controller,
+// so that it can be restored. The ID of the stored backup is returned.
+func (c *Client) Upload(ctx context.Context, archive io.Reader) (string, error) {
+	if c.BestAPIVersion() < 4 {
+		return "", notSupported
+	}
+
+	httpClient, err := c.st.HTTPClient(base.HTTPClientScopeModel)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, "/backups", archive)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", params.ContentTypeRaw)
+
+	var result params.BackupsUploadResult
+	if err := httpClient.Do(ctx, req, &result); err != nil {
+		return "", errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return result.ID, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package changestream provides a client for the change stream export
+// API, which exposes a resumable feed of the changes made to a model.
+package changestream
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// OldestRetained is the cursor to read changes from the oldest change
+// retained by the controller.
+const OldestRetained int64 = -1
+
+// Event is a change made to a model.
+type Event struct {
+	// ID is the stream ID of the change. IDs increase monotonically.
+	ID int64
+	// Namespace is the namespace of the change, typically the table that
+	// was changed.
+	Namespace string
+	// Changed is the value that was changed, typically the primary key of
+	// the changed row.
+	Changed string
+	// ChangeType is one of create, update or delete.
+	ChangeType string
+	// CreatedAt is the time the change was recorded.
+	CreatedAt time.Time
+}
+
+// Page is a page of changes.
+type Page struct {
+	// Events are the changes, ordered by ID.
+	Events []Event
+	// Cursor is the cursor to read the next page of changes after.
+	Cursor int64
+	// More is true if there are more changes after the cursor.
+	More bool
+}
+
+// ChangesArgs holds the arguments for reading a page of changes.
+type ChangesArgs struct {
+	// After is the cursor to read the changes after, either the cursor of
+	// a previous page or OldestRetained.
+	After int64
+	// Limit is the maximum number of changes to return. If it is zero,
+	// the controller's default is used.
+	Limit int
+	// Namespaces restricts the changes to the namespaces, if not empty.
+	Namespaces []string
+	// Consumer names the reader of the changes. The controller retains the
+	// changes after the last cursor read by a named consumer, so that it
+	// doesn't miss changes between reads. Consumers belong to the user
+	// reading the changes, and only model or controller admins can create
+	// them.
+	Consumer string
+}
+
+type changesParams struct {
+	httprequest.Route `httprequest:"GET /changes"`
+	After             int64    `httprequest:"after,form"`
+	Limit             int      `httprequest:"limit,form,omitempty"`
+	Namespaces        []string `httprequest:"namespace,form,omitempty"`
+	Consumer          string   `httprequest:"consumer,form,omitempty"`
+}
+
+// Client reads the change stream of a model.
+type Client struct {
+	st base.APICaller
+}
+
+// NewClient returns a new change stream client for the model the API
+// caller is connected to.
+func NewClient(caller base.APICaller) *Client {
+	return &Client{st: caller}
+}
+
+// Changes returns a page of the changes made to the model after the
+// cursor. An error satisfying [errors.NotFound] is returned if the changes
+// after the cursor are no longer retained by the controller, in which case
+// the consumer must resynchronise and read from OldestRetained.
+func (c *Client) Changes(ctx context.Context, args ChangesArgs) (Page, error) {
+	httpClient, err := c.st.HTTPClient(base.HTTPClientScopeModel)
+	if err != nil {
+		return Page{}, errors.Trace(err)
+	}
+
+	var result params.ChangeStreamPage
+	err = httpClient.Call(ctx, &changesParams{
+		After:      args.After,
+		Limit:      args.Limit,
+		Namespaces: args.Namespaces,
+		Consumer:   args.Consumer,
+	}, &result)
+	if err != nil {
+		return Page{}, errors.Trace(apiservererrors.RestoreError(err))
+	}
+
+	page := Page{
+		Events: make([]Event, len(result.Events)),
+		Cursor: result.Cursor,
+		More:   result.More,
+	}
+	for i, event := range result.Events {
+		page.Events[i] = Event{
+			ID:         event.ID,
+			Namespace:  event.Namespace,
+			Changed:    event.Changed,
+			ChangeType: event.ChangeType,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	return page, nil
+}
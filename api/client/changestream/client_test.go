@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct {
+	apiCaller *mocks.MockAPICaller
+}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) TestChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, "GET")
+		c.Check(r.URL.Path, tc.Equals, "/changes")
+		c.Check(r.URL.Query(), tc.DeepEquals, url.Values{
+			"after":     {"42"},
+			"limit":     {"10"},
+			"namespace": {"application", "unit"},
+			"consumer":  {"cmdb"},
+		})
+		w.Header().Set("Content-Type", params.ContentTypeJSON)
+		err := json.NewEncoder(w).Encode(params.ChangeStreamPage{
+			Events: []params.ChangeStreamEvent{{
+				ID:         43,
+				Namespace:  "application",
+				Changed:    "app-uuid",
+				ChangeType: "create",
+				CreatedAt:  now,
+			}},
+			Cursor: 43,
+			More:   true,
+		})
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+
+	s.apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeModel).Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	client := NewClient(s.apiCaller)
+	page, err := client.Changes(c.Context(), ChangesArgs{
+		After:      42,
+		Limit:      10,
+		Namespaces: []string{"application", "unit"},
+		Consumer:   "cmdb",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page, tc.DeepEquals, Page{
+		Events: []Event{{
+			ID:         43,
+			Namespace:  "application",
+			Changed:    "app-uuid",
+			ChangeType: "create",
+			CreatedAt:  now,
+		}},
+		Cursor: 43,
+		More:   true,
+	})
+}
+
+func (s *clientSuite) TestChangesOldestRetained(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Query(), tc.DeepEquals, url.Values{
+			"after": {"-1"},
+		})
+		w.Header().Set("Content-Type", params.ContentTypeJSON)
+		err := json.NewEncoder(w).Encode(params.ChangeStreamPage{Cursor: 7})
+		c.Check(err, tc.ErrorIsNil)
+	}))
+	defer srv.Close()
+
+	s.apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeModel).Return(&httprequest.Client{BaseURL: srv.URL}, nil)
+
+	client := NewClient(s.apiCaller)
+	page, err := client.Changes(c.Context(), ChangesArgs{After: OldestRetained})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page, tc.DeepEquals, Page{
+		Events: []Event{},
+		Cursor: 7,
+	})
+}
+
+func (s *clientSuite) TestChangesHTTPClientError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.apiCaller.EXPECT().HTTPClient(base.HTTPClientScopeModel).Return(nil, errors.New("boom"))
+
+	client := NewClient(s.apiCaller)
+	_, err := client.Changes(c.Context(), ChangesArgs{})
+	c.Check(err, tc.ErrorMatches, "boom")
+}
+
+func (s *clientSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.apiCaller = mocks.NewMockAPICaller(ctrl)
+
+	return ctrl
+}
@@ -146,10 +146,18 @@ func (c *Client) GetDownloadInfo(ctx context.Context, curl *charm.URL, origin ap
 // when retrieving the charm from the charm store, an error
 // satisfying params.IsCodeUnauthorized will be returned.
 func (c *Client) AddCharm(ctx context.Context, curl *charm.URL, origin apicharm.Origin, force bool) (apicharm.Origin, error) {
+	return c.AddCharmForApplication(ctx, curl, origin, force, "")
+}
+
+// AddCharmForApplication adds the given charm URL to the model to refresh
+// the named application. Unlike AddCharm, it only requires a refresh grant
+// on the application rather than write access to the model.
+func (c *Client) AddCharmForApplication(ctx context.Context, curl *charm.URL, origin apicharm.Origin, force bool, application string) (apicharm.Origin, error) {
 	args := params.AddCharmWithOrigin{
-		URL:    curl.String(),
-		Origin: origin.ParamsCharmOrigin(),
-		Force:  force,
+		URL:         curl.String(),
+		Origin:      origin.ParamsCharmOrigin(),
+		Force:       force,
+		Application: application,
 	}
 	var result params.CharmOriginResult
 	if err := c.facade.FacadeCall(ctx, "AddCharm", args, &result); err != nil {
@@ -221,6 +221,42 @@ func (s *addCharmSuite) TestAddCharm(c *tc.C) {
 	c.Assert(got, tc.DeepEquals, origin)
 }
 
+func (s *addCharmSuite) TestAddCharmForApplication(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	curl := charm.MustParseURL("ch:testme-2")
+	origin := apicharm.Origin{
+		Source:       "charm-hub",
+		Risk:         "stable",
+		Revision:     &curl.Revision,
+		Architecture: arch.DefaultArchitecture,
+		Base:         corebase.MakeDefaultBase("ubuntu", "18.04"),
+	}
+	facadeArgs := params.AddCharmWithOrigin{
+		URL:         curl.String(),
+		Origin:      origin.ParamsCharmOrigin(),
+		Application: "testme",
+	}
+	result := new(params.CharmOriginResult)
+	actualResult := params.CharmOriginResult{
+		Origin: origin.ParamsCharmOrigin(),
+	}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "AddCharm", facadeArgs, result,
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(actualResult))
+		return nil
+	})
+
+	client := charms.NewClientWithFacade(mockFacadeCaller, nil)
+	got, err := client.AddCharmForApplication(c.Context(), curl, origin, false, "testme")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got, tc.DeepEquals, origin)
+}
+
 func (s *charmsMockSuite) TestListCharmResources(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
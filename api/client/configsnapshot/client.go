@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"context"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client provides access to the ConfigSnapshot facade, used to take named
+// snapshots of the configuration of a model and roll back to them.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient returns a new ConfigSnapshot client.
+func NewClient(caller base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(caller, "ConfigSnapshot", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// CreateSnapshot records the current configuration of the model as a
+// snapshot with the given name. If replace is true, any existing snapshot
+// with the same name is replaced.
+func (c *Client) CreateSnapshot(ctx context.Context, name string, replace bool) error {
+	args := params.ConfigSnapshotArg{
+		Name:    name,
+		Replace: replace,
+	}
+	return c.facade.FacadeCall(ctx, "CreateSnapshot", args, nil)
+}
+
+// ListSnapshots returns the config snapshots of the model, ordered by
+// creation time.
+func (c *Client) ListSnapshots(ctx context.Context) ([]params.ConfigSnapshotInfo, error) {
+	var result params.ConfigSnapshotsResult
+	if err := c.facade.FacadeCall(ctx, "ListSnapshots", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Snapshots, nil
+}
+
+// RemoveSnapshot removes the config snapshot with the given name.
+func (c *Client) RemoveSnapshot(ctx context.Context, name string) error {
+	args := params.ConfigSnapshotArg{Name: name}
+	return c.facade.FacadeCall(ctx, "RemoveSnapshot", args, nil)
+}
+
+// RollbackConfig rolls the configuration of the model back to the config
+// snapshot with the given name, and returns the changes made. If dryRun is
+// true, the changes are returned without being made.
+func (c *Client) RollbackConfig(ctx context.Context, name string, dryRun bool) ([]params.ConfigSnapshotChange, error) {
+	args := params.ConfigRollbackArg{
+		Name:   name,
+		DryRun: dryRun,
+	}
+	var result params.ConfigRollbackResult
+	if err := c.facade.FacadeCall(ctx, "RollbackConfig", args, &result); err != nil {
+		return nil, err
+	}
+	return result.Changes, nil
+}
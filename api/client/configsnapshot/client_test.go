@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/configsnapshot"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) TestCreateSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "CreateSnapshot", params.ConfigSnapshotArg{Name: "before-upgrade", Replace: true}, nil,
+	).Return(nil)
+	client := configsnapshot.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	err := client.CreateSnapshot(c.Context(), "before-upgrade", true)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestListSnapshots(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	snapshots := []params.ConfigSnapshotInfo{{Name: "before-upgrade", CreatedAt: time.Now()}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "ListSnapshots", nil, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		*(result.(*params.ConfigSnapshotsResult)) = params.ConfigSnapshotsResult{Snapshots: snapshots}
+		return nil
+	})
+	client := configsnapshot.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	result, err := client.ListSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, snapshots)
+}
+
+func (s *clientSuite) TestRemoveSnapshot(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "RemoveSnapshot", params.ConfigSnapshotArg{Name: "before-upgrade"}, nil,
+	).Return(&params.Error{Code: params.CodeNotFound, Message: `config snapshot "before-upgrade" not found`})
+	client := configsnapshot.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	err := client.RemoveSnapshot(c.Context(), "before-upgrade")
+	c.Check(params.IsCodeNotFound(err), tc.IsTrue)
+}
+
+func (s *clientSuite) TestRollbackConfig(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	changes := []params.ConfigSnapshotChange{{
+		Kind:    "model-config",
+		Key:     "logging-config",
+		Current: "<root>=DEBUG",
+		Target:  "<root>=INFO",
+	}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "RollbackConfig", params.ConfigRollbackArg{Name: "before-upgrade", DryRun: true}, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		*(result.(*params.ConfigRollbackResult)) = params.ConfigRollbackResult{Changes: changes}
+		return nil
+	})
+	client := configsnapshot.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	result, err := client.RollbackConfig(c.Context(), "before-upgrade", true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, changes)
+}
@@ -37,8 +37,23 @@ func NewClient(caller base.APICallCloser, options ...Option) *Client {
 type ControllerDetails struct {
 	ControllerID string
 	APIEndpoints []string
+
+	// DqliteNodeID is the ID of the controller's node in the Dqlite cluster,
+	// zero if the controller has not yet joined it.
+	DqliteNodeID uint64
+
+	// DqliteAddress is the address the controller's Dqlite node is bound to.
+	DqliteAddress string
+
+	// DqliteRole is the role of the controller's node in the Dqlite cluster
+	// (voter, standby or spare), empty if it is not a member of the cluster.
+	// It is only reported by controllers supporting version 4 or later of the
+	// facade.
+	DqliteRole string
 }
 
+// ControllerDetails returns the details of each controller, keyed by
+// controller ID.
 func (c *Client) ControllerDetails(ctx context.Context) (map[string]ControllerDetails, error) {
 	if c.BestAPIVersion() < 3 {
 		return nil, errors.NotImplemented
@@ -58,8 +73,11 @@ func (c *Client) ControllerDetails(ctx context.Context) (map[string]ControllerDe
 			return nil, apiservererrors.RestoreError(r.Error)
 		}
 		result[r.ControllerId] = ControllerDetails{
-			ControllerID: r.ControllerId,
-			APIEndpoints: r.APIAddresses,
+			ControllerID:  r.ControllerId,
+			APIEndpoints:  r.APIAddresses,
+			DqliteNodeID:  r.DqliteNodeID,
+			DqliteAddress: r.DqliteAddress,
+			DqliteRole:    r.DqliteRole,
 		}
 	}
 	return result, nil
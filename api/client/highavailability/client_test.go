@@ -57,6 +57,49 @@ func (s *clientSuite) TestControllerDetails(c *tc.C) {
 	})
 }
 
+func (s *clientSuite) TestControllerDetailsDqliteMembership(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.ControllerDetailsResults)
+	results := params.ControllerDetailsResults{
+		Results: []params.ControllerDetails{{
+			ControllerId:  "0",
+			APIAddresses:  []string{"10.0.0.1:17070"},
+			DqliteNodeID:  1,
+			DqliteAddress: "10.0.0.1",
+			DqliteRole:    "voter",
+		}, {
+			ControllerId: "1",
+		}}}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "ControllerDetails", nil, res,
+	).DoAndReturn(func(_ context.Context, _ string, _ any, resPtr any) error {
+		reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(results))
+		return nil
+	})
+	mockClient := basemocks.NewMockClientFacade(ctrl)
+	mockClient.EXPECT().BestAPIVersion().Return(4)
+	client := highavailability.NewClientFromCaller(mockFacadeCaller, mockClient)
+
+	result, err := client.ControllerDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, map[string]highavailability.ControllerDetails{
+		"0": {
+			ControllerID:  "0",
+			APIEndpoints:  []string{"10.0.0.1:17070"},
+			DqliteNodeID:  1,
+			DqliteAddress: "10.0.0.1",
+			DqliteRole:    "voter",
+		},
+		"1": {
+			ControllerID: "1",
+		},
+	})
+}
+
 func (s *clientSuite) TestControllerDetailsNotSupported(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
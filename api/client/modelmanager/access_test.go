@@ -5,6 +5,7 @@ package modelmanager_test
 
 import (
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
@@ -191,3 +192,95 @@ func (s *accessSuite) TestInvalidResultCount(c *tc.C) {
 	err := client.GrantModel(c.Context(), "bob", "write", someModelUUID, someModelUUID)
 	c.Assert(err, tc.ErrorMatches, "expected 2 results, got 0")
 }
+
+func (s *accessSuite) TestGrantApplication(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string, version int, id, request string, a, result any) error {
+			c.Check(objType, tc.Equals, "ModelManager")
+			c.Check(request, tc.Equals, "ModifyApplicationAccess")
+			c.Check(a, tc.DeepEquals, params.ModifyApplicationAccessRequest{
+				Changes: []params.ModifyApplicationAccess{{
+					UserTag:         names.NewUserTag("bob").String(),
+					Action:          params.GrantModelAccess,
+					Access:          params.ApplicationRunActionAccess,
+					ModelTag:        someModelTag,
+					ApplicationName: "mysql",
+				}},
+			})
+
+			resp := assertResponse(c, result)
+			*resp = params.ErrorResults{Results: []params.ErrorResult{{Error: nil}}}
+			return nil
+		},
+		BestVersion: 12,
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.GrantApplication(c.Context(), "bob", "run-action", someModelUUID, "mysql")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *accessSuite) TestRevokeApplicationInvalidAccess(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string, version int, id, request string, a, result any) error {
+			c.Fatalf("unexpected api call")
+			return nil
+		},
+		BestVersion: 12,
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.RevokeApplication(c.Context(), "bob", "consume", someModelUUID, "mysql")
+	c.Assert(err, tc.ErrorMatches, `"consume" application access not valid`)
+}
+
+func (s *accessSuite) TestGrantApplicationNotSupported(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string, version int, id, request string, a, result any) error {
+			c.Fatalf("unexpected api call")
+			return nil
+		},
+		BestVersion: 11,
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.GrantApplication(c.Context(), "bob", "write", someModelUUID, "mysql")
+	c.Assert(err, tc.ErrorMatches, "application access on this juju version not supported")
+}
+
+func (s *accessSuite) TestGrantModelUntil(c *tc.C) {
+	expiry := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string, version int, id, request string, a, result any) error {
+			c.Check(objType, tc.Equals, "ModelManager")
+			c.Check(request, tc.Equals, "ModifyModelAccess")
+			c.Check(a, tc.DeepEquals, params.ModifyModelAccessRequest{
+				Changes: []params.ModifyModelAccess{{
+					UserTag:  names.NewUserTag("bob").String(),
+					Action:   params.GrantModelAccess,
+					Access:   params.ModelAdminAccess,
+					ModelTag: someModelTag,
+					Expiry:   &expiry,
+				}},
+			})
+
+			resp := assertResponse(c, result)
+			*resp = params.ErrorResults{Results: []params.ErrorResult{{Error: nil}}}
+			return nil
+		},
+		BestVersion: 13,
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.GrantModelUntil(c.Context(), "bob", "admin", expiry, someModelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *accessSuite) TestGrantModelUntilNotSupported(c *tc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string, version int, id, request string, a, result any) error {
+			c.Fatalf("unexpected api call")
+			return nil
+		},
+		BestVersion: 12,
+	}
+	client := modelmanager.NewClient(apiCaller)
+	err := client.GrantModelUntil(c.Context(), "bob", "admin", time.Now().Add(time.Hour), someModelUUID)
+	c.Assert(err, tc.ErrorMatches, "time-limited model access on this juju version not supported")
+}
@@ -376,15 +376,24 @@ func (c *Client) DestroyModel(ctx context.Context, tag names.ModelTag, destroySt
 
 // GrantModel grants a user access to the specified models.
 func (c *Client) GrantModel(ctx context.Context, user, access string, modelUUIDs ...string) error {
-	return c.modifyModelUser(ctx, params.GrantModelAccess, user, access, modelUUIDs)
+	return c.modifyModelUser(ctx, params.GrantModelAccess, user, access, nil, modelUUIDs)
+}
+
+// GrantModelUntil grants a user access to the specified models until the
+// given expiry time, after which the user reverts to their previous access.
+func (c *Client) GrantModelUntil(ctx context.Context, user, access string, expiry time.Time, modelUUIDs ...string) error {
+	if c.BestAPIVersion() < 13 {
+		return errors.NotSupportedf("time-limited model access on this juju version")
+	}
+	return c.modifyModelUser(ctx, params.GrantModelAccess, user, access, &expiry, modelUUIDs)
 }
 
 // RevokeModel revokes a user's access to the specified models.
 func (c *Client) RevokeModel(ctx context.Context, user, access string, modelUUIDs ...string) error {
-	return c.modifyModelUser(ctx, params.RevokeModelAccess, user, access, modelUUIDs)
+	return c.modifyModelUser(ctx, params.RevokeModelAccess, user, access, nil, modelUUIDs)
 }
 
-func (c *Client) modifyModelUser(ctx context.Context, action params.ModelAction, user, access string, modelUUIDs []string) error {
+func (c *Client) modifyModelUser(ctx context.Context, action params.ModelAction, user, access string, expiry *time.Time, modelUUIDs []string) error {
 	var args params.ModifyModelAccessRequest
 
 	if !names.IsValidUser(user) {
@@ -406,6 +415,7 @@ func (c *Client) modifyModelUser(ctx context.Context, action params.ModelAction,
 			Action:   action,
 			Access:   params.UserAccessPermission(modelAccess),
 			ModelTag: modelTag.String(),
+			Expiry:   expiry,
 		})
 	}
 
@@ -427,6 +437,63 @@ func (c *Client) modifyModelUser(ctx context.Context, action params.ModelAction,
 	return result.Combine()
 }
 
+// GrantApplication grants a user access to the named application in the
+// specified model.
+func (c *Client) GrantApplication(ctx context.Context, user, access, modelUUID, application string) error {
+	return c.modifyApplicationUser(ctx, params.GrantModelAccess, user, access, modelUUID, application, nil)
+}
+
+// GrantApplicationUntil grants a user access to the named application in the
+// specified model until the given expiry time.
+func (c *Client) GrantApplicationUntil(ctx context.Context, user, access, modelUUID, application string, expiry time.Time) error {
+	if c.BestAPIVersion() < 13 {
+		return errors.NotSupportedf("time-limited application access on this juju version")
+	}
+	return c.modifyApplicationUser(ctx, params.GrantModelAccess, user, access, modelUUID, application, &expiry)
+}
+
+// RevokeApplication revokes a user's access to the named application in the
+// specified model.
+func (c *Client) RevokeApplication(ctx context.Context, user, access, modelUUID, application string) error {
+	return c.modifyApplicationUser(ctx, params.RevokeModelAccess, user, access, modelUUID, application, nil)
+}
+
+func (c *Client) modifyApplicationUser(ctx context.Context, action params.ModelAction, user, access, modelUUID, application string, expiry *time.Time) error {
+	if c.BestAPIVersion() < 12 {
+		return errors.NotSupportedf("application access on this juju version")
+	}
+	if !names.IsValidUser(user) {
+		return errors.Errorf("invalid username: %q", user)
+	}
+	if !names.IsValidModel(modelUUID) {
+		return errors.Errorf("invalid model: %q", modelUUID)
+	}
+	if !names.IsValidApplication(application) {
+		return errors.Errorf("invalid application: %q", application)
+	}
+	applicationAccess := permission.Access(access)
+	if err := permission.ValidateApplicationAccess(applicationAccess); err != nil {
+		return errors.Trace(err)
+	}
+	args := params.ModifyApplicationAccessRequest{
+		Changes: []params.ModifyApplicationAccess{{
+			UserTag:         names.NewUserTag(user).String(),
+			Action:          action,
+			Access:          params.UserAccessPermission(applicationAccess),
+			ModelTag:        names.NewModelTag(modelUUID).String(),
+			ApplicationName: application,
+			Expiry:          expiry,
+		}},
+	}
+
+	var result params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "ModifyApplicationAccess", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
 // ModelDefaults returns the default values for various sources used when
 // creating a new model on the specified cloud.
 func (c *Client) ModelDefaults(ctx context.Context, cloud string) (config.ModelDefaultAttributes, error) {
@@ -239,3 +239,90 @@ func (c *Client) ResetPassword(ctx context.Context, username string) ([]byte, er
 	}
 	return result.SecretKey, nil
 }
+
+// AddGroup creates a new user group in the controller.
+func (c *Client) AddGroup(ctx context.Context, name string) error {
+	if c.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("user groups on this juju version")
+	}
+	args := params.AddGroups{
+		Groups: []params.AddGroup{{Name: name}},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "AddGroup", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// AddToGroup adds the users as members of the group.
+func (c *Client) AddToGroup(ctx context.Context, group string, usernames ...string) error {
+	return c.modifyGroupMembership(ctx, "AddToGroup", group, usernames)
+}
+
+// RemoveFromGroup removes the users from the membership of the group.
+func (c *Client) RemoveFromGroup(ctx context.Context, group string, usernames ...string) error {
+	return c.modifyGroupMembership(ctx, "RemoveFromGroup", group, usernames)
+}
+
+func (c *Client) modifyGroupMembership(ctx context.Context, methodCall, group string, usernames []string) error {
+	if c.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("user groups on this juju version")
+	}
+	var args params.ModifyGroupMembershipRequest
+	for _, username := range usernames {
+		if !names.IsValidUser(username) {
+			return errors.Errorf("%q is not a valid username", username)
+		}
+		args.Changes = append(args.Changes, params.ModifyGroupMembership{
+			Group:   group,
+			UserTag: names.NewUserTag(username).String(),
+		})
+	}
+
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, methodCall, args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(args.Changes) {
+		return errors.Errorf("expected %d results, got %d", len(args.Changes), len(results.Results))
+	}
+	return results.Combine()
+}
+
+// GrantGroup grants the group access to the given models or controller.
+func (c *Client) GrantGroup(ctx context.Context, group, access string, targets ...names.Tag) error {
+	return c.modifyGroupAccess(ctx, params.GrantModelAccess, group, access, targets)
+}
+
+// RevokeGroup revokes the group's access to the given models or controller.
+func (c *Client) RevokeGroup(ctx context.Context, group, access string, targets ...names.Tag) error {
+	return c.modifyGroupAccess(ctx, params.RevokeModelAccess, group, access, targets)
+}
+
+func (c *Client) modifyGroupAccess(ctx context.Context, action params.ModelAction, group, access string, targets []names.Tag) error {
+	if c.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("user groups on this juju version")
+	}
+	var args params.ModifyGroupAccessRequest
+	for _, target := range targets {
+		args.Changes = append(args.Changes, params.ModifyGroupAccess{
+			Group:     group,
+			Action:    action,
+			Access:    access,
+			TargetTag: target.String(),
+		})
+	}
+
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "ModifyGroupAccess", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != len(args.Changes) {
+		return errors.Errorf("expected %d results, got %d", len(args.Changes), len(results.Results))
+	}
+	return results.Combine()
+}
@@ -453,3 +453,92 @@ func (s *usermanagerSuite) TestResetPasswordResultCount(c *tc.C) {
 	_, err := client.ResetPassword(c.Context(), "foobar")
 	c.Assert(err, tc.ErrorMatches, "expected 1 result, got 2")
 }
+
+func (s *usermanagerSuite) TestAddGroup(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AddGroups{
+		Groups: []params.AddGroup{{Name: "sre"}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "group already exists"}}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddGroup", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, resPtr any) error {
+			reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddGroup(c.Context(), "sre")
+	c.Assert(err, tc.ErrorMatches, "group already exists")
+}
+
+func (s *usermanagerSuite) TestAddGroupNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(3)
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddGroup(c.Context(), "sre")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *usermanagerSuite) TestAddToGroup(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModifyGroupMembershipRequest{
+		Changes: []params.ModifyGroupMembership{
+			{Group: "sre", UserTag: "user-alice"},
+			{Group: "sre", UserTag: "user-bob"},
+		},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, 2),
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddToGroup", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, resPtr any) error {
+			reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddToGroup(c.Context(), "sre", "alice", "bob")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *usermanagerSuite) TestGrantGroup(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelTag := names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+	args := params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group:     "sre",
+			Action:    params.GrantModelAccess,
+			Access:    "admin",
+			TargetTag: modelTag.String(),
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, 1),
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ModifyGroupAccess", args, result).DoAndReturn(
+		func(_ context.Context, _ string, _ any, resPtr any) error {
+			reflect.ValueOf(resPtr).Elem().Set(reflect.ValueOf(results))
+			return nil
+		})
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.GrantGroup(c.Context(), "sre", "admin", modelTag)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+
+	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client provides access to the WaitFor facade, used to wait for the
+// entities in a model to reach a given state.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient returns a new WaitFor client.
+func NewClient(caller base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(caller, "WaitFor", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// WatchStatus returns a watcher that notifies of any change to the life or
+// status of the model, or of the applications, units and machines in it.
+func (c *Client) WatchStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	var result params.NotifyWatchResult
+	if err := c.facade.FacadeCall(ctx, "WatchStatus", nil, &result); err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, apiservererrors.RestoreError(result.Error)
+	}
+	return apiwatcher.NewNotifyWatcher(c.facade.RawAPICaller(), result), nil
+}
+
+// Status returns the life and status of the model and the applications,
+// units and machines in it.
+func (c *Client) Status(ctx context.Context) (params.WaitForStatus, error) {
+	var result params.WaitForStatus
+	if err := c.facade.FacadeCall(ctx, "Status", nil, &result); err != nil {
+		return params.WaitForStatus{}, err
+	}
+	return result, nil
+}
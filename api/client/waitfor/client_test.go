@@ -0,0 +1,66 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/waitfor"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) TestStatus(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	status := params.WaitForStatus{
+		Model: params.WaitForModelStatus{Name: "prod", Status: "available"},
+		Units: map[string]params.WaitForUnitStatus{
+			"mysql/0": {Name: "mysql/0", WorkloadStatus: "active"},
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "Status", nil, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		*(result.(*params.WaitForStatus)) = status
+		return nil
+	})
+	client := waitfor.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	result, err := client.Status(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, status)
+}
+
+func (s *clientSuite) TestWatchStatusError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(
+		gomock.Any(), "WatchStatus", nil, gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ string, _ any, result any) error {
+		*(result.(*params.NotifyWatchResult)) = params.NotifyWatchResult{
+			Error: &params.Error{Code: params.CodeNotFound, Message: "model not found"},
+		}
+		return nil
+	})
+	client := waitfor.NewClientFromCaller(mockFacadeCaller, basemocks.NewMockClientFacade(ctrl))
+
+	_, err := client.WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/juju/api/base"
+)
+
+func NewClientFromCaller(caller base.FacadeCaller, facade base.ClientFacade) *Client {
+	return &Client{
+		ClientFacade: facade,
+		facade:       caller,
+	}
+}
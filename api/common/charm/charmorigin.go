@@ -24,6 +24,8 @@ const (
 	OriginLocal OriginSource = "local"
 	// OriginCharmHub represents a charm from the new charm-hub.
 	OriginCharmHub OriginSource = "charm-hub"
+	// OriginOCI represents a charm pulled from an OCI registry.
+	OriginOCI OriginSource = "oci"
 )
 
 // Origin holds the information about where the charm originates.
@@ -41,6 +41,16 @@ type DebugLogParams struct {
 	ExcludeModule []string
 	// ExcludeLabel lists logging labels to exclude from the response.
 	ExcludeLabels map[string]string
+	// IncludeMessage lists regular expressions which a log message must
+	// all match to be included in the response.
+	IncludeMessage []string
+	// ExcludeMessage lists regular expressions, log messages matching any
+	// of them are excluded from the response.
+	ExcludeMessage []string
+	// Labels lists label matchers which must all match the labels of a log
+	// message for it to be included in the response. Matchers take the form
+	// key=value, key!=value, key=~regexp or key!~regexp.
+	Labels []string
 
 	// Limit defines the maximum number of lines to return. Once this many
 	// have been sent, the socket is closed.  If zero, all filtered lines are
@@ -61,6 +71,13 @@ type DebugLogParams struct {
 	// StartTime should be a time in the past - only records with a
 	// log time on or after StartTime will be returned.
 	StartTime time.Time
+	// EndTime, when set, excludes records with a log time after it.
+	// The server stops sending records once it passes EndTime.
+	EndTime time.Time
+	// FromOffset is the offset of a log record, as returned in a
+	// LogMessage. Records after it are returned, unless Backlog is set,
+	// in which case the Backlog records before it are returned.
+	FromOffset string
 	// Firehose streams logs from all models from the logsink.log file.
 	Firehose bool
 }
@@ -117,6 +134,21 @@ func (args DebugLogParams) URLQuery() url.Values {
 	if !args.StartTime.IsZero() {
 		attrs.Set("startTime", args.StartTime.Format(time.RFC3339Nano))
 	}
+	if len(args.IncludeMessage) > 0 {
+		attrs["includeMessage"] = args.IncludeMessage
+	}
+	if len(args.ExcludeMessage) > 0 {
+		attrs["excludeMessage"] = args.ExcludeMessage
+	}
+	if len(args.Labels) > 0 {
+		attrs["label"] = args.Labels
+	}
+	if !args.EndTime.IsZero() {
+		attrs.Set("endTime", args.EndTime.Format(time.RFC3339Nano))
+	}
+	if args.FromOffset != "" {
+		attrs.Set("fromOffset", args.FromOffset)
+	}
 	return attrs
 }
 
@@ -130,6 +162,9 @@ type LogMessage struct {
 	Location  string
 	Message   string
 	Labels    map[string]string
+	// Offset identifies the record, so that reading can be resumed from
+	// it with DebugLogParams.FromOffset.
+	Offset string
 }
 
 // StreamDebugLog requests the specified debug log records from the
@@ -169,6 +204,7 @@ func StreamDebugLog(ctx context.Context, source base.StreamConnector, args Debug
 				Location:  msg.Location,
 				Message:   msg.Message,
 				Labels:    msg.Labels,
+				Offset:    msg.Offset,
 			}
 		}
 	}()
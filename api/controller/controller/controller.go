@@ -211,15 +211,24 @@ func (c *Client) WatchAllModelSummaries(ctx context.Context) (*SummaryWatcher, e
 
 // GrantController grants a user access to the controller.
 func (c *Client) GrantController(ctx context.Context, user, access string) error {
-	return c.modifyControllerUser(ctx, params.GrantControllerAccess, user, access)
+	return c.modifyControllerUser(ctx, params.GrantControllerAccess, user, access, nil)
+}
+
+// GrantControllerUntil grants a user access to the controller until the
+// given expiry time, after which the user reverts to their previous access.
+func (c *Client) GrantControllerUntil(ctx context.Context, user, access string, expiry time.Time) error {
+	if c.BestAPIVersion() < 15 {
+		return errors.NotSupportedf("time-limited controller access on this juju version")
+	}
+	return c.modifyControllerUser(ctx, params.GrantControllerAccess, user, access, &expiry)
 }
 
 // RevokeController revokes a user's access to the controller.
 func (c *Client) RevokeController(ctx context.Context, user, access string) error {
-	return c.modifyControllerUser(ctx, params.RevokeControllerAccess, user, access)
+	return c.modifyControllerUser(ctx, params.RevokeControllerAccess, user, access, nil)
 }
 
-func (c *Client) modifyControllerUser(ctx context.Context, action params.ControllerAction, user, access string) error {
+func (c *Client) modifyControllerUser(ctx context.Context, action params.ControllerAction, user, access string, expiry *time.Time) error {
 	var args params.ModifyControllerAccessRequest
 
 	if !names.IsValidUser(user) {
@@ -231,6 +240,7 @@ func (c *Client) modifyControllerUser(ctx context.Context, action params.Control
 		UserTag: userTag.String(),
 		Action:  action,
 		Access:  access,
+		Expiry:  expiry,
 	}}
 
 	var result params.ErrorResults
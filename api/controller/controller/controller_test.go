@@ -647,3 +647,37 @@ func (s *Suite) TestValidateMigrationCredentials(c *tc.C) {
 	err = spec.Validate()
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *Suite) TestGrantControllerUntil(c *tc.C) {
+	expiry := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	apiCaller := apitesting.BestVersionCaller{APICallerFunc: func(objType string, version int, id, request string, arg, result any) error {
+		c.Check(objType, tc.Equals, "Controller")
+		c.Check(request, tc.Equals, "ModifyControllerAccess")
+		c.Check(arg, tc.DeepEquals, params.ModifyControllerAccessRequest{
+			Changes: []params.ModifyControllerAccess{{
+				UserTag: names.NewUserTag("bob").String(),
+				Action:  params.GrantControllerAccess,
+				Access:  "superuser",
+				Expiry:  &expiry,
+			}},
+		})
+		out := result.(*params.ErrorResults)
+		*out = params.ErrorResults{Results: []params.ErrorResult{{}}}
+		return nil
+	}, BestVersion: 15}
+	client := controller.NewClient(apiCaller)
+
+	err := client.GrantControllerUntil(c.Context(), "bob", "superuser", expiry)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *Suite) TestGrantControllerUntilNotSupported(c *tc.C) {
+	apiCaller := apitesting.BestVersionCaller{APICallerFunc: func(objType string, version int, id, request string, arg, result any) error {
+		c.Fatalf("unexpected api call")
+		return nil
+	}, BestVersion: 14}
+	client := controller.NewClient(apiCaller)
+
+	err := client.GrantControllerUntil(c.Context(), "bob", "superuser", time.Now().Add(time.Hour))
+	c.Assert(err, tc.ErrorMatches, "time-limited controller access on this juju version not supported")
+}
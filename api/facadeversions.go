@@ -21,13 +21,14 @@ func SupportedFacadeVersions() facades.FacadeVersions {
 // New facades should start at 1.
 // We no longer support facade versions at 0.
 var facadeVersions = facades.FacadeVersions{
-	"Action":            {7},
+	"Action":            {7, 8},
 	"Agent":             {3},
 	"AgentLifeFlag":     {1},
 	"Annotations":       {2},
-	"Application":       {19, 20, 21, 22},
+	"Application":       {19, 20, 21, 22, 23},
 	"ApplicationOffers": {5, 6},
-	"Backups":           {3},
+	"AuditLog":          {1},
+	"Backups":           {3, 4},
 	"Block":             {2},
 	// Note that this version of Juju does not implement version 6 of the
 	// facade, but 3.6 does. Care must be taken not to break client
@@ -42,7 +43,8 @@ var facadeVersions = facades.FacadeVersions{
 	"Charms":                       {7},
 	"Client":                       {8},
 	"Cloud":                        {7, 8},
-	"Controller":                   {12, 13, 14},
+	"ConfigSnapshot":               {1},
+	"Controller":                   {12, 13, 14, 15},
 	"CredentialManager":            {1},
 	"CredentialValidator":          {2, 3},
 	"CrossController":              {1},
@@ -52,7 +54,7 @@ var facadeVersions = facades.FacadeVersions{
 	"DiskManager":                  {2, 3},
 	"EntityWatcher":                {2},
 	"FilesystemAttachmentsWatcher": {2},
-	"HighAvailability":             {2, 3},
+	"HighAvailability":             {2, 3, 4},
 	"HostKeyReporter":              {1},
 	"ImageMetadata":                {3},
 	"ImageMetadataManager":         {1},
@@ -77,7 +79,7 @@ var facadeVersions = facades.FacadeVersions{
 	// to negotiate the new model migration path against those targets.
 	"MigrationTarget":              {4, 5, 6, 7, 8},
 	"ModelConfig":                  {3, 4},
-	"ModelManager":                 {9, 10, 11},
+	"ModelManager":                 {9, 10, 11, 12, 13},
 	"ModelSummaryWatcher":          {1},
 	"ModelUpgrader":                {1, 2},
 	"NotifyWatcher":                {1},
@@ -111,9 +113,10 @@ var facadeVersions = facades.FacadeVersions{
 	"Tracer":                       {1},
 	"Uniter":                       {19, 20, 21, 22},
 	"Upgrader":                     {1},
-	"UserManager":                  {3},
+	"UserManager":                  {3, 4},
 	"VolumeAttachmentsWatcher":     {2},
 	"VolumeAttachmentPlansWatcher": {1},
+	"WaitFor":                      {1},
 
 	// Technically, we don't require this facade in the client, as it is only
 	// used by the agent. Yet the migration checks will use this to verify
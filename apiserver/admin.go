@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	"github.com/juju/juju/domain/modelmigration"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/worker/watcherregistry"
 	"github.com/juju/juju/rpc"
 	"github.com/juju/juju/rpc/params"
@@ -82,6 +83,95 @@ func (a *admin) RedirectInfo() (params.RedirectInfoResult, error) {
 	return params.RedirectInfoResult{}, fmt.Errorf("not redirected")
 }
 
+// adminV4 extends the Admin facade with logins through the device
+// authorization flow of the OpenID Connect identity provider.
+type adminV4 struct {
+	*admin
+
+	// deviceAuthorization is the device authorization started by
+	// LoginDevice, guarded by the admin mutex.
+	deviceAuthorization *oidc.DeviceAuthorization
+}
+
+func newAdminAPIV4(srv *Server, root *apiHandler, apiObserver observer.Observer) any {
+	return &adminV4{
+		admin: &admin{
+			srv:         srv,
+			root:        root,
+			apiObserver: apiObserver,
+		},
+	}
+}
+
+// Admin returns an object that provides API access to methods that can be
+// called even when not authenticated.
+func (a *adminV4) Admin(id string) (*adminV4, error) {
+	if id != "" {
+		// Safeguard id for possible future use.
+		return nil, apiservererrors.ErrBadId
+	}
+	return a, nil
+}
+
+// Login logs in with the provided credentials.  All subsequent requests on the
+// connection will act as the authenticated user.
+func (a *adminV4) Login(ctx context.Context, req params.LoginRequest) (params.LoginResult, error) {
+	return a.login(ctx, req, 4)
+}
+
+// LoginDevice starts a device authorization with the controller's identity
+// provider, returning the code the user enters at the verification URI to
+// complete the login.
+func (a *adminV4) LoginDevice(ctx context.Context) (params.LoginDeviceResult, error) {
+	provider := a.srv.oidcProvider
+	if provider == nil || !provider.Enabled() {
+		return params.LoginDeviceResult{}, errors.NotSupportedf("login with an identity provider")
+	}
+
+	auth, err := provider.StartDeviceAuthorization(ctx)
+	if err != nil {
+		return params.LoginDeviceResult{}, errors.Annotate(err, "starting device login")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.deviceAuthorization = &auth
+
+	return params.LoginDeviceResult{
+		UserCode:        auth.UserCode,
+		VerificationURI: auth.VerificationURI,
+	}, nil
+}
+
+// GetDeviceSessionToken waits for the user to complete the device login
+// started by LoginDevice, returning the session token to log in with.
+func (a *adminV4) GetDeviceSessionToken(ctx context.Context) (params.SessionTokenResult, error) {
+	a.mu.Lock()
+	auth := a.deviceAuthorization
+	a.mu.Unlock()
+	if auth == nil {
+		return params.SessionTokenResult{}, errors.NotValidf("device login not started")
+	}
+
+	token, err := a.srv.oidcProvider.WaitForDeviceToken(ctx, *auth)
+	if err != nil {
+		return params.SessionTokenResult{}, errors.Annotate(err, "waiting for device login")
+	}
+	return params.SessionTokenResult{SessionToken: token}, nil
+}
+
+// LoginWithSessionToken logs in with a session token issued by the
+// controller's identity provider. All subsequent requests on the
+// connection will act as the authenticated user.
+func (a *adminV4) LoginWithSessionToken(ctx context.Context, req params.SessionTokenLoginRequest) (params.LoginResult, error) {
+	if req.SessionToken == "" {
+		return params.LoginResult{}, apiservererrors.ErrSessionTokenInvalid
+	}
+	return a.login(ctx, params.LoginRequest{
+		Token: req.SessionToken,
+	}, 4)
+}
+
 var MaintenanceNoLoginError = errors.New("login failed - maintenance in progress")
 var errAlreadyLoggedIn = errors.New("already logged in")
 
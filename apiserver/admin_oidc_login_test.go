@@ -0,0 +1,172 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/oidc"
+	jujutesting "github.com/juju/juju/juju/testing"
+)
+
+// fakeOIDCProvider is an identity provider which issues a single token
+// through the device authorization flow.
+type fakeOIDCProvider struct {
+	enabled  bool
+	token    string
+	identity oidc.Identity
+}
+
+func (p *fakeOIDCProvider) Enabled() bool {
+	return p.enabled
+}
+
+func (p *fakeOIDCProvider) Verify(_ context.Context, rawToken string) (oidc.Identity, error) {
+	if rawToken != p.token {
+		return oidc.Identity{}, fmt.Errorf("%w: unknown token", oidc.ErrTokenInvalid)
+	}
+	return p.identity, nil
+}
+
+func (p *fakeOIDCProvider) StartDeviceAuthorization(context.Context) (oidc.DeviceAuthorization, error) {
+	return oidc.DeviceAuthorization{
+		DeviceCode:      "device-code",
+		UserCode:        "ABCD-EFGH",
+		VerificationURI: "https://idp.example.com/device",
+		ExpiresAt:       time.Now().Add(time.Minute),
+		Interval:        time.Second,
+	}, nil
+}
+
+func (p *fakeOIDCProvider) WaitForDeviceToken(_ context.Context, auth oidc.DeviceAuthorization) (string, error) {
+	if auth.DeviceCode != "device-code" {
+		return "", oidc.ErrDeviceAuthorizationDenied
+	}
+	return p.token, nil
+}
+
+// oidcLoginSuite tests logging in with tokens issued by an OpenID Connect
+// identity provider.
+type oidcLoginSuite struct {
+	jujutesting.ApiServerSuite
+
+	provider *fakeOIDCProvider
+}
+
+func TestOIDCLoginSuite(t *stdtesting.T) {
+	tc.Run(t, &oidcLoginSuite{})
+}
+
+func (s *oidcLoginSuite) SetUpTest(c *tc.C) {
+	s.provider = &fakeOIDCProvider{
+		enabled: true,
+		token:   "id-token",
+		identity: oidc.Identity{
+			User:   names.NewUserTag("alice@oidc"),
+			Groups: []string{"sre"},
+		},
+	}
+	s.WithOIDCProvider = s.provider
+	s.ApiServerSuite.SetUpTest(c)
+
+	accessService := s.ControllerDomainServices(c).Access()
+	err := accessService.AddExternalUser(c.Context(), permission.EveryoneUserName, "", s.AdminUserUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	err = accessService.AddGroup(c.Context(), "sre", user.AdminUserName)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *oidcLoginSuite) grantGroup(c *tc.C, target permission.ID, accessLevel permission.Access) {
+	err := s.ControllerDomainServices(c).Access().UpdateGroupPermission(c.Context(), access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: target,
+			Access: accessLevel,
+		},
+		Change: permission.Grant,
+		Group:  "sre",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *oidcLoginSuite) openWithSessionToken(c *tc.C, token string) (api.Connection, string, string, error) {
+	info := s.ControllerModelApiInfo()
+	info.Tag = nil
+	info.Password = ""
+	info.Macaroons = nil
+
+	var (
+		output       bytes.Buffer
+		sessionToken string
+	)
+	conn, err := api.Open(c.Context(), info, api.DialOpts{
+		LoginProvider: api.NewSessionTokenLoginProvider(token, &output, func(token string) {
+			sessionToken = token
+		}),
+	})
+	return conn, output.String(), sessionToken, err
+}
+
+func (s *oidcLoginSuite) TestDeviceLoginWithGroupPermissions(c *tc.C) {
+	s.grantGroup(c, permission.ID{
+		ObjectType: permission.Controller,
+		Key:        s.ControllerUUID,
+	}, permission.LoginAccess)
+	s.grantGroup(c, permission.ID{
+		ObjectType: permission.Model,
+		Key:        s.ControllerModelUUID(),
+	}, permission.ReadAccess)
+
+	conn, output, sessionToken, err := s.openWithSessionToken(c, "")
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = conn.Close() }()
+
+	c.Check(output, tc.Contains, "https://idp.example.com/device")
+	c.Check(output, tc.Contains, "ABCD-EFGH")
+	c.Check(sessionToken, tc.Equals, "id-token")
+
+	// The user is recorded as an external user on first login.
+	_, err = s.ControllerDomainServices(c).Access().GetUserByName(c.Context(), tc.Must1(c, user.NewName, "alice@oidc"))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *oidcLoginSuite) TestSessionTokenLogin(c *tc.C) {
+	s.grantGroup(c, permission.ID{
+		ObjectType: permission.Controller,
+		Key:        s.ControllerUUID,
+	}, permission.SuperuserAccess)
+
+	conn, output, _, err := s.openWithSessionToken(c, "id-token")
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = conn.Close() }()
+
+	// A valid session token does not need a device login.
+	c.Check(output, tc.Equals, "")
+}
+
+func (s *oidcLoginSuite) TestSessionTokenLoginWithoutPermissions(c *tc.C) {
+	_, _, _, err := s.openWithSessionToken(c, "id-token")
+	c.Assert(err, tc.ErrorMatches, ".*permission denied.*")
+
+	_, err = s.ControllerDomainServices(c).Access().GetUserByName(c.Context(), tc.Must1(c, user.NewName, "alice@oidc"))
+	c.Assert(err, tc.ErrorIs, accesserrors.UserNotFound)
+}
+
+func (s *oidcLoginSuite) TestDeviceLoginNotEnabled(c *tc.C) {
+	s.provider.enabled = false
+
+	_, _, _, err := s.openWithSessionToken(c, "")
+	c.Assert(err, tc.ErrorMatches, ".*login with an identity provider not supported.*")
+}
@@ -41,12 +41,14 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/annotations" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/application"
 	"github.com/juju/juju/apiserver/facades/client/applicationoffers" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/auditlog"          // Controller Superuser
 	"github.com/juju/juju/apiserver/facades/client/backups"           // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/block"             // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/bundle"
-	"github.com/juju/juju/apiserver/facades/client/charms"     // ModelUser Write
-	"github.com/juju/juju/apiserver/facades/client/client"     // ModelUser Write
-	"github.com/juju/juju/apiserver/facades/client/cloud"      // ModelUser Read
+	"github.com/juju/juju/apiserver/facades/client/charms" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/client" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/cloud"  // ModelUser Read
+	"github.com/juju/juju/apiserver/facades/client/configsnapshot"
 	"github.com/juju/juju/apiserver/facades/client/controller" // ModelUser Admin (although some methods check for read only)
 	"github.com/juju/juju/apiserver/facades/client/credentialmanager"
 	"github.com/juju/juju/apiserver/facades/client/highavailability"
@@ -65,6 +67,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/storage"
 	"github.com/juju/juju/apiserver/facades/client/subnets"
 	"github.com/juju/juju/apiserver/facades/client/usermanager"
+	"github.com/juju/juju/apiserver/facades/client/waitfor"
 	"github.com/juju/juju/apiserver/facades/controller/caasoperatorupgrader"
 	"github.com/juju/juju/apiserver/facades/controller/crosscontroller"
 	"github.com/juju/juju/apiserver/facades/controller/crossmodelrelations"
@@ -148,12 +151,14 @@ func AllFacades() *facade.Registry {
 	annotations.Register(registry)
 	application.Register(registry)
 	applicationoffers.Register(registry)
+	auditlog.Register(registry)
 	backups.Register(registry)
 	block.Register(registry)
 	bundle.Register(registry)
 	charms.Register(registry)
 	client.Register(registry)
 	cloud.Register(registry)
+	configsnapshot.Register(registry)
 	agentlifeflag.Register(registry)
 
 	// CAAS related facades.
@@ -213,6 +218,7 @@ func AllFacades() *facade.Registry {
 	uniter.Register(registry)
 	upgrader.Register(registry)
 	usermanager.Register(registry)
+	waitfor.Register(registry)
 
 	registerWatchers(registry)
 
@@ -223,6 +229,7 @@ func AllFacades() *facade.Registry {
 // admin APIs with specific versions.
 var adminAPIFactories = map[int]adminAPIFactory{
 	3: newAdminAPIV3,
+	4: newAdminAPIV4,
 }
 
 // AdminFacadeDetails returns information on the Admin facade provided
@@ -29,6 +29,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/authentication/jwt"
 	"github.com/juju/juju/apiserver/authentication/macaroon"
+	oidcauth "github.com/juju/juju/apiserver/authentication/oidc"
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/common/apihttp"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
@@ -36,6 +37,8 @@ import (
 	"github.com/juju/juju/apiserver/httpcontext"
 	"github.com/juju/juju/apiserver/internal/crossmodel"
 	crossmodelbakery "github.com/juju/juju/apiserver/internal/crossmodel/bakery"
+	handlerschangestream "github.com/juju/juju/apiserver/internal/handlers/changestream"
+	handlerscharmhubmirror "github.com/juju/juju/apiserver/internal/handlers/charmhubmirror"
 	handlerscrossmodel "github.com/juju/juju/apiserver/internal/handlers/crossmodel"
 	"github.com/juju/juju/apiserver/internal/handlers/objects"
 	handlersresources "github.com/juju/juju/apiserver/internal/handlers/resources"
@@ -59,10 +62,12 @@ import (
 	"github.com/juju/juju/core/securitylog"
 	coretrace "github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
+	domainchangestream "github.com/juju/juju/domain/changestream"
 	"github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	internalerrors "github.com/juju/juju/internal/errors"
 	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/resource"
 	resourcecharmhub "github.com/juju/juju/internal/resource/charmhub"
 	"github.com/juju/juju/internal/services"
@@ -113,6 +118,7 @@ type Server struct {
 
 	localMacaroonAuthenticator macaroon.LocalMacaroonAuthenticator
 	jwtAuthenticator           jwt.Authenticator
+	oidcProvider               OIDCProvider
 
 	httpAuthenticators  []authentication.HTTPAuthenticator
 	loginAuthenticators []authentication.LoginAuthenticator
@@ -149,6 +155,9 @@ type Server struct {
 	agentRateLimitRate time.Duration
 	agentRateLimit     *ratelimit.Bucket
 
+	// userRateLimiter rate limits the API requests made by users.
+	userRateLimiter *userRateLimiter
+
 	// resourceLock is used to limit the number of
 	// concurrent resource downloads to units.
 	resourceLock resource.ResourceDownloadLock
@@ -160,6 +169,21 @@ type Server struct {
 	registerIntrospectionHandlers func(func(string, http.Handler))
 }
 
+// OIDCProvider verifies ID tokens issued by an OpenID Connect identity
+// provider, and obtains them on behalf of users through the device
+// authorization flow.
+type OIDCProvider interface {
+	oidcauth.TokenVerifier
+
+	// StartDeviceAuthorization starts a device authorization with the
+	// identity provider.
+	StartDeviceAuthorization(ctx context.Context) (oidc.DeviceAuthorization, error)
+
+	// WaitForDeviceToken waits for the user to complete the device
+	// authorization, returning the ID token issued to them.
+	WaitForDeviceToken(ctx context.Context, auth oidc.DeviceAuthorization) (string, error)
+}
+
 // ServerConfig holds parameters required to set up an API server.
 type ServerConfig struct {
 	Clock     clock.Clock
@@ -184,6 +208,10 @@ type ServerConfig struct {
 	// provider.
 	JWTAuthenticator jwt.Authenticator
 
+	// OIDCProvider is the OpenID Connect identity provider users can log
+	// in with. If nil, OpenID Connect login is not available.
+	OIDCProvider OIDCProvider
+
 	// UpgradeComplete is a function that reports whether or not
 	// the if the agent running the API server has completed
 	// running upgrade steps. This is used by the API server to
@@ -371,6 +399,14 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 
 	httpAuthenticators := []authentication.HTTPAuthenticator{cfg.LocalMacaroonAuthenticator, cfg.JWTAuthenticator}
 	loginAuthenticators := []authentication.LoginAuthenticator{cfg.LocalMacaroonAuthenticator, cfg.JWTAuthenticator}
+	if cfg.OIDCProvider != nil {
+		// The OpenID Connect authenticator must come first, as the local
+		// authenticator rejects any basic authentication it does not
+		// recognise.
+		oidcAuthenticator := oidcauth.NewAuthenticator(cfg.OIDCProvider, controllerDomainServices.Access())
+		httpAuthenticators = append([]authentication.HTTPAuthenticator{oidcAuthenticator}, httpAuthenticators...)
+		loginAuthenticators = append([]authentication.LoginAuthenticator{oidcAuthenticator}, loginAuthenticators...)
+	}
 
 	shared, err := newSharedServerContext(sharedServerConfig{
 		flightRecorder:           cfg.FlightRecorder,
@@ -412,6 +448,7 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		mux:                           cfg.Mux,
 		localMacaroonAuthenticator:    cfg.LocalMacaroonAuthenticator,
 		jwtAuthenticator:              cfg.JWTAuthenticator,
+		oidcProvider:                  cfg.OIDCProvider,
 		httpAuthenticators:            httpAuthenticators,
 		loginAuthenticators:           loginAuthenticators,
 		allowModelAccess:              cfg.AllowModelAccess,
@@ -429,6 +466,7 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		healthStatus: "starting",
 	}
 	srv.updateAgentRateLimiter(controllerConfig)
+	srv.userRateLimiter = newUserRateLimiter(srv.clock, controllerConfig)
 	if err := srv.updateResourceDownloadLimiters(controllerConfig); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -461,6 +499,7 @@ func (srv *Server) Report(ctx context.Context) map[string]any {
 	result := map[string]any{
 		"agent-ratelimit-max":  srv.agentRateLimitMax,
 		"agent-ratelimit-rate": srv.agentRateLimitRate,
+		"api-ratelimit":        srv.userRateLimiter.report(),
 	}
 
 	if srv.publicDNSName_ != "" {
@@ -673,6 +712,7 @@ func (srv *Server) loop(ready chan struct{}) error {
 			}
 
 			srv.updateAgentRateLimiter(controllerConfig)
+			srv.userRateLimiter.update(controllerConfig)
 			srv.shared.updateControllerConfig(ctx, controllerConfig)
 
 			// If the update fails, there is nothing else we can do but log the
@@ -928,6 +968,42 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		&resourcesResourceServiceGetter{domainServiceForRequest: httpCtxt.domainServicesDuringMigrationForRequest},
 		logger,
 	), "applications")
+	// changesAuthorizer allows controller admins and users with read
+	// access to the model to read the model's change stream.
+	var changesAuthorizer httpcontext.CompositeAuthorizer = []authentication.Authorizer{
+		controllerAdminAuthorizer,
+		modelPermissionAuthorizer{
+			perm: permission.ReadAccess,
+		},
+	}
+	// changesConsumerAuthorizer allows controller and model admins to
+	// create change stream consumers, which hold back the pruning of the
+	// model's change log.
+	var changesConsumerAuthorizer httpcontext.CompositeAuthorizer = []authentication.Authorizer{
+		controllerAdminAuthorizer,
+		modelPermissionAuthorizer{
+			perm: permission.AdminAccess,
+		},
+	}
+	changesHandler := srv.monitoredHandler(handlerschangestream.NewChangesHTTPHandler(
+		&changeStreamServiceGetter{
+			ctxt:               httpCtxt,
+			consumerAuthorizer: changesConsumerAuthorizer,
+		},
+		logger.Child("changes"),
+	), "changes")
+	// The charmhub mirror is served to other controllers, which are not
+	// users of this one. The mirror authenticates them as its own clients,
+	// so its routes skip the API server authentication.
+	charmhubMirror := handlerscharmhubmirror.NewMirror(
+		srv.shared.controllerDomainServices.CharmMirror(),
+		srv.shared.controllerDomainServices.ControllerConfig(),
+		srv.shared.charmhubHTTPClient,
+		logger.Child("charmhubmirror"),
+	)
+	backupHandler := srv.monitoredHandler(&backupHandler{
+		store: srv.shared.backups,
+	}, "backups")
 	registerHandler := srv.monitoredHandler(&registerUserHandler{
 		ctxt: httpCtxt,
 	}, "register")
@@ -976,6 +1052,15 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:    modelRoutePrefix + "/units/:unit/resources/:resource",
 		handler:    unitResourcesHandler,
 		authorizer: httpcontext.TODOAuthorizer,
+	}, {
+		pattern:    modelRoutePrefix + "/changes",
+		methods:    []string{"GET"},
+		handler:    changesHandler,
+		authorizer: changesAuthorizer,
+	}, {
+		pattern:    modelRoutePrefix + "/backups",
+		handler:    backupHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern:    "/migrate/charms/:object",
 		handler:    migrateObjectsCharmsHTTPHandler,
@@ -1014,6 +1099,26 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		handler:         healthHandler,
 		unauthenticated: true,
 		noModelUUID:     true,
+	}, {
+		pattern:         handlerscharmhubmirror.InfoRoute,
+		methods:         []string{"GET"},
+		handler:         srv.monitoredHandler(charmhubMirror.InfoHandler(), "charmhub-mirror"),
+		unauthenticated: true,
+	}, {
+		pattern:         handlerscharmhubmirror.FindRoute,
+		methods:         []string{"GET"},
+		handler:         srv.monitoredHandler(charmhubMirror.FindHandler(), "charmhub-mirror"),
+		unauthenticated: true,
+	}, {
+		pattern:         handlerscharmhubmirror.RefreshRoute,
+		methods:         []string{"POST"},
+		handler:         srv.monitoredHandler(charmhubMirror.RefreshHandler(), "charmhub-mirror"),
+		unauthenticated: true,
+	}, {
+		pattern:         handlerscharmhubmirror.DownloadRoute,
+		methods:         []string{"GET"},
+		handler:         srv.monitoredHandler(charmhubMirror.DownloadHandler(), "charmhub-mirror"),
+		unauthenticated: true,
 	}, {
 		pattern:         "/register",
 		handler:         registerHandler,
@@ -1472,6 +1577,41 @@ func (a *migratingObjectsApplicationServiceGetter) Application(r *http.Request)
 	return domainServices.Application(), nil
 }
 
+type changeStreamServiceGetter struct {
+	ctxt               httpContext
+	consumerAuthorizer authentication.Authorizer
+}
+
+func (a *changeStreamServiceGetter) ChangeStream(r *http.Request) (handlerschangestream.ChangeStreamService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	return domainServices.ChangeStream(), nil
+}
+
+// Consumer returns the change stream consumer with the given name owned by
+// the user making the request. The consumer can only be created if the user
+// is authorized to create consumers.
+func (a *changeStreamServiceGetter) Consumer(r *http.Request, name string) (domainchangestream.Consumer, error) {
+	authInfo, ok := httpcontext.RequestAuthInfo(r.Context())
+	if !ok {
+		return domainchangestream.Consumer{}, apiservererrors.ErrPerm
+	}
+	userTag, ok := authInfo.Tag.(names.UserTag)
+	if !ok {
+		return domainchangestream.Consumer{}, errors.NotSupportedf(
+			"change stream consumers for %s", names.ReadableString(authInfo.Tag),
+		)
+	}
+	return domainchangestream.Consumer{
+		Owner:     userTag.Id(),
+		Name:      name,
+		CanCreate: a.consumerAuthorizer.Authorize(r.Context(), authInfo) == nil,
+	}, nil
+}
+
 type objectStoreServiceGetter struct {
 	ctxt httpContext
 }
@@ -39,6 +39,14 @@ const (
 
 	// MetricLabelVersion is the metric for the Juju Version of the controller
 	MetricLabelVersion = "version"
+
+	// MetricLabelFacade defines a facade constant for the ThrottledRequests
+	// Label
+	MetricLabelFacade = "facade"
+
+	// MetricLabelLimit defines a constant for the ThrottledRequests Label,
+	// naming the rate limit that rejected the request
+	MetricLabelLimit = "limit"
 )
 
 // MetricAPIConnectionsLabelNames defines a series of labels for the
@@ -76,6 +84,13 @@ var MetricTotalRequestsLabelNames = []string{
 	MetricLabelHost,
 }
 
+// MetricThrottledRequestsLabelNames defines a series of labels for the
+// ThrottledRequests metric.
+var MetricThrottledRequestsLabelNames = []string{
+	MetricLabelFacade,
+	MetricLabelLimit,
+}
+
 // Collector is a prometheus.Collector that collects metrics based
 // on apiserver status.
 type Collector struct {
@@ -94,6 +109,8 @@ type Collector struct {
 	TotalRequests         *prometheus.CounterVec
 	TotalRequestErrors    *prometheus.CounterVec
 	TotalRequestsDuration *prometheus.SummaryVec
+
+	ThrottledRequests *prometheus.CounterVec
 }
 
 // NewMetricsCollector returns a new Collector.
@@ -194,6 +211,13 @@ func NewMetricsCollector() *Collector {
 				0.99: 0.001,
 			},
 		}, MetricTotalRequestsLabelNames),
+
+		ThrottledRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: apiserverMetricsNamespace,
+			Subsystem: apiserverSubsystemNamespace,
+			Name:      "throttled_requests_total",
+			Help:      "Total number of API requests rejected by a user rate limit",
+		}, MetricThrottledRequestsLabelNames),
 		BuildInfo: buildInfo,
 	}
 }
@@ -210,6 +234,7 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.TotalRequests.Describe(ch)
 	c.TotalRequestErrors.Describe(ch)
 	c.TotalRequestsDuration.Describe(ch)
+	c.ThrottledRequests.Describe(ch)
 	c.BuildInfo.Describe(ch)
 }
 
@@ -225,5 +250,6 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.TotalRequests.Collect(ch)
 	c.TotalRequestErrors.Collect(ch)
 	c.TotalRequestsDuration.Collect(ch)
+	c.ThrottledRequests.Collect(ch)
 	c.BuildInfo.Collect(ch)
 }
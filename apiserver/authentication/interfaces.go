@@ -26,6 +26,10 @@ type AuthInfo struct {
 	// Tag is the user/machine/unit/etc that has authenticated.
 	Tag names.Tag
 
+	// Groups are the user groups the identity provider reported the
+	// authenticated entity to be a member of, if any.
+	Groups []string
+
 	// PermissionsFn is a function that can return the permissions associated
 	// with  the current AuthInfo. PermissionsFn should not be considered
 	// concurrency safe.
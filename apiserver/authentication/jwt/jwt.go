@@ -196,6 +196,9 @@ func PermissionFromToken(token jwt.Token, subject permission.ID) (permission.Acc
 		validate = permission.ValidateCloudAccess
 	case permission.Offer:
 		validate = permission.ValidateOfferAccess
+	case permission.Application:
+		// Application grants are not carried in tokens.
+		return permission.NoAccess, nil
 	default:
 		return "", errors.NotValidf("%q as a target", subject)
 	}
@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package oidc provides an authentication mechanism whereby a Juju
+// controller accepts the ID tokens of an OpenID Connect identity provider
+// as the means to identify a user.
+//
+// This mechanism kicks in when the 'oidc-issuer-url' and 'oidc-client-id'
+// controller config are set. Tokens are verified by a separate object, see
+// [github.com/juju/juju/internal/oidc].
+//
+// # Authentication
+//
+// The ID token is used to authenticate Juju login requests made with a
+// session token, and raw HTTP requests carrying the token as a bearer token
+// or as the password of basic authentication with an empty user name. Users
+// are identified as external users in the oidc domain, eg "alice@oidc".
+//
+// # Authorisation
+//
+// Unlike JAAS tokens, ID tokens carry no permissions. Users hold the
+// permissions granted to them in the Juju permission model, together with
+// those granted to the Juju user groups named in the groups claim of their
+// token.
+package oidc
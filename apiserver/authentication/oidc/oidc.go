@@ -0,0 +1,199 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/httpcontext"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/oidc"
+)
+
+// TokenVerifier verifies the ID tokens of the identity provider.
+type TokenVerifier interface {
+	// Enabled returns whether users can log in with the identity provider.
+	Enabled() bool
+
+	// Verify verifies the given ID token, returning the identity of the
+	// user it was issued to. If the token was not issued by the identity
+	// provider oidc.ErrNotIssued is returned, and if it cannot be verified
+	// an error satisfying oidc.ErrTokenInvalid is returned.
+	Verify(ctx context.Context, rawToken string) (oidc.Identity, error)
+}
+
+// AccessService reads the permissions of users and user groups.
+type AccessService interface {
+	// ReadUserAccessLevelForTarget returns the access level of the user on
+	// the target.
+	ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error)
+
+	// ReadGroupAccessLevelForTarget returns the greatest access level any
+	// of the named user groups has on the target.
+	ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error)
+}
+
+// Authenticator authenticates requests carrying an ID token of the
+// identity provider.
+type Authenticator struct {
+	verifier      TokenVerifier
+	accessService AccessService
+}
+
+// NewAuthenticator returns a new Authenticator verifying tokens with the
+// supplied verifier.
+func NewAuthenticator(verifier TokenVerifier, accessService AccessService) *Authenticator {
+	return &Authenticator{
+		verifier:      verifier,
+		accessService: accessService,
+	}
+}
+
+// Authenticate implements authentication.HTTPAuthenticator. The token is
+// read from a bearer authorization header, or from the password of basic
+// authentication with an empty user name.
+func (a *Authenticator) Authenticate(req *http.Request) (authentication.AuthInfo, error) {
+	if !a.verifier.Enabled() {
+		return authentication.AuthInfo{}, errors.NotImplementedf("oidc authentication")
+	}
+
+	rawToken, ok := tokenFromHeader(req)
+	if !ok {
+		return authentication.AuthInfo{}, errors.NotFoundf("oidc token in authorization header")
+	}
+
+	authInfo, err := a.authenticate(req.Context(), rawToken)
+	if errors.Is(err, oidc.ErrNotIssued) {
+		// Leave the token to other authenticators.
+		return authentication.AuthInfo{}, errors.NotFoundf("oidc token in authorization header")
+	} else if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+	if modelUUID, ok := httpcontext.RequestModelUUID(req.Context()); ok {
+		authInfo.ModelTag = names.NewModelTag(modelUUID)
+	}
+	return authInfo, nil
+}
+
+// AuthenticateLoginRequest implements authentication.LoginAuthenticator.
+func (a *Authenticator) AuthenticateLoginRequest(
+	ctx context.Context,
+	_ string,
+	_ model.UUID,
+	authParams authentication.AuthParams,
+) (authentication.AuthInfo, error) {
+	if !a.verifier.Enabled() {
+		return authentication.AuthInfo{}, errors.NotImplementedf("oidc authentication")
+	}
+	if authParams.Token == "" {
+		return authentication.AuthInfo{}, errors.NotSupportedf("login without token")
+	}
+
+	authInfo, err := a.authenticate(ctx, authParams.Token)
+	if errors.Is(err, oidc.ErrNotIssued) {
+		// Leave the token to other authenticators.
+		return authentication.AuthInfo{}, errors.NotImplementedf("oidc authentication of token")
+	}
+	return authInfo, errors.Trace(err)
+}
+
+// authenticate verifies the token, returning the authentication info of
+// the user it was issued to.
+func (a *Authenticator) authenticate(ctx context.Context, rawToken string) (authentication.AuthInfo, error) {
+	identity, err := a.verifier.Verify(ctx, rawToken)
+	if errors.Is(err, oidc.ErrTokenInvalid) {
+		return authentication.AuthInfo{}, fmt.Errorf("%w: %v", apiservererrors.ErrSessionTokenInvalid, err)
+	} else if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+
+	return authentication.AuthInfo{
+		Tag:    identity.User,
+		Groups: identity.Groups,
+		Delegator: &PermissionDelegator{
+			AccessService: a.accessService,
+			User:          identity.User,
+			Groups:        identity.Groups,
+		},
+		IsExternallyAuthenticated: true,
+	}, nil
+}
+
+// tokenFromHeader returns the token held in the authorization header of
+// the request.
+func tokenFromHeader(req *http.Request) (string, bool) {
+	if username, password, ok := req.BasicAuth(); ok {
+		return password, username == "" && password != ""
+	}
+	scheme, token, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || scheme != "Bearer" || token == "" || strings.Contains(token, " ") {
+		return "", false
+	}
+	return token, true
+}
+
+// PermissionDelegator implements authentication.PermissionDelegator for a
+// user authenticated by the identity provider. The user holds the access
+// granted to them directly, and that granted to their user groups.
+type PermissionDelegator struct {
+	AccessService AccessService
+
+	// User is the authenticated user.
+	User names.UserTag
+
+	// Groups are the Juju user groups the authenticated user is a member
+	// of.
+	Groups []string
+}
+
+// SubjectPermissions returns the greatest of the access granted to the
+// user and to the user groups of the authenticated user on the target.
+// Group access only applies when the subject is the authenticated user.
+func (p *PermissionDelegator) SubjectPermissions(
+	ctx context.Context, userName string, target permission.ID,
+) (permission.Access, error) {
+	name, err := user.NewName(userName)
+	if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	access, err := p.AccessService.ReadUserAccessLevelForTarget(ctx, name, target)
+	if errors.Is(err, accesserrors.AccessNotFound) || errors.Is(err, accesserrors.UserNotFound) {
+		access = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	if userName == p.User.Id() && len(p.Groups) > 0 {
+		groupAccess, err := p.AccessService.ReadGroupAccessLevelForTarget(ctx, p.Groups, target)
+		if err != nil && !errors.Is(err, accesserrors.AccessNotFound) {
+			return permission.NoAccess, errors.Trace(err)
+		}
+		if err == nil && (access == permission.NoAccess ||
+			!(permission.AccessSpec{Target: target, Access: access}).EqualOrGreaterThan(groupAccess)) {
+			access = groupAccess
+		}
+	}
+
+	if access == permission.NoAccess {
+		return permission.NoAccess, accesserrors.PermissionNotFound
+	}
+	return access, nil
+}
+
+// PermissionError implements authentication.PermissionDelegator.
+func (p *PermissionDelegator) PermissionError(_ names.Tag, _ permission.Access) error {
+	return apiservererrors.ErrPerm
+}
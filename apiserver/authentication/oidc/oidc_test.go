@@ -0,0 +1,227 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/oidc"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type authenticatorSuite struct {
+	verifier      *MockTokenVerifier
+	accessService *MockAccessService
+}
+
+func TestAuthenticatorSuite(t *testing.T) {
+	tc.Run(t, &authenticatorSuite{})
+}
+
+var (
+	alice         = names.NewUserTag("alice@oidc")
+	controllerID  = permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()}
+	aliceIdentity = oidc.Identity{User: alice, Groups: []string{"sre"}}
+)
+
+func (s *authenticatorSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.verifier = NewMockTokenVerifier(ctrl)
+	s.accessService = NewMockAccessService(ctrl)
+	return ctrl
+}
+
+func (s *authenticatorSuite) TestAuthenticateLoginRequest(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+	s.verifier.EXPECT().Verify(gomock.Any(), "id-token").Return(aliceIdentity, nil)
+
+	authInfo, err := NewAuthenticator(s.verifier, s.accessService).AuthenticateLoginRequest(
+		c.Context(), "", "", authentication.AuthParams{Token: "id-token"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(authInfo.Tag, tc.Equals, alice)
+	c.Check(authInfo.IsExternallyAuthenticated, tc.IsTrue)
+	c.Check(authInfo.Groups, tc.DeepEquals, []string{"sre"})
+	c.Check(authInfo.Delegator, tc.DeepEquals, &PermissionDelegator{
+		AccessService: s.accessService,
+		User:          alice,
+		Groups:        []string{"sre"},
+	})
+}
+
+func (s *authenticatorSuite) TestAuthenticateLoginRequestNotEnabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(false)
+
+	_, err := NewAuthenticator(s.verifier, s.accessService).AuthenticateLoginRequest(
+		c.Context(), "", "", authentication.AuthParams{Token: "id-token"})
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *authenticatorSuite) TestAuthenticateLoginRequestNoToken(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+
+	_, err := NewAuthenticator(s.verifier, s.accessService).AuthenticateLoginRequest(
+		c.Context(), "", "", authentication.AuthParams{})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *authenticatorSuite) TestAuthenticateLoginRequestNotIssued(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+	s.verifier.EXPECT().Verify(gomock.Any(), "jaas-token").Return(oidc.Identity{}, oidc.ErrNotIssued)
+
+	_, err := NewAuthenticator(s.verifier, s.accessService).AuthenticateLoginRequest(
+		c.Context(), "", "", authentication.AuthParams{Token: "jaas-token"})
+	c.Assert(err, tc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *authenticatorSuite) TestAuthenticateLoginRequestTokenInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+	s.verifier.EXPECT().Verify(gomock.Any(), "id-token").Return(oidc.Identity{}, fmt.Errorf("%w: token expired", oidc.ErrTokenInvalid))
+
+	_, err := NewAuthenticator(s.verifier, s.accessService).AuthenticateLoginRequest(
+		c.Context(), "", "", authentication.AuthParams{Token: "id-token"})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrSessionTokenInvalid)
+	c.Check(apiservererrors.ServerError(err).Code, tc.Equals, params.CodeSessionTokenInvalid)
+}
+
+func (s *authenticatorSuite) TestAuthenticateBearer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+	s.verifier.EXPECT().Verify(gomock.Any(), "id-token").Return(aliceIdentity, nil)
+
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	req.Header.Set("Authorization", "Bearer id-token")
+
+	authInfo, err := NewAuthenticator(s.verifier, s.accessService).Authenticate(req)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(authInfo.Tag, tc.Equals, alice)
+}
+
+func (s *authenticatorSuite) TestAuthenticateBasicAuth(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+	s.verifier.EXPECT().Verify(gomock.Any(), "id-token").Return(aliceIdentity, nil)
+
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	req.SetBasicAuth("", "id-token")
+
+	authInfo, err := NewAuthenticator(s.verifier, s.accessService).Authenticate(req)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(authInfo.Tag, tc.Equals, alice)
+}
+
+func (s *authenticatorSuite) TestAuthenticateNoToken(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true).Times(3)
+	authenticator := NewAuthenticator(s.verifier, s.accessService)
+
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = authenticator.Authenticate(req)
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+
+	// Basic authentication of a user is left to other authenticators.
+	req.SetBasicAuth("user-bob", "password")
+	_, err = authenticator.Authenticate(req)
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+
+	req.Header.Set("Authorization", "Macaroon xyz")
+	_, err = authenticator.Authenticate(req)
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *authenticatorSuite) TestAuthenticateNotIssued(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.verifier.EXPECT().Enabled().Return(true)
+	s.verifier.EXPECT().Verify(gomock.Any(), "jaas-token").Return(oidc.Identity{}, oidc.ErrNotIssued)
+
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	req.Header.Set("Authorization", "Bearer jaas-token")
+
+	_, err = NewAuthenticator(s.verifier, s.accessService).Authenticate(req)
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *authenticatorSuite) TestSubjectPermissionsGroupAccessGreater(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.accessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), usertesting.GenNewName(c, "alice@oidc"), controllerID).
+		Return(permission.LoginAccess, nil)
+	s.accessService.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"sre"}, controllerID).
+		Return(permission.SuperuserAccess, nil)
+
+	delegator := &PermissionDelegator{AccessService: s.accessService, User: alice, Groups: []string{"sre"}}
+	access, err := delegator.SubjectPermissions(c.Context(), "alice@oidc", controllerID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, permission.SuperuserAccess)
+}
+
+func (s *authenticatorSuite) TestSubjectPermissionsUserAccessGreater(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.accessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), usertesting.GenNewName(c, "alice@oidc"), controllerID).
+		Return(permission.SuperuserAccess, nil)
+	s.accessService.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"sre"}, controllerID).
+		Return(permission.LoginAccess, nil)
+
+	delegator := &PermissionDelegator{AccessService: s.accessService, User: alice, Groups: []string{"sre"}}
+	access, err := delegator.SubjectPermissions(c.Context(), "alice@oidc", controllerID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, permission.SuperuserAccess)
+}
+
+func (s *authenticatorSuite) TestSubjectPermissionsGroupAccessOnly(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.accessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), gomock.Any(), controllerID).
+		Return(permission.NoAccess, accesserrors.UserNotFound)
+	s.accessService.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"sre"}, controllerID).
+		Return(permission.LoginAccess, nil)
+
+	delegator := &PermissionDelegator{AccessService: s.accessService, User: alice, Groups: []string{"sre"}}
+	access, err := delegator.SubjectPermissions(c.Context(), "alice@oidc", controllerID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, permission.LoginAccess)
+}
+
+func (s *authenticatorSuite) TestSubjectPermissionsNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.accessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), gomock.Any(), controllerID).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
+	s.accessService.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"sre"}, controllerID).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
+
+	delegator := &PermissionDelegator{AccessService: s.accessService, User: alice, Groups: []string{"sre"}}
+	_, err := delegator.SubjectPermissions(c.Context(), "alice@oidc", controllerID)
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionNotFound)
+}
+
+func (s *authenticatorSuite) TestSubjectPermissionsOtherSubject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.accessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), usertesting.GenNewName(c, "bob"), controllerID).
+		Return(permission.LoginAccess, nil)
+
+	// The groups of the authenticated user do not apply to other users.
+	delegator := &PermissionDelegator{AccessService: s.accessService, User: alice, Groups: []string{"sre"}}
+	access, err := delegator.SubjectPermissions(c.Context(), "bob", controllerID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, permission.LoginAccess)
+}
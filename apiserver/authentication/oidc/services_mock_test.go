@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/authentication/oidc (interfaces: TokenVerifier,AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -package oidc -destination services_mock_test.go github.com/juju/juju/apiserver/authentication/oidc TokenVerifier,AccessService
+//
+
+// Package oidc is a generated GoMock package.
+package oidc
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	permission "github.com/juju/juju/core/permission"
+	user "github.com/juju/juju/core/user"
+	oidc "github.com/juju/juju/internal/oidc"
+)
+
+// MockTokenVerifier is a mock of TokenVerifier interface.
+type MockTokenVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenVerifierMockRecorder is the mock recorder for MockTokenVerifier.
+type MockTokenVerifierMockRecorder struct {
+	mock           *MockTokenVerifier
+	enabledExpects []*gomock.Call0_1[bool]
+	verifyExpects  []*gomock.Call2_2[context.Context, string, oidc.Identity, error]
+}
+
+// NewMockTokenVerifier creates a new mock instance.
+func NewMockTokenVerifier(ctrl *gomock.Controller) *MockTokenVerifier {
+	mock := &MockTokenVerifier{ctrl: ctrl}
+	mock.recorder = &MockTokenVerifierMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenVerifier) EXPECT() *MockTokenVerifierMockRecorder {
+	return m.recorder
+}
+
+// Enabled mocks base method.
+func (m *MockTokenVerifier) Enabled() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.enabledExpects, m.ctrl, m, "Enabled")
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockTokenVerifierMockRecorder) Enabled() *MockTokenVerifierEnabledCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "Enabled")
+	mr.enabledExpects = append(mr.enabledExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockTokenVerifierEnabledCall is the typed call wrapper for Enabled.
+type MockTokenVerifierEnabledCall = gomock.Call0_1[bool]
+
+// Verify mocks base method.
+func (m *MockTokenVerifier) Verify(ctx context.Context, rawToken string) (oidc.Identity, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.verifyExpects, m.ctrl, m, "Verify", ctx, rawToken)
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTokenVerifierMockRecorder) Verify(ctx, rawToken any) *MockTokenVerifierVerifyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, oidc.Identity, error](mr.mock.ctrl.T, mr.mock, "Verify", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(rawToken))
+	mr.verifyExpects = append(mr.verifyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockTokenVerifierVerifyCall is the typed call wrapper for Verify.
+type MockTokenVerifierVerifyCall = gomock.Call2_2[context.Context, string, oidc.Identity, error]
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock                                 *MockAccessService
+	readGroupAccessLevelForTargetExpects []*gomock.Call3_2[context.Context, []string, permission.ID, permission.Access, error]
+	readUserAccessLevelForTargetExpects  []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// ReadGroupAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readGroupAccessLevelForTargetExpects, m.ctrl, m, "ReadGroupAccessLevelForTarget", ctx, groups, target)
+}
+
+// ReadGroupAccessLevelForTarget indicates an expected call of ReadGroupAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadGroupAccessLevelForTarget(ctx, groups, target any) *MockAccessServiceReadGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, []string, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadGroupAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(groups), gomock.EnsureMatcher(target))
+	mr.readGroupAccessLevelForTargetExpects = append(mr.readGroupAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadGroupAccessLevelForTargetCall is the typed call wrapper for ReadGroupAccessLevelForTarget.
+type MockAccessServiceReadGroupAccessLevelForTargetCall = gomock.Call3_2[context.Context, []string, permission.ID, permission.Access, error]
+
+// ReadUserAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readUserAccessLevelForTargetExpects, m.ctrl, m, "ReadUserAccessLevelForTarget", ctx, subject, target)
+}
+
+// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadUserAccessLevelForTarget(ctx, subject, target any) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, user.Name, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadUserAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(subject), gomock.EnsureMatcher(target))
+	mr.readUserAccessLevelForTargetExpects = append(mr.readUserAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadUserAccessLevelForTargetCall is the typed call wrapper for ReadUserAccessLevelForTarget.
+type MockAccessServiceReadUserAccessLevelForTargetCall = gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
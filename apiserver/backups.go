@@ -0,0 +1,88 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/juju/errors"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+// BackupStore describes the storage of backup archives that can be
+// downloaded and uploaded over HTTP.
+type BackupStore interface {
+	// Get returns the metadata of the backup with the given ID along with
+	// a reader for the backup archive.
+	Get(id string) (*corebackups.Metadata, io.ReadCloser, error)
+
+	// Add stores the backup archive read from the reader, returning the ID
+	// of the stored backup.
+	Add(r io.Reader) (string, error)
+}
+
+// backupHandler handles the downloading and uploading of backup archives.
+type backupHandler struct {
+	store BackupStore
+}
+
+func (h *backupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		if err := h.download(w, r); err != nil {
+			logger.Errorf(r.Context(), "GET(%s) failed: %v", r.URL, err)
+			if err := sendError(w, err); err != nil {
+				logger.Errorf(r.Context(), "%v", err)
+			}
+		}
+	case "PUT":
+		id, err := h.store.Add(r.Body)
+		if err != nil {
+			logger.Errorf(r.Context(), "PUT(%s) failed: %v", r.URL, err)
+			if err := sendError(w, err); err != nil {
+				logger.Errorf(r.Context(), "%v", err)
+			}
+			return
+		}
+		if err := internalhttp.SendStatusAndJSON(w, http.StatusOK, &params.BackupsUploadResult{
+			ID: id,
+		}); err != nil {
+			logger.Errorf(r.Context(), "%v", err)
+		}
+	default:
+		if err := sendError(w, errors.MethodNotAllowedf("unsupported method: %q", r.Method)); err != nil {
+			logger.Errorf(r.Context(), "%v", err)
+		}
+	}
+}
+
+// download sends the backup archive identified in the request body. An error
+// is only returned if nothing has been written to the response.
+func (h *backupHandler) download(w http.ResponseWriter, r *http.Request) error {
+	var args params.BackupsDownloadArgs
+	if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+		return errors.NewBadRequest(err, "while unmarshaling request")
+	}
+
+	meta, archive, err := h.store.Get(args.ID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	w.Header().Set("Content-Type", params.ContentTypeRaw)
+	w.Header().Set("Content-Length", strconv.FormatInt(meta.Size(), 10))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, archive); err != nil {
+		// Having begun writing, it is too late to send an error response here.
+		logger.Errorf(r.Context(), "failed to send backup %q: %v", args.ID, err)
+	}
+	return nil
+}
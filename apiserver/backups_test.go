@@ -0,0 +1,121 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	apitesting "github.com/juju/juju/apiserver/testing"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backupsSuite struct {
+	store *MockBackupStore
+}
+
+func TestBackupsSuite(t *testing.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+func (s *backupsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.store = NewMockBackupStore(ctrl)
+	return ctrl
+}
+
+func (s *backupsSuite) TestDownload(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := corebackups.NewMetadata()
+	err := meta.MarkComplete(7, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+	s.store.EXPECT().Get("juju-backup-20261017-100405.tar.gz").Return(
+		meta, io.NopCloser(strings.NewReader("archive")), nil,
+	)
+
+	handler := &backupHandler{store: s.store}
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/backups", strings.NewReader(`{"id":"juju-backup-20261017-100405.tar.gz"}`))
+
+	handler.ServeHTTP(res, req)
+	body := apitesting.AssertResponse(c, res.Result(), http.StatusOK, params.ContentTypeRaw)
+	c.Check(string(body), tc.Equals, "archive")
+	c.Check(res.Header().Get("Content-Length"), tc.Equals, "7")
+}
+
+func (s *backupsSuite) TestDownloadNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.store.EXPECT().Get("juju-backup-foo.tar.gz").Return(nil, nil, errors.NotFoundf(`backup "juju-backup-foo.tar.gz"`))
+
+	handler := &backupHandler{store: s.store}
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/backups", strings.NewReader(`{"id":"juju-backup-foo.tar.gz"}`))
+
+	handler.ServeHTTP(res, req)
+	s.assertJSONErrorResponse(c, res.Result(), http.StatusNotFound, `backup "juju-backup-foo.tar.gz" not found`)
+}
+
+func (s *backupsSuite) TestDownloadBadRequest(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	handler := &backupHandler{store: s.store}
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/backups", strings.NewReader(`{`))
+
+	handler.ServeHTTP(res, req)
+	s.assertJSONErrorResponse(c, res.Result(), http.StatusBadRequest, `while unmarshaling request: .*`)
+}
+
+func (s *backupsSuite) TestUpload(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.store.EXPECT().Add(gomock.Any()).DoAndReturn(func(r io.Reader) (string, error) {
+		data, err := io.ReadAll(r)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(string(data), tc.Equals, "archive")
+		return "juju-backup-20261017-100405.tar.gz", nil
+	})
+
+	handler := &backupHandler{store: s.store}
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPut, "/backups", strings.NewReader("archive"))
+
+	handler.ServeHTTP(res, req)
+	body := apitesting.AssertResponse(c, res.Result(), http.StatusOK, params.ContentTypeJSON)
+	var result params.BackupsUploadResult
+	err := json.Unmarshal(body, &result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ID, tc.Equals, "juju-backup-20261017-100405.tar.gz")
+}
+
+func (s *backupsSuite) TestUnsupportedMethod(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	handler := &backupHandler{store: s.store}
+	res := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/backups", nil)
+
+	handler.ServeHTTP(res, req)
+	s.assertJSONErrorResponse(c, res.Result(), http.StatusMethodNotAllowed, `unsupported method: "DELETE"`)
+}
+
+func (s *backupsSuite) assertJSONErrorResponse(c *tc.C, resp *http.Response, expCode int, expError string) {
+	body := apitesting.AssertResponse(c, resp, expCode, params.ContentTypeJSON)
+	var result params.ErrorResult
+	err := json.Unmarshal(body, &result)
+	c.Assert(err, tc.ErrorIsNil, tc.Commentf("Body: %s", body))
+	c.Assert(result.Error, tc.NotNil)
+	c.Check(result.Error.Message, tc.Matches, expError)
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	apitesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/internal/auth"
+	"github.com/juju/juju/juju/testing"
+)
+
+type charmhubMirrorSuite struct {
+	testing.ApiServerSuite
+}
+
+func TestCharmhubMirrorSuite(t *stdtesting.T) {
+	tc.Run(t, &charmhubMirrorSuite{})
+}
+
+func (s *charmhubMirrorSuite) TestRequiresAuth(c *tc.C) {
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method: "GET",
+		URL:    s.URL("/charmhub-mirror/v2/charms/info/postgresql", url.Values{}).String(),
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusUnauthorized)
+}
+
+func (s *charmhubMirrorSuite) TestUserLoginRejected(c *tc.C) {
+	// Users of the controller are not clients of the mirror, so the admin
+	// credentials can't be used for it.
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:   "GET",
+		URL:      s.URL("/charmhub-mirror/v2/charms/info/postgresql", url.Values{}).String(),
+		Tag:      testing.AdminUser.String(),
+		Password: testing.AdminSecret,
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusUnauthorized)
+}
+
+func (s *charmhubMirrorSuite) TestMirrorClient(c *tc.C) {
+	err := s.ControllerDomainServices(c).CharmMirror().SetClient(c.Context(), "downstream", auth.NewPassword("hunter2"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The mirror is not enabled, so getting past authentication is all that
+	// is checked.
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:   "GET",
+		URL:      s.URL("/charmhub-mirror/v2/charms/info/postgresql", url.Values{}).String(),
+		Tag:      "downstream",
+		Password: "hunter2",
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusNotFound)
+
+	// Deploys go through refresh, so mirror clients can use it too.
+	resp = apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:   "POST",
+		URL:      s.URL("/charmhub-mirror/v2/charms/refresh", url.Values{}).String(),
+		Tag:      "downstream",
+		Password: "hunter2",
+		Body:     strings.NewReader(`{"context": [], "actions": []}`),
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusNotFound)
+
+	resp = apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:   "GET",
+		URL:      s.URL("/charmhub-mirror/v2/charms/info/postgresql", url.Values{}).String(),
+		Tag:      "downstream",
+		Password: "wrong",
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusUnauthorized)
+}
@@ -79,3 +79,48 @@ func HasPermission(
 	}
 	return true, nil
 }
+
+// HasApplicationPermission returns true if the specified user has been granted
+// the specified permission on the application with the given UUID in the
+// given model. Application permissions are only ever granted to users
+// directly, so the requested permission must be a valid application access
+// level. An operation specific permission is held either through write or
+// admin access to the application or through a grant of that operation.
+func HasApplicationPermission(
+	ctx context.Context,
+	accessGetter UserAccessFunc,
+	utag names.Tag,
+	requestedPermission permission.Access,
+	modelUUID string,
+	appUUID string,
+) (bool, error) {
+	if err := permission.ValidateApplicationAccess(requestedPermission); err != nil {
+		return false, nil
+	}
+
+	userTag, ok := utag.(names.UserTag)
+	if !ok {
+		// Reveal no more than is strictly necessary.
+		return false, nil
+	}
+
+	appID := permission.ApplicationID(modelUUID, appUUID)
+	ids := []permission.ID{appID}
+	if operationID := permission.ApplicationAccessID(modelUUID, appUUID, requestedPermission); operationID != appID {
+		ids = append(ids, operationID)
+	}
+	for _, id := range ids {
+		userAccess, err := accessGetter(ctx, coreuser.NameFromTag(userTag), id)
+		if err != nil && !errors.IsOneOf(err,
+			accesserrors.AccessNotFound,
+			accesserrors.UserNotFound,
+			accesserrors.PermissionNotFound,
+		) {
+			return false, errors.Errorf("while obtaining application user: %w", err)
+		}
+		if userAccess != permission.NoAccess && userAccess.EqualOrGreaterApplicationAccessThan(requestedPermission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
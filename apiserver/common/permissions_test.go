@@ -224,3 +224,75 @@ func (r *PermissionSuite) TestUnknownTargetKindReturnsNoPermission(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(hasPermission, tc.IsFalse)
 }
+
+func (r *PermissionSuite) TestHasApplicationPermission(c *tc.C) {
+	testCases := []struct {
+		title            string
+		userGetterAccess permission.Access
+		access           permission.Access
+		expected         bool
+	}{
+		{
+			title:            "user has the requested operation",
+			userGetterAccess: permission.RunActionAccess,
+			access:           permission.RunActionAccess,
+			expected:         true,
+		},
+		{
+			title:            "user has a different operation",
+			userGetterAccess: permission.ConfigAccess,
+			access:           permission.RunActionAccess,
+			expected:         false,
+		},
+		{
+			title:            "user has write on the application",
+			userGetterAccess: permission.WriteAccess,
+			access:           permission.ScaleAccess,
+			expected:         true,
+		},
+		{
+			title:            "user has read on the application",
+			userGetterAccess: permission.ReadAccess,
+			access:           permission.RefreshAccess,
+			expected:         false,
+		},
+		{
+			title:            "user has no access to the application",
+			userGetterAccess: permission.NoAccess,
+			access:           permission.NoAccess,
+			expected:         false,
+		},
+		{
+			title:            "user requests offer permission on application",
+			userGetterAccess: permission.AdminAccess,
+			access:           permission.ConsumeAccess,
+			expected:         false,
+		},
+	}
+	userTag := names.NewUserTag("validuser")
+	appUUID := "f00dfeed-0bad-400d-8000-4b1d0d06f00d"
+	for i, t := range testCases {
+		userGetter := &fakeUserAccess{
+			access: t.userGetterAccess,
+		}
+		c.Logf("HasApplicationPermission test n %d: %s", i, t.title)
+		hasPermission, err := common.HasApplicationPermission(c.Context(), userGetter.call, userTag, t.access, "beef1beef2-0000-0000-000011112222", appUUID)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(hasPermission, tc.Equals, t.expected)
+	}
+}
+
+func (r *PermissionSuite) TestHasApplicationPermissionTarget(c *tc.C) {
+	userTag := names.NewUserTag("validuser")
+	userGetter := &fakeUserAccess{
+		err: accesserrors.PermissionNotFound,
+	}
+	hasPermission, err := common.HasApplicationPermission(c.Context(), userGetter.call, userTag, permission.ConfigAccess, "beef1beef2-0000-0000-000011112222", "f00dfeed-0bad-400d-8000-4b1d0d06f00d")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(hasPermission, tc.IsFalse)
+	// Both the application and the operation are checked.
+	c.Assert(userGetter.targets, tc.DeepEquals, []permission.ID{
+		permission.ApplicationID("beef1beef2-0000-0000-000011112222", "f00dfeed-0bad-400d-8000-4b1d0d06f00d"),
+		permission.ApplicationOperationID("beef1beef2-0000-0000-000011112222", "f00dfeed-0bad-400d-8000-4b1d0d06f00d", permission.ConfigAccess),
+	})
+}
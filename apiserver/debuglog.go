@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"syscall"
@@ -25,6 +26,7 @@ import (
 	"github.com/juju/juju/apiserver/websocket"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/loki"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -87,6 +89,10 @@ func newDebugLogHandler(
 //	excludeEntity -> []string - lists entity tags to exclude from the response
 //	   - as with include, it may finish with a '*'
 //	excludeModule -> []string - lists logging modules to exclude from the response
+//	includeMessage -> []string - regular expressions a message must all match
+//	excludeMessage -> []string - regular expressions a message must not match
+//	label -> []string - label matchers of the form key=value, key!=value,
+//	   - key=~regexp or key!~regexp, which must all match
 //	limit -> uint - show *at most* this many lines
 //	backlog -> uint
 //	   - go back this many lines from the end before starting to filter
@@ -121,12 +127,15 @@ func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		}
 
 		// When Loki forwarding is enabled, logs are no longer written to the
-		// logsink log file that debug-log tails. Notify the client that logs
-		// are being forwarded to Loki and close the connection. This is a
-		// server-side notice; no client change is required.
-		if lokiForwardingEnabled(req.Context(), h.ctxt.srv.shared.controllerDomainServices) {
-			logger.Warningf(req.Context(), "debug-log: Loki forwarding enabled, notifying client and closing connection")
-			sendLokiForwardingNotice(socket, params.version)
+		// logsink log file that debug-log tails. Send the client the
+		// matching logs queried from Loki instead, and close the
+		// connection. No client change is required.
+		if domainServices := h.ctxt.srv.shared.controllerDomainServices; lokiForwardingEnabled(req.Context(), domainServices) {
+			logger.Debugf(req.Context(), "debug-log: Loki forwarding enabled, querying Loki")
+			sendLokiLogs(req.Context(), socket, modelUUID, params, h.ctxt.srv.clock.Now(),
+				func(ctx context.Context, args loki.QueryArgs) ([]loki.Entry, error) {
+					return queryLokiLogs(ctx, domainServices, args)
+				})
 			return
 		}
 
@@ -235,6 +244,8 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage, version in
 type debugLogParams struct {
 	version       int
 	startTime     time.Time
+	endTime       time.Time
+	fromOffset    logtailer.Offset
 	fromTheStart  bool
 	noTail        bool
 	firehose      bool
@@ -246,6 +257,10 @@ type debugLogParams struct {
 	excludeModule []string
 	includeLabels map[string]string
 	excludeLabels map[string]string
+
+	includeMessage []string
+	excludeMessage []string
+	labelMatchers  []logtailer.LabelMatcher
 }
 
 func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
@@ -317,6 +332,22 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		params.startTime = startTime
 	}
 
+	if value := queryMap.Get("endTime"); value != "" {
+		endTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return params, errors.Errorf("end time %q is not a valid time in RFC3339 format", value)
+		}
+		params.endTime = endTime
+	}
+
+	if value := queryMap.Get("fromOffset"); value != "" {
+		offset, err := logtailer.ParseOffset(value)
+		if err != nil {
+			return params, errors.Errorf("from offset %q is not a valid log offset", value)
+		}
+		params.fromOffset = offset
+	}
+
 	params.includeEntity = queryMap["includeEntity"]
 	params.excludeEntity = queryMap["excludeEntity"]
 	params.includeModule = queryMap["includeModule"]
@@ -348,5 +379,23 @@ func readDebugLogParams(queryMap url.Values) (debugLogParams, error) {
 		// For compatibility with older clients.
 		params.excludeLabels[loggo.LoggerTags] = strings.Join(loggerTags, ",")
 	}
+
+	for _, key := range []string{"includeMessage", "excludeMessage"} {
+		for _, pattern := range queryMap[key] {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return debugLogParams{}, fmt.Errorf("%s regexp %q %w", key, pattern, errors.NotValid)
+			}
+		}
+	}
+	params.includeMessage = queryMap["includeMessage"]
+	params.excludeMessage = queryMap["excludeMessage"]
+
+	for _, label := range queryMap["label"] {
+		matcher, err := logtailer.ParseLabelMatcher(label)
+		if err != nil {
+			return debugLogParams{}, fmt.Errorf("label matcher %q %w", label, errors.NotValid)
+		}
+		params.labelMatchers = append(params.labelMatchers, matcher)
+	}
 	return params, nil
 }
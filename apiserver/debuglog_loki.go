@@ -4,33 +4,301 @@
 package apiserver
 
 import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/juju/errors"
+
 	corelogger "github.com/juju/juju/core/logger"
+	jujuhttp "github.com/juju/juju/internal/http"
+	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/loki"
+	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/rpc/params"
 )
 
 // lokiForwardingNoticeMessage is the notice sent to debug-log clients when
-// logs are being forwarded to Loki, informing them that debug-log will not
-// receive log lines and the connection will be closed.
-const lokiForwardingNoticeMessage = "logs are being forwarded to Loki; debug-log is unavailable"
-
-// sendLokiForwardingNotice sends the initial OK response followed by a
-// warning log record informing the client that logs are being forwarded to
-// Loki. The caller is expected to close the connection after this returns.
-func sendLokiForwardingNotice(socket debugLogSocket, version int) {
+// logs are being forwarded to Loki, informing them that the log lines sent
+// are queried from Loki and that new log lines will not be followed.
+const lokiForwardingNoticeMessage = "logs are being forwarded to Loki; showing logs queried from Loki, new logs are not followed"
+
+const (
+	// defaultLokiLookback is how far back debug-log queries Loki when no
+	// start time is given.
+	defaultLokiLookback = 24 * time.Hour
+
+	// defaultLokiLimit is the number of log lines debug-log queries Loki
+	// for when no number of lines is given.
+	defaultLokiLimit = 1000
+)
+
+// queryLokiLogs runs the given range query against the Loki instance logs
+// are being forwarded to.
+var queryLokiLogs = func(
+	ctx context.Context,
+	controllerDomainServices services.ControllerDomainServices,
+	args loki.QueryArgs,
+) ([]loki.Entry, error) {
+	cfg, err := controllerDomainServices.Logging().GetLokiConfig(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting Loki config")
+	}
+	options := []jujuhttp.Option{
+		jujuhttp.WithLogger(logger),
+	}
+	if cfg.CACertificate != "" {
+		options = append(options, jujuhttp.WithCACertificates(cfg.CACertificate))
+	}
+	if cfg.InsecureSkipVerify != nil {
+		options = append(options, jujuhttp.WithSkipHostnameVerification(*cfg.InsecureSkipVerify))
+	}
+	args.OrgID = cfg.OrgID
+	return loki.QueryRange(ctx, jujuhttp.NewClient(options...), cfg.Endpoint, args)
+}
+
+// sendLokiLogs sends the initial OK response, a notice that logs are being
+// forwarded to Loki, and then the log lines matching the request queried
+// from Loki. The debug-log filters are applied to the returned log lines as
+// well as being part of the query, so that only what the client asked for
+// is sent. The caller is expected to close the connection after this
+// returns.
+func sendLokiLogs(
+	ctx context.Context,
+	socket debugLogSocket,
+	modelUUID string,
+	p debugLogParams,
+	now time.Time,
+	query func(context.Context, loki.QueryArgs) ([]loki.Entry, error),
+) {
+	if p.firehose {
+		modelUUID = ""
+	}
+	filter, err := logtailer.NewRecordFilter(modelUUID, lokiTailerParams(makeLogTailerParams(p)))
+	if err != nil {
+		socket.sendError(err)
+		return
+	}
+
+	logql := lokiQuery(modelUUID, p)
 	socket.sendOk()
-	_ = socket.sendLogRecord(lokiForwardingNoticeRecord(time.Now()), version)
+	if err := socket.sendLogRecord(lokiForwardingNoticeRecord(now, logql), p.version); err != nil {
+		return
+	}
+
+	args := loki.QueryArgs{
+		Query:   logql,
+		Start:   p.startTime,
+		End:     p.endTime,
+		Limit:   defaultLokiLimit,
+		Forward: p.fromTheStart || !p.startTime.IsZero(),
+	}
+	if args.Start.IsZero() {
+		args.Start = now.Add(-defaultLokiLookback)
+	}
+	if args.End.IsZero() {
+		args.End = now
+	}
+	if p.initialLines > 0 {
+		args.Limit = int(p.initialLines)
+	}
+	entries, err := query(ctx, args)
+	if err != nil {
+		logger.Warningf(ctx, "debug-log: querying Loki: %v", err)
+		return
+	}
+
+	var records []corelogger.LogRecord
+	for _, entry := range entries {
+		rec := lokiLogRecord(entry)
+		if !filter.Include(rec) {
+			continue
+		}
+		records = append(records, rec)
+	}
+	if len(records) > args.Limit {
+		if args.Forward {
+			records = records[:args.Limit]
+		} else {
+			records = records[len(records)-args.Limit:]
+		}
+	}
+	for _, rec := range records {
+		if err := socket.sendLogRecord(formatLogRecord(rec), p.version); err != nil {
+			return
+		}
+	}
 }
 
+// lokiTailerParams returns the tailer parameters with the label keys mapped
+// to the names of the structured metadata fields they are pushed to Loki
+// as, matching the labels of the records returned by lokiLogRecord.
+func lokiTailerParams(p logtailer.LogTailerParams) logtailer.LogTailerParams {
+	p.IncludeLabels = lokiRecordLabels(p.IncludeLabels)
+	p.ExcludeLabels = lokiRecordLabels(p.ExcludeLabels)
+	if len(p.LabelMatchers) > 0 {
+		matchers := make([]logtailer.LabelMatcher, len(p.LabelMatchers))
+		for i, m := range p.LabelMatchers {
+			m.Key = lokiRecordLabel(m.Key)
+			matchers[i] = m
+		}
+		p.LabelMatchers = matchers
+	}
+	return p
+}
+
+func lokiRecordLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+	result := make(map[string]string, len(labels))
+	for k, v := range labels {
+		result[lokiRecordLabel(k)] = v
+	}
+	return result
+}
+
+// lokiRecordLabel returns the label a Juju log label has in the records
+// returned by lokiLogRecord.
+func lokiRecordLabel(label string) string {
+	if label == loki.TraceIDLabel || label == loki.SpanIDLabel {
+		return label
+	}
+	return loki.LabelField(label)
+}
+
+// lokiLogRecord returns the log record for a log line queried from Loki.
+func lokiLogRecord(entry loki.Entry) corelogger.LogRecord {
+	rec := corelogger.LogRecord{
+		Time:      entry.Timestamp,
+		ModelUUID: entry.Labels["juju_model"],
+		Entity:    entry.Labels["juju_agent"],
+		Module:    entry.Labels["module"],
+		Location:  entry.Labels["location"],
+		Message:   entry.Line,
+	}
+	if level, ok := corelogger.ParseLevelFromString(entry.Labels["level"]); ok {
+		rec.Level = level
+	}
+	for k, v := range entry.Labels {
+		switch k {
+		case "service_name", "juju_controller", "juju_model", "juju_agent", "level", "module", "location":
+			continue
+		case "traceID":
+			k = loki.TraceIDLabel
+		case "spanID":
+			k = loki.SpanIDLabel
+		}
+		if rec.Labels == nil {
+			rec.Labels = make(map[string]string)
+		}
+		rec.Labels[k] = v
+	}
+	return rec
+}
+
+// lokiQueryLabel is the label of the notice record holding the LogQL query
+// equivalent to the debug-log request.
+const lokiQueryLabel = "logql"
+
 // lokiForwardingNoticeRecord returns a warning log record informing the
-// client that logs are being forwarded to Loki.
-func lokiForwardingNoticeRecord(now time.Time) *params.LogMessage {
-	return &params.LogMessage{
+// client that logs are being forwarded to Loki. The record is labelled with
+// the LogQL query which selects the logs the client asked for.
+func lokiForwardingNoticeRecord(now time.Time, query string) *params.LogMessage {
+	rec := &params.LogMessage{
 		Entity:    "controller",
 		Timestamp: now,
 		Severity:  corelogger.INFO.String(),
 		Module:    "juju.apiserver",
 		Message:   lokiForwardingNoticeMessage,
 	}
+	if query != "" {
+		rec.Labels = map[string]string{lokiQueryLabel: query}
+	}
+	return rec
+}
+
+// lokiQuery returns the LogQL query selecting the log lines a debug-log
+// request would have returned, from the logs forwarded to Loki. Log labels
+// are forwarded as structured metadata, see loki.LabelField.
+func lokiQuery(modelUUID string, p debugLogParams) string {
+	var selector []string
+	if p.firehose || modelUUID == "" {
+		selector = append(selector, `juju_model=~".+"`)
+	} else {
+		selector = append(selector, "juju_model="+strconv.Quote(modelUUID))
+	}
+	if len(p.includeEntity) > 0 {
+		selector = append(selector, "juju_agent=~"+strconv.Quote(lokiEntityPattern(p.includeEntity)))
+	}
+	if len(p.excludeEntity) > 0 {
+		selector = append(selector, "juju_agent!~"+strconv.Quote(lokiEntityPattern(p.excludeEntity)))
+	}
+	query := "{" + strings.Join(selector, ", ") + "}"
+
+	for _, pattern := range p.includeMessage {
+		query += " |~ " + strconv.Quote(pattern)
+	}
+	for _, pattern := range p.excludeMessage {
+		query += " !~ " + strconv.Quote(pattern)
+	}
+	if p.filterLevel > corelogger.TRACE {
+		var levels []string
+		for level := p.filterLevel; level <= corelogger.CRITICAL; level++ {
+			levels = append(levels, level.String())
+		}
+		query += " | level=~" + strconv.Quote(strings.Join(levels, "|"))
+	}
+	if len(p.includeModule) > 0 {
+		query += " | module=~" + strconv.Quote(lokiModulePattern(p.includeModule))
+	}
+	if len(p.excludeModule) > 0 {
+		query += " | module!~" + strconv.Quote(lokiModulePattern(p.excludeModule))
+	}
+	if len(p.includeLabels) > 0 {
+		var matchers []string
+		for _, k := range sortedKeys(p.includeLabels) {
+			matchers = append(matchers, loki.LabelField(k)+"="+strconv.Quote(p.includeLabels[k]))
+		}
+		query += " | " + strings.Join(matchers, " or ")
+	}
+	for _, k := range sortedKeys(p.excludeLabels) {
+		query += " | " + loki.LabelField(k) + "!=" + strconv.Quote(p.excludeLabels[k])
+	}
+	for _, m := range p.labelMatchers {
+		query += " | " + lokiLabelMatcher(m)
+	}
+	return query
+}
+
+func lokiLabelMatcher(m logtailer.LabelMatcher) string {
+	return loki.LabelField(m.Key) + string(m.Op) + strconv.Quote(m.Value)
+}
+
+func lokiEntityPattern(entities []string) string {
+	patterns := make([]string, len(entities))
+	for i, entity := range entities {
+		patterns[i] = strings.ReplaceAll(regexp.QuoteMeta(entity), regexp.QuoteMeta("*"), ".*")
+	}
+	return strings.Join(patterns, "|")
+}
+
+func lokiModulePattern(modules []string) string {
+	patterns := make([]string, len(modules))
+	for i, module := range modules {
+		patterns[i] = regexp.QuoteMeta(module)
+	}
+	return `(` + strings.Join(patterns, "|") + `)(\..+)?`
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
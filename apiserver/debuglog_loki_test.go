@@ -4,11 +4,18 @@
 package apiserver
 
 import (
+	"context"
+	"errors"
+	"maps"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/juju/tc"
 
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/loki"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -36,24 +43,198 @@ func (s *recordingSocket) sendLogRecord(record *params.LogMessage, _ int) error
 	return nil
 }
 
-func (s *debugLogLokiSuite) TestSendLokiForwardingNotice(c *tc.C) {
+func (s *debugLogLokiSuite) TestSendLokiLogs(c *tc.C) {
 	socket := &recordingSocket{}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
 
-	sendLokiForwardingNotice(socket, 2)
+	var args loki.QueryArgs
+	sendLokiLogs(c.Context(), socket, "deadbeef", debugLogParams{version: 2}, now,
+		func(_ context.Context, a loki.QueryArgs) ([]loki.Entry, error) {
+			args = a
+			return []loki.Entry{{
+				Timestamp: now.Add(-time.Minute),
+				Line:      "hello",
+				Labels: map[string]string{
+					"service_name": "juju",
+					"juju_model":   "deadbeef",
+					"juju_agent":   "machine-0",
+					"module":       "juju.worker",
+					"location":     "worker.go:42",
+					"level":        "INFO",
+					"logger_tags":  "cmr",
+					"traceID":      "0123456789abcdef0123456789abcdef",
+				},
+			}}, nil
+		})
 
+	c.Check(args, tc.DeepEquals, loki.QueryArgs{
+		Query: `{juju_model="deadbeef"}`,
+		Start: now.Add(-defaultLokiLookback),
+		End:   now,
+		Limit: defaultLokiLimit,
+	})
 	c.Check(socket.ok, tc.IsTrue)
-	c.Check(socket.records, tc.HasLen, 1)
-	rec := socket.records[0]
-	c.Check(rec.Message, tc.Equals, lokiForwardingNoticeMessage)
+	c.Assert(socket.records, tc.HasLen, 2)
+	c.Check(socket.records[0].Message, tc.Equals, lokiForwardingNoticeMessage)
+	c.Check(socket.records[0].Labels, tc.DeepEquals, map[string]string{"logql": `{juju_model="deadbeef"}`})
+	rec := socket.records[1]
+	c.Check(rec.ModelUUID, tc.Equals, "deadbeef")
+	c.Check(rec.Entity, tc.Equals, "machine-0")
+	c.Check(rec.Timestamp, tc.Equals, now.Add(-time.Minute))
 	c.Check(rec.Severity, tc.Equals, "INFO")
-	c.Check(rec.Module, tc.Equals, "juju.apiserver")
+	c.Check(rec.Module, tc.Equals, "juju.worker")
+	c.Check(rec.Location, tc.Equals, "worker.go:42")
+	c.Check(rec.Message, tc.Equals, "hello")
+	c.Check(rec.Labels, tc.DeepEquals, map[string]string{
+		"logger_tags": "cmr",
+		"trace_id":    "0123456789abcdef0123456789abcdef",
+	})
+}
+
+func (s *debugLogLokiSuite) TestSendLokiLogsFiltersRecords(c *tc.C) {
+	socket := &recordingSocket{}
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	charm, err := logtailer.ParseLabelMatcher("charm-name=mysql")
+	c.Assert(err, tc.ErrorIsNil)
+
+	entry := func(line, model, agent, level, module string, labels map[string]string) loki.Entry {
+		all := map[string]string{
+			"juju_model": model,
+			"juju_agent": agent,
+			"level":      level,
+			"module":     module,
+		}
+		maps.Copy(all, labels)
+		return loki.Entry{Timestamp: now.Add(-time.Minute), Line: line, Labels: all}
+	}
+	mysql := map[string]string{"charm_name": "mysql", "logger_tags": "cmr"}
+
+	sendLokiLogs(c.Context(), socket, "deadbeef", debugLogParams{
+		version:        2,
+		filterLevel:    corelogger.WARNING,
+		includeEntity:  []string{"unit-mysql-*"},
+		includeModule:  []string{"juju.worker"},
+		excludeMessage: []string{"refused"},
+		includeLabels:  map[string]string{"logger-tags": "cmr"},
+		labelMatchers:  []logtailer.LabelMatcher{charm},
+	}, now, func(context.Context, loki.QueryArgs) ([]loki.Entry, error) {
+		return []loki.Entry{
+			entry("match", "deadbeef", "unit-mysql-0", "ERROR", "juju.worker.uniter", mysql),
+			entry("other model", "cafebabe", "unit-mysql-0", "ERROR", "juju.worker", mysql),
+			entry("other entity", "deadbeef", "machine-0", "ERROR", "juju.worker", mysql),
+			entry("low level", "deadbeef", "unit-mysql-0", "INFO", "juju.worker", mysql),
+			entry("other module", "deadbeef", "unit-mysql-0", "ERROR", "juju.apiserver", mysql),
+			entry("connection refused", "deadbeef", "unit-mysql-0", "ERROR", "juju.worker", mysql),
+			entry("no labels", "deadbeef", "unit-mysql-0", "ERROR", "juju.worker", nil),
+			entry("other charm", "deadbeef", "unit-mysql-0", "ERROR", "juju.worker",
+				map[string]string{"charm_name": "pg", "logger_tags": "cmr"}),
+		}, nil
+	})
+
+	c.Assert(socket.errs, tc.HasLen, 0)
+	var messages []string
+	for _, rec := range socket.records[1:] {
+		messages = append(messages, rec.Message)
+	}
+	c.Check(messages, tc.DeepEquals, []string{"match"})
+}
+
+func (s *debugLogLokiSuite) TestSendLokiLogsLimit(c *tc.C) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	entries := make([]loki.Entry, 3)
+	for i := range entries {
+		entries[i] = loki.Entry{
+			Timestamp: now.Add(time.Duration(i-3) * time.Minute),
+			Line:      strconv.Itoa(i),
+			Labels:    map[string]string{"juju_model": "deadbeef"},
+		}
+	}
+	query := func(context.Context, loki.QueryArgs) ([]loki.Entry, error) {
+		return entries, nil
+	}
+	messages := func(socket *recordingSocket) []string {
+		var result []string
+		for _, rec := range socket.records[1:] {
+			result = append(result, rec.Message)
+		}
+		return result
+	}
+
+	socket := &recordingSocket{}
+	sendLokiLogs(c.Context(), socket, "deadbeef", debugLogParams{initialLines: 2}, now, query)
+	c.Check(messages(socket), tc.DeepEquals, []string{"1", "2"})
+
+	socket = &recordingSocket{}
+	sendLokiLogs(c.Context(), socket, "deadbeef", debugLogParams{initialLines: 2, fromTheStart: true}, now, query)
+	c.Check(messages(socket), tc.DeepEquals, []string{"0", "1"})
+}
+
+func (s *debugLogLokiSuite) TestSendLokiLogsQueryError(c *tc.C) {
+	socket := &recordingSocket{}
+
+	sendLokiLogs(c.Context(), socket, "deadbeef", debugLogParams{}, time.Now(),
+		func(context.Context, loki.QueryArgs) ([]loki.Entry, error) {
+			return nil, errors.New("boom")
+		})
+
+	c.Check(socket.ok, tc.IsTrue)
+	c.Assert(socket.records, tc.HasLen, 1)
+	c.Check(socket.records[0].Message, tc.Equals, lokiForwardingNoticeMessage)
+}
+
+func (s *debugLogLokiSuite) TestSendLokiLogsInvalidFilter(c *tc.C) {
+	socket := &recordingSocket{}
+
+	sendLokiLogs(c.Context(), socket, "deadbeef", debugLogParams{includeMessage: []string{"("}}, time.Now(),
+		func(context.Context, loki.QueryArgs) ([]loki.Entry, error) {
+			c.Fatalf("unexpected query")
+			return nil, nil
+		})
+
+	c.Check(socket.ok, tc.IsFalse)
+	c.Check(socket.errs, tc.HasLen, 1)
+	c.Check(socket.records, tc.HasLen, 0)
 }
 
 func (s *debugLogLokiSuite) TestLokiForwardingNoticeRecord(c *tc.C) {
 	now := time.Now()
-	rec := lokiForwardingNoticeRecord(now)
+	rec := lokiForwardingNoticeRecord(now, "")
 	c.Check(rec.Timestamp, tc.Equals, now)
 	c.Check(rec.Entity, tc.Equals, "controller")
 	c.Check(rec.Severity, tc.Equals, "INFO")
 	c.Check(rec.Message, tc.Equals, lokiForwardingNoticeMessage)
 }
+
+func (s *debugLogLokiSuite) TestLokiQueryModel(c *tc.C) {
+	query := lokiQuery("deadbeef", debugLogParams{})
+	c.Check(query, tc.Equals, `{juju_model="deadbeef"}`)
+
+	query = lokiQuery("deadbeef", debugLogParams{firehose: true})
+	c.Check(query, tc.Equals, `{juju_model=~".+"}`)
+}
+
+func (s *debugLogLokiSuite) TestLokiQueryFilters(c *tc.C) {
+	charm, err := logtailer.ParseLabelMatcher("charm=~my.*")
+	c.Assert(err, tc.ErrorIsNil)
+	requestID, err := logtailer.ParseLabelMatcher("http-request-id!=42")
+	c.Assert(err, tc.ErrorIsNil)
+
+	query := lokiQuery("deadbeef", debugLogParams{
+		includeEntity:  []string{"unit-mysql-*"},
+		excludeEntity:  []string{"machine-0"},
+		includeMessage: []string{`conn\w+`},
+		excludeMessage: []string{"refused"},
+		filterLevel:    corelogger.WARNING,
+		includeModule:  []string{"juju.worker"},
+		excludeModule:  []string{"juju.worker.uniter"},
+		includeLabels:  map[string]string{"logger-tags": "cmr", "a": "b"},
+		excludeLabels:  map[string]string{"logger-tags": "http"},
+		labelMatchers:  []logtailer.LabelMatcher{charm, requestID},
+	})
+	c.Check(query, tc.Equals, `{juju_model="deadbeef", juju_agent=~"unit-mysql-.*", juju_agent!~"machine-0"}`+
+		` |~ "conn\\w+" !~ "refused"`+
+		` | level=~"WARNING|ERROR|CRITICAL"`+
+		` | module=~"(juju\\.worker)(\\..+)?" | module!~"(juju\\.worker\\.uniter)(\\..+)?"`+
+		` | a="b" or logger_tags="cmr" | logger_tags!="http"`+
+		` | charm=~"my.*" | http_request_id!="42"`)
+}
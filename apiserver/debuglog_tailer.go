@@ -80,6 +80,8 @@ func makeLogTailerParams(reqParams debugLogParams) logtailer.LogTailerParams {
 		NoTail:        reqParams.noTail,
 		Firehose:      reqParams.firehose,
 		StartTime:     reqParams.startTime,
+		EndTime:       reqParams.endTime,
+		FromOffset:    reqParams.fromOffset,
 		InitialLines:  int(reqParams.initialLines),
 		IncludeEntity: reqParams.includeEntity,
 		ExcludeEntity: reqParams.excludeEntity,
@@ -88,6 +90,10 @@ func makeLogTailerParams(reqParams debugLogParams) logtailer.LogTailerParams {
 		IncludeLabels: reqParams.includeLabels,
 		ExcludeLabels: reqParams.excludeLabels,
 		FromTheStart:  reqParams.fromTheStart,
+
+		IncludeMessage: reqParams.includeMessage,
+		ExcludeMessage: reqParams.excludeMessage,
+		LabelMatchers:  reqParams.labelMatchers,
 	}
 }
 
@@ -101,5 +107,6 @@ func formatLogRecord(r corelogger.LogRecord) *params.LogMessage {
 		Location:  r.Location,
 		Message:   r.Message,
 		Labels:    r.Labels,
+		Offset:    logtailer.OffsetOf(r).String(),
 	}
 }
@@ -38,6 +38,11 @@ const (
 	ErrBadRequest         = errors.ConstError("invalid request")
 	ErrTryAgain           = errors.ConstError("try again")
 	ErrActionNotAvailable = errors.ConstError("action no longer available")
+
+	// ErrSessionTokenInvalid is returned when logging in with a session
+	// token which is missing, expired or cannot be verified. Clients respond
+	// by obtaining a new token.
+	ErrSessionTokenInvalid = errors.ConstError("session token invalid")
 )
 
 // OperationBlockedError returns an error which signifies that
@@ -66,6 +71,7 @@ var singletonErrorCodes = map[errors.ConstError]string{
 	ErrStoppedWatcher:                            params.CodeStopped,
 	ErrTryAgain:                                  params.CodeTryAgain,
 	ErrActionNotAvailable:                        params.CodeActionNotAvailable,
+	ErrSessionTokenInvalid:                       params.CodeSessionTokenInvalid,
 }
 
 // ParamsErrorf is responsible for constructing a [params.Error] with the given
@@ -109,7 +115,8 @@ func ServerErrorAndStatus(err error) (*params.Error, int) {
 	}
 	status := http.StatusInternalServerError
 	switch err1.Code {
-	case params.CodeUnauthorized:
+	case params.CodeUnauthorized,
+		params.CodeSessionTokenInvalid:
 		status = http.StatusUnauthorized
 	case params.CodeNotFound,
 		params.CodeUserNotFound,
@@ -144,6 +151,8 @@ func ServerErrorAndStatus(err error) (*params.Error, int) {
 		status = http.StatusConflict
 	case params.CodeNotLeader:
 		status = http.StatusTemporaryRedirect
+	case params.CodeRateLimitExceeded:
+		status = http.StatusTooManyRequests
 	}
 	return err1, status
 }
@@ -169,6 +178,7 @@ func ServerError(err error) *params.Error {
 		notLeaderError         *NotLeaderError
 		redirectError          *RedirectError
 		accessRequiredError    *AccessRequiredError
+		rateLimitError         *RateLimitExceededError
 	)
 	// Skip past annotations when looking for the code.
 	err = errors.Cause(err)
@@ -253,6 +263,9 @@ func ServerError(err error) *params.Error {
 		}.AsMap()
 	case errors.Is(err, errors.QuotaLimitExceeded):
 		code = params.CodeQuotaLimitExceeded
+	case errors.As(err, &rateLimitError):
+		code = params.CodeRateLimitExceeded
+		info = rateLimitError.AsMap()
 	case errors.Is(err, errors.NotYetAvailable):
 		code = params.CodeNotYetAvailable
 	case errors.Is(err, ErrTryAgain):
@@ -369,6 +382,9 @@ func RestoreError(err error) error {
 		return fmt.Errorf(msg+"%w", errors.Hide(DeadlineExceededError))
 	case params.IsCodeTryAgain(err):
 		return ErrTryAgain
+	case params.IsCodeRateLimitExceeded(err):
+		retryAfter, _ := params.RateLimitRetryAfter(err)
+		return &RateLimitExceededError{RetryAfter: retryAfter}
 	default:
 		// Handle all other codes here.
 		return params.TranslateWellKnownError(err)
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"reflect"
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -137,6 +138,11 @@ var errorTransformTests = []struct {
 	code:       params.CodeTryAgain,
 	status:     http.StatusInternalServerError,
 	helperFunc: params.IsCodeTryAgain,
+}, {
+	err:        apiservererrors.ErrSessionTokenInvalid,
+	code:       params.CodeSessionTokenInvalid,
+	status:     http.StatusUnauthorized,
+	helperFunc: params.IsCodeSessionTokenInvalid,
 }, {
 	err:        errors.ConstError(leadership.ErrClaimDenied),
 	code:       params.CodeLeadershipClaimDenied,
@@ -227,6 +233,15 @@ var errorTransformTests = []struct {
 	code:       params.CodeQuotaLimitExceeded,
 	status:     http.StatusInternalServerError,
 	helperFunc: params.IsCodeQuotaLimitExceeded,
+}, {
+	err:        &apiservererrors.RateLimitExceededError{RetryAfter: 2 * time.Second},
+	code:       params.CodeRateLimitExceeded,
+	status:     http.StatusTooManyRequests,
+	helperFunc: params.IsCodeRateLimitExceeded,
+	targetTester: func(e error) bool {
+		var rateLimitErr *apiservererrors.RateLimitExceededError
+		return errors.As(e, &rateLimitErr) && rateLimitErr.RetryAfter == 2*time.Second
+	},
 }, {
 	err:        errors.NotYetAvailable,
 	code:       params.CodeNotYetAvailable,
@@ -6,6 +6,7 @@ package errors
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
 )
 
 const (
@@ -150,6 +152,25 @@ func NewNotLeaderError(serverAddress, serverID string) error {
 	}
 }
 
+// RateLimitExceededError is the error returned when an api request is
+// rejected because the caller has exceeded its request rate limit.
+type RateLimitExceededError struct {
+	// RetryAfter holds the duration the caller should wait before retrying.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *RateLimitExceededError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %v", e.RetryAfter)
+}
+
+// AsMap returns the data for the info part of an error param struct.
+func (e *RateLimitExceededError) AsMap() map[string]any {
+	return params.RateLimitExceededErrorInfo{
+		RetryAfter: e.RetryAfter,
+	}.AsMap()
+}
+
 // AccessRequiredError is the error returned when an api
 // request needs a login token with specified permissions.
 type AccessRequiredError struct {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
@@ -192,6 +193,29 @@ func SetAllowModelAccess(server *Server, allow bool) {
 	server.allowModelAccess = allow
 }
 
+// NewUserRateLimiter returns a user rate limiter configured from the
+// controller config.
+func NewUserRateLimiter(clock clock.Clock, cfg controller.Config) *userRateLimiter {
+	return newUserRateLimiter(clock, cfg)
+}
+
+// UpdateUserRateLimiter reconfigures the user rate limiter.
+func UpdateUserRateLimiter(l *userRateLimiter, cfg controller.Config) {
+	l.update(cfg)
+}
+
+// TakeUserRateLimit takes a token from the user rate limiter, returning the
+// name of the limit exceeded if the request is rejected.
+func TakeUserRateLimit(l *userRateLimiter, user, facadeName string) (string, error) {
+	return l.take(user, facadeName, "")
+}
+
+// TakeUserRateLimitForMethod takes a token from the user rate limiter for a
+// request to the method of the facade.
+func TakeUserRateLimitForMethod(l *userRateLimiter, user, facadeName, methodName string) (string, error) {
+	return l.take(user, facadeName, methodName)
+}
+
 // Patcher defines an interface that matches the PatchValue method on
 // CleanupSuite
 type Patcher interface {
@@ -6,6 +6,7 @@ package facadetest
 import (
 	"context"
 
+	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/services"
@@ -20,6 +21,7 @@ type MultiModelContext struct {
 	DomainServicesForModelFunc_ func(model.UUID) services.DomainServices
 	DomainServicesForModel_     services.DomainServices
 	ObjectStoreForModel_        objectstore.ObjectStore
+	Backups_                    facade.Backups
 }
 
 // DomainServicesForModel returns the services factory for a given model uuid.
@@ -35,6 +37,11 @@ func (c MultiModelContext) ObjectStoreForModel(ctx context.Context, modelUUID st
 	return c.ObjectStoreForModel_, nil
 }
 
+// Backups returns the backups of the controller.
+func (c MultiModelContext) Backups() facade.Backups {
+	return c.Backups_
+}
+
 // ControllerModelUUID returns the UUID of the controller model.
 func (c MultiModelContext) ControllerModelUUID() model.UUID {
 	return model.UUID(testing.ControllerModelTag.Id())
@@ -5,6 +5,7 @@ package facade
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"gopkg.in/macaroon.v2"
 
 	crossmodelbakery "github.com/juju/juju/apiserver/internal/crossmodel/bakery"
+	corebackups "github.com/juju/juju/core/backups"
 	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/lease"
@@ -81,6 +83,31 @@ type MultiModelContext interface {
 
 	// ObjectStoreForModel returns the object store for a given model uuid.
 	ObjectStoreForModel(ctx context.Context, modelUUID string) (objectstore.ObjectStore, error)
+
+	// Backups returns the backups of the controller.
+	Backups() Backups
+}
+
+// Backups describes the ability to create, store and restore backups of the
+// controller databases and object store.
+type Backups interface {
+	// Create creates a new backup of the controller and the given models,
+	// returning the ID of the stored backup.
+	Create(ctx context.Context, meta *corebackups.Metadata, modelUUIDs []string) (string, error)
+
+	// List returns the metadata of all the stored backups.
+	List() ([]*corebackups.Metadata, error)
+
+	// Get returns the metadata of the backup with the given ID along with
+	// a reader for the backup archive.
+	Get(id string) (*corebackups.Metadata, io.ReadCloser, error)
+
+	// Remove removes the backup with the given ID.
+	Remove(id string) error
+
+	// Restore restores the backup with the given ID onto the controller,
+	// which is running the current version.
+	Restore(ctx context.Context, id string, current semversion.Number) (*corebackups.Metadata, error)
 }
 
 // ModelContext exposes useful capabilities to a Facade for a given model.
@@ -259,6 +286,10 @@ type Authorizer interface {
 	// GetAuthTag returns the entity's tag.
 	GetAuthTag() names.Tag
 
+	// GetAuthGroups returns the user groups the identity provider reported
+	// the authenticated entity to be a member of, if any.
+	GetAuthGroups() []string
+
 	// AuthController returns whether the authenticated entity is
 	// a machine acting as a controller. Can't be removed from this
 	// interface without introducing a dependency on something else
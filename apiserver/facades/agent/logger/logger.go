@@ -7,13 +7,17 @@ import (
 	"context"
 
 	"github.com/juju/names/v6"
+	"github.com/juju/worker/v5"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/internal"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
 	loggingerrors "github.com/juju/juju/domain/logging/errors"
+	tracingservice "github.com/juju/juju/domain/tracing/service"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/otlp"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -60,6 +64,7 @@ type LoggerAPIV2 struct {
 	*LoggerAPI
 
 	controllerLokiConfigService ControllerLokiConfigService
+	tracingService              TracingService
 }
 
 var _ LoggerV2 = (*LoggerAPIV2)(nil)
@@ -86,7 +91,8 @@ func NewLoggerAPI(authorizer facade.Authorizer,
 func NewLoggerAPIV2(authorizer facade.Authorizer,
 	watcherRegistry facade.WatcherRegistry,
 	modelConfigService ModelConfigService,
-	controllerLokiConfigService ControllerLokiConfigService) (*LoggerAPIV2, error) {
+	controllerLokiConfigService ControllerLokiConfigService,
+	tracingService TracingService) (*LoggerAPIV2, error) {
 	loggerAPI, err := NewLoggerAPI(authorizer, watcherRegistry, modelConfigService)
 	if err != nil {
 		return nil, err
@@ -94,6 +100,7 @@ func NewLoggerAPIV2(authorizer facade.Authorizer,
 	return &LoggerAPIV2{
 		LoggerAPI:                   loggerAPI,
 		controllerLokiConfigService: controllerLokiConfigService,
+		tracingService:              tracingService,
 	}, nil
 }
 
@@ -163,7 +170,9 @@ func (api *LoggerAPI) LoggingConfig(ctx context.Context, arg params.Entities) pa
 }
 
 // GetControllerLokiConfig reports the controller-wide Loki configuration for
-// the agent specified.
+// the agent specified. When log export is enabled in the workload tracing
+// config, the agent is instead given the OTEL collector and TLS settings used
+// for tracing, so its logs are sent to the same collector as the traces.
 func (api *LoggerAPIV2) GetControllerLokiConfig(ctx context.Context, arg params.Entity) params.LokiConfigResult {
 	tag, err := names.ParseTag(arg.Tag)
 	if err != nil {
@@ -173,6 +182,21 @@ func (api *LoggerAPIV2) GetControllerLokiConfig(ctx context.Context, arg params.
 		return params.LokiConfigResult{Error: apiservererrors.ServerError(apiservererrors.ErrPerm)}
 	}
 
+	tracingConfig, err := api.tracingService.GetWorkloadTracingConfig(ctx)
+	if err != nil {
+		return params.LokiConfigResult{Error: apiservererrors.ServerError(err)}
+	}
+	if endpoint := logExportEndpoint(tracingConfig); endpoint != "" {
+		result := params.LokiConfigResult{
+			Endpoint:           endpoint,
+			InsecureSkipVerify: tracingConfig.InsecureSkipVerify,
+		}
+		if tracingConfig.CACertificate != "" {
+			result.CACert = &tracingConfig.CACertificate
+		}
+		return result
+	}
+
 	config, err := api.controllerLokiConfigService.GetLokiConfig(ctx)
 	if internalerrors.Is(err, loggingerrors.LokiConfigNotFound) {
 		return params.LokiConfigResult{
@@ -195,7 +219,8 @@ func (api *LoggerAPIV2) GetControllerLokiConfig(ctx context.Context, arg params.
 }
 
 // WatchControllerLokiConfig starts a watcher to track changes to the
-// controller-wide Loki configuration for the agent specified.
+// controller-wide Loki configuration, and to the workload tracing config which
+// may replace it, for the agent specified.
 func (api *LoggerAPIV2) WatchControllerLokiConfig(ctx context.Context, arg params.Entity) params.NotifyWatchResult {
 	tag, err := names.ParseTag(arg.Tag)
 	if err != nil {
@@ -205,8 +230,19 @@ func (api *LoggerAPIV2) WatchControllerLokiConfig(ctx context.Context, arg param
 		return params.NotifyWatchResult{Error: apiservererrors.ServerError(apiservererrors.ErrPerm)}
 	}
 
-	watch, err := api.controllerLokiConfigService.WatchLokiConfig(ctx)
+	lokiWatcher, err := api.controllerLokiConfigService.WatchLokiConfig(ctx)
+	if err != nil {
+		return params.NotifyWatchResult{Error: apiservererrors.ServerError(err)}
+	}
+	tracingWatcher, err := api.tracingService.WatchWorkloadTracingConfig(ctx)
+	if err != nil {
+		_ = worker.Stop(lokiWatcher)
+		return params.NotifyWatchResult{Error: apiservererrors.ServerError(err)}
+	}
+	watch, err := eventsource.NewMultiNotifyWatcher(ctx, lokiWatcher, tracingWatcher)
 	if err != nil {
+		_ = worker.Stop(lokiWatcher)
+		_ = worker.Stop(tracingWatcher)
 		return params.NotifyWatchResult{Error: apiservererrors.ServerError(err)}
 	}
 
@@ -217,3 +253,16 @@ func (api *LoggerAPIV2) WatchControllerLokiConfig(ctx context.Context, arg param
 	}
 	return result
 }
+
+// logExportEndpoint returns the logging endpoint which sends an agent's logs
+// to the collector of the workload tracing config, or an empty string if log
+// export isn't enabled.
+func logExportEndpoint(config tracingservice.WorkloadTracingConfig) string {
+	if config.ExportLogs == nil || !*config.ExportLogs {
+		return ""
+	}
+	return otlp.LogEndpoint(otlp.Config{
+		HTTPEndpoint: config.HTTPEndpoint,
+		GRPCEndpoint: config.GRPCEndpoint,
+	})
+}
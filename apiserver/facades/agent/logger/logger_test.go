@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/domain/logging"
 	loggingerrors "github.com/juju/juju/domain/logging/errors"
+	tracingservice "github.com/juju/juju/domain/tracing/service"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
 )
@@ -35,6 +36,7 @@ type loggerSuite struct {
 
 	modelConfigService          *MockModelConfigService
 	controllerLokiConfigService *stubControllerLokiConfigService
+	tracingService              *stubTracingService
 }
 
 func TestLoggerSuite(t *testing.T) {
@@ -48,6 +50,7 @@ func (s *loggerSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	s.modelConfigService = NewMockModelConfigService(ctrl)
 	s.controllerLokiConfigService = &stubControllerLokiConfigService{}
+	s.tracingService = &stubTracingService{}
 
 	return ctrl
 }
@@ -71,6 +74,7 @@ func (s *loggerSuite) setupAPIV2(c *tc.C) {
 		s.watcherRegistry,
 		s.modelConfigService,
 		s.controllerLokiConfigService,
+		s.tracingService,
 	)
 	c.Assert(err, tc.ErrorIsNil)
 }
@@ -292,6 +296,56 @@ func (s *loggerSuite) TestGetControllerLokiConfigReturnsNotFoundError(c *tc.C) {
 	c.Check(s.controllerLokiConfigService.getCalls, tc.Equals, 1)
 }
 
+func (s *loggerSuite) TestGetControllerLokiConfigExportLogs(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPIV2(c)
+
+	exportLogs := true
+	insecureTrue := true
+	s.tracingService.config = tracingservice.WorkloadTracingConfig{
+		HTTPEndpoint:       "https://collector.example.com:4318",
+		CACertificate:      "tracing-ca-cert",
+		InsecureSkipVerify: &insecureTrue,
+		ExportLogs:         &exportLogs,
+	}
+	s.controllerLokiConfigService.config = logging.LokiConfig{
+		Endpoint:      "https://loki.example.com/loki/api/v1/push",
+		CACertificate: "ca-cert",
+	}
+
+	args := params.Entity{Tag: defaultMachineTag.String()}
+	result := s.loggerV2.GetControllerLokiConfig(c.Context(), args)
+	c.Assert(result.Error, tc.IsNil)
+	c.Check(result.Endpoint, tc.Equals, "otlp+https://collector.example.com:4318")
+	c.Assert(result.CACert, tc.NotNil)
+	c.Check(*result.CACert, tc.Equals, "tracing-ca-cert")
+	c.Assert(result.InsecureSkipVerify, tc.NotNil)
+	c.Check(*result.InsecureSkipVerify, tc.Equals, true)
+	c.Check(s.controllerLokiConfigService.getCalls, tc.Equals, 0)
+}
+
+func (s *loggerSuite) TestGetControllerLokiConfigExportLogsDisabled(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPIV2(c)
+
+	exportLogs := false
+	s.tracingService.config = tracingservice.WorkloadTracingConfig{
+		GRPCEndpoint: "collector.example.com:4317",
+		ExportLogs:   &exportLogs,
+	}
+	s.controllerLokiConfigService.config = logging.LokiConfig{
+		Endpoint: "https://loki.example.com/loki/api/v1/push",
+	}
+
+	args := params.Entity{Tag: defaultMachineTag.String()}
+	result := s.loggerV2.GetControllerLokiConfig(c.Context(), args)
+	c.Assert(result.Error, tc.IsNil)
+	c.Check(result.Endpoint, tc.Equals, "https://loki.example.com/loki/api/v1/push")
+	c.Check(s.controllerLokiConfigService.getCalls, tc.Equals, 1)
+}
+
 func (s *loggerSuite) TestWatchControllerLokiConfig(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -300,6 +354,9 @@ func (s *loggerSuite) TestWatchControllerLokiConfig(c *tc.C) {
 	notifyCh := make(chan struct{}, 1)
 	notifyCh <- struct{}{}
 	s.controllerLokiConfigService.watcher = watchertest.NewMockNotifyWatcher(notifyCh)
+	tracingCh := make(chan struct{}, 1)
+	tracingCh <- struct{}{}
+	s.tracingService.watcher = watchertest.NewMockNotifyWatcher(tracingCh)
 	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("1", nil)
 
 	args := params.Entity{Tag: defaultMachineTag.String()}
@@ -307,6 +364,7 @@ func (s *loggerSuite) TestWatchControllerLokiConfig(c *tc.C) {
 	c.Assert(result.NotifyWatcherId, tc.Not(tc.Equals), "")
 	c.Assert(result.Error, tc.IsNil)
 	c.Check(s.controllerLokiConfigService.watchCalls, tc.Equals, 1)
+	c.Check(s.tracingService.watchCalls, tc.Equals, 1)
 }
 
 func (s *loggerSuite) TestWatchControllerLokiConfigRefusesWrongAgent(c *tc.C) {
@@ -339,3 +397,20 @@ func (s *stubControllerLokiConfigService) WatchLokiConfig(ctx context.Context) (
 	s.watchCalls++
 	return s.watcher, s.watchErr
 }
+
+type stubTracingService struct {
+	config     tracingservice.WorkloadTracingConfig
+	getErr     error
+	watcher    *watchertest.MockNotifyWatcher
+	watchErr   error
+	watchCalls int
+}
+
+func (s *stubTracingService) GetWorkloadTracingConfig(ctx context.Context) (tracingservice.WorkloadTracingConfig, error) {
+	return s.config, s.getErr
+}
+
+func (s *stubTracingService) WatchWorkloadTracingConfig(ctx context.Context) (watcher.NotifyWatcher, error) {
+	s.watchCalls++
+	return s.watcher, s.watchErr
+}
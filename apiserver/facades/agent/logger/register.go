@@ -32,5 +32,6 @@ func newLoggerAPIV2(ctx facade.ModelContext) (*LoggerAPIV2, error) {
 	return NewLoggerAPIV2(ctx.Auth(),
 		ctx.WatcherRegistry(),
 		ctx.DomainServices().Config(),
-		ctx.DomainServices().Logging())
+		ctx.DomainServices().Logging(),
+		ctx.DomainServices().Tracing())
 }
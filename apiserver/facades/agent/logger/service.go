@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/logging"
+	tracingservice "github.com/juju/juju/domain/tracing/service"
 	"github.com/juju/juju/environs/config"
 )
 
@@ -31,3 +32,15 @@ type ControllerLokiConfigService interface {
 	// changes.
 	WatchLokiConfig(ctx context.Context) (watcher.NotifyWatcher, error)
 }
+
+// TracingService is an interface that provides access to the controller
+// workload tracing configuration, which holds the OTEL collector that agents
+// send their logs to when log export is enabled.
+type TracingService interface {
+	// GetWorkloadTracingConfig returns the workload tracing config.
+	GetWorkloadTracingConfig(ctx context.Context) (tracingservice.WorkloadTracingConfig, error)
+
+	// WatchWorkloadTracingConfig returns a watcher that emits notifications
+	// when the workload tracing configuration changes.
+	WatchWorkloadTracingConfig(ctx context.Context) (watcher.NotifyWatcher, error)
+}
@@ -224,6 +224,11 @@ type ApplicationService interface {
 	// GetCharmLocatorByApplicationName returns a CharmLocator by application name.
 	GetCharmLocatorByApplicationName(ctx context.Context, name string) (charm.CharmLocator, error)
 
+	// GetUnitRefreshCharmLocator returns the locator of the charm the named
+	// unit should be running. This is the charm of its application, unless a
+	// staged refresh of the application has not yet released the unit.
+	GetUnitRefreshCharmLocator(ctx context.Context, unitName coreunit.Name) (charm.CharmLocator, error)
+
 	// ShouldAllowCharmUpgradeOnError indicates if the units of an application should
 	// upgrade to the latest version of the application charm even if they are in
 	// error state.
@@ -71,6 +71,7 @@ type MockApplicationServiceMockRecorder struct {
 	getUnitNamesForApplicationExpects       []*gomock.Call2_2[context.Context, string, []unit.Name, error]
 	getUnitPrincipalExpects                 []*gomock.Call2_3[context.Context, unit.Name, unit.Name, bool, error]
 	getUnitRefreshAttributesExpects         []*gomock.Call2_2[context.Context, unit.Name, application0.UnitAttributes, error]
+	getUnitRefreshCharmLocatorExpects       []*gomock.Call2_2[context.Context, unit.Name, charm.CharmLocator, error]
 	getUnitSubordinatesExpects              []*gomock.Call2_2[context.Context, unit.Name, []unit.Name, error]
 	getUnitUUIDExpects                      []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
 	getUnitWorkloadVersionExpects           []*gomock.Call2_2[context.Context, unit.Name, string, error]
@@ -384,6 +385,24 @@ func (mr *MockApplicationServiceMockRecorder) GetUnitRefreshAttributes(arg0, arg
 // MockApplicationServiceGetUnitRefreshAttributesCall is the typed call wrapper for GetUnitRefreshAttributes.
 type MockApplicationServiceGetUnitRefreshAttributesCall = gomock.Call2_2[context.Context, unit.Name, application0.UnitAttributes, error]
 
+// GetUnitRefreshCharmLocator mocks base method.
+func (m *MockApplicationService) GetUnitRefreshCharmLocator(ctx context.Context, unitName unit.Name) (charm.CharmLocator, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getUnitRefreshCharmLocatorExpects, m.ctrl, m, "GetUnitRefreshCharmLocator", ctx, unitName)
+}
+
+// GetUnitRefreshCharmLocator indicates an expected call of GetUnitRefreshCharmLocator.
+func (mr *MockApplicationServiceMockRecorder) GetUnitRefreshCharmLocator(ctx, unitName any) *MockApplicationServiceGetUnitRefreshCharmLocatorCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, unit.Name, charm.CharmLocator, error](mr.mock.ctrl.T, mr.mock, "GetUnitRefreshCharmLocator", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(unitName))
+	mr.getUnitRefreshCharmLocatorExpects = append(mr.getUnitRefreshCharmLocatorExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetUnitRefreshCharmLocatorCall is the typed call wrapper for GetUnitRefreshCharmLocator.
+type MockApplicationServiceGetUnitRefreshCharmLocatorCall = gomock.Call2_2[context.Context, unit.Name, charm.CharmLocator, error]
+
 // GetUnitSubordinates mocks base method.
 func (m *MockApplicationService) GetUnitSubordinates(arg0 context.Context, arg1 unit.Name) ([]unit.Name, error) {
 	m.ctrl.T.Helper()
@@ -40,6 +40,7 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	domainapplication "github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	"github.com/juju/juju/domain/deployment/charm"
@@ -852,7 +853,15 @@ func (u *UniterAPI) charmURLForApplication(ctx context.Context, tag names.Applic
 	if err != nil {
 		return "", false, internalerrors.Capture(err)
 	}
-	charmLocator, err := u.applicationService.GetCharmLocatorByApplicationName(ctx, tag.Id())
+	// A unit agent asking for its application's charm is told the charm it
+	// should be running, which differs from the application's while a staged
+	// refresh hasn't released the unit.
+	var charmLocator applicationcharm.CharmLocator
+	if unitTag, ok := u.auth.GetAuthTag().(names.UnitTag); ok && isUnitOfApplication(unitTag, tag) {
+		charmLocator, err = u.applicationService.GetUnitRefreshCharmLocator(ctx, coreunit.Name(unitTag.Id()))
+	} else {
+		charmLocator, err = u.applicationService.GetCharmLocatorByApplicationName(ctx, tag.Id())
+	}
 	if err != nil {
 		return "", false, internalerrors.Capture(err)
 	}
@@ -863,12 +872,13 @@ func (u *UniterAPI) charmURLForApplication(ctx context.Context, tag names.Applic
 	return curl, charmUpgradeOnError, nil
 }
 
+func isUnitOfApplication(unitTag names.UnitTag, appTag names.ApplicationTag) bool {
+	appName, err := names.UnitApplication(unitTag.Id())
+	return err == nil && appName == appTag.Id()
+}
+
 func (u *UniterAPI) charmURLForUnit(ctx context.Context, tag names.UnitTag) (string, bool, error) {
-	appName, err := names.UnitApplication(tag.Id())
-	if err != nil {
-		return "", false, internalerrors.Capture(err)
-	}
-	charmLocator, err := u.applicationService.GetCharmLocatorByApplicationName(ctx, appName)
+	charmLocator, err := u.applicationService.GetUnitRefreshCharmLocator(ctx, coreunit.Name(tag.Id()))
 	if err != nil {
 		return "", false, internalerrors.Capture(err)
 	}
@@ -87,6 +87,7 @@ func (s *uniterSuite) SetUpTest(c *tc.C) {
 	s.IsolationSuite.SetUpTest(c)
 
 	s.badTag = nil
+	s.authTag = nil
 }
 
 func (s *uniterSuite) TestEnsureDeadUnauthorised(c *tc.C) {
@@ -856,12 +857,12 @@ func (s *uniterSuite) TestCharmURL(c *tc.C) {
 		Architecture: architecture.AMD64,
 	}
 	// Arrange: expected unit calls
-	s.expectGetCharmLocatorByApplicationName(c, "mysql", locator, nil)
+	s.expectGetUnitRefreshCharmLocator(c, "mysql/0", locator, nil)
 
-	s.expectGetCharmLocatorByApplicationName(c, "wordpress", locator, nil)
+	s.expectGetUnitRefreshCharmLocator(c, "wordpress/0", locator, nil)
 
 	boom := internalerrors.New("boom")
-	s.expectGetCharmLocatorByApplicationName(c, "foo", locator, boom)
+	s.expectGetUnitRefreshCharmLocator(c, "foo/42", locator, boom)
 
 	// Arrange: expected application calls
 	s.expectShouldAllowCharmUpgradeOnError(c, "mysql", true, nil)
@@ -891,6 +892,33 @@ func (s *uniterSuite) TestCharmURL(c *tc.C) {
 	})
 }
 
+func (s *uniterSuite) TestCharmURLApplicationOfUnitAgent(c *tc.C) {
+	s.authTag = names.NewUnitTag("mysql/0")
+	defer s.setupMocks(c).Finish()
+	// Arrange:
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: "application-mysql"},
+	}}
+	locator := domaincharm.CharmLocator{
+		Source:       domaincharm.CharmHubSource,
+		Revision:     41,
+		Architecture: architecture.AMD64,
+	}
+	s.expectShouldAllowCharmUpgradeOnError(c, "mysql", true, nil)
+	s.expectGetUnitRefreshCharmLocator(c, "mysql/0", locator, nil)
+
+	// Act:
+	result, err := s.uniter.CharmURL(c.Context(), args)
+
+	// Assert:
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.StringBoolResults{
+		Results: []params.StringBoolResult{
+			{Result: "ch:amd64/-41", Ok: true},
+		},
+	})
+}
+
 func (s *uniterSuite) TestSetCharm(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -970,6 +998,10 @@ func (s *uniterSuite) expectGetCharmLocatorByApplicationName(c *tc.C, appName st
 	s.applicationService.EXPECT().GetCharmLocatorByApplicationName(gomock.Any(), appName).Return(charmLocator, err)
 }
 
+func (s *uniterSuite) expectGetUnitRefreshCharmLocator(c *tc.C, unitName coreunit.Name, charmLocator domaincharm.CharmLocator, err error) {
+	s.applicationService.EXPECT().GetUnitRefreshCharmLocator(gomock.Any(), unitName).Return(charmLocator, err)
+}
+
 func (s *uniterSuite) expectShouldAllowCharmUpgradeOnError(c *tc.C, appName string, v bool, err error) {
 	s.applicationService.EXPECT().ShouldAllowCharmUpgradeOnError(gomock.Any(), appName).Return(v, err)
 }
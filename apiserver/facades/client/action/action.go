@@ -85,6 +85,16 @@ type OperationService interface {
 	// messages for a specified action being added. The strings are json encoded
 	// action messages.
 	WatchTaskLogs(ctx context.Context, taskID string) (watcher.StringsWatcher, error)
+
+	// AddSchedule adds a schedule to run an action repeatedly, whenever the
+	// given cron expression falls due.
+	AddSchedule(ctx context.Context, args operation.ScheduleArgs) error
+
+	// GetSchedules returns all the schedules in the model, ordered by name.
+	GetSchedules(ctx context.Context) ([]operation.Schedule, error)
+
+	// RemoveSchedule removes the named schedule.
+	RemoveSchedule(ctx context.Context, name string) error
 }
 
 // ActionAPI implements the client API for interacting with Actions
@@ -100,11 +110,25 @@ type ActionAPI struct {
 	operationService   OperationService
 }
 
+// APIv8 provides the Action API facade for version 8.
+type APIv8 struct {
+	*ActionAPI
+}
+
 // APIv7 provides the Action API facade for version 7.
 type APIv7 struct {
-	*ActionAPI
+	*APIv8
 }
 
+// AddSchedules isn't on the v7 API.
+func (api *APIv7) AddSchedules(_ struct{}) {}
+
+// ListSchedules isn't on the v7 API.
+func (api *APIv7) ListSchedules(_ struct{}) {}
+
+// RemoveSchedules isn't on the v7 API.
+func (api *APIv7) RemoveSchedules(_ struct{}) {}
+
 func newActionAPI(
 	authorizer facade.Authorizer,
 	getLeadershipReader func() (leadership.Reader, error),
@@ -339,7 +363,7 @@ func toOperationResult(op operation.OperationInfo) params.OperationResult {
 		result := toActionResult(names.NewUnitTag(f.ReceiverName.String()), f.TaskInfo)
 		return result
 	})
-	return params.OperationResult{
+	result := params.OperationResult{
 		OperationTag: names.NewOperationTag(op.OperationID).String(),
 		Summary:      op.Summary,
 		Enqueued:     op.Enqueued,
@@ -348,7 +372,12 @@ func toOperationResult(op operation.OperationInfo) params.OperationResult {
 		Status:       op.Status.String(),
 		Actions:      append(machineResult, unitResults...),
 		Error:        apiservererrors.ServerError(op.Error),
+		Schedule:     op.Schedule,
+	}
+	if !op.ScheduledBy.IsZero() {
+		result.ScheduledByTag = names.NewUserTag(op.ScheduledBy.Name()).String()
 	}
+	return result
 }
 
 // toActionResult converts an operation.TaskInfo to a params.ActionResult.
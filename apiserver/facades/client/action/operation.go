@@ -11,11 +11,13 @@ import (
 	"github.com/juju/collections/transform"
 	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	coreerrors "github.com/juju/juju/core/errors"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	coreoperation "github.com/juju/juju/core/operation"
+	"github.com/juju/juju/core/permission"
 	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/operation"
@@ -30,8 +32,12 @@ const leader = "/leader"
 // an operation, each action running as a task on the designated ActionReceiver.
 // We return the ID of the overall operation and each individual task.
 func (a *ActionAPI) EnqueueOperation(ctx context.Context, arg params.Actions) (params.EnqueuedActions, error) {
-	if err := a.checkCanWrite(ctx); err != nil {
-		return params.EnqueuedActions{}, errors.Capture(err)
+	// Users without write access to the model may still run actions on an
+	// application they have been granted run-action on. That is checked once
+	// the application of the receivers is known.
+	writeErr := a.checkCanWrite(ctx)
+	if writeErr != nil && !errors.Is(writeErr, authentication.ErrorEntityMissingPermission) {
+		return params.EnqueuedActions{}, errors.Capture(writeErr)
 	}
 
 	if len(arg.Actions) == 0 {
@@ -90,6 +96,15 @@ func (a *ActionAPI) EnqueueOperation(ctx context.Context, arg params.Actions) (p
 		receivers = append(receivers, operation.ActionReceiver{Unit: unit.Name(unitTag.Id())})
 	}
 
+	if writeErr != nil {
+		if applicationName == "" {
+			return params.EnqueuedActions{}, errors.Capture(writeErr)
+		}
+		if err := a.authorizer.HasPermission(ctx, permission.RunActionAccess, names.NewApplicationTag(applicationName)); err != nil {
+			return params.EnqueuedActions{}, errors.Capture(err)
+		}
+	}
+
 	// If no valid receivers (all are invalid), do not call service; return per-action errors.
 	if len(receivers) == 0 {
 		return params.EnqueuedActions{Actions: actionResults}, nil
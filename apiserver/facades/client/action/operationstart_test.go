@@ -54,6 +54,55 @@ func (s *enqueueSuite) TestEnqueuePermissionDenied(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
 }
 
+// TestEnqueueApplicationPermission verifies that a user without write access
+// to the model can enqueue an operation on an application they have been
+// granted run-action on.
+func (s *enqueueSuite) TestEnqueueApplicationPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	// Arrange
+	auth := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("run-action-application-app")}
+	api := s.newActionAPIWithAuthorizer(c, auth)
+	taskArgs := operation.TaskArgs{ActionName: "do"}
+	s.OperationService.EXPECT().AddActionOperation(gomock.Any(), []operation.ActionReceiver{{Unit: "app/0"}},
+		taskArgs).
+		Return(operation.RunResult{
+			OperationID: "1",
+			Units: []operation.UnitTaskResult{{
+				ReceiverName: "app/0",
+				TaskInfo: operation.TaskInfo{
+					ID:         "2",
+					ActionName: taskArgs.ActionName,
+				}}}}, nil)
+
+	// Act
+	res, err := api.EnqueueOperation(c.Context(), params.Actions{Actions: []params.Action{{
+		Receiver: "unit-app-0",
+		Name:     "do",
+	}}})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res.OperationTag, tc.Equals, "operation-1")
+}
+
+// TestEnqueueApplicationPermissionDenied verifies that a run-action grant on
+// one application does not allow actions to be run on another.
+func (s *enqueueSuite) TestEnqueueApplicationPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	// Arrange
+	auth := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("run-action-application-other")}
+	api := s.newActionAPIWithAuthorizer(c, auth)
+
+	// Act
+	_, err := api.EnqueueOperation(c.Context(), params.Actions{Actions: []params.Action{{
+		Receiver: "unit-app-0",
+		Name:     "do",
+	}}})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
 // TestEnqueueNoActions verifies that enqueuing an operation with no actions results
 // in an appropriate error response.
 func (s *enqueueSuite) TestEnqueueNoActions(c *tc.C) {
@@ -138,10 +138,13 @@ type MockOperationServiceMockRecorder struct {
 	addActionOperationExpects            []*gomock.Call3_2[context.Context, []operation.ActionReceiver, operation.TaskArgs, operation.RunResult, error]
 	addExecOperationExpects              []*gomock.Call3_2[context.Context, operation.Receivers, operation.ExecArgs, operation.RunResult, error]
 	addExecOperationOnAllMachinesExpects []*gomock.Call2_2[context.Context, operation.ExecArgs, operation.RunResult, error]
+	addScheduleExpects                   []*gomock.Call2_1[context.Context, operation.ScheduleArgs, error]
 	cancelTaskExpects                    []*gomock.Call2_2[context.Context, string, operation.Task, error]
 	getOperationByIDExpects              []*gomock.Call2_2[context.Context, string, operation.OperationInfo, error]
 	getOperationsExpects                 []*gomock.Call2_2[context.Context, operation.QueryArgs, operation.QueryResult, error]
+	getSchedulesExpects                  []*gomock.Call1_2[context.Context, []operation.Schedule, error]
 	getTaskExpects                       []*gomock.Call2_2[context.Context, string, operation.Task, error]
+	removeScheduleExpects                []*gomock.Call2_1[context.Context, string, error]
 	watchTaskLogsExpects                 []*gomock.Call2_2[context.Context, string, watcher.StringsWatcher, error]
 }
 
@@ -211,6 +214,24 @@ func (mr *MockOperationServiceMockRecorder) AddExecOperationOnAllMachines(ctx, a
 // MockOperationServiceAddExecOperationOnAllMachinesCall is the typed call wrapper for AddExecOperationOnAllMachines.
 type MockOperationServiceAddExecOperationOnAllMachinesCall = gomock.Call2_2[context.Context, operation.ExecArgs, operation.RunResult, error]
 
+// AddSchedule mocks base method.
+func (m *MockOperationService) AddSchedule(ctx context.Context, args operation.ScheduleArgs) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addScheduleExpects, m.ctrl, m, "AddSchedule", ctx, args)
+}
+
+// AddSchedule indicates an expected call of AddSchedule.
+func (mr *MockOperationServiceMockRecorder) AddSchedule(ctx, args any) *MockOperationServiceAddScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, operation.ScheduleArgs, error](mr.mock.ctrl.T, mr.mock, "AddSchedule", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.addScheduleExpects = append(mr.addScheduleExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOperationServiceAddScheduleCall is the typed call wrapper for AddSchedule.
+type MockOperationServiceAddScheduleCall = gomock.Call2_1[context.Context, operation.ScheduleArgs, error]
+
 // CancelTask mocks base method.
 func (m *MockOperationService) CancelTask(ctx context.Context, taskID string) (operation.Task, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +286,24 @@ func (mr *MockOperationServiceMockRecorder) GetOperations(ctx, args any) *MockOp
 // MockOperationServiceGetOperationsCall is the typed call wrapper for GetOperations.
 type MockOperationServiceGetOperationsCall = gomock.Call2_2[context.Context, operation.QueryArgs, operation.QueryResult, error]
 
+// GetSchedules mocks base method.
+func (m *MockOperationService) GetSchedules(ctx context.Context) ([]operation.Schedule, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getSchedulesExpects, m.ctrl, m, "GetSchedules", ctx)
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *MockOperationServiceMockRecorder) GetSchedules(ctx any) *MockOperationServiceGetSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []operation.Schedule, error](mr.mock.ctrl.T, mr.mock, "GetSchedules", gomock.EnsureMatcher(ctx))
+	mr.getSchedulesExpects = append(mr.getSchedulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOperationServiceGetSchedulesCall is the typed call wrapper for GetSchedules.
+type MockOperationServiceGetSchedulesCall = gomock.Call1_2[context.Context, []operation.Schedule, error]
+
 // GetTask mocks base method.
 func (m *MockOperationService) GetTask(ctx context.Context, taskID string) (operation.Task, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +322,24 @@ func (mr *MockOperationServiceMockRecorder) GetTask(ctx, taskID any) *MockOperat
 // MockOperationServiceGetTaskCall is the typed call wrapper for GetTask.
 type MockOperationServiceGetTaskCall = gomock.Call2_2[context.Context, string, operation.Task, error]
 
+// RemoveSchedule mocks base method.
+func (m *MockOperationService) RemoveSchedule(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.removeScheduleExpects, m.ctrl, m, "RemoveSchedule", ctx, name)
+}
+
+// RemoveSchedule indicates an expected call of RemoveSchedule.
+func (mr *MockOperationServiceMockRecorder) RemoveSchedule(ctx, name any) *MockOperationServiceRemoveScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "RemoveSchedule", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.removeScheduleExpects = append(mr.removeScheduleExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOperationServiceRemoveScheduleCall is the typed call wrapper for RemoveSchedule.
+type MockOperationServiceRemoveScheduleCall = gomock.Call2_1[context.Context, string, error]
+
 // WatchTaskLogs mocks base method.
 func (m *MockOperationService) WatchTaskLogs(ctx context.Context, taskID string) (watcher.StringsWatcher, error) {
 	m.ctrl.T.Helper()
@@ -17,10 +17,22 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Action", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newActionAPIV7(ctx)
 	}, reflect.TypeFor[*APIv7]())
+	registry.MustRegister("Action", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newActionAPIV8(ctx)
+	}, reflect.TypeFor[*APIv8]())
 }
 
 // newActionAPIV7 returns an initialized ActionAPI for version 7.
 func newActionAPIV7(ctx facade.ModelContext) (*APIv7, error) {
+	api, err := newActionAPIV8(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv7{APIv8: api}, nil
+}
+
+// newActionAPIV8 returns an initialized ActionAPI for version 8.
+func newActionAPIV8(ctx facade.ModelContext) (*APIv8, error) {
 	domainServices := ctx.DomainServices()
 
 	api, err := newActionAPI(
@@ -36,5 +48,5 @@ func newActionAPIV7(ctx facade.ModelContext) (*APIv7, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &APIv7{ActionAPI: api}, nil
+	return &APIv8{ActionAPI: api}, nil
 }
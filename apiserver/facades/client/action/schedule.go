@@ -0,0 +1,160 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/collections/transform"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/unit"
+	coreuser "github.com/juju/juju/core/user"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/operation"
+	operationerrors "github.com/juju/juju/domain/operation/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// AddSchedules schedules actions to run repeatedly, each time its cron
+// expression falls due. The actions are run on behalf of the calling user,
+// for as long as they have write access to the model.
+func (a *ActionAPI) AddSchedules(ctx context.Context, args params.ScheduleActionsArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+	if err := a.check.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+	userTag, ok := a.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return params.ErrorResults{}, apiservererrors.ErrPerm
+	}
+	createdBy := coreuser.NameFromTag(userTag)
+	// The groups the identity provider reported the user to be a member of
+	// are recorded with the schedule, as they count towards the creator's
+	// access each time it falls due.
+	creatorGroups := a.authorizer.GetAuthGroups()
+
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Schedules)),
+	}
+	for i, arg := range args.Schedules {
+		results.Results[i].Error = apiservererrors.ServerError(a.addSchedule(ctx, arg, createdBy, creatorGroups))
+	}
+	return results, nil
+}
+
+func (a *ActionAPI) addSchedule(
+	ctx context.Context, arg params.ScheduleActionArg, createdBy coreuser.Name, creatorGroups []string,
+) error {
+	receivers := make([]operation.ActionReceiver, len(arg.Receivers))
+	for i, receiver := range arg.Receivers {
+		if appName, ok := strings.CutSuffix(receiver, leader); ok {
+			receivers[i] = operation.ActionReceiver{LeaderUnit: appName}
+			continue
+		}
+		unitTag, err := names.ParseUnitTag(receiver)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		receivers[i] = operation.ActionReceiver{Unit: unit.Name(unitTag.Id())}
+	}
+
+	err := a.operationService.AddSchedule(ctx, operation.ScheduleArgs{
+		Name:      arg.Name,
+		Schedule:  arg.Schedule,
+		Receivers: receivers,
+		Task: operation.TaskArgs{
+			ActionName:     arg.Action,
+			Parameters:     arg.Parameters,
+			IsParallel:     zeroNilPtr(arg.Parallel),
+			ExecutionGroup: zeroNilPtr(arg.ExecutionGroup),
+		},
+		CreatedBy:     createdBy,
+		CreatorGroups: creatorGroups,
+	})
+	if notDefined, ok := errors.AsType[operationerrors.ActionNotDefined](err); ok {
+		if notDefined.HasActions {
+			return apiservererrors.ParamsErrorf(params.CodeNotFound,
+				"action %q not defined for unit %q.", arg.Action, notDefined.UnitName)
+		}
+		return apiservererrors.ParamsErrorf(params.CodeNotFound,
+			"no actions defined for charm %s.", notDefined.CharmName)
+	} else if errors.Is(err, operationerrors.ScheduleAlreadyExists) {
+		return apiservererrors.ParamsErrorf(params.CodeAlreadyExists,
+			"scheduled action %q already exists", arg.Name)
+	} else if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return apiservererrors.ParamsErrorf(params.CodeNotFound, "%v", err)
+	}
+	return errors.Capture(err)
+}
+
+// ListSchedules returns the scheduled actions of the model.
+func (a *ActionAPI) ListSchedules(ctx context.Context) (params.ScheduledActionResults, error) {
+	if err := a.checkCanRead(ctx); err != nil {
+		return params.ScheduledActionResults{}, errors.Capture(err)
+	}
+
+	schedules, err := a.operationService.GetSchedules(ctx)
+	if err != nil {
+		return params.ScheduledActionResults{}, errors.Capture(err)
+	}
+	return params.ScheduledActionResults{
+		Results: transform.Slice(schedules, toScheduledAction),
+	}, nil
+}
+
+// RemoveSchedules removes scheduled actions. Operations already started by
+// them are kept.
+func (a *ActionAPI) RemoveSchedules(ctx context.Context, args params.RemoveScheduledActionsArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+	if err := a.check.RemoveAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Capture(err)
+	}
+
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		err := a.operationService.RemoveSchedule(ctx, name)
+		if errors.Is(err, operationerrors.ScheduleNotFound) {
+			err = apiservererrors.ParamsErrorf(params.CodeNotFound, "scheduled action %q not found", name)
+		}
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// toScheduledAction converts an operation.Schedule to a
+// params.ScheduledAction.
+func toScheduledAction(s operation.Schedule) params.ScheduledAction {
+	result := params.ScheduledAction{
+		Name:     s.Name,
+		Schedule: s.Schedule,
+		Receivers: transform.Slice(s.Receivers, func(r operation.ActionReceiver) string {
+			if r.LeaderUnit != "" {
+				return r.LeaderUnit + leader
+			}
+			return names.NewUnitTag(r.Unit.String()).String()
+		}),
+		Action:         s.Task.ActionName,
+		Parameters:     s.Task.Parameters,
+		Parallel:       s.Task.IsParallel,
+		ExecutionGroup: s.Task.ExecutionGroup,
+		CreatedByTag:   names.NewUserTag(s.CreatedBy.Name()).String(),
+		Created:        s.Created,
+		NextRun:        s.NextRun,
+		LastRun:        s.LastRun,
+	}
+	if s.LastOperationID != "" {
+		result.LastOperationTag = names.NewOperationTag(s.LastOperationID).String()
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	stdtesting "testing"
+	"time"
+
+	gomock "github.com/canonical/gomock/gomock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	"github.com/juju/juju/domain/operation"
+	operationerrors "github.com/juju/juju/domain/operation/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type scheduleSuite struct {
+	MockBaseSuite
+}
+
+func TestScheduleSuite(t *stdtesting.T) {
+	tc.Run(t, &scheduleSuite{})
+}
+
+func (s *scheduleSuite) newActionAPI(c *tc.C) *ActionAPI {
+	s.BlockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("",
+		blockcommanderrors.NotFound).AnyTimes()
+	return s.MockBaseSuite.newActionAPI(c)
+}
+
+func (s *scheduleSuite) TestAddSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.newActionAPI(c)
+
+	parallel := true
+	group := "backups"
+	s.Authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.Authorizer.EXPECT().GetAuthGroups().Return([]string{"ops"})
+	s.OperationService.EXPECT().AddSchedule(gomock.Any(), operation.ScheduleArgs{
+		Name:     "nightly",
+		Schedule: "0 2 * * *",
+		Receivers: []operation.ActionReceiver{
+			{Unit: "app/0"},
+			{LeaderUnit: "app"},
+		},
+		Task: operation.TaskArgs{
+			ActionName:     "backup",
+			Parameters:     map[string]any{"target": "s3"},
+			IsParallel:     true,
+			ExecutionGroup: "backups",
+		},
+		CreatedBy:     usertesting.GenNewName(c, "fred"),
+		CreatorGroups: []string{"ops"},
+	}).Return(nil)
+	s.OperationService.EXPECT().AddSchedule(gomock.Any(), gomock.Any()).Return(
+		errors.Errorf("schedule %q", "hourly").Add(operationerrors.ScheduleAlreadyExists))
+
+	res, err := api.AddSchedules(c.Context(), params.ScheduleActionsArgs{
+		Schedules: []params.ScheduleActionArg{{
+			Name:           "nightly",
+			Schedule:       "0 2 * * *",
+			Receivers:      []string{"unit-app-0", "app/leader"},
+			Action:         "backup",
+			Parameters:     map[string]any{"target": "s3"},
+			Parallel:       &parallel,
+			ExecutionGroup: &group,
+		}, {
+			Name:      "hourly",
+			Schedule:  "@hourly",
+			Receivers: []string{"unit-app-0"},
+			Action:    "backup",
+		}, {
+			Name:      "bad",
+			Schedule:  "@hourly",
+			Receivers: []string{"machine-0"},
+			Action:    "backup",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.DeepEquals, &params.Error{
+		Code:    params.CodeAlreadyExists,
+		Message: `scheduled action "hourly" already exists`,
+	})
+	c.Check(res.Results[2].Error, tc.ErrorMatches, `"machine-0" is not a valid unit tag`)
+}
+
+func (s *scheduleSuite) TestAddSchedulesActionNotDefined(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.newActionAPI(c)
+
+	s.Authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("fred"))
+	s.Authorizer.EXPECT().GetAuthGroups().Return(nil)
+	s.OperationService.EXPECT().AddSchedule(gomock.Any(), gomock.Any()).Return(
+		operationerrors.ActionNotDefined{CharmName: "mycharm", UnitName: "app/0", HasActions: true})
+
+	res, err := api.AddSchedules(c.Context(), params.ScheduleActionsArgs{
+		Schedules: []params.ScheduleActionArg{{
+			Name:      "nightly",
+			Schedule:  "0 2 * * *",
+			Receivers: []string{"unit-app-0"},
+			Action:    "backup",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error, tc.DeepEquals, &params.Error{
+		Code:    params.CodeNotFound,
+		Message: `action "backup" not defined for unit "app/0".`,
+	})
+}
+
+func (s *scheduleSuite) TestAddSchedulesPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	auth := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("readonly")}
+	api := s.newActionAPIWithAuthorizer(c, auth)
+
+	_, err := api.AddSchedules(c.Context(), params.ScheduleActionsArgs{
+		Schedules: []params.ScheduleActionArg{{Name: "nightly"}},
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *scheduleSuite) TestAddSchedulesRequiresWriteAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.Authorizer.EXPECT().AuthClient().Return(true)
+	api := s.newActionAPIWithAuthorizer(c, s.Authorizer)
+	s.Authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, api.modelTag).Return(
+		apiservererrors.ErrPerm)
+
+	// No schedule is added.
+	_, err := api.AddSchedules(c.Context(), params.ScheduleActionsArgs{
+		Schedules: []params.ScheduleActionArg{{
+			Name:      "nightly",
+			Schedule:  "0 2 * * *",
+			Receivers: []string{"unit-app-0"},
+			Action:    "backup",
+		}},
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *scheduleSuite) TestListSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.newActionAPI(c)
+
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	nextRun := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	lastRun := time.Date(2026, 3, 4, 2, 0, 0, 0, time.UTC)
+	s.OperationService.EXPECT().GetSchedules(gomock.Any()).Return([]operation.Schedule{{
+		Name:     "nightly",
+		Schedule: "0 2 * * *",
+		Receivers: []operation.ActionReceiver{
+			{LeaderUnit: "app"},
+			{Unit: "app/0"},
+		},
+		Task: operation.TaskArgs{
+			ActionName:     "backup",
+			Parameters:     map[string]any{"target": "s3"},
+			ExecutionGroup: "backups",
+		},
+		CreatedBy:       usertesting.GenNewName(c, "fred"),
+		Created:         created,
+		NextRun:         nextRun,
+		LastRun:         lastRun,
+		LastOperationID: "42",
+	}}, nil)
+
+	res, err := api.ListSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(res.Results, tc.DeepEquals, []params.ScheduledAction{{
+		Name:             "nightly",
+		Schedule:         "0 2 * * *",
+		Receivers:        []string{"app/leader", "unit-app-0"},
+		Action:           "backup",
+		Parameters:       map[string]any{"target": "s3"},
+		ExecutionGroup:   "backups",
+		CreatedByTag:     "user-fred",
+		Created:          created,
+		NextRun:          nextRun,
+		LastRun:          lastRun,
+		LastOperationTag: "operation-42",
+	}})
+}
+
+func (s *scheduleSuite) TestRemoveSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.newActionAPI(c)
+
+	s.OperationService.EXPECT().RemoveSchedule(gomock.Any(), "nightly").Return(nil)
+	s.OperationService.EXPECT().RemoveSchedule(gomock.Any(), "missing").Return(
+		errors.Errorf("schedule %q", "missing").Add(operationerrors.ScheduleNotFound))
+
+	res, err := api.RemoveSchedules(c.Context(), params.RemoveScheduledActionsArgs{
+		Names: []string{"nightly", "missing"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 2)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.DeepEquals, &params.Error{
+		Code:    params.CodeNotFound,
+		Message: `scheduled action "missing" not found`,
+	})
+}
+
+func (s *scheduleSuite) TestRemoveSchedulesPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	auth := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("readonly")}
+	api := s.newActionAPIWithAuthorizer(c, auth)
+
+	_, err := api.RemoveSchedules(c.Context(), params.RemoveScheduledActionsArgs{
+		Names: []string{"nightly"},
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *scheduleSuite) TestRemoveSchedulesRequiresWriteAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.Authorizer.EXPECT().AuthClient().Return(true)
+	api := s.newActionAPIWithAuthorizer(c, s.Authorizer)
+	s.Authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, api.modelTag).Return(
+		apiservererrors.ErrPerm)
+
+	// No schedule is removed.
+	_, err := api.RemoveSchedules(c.Context(), params.RemoveScheduledActionsArgs{
+		Names: []string{"nightly"},
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
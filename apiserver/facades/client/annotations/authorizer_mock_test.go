@@ -35,6 +35,7 @@ type MockAuthorizerMockRecorder struct {
 	authOwnerExpects            []*gomock.Call1_1[names.Tag, bool]
 	authUnitAgentExpects        []*gomock.Call0_1[bool]
 	entityHasPermissionExpects  []*gomock.Call4_1[context.Context, names.Tag, permission.Access, names.Tag, error]
+	getAuthGroupsExpects        []*gomock.Call0_1[[]string]
 	getAuthTagExpects           []*gomock.Call0_1[names.Tag]
 	hasPermissionExpects        []*gomock.Call3_1[context.Context, permission.Access, names.Tag, error]
 }
@@ -195,6 +196,24 @@ func (mr *MockAuthorizerMockRecorder) EntityHasPermission(ctx, entity, operation
 // MockAuthorizerEntityHasPermissionCall is the typed call wrapper for EntityHasPermission.
 type MockAuthorizerEntityHasPermissionCall = gomock.Call4_1[context.Context, names.Tag, permission.Access, names.Tag, error]
 
+// GetAuthGroups mocks base method.
+func (m *MockAuthorizer) GetAuthGroups() []string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.getAuthGroupsExpects, m.ctrl, m, "GetAuthGroups")
+}
+
+// GetAuthGroups indicates an expected call of GetAuthGroups.
+func (mr *MockAuthorizerMockRecorder) GetAuthGroups() *MockAuthorizerGetAuthGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[[]string](mr.mock.ctrl.T, mr.mock, "GetAuthGroups")
+	mr.getAuthGroupsExpects = append(mr.getAuthGroupsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerGetAuthGroupsCall is the typed call wrapper for GetAuthGroups.
+type MockAuthorizerGetAuthGroupsCall = gomock.Call0_1[[]string]
+
 // GetAuthTag mocks base method.
 func (m *MockAuthorizer) GetAuthTag() names.Tag {
 	m.ctrl.T.Helper()
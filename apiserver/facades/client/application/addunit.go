@@ -12,6 +12,7 @@ import (
 	coreerrors "github.com/juju/juju/core/errors"
 	coreinstance "github.com/juju/juju/core/instance"
 	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
 	coreunit "github.com/juju/juju/core/unit"
 	domainapplicationerrors "github.com/juju/juju/domain/application/errors"
 	domainapplicationservice "github.com/juju/juju/domain/application/service"
@@ -24,7 +25,7 @@ import (
 func (api *APIBase) AddUnits(
 	ctx context.Context, args params.AddApplicationUnits,
 ) (params.AddApplicationUnitsResults, error) {
-	if err := api.checkCanWrite(ctx); err != nil {
+	if err := api.checkCanOperateApplications(ctx, permission.ScaleAccess, args.ApplicationName); err != nil {
 		return params.AddApplicationUnitsResults{}, errors.Capture(err)
 	}
 	if err := api.check.ChangeAllowed(ctx); err != nil {
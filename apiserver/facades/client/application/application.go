@@ -21,6 +21,7 @@ import (
 	"gopkg.in/macaroon.v2"
 	goyaml "gopkg.in/yaml.v2"
 
+	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
@@ -65,9 +66,14 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+// APIv23 provides the Application API facade for version 23.
+type APIv23 struct {
+	*APIBase
+}
+
 // APIv22 provides the Application API facade for version 22.
 type APIv22 struct {
-	*APIBase
+	*APIv23
 }
 
 // APIv21 provides the Application API facade for version 21.
@@ -152,6 +158,7 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 
 	validatorCfg := validatorConfig{
 		charmhubHTTPClient: charmhubHTTPClient,
+		registryService:    domainServices.CharmRegistry(),
 		caasBroker:         nil,
 		modelInfo:          modelInfo,
 		modelConfigService: domainServices.Config(),
@@ -274,6 +281,23 @@ func (api *APIBase) checkCanWrite(ctx context.Context) error {
 	return api.checkAccess(ctx, permission.WriteAccess)
 }
 
+// checkCanOperateApplications checks that the user can perform the operation
+// on each of the named applications. Write access to the model allows every
+// operation; without it, the user must have been granted the operation, or
+// write access, on each application.
+func (api *APIBase) checkCanOperateApplications(ctx context.Context, operation permission.Access, appNames ...string) error {
+	err := api.checkCanWrite(ctx)
+	if !errors.Is(err, authentication.ErrorEntityMissingPermission) || len(appNames) == 0 {
+		return err
+	}
+	for _, appName := range appNames {
+		if err := api.authorizer.HasPermission(ctx, operation, names.NewApplicationTag(appName)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Deploy fetches the charms from the charm store and deploys them
 // using the specified placement directives.
 func (api *APIv20) Deploy(ctx context.Context, args params.ApplicationsDeploy) (params.ErrorResults, error) {
@@ -672,7 +696,7 @@ func (api *APIv19) SetCharm(ctx context.Context, argsV1 params.ApplicationSetCha
 
 // SetCharm sets the charm for a given for the application.
 func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetCharmV2) error {
-	if err := api.checkCanWrite(ctx); err != nil {
+	if err := api.checkCanOperateApplications(ctx, permission.RefreshAccess, args.ApplicationName); err != nil {
 		return err
 	}
 
@@ -706,6 +730,7 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 		ForceBase:                 args.ForceBase,
 		EndpointBindings:          transform.Map(args.EndpointBindings, func(k, v string) (string, network.SpaceName) { return k, network.SpaceName(v) }),
 		StorageDirectiveOverrides: storageDirectiveOverrides,
+		Rollout:                   convertRefreshRolloutArgs(args.Rollout),
 	})
 	switch {
 	case errors.Is(err, applicationerrors.ApplicationNotFound):
@@ -775,6 +800,11 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 			params.CodeNotSupported,
 			"cannot set charm %q because %s", args.CharmURL, typeErr.Error(),
 		)
+	case errors.Is(err, applicationerrors.RefreshRolloutInProgress):
+		return apiservererrors.ParamsErrorf(
+			params.CodeBadRequest,
+			"cannot set charm %q: %s", args.CharmURL, err.Error(),
+		)
 	case err != nil:
 		return err
 	}
@@ -782,6 +812,75 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 	return nil
 }
 
+// convertRefreshRolloutArgs converts the params of a staged refresh to the
+// domain representation. A nil input results in a nil output.
+func convertRefreshRolloutArgs(in *params.RefreshRolloutArgs) *application.RefreshRolloutParams {
+	if in == nil {
+		return nil
+	}
+	return &application.RefreshRolloutParams{
+		BatchSize:       in.BatchSize,
+		BatchPercent:    in.BatchPercent,
+		BatchTimeout:    in.BatchTimeout,
+		RollbackOnError: in.RollbackOnError,
+	}
+}
+
+// ContinueRefresh resumes the paused staged refreshes of the given
+// applications.
+// The following apiserver codes can be returned in each ErrorResult:
+//   - [params.CodeNotFound]: If the application or its staged refresh doesn't
+//     exist.
+//   - [params.CodeBadRequest]: If the staged refresh is not paused.
+func (api *APIBase) ContinueRefresh(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
+	var appNames []string
+	for _, entity := range args.Entities {
+		if appTag, err := names.ParseApplicationTag(entity.Tag); err == nil {
+			appNames = append(appNames, appTag.Id())
+		}
+	}
+	if err := api.checkCanOperateApplications(ctx, permission.RefreshAccess, appNames...); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		results.Results[i].Error = apiservererrors.ServerError(api.continueOneRefresh(ctx, entity))
+	}
+	return results, nil
+}
+
+func (api *APIBase) continueOneRefresh(ctx context.Context, entity params.Entity) error {
+	appTag, err := names.ParseApplicationTag(entity.Tag)
+	if err != nil {
+		return err
+	}
+	appName := appTag.Id()
+
+	err = api.applicationService.ContinueRefreshRollout(ctx, appName)
+	switch {
+	case errors.Is(err, applicationerrors.ApplicationNotFound):
+		return errors.NotFoundf("application %q", appName)
+	case errors.Is(err, applicationerrors.RefreshRolloutNotFound):
+		return errors.NotFoundf("refresh of application %q", appName)
+	case errors.Is(err, applicationerrors.RefreshRolloutNotPaused):
+		return apiservererrors.ParamsErrorf(
+			params.CodeBadRequest, "refresh of application %q is not paused", appName,
+		)
+	case err != nil:
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// ContinueRefresh isn't on the v22 API.
+func (api *APIv22) ContinueRefresh(_ struct{}) {}
+
 func convertToApplicationStorageDirectiveOverrides(
 	ctx context.Context,
 	storageService StorageService,
@@ -1023,7 +1122,13 @@ func (api *APIBase) DestroyUnit(ctx context.Context, args params.DestroyUnitsPar
 	if api.modelType == model.CAAS {
 		return params.DestroyUnitResults{}, errors.NotSupportedf("removing units on a non-container model")
 	}
-	if err := api.checkCanWrite(ctx); err != nil {
+	var appNames []string
+	for _, arg := range args.Units {
+		if unitTag, err := names.ParseUnitTag(arg.UnitTag); err == nil {
+			appNames = append(appNames, coreunit.Name(unitTag.Id()).Application())
+		}
+	}
+	if err := api.checkCanOperateApplications(ctx, permission.ScaleAccess, appNames...); err != nil {
 		return params.DestroyUnitResults{}, errors.Trace(err)
 	}
 	if err := api.check.RemoveAllowed(ctx); err != nil {
@@ -1232,7 +1337,13 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 	if api.modelType != model.CAAS {
 		return params.ScaleApplicationResults{}, errors.NotSupportedf("scaling applications on a non-container model")
 	}
-	if err := api.checkCanWrite(ctx); err != nil {
+	var appNames []string
+	for _, arg := range args.Applications {
+		if appTag, err := names.ParseApplicationTag(arg.ApplicationTag); err == nil {
+			appNames = append(appNames, appTag.Id())
+		}
+	}
+	if err := api.checkCanOperateApplications(ctx, permission.ScaleAccess, appNames...); err != nil {
 		return params.ScaleApplicationResults{}, errors.Trace(err)
 	}
 	if err := api.check.ChangeAllowed(ctx); err != nil {
@@ -1737,7 +1848,10 @@ func (api *APIBase) GetConfig(ctx context.Context, args params.Entities) (params
 // Config map that are set to an empty string. Unset should be used for that.
 func (api *APIBase) SetConfigs(ctx context.Context, args params.ConfigSetArgs) (params.ErrorResults, error) {
 	var result params.ErrorResults
-	if err := api.checkCanWrite(ctx); err != nil {
+	appNames := transform.Slice(args.Args, func(arg params.ConfigSet) string {
+		return arg.ApplicationName
+	})
+	if err := api.checkCanOperateApplications(ctx, permission.ConfigAccess, appNames...); err != nil {
 		return result, errors.Trace(err)
 	}
 	if err := api.check.ChangeAllowed(ctx); err != nil {
@@ -1783,7 +1897,10 @@ func (api *APIBase) setConfig(ctx context.Context, arg params.ConfigSet) params.
 // UnsetApplicationsConfig implements the server side of Application.UnsetApplicationsConfig.
 func (api *APIBase) UnsetApplicationsConfig(ctx context.Context, args params.ApplicationConfigUnsetArgs) (params.ErrorResults, error) {
 	var result params.ErrorResults
-	if err := api.checkCanWrite(ctx); err != nil {
+	appNames := transform.Slice(args.Args, func(arg params.ApplicationUnset) string {
+		return arg.ApplicationName
+	})
+	if err := api.checkCanOperateApplications(ctx, permission.ConfigAccess, appNames...); err != nil {
 		return result, errors.Trace(err)
 	}
 	if err := api.check.ChangeAllowed(ctx); err != nil {
@@ -1924,6 +2041,17 @@ func (api *APIBase) ApplicationsInfo(ctx context.Context, in params.Entities) (p
 			continue
 		}
 
+		// The signer is only known if the charm archive was signed by a
+		// trusted key when it was uploaded.
+		var charmSigner string
+		signature, err := api.applicationService.GetCharmSignature(ctx, locator)
+		if err != nil {
+			out[i].Error = apiservererrors.ServerError(err)
+			continue
+		} else if signature != nil {
+			charmSigner = signature.Signer
+		}
+
 		bindings, err := api.applicationService.GetApplicationEndpointBindings(ctx, tag.Name)
 		if errors.Is(err, applicationerrors.ApplicationNotFound) {
 			out[i].Error = apiservererrors.ParamsErrorf(params.CodeNotFound, "application %s not found", tag.Name)
@@ -2021,6 +2149,7 @@ func (api *APIBase) ApplicationsInfo(ctx context.Context, in params.Entities) (p
 			Life:             string(appLife),
 			EndpointBindings: bindingsMap,
 			ExposedEndpoints: mappedExposedEndpoints,
+			CharmSigner:      charmSigner,
 		}
 	}
 	return params.ApplicationInfoResults{
@@ -12,6 +12,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/permission"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -91,6 +92,33 @@ func (s *permBaseSuite) TestSetCharmPermission(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
 }
 
+func (s *permBaseSuite) TestSetCharmApplicationPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasMissingWritePermission()
+	s.expectHasApplicationPermission(permission.RefreshAccess, "foo", nil)
+	s.expectDisallowBlockChange()
+
+	s.newAPI(c)
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{ApplicationName: "foo"})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
+func (s *permBaseSuite) TestSetCharmApplicationPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasMissingWritePermission()
+	s.expectHasApplicationPermission(permission.RefreshAccess, "foo", apiservererrors.ErrPerm)
+
+	s.newAPI(c)
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{ApplicationName: "foo"})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
 func (s *permBaseSuite) TestSetCharmBlocked(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -457,6 +485,38 @@ func (s *permBaseSuite) TestSetConfigsBlocked(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "blocked")
 }
 
+func (s *permBaseSuite) TestSetConfigsApplicationPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasMissingWritePermission()
+	s.expectHasApplicationPermission(permission.ConfigAccess, "foo", nil)
+	s.expectDisallowBlockChange()
+
+	s.newAPI(c)
+
+	_, err := s.api.SetConfigs(c.Context(), params.ConfigSetArgs{
+		Args: []params.ConfigSet{{ApplicationName: "foo"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
+func (s *permBaseSuite) TestSetConfigsApplicationPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasMissingWritePermission()
+	s.expectHasApplicationPermission(permission.ConfigAccess, "foo", nil)
+	s.expectHasApplicationPermission(permission.ConfigAccess, "bar", apiservererrors.ErrPerm)
+
+	s.newAPI(c)
+
+	_, err := s.api.SetConfigs(c.Context(), params.ConfigSetArgs{
+		Args: []params.ConfigSet{{ApplicationName: "foo"}, {ApplicationName: "bar"}},
+	})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
 func (s *permBaseSuite) TestUnsetApplicationsConfigPermission(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -607,6 +667,22 @@ func (s *permBaseSuite) TestAddUnitsPermission(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
 }
 
+func (s *permBaseSuite) TestAddUnitsApplicationPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasMissingWritePermission()
+	s.expectHasApplicationPermission(permission.ScaleAccess, "foo", nil)
+	s.expectDisallowBlockChange()
+
+	s.newAPI(c)
+
+	_, err := s.api.AddUnits(c.Context(), params.AddApplicationUnits{
+		ApplicationName: "foo",
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permBaseSuite) TestAddUnitsBlocked(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -694,6 +770,22 @@ func (s *permSuiteCAAS) TestScaleApplicationsPermission(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
 }
 
+func (s *permSuiteCAAS) TestScaleApplicationsApplicationPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasMissingWritePermission()
+	s.expectHasApplicationPermission(permission.ScaleAccess, "foo", nil)
+	s.expectDisallowBlockChange()
+
+	s.newAPI(c)
+
+	_, err := s.api.ScaleApplications(c.Context(), params.ScaleApplicationsParamsV2{
+		Applications: []params.ScaleApplicationParamsV2{{ApplicationTag: "application-foo"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "blocked")
+}
+
 func (s *permSuiteCAAS) TestScaleApplicationsBlocked(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1010,6 +1010,96 @@ func (s *applicationSuite) TestSetCharm(c *tc.C) {
 
 }
 
+func (s *applicationSuite) TestSetCharmRollout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), "foo", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ applicationcharm.CharmLocator, args domainapplication.SetCharmParams) error {
+			c.Check(args.Rollout, tc.DeepEquals, &domainapplication.RefreshRolloutParams{
+				BatchPercent:    25,
+				BatchTimeout:    10 * time.Minute,
+				RollbackOnError: true,
+			})
+			return nil
+		})
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "ch:arm64/foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "charm-hub",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04",
+			},
+			Architecture: "arm64",
+			Revision:     new(42),
+			Risk:         "stable",
+		},
+		Rollout: &params.RefreshRolloutArgs{
+			BatchPercent:    25,
+			BatchTimeout:    10 * time.Minute,
+			RollbackOnError: true,
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestSetCharmRolloutInProgress(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), "foo", gomock.Any(), gomock.Any()).
+		Return(applicationerrors.RefreshRolloutInProgress)
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "ch:arm64/foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "charm-hub",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04",
+			},
+			Architecture: "arm64",
+			Revision:     new(42),
+			Risk:         "stable",
+		},
+		Rollout: &params.RefreshRolloutArgs{
+			BatchSize:    1,
+			BatchTimeout: time.Minute,
+		},
+	})
+	c.Assert(err, tc.Satisfies, params.IsBadRequest)
+}
+
+func (s *applicationSuite) TestContinueRefresh(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.applicationService.EXPECT().ContinueRefreshRollout(gomock.Any(), "foo").Return(nil)
+	s.applicationService.EXPECT().ContinueRefreshRollout(gomock.Any(), "bar").Return(applicationerrors.RefreshRolloutNotPaused)
+	s.applicationService.EXPECT().ContinueRefreshRollout(gomock.Any(), "baz").Return(applicationerrors.RefreshRolloutNotFound)
+
+	res, err := s.api.ContinueRefresh(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-foo"},
+			{Tag: "application-bar"},
+			{Tag: "application-baz"},
+			{Tag: "unit-foo-0"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 4)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsBadRequest)
+	c.Check(res.Results[2].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[3].Error, tc.ErrorMatches, `"unit-foo-0" is not a valid application tag`)
+}
+
 func (s *applicationSuite) TestSetConfigsYAMLNotImplemented(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -177,7 +177,7 @@ func (d deployApplicationLocalRepo) Deploy(ctx context.Context, args DeployAppli
 	}
 
 	var downloadInfo *applicationcharm.DownloadInfo
-	if args.CharmOrigin.Source == corecharm.CharmHub {
+	if source := args.CharmOrigin.Source; source == corecharm.CharmHub || source == corecharm.OCI {
 		var err error
 		downloadInfo, err = d.applicationService.GetCharmDownloadInfo(ctx, args.Charm.locator)
 		if err != nil {
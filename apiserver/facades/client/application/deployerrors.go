@@ -8,6 +8,7 @@ import (
 	coreerrors "github.com/juju/juju/core/errors"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -98,6 +99,14 @@ func handleApplicationDomainDeployError(err error) error {
 			prohibitErr.CharmStorageLocation,
 			prohibitErr.ProhibitedLocation,
 		).Add(coreerrors.NotValid)
+	// When the model's charm-signature-policy rejects a charm that is yet to
+	// be downloaded.
+	case errors.Is(err, charmsignature.ErrSignatureNotFound),
+		errors.Is(err, charmsignature.ErrSignatureNotValid),
+		errors.Is(err, charmsignature.ErrSignerNotTrusted):
+		return errors.Errorf(
+			"charm rejected by the charm-signature-policy of the model: %w", err,
+		).Add(coreerrors.NotValid)
 	// When attaching storage to an application but the application name is not
 	// a valid application name.
 	case errors.Is(err, applicationerrors.ApplicationNameNotValid):
@@ -42,6 +42,7 @@ import (
 	storageerrors "github.com/juju/juju/domain/storage/errors"
 	"github.com/juju/juju/environs/bootstrap"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/ocicharm"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -190,6 +191,10 @@ func (api *DeployFromRepositoryAPI) DeployFromRepository(
 			DownloadURL:        dt.downloadInfo.DownloadURL,
 			DownloadSize:       dt.downloadInfo.DownloadSize,
 		},
+		PublishedSignatures: &applicationcharm.PublishedSignatures{
+			Digest:     dt.downloadInfo.SignedDigest,
+			Signatures: dt.downloadInfo.Signatures,
+		},
 		ResolvedResources: dt.resolvedResources,
 		EndpointBindings:  dt.endpoints,
 		Devices:           arg.Devices,
@@ -341,7 +346,7 @@ func (v *deployFromRepositoryValidator) resolveResources(
 	}
 
 	// Solve revision against charm repository.
-	repo, err := v.getCharmRepository(ctx)
+	repo, err := v.getCharmRepository(ctx, origin.Source)
 	if err != nil {
 		return nil, nil, errors.Trace(err)
 	}
@@ -390,6 +395,7 @@ type deployTemplate struct {
 
 type validatorConfig struct {
 	charmhubHTTPClient facade.HTTPClient
+	registryService    repository.RegistryCredentialGetter
 	caasBroker         CaasBrokerInterface
 	modelInfo          coremodel.ModelInfo
 	modelConfigService ModelConfigService
@@ -402,6 +408,7 @@ type validatorConfig struct {
 func makeDeployFromRepositoryValidator(ctx context.Context, cfg validatorConfig) DeployFromRepositoryValidator {
 	v := &deployFromRepositoryValidator{
 		charmhubHTTPClient: cfg.charmhubHTTPClient,
+		registryService:    cfg.registryService,
 		modelInfo:          cfg.modelInfo,
 		modelConfigService: cfg.modelConfigService,
 		applicationService: cfg.applicationService,
@@ -410,6 +417,9 @@ func makeDeployFromRepositoryValidator(ctx context.Context, cfg validatorConfig)
 		newCharmHubRepository: func(cfg repository.CharmHubRepositoryConfig) (corecharm.Repository, error) {
 			return repository.NewCharmHubRepository(cfg)
 		},
+		newOCIRepository: func(cfg repository.OCIRepositoryConfig) (corecharm.Repository, error) {
+			return repository.NewOCIRepository(cfg)
+		},
 		logger: cfg.logger,
 	}
 	if cfg.modelInfo.Type == coremodel.CAAS {
@@ -446,7 +456,9 @@ type deployFromRepositoryValidator struct {
 
 	// For testing using mocks.
 	newCharmHubRepository func(repository.CharmHubRepositoryConfig) (corecharm.Repository, error)
+	newOCIRepository      func(repository.OCIRepositoryConfig) (corecharm.Repository, error)
 	charmhubHTTPClient    facade.HTTPClient
+	registryService       repository.RegistryCredentialGetter
 
 	logger corelogger.Logger
 }
@@ -742,6 +754,10 @@ func (v iaasDeployFromRepositoryValidator) ValidateArg(ctx context.Context, arg
 }
 
 func (v *deployFromRepositoryValidator) createOrigin(ctx context.Context, arg params.DeployFromRepositoryArg) (*charm.URL, corecharm.Origin, bool, error) {
+	if strings.HasPrefix(arg.CharmName, ocicharm.Scheme+"://") {
+		return v.createOCIOrigin(ctx, arg)
+	}
+
 	path, err := charm.EnsureSchema(arg.CharmName, charm.CharmHub)
 	if err != nil {
 		return nil, corecharm.Origin{}, false, err
@@ -779,6 +795,34 @@ func (v *deployFromRepositoryValidator) createOrigin(ctx context.Context, arg pa
 	return curl, origin, usedModelDefaultBase, nil
 }
 
+// createOCIOrigin creates the origin of a charm in an OCI registry. The tag or
+// digest of the reference takes the place of the channel.
+func (v *deployFromRepositoryValidator) createOCIOrigin(ctx context.Context, arg params.DeployFromRepositoryArg) (*charm.URL, corecharm.Origin, bool, error) {
+	if arg.Channel != nil && *arg.Channel != "" {
+		return nil, corecharm.Origin{}, false, errors.BadRequestf("channel is not supported for OCI charm %q, use a tag instead", arg.CharmName)
+	}
+	ref, err := ocicharm.ParseReference(arg.CharmName)
+	if err != nil {
+		return nil, corecharm.Origin{}, false, errors.Trace(err)
+	}
+	curl, err := charm.ParseURL(arg.CharmName)
+	if err != nil {
+		return nil, corecharm.Origin{}, false, errors.Trace(err)
+	}
+	if arg.Revision != nil {
+		curl = curl.WithRevision(*arg.Revision)
+	}
+
+	plat, usedModelDefaultBase, err := v.deducePlatform(ctx, arg)
+	if err != nil {
+		return nil, corecharm.Origin{}, false, err
+	}
+
+	origin := repository.OCIOrigin(ref, plat)
+	origin.Revision = arg.Revision
+	return curl, origin, usedModelDefaultBase, nil
+}
+
 // deducePlatform returns a platform for initial resolveCharm call.
 // At minimum, it must contain an architecture.
 // Platform is determined by the args: architecture constraint and provided
@@ -949,7 +993,7 @@ func (v *deployFromRepositoryValidator) platformFromPlacement(ctx context.Contex
 }
 
 func (v *deployFromRepositoryValidator) resolveCharm(ctx context.Context, curl *charm.URL, requestedOrigin corecharm.Origin, force, usedModelDefaultBase bool, cons constraints.Value) (corecharm.ResolvedDataForDeploy, error) {
-	repo, err := v.getCharmRepository(ctx)
+	repo, err := v.getCharmRepository(ctx, requestedOrigin.Source)
 	if err != nil {
 		return corecharm.ResolvedDataForDeploy{}, errors.Trace(err)
 	}
@@ -1106,7 +1150,15 @@ func (v *deployFromRepositoryValidator) getCharm(ctx context.Context, arg params
 
 }
 
-func (v *deployFromRepositoryValidator) getCharmRepository(ctx context.Context) (corecharm.Repository, error) {
+func (v *deployFromRepositoryValidator) getCharmRepository(ctx context.Context, source corecharm.Source) (corecharm.Repository, error) {
+	if source == corecharm.OCI {
+		return v.newOCIRepository(repository.OCIRepositoryConfig{
+			Logger:      v.logger,
+			HTTPClient:  v.charmhubHTTPClient,
+			Credentials: repository.OCICredentials(v.registryService),
+		})
+	}
+
 	modelCfg, err := v.modelConfigService.ModelConfig(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -1117,5 +1169,6 @@ func (v *deployFromRepositoryValidator) getCharmRepository(ctx context.Context)
 		Logger:             v.logger,
 		CharmhubHTTPClient: v.charmhubHTTPClient,
 		CharmhubURL:        charmhubURL,
+		Credentials:        repository.CharmhubCredentials(v.registryService),
 	})
 }
@@ -8,10 +8,12 @@ import (
 
 	gomock "github.com/canonical/gomock/gomock"
 	"github.com/juju/clock"
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	"gopkg.in/macaroon.v2"
 
+	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
@@ -105,6 +107,15 @@ func (s *baseSuite) expectHasIncorrectPermission() {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any(), names.NewModelTag(s.modelUUID.String())).Return(apiservererrors.ErrPerm)
 }
 
+func (s *baseSuite) expectHasMissingWritePermission() {
+	err := jujuerrors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, names.NewModelTag(s.modelUUID.String())).Return(err)
+}
+
+func (s *baseSuite) expectHasApplicationPermission(access permission.Access, appName string, err error) {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), access, names.NewApplicationTag(appName)).Return(err)
+}
+
 func (s *baseSuite) expectAnyPermissions() {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 }
@@ -187,6 +187,10 @@ type ApplicationService interface {
 	// aspects such as storage are still viable with the new charm.
 	SetApplicationCharm(ctx context.Context, appName string, locator applicationcharm.CharmLocator, params application.SetCharmParams) error
 
+	// ContinueRefreshRollout resumes the paused staged refresh of the named
+	// application.
+	ContinueRefreshRollout(ctx context.Context, appName string) error
+
 	// SetApplicationScale sets the application's desired scale value.
 	// This is used on CAAS models.
 	SetApplicationScale(ctx context.Context, name string, scale int) error
@@ -244,6 +248,10 @@ type ApplicationService interface {
 	// to load the charm metadata.
 	GetCharmLocatorByApplicationName(ctx context.Context, name string) (applicationcharm.CharmLocator, error)
 
+	// GetCharmSignature returns the verified signature of the charm. Nil is
+	// returned if the charm archive was not signed by a trusted key.
+	GetCharmSignature(ctx context.Context, locator applicationcharm.CharmLocator) (*applicationcharm.Signature, error)
+
 	// GetCharm returns the charm by name, source and revision. Calling this
 	// method will return all the data associated with the charm. It is not
 	// expected to call this method for all calls, instead use the move focused
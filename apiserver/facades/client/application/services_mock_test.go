@@ -348,6 +348,7 @@ type MockApplicationServiceMockRecorder struct {
 	addCAASUnitsExpects                        []*gomock.Call2V_2[context.Context, string, service.AddUnitArg, []unit.Name, error]
 	addIAASUnitsExpects                        []*gomock.Call2V_3[context.Context, string, service.AddIAASUnitArg, []unit.Name, []machine.Name, error]
 	changeApplicationScaleExpects              []*gomock.Call3_2[context.Context, string, int, int, error]
+	continueRefreshRolloutExpects              []*gomock.Call2_1[context.Context, string, error]
 	createCAASApplicationExpects               []*gomock.Call5V_2[context.Context, string, charm1.Charm, charm.Origin, service.AddApplicationArgs, service.AddUnitArg, application.UUID, error]
 	createIAASApplicationExpects               []*gomock.Call5V_2[context.Context, string, charm1.Charm, charm.Origin, service.AddApplicationArgs, service.AddIAASUnitArg, application.UUID, error]
 	getApplicationAndCharmConfigExpects        []*gomock.Call2_2[context.Context, application.UUID, service.ApplicationConfig, error]
@@ -364,6 +365,7 @@ type MockApplicationServiceMockRecorder struct {
 	getCharmLocatorByApplicationNameExpects    []*gomock.Call2_2[context.Context, string, charm0.CharmLocator, error]
 	getCharmMetadataExpects                    []*gomock.Call2_2[context.Context, charm0.CharmLocator, charm1.Meta, error]
 	getCharmMetadataNameExpects                []*gomock.Call2_2[context.Context, charm0.CharmLocator, string, error]
+	getCharmSignatureExpects                   []*gomock.Call2_2[context.Context, charm0.CharmLocator, *charm0.Signature, error]
 	getExposedEndpointsExpects                 []*gomock.Call2_2[context.Context, string, map[string]application0.ExposedEndpoint, error]
 	getSupportedFeaturesExpects                []*gomock.Call1_2[context.Context, assumes.FeatureSet, error]
 	getUnitK8sPodInfoExpects                   []*gomock.Call2_2[context.Context, unit.Name, application0.K8sPodInfo, error]
@@ -455,6 +457,24 @@ func (mr *MockApplicationServiceMockRecorder) ChangeApplicationScale(ctx, name,
 // MockApplicationServiceChangeApplicationScaleCall is the typed call wrapper for ChangeApplicationScale.
 type MockApplicationServiceChangeApplicationScaleCall = gomock.Call3_2[context.Context, string, int, int, error]
 
+// ContinueRefreshRollout mocks base method.
+func (m *MockApplicationService) ContinueRefreshRollout(ctx context.Context, appName string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.continueRefreshRolloutExpects, m.ctrl, m, "ContinueRefreshRollout", ctx, appName)
+}
+
+// ContinueRefreshRollout indicates an expected call of ContinueRefreshRollout.
+func (mr *MockApplicationServiceMockRecorder) ContinueRefreshRollout(ctx, appName any) *MockApplicationServiceContinueRefreshRolloutCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "ContinueRefreshRollout", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appName))
+	mr.continueRefreshRolloutExpects = append(mr.continueRefreshRolloutExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceContinueRefreshRolloutCall is the typed call wrapper for ContinueRefreshRollout.
+type MockApplicationServiceContinueRefreshRolloutCall = gomock.Call2_1[context.Context, string, error]
+
 // CreateCAASApplication mocks base method.
 func (m *MockApplicationService) CreateCAASApplication(arg0 context.Context, arg1 string, arg2 charm1.Charm, arg3 charm.Origin, arg4 service.AddApplicationArgs, arg5 ...service.AddUnitArg) (application.UUID, error) {
 	m.ctrl.T.Helper()
@@ -745,6 +765,24 @@ func (mr *MockApplicationServiceMockRecorder) GetCharmMetadataName(ctx, locator
 // MockApplicationServiceGetCharmMetadataNameCall is the typed call wrapper for GetCharmMetadataName.
 type MockApplicationServiceGetCharmMetadataNameCall = gomock.Call2_2[context.Context, charm0.CharmLocator, string, error]
 
+// GetCharmSignature mocks base method.
+func (m *MockApplicationService) GetCharmSignature(ctx context.Context, locator charm0.CharmLocator) (*charm0.Signature, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getCharmSignatureExpects, m.ctrl, m, "GetCharmSignature", ctx, locator)
+}
+
+// GetCharmSignature indicates an expected call of GetCharmSignature.
+func (mr *MockApplicationServiceMockRecorder) GetCharmSignature(ctx, locator any) *MockApplicationServiceGetCharmSignatureCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, charm0.CharmLocator, *charm0.Signature, error](mr.mock.ctrl.T, mr.mock, "GetCharmSignature", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(locator))
+	mr.getCharmSignatureExpects = append(mr.getCharmSignatureExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetCharmSignatureCall is the typed call wrapper for GetCharmSignature.
+type MockApplicationServiceGetCharmSignatureCall = gomock.Call2_2[context.Context, charm0.CharmLocator, *charm0.Signature, error]
+
 // GetExposedEndpoints mocks base method.
 func (m *MockApplicationService) GetExposedEndpoints(ctx context.Context, appName string) (map[string]application0.ExposedEndpoint, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/auditlog"
+	"github.com/juju/juju/rpc/params"
+)
+
+// AuditLogAPI implements the AuditLog facade, which queries the audit log
+// of API requests recorded by the controller.
+type AuditLogAPI struct {
+	controllerTag names.ControllerTag
+	authorizer    facade.Authorizer
+
+	auditLogService AuditLogService
+}
+
+// Query returns the audit log entries matching the given filter, ordered by
+// the time their request was made. Only controller superusers may query the
+// audit log.
+func (api *AuditLogAPI) Query(ctx context.Context, args params.AuditLogQueryArgs) (params.AuditLogQueryResult, error) {
+	if err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag); err != nil {
+		return params.AuditLogQueryResult{}, err
+	}
+
+	filter := auditlog.Filter{
+		User:    args.User,
+		Model:   args.Model,
+		Facade:  args.Facade,
+		Method:  args.Method,
+		Outcome: auditlog.Outcome(args.Outcome),
+		Limit:   args.Limit,
+	}
+	if args.Since != nil {
+		filter.Since = *args.Since
+	}
+	if args.Until != nil {
+		filter.Until = *args.Until
+	}
+
+	entries, err := api.auditLogService.Query(ctx, filter)
+	if err != nil {
+		return params.AuditLogQueryResult{}, apiservererrors.ServerError(err)
+	}
+
+	result := params.AuditLogQueryResult{
+		Entries: make([]params.AuditLogEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		result.Entries[i] = params.AuditLogEntry{
+			ConversationID: entry.Conversation.ConversationID,
+			ConnectionID:   entry.Conversation.ConnectionID,
+			Who:            entry.Conversation.Who,
+			What:           entry.Conversation.What,
+			ModelName:      entry.Conversation.ModelName,
+			ModelUUID:      entry.Conversation.ModelUUID,
+			RequestID:      entry.Request.RequestID,
+			Facade:         entry.Request.Facade,
+			Method:         entry.Request.Method,
+			Version:        entry.Request.Version,
+			Args:           entry.Request.Args,
+			When:           entry.Request.When,
+			Outcome:        string(entry.Outcome),
+			RespondedAt:    entry.RespondedAt,
+		}
+		for _, e := range entry.Errors {
+			result.Entries[i].Errors = append(result.Entries[i].Errors, params.AuditLogError{
+				Code:    e.Code,
+				Message: e.Message,
+			})
+		}
+	}
+	return result, nil
+}
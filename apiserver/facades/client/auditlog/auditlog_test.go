@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/auditlog"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type auditLogSuite struct {
+	authorizer      *MockAuthorizer
+	auditLogService *MockAuditLogService
+}
+
+func TestAuditLogSuite(t *stdtesting.T) {
+	tc.Run(t, &auditLogSuite{})
+}
+
+func (s *auditLogSuite) TestQuery(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(nil)
+
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	when := since.Add(time.Hour)
+	respondedAt := when.Add(time.Second)
+	s.auditLogService.EXPECT().Query(gomock.Any(), auditlog.Filter{
+		User:    "alice",
+		Model:   "admin/prod",
+		Facade:  "Application",
+		Since:   since,
+		Outcome: auditlog.OutcomeFailed,
+		Limit:   10,
+	}).Return([]auditlog.Entry{{
+		Conversation: auditlog.Conversation{
+			ConversationID: "0123456789abcdef",
+			ConnectionID:   "1A",
+			Who:            "alice",
+			What:           "juju remove-application mysql",
+			ModelName:      "admin/prod",
+			ModelUUID:      "model-uuid",
+			When:           since,
+		},
+		Request: auditlog.Request{
+			ConversationID: "0123456789abcdef",
+			RequestID:      3,
+			Facade:         "Application",
+			Method:         "DestroyApplication",
+			Version:        20,
+			When:           when,
+		},
+		Outcome:     auditlog.OutcomeFailed,
+		RespondedAt: &respondedAt,
+		Errors:      []auditlog.Error{{Code: "not found", Message: "application not found"}},
+	}}, nil)
+
+	result, err := s.newAPI().Query(c.Context(), params.AuditLogQueryArgs{
+		User:    "alice",
+		Model:   "admin/prod",
+		Facade:  "Application",
+		Since:   &since,
+		Outcome: "failed",
+		Limit:   10,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.AuditLogQueryResult{
+		Entries: []params.AuditLogEntry{{
+			ConversationID: "0123456789abcdef",
+			ConnectionID:   "1A",
+			Who:            "alice",
+			What:           "juju remove-application mysql",
+			ModelName:      "admin/prod",
+			ModelUUID:      "model-uuid",
+			RequestID:      3,
+			Facade:         "Application",
+			Method:         "DestroyApplication",
+			Version:        20,
+			When:           when,
+			Outcome:        "failed",
+			RespondedAt:    &respondedAt,
+			Errors:         []params.AuditLogError{{Code: "not found", Message: "application not found"}},
+		}},
+	})
+}
+
+func (s *auditLogSuite) TestQueryFilterNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(nil)
+
+	s.auditLogService.EXPECT().Query(gomock.Any(), auditlog.Filter{Outcome: "exploded"}).
+		Return(nil, errors.NotValidf("audit log outcome %q", "exploded"))
+
+	_, err := s.newAPI().Query(c.Context(), params.AuditLogQueryArgs{Outcome: "exploded"})
+	c.Check(params.ErrCode(err), tc.Equals, params.CodeNotValid)
+}
+
+func (s *auditLogSuite) TestQueryPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).
+		Return(errors.New("permission denied"))
+
+	_, err := s.newAPI().Query(c.Context(), params.AuditLogQueryArgs{})
+	c.Check(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *auditLogSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.auditLogService = NewMockAuditLogService(ctrl)
+	return ctrl
+}
+
+func (s *auditLogSuite) newAPI() *AuditLogAPI {
+	return &AuditLogAPI{
+		controllerTag:   testing.ControllerTag,
+		authorizer:      s.authorizer,
+		auditLogService: s.auditLogService,
+	}
+}
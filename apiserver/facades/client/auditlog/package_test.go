@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+//go:generate go run github.com/canonical/gomock/mockgen -package auditlog -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/auditlog AuditLogService
+//go:generate go run github.com/canonical/gomock/mockgen -package auditlog -destination facade_mock_test.go github.com/juju/juju/apiserver/facade Authorizer
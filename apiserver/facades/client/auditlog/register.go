@@ -0,0 +1,35 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("AuditLog", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newAuditLogAPI(ctx)
+	}, reflect.TypeFor[*AuditLogAPI]())
+}
+
+// newAuditLogAPI returns a new AuditLog facade.
+func newAuditLogAPI(ctx facade.ModelContext) (*AuditLogAPI, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	return &AuditLogAPI{
+		controllerTag:   names.NewControllerTag(ctx.ControllerUUID()),
+		authorizer:      authorizer,
+		auditLogService: ctx.DomainServices().AuditLog(),
+	}, nil
+}
@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import (
+	"context"
+
+	"github.com/juju/juju/domain/auditlog"
+)
+
+// AuditLogService describes the methods of the audit log service used to
+// query the audit log.
+type AuditLogService interface {
+	// Query returns the audit log entries matching the filter, ordered by
+	// the time their request was made.
+	Query(ctx context.Context, filter auditlog.Filter) ([]auditlog.Entry, error)
+}
@@ -0,0 +1,60 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/auditlog (interfaces: AuditLogService)
+//
+// Generated by this command:
+//
+//	mockgen -package auditlog -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/auditlog AuditLogService
+//
+
+// Package auditlog is a generated GoMock package.
+package auditlog
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	auditlog "github.com/juju/juju/domain/auditlog"
+)
+
+// MockAuditLogService is a mock of AuditLogService interface.
+type MockAuditLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogServiceMockRecorder is the mock recorder for MockAuditLogService.
+type MockAuditLogServiceMockRecorder struct {
+	mock         *MockAuditLogService
+	queryExpects []*gomock.Call2_2[context.Context, auditlog.Filter, []auditlog.Entry, error]
+}
+
+// NewMockAuditLogService creates a new mock instance.
+func NewMockAuditLogService(ctrl *gomock.Controller) *MockAuditLogService {
+	mock := &MockAuditLogService{ctrl: ctrl}
+	mock.recorder = &MockAuditLogServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogService) EXPECT() *MockAuditLogServiceMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method.
+func (m *MockAuditLogService) Query(ctx context.Context, filter auditlog.Filter) ([]auditlog.Entry, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.queryExpects, m.ctrl, m, "Query", ctx, filter)
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockAuditLogServiceMockRecorder) Query(ctx, filter any) *MockAuditLogServiceQueryCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, auditlog.Filter, []auditlog.Entry, error](mr.mock.ctrl.T, mr.mock, "Query", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(filter))
+	mr.queryExpects = append(mr.queryExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuditLogServiceQueryCall is the typed call wrapper for Query.
+type MockAuditLogServiceQueryCall = gomock.Call2_2[context.Context, auditlog.Filter, []auditlog.Entry, error]
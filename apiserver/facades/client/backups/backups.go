@@ -5,13 +5,19 @@ package backups
 
 import (
 	"context"
+	"io"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
 	corebackups "github.com/juju/juju/core/backups"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/semversion"
 )
 
 // ControllerConfigService is an interface that provides the controller config.
@@ -20,10 +26,56 @@ type ControllerConfigService interface {
 	ControllerConfig(context.Context) (controller.Config, error)
 }
 
+// ControllerNodeService is an interface that provides the controller nodes.
+type ControllerNodeService interface {
+	// GetControllerIDs returns the IDs of the controller nodes.
+	GetControllerIDs(context.Context) ([]string, error)
+}
+
+// ModelService is an interface that provides the models of the controller.
+type ModelService interface {
+	// GetModelUUIDs returns the UUIDs of all the active models in the
+	// controller.
+	GetModelUUIDs(context.Context) ([]model.UUID, error)
+}
+
+// Backups describes the ability to create, store and restore backups of the
+// controller.
+type Backups interface {
+	// Create creates a new backup of the controller and the given models,
+	// returning the ID of the stored backup.
+	Create(ctx context.Context, meta *corebackups.Metadata, modelUUIDs []string) (string, error)
+
+	// List returns the metadata of all the stored backups.
+	List() ([]*corebackups.Metadata, error)
+
+	// Get returns the metadata of the backup with the given ID along with
+	// a reader for the backup archive.
+	Get(id string) (*corebackups.Metadata, io.ReadCloser, error)
+
+	// Remove removes the backup with the given ID.
+	Remove(id string) error
+
+	// Restore restores the backup with the given ID onto the controller,
+	// which is running the current version.
+	Restore(ctx context.Context, id string, current semversion.Number) (*corebackups.Metadata, error)
+}
+
+// APIv3 provides the Backups API facade for version 3.
+type APIv3 struct {
+	*API
+}
+
 // API provides backup-specific API methods.
 type API struct {
 	controllerConfigService ControllerConfigService
-	paths                   *corebackups.Paths
+	controllerNodeService   ControllerNodeService
+	modelService            ModelService
+	backups                 Backups
+	logger                  corelogger.Logger
+
+	// controllerModelUUID is the UUID of the controller model.
+	controllerModelUUID model.UUID
 
 	// machineID is the ID of the machine where the API server is running.
 	machineID string
@@ -31,27 +83,34 @@ type API struct {
 
 // NewAPI creates a new instance of the Backups API facade.
 func NewAPI(
+	ctx context.Context,
 	controllerConfigService ControllerConfigService,
+	controllerNodeService ControllerNodeService,
+	modelService ModelService,
+	backups Backups,
 	authorizer facade.Authorizer,
+	controllerUUID string,
+	controllerModelUUID model.UUID,
 	machineTag names.Tag,
-	dataDir, logDir string,
+	logger corelogger.Logger,
 ) (*API, error) {
-	// TODO (manadart 2023-10-11) Restore this assignment based on super-user
-	// access to the controller when re-implemented for Dqlite.
-	isControllerAdmin := true
-
-	if !authorizer.AuthClient() || !isControllerAdmin {
+	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
-
-	paths := corebackups.Paths{
-		DataDir: dataDir,
-		LogsDir: logDir,
+	// Backups hold the contents of every model in the controller, so only
+	// controller superusers may manage them.
+	err := authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(controllerUUID))
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
 
 	b := API{
 		controllerConfigService: controllerConfigService,
-		paths:                   &paths,
+		controllerNodeService:   controllerNodeService,
+		modelService:            modelService,
+		backups:                 backups,
+		logger:                  logger,
+		controllerModelUUID:     controllerModelUUID,
 		machineID:               machineTag.Id(),
 	}
 	return &b, nil
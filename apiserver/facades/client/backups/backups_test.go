@@ -0,0 +1,201 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"strings"
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/semversion"
+	jujuversion "github.com/juju/juju/core/version"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backupsSuite struct {
+	testhelpers.IsolationSuite
+
+	controllerConfigService *MockControllerConfigService
+	controllerNodeService   *MockControllerNodeService
+	modelService            *MockModelService
+	backups                 *MockBackups
+}
+
+func TestBackupsSuite(t *stdtesting.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+func (s *backupsSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.controllerNodeService = NewMockControllerNodeService(ctrl)
+	s.modelService = NewMockModelService(ctrl)
+	s.backups = NewMockBackups(ctrl)
+	return ctrl
+}
+
+func (s *backupsSuite) newAPI(c *tc.C, user string) (*API, error) {
+	return NewAPI(
+		c.Context(),
+		s.controllerConfigService,
+		s.controllerNodeService,
+		s.modelService,
+		s.backups,
+		apiservertesting.FakeAuthorizer{Tag: names.NewUserTag(user)},
+		testing.ControllerTag.Id(),
+		model.UUID(testing.ModelTag.Id()),
+		names.NewMachineTag("0"),
+		loggertesting.WrapCheckLog(c),
+	)
+}
+
+func (s *backupsSuite) TestNewAPIRequiresSuperuser(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.newAPI(c, "read")
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *backupsSuite) TestCreate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.ControllerUUIDKey: testing.ControllerTag.Id(),
+	}, nil)
+	s.controllerNodeService.EXPECT().GetControllerIDs(gomock.Any()).Return([]string{"0", "1", "2"}, nil)
+	s.modelService.EXPECT().GetModelUUIDs(gomock.Any()).Return([]model.UUID{
+		model.UUID(testing.ModelTag.Id()),
+		"d3d7fa1c-6f1b-4d2f-8b3e-9a4c0f3e6b21",
+	}, nil)
+	s.backups.EXPECT().Create(gomock.Any(), gomock.Any(), []string{
+		testing.ModelTag.Id(),
+		"d3d7fa1c-6f1b-4d2f-8b3e-9a4c0f3e6b21",
+	}).DoAndReturn(func(_ context.Context, meta *corebackups.Metadata, _ []string) (string, error) {
+		c.Check(meta.Notes, tc.Equals, "before upgrade")
+		c.Check(meta.Origin.Model, tc.Equals, testing.ModelTag.Id())
+		c.Check(meta.Origin.Machine, tc.Equals, "0")
+		c.Check(meta.Origin.Version, tc.Equals, jujuversion.Current)
+		c.Check(meta.Controller.UUID, tc.Equals, testing.ControllerTag.Id())
+		c.Check(meta.Controller.HANodes, tc.Equals, int64(3))
+
+		meta.SetID("juju-backup-20261017-100405.tar.gz")
+		return "juju-backup-20261017-100405.tar.gz", meta.MarkComplete(10, "checksum")
+	})
+
+	api, err := s.newAPI(c, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.Create(c.Context(), params.BackupsCreateArgs{Notes: "before upgrade"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ID, tc.Equals, "juju-backup-20261017-100405.tar.gz")
+	c.Check(result.Filename, tc.Equals, "juju-backup-20261017-100405.tar.gz")
+	c.Check(result.Size, tc.Equals, int64(10))
+	c.Check(result.HANodes, tc.Equals, int64(3))
+}
+
+func (s *backupsSuite) TestList(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	first := s.newMetadata(c, "juju-backup-20261016-100405.tar.gz")
+	second := s.newMetadata(c, "juju-backup-20261017-100405.tar.gz")
+	s.backups.EXPECT().List().Return([]*corebackups.Metadata{first, second}, nil)
+
+	api, err := s.newAPI(c, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.List(c.Context(), params.BackupsListArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.List, tc.DeepEquals, []params.BackupsMetadataResult{
+		params.CreateResult(first, first.ID()),
+		params.CreateResult(second, second.ID()),
+	})
+}
+
+func (s *backupsSuite) TestInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := s.newMetadata(c, "juju-backup-20261017-100405.tar.gz")
+	s.backups.EXPECT().Get("juju-backup-20261017-100405.tar.gz").Return(meta, io.NopCloser(strings.NewReader("")), nil)
+
+	api, err := s.newAPI(c, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.Info(c.Context(), params.BackupsInfoArgs{ID: "juju-backup-20261017-100405.tar.gz"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.CreateResult(meta, meta.ID()))
+}
+
+func (s *backupsSuite) TestRemove(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.backups.EXPECT().Remove("juju-backup-20261016-100405.tar.gz").Return(nil)
+	s.backups.EXPECT().Remove("juju-backup-foo.tar.gz").Return(errors.NotFoundf(`backup "juju-backup-foo.tar.gz"`))
+
+	api, err := s.newAPI(c, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.Remove(c.Context(), params.BackupsRemoveArgs{
+		IDs: []string{"juju-backup-20261016-100405.tar.gz", "juju-backup-foo.tar.gz"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.DeepEquals, &params.Error{
+		Message: `backup "juju-backup-foo.tar.gz" not found`,
+		Code:    params.CodeNotFound,
+	})
+}
+
+func (s *backupsSuite) TestRestore(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	meta := s.newMetadata(c, "juju-backup-20261017-100405.tar.gz")
+	s.backups.EXPECT().Restore(gomock.Any(), "juju-backup-20261017-100405.tar.gz", jujuversion.Current).Return(meta, nil)
+
+	api, err := s.newAPI(c, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := api.Restore(c.Context(), params.RestoreArgs{BackupID: "juju-backup-20261017-100405.tar.gz"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.ID, tc.Equals, "juju-backup-20261017-100405.tar.gz")
+}
+
+func (s *backupsSuite) TestRestoreIncompatible(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.backups.EXPECT().Restore(gomock.Any(), "juju-backup-20261017-100405.tar.gz", jujuversion.Current).Return(
+		nil, errors.NotSupportedf("restoring backup from 3.6.0 onto controller running %s", jujuversion.Current),
+	)
+
+	api, err := s.newAPI(c, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = api.Restore(c.Context(), params.RestoreArgs{BackupID: "juju-backup-20261017-100405.tar.gz"})
+	c.Assert(err, tc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *backupsSuite) newMetadata(c *tc.C, id string) *corebackups.Metadata {
+	meta := corebackups.NewMetadata()
+	meta.SetID(id)
+	meta.Started = time.Date(2026, 10, 17, 10, 4, 5, 0, time.UTC)
+	meta.Origin.Version = semversion.MustParse("4.0.2")
+	err := meta.MarkComplete(10, "checksum")
+	c.Assert(err, tc.ErrorIsNil)
+	return meta
+}
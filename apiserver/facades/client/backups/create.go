@@ -5,15 +5,62 @@ package backups
 
 import (
 	"context"
+	"os"
 
 	"github.com/juju/errors"
 
+	corebackups "github.com/juju/juju/core/backups"
+	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/rpc/params"
 )
 
 // Create is the API method that requests juju to create a new backup
-// of its state.
-func (a *API) Create(context.Context, params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
+// of its state. The backup is stored on the controller, from where it can
+// be downloaded, regardless of whether the client asks not to download it.
+func (a *API) Create(ctx context.Context, args params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
 	result := params.BackupsMetadataResult{}
-	return result, errors.NotImplementedf("Dqlite-based backups")
+
+	controllerConfig, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "getting controller config")
+	}
+	controllerIDs, err := a.controllerNodeService.GetControllerIDs(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "getting controller nodes")
+	}
+	modelUUIDs, err := a.modelService.GetModelUUIDs(ctx)
+	if err != nil {
+		return result, errors.Annotate(err, "getting models")
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = corebackups.UnknownString
+	}
+
+	meta := corebackups.NewMetadata()
+	meta.Notes = args.Notes
+	meta.Origin = corebackups.Origin{
+		Model:    a.controllerModelUUID.String(),
+		Machine:  a.machineID,
+		Hostname: hostname,
+		Version:  jujuversion.Current,
+	}
+	meta.Controller = corebackups.ControllerMetadata{
+		UUID:      controllerConfig.ControllerUUID(),
+		MachineID: a.machineID,
+		HANodes:   int64(len(controllerIDs)),
+	}
+
+	uuids := make([]string, len(modelUUIDs))
+	for i, uuid := range modelUUIDs {
+		uuids[i] = uuid.String()
+	}
+	id, err := a.backups.Create(ctx, meta, uuids)
+	if err != nil {
+		return result, errors.Annotate(err, "creating backup")
+	}
+	a.logger.Infof(ctx, "created backup %q", id)
+
+	return params.CreateResult(meta, id), nil
 }
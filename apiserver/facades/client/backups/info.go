@@ -0,0 +1,26 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Info returns the metadata of the requested backup.
+func (a *API) Info(ctx context.Context, args params.BackupsInfoArgs) (params.BackupsMetadataResult, error) {
+	meta, archive, err := a.backups.Get(args.ID)
+	if err != nil {
+		return params.BackupsMetadataResult{}, errors.Trace(err)
+	}
+	_ = archive.Close()
+
+	return params.CreateResult(meta, meta.ID()), nil
+}
+
+// Info isn't on the v3 API.
+func (a *APIv3) Info(_, _ struct{}) {}
@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// List returns the metadata of all the backups stored on the controller.
+func (a *API) List(ctx context.Context, args params.BackupsListArgs) (params.BackupsListResult, error) {
+	var result params.BackupsListResult
+
+	metaList, err := a.backups.List()
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.List = make([]params.BackupsMetadataResult, len(metaList))
+	for i, meta := range metaList {
+		result.List[i] = params.CreateResult(meta, meta.ID())
+	}
+	return result, nil
+}
+
+// List isn't on the v3 API.
+func (a *APIv3) List(_, _ struct{}) {}
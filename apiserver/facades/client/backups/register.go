@@ -7,23 +7,42 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/apiserver/facade"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("Backups", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newFacade(ctx)
+	registry.MustRegisterForMultiModel("Backups", 3, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return newFacadeV3(stdCtx, ctx)
+	}, reflect.TypeFor[*APIv3]())
+	registry.MustRegisterForMultiModel("Backups", 4, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		return newFacade(stdCtx, ctx)
 	}, reflect.TypeFor[*API]())
 }
 
+func newFacadeV3(stdCtx context.Context, ctx facade.MultiModelContext) (*APIv3, error) {
+	api, err := newFacade(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv3{API: api}, nil
+}
+
 // newFacade provides the required signature for facade registration.
-func newFacade(ctx facade.ModelContext) (*API, error) {
+func newFacade(stdCtx context.Context, ctx facade.MultiModelContext) (*API, error) {
+	domainServices := ctx.DomainServices()
 	return NewAPI(
-		ctx.DomainServices().ControllerConfig(),
+		stdCtx,
+		domainServices.ControllerConfig(),
+		domainServices.ControllerNode(),
+		domainServices.Model(),
+		ctx.Backups(),
 		ctx.Auth(),
+		ctx.ControllerUUID(),
+		ctx.ControllerModelUUID(),
 		ctx.MachineTag(),
-		ctx.DataDir(),
-		ctx.LogDir(),
+		ctx.Logger().Child("backups"),
 	)
 }
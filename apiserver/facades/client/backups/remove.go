@@ -0,0 +1,29 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Remove deletes the requested backups from the controller.
+func (a *API) Remove(ctx context.Context, args params.BackupsRemoveArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.IDs)),
+	}
+	for i, id := range args.IDs {
+		if err := a.backups.Remove(id); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		a.logger.Infof(ctx, "removed backup %q", id)
+	}
+	return results, nil
+}
+
+// Remove isn't on the v3 API.
+func (a *APIv3) Remove(_, _ struct{}) {}
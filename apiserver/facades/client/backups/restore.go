@@ -0,0 +1,32 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Restore restores the requested backup onto the controller. The backup
+// must have been created by a compatible version of Juju. The controller
+// agents need to be restarted once the restore has completed, so that they
+// pick up the restored state.
+func (a *API) Restore(ctx context.Context, args params.RestoreArgs) (params.BackupsMetadataResult, error) {
+	a.logger.Infof(ctx, "restoring backup %q", args.BackupID)
+
+	meta, err := a.backups.Restore(ctx, args.BackupID, jujuversion.Current)
+	if err != nil {
+		return params.BackupsMetadataResult{}, errors.Annotatef(err, "restoring backup %q", args.BackupID)
+	}
+
+	a.logger.Infof(ctx, "restored backup %q", args.BackupID)
+	return params.CreateResult(meta, meta.ID()), nil
+}
+
+// Restore isn't on the v3 API.
+func (a *APIv3) Restore(_, _ struct{}) {}
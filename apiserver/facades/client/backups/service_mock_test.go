@@ -0,0 +1,269 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/backups (interfaces: ControllerConfigService,ControllerNodeService,ModelService,Backups)
+//
+// Generated by this command:
+//
+//	mockgen -package backups -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/backups ControllerConfigService,ControllerNodeService,ModelService,Backups
+//
+
+// Package backups is a generated GoMock package.
+package backups
+
+import (
+	context "context"
+	io "io"
+
+	gomock "github.com/canonical/gomock/gomock"
+	controller "github.com/juju/juju/controller"
+	backups "github.com/juju/juju/core/backups"
+	model "github.com/juju/juju/core/model"
+	semversion "github.com/juju/juju/core/semversion"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock                    *MockControllerConfigService
+	controllerConfigExpects []*gomock.Call1_2[context.Context, controller.Config, error]
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.controllerConfigExpects, m.ctrl, m, "ControllerConfig", arg0)
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, controller.Config, error](mr.mock.ctrl.T, mr.mock, "ControllerConfig", gomock.EnsureMatcher(arg0))
+	mr.controllerConfigExpects = append(mr.controllerConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerConfigServiceControllerConfigCall is the typed call wrapper for ControllerConfig.
+type MockControllerConfigServiceControllerConfigCall = gomock.Call1_2[context.Context, controller.Config, error]
+
+// MockControllerNodeService is a mock of ControllerNodeService interface.
+type MockControllerNodeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerNodeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockControllerNodeServiceMockRecorder is the mock recorder for MockControllerNodeService.
+type MockControllerNodeServiceMockRecorder struct {
+	mock                    *MockControllerNodeService
+	getControllerIDsExpects []*gomock.Call1_2[context.Context, []string, error]
+}
+
+// NewMockControllerNodeService creates a new mock instance.
+func NewMockControllerNodeService(ctrl *gomock.Controller) *MockControllerNodeService {
+	mock := &MockControllerNodeService{ctrl: ctrl}
+	mock.recorder = &MockControllerNodeServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerNodeService) EXPECT() *MockControllerNodeServiceMockRecorder {
+	return m.recorder
+}
+
+// GetControllerIDs mocks base method.
+func (m *MockControllerNodeService) GetControllerIDs(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerIDsExpects, m.ctrl, m, "GetControllerIDs", arg0)
+}
+
+// GetControllerIDs indicates an expected call of GetControllerIDs.
+func (mr *MockControllerNodeServiceMockRecorder) GetControllerIDs(arg0 any) *MockControllerNodeServiceGetControllerIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []string, error](mr.mock.ctrl.T, mr.mock, "GetControllerIDs", gomock.EnsureMatcher(arg0))
+	mr.getControllerIDsExpects = append(mr.getControllerIDsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerNodeServiceGetControllerIDsCall is the typed call wrapper for GetControllerIDs.
+type MockControllerNodeServiceGetControllerIDsCall = gomock.Call1_2[context.Context, []string, error]
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock                 *MockModelService
+	getModelUUIDsExpects []*gomock.Call1_2[context.Context, []model.UUID, error]
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelUUIDs mocks base method.
+func (m *MockModelService) GetModelUUIDs(arg0 context.Context) ([]model.UUID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getModelUUIDsExpects, m.ctrl, m, "GetModelUUIDs", arg0)
+}
+
+// GetModelUUIDs indicates an expected call of GetModelUUIDs.
+func (mr *MockModelServiceMockRecorder) GetModelUUIDs(arg0 any) *MockModelServiceGetModelUUIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []model.UUID, error](mr.mock.ctrl.T, mr.mock, "GetModelUUIDs", gomock.EnsureMatcher(arg0))
+	mr.getModelUUIDsExpects = append(mr.getModelUUIDsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelServiceGetModelUUIDsCall is the typed call wrapper for GetModelUUIDs.
+type MockModelServiceGetModelUUIDsCall = gomock.Call1_2[context.Context, []model.UUID, error]
+
+// MockBackups is a mock of Backups interface.
+type MockBackups struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupsMockRecorder
+	isgomock struct{}
+}
+
+// MockBackupsMockRecorder is the mock recorder for MockBackups.
+type MockBackupsMockRecorder struct {
+	mock           *MockBackups
+	createExpects  []*gomock.Call3_2[context.Context, *backups.Metadata, []string, string, error]
+	getExpects     []*gomock.Call1_3[string, *backups.Metadata, io.ReadCloser, error]
+	listExpects    []*gomock.Call0_2[[]*backups.Metadata, error]
+	removeExpects  []*gomock.Call1_1[string, error]
+	restoreExpects []*gomock.Call3_2[context.Context, string, semversion.Number, *backups.Metadata, error]
+}
+
+// NewMockBackups creates a new mock instance.
+func NewMockBackups(ctrl *gomock.Controller) *MockBackups {
+	mock := &MockBackups{ctrl: ctrl}
+	mock.recorder = &MockBackupsMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackups) EXPECT() *MockBackupsMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBackups) Create(ctx context.Context, meta *backups.Metadata, modelUUIDs []string) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.createExpects, m.ctrl, m, "Create", ctx, meta, modelUUIDs)
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBackupsMockRecorder) Create(ctx, meta, modelUUIDs any) *MockBackupsCreateCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, *backups.Metadata, []string, string, error](mr.mock.ctrl.T, mr.mock, "Create", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(meta), gomock.EnsureMatcher(modelUUIDs))
+	mr.createExpects = append(mr.createExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupsCreateCall is the typed call wrapper for Create.
+type MockBackupsCreateCall = gomock.Call3_2[context.Context, *backups.Metadata, []string, string, error]
+
+// Get mocks base method.
+func (m *MockBackups) Get(id string) (*backups.Metadata, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_3(&m.recorder.getExpects, m.ctrl, m, "Get", id)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBackupsMockRecorder) Get(id any) *MockBackupsGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_3[string, *backups.Metadata, io.ReadCloser, error](mr.mock.ctrl.T, mr.mock, "Get", gomock.EnsureMatcher(id))
+	mr.getExpects = append(mr.getExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupsGetCall is the typed call wrapper for Get.
+type MockBackupsGetCall = gomock.Call1_3[string, *backups.Metadata, io.ReadCloser, error]
+
+// List mocks base method.
+func (m *MockBackups) List() ([]*backups.Metadata, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_2(&m.recorder.listExpects, m.ctrl, m, "List")
+}
+
+// List indicates an expected call of List.
+func (mr *MockBackupsMockRecorder) List() *MockBackupsListCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_2[[]*backups.Metadata, error](mr.mock.ctrl.T, mr.mock, "List")
+	mr.listExpects = append(mr.listExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupsListCall is the typed call wrapper for List.
+type MockBackupsListCall = gomock.Call0_2[[]*backups.Metadata, error]
+
+// Remove mocks base method.
+func (m *MockBackups) Remove(id string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.removeExpects, m.ctrl, m, "Remove", id)
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockBackupsMockRecorder) Remove(id any) *MockBackupsRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[string, error](mr.mock.ctrl.T, mr.mock, "Remove", gomock.EnsureMatcher(id))
+	mr.removeExpects = append(mr.removeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupsRemoveCall is the typed call wrapper for Remove.
+type MockBackupsRemoveCall = gomock.Call1_1[string, error]
+
+// Restore mocks base method.
+func (m *MockBackups) Restore(ctx context.Context, id string, current semversion.Number) (*backups.Metadata, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.restoreExpects, m.ctrl, m, "Restore", ctx, id, current)
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockBackupsMockRecorder) Restore(ctx, id, current any) *MockBackupsRestoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, semversion.Number, *backups.Metadata, error](mr.mock.ctrl.T, mr.mock, "Restore", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(id), gomock.EnsureMatcher(current))
+	mr.restoreExpects = append(mr.restoreExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupsRestoreCall is the typed call wrapper for Restore.
+type MockBackupsRestoreCall = gomock.Call3_2[context.Context, string, semversion.Number, *backups.Metadata, error]
@@ -45,6 +45,8 @@ type API struct {
 	requestRecorder facade.RequestRecorder
 
 	newCharmHubRepository func(repository.CharmHubRepositoryConfig) (corecharm.Repository, error)
+	newOCIRepository      func(repository.OCIRepositoryConfig) (corecharm.Repository, error)
+	registryService       repository.RegistryCredentialGetter
 
 	logger corelogger.Logger
 
@@ -75,6 +77,20 @@ func (a *API) checkCanWrite(ctx context.Context) error {
 	return a.authorizer.HasPermission(ctx, permission.WriteAccess, a.modelTag)
 }
 
+// checkCanAddCharm checks the caller can write to the model or, when the
+// charm is added to refresh an application, holds a refresh grant on that
+// application.
+func (a *API) checkCanAddCharm(ctx context.Context, appName string) error {
+	err := a.checkCanWrite(ctx)
+	if !errors.Is(err, authentication.ErrorEntityMissingPermission) || appName == "" {
+		return err
+	}
+	if !names.IsValidApplication(appName) {
+		return errors.NotValidf("application name %q", appName)
+	}
+	return a.authorizer.HasPermission(ctx, permission.RefreshAccess, names.NewApplicationTag(appName))
+}
+
 // List returns a list of charm URLs currently in the state. If supplied
 // parameter contains any names, the result will be filtered to return only the
 // charms with supplied names. The order of the charms is not guaranteed to be
@@ -143,7 +159,7 @@ func (a *API) getDownloadInfo(ctx context.Context, arg params.CharmURLAndOrigin)
 		return params.DownloadInfoResult{}, apiservererrors.ServerError(err)
 	}
 
-	repo, err := a.getCharmRepository(ctx)
+	repo, err := a.getCharmRepository(ctx, corecharm.Source(charmOrigin.Source))
 	if err != nil {
 		return params.DownloadInfoResult{}, apiservererrors.ServerError(err)
 	}
@@ -175,14 +191,14 @@ func (a *API) getDefaultArch() (string, error) {
 
 // AddCharm adds the given charm URL (which must include revision) to the
 // environment, if it does not exist yet. Local charms are not supported,
-// only charm store and charm hub URLs. See also AddLocalCharm().
+// only charm hub and OCI registry URLs. See also AddLocalCharm().
 func (a *API) AddCharm(ctx context.Context, args params.AddCharmWithOrigin) (params.CharmOriginResult, error) {
-	if err := a.checkCanWrite(ctx); err != nil {
+	if err := a.checkCanAddCharm(ctx, args.Application); err != nil {
 		return params.CharmOriginResult{}, err
 	}
 
 	a.logger.Debugf(ctx, "AddCharm request: %+v", args)
-	if commoncharm.OriginSource(args.Origin.Source) != commoncharm.OriginCharmHub {
+	if source := commoncharm.OriginSource(args.Origin.Source); source != commoncharm.OriginCharmHub && source != commoncharm.OriginOCI {
 		return params.CharmOriginResult{}, errors.Errorf("unknown schema for charm URL %q", args.URL)
 	}
 
@@ -217,7 +233,7 @@ func (a *API) addCharm(ctx context.Context, args params.AddCharmWithOrigin) (cor
 	if err != nil {
 		return corecharm.Origin{}, errors.Trace(err)
 	}
-	repo, err := a.getCharmRepository(ctx)
+	repo, err := a.getCharmRepository(ctx, requestedOrigin.Source)
 	if err != nil {
 		return corecharm.Origin{}, errors.Trace(err)
 	}
@@ -253,6 +269,10 @@ func (a *API) addCharm(ctx context.Context, args params.AddCharmWithOrigin) (cor
 			DownloadURL:        essentialMetadata.DownloadInfo.DownloadURL,
 			DownloadSize:       essentialMetadata.DownloadInfo.DownloadSize,
 		},
+		PublishedSignatures: &applicationcharm.PublishedSignatures{
+			Digest:     essentialMetadata.DownloadInfo.SignedDigest,
+			Signatures: essentialMetadata.DownloadInfo.Signatures,
+		},
 	}); err != nil && !errors.Is(err, applicationerrors.CharmAlreadyExists) {
 		return corecharm.Origin{}, errors.Annotatef(err, "setting charm %q", args.URL)
 	} else if len(warnings) > 0 {
@@ -298,7 +318,7 @@ func (a *API) resolveOneCharm(ctx context.Context, arg params.ResolveCharmWithCh
 		result.Error = apiservererrors.ServerError(err)
 		return result
 	}
-	if !charm.CharmHub.Matches(curl.Schema) {
+	if !charm.CharmHub.Matches(curl.Schema) && !charm.OCI.Matches(curl.Schema) {
 		result.Error = apiservererrors.ServerError(errors.Errorf("unknown schema for charm URL %q", curl.String()))
 		return result
 	}
@@ -309,7 +329,7 @@ func (a *API) resolveOneCharm(ctx context.Context, arg params.ResolveCharmWithCh
 		return result
 	}
 
-	repo, err := a.getCharmRepository(ctx)
+	repo, err := a.getCharmRepository(ctx, requestedOrigin.Source)
 	if err != nil {
 		result.Error = apiservererrors.ServerError(err)
 		return result
@@ -355,7 +375,15 @@ func convertCharmBase(in corecharm.Platform) params.Base {
 	}
 }
 
-func (a *API) getCharmRepository(ctx context.Context) (corecharm.Repository, error) {
+func (a *API) getCharmRepository(ctx context.Context, source corecharm.Source) (corecharm.Repository, error) {
+	if source == corecharm.OCI {
+		return a.newOCIRepository(repository.OCIRepositoryConfig{
+			Logger:      a.logger,
+			HTTPClient:  a.charmhubHTTPClient,
+			Credentials: repository.OCICredentials(a.registryService),
+		})
+	}
+
 	modelCfg, err := a.modelConfigService.ModelConfig(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -365,6 +393,7 @@ func (a *API) getCharmRepository(ctx context.Context) (corecharm.Repository, err
 	return a.newCharmHubRepository(repository.CharmHubRepositoryConfig{
 		Logger:             a.logger,
 		CharmhubHTTPClient: a.charmhubHTTPClient,
+		Credentials:        repository.CharmhubCredentials(a.registryService),
 		CharmhubURL:        charmhubURL,
 	})
 }
@@ -405,7 +434,7 @@ func (a *API) listOneCharmResources(ctx context.Context, arg params.CharmURLAndO
 	if err != nil {
 		return nil, apiservererrors.ServerError(err)
 	}
-	if !charm.CharmHub.Matches(curl.Schema) {
+	if !charm.CharmHub.Matches(curl.Schema) && !charm.OCI.Matches(curl.Schema) {
 		return nil, apiservererrors.ServerError(errors.NotValidf("charm %q", curl.Name))
 	}
 
@@ -418,7 +447,7 @@ func (a *API) listOneCharmResources(ctx context.Context, arg params.CharmURLAndO
 	if err != nil {
 		return nil, apiservererrors.ServerError(err)
 	}
-	repo, err := a.getCharmRepository(ctx)
+	repo, err := a.getCharmRepository(ctx, corecharm.Source(charmOrigin.Source))
 	if err != nil {
 		return nil, apiservererrors.ServerError(err)
 	}
@@ -8,14 +8,18 @@ import (
 	stdtesting "testing"
 
 	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	apiservermocks "github.com/juju/juju/apiserver/facade/mocks"
 	"github.com/juju/juju/apiserver/facades/client/charms/mocks"
 	corecharm "github.com/juju/juju/core/charm"
 	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/domain/application/architecture"
 	domaincharm "github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/deployment/charm"
@@ -186,6 +190,56 @@ func (s *charmsMockSuite) TestAddCharmWithLocalSource(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `unknown schema for charm URL "local:testme"`)
 }
 
+func (s *charmsMockSuite) expectMissingWritePermission() {
+	err := errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(err)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, gomock.Any()).Return(err)
+}
+
+func (s *charmsMockSuite) TestAddCharmWithRefreshGrant(c *tc.C) {
+	defer s.setupMocksWithoutPermissions(c).Finish()
+	s.expectMissingWritePermission()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.RefreshAccess, names.NewApplicationTag("testme")).Return(nil)
+	api := s.api(c)
+
+	// The local source is rejected only once the caller is authorized.
+	args := params.AddCharmWithOrigin{
+		URL:         "local:testme",
+		Origin:      params.CharmOrigin{Source: "local"},
+		Application: "testme",
+	}
+	_, err := api.AddCharm(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `unknown schema for charm URL "local:testme"`)
+}
+
+func (s *charmsMockSuite) TestAddCharmWithoutRefreshGrant(c *tc.C) {
+	defer s.setupMocksWithoutPermissions(c).Finish()
+	s.expectMissingWritePermission()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.RefreshAccess, names.NewApplicationTag("testme")).Return(apiservererrors.ErrPerm)
+	api := s.api(c)
+
+	args := params.AddCharmWithOrigin{
+		URL:         "ch:testme",
+		Origin:      params.CharmOrigin{Source: "charm-hub"},
+		Application: "testme",
+	}
+	_, err := api.AddCharm(c.Context(), args)
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *charmsMockSuite) TestAddCharmWithoutWriteAccess(c *tc.C) {
+	defer s.setupMocksWithoutPermissions(c).Finish()
+	s.expectMissingWritePermission()
+	api := s.api(c)
+
+	args := params.AddCharmWithOrigin{
+		URL:    "ch:testme",
+		Origin: params.CharmOrigin{Source: "charm-hub"},
+	}
+	_, err := api.AddCharm(c.Context(), args)
+	c.Assert(err, tc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
 func (s *charmsMockSuite) TestAddCharmCharmhub(c *tc.C) {
 	// Charmhub charms are downloaded asynchronously
 	defer s.setupMocks(c).Finish()
@@ -296,10 +350,15 @@ func (s *charmsMockSuite) api(c *tc.C) *API {
 }
 
 func (s *charmsMockSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := s.setupMocksWithoutPermissions(c)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return ctrl
+}
+
+func (s *charmsMockSuite) setupMocksWithoutPermissions(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.authorizer = apiservermocks.NewMockAuthorizer(ctrl)
-	s.authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	s.repository = mocks.NewMockRepository(ctrl)
 	s.charmArchive = mocks.NewMockCharmArchive(ctrl)
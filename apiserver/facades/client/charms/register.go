@@ -68,6 +68,10 @@ func makeFacadeBase(_ context.Context, ctx facade.ModelContext) (*API, error) {
 		newCharmHubRepository: func(cfg repository.CharmHubRepositoryConfig) (corecharm.Repository, error) {
 			return repository.NewCharmHubRepository(cfg)
 		},
+		newOCIRepository: func(cfg repository.OCIRepositoryConfig) (corecharm.Repository, error) {
+			return repository.NewOCIRepository(cfg)
+		},
+		registryService: domainServices.CharmRegistry(),
 		modelTag:        names.NewModelTag(ctx.ModelUUID().String()),
 		controllerTag:   names.NewControllerTag(ctx.ControllerUUID()),
 		requestRecorder: ctx.RequestRecorder(),
@@ -374,6 +374,7 @@ func (s *fullStatusSuite) TestFullStatusControllerAppPortsAugmented(c *tc.C) {
 		},
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.applicationService.EXPECT().GetAllRefreshRollouts(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(nil, nil)
 	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
@@ -405,6 +406,7 @@ func (s *fullStatusSuite) TestFullStatusExposedEndpointsFetchedInBulk(c *tc.C) {
 		Status: status.Available,
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.applicationService.EXPECT().GetAllRefreshRollouts(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
 		"mysql": {
 			CharmLocator: charm.CharmLocator{
@@ -446,6 +448,83 @@ func (s *fullStatusSuite) TestFullStatusExposedEndpointsFetchedInBulk(c *tc.C) {
 	})
 }
 
+func (s *fullStatusSuite) TestFullStatusRefreshRollout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	client := s.client(false)
+	s.expectCheckCanRead(client, true)
+	s.expectCheckIsAdmin(client, false)
+
+	s.modelInfoService.EXPECT().GetModelInfo(c.Context()).Return(model.ModelInfo{
+		Cloud:     "dummy",
+		CloudType: "dummy",
+		Type:      model.IAAS,
+	}, nil)
+	s.statusService.EXPECT().GetModelStatus(gomock.Any()).Return(status.StatusInfo{
+		Status: status.Available,
+	}, nil)
+	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.applicationService.EXPECT().GetAllRefreshRollouts(gomock.Any()).Return(map[string]application.RefreshRollout{
+		"mysql": {
+			RefreshRolloutParams: application.RefreshRolloutParams{
+				BatchSize: 1,
+			},
+			ApplicationName: "mysql",
+			Status:          application.RefreshRolloutPaused,
+			Message:         "unit mysql/0 is in error",
+			Charm: charm.CharmLocator{
+				Name:         "mysql",
+				Revision:     2,
+				Source:       charm.CharmHubSource,
+				Architecture: architecture.AMD64,
+			},
+			PreviousCharm: charm.CharmLocator{
+				Name:         "mysql",
+				Revision:     1,
+				Source:       charm.CharmHubSource,
+				Architecture: architecture.AMD64,
+			},
+			UnitsRefreshed: 1,
+			UnitsTotal:     3,
+		},
+	}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
+		"mysql": {
+			CharmLocator: charm.CharmLocator{
+				Name:         "mysql",
+				Revision:     2,
+				Source:       charm.CharmHubSource,
+				Architecture: architecture.AMD64,
+			},
+			Platform: deployment.Platform{
+				OSType:  deployment.Ubuntu,
+				Channel: "22.04/stable",
+			},
+			Status: status.StatusInfo{
+				Status: status.Active,
+			},
+		},
+	}, nil)
+	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(nil, nil)
+	s.statusService.EXPECT().GetRemoteApplicationOffererStatuses(gomock.Any()).Return(nil, nil)
+	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(nil, nil)
+	s.networkService.EXPECT().GetAllDevicesByMachineNames(gomock.Any()).Return(nil, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+
+	output, err := client.FullStatus(c.Context(), params.StatusParams{})
+	c.Assert(err, tc.IsNil)
+	c.Check(output.Applications["mysql"].RefreshRollout, tc.DeepEquals, &params.RefreshRolloutStatus{
+		Status:         "paused",
+		Message:        "unit mysql/0 is in error",
+		Charm:          "ch:amd64/mysql-2",
+		PreviousCharm:  "ch:amd64/mysql-1",
+		UnitsRefreshed: 1,
+		UnitsTotal:     3,
+		BatchSize:      1,
+	})
+}
+
 func (s *fullStatusSuite) TestFullStatusCAASApplicationAddress(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -463,6 +542,7 @@ func (s *fullStatusSuite) TestFullStatusCAASApplicationAddress(c *tc.C) {
 		Status: status.Available,
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.applicationService.EXPECT().GetAllRefreshRollouts(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
 		"postgresql-k8s": {
 			CharmLocator: charm.CharmLocator{
@@ -512,6 +592,7 @@ func (s *fullStatusSuite) TestFullStatusFilteredByApplicationName(c *tc.C) {
 		Status: status.Available,
 	}, nil)
 	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.applicationService.EXPECT().GetAllRefreshRollouts(gomock.Any()).Return(nil, nil)
 	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]service.Application{
 		"mysql": {
 			CharmLocator: charm.CharmLocator{
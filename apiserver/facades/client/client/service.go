@@ -57,6 +57,10 @@ type ApplicationService interface {
 
 	// GetUnitsK8sPodInfo returns information about the k8s pods for all alive units.
 	GetUnitsK8sPodInfo(ctx context.Context) (map[unit.Name]application.K8sPodInfo, error)
+
+	// GetAllRefreshRollouts returns the staged refreshes of all applications
+	// in the model, keyed by application name.
+	GetAllRefreshRollouts(ctx context.Context) (map[string]application.RefreshRollout, error)
 }
 
 // StatusService defines the methods that the facade assumes from the Status
@@ -44,6 +44,7 @@ type MockApplicationServiceMockRecorder struct {
 	mock                                 *MockApplicationService
 	getAllEndpointBindingsExpects        []*gomock.Call1_2[context.Context, map[string]map[string]network.SpaceName, error]
 	getAllExposedEndpointsExpects        []*gomock.Call1_2[context.Context, map[string]map[string]application.ExposedEndpoint, error]
+	getAllRefreshRolloutsExpects         []*gomock.Call1_2[context.Context, map[string]application.RefreshRollout, error]
 	getExposedEndpointsExpects           []*gomock.Call2_2[context.Context, string, map[string]application.ExposedEndpoint, error]
 	getLatestPendingCharmhubCharmExpects []*gomock.Call3_2[context.Context, string, architecture.Architecture, charm.CharmLocator, error]
 	getUnitUUIDExpects                   []*gomock.Call2_2[context.Context, unit.Name, unit.UUID, error]
@@ -98,6 +99,24 @@ func (mr *MockApplicationServiceMockRecorder) GetAllExposedEndpoints(ctx any) *M
 // MockApplicationServiceGetAllExposedEndpointsCall is the typed call wrapper for GetAllExposedEndpoints.
 type MockApplicationServiceGetAllExposedEndpointsCall = gomock.Call1_2[context.Context, map[string]map[string]application.ExposedEndpoint, error]
 
+// GetAllRefreshRollouts mocks base method.
+func (m *MockApplicationService) GetAllRefreshRollouts(ctx context.Context) (map[string]application.RefreshRollout, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllRefreshRolloutsExpects, m.ctrl, m, "GetAllRefreshRollouts", ctx)
+}
+
+// GetAllRefreshRollouts indicates an expected call of GetAllRefreshRollouts.
+func (mr *MockApplicationServiceMockRecorder) GetAllRefreshRollouts(ctx any) *MockApplicationServiceGetAllRefreshRolloutsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]application.RefreshRollout, error](mr.mock.ctrl.T, mr.mock, "GetAllRefreshRollouts", gomock.EnsureMatcher(ctx))
+	mr.getAllRefreshRolloutsExpects = append(mr.getAllRefreshRolloutsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetAllRefreshRolloutsCall is the typed call wrapper for GetAllRefreshRollouts.
+type MockApplicationServiceGetAllRefreshRolloutsCall = gomock.Call1_2[context.Context, map[string]application.RefreshRollout, error]
+
 // GetExposedEndpoints mocks base method.
 func (m *MockApplicationService) GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error) {
 	m.ctrl.T.Helper()
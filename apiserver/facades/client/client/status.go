@@ -213,6 +213,10 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 			logger.Warningf(ctx, "could not determine application leaders: %v", err)
 			context.leaders = make(map[string]string)
 		}
+		// The progress of staged refreshes is additive too.
+		if context.refreshRollouts, err = c.applicationService.GetAllRefreshRollouts(ctx); err != nil {
+			logger.Warningf(ctx, "could not fetch refresh rollouts: %v", err)
+		}
 	}
 
 	if logger.IsLevelEnabled(corelogger.TRACE) {
@@ -382,6 +386,9 @@ type statusContext struct {
 	leaders                   map[string]string
 	podsInfo                  map[coreunit.Name]application.K8sPodInfo
 
+	// refreshRollouts: application name -> unfinished staged refresh
+	refreshRollouts map[string]application.RefreshRollout
+
 	// Information about all spaces.
 	spaceInfos network.SpaceInfos
 }
@@ -1086,6 +1093,14 @@ func (c *statusContext) processApplication(ctx context.Context, name string, app
 		func(k string, v network.SpaceName) (string, string) { return k, v.String() },
 	)
 
+	if rollout, ok := c.refreshRollouts[name]; ok {
+		processedStatus.RefreshRollout, err = processRefreshRollout(rollout)
+		if err != nil {
+			processedStatus.Err = apiservererrors.ServerError(err)
+			return processedStatus
+		}
+	}
+
 	// IAAS applications have all the information they need in the application
 	// status. CAAS applications have some additional information.
 	if c.model.Type == model.IAAS {
@@ -1107,6 +1122,27 @@ func (c *statusContext) processApplication(ctx context.Context, name string, app
 	return processedStatus
 }
 
+func processRefreshRollout(rollout application.RefreshRollout) (*params.RefreshRolloutStatus, error) {
+	charmURL, err := charms.CharmURLFromLocator(rollout.Charm.Name, rollout.Charm)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	previousCharmURL, err := charms.CharmURLFromLocator(rollout.PreviousCharm.Name, rollout.PreviousCharm)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return &params.RefreshRolloutStatus{
+		Status:         string(rollout.Status),
+		Message:        rollout.Message,
+		Charm:          charmURL,
+		PreviousCharm:  previousCharmURL,
+		UnitsRefreshed: rollout.UnitsRefreshed,
+		UnitsTotal:     rollout.UnitsTotal,
+		BatchSize:      rollout.BatchSize,
+		BatchPercent:   rollout.BatchPercent,
+	}, nil
+}
+
 func (c *statusContext) mapExposedEndpointsFromDomain(
 	exposedEndpoints map[string]application.ExposedEndpoint,
 ) (map[string]params.ExposedEndpoint, error) {
@@ -0,0 +1,515 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/facades/client/modelconfig"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/configsnapshot"
+	configsnapshoterrors "github.com/juju/juju/domain/configsnapshot/errors"
+	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// excludedModelConfigKeys holds the model config attributes which are not
+// recorded in snapshots, as they are either immutable or managed through
+// other means than model config.
+var excludedModelConfigKeys = set.NewStrings(
+	config.NameKey,
+	config.UUIDKey,
+	config.TypeKey,
+	config.AgentVersionKey,
+	config.AgentStreamKey,
+	config.AuthorizedKeysKey,
+	config.CharmHubURLKey,
+	config.ContainerNetworkingMethodKey,
+	"firewall-mode",
+)
+
+// ConfigSnapshotAPI implements the ConfigSnapshot facade, which takes named
+// snapshots of the configuration of a model and rolls the model back to
+// them.
+type ConfigSnapshotAPI struct {
+	controllerTag names.ControllerTag
+	modelTag      names.ModelTag
+	authorizer    facade.Authorizer
+	check         BlockChecker
+
+	configSnapshotService ConfigSnapshotService
+	applicationService    ApplicationService
+	modelConfigService    ModelConfigService
+	networkService        NetworkService
+
+	logger logger.Logger
+}
+
+// CreateSnapshot records the current configuration of the model as a
+// snapshot with the given name.
+func (api *ConfigSnapshotAPI) CreateSnapshot(ctx context.Context, arg params.ConfigSnapshotArg) error {
+	if err := api.authorizer.HasPermission(ctx, permission.WriteAccess, api.modelTag); err != nil {
+		return err
+	}
+
+	current, err := api.currentConfig(ctx)
+	if err != nil {
+		return apiservererrors.ServerError(err)
+	}
+	current.Name = arg.Name
+
+	err = api.configSnapshotService.CreateSnapshot(ctx, current, arg.Replace)
+	switch {
+	case errors.Is(err, configsnapshoterrors.SnapshotNameNotValid):
+		return apiservererrors.ParamsErrorf(params.CodeNotValid, "config snapshot name %q not valid", arg.Name)
+	case errors.Is(err, configsnapshoterrors.SnapshotAlreadyExists):
+		return apiservererrors.ParamsErrorf(params.CodeAlreadyExists, "config snapshot %q already exists", arg.Name)
+	case err != nil:
+		return apiservererrors.ServerError(err)
+	}
+	return nil
+}
+
+// ListSnapshots returns the config snapshots of the model.
+func (api *ConfigSnapshotAPI) ListSnapshots(ctx context.Context) (params.ConfigSnapshotsResult, error) {
+	if err := api.authorizer.HasPermission(ctx, permission.ReadAccess, api.modelTag); err != nil {
+		return params.ConfigSnapshotsResult{}, err
+	}
+
+	infos, err := api.configSnapshotService.ListSnapshots(ctx)
+	if err != nil {
+		return params.ConfigSnapshotsResult{}, apiservererrors.ServerError(err)
+	}
+
+	result := params.ConfigSnapshotsResult{
+		Snapshots: make([]params.ConfigSnapshotInfo, len(infos)),
+	}
+	for i, info := range infos {
+		result.Snapshots[i] = params.ConfigSnapshotInfo{
+			Name:      info.Name,
+			CreatedAt: info.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// RemoveSnapshot removes the config snapshot with the given name.
+func (api *ConfigSnapshotAPI) RemoveSnapshot(ctx context.Context, arg params.ConfigSnapshotArg) error {
+	if err := api.authorizer.HasPermission(ctx, permission.WriteAccess, api.modelTag); err != nil {
+		return err
+	}
+
+	err := api.configSnapshotService.DeleteSnapshot(ctx, arg.Name)
+	if errors.Is(err, configsnapshoterrors.SnapshotNotFound) {
+		return apiservererrors.ParamsErrorf(params.CodeNotFound, "config snapshot %q not found", arg.Name)
+	} else if err != nil {
+		return apiservererrors.ServerError(err)
+	}
+	return nil
+}
+
+// RollbackConfig rolls the configuration of the model back to the config
+// snapshot with the given name, and returns the changes made. Applications
+// which have been removed since the snapshot was taken are reported, but
+// not deployed again. If DryRun is set, the changes are reported without
+// being made.
+func (api *ConfigSnapshotAPI) RollbackConfig(ctx context.Context, arg params.ConfigRollbackArg) (params.ConfigRollbackResult, error) {
+	if err := api.authorizer.HasPermission(ctx, permission.WriteAccess, api.modelTag); err != nil {
+		return params.ConfigRollbackResult{}, err
+	}
+	if !arg.DryRun {
+		if err := api.check.ChangeAllowed(ctx); err != nil {
+			return params.ConfigRollbackResult{}, errors.Capture(err)
+		}
+	}
+
+	target, err := api.configSnapshotService.GetSnapshot(ctx, arg.Name)
+	if errors.Is(err, configsnapshoterrors.SnapshotNotFound) {
+		return params.ConfigRollbackResult{}, apiservererrors.ParamsErrorf(params.CodeNotFound, "config snapshot %q not found", arg.Name)
+	} else if err != nil {
+		return params.ConfigRollbackResult{}, apiservererrors.ServerError(err)
+	}
+
+	current, err := api.currentConfig(ctx)
+	if err != nil {
+		return params.ConfigRollbackResult{}, apiservererrors.ServerError(err)
+	}
+
+	changes := configsnapshot.Diff(current, target)
+	if !arg.DryRun {
+		if err := api.applyChanges(ctx, target, changes); err != nil {
+			return params.ConfigRollbackResult{}, apiservererrors.ServerError(err)
+		}
+		api.logger.Infof(ctx, "rolled back model config to snapshot %q, %d changes", arg.Name, len(changes))
+	}
+
+	result := params.ConfigRollbackResult{
+		Changes: make([]params.ConfigSnapshotChange, len(changes)),
+	}
+	for i, change := range changes {
+		result.Changes[i] = params.ConfigSnapshotChange{
+			Kind:        string(change.Kind),
+			Application: change.Application,
+			Key:         change.Key,
+			Current:     change.Current,
+			Target:      change.Target,
+			Unset:       change.Unset,
+		}
+	}
+	return result, nil
+}
+
+// currentConfig returns the current configuration of the model, in the form
+// of an unnamed snapshot.
+func (api *ConfigSnapshotAPI) currentConfig(ctx context.Context) (configsnapshot.Snapshot, error) {
+	modelConfig, err := api.currentModelConfig(ctx)
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+
+	spaceInfos, err := api.networkService.GetAllSpaces(ctx)
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Errorf("getting spaces: %w", err)
+	}
+	allBindings, err := api.applicationService.GetAllEndpointBindings(ctx)
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Errorf("getting endpoint bindings: %w", err)
+	}
+	allExposed, err := api.applicationService.GetAllExposedEndpoints(ctx)
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Errorf("getting exposed endpoints: %w", err)
+	}
+
+	applications := make(map[string]configsnapshot.Application, len(allBindings))
+	for appName, bindings := range allBindings {
+		details, err := api.applicationService.GetApplicationDetailsByName(ctx, appName)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			// The application was removed while the snapshot was taken.
+			continue
+		} else if err != nil {
+			return configsnapshot.Snapshot{}, errors.Errorf("getting application %q: %w", appName, err)
+		}
+		// Synthetic applications represent the offering side of cross model
+		// relations, they have no configuration of their own.
+		if details.IsApplicationSynthetic {
+			continue
+		}
+
+		app, err := api.currentApplication(ctx, details.UUID, appName, bindings, allExposed[appName], spaceInfos)
+		if err != nil {
+			return configsnapshot.Snapshot{}, errors.Errorf("getting application %q config: %w", appName, err)
+		}
+		applications[appName] = app
+	}
+
+	return configsnapshot.Snapshot{
+		ModelConfig:  modelConfig,
+		Applications: applications,
+	}, nil
+}
+
+// currentModelConfig returns the model config attributes set for the model,
+// excluding secret attributes and those which can't be rolled back.
+func (api *ConfigSnapshotAPI) currentModelConfig(ctx context.Context) (map[string]string, error) {
+	values, err := api.modelConfigService.ModelConfigValues(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting model config: %w", err)
+	}
+	schema, err := config.Schema(nil)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	attrs := make(map[string]any)
+	for key, value := range values {
+		if value.Source != config.JujuModelConfigSource || excludedModelConfigKeys.Contains(key) {
+			continue
+		}
+		if attr, ok := schema[key]; ok && attr.Secret {
+			continue
+		}
+		attrs[key] = value.Value
+	}
+	modelConfig, err := modelconfigservice.CoerceConfigForStorage(attrs)
+	if err != nil {
+		return nil, errors.Errorf("coercing model config: %w", err)
+	}
+	return modelConfig, nil
+}
+
+func (api *ConfigSnapshotAPI) currentApplication(
+	ctx context.Context,
+	appUUID coreapplication.UUID,
+	appName string,
+	bindings map[string]network.SpaceName,
+	exposed map[string]application.ExposedEndpoint,
+	spaceInfos network.SpaceInfos,
+) (configsnapshot.Application, error) {
+	locator, err := api.applicationService.GetCharmLocatorByApplicationName(ctx, appName)
+	if err != nil {
+		return configsnapshot.Application{}, errors.Errorf("getting charm: %w", err)
+	}
+	appConfig, err := api.applicationService.GetApplicationAndCharmConfig(ctx, appUUID)
+	if err != nil {
+		return configsnapshot.Application{}, errors.Errorf("getting config: %w", err)
+	}
+	cons, err := api.applicationService.GetApplicationConstraints(ctx, appUUID)
+	if err != nil {
+		return configsnapshot.Application{}, errors.Errorf("getting constraints: %w", err)
+	}
+
+	cfg := make(map[string]string, len(appConfig.ApplicationConfig)+1)
+	for key, value := range appConfig.ApplicationConfig {
+		if value == nil {
+			continue
+		}
+		cfg[key] = fmt.Sprint(value)
+	}
+	// Trust is always recorded, so that rolling back resets it.
+	cfg[coreapplication.TrustConfigOptionName] = strconv.FormatBool(appConfig.Trust)
+
+	endpointBindings := make(map[string]string, len(bindings))
+	for endpoint, space := range bindings {
+		endpointBindings[endpoint] = string(space)
+	}
+
+	exposedEndpoints := make(map[string]configsnapshot.ExposedEndpoint, len(exposed))
+	for endpoint, settings := range exposed {
+		var spaces []string
+		for _, spaceID := range settings.ExposeToSpaceIDs.SortedValues() {
+			space := spaceInfos.GetByID(network.SpaceUUID(spaceID))
+			if space == nil {
+				return configsnapshot.Application{}, errors.Errorf("space with ID %q not found", spaceID)
+			}
+			spaces = append(spaces, string(space.Name))
+		}
+		exposedEndpoints[endpoint] = configsnapshot.ExposedEndpoint{
+			Spaces: spaces,
+			CIDRs:  settings.ExposeToCIDRs.SortedValues(),
+		}
+	}
+
+	return configsnapshot.Application{
+		Charm: configsnapshot.CharmRef{
+			Name:     locator.Name,
+			Source:   string(locator.Source),
+			Revision: locator.Revision,
+		},
+		Config:           cfg,
+		Constraints:      cons.String(),
+		EndpointBindings: endpointBindings,
+		ExposedEndpoints: exposedEndpoints,
+	}, nil
+}
+
+// applyChanges makes the changes required to roll the model back to the
+// target snapshot. The model config is validated before anything is changed.
+// Applications on a different charm revision than in the snapshot are then
+// refreshed back to that revision, which must still be in the model. The
+// config, constraints, bindings and expose settings of all the applications
+// are rolled back in a single transaction, and finally the model config is
+// updated through the model config service.
+func (api *ConfigSnapshotAPI) applyChanges(
+	ctx context.Context, target configsnapshot.Snapshot, changes []configsnapshot.Change,
+) error {
+	var (
+		modelUpdates = make(map[string]any)
+		modelRemoves []string
+		charmApps    []string
+		appOrder     []string
+		appChanges   = make(map[string][]configsnapshot.Change)
+	)
+	for _, change := range changes {
+		switch change.Kind {
+		case configsnapshot.ModelConfigChange:
+			if change.Unset {
+				modelRemoves = append(modelRemoves, change.Key)
+			} else {
+				modelUpdates[change.Key] = change.Target
+			}
+		case configsnapshot.MissingApplication:
+			// Removed applications can't be rolled back.
+		case configsnapshot.CharmChange:
+			charmApps = append(charmApps, change.Application)
+		default:
+			if _, ok := appChanges[change.Application]; !ok {
+				appOrder = append(appOrder, change.Application)
+			}
+			appChanges[change.Application] = append(appChanges[change.Application], change)
+		}
+	}
+
+	validator, err := api.modelConfigValidator(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(modelUpdates) > 0 || len(modelRemoves) > 0 {
+		err := api.modelConfigService.ValidateModelConfigUpdate(ctx, modelUpdates, modelRemoves, validator)
+		if err != nil {
+			return modelConfigError(err)
+		}
+	}
+
+	for _, appName := range charmApps {
+		if err := api.rollbackCharm(ctx, appName, target.Applications[appName].Charm); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	if len(appOrder) > 0 {
+		spaceInfos, err := api.networkService.GetAllSpaces(ctx)
+		if err != nil {
+			return errors.Errorf("getting spaces: %w", err)
+		}
+		updates := make([]applicationservice.UpdateApplicationConfigurationParams, len(appOrder))
+		for i, appName := range appOrder {
+			updates[i], err = api.applicationUpdate(ctx, appName, target.Applications[appName], appChanges[appName], spaceInfos)
+			if err != nil {
+				return errors.Errorf("rolling back application %q: %w", appName, err)
+			}
+		}
+		if err := api.applicationService.UpdateApplicationsConfiguration(ctx, updates); err != nil {
+			return errors.Errorf("rolling back application configuration: %w", err)
+		}
+	}
+
+	if len(modelUpdates) > 0 || len(modelRemoves) > 0 {
+		err := api.modelConfigService.UpdateModelConfig(ctx, modelUpdates, modelRemoves)
+		if err != nil {
+			return modelConfigError(err)
+		}
+	}
+	return nil
+}
+
+// rollbackCharm sets the charm of the application back to the revision
+// recorded in the snapshot.
+func (api *ConfigSnapshotAPI) rollbackCharm(ctx context.Context, appName string, ref configsnapshot.CharmRef) error {
+	locator := charm.CharmLocator{
+		Name:     ref.Name,
+		Revision: ref.Revision,
+		Source:   charm.CharmSource(ref.Source),
+	}
+	err := api.applicationService.SetApplicationCharm(ctx, appName, locator, application.SetCharmParams{})
+	if errors.Is(err, applicationerrors.CharmNotFound) {
+		return errors.Errorf(
+			"rolling back application %q: charm %s revision %d is no longer in the model; deploy or refresh to it first",
+			appName, ref.Name, ref.Revision,
+		).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Errorf("rolling back charm of application %q: %w", appName, err)
+	}
+	return nil
+}
+
+// modelConfigValidator returns the validator applied to model config changes
+// on top of the usual model config validation.
+func (api *ConfigSnapshotAPI) modelConfigValidator(ctx context.Context) (config.Validator, error) {
+	// Setting TRACE logging requires controller admin access, as it does
+	// when setting model config directly.
+	isControllerAdmin := true
+	err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+	if errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		isControllerAdmin = false
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return modelconfig.LogTracingValidator(isControllerAdmin), nil
+}
+
+// modelConfigError returns the error to report for a failure to validate or
+// update the model config.
+func modelConfigError(err error) error {
+	var validationError *config.ValidationError
+	if errors.As(err, &validationError) {
+		return errors.Errorf("model config key %q not valid: %s", validationError.InvalidAttrs, validationError.Reason)
+	}
+	return errors.Errorf("rolling back model config: %w", err)
+}
+
+// applicationUpdate returns the changes required to roll the configuration
+// of the application back to the target.
+func (api *ConfigSnapshotAPI) applicationUpdate(
+	ctx context.Context,
+	appName string,
+	target configsnapshot.Application,
+	changes []configsnapshot.Change,
+	spaceInfos network.SpaceInfos,
+) (applicationservice.UpdateApplicationConfigurationParams, error) {
+	details, err := api.applicationService.GetApplicationDetailsByName(ctx, appName)
+	if err != nil {
+		return applicationservice.UpdateApplicationConfigurationParams{}, errors.Capture(err)
+	}
+
+	update := applicationservice.UpdateApplicationConfigurationParams{
+		UUID:             details.UUID,
+		ConfigUpdates:    make(map[string]string),
+		EndpointBindings: make(map[string]network.SpaceName),
+		ExposeUpdates:    make(map[string]application.ExposedEndpoint),
+		ExposeRemoves:    set.NewStrings(),
+	}
+	for _, change := range changes {
+		switch change.Kind {
+		case configsnapshot.ApplicationConfigChange:
+			if change.Unset {
+				update.ConfigRemoves = append(update.ConfigRemoves, change.Key)
+			} else {
+				update.ConfigUpdates[change.Key] = change.Target
+			}
+		case configsnapshot.ConstraintsChange:
+			cons, err := constraints.Parse(target.Constraints)
+			if err != nil {
+				return applicationservice.UpdateApplicationConfigurationParams{}, errors.Errorf("parsing constraints %q: %w", target.Constraints, err)
+			}
+			update.Constraints = &cons
+		case configsnapshot.BindingChange:
+			update.EndpointBindings[change.Key] = network.SpaceName(change.Target)
+		case configsnapshot.ExposeChange:
+			if change.Unset {
+				update.ExposeRemoves.Add(change.Key)
+				continue
+			}
+			exposed, err := exposedEndpointToDomain(target.ExposedEndpoints[change.Key], spaceInfos)
+			if err != nil {
+				return applicationservice.UpdateApplicationConfigurationParams{}, errors.Capture(err)
+			}
+			update.ExposeUpdates[change.Key] = exposed
+		}
+	}
+	return update, nil
+}
+
+func exposedEndpointToDomain(exposed configsnapshot.ExposedEndpoint, spaceInfos network.SpaceInfos) (application.ExposedEndpoint, error) {
+	spaceIDs := set.NewStrings()
+	for _, spaceName := range exposed.Spaces {
+		space := spaceInfos.GetByName(network.SpaceName(spaceName))
+		if space == nil {
+			return application.ExposedEndpoint{}, errors.Errorf("space %q not found", spaceName)
+		}
+		spaceIDs.Add(space.ID.String())
+	}
+	return application.ExposedEndpoint{
+		ExposeToSpaceIDs: spaceIDs,
+		ExposeToCIDRs:    set.NewStrings(exposed.CIDRs...),
+	}, nil
+}
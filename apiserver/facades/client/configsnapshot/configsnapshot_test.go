@@ -0,0 +1,428 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/authentication"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/configsnapshot"
+	configsnapshoterrors "github.com/juju/juju/domain/configsnapshot/errors"
+	"github.com/juju/juju/environs/config"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type configSnapshotSuite struct {
+	authorizer            *MockAuthorizer
+	check                 *MockBlockChecker
+	configSnapshotService *MockConfigSnapshotService
+	applicationService    *MockApplicationService
+	modelConfigService    *MockModelConfigService
+	networkService        *MockNetworkService
+
+	appUUID coreapplication.UUID
+}
+
+func TestConfigSnapshotSuite(t *stdtesting.T) {
+	tc.Run(t, &configSnapshotSuite{})
+}
+
+var spaceInfos = network.SpaceInfos{
+	{ID: "alpha-id", Name: "alpha"},
+	{ID: "beta-id", Name: "beta"},
+}
+
+func (s *configSnapshotSuite) TestCreateSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.expectCurrentConfig(c)
+
+	s.configSnapshotService.EXPECT().CreateSnapshot(gomock.Any(), configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{Name: "before-upgrade"},
+		ModelConfig:  map[string]string{"logging-config": "<root>=DEBUG"},
+		Applications: map[string]configsnapshot.Application{
+			"mysql": {
+				Charm:            configsnapshot.CharmRef{Name: "mysql", Source: "charmhub", Revision: 7},
+				Config:           map[string]string{"port": "3307", "trust": "false"},
+				Constraints:      "mem=8192M",
+				EndpointBindings: map[string]string{"": "alpha", "db": "beta"},
+				ExposedEndpoints: map[string]configsnapshot.ExposedEndpoint{
+					"db": {Spaces: []string{"beta"}, CIDRs: []string{"10.0.0.0/8"}},
+				},
+			},
+		},
+	}, true).Return(nil)
+
+	err := s.newAPI(c).CreateSnapshot(c.Context(), params.ConfigSnapshotArg{
+		Name:    "before-upgrade",
+		Replace: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *configSnapshotSuite) TestCreateSnapshotAlreadyExists(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.expectCurrentConfig(c)
+
+	s.configSnapshotService.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any(), false).
+		Return(configsnapshoterrors.SnapshotAlreadyExists)
+
+	err := s.newAPI(c).CreateSnapshot(c.Context(), params.ConfigSnapshotArg{Name: "foo"})
+	c.Check(params.IsCodeAlreadyExists(err), tc.IsTrue)
+}
+
+func (s *configSnapshotSuite) TestCreateSnapshotPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, testing.ModelTag).
+		Return(errors.New("permission denied"))
+
+	err := s.newAPI(c).CreateSnapshot(c.Context(), params.ConfigSnapshotArg{Name: "foo"})
+	c.Check(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *configSnapshotSuite) TestListSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.ReadAccess)
+
+	now := time.Now()
+	s.configSnapshotService.EXPECT().ListSnapshots(gomock.Any()).Return([]configsnapshot.SnapshotInfo{
+		{Name: "first", CreatedAt: now},
+	}, nil)
+
+	result, err := s.newAPI(c).ListSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ConfigSnapshotsResult{
+		Snapshots: []params.ConfigSnapshotInfo{{Name: "first", CreatedAt: now}},
+	})
+}
+
+func (s *configSnapshotSuite) TestRemoveSnapshotNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+
+	s.configSnapshotService.EXPECT().DeleteSnapshot(gomock.Any(), "foo").
+		Return(configsnapshoterrors.SnapshotNotFound)
+
+	err := s.newAPI(c).RemoveSnapshot(c.Context(), params.ConfigSnapshotArg{Name: "foo"})
+	c.Check(params.IsCodeNotFound(err), tc.IsTrue)
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.check.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+
+	s.configSnapshotService.EXPECT().GetSnapshot(gomock.Any(), "foo").
+		Return(configsnapshot.Snapshot{}, configsnapshoterrors.SnapshotNotFound)
+
+	_, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{Name: "foo"})
+	c.Check(params.IsCodeNotFound(err), tc.IsTrue)
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.check.EXPECT().ChangeAllowed(gomock.Any()).Return(errors.New("changes blocked"))
+
+	_, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{Name: "foo"})
+	c.Check(err, tc.ErrorMatches, "changes blocked")
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigDryRun(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.configSnapshotService.EXPECT().GetSnapshot(gomock.Any(), "before-upgrade").Return(s.targetSnapshot(), nil)
+	s.expectCurrentConfig(c)
+
+	result, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{
+		Name:   "before-upgrade",
+		DryRun: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Changes, tc.DeepEquals, s.expectedChanges())
+}
+
+func (s *configSnapshotSuite) TestRollbackConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.check.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+
+	// The charm is already on the snapshot revision.
+	target := s.targetSnapshot()
+	mysql := target.Applications["mysql"]
+	mysql.Charm.Revision = 7
+	target.Applications["mysql"] = mysql
+	s.configSnapshotService.EXPECT().GetSnapshot(gomock.Any(), "before-upgrade").Return(target, nil)
+	s.expectCurrentConfig(c)
+
+	s.expectModelConfigValidation()
+	s.expectApplicationUpdate()
+	s.modelConfigService.EXPECT().UpdateModelConfig(gomock.Any(),
+		map[string]any{"logging-config": "<root>=INFO"}, []string(nil),
+	).Return(nil)
+
+	result, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{Name: "before-upgrade"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var expected []params.ConfigSnapshotChange
+	for _, change := range s.expectedChanges() {
+		if change.Kind != "charm" {
+			expected = append(expected, change)
+		}
+	}
+	c.Check(result.Changes, tc.DeepEquals, expected)
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigCharmChange(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.check.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.configSnapshotService.EXPECT().GetSnapshot(gomock.Any(), "before-upgrade").Return(s.targetSnapshot(), nil)
+	s.expectCurrentConfig(c)
+
+	s.expectModelConfigValidation()
+	// The charm is rolled back before the application config, which may
+	// only be valid for the snapshot revision.
+	gomock.InOrder(
+		s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), "mysql", charm.CharmLocator{
+			Name:     "mysql",
+			Revision: 5,
+			Source:   charm.CharmHubSource,
+		}, application.SetCharmParams{}).Return(nil),
+		s.expectApplicationUpdate(),
+	)
+	s.modelConfigService.EXPECT().UpdateModelConfig(gomock.Any(),
+		map[string]any{"logging-config": "<root>=INFO"}, []string(nil),
+	).Return(nil)
+
+	result, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{Name: "before-upgrade"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Changes, tc.DeepEquals, s.expectedChanges())
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigCharmNotInModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.check.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.configSnapshotService.EXPECT().GetSnapshot(gomock.Any(), "before-upgrade").Return(s.targetSnapshot(), nil)
+	s.expectCurrentConfig(c)
+
+	s.expectModelConfigValidation()
+	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), "mysql", gomock.Any(), gomock.Any()).
+		Return(applicationerrors.CharmNotFound)
+
+	// Nothing else is changed when the charm can't be rolled back.
+	_, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{Name: "before-upgrade"})
+	c.Assert(err, tc.ErrorMatches, `rolling back application "mysql": charm mysql revision 5 is no longer in the model; deploy or refresh to it first`)
+	c.Check(err, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigInvalidModelConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectAccess(permission.WriteAccess)
+	s.check.EXPECT().ChangeAllowed(gomock.Any()).Return(nil)
+	s.configSnapshotService.EXPECT().GetSnapshot(gomock.Any(), "before-upgrade").Return(s.targetSnapshot(), nil)
+	s.expectCurrentConfig(c)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).Return(nil)
+	s.modelConfigService.EXPECT().ValidateModelConfigUpdate(gomock.Any(),
+		map[string]any{"logging-config": "<root>=INFO"}, []string(nil), gomock.Any(),
+	).Return(&config.ValidationError{InvalidAttrs: []string{"logging-config"}, Reason: "bad"})
+
+	// Nothing is changed when the model config is not valid.
+	_, err := s.newAPI(c).RollbackConfig(c.Context(), params.ConfigRollbackArg{Name: "before-upgrade"})
+	c.Assert(err, tc.ErrorMatches, `model config key \["logging-config"\] not valid: bad`)
+}
+
+func (s *configSnapshotSuite) expectModelConfigValidation() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, testing.ControllerTag).
+		Return(authentication.ErrorEntityMissingPermission)
+	s.modelConfigService.EXPECT().ValidateModelConfigUpdate(gomock.Any(),
+		map[string]any{"logging-config": "<root>=INFO"}, []string(nil), gomock.Any(),
+	).Return(nil)
+}
+
+func (s *configSnapshotSuite) expectApplicationUpdate() any {
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(spaceInfos, nil)
+	s.applicationService.EXPECT().GetApplicationDetailsByName(gomock.Any(), "mysql").Return(application.ApplicationDetails{
+		UUID: s.appUUID,
+		Name: "mysql",
+	}, nil)
+
+	cons := constraints.MustParse("mem=4096M")
+	return s.applicationService.EXPECT().UpdateApplicationsConfiguration(gomock.Any(), []applicationservice.UpdateApplicationConfigurationParams{{
+		UUID:          s.appUUID,
+		ConfigUpdates: map[string]string{"port": "3306"},
+		Constraints:   &cons,
+		EndpointBindings: map[string]network.SpaceName{
+			"db": "alpha",
+		},
+		ExposeUpdates: map[string]application.ExposedEndpoint{
+			"": {
+				ExposeToSpaceIDs: set.NewStrings(),
+				ExposeToCIDRs:    set.NewStrings("0.0.0.0/0"),
+			},
+		},
+		ExposeRemoves: set.NewStrings("db"),
+	}}).Return(nil)
+}
+
+func (s *configSnapshotSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.check = NewMockBlockChecker(ctrl)
+	s.configSnapshotService = NewMockConfigSnapshotService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.networkService = NewMockNetworkService(ctrl)
+	s.appUUID = coreapplication.UUID("6a5eb1ff-8c3b-4e9c-8b43-2fb1b2c6b1d9")
+	return ctrl
+}
+
+func (s *configSnapshotSuite) newAPI(c *tc.C) *ConfigSnapshotAPI {
+	return &ConfigSnapshotAPI{
+		controllerTag:         testing.ControllerTag,
+		modelTag:              testing.ModelTag,
+		authorizer:            s.authorizer,
+		check:                 s.check,
+		configSnapshotService: s.configSnapshotService,
+		applicationService:    s.applicationService,
+		modelConfigService:    s.modelConfigService,
+		networkService:        s.networkService,
+		logger:                loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *configSnapshotSuite) expectAccess(access permission.Access) {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), access, testing.ModelTag).Return(nil)
+}
+
+// expectCurrentConfig sets up the expectations for reading the current
+// configuration of a model with a single mysql application, and a
+// synthetic application which is ignored.
+func (s *configSnapshotSuite) expectCurrentConfig(c *tc.C) {
+	s.modelConfigService.EXPECT().ModelConfigValues(gomock.Any()).Return(config.ConfigValues{
+		"name":           {Value: "prod", Source: config.JujuModelConfigSource},
+		"logging-config": {Value: "<root>=DEBUG", Source: config.JujuModelConfigSource},
+		"ftp-proxy":      {Value: "", Source: config.JujuDefaultSource},
+	}, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(spaceInfos, nil)
+	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(map[string]map[string]network.SpaceName{
+		"mysql":  {"": "alpha", "db": "beta"},
+		"remote": {"": "alpha"},
+	}, nil)
+	s.applicationService.EXPECT().GetAllExposedEndpoints(gomock.Any()).Return(map[string]map[string]application.ExposedEndpoint{
+		"mysql": {
+			"db": {
+				ExposeToSpaceIDs: set.NewStrings("beta-id"),
+				ExposeToCIDRs:    set.NewStrings("10.0.0.0/8"),
+			},
+		},
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationDetailsByName(gomock.Any(), "remote").Return(application.ApplicationDetails{
+		Name:                   "remote",
+		IsApplicationSynthetic: true,
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationDetailsByName(gomock.Any(), "mysql").Return(application.ApplicationDetails{
+		UUID: s.appUUID,
+		Name: "mysql",
+	}, nil)
+	s.applicationService.EXPECT().GetCharmLocatorByApplicationName(gomock.Any(), "mysql").Return(charm.CharmLocator{
+		Name:     "mysql",
+		Source:   charm.CharmHubSource,
+		Revision: 7,
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationAndCharmConfig(gomock.Any(), s.appUUID).Return(applicationservice.ApplicationConfig{
+		ApplicationConfig: map[string]any{"port": 3307},
+		Trust:             false,
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationConstraints(gomock.Any(), s.appUUID).Return(constraints.MustParse("mem=8G"), nil)
+}
+
+// targetSnapshot returns a snapshot which differs from the configuration
+// set up by expectCurrentConfig.
+func (s *configSnapshotSuite) targetSnapshot() configsnapshot.Snapshot {
+	return configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{Name: "before-upgrade"},
+		ModelConfig:  map[string]string{"logging-config": "<root>=INFO"},
+		Applications: map[string]configsnapshot.Application{
+			"mysql": {
+				Charm:            configsnapshot.CharmRef{Name: "mysql", Source: "charmhub", Revision: 5},
+				Config:           map[string]string{"port": "3306", "trust": "false"},
+				Constraints:      "mem=4096M",
+				EndpointBindings: map[string]string{"": "alpha", "db": "alpha"},
+				ExposedEndpoints: map[string]configsnapshot.ExposedEndpoint{
+					"": {CIDRs: []string{"0.0.0.0/0"}},
+				},
+			},
+			"wordpress": {
+				Charm: configsnapshot.CharmRef{Name: "wordpress", Source: "charmhub", Revision: 3},
+			},
+		},
+	}
+}
+
+func (s *configSnapshotSuite) expectedChanges() []params.ConfigSnapshotChange {
+	return []params.ConfigSnapshotChange{{
+		Kind:    "model-config",
+		Key:     "logging-config",
+		Current: "<root>=DEBUG",
+		Target:  "<root>=INFO",
+	}, {
+		Kind:        "charm",
+		Application: "mysql",
+		Current:     "ch:mysql-7",
+		Target:      "ch:mysql-5",
+	}, {
+		Kind:        "config",
+		Application: "mysql",
+		Key:         "port",
+		Current:     "3307",
+		Target:      "3306",
+	}, {
+		Kind:        "constraints",
+		Application: "mysql",
+		Current:     "mem=8192M",
+		Target:      "mem=4096M",
+	}, {
+		Kind:        "binding",
+		Application: "mysql",
+		Key:         "db",
+		Current:     "beta",
+		Target:      "alpha",
+	}, {
+		Kind:        "expose",
+		Application: "mysql",
+		Key:         "",
+		Target:      "cidrs=0.0.0.0/0",
+	}, {
+		Kind:        "expose",
+		Application: "mysql",
+		Key:         "db",
+		Current:     "spaces=beta cidrs=10.0.0.0/8",
+		Unset:       true,
+	}, {
+		Kind:        "application",
+		Application: "wordpress",
+		Target:      "ch:wordpress-3",
+	}}
+}
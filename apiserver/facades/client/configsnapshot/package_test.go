@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+//go:generate go run github.com/canonical/gomock/mockgen -package configsnapshot -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/configsnapshot ConfigSnapshotService,ApplicationService,ModelConfigService,NetworkService,BlockChecker
+//go:generate go run github.com/canonical/gomock/mockgen -package configsnapshot -destination facade_mock_test.go github.com/juju/juju/apiserver/facade Authorizer
@@ -0,0 +1,43 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/common"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("ConfigSnapshot", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newConfigSnapshotAPI(ctx)
+	}, reflect.TypeFor[*ConfigSnapshotAPI]())
+}
+
+// newConfigSnapshotAPI returns a new ConfigSnapshot facade.
+func newConfigSnapshotAPI(ctx facade.ModelContext) (*ConfigSnapshotAPI, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	domainServices := ctx.DomainServices()
+	return &ConfigSnapshotAPI{
+		controllerTag:         names.NewControllerTag(ctx.ControllerUUID()),
+		modelTag:              names.NewModelTag(ctx.ModelUUID().String()),
+		authorizer:            authorizer,
+		check:                 common.NewBlockChecker(domainServices.BlockCommand()),
+		configSnapshotService: domainServices.ConfigSnapshot(),
+		applicationService:    domainServices.Application(),
+		modelConfigService:    domainServices.Config(),
+		networkService:        domainServices.Network(),
+		logger:                ctx.Logger().Child("configsnapshot"),
+	}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"context"
+
+	coreapplication "github.com/juju/juju/core/application"
+	coreconstraints "github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/configsnapshot"
+	"github.com/juju/juju/environs/config"
+)
+
+// ConfigSnapshotService describes the methods of the config snapshot service
+// used to store and read snapshots.
+type ConfigSnapshotService interface {
+	// CreateSnapshot records the given configuration as a snapshot, taken
+	// now. If replace is true, any existing snapshot with the same name is
+	// replaced.
+	CreateSnapshot(ctx context.Context, snapshot configsnapshot.Snapshot, replace bool) error
+
+	// GetSnapshot returns the snapshot with the given name.
+	GetSnapshot(ctx context.Context, name string) (configsnapshot.Snapshot, error)
+
+	// ListSnapshots returns the snapshots of the model, ordered by creation
+	// time.
+	ListSnapshots(ctx context.Context) ([]configsnapshot.SnapshotInfo, error)
+
+	// DeleteSnapshot removes the snapshot with the given name.
+	DeleteSnapshot(ctx context.Context, name string) error
+}
+
+// ApplicationService describes the methods of the application service used
+// to read and roll back the configuration of applications.
+type ApplicationService interface {
+	// GetAllEndpointBindings returns the all endpoint bindings for the
+	// model, indexed by application name.
+	GetAllEndpointBindings(ctx context.Context) (map[string]map[string]network.SpaceName, error)
+
+	// GetAllExposedEndpoints returns the exposed endpoints of all the
+	// applications in the model, indexed by application name.
+	GetAllExposedEndpoints(ctx context.Context) (map[string]map[string]application.ExposedEndpoint, error)
+
+	// GetApplicationDetailsByName returns the details of the application
+	// with the given name.
+	GetApplicationDetailsByName(ctx context.Context, name string) (application.ApplicationDetails, error)
+
+	// GetCharmLocatorByApplicationName returns a CharmLocator by application
+	// name.
+	GetCharmLocatorByApplicationName(ctx context.Context, name string) (charm.CharmLocator, error)
+
+	// GetApplicationAndCharmConfig returns the application and charm config
+	// for the specified application UUID.
+	GetApplicationAndCharmConfig(ctx context.Context, appUUID coreapplication.UUID) (applicationservice.ApplicationConfig, error)
+
+	// GetApplicationConstraints returns the application constraints for the
+	// specified application UUID.
+	GetApplicationConstraints(ctx context.Context, appUUID coreapplication.UUID) (coreconstraints.Value, error)
+
+	// SetApplicationCharm sets a new charm for the application.
+	SetApplicationCharm(ctx context.Context, appName string, locator charm.CharmLocator, params application.SetCharmParams) error
+
+	// UpdateApplicationsConfiguration applies the changes to the
+	// configuration of the applications in a single transaction.
+	UpdateApplicationsConfiguration(ctx context.Context, params []applicationservice.UpdateApplicationConfigurationParams) error
+}
+
+// ModelConfigService describes the methods of the model config service used
+// to read and roll back model config.
+type ModelConfigService interface {
+	// ModelConfigValues returns the config values for the current model and
+	// the source of each value.
+	ModelConfigValues(ctx context.Context) (config.ConfigValues, error)
+
+	// ValidateModelConfigUpdate validates a set of updated and removed
+	// attributes without applying them.
+	ValidateModelConfigUpdate(ctx context.Context, updateAttrs map[string]any, removeAttrs []string, validators ...config.Validator) error
+
+	// UpdateModelConfig updates the model config.
+	UpdateModelConfig(ctx context.Context, updateAttrs map[string]any, removeAttrs []string, validators ...config.Validator) error
+}
+
+// NetworkService describes the methods of the network service used to map
+// between space names and IDs.
+type NetworkService interface {
+	// GetAllSpaces returns all spaces for the model.
+	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
+}
+
+// BlockChecker defines the block-checking functionality required by the
+// facade.
+type BlockChecker interface {
+	// ChangeAllowed checks if change block is in place.
+	ChangeAllowed(context.Context) error
+}
@@ -0,0 +1,469 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/configsnapshot (interfaces: ConfigSnapshotService,ApplicationService,ModelConfigService,NetworkService,BlockChecker)
+//
+// Generated by this command:
+//
+//	mockgen -package configsnapshot -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/configsnapshot ConfigSnapshotService,ApplicationService,ModelConfigService,NetworkService,BlockChecker
+//
+
+// Package configsnapshot is a generated GoMock package.
+package configsnapshot
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	application "github.com/juju/juju/core/application"
+	constraints "github.com/juju/juju/core/constraints"
+	network "github.com/juju/juju/core/network"
+	application0 "github.com/juju/juju/domain/application"
+	charm "github.com/juju/juju/domain/application/charm"
+	service "github.com/juju/juju/domain/application/service"
+	configsnapshot "github.com/juju/juju/domain/configsnapshot"
+	config "github.com/juju/juju/environs/config"
+)
+
+// MockConfigSnapshotService is a mock of ConfigSnapshotService interface.
+type MockConfigSnapshotService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigSnapshotServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockConfigSnapshotServiceMockRecorder is the mock recorder for MockConfigSnapshotService.
+type MockConfigSnapshotServiceMockRecorder struct {
+	mock                  *MockConfigSnapshotService
+	createSnapshotExpects []*gomock.Call3_1[context.Context, configsnapshot.Snapshot, bool, error]
+	deleteSnapshotExpects []*gomock.Call2_1[context.Context, string, error]
+	getSnapshotExpects    []*gomock.Call2_2[context.Context, string, configsnapshot.Snapshot, error]
+	listSnapshotsExpects  []*gomock.Call1_2[context.Context, []configsnapshot.SnapshotInfo, error]
+}
+
+// NewMockConfigSnapshotService creates a new mock instance.
+func NewMockConfigSnapshotService(ctrl *gomock.Controller) *MockConfigSnapshotService {
+	mock := &MockConfigSnapshotService{ctrl: ctrl}
+	mock.recorder = &MockConfigSnapshotServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigSnapshotService) EXPECT() *MockConfigSnapshotServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockConfigSnapshotService) CreateSnapshot(ctx context.Context, snapshot configsnapshot.Snapshot, replace bool) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.createSnapshotExpects, m.ctrl, m, "CreateSnapshot", ctx, snapshot, replace)
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockConfigSnapshotServiceMockRecorder) CreateSnapshot(ctx, snapshot, replace any) *MockConfigSnapshotServiceCreateSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, configsnapshot.Snapshot, bool, error](mr.mock.ctrl.T, mr.mock, "CreateSnapshot", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(snapshot), gomock.EnsureMatcher(replace))
+	mr.createSnapshotExpects = append(mr.createSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigSnapshotServiceCreateSnapshotCall is the typed call wrapper for CreateSnapshot.
+type MockConfigSnapshotServiceCreateSnapshotCall = gomock.Call3_1[context.Context, configsnapshot.Snapshot, bool, error]
+
+// DeleteSnapshot mocks base method.
+func (m *MockConfigSnapshotService) DeleteSnapshot(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.deleteSnapshotExpects, m.ctrl, m, "DeleteSnapshot", ctx, name)
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockConfigSnapshotServiceMockRecorder) DeleteSnapshot(ctx, name any) *MockConfigSnapshotServiceDeleteSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "DeleteSnapshot", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.deleteSnapshotExpects = append(mr.deleteSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigSnapshotServiceDeleteSnapshotCall is the typed call wrapper for DeleteSnapshot.
+type MockConfigSnapshotServiceDeleteSnapshotCall = gomock.Call2_1[context.Context, string, error]
+
+// GetSnapshot mocks base method.
+func (m *MockConfigSnapshotService) GetSnapshot(ctx context.Context, name string) (configsnapshot.Snapshot, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getSnapshotExpects, m.ctrl, m, "GetSnapshot", ctx, name)
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockConfigSnapshotServiceMockRecorder) GetSnapshot(ctx, name any) *MockConfigSnapshotServiceGetSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, configsnapshot.Snapshot, error](mr.mock.ctrl.T, mr.mock, "GetSnapshot", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getSnapshotExpects = append(mr.getSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigSnapshotServiceGetSnapshotCall is the typed call wrapper for GetSnapshot.
+type MockConfigSnapshotServiceGetSnapshotCall = gomock.Call2_2[context.Context, string, configsnapshot.Snapshot, error]
+
+// ListSnapshots mocks base method.
+func (m *MockConfigSnapshotService) ListSnapshots(ctx context.Context) ([]configsnapshot.SnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.listSnapshotsExpects, m.ctrl, m, "ListSnapshots", ctx)
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockConfigSnapshotServiceMockRecorder) ListSnapshots(ctx any) *MockConfigSnapshotServiceListSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []configsnapshot.SnapshotInfo, error](mr.mock.ctrl.T, mr.mock, "ListSnapshots", gomock.EnsureMatcher(ctx))
+	mr.listSnapshotsExpects = append(mr.listSnapshotsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockConfigSnapshotServiceListSnapshotsCall is the typed call wrapper for ListSnapshots.
+type MockConfigSnapshotServiceListSnapshotsCall = gomock.Call1_2[context.Context, []configsnapshot.SnapshotInfo, error]
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock                                    *MockApplicationService
+	getAllEndpointBindingsExpects           []*gomock.Call1_2[context.Context, map[string]map[string]network.SpaceName, error]
+	getAllExposedEndpointsExpects           []*gomock.Call1_2[context.Context, map[string]map[string]application0.ExposedEndpoint, error]
+	getApplicationAndCharmConfigExpects     []*gomock.Call2_2[context.Context, application.UUID, service.ApplicationConfig, error]
+	getApplicationConstraintsExpects        []*gomock.Call2_2[context.Context, application.UUID, constraints.Value, error]
+	getApplicationDetailsByNameExpects      []*gomock.Call2_2[context.Context, string, application0.ApplicationDetails, error]
+	getCharmLocatorByApplicationNameExpects []*gomock.Call2_2[context.Context, string, charm.CharmLocator, error]
+	setApplicationCharmExpects              []*gomock.Call4_1[context.Context, string, charm.CharmLocator, application0.SetCharmParams, error]
+	updateApplicationsConfigurationExpects  []*gomock.Call2_1[context.Context, []service.UpdateApplicationConfigurationParams, error]
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllEndpointBindings mocks base method.
+func (m *MockApplicationService) GetAllEndpointBindings(ctx context.Context) (map[string]map[string]network.SpaceName, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllEndpointBindingsExpects, m.ctrl, m, "GetAllEndpointBindings", ctx)
+}
+
+// GetAllEndpointBindings indicates an expected call of GetAllEndpointBindings.
+func (mr *MockApplicationServiceMockRecorder) GetAllEndpointBindings(ctx any) *MockApplicationServiceGetAllEndpointBindingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]map[string]network.SpaceName, error](mr.mock.ctrl.T, mr.mock, "GetAllEndpointBindings", gomock.EnsureMatcher(ctx))
+	mr.getAllEndpointBindingsExpects = append(mr.getAllEndpointBindingsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetAllEndpointBindingsCall is the typed call wrapper for GetAllEndpointBindings.
+type MockApplicationServiceGetAllEndpointBindingsCall = gomock.Call1_2[context.Context, map[string]map[string]network.SpaceName, error]
+
+// GetAllExposedEndpoints mocks base method.
+func (m *MockApplicationService) GetAllExposedEndpoints(ctx context.Context) (map[string]map[string]application0.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllExposedEndpointsExpects, m.ctrl, m, "GetAllExposedEndpoints", ctx)
+}
+
+// GetAllExposedEndpoints indicates an expected call of GetAllExposedEndpoints.
+func (mr *MockApplicationServiceMockRecorder) GetAllExposedEndpoints(ctx any) *MockApplicationServiceGetAllExposedEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]map[string]application0.ExposedEndpoint, error](mr.mock.ctrl.T, mr.mock, "GetAllExposedEndpoints", gomock.EnsureMatcher(ctx))
+	mr.getAllExposedEndpointsExpects = append(mr.getAllExposedEndpointsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetAllExposedEndpointsCall is the typed call wrapper for GetAllExposedEndpoints.
+type MockApplicationServiceGetAllExposedEndpointsCall = gomock.Call1_2[context.Context, map[string]map[string]application0.ExposedEndpoint, error]
+
+// GetApplicationAndCharmConfig mocks base method.
+func (m *MockApplicationService) GetApplicationAndCharmConfig(ctx context.Context, appUUID application.UUID) (service.ApplicationConfig, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationAndCharmConfigExpects, m.ctrl, m, "GetApplicationAndCharmConfig", ctx, appUUID)
+}
+
+// GetApplicationAndCharmConfig indicates an expected call of GetApplicationAndCharmConfig.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationAndCharmConfig(ctx, appUUID any) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, application.UUID, service.ApplicationConfig, error](mr.mock.ctrl.T, mr.mock, "GetApplicationAndCharmConfig", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID))
+	mr.getApplicationAndCharmConfigExpects = append(mr.getApplicationAndCharmConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetApplicationAndCharmConfigCall is the typed call wrapper for GetApplicationAndCharmConfig.
+type MockApplicationServiceGetApplicationAndCharmConfigCall = gomock.Call2_2[context.Context, application.UUID, service.ApplicationConfig, error]
+
+// GetApplicationConstraints mocks base method.
+func (m *MockApplicationService) GetApplicationConstraints(ctx context.Context, appUUID application.UUID) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationConstraintsExpects, m.ctrl, m, "GetApplicationConstraints", ctx, appUUID)
+}
+
+// GetApplicationConstraints indicates an expected call of GetApplicationConstraints.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationConstraints(ctx, appUUID any) *MockApplicationServiceGetApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, application.UUID, constraints.Value, error](mr.mock.ctrl.T, mr.mock, "GetApplicationConstraints", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID))
+	mr.getApplicationConstraintsExpects = append(mr.getApplicationConstraintsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetApplicationConstraintsCall is the typed call wrapper for GetApplicationConstraints.
+type MockApplicationServiceGetApplicationConstraintsCall = gomock.Call2_2[context.Context, application.UUID, constraints.Value, error]
+
+// GetApplicationDetailsByName mocks base method.
+func (m *MockApplicationService) GetApplicationDetailsByName(ctx context.Context, name string) (application0.ApplicationDetails, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationDetailsByNameExpects, m.ctrl, m, "GetApplicationDetailsByName", ctx, name)
+}
+
+// GetApplicationDetailsByName indicates an expected call of GetApplicationDetailsByName.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationDetailsByName(ctx, name any) *MockApplicationServiceGetApplicationDetailsByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, application0.ApplicationDetails, error](mr.mock.ctrl.T, mr.mock, "GetApplicationDetailsByName", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getApplicationDetailsByNameExpects = append(mr.getApplicationDetailsByNameExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetApplicationDetailsByNameCall is the typed call wrapper for GetApplicationDetailsByName.
+type MockApplicationServiceGetApplicationDetailsByNameCall = gomock.Call2_2[context.Context, string, application0.ApplicationDetails, error]
+
+// GetCharmLocatorByApplicationName mocks base method.
+func (m *MockApplicationService) GetCharmLocatorByApplicationName(ctx context.Context, name string) (charm.CharmLocator, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getCharmLocatorByApplicationNameExpects, m.ctrl, m, "GetCharmLocatorByApplicationName", ctx, name)
+}
+
+// GetCharmLocatorByApplicationName indicates an expected call of GetCharmLocatorByApplicationName.
+func (mr *MockApplicationServiceMockRecorder) GetCharmLocatorByApplicationName(ctx, name any) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, charm.CharmLocator, error](mr.mock.ctrl.T, mr.mock, "GetCharmLocatorByApplicationName", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getCharmLocatorByApplicationNameExpects = append(mr.getCharmLocatorByApplicationNameExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetCharmLocatorByApplicationNameCall is the typed call wrapper for GetCharmLocatorByApplicationName.
+type MockApplicationServiceGetCharmLocatorByApplicationNameCall = gomock.Call2_2[context.Context, string, charm.CharmLocator, error]
+
+// SetApplicationCharm mocks base method.
+func (m *MockApplicationService) SetApplicationCharm(ctx context.Context, appName string, locator charm.CharmLocator, params application0.SetCharmParams) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.setApplicationCharmExpects, m.ctrl, m, "SetApplicationCharm", ctx, appName, locator, params)
+}
+
+// SetApplicationCharm indicates an expected call of SetApplicationCharm.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationCharm(ctx, appName, locator, params any) *MockApplicationServiceSetApplicationCharmCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, string, charm.CharmLocator, application0.SetCharmParams, error](mr.mock.ctrl.T, mr.mock, "SetApplicationCharm", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appName), gomock.EnsureMatcher(locator), gomock.EnsureMatcher(params))
+	mr.setApplicationCharmExpects = append(mr.setApplicationCharmExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceSetApplicationCharmCall is the typed call wrapper for SetApplicationCharm.
+type MockApplicationServiceSetApplicationCharmCall = gomock.Call4_1[context.Context, string, charm.CharmLocator, application0.SetCharmParams, error]
+
+// UpdateApplicationsConfiguration mocks base method.
+func (m *MockApplicationService) UpdateApplicationsConfiguration(ctx context.Context, params []service.UpdateApplicationConfigurationParams) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateApplicationsConfigurationExpects, m.ctrl, m, "UpdateApplicationsConfiguration", ctx, params)
+}
+
+// UpdateApplicationsConfiguration indicates an expected call of UpdateApplicationsConfiguration.
+func (mr *MockApplicationServiceMockRecorder) UpdateApplicationsConfiguration(ctx, params any) *MockApplicationServiceUpdateApplicationsConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, []service.UpdateApplicationConfigurationParams, error](mr.mock.ctrl.T, mr.mock, "UpdateApplicationsConfiguration", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(params))
+	mr.updateApplicationsConfigurationExpects = append(mr.updateApplicationsConfigurationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceUpdateApplicationsConfigurationCall is the typed call wrapper for UpdateApplicationsConfiguration.
+type MockApplicationServiceUpdateApplicationsConfigurationCall = gomock.Call2_1[context.Context, []service.UpdateApplicationConfigurationParams, error]
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock                             *MockModelConfigService
+	modelConfigValuesExpects         []*gomock.Call1_2[context.Context, config.ConfigValues, error]
+	updateModelConfigExpects         []*gomock.Call3V_1[context.Context, map[string]any, []string, config.Validator, error]
+	validateModelConfigUpdateExpects []*gomock.Call3V_1[context.Context, map[string]any, []string, config.Validator, error]
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfigValues mocks base method.
+func (m *MockModelConfigService) ModelConfigValues(ctx context.Context) (config.ConfigValues, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.modelConfigValuesExpects, m.ctrl, m, "ModelConfigValues", ctx)
+}
+
+// ModelConfigValues indicates an expected call of ModelConfigValues.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfigValues(ctx any) *MockModelConfigServiceModelConfigValuesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, config.ConfigValues, error](mr.mock.ctrl.T, mr.mock, "ModelConfigValues", gomock.EnsureMatcher(ctx))
+	mr.modelConfigValuesExpects = append(mr.modelConfigValuesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelConfigServiceModelConfigValuesCall is the typed call wrapper for ModelConfigValues.
+type MockModelConfigServiceModelConfigValuesCall = gomock.Call1_2[context.Context, config.ConfigValues, error]
+
+// UpdateModelConfig mocks base method.
+func (m *MockModelConfigService) UpdateModelConfig(ctx context.Context, updateAttrs map[string]any, removeAttrs []string, validators ...config.Validator) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3V_1(&m.recorder.updateModelConfigExpects, m.ctrl, m, "UpdateModelConfig", ctx, updateAttrs, removeAttrs, validators...)
+}
+
+// UpdateModelConfig indicates an expected call of UpdateModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) UpdateModelConfig(ctx, updateAttrs, removeAttrs any, validators ...any) *MockModelConfigServiceUpdateModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(validators)
+	call := gomock.NewCall3V_1[context.Context, map[string]any, []string, config.Validator, error](mr.mock.ctrl.T, mr.mock, "UpdateModelConfig", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(updateAttrs), gomock.EnsureMatcher(removeAttrs), varArgs)
+	mr.updateModelConfigExpects = append(mr.updateModelConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelConfigServiceUpdateModelConfigCall is the typed call wrapper for UpdateModelConfig.
+type MockModelConfigServiceUpdateModelConfigCall = gomock.Call3V_1[context.Context, map[string]any, []string, config.Validator, error]
+
+// ValidateModelConfigUpdate mocks base method.
+func (m *MockModelConfigService) ValidateModelConfigUpdate(ctx context.Context, updateAttrs map[string]any, removeAttrs []string, validators ...config.Validator) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3V_1(&m.recorder.validateModelConfigUpdateExpects, m.ctrl, m, "ValidateModelConfigUpdate", ctx, updateAttrs, removeAttrs, validators...)
+}
+
+// ValidateModelConfigUpdate indicates an expected call of ValidateModelConfigUpdate.
+func (mr *MockModelConfigServiceMockRecorder) ValidateModelConfigUpdate(ctx, updateAttrs, removeAttrs any, validators ...any) *MockModelConfigServiceValidateModelConfigUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(validators)
+	call := gomock.NewCall3V_1[context.Context, map[string]any, []string, config.Validator, error](mr.mock.ctrl.T, mr.mock, "ValidateModelConfigUpdate", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(updateAttrs), gomock.EnsureMatcher(removeAttrs), varArgs)
+	mr.validateModelConfigUpdateExpects = append(mr.validateModelConfigUpdateExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelConfigServiceValidateModelConfigUpdateCall is the typed call wrapper for ValidateModelConfigUpdate.
+type MockModelConfigServiceValidateModelConfigUpdateCall = gomock.Call3V_1[context.Context, map[string]any, []string, config.Validator, error]
+
+// MockNetworkService is a mock of NetworkService interface.
+type MockNetworkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockNetworkServiceMockRecorder is the mock recorder for MockNetworkService.
+type MockNetworkServiceMockRecorder struct {
+	mock                *MockNetworkService
+	getAllSpacesExpects []*gomock.Call1_2[context.Context, network.SpaceInfos, error]
+}
+
+// NewMockNetworkService creates a new mock instance.
+func NewMockNetworkService(ctrl *gomock.Controller) *MockNetworkService {
+	mock := &MockNetworkService{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkService) EXPECT() *MockNetworkServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllSpaces mocks base method.
+func (m *MockNetworkService) GetAllSpaces(ctx context.Context) (network.SpaceInfos, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllSpacesExpects, m.ctrl, m, "GetAllSpaces", ctx)
+}
+
+// GetAllSpaces indicates an expected call of GetAllSpaces.
+func (mr *MockNetworkServiceMockRecorder) GetAllSpaces(ctx any) *MockNetworkServiceGetAllSpacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, network.SpaceInfos, error](mr.mock.ctrl.T, mr.mock, "GetAllSpaces", gomock.EnsureMatcher(ctx))
+	mr.getAllSpacesExpects = append(mr.getAllSpacesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockNetworkServiceGetAllSpacesCall is the typed call wrapper for GetAllSpaces.
+type MockNetworkServiceGetAllSpacesCall = gomock.Call1_2[context.Context, network.SpaceInfos, error]
+
+// MockBlockChecker is a mock of BlockChecker interface.
+type MockBlockChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockCheckerMockRecorder is the mock recorder for MockBlockChecker.
+type MockBlockCheckerMockRecorder struct {
+	mock                 *MockBlockChecker
+	changeAllowedExpects []*gomock.Call1_1[context.Context, error]
+}
+
+// NewMockBlockChecker creates a new mock instance.
+func NewMockBlockChecker(ctrl *gomock.Controller) *MockBlockChecker {
+	mock := &MockBlockChecker{ctrl: ctrl}
+	mock.recorder = &MockBlockCheckerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockChecker) EXPECT() *MockBlockCheckerMockRecorder {
+	return m.recorder
+}
+
+// ChangeAllowed mocks base method.
+func (m *MockBlockChecker) ChangeAllowed(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.changeAllowedExpects, m.ctrl, m, "ChangeAllowed", arg0)
+}
+
+// ChangeAllowed indicates an expected call of ChangeAllowed.
+func (mr *MockBlockCheckerMockRecorder) ChangeAllowed(arg0 any) *MockBlockCheckerChangeAllowedCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "ChangeAllowed", gomock.EnsureMatcher(arg0))
+	mr.changeAllowedExpects = append(mr.changeAllowedExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBlockCheckerChangeAllowedCall is the typed call wrapper for ChangeAllowed.
+type MockBlockCheckerChangeAllowedCall = gomock.Call1_1[context.Context, error]
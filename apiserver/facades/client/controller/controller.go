@@ -50,6 +50,11 @@ type ControllerAPIV12 struct {
 
 // ControllerAPIV13 implements the controller APIV13.
 type ControllerAPIV13 struct {
+	*ControllerAPIV14
+}
+
+// ControllerAPIV14 implements the controller APIV14.
+type ControllerAPIV14 struct {
 	*ControllerAPI
 }
 
@@ -695,8 +700,9 @@ func (c *ControllerAPI) ModifyControllerAccess(ctx context.Context, args params.
 		}
 
 		updateArgs := access.UpdatePermissionArgs{
-			Change:  permission.AccessChange(string(arg.Action)),
-			Subject: user.NameFromTag(targetUserTag),
+			Change:    permission.AccessChange(string(arg.Action)),
+			Subject:   user.NameFromTag(targetUserTag),
+			ExpiresAt: arg.Expiry,
 			AccessSpec: permission.AccessSpec{
 				Access: permission.Access(arg.Access),
 				Target: permission.ID{
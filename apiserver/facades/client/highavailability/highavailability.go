@@ -12,12 +12,24 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	corelogger "github.com/juju/juju/core/logger"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/permission"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
 	"github.com/juju/juju/rpc/params"
 )
 
+// DefaultNumControllers is the number of controllers ensured by EnableHA
+// when no number is requested.
+const DefaultNumControllers = 3
+
 // ControllerNodeService describes the maintenance of controller entries.
 type ControllerNodeService interface {
 	// GetControllerAPIAddresses returns the list of API addresses for all
@@ -25,18 +37,53 @@ type ControllerNodeService interface {
 	GetAPIAddressesByControllerIDForClients(ctx context.Context) (map[string][]string, error)
 }
 
+// ControllerNodeClusterService describes the membership of the controller
+// nodes in the Dqlite cluster.
+type ControllerNodeClusterService interface {
+	// GetControllerNodes returns the controller nodes along with their role
+	// in the Dqlite cluster.
+	GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error)
+}
+
+// ApplicationService describes the management of the controller application,
+// whose units make up the controllers.
+type ApplicationService interface {
+	// GetApplicationDetailsByName returns the details of the named
+	// application.
+	GetApplicationDetailsByName(ctx context.Context, name string) (application.ApplicationDetails, error)
+
+	// GetMachinesForApplication returns the names of the machines which have
+	// a unit of the named application deployed to them.
+	GetMachinesForApplication(ctx context.Context, appName string) ([]coremachine.Name, error)
+
+	// SetApplicationConstraints sets the constraints of the application.
+	SetApplicationConstraints(ctx context.Context, appID coreapplication.UUID, cons constraints.Value) error
+
+	// AddIAASUnits adds the specified units to the application, returning
+	// the names of the units and of the machines they were placed on.
+	AddIAASUnits(ctx context.Context, appName string, units ...applicationservice.AddIAASUnitArg) ([]coreunit.Name, []coremachine.Name, error)
+}
+
 // HighAvailabilityAPI implements the HighAvailability interface and is the concrete
 // implementation of the api end point.
 type HighAvailabilityAPI struct {
-	controllerTag         names.ControllerTag
-	controllerNodeService ControllerNodeService
-	authorizer            facade.Authorizer
-	logger                corelogger.Logger
+	controllerTag                names.ControllerTag
+	isControllerModel            bool
+	controllerNodeService        ControllerNodeService
+	controllerNodeClusterService ControllerNodeClusterService
+	applicationService           ApplicationService
+	authorizer                   facade.Authorizer
+	logger                       corelogger.Logger
+}
+
+// HighAvailabilityAPIV3 implements v3 of the high availability facade.
+type HighAvailabilityAPIV3 struct {
+	HighAvailabilityAPI
 }
 
 // HighAvailabilityAPIV2 implements v2 of the high availability facade.
 type HighAvailabilityAPIV2 struct {
-	HighAvailabilityAPI
+	HighAvailabilityAPIV3
 }
 
 // EnableHA adds controller machines as necessary to ensure the
@@ -44,13 +91,157 @@ type HighAvailabilityAPIV2 struct {
 func (api *HighAvailabilityAPI) EnableHA(
 	ctx context.Context, args params.ControllersSpecs,
 ) (params.ControllersChangeResults, error) {
-	return params.ControllersChangeResults{}, apiservererrors.ServerError(errors.NotSupportedf("enable HA"))
+	results := params.ControllersChangeResults{}
+
+	err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+	if err != nil {
+		return results, apiservererrors.ServerError(apiservererrors.ErrPerm)
+	}
+	if !api.isControllerModel {
+		return results, apiservererrors.ServerError(
+			errors.NotSupportedf("enabling HA outside of the controller model"))
+	}
+	// Only one spec is supported, as the controller application can only be
+	// scaled in one way at a time.
+	if len(args.Specs) > 1 {
+		return results, apiservererrors.ServerError(
+			errors.NotValidf("more than one controller spec"))
+	}
+
+	results.Results = make([]params.ControllersChangeResult, len(args.Specs))
+	for i, spec := range args.Specs {
+		changes, err := api.enableHASingle(ctx, spec)
+		results.Results[i].Result = changes
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+func (api *HighAvailabilityAPI) enableHASingle(
+	ctx context.Context, spec params.ControllersSpec,
+) (params.ControllersChanges, error) {
+	numControllers := spec.NumControllers
+	if numControllers == 0 {
+		numControllers = DefaultNumControllers
+	}
+	if numControllers < 0 || numControllers%2 != 1 {
+		return params.ControllersChanges{}, errors.NotValidf("number of controllers %d, must be odd and non-negative", numControllers)
+	}
+
+	existing, err := api.applicationService.GetMachinesForApplication(ctx, coreapplication.ControllerApplicationName)
+	if err != nil {
+		return params.ControllersChanges{}, errors.Annotate(err, "getting controller machines")
+	}
+	changes := params.ControllersChanges{
+		Maintained: machineNames(existing),
+	}
+
+	toAdd := numControllers - len(existing)
+	if toAdd < 0 {
+		return params.ControllersChanges{}, errors.NotSupportedf(
+			"removing controllers with enable-ha, remove the controller machines instead")
+	} else if toAdd == 0 {
+		return changes, nil
+	}
+	if len(spec.Placement) > toAdd {
+		return params.ControllersChanges{}, errors.NotValidf(
+			"%d placement directives for %d new controllers", len(spec.Placement), toAdd)
+	}
+
+	units := make([]applicationservice.AddIAASUnitArg, toAdd)
+	converting := make([]bool, toAdd)
+	for i, p := range spec.Placement {
+		placement, err := parsePlacement(p)
+		if err != nil {
+			return params.ControllersChanges{}, errors.Trace(err)
+		}
+		units[i].Placement = placement
+		converting[i] = placement.Scope == instance.MachineScope
+	}
+
+	if !constraints.IsEmpty(&spec.Constraints) {
+		app, err := api.applicationService.GetApplicationDetailsByName(ctx, coreapplication.ControllerApplicationName)
+		if err != nil {
+			return params.ControllersChanges{}, errors.Annotate(err, "getting controller application")
+		}
+		if err := api.applicationService.SetApplicationConstraints(ctx, app.UUID, spec.Constraints); err != nil {
+			return params.ControllersChanges{}, errors.Annotate(err, "setting controller constraints")
+		}
+	}
+
+	_, machines, err := api.applicationService.AddIAASUnits(ctx, coreapplication.ControllerApplicationName, units...)
+	if err != nil {
+		return params.ControllersChanges{}, errors.Annotate(err, "adding controllers")
+	}
+	for i, m := range machines {
+		if i < len(converting) && converting[i] {
+			changes.Converted = append(changes.Converted, m.String())
+		} else {
+			changes.Added = append(changes.Added, m.String())
+		}
+	}
+	api.logger.Infof(ctx, "enabling HA with %d controllers, adding %v and converting %v",
+		numControllers, changes.Added, changes.Converted)
+	return changes, nil
+}
+
+// parsePlacement parses a placement directive for a new controller. Existing
+// machines may be targeted, but not containers; any other directive is
+// passed on to the provider.
+func parsePlacement(directive string) (*instance.Placement, error) {
+	placement, err := instance.ParsePlacement(directive)
+	if errors.Is(err, instance.ErrPlacementScopeMissing) {
+		return &instance.Placement{Scope: instance.ModelScope, Directive: directive}, nil
+	} else if err != nil {
+		return nil, errors.NotValidf("placement directive %q", directive)
+	}
+	if placement.Scope != instance.MachineScope || names.IsContainerMachine(placement.Directive) {
+		return nil, errors.NotSupportedf("controller placement directive %q", directive)
+	}
+	return placement, nil
+}
+
+func machineNames(machines []coremachine.Name) []string {
+	if len(machines) == 0 {
+		return nil
+	}
+	names := make([]string, len(machines))
+	for i, m := range machines {
+		names[i] = m.String()
+	}
+	sort.Strings(names)
+	return names
 }
 
 // ControllerDetails is only available on V3 or later.
 func (api *HighAvailabilityAPIV2) ControllerDetails(_ struct{}) {}
 
 // ControllerDetails returns details about each controller node.
+func (api *HighAvailabilityAPIV3) ControllerDetails(
+	ctx context.Context,
+) (params.ControllerDetailsResults, error) {
+	results, err := api.HighAvailabilityAPI.ControllerDetails(ctx)
+	if err != nil {
+		return results, err
+	}
+	// The Dqlite membership of the controllers was added in V4, and only
+	// the controllers with API addresses were reported before that.
+	details := make([]params.ControllerDetails, 0, len(results.Results))
+	for _, d := range results.Results {
+		if len(d.APIAddresses) == 0 {
+			continue
+		}
+		details = append(details, params.ControllerDetails{
+			ControllerId: d.ControllerId,
+			APIAddresses: d.APIAddresses,
+		})
+	}
+	results.Results = details
+	return results, nil
+}
+
+// ControllerDetails returns details about each controller node, including
+// its membership of the Dqlite cluster.
 func (api *HighAvailabilityAPI) ControllerDetails(
 	ctx context.Context,
 ) (params.ControllerDetailsResults, error) {
@@ -62,26 +253,37 @@ func (api *HighAvailabilityAPI) ControllerDetails(
 	}
 
 	controllerAddresses, err := api.controllerNodeService.GetAPIAddressesByControllerIDForClients(ctx)
-	if errors.Is(err, controllernodeerrors.EmptyAPIAddresses) {
-		// If there are no API addresses, we return an empty result.
-		return results, nil
-	} else if err != nil {
+	if err != nil && !errors.Is(err, controllernodeerrors.EmptyAPIAddresses) {
+		return results, apiservererrors.ServerError(errors.Trace(err))
+	}
+	nodes, err := api.controllerNodeClusterService.GetControllerNodes(ctx)
+	if err != nil && !errors.Is(err, controllernodeerrors.EmptyControllerIDs) {
 		return results, apiservererrors.ServerError(errors.Trace(err))
 	}
 
-	details := make([]params.ControllerDetails, 0, len(controllerAddresses))
+	details := make(map[string]params.ControllerDetails, len(nodes))
 	for id, addresses := range controllerAddresses {
-		details = append(details, params.ControllerDetails{
+		details[id] = params.ControllerDetails{
 			ControllerId: id,
 			APIAddresses: addresses,
-		})
+		}
+	}
+	for _, node := range nodes {
+		d := details[node.ControllerID]
+		d.ControllerId = node.ControllerID
+		d.DqliteNodeID = node.DqliteNodeID
+		d.DqliteAddress = node.DqliteBindAddress
+		d.DqliteRole = node.Role.String()
+		details[node.ControllerID] = d
 	}
 
-	sort.Slice(details, func(i, j int) bool {
-		return details[i].ControllerId < details[j].ControllerId
+	results.Results = make([]params.ControllerDetails, 0, len(details))
+	for _, d := range details {
+		results.Results = append(results.Results, d)
+	}
+	sort.Slice(results.Results, func(i, j int) bool {
+		return results.Results[i].ControllerId < results.Results[j].ControllerId
 	})
 
-	results.Results = details
-
 	return results, nil
 }
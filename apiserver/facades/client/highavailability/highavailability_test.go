@@ -10,14 +10,25 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/instance"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/application"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
 type clientSuite struct {
-	authorizer            *MockAuthorizer
-	controllerNodeService *MockControllerNodeService
+	authorizer                   *MockAuthorizer
+	controllerNodeService        *MockControllerNodeService
+	controllerNodeClusterService *MockControllerNodeClusterService
+	applicationService           *MockApplicationService
 }
 
 func TestClientSuite(t *stdtesting.T) {
@@ -29,11 +40,7 @@ func (s *clientSuite) TestControllerDetailsPermissionDenied(c *tc.C) {
 
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.LoginAccess, gomock.Any()).Return(errors.New("boom"))
 
-	api := HighAvailabilityAPI{
-		controllerNodeService: s.controllerNodeService,
-		authorizer:            s.authorizer,
-	}
-	_, err := api.ControllerDetails(c.Context())
+	_, err := s.newAPI(c).ControllerDetails(c.Context())
 	c.Assert(err, tc.DeepEquals, &params.Error{Message: "permission denied", Code: "unauthorized access"})
 }
 
@@ -42,12 +49,9 @@ func (s *clientSuite) TestControllerDetailsEmpty(c *tc.C) {
 
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.LoginAccess, gomock.Any()).Return(nil)
 	s.controllerNodeService.EXPECT().GetAPIAddressesByControllerIDForClients(gomock.Any()).Return(map[string][]string{}, controllernodeerrors.EmptyAPIAddresses)
+	s.controllerNodeClusterService.EXPECT().GetControllerNodes(gomock.Any()).Return(nil, controllernodeerrors.EmptyControllerIDs)
 
-	api := HighAvailabilityAPI{
-		controllerNodeService: s.controllerNodeService,
-		authorizer:            s.authorizer,
-	}
-	results, err := api.ControllerDetails(c.Context())
+	results, err := s.newAPI(c).ControllerDetails(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(results.Results, tc.HasLen, 0)
 }
@@ -55,20 +59,35 @@ func (s *clientSuite) TestControllerDetailsEmpty(c *tc.C) {
 func (s *clientSuite) TestControllerDetails(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
-	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.LoginAccess, gomock.Any()).Return(nil)
-	s.controllerNodeService.EXPECT().GetAPIAddressesByControllerIDForClients(gomock.Any()).Return(map[string][]string{
-		"0": {"10.0.0.1:17070"},
-		"1": {"10.0.0.43:17070", "10.0.0.7:17070"},
-	}, nil)
+	s.expectControllerDetails()
 
-	api := HighAvailabilityAPI{
-		controllerNodeService: s.controllerNodeService,
-		authorizer:            s.authorizer,
-	}
-	results, err := api.ControllerDetails(c.Context())
+	results, err := s.newAPI(c).ControllerDetails(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
-	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results, tc.DeepEquals, []params.ControllerDetails{{
+		ControllerId:  "0",
+		APIAddresses:  []string{"10.0.0.1:17070"},
+		DqliteNodeID:  1,
+		DqliteAddress: "10.0.0.1",
+		DqliteRole:    "voter",
+	}, {
+		ControllerId:  "1",
+		APIAddresses:  []string{"10.0.0.43:17070", "10.0.0.7:17070"},
+		DqliteNodeID:  2,
+		DqliteAddress: "10.0.0.43",
+		DqliteRole:    "standby",
+	}, {
+		ControllerId: "2",
+	}})
+}
+
+func (s *clientSuite) TestControllerDetailsV3(c *tc.C) {
+	defer s.setupMocks(c).Finish()
 
+	s.expectControllerDetails()
+
+	api := HighAvailabilityAPIV3{HighAvailabilityAPI: *s.newAPI(c)}
+	results, err := api.ControllerDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
 	c.Check(results.Results, tc.DeepEquals, []params.ControllerDetails{{
 		ControllerId: "0",
 		APIAddresses: []string{"10.0.0.1:17070"},
@@ -78,15 +97,194 @@ func (s *clientSuite) TestControllerDetails(c *tc.C) {
 	}})
 }
 
+func (s *clientSuite) TestEnableHAPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(errors.New("boom"))
+
+	_, err := s.newAPI(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}},
+	})
+	c.Assert(err, tc.DeepEquals, &params.Error{Message: "permission denied", Code: "unauthorized access"})
+}
+
+func (s *clientSuite) TestEnableHANotControllerModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+
+	api := s.newAPI(c)
+	api.isControllerModel = false
+	_, err := api.EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}},
+	})
+	c.Assert(err, tc.ErrorMatches, "enabling HA outside of the controller model not supported")
+}
+
+func (s *clientSuite) TestEnableHADefault(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetMachinesForApplication(gomock.Any(), coreapplication.ControllerApplicationName).
+		Return([]coremachine.Name{"0"}, nil)
+	s.applicationService.EXPECT().AddIAASUnits(gomock.Any(), coreapplication.ControllerApplicationName,
+		applicationservice.AddIAASUnitArg{}, applicationservice.AddIAASUnitArg{},
+	).Return(nil, []coremachine.Name{"1", "2"}, nil)
+
+	results, err := s.newAPI(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Result, tc.DeepEquals, params.ControllersChanges{
+		Maintained: []string{"0"},
+		Added:      []string{"1", "2"},
+	})
+}
+
+func (s *clientSuite) TestEnableHAPlacementAndConstraints(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cons := constraints.MustParse("mem=8G")
+	appUUID := coreapplication.UUID("controller-app-uuid")
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetMachinesForApplication(gomock.Any(), coreapplication.ControllerApplicationName).
+		Return([]coremachine.Name{"2", "0", "1"}, nil)
+	s.applicationService.EXPECT().GetApplicationDetailsByName(gomock.Any(), coreapplication.ControllerApplicationName).
+		Return(application.ApplicationDetails{UUID: appUUID}, nil)
+	s.applicationService.EXPECT().SetApplicationConstraints(gomock.Any(), appUUID, cons).Return(nil)
+	s.applicationService.EXPECT().AddIAASUnits(gomock.Any(), coreapplication.ControllerApplicationName,
+		applicationservice.AddIAASUnitArg{AddUnitArg: applicationservice.AddUnitArg{
+			Placement: &instance.Placement{Scope: instance.MachineScope, Directive: "5"},
+		}},
+		applicationservice.AddIAASUnitArg{AddUnitArg: applicationservice.AddUnitArg{
+			Placement: &instance.Placement{Scope: instance.ModelScope, Directive: "zone=us-east-1a"},
+		}},
+	).Return(nil, []coremachine.Name{"5", "6"}, nil)
+
+	results, err := s.newAPI(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{
+			NumControllers: 5,
+			Constraints:    cons,
+			Placement:      []string{"5", "zone=us-east-1a"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Result, tc.DeepEquals, params.ControllersChanges{
+		Maintained: []string{"0", "1", "2"},
+		Converted:  []string{"5"},
+		Added:      []string{"6"},
+	})
+}
+
+func (s *clientSuite) TestEnableHANothingToDo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+	s.applicationService.EXPECT().GetMachinesForApplication(gomock.Any(), coreapplication.ControllerApplicationName).
+		Return([]coremachine.Name{"0", "1", "2"}, nil)
+
+	results, err := s.newAPI(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Result, tc.DeepEquals, params.ControllersChanges{
+		Maintained: []string{"0", "1", "2"},
+	})
+}
+
+func (s *clientSuite) TestEnableHAInvalidSpecs(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil).AnyTimes()
+	s.applicationService.EXPECT().GetMachinesForApplication(gomock.Any(), coreapplication.ControllerApplicationName).
+		Return([]coremachine.Name{"0", "1", "2"}, nil).AnyTimes()
+
+	for _, test := range []struct {
+		spec     params.ControllersSpec
+		expected string
+	}{{
+		spec:     params.ControllersSpec{NumControllers: 4},
+		expected: "number of controllers 4, must be odd and non-negative not valid",
+	}, {
+		spec:     params.ControllersSpec{NumControllers: -1},
+		expected: "number of controllers -1, must be odd and non-negative not valid",
+	}, {
+		spec:     params.ControllersSpec{NumControllers: 1},
+		expected: "removing controllers with enable-ha, remove the controller machines instead not supported",
+	}, {
+		spec:     params.ControllersSpec{NumControllers: 5, Placement: []string{"3", "4", "5"}},
+		expected: "3 placement directives for 2 new controllers not valid",
+	}, {
+		spec:     params.ControllersSpec{NumControllers: 5, Placement: []string{"lxd:3"}},
+		expected: `controller placement directive "lxd:3" not supported`,
+	}, {
+		spec:     params.ControllersSpec{NumControllers: 5, Placement: []string{"3/lxd/0"}},
+		expected: `controller placement directive "3/lxd/0" not supported`,
+	}} {
+		c.Logf("spec %+v", test.spec)
+		results, err := s.newAPI(c).EnableHA(c.Context(), params.ControllersSpecs{
+			Specs: []params.ControllersSpec{test.spec},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(results.Results, tc.HasLen, 1)
+		c.Check(results.Results[0].Error, tc.ErrorMatches, test.expected)
+	}
+}
+
+func (s *clientSuite) TestEnableHAMultipleSpecs(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+
+	_, err := s.newAPI(c).EnableHA(c.Context(), params.ControllersSpecs{
+		Specs: []params.ControllersSpec{{NumControllers: 3}, {NumControllers: 5}},
+	})
+	c.Assert(err, tc.ErrorMatches, "more than one controller spec not valid")
+}
+
+func (s *clientSuite) expectControllerDetails() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.LoginAccess, gomock.Any()).Return(nil)
+	s.controllerNodeService.EXPECT().GetAPIAddressesByControllerIDForClients(gomock.Any()).Return(map[string][]string{
+		"0": {"10.0.0.1:17070"},
+		"1": {"10.0.0.43:17070", "10.0.0.7:17070"},
+	}, nil)
+	s.controllerNodeClusterService.EXPECT().GetControllerNodes(gomock.Any()).Return([]controllernode.ControllerNode{
+		{ControllerID: "0", DqliteNodeID: 1, DqliteBindAddress: "10.0.0.1", Role: database.Voter},
+		{ControllerID: "1", DqliteNodeID: 2, DqliteBindAddress: "10.0.0.43", Role: database.Standby},
+		{ControllerID: "2"},
+	}, nil)
+}
+
+func (s *clientSuite) newAPI(c *tc.C) *HighAvailabilityAPI {
+	return &HighAvailabilityAPI{
+		isControllerModel:            true,
+		controllerNodeService:        s.controllerNodeService,
+		controllerNodeClusterService: s.controllerNodeClusterService,
+		applicationService:           s.applicationService,
+		authorizer:                   s.authorizer,
+		logger:                       loggertesting.WrapCheckLog(c),
+	}
+}
+
 func (s *clientSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.controllerNodeService = NewMockControllerNodeService(ctrl)
+	s.controllerNodeClusterService = NewMockControllerNodeClusterService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
 	s.authorizer = NewMockAuthorizer(ctrl)
 
 	c.Cleanup(func() {
 		s.authorizer = nil
 		s.controllerNodeService = nil
+		s.controllerNodeClusterService = nil
+		s.applicationService = nil
 	})
 
 	return ctrl
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/highavailability (interfaces: ControllerNodeService)
+// Source: github.com/juju/juju/apiserver/facades/client/highavailability (interfaces: ControllerNodeService,ControllerNodeClusterService,ApplicationService)
 //
 // Generated by this command:
 //
-//	mockgen -package highavailability -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/highavailability ControllerNodeService
+//	mockgen -package highavailability -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/highavailability ControllerNodeService,ControllerNodeClusterService,ApplicationService
 //
 
 // Package highavailability is a generated GoMock package.
@@ -13,6 +13,13 @@ import (
 	context "context"
 
 	gomock "github.com/canonical/gomock/gomock"
+	application "github.com/juju/juju/core/application"
+	constraints "github.com/juju/juju/core/constraints"
+	machine "github.com/juju/juju/core/machine"
+	unit "github.com/juju/juju/core/unit"
+	application0 "github.com/juju/juju/domain/application"
+	service "github.com/juju/juju/domain/application/service"
+	controllernode "github.com/juju/juju/domain/controllernode"
 )
 
 // MockControllerNodeService is a mock of ControllerNodeService interface.
@@ -57,3 +64,147 @@ func (mr *MockControllerNodeServiceMockRecorder) GetAPIAddressesByControllerIDFo
 
 // MockControllerNodeServiceGetAPIAddressesByControllerIDForClientsCall is the typed call wrapper for GetAPIAddressesByControllerIDForClients.
 type MockControllerNodeServiceGetAPIAddressesByControllerIDForClientsCall = gomock.Call1_2[context.Context, map[string][]string, error]
+
+// MockControllerNodeClusterService is a mock of ControllerNodeClusterService interface.
+type MockControllerNodeClusterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerNodeClusterServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockControllerNodeClusterServiceMockRecorder is the mock recorder for MockControllerNodeClusterService.
+type MockControllerNodeClusterServiceMockRecorder struct {
+	mock                      *MockControllerNodeClusterService
+	getControllerNodesExpects []*gomock.Call1_2[context.Context, []controllernode.ControllerNode, error]
+}
+
+// NewMockControllerNodeClusterService creates a new mock instance.
+func NewMockControllerNodeClusterService(ctrl *gomock.Controller) *MockControllerNodeClusterService {
+	mock := &MockControllerNodeClusterService{ctrl: ctrl}
+	mock.recorder = &MockControllerNodeClusterServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerNodeClusterService) EXPECT() *MockControllerNodeClusterServiceMockRecorder {
+	return m.recorder
+}
+
+// GetControllerNodes mocks base method.
+func (m *MockControllerNodeClusterService) GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerNodesExpects, m.ctrl, m, "GetControllerNodes", ctx)
+}
+
+// GetControllerNodes indicates an expected call of GetControllerNodes.
+func (mr *MockControllerNodeClusterServiceMockRecorder) GetControllerNodes(ctx any) *MockControllerNodeClusterServiceGetControllerNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []controllernode.ControllerNode, error](mr.mock.ctrl.T, mr.mock, "GetControllerNodes", gomock.EnsureMatcher(ctx))
+	mr.getControllerNodesExpects = append(mr.getControllerNodesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerNodeClusterServiceGetControllerNodesCall is the typed call wrapper for GetControllerNodes.
+type MockControllerNodeClusterServiceGetControllerNodesCall = gomock.Call1_2[context.Context, []controllernode.ControllerNode, error]
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock                               *MockApplicationService
+	addIAASUnitsExpects                []*gomock.Call2V_3[context.Context, string, service.AddIAASUnitArg, []unit.Name, []machine.Name, error]
+	getApplicationDetailsByNameExpects []*gomock.Call2_2[context.Context, string, application0.ApplicationDetails, error]
+	getMachinesForApplicationExpects   []*gomock.Call2_2[context.Context, string, []machine.Name, error]
+	setApplicationConstraintsExpects   []*gomock.Call3_1[context.Context, application.UUID, constraints.Value, error]
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// AddIAASUnits mocks base method.
+func (m *MockApplicationService) AddIAASUnits(ctx context.Context, appName string, units ...service.AddIAASUnitArg) ([]unit.Name, []machine.Name, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2V_3(&m.recorder.addIAASUnitsExpects, m.ctrl, m, "AddIAASUnits", ctx, appName, units...)
+}
+
+// AddIAASUnits indicates an expected call of AddIAASUnits.
+func (mr *MockApplicationServiceMockRecorder) AddIAASUnits(ctx, appName any, units ...any) *MockApplicationServiceAddIAASUnitsCall {
+	mr.mock.ctrl.T.Helper()
+	varArgs := gomock.EnsureVariadicMatcher(units)
+	call := gomock.NewCall2V_3[context.Context, string, service.AddIAASUnitArg, []unit.Name, []machine.Name, error](mr.mock.ctrl.T, mr.mock, "AddIAASUnits", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appName), varArgs)
+	mr.addIAASUnitsExpects = append(mr.addIAASUnitsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceAddIAASUnitsCall is the typed call wrapper for AddIAASUnits.
+type MockApplicationServiceAddIAASUnitsCall = gomock.Call2V_3[context.Context, string, service.AddIAASUnitArg, []unit.Name, []machine.Name, error]
+
+// GetApplicationDetailsByName mocks base method.
+func (m *MockApplicationService) GetApplicationDetailsByName(ctx context.Context, name string) (application0.ApplicationDetails, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationDetailsByNameExpects, m.ctrl, m, "GetApplicationDetailsByName", ctx, name)
+}
+
+// GetApplicationDetailsByName indicates an expected call of GetApplicationDetailsByName.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationDetailsByName(ctx, name any) *MockApplicationServiceGetApplicationDetailsByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, application0.ApplicationDetails, error](mr.mock.ctrl.T, mr.mock, "GetApplicationDetailsByName", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getApplicationDetailsByNameExpects = append(mr.getApplicationDetailsByNameExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetApplicationDetailsByNameCall is the typed call wrapper for GetApplicationDetailsByName.
+type MockApplicationServiceGetApplicationDetailsByNameCall = gomock.Call2_2[context.Context, string, application0.ApplicationDetails, error]
+
+// GetMachinesForApplication mocks base method.
+func (m *MockApplicationService) GetMachinesForApplication(ctx context.Context, appName string) ([]machine.Name, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getMachinesForApplicationExpects, m.ctrl, m, "GetMachinesForApplication", ctx, appName)
+}
+
+// GetMachinesForApplication indicates an expected call of GetMachinesForApplication.
+func (mr *MockApplicationServiceMockRecorder) GetMachinesForApplication(ctx, appName any) *MockApplicationServiceGetMachinesForApplicationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, []machine.Name, error](mr.mock.ctrl.T, mr.mock, "GetMachinesForApplication", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appName))
+	mr.getMachinesForApplicationExpects = append(mr.getMachinesForApplicationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceGetMachinesForApplicationCall is the typed call wrapper for GetMachinesForApplication.
+type MockApplicationServiceGetMachinesForApplicationCall = gomock.Call2_2[context.Context, string, []machine.Name, error]
+
+// SetApplicationConstraints mocks base method.
+func (m *MockApplicationService) SetApplicationConstraints(ctx context.Context, appID application.UUID, cons constraints.Value) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.setApplicationConstraintsExpects, m.ctrl, m, "SetApplicationConstraints", ctx, appID, cons)
+}
+
+// SetApplicationConstraints indicates an expected call of SetApplicationConstraints.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationConstraints(ctx, appID, cons any) *MockApplicationServiceSetApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, application.UUID, constraints.Value, error](mr.mock.ctrl.T, mr.mock, "SetApplicationConstraints", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appID), gomock.EnsureMatcher(cons))
+	mr.setApplicationConstraintsExpects = append(mr.setApplicationConstraintsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceSetApplicationConstraintsCall is the typed call wrapper for SetApplicationConstraints.
+type MockApplicationServiceSetApplicationConstraintsCall = gomock.Call3_1[context.Context, application.UUID, constraints.Value, error]
@@ -28,6 +28,7 @@ import (
 	coreuser "github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/access"
 	accesserrors "github.com/juju/juju/domain/access/errors"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	clouderrors "github.com/juju/juju/domain/cloud/errors"
 	credentialerrors "github.com/juju/juju/domain/credential/errors"
 	"github.com/juju/juju/domain/model"
@@ -51,6 +52,16 @@ type ModelStatusAPI interface {
 
 // ModelManagerAPIV10 implements the model manager V10.
 type ModelManagerAPIV10 struct {
+	*ModelManagerAPIV11
+}
+
+// ModelManagerAPIV11 implements the model manager V11.
+type ModelManagerAPIV11 struct {
+	*ModelManagerAPIV12
+}
+
+// ModelManagerAPIV12 implements the model manager V12.
+type ModelManagerAPIV12 struct {
 	*ModelManagerAPI
 }
 
@@ -1104,8 +1115,9 @@ func (m *ModelManagerAPI) ModifyModelAccess(
 				},
 				Access: modelAccess,
 			},
-			Change:  permission.AccessChange(arg.Action),
-			Subject: coreuser.NameFromTag(targetUserTag),
+			Change:    permission.AccessChange(arg.Action),
+			Subject:   coreuser.NameFromTag(targetUserTag),
+			ExpiresAt: arg.Expiry,
 		})
 
 		result.Results[i].Error = apiservererrors.ServerError(err)
@@ -1113,6 +1125,86 @@ func (m *ModelManagerAPI) ModifyModelAccess(
 	return result, nil
 }
 
+// ModifyApplicationAccess changes the access granted to users on individual
+// applications. Only model admins and controller superusers may change the
+// access to the applications of a model.
+func (m *ModelManagerAPI) ModifyApplicationAccess(
+	ctx context.Context, args params.ModifyApplicationAccessRequest,
+) (result params.ErrorResults, _ error) {
+	result = params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Changes)),
+	}
+
+	err := m.authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(m.controllerUUID.String()))
+	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return result, errors.Trace(err)
+	}
+	canModifyController := err == nil
+
+	for i, arg := range args.Changes {
+		modelTag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(errors.Annotate(err, "could not modify application access"))
+			continue
+		}
+		err = m.authorizer.HasPermission(ctx, permission.AdminAccess, modelTag)
+		if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+			return result, errors.Trace(err)
+		}
+		if err != nil && !canModifyController {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		if !names.IsValidApplication(arg.ApplicationName) {
+			result.Results[i].Error = apiservererrors.ServerError(errors.NotValidf("application name %q", arg.ApplicationName))
+			continue
+		}
+		targetUserTag, err := names.ParseUserTag(arg.UserTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(errors.Annotate(err, "could not modify application access"))
+			continue
+		}
+		appUUID, err := m.applicationUUID(ctx, coremodel.UUID(modelTag.Id()), arg.ApplicationName)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = m.accessService.UpdatePermission(ctx, access.UpdatePermissionArgs{
+			AccessSpec: permission.AccessSpec{
+				Target: permission.ApplicationAccessID(modelTag.Id(), appUUID, permission.Access(arg.Access)),
+				Access: permission.Access(arg.Access),
+			},
+			Change:    permission.AccessChange(arg.Action),
+			Subject:   coreuser.NameFromTag(targetUserTag),
+			ExpiresAt: arg.Expiry,
+		})
+
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// applicationUUID returns the UUID of the named application in the model.
+// Access is granted on the application UUID, so that it isn't inherited by a
+// later application with the same name.
+func (m *ModelManagerAPI) applicationUUID(ctx context.Context, modelUUID coremodel.UUID, appName string) (string, error) {
+	modelDomainServices, err := m.domainServicesGetter.DomainServicesForModel(ctx, modelUUID)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	app, err := modelDomainServices.Application().GetApplicationDetailsByName(ctx, appName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return "", errors.NotFoundf("application %q", appName)
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	return app.UUID.String(), nil
+}
+
+// ModifyApplicationAccess isn't on the v11 API.
+func (*ModelManagerAPIV11) ModifyApplicationAccess(_, _ struct{}) {}
+
 // ModelDefaultsForClouds returns the default config values for the specified
 // clouds.
 func (m *ModelManagerAPI) ModelDefaultsForClouds(ctx context.Context, args params.Entities) (params.ModelDefaultsResults, error) {
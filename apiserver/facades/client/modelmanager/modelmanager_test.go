@@ -22,6 +22,7 @@ import (
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/cloud"
 	coreagentbinary "github.com/juju/juju/core/agentbinary"
+	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/credential"
 	coredatabase "github.com/juju/juju/core/database"
@@ -35,6 +36,8 @@ import (
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/domain/access"
 	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/blockcommand"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
 	domainexport "github.com/juju/juju/domain/export"
@@ -750,6 +753,115 @@ func (s *modelManagerSuite) TestUpdatedModel(c *tc.C) {
 	c.Check(results.OneError(), tc.ErrorIsNil)
 }
 
+func (s *modelManagerSuite) TestModifyApplicationAccess(c *tc.C) {
+	ctrl := s.setUpAPIWithUser(c, jujutesting.AdminUser)
+	defer ctrl.Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+	testUser := names.NewUserTag("foobar")
+	appUUID := coreapplication.UUID("f00dfeed-0bad-400d-8000-4b1d0d06f00d")
+	appService := NewMockModelApplicationService(ctrl)
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any(), modelUUID).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().Application().Return(appService)
+	appService.EXPECT().GetApplicationDetailsByName(gomock.Any(), "mysql").Return(application.ApplicationDetails{
+		UUID: appUUID,
+		Name: "mysql",
+	}, nil)
+	s.accessService.EXPECT().UpdatePermission(gomock.Any(), access.UpdatePermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ApplicationOperationID(modelUUID.String(), appUUID.String(), permission.RunActionAccess),
+			Access: permission.RunActionAccess,
+		},
+		Change:  permission.Grant,
+		Subject: coreuser.NameFromTag(testUser),
+	}).Return(nil)
+
+	results, err := s.api.ModifyApplicationAccess(c.Context(), params.ModifyApplicationAccessRequest{
+		Changes: []params.ModifyApplicationAccess{{
+			UserTag:         testUser.String(),
+			Action:          params.GrantModelAccess,
+			Access:          params.ApplicationRunActionAccess,
+			ModelTag:        modelTag.String(),
+			ApplicationName: "mysql",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results.Results, tc.HasLen, 1)
+	c.Check(results.OneError(), tc.ErrorIsNil)
+}
+
+func (s *modelManagerSuite) TestModifyApplicationAccessApplicationNotFound(c *tc.C) {
+	ctrl := s.setUpAPIWithUser(c, jujutesting.AdminUser)
+	defer ctrl.Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+	appService := NewMockModelApplicationService(ctrl)
+	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any(), modelUUID).Return(s.domainServices, nil)
+	s.domainServices.EXPECT().Application().Return(appService)
+	appService.EXPECT().GetApplicationDetailsByName(gomock.Any(), "mysql").Return(application.ApplicationDetails{}, applicationerrors.ApplicationNotFound)
+
+	results, err := s.api.ModifyApplicationAccess(c.Context(), params.ModifyApplicationAccessRequest{
+		Changes: []params.ModifyApplicationAccess{{
+			UserTag:         names.NewUserTag("foobar").String(),
+			Action:          params.GrantModelAccess,
+			Access:          params.ApplicationRunActionAccess,
+			ModelTag:        modelTag.String(),
+			ApplicationName: "mysql",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results.OneError(), tc.ErrorMatches, `application "mysql" not found`)
+}
+
+func (s *modelManagerSuite) TestModifyModelAccessWithExpiry(c *tc.C) {
+	defer s.setUpAPIWithUser(c, jujutesting.AdminUser).Finish()
+
+	modelUUID, modelTag := generateModelUUIDAndTag(c)
+	testUser := names.NewUserTag("foobar")
+	expiry := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.accessService.EXPECT().UpdatePermission(gomock.Any(), access.UpdatePermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ID{
+				ObjectType: permission.Model,
+				Key:        modelUUID.String(),
+			},
+			Access: permission.AdminAccess,
+		},
+		Change:    permission.Grant,
+		Subject:   coreuser.NameFromTag(testUser),
+		ExpiresAt: &expiry,
+	}).Return(nil)
+
+	results, err := s.api.ModifyModelAccess(c.Context(), params.ModifyModelAccessRequest{
+		Changes: []params.ModifyModelAccess{{
+			UserTag:  testUser.String(),
+			Action:   params.GrantModelAccess,
+			Access:   params.ModelAdminAccess,
+			ModelTag: modelTag.String(),
+			Expiry:   &expiry,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results.OneError(), tc.ErrorIsNil)
+}
+
+func (s *modelManagerSuite) TestModifyApplicationAccessInvalidApplication(c *tc.C) {
+	defer s.setUpAPIWithUser(c, jujutesting.AdminUser).Finish()
+
+	_, modelTag := generateModelUUIDAndTag(c)
+	results, err := s.api.ModifyApplicationAccess(c.Context(), params.ModifyApplicationAccessRequest{
+		Changes: []params.ModifyApplicationAccess{{
+			UserTag:         names.NewUserTag("foobar").String(),
+			Action:          params.GrantModelAccess,
+			Access:          params.ApplicationRunActionAccess,
+			ModelTag:        modelTag.String(),
+			ApplicationName: "Not_Valid",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results.OneError(), tc.ErrorMatches, `application name "Not_Valid" not valid`)
+}
+
 func (s *modelManagerSuite) TestModelStatus(c *tc.C) {
 	defer s.setUpAPI(c).Finish()
 
@@ -1231,6 +1343,23 @@ func (s *modelManagerStateSuite) TestModifyModelAccessFailedPermissionDenied(c *
 	c.Assert(result.OneError(), tc.ErrorMatches, `permission denied`)
 }
 
+func (s *modelManagerStateSuite) TestModifyApplicationAccessFailedPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	userTag := names.NewUserTag("non-admin@remote")
+	s.setAPIUser(c, userTag)
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	modelTag := names.NewModelTag(modelUUID.String())
+
+	args := params.ModifyApplicationAccessRequest{Changes: []params.ModifyApplicationAccess{
+		{ModelTag: modelTag.String(), ApplicationName: "mysql"},
+	}}
+
+	result, err := s.modelmanager.ModifyApplicationAccess(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.OneError(), tc.ErrorMatches, `permission denied`)
+}
+
 type fakeProvider struct {
 	environs.CloudEnvironProvider
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,ModelApplicationService)
 //
 // Generated by this command:
 //
-//	mockgen -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService
+//	mockgen -package modelmanager_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,StatusService,ModelApplicationService
 //
 
 // Package modelmanager_test is a generated GoMock package.
@@ -27,6 +27,7 @@ import (
 	user "github.com/juju/juju/core/user"
 	watcher "github.com/juju/juju/core/watcher"
 	access "github.com/juju/juju/domain/access"
+	application "github.com/juju/juju/domain/application"
 	model0 "github.com/juju/juju/domain/model"
 	modeldefaults "github.com/juju/juju/domain/modeldefaults"
 	service "github.com/juju/juju/domain/secretbackend/service"
@@ -868,6 +869,7 @@ type MockModelDomainServices struct {
 type MockModelDomainServicesMockRecorder struct {
 	mock                *MockModelDomainServices
 	agentExpects        []*gomock.Call0_1[modelmanager.ModelAgentService]
+	applicationExpects  []*gomock.Call0_1[modelmanager.ModelApplicationService]
 	blockCommandExpects []*gomock.Call0_1[modelmanager.BlockCommandService]
 	configExpects       []*gomock.Call0_1[modelmanager.ModelConfigService]
 	exportExpects       []*gomock.Call0_1[modelmanager.ExportService]
@@ -908,6 +910,24 @@ func (mr *MockModelDomainServicesMockRecorder) Agent() *MockModelDomainServicesA
 // MockModelDomainServicesAgentCall is the typed call wrapper for Agent.
 type MockModelDomainServicesAgentCall = gomock.Call0_1[modelmanager.ModelAgentService]
 
+// Application mocks base method.
+func (m *MockModelDomainServices) Application() modelmanager.ModelApplicationService {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.applicationExpects, m.ctrl, m, "Application")
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockModelDomainServicesMockRecorder) Application() *MockModelDomainServicesApplicationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[modelmanager.ModelApplicationService](mr.mock.ctrl.T, mr.mock, "Application")
+	mr.applicationExpects = append(mr.applicationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelDomainServicesApplicationCall is the typed call wrapper for Application.
+type MockModelDomainServicesApplicationCall = gomock.Call0_1[modelmanager.ModelApplicationService]
+
 // BlockCommand mocks base method.
 func (m *MockModelDomainServices) BlockCommand() modelmanager.BlockCommandService {
 	m.ctrl.T.Helper()
@@ -1370,3 +1390,46 @@ func (mr *MockStatusServiceMockRecorder) GetModelStatusInfo(ctx any) *MockStatus
 
 // MockStatusServiceGetModelStatusInfoCall is the typed call wrapper for GetModelStatusInfo.
 type MockStatusServiceGetModelStatusInfoCall = gomock.Call1_2[context.Context, status0.ModelStatusInfo, error]
+
+// MockModelApplicationService is a mock of ModelApplicationService interface.
+type MockModelApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelApplicationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelApplicationServiceMockRecorder is the mock recorder for MockModelApplicationService.
+type MockModelApplicationServiceMockRecorder struct {
+	mock                               *MockModelApplicationService
+	getApplicationDetailsByNameExpects []*gomock.Call2_2[context.Context, string, application.ApplicationDetails, error]
+}
+
+// NewMockModelApplicationService creates a new mock instance.
+func NewMockModelApplicationService(ctrl *gomock.Controller) *MockModelApplicationService {
+	mock := &MockModelApplicationService{ctrl: ctrl}
+	mock.recorder = &MockModelApplicationServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelApplicationService) EXPECT() *MockModelApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationDetailsByName mocks base method.
+func (m *MockModelApplicationService) GetApplicationDetailsByName(ctx context.Context, name string) (application.ApplicationDetails, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getApplicationDetailsByNameExpects, m.ctrl, m, "GetApplicationDetailsByName", ctx, name)
+}
+
+// GetApplicationDetailsByName indicates an expected call of GetApplicationDetailsByName.
+func (mr *MockModelApplicationServiceMockRecorder) GetApplicationDetailsByName(ctx, name any) *MockModelApplicationServiceGetApplicationDetailsByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, application.ApplicationDetails, error](mr.mock.ctrl.T, mr.mock, "GetApplicationDetailsByName", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getApplicationDetailsByNameExpects = append(mr.getApplicationDetailsByNameExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelApplicationServiceGetApplicationDetailsByNameCall is the typed call wrapper for GetApplicationDetailsByName.
+type MockModelApplicationServiceGetApplicationDetailsByNameCall = gomock.Call2_2[context.Context, string, application.ApplicationDetails, error]
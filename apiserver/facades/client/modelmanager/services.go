@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/access"
+	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/blockcommand"
 	domainexport "github.com/juju/juju/domain/export"
 	"github.com/juju/juju/domain/model"
@@ -70,6 +71,9 @@ type ModelDomainServices interface {
 
 	// Removal returns the removal service.
 	Removal() RemovalService
+
+	// Application returns the application service of the model.
+	Application() ModelApplicationService
 }
 
 // DomainServicesGetter is a factory for creating model services.
@@ -311,6 +315,14 @@ type ApplicationService interface {
 	GetSupportedFeatures(ctx context.Context) (assumes.FeatureSet, error)
 }
 
+// ModelApplicationService defines the application service methods used on
+// the applications of a model.
+type ModelApplicationService interface {
+	// GetApplicationDetailsByName returns the details of the named
+	// application, including its UUID.
+	GetApplicationDetailsByName(ctx context.Context, name string) (application.ApplicationDetails, error)
+}
+
 // RemovalService defines operations for removing juju entities.
 type RemovalService interface {
 	// RemoveModel checks if a model with the input name exists.
@@ -417,6 +429,10 @@ func (s domainServices) Export() ExportService {
 	return s.domainServices.Export()
 }
 
+func (s domainServices) Application() ModelApplicationService {
+	return s.domainServices.Application()
+}
+
 func (s domainServices) Removal() RemovalService {
 	return s.domainServices.Removal()
 }
@@ -16,6 +16,7 @@ import (
 	model "github.com/juju/juju/core/model"
 	permission "github.com/juju/juju/core/permission"
 	user "github.com/juju/juju/core/user"
+	access "github.com/juju/juju/domain/access"
 	service "github.com/juju/juju/domain/access/service"
 	auth "github.com/juju/juju/internal/auth"
 )
@@ -30,16 +31,21 @@ type MockAccessService struct {
 // MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
 type MockAccessServiceMockRecorder struct {
 	mock                                *MockAccessService
+	addGroupExpects                     []*gomock.Call3_1[context.Context, string, user.Name, error]
 	addUserExpects                      []*gomock.Call2_3[context.Context, service.AddUserArg, user.UUID, []byte, error]
+	addUserToGroupExpects               []*gomock.Call3_1[context.Context, string, user.Name, error]
 	disableUserAuthenticationExpects    []*gomock.Call2_1[context.Context, user.Name, error]
 	enableUserAuthenticationExpects     []*gomock.Call2_1[context.Context, user.Name, error]
 	getAllUsersExpects                  []*gomock.Call2_2[context.Context, bool, []user.User, error]
 	getUserExpects                      []*gomock.Call2_2[context.Context, user.UUID, user.User, error]
 	getUserByNameExpects                []*gomock.Call2_2[context.Context, user.Name, user.User, error]
+	readAllUserAccessForUserExpects     []*gomock.Call2_2[context.Context, user.Name, []permission.UserAccess, error]
 	readUserAccessLevelForTargetExpects []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
 	removeUserExpects                   []*gomock.Call2_1[context.Context, user.Name, error]
+	removeUserFromGroupExpects          []*gomock.Call3_1[context.Context, string, user.Name, error]
 	resetPasswordExpects                []*gomock.Call2_2[context.Context, user.Name, []byte, error]
 	setPasswordExpects                  []*gomock.Call3_1[context.Context, user.Name, auth.Password, error]
+	updateGroupPermissionExpects        []*gomock.Call2_1[context.Context, access.UpdateGroupPermissionArgs, error]
 }
 
 // NewMockAccessService creates a new mock instance.
@@ -54,6 +60,24 @@ func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
 	return m.recorder
 }
 
+// AddGroup mocks base method.
+func (m *MockAccessService) AddGroup(ctx context.Context, name string, creator user.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.addGroupExpects, m.ctrl, m, "AddGroup", ctx, name, creator)
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockAccessServiceMockRecorder) AddGroup(ctx, name, creator any) *MockAccessServiceAddGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, user.Name, error](mr.mock.ctrl.T, mr.mock, "AddGroup", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(creator))
+	mr.addGroupExpects = append(mr.addGroupExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceAddGroupCall is the typed call wrapper for AddGroup.
+type MockAccessServiceAddGroupCall = gomock.Call3_1[context.Context, string, user.Name, error]
+
 // AddUser mocks base method.
 func (m *MockAccessService) AddUser(ctx context.Context, arg service.AddUserArg) (user.UUID, []byte, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +96,24 @@ func (mr *MockAccessServiceMockRecorder) AddUser(ctx, arg any) *MockAccessServic
 // MockAccessServiceAddUserCall is the typed call wrapper for AddUser.
 type MockAccessServiceAddUserCall = gomock.Call2_3[context.Context, service.AddUserArg, user.UUID, []byte, error]
 
+// AddUserToGroup mocks base method.
+func (m *MockAccessService) AddUserToGroup(ctx context.Context, group string, name user.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.addUserToGroupExpects, m.ctrl, m, "AddUserToGroup", ctx, group, name)
+}
+
+// AddUserToGroup indicates an expected call of AddUserToGroup.
+func (mr *MockAccessServiceMockRecorder) AddUserToGroup(ctx, group, name any) *MockAccessServiceAddUserToGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, user.Name, error](mr.mock.ctrl.T, mr.mock, "AddUserToGroup", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(group), gomock.EnsureMatcher(name))
+	mr.addUserToGroupExpects = append(mr.addUserToGroupExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceAddUserToGroupCall is the typed call wrapper for AddUserToGroup.
+type MockAccessServiceAddUserToGroupCall = gomock.Call3_1[context.Context, string, user.Name, error]
+
 // DisableUserAuthentication mocks base method.
 func (m *MockAccessService) DisableUserAuthentication(ctx context.Context, name user.Name) error {
 	m.ctrl.T.Helper()
@@ -162,6 +204,24 @@ func (mr *MockAccessServiceMockRecorder) GetUserByName(ctx, name any) *MockAcces
 // MockAccessServiceGetUserByNameCall is the typed call wrapper for GetUserByName.
 type MockAccessServiceGetUserByNameCall = gomock.Call2_2[context.Context, user.Name, user.User, error]
 
+// ReadAllUserAccessForUser mocks base method.
+func (m *MockAccessService) ReadAllUserAccessForUser(ctx context.Context, subject user.Name) ([]permission.UserAccess, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.readAllUserAccessForUserExpects, m.ctrl, m, "ReadAllUserAccessForUser", ctx, subject)
+}
+
+// ReadAllUserAccessForUser indicates an expected call of ReadAllUserAccessForUser.
+func (mr *MockAccessServiceMockRecorder) ReadAllUserAccessForUser(ctx, subject any) *MockAccessServiceReadAllUserAccessForUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, user.Name, []permission.UserAccess, error](mr.mock.ctrl.T, mr.mock, "ReadAllUserAccessForUser", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(subject))
+	mr.readAllUserAccessForUserExpects = append(mr.readAllUserAccessForUserExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadAllUserAccessForUserCall is the typed call wrapper for ReadAllUserAccessForUser.
+type MockAccessServiceReadAllUserAccessForUserCall = gomock.Call2_2[context.Context, user.Name, []permission.UserAccess, error]
+
 // ReadUserAccessLevelForTarget mocks base method.
 func (m *MockAccessService) ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +258,24 @@ func (mr *MockAccessServiceMockRecorder) RemoveUser(ctx, name any) *MockAccessSe
 // MockAccessServiceRemoveUserCall is the typed call wrapper for RemoveUser.
 type MockAccessServiceRemoveUserCall = gomock.Call2_1[context.Context, user.Name, error]
 
+// RemoveUserFromGroup mocks base method.
+func (m *MockAccessService) RemoveUserFromGroup(ctx context.Context, group string, name user.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.removeUserFromGroupExpects, m.ctrl, m, "RemoveUserFromGroup", ctx, group, name)
+}
+
+// RemoveUserFromGroup indicates an expected call of RemoveUserFromGroup.
+func (mr *MockAccessServiceMockRecorder) RemoveUserFromGroup(ctx, group, name any) *MockAccessServiceRemoveUserFromGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, user.Name, error](mr.mock.ctrl.T, mr.mock, "RemoveUserFromGroup", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(group), gomock.EnsureMatcher(name))
+	mr.removeUserFromGroupExpects = append(mr.removeUserFromGroupExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceRemoveUserFromGroupCall is the typed call wrapper for RemoveUserFromGroup.
+type MockAccessServiceRemoveUserFromGroupCall = gomock.Call3_1[context.Context, string, user.Name, error]
+
 // ResetPassword mocks base method.
 func (m *MockAccessService) ResetPassword(ctx context.Context, name user.Name) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +312,24 @@ func (mr *MockAccessServiceMockRecorder) SetPassword(ctx, name, password any) *M
 // MockAccessServiceSetPasswordCall is the typed call wrapper for SetPassword.
 type MockAccessServiceSetPasswordCall = gomock.Call3_1[context.Context, user.Name, auth.Password, error]
 
+// UpdateGroupPermission mocks base method.
+func (m *MockAccessService) UpdateGroupPermission(ctx context.Context, args access.UpdateGroupPermissionArgs) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateGroupPermissionExpects, m.ctrl, m, "UpdateGroupPermission", ctx, args)
+}
+
+// UpdateGroupPermission indicates an expected call of UpdateGroupPermission.
+func (mr *MockAccessServiceMockRecorder) UpdateGroupPermission(ctx, args any) *MockAccessServiceUpdateGroupPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, access.UpdateGroupPermissionArgs, error](mr.mock.ctrl.T, mr.mock, "UpdateGroupPermission", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.updateGroupPermissionExpects = append(mr.updateGroupPermissionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceUpdateGroupPermissionCall is the typed call wrapper for UpdateGroupPermission.
+type MockAccessServiceUpdateGroupPermissionCall = gomock.Call2_1[context.Context, access.UpdateGroupPermissionArgs, error]
+
 // MockModelService is a mock of ModelService interface.
 type MockModelService struct {
 	ctrl     *gomock.Controller
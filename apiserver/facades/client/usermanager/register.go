@@ -21,10 +21,23 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("UserManager", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newUserManagerAPI(stdCtx, ctx) // Adds ModelUserInfo
+		return newUserManagerAPIV3(stdCtx, ctx) // Adds ModelUserInfo
+	}, reflect.TypeFor[*UserManagerAPIV3]())
+	// v4 adds AddGroup, AddToGroup, RemoveFromGroup and ModifyGroupAccess.
+	registry.MustRegister("UserManager", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUserManagerAPI(stdCtx, ctx)
 	}, reflect.TypeFor[*UserManagerAPI]())
 }
 
+// newUserManagerAPIV3 is used for API registration.
+func newUserManagerAPIV3(stdCtx context.Context, ctx facade.ModelContext) (*UserManagerAPIV3, error) {
+	api, err := newUserManagerAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &UserManagerAPIV3{UserManagerAPI: api}, nil
+}
+
 // newUserManagerAPI provides the signature required for facade registration.
 func newUserManagerAPI(stdCtx context.Context, ctx facade.ModelContext) (*UserManagerAPI, error) {
 	authorizer := ctx.Auth()
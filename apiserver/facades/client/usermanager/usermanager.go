@@ -5,6 +5,7 @@ package usermanager
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/securitylog"
 	coreuser "github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
 	accesserrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/domain/access/service"
 	"github.com/juju/juju/environs"
@@ -47,6 +49,25 @@ type AccessService interface {
 	// If the access level of a user cannot be found then
 	// accesserrors.AccessNotFound is returned.
 	ReadUserAccessLevelForTarget(ctx context.Context, subject coreuser.Name, target permission.ID) (permission.Access, error)
+
+	// ReadAllUserAccessForUser returns a slice of the user access the given
+	// user has for any access type.
+	ReadAllUserAccessForUser(ctx context.Context, subject coreuser.Name) ([]permission.UserAccess, error)
+
+	// AddGroup adds a new user group with the given name, created by the
+	// given user.
+	AddGroup(ctx context.Context, name string, creator coreuser.Name) error
+
+	// AddUserToGroup adds the user as a member of the named group.
+	AddUserToGroup(ctx context.Context, group string, name coreuser.Name) error
+
+	// RemoveUserFromGroup removes the user from the membership of the named
+	// group.
+	RemoveUserFromGroup(ctx context.Context, group string, name coreuser.Name) error
+
+	// UpdateGroupPermission grants or revokes the access of a user group on
+	// a target.
+	UpdateGroupPermission(ctx context.Context, args access.UpdateGroupPermissionArgs) error
 }
 
 // ModelService defines an interface for interacting with the model service.
@@ -71,6 +92,11 @@ type ModelService interface {
 	GetModelUser(ctx context.Context, modelUUID coremodel.UUID, name coreuser.Name) (coremodel.ModelUserInfo, error)
 }
 
+// UserManagerAPIV3 implements the user manager V3.
+type UserManagerAPIV3 struct {
+	*UserManagerAPI
+}
+
 // UserManagerAPI implements the user manager interface and is the concrete
 // implementation of the api end point.
 type UserManagerAPI struct {
@@ -411,12 +437,47 @@ func (api *UserManagerAPI) infoForUser(ctx context.Context, tag names.UserTag, u
 	if err != nil && !errors.Is(err, accesserrors.AccessNotFound) {
 		result.Result = nil
 		result.Error = apiservererrors.ServerError(err)
-	} else {
-		result.Result.Access = string(access)
+		return result
+	}
+	result.Result.Access = string(access)
+
+	timeLimited, err := api.timeLimitedAccessForUser(ctx, coreuser.NameFromTag(tag))
+	if err != nil {
+		result.Result = nil
+		result.Error = apiservererrors.ServerError(err)
+		return result
 	}
+	result.Result.TimeLimitedAccess = timeLimited
 	return result
 }
 
+// timeLimitedAccessForUser returns the grants held by the user which expire
+// automatically, ordered by expiry.
+func (api *UserManagerAPI) timeLimitedAccessForUser(ctx context.Context, name coreuser.Name) ([]params.TimeLimitedAccess, error) {
+	userAccess, err := api.accessService.ReadAllUserAccessForUser(ctx, name)
+	if errors.Is(err, accesserrors.PermissionNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []params.TimeLimitedAccess
+	for _, ua := range userAccess {
+		if ua.ExpiresAt == nil {
+			continue
+		}
+		result = append(result, params.TimeLimitedAccess{
+			ObjectType: string(ua.Object.ObjectType),
+			Key:        ua.Object.Key,
+			Access:     string(ua.Access),
+			Expiry:     *ua.ExpiresAt,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Expiry.Before(result[j].Expiry)
+	})
+	return result, nil
+}
+
 func (api *UserManagerAPI) checkCanRead(ctx context.Context, modelTag names.Tag) error {
 	return api.authorizer.HasPermission(ctx, permission.ReadAccess, modelTag)
 }
@@ -584,3 +645,142 @@ func (api *UserManagerAPI) isModelAdmin(ctx context.Context, modelTag names.Mode
 	}
 	return api.authorizer.HasPermission(ctx, permission.AdminAccess, modelTag) == nil
 }
+
+// AddGroup adds the named user groups. Only controller superusers may add
+// groups.
+func (api *UserManagerAPI) AddGroup(ctx context.Context, args params.AddGroups) (params.ErrorResults, error) {
+	var result params.ErrorResults
+
+	if _, err := api.hasControllerAdminAccess(ctx); err != nil {
+		return result, err
+	}
+
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Groups))
+	for i, arg := range args.Groups {
+		err := api.accessService.AddGroup(ctx, arg.Name, api.apiUser.Name)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(
+				errors.Annotatef(err, "creating group %q", arg.Name))
+		}
+	}
+	return result, nil
+}
+
+// AddToGroup adds users as members of groups. Only controller superusers may
+// change the membership of groups.
+func (api *UserManagerAPI) AddToGroup(ctx context.Context, args params.ModifyGroupMembershipRequest) (params.ErrorResults, error) {
+	return api.modifyGroupMembership(ctx, args, api.accessService.AddUserToGroup)
+}
+
+// RemoveFromGroup removes users from the membership of groups. Only
+// controller superusers may change the membership of groups.
+func (api *UserManagerAPI) RemoveFromGroup(ctx context.Context, args params.ModifyGroupMembershipRequest) (params.ErrorResults, error) {
+	return api.modifyGroupMembership(ctx, args, api.accessService.RemoveUserFromGroup)
+}
+
+func (api *UserManagerAPI) modifyGroupMembership(
+	ctx context.Context,
+	args params.ModifyGroupMembershipRequest,
+	modify func(context.Context, string, coreuser.Name) error,
+) (params.ErrorResults, error) {
+	var result params.ErrorResults
+
+	if _, err := api.hasControllerAdminAccess(ctx); err != nil {
+		return result, err
+	}
+
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Changes))
+	for i, arg := range args.Changes {
+		userTag, err := names.ParseUserTag(arg.UserTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if err := modify(ctx, arg.Group, coreuser.NameFromTag(userTag)); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return result, nil
+}
+
+// ModifyGroupAccess changes the access granted to groups on models and the
+// controller. Only controller superusers may change the access of groups.
+func (api *UserManagerAPI) ModifyGroupAccess(ctx context.Context, args params.ModifyGroupAccessRequest) (params.ErrorResults, error) {
+	var result params.ErrorResults
+
+	if _, err := api.hasControllerAdminAccess(ctx); err != nil {
+		return result, err
+	}
+
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+
+	result.Results = make([]params.ErrorResult, len(args.Changes))
+	for i, arg := range args.Changes {
+		err := api.modifyOneGroupAccess(ctx, arg)
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (api *UserManagerAPI) modifyOneGroupAccess(ctx context.Context, arg params.ModifyGroupAccess) error {
+	targetTag, err := names.ParseTag(arg.TargetTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	target, err := permission.ParseTagForID(targetTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if target.ObjectType != permission.Model && target.ObjectType != permission.Controller {
+		return errors.NotSupportedf("group access on %s", target.ObjectType)
+	}
+	if target.ObjectType == permission.Controller && target.Key != api.controllerUUID {
+		return errors.NotValidf("controller %q", target.Key)
+	}
+
+	accessLevel := permission.Access(arg.Access)
+	if err := target.ValidateAccess(accessLevel); err != nil {
+		return errors.Trace(err)
+	}
+
+	var change permission.AccessChange
+	switch arg.Action {
+	case params.GrantModelAccess:
+		change = permission.Grant
+	case params.RevokeModelAccess:
+		change = permission.Revoke
+	default:
+		return errors.NotValidf("action %q", arg.Action)
+	}
+
+	return api.accessService.UpdateGroupPermission(ctx, access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: target,
+			Access: accessLevel,
+		},
+		Change: change,
+		Group:  arg.Group,
+	})
+}
+
+// AddGroup isn't on the v3 API.
+func (*UserManagerAPIV3) AddGroup(_, _ struct{}) {}
+
+// AddToGroup isn't on the v3 API.
+func (*UserManagerAPIV3) AddToGroup(_, _ struct{}) {}
+
+// RemoveFromGroup isn't on the v3 API.
+func (*UserManagerAPIV3) RemoveFromGroup(_, _ struct{}) {}
+
+// ModifyGroupAccess isn't on the v3 API.
+func (*UserManagerAPIV3) ModifyGroupAccess(_, _ struct{}) {}
@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/permission"
 	coreuser "github.com/juju/juju/core/user"
 	coreusertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
 	usererrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/domain/access/service"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
@@ -326,6 +327,17 @@ func (s *userManagerSuite) TestUserInfo(c *tc.C) {
 		Key:        s.ControllerUUID,
 	}).Return(permission.SuperuserAccess, nil)
 
+	expiry := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	exp.ReadAllUserAccessForUser(gomock.Any(), coreusertesting.GenNewName(c, "foobar")).Return([]permission.UserAccess{{
+		Object: permission.ID{ObjectType: permission.Controller, Key: s.ControllerUUID},
+		Access: permission.LoginAccess,
+	}, {
+		Object:    permission.ID{ObjectType: permission.Model, Key: "deadbeef"},
+		Access:    permission.AdminAccess,
+		ExpiresAt: &expiry,
+	}}, nil)
+	exp.ReadAllUserAccessForUser(gomock.Any(), coreusertesting.GenNewName(c, "mary@external")).Return(nil, usererrors.PermissionNotFound)
+
 	args := params.UserInfoRequest{
 		Entities: []params.Entity{
 			{
@@ -353,6 +365,12 @@ func (s *userManagerSuite) TestUserInfo(c *tc.C) {
 	c.Check(r0.Username, tc.Equals, "foobar")
 	c.Check(r0.Disabled, tc.Equals, false)
 	c.Check(r0.Access, tc.Equals, string(permission.LoginAccess))
+	c.Check(r0.TimeLimitedAccess, tc.DeepEquals, []params.TimeLimitedAccess{{
+		ObjectType: "model",
+		Key:        "deadbeef",
+		Access:     "admin",
+		Expiry:     expiry,
+	}})
 
 	c.Assert(res[1].Error, tc.IsNil)
 	r1 := res[1].Result
@@ -420,6 +438,7 @@ func (s *userManagerSuite) TestUserInfoAll(c *tc.C) {
 		ObjectType: permission.Controller,
 		Key:        s.ControllerUUID,
 	}).Return(permission.LoginAccess, nil).Times(2)
+	s.accessService.EXPECT().ReadAllUserAccessForUser(gomock.Any(), coreusertesting.GenNewName(c, "fred")).Return(nil, nil).Times(2)
 
 	results, err := s.api.UserInfo(c.Context(), params.UserInfoRequest{})
 	c.Assert(err, tc.ErrorIsNil)
@@ -468,6 +487,7 @@ func (s *userManagerSuite) TestUserInfoNonControllerAdmin(c *tc.C) {
 		ObjectType: permission.Controller,
 		Key:        s.ControllerUUID,
 	}).Return(permission.LoginAccess, nil)
+	s.accessService.EXPECT().ReadAllUserAccessForUser(gomock.Any(), coreuser.NameFromTag(userAardvark)).Return(nil, nil)
 
 	args := params.UserInfoRequest{Entities: []params.Entity{
 		{Tag: userAardvark.String()},
@@ -933,6 +953,147 @@ func (s *userManagerSuite) TestResetPasswordEmpty(c *tc.C) {
 	c.Assert(results.Results, tc.HasLen, 0)
 }
 
+func (s *userManagerSuite) TestAddGroup(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound)
+	s.accessService.EXPECT().AddGroup(gomock.Any(), "sre", s.apiUser.Name).Return(nil)
+	s.accessService.EXPECT().AddGroup(gomock.Any(), "ops", s.apiUser.Name).Return(usererrors.GroupAlreadyExists)
+
+	result, err := s.api.AddGroup(c.Context(), params.AddGroups{
+		Groups: []params.AddGroup{{Name: "sre"}, {Name: "ops"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.ErrorMatches, `creating group "ops": .*`)
+}
+
+func (s *userManagerSuite) TestAddGroupAsNormalUser(c *tc.C) {
+	s.setAPIUserAndAuth(c, "alex")
+	defer s.setUpAPI(c).Finish()
+
+	_, err := s.api.AddGroup(c.Context(), params.AddGroups{
+		Groups: []params.AddGroup{{Name: "sre"}},
+	})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *userManagerSuite) TestAddToGroup(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound)
+	s.accessService.EXPECT().AddUserToGroup(gomock.Any(), "sre", coreusertesting.GenNewName(c, "alex")).Return(nil)
+
+	result, err := s.api.AddToGroup(c.Context(), params.ModifyGroupMembershipRequest{
+		Changes: []params.ModifyGroupMembership{{
+			Group:   "sre",
+			UserTag: names.NewUserTag("alex").String(),
+		}, {
+			Group:   "sre",
+			UserTag: "not-a-tag",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.ErrorMatches, `"not-a-tag" is not a valid tag`)
+}
+
+func (s *userManagerSuite) TestRemoveFromGroupNotMember(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound)
+	s.accessService.EXPECT().RemoveUserFromGroup(gomock.Any(), "sre", coreusertesting.GenNewName(c, "alex")).Return(usererrors.GroupMemberNotFound)
+
+	result, err := s.api.RemoveFromGroup(c.Context(), params.ModifyGroupMembershipRequest{
+		Changes: []params.ModifyGroupMembership{{
+			Group:   "sre",
+			UserTag: names.NewUserTag("alex").String(),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, "user is not a member of the group")
+}
+
+func (s *userManagerSuite) TestModifyGroupAccess(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	modelUUID := s.ControllerModelUUID()
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound)
+	s.accessService.EXPECT().UpdateGroupPermission(gomock.Any(), access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ID{
+				ObjectType: permission.Model,
+				Key:        modelUUID,
+			},
+			Access: permission.AdminAccess,
+		},
+		Change: permission.Grant,
+		Group:  "sre",
+	}).Return(nil)
+	s.accessService.EXPECT().UpdateGroupPermission(gomock.Any(), access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ID{
+				ObjectType: permission.Controller,
+				Key:        s.ControllerUUID,
+			},
+			Access: permission.SuperuserAccess,
+		},
+		Change: permission.Revoke,
+		Group:  "sre",
+	}).Return(nil)
+
+	result, err := s.api.ModifyGroupAccess(c.Context(), params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group:     "sre",
+			Action:    params.GrantModelAccess,
+			Access:    "admin",
+			TargetTag: names.NewModelTag(modelUUID).String(),
+		}, {
+			Group:     "sre",
+			Action:    params.RevokeModelAccess,
+			Access:    "superuser",
+			TargetTag: names.NewControllerTag(s.ControllerUUID).String(),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.IsNil)
+}
+
+func (s *userManagerSuite) TestModifyGroupAccessInvalid(c *tc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound)
+
+	result, err := s.api.ModifyGroupAccess(c.Context(), params.ModifyGroupAccessRequest{
+		Changes: []params.ModifyGroupAccess{{
+			Group:     "sre",
+			Action:    params.GrantModelAccess,
+			Access:    "add-model",
+			TargetTag: names.NewCloudTag("dummy").String(),
+		}, {
+			Group:     "sre",
+			Action:    params.GrantModelAccess,
+			Access:    "superuser",
+			TargetTag: names.NewModelTag(s.ControllerModelUUID()).String(),
+		}, {
+			Group:     "sre",
+			Action:    "dance",
+			Access:    "read",
+			TargetTag: names.NewModelTag(s.ControllerModelUUID()).String(),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 3)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, "group access on cloud not supported")
+	c.Check(result.Results[1].Error, tc.ErrorMatches, `.*"superuser".*`)
+	c.Check(result.Results[2].Error, tc.ErrorMatches, `action "dance" not valid`)
+}
+
 // setAPIUserAndAuth can be called prior to setUpAPI in order to simulate
 // calling the API as the input user. Any name other than "admin" indicates
 // that the caller is not an administrator of the controller.
@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facade (interfaces: Authorizer,WatcherRegistry)
+//
+// Generated by this command:
+//
+//	mockgen -package waitfor -destination facade_mock_test.go github.com/juju/juju/apiserver/facade Authorizer,WatcherRegistry
+//
+
+// Package waitfor is a generated GoMock package.
+package waitfor
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	permission "github.com/juju/juju/core/permission"
+	names "github.com/juju/names/v6"
+	worker "github.com/juju/worker/v5"
+)
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock                        *MockAuthorizer
+	authApplicationAgentExpects []*gomock.Call0_1[bool]
+	authClientExpects           []*gomock.Call0_1[bool]
+	authControllerExpects       []*gomock.Call0_1[bool]
+	authMachineAgentExpects     []*gomock.Call0_1[bool]
+	authModelAgentExpects       []*gomock.Call0_1[bool]
+	authOwnerExpects            []*gomock.Call1_1[names.Tag, bool]
+	authUnitAgentExpects        []*gomock.Call0_1[bool]
+	entityHasPermissionExpects  []*gomock.Call4_1[context.Context, names.Tag, permission.Access, names.Tag, error]
+	getAuthGroupsExpects        []*gomock.Call0_1[[]string]
+	getAuthTagExpects           []*gomock.Call0_1[names.Tag]
+	hasPermissionExpects        []*gomock.Call3_1[context.Context, permission.Access, names.Tag, error]
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// AuthApplicationAgent mocks base method.
+func (m *MockAuthorizer) AuthApplicationAgent() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.authApplicationAgentExpects, m.ctrl, m, "AuthApplicationAgent")
+}
+
+// AuthApplicationAgent indicates an expected call of AuthApplicationAgent.
+func (mr *MockAuthorizerMockRecorder) AuthApplicationAgent() *MockAuthorizerAuthApplicationAgentCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "AuthApplicationAgent")
+	mr.authApplicationAgentExpects = append(mr.authApplicationAgentExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthApplicationAgentCall is the typed call wrapper for AuthApplicationAgent.
+type MockAuthorizerAuthApplicationAgentCall = gomock.Call0_1[bool]
+
+// AuthClient mocks base method.
+func (m *MockAuthorizer) AuthClient() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.authClientExpects, m.ctrl, m, "AuthClient")
+}
+
+// AuthClient indicates an expected call of AuthClient.
+func (mr *MockAuthorizerMockRecorder) AuthClient() *MockAuthorizerAuthClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "AuthClient")
+	mr.authClientExpects = append(mr.authClientExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthClientCall is the typed call wrapper for AuthClient.
+type MockAuthorizerAuthClientCall = gomock.Call0_1[bool]
+
+// AuthController mocks base method.
+func (m *MockAuthorizer) AuthController() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.authControllerExpects, m.ctrl, m, "AuthController")
+}
+
+// AuthController indicates an expected call of AuthController.
+func (mr *MockAuthorizerMockRecorder) AuthController() *MockAuthorizerAuthControllerCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "AuthController")
+	mr.authControllerExpects = append(mr.authControllerExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthControllerCall is the typed call wrapper for AuthController.
+type MockAuthorizerAuthControllerCall = gomock.Call0_1[bool]
+
+// AuthMachineAgent mocks base method.
+func (m *MockAuthorizer) AuthMachineAgent() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.authMachineAgentExpects, m.ctrl, m, "AuthMachineAgent")
+}
+
+// AuthMachineAgent indicates an expected call of AuthMachineAgent.
+func (mr *MockAuthorizerMockRecorder) AuthMachineAgent() *MockAuthorizerAuthMachineAgentCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "AuthMachineAgent")
+	mr.authMachineAgentExpects = append(mr.authMachineAgentExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthMachineAgentCall is the typed call wrapper for AuthMachineAgent.
+type MockAuthorizerAuthMachineAgentCall = gomock.Call0_1[bool]
+
+// AuthModelAgent mocks base method.
+func (m *MockAuthorizer) AuthModelAgent() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.authModelAgentExpects, m.ctrl, m, "AuthModelAgent")
+}
+
+// AuthModelAgent indicates an expected call of AuthModelAgent.
+func (mr *MockAuthorizerMockRecorder) AuthModelAgent() *MockAuthorizerAuthModelAgentCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "AuthModelAgent")
+	mr.authModelAgentExpects = append(mr.authModelAgentExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthModelAgentCall is the typed call wrapper for AuthModelAgent.
+type MockAuthorizerAuthModelAgentCall = gomock.Call0_1[bool]
+
+// AuthOwner mocks base method.
+func (m *MockAuthorizer) AuthOwner(tag names.Tag) bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.authOwnerExpects, m.ctrl, m, "AuthOwner", tag)
+}
+
+// AuthOwner indicates an expected call of AuthOwner.
+func (mr *MockAuthorizerMockRecorder) AuthOwner(tag any) *MockAuthorizerAuthOwnerCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[names.Tag, bool](mr.mock.ctrl.T, mr.mock, "AuthOwner", gomock.EnsureMatcher(tag))
+	mr.authOwnerExpects = append(mr.authOwnerExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthOwnerCall is the typed call wrapper for AuthOwner.
+type MockAuthorizerAuthOwnerCall = gomock.Call1_1[names.Tag, bool]
+
+// AuthUnitAgent mocks base method.
+func (m *MockAuthorizer) AuthUnitAgent() bool {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.authUnitAgentExpects, m.ctrl, m, "AuthUnitAgent")
+}
+
+// AuthUnitAgent indicates an expected call of AuthUnitAgent.
+func (mr *MockAuthorizerMockRecorder) AuthUnitAgent() *MockAuthorizerAuthUnitAgentCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[bool](mr.mock.ctrl.T, mr.mock, "AuthUnitAgent")
+	mr.authUnitAgentExpects = append(mr.authUnitAgentExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerAuthUnitAgentCall is the typed call wrapper for AuthUnitAgent.
+type MockAuthorizerAuthUnitAgentCall = gomock.Call0_1[bool]
+
+// EntityHasPermission mocks base method.
+func (m *MockAuthorizer) EntityHasPermission(ctx context.Context, entity names.Tag, operation permission.Access, target names.Tag) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.entityHasPermissionExpects, m.ctrl, m, "EntityHasPermission", ctx, entity, operation, target)
+}
+
+// EntityHasPermission indicates an expected call of EntityHasPermission.
+func (mr *MockAuthorizerMockRecorder) EntityHasPermission(ctx, entity, operation, target any) *MockAuthorizerEntityHasPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, names.Tag, permission.Access, names.Tag, error](mr.mock.ctrl.T, mr.mock, "EntityHasPermission", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(entity), gomock.EnsureMatcher(operation), gomock.EnsureMatcher(target))
+	mr.entityHasPermissionExpects = append(mr.entityHasPermissionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerEntityHasPermissionCall is the typed call wrapper for EntityHasPermission.
+type MockAuthorizerEntityHasPermissionCall = gomock.Call4_1[context.Context, names.Tag, permission.Access, names.Tag, error]
+
+// GetAuthGroups mocks base method.
+func (m *MockAuthorizer) GetAuthGroups() []string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.getAuthGroupsExpects, m.ctrl, m, "GetAuthGroups")
+}
+
+// GetAuthGroups indicates an expected call of GetAuthGroups.
+func (mr *MockAuthorizerMockRecorder) GetAuthGroups() *MockAuthorizerGetAuthGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[[]string](mr.mock.ctrl.T, mr.mock, "GetAuthGroups")
+	mr.getAuthGroupsExpects = append(mr.getAuthGroupsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerGetAuthGroupsCall is the typed call wrapper for GetAuthGroups.
+type MockAuthorizerGetAuthGroupsCall = gomock.Call0_1[[]string]
+
+// GetAuthTag mocks base method.
+func (m *MockAuthorizer) GetAuthTag() names.Tag {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.getAuthTagExpects, m.ctrl, m, "GetAuthTag")
+}
+
+// GetAuthTag indicates an expected call of GetAuthTag.
+func (mr *MockAuthorizerMockRecorder) GetAuthTag() *MockAuthorizerGetAuthTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[names.Tag](mr.mock.ctrl.T, mr.mock, "GetAuthTag")
+	mr.getAuthTagExpects = append(mr.getAuthTagExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerGetAuthTagCall is the typed call wrapper for GetAuthTag.
+type MockAuthorizerGetAuthTagCall = gomock.Call0_1[names.Tag]
+
+// HasPermission mocks base method.
+func (m *MockAuthorizer) HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.hasPermissionExpects, m.ctrl, m, "HasPermission", ctx, operation, target)
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockAuthorizerMockRecorder) HasPermission(ctx, operation, target any) *MockAuthorizerHasPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, permission.Access, names.Tag, error](mr.mock.ctrl.T, mr.mock, "HasPermission", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(operation), gomock.EnsureMatcher(target))
+	mr.hasPermissionExpects = append(mr.hasPermissionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuthorizerHasPermissionCall is the typed call wrapper for HasPermission.
+type MockAuthorizerHasPermissionCall = gomock.Call3_1[context.Context, permission.Access, names.Tag, error]
+
+// MockWatcherRegistry is a mock of WatcherRegistry interface.
+type MockWatcherRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockWatcherRegistryMockRecorder is the mock recorder for MockWatcherRegistry.
+type MockWatcherRegistryMockRecorder struct {
+	mock                 *MockWatcherRegistry
+	getExpects           []*gomock.Call1_2[string, worker.Worker, error]
+	registerExpects      []*gomock.Call2_2[context.Context, worker.Worker, string, error]
+	registerNamedExpects []*gomock.Call3_1[context.Context, string, worker.Worker, error]
+	stopExpects          []*gomock.Call1_1[string, error]
+}
+
+// NewMockWatcherRegistry creates a new mock instance.
+func NewMockWatcherRegistry(ctrl *gomock.Controller) *MockWatcherRegistry {
+	mock := &MockWatcherRegistry{ctrl: ctrl}
+	mock.recorder = &MockWatcherRegistryMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherRegistry) EXPECT() *MockWatcherRegistryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockWatcherRegistry) Get(arg0 string) (worker.Worker, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getExpects, m.ctrl, m, "Get", arg0)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWatcherRegistryMockRecorder) Get(arg0 any) *MockWatcherRegistryGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[string, worker.Worker, error](mr.mock.ctrl.T, mr.mock, "Get", gomock.EnsureMatcher(arg0))
+	mr.getExpects = append(mr.getExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryGetCall is the typed call wrapper for Get.
+type MockWatcherRegistryGetCall = gomock.Call1_2[string, worker.Worker, error]
+
+// Register mocks base method.
+func (m *MockWatcherRegistry) Register(arg0 context.Context, arg1 worker.Worker) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.registerExpects, m.ctrl, m, "Register", arg0, arg1)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockWatcherRegistryMockRecorder) Register(arg0, arg1 any) *MockWatcherRegistryRegisterCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, worker.Worker, string, error](mr.mock.ctrl.T, mr.mock, "Register", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.registerExpects = append(mr.registerExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryRegisterCall is the typed call wrapper for Register.
+type MockWatcherRegistryRegisterCall = gomock.Call2_2[context.Context, worker.Worker, string, error]
+
+// RegisterNamed mocks base method.
+func (m *MockWatcherRegistry) RegisterNamed(arg0 context.Context, arg1 string, arg2 worker.Worker) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.registerNamedExpects, m.ctrl, m, "RegisterNamed", arg0, arg1, arg2)
+}
+
+// RegisterNamed indicates an expected call of RegisterNamed.
+func (mr *MockWatcherRegistryMockRecorder) RegisterNamed(arg0, arg1, arg2 any) *MockWatcherRegistryRegisterNamedCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, worker.Worker, error](mr.mock.ctrl.T, mr.mock, "RegisterNamed", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.registerNamedExpects = append(mr.registerNamedExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryRegisterNamedCall is the typed call wrapper for RegisterNamed.
+type MockWatcherRegistryRegisterNamedCall = gomock.Call3_1[context.Context, string, worker.Worker, error]
+
+// Stop mocks base method.
+func (m *MockWatcherRegistry) Stop(id string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.stopExpects, m.ctrl, m, "Stop", id)
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockWatcherRegistryMockRecorder) Stop(id any) *MockWatcherRegistryStopCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[string, error](mr.mock.ctrl.T, mr.mock, "Stop", gomock.EnsureMatcher(id))
+	mr.stopExpects = append(mr.stopExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockWatcherRegistryStopCall is the typed call wrapper for Stop.
+type MockWatcherRegistryStopCall = gomock.Call1_1[string, error]
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/core/leadership (interfaces: Reader)
+//
+// Generated by this command:
+//
+//	mockgen -package waitfor -destination leadership_mock_test.go github.com/juju/juju/core/leadership Reader
+//
+
+// Package waitfor is a generated GoMock package.
+package waitfor
+
+import (
+	gomock "github.com/canonical/gomock/gomock"
+)
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+	isgomock struct{}
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock           *MockReader
+	leadersExpects []*gomock.Call0_2[map[string]string, error]
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// Leaders mocks base method.
+func (m *MockReader) Leaders() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_2(&m.recorder.leadersExpects, m.ctrl, m, "Leaders")
+}
+
+// Leaders indicates an expected call of Leaders.
+func (mr *MockReaderMockRecorder) Leaders() *MockReaderLeadersCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_2[map[string]string, error](mr.mock.ctrl.T, mr.mock, "Leaders")
+	mr.leadersExpects = append(mr.leadersExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockReaderLeadersCall is the typed call wrapper for Leaders.
+type MockReaderLeadersCall = gomock.Call0_2[map[string]string, error]
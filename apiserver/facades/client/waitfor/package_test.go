@@ -0,0 +1,8 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+//go:generate go run github.com/canonical/gomock/mockgen -package waitfor -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/waitfor StatusService,ModelInfoService
+//go:generate go run github.com/canonical/gomock/mockgen -package waitfor -destination facade_mock_test.go github.com/juju/juju/apiserver/facade Authorizer,WatcherRegistry
+//go:generate go run github.com/canonical/gomock/mockgen -package waitfor -destination leadership_mock_test.go github.com/juju/juju/core/leadership Reader
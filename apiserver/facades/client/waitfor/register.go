@@ -0,0 +1,47 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("WaitFor", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newWaitForAPI(ctx)
+	}, reflect.TypeFor[*WaitForAPI]())
+}
+
+// newWaitForAPI returns a new WaitFor facade.
+func newWaitForAPI(ctx facade.ModelContext) (*WaitForAPI, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+
+	leadershipReader, err := ctx.LeadershipReader()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	domainServices := ctx.DomainServices()
+	return &WaitForAPI{
+		controllerTag:    names.NewControllerTag(ctx.ControllerUUID()),
+		modelTag:         names.NewModelTag(ctx.ModelUUID().String()),
+		authorizer:       authorizer,
+		leadershipReader: leadershipReader,
+		statusService:    domainServices.Status(),
+		modelInfoService: domainServices.ModelInfo(),
+		watcherRegistry:  ctx.WatcherRegistry(),
+		logger:           ctx.Logger().Child("waitfor"),
+	}, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/watcher"
+	statusservice "github.com/juju/juju/domain/status/service"
+)
+
+// StatusService describes the methods of the status service used to report
+// on, and watch, the status of the entities in a model.
+type StatusService interface {
+	// WatchModelStatus returns a watcher that notifies of any change to the
+	// life or status of the model, or of the applications, units and
+	// machines in it.
+	WatchModelStatus(ctx context.Context) (watcher.NotifyWatcher, error)
+
+	// GetModelStatus returns the current status of the model.
+	GetModelStatus(ctx context.Context) (corestatus.StatusInfo, error)
+
+	// GetApplicationAndUnitStatuses returns the application statuses of all
+	// the applications in the model, indexed by application name.
+	GetApplicationAndUnitStatuses(ctx context.Context) (map[string]statusservice.Application, error)
+
+	// GetMachineFullStatuses returns all the machines and their statuses for
+	// the model, indexed by machine name.
+	GetMachineFullStatuses(ctx context.Context) (map[machine.Name]statusservice.Machine, error)
+}
+
+// ModelInfoService describes the methods of the model info service used to
+// report on the model.
+type ModelInfoService interface {
+	// GetModelInfo returns information about the current model.
+	GetModelInfo(context.Context) (model.ModelInfo, error)
+}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/waitfor (interfaces: StatusService,ModelInfoService)
+//
+// Generated by this command:
+//
+//	mockgen -package waitfor -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/waitfor StatusService,ModelInfoService
+//
+
+// Package waitfor is a generated GoMock package.
+package waitfor
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	machine "github.com/juju/juju/core/machine"
+	model "github.com/juju/juju/core/model"
+	status "github.com/juju/juju/core/status"
+	watcher "github.com/juju/juju/core/watcher"
+	service "github.com/juju/juju/domain/status/service"
+)
+
+// MockStatusService is a mock of StatusService interface.
+type MockStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
+type MockStatusServiceMockRecorder struct {
+	mock                                 *MockStatusService
+	getApplicationAndUnitStatusesExpects []*gomock.Call1_2[context.Context, map[string]service.Application, error]
+	getMachineFullStatusesExpects        []*gomock.Call1_2[context.Context, map[machine.Name]service.Machine, error]
+	getModelStatusExpects                []*gomock.Call1_2[context.Context, status.StatusInfo, error]
+	watchModelStatusExpects              []*gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
+}
+
+// NewMockStatusService creates a new mock instance.
+func NewMockStatusService(ctrl *gomock.Controller) *MockStatusService {
+	mock := &MockStatusService{ctrl: ctrl}
+	mock.recorder = &MockStatusServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusService) EXPECT() *MockStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationAndUnitStatuses mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitStatuses(ctx context.Context) (map[string]service.Application, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getApplicationAndUnitStatusesExpects, m.ctrl, m, "GetApplicationAndUnitStatuses", ctx)
+}
+
+// GetApplicationAndUnitStatuses indicates an expected call of GetApplicationAndUnitStatuses.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatuses(ctx any) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]service.Application, error](mr.mock.ctrl.T, mr.mock, "GetApplicationAndUnitStatuses", gomock.EnsureMatcher(ctx))
+	mr.getApplicationAndUnitStatusesExpects = append(mr.getApplicationAndUnitStatusesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceGetApplicationAndUnitStatusesCall is the typed call wrapper for GetApplicationAndUnitStatuses.
+type MockStatusServiceGetApplicationAndUnitStatusesCall = gomock.Call1_2[context.Context, map[string]service.Application, error]
+
+// GetMachineFullStatuses mocks base method.
+func (m *MockStatusService) GetMachineFullStatuses(ctx context.Context) (map[machine.Name]service.Machine, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getMachineFullStatusesExpects, m.ctrl, m, "GetMachineFullStatuses", ctx)
+}
+
+// GetMachineFullStatuses indicates an expected call of GetMachineFullStatuses.
+func (mr *MockStatusServiceMockRecorder) GetMachineFullStatuses(ctx any) *MockStatusServiceGetMachineFullStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[machine.Name]service.Machine, error](mr.mock.ctrl.T, mr.mock, "GetMachineFullStatuses", gomock.EnsureMatcher(ctx))
+	mr.getMachineFullStatusesExpects = append(mr.getMachineFullStatusesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceGetMachineFullStatusesCall is the typed call wrapper for GetMachineFullStatuses.
+type MockStatusServiceGetMachineFullStatusesCall = gomock.Call1_2[context.Context, map[machine.Name]service.Machine, error]
+
+// GetModelStatus mocks base method.
+func (m *MockStatusService) GetModelStatus(ctx context.Context) (status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getModelStatusExpects, m.ctrl, m, "GetModelStatus", ctx)
+}
+
+// GetModelStatus indicates an expected call of GetModelStatus.
+func (mr *MockStatusServiceMockRecorder) GetModelStatus(ctx any) *MockStatusServiceGetModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, status.StatusInfo, error](mr.mock.ctrl.T, mr.mock, "GetModelStatus", gomock.EnsureMatcher(ctx))
+	mr.getModelStatusExpects = append(mr.getModelStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceGetModelStatusCall is the typed call wrapper for GetModelStatus.
+type MockStatusServiceGetModelStatusCall = gomock.Call1_2[context.Context, status.StatusInfo, error]
+
+// WatchModelStatus mocks base method.
+func (m *MockStatusService) WatchModelStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.watchModelStatusExpects, m.ctrl, m, "WatchModelStatus", ctx)
+}
+
+// WatchModelStatus indicates an expected call of WatchModelStatus.
+func (mr *MockStatusServiceMockRecorder) WatchModelStatus(ctx any) *MockStatusServiceWatchModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, watcher.NotifyWatcher, error](mr.mock.ctrl.T, mr.mock, "WatchModelStatus", gomock.EnsureMatcher(ctx))
+	mr.watchModelStatusExpects = append(mr.watchModelStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceWatchModelStatusCall is the typed call wrapper for WatchModelStatus.
+type MockStatusServiceWatchModelStatusCall = gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
+
+// MockModelInfoService is a mock of ModelInfoService interface.
+type MockModelInfoService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelInfoServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelInfoServiceMockRecorder is the mock recorder for MockModelInfoService.
+type MockModelInfoServiceMockRecorder struct {
+	mock                *MockModelInfoService
+	getModelInfoExpects []*gomock.Call1_2[context.Context, model.ModelInfo, error]
+}
+
+// NewMockModelInfoService creates a new mock instance.
+func NewMockModelInfoService(ctrl *gomock.Controller) *MockModelInfoService {
+	mock := &MockModelInfoService{ctrl: ctrl}
+	mock.recorder = &MockModelInfoServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelInfoService) EXPECT() *MockModelInfoServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelInfo mocks base method.
+func (m *MockModelInfoService) GetModelInfo(arg0 context.Context) (model.ModelInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getModelInfoExpects, m.ctrl, m, "GetModelInfo", arg0)
+}
+
+// GetModelInfo indicates an expected call of GetModelInfo.
+func (mr *MockModelInfoServiceMockRecorder) GetModelInfo(arg0 any) *MockModelInfoServiceGetModelInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, model.ModelInfo, error](mr.mock.ctrl.T, mr.mock, "GetModelInfo", gomock.EnsureMatcher(arg0))
+	mr.getModelInfoExpects = append(mr.getModelInfoExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelInfoServiceGetModelInfoCall is the typed call wrapper for GetModelInfo.
+type MockModelInfoServiceGetModelInfoCall = gomock.Call1_2[context.Context, model.ModelInfo, error]
@@ -0,0 +1,194 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal"
+	"github.com/juju/juju/core/leadership"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/permission"
+	statusservice "github.com/juju/juju/domain/status/service"
+	"github.com/juju/juju/rpc/params"
+)
+
+// WaitForAPI implements the WaitFor facade, used by clients to wait for the
+// entities in a model to reach a given state. Clients watch the model for
+// changes and read the status of the entities they wait on as each change is
+// reported, rather than polling the full status of the model.
+type WaitForAPI struct {
+	controllerTag names.ControllerTag
+	modelTag      names.ModelTag
+
+	authorizer       facade.Authorizer
+	leadershipReader leadership.Reader
+
+	statusService    StatusService
+	modelInfoService ModelInfoService
+
+	watcherRegistry facade.WatcherRegistry
+	logger          corelogger.Logger
+}
+
+func (api *WaitForAPI) checkCanRead(ctx context.Context) error {
+	err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return errors.Trace(err)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	return api.authorizer.HasPermission(ctx, permission.ReadAccess, api.modelTag)
+}
+
+// WatchStatus returns a watcher that notifies of any change to the life or
+// status of the model, or of the applications, units and machines in it.
+func (api *WaitForAPI) WatchStatus(ctx context.Context) (params.NotifyWatchResult, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.NotifyWatchResult{}, apiservererrors.ServerError(err)
+	}
+
+	w, err := api.statusService.WatchModelStatus(ctx)
+	if err != nil {
+		return params.NotifyWatchResult{}, apiservererrors.ServerError(err)
+	}
+	id, _, err := internal.EnsureRegisterWatcher[struct{}](ctx, api.watcherRegistry, w)
+	if err != nil {
+		return params.NotifyWatchResult{}, apiservererrors.ServerError(err)
+	}
+	return params.NotifyWatchResult{NotifyWatcherId: id}, nil
+}
+
+// Status returns the life and status of the model and the applications,
+// units and machines in it.
+func (api *WaitForAPI) Status(ctx context.Context) (params.WaitForStatus, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.WaitForStatus{}, apiservererrors.ServerError(err)
+	}
+
+	model, err := api.modelStatus(ctx)
+	if err != nil {
+		return params.WaitForStatus{}, apiservererrors.ServerError(err)
+	}
+
+	applications, err := api.statusService.GetApplicationAndUnitStatuses(ctx)
+	if err != nil {
+		return params.WaitForStatus{}, apiservererrors.ServerError(errors.Annotate(err, "getting application statuses"))
+	}
+	machines, err := api.statusService.GetMachineFullStatuses(ctx)
+	if err != nil {
+		return params.WaitForStatus{}, apiservererrors.ServerError(errors.Annotate(err, "getting machine statuses"))
+	}
+
+	var leaders map[string]string
+	if len(applications) > 0 {
+		if leaders, err = api.leadershipReader.Leaders(); err != nil {
+			// Leadership is additive, so report the rest of the status
+			// rather than failing, as is done for the full status.
+			api.logger.Warningf(ctx, "could not determine application leaders: %v", err)
+		}
+	}
+
+	result := params.WaitForStatus{
+		Model:        model,
+		Applications: make(map[string]params.WaitForApplicationStatus, len(applications)),
+		Units:        make(map[string]params.WaitForUnitStatus),
+		Machines:     make(map[string]params.WaitForMachineStatus, len(machines)),
+	}
+	for name, app := range applications {
+		result.Applications[name] = encodeApplication(name, app)
+		for unitName, unit := range app.Units {
+			result.Units[unitName.String()] = encodeUnit(unitName.String(), unit, leaders[name])
+		}
+	}
+	for name, machine := range machines {
+		result.Machines[name.String()] = encodeMachine(name.String(), machine)
+	}
+	return result, nil
+}
+
+func (api *WaitForAPI) modelStatus(ctx context.Context) (params.WaitForModelStatus, error) {
+	info, err := api.modelInfoService.GetModelInfo(ctx)
+	if err != nil {
+		return params.WaitForModelStatus{}, errors.Annotate(err, "getting model info")
+	}
+	status, err := api.statusService.GetModelStatus(ctx)
+	if err != nil {
+		return params.WaitForModelStatus{}, errors.Annotate(err, "getting model status")
+	}
+	return params.WaitForModelStatus{
+		Name:    info.Name,
+		Type:    info.Type.String(),
+		Status:  status.Status.String(),
+		Message: status.Message,
+	}, nil
+}
+
+func encodeApplication(name string, app statusservice.Application) params.WaitForApplicationStatus {
+	units := make([]string, 0, len(app.Units))
+	for unitName := range app.Units {
+		units = append(units, unitName.String())
+	}
+	sort.Strings(units)
+
+	scale := len(units)
+	if app.Scale != nil {
+		scale = *app.Scale
+	}
+	return params.WaitForApplicationStatus{
+		Name:          name,
+		Life:          string(app.Life),
+		Status:        app.Status.Status.String(),
+		Message:       app.Status.Message,
+		Charm:         app.CharmLocator.Name,
+		CharmRevision: app.CharmLocator.Revision,
+		Exposed:       app.Exposed,
+		Subordinate:   app.Subordinate,
+		Scale:         scale,
+		Units:         units,
+	}
+}
+
+func encodeUnit(name string, unit statusservice.Unit, leader string) params.WaitForUnitStatus {
+	result := params.WaitForUnitStatus{
+		Name:            name,
+		Application:     unit.ApplicationName,
+		Life:            string(unit.Life),
+		WorkloadStatus:  unit.WorkloadStatus.Status.String(),
+		WorkloadMessage: unit.WorkloadStatus.Message,
+		AgentStatus:     unit.AgentStatus.Status.String(),
+		AgentMessage:    unit.AgentStatus.Message,
+		Leader:          name == leader,
+	}
+	if unit.MachineName != nil {
+		result.Machine = unit.MachineName.String()
+	}
+	if unit.PrincipalName != nil {
+		result.Principal = unit.PrincipalName.String()
+	}
+	return result
+}
+
+func encodeMachine(name string, machine statusservice.Machine) params.WaitForMachineStatus {
+	return params.WaitForMachineStatus{
+		Name:            name,
+		Life:            string(machine.Life),
+		Status:          machine.MachineStatus.Status.String(),
+		Message:         machine.MachineStatus.Message,
+		InstanceStatus:  machine.InstanceStatus.Status.String(),
+		InstanceMessage: machine.InstanceStatus.Message,
+		InstanceID:      machine.InstanceID.String(),
+		Hostname:        machine.Hostname,
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	stdtesting "testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/life"
+	coremachine "github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/application/charm"
+	statusservice "github.com/juju/juju/domain/status/service"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type waitForSuite struct {
+	authorizer       *MockAuthorizer
+	watcherRegistry  *MockWatcherRegistry
+	leadershipReader *MockReader
+	statusService    *MockStatusService
+	modelInfoService *MockModelInfoService
+}
+
+func TestWaitForSuite(t *stdtesting.T) {
+	tc.Run(t, &waitForSuite{})
+}
+
+func (s *waitForSuite) TestWatchStatus(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCanRead()
+
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	s.statusService.EXPECT().WatchModelStatus(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(ch), nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("1", nil)
+
+	result, err := s.newAPI(c).WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.NotifyWatchResult{NotifyWatcherId: "1"})
+}
+
+func (s *waitForSuite) TestWatchStatusPermissionDenied(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).
+		Return(authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, testing.ModelTag).
+		Return(errors.New("permission denied"))
+
+	_, err := s.newAPI(c).WatchStatus(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *waitForSuite) TestStatus(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCanRead()
+
+	machineName := coremachine.Name("0")
+	scale := 1
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(model.ModelInfo{
+		Name: "prod",
+		Type: model.IAAS,
+	}, nil)
+	s.statusService.EXPECT().GetModelStatus(gomock.Any()).Return(corestatus.StatusInfo{
+		Status: corestatus.Available,
+	}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			Life:         life.Alive,
+			Status:       corestatus.StatusInfo{Status: corestatus.Active, Message: "ready"},
+			CharmLocator: charm.CharmLocator{Name: "mysql", Revision: 42},
+			Scale:        &scale,
+			Units: map[coreunit.Name]statusservice.Unit{
+				"mysql/0": {
+					Life:            life.Alive,
+					ApplicationName: "mysql",
+					MachineName:     &machineName,
+					WorkloadStatus:  corestatus.StatusInfo{Status: corestatus.Active, Message: "ready"},
+					AgentStatus:     corestatus.StatusInfo{Status: corestatus.Idle},
+				},
+			},
+		},
+	}, nil)
+	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(map[coremachine.Name]statusservice.Machine{
+		"0": {
+			Name:           "0",
+			Hostname:       "juju-0",
+			InstanceID:     "i-0",
+			Life:           life.Alive,
+			MachineStatus:  corestatus.StatusInfo{Status: corestatus.Started},
+			InstanceStatus: corestatus.StatusInfo{Status: corestatus.Running},
+		},
+	}, nil)
+	s.leadershipReader.EXPECT().Leaders().Return(map[string]string{"mysql": "mysql/0"}, nil)
+
+	result, err := s.newAPI(c).Status(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.WaitForStatus{
+		Model: params.WaitForModelStatus{
+			Name:   "prod",
+			Type:   "iaas",
+			Status: "available",
+		},
+		Applications: map[string]params.WaitForApplicationStatus{
+			"mysql": {
+				Name:          "mysql",
+				Life:          "alive",
+				Status:        "active",
+				Message:       "ready",
+				Charm:         "mysql",
+				CharmRevision: 42,
+				Scale:         1,
+				Units:         []string{"mysql/0"},
+			},
+		},
+		Units: map[string]params.WaitForUnitStatus{
+			"mysql/0": {
+				Name:            "mysql/0",
+				Application:     "mysql",
+				Life:            "alive",
+				WorkloadStatus:  "active",
+				WorkloadMessage: "ready",
+				AgentStatus:     "idle",
+				Machine:         "0",
+				Leader:          true,
+			},
+		},
+		Machines: map[string]params.WaitForMachineStatus{
+			"0": {
+				Name:           "0",
+				Life:           "alive",
+				Status:         "started",
+				InstanceStatus: "running",
+				InstanceID:     "i-0",
+				Hostname:       "juju-0",
+			},
+		},
+	})
+}
+
+func (s *waitForSuite) TestStatusLeadershipError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCanRead()
+
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(model.ModelInfo{Name: "prod", Type: model.IAAS}, nil)
+	s.statusService.EXPECT().GetModelStatus(gomock.Any()).Return(corestatus.StatusInfo{Status: corestatus.Available}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			Life: life.Alive,
+			Units: map[coreunit.Name]statusservice.Unit{
+				"mysql/0": {Life: life.Alive, ApplicationName: "mysql"},
+			},
+		},
+	}, nil)
+	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(nil, nil)
+	s.leadershipReader.EXPECT().Leaders().Return(nil, errors.New("boom"))
+
+	result, err := s.newAPI(c).Status(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Units["mysql/0"].Leader, tc.IsFalse)
+}
+
+func (s *waitForSuite) expectCanRead() {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, gomock.Any()).Return(nil)
+}
+
+func (s *waitForSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.watcherRegistry = NewMockWatcherRegistry(ctrl)
+	s.leadershipReader = NewMockReader(ctrl)
+	s.statusService = NewMockStatusService(ctrl)
+	s.modelInfoService = NewMockModelInfoService(ctrl)
+
+	c.Cleanup(func() {
+		s.authorizer = nil
+		s.watcherRegistry = nil
+		s.leadershipReader = nil
+		s.statusService = nil
+		s.modelInfoService = nil
+	})
+	return ctrl
+}
+
+func (s *waitForSuite) newAPI(c *tc.C) *WaitForAPI {
+	return &WaitForAPI{
+		controllerTag:    testing.ControllerTag,
+		modelTag:         testing.ModelTag,
+		authorizer:       s.authorizer,
+		leadershipReader: s.leadershipReader,
+		statusService:    s.statusService,
+		modelInfoService: s.modelInfoService,
+		watcherRegistry:  s.watcherRegistry,
+		logger:           loggertesting.WrapCheckLog(c),
+	}
+}
@@ -836,6 +836,18 @@ func importModelArgs(envelope params.SerializedModelV2, modelDBPayload *latest.M
 			Access:      p.Access,
 		})
 	}
+	if n := len(envelope.GroupPermissions); n > 0 {
+		info.GroupPermissions = make([]coremodelmigration.ModelGroupPermission, 0, n)
+	}
+	for _, p := range envelope.GroupPermissions {
+		info.GroupPermissions = append(info.GroupPermissions, coremodelmigration.ModelGroupPermission{
+			ObjectType:     p.ObjectType,
+			GrantOn:        p.GrantOn,
+			GroupName:      p.GroupName,
+			GroupCreatedBy: p.GroupCreatedBy,
+			Access:         p.Access,
+		})
+	}
 	if n := len(envelope.AuthorizedKeys); n > 0 {
 		info.AuthorizedKeys = make([]coremodelmigration.ModelAuthorizedKey, 0, n)
 	}
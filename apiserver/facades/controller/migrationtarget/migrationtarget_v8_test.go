@@ -451,6 +451,9 @@ func (s *v8Suite) TestImportRunsGuardsThenDelegatesToImportModel(c *tc.C) {
 	envelope.Permissions = []params.ModelPermission{{
 		ObjectType: "model", GrantOn: s.modelUUID, SubjectName: "bob@external", Access: "read",
 	}}
+	envelope.GroupPermissions = []params.ModelGroupPermission{{
+		ObjectType: "model", GrantOn: s.modelUUID, GroupName: "ops", GroupCreatedBy: "bob@external", Access: "write",
+	}}
 	envelope.AuthorizedKeys = []params.ModelAuthorizedKey{{
 		Username: "bob@external", PublicKey: "ssh-ed25519 AAAA bob@host",
 	}}
@@ -512,6 +515,9 @@ func (s *v8Suite) TestImportRunsGuardsThenDelegatesToImportModel(c *tc.C) {
 			Permissions: []coremodelmigration.ModelPermission{{
 				ObjectType: "model", GrantOn: s.modelUUID, SubjectName: "bob@external", Access: "read",
 			}},
+			GroupPermissions: []coremodelmigration.ModelGroupPermission{{
+				ObjectType: "model", GrantOn: s.modelUUID, GroupName: "ops", GroupCreatedBy: "bob@external", Access: "write",
+			}},
 			AuthorizedKeys: []coremodelmigration.ModelAuthorizedKey{{
 				Username: "bob@external", PublicKey: "ssh-ed25519 AAAA bob@host",
 			}},
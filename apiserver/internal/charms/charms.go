@@ -73,6 +73,7 @@ func ValidateCharmOrigin(o *params.CharmOrigin) error {
 			return errors.BadRequestf("programming error, both CharmOrigin ID and Hash must be set or neither. See CharmHubRepository GetDownloadURL.")
 		}
 	case corecharm.Local.Matches(o.Source):
+	case corecharm.OCI.Matches(o.Source):
 	default:
 		return errors.BadRequestf("%q not a valid charm origin source", o.Source)
 	}
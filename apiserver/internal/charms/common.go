@@ -143,6 +143,8 @@ func convertSource(source applicationcharm.CharmSource) (string, error) {
 		return charm.CharmHub.String(), nil
 	case applicationcharm.LocalSource:
 		return charm.Local.String(), nil
+	case applicationcharm.OCISource:
+		return charm.OCI.String(), nil
 	default:
 		return "", errors.Errorf("unsupported source %q", source)
 	}
@@ -0,0 +1,157 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	jujuerrors "github.com/juju/errors"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/changestream"
+	changestreamerrors "github.com/juju/juju/domain/changestream/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// afterParam is the query parameter holding the cursor to read the
+	// changes after.
+	afterParam = "after"
+
+	// limitParam is the query parameter holding the maximum number of
+	// changes to return.
+	limitParam = "limit"
+
+	// namespaceParam is the query parameter holding a namespace to filter
+	// the changes by. It may be repeated.
+	namespaceParam = "namespace"
+
+	// consumerParam is the query parameter naming the consumer reading the
+	// changes, for which the change log is retained. Consumers are scoped
+	// to the user making the request, and only admins may create them.
+	consumerParam = "consumer"
+)
+
+// ChangeStreamService provides access to the model's change log.
+type ChangeStreamService interface {
+	// Changes returns a page of changes from the change log with an ID
+	// greater than after.
+	Changes(ctx context.Context, consumer changestream.Consumer, after int64, limit int, namespaces []string) (changestream.ChangePage, error)
+}
+
+// ChangeStreamServiceGetter is an interface that provides methods to get a
+// change stream service for the model of a request, and the consumer the
+// request reads the change stream as.
+type ChangeStreamServiceGetter interface {
+	ChangeStream(*http.Request) (ChangeStreamService, error)
+
+	// Consumer returns the consumer with the given name owned by the user
+	// making the request.
+	Consumer(r *http.Request, name string) (changestream.Consumer, error)
+}
+
+// ChangesHTTPHandler implements the http.Handler interface for the change
+// stream export API. It serves pages of model changes, which can be read
+// one after the other by passing the cursor of a page as the "after" query
+// parameter of the next request.
+type ChangesHTTPHandler struct {
+	serviceGetter ChangeStreamServiceGetter
+	logger        corelogger.Logger
+}
+
+// NewChangesHTTPHandler returns a new ChangesHTTPHandler.
+func NewChangesHTTPHandler(
+	serviceGetter ChangeStreamServiceGetter,
+	logger corelogger.Logger,
+) *ChangesHTTPHandler {
+	return &ChangesHTTPHandler{
+		serviceGetter: serviceGetter,
+		logger:        logger,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *ChangesHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.Method {
+	case "GET":
+		err = h.serveGet(w, r)
+	default:
+		err = jujuerrors.MethodNotAllowedf("unsupported method: %q", r.Method)
+	}
+	if err == nil {
+		return
+	}
+
+	if err := internalhttp.SendError(w, err, h.logger); err != nil {
+		h.logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+	}
+}
+
+func (h *ChangesHTTPHandler) serveGet(w http.ResponseWriter, r *http.Request) error {
+	query := r.URL.Query()
+
+	after := int64(-1)
+	if value := query.Get(afterParam); value != "" {
+		var err error
+		if after, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return jujuerrors.BadRequestf("invalid %s value %q", afterParam, value)
+		}
+	}
+
+	var limit int
+	if value := query.Get(limitParam); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 0 {
+			return jujuerrors.BadRequestf("invalid %s value %q", limitParam, value)
+		}
+	}
+
+	var consumer changestream.Consumer
+	if name := query.Get(consumerParam); name != "" {
+		var err error
+		if consumer, err = h.serviceGetter.Consumer(r, name); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	service, err := h.serviceGetter.ChangeStream(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	page, err := service.Changes(r.Context(), consumer, after, limit, query[namespaceParam])
+	if errors.Is(err, changestreamerrors.CursorExpired) {
+		return jujuerrors.NotFoundf("changes after cursor %d", after)
+	} else if errors.Is(err, changestreamerrors.ConsumerNotFound) {
+		return jujuerrors.Forbiddenf("consumer %q not found, only admins can create consumers", consumer.Name)
+	} else if errors.Is(err, changestreamerrors.ConsumerLimitExceeded) {
+		return jujuerrors.QuotaLimitExceededf("cannot create consumer %q, %d consumers", consumer.Name, changestream.MaxConsumersPerOwner)
+	} else if err != nil {
+		return errors.Errorf("reading changes: %w", err)
+	}
+
+	result := params.ChangeStreamPage{
+		Events: make([]params.ChangeStreamEvent, len(page.Events)),
+		Cursor: page.Cursor,
+		More:   page.More,
+	}
+	for i, event := range page.Events {
+		result.Events[i] = params.ChangeStreamEvent{
+			ID:         event.ID,
+			Namespace:  event.Namespace,
+			Changed:    event.Changed,
+			ChangeType: event.ChangeType,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	if err := internalhttp.SendStatusAndJSON(w, http.StatusOK, result); err != nil {
+		return errors.Errorf("sending changes: %w", err)
+	}
+	return nil
+}
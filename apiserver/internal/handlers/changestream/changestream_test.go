@@ -0,0 +1,218 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/domain/changestream"
+	changestreamerrors "github.com/juju/juju/domain/changestream/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	changesRoutePrefix = "/model-:modeluuid/changes"
+)
+
+type changesHandlerSuite struct {
+	serviceGetter *MockChangeStreamServiceGetter
+	service       *MockChangeStreamService
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestChangesHandlerSuite(t *stdtesting.T) {
+	tc.Run(t, &changesHandlerSuite{})
+}
+
+func (s *changesHandlerSuite) SetUpTest(c *tc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *changesHandlerSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *changesHandlerSuite) TestServeGet(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	consumer := changestream.Consumer{Owner: "admin", Name: "cmdb", CanCreate: true}
+	s.serviceGetter.EXPECT().Consumer(gomock.Any(), "cmdb").Return(consumer, nil)
+	s.serviceGetter.EXPECT().ChangeStream(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().Changes(gomock.Any(), consumer, int64(42), 2, []string{"application", "unit"}).Return(changestream.ChangePage{
+		Events: []changestream.ChangeEvent{{
+			ID:         43,
+			Namespace:  "application",
+			Changed:    "app-uuid",
+			ChangeType: "create",
+			CreatedAt:  now,
+		}, {
+			ID:         44,
+			Namespace:  "unit",
+			Changed:    "unit-uuid",
+			ChangeType: "update",
+			CreatedAt:  now,
+		}},
+		Cursor: 44,
+		More:   true,
+	}, nil)
+
+	resp := s.get(c, "?after=42&limit=2&namespace=application&namespace=unit&consumer=cmdb")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var page params.ChangeStreamPage
+	err := json.NewDecoder(resp.Body).Decode(&page)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page, tc.DeepEquals, params.ChangeStreamPage{
+		Events: []params.ChangeStreamEvent{{
+			ID:         43,
+			Namespace:  "application",
+			Changed:    "app-uuid",
+			ChangeType: "create",
+			CreatedAt:  now,
+		}, {
+			ID:         44,
+			Namespace:  "unit",
+			Changed:    "unit-uuid",
+			ChangeType: "update",
+			CreatedAt:  now,
+		}},
+		Cursor: 44,
+		More:   true,
+	})
+}
+
+func (s *changesHandlerSuite) TestServeGetDefaults(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.serviceGetter.EXPECT().ChangeStream(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().Changes(gomock.Any(), changestream.Consumer{}, int64(-1), 0, nil).Return(changestream.ChangePage{
+		Cursor: 10,
+	}, nil)
+
+	resp := s.get(c, "")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var page params.ChangeStreamPage
+	err := json.NewDecoder(resp.Body).Decode(&page)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page, tc.DeepEquals, params.ChangeStreamPage{
+		Events: []params.ChangeStreamEvent{},
+		Cursor: 10,
+	})
+}
+
+func (s *changesHandlerSuite) TestServeGetInvalidParams(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	for _, query := range []string{"?after=foo", "?limit=bar", "?limit=-1"} {
+		resp := s.get(c, query)
+		c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest, tc.Commentf("query %q", query))
+		resp.Body.Close()
+	}
+}
+
+func (s *changesHandlerSuite) TestServeGetCursorExpired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.serviceGetter.EXPECT().ChangeStream(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().Changes(gomock.Any(), changestream.Consumer{}, int64(42), 0, nil).Return(changestream.ChangePage{}, changestreamerrors.CursorExpired)
+
+	resp := s.get(c, "?after=42")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusNotFound)
+
+	var result params.ErrorResult
+	err := json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error.Code, tc.Equals, params.CodeNotFound)
+	c.Check(result.Error.Message, tc.Equals, "changes after cursor 42 not found")
+}
+
+func (s *changesHandlerSuite) TestServeGetConsumerNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	consumer := changestream.Consumer{Owner: "bob", Name: "cmdb"}
+	s.serviceGetter.EXPECT().Consumer(gomock.Any(), "cmdb").Return(consumer, nil)
+	s.serviceGetter.EXPECT().ChangeStream(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().Changes(gomock.Any(), consumer, int64(-1), 0, nil).Return(changestream.ChangePage{}, changestreamerrors.ConsumerNotFound)
+
+	resp := s.get(c, "?consumer=cmdb")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusForbidden)
+
+	var result params.ErrorResult
+	err := json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error.Code, tc.Equals, params.CodeForbidden)
+	c.Check(result.Error.Message, tc.Equals, `consumer "cmdb" not found, only admins can create consumers`)
+}
+
+func (s *changesHandlerSuite) TestServeGetConsumerLimitExceeded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	consumer := changestream.Consumer{Owner: "admin", Name: "cmdb", CanCreate: true}
+	s.serviceGetter.EXPECT().Consumer(gomock.Any(), "cmdb").Return(consumer, nil)
+	s.serviceGetter.EXPECT().ChangeStream(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().Changes(gomock.Any(), consumer, int64(-1), 0, nil).Return(changestream.ChangePage{}, changestreamerrors.ConsumerLimitExceeded)
+
+	resp := s.get(c, "?consumer=cmdb")
+	defer resp.Body.Close()
+
+	var result params.ErrorResult
+	err := json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error.Code, tc.Equals, params.CodeQuotaLimitExceeded)
+}
+
+func (s *changesHandlerSuite) TestServeMethodNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	handler := NewChangesHTTPHandler(s.serviceGetter, loggertesting.WrapCheckLog(c))
+	s.mux.AddHandler("POST", changesRoutePrefix, handler)
+	defer s.mux.RemoveHandler("POST", changesRoutePrefix)
+
+	url := fmt.Sprintf("%s/model-%s/changes", s.srv.URL, testing.ModelTag.Id())
+	resp, err := http.Post(url, "application/json", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusMethodNotAllowed)
+}
+
+func (s *changesHandlerSuite) get(c *tc.C, query string) *http.Response {
+	handler := NewChangesHTTPHandler(s.serviceGetter, loggertesting.WrapCheckLog(c))
+	s.mux.AddHandler("GET", changesRoutePrefix, handler)
+	defer s.mux.RemoveHandler("GET", changesRoutePrefix)
+
+	url := fmt.Sprintf("%s/model-%s/changes%s", s.srv.URL, testing.ModelTag.Id(), query)
+	resp, err := http.Get(url)
+	c.Assert(err, tc.ErrorIsNil)
+	return resp
+}
+
+func (s *changesHandlerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.serviceGetter = NewMockChangeStreamServiceGetter(ctrl)
+	s.service = NewMockChangeStreamService(ctrl)
+
+	return ctrl
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package changestream provides a handler exporting the change stream of a
+// model, so that external consumers can mirror the model state.
+
+package changestream
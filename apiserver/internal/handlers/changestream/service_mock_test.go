@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/changestream (interfaces: ChangeStreamServiceGetter,ChangeStreamService)
+//
+// Generated by this command:
+//
+//	mockgen -package changestream -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/changestream ChangeStreamServiceGetter,ChangeStreamService
+//
+
+// Package changestream is a generated GoMock package.
+package changestream
+
+import (
+	context "context"
+	http "net/http"
+
+	gomock "github.com/canonical/gomock/gomock"
+	changestream "github.com/juju/juju/domain/changestream"
+)
+
+// MockChangeStreamServiceGetter is a mock of ChangeStreamServiceGetter interface.
+type MockChangeStreamServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeStreamServiceGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockChangeStreamServiceGetterMockRecorder is the mock recorder for MockChangeStreamServiceGetter.
+type MockChangeStreamServiceGetterMockRecorder struct {
+	mock                *MockChangeStreamServiceGetter
+	changeStreamExpects []*gomock.Call1_2[*http.Request, ChangeStreamService, error]
+	consumerExpects     []*gomock.Call2_2[*http.Request, string, changestream.Consumer, error]
+}
+
+// NewMockChangeStreamServiceGetter creates a new mock instance.
+func NewMockChangeStreamServiceGetter(ctrl *gomock.Controller) *MockChangeStreamServiceGetter {
+	mock := &MockChangeStreamServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockChangeStreamServiceGetterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeStreamServiceGetter) EXPECT() *MockChangeStreamServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// ChangeStream mocks base method.
+func (m *MockChangeStreamServiceGetter) ChangeStream(arg0 *http.Request) (ChangeStreamService, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.changeStreamExpects, m.ctrl, m, "ChangeStream", arg0)
+}
+
+// ChangeStream indicates an expected call of ChangeStream.
+func (mr *MockChangeStreamServiceGetterMockRecorder) ChangeStream(arg0 any) *MockChangeStreamServiceGetterChangeStreamCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[*http.Request, ChangeStreamService, error](mr.mock.ctrl.T, mr.mock, "ChangeStream", gomock.EnsureMatcher(arg0))
+	mr.changeStreamExpects = append(mr.changeStreamExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockChangeStreamServiceGetterChangeStreamCall is the typed call wrapper for ChangeStream.
+type MockChangeStreamServiceGetterChangeStreamCall = gomock.Call1_2[*http.Request, ChangeStreamService, error]
+
+// Consumer mocks base method.
+func (m *MockChangeStreamServiceGetter) Consumer(r *http.Request, name string) (changestream.Consumer, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.consumerExpects, m.ctrl, m, "Consumer", r, name)
+}
+
+// Consumer indicates an expected call of Consumer.
+func (mr *MockChangeStreamServiceGetterMockRecorder) Consumer(r, name any) *MockChangeStreamServiceGetterConsumerCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[*http.Request, string, changestream.Consumer, error](mr.mock.ctrl.T, mr.mock, "Consumer", gomock.EnsureMatcher(r), gomock.EnsureMatcher(name))
+	mr.consumerExpects = append(mr.consumerExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockChangeStreamServiceGetterConsumerCall is the typed call wrapper for Consumer.
+type MockChangeStreamServiceGetterConsumerCall = gomock.Call2_2[*http.Request, string, changestream.Consumer, error]
+
+// MockChangeStreamService is a mock of ChangeStreamService interface.
+type MockChangeStreamService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeStreamServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockChangeStreamServiceMockRecorder is the mock recorder for MockChangeStreamService.
+type MockChangeStreamServiceMockRecorder struct {
+	mock           *MockChangeStreamService
+	changesExpects []*gomock.Call5_2[context.Context, changestream.Consumer, int64, int, []string, changestream.ChangePage, error]
+}
+
+// NewMockChangeStreamService creates a new mock instance.
+func NewMockChangeStreamService(ctrl *gomock.Controller) *MockChangeStreamService {
+	mock := &MockChangeStreamService{ctrl: ctrl}
+	mock.recorder = &MockChangeStreamServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeStreamService) EXPECT() *MockChangeStreamServiceMockRecorder {
+	return m.recorder
+}
+
+// Changes mocks base method.
+func (m *MockChangeStreamService) Changes(ctx context.Context, consumer changestream.Consumer, after int64, limit int, namespaces []string) (changestream.ChangePage, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_2(&m.recorder.changesExpects, m.ctrl, m, "Changes", ctx, consumer, after, limit, namespaces)
+}
+
+// Changes indicates an expected call of Changes.
+func (mr *MockChangeStreamServiceMockRecorder) Changes(ctx, consumer, after, limit, namespaces any) *MockChangeStreamServiceChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_2[context.Context, changestream.Consumer, int64, int, []string, changestream.ChangePage, error](mr.mock.ctrl.T, mr.mock, "Changes", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(consumer), gomock.EnsureMatcher(after), gomock.EnsureMatcher(limit), gomock.EnsureMatcher(namespaces))
+	mr.changesExpects = append(mr.changesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockChangeStreamServiceChangesCall is the typed call wrapper for Changes.
+type MockChangeStreamServiceChangesCall = gomock.Call5_2[context.Context, changestream.Consumer, int64, int, []string, changestream.ChangePage, error]
@@ -0,0 +1,19 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package charmhubmirror provides handlers serving the Info, Find, Refresh
+// and Download subset of the Charmhub API from the charm and bundle
+// revisions held by the controller, so that other controllers can use it as
+// their charmhub-url when they cannot reach Charmhub.
+//
+// Those controllers are not users of this one. Each authenticates with basic
+// auth as a mirror client, using the credential it holds as its charm
+// registry credential for this controller, so no credentials are held in
+// their model config. Every route, including refresh, is served to mirror
+// clients.
+//
+// Resources are not mirrored. Refresh requests for charms declaring
+// resources are rejected, so such charms must be deployed from local charm
+// files along with their resources.
+
+package charmhubmirror
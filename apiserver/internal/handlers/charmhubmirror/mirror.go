@@ -0,0 +1,869 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmhubmirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/internal/auth"
+	"github.com/juju/juju/internal/charmhub/transport"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// RoutePrefix is the prefix of the routes served by the mirror. Other
+	// controllers use https://<controller>:<api-port>/charmhub-mirror as
+	// their charmhub-url.
+	RoutePrefix = "/charmhub-mirror"
+
+	// InfoRoute serves the Charmhub info API.
+	InfoRoute = RoutePrefix + "/v2/charms/info/:name"
+
+	// FindRoute serves the Charmhub find API.
+	FindRoute = RoutePrefix + "/v2/charms/find"
+
+	// RefreshRoute serves the Charmhub refresh API.
+	RefreshRoute = RoutePrefix + "/v2/charms/refresh"
+
+	// DownloadRoute serves the archives of the mirrored revisions.
+	DownloadRoute = RoutePrefix + "/download/:sha256"
+)
+
+const (
+	// notAvailable is the placeholder Charmhub clients use for the name and
+	// channel of a base that can be anything.
+	notAvailable = "NA"
+
+	// defaultChannel is the channel the default release of a charm or
+	// bundle is taken from.
+	defaultChannel = "latest/stable"
+
+	// megabyte is the unit the cache limits are configured in.
+	megabyte = 1024 * 1024
+)
+
+// MirrorService provides access to the revisions held by the mirror.
+type MirrorService interface {
+	// GetEntities returns every revision held for the charm or bundle with
+	// the given name or Charmhub ID, ordered by descending revision.
+	GetEntities(ctx context.Context, name string) ([]charmmirror.Entity, error)
+
+	// FindEntities returns every revision held for the charms and bundles
+	// whose name contains the query, ordered by name and descending
+	// revision.
+	FindEntities(ctx context.Context, query string) ([]charmmirror.Entity, error)
+
+	// Resolve returns the revision matching the arguments.
+	Resolve(ctx context.Context, args charmmirror.ResolveArgs) (charmmirror.Resolved, error)
+
+	// OpenArchive returns a reader for the mirrored archive with the given
+	// SHA256 hash, along with its size.
+	OpenArchive(ctx context.Context, sha256 string) (io.ReadCloser, int64, error)
+
+	// CacheEntity adds a revision fetched from the upstream, with its
+	// archive read from r, to the mirror, evicting the revisions cached
+	// longest ago once the cached archives exceed the limits.
+	CacheEntity(ctx context.Context, entity charmmirror.Entity, r io.Reader, limits charmmirror.CacheLimits) error
+
+	// AuthenticateClient checks the password of the mirror client with the
+	// given name. If there is no such client, or the password is wrong, an
+	// error satisfying [charmmirrorerrors.ClientUnauthorized] is returned.
+	AuthenticateClient(ctx context.Context, name string, password auth.Password) error
+}
+
+// ControllerConfigService provides the controller config, which determines
+// whether the mirror is enabled and where its upstream is.
+type ControllerConfigService interface {
+	// ControllerConfig returns the config values for the controller.
+	ControllerConfig(ctx context.Context) (controller.Config, error)
+}
+
+// HTTPClient is the client used to talk to the upstream Charmhub.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Mirror serves the subset of the Charmhub API used to deploy and refresh
+// charms from the revisions held by the controller. When the mirror has an
+// upstream, channels are resolved upstream and the revisions fetched from
+// it are cached, so that they can still be served when the upstream cannot
+// be reached. Requests must carry the basic auth credentials of one of the
+// mirror's clients. Charms with resources are not mirrored.
+type Mirror struct {
+	mirror     MirrorService
+	config     ControllerConfigService
+	httpClient HTTPClient
+	logger     corelogger.Logger
+}
+
+// NewMirror returns a new Mirror.
+func NewMirror(
+	mirror MirrorService,
+	config ControllerConfigService,
+	httpClient HTTPClient,
+	logger corelogger.Logger,
+) *Mirror {
+	return &Mirror{
+		mirror:     mirror,
+		config:     config,
+		httpClient: httpClient,
+		logger:     logger,
+	}
+}
+
+// InfoHandler returns the handler for the info API.
+func (m *Mirror) InfoHandler() http.Handler {
+	return m.handler(http.MethodGet, m.serveInfo)
+}
+
+// FindHandler returns the handler for the find API.
+func (m *Mirror) FindHandler() http.Handler {
+	return m.handler(http.MethodGet, m.serveFind)
+}
+
+// RefreshHandler returns the handler for the refresh API.
+func (m *Mirror) RefreshHandler() http.Handler {
+	return m.handler(http.MethodPost, m.serveRefresh)
+}
+
+// DownloadHandler returns the handler serving the mirrored archives.
+func (m *Mirror) DownloadHandler() http.Handler {
+	return m.handler(http.MethodGet, m.serveDownload)
+}
+
+type serveFunc func(http.ResponseWriter, *http.Request, controller.Config) error
+
+func (m *Mirror) handler(method string, serve serveFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			m.sendError(w, r, http.StatusMethodNotAllowed, transport.ErrorCodeBadArgument,
+				fmt.Sprintf("unsupported method: %q", r.Method))
+			return
+		}
+
+		if err := m.authenticate(r); errors.Is(err, charmmirrorerrors.ClientUnauthorized) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="juju charmhub mirror"`)
+			m.sendError(w, r, http.StatusUnauthorized, transport.ErrorCodeUserAuthenticationError, "invalid charmhub mirror credentials")
+			return
+		} else if err != nil {
+			m.logger.Errorf(r.Context(), "authenticating charmhub mirror client: %v", err)
+			m.sendError(w, r, http.StatusInternalServerError, transport.ErrorCodeAPIError, "cannot authenticate charmhub mirror client")
+			return
+		}
+
+		cfg, err := m.config.ControllerConfig(r.Context())
+		if err != nil {
+			m.logger.Errorf(r.Context(), "getting controller config: %v", err)
+			m.sendError(w, r, http.StatusInternalServerError, transport.ErrorCodeAPIError, "cannot get controller config")
+			return
+		}
+		if !cfg.CharmhubMirror() {
+			m.sendError(w, r, http.StatusNotFound, transport.ErrorCodeEndpointDisabled, "the charmhub mirror is not enabled")
+			return
+		}
+
+		err = serve(w, r, cfg)
+		switch {
+		case err == nil:
+		case errors.IsOneOf(err, charmmirrorerrors.EntityNotFound, charmmirrorerrors.RevisionNotFound, charmmirrorerrors.ArchiveNotFound):
+			m.sendError(w, r, http.StatusNotFound, transport.ErrorCodeNotFound, err.Error())
+		case errors.Is(err, coreerrors.NotValid):
+			m.sendError(w, r, http.StatusBadRequest, transport.ErrorCodeBadArgument, err.Error())
+		default:
+			m.logger.Errorf(r.Context(), "serving charmhub mirror request %q: %v", r.URL.Path, err)
+			m.sendError(w, r, http.StatusInternalServerError, transport.ErrorCodeAPIError, err.Error())
+		}
+	})
+}
+
+// authenticate checks the request carries the basic auth credentials of one
+// of the mirror's clients.
+func (m *Mirror) authenticate(r *http.Request) error {
+	name, password, ok := r.BasicAuth()
+	if !ok {
+		return errors.New("no charmhub mirror credentials").Add(charmmirrorerrors.ClientUnauthorized)
+	}
+	return m.mirror.AuthenticateClient(r.Context(), name, auth.NewPassword(password))
+}
+
+func (m *Mirror) serveInfo(w http.ResponseWriter, r *http.Request, _ controller.Config) error {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	entities, err := m.mirror.GetEntities(ctx, query.Get(":name"))
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	latest := entities[0]
+	resp := transport.InfoResponse{
+		Type:   transport.Type(latest.Type),
+		ID:     latest.ID,
+		Name:   latest.Name,
+		Entity: transportEntity(latest),
+	}
+	for _, h := range channelHeads(entities) {
+		resp.ChannelMap = append(resp.ChannelMap, transport.InfoChannelMap{
+			Channel:  transportChannel(h.channel, h.base),
+			Revision: infoRevision(r, h.entity, false),
+		})
+	}
+
+	entity, channel := defaultRelease(entities)
+	if name := query.Get("channel"); name != "" {
+		resolved, err := m.mirror.Resolve(ctx, charmmirror.ResolveArgs{Name: latest.ID, Channel: name})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		entity, channel = resolved.Entity, resolved.Channel
+	}
+	resp.DefaultRelease = transport.InfoChannelMap{
+		Channel:  transportChannel(channel, firstBase(entity)),
+		Revision: infoRevision(r, entity, true),
+	}
+	return sendJSON(w, http.StatusOK, resp)
+}
+
+func (m *Mirror) serveFind(w http.ResponseWriter, r *http.Request, _ controller.Config) error {
+	entities, err := m.mirror.FindEntities(r.Context(), r.URL.Query().Get("q"))
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	resp := transport.FindResponses{
+		Results: []transport.FindResponse{},
+	}
+	// Entities are ordered by name, so each run of entities with the same
+	// name holds the revisions of one charm or bundle.
+	for start := 0; start < len(entities); {
+		end := start + 1
+		for end < len(entities) && entities[end].Name == entities[start].Name {
+			end++
+		}
+		group := entities[start:end]
+		start = end
+
+		entity, channel := defaultRelease(group)
+		resp.Results = append(resp.Results, transport.FindResponse{
+			Type:   transport.Type(entity.Type),
+			ID:     entity.ID,
+			Name:   entity.Name,
+			Entity: transportEntity(group[0]),
+			DefaultRelease: transport.FindChannelMap{
+				Channel: transportChannel(channel, firstBase(entity)),
+				Revision: transport.FindRevision{
+					CreatedAt: formatTime(entity.CreatedAt),
+					Download:  transportDownload(r, entity),
+					Bases:     transportBases(entity.Bases),
+					Revision:  entity.Revision,
+					Version:   entity.Version,
+				},
+			},
+		})
+	}
+	return sendJSON(w, http.StatusOK, resp)
+}
+
+func (m *Mirror) serveRefresh(w http.ResponseWriter, r *http.Request, cfg controller.Config) error {
+	var req transport.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return errors.Errorf("decoding refresh request: %w", err).Add(coreerrors.NotValid)
+	}
+
+	resp := transport.RefreshResponses{
+		Results: make([]transport.RefreshResponse, len(req.Actions)),
+	}
+	for i, action := range req.Actions {
+		resp.Results[i] = m.refreshAction(r, cfg, req, action)
+	}
+	return sendJSON(w, http.StatusOK, resp)
+}
+
+func (m *Mirror) refreshAction(
+	r *http.Request, cfg controller.Config,
+	req transport.RefreshRequest, action transport.RefreshRequestAction,
+) transport.RefreshResponse {
+	ctx := r.Context()
+	upstream := cfg.CharmhubMirrorUpstream()
+
+	args, err := resolveArgs(req, action)
+	if err != nil {
+		return refreshError(action, transport.APIError{
+			Code:    transport.ErrorCodeBadArgument,
+			Message: err.Error(),
+		})
+	}
+
+	// A revision never changes, so one held by the mirror is served without
+	// asking the upstream. Channels move on, so they are resolved upstream
+	// first, falling back to the mirror when the upstream cannot be reached.
+	var upstreamResult *transport.RefreshResponse
+	if upstream != "" && args.Revision == nil {
+		upstreamResult = m.refreshUpstream(r, cfg, req, action)
+		if upstreamResult != nil && upstreamResult.Error == nil {
+			return *upstreamResult
+		}
+	}
+
+	resolved, err := m.mirror.Resolve(ctx, args)
+	if err == nil {
+		return refreshResult(r, action, resolved)
+	}
+
+	if upstream != "" && args.Revision != nil &&
+		errors.IsOneOf(err, charmmirrorerrors.EntityNotFound, charmmirrorerrors.RevisionNotFound) {
+		upstreamResult = m.refreshUpstream(r, cfg, req, action)
+		if upstreamResult != nil && upstreamResult.Error == nil {
+			return *upstreamResult
+		}
+	}
+	// The upstream knows more than the mirror does, so its error is the
+	// more useful one to return.
+	if upstreamResult != nil {
+		return *upstreamResult
+	}
+
+	switch {
+	case errors.Is(err, charmmirrorerrors.EntityNotFound):
+		code := transport.ErrorCodeNameNotFound
+		if action.ID != nil {
+			code = transport.ErrorCodeIDNotFound
+		}
+		return refreshError(action, transport.APIError{
+			Code:    code,
+			Message: fmt.Sprintf("no charm or bundle %q in the charmhub mirror", args.Name),
+		})
+	case errors.Is(err, charmmirrorerrors.RevisionNotFound):
+		apiErr := transport.APIError{
+			Code:    transport.ErrorCodeRevisionNotFound,
+			Message: fmt.Sprintf("no revision of %q matching the request in the charmhub mirror", args.Name),
+		}
+		if entities, err := m.mirror.GetEntities(ctx, args.Name); err == nil {
+			for _, h := range channelHeads(entities) {
+				apiErr.Extra.Releases = append(apiErr.Extra.Releases, transport.Release{
+					Base:    transportBase(h.base),
+					Channel: h.channel.Name,
+				})
+			}
+		}
+		return refreshError(action, apiErr)
+	case errors.Is(err, coreerrors.NotValid):
+		return refreshError(action, transport.APIError{
+			Code:    transport.ErrorCodeBadArgument,
+			Message: err.Error(),
+		})
+	default:
+		m.logger.Errorf(ctx, "resolving %q from the charmhub mirror: %v", args.Name, err)
+		return refreshError(action, transport.APIError{
+			Code:    transport.ErrorCodeAPIError,
+			Message: err.Error(),
+		})
+	}
+}
+
+// refreshUpstream asks the upstream Charmhub to resolve the action, and
+// caches the revision it resolves to in the mirror. If the upstream cannot be
+// reached, nil is returned so that the action is resolved from the mirror.
+// Revisions the mirror cannot serve, because they have resources or their
+// archive is too large, are returned as errors.
+func (m *Mirror) refreshUpstream(
+	r *http.Request, cfg controller.Config,
+	req transport.RefreshRequest, action transport.RefreshRequestAction,
+) *transport.RefreshResponse {
+	ctx := r.Context()
+	upstream := cfg.CharmhubMirrorUpstream()
+
+	upstreamReq := transport.RefreshRequest{
+		Context: []transport.RefreshRequestContext{},
+		Actions: []transport.RefreshRequestAction{action},
+		Fields:  req.Fields,
+	}
+	for _, rctx := range req.Context {
+		if rctx.InstanceKey == action.InstanceKey {
+			upstreamReq.Context = append(upstreamReq.Context, rctx)
+		}
+	}
+
+	resp, err := m.postRefresh(ctx, upstream, upstreamReq)
+	if err != nil {
+		m.logger.Warningf(ctx, "resolving %q from the charmhub mirror upstream, falling back to the mirror: %v", action.InstanceKey, err)
+		return nil
+	}
+	if len(resp.Results) != 1 {
+		m.logger.Warningf(ctx, "charmhub mirror upstream returned %d results for %q, falling back to the mirror", len(resp.Results), action.InstanceKey)
+		return nil
+	}
+	result := resp.Results[0]
+	if result.Error != nil {
+		return &result
+	}
+	if len(result.Entity.Resources) > 0 {
+		rejected := resourcesNotMirrored(action, result.Entity.Name)
+		return &rejected
+	}
+
+	limits := charmmirror.CacheLimits{
+		MaxArchiveSize: int64(cfg.CharmhubMirrorMaxArchiveSizeMB()) * megabyte,
+		MaxSize:        int64(cfg.CharmhubMirrorMaxSizeMB()) * megabyte,
+	}
+	err = m.cacheUpstream(ctx, result, limits)
+	switch {
+	case errors.Is(err, charmmirrorerrors.ResourcesNotSupported):
+		rejected := resourcesNotMirrored(action, result.Entity.Name)
+		return &rejected
+	case errors.Is(err, charmmirrorerrors.ArchiveTooLarge):
+		rejected := refreshError(action, transport.APIError{
+			Code:    transport.ErrorCodeAPIError,
+			Message: fmt.Sprintf("cannot mirror %q: %v", result.Entity.Name, err),
+		})
+		return &rejected
+	case err != nil:
+		m.logger.Warningf(ctx, "caching %q revision %d in the charmhub mirror: %v", result.Entity.Name, result.Entity.Revision, err)
+		return nil
+	}
+	result.Entity.Download = transportDownload(r, charmmirror.Entity{
+		SHA256: result.Entity.Download.HashSHA256,
+		SHA384: result.Entity.Download.HashSHA384,
+		Size:   int64(result.Entity.Download.Size),
+	})
+	return &result
+}
+
+func (m *Mirror) postRefresh(ctx context.Context, upstream string, req transport.RefreshRequest) (transport.RefreshResponses, error) {
+	var resp transport.RefreshResponses
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return resp, errors.Errorf("marshalling refresh request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(upstream, "/")+"/v2/charms/refresh", bytes.NewReader(body))
+	if err != nil {
+		return resp, errors.Errorf("creating refresh request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := m.httpClient.Do(httpReq)
+	if err != nil {
+		return resp, errors.Errorf("posting refresh request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return resp, errors.Errorf("refresh request returned %q", httpResp.Status)
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return resp, errors.Errorf("decoding refresh response: %w", err)
+	}
+	if len(resp.ErrorList) > 0 {
+		return resp, errors.Errorf("refresh request failed: %w", resp.ErrorList)
+	}
+	return resp, nil
+}
+
+// cacheUpstream adds the revision resolved by the upstream to the mirror,
+// recording the channel it was resolved from. The archive is only downloaded
+// if the mirror does not already hold it.
+func (m *Mirror) cacheUpstream(ctx context.Context, result transport.RefreshResponse, limits charmmirror.CacheLimits) error {
+	e := result.Entity
+	entity := charmmirror.Entity{
+		ID:           e.ID,
+		Name:         e.Name,
+		Type:         string(e.Type),
+		Revision:     e.Revision,
+		Version:      e.Version,
+		Summary:      e.Summary,
+		License:      e.License,
+		Publisher:    e.Publisher["display-name"],
+		CreatedAt:    e.CreatedAt,
+		MetadataYAML: e.MetadataYAML,
+		ConfigYAML:   e.ConfigYAML,
+		ActionsYAML:  e.ActionsYAML,
+		SHA256:       e.Download.HashSHA256,
+		Size:         int64(e.Download.Size),
+	}
+	if entity.ID == "" {
+		entity.ID = result.ID
+	}
+	for _, base := range e.Bases {
+		entity.Bases = append(entity.Bases, mirrorBase(base))
+	}
+	if result.EffectiveChannel != "" {
+		entity.Channels = []charmmirror.Channel{{
+			Name:       result.EffectiveChannel,
+			ReleasedAt: result.ReleasedAt,
+		}}
+	}
+
+	archive, _, err := m.mirror.OpenArchive(ctx, entity.SHA256)
+	if errors.Is(err, charmmirrorerrors.ArchiveNotFound) {
+		archive, err = m.downloadUpstream(ctx, e.Download.URL)
+	}
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer archive.Close()
+
+	return m.mirror.CacheEntity(ctx, entity, archive, limits)
+}
+
+func (m *Mirror) downloadUpstream(ctx context.Context, downloadURL string) (io.ReadCloser, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, errors.Errorf("creating download request: %w", err)
+	}
+	httpResp, err := m.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, errors.Errorf("downloading %q: %w", downloadURL, err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		_ = httpResp.Body.Close()
+		return nil, errors.Errorf("downloading %q returned %q", downloadURL, httpResp.Status)
+	}
+	return httpResp.Body, nil
+}
+
+func (m *Mirror) serveDownload(w http.ResponseWriter, r *http.Request, _ controller.Config) error {
+	sha256 := r.URL.Query().Get(":sha256")
+	reader, size, err := m.mirror.OpenArchive(r.Context(), sha256)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer reader.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, reader); err != nil {
+		// The headers have been sent, so all that can be done is to log.
+		m.logger.Errorf(r.Context(), "sending archive %q: %v", sha256, err)
+	}
+	return nil
+}
+
+func (m *Mirror) sendError(w http.ResponseWriter, r *http.Request, status int, code transport.APIErrorCode, message string) {
+	if err := sendJSON(w, status, errorResponse{
+		ErrorList: transport.APIErrors{{Code: code, Message: message}},
+	}); err != nil {
+		m.logger.Errorf(r.Context(), "cannot return error to charmhub mirror client: %v", err)
+	}
+}
+
+type errorResponse struct {
+	ErrorList transport.APIErrors `json:"error-list"`
+}
+
+func sendJSON(w http.ResponseWriter, status int, resp any) error {
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return errors.Errorf("marshalling response: %w", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		return errors.Errorf("writing response: %w", err)
+	}
+	return nil
+}
+
+// resolveArgs returns the arguments to resolve a refresh action from the
+// mirror with.
+func resolveArgs(req transport.RefreshRequest, action transport.RefreshRequestAction) (charmmirror.ResolveArgs, error) {
+	args := charmmirror.ResolveArgs{
+		Revision: action.Revision,
+	}
+	if action.Channel != nil {
+		args.Channel = *action.Channel
+	}
+	if action.Base != nil {
+		args.Base = mirrorBase(*action.Base)
+	}
+
+	switch action.Action {
+	case "install", "download":
+		switch {
+		case action.ID != nil && *action.ID != "":
+			args.Name = *action.ID
+		case action.Name != nil && *action.Name != "":
+			args.Name = *action.Name
+		default:
+			return args, errors.Errorf("%s action %q has no id or name", action.Action, action.InstanceKey)
+		}
+	case "refresh":
+		var found bool
+		for _, rctx := range req.Context {
+			if rctx.InstanceKey != action.InstanceKey {
+				continue
+			}
+			args.Name = rctx.ID
+			args.Base = mirrorBase(rctx.Base)
+			if args.Channel == "" {
+				args.Channel = rctx.TrackingChannel
+			}
+			found = true
+			break
+		}
+		if !found {
+			return args, errors.Errorf("refresh action %q has no context", action.InstanceKey)
+		}
+	default:
+		return args, errors.Errorf("unknown action %q", action.Action)
+	}
+	return args, nil
+}
+
+func refreshResult(r *http.Request, action transport.RefreshRequestAction, resolved charmmirror.Resolved) transport.RefreshResponse {
+	entity := resolved.Entity
+	return transport.RefreshResponse{
+		Entity: transport.RefreshEntity{
+			Type:         transport.Type(entity.Type),
+			Download:     transportDownload(r, entity),
+			ID:           entity.ID,
+			License:      entity.License,
+			Name:         entity.Name,
+			Publisher:    publisher(entity.Publisher),
+			Resources:    []transport.ResourceRevision{},
+			Bases:        transportBases(entity.Bases),
+			Revision:     entity.Revision,
+			Summary:      entity.Summary,
+			Version:      entity.Version,
+			CreatedAt:    entity.CreatedAt,
+			MetadataYAML: entity.MetadataYAML,
+			ConfigYAML:   entity.ConfigYAML,
+			ActionsYAML:  entity.ActionsYAML,
+		},
+		EffectiveChannel: resolved.Channel.Name,
+		ID:               entity.ID,
+		InstanceKey:      action.InstanceKey,
+		Name:             entity.Name,
+		Result:           action.Action,
+		ReleasedAt:       resolved.Channel.ReleasedAt,
+	}
+}
+
+// resourcesNotMirrored returns the error for a charm with resources, which
+// could not be deployed from the mirror as resources are not mirrored.
+func resourcesNotMirrored(action transport.RefreshRequestAction, name string) transport.RefreshResponse {
+	return refreshError(action, transport.APIError{
+		Code:    transport.ErrorCodeInvalidCharmResource,
+		Message: fmt.Sprintf("%q has resources, which the charmhub mirror does not serve", name),
+	})
+}
+
+func refreshError(action transport.RefreshRequestAction, apiErr transport.APIError) transport.RefreshResponse {
+	resp := transport.RefreshResponse{
+		Error:       &apiErr,
+		InstanceKey: action.InstanceKey,
+		Result:      "error",
+	}
+	if action.ID != nil {
+		resp.ID = *action.ID
+	}
+	if action.Name != nil {
+		resp.Name = *action.Name
+	}
+	return resp
+}
+
+type channelHead struct {
+	entity  charmmirror.Entity
+	channel charmmirror.Channel
+	base    charmmirror.Base
+}
+
+// channelHeads returns the revision most recently released to each channel
+// and base, ordered by channel and base.
+func channelHeads(entities []charmmirror.Entity) []channelHead {
+	type key struct {
+		channel string
+		base    charmmirror.Base
+	}
+	heads := make(map[key]channelHead)
+	for _, entity := range entities {
+		for _, ch := range entity.Channels {
+			for _, base := range entityBases(entity) {
+				k := key{channel: ch.Name, base: base}
+				if existing, ok := heads[k]; ok && !ch.ReleasedAt.After(existing.channel.ReleasedAt) {
+					continue
+				}
+				heads[k] = channelHead{entity: entity, channel: ch, base: base}
+			}
+		}
+	}
+
+	result := make([]channelHead, 0, len(heads))
+	for _, h := range heads {
+		result = append(result, h)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.channel.Name != b.channel.Name {
+			return a.channel.Name < b.channel.Name
+		}
+		if a.base.OS != b.base.OS {
+			return a.base.OS < b.base.OS
+		}
+		if a.base.Channel != b.base.Channel {
+			return a.base.Channel < b.base.Channel
+		}
+		return a.base.Architecture < b.base.Architecture
+	})
+	return result
+}
+
+// defaultRelease returns the revision most recently released to
+// latest/stable, or the highest revision if none has been.
+func defaultRelease(entities []charmmirror.Entity) (charmmirror.Entity, charmmirror.Channel) {
+	var (
+		result  charmmirror.Entity
+		channel charmmirror.Channel
+		found   bool
+	)
+	for _, entity := range entities {
+		for _, ch := range entity.Channels {
+			if ch.Name == defaultChannel && (!found || ch.ReleasedAt.After(channel.ReleasedAt)) {
+				result, channel, found = entity, ch, true
+			}
+		}
+	}
+	if found {
+		return result, channel
+	}
+
+	result = entities[0]
+	for _, ch := range result.Channels {
+		if ch.ReleasedAt.After(channel.ReleasedAt) {
+			channel = ch
+		}
+	}
+	return result, channel
+}
+
+func infoRevision(r *http.Request, entity charmmirror.Entity, withMetadata bool) transport.InfoRevision {
+	rev := transport.InfoRevision{
+		CreatedAt: formatTime(entity.CreatedAt),
+		Download:  transportDownload(r, entity),
+		Bases:     transportBases(entity.Bases),
+		Revision:  entity.Revision,
+		Version:   entity.Version,
+	}
+	if withMetadata {
+		rev.MetadataYAML = entity.MetadataYAML
+		rev.ConfigYAML = entity.ConfigYAML
+	}
+	return rev
+}
+
+func transportEntity(entity charmmirror.Entity) transport.Entity {
+	return transport.Entity{
+		License:   entity.License,
+		Publisher: publisher(entity.Publisher),
+		Summary:   entity.Summary,
+	}
+}
+
+// transportDownload returns the download details for the archive of the
+// entity, which is served by the mirror at the address the request was made
+// to. The API server only serves TLS, so the scheme is always https.
+func transportDownload(r *http.Request, entity charmmirror.Entity) transport.Download {
+	u := url.URL{
+		Scheme: "https",
+		Host:   r.Host,
+		Path:   path.Join(RoutePrefix, "download", entity.SHA256),
+	}
+	return transport.Download{
+		HashSHA256: entity.SHA256,
+		HashSHA384: entity.SHA384,
+		Size:       int(entity.Size),
+		URL:        u.String(),
+	}
+}
+
+func transportChannel(ch charmmirror.Channel, base charmmirror.Base) transport.Channel {
+	var track, risk string
+	parts := strings.SplitN(ch.Name, "/", 3)
+	if len(parts) >= 2 {
+		track, risk = parts[0], parts[1]
+	}
+	return transport.Channel{
+		Name:       ch.Name,
+		Base:       transportBase(base),
+		ReleasedAt: formatTime(ch.ReleasedAt),
+		Risk:       risk,
+		Track:      track,
+	}
+}
+
+func transportBase(base charmmirror.Base) transport.Base {
+	return transport.Base{
+		Architecture: base.Architecture,
+		Name:         base.OS,
+		Channel:      base.Channel,
+	}
+}
+
+func transportBases(bases []charmmirror.Base) []transport.Base {
+	result := make([]transport.Base, len(bases))
+	for i, base := range bases {
+		result[i] = transportBase(base)
+	}
+	return result
+}
+
+func mirrorBase(base transport.Base) charmmirror.Base {
+	result := charmmirror.Base{
+		Architecture: base.Architecture,
+		OS:           base.Name,
+		Channel:      base.Channel,
+	}
+	if result.OS == notAvailable {
+		result.OS = ""
+	}
+	if result.Channel == notAvailable {
+		result.Channel = ""
+	}
+	return result
+}
+
+func entityBases(entity charmmirror.Entity) []charmmirror.Base {
+	if len(entity.Bases) == 0 {
+		return []charmmirror.Base{{}}
+	}
+	return entity.Bases
+}
+
+func firstBase(entity charmmirror.Entity) charmmirror.Base {
+	return entityBases(entity)[0]
+}
+
+func publisher(name string) map[string]string {
+	if name == "" {
+		return nil
+	}
+	return map[string]string{"display-name": name}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,620 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmhubmirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/internal/auth"
+	"github.com/juju/juju/internal/charmhub/transport"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type mirrorSuite struct {
+	mirror     *MockMirrorService
+	config     *MockControllerConfigService
+	httpClient *MockHTTPClient
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+func TestMirrorSuite(t *stdtesting.T) {
+	tc.Run(t, &mirrorSuite{})
+}
+
+func (s *mirrorSuite) SetUpTest(c *tc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *mirrorSuite) TearDownTest(c *tc.C) {
+	s.srv.Close()
+}
+
+func (s *mirrorSuite) TestDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.config.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil)
+
+	resp := s.get(c, "/charmhub-mirror/v2/charms/info/postgresql")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusNotFound)
+	c.Check(s.errorCode(c, resp), tc.Equals, transport.ErrorCodeEndpointDisabled)
+}
+
+func (s *mirrorSuite) TestUnauthenticated(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	m := NewMirror(s.mirror, s.config, s.httpClient, loggertesting.WrapCheckLog(c))
+	s.mux.AddHandler("GET", InfoRoute, m.InfoHandler())
+	defer s.mux.RemoveHandler("GET", InfoRoute)
+
+	resp, err := http.Get(s.srv.URL + "/charmhub-mirror/v2/charms/info/postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusUnauthorized)
+	c.Check(resp.Header.Get("WWW-Authenticate"), tc.Equals, `Basic realm="juju charmhub mirror"`)
+	c.Check(s.errorCode(c, resp), tc.Equals, transport.ErrorCodeUserAuthenticationError)
+}
+
+func (s *mirrorSuite) TestUnauthorized(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mirror.EXPECT().AuthenticateClient(gomock.Any(), "downstream", gomock.Any()).
+		Return(charmmirrorerrors.ClientUnauthorized)
+
+	m := NewMirror(s.mirror, s.config, s.httpClient, loggertesting.WrapCheckLog(c))
+	s.mux.AddHandler("POST", RefreshRoute, m.RefreshHandler())
+	defer s.mux.RemoveHandler("POST", RefreshRoute)
+
+	req, err := http.NewRequest("POST", s.srv.URL+"/charmhub-mirror/v2/charms/refresh", strings.NewReader("{}"))
+	c.Assert(err, tc.ErrorIsNil)
+	req.SetBasicAuth("downstream", "wrong-password")
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusUnauthorized)
+	c.Check(s.errorCode(c, resp), tc.Equals, transport.ErrorCodeUserAuthenticationError)
+}
+
+func (s *mirrorSuite) TestInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	rev1 := s.entity(1, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)})
+	rev2 := s.entity(2,
+		charmmirror.Channel{Name: "latest/edge", ReleasedAt: s.releasedAt(1)},
+		charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(2)},
+	)
+	s.mirror.EXPECT().GetEntities(gomock.Any(), "postgresql").Return([]charmmirror.Entity{rev2, rev1}, nil)
+
+	resp := s.get(c, "/charmhub-mirror/v2/charms/info/postgresql")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var info transport.InfoResponse
+	err := json.NewDecoder(resp.Body).Decode(&info)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.Name, tc.Equals, "postgresql")
+	c.Check(info.ID, tc.Equals, "id-postgresql")
+	c.Check(info.Entity.Publisher, tc.DeepEquals, map[string]string{"display-name": "Canonical"})
+	c.Assert(info.ChannelMap, tc.HasLen, 2)
+	c.Check(info.ChannelMap[0].Channel.Name, tc.Equals, "latest/edge")
+	c.Check(info.ChannelMap[0].Revision.Revision, tc.Equals, 2)
+	c.Check(info.ChannelMap[1].Channel.Name, tc.Equals, "latest/stable")
+	c.Check(info.ChannelMap[1].Channel.Track, tc.Equals, "latest")
+	c.Check(info.ChannelMap[1].Channel.Risk, tc.Equals, "stable")
+	c.Check(info.ChannelMap[1].Revision.Revision, tc.Equals, 2)
+	c.Check(info.DefaultRelease.Revision.Revision, tc.Equals, 2)
+	c.Check(info.DefaultRelease.Revision.MetadataYAML, tc.Equals, "name: postgresql\n")
+	c.Check(info.DefaultRelease.Revision.Download.URL, tc.Equals,
+		"https://"+strings.TrimPrefix(s.srv.URL, "http://")+"/charmhub-mirror/download/sha256-2")
+}
+
+func (s *mirrorSuite) TestInfoNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	s.mirror.EXPECT().GetEntities(gomock.Any(), "postgresql").Return(nil, charmmirrorerrors.EntityNotFound)
+
+	resp := s.get(c, "/charmhub-mirror/v2/charms/info/postgresql")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusNotFound)
+	c.Check(s.errorCode(c, resp), tc.Equals, transport.ErrorCodeNotFound)
+}
+
+func (s *mirrorSuite) TestFind(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	mysql := s.entity(3, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)})
+	mysql.ID, mysql.Name = "id-mysql", "mysql"
+	s.mirror.EXPECT().FindEntities(gomock.Any(), "sql").Return([]charmmirror.Entity{
+		mysql,
+		s.entity(2, charmmirror.Channel{Name: "latest/edge", ReleasedAt: s.releasedAt(1)}),
+		s.entity(1, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)}),
+	}, nil)
+
+	resp := s.get(c, "/charmhub-mirror/v2/charms/find?q=sql")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var find transport.FindResponses
+	err := json.NewDecoder(resp.Body).Decode(&find)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(find.Results, tc.HasLen, 2)
+	c.Check(find.Results[0].Name, tc.Equals, "mysql")
+	c.Check(find.Results[0].DefaultRelease.Revision.Revision, tc.Equals, 3)
+	c.Check(find.Results[1].Name, tc.Equals, "postgresql")
+	c.Check(find.Results[1].DefaultRelease.Revision.Revision, tc.Equals, 1)
+	c.Check(find.Results[1].DefaultRelease.Channel.Name, tc.Equals, "latest/stable")
+}
+
+func (s *mirrorSuite) TestRefreshInstall(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	rev := s.entity(2, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)})
+	s.mirror.EXPECT().Resolve(gomock.Any(), charmmirror.ResolveArgs{
+		Name:    "postgresql",
+		Channel: "stable",
+		Base:    charmmirror.Base{Architecture: "amd64"},
+	}).Return(charmmirror.Resolved{Entity: rev, Channel: rev.Channels[0]}, nil)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+			Channel:     ptr("stable"),
+			Base:        &transport.Base{Architecture: "amd64", Name: "NA", Channel: "NA"},
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	result := resp.Results[0]
+	c.Check(result.Error, tc.IsNil)
+	c.Check(result.InstanceKey, tc.Equals, "key")
+	c.Check(result.Result, tc.Equals, "install")
+	c.Check(result.EffectiveChannel, tc.Equals, "latest/stable")
+	c.Check(result.Entity.Revision, tc.Equals, 2)
+	c.Check(result.Entity.MetadataYAML, tc.Equals, "name: postgresql\n")
+	c.Check(result.Entity.Download.HashSHA256, tc.Equals, "sha256-2")
+}
+
+func (s *mirrorSuite) TestRefreshRefreshUsesContext(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	rev := s.entity(2, charmmirror.Channel{Name: "latest/edge", ReleasedAt: s.releasedAt(0)})
+	s.mirror.EXPECT().Resolve(gomock.Any(), charmmirror.ResolveArgs{
+		Name:    "id-postgresql",
+		Channel: "latest/edge",
+		Base:    charmmirror.Base{Architecture: "amd64", OS: "ubuntu", Channel: "22.04"},
+	}).Return(charmmirror.Resolved{Entity: rev, Channel: rev.Channels[0]}, nil)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Context: []transport.RefreshRequestContext{{
+			InstanceKey:     "key",
+			ID:              "id-postgresql",
+			Revision:        1,
+			Base:            transport.Base{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"},
+			TrackingChannel: "latest/edge",
+		}},
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "refresh",
+			InstanceKey: "key",
+			ID:          ptr("id-postgresql"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Check(resp.Results[0].Error, tc.IsNil)
+	c.Check(resp.Results[0].Entity.Revision, tc.Equals, 2)
+}
+
+func (s *mirrorSuite) TestRefreshNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	s.mirror.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(charmmirror.Resolved{}, charmmirrorerrors.EntityNotFound)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Assert(resp.Results[0].Error, tc.NotNil)
+	c.Check(resp.Results[0].Error.Code, tc.Equals, transport.ErrorCodeNameNotFound)
+	c.Check(resp.Results[0].Result, tc.Equals, "error")
+}
+
+func (s *mirrorSuite) TestRefreshRevisionNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	rev := s.entity(2, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)})
+	s.mirror.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(charmmirror.Resolved{}, charmmirrorerrors.RevisionNotFound)
+	s.mirror.EXPECT().GetEntities(gomock.Any(), "postgresql").Return([]charmmirror.Entity{rev}, nil)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+			Channel:     ptr("latest/edge"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Assert(resp.Results[0].Error, tc.NotNil)
+	c.Check(resp.Results[0].Error.Code, tc.Equals, transport.ErrorCodeRevisionNotFound)
+	c.Check(resp.Results[0].Error.Extra.Releases, tc.DeepEquals, []transport.Release{{
+		Base:    transport.Base{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"},
+		Channel: "latest/stable",
+	}})
+}
+
+func (s *mirrorSuite) TestRefreshUpstreamCaches(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("https://api.charmhub.io")
+
+	releasedAt := s.releasedAt(0)
+	upstream := transport.RefreshResponses{
+		Results: []transport.RefreshResponse{{
+			Entity: transport.RefreshEntity{
+				Type:     transport.CharmType,
+				ID:       "id-postgresql",
+				Name:     "postgresql",
+				Revision: 3,
+				Bases:    []transport.Base{{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"}},
+				Download: transport.Download{
+					HashSHA256: "sha256-3",
+					HashSHA384: "sha384-3",
+					Size:       7,
+					URL:        "https://api.charmhub.io/api/v1/charms/download/id-postgresql_3.charm",
+				},
+			},
+			EffectiveChannel: "latest/stable",
+			ID:               "id-postgresql",
+			InstanceKey:      "key",
+			Name:             "postgresql",
+			Result:           "install",
+			ReleasedAt:       releasedAt,
+		}},
+	}
+	s.httpClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		c.Check(req.Method, tc.Equals, http.MethodPost)
+		c.Check(req.URL.String(), tc.Equals, "https://api.charmhub.io/v2/charms/refresh")
+		return s.jsonResponse(c, upstream), nil
+	})
+	s.mirror.EXPECT().OpenArchive(gomock.Any(), "sha256-3").Return(nil, int64(-1), charmmirrorerrors.ArchiveNotFound)
+	s.httpClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		c.Check(req.URL.String(), tc.Equals, "https://api.charmhub.io/api/v1/charms/download/id-postgresql_3.charm")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("archive")),
+		}, nil
+	})
+	s.mirror.EXPECT().CacheEntity(gomock.Any(), gomock.Any(), gomock.Any(), charmmirror.CacheLimits{
+		MaxArchiveSize: controller.DefaultCharmhubMirrorMaxArchiveSize * megabyte,
+		MaxSize:        controller.DefaultCharmhubMirrorMaxSize * megabyte,
+	}).DoAndReturn(
+		func(_ context.Context, entity charmmirror.Entity, r io.Reader, _ charmmirror.CacheLimits) error {
+			c.Check(entity.Name, tc.Equals, "postgresql")
+			c.Check(entity.Revision, tc.Equals, 3)
+			c.Check(entity.SHA256, tc.Equals, "sha256-3")
+			c.Check(entity.Channels, tc.DeepEquals, []charmmirror.Channel{{Name: "latest/stable", ReleasedAt: releasedAt}})
+			data, err := io.ReadAll(r)
+			c.Check(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "archive")
+			return nil
+		})
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	result := resp.Results[0]
+	c.Check(result.Error, tc.IsNil)
+	c.Check(result.Entity.Revision, tc.Equals, 3)
+	c.Check(result.Entity.Resources, tc.HasLen, 0)
+	c.Check(result.Entity.Download.URL, tc.Equals,
+		"https://"+strings.TrimPrefix(s.srv.URL, "http://")+"/charmhub-mirror/download/sha256-3")
+}
+
+func (s *mirrorSuite) TestRefreshUpstreamRejectsResources(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("https://api.charmhub.io")
+	upstream := transport.RefreshResponses{
+		Results: []transport.RefreshResponse{{
+			Entity: transport.RefreshEntity{
+				Type:      transport.CharmType,
+				ID:        "id-postgresql",
+				Name:      "postgresql",
+				Revision:  3,
+				Resources: []transport.ResourceRevision{{Name: "image"}},
+			},
+			EffectiveChannel: "latest/stable",
+			InstanceKey:      "key",
+			Result:           "install",
+		}},
+	}
+	s.httpClient.EXPECT().Do(gomock.Any()).Return(s.jsonResponse(c, upstream), nil)
+	s.mirror.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(charmmirror.Resolved{}, charmmirrorerrors.EntityNotFound)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Assert(resp.Results[0].Error, tc.NotNil)
+	c.Check(resp.Results[0].Error.Code, tc.Equals, transport.ErrorCodeInvalidCharmResource)
+	c.Check(resp.Results[0].Error.Message, tc.Equals, `"postgresql" has resources, which the charmhub mirror does not serve`)
+}
+
+func (s *mirrorSuite) TestRefreshUpstreamArchiveTooLarge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("https://api.charmhub.io")
+	upstream := transport.RefreshResponses{
+		Results: []transport.RefreshResponse{{
+			Entity: transport.RefreshEntity{
+				Type:     transport.CharmType,
+				ID:       "id-postgresql",
+				Name:     "postgresql",
+				Revision: 3,
+				Download: transport.Download{HashSHA256: "sha256-3", Size: 7},
+			},
+			InstanceKey: "key",
+			Result:      "install",
+		}},
+	}
+	s.httpClient.EXPECT().Do(gomock.Any()).Return(s.jsonResponse(c, upstream), nil)
+	s.mirror.EXPECT().OpenArchive(gomock.Any(), "sha256-3").Return(io.NopCloser(strings.NewReader("archive")), int64(7), nil)
+	s.mirror.EXPECT().CacheEntity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(charmmirrorerrors.ArchiveTooLarge)
+	s.mirror.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(charmmirror.Resolved{}, charmmirrorerrors.EntityNotFound)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Assert(resp.Results[0].Error, tc.NotNil)
+	c.Check(resp.Results[0].Error.Message, tc.Matches, `cannot mirror "postgresql": .*too large`)
+}
+
+func (s *mirrorSuite) TestRefreshUpstreamUnreachable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("https://api.charmhub.io")
+	s.httpClient.EXPECT().Do(gomock.Any()).Return(nil, io.ErrUnexpectedEOF)
+	rev := s.entity(2, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)})
+	s.mirror.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(charmmirror.Resolved{Entity: rev, Channel: rev.Channels[0]}, nil)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "install",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Check(resp.Results[0].Error, tc.IsNil)
+	c.Check(resp.Results[0].Entity.Revision, tc.Equals, 2)
+}
+
+func (s *mirrorSuite) TestRefreshPinnedRevisionServedLocally(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The upstream is not asked about revisions the mirror holds.
+	s.expectEnabled("https://api.charmhub.io")
+	rev := s.entity(2, charmmirror.Channel{Name: "latest/stable", ReleasedAt: s.releasedAt(0)})
+	s.mirror.EXPECT().Resolve(gomock.Any(), charmmirror.ResolveArgs{
+		Name:     "postgresql",
+		Revision: ptr(2),
+	}).Return(charmmirror.Resolved{Entity: rev}, nil)
+
+	resp := s.refresh(c, transport.RefreshRequest{
+		Actions: []transport.RefreshRequestAction{{
+			Action:      "download",
+			InstanceKey: "key",
+			Name:        ptr("postgresql"),
+			Revision:    ptr(2),
+		}},
+	})
+	c.Assert(resp.Results, tc.HasLen, 1)
+	c.Check(resp.Results[0].Error, tc.IsNil)
+	c.Check(resp.Results[0].Result, tc.Equals, "download")
+}
+
+func (s *mirrorSuite) TestDownload(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	s.mirror.EXPECT().OpenArchive(gomock.Any(), "sha256-2").Return(io.NopCloser(strings.NewReader("archive")), int64(7), nil)
+
+	resp := s.get(c, "/charmhub-mirror/download/sha256-2")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(resp.ContentLength, tc.Equals, int64(7))
+	data, err := io.ReadAll(resp.Body)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "archive")
+}
+
+func (s *mirrorSuite) TestDownloadNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectEnabled("")
+	s.mirror.EXPECT().OpenArchive(gomock.Any(), "sha256-2").Return(nil, int64(-1), charmmirrorerrors.ArchiveNotFound)
+
+	resp := s.get(c, "/charmhub-mirror/download/sha256-2")
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusNotFound)
+}
+
+func (s *mirrorSuite) TestMethodNotAllowed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mux.AddHandler("POST", InfoRoute, NewMirror(s.mirror, s.config, s.httpClient, loggertesting.WrapCheckLog(c)).InfoHandler())
+	defer s.mux.RemoveHandler("POST", InfoRoute)
+
+	resp, err := http.Post(s.srv.URL+"/charmhub-mirror/v2/charms/info/postgresql", "application/json", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, tc.Equals, http.StatusMethodNotAllowed)
+}
+
+func (s *mirrorSuite) get(c *tc.C, path string) *http.Response {
+	m := NewMirror(s.mirror, s.config, s.httpClient, loggertesting.WrapCheckLog(c))
+	for route, handler := range map[string]http.Handler{
+		InfoRoute:     m.InfoHandler(),
+		FindRoute:     m.FindHandler(),
+		DownloadRoute: m.DownloadHandler(),
+	} {
+		s.mux.AddHandler("GET", route, handler)
+		defer s.mux.RemoveHandler("GET", route)
+	}
+
+	s.expectAuthenticated()
+	req, err := http.NewRequest("GET", s.srv.URL+path, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	req.SetBasicAuth("downstream", "sekrit-password")
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	return resp
+}
+
+func (s *mirrorSuite) refresh(c *tc.C, req transport.RefreshRequest) transport.RefreshResponses {
+	m := NewMirror(s.mirror, s.config, s.httpClient, loggertesting.WrapCheckLog(c))
+	s.mux.AddHandler("POST", RefreshRoute, m.RefreshHandler())
+	defer s.mux.RemoveHandler("POST", RefreshRoute)
+
+	s.expectAuthenticated()
+	body, err := json.Marshal(req)
+	c.Assert(err, tc.ErrorIsNil)
+	httpReq, err := http.NewRequest("POST", s.srv.URL+"/charmhub-mirror/v2/charms/refresh", bytes.NewReader(body))
+	c.Assert(err, tc.ErrorIsNil)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.SetBasicAuth("downstream", "sekrit-password")
+	resp, err := http.DefaultClient.Do(httpReq)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, tc.Equals, http.StatusOK)
+
+	var result transport.RefreshResponses
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	return result
+}
+
+func (s *mirrorSuite) errorCode(c *tc.C, resp *http.Response) transport.APIErrorCode {
+	var result errorResponse
+	err := json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.ErrorList, tc.HasLen, 1)
+	return result.ErrorList[0].Code
+}
+
+func (s *mirrorSuite) jsonResponse(c *tc.C, v any) *http.Response {
+	body, err := json.Marshal(v)
+	c.Assert(err, tc.ErrorIsNil)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
+
+func (s *mirrorSuite) expectAuthenticated() {
+	s.mirror.EXPECT().AuthenticateClient(gomock.Any(), "downstream", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, password auth.Password) error {
+			salt := []byte("salt")
+			expected, err := auth.HashPassword(auth.NewPassword("sekrit-password"), salt)
+			if err != nil {
+				return err
+			}
+			if got, err := auth.HashPassword(password, salt); err != nil || got != expected {
+				return charmmirrorerrors.ClientUnauthorized
+			}
+			return nil
+		})
+}
+
+func (s *mirrorSuite) expectEnabled(upstream string) {
+	cfg := controller.Config{controller.CharmhubMirror: true}
+	if upstream != "" {
+		cfg[controller.CharmhubMirrorUpstream] = upstream
+	}
+	s.config.EXPECT().ControllerConfig(gomock.Any()).Return(cfg, nil)
+}
+
+func (s *mirrorSuite) entity(revision int, channels ...charmmirror.Channel) charmmirror.Entity {
+	return charmmirror.Entity{
+		ID:           "id-postgresql",
+		Name:         "postgresql",
+		Type:         "charm",
+		Revision:     revision,
+		Summary:      "postgresql summary",
+		Publisher:    "Canonical",
+		CreatedAt:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		MetadataYAML: "name: postgresql\n",
+		Bases: []charmmirror.Base{{
+			Architecture: "amd64",
+			OS:           "ubuntu",
+			Channel:      "22.04",
+		}},
+		Channels: channels,
+		SHA256:   fmt.Sprintf("sha256-%d", revision),
+		SHA384:   fmt.Sprintf("sha384-%d", revision),
+		Size:     7,
+	}
+}
+
+func (s *mirrorSuite) releasedAt(days int) time.Time {
+	return time.Date(2026, 2, 1+days, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *mirrorSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.mirror = NewMockMirrorService(ctrl)
+	s.config = NewMockControllerConfigService(ctrl)
+	s.httpClient = NewMockHTTPClient(ctrl)
+
+	return ctrl
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
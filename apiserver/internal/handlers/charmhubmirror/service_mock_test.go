@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/charmhubmirror (interfaces: MirrorService,ControllerConfigService,HTTPClient)
+//
+// Generated by this command:
+//
+//	mockgen -package charmhubmirror -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/charmhubmirror MirrorService,ControllerConfigService,HTTPClient
+//
+
+// Package charmhubmirror is a generated GoMock package.
+package charmhubmirror
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+
+	gomock "github.com/canonical/gomock/gomock"
+	controller "github.com/juju/juju/controller"
+	charmmirror "github.com/juju/juju/domain/charmmirror"
+	auth "github.com/juju/juju/internal/auth"
+)
+
+// MockMirrorService is a mock of MirrorService interface.
+type MockMirrorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMirrorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMirrorServiceMockRecorder is the mock recorder for MockMirrorService.
+type MockMirrorServiceMockRecorder struct {
+	mock                      *MockMirrorService
+	authenticateClientExpects []*gomock.Call3_1[context.Context, string, auth.Password, error]
+	cacheEntityExpects        []*gomock.Call4_1[context.Context, charmmirror.Entity, io.Reader, charmmirror.CacheLimits, error]
+	findEntitiesExpects       []*gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+	getEntitiesExpects        []*gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+	openArchiveExpects        []*gomock.Call2_3[context.Context, string, io.ReadCloser, int64, error]
+	resolveExpects            []*gomock.Call2_2[context.Context, charmmirror.ResolveArgs, charmmirror.Resolved, error]
+}
+
+// NewMockMirrorService creates a new mock instance.
+func NewMockMirrorService(ctrl *gomock.Controller) *MockMirrorService {
+	mock := &MockMirrorService{ctrl: ctrl}
+	mock.recorder = &MockMirrorServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMirrorService) EXPECT() *MockMirrorServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateClient mocks base method.
+func (m *MockMirrorService) AuthenticateClient(ctx context.Context, name string, password auth.Password) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.authenticateClientExpects, m.ctrl, m, "AuthenticateClient", ctx, name, password)
+}
+
+// AuthenticateClient indicates an expected call of AuthenticateClient.
+func (mr *MockMirrorServiceMockRecorder) AuthenticateClient(ctx, name, password any) *MockMirrorServiceAuthenticateClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, auth.Password, error](mr.mock.ctrl.T, mr.mock, "AuthenticateClient", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(password))
+	mr.authenticateClientExpects = append(mr.authenticateClientExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMirrorServiceAuthenticateClientCall is the typed call wrapper for AuthenticateClient.
+type MockMirrorServiceAuthenticateClientCall = gomock.Call3_1[context.Context, string, auth.Password, error]
+
+// CacheEntity mocks base method.
+func (m *MockMirrorService) CacheEntity(ctx context.Context, entity charmmirror.Entity, r io.Reader, limits charmmirror.CacheLimits) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.cacheEntityExpects, m.ctrl, m, "CacheEntity", ctx, entity, r, limits)
+}
+
+// CacheEntity indicates an expected call of CacheEntity.
+func (mr *MockMirrorServiceMockRecorder) CacheEntity(ctx, entity, r, limits any) *MockMirrorServiceCacheEntityCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, charmmirror.Entity, io.Reader, charmmirror.CacheLimits, error](mr.mock.ctrl.T, mr.mock, "CacheEntity", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(entity), gomock.EnsureMatcher(r), gomock.EnsureMatcher(limits))
+	mr.cacheEntityExpects = append(mr.cacheEntityExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMirrorServiceCacheEntityCall is the typed call wrapper for CacheEntity.
+type MockMirrorServiceCacheEntityCall = gomock.Call4_1[context.Context, charmmirror.Entity, io.Reader, charmmirror.CacheLimits, error]
+
+// FindEntities mocks base method.
+func (m *MockMirrorService) FindEntities(ctx context.Context, query string) ([]charmmirror.Entity, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.findEntitiesExpects, m.ctrl, m, "FindEntities", ctx, query)
+}
+
+// FindEntities indicates an expected call of FindEntities.
+func (mr *MockMirrorServiceMockRecorder) FindEntities(ctx, query any) *MockMirrorServiceFindEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, []charmmirror.Entity, error](mr.mock.ctrl.T, mr.mock, "FindEntities", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(query))
+	mr.findEntitiesExpects = append(mr.findEntitiesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMirrorServiceFindEntitiesCall is the typed call wrapper for FindEntities.
+type MockMirrorServiceFindEntitiesCall = gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+
+// GetEntities mocks base method.
+func (m *MockMirrorService) GetEntities(ctx context.Context, name string) ([]charmmirror.Entity, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getEntitiesExpects, m.ctrl, m, "GetEntities", ctx, name)
+}
+
+// GetEntities indicates an expected call of GetEntities.
+func (mr *MockMirrorServiceMockRecorder) GetEntities(ctx, name any) *MockMirrorServiceGetEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, []charmmirror.Entity, error](mr.mock.ctrl.T, mr.mock, "GetEntities", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getEntitiesExpects = append(mr.getEntitiesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMirrorServiceGetEntitiesCall is the typed call wrapper for GetEntities.
+type MockMirrorServiceGetEntitiesCall = gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+
+// OpenArchive mocks base method.
+func (m *MockMirrorService) OpenArchive(ctx context.Context, sha256 string) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_3(&m.recorder.openArchiveExpects, m.ctrl, m, "OpenArchive", ctx, sha256)
+}
+
+// OpenArchive indicates an expected call of OpenArchive.
+func (mr *MockMirrorServiceMockRecorder) OpenArchive(ctx, sha256 any) *MockMirrorServiceOpenArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_3[context.Context, string, io.ReadCloser, int64, error](mr.mock.ctrl.T, mr.mock, "OpenArchive", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(sha256))
+	mr.openArchiveExpects = append(mr.openArchiveExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMirrorServiceOpenArchiveCall is the typed call wrapper for OpenArchive.
+type MockMirrorServiceOpenArchiveCall = gomock.Call2_3[context.Context, string, io.ReadCloser, int64, error]
+
+// Resolve mocks base method.
+func (m *MockMirrorService) Resolve(ctx context.Context, args charmmirror.ResolveArgs) (charmmirror.Resolved, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.resolveExpects, m.ctrl, m, "Resolve", ctx, args)
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockMirrorServiceMockRecorder) Resolve(ctx, args any) *MockMirrorServiceResolveCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, charmmirror.ResolveArgs, charmmirror.Resolved, error](mr.mock.ctrl.T, mr.mock, "Resolve", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.resolveExpects = append(mr.resolveExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockMirrorServiceResolveCall is the typed call wrapper for Resolve.
+type MockMirrorServiceResolveCall = gomock.Call2_2[context.Context, charmmirror.ResolveArgs, charmmirror.Resolved, error]
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock                    *MockControllerConfigService
+	controllerConfigExpects []*gomock.Call1_2[context.Context, controller.Config, error]
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(ctx context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.controllerConfigExpects, m.ctrl, m, "ControllerConfig", ctx)
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(ctx any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, controller.Config, error](mr.mock.ctrl.T, mr.mock, "ControllerConfig", gomock.EnsureMatcher(ctx))
+	mr.controllerConfigExpects = append(mr.controllerConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerConfigServiceControllerConfigCall is the typed call wrapper for ControllerConfig.
+type MockControllerConfigServiceControllerConfigCall = gomock.Call1_2[context.Context, controller.Config, error]
+
+// MockHTTPClient is a mock of HTTPClient interface.
+type MockHTTPClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHTTPClientMockRecorder
+	isgomock struct{}
+}
+
+// MockHTTPClientMockRecorder is the mock recorder for MockHTTPClient.
+type MockHTTPClientMockRecorder struct {
+	mock      *MockHTTPClient
+	doExpects []*gomock.Call1_2[*http.Request, *http.Response, error]
+}
+
+// NewMockHTTPClient creates a new mock instance.
+func NewMockHTTPClient(ctrl *gomock.Controller) *MockHTTPClient {
+	mock := &MockHTTPClient{ctrl: ctrl}
+	mock.recorder = &MockHTTPClientMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHTTPClient) EXPECT() *MockHTTPClientMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockHTTPClient) Do(arg0 *http.Request) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.doExpects, m.ctrl, m, "Do", arg0)
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockHTTPClientMockRecorder) Do(arg0 any) *MockHTTPClientDoCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[*http.Request, *http.Response, error](mr.mock.ctrl.T, mr.mock, "Do", gomock.EnsureMatcher(arg0))
+	mr.doExpects = append(mr.doExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockHTTPClientDoCall is the typed call wrapper for Do.
+type MockHTTPClientDoCall = gomock.Call1_2[*http.Request, *http.Response, error]
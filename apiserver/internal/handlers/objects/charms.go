@@ -25,6 +25,7 @@ import (
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/rpc/params"
@@ -206,12 +207,19 @@ func (h *ObjectsCharmHTTPHandler) processPut(ctx context.Context, r *http.Reques
 	}
 	curl.Name = name
 
+	signature, err := charmsignature.DecodeHeader(r.Header.Get(params.JujuSignatureHeader))
+	if err != nil {
+		return nil, jujuerrors.BadRequestf("invalid %q header: %v", params.JujuSignatureHeader, err)
+	}
+
 	var source corecharm.Source
 	switch curl.Schema {
 	case charm.CharmHub.String():
 		source = corecharm.CharmHub
 	case charm.Local.String():
 		source = corecharm.Local
+	case charm.OCI.String():
+		source = corecharm.OCI
 	default:
 		return nil, jujuerrors.BadRequestf("unsupported charm source %q", curl.Schema)
 	}
@@ -226,6 +234,8 @@ func (h *ObjectsCharmHTTPHandler) processPut(ctx context.Context, r *http.Reques
 		// Prevent an upload starvation attack by limiting the size of the
 		// charm that can be uploaded.
 		Reader: io.LimitReader(r.Body, maxUploadSize),
+
+		Signature: signature,
 	})
 	if errors.Is(err, applicationerrors.CharmNotFound) {
 		return nil, jujuerrors.NotFoundf("charm")
@@ -321,6 +331,8 @@ func convertSource(source applicationcharm.CharmSource) (string, error) {
 		return charm.CharmHub.String(), nil
 	case applicationcharm.LocalSource:
 		return charm.Local.String(), nil
+	case applicationcharm.OCISource:
+		return charm.OCI.String(), nil
 	default:
 		return "", errors.Errorf("unsupported source %q", source)
 	}
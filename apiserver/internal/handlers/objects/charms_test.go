@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -298,6 +299,80 @@ func (s *objectsCharmHandlerSuite) TestServePut(c *tc.C) {
 	c.Check(resp.Header.Get(params.JujuCharmURLHeader), tc.Equals, "ch:amd64/testcharm-2")
 }
 
+func (s *objectsCharmHandlerSuite) TestServePutWithSignature(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	handlers := &ObjectsCharmHTTPHandler{
+		applicationServiceGetter: s.applicationsServiceGetter,
+		makeCharmURL:             CharmURLFromLocator,
+	}
+
+	s.expectApplicationService()
+
+	s.mux.AddHandler("PUT", charmsObjectsRoutePrefix, handlers)
+	defer s.mux.RemoveHandler("PUT", charmsObjectsRoutePrefix)
+
+	modelUUID := testing.ModelTag.Id()
+	hashPrefix := "01abcdef"
+
+	url := fmt.Sprintf("%s/model-%s/charms/testcharm-%s", s.srv.URL, modelUUID, hashPrefix)
+	req, err := http.NewRequest("PUT", url, strings.NewReader("charm-content"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	signature := "-----BEGIN SSH SIGNATURE-----\nsig\n-----END SSH SIGNATURE-----\n"
+	req.Header.Set("Content-Type", "application/zip")
+	req.Header.Set(params.JujuCharmURLHeader, "local:testcharm-1")
+	req.Header.Set(params.JujuSignatureHeader, charmsignature.EncodeHeader([]byte(signature)))
+
+	s.applicationsService.EXPECT().ResolveUploadCharm(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, args applicationcharm.ResolveUploadCharm) (applicationcharm.CharmLocator, error) {
+		c.Check(string(args.Signature), tc.Equals, signature)
+
+		return applicationcharm.CharmLocator{
+			Name:         "testcharm",
+			Revision:     2,
+			Source:       applicationcharm.LocalSource,
+			Architecture: architecture.AMD64,
+		}, nil
+	})
+
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+
+	c.Check(resp.StatusCode, tc.Equals, http.StatusOK)
+}
+
+func (s *objectsCharmHandlerSuite) TestServePutWithInvalidSignatureHeader(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	handlers := &ObjectsCharmHTTPHandler{
+		applicationServiceGetter: s.applicationsServiceGetter,
+		makeCharmURL:             CharmURLFromLocator,
+	}
+
+	s.expectApplicationService()
+
+	s.mux.AddHandler("PUT", charmsObjectsRoutePrefix, handlers)
+	defer s.mux.RemoveHandler("PUT", charmsObjectsRoutePrefix)
+
+	modelUUID := testing.ModelTag.Id()
+	hashPrefix := "01abcdef"
+
+	url := fmt.Sprintf("%s/model-%s/charms/testcharm-%s", s.srv.URL, modelUUID, hashPrefix)
+	req, err := http.NewRequest("PUT", url, strings.NewReader("charm-content"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	req.Header.Set("Content-Type", "application/zip")
+	req.Header.Set(params.JujuCharmURLHeader, "local:testcharm-1")
+	req.Header.Set(params.JujuSignatureHeader, "!not-base64!")
+
+	resp, err := http.DefaultClient.Do(req)
+	c.Assert(err, tc.ErrorIsNil)
+	defer resp.Body.Close()
+
+	c.Check(resp.StatusCode, tc.Equals, http.StatusBadRequest)
+}
+
 func (s *objectsCharmHandlerSuite) TestCharmURLFromLocator(c *tc.C) {
 	locator := applicationcharm.CharmLocator{
 		Name:         "testcharm",
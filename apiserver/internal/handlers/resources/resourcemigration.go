@@ -161,6 +161,10 @@ func (h *resourcesMigrationUploadHandler) processPost(
 		RetrievedByType: retrievedByType,
 		Size:            details.size,
 		Fingerprint:     details.fingerprint,
+
+		// The resource was accepted by the source model, it isn't
+		// subject to this model's signature policy.
+		SkipSignatureVerification: true,
 	})
 }
 
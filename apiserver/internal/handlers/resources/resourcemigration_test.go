@@ -290,11 +290,12 @@ func (s *resourcesUploadSuite) TestServeUploadApplication(c *tc.C) {
 		Timestamp: now,
 	}
 	s.resourceService.EXPECT().StoreResource(gomock.Any(), domainresource.StoreResourceArgs{
-		ResourceUUID:    "res-uuid",
-		Reader:          http.NoBody,
-		RetrievedByType: resource.Application,
-		Fingerprint:     s.fingerprint,
-		Size:            s.size,
+		ResourceUUID:              "res-uuid",
+		Reader:                    http.NoBody,
+		RetrievedByType:           resource.Application,
+		Fingerprint:               s.fingerprint,
+		Size:                      s.size,
+		SkipSignatureVerification: true,
 	}).Return(expectedResource, nil)
 	s.resourceService.EXPECT().GetResource(gomock.Any(), resource.UUID("res-uuid")).Return(expectedResource, nil)
 
@@ -346,12 +347,13 @@ func (s *resourcesUploadSuite) TestServeUploadApplicationRetrievedByUser(c *tc.C
 		Timestamp: now,
 	}
 	s.resourceService.EXPECT().StoreResource(gomock.Any(), domainresource.StoreResourceArgs{
-		ResourceUUID:    "res-uuid",
-		Reader:          http.NoBody,
-		RetrievedByType: resource.User,
-		RetrievedBy:     "username",
-		Fingerprint:     s.fingerprint,
-		Size:            s.size,
+		ResourceUUID:              "res-uuid",
+		Reader:                    http.NoBody,
+		RetrievedByType:           resource.User,
+		RetrievedBy:               "username",
+		Fingerprint:               s.fingerprint,
+		Size:                      s.size,
+		SkipSignatureVerification: true,
 	}).Return(expectedRes, nil)
 	s.resourceService.EXPECT().GetResource(gomock.Any(), resource.UUID("res-uuid")).Return(expectedRes, nil)
 
@@ -392,12 +394,13 @@ func (s *resourcesUploadSuite) TestServeUploadApplicationRetrievedByApplication(
 		Timestamp: now,
 	}
 	s.resourceService.EXPECT().StoreResource(gomock.Any(), domainresource.StoreResourceArgs{
-		ResourceUUID:    "res-uuid",
-		Reader:          http.NoBody,
-		RetrievedByType: resource.Application,
-		RetrievedBy:     "app-name",
-		Fingerprint:     s.fingerprint,
-		Size:            s.size,
+		ResourceUUID:              "res-uuid",
+		Reader:                    http.NoBody,
+		RetrievedByType:           resource.Application,
+		RetrievedBy:               "app-name",
+		Fingerprint:               s.fingerprint,
+		Size:                      s.size,
+		SkipSignatureVerification: true,
 	}).Return(expectedRes, nil)
 	s.resourceService.EXPECT().GetResource(gomock.Any(), resource.UUID("res-uuid")).Return(expectedRes, nil)
 
@@ -437,12 +440,13 @@ func (s *resourcesUploadSuite) TestServeUploadApplicationRetrievedByUnit(c *tc.C
 		Timestamp: now,
 	}
 	s.resourceService.EXPECT().StoreResource(gomock.Any(), domainresource.StoreResourceArgs{
-		ResourceUUID:    "res-uuid",
-		Reader:          http.NoBody,
-		RetrievedByType: resource.Unit,
-		RetrievedBy:     "app-name/0",
-		Fingerprint:     s.fingerprint,
-		Size:            s.size,
+		ResourceUUID:              "res-uuid",
+		Reader:                    http.NoBody,
+		RetrievedByType:           resource.Unit,
+		RetrievedBy:               "app-name/0",
+		Fingerprint:               s.fingerprint,
+		Size:                      s.size,
+		SkipSignatureVerification: true,
 	}).Return(expectedRes, nil)
 	s.resourceService.EXPECT().GetResource(gomock.Any(), resource.UUID("res-uuid")).Return(expectedRes, nil)
 
@@ -25,6 +25,7 @@ import (
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/domain/resource"
 	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/wrench"
 	"github.com/juju/juju/rpc/params"
@@ -198,6 +199,11 @@ func (h *ResourceHandler) upload(service ResourceService, req *http.Request, use
 		return nil, errors.Capture(err)
 	}
 
+	signature, err := charmsignature.DecodeHeader(req.Header.Get(params.JujuSignatureHeader))
+	if err != nil {
+		return nil, jujuerrors.BadRequestf("invalid %q header: %v", params.JujuSignatureHeader, err)
+	}
+
 	if wrench.IsActive("resources", "upload-delay") {
 		h.logger.Warningf(ctx, "delaying resource upload due to wrench resources/upload-delay")
 		time.Sleep(30 * time.Second)
@@ -210,6 +216,7 @@ func (h *ResourceHandler) upload(service ResourceService, req *http.Request, use
 		RetrievedByType: coreresource.User,
 		Size:            uploaded.size,
 		Fingerprint:     uploaded.fingerprint,
+		Signature:       signature,
 	}
 	var res coreresource.Resource
 	if uploaded.pending {
@@ -222,7 +229,11 @@ func (h *ResourceHandler) upload(service ResourceService, req *http.Request, use
 		// independently of a charm.
 		res, err = service.StoreResourceAndIncrementCharmModifiedVersion(ctx, args)
 	}
-	if err != nil {
+	if errors.Is(err, charmsignature.ErrSignatureNotFound) ||
+		errors.Is(err, charmsignature.ErrSignatureNotValid) ||
+		errors.Is(err, charmsignature.ErrSignerNotTrusted) {
+		return nil, jujuerrors.NewBadRequest(err, fmt.Sprintf("storing resource %s of application %s", uploaded.resourceName, uploaded.applicationName))
+	} else if err != nil {
 		return nil, errors.Errorf("storing resource %s of application %s: %w", uploaded.resourceName, uploaded.applicationName, err)
 	}
 
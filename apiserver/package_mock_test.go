@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver (interfaces: AgentBinaryStore,BlockChecker,ControllerConfigService,ModelAuthorizationInfo)
+// Source: github.com/juju/juju/apiserver (interfaces: AgentBinaryStore,BackupStore,BlockChecker,ControllerConfigService,ModelAuthorizationInfo)
 //
 // Generated by this command:
 //
-//	mockgen -package apiserver -destination package_mock_test.go github.com/juju/juju/apiserver AgentBinaryStore,BlockChecker,ControllerConfigService,ModelAuthorizationInfo
+//	mockgen -package apiserver -destination package_mock_test.go github.com/juju/juju/apiserver AgentBinaryStore,BackupStore,BlockChecker,ControllerConfigService,ModelAuthorizationInfo
 //
 
 // Package apiserver is a generated GoMock package.
@@ -16,6 +16,7 @@ import (
 	gomock "github.com/canonical/gomock/gomock"
 	controller "github.com/juju/juju/controller"
 	agentbinary "github.com/juju/juju/core/agentbinary"
+	backups "github.com/juju/juju/core/backups"
 	watcher "github.com/juju/juju/core/watcher"
 )
 
@@ -62,6 +63,68 @@ func (mr *MockAgentBinaryStoreMockRecorder) AddAgentBinaryWithSHA256(ctx, data,
 // MockAgentBinaryStoreAddAgentBinaryWithSHA256Call is the typed call wrapper for AddAgentBinaryWithSHA256.
 type MockAgentBinaryStoreAddAgentBinaryWithSHA256Call = gomock.Call5_1[context.Context, io.Reader, agentbinary.Version, int64, string, error]
 
+// MockBackupStore is a mock of BackupStore interface.
+type MockBackupStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockBackupStoreMockRecorder is the mock recorder for MockBackupStore.
+type MockBackupStoreMockRecorder struct {
+	mock       *MockBackupStore
+	addExpects []*gomock.Call1_2[io.Reader, string, error]
+	getExpects []*gomock.Call1_3[string, *backups.Metadata, io.ReadCloser, error]
+}
+
+// NewMockBackupStore creates a new mock instance.
+func NewMockBackupStore(ctrl *gomock.Controller) *MockBackupStore {
+	mock := &MockBackupStore{ctrl: ctrl}
+	mock.recorder = &MockBackupStoreMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupStore) EXPECT() *MockBackupStoreMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockBackupStore) Add(r io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.addExpects, m.ctrl, m, "Add", r)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockBackupStoreMockRecorder) Add(r any) *MockBackupStoreAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[io.Reader, string, error](mr.mock.ctrl.T, mr.mock, "Add", gomock.EnsureMatcher(r))
+	mr.addExpects = append(mr.addExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupStoreAddCall is the typed call wrapper for Add.
+type MockBackupStoreAddCall = gomock.Call1_2[io.Reader, string, error]
+
+// Get mocks base method.
+func (m *MockBackupStore) Get(id string) (*backups.Metadata, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_3(&m.recorder.getExpects, m.ctrl, m, "Get", id)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBackupStoreMockRecorder) Get(id any) *MockBackupStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_3[string, *backups.Metadata, io.ReadCloser, error](mr.mock.ctrl.T, mr.mock, "Get", gomock.EnsureMatcher(id))
+	mr.getExpects = append(mr.getExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockBackupStoreGetCall is the typed call wrapper for Get.
+type MockBackupStoreGetCall = gomock.Call1_3[string, *backups.Metadata, io.ReadCloser, error]
+
 // MockBlockChecker is a mock of BlockChecker interface.
 type MockBlockChecker struct {
 	ctrl     *gomock.Controller
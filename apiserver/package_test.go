@@ -14,7 +14,7 @@ import (
 //go:generate go run github.com/canonical/gomock/mockgen -package apiserver_test -destination registration_environs_mock_test.go github.com/juju/juju/environs ConnectorInfo
 //go:generate go run github.com/canonical/gomock/mockgen -package apiserver_test -destination registration_proxy_mock_test.go github.com/juju/juju/internal/proxy Proxier
 //go:generate go run github.com/canonical/gomock/mockgen -package apiserver_test -destination provider_factory_mock_test.go github.com/juju/juju/core/providertracker ProviderFactory
-//go:generate go run github.com/canonical/gomock/mockgen -package apiserver -destination package_mock_test.go github.com/juju/juju/apiserver AgentBinaryStore,BlockChecker,ControllerConfigService,ModelAuthorizationInfo
+//go:generate go run github.com/canonical/gomock/mockgen -package apiserver -destination package_mock_test.go github.com/juju/juju/apiserver AgentBinaryStore,BackupStore,BlockChecker,ControllerConfigService,ModelAuthorizationInfo
 //go:generate go run github.com/canonical/gomock/mockgen -package apiserver -destination services_mock_test.go github.com/juju/juju/internal/services ControllerDomainServices
 //go:generate go run github.com/canonical/gomock/mockgen -package apiserver -destination facade_mock_test.go github.com/juju/juju/apiserver/facade CrossModelAuthContext
 //go:generate go run github.com/canonical/gomock/mockgen -package apiserver -destination service_mock_test.go github.com/juju/juju/apiserver RelationService,StatusService,ModelRedirectService,LogTransferModelService,LogTransferMigrationModeService
@@ -0,0 +1,186 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/ratelimit"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+)
+
+const (
+	// userRateLimit is the value of the limit label of the throttled
+	// requests metric when the per-user limit rejects a request.
+	userRateLimit = "user"
+
+	// facadeRateLimit is the value of the limit label of the throttled
+	// requests metric when a per-facade limit rejects a request.
+	facadeRateLimit = "facade"
+)
+
+// unlimitedFacades holds the facades that are never rate limited, because
+// throttling them would break the connection rather than slow the caller.
+// Watcher facades, whose names all end in "Watcher", are not limited either.
+var unlimitedFacades = map[string]bool{
+	"Pinger": true,
+}
+
+// unlimitedMethods holds the methods that are never rate limited, on any
+// facade. Next long-polls a watcher for its changes and Stop releases it, so
+// throttling them would stall the watchers the caller has already started.
+var unlimitedMethods = map[string]bool{
+	"Next": true,
+	"Stop": true,
+}
+
+// isUnlimited reports whether requests for the method of the facade are
+// exempt from the rate limits.
+func isUnlimited(facadeName, methodName string) bool {
+	return unlimitedFacades[facadeName] ||
+		strings.HasSuffix(facadeName, "Watcher") ||
+		unlimitedMethods[methodName]
+}
+
+// userRateLimiter applies the token bucket rate limits configured in
+// controller config to the API requests made by users. Each user has their
+// own bucket, and their own bucket for each facade with a limit, so a single
+// user cannot starve the others.
+type userRateLimiter struct {
+	clock clock.Clock
+
+	mu       sync.Mutex
+	userMax  int
+	userRate time.Duration
+	facades  map[string]controller.APIRateLimit
+	users    map[string]*userRateBuckets
+}
+
+// userRateBuckets holds the token buckets of a single user.
+type userRateBuckets struct {
+	user    *ratelimit.Bucket
+	facades map[string]*ratelimit.Bucket
+}
+
+// newUserRateLimiter returns a userRateLimiter configured from the
+// controller config.
+func newUserRateLimiter(clock clock.Clock, cfg controller.Config) *userRateLimiter {
+	l := &userRateLimiter{
+		clock: clock,
+	}
+	l.update(cfg)
+	return l
+}
+
+// update reconfigures the limiter from the controller config. The buckets of
+// all users are discarded if the limits have changed.
+func (l *userRateLimiter) update(cfg controller.Config) {
+	userMax := cfg.APIRateLimitUserMax()
+	userRate := cfg.APIRateLimitUserRate()
+	facades := cfg.APIRateLimitFacades()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.users != nil && userMax == l.userMax && userRate == l.userRate && maps.Equal(facades, l.facades) {
+		return
+	}
+	l.userMax = userMax
+	l.userRate = userRate
+	l.facades = facades
+	l.users = make(map[string]*userRateBuckets)
+}
+
+// report returns the current limits, for inclusion in the server report.
+func (l *userRateLimiter) report() map[string]any {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	facades := make(map[string]string, len(l.facades))
+	for facade, limit := range l.facades {
+		facades[facade] = fmt.Sprintf("%d/%v", limit.Max, limit.Rate)
+	}
+	return map[string]any{
+		"user-max":  l.userMax,
+		"user-rate": l.userRate,
+		"facades":   facades,
+	}
+}
+
+// take takes a token for a request made by the user to the method of the
+// facade. If the request exceeds one of the limits, nothing is taken and the
+// name of the limit that was exceeded is returned along with a
+// RateLimitExceededError.
+func (l *userRateLimiter) take(user, facadeName, methodName string) (string, error) {
+	if isUnlimited(facadeName, methodName) {
+		return "", nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	facadeLimit, facadeLimited := l.facades[facadeName]
+	if l.userMax <= 0 && !facadeLimited {
+		return "", nil
+	}
+
+	buckets, ok := l.users[user]
+	if !ok {
+		buckets = &userRateBuckets{
+			facades: make(map[string]*ratelimit.Bucket),
+		}
+		if l.userMax > 0 {
+			buckets.user = l.newBucket(l.userRate, l.userMax)
+		}
+		l.users[user] = buckets
+	}
+
+	var facadeBucket *ratelimit.Bucket
+	if facadeLimited {
+		if facadeBucket, ok = buckets.facades[facadeName]; !ok {
+			facadeBucket = l.newBucket(facadeLimit.Rate, facadeLimit.Max)
+			buckets.facades[facadeName] = facadeBucket
+		}
+	}
+
+	// Check both buckets before taking from either, so that a request
+	// rejected by one limit does not use up the allowance of the other.
+	if facadeBucket != nil && facadeBucket.Available() < 1 {
+		return facadeRateLimit, &apiservererrors.RateLimitExceededError{
+			RetryAfter: facadeLimit.Rate,
+		}
+	}
+	if buckets.user != nil && buckets.user.Available() < 1 {
+		return userRateLimit, &apiservererrors.RateLimitExceededError{
+			RetryAfter: l.userRate,
+		}
+	}
+	if facadeBucket != nil {
+		facadeBucket.TakeAvailable(1)
+	}
+	if buckets.user != nil {
+		buckets.user.TakeAvailable(1)
+	}
+	return "", nil
+}
+
+func (l *userRateLimiter) newBucket(rate time.Duration, maxTokens int) *ratelimit.Bucket {
+	return ratelimit.NewBucketWithClock(rate, int64(maxTokens), rateClock{Clock: l.clock})
+}
+
+// userRateLimitCheck returns a restrictRoot check function that applies the
+// server's user rate limits to the requests of the given user.
+func (srv *Server) userRateLimitCheck(user string) func(string, string) error {
+	return func(facadeName, methodName string) error {
+		limit, err := srv.userRateLimiter.take(user, facadeName, methodName)
+		if err != nil {
+			srv.metricsCollector.ThrottledRequests.WithLabelValues(facadeName, limit).Inc()
+		}
+		return err
+	}
+}
@@ -5,8 +5,15 @@ package apiserver_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
 	"github.com/juju/tc"
+
+	"github.com/juju/juju/apiserver"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
 )
 
 type rateLimitSuite struct {
@@ -23,3 +30,146 @@ func (s *rateLimitSuite) TestStub(c *tc.C) {
 - Rate limit for machines is not applied when the controller is configured, but the rate limit is set to 0.
 `)
 }
+
+func (s *rateLimitSuite) TestUserRateLimitDisabledByDefault(c *tc.C) {
+	limiter := apiserver.NewUserRateLimiter(testclock.NewClock(time.Now()), controller.Config{})
+	for i := 0; i < 100; i++ {
+		_, err := apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+		c.Assert(err, tc.ErrorIsNil)
+	}
+}
+
+func (s *rateLimitSuite) TestUserRateLimit(c *tc.C) {
+	clock := testclock.NewClock(time.Now())
+	limiter := apiserver.NewUserRateLimiter(clock, controller.Config{
+		controller.APIRateLimitUserMax:  2,
+		controller.APIRateLimitUserRate: "1s",
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	limit, err := apiserver.TakeUserRateLimit(limiter, "bob", "ModelManager")
+	c.Check(limit, tc.Equals, "user")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+	var rateLimitErr *apiservererrors.RateLimitExceededError
+	c.Assert(errors.As(err, &rateLimitErr), tc.IsTrue)
+	c.Check(rateLimitErr.RetryAfter, tc.Equals, time.Second)
+
+	// Other users have their own allowance.
+	_, err = apiserver.TakeUserRateLimit(limiter, "alice", "Client")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The pinger keeps the connection alive, so it is never limited.
+	_, err = apiserver.TakeUserRateLimit(limiter, "bob", "Pinger")
+	c.Assert(err, tc.ErrorIsNil)
+
+	clock.Advance(time.Second)
+	_, err = apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+}
+
+func (s *rateLimitSuite) TestFacadeRateLimit(c *tc.C) {
+	clock := testclock.NewClock(time.Now())
+	limiter := apiserver.NewUserRateLimiter(clock, controller.Config{
+		controller.APIRateLimitUserMax:  3,
+		controller.APIRateLimitUserRate: "1s",
+		controller.APIRateLimitFacades:  "Client=1/5s",
+	})
+
+	_, err := apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.ErrorIsNil)
+	limit, err := apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Check(limit, tc.Equals, "facade")
+	var rateLimitErr *apiservererrors.RateLimitExceededError
+	c.Assert(errors.As(err, &rateLimitErr), tc.IsTrue)
+	c.Check(rateLimitErr.RetryAfter, tc.Equals, 5*time.Second)
+
+	// The rejected request did not use up the user's allowance, so two
+	// requests to other facades are still allowed.
+	for i := 0; i < 2; i++ {
+		_, err = apiserver.TakeUserRateLimit(limiter, "bob", "ModelManager")
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	limit, err = apiserver.TakeUserRateLimit(limiter, "bob", "ModelManager")
+	c.Check(limit, tc.Equals, "user")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+}
+
+func (s *rateLimitSuite) TestFacadeRateLimitWithoutUserLimit(c *tc.C) {
+	limiter := apiserver.NewUserRateLimiter(testclock.NewClock(time.Now()), controller.Config{
+		controller.APIRateLimitFacades: "Client=1/1s",
+	})
+
+	_, err := apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+	for i := 0; i < 10; i++ {
+		_, err = apiserver.TakeUserRateLimit(limiter, "bob", "ModelManager")
+		c.Assert(err, tc.ErrorIsNil)
+	}
+}
+
+func (s *rateLimitSuite) TestWatchersNotRateLimited(c *tc.C) {
+	limiter := apiserver.NewUserRateLimiter(testclock.NewClock(time.Now()), controller.Config{
+		controller.APIRateLimitUserMax:  1,
+		controller.APIRateLimitUserRate: "1m",
+		controller.APIRateLimitFacades:  "AllWatcher=1/1m,Application=1/1m",
+	})
+
+	// Use up the user's allowance.
+	_, err := apiserver.TakeUserRateLimitForMethod(limiter, "bob", "Client", "FullStatus")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = apiserver.TakeUserRateLimitForMethod(limiter, "bob", "Client", "FullStatus")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+
+	// Watchers already started keep delivering their changes, however many
+	// times they are polled, and can still be stopped.
+	for i := 0; i < 10; i++ {
+		for _, facade := range []string{"AllWatcher", "AllModelWatcher", "NotifyWatcher", "StringsWatcher", "RelationUnitsWatcher"} {
+			_, err = apiserver.TakeUserRateLimitForMethod(limiter, "bob", facade, "Next")
+			c.Assert(err, tc.ErrorIsNil, tc.Commentf("%s.Next", facade))
+		}
+	}
+	_, err = apiserver.TakeUserRateLimitForMethod(limiter, "bob", "AllWatcher", "Stop")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Next and Stop are not limited on other facades either, but their
+	// other methods are.
+	_, err = apiserver.TakeUserRateLimitForMethod(limiter, "bob", "Application", "Next")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = apiserver.TakeUserRateLimitForMethod(limiter, "bob", "Application", "Stop")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = apiserver.TakeUserRateLimitForMethod(limiter, "bob", "Application", "Deploy")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+}
+
+func (s *rateLimitSuite) TestUpdateUserRateLimiter(c *tc.C) {
+	cfg := controller.Config{
+		controller.APIRateLimitUserMax:  1,
+		controller.APIRateLimitUserRate: "1m",
+	}
+	limiter := apiserver.NewUserRateLimiter(testclock.NewClock(time.Now()), cfg)
+	_, err := apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Updating with the same limits keeps the existing buckets.
+	apiserver.UpdateUserRateLimiter(limiter, cfg)
+	_, err = apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.Satisfies, isRateLimitExceeded)
+
+	// Disabling the limit lets requests through straight away.
+	apiserver.UpdateUserRateLimiter(limiter, controller.Config{
+		controller.APIRateLimitUserMax: 0,
+	})
+	_, err = apiserver.TakeUserRateLimit(limiter, "bob", "Client")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func isRateLimitExceeded(err error) bool {
+	return errors.HasType[*apiservererrors.RateLimitExceededError](err)
+}
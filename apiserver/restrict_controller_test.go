@@ -35,6 +35,7 @@ func (s *restrictControllerSuite) TestAllowed(c *tc.C) {
 	s.assertMethod(c, "Pinger", pingerFacadeVersion, "Ping")
 	s.assertMethod(c, "Bundle", 8, "GetChangesMapArgs")
 	s.assertMethod(c, "ApplicationOffers", 5, "ApplicationOffers")
+	s.assertMethod(c, "AuditLog", 1, "Query")
 }
 
 func (s *restrictControllerSuite) TestNotAllowed(c *tc.C) {
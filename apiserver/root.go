@@ -31,6 +31,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/user"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/modelmigration"
 	"github.com/juju/juju/internal/migration"
 	"github.com/juju/juju/internal/services"
@@ -317,6 +318,12 @@ func (r *apiHandler) GetAuthTag() names.Tag {
 	return r.authInfo.Tag
 }
 
+// GetAuthGroups returns the user groups the identity provider reported the
+// authenticated entity to be a member of, if any.
+func (r *apiHandler) GetAuthGroups() []string {
+	return r.authInfo.Groups
+}
+
 // HasPermission is responsible for reporting if the logged in user is
 // able to perform operation x on target y. It uses the authentication mechanism
 // of the user to interrogate their permissions. If the entity does not have
@@ -344,7 +351,18 @@ func (r *apiHandler) EntityHasPermission(
 		}
 		return r.authInfo.Delegator.SubjectPermissions(ctx, userName.Name(), target)
 	}
-	has, err := common.HasPermission(ctx, userAccessFunc, entity, operation, target)
+	var (
+		has bool
+		err error
+	)
+	if appTag, ok := target.(names.ApplicationTag); ok {
+		// Application permissions are granted on the application UUID, and
+		// applications are only unique within a model, so the application
+		// is looked up in the model of this connection.
+		has, err = r.applicationHasPermission(ctx, userAccessFunc, entity, operation, appTag)
+	} else {
+		has, err = common.HasPermission(ctx, userAccessFunc, entity, operation, target)
+	}
 	if err != nil {
 		return fmt.Errorf("checking entity %q has permission: %w", entity, err)
 	}
@@ -358,6 +376,24 @@ func (r *apiHandler) EntityHasPermission(
 	return nil
 }
 
+// applicationHasPermission reports whether the entity has the permission on
+// the named application in the model of this connection. An application
+// that doesn't exist grants no permission.
+func (r *apiHandler) applicationHasPermission(
+	ctx context.Context, accessGetter common.UserAccessFunc, entity names.Tag, operation permission.Access, appTag names.ApplicationTag,
+) (bool, error) {
+	if r.domainServices == nil {
+		return false, nil
+	}
+	app, err := r.domainServices.Application().GetApplicationDetailsByName(ctx, appTag.Id())
+	if errors.Is(err, applicationerrors.ApplicationNotFound) || errors.Is(err, applicationerrors.ApplicationNameNotValid) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("getting application %q: %w", appTag.Id(), err)
+	}
+	return common.HasApplicationPermission(ctx, accessGetter, entity, operation, r.modelUUID.String(), app.UUID.String())
+}
+
 // srvCaller is our implementation of the rpcreflect.MethodCaller interface.
 // It lives just long enough to encapsulate the methods that should be
 // available for an RPC call and allow the RPC code to instantiate an object
@@ -508,6 +544,9 @@ func restrictAPIRoot(
 		}
 		apiRoot = restrictedRoot
 	}
+	if userTag, ok := auth.tag.(names.UserTag); ok {
+		apiRoot = restrictRoot(apiRoot, srv.userRateLimitCheck(userTag.Id()))
+	}
 	if auth.controllerOnlyLogin {
 		apiRoot = restrictRoot(apiRoot, controllerFacadesOnly)
 	} else {
@@ -992,6 +1031,11 @@ func (ctx *facadeContext) ObjectStoreForModel(stdCtx context.Context, modelUUID
 	return ctx.r.objectStoreGetter.GetObjectStore(stdCtx, modelUUID)
 }
 
+// Backups returns the backups of the controller.
+func (ctx *facadeContext) Backups() facade.Backups {
+	return ctx.r.shared.backups
+}
+
 // DescribeFacades returns the list of available Facades and their Versions
 func DescribeFacades(registry *facade.Registry) []params.FacadeVersions {
 	facades := registry.List()
@@ -13,23 +13,26 @@ import (
 	gomock "github.com/canonical/gomock/gomock"
 	service "github.com/juju/juju/domain/access/service"
 	service0 "github.com/juju/juju/domain/agentbinary/service"
-	service1 "github.com/juju/juju/domain/autocert/service"
-	service2 "github.com/juju/juju/domain/changestream/service"
-	service3 "github.com/juju/juju/domain/cloud/service"
-	service4 "github.com/juju/juju/domain/controller/service"
-	service5 "github.com/juju/juju/domain/controllerconfig/service"
-	service6 "github.com/juju/juju/domain/controllernode/service"
-	service7 "github.com/juju/juju/domain/credential/service"
-	service8 "github.com/juju/juju/domain/externalcontroller/service"
-	service9 "github.com/juju/juju/domain/flag/service"
-	service10 "github.com/juju/juju/domain/logging/service"
-	service11 "github.com/juju/juju/domain/macaroon/service"
-	service12 "github.com/juju/juju/domain/model/service"
-	service13 "github.com/juju/juju/domain/modeldefaults/service"
-	service14 "github.com/juju/juju/domain/secretbackend/service"
+	service1 "github.com/juju/juju/domain/auditlog/service"
+	service2 "github.com/juju/juju/domain/autocert/service"
+	service3 "github.com/juju/juju/domain/changestream/service"
+	service4 "github.com/juju/juju/domain/charmmirror/service"
+	service5 "github.com/juju/juju/domain/charmregistry/service"
+	service6 "github.com/juju/juju/domain/cloud/service"
+	service7 "github.com/juju/juju/domain/controller/service"
+	service8 "github.com/juju/juju/domain/controllerconfig/service"
+	service9 "github.com/juju/juju/domain/controllernode/service"
+	service10 "github.com/juju/juju/domain/credential/service"
+	service11 "github.com/juju/juju/domain/externalcontroller/service"
+	service12 "github.com/juju/juju/domain/flag/service"
+	service13 "github.com/juju/juju/domain/logging/service"
+	service14 "github.com/juju/juju/domain/macaroon/service"
+	service15 "github.com/juju/juju/domain/model/service"
+	service16 "github.com/juju/juju/domain/modeldefaults/service"
+	service17 "github.com/juju/juju/domain/secretbackend/service"
 	controller "github.com/juju/juju/domain/ssh/service/controller"
-	service15 "github.com/juju/juju/domain/tracing/service"
-	service16 "github.com/juju/juju/domain/upgrade/service"
+	service18 "github.com/juju/juju/domain/tracing/service"
+	service19 "github.com/juju/juju/domain/upgrade/service"
 )
 
 // MockControllerDomainServices is a mock of ControllerDomainServices interface.
@@ -43,24 +46,27 @@ type MockControllerDomainServices struct {
 type MockControllerDomainServicesMockRecorder struct {
 	mock                              *MockControllerDomainServices
 	accessExpects                     []*gomock.Call0_1[*service.Service]
-	autocertCacheExpects              []*gomock.Call0_1[*service1.Service]
-	cloudExpects                      []*gomock.Call0_1[*service3.WatchableService]
-	controllerExpects                 []*gomock.Call0_1[*service4.Service]
+	auditLogExpects                   []*gomock.Call0_1[*service1.Service]
+	autocertCacheExpects              []*gomock.Call0_1[*service2.Service]
+	charmMirrorExpects                []*gomock.Call0_1[*service4.Service]
+	charmRegistryExpects              []*gomock.Call0_1[*service5.Service]
+	cloudExpects                      []*gomock.Call0_1[*service6.WatchableService]
+	controllerExpects                 []*gomock.Call0_1[*service7.Service]
 	controllerAgentBinaryStoreExpects []*gomock.Call0_1[*service0.AgentBinaryStore]
-	controllerChangeStreamExpects     []*gomock.Call0_1[*service2.Service]
-	controllerConfigExpects           []*gomock.Call0_1[*service5.WatchableService]
-	controllerNodeExpects             []*gomock.Call0_1[*service6.WatchableService]
-	credentialExpects                 []*gomock.Call0_1[*service7.WatchableService]
-	externalControllerExpects         []*gomock.Call0_1[*service8.WatchableService]
-	flagExpects                       []*gomock.Call0_1[*service9.Service]
-	loggingExpects                    []*gomock.Call0_1[*service10.WatchableService]
-	macaroonExpects                   []*gomock.Call0_1[*service11.Service]
-	modelExpects                      []*gomock.Call0_1[*service12.WatchableService]
-	modelDefaultsExpects              []*gomock.Call0_1[*service13.Service]
+	controllerChangeStreamExpects     []*gomock.Call0_1[*service3.Service]
+	controllerConfigExpects           []*gomock.Call0_1[*service8.WatchableService]
+	controllerNodeExpects             []*gomock.Call0_1[*service9.WatchableService]
+	credentialExpects                 []*gomock.Call0_1[*service10.WatchableService]
+	externalControllerExpects         []*gomock.Call0_1[*service11.WatchableService]
+	flagExpects                       []*gomock.Call0_1[*service12.Service]
+	loggingExpects                    []*gomock.Call0_1[*service13.WatchableService]
+	macaroonExpects                   []*gomock.Call0_1[*service14.Service]
+	modelExpects                      []*gomock.Call0_1[*service15.WatchableService]
+	modelDefaultsExpects              []*gomock.Call0_1[*service16.Service]
 	sSHServerHostKeyExpects           []*gomock.Call0_1[*controller.Service]
-	secretBackendExpects              []*gomock.Call0_1[*service14.WatchableService]
-	tracingExpects                    []*gomock.Call0_1[*service15.WatchableService]
-	upgradeExpects                    []*gomock.Call0_1[*service16.WatchableService]
+	secretBackendExpects              []*gomock.Call0_1[*service17.WatchableService]
+	tracingExpects                    []*gomock.Call0_1[*service18.WatchableService]
+	upgradeExpects                    []*gomock.Call0_1[*service19.WatchableService]
 }
 
 // NewMockControllerDomainServices creates a new mock instance.
@@ -93,8 +99,26 @@ func (mr *MockControllerDomainServicesMockRecorder) Access() *MockControllerDoma
 // MockControllerDomainServicesAccessCall is the typed call wrapper for Access.
 type MockControllerDomainServicesAccessCall = gomock.Call0_1[*service.Service]
 
+// AuditLog mocks base method.
+func (m *MockControllerDomainServices) AuditLog() *service1.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.auditLogExpects, m.ctrl, m, "AuditLog")
+}
+
+// AuditLog indicates an expected call of AuditLog.
+func (mr *MockControllerDomainServicesMockRecorder) AuditLog() *MockControllerDomainServicesAuditLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service1.Service](mr.mock.ctrl.T, mr.mock, "AuditLog")
+	mr.auditLogExpects = append(mr.auditLogExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerDomainServicesAuditLogCall is the typed call wrapper for AuditLog.
+type MockControllerDomainServicesAuditLogCall = gomock.Call0_1[*service1.Service]
+
 // AutocertCache mocks base method.
-func (m *MockControllerDomainServices) AutocertCache() *service1.Service {
+func (m *MockControllerDomainServices) AutocertCache() *service2.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.autocertCacheExpects, m.ctrl, m, "AutocertCache")
 }
@@ -102,17 +126,53 @@ func (m *MockControllerDomainServices) AutocertCache() *service1.Service {
 // AutocertCache indicates an expected call of AutocertCache.
 func (mr *MockControllerDomainServicesMockRecorder) AutocertCache() *MockControllerDomainServicesAutocertCacheCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service1.Service](mr.mock.ctrl.T, mr.mock, "AutocertCache")
+	call := gomock.NewCall0_1[*service2.Service](mr.mock.ctrl.T, mr.mock, "AutocertCache")
 	mr.autocertCacheExpects = append(mr.autocertCacheExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesAutocertCacheCall is the typed call wrapper for AutocertCache.
-type MockControllerDomainServicesAutocertCacheCall = gomock.Call0_1[*service1.Service]
+type MockControllerDomainServicesAutocertCacheCall = gomock.Call0_1[*service2.Service]
+
+// CharmMirror mocks base method.
+func (m *MockControllerDomainServices) CharmMirror() *service4.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.charmMirrorExpects, m.ctrl, m, "CharmMirror")
+}
+
+// CharmMirror indicates an expected call of CharmMirror.
+func (mr *MockControllerDomainServicesMockRecorder) CharmMirror() *MockControllerDomainServicesCharmMirrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service4.Service](mr.mock.ctrl.T, mr.mock, "CharmMirror")
+	mr.charmMirrorExpects = append(mr.charmMirrorExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerDomainServicesCharmMirrorCall is the typed call wrapper for CharmMirror.
+type MockControllerDomainServicesCharmMirrorCall = gomock.Call0_1[*service4.Service]
+
+// CharmRegistry mocks base method.
+func (m *MockControllerDomainServices) CharmRegistry() *service5.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.charmRegistryExpects, m.ctrl, m, "CharmRegistry")
+}
+
+// CharmRegistry indicates an expected call of CharmRegistry.
+func (mr *MockControllerDomainServicesMockRecorder) CharmRegistry() *MockControllerDomainServicesCharmRegistryCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service5.Service](mr.mock.ctrl.T, mr.mock, "CharmRegistry")
+	mr.charmRegistryExpects = append(mr.charmRegistryExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerDomainServicesCharmRegistryCall is the typed call wrapper for CharmRegistry.
+type MockControllerDomainServicesCharmRegistryCall = gomock.Call0_1[*service5.Service]
 
 // Cloud mocks base method.
-func (m *MockControllerDomainServices) Cloud() *service3.WatchableService {
+func (m *MockControllerDomainServices) Cloud() *service6.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.cloudExpects, m.ctrl, m, "Cloud")
 }
@@ -120,17 +180,17 @@ func (m *MockControllerDomainServices) Cloud() *service3.WatchableService {
 // Cloud indicates an expected call of Cloud.
 func (mr *MockControllerDomainServicesMockRecorder) Cloud() *MockControllerDomainServicesCloudCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service3.WatchableService](mr.mock.ctrl.T, mr.mock, "Cloud")
+	call := gomock.NewCall0_1[*service6.WatchableService](mr.mock.ctrl.T, mr.mock, "Cloud")
 	mr.cloudExpects = append(mr.cloudExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesCloudCall is the typed call wrapper for Cloud.
-type MockControllerDomainServicesCloudCall = gomock.Call0_1[*service3.WatchableService]
+type MockControllerDomainServicesCloudCall = gomock.Call0_1[*service6.WatchableService]
 
 // Controller mocks base method.
-func (m *MockControllerDomainServices) Controller() *service4.Service {
+func (m *MockControllerDomainServices) Controller() *service7.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerExpects, m.ctrl, m, "Controller")
 }
@@ -138,14 +198,14 @@ func (m *MockControllerDomainServices) Controller() *service4.Service {
 // Controller indicates an expected call of Controller.
 func (mr *MockControllerDomainServicesMockRecorder) Controller() *MockControllerDomainServicesControllerCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service4.Service](mr.mock.ctrl.T, mr.mock, "Controller")
+	call := gomock.NewCall0_1[*service7.Service](mr.mock.ctrl.T, mr.mock, "Controller")
 	mr.controllerExpects = append(mr.controllerExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesControllerCall is the typed call wrapper for Controller.
-type MockControllerDomainServicesControllerCall = gomock.Call0_1[*service4.Service]
+type MockControllerDomainServicesControllerCall = gomock.Call0_1[*service7.Service]
 
 // ControllerAgentBinaryStore mocks base method.
 func (m *MockControllerDomainServices) ControllerAgentBinaryStore() *service0.AgentBinaryStore {
@@ -166,7 +226,7 @@ func (mr *MockControllerDomainServicesMockRecorder) ControllerAgentBinaryStore()
 type MockControllerDomainServicesControllerAgentBinaryStoreCall = gomock.Call0_1[*service0.AgentBinaryStore]
 
 // ControllerChangeStream mocks base method.
-func (m *MockControllerDomainServices) ControllerChangeStream() *service2.Service {
+func (m *MockControllerDomainServices) ControllerChangeStream() *service3.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerChangeStreamExpects, m.ctrl, m, "ControllerChangeStream")
 }
@@ -174,17 +234,17 @@ func (m *MockControllerDomainServices) ControllerChangeStream() *service2.Servic
 // ControllerChangeStream indicates an expected call of ControllerChangeStream.
 func (mr *MockControllerDomainServicesMockRecorder) ControllerChangeStream() *MockControllerDomainServicesControllerChangeStreamCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service2.Service](mr.mock.ctrl.T, mr.mock, "ControllerChangeStream")
+	call := gomock.NewCall0_1[*service3.Service](mr.mock.ctrl.T, mr.mock, "ControllerChangeStream")
 	mr.controllerChangeStreamExpects = append(mr.controllerChangeStreamExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesControllerChangeStreamCall is the typed call wrapper for ControllerChangeStream.
-type MockControllerDomainServicesControllerChangeStreamCall = gomock.Call0_1[*service2.Service]
+type MockControllerDomainServicesControllerChangeStreamCall = gomock.Call0_1[*service3.Service]
 
 // ControllerConfig mocks base method.
-func (m *MockControllerDomainServices) ControllerConfig() *service5.WatchableService {
+func (m *MockControllerDomainServices) ControllerConfig() *service8.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerConfigExpects, m.ctrl, m, "ControllerConfig")
 }
@@ -192,17 +252,17 @@ func (m *MockControllerDomainServices) ControllerConfig() *service5.WatchableSer
 // ControllerConfig indicates an expected call of ControllerConfig.
 func (mr *MockControllerDomainServicesMockRecorder) ControllerConfig() *MockControllerDomainServicesControllerConfigCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service5.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerConfig")
+	call := gomock.NewCall0_1[*service8.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerConfig")
 	mr.controllerConfigExpects = append(mr.controllerConfigExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesControllerConfigCall is the typed call wrapper for ControllerConfig.
-type MockControllerDomainServicesControllerConfigCall = gomock.Call0_1[*service5.WatchableService]
+type MockControllerDomainServicesControllerConfigCall = gomock.Call0_1[*service8.WatchableService]
 
 // ControllerNode mocks base method.
-func (m *MockControllerDomainServices) ControllerNode() *service6.WatchableService {
+func (m *MockControllerDomainServices) ControllerNode() *service9.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerNodeExpects, m.ctrl, m, "ControllerNode")
 }
@@ -210,17 +270,17 @@ func (m *MockControllerDomainServices) ControllerNode() *service6.WatchableServi
 // ControllerNode indicates an expected call of ControllerNode.
 func (mr *MockControllerDomainServicesMockRecorder) ControllerNode() *MockControllerDomainServicesControllerNodeCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service6.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerNode")
+	call := gomock.NewCall0_1[*service9.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerNode")
 	mr.controllerNodeExpects = append(mr.controllerNodeExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesControllerNodeCall is the typed call wrapper for ControllerNode.
-type MockControllerDomainServicesControllerNodeCall = gomock.Call0_1[*service6.WatchableService]
+type MockControllerDomainServicesControllerNodeCall = gomock.Call0_1[*service9.WatchableService]
 
 // Credential mocks base method.
-func (m *MockControllerDomainServices) Credential() *service7.WatchableService {
+func (m *MockControllerDomainServices) Credential() *service10.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.credentialExpects, m.ctrl, m, "Credential")
 }
@@ -228,17 +288,17 @@ func (m *MockControllerDomainServices) Credential() *service7.WatchableService {
 // Credential indicates an expected call of Credential.
 func (mr *MockControllerDomainServicesMockRecorder) Credential() *MockControllerDomainServicesCredentialCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service7.WatchableService](mr.mock.ctrl.T, mr.mock, "Credential")
+	call := gomock.NewCall0_1[*service10.WatchableService](mr.mock.ctrl.T, mr.mock, "Credential")
 	mr.credentialExpects = append(mr.credentialExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesCredentialCall is the typed call wrapper for Credential.
-type MockControllerDomainServicesCredentialCall = gomock.Call0_1[*service7.WatchableService]
+type MockControllerDomainServicesCredentialCall = gomock.Call0_1[*service10.WatchableService]
 
 // ExternalController mocks base method.
-func (m *MockControllerDomainServices) ExternalController() *service8.WatchableService {
+func (m *MockControllerDomainServices) ExternalController() *service11.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.externalControllerExpects, m.ctrl, m, "ExternalController")
 }
@@ -246,17 +306,17 @@ func (m *MockControllerDomainServices) ExternalController() *service8.WatchableS
 // ExternalController indicates an expected call of ExternalController.
 func (mr *MockControllerDomainServicesMockRecorder) ExternalController() *MockControllerDomainServicesExternalControllerCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service8.WatchableService](mr.mock.ctrl.T, mr.mock, "ExternalController")
+	call := gomock.NewCall0_1[*service11.WatchableService](mr.mock.ctrl.T, mr.mock, "ExternalController")
 	mr.externalControllerExpects = append(mr.externalControllerExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesExternalControllerCall is the typed call wrapper for ExternalController.
-type MockControllerDomainServicesExternalControllerCall = gomock.Call0_1[*service8.WatchableService]
+type MockControllerDomainServicesExternalControllerCall = gomock.Call0_1[*service11.WatchableService]
 
 // Flag mocks base method.
-func (m *MockControllerDomainServices) Flag() *service9.Service {
+func (m *MockControllerDomainServices) Flag() *service12.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.flagExpects, m.ctrl, m, "Flag")
 }
@@ -264,17 +324,17 @@ func (m *MockControllerDomainServices) Flag() *service9.Service {
 // Flag indicates an expected call of Flag.
 func (mr *MockControllerDomainServicesMockRecorder) Flag() *MockControllerDomainServicesFlagCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service9.Service](mr.mock.ctrl.T, mr.mock, "Flag")
+	call := gomock.NewCall0_1[*service12.Service](mr.mock.ctrl.T, mr.mock, "Flag")
 	mr.flagExpects = append(mr.flagExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesFlagCall is the typed call wrapper for Flag.
-type MockControllerDomainServicesFlagCall = gomock.Call0_1[*service9.Service]
+type MockControllerDomainServicesFlagCall = gomock.Call0_1[*service12.Service]
 
 // Logging mocks base method.
-func (m *MockControllerDomainServices) Logging() *service10.WatchableService {
+func (m *MockControllerDomainServices) Logging() *service13.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.loggingExpects, m.ctrl, m, "Logging")
 }
@@ -282,17 +342,17 @@ func (m *MockControllerDomainServices) Logging() *service10.WatchableService {
 // Logging indicates an expected call of Logging.
 func (mr *MockControllerDomainServicesMockRecorder) Logging() *MockControllerDomainServicesLoggingCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service10.WatchableService](mr.mock.ctrl.T, mr.mock, "Logging")
+	call := gomock.NewCall0_1[*service13.WatchableService](mr.mock.ctrl.T, mr.mock, "Logging")
 	mr.loggingExpects = append(mr.loggingExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesLoggingCall is the typed call wrapper for Logging.
-type MockControllerDomainServicesLoggingCall = gomock.Call0_1[*service10.WatchableService]
+type MockControllerDomainServicesLoggingCall = gomock.Call0_1[*service13.WatchableService]
 
 // Macaroon mocks base method.
-func (m *MockControllerDomainServices) Macaroon() *service11.Service {
+func (m *MockControllerDomainServices) Macaroon() *service14.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.macaroonExpects, m.ctrl, m, "Macaroon")
 }
@@ -300,17 +360,17 @@ func (m *MockControllerDomainServices) Macaroon() *service11.Service {
 // Macaroon indicates an expected call of Macaroon.
 func (mr *MockControllerDomainServicesMockRecorder) Macaroon() *MockControllerDomainServicesMacaroonCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service11.Service](mr.mock.ctrl.T, mr.mock, "Macaroon")
+	call := gomock.NewCall0_1[*service14.Service](mr.mock.ctrl.T, mr.mock, "Macaroon")
 	mr.macaroonExpects = append(mr.macaroonExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesMacaroonCall is the typed call wrapper for Macaroon.
-type MockControllerDomainServicesMacaroonCall = gomock.Call0_1[*service11.Service]
+type MockControllerDomainServicesMacaroonCall = gomock.Call0_1[*service14.Service]
 
 // Model mocks base method.
-func (m *MockControllerDomainServices) Model() *service12.WatchableService {
+func (m *MockControllerDomainServices) Model() *service15.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelExpects, m.ctrl, m, "Model")
 }
@@ -318,17 +378,17 @@ func (m *MockControllerDomainServices) Model() *service12.WatchableService {
 // Model indicates an expected call of Model.
 func (mr *MockControllerDomainServicesMockRecorder) Model() *MockControllerDomainServicesModelCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service12.WatchableService](mr.mock.ctrl.T, mr.mock, "Model")
+	call := gomock.NewCall0_1[*service15.WatchableService](mr.mock.ctrl.T, mr.mock, "Model")
 	mr.modelExpects = append(mr.modelExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesModelCall is the typed call wrapper for Model.
-type MockControllerDomainServicesModelCall = gomock.Call0_1[*service12.WatchableService]
+type MockControllerDomainServicesModelCall = gomock.Call0_1[*service15.WatchableService]
 
 // ModelDefaults mocks base method.
-func (m *MockControllerDomainServices) ModelDefaults() *service13.Service {
+func (m *MockControllerDomainServices) ModelDefaults() *service16.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelDefaultsExpects, m.ctrl, m, "ModelDefaults")
 }
@@ -336,14 +396,14 @@ func (m *MockControllerDomainServices) ModelDefaults() *service13.Service {
 // ModelDefaults indicates an expected call of ModelDefaults.
 func (mr *MockControllerDomainServicesMockRecorder) ModelDefaults() *MockControllerDomainServicesModelDefaultsCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service13.Service](mr.mock.ctrl.T, mr.mock, "ModelDefaults")
+	call := gomock.NewCall0_1[*service16.Service](mr.mock.ctrl.T, mr.mock, "ModelDefaults")
 	mr.modelDefaultsExpects = append(mr.modelDefaultsExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesModelDefaultsCall is the typed call wrapper for ModelDefaults.
-type MockControllerDomainServicesModelDefaultsCall = gomock.Call0_1[*service13.Service]
+type MockControllerDomainServicesModelDefaultsCall = gomock.Call0_1[*service16.Service]
 
 // SSHServerHostKey mocks base method.
 func (m *MockControllerDomainServices) SSHServerHostKey() *controller.Service {
@@ -364,7 +424,7 @@ func (mr *MockControllerDomainServicesMockRecorder) SSHServerHostKey() *MockCont
 type MockControllerDomainServicesSSHServerHostKeyCall = gomock.Call0_1[*controller.Service]
 
 // SecretBackend mocks base method.
-func (m *MockControllerDomainServices) SecretBackend() *service14.WatchableService {
+func (m *MockControllerDomainServices) SecretBackend() *service17.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.secretBackendExpects, m.ctrl, m, "SecretBackend")
 }
@@ -372,17 +432,17 @@ func (m *MockControllerDomainServices) SecretBackend() *service14.WatchableServi
 // SecretBackend indicates an expected call of SecretBackend.
 func (mr *MockControllerDomainServicesMockRecorder) SecretBackend() *MockControllerDomainServicesSecretBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service14.WatchableService](mr.mock.ctrl.T, mr.mock, "SecretBackend")
+	call := gomock.NewCall0_1[*service17.WatchableService](mr.mock.ctrl.T, mr.mock, "SecretBackend")
 	mr.secretBackendExpects = append(mr.secretBackendExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesSecretBackendCall is the typed call wrapper for SecretBackend.
-type MockControllerDomainServicesSecretBackendCall = gomock.Call0_1[*service14.WatchableService]
+type MockControllerDomainServicesSecretBackendCall = gomock.Call0_1[*service17.WatchableService]
 
 // Tracing mocks base method.
-func (m *MockControllerDomainServices) Tracing() *service15.WatchableService {
+func (m *MockControllerDomainServices) Tracing() *service18.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.tracingExpects, m.ctrl, m, "Tracing")
 }
@@ -390,17 +450,17 @@ func (m *MockControllerDomainServices) Tracing() *service15.WatchableService {
 // Tracing indicates an expected call of Tracing.
 func (mr *MockControllerDomainServicesMockRecorder) Tracing() *MockControllerDomainServicesTracingCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service15.WatchableService](mr.mock.ctrl.T, mr.mock, "Tracing")
+	call := gomock.NewCall0_1[*service18.WatchableService](mr.mock.ctrl.T, mr.mock, "Tracing")
 	mr.tracingExpects = append(mr.tracingExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesTracingCall is the typed call wrapper for Tracing.
-type MockControllerDomainServicesTracingCall = gomock.Call0_1[*service15.WatchableService]
+type MockControllerDomainServicesTracingCall = gomock.Call0_1[*service18.WatchableService]
 
 // Upgrade mocks base method.
-func (m *MockControllerDomainServices) Upgrade() *service16.WatchableService {
+func (m *MockControllerDomainServices) Upgrade() *service19.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.upgradeExpects, m.ctrl, m, "Upgrade")
 }
@@ -408,11 +468,11 @@ func (m *MockControllerDomainServices) Upgrade() *service16.WatchableService {
 // Upgrade indicates an expected call of Upgrade.
 func (mr *MockControllerDomainServicesMockRecorder) Upgrade() *MockControllerDomainServicesUpgradeCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service16.WatchableService](mr.mock.ctrl.T, mr.mock, "Upgrade")
+	call := gomock.NewCall0_1[*service19.WatchableService](mr.mock.ctrl.T, mr.mock, "Upgrade")
 	mr.upgradeExpects = append(mr.upgradeExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockControllerDomainServicesUpgradeCall is the typed call wrapper for Upgrade.
-type MockControllerDomainServicesUpgradeCall = gomock.Call0_1[*service16.WatchableService]
+type MockControllerDomainServicesUpgradeCall = gomock.Call0_1[*service19.WatchableService]
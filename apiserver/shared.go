@@ -5,6 +5,7 @@ package apiserver
 
 import (
 	"context"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -16,13 +17,16 @@ import (
 
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
+	corebackups "github.com/juju/juju/core/backups"
 	"github.com/juju/juju/core/changestream"
+	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/flightrecorder"
 	"github.com/juju/juju/core/lease"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
+	"github.com/juju/juju/internal/backups"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/trace"
 	"github.com/juju/juju/internal/worker/watcherregistry"
@@ -49,6 +53,10 @@ type sharedServerContext struct {
 	// creating a new database for new models and during model migrations.
 	dbGetter changestream.WatchableDBGetter
 
+	// backups is used to create, store and restore backups of the
+	// controller.
+	backups *backups.Backups
+
 	// DomainServicesGetter is used to get the domain services for controllers
 	// and models.
 	domainServicesGetter     services.DomainServicesGetter
@@ -166,18 +174,23 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 		return nil, errors.Trace(err)
 	}
 	return &sharedServerContext{
-		flightRecorder:           config.flightRecorder,
-		leaseManager:             config.leaseManager,
-		logger:                   config.logger,
-		clock:                    config.clock,
-		controllerUUID:           config.controllerUUID,
-		controllerModelUUID:      config.controllerModelUUID,
-		controllerConfig:         config.controllerConfig,
-		loginTokenRefreshURL:     config.loginTokenRefreshURL,
-		offersThirdPartyKeyPair:  config.offersThirdPartyKeyPair,
-		charmhubHTTPClient:       config.charmhubHTTPClient,
-		macaroonHTTPClient:       config.macaroonHTTPClient,
-		dbGetter:                 config.dbGetter,
+		flightRecorder:          config.flightRecorder,
+		leaseManager:            config.leaseManager,
+		logger:                  config.logger,
+		clock:                   config.clock,
+		controllerUUID:          config.controllerUUID,
+		controllerModelUUID:     config.controllerModelUUID,
+		controllerConfig:        config.controllerConfig,
+		loginTokenRefreshURL:    config.loginTokenRefreshURL,
+		offersThirdPartyKeyPair: config.offersThirdPartyKeyPair,
+		charmhubHTTPClient:      config.charmhubHTTPClient,
+		macaroonHTTPClient:      config.macaroonHTTPClient,
+		dbGetter:                config.dbGetter,
+		backups: backups.NewBackups(backupsDBGetter{config.dbGetter}, corebackups.Paths{
+			BackupDir: filepath.Join(config.dataDir, backups.BackupDirName),
+			DataDir:   config.dataDir,
+			LogsDir:   config.logDir,
+		}, config.logger.Child("backups")),
 		domainServicesGetter:     config.domainServicesGetter,
 		controllerDomainServices: config.controllerDomainServices,
 		tracerGetter:             config.tracerGetter,
@@ -191,6 +204,17 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 	}, nil
 }
 
+// backupsDBGetter adapts the database getter of the API server for backups,
+// which have no need for the change stream.
+type backupsDBGetter struct {
+	changestream.WatchableDBGetter
+}
+
+// GetDB is part of the coredatabase.DBGetter interface.
+func (g backupsDBGetter) GetDB(ctx context.Context, namespace string) (coredatabase.TxnRunner, error) {
+	return g.GetWatchableDB(ctx, namespace)
+}
+
 // NewCrossModelAuthContext returns a new CrossModelAuthContext for the given
 // server host.
 func (c *sharedServerContext) NewCrossModelAuthContext(serverHost string) (facade.CrossModelAuthContext, error) {
@@ -55,6 +55,12 @@ type AccessService interface {
 	// state layer are passed through. If the access level of a user cannot be
 	// found then [accesserrors.AccessNotFound] is returned.
 	ReadUserAccessLevelForTarget(ctx context.Context, subject coreuser.Name, target corepermission.ID) (corepermission.Access, error)
+
+	// ReadUserGroupAccessLevelForTarget returns the greatest access level
+	// any of the groups the user is a member of has on the given target. If
+	// none of the groups have access then [accesserrors.AccessNotFound] is
+	// returned.
+	ReadUserGroupAccessLevelForTarget(ctx context.Context, subject coreuser.Name, target corepermission.ID) (corepermission.Access, error)
 }
 
 // AgentAuthenticatorGetter is a getter for creating authenticators, which
@@ -21,7 +21,8 @@ type PermissionDelegator struct {
 }
 
 // SubjectPermissions ensures that the input entity is a user,
-// then returns that user's access to the input target.
+// then returns that user's access to the input target: the greatest of the
+// access granted to the user and to the groups the user is a member of.
 //
 // This method is a pure permission read with no side effects.
 // External users can inherit permissions from everyone@external, including
@@ -39,10 +40,25 @@ func (p *PermissionDelegator) SubjectPermissions(
 
 	access, err := p.AccessService.ReadUserAccessLevelForTarget(ctx, name, target)
 	if errors.Is(err, accesserrors.AccessNotFound) {
-		return permission.NoAccess, accesserrors.PermissionNotFound
+		access = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	groupAccess, err := p.AccessService.ReadUserGroupAccessLevelForTarget(ctx, name, target)
+	if errors.Is(err, accesserrors.AccessNotFound) || errors.Is(err, accesserrors.UserNotFound) {
+		groupAccess = permission.NoAccess
 	} else if err != nil {
 		return permission.NoAccess, errors.Trace(err)
 	}
+	if groupAccess != permission.NoAccess && (access == permission.NoAccess ||
+		!(permission.AccessSpec{Target: target, Access: access}).EqualOrGreaterThan(groupAccess)) {
+		access = groupAccess
+	}
+
+	if access == permission.NoAccess {
+		return permission.NoAccess, accesserrors.PermissionNotFound
+	}
 	return access, nil
 }
 
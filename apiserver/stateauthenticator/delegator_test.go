@@ -51,6 +51,9 @@ func (s *permissionDelegatorSuite) TestSubjectPermissionsLocalUserSuccess(c *tc.
 	s.accessService.EXPECT().
 		ReadUserAccessLevelForTarget(gomock.Any(), aliceName, target).
 		Return(permission.AdminAccess, nil)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
 
 	access, err := s.delegator().SubjectPermissions(c.Context(), "alice@local", target)
 	c.Assert(err, tc.ErrorIsNil)
@@ -71,6 +74,9 @@ func (s *permissionDelegatorSuite) TestSubjectPermissionsAccessNotFound(c *tc.C)
 	s.accessService.EXPECT().
 		ReadUserAccessLevelForTarget(gomock.Any(), aliceName, target).
 		Return(permission.NoAccess, accesserrors.AccessNotFound)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
 
 	access, err := s.delegator().SubjectPermissions(c.Context(), "alice@local", target)
 	c.Assert(err, tc.ErrorIs, accesserrors.PermissionNotFound)
@@ -125,6 +131,9 @@ func (s *permissionDelegatorSuite) TestSubjectPermissionsDisabledExternalUser(c
 	s.accessService.EXPECT().
 		ReadUserAccessLevelForTarget(gomock.Any(), jimName, target).
 		Return(permission.NoAccess, accesserrors.AccessNotFound)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), jimName, target).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
 
 	access, err := s.delegator().SubjectPermissions(c.Context(), "jim@external", target)
 	c.Check(err, tc.ErrorIs, accesserrors.PermissionNotFound)
@@ -145,12 +154,83 @@ func (s *permissionDelegatorSuite) TestSubjectPermissionsRemovedExternalUser(c *
 	s.accessService.EXPECT().
 		ReadUserAccessLevelForTarget(gomock.Any(), jimName, target).
 		Return(permission.NoAccess, accesserrors.AccessNotFound)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), jimName, target).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
 
 	access, err := s.delegator().SubjectPermissions(c.Context(), "jim@external", target)
 	c.Check(err, tc.ErrorIs, accesserrors.PermissionNotFound)
 	c.Check(access, tc.Equals, permission.NoAccess)
 }
 
+// TestSubjectPermissionsGroupGreater verifies that SubjectPermissions returns
+// the access granted to a group the user is a member of when it is greater
+// than the access granted to the user.
+func (s *permissionDelegatorSuite) TestSubjectPermissionsGroupGreater(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	aliceName := tc.Must1(c, user.NewName, "alice")
+	target := permission.ID{
+		ObjectType: permission.Model,
+		Key:        "model-uuid",
+	}
+	s.accessService.EXPECT().
+		ReadUserAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.ReadAccess, nil)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.AdminAccess, nil)
+
+	access, err := s.delegator().SubjectPermissions(c.Context(), "alice", target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(access, tc.Equals, permission.AdminAccess)
+}
+
+// TestSubjectPermissionsUserGreater verifies that SubjectPermissions returns
+// the access granted to the user when it is greater than the access granted
+// to the user's groups.
+func (s *permissionDelegatorSuite) TestSubjectPermissionsUserGreater(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	aliceName := tc.Must1(c, user.NewName, "alice")
+	target := permission.ID{
+		ObjectType: permission.Model,
+		Key:        "model-uuid",
+	}
+	s.accessService.EXPECT().
+		ReadUserAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.WriteAccess, nil)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.ReadAccess, nil)
+
+	access, err := s.delegator().SubjectPermissions(c.Context(), "alice", target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(access, tc.Equals, permission.WriteAccess)
+}
+
+// TestSubjectPermissionsGroupOnly verifies that a user with no access of
+// their own holds the access granted to their groups.
+func (s *permissionDelegatorSuite) TestSubjectPermissionsGroupOnly(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	aliceName := tc.Must1(c, user.NewName, "alice")
+	target := permission.ID{
+		ObjectType: permission.Controller,
+		Key:        "controller-uuid",
+	}
+	s.accessService.EXPECT().
+		ReadUserAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.NoAccess, accesserrors.AccessNotFound)
+	s.accessService.EXPECT().
+		ReadUserGroupAccessLevelForTarget(gomock.Any(), aliceName, target).
+		Return(permission.LoginAccess, nil)
+
+	access, err := s.delegator().SubjectPermissions(c.Context(), "alice", target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(access, tc.Equals, permission.LoginAccess)
+}
+
 // TestPermissionError verifies that PermissionError always returns ErrPerm,
 // regardless of the tag or access level provided.
 func (s *permissionDelegatorSuite) TestPermissionError(c *tc.C) {
@@ -23,6 +23,7 @@ type FakeAuthorizer struct {
 	HasConsumeTag names.UserTag
 	HasWriteTag   names.UserTag
 	HasReadTag    names.UserTag
+	Groups        []string
 }
 
 func (fa FakeAuthorizer) AuthOwner(tag names.Tag) bool {
@@ -70,6 +71,10 @@ func (fa FakeAuthorizer) GetAuthTag() names.Tag {
 	return fa.Tag
 }
 
+func (fa FakeAuthorizer) GetAuthGroups() []string {
+	return fa.Groups
+}
+
 // HasPermission returns true if the logged in user is admin or has a name equal to
 // the pre-set admin tag.
 func (fa FakeAuthorizer) HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error {
@@ -120,6 +125,14 @@ func nameBasedHasPermission(name string, operation permission.Access, target nam
 		perm = permission.ConsumeAccess
 	case strings.HasPrefix(name, string(permission.ReadAccess)):
 		perm = permission.ReadAccess
+	case strings.HasPrefix(name, string(permission.RunActionAccess)):
+		perm = permission.RunActionAccess
+	case strings.HasPrefix(name, string(permission.ConfigAccess)):
+		perm = permission.ConfigAccess
+	case strings.HasPrefix(name, string(permission.RefreshAccess)):
+		perm = permission.RefreshAccess
+	case strings.HasPrefix(name, string(permission.ScaleAccess)):
+		perm = permission.ScaleAccess
 	default:
 		return false
 	}
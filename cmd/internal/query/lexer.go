@@ -0,0 +1,218 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenType identifies the kind of a lexed token.
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenTrue
+	tokenFalse
+	tokenNull
+	tokenEQ
+	tokenNEQ
+	tokenLT
+	tokenLE
+	tokenGT
+	tokenGE
+	tokenMatch
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenDot
+)
+
+var tokenNames = map[tokenType]string{
+	tokenEOF:    "end of query",
+	tokenIdent:  "identifier",
+	tokenString: "string",
+	tokenNumber: "number",
+	tokenTrue:   "true",
+	tokenFalse:  "false",
+	tokenNull:   "null",
+	tokenEQ:     "==",
+	tokenNEQ:    "!=",
+	tokenLT:     "<",
+	tokenLE:     "<=",
+	tokenGT:     ">",
+	tokenGE:     ">=",
+	tokenMatch:  "=~",
+	tokenAnd:    "&&",
+	tokenOr:     "||",
+	tokenNot:    "!",
+	tokenLParen: "(",
+	tokenRParen: ")",
+	tokenDot:    ".",
+}
+
+// String returns the human readable name of the token type.
+func (t tokenType) String() string {
+	return tokenNames[t]
+}
+
+var keywords = map[string]tokenType{
+	"true":  tokenTrue,
+	"false": tokenFalse,
+	"null":  tokenNull,
+}
+
+// token is a single lexed token along with its position in the query.
+type token struct {
+	typ     tokenType
+	literal string
+	pos     int
+}
+
+// String returns the token as it is shown in error messages.
+func (t token) String() string {
+	switch t.typ {
+	case tokenEOF:
+		return t.typ.String()
+	case tokenString:
+		return fmt.Sprintf("%q", t.literal)
+	}
+	return t.literal
+}
+
+// lex splits the input into tokens, terminated by an EOF token.
+func lex(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+	for pos := 0; pos < len(runes); {
+		r := runes[pos]
+		switch {
+		case unicode.IsSpace(r):
+			pos++
+
+		case isIdentStart(r):
+			start := pos
+			for pos < len(runes) && isIdentPart(runes[pos]) {
+				pos++
+			}
+			literal := string(runes[start:pos])
+			typ, ok := keywords[literal]
+			if !ok {
+				typ = tokenIdent
+			}
+			tokens = append(tokens, token{typ: typ, literal: literal, pos: start})
+
+		case unicode.IsDigit(r):
+			start := pos
+			for pos < len(runes) && (unicode.IsDigit(runes[pos]) || runes[pos] == '.') {
+				pos++
+			}
+			tokens = append(tokens, token{typ: tokenNumber, literal: string(runes[start:pos]), pos: start})
+
+		case r == '"' || r == '\'':
+			start := pos
+			literal, end, err := lexString(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			pos = end
+			tokens = append(tokens, token{typ: tokenString, literal: literal, pos: start})
+
+		default:
+			typ, width, err := lexOperator(runes, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{typ: typ, literal: string(runes[pos : pos+width]), pos: pos})
+			pos += width
+		}
+	}
+	return append(tokens, token{typ: tokenEOF, pos: len(runes)}), nil
+}
+
+// lexString reads a quoted string starting at pos, returning its unquoted
+// value and the position just after the closing quote.
+func lexString(runes []rune, pos int) (string, int, error) {
+	quote := runes[pos]
+	var sb strings.Builder
+	for i := pos + 1; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case quote:
+			return sb.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				r = runes[i]
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string starting at position %d", pos)
+}
+
+// lexOperator reads the operator starting at pos, returning its type and
+// width in runes.
+func lexOperator(runes []rune, pos int) (tokenType, int, error) {
+	next := func(r rune) bool {
+		return pos+1 < len(runes) && runes[pos+1] == r
+	}
+	switch runes[pos] {
+	case '=':
+		if next('=') {
+			return tokenEQ, 2, nil
+		}
+		if next('~') {
+			return tokenMatch, 2, nil
+		}
+	case '!':
+		if next('=') {
+			return tokenNEQ, 2, nil
+		}
+		return tokenNot, 1, nil
+	case '<':
+		if next('=') {
+			return tokenLE, 2, nil
+		}
+		return tokenLT, 1, nil
+	case '>':
+		if next('=') {
+			return tokenGE, 2, nil
+		}
+		return tokenGT, 1, nil
+	case '&':
+		if next('&') {
+			return tokenAnd, 2, nil
+		}
+	case '|':
+		if next('|') {
+			return tokenOr, 2, nil
+		}
+	case '(':
+		return tokenLParen, 1, nil
+	case ')':
+		return tokenRParen, 1, nil
+	case '.':
+		return tokenDot, 1, nil
+	}
+	return tokenEOF, 0, fmt.Errorf("unexpected character %q at position %d", runes[pos], pos)
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+// isIdentPart reports whether r can continue an identifier. Hyphens are
+// allowed so that the names used in the YAML and JSON output of juju
+// commands, such as workload-status, can be used as is.
+func isIdentPart(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r) || r == '-'
+}
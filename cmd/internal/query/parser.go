@@ -0,0 +1,235 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// expr is a node of a parsed query.
+type expr interface {
+	// String returns the node as it would be written in a query.
+	String() string
+}
+
+// literalExpr is a string, number, boolean or null literal.
+type literalExpr struct {
+	value any
+}
+
+func (e literalExpr) String() string {
+	switch v := e.value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	}
+	return fmt.Sprint(e.value)
+}
+
+// identExpr is a reference to a value in the scope of the query.
+type identExpr struct {
+	name string
+}
+
+func (e identExpr) String() string {
+	return e.name
+}
+
+// selectorExpr selects a field of an object, such as machine.hostname.
+type selectorExpr struct {
+	x     expr
+	field string
+}
+
+func (e selectorExpr) String() string {
+	return e.x.String() + "." + e.field
+}
+
+// unaryExpr is a unary operation, of which only negation is supported.
+type unaryExpr struct {
+	op tokenType
+	x  expr
+}
+
+func (e unaryExpr) String() string {
+	return e.op.String() + e.x.String()
+}
+
+// binaryExpr is a comparison or logical operation.
+type binaryExpr struct {
+	op   tokenType
+	x, y expr
+}
+
+func (e binaryExpr) String() string {
+	return "(" + e.x.String() + " " + e.op.String() + " " + e.y.String() + ")"
+}
+
+// matchExpr matches a string against a regular expression, such as
+// machine.hardware=~"availability-zone=a".
+type matchExpr struct {
+	x       expr
+	pattern *regexp.Regexp
+}
+
+func (e matchExpr) String() string {
+	return "(" + e.x.String() + " =~ " + strconv.Quote(e.pattern.String()) + ")"
+}
+
+// parser is a recursive descent parser over the tokens of a query. The
+// grammar, in decreasing order of precedence, is:
+//
+//	primary    = literal | ident { "." ident } | "(" expr ")"
+//	unary      = "!" unary | primary
+//	comparison = unary [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) unary
+//	                   | "=~" string ]
+//	and        = comparison { "&&" comparison }
+//	expr       = and { "||" and }
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(typ tokenType) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, unexpected(t, typ.String())
+	}
+	return t, nil
+}
+
+func (p *parser) parse() (expr, error) {
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, unexpected(t, "operator")
+	}
+	return e, nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	return p.parseBinary(p.parseAnd, tokenOr)
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	return p.parseBinary(p.parseComparison, tokenAnd)
+}
+
+func (p *parser) parseBinary(operand func() (expr, error), op tokenType) (expr, error) {
+	x, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().typ == op {
+		p.next()
+		y, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		x = binaryExpr{op: op, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseComparison() (expr, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	switch op := p.peek().typ; op {
+	case tokenMatch:
+		p.next()
+		t, err := p.expect(tokenString)
+		if err != nil {
+			return nil, err
+		}
+		pattern, err := regexp.Compile(t.literal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q at position %d: %w", t.literal, t.pos, err)
+		}
+		return matchExpr{x: x, pattern: pattern}, nil
+	case tokenEQ, tokenNEQ, tokenLT, tokenLE, tokenGT, tokenGE:
+		p.next()
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return binaryExpr{op: op, x: x, y: y}, nil
+	}
+	return x, nil
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.peek().typ == tokenNot {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryExpr{op: tokenNot, x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.next()
+	switch t.typ {
+	case tokenString:
+		return literalExpr{value: t.literal}, nil
+	case tokenNumber:
+		f, err := strconv.ParseFloat(t.literal, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.literal, t.pos)
+		}
+		return literalExpr{value: f}, nil
+	case tokenTrue:
+		return literalExpr{value: true}, nil
+	case tokenFalse:
+		return literalExpr{value: false}, nil
+	case tokenNull:
+		return literalExpr{value: nil}, nil
+	case tokenIdent:
+		var x expr = identExpr{name: t.literal}
+		for p.peek().typ == tokenDot {
+			p.next()
+			field, err := p.expect(tokenIdent)
+			if err != nil {
+				return nil, err
+			}
+			x = selectorExpr{x: x, field: field.literal}
+		}
+		return x, nil
+	case tokenLParen:
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+	return nil, unexpected(t, "value")
+}
+
+func unexpected(t token, want string) error {
+	return fmt.Errorf("unexpected %s at position %d, expected %s", t, t.pos, want)
+}
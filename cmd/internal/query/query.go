@@ -0,0 +1,273 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package query implements the small expression language used to test the
+// state of juju entities, for example:
+//
+//	life=="alive" && (status=="active" || status=="idle")
+//
+// Queries are evaluated against a Scope holding the attributes of an entity.
+// Strings, numbers, booleans and null can be compared with ==, !=, <, <=, >
+// and >=, strings can be matched against a regular expression with =~, and
+// the results combined with &&, || and !. Fields of nested objects are
+// selected with a dot, such as machine.hostname.
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// InvalidIdentifier is returned when a query references an identifier that is
+// not in its scope.
+const InvalidIdentifier = errors.ConstError("invalid identifier")
+
+// Scope holds the values that the identifiers of a query refer to. Values
+// are expected to be of the types produced when unmarshalling JSON into an
+// interface value: nil, bool, float64, string, []any and map[string]any.
+// Other numeric types are converted to float64 when compared.
+type Scope map[string]any
+
+// Query is a parsed query expression.
+type Query struct {
+	input string
+	root  expr
+}
+
+// Parse parses the input into a query.
+func Parse(input string) (Query, error) {
+	if strings.TrimSpace(input) == "" {
+		return Query{}, errors.NotValidf("empty query")
+	}
+	tokens, err := lex(input)
+	if err != nil {
+		return Query{}, errors.Annotatef(err, "parsing query %q", input)
+	}
+	p := parser{tokens: tokens}
+	root, err := p.parse()
+	if err != nil {
+		return Query{}, errors.Annotatef(err, "parsing query %q", input)
+	}
+	return Query{input: input, root: root}, nil
+}
+
+// String returns the query as it was input.
+func (q Query) String() string {
+	return q.input
+}
+
+// Run evaluates the query against the scope, returning an error if the query
+// does not evaluate to a boolean.
+func (q Query) Run(scope Scope) (bool, error) {
+	v, err := q.Eval(scope)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, errors.Errorf("query %q evaluated to %s, expected a boolean", q.input, describe(v))
+	}
+	return b, nil
+}
+
+// Eval evaluates the query against the scope, returning its value.
+func (q Query) Eval(scope Scope) (any, error) {
+	v, err := eval(q.root, scope)
+	if err != nil {
+		return nil, errors.Annotatef(err, "running query %q", q.input)
+	}
+	return v, nil
+}
+
+func eval(e expr, scope Scope) (any, error) {
+	switch e := e.(type) {
+	case literalExpr:
+		return e.value, nil
+
+	case identExpr:
+		v, ok := scope[e.name]
+		if !ok {
+			return nil, fmt.Errorf("%w %q, expected one of %s",
+				InvalidIdentifier, e.name, strings.Join(scope.names(), ", "))
+		}
+		return normalise(v), nil
+
+	case selectorExpr:
+		x, err := eval(e.x, scope)
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case nil:
+			return nil, nil
+		case map[string]any:
+			// Fields which are absent are treated as null, as optional
+			// fields are omitted from the serialised form of entities.
+			return normalise(x[e.field]), nil
+		}
+		return nil, errors.Errorf("cannot select %q from %s in %s", e.field, describe(x), e)
+
+	case unaryExpr:
+		x, err := evalBool(e.x, scope)
+		if err != nil {
+			return nil, err
+		}
+		return !x, nil
+
+	case binaryExpr:
+		return evalBinary(e, scope)
+
+	case matchExpr:
+		x, err := eval(e.x, scope)
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case nil:
+			return false, nil
+		case string:
+			return e.pattern.MatchString(x), nil
+		}
+		return nil, errors.Errorf("cannot match %s against a pattern in %s", describe(x), e)
+	}
+	return nil, errors.Errorf("unexpected expression %T", e)
+}
+
+func evalBinary(e binaryExpr, scope Scope) (any, error) {
+	switch e.op {
+	case tokenAnd, tokenOr:
+		x, err := evalBool(e.x, scope)
+		if err != nil {
+			return nil, err
+		}
+		// Short circuit, so the remainder of the query may rely on the
+		// left hand side, such as machine!=null && machine.hostname=="a".
+		if (e.op == tokenAnd && !x) || (e.op == tokenOr && x) {
+			return x, nil
+		}
+		return evalBool(e.y, scope)
+	}
+
+	x, err := eval(e.x, scope)
+	if err != nil {
+		return nil, err
+	}
+	y, err := eval(e.y, scope)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case tokenEQ:
+		return equal(x, y), nil
+	case tokenNEQ:
+		return !equal(x, y), nil
+	}
+
+	cmp, err := compare(x, y)
+	if err != nil {
+		return nil, errors.Errorf("%v in %s", err, e)
+	}
+	switch e.op {
+	case tokenLT:
+		return cmp < 0, nil
+	case tokenLE:
+		return cmp <= 0, nil
+	case tokenGT:
+		return cmp > 0, nil
+	case tokenGE:
+		return cmp >= 0, nil
+	}
+	return nil, errors.Errorf("unexpected operator %s", e.op)
+}
+
+func evalBool(e expr, scope Scope) (bool, error) {
+	v, err := eval(e, scope)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, errors.Errorf("expected a boolean for %s, got %s", e, describe(v))
+	}
+	return b, nil
+}
+
+// equal reports whether the values are equal. Values of different types are
+// never equal.
+func equal(x, y any) bool {
+	switch x := x.(type) {
+	case nil, bool, float64, string:
+		return x == y
+	}
+	return false
+}
+
+// compare orders two numbers or two strings.
+func compare(x, y any) (int, error) {
+	switch x := x.(type) {
+	case float64:
+		if y, ok := y.(float64); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case string:
+		if y, ok := y.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	}
+	return 0, errors.Errorf("cannot compare %s with %s", describe(x), describe(y))
+}
+
+// normalise converts numeric values to float64, so they can be compared with
+// the numbers in a query.
+func normalise(v any) any {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	}
+	return v
+}
+
+func describe(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []any:
+		return "a list"
+	case map[string]any:
+		return "an object"
+	default:
+		return fmt.Sprintf("a %T", v)
+	}
+}
+
+func (s Scope) names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+)
+
+type querySuite struct{}
+
+func TestQuerySuite(t *testing.T) {
+	tc.Run(t, &querySuite{})
+}
+
+var unitScope = Scope{
+	"name":            "mysql/0",
+	"life":            "alive",
+	"workload-status": "active",
+	"leader":          true,
+	"revision":        42,
+	"machine": map[string]any{
+		"id":   "0",
+		"zone": "a",
+	},
+	"subordinate-to": nil,
+}
+
+func (s *querySuite) TestRun(c *tc.C) {
+	tests := []struct {
+		query    string
+		expected bool
+	}{
+		{query: `life=="alive"`, expected: true},
+		{query: `life == 'alive'`, expected: true},
+		{query: `life!="alive"`, expected: false},
+		{query: `life=="alive" && workload-status=="active"`, expected: true},
+		{query: `life=="dying" && workload-status=="active"`, expected: false},
+		{query: `life=="dying" || workload-status=="active"`, expected: true},
+		{query: `!(life=="dying")`, expected: true},
+		{query: `leader`, expected: true},
+		{query: `!leader || life=="dead"`, expected: false},
+		{query: `leader==true`, expected: true},
+		{query: `revision==42`, expected: true},
+		{query: `revision>=42 && revision<43`, expected: true},
+		{query: `revision>42.5`, expected: false},
+		{query: `name>"mysql/"`, expected: true},
+		{query: `machine.zone=="a"`, expected: true},
+		{query: `machine.instance-id==null`, expected: true},
+		{query: `subordinate-to==null`, expected: true},
+		{query: `subordinate-to.name==null`, expected: true},
+		{query: `revision=="42"`, expected: false},
+		{query: `life=="dying" || life=="alive" && leader`, expected: true},
+		{query: `name=~"^mysql/"`, expected: true},
+		{query: `name=~'^mysql/[1-9]'`, expected: false},
+		{query: `machine.instance-id=~"."`, expected: false},
+		{query: `!(workload-status=~"error|blocked")`, expected: true},
+	}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.query)
+		q, err := Parse(test.query)
+		c.Assert(err, tc.ErrorIsNil)
+		result, err := q.Run(unitScope)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(result, tc.Equals, test.expected)
+	}
+}
+
+func (s *querySuite) TestRunShortCircuits(c *tc.C) {
+	q, err := Parse(`subordinate-to!=null && subordinate-to.name=="x" || true`)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := q.Run(Scope{"subordinate-to": "not an object"})
+	c.Assert(err, tc.ErrorMatches, `.*cannot select "name" from a string.*`)
+	c.Check(result, tc.IsFalse)
+
+	result, err = q.Run(Scope{"subordinate-to": nil})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.IsTrue)
+}
+
+func (s *querySuite) TestEval(c *tc.C) {
+	q, err := Parse(`machine.id`)
+	c.Assert(err, tc.ErrorIsNil)
+	result, err := q.Eval(unitScope)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, "0")
+}
+
+func (s *querySuite) TestParseErrors(c *tc.C) {
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{query: ``, err: `empty query not valid`},
+		{query: `life=`, err: `.*unexpected character '=' at position 4`},
+		{query: `life=="alive`, err: `.*unterminated string starting at position 6`},
+		{query: `life=="alive" &&`, err: `.*unexpected end of query at position 16, expected value`},
+		{query: `(life=="alive"`, err: `.*unexpected end of query at position 14, expected \)`},
+		{query: `life "alive"`, err: `.*unexpected "alive" at position 5, expected operator`},
+		{query: `machine.`, err: `.*unexpected end of query at position 8, expected identifier`},
+		{query: `1.2.3==life`, err: `.*invalid number "1.2.3" at position 0`},
+		{query: `name=~life`, err: `.*unexpected life at position 6, expected string`},
+		{query: `name=~"("`, err: `.*invalid pattern "\(" at position 6: .*`},
+	}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.query)
+		_, err := Parse(test.query)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *querySuite) TestRunErrors(c *tc.C) {
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{query: `status=="active"`, err: `.*invalid identifier "status", expected one of leader, life, machine, name, revision, subordinate-to, workload-status`},
+		{query: `life`, err: `query "life" evaluated to a string, expected a boolean`},
+		{query: `life && leader`, err: `.*expected a boolean for life, got a string`},
+		{query: `life>1`, err: `.*cannot compare a string with a number in \(life > 1\)`},
+		{query: `name.id=="0"`, err: `.*cannot select "id" from a string in name.id`},
+		{query: `revision=~"4"`, err: `.*cannot match a number against a pattern in \(revision =~ "4"\)`},
+	}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.query)
+		q, err := Parse(test.query)
+		c.Assert(err, tc.ErrorIsNil)
+		_, err = q.Run(unitScope)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+
+	q, err := Parse(`status=="active"`)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = q.Run(unitScope)
+	c.Check(err, tc.ErrorIs, InvalidIdentifier)
+}
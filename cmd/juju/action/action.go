@@ -51,6 +51,15 @@ type APIClient interface {
 
 	// WatchActionProgress reports on logged action progress messages.
 	WatchActionProgress(ctx context.Context, actionId string) (watcher.StringsWatcher, error)
+
+	// AddSchedule schedules an action to run repeatedly on a cron schedule.
+	AddSchedule(ctx context.Context, args action.ScheduleArgs) error
+
+	// ListSchedules returns the scheduled actions of the model.
+	ListSchedules(ctx context.Context) ([]action.ScheduledAction, error)
+
+	// RemoveSchedules removes the named scheduled actions.
+	RemoveSchedules(ctx context.Context, names []string) error
 }
 
 // ActionCommandBase is the base type for action sub-commands.
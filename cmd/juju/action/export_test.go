@@ -179,3 +179,21 @@ func NewListOperationsCommandForTest(store jujuclient.ClientStore) (cmd.Command,
 	c.SetClientStore(store)
 	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel), &ListOperationsCommand{c}
 }
+
+func NewScheduleActionCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &scheduleActionCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel)
+}
+
+func NewListScheduledActionsCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &listScheduledActionsCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel)
+}
+
+func NewRemoveScheduledActionCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &removeScheduledActionCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel)
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"maps"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -55,6 +56,9 @@ When run without any arguments, operations corresponding to actions for all
 application units are returned.
 To see operations corresponding to ` + "`juju run`" + ` tasks, specify an action name,
 ` + "`juju-exec`" + `, and/or one or more machines.
+
+Operations started by a scheduled action show the name of the schedule. See
+` + "`juju scheduled-actions`" + `.
 `
 
 const listOperationsExamples = `
@@ -100,6 +104,7 @@ func (c *listOperationsCommand) Info() *cmd.Info {
 		Examples: listOperationsExamples,
 		SeeAlso: []string{
 			"run",
+			"scheduled-actions",
 			"show-operation",
 			"show-task",
 		},
@@ -218,6 +223,7 @@ type operationLine struct {
 	operation string
 	tasks     []string
 	status    string
+	schedule  string
 }
 
 const maxTaskIDs = 5
@@ -231,6 +237,13 @@ func (c *listOperationsCommand) formatTabular(writer io.Writer, value any) error
 	w := output.Wrapper{TabWriter: tw}
 	w.SetColumnAlignRight(0)
 
+	lines := actionOperationLinesFromResults(results)
+	// The schedule column is only shown if an operation was started by a
+	// scheduled action.
+	showSchedule := slices.ContainsFunc(lines, func(line operationLine) bool {
+		return line.schedule != ""
+	})
+
 	printOperations := func(operations []operationLine, utc bool) {
 		for _, line := range operations {
 			numTasks := min(len(line.tasks), maxTaskIDs)
@@ -242,11 +255,18 @@ func (c *listOperationsCommand) formatTabular(writer io.Writer, value any) error
 			w.Print(formatTimestamp(line.started, false, c.utc, true))
 			w.Print(formatTimestamp(line.finished, false, c.utc, true))
 			w.Print(tasks)
+			if showSchedule {
+				w.Print(line.schedule)
+			}
 			w.Println(line.operation)
 		}
 	}
-	w.Println("ID", "Status", "Started", "Finished", "Task IDs", "Summary")
-	printOperations(actionOperationLinesFromResults(results), c.utc)
+	if showSchedule {
+		w.Println("ID", "Status", "Started", "Finished", "Task IDs", "Schedule", "Summary")
+	} else {
+		w.Println("ID", "Status", "Started", "Finished", "Task IDs", "Summary")
+	}
+	printOperations(lines, c.utc)
 	return tw.Flush()
 }
 
@@ -259,6 +279,7 @@ func actionOperationLinesFromResults(results []actionapi.Operation) []operationL
 			finished:  r.Completed,
 			status:    r.Status,
 			operation: r.Summary,
+			schedule:  r.Schedule,
 		}
 		for _, a := range r.Actions {
 			if a.Action == nil {
@@ -298,13 +319,15 @@ func (s byId) Less(i, j int) bool {
 }
 
 type operationInfo struct {
-	Summary string              `yaml:"summary" json:"summary"`
-	Status  string              `yaml:"status" json:"status"`
-	Fail    string              `yaml:"fail,omitempty" json:"fail,omitempty"`
-	Error   string              `yaml:"error,omitempty" json:"error,omitempty"`
-	Action  *actionSummary      `yaml:"action,omitempty" json:"action,omitempty"`
-	Timing  timingInfo          `yaml:"timing,omitempty" json:"timing,omitempty"`
-	Tasks   map[string]taskInfo `yaml:"tasks,omitempty" json:"tasks,omitempty"`
+	Summary     string              `yaml:"summary" json:"summary"`
+	Status      string              `yaml:"status" json:"status"`
+	Schedule    string              `yaml:"schedule,omitempty" json:"schedule,omitempty"`
+	ScheduledBy string              `yaml:"scheduled-by,omitempty" json:"scheduled-by,omitempty"`
+	Fail        string              `yaml:"fail,omitempty" json:"fail,omitempty"`
+	Error       string              `yaml:"error,omitempty" json:"error,omitempty"`
+	Action      *actionSummary      `yaml:"action,omitempty" json:"action,omitempty"`
+	Timing      timingInfo          `yaml:"timing,omitempty" json:"timing,omitempty"`
+	Tasks       map[string]taskInfo `yaml:"tasks,omitempty" json:"tasks,omitempty"`
 }
 
 type timingInfo struct {
@@ -332,9 +355,11 @@ type taskInfo struct {
 // write in an easy-to-read format.
 func formatOperationResult(operation actionapi.Operation, utc bool) operationInfo {
 	result := operationInfo{
-		Summary: operation.Summary,
-		Fail:    operation.Fail,
-		Status:  operation.Status,
+		Summary:     operation.Summary,
+		Fail:        operation.Fail,
+		Status:      operation.Status,
+		Schedule:    operation.Schedule,
+		ScheduledBy: operation.ScheduledBy,
 		Timing: timingInfo{
 			Enqueued:  formatTimestamp(operation.Enqueued, false, utc, false),
 			Started:   formatTimestamp(operation.Started, false, utc, false),
@@ -202,6 +202,61 @@ ID  Status   Started  Finished             Task IDs  Summary
 	}
 }
 
+func (s *ListOperationsSuite) TestRunPlainScheduled(c *tc.C) {
+	results := exampleListOperationResults()
+	results.Operations[1].Schedule = "nightly"
+	fakeClient := &fakeAPIClient{
+		operationResults: results,
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	s.wrappedCommand, _ = action.NewListOperationsCommandForTest(s.store)
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand, "-m", "admin", "--utc")
+	c.Assert(err, tc.ErrorIsNil)
+	expected := `
+ID  Status   Started  Finished             Task IDs  Schedule  Summary
+ 1  error                                  2                   operation 1
+ 3  running           2014-02-14T06:06:06  4         nightly   operation 3
+ 5  pending                                6                   operation 5
+10  error                                                      operation 10
+`[1:]
+	c.Check(ctx.Stdout.(*bytes.Buffer).String(), tc.Equals, expected)
+}
+
+func (s *ListOperationsSuite) TestRunYamlScheduled(c *tc.C) {
+	results := exampleListOperationResults()
+	results.Operations = results.Operations[1:2]
+	results.Operations[0].Schedule = "nightly"
+	results.Operations[0].ScheduledBy = "fred"
+	fakeClient := &fakeAPIClient{
+		operationResults: results,
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	s.wrappedCommand, _ = action.NewListOperationsCommandForTest(s.store)
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand, "-m", "admin", "--format", "yaml", "--utc")
+	c.Assert(err, tc.ErrorIsNil)
+	expected := `
+"3":
+  summary: operation 3
+  status: running
+  schedule: nightly
+  scheduled-by: fred
+  action:
+    name: restore
+    parameters: {}
+  timing:
+    completed: 2014-02-14 06:06:06 +0000 UTC
+  tasks:
+    "4":
+      host: mysql/1
+      status: ""
+`[1:]
+	c.Check(ctx.Stdout.(*bytes.Buffer).String(), tc.Equals, expected)
+}
+
 func (s *ListOperationsSuite) TestRunPlainTruncated(c *tc.C) {
 	listOperationResults := exampleListOperationResults()
 	listOperationResults.Truncated = true
@@ -0,0 +1,145 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	actionapi "github.com/juju/juju/api/client/action"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+)
+
+// NewListScheduledActionsCommand returns a command to list scheduled
+// actions.
+func NewListScheduledActionsCommand() cmd.Command {
+	return modelcmd.Wrap(&listScheduledActionsCommand{})
+}
+
+// listScheduledActionsCommand lists the scheduled actions of a model.
+type listScheduledActionsCommand struct {
+	ActionCommandBase
+	out cmd.Output
+	utc bool
+}
+
+const listScheduledActionsDoc = `
+List the actions scheduled to run repeatedly in the model, with when each
+next runs and the operation started by its most recent run.
+`
+
+const listScheduledActionsExamples = `
+    juju scheduled-actions
+    juju scheduled-actions --format yaml
+`
+
+// SetFlags implements Command.
+func (c *listScheduledActionsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ActionCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": c.formatTabular,
+	})
+	f.BoolVar(&c.utc, "utc", false, "Show times in UTC")
+}
+
+// Info implements Command.
+func (c *listScheduledActionsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "scheduled-actions",
+		Purpose:  "List actions scheduled to run repeatedly.",
+		Doc:      listScheduledActionsDoc,
+		Aliases:  []string{"list-scheduled-actions"},
+		Examples: listScheduledActionsExamples,
+		SeeAlso: []string{
+			"schedule-action",
+			"remove-scheduled-action",
+			"operations",
+		},
+	})
+}
+
+// Init implements Command.
+func (c *listScheduledActionsCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// scheduledActionInfo is the formatted output of a scheduled action.
+type scheduledActionInfo struct {
+	Schedule      string         `yaml:"schedule" json:"schedule"`
+	Units         []string       `yaml:"units" json:"units"`
+	Action        string         `yaml:"action" json:"action"`
+	Parameters    map[string]any `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	CreatedBy     string         `yaml:"created-by" json:"created-by"`
+	Created       string         `yaml:"created" json:"created"`
+	NextRun       string         `yaml:"next-run,omitempty" json:"next-run,omitempty"`
+	LastRun       string         `yaml:"last-run,omitempty" json:"last-run,omitempty"`
+	LastOperation string         `yaml:"last-operation,omitempty" json:"last-operation,omitempty"`
+}
+
+// Run implements Command.
+func (c *listScheduledActionsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.NewActionAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	schedules, err := api.ListSchedules(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(schedules) == 0 {
+		ctx.Infof("no scheduled actions")
+		return nil
+	}
+	if c.out.Name() == "tabular" {
+		return c.out.Write(ctx, schedules)
+	}
+
+	out := make(map[string]scheduledActionInfo, len(schedules))
+	for _, s := range schedules {
+		out[s.Name] = scheduledActionInfo{
+			Schedule:      s.Schedule,
+			Units:         s.Receivers,
+			Action:        s.Action,
+			Parameters:    s.Parameters,
+			CreatedBy:     s.CreatedBy,
+			Created:       formatTimestamp(s.Created, false, c.utc, false),
+			NextRun:       formatTimestamp(s.NextRun, false, c.utc, false),
+			LastRun:       formatTimestamp(s.LastRun, false, c.utc, false),
+			LastOperation: s.LastOperationID,
+		}
+	}
+	return c.out.Write(ctx, out)
+}
+
+func (c *listScheduledActionsCommand) formatTabular(writer io.Writer, value any) error {
+	schedules, ok := value.([]actionapi.ScheduledAction)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", schedules, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Name", "Schedule", "Action", "Units", "Created by", "Next run", "Last run", "Last operation")
+	for _, s := range schedules {
+		w.Print(s.Name, s.Schedule, s.Action)
+		w.Print(strings.Join(s.Receivers, ","), s.CreatedBy)
+		w.Print(c.formatTime(s.NextRun), c.formatTime(s.LastRun))
+		w.Println(s.LastOperationID)
+	}
+	return tw.Flush()
+}
+
+func (c *listScheduledActionsCommand) formatTime(t time.Time) string {
+	return formatTimestamp(t, false, c.utc, true)
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	actionapi "github.com/juju/juju/api/client/action"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/action"
+)
+
+type ListScheduledActionsSuite struct {
+	BaseActionSuite
+}
+
+func TestListScheduledActionsSuite(t *testing.T) {
+	tc.Run(t, &ListScheduledActionsSuite{})
+}
+
+func exampleScheduledActions() []actionapi.ScheduledAction {
+	return []actionapi.ScheduledAction{{
+		Name:            "hourly",
+		Schedule:        "@hourly",
+		Receivers:       []string{"mysql/0", "mysql/1"},
+		Action:          "check",
+		CreatedBy:       "fred",
+		Created:         time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		NextRun:         time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC),
+		LastRun:         time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC),
+		LastOperationID: "42",
+	}, {
+		Name:       "nightly",
+		Schedule:   "0 2 * * *",
+		Receivers:  []string{"mysql/leader"},
+		Action:     "backup",
+		Parameters: map[string]any{"target": "s3"},
+		CreatedBy:  "mary",
+		Created:    time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		NextRun:    time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC),
+	}}
+}
+
+func (s *ListScheduledActionsSuite) TestRunNoResults(c *tc.C) {
+	fakeClient := &fakeAPIClient{}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	ctx, err := cmdtesting.RunCommand(c, action.NewListScheduledActionsCommandForTest(s.store), "-m", "admin")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ctx.Stdout.(*bytes.Buffer).String(), tc.Equals, "")
+	c.Check(ctx.Stderr.(*bytes.Buffer).String(), tc.Equals, "no scheduled actions\n")
+}
+
+func (s *ListScheduledActionsSuite) TestRunTabular(c *tc.C) {
+	fakeClient := &fakeAPIClient{schedules: exampleScheduledActions()}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	ctx, err := cmdtesting.RunCommand(c, action.NewListScheduledActionsCommandForTest(s.store), "-m", "admin", "--utc")
+	c.Assert(err, tc.ErrorIsNil)
+	expected := `
+Name     Schedule   Action  Units            Created by  Next run             Last run             Last operation
+hourly   @hourly    check   mysql/0,mysql/1  fred        2026-03-04T11:00:00  2026-03-04T10:00:00  42
+nightly  0 2 * * *  backup  mysql/leader     mary        2026-03-05T02:00:00                       
+`[1:]
+	c.Check(ctx.Stdout.(*bytes.Buffer).String(), tc.Equals, expected)
+}
+
+func (s *ListScheduledActionsSuite) TestRunYaml(c *tc.C) {
+	fakeClient := &fakeAPIClient{schedules: exampleScheduledActions()}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	ctx, err := cmdtesting.RunCommand(c, action.NewListScheduledActionsCommandForTest(s.store),
+		"-m", "admin", "--utc", "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	expected := `
+hourly:
+  schedule: '@hourly'
+  units:
+  - mysql/0
+  - mysql/1
+  action: check
+  created-by: fred
+  created: 2026-03-01 00:00:00 +0000 UTC
+  next-run: 2026-03-04 11:00:00 +0000 UTC
+  last-run: 2026-03-04 10:00:00 +0000 UTC
+  last-operation: "42"
+nightly:
+  schedule: 0 2 * * *
+  units:
+  - mysql/leader
+  action: backup
+  parameters:
+    target: s3
+  created-by: mary
+  created: 2026-03-01 00:00:00 +0000 UTC
+  next-run: 2026-03-05 02:00:00 +0000 UTC
+`[1:]
+	c.Check(ctx.Stdout.(*bytes.Buffer).String(), tc.Equals, expected)
+}
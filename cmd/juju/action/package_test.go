@@ -144,6 +144,9 @@ type fakeAPIClient struct {
 	charmActions       map[string]actionapi.ActionSpec
 	machines           set.Strings
 	execParams         *actionapi.RunParams
+	scheduleArgs       *actionapi.ScheduleArgs
+	schedules          []actionapi.ScheduledAction
+	removedSchedules   []string
 	apiErr             error
 	logMessageCh       chan []string
 	waitForResults     chan bool
@@ -355,3 +358,17 @@ func (c *fakeAPIClient) Run(ctx context.Context, runParams actionapi.RunParams)
 
 	return result, nil
 }
+
+func (c *fakeAPIClient) AddSchedule(ctx context.Context, args actionapi.ScheduleArgs) error {
+	c.scheduleArgs = &args
+	return c.apiErr
+}
+
+func (c *fakeAPIClient) ListSchedules(ctx context.Context) ([]actionapi.ScheduledAction, error) {
+	return c.schedules, c.apiErr
+}
+
+func (c *fakeAPIClient) RemoveSchedules(ctx context.Context, names []string) error {
+	c.removedSchedules = names
+	return c.apiErr
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewRemoveScheduledActionCommand returns a command to remove scheduled
+// actions.
+func NewRemoveScheduledActionCommand() cmd.Command {
+	return modelcmd.Wrap(&removeScheduledActionCommand{})
+}
+
+// removeScheduledActionCommand removes scheduled actions from a model.
+type removeScheduledActionCommand struct {
+	ActionCommandBase
+	names []string
+}
+
+const removeScheduledActionDoc = `
+Remove actions scheduled to run repeatedly. Operations already started by the
+scheduled actions are not affected, and remain visible in ` + "`juju operations`" + `.
+`
+
+const removeScheduledActionExamples = `
+    juju remove-scheduled-action nightly-backup
+    juju remove-scheduled-action nightly-backup hourly-check
+`
+
+// Info implements Command.
+func (c *removeScheduledActionCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-scheduled-action",
+		Args:     "<name> [<name> ...]",
+		Purpose:  "Remove scheduled actions.",
+		Doc:      removeScheduledActionDoc,
+		Examples: removeScheduledActionExamples,
+		SeeAlso: []string{
+			"schedule-action",
+			"scheduled-actions",
+		},
+	})
+}
+
+// Init implements Command.
+func (c *removeScheduledActionCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no scheduled action name specified")
+	}
+	c.names = args
+	return nil
+}
+
+// Run implements Command.
+func (c *removeScheduledActionCommand) Run(ctx *cmd.Context) error {
+	api, err := c.NewActionAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	return errors.Trace(api.RemoveSchedules(ctx, c.names))
+}
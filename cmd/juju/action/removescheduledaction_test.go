@@ -0,0 +1,37 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/action"
+)
+
+type RemoveScheduledActionSuite struct {
+	BaseActionSuite
+}
+
+func TestRemoveScheduledActionSuite(t *testing.T) {
+	tc.Run(t, &RemoveScheduledActionSuite{})
+}
+
+func (s *RemoveScheduledActionSuite) TestInitNoArgs(c *tc.C) {
+	err := cmdtesting.InitCommand(action.NewRemoveScheduledActionCommandForTest(s.store), []string{"-m", "admin"})
+	c.Assert(err, tc.ErrorMatches, "no scheduled action name specified")
+}
+
+func (s *RemoveScheduledActionSuite) TestRun(c *tc.C) {
+	fakeClient := &fakeAPIClient{}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	_, err := cmdtesting.RunCommand(c, action.NewRemoveScheduledActionCommandForTest(s.store),
+		"-m", "admin", "nightly", "hourly")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(fakeClient.removedSchedules, tc.DeepEquals, []string{"nightly", "hourly"})
+}
@@ -150,23 +150,30 @@ func (c *runCommand) Init(args []string) (err error) {
 	}
 
 	// Parse CLI key-value args if they exist.
-	c.args = make([][]string, 0)
-	for _, arg := range args[len(c.unitReceivers)+1:] {
+	c.args, err = parseActionArgs(args[len(c.unitReceivers)+1:])
+	return errors.Trace(err)
+}
+
+// parseActionArgs parses action arguments of the form key.key.key...=value
+// into slices of the keys followed by the value.
+func parseActionArgs(args []string) ([][]string, error) {
+	result := make([][]string, 0)
+	for _, arg := range args {
 		thisArg := strings.SplitN(arg, "=", 2)
 		if len(thisArg) != 2 {
-			return errors.Errorf("argument %q must be of the form key.key.key...=value", arg)
+			return nil, errors.Errorf("argument %q must be of the form key.key.key...=value", arg)
 		}
 		keySlice := strings.Split(thisArg[0], ".")
 		// check each key for validity
 		for _, key := range keySlice {
 			if valid := nameRule.MatchString(key); !valid {
-				return errors.Errorf("key %q must start and end with lowercase alphanumeric, "+
+				return nil, errors.Errorf("key %q must start and end with lowercase alphanumeric, "+
 					"and contain only lowercase alphanumeric and hyphens", key)
 			}
 		}
-		c.args = append(c.args, append(keySlice, thisArg[1]))
+		result = append(result, append(keySlice, thisArg[1]))
 	}
-	return nil
+	return result, nil
 }
 
 func (c *runCommand) Run(ctx *cmd.Context) error {
@@ -183,9 +190,36 @@ func (c *runCommand) Run(ctx *cmd.Context) error {
 }
 
 func (c *runCommand) enqueueActions(ctx *cmd.Context) (*actionapi.EnqueuedActions, error) {
+	actionParams, err := actionParameters(ctx, c.paramsYAML, c.args, c.parseStrings)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	actions := make([]actionapi.Action, len(c.unitReceivers))
+	for i, unitReceiver := range c.unitReceivers {
+		if strings.HasSuffix(unitReceiver, "leader") {
+			actions[i].Receiver = unitReceiver
+		} else {
+			actions[i].Receiver = names.NewUnitTag(unitReceiver).String()
+		}
+		actions[i].Name = c.actionName
+		actions[i].Parameters = actionParams
+	}
+	results, err := c.api.EnqueueOperation(ctx, actions)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Actions) != len(c.unitReceivers) {
+		return nil, errors.New("illegal number of results returned")
+	}
+	return &results, nil
+}
+
+// actionParameters builds the parameters of an action from the YAML params
+// file, if any, overridden by the parsed key-value args.
+func actionParameters(ctx *cmd.Context, paramsYAML cmd.FileVar, args [][]string, parseStrings bool) (map[string]any, error) {
 	actionParams := map[string]any{}
-	if c.paramsYAML.Path != "" {
-		b, err := c.paramsYAML.Read(ctx)
+	if paramsYAML.Path != "" {
+		b, err := paramsYAML.Read(ctx)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -209,12 +243,12 @@ func (c *runCommand) enqueueActions(ctx *cmd.Context) (*actionapi.EnqueuedAction
 	}
 	// If we had explicit args {..., [key, key, key, key, value], ...}
 	// then iterate and set params ..., key.key.key.key=value, ...
-	for _, argSlice := range c.args {
+	for _, argSlice := range args {
 		valueIndex := len(argSlice) - 1
 		keys := argSlice[:valueIndex]
 		value := argSlice[valueIndex]
 		cleansedValue := any(value)
-		if !c.parseStrings {
+		if !parseStrings {
 			err := yaml.Unmarshal([]byte(value), &cleansedValue)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -227,26 +261,9 @@ func (c *runCommand) enqueueActions(ctx *cmd.Context) (*actionapi.EnqueuedAction
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	typedConformantParams, ok := conformantParams.(map[string]any)
+	_, ok := conformantParams.(map[string]any)
 	if !ok {
-		return nil, errors.Errorf("params must be a map, got %T", typedConformantParams)
-	}
-	actions := make([]actionapi.Action, len(c.unitReceivers))
-	for i, unitReceiver := range c.unitReceivers {
-		if strings.HasSuffix(unitReceiver, "leader") {
-			actions[i].Receiver = unitReceiver
-		} else {
-			actions[i].Receiver = names.NewUnitTag(unitReceiver).String()
-		}
-		actions[i].Name = c.actionName
-		actions[i].Parameters = actionParams
+		return nil, errors.Errorf("params must be a map, got %T", conformantParams)
 	}
-	results, err := c.api.EnqueueOperation(ctx, actions)
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-	if len(results.Actions) != len(c.unitReceivers) {
-		return nil, errors.New("illegal number of results returned")
-	}
-	return &results, nil
+	return actionParams, nil
 }
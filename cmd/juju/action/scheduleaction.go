@@ -0,0 +1,164 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	actionapi "github.com/juju/juju/api/client/action"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cron"
+)
+
+// NewScheduleActionCommand returns a command to schedule an action.
+func NewScheduleActionCommand() cmd.Command {
+	return modelcmd.Wrap(&scheduleActionCommand{})
+}
+
+// scheduleActionCommand schedules an action to run repeatedly on the given
+// units.
+type scheduleActionCommand struct {
+	ActionCommandBase
+	name          string
+	schedule      string
+	unitReceivers []string
+	actionName    string
+	paramsYAML    cmd.FileVar
+	parseStrings  bool
+	args          [][]string
+}
+
+const scheduleActionDoc = `
+Schedule a charm action to run repeatedly on the given unit(s), whenever a
+cron expression falls due. The schedule is stored in the model and run by the
+controller, so no external scheduler is needed.
+
+Each run of a scheduled action starts an operation, just as ` + "`juju run`" + `
+does. The operations started by a scheduled action are shown with the
+schedule's name in ` + "`juju operations`" + `.
+
+The schedule is given with the ` + "`--cron`" + ` option as a standard five field
+cron expression, ` + "`<minute> <hour> <day-of-month> <month> <day-of-week>`" + `,
+evaluated in UTC. The descriptors ` + "`@hourly`" + `, ` + "`@daily`" + `,
+` + "`@weekly`" + `, ` + "`@monthly`" + ` and ` + "`@yearly`" + ` are also accepted.
+
+All units must be of the same application. Units and params are given as for
+` + "`juju run`" + `; if the leader syntax ` + "`<application>/leader`" + ` is
+used, the leader is resolved each time the action runs.
+
+Runs missed while the controller is unavailable are not caught up; the action
+next runs when the schedule next falls due.
+
+The action runs on behalf of the user who scheduled it. Each time the schedule
+falls due, the action only runs if that user still has write access to the
+model, or is a controller superuser; if their access has been revoked or has
+expired, the run is skipped. Access granted to the user's groups counts, as it
+does when running actions directly. For users who log in with an identity
+provider, the groups it reported when the action was scheduled are used.
+`
+
+const scheduleActionExamples = `
+    juju schedule-action nightly-backup mysql/leader backup --cron "0 2 * * *"
+    juju schedule-action hourly-check mysql/0 mysql/1 check --cron @hourly
+    juju schedule-action weekly-backup mysql/leader backup target=s3 --cron "30 3 * * 0"
+    juju schedule-action weekly-backup mysql/leader backup --params p.yml --cron @weekly
+`
+
+// SetFlags implements Command.
+func (c *scheduleActionCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ActionCommandBase.SetFlags(f)
+	f.StringVar(&c.schedule, "cron", "", "Cron expression saying when the action runs")
+	f.Var(&c.paramsYAML, "params", "Path to yaml-formatted params file")
+	f.BoolVar(&c.parseStrings, "string-args", false, "Use raw string values of CLI args")
+}
+
+// Info implements Command.
+func (c *scheduleActionCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "schedule-action",
+		Args:     "<name> <unit> [<unit> ...] <action-name> [<key>=<value> [<key>[.<key> ...]=<value>]]",
+		Purpose:  "Schedule an action to run repeatedly on the specified units.",
+		Doc:      scheduleActionDoc,
+		Examples: scheduleActionExamples,
+		SeeAlso: []string{
+			"run",
+			"scheduled-actions",
+			"remove-scheduled-action",
+			"operations",
+		},
+	})
+}
+
+// Init implements Command.
+func (c *scheduleActionCommand) Init(args []string) (err error) {
+	if len(args) == 0 {
+		return errors.New("no schedule name specified")
+	}
+	c.name, args = args[0], args[1:]
+	if !nameRule.MatchString(c.name) {
+		return errors.Errorf("invalid schedule name %q", c.name)
+	}
+	if c.schedule == "" {
+		return errors.New("no schedule specified, use --cron")
+	}
+	if _, err := cron.Parse(c.schedule); err != nil {
+		return errors.Trace(err)
+	}
+
+	applicationNames := set.NewStrings()
+	for _, arg := range args {
+		if s := validUnitOrLeader.FindStringSubmatch(arg); s != nil {
+			applicationNames.Add(s[1])
+			c.unitReceivers = append(c.unitReceivers, arg)
+		} else if nameRule.MatchString(arg) {
+			c.actionName = arg
+			break
+		} else {
+			return errors.Errorf("invalid unit or action name %q", arg)
+		}
+	}
+	if len(c.unitReceivers) == 0 {
+		return errors.New("no unit specified")
+	}
+	if c.actionName == "" {
+		return errors.New("no action specified")
+	}
+	if len(applicationNames) > 1 {
+		return errors.New("all units must be of the same application")
+	}
+
+	c.args, err = parseActionArgs(args[len(c.unitReceivers)+1:])
+	return errors.Trace(err)
+}
+
+// Run implements Command.
+func (c *scheduleActionCommand) Run(ctx *cmd.Context) error {
+	actionParams, err := actionParameters(ctx, c.paramsYAML, c.args, c.parseStrings)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.NewActionAPIClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	err = api.AddSchedule(ctx, actionapi.ScheduleArgs{
+		Name:       c.name,
+		Schedule:   c.schedule,
+		Receivers:  c.unitReceivers,
+		Action:     c.actionName,
+		Parameters: actionParams,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Scheduled action %q to run %q", c.name, c.schedule)
+	return nil
+}
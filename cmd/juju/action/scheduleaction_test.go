@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/juju/tc"
+
+	actionapi "github.com/juju/juju/api/client/action"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/action"
+)
+
+type ScheduleActionSuite struct {
+	BaseActionSuite
+}
+
+func TestScheduleActionSuite(t *testing.T) {
+	tc.Run(t, &ScheduleActionSuite{})
+}
+
+func (s *ScheduleActionSuite) TestInit(c *tc.C) {
+	tests := []struct {
+		should      string
+		args        []string
+		expectedErr string
+	}{{
+		should:      "fail with no args",
+		expectedErr: "no schedule name specified",
+	}, {
+		should:      "fail with invalid name",
+		args:        []string{"Nightly", "mysql/0", "backup", "--cron", "@daily"},
+		expectedErr: `invalid schedule name "Nightly"`,
+	}, {
+		should:      "fail with no schedule",
+		args:        []string{"nightly", "mysql/0", "backup"},
+		expectedErr: "no schedule specified, use --cron",
+	}, {
+		should:      "fail with invalid schedule",
+		args:        []string{"nightly", "mysql/0", "backup", "--cron", "0 25 * * *"},
+		expectedErr: "cron expression: value 25 out of range 0-23 in hour field",
+	}, {
+		should:      "fail with no unit",
+		args:        []string{"nightly", "backup", "--cron", "@daily"},
+		expectedErr: "no unit specified",
+	}, {
+		should:      "fail with no action",
+		args:        []string{"nightly", "mysql/0", "--cron", "@daily"},
+		expectedErr: "no action specified",
+	}, {
+		should:      "fail with units of different applications",
+		args:        []string{"nightly", "mysql/0", "wordpress/leader", "backup", "--cron", "@daily"},
+		expectedErr: "all units must be of the same application",
+	}, {
+		should:      "fail with invalid argument",
+		args:        []string{"nightly", "mysql/0", "backup", "foo", "--cron", "@daily"},
+		expectedErr: `argument "foo" must be of the form key.key.key...=value`,
+	}, {
+		should: "init properly",
+		args:   []string{"nightly", "mysql/0", "mysql/leader", "backup", "out=s3", "--cron", "0 2 * * *"},
+	}}
+
+	for i, t := range tests {
+		c.Logf("test %d: should %s", i, t.should)
+		args := append([]string{"-m", "admin"}, t.args...)
+		err := cmdtesting.InitCommand(action.NewScheduleActionCommandForTest(s.store), args)
+		if t.expectedErr == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, t.expectedErr)
+		}
+	}
+}
+
+func (s *ScheduleActionSuite) TestRun(c *tc.C) {
+	fakeClient := &fakeAPIClient{}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	ctx, err := cmdtesting.RunCommand(c, action.NewScheduleActionCommandForTest(s.store),
+		"-m", "admin", "nightly", "mysql/leader", "backup", "target=s3", "file.kind=xz", "--cron", "0 2 * * *")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(fakeClient.scheduleArgs, tc.DeepEquals, &actionapi.ScheduleArgs{
+		Name:      "nightly",
+		Schedule:  "0 2 * * *",
+		Receivers: []string{"mysql/leader"},
+		Action:    "backup",
+		Parameters: map[string]any{
+			"target": "s3",
+			"file":   map[string]any{"kind": "xz"},
+		},
+	})
+	c.Check(ctx.Stderr.(*bytes.Buffer).String(), tc.Equals, "Scheduled action \"nightly\" to run \"0 2 * * *\"\n")
+}
+
+func (s *ScheduleActionSuite) TestRunError(c *tc.C) {
+	fakeClient := &fakeAPIClient{apiErr: errors.New("boom")}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	_, err := cmdtesting.RunCommand(c, action.NewScheduleActionCommandForTest(s.store),
+		"-m", "admin", "nightly", "mysql/0", "backup", "--cron", "@daily")
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
@@ -5,6 +5,7 @@ package application
 
 import (
 	"context"
+	"os"
 	"strconv"
 	"strings"
 
@@ -41,6 +42,7 @@ import (
 	"github.com/juju/juju/core/storage"
 	"github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/environs/config"
+	bundlechanges "github.com/juju/juju/internal/bundle/changes"
 	"github.com/juju/juju/internal/charmhub"
 	apiparams "github.com/juju/juju/rpc/params"
 )
@@ -265,6 +267,14 @@ type DeployCommand struct {
 	// deployed but just output the changes.
 	DryRun bool
 
+	// PlanFormat is the machine-readable format, yaml or json, in which a
+	// dry run of a bundle outputs its plan of changes.
+	PlanFormat string
+
+	// PlanFile is the path of a plan of changes, as output by a dry run,
+	// that a bundle deployment must match exactly.
+	PlanFile string
+
 	ApplicationName  string
 	ConfigOptions    common.ConfigFlag
 	ConstraintsStr   common.ConstraintsFlag
@@ -341,6 +351,9 @@ type DeployCommand struct {
 
 	unknownModel bool
 
+	// plan holds the plan parsed from PlanFile.
+	plan *bundlechanges.Plan
+
 	controllerAPIRoot api.Connection
 	apiRoot           api.Connection
 }
@@ -367,6 +380,15 @@ If a revision is specified, a channel must also be specified for Charmhub charms
 and bundles.  The charm will be deployed with revision.  The channel will be used
 when refreshing the application in the future.
 
+A charm stored as an artifact in an OCI registry may be deployed by giving its
+full reference, with a tag or a digest in place of a channel:
+
+    juju deploy oci://registry.example.com/charms/postgresql:14
+    juju deploy oci://registry.example.com/charms/postgresql@sha256:<digest>
+
+Applications deployed by tag are refreshed when the tag moves to a newer
+revision. Credentials for private registries are held by the controller.
+
 A local charm may be deployed by giving the path to its directory:
 
     juju deploy /path/to/charm
@@ -544,6 +566,16 @@ bundle.
 Only top level machines can be mapped in this way, just as only top level
 machines can be defined in the machines section of the bundle.
 
+The ` + "`--dry-run`" + ` option shows the changes a bundle deployment would make
+without making them. Combined with ` + "`--format=yaml`" + ` or ` + "`--format=json`" + `, the
+ordered changes are output as a machine-readable plan, with the ID, arguments
+and requirements of each change. A saved plan can be passed back to deploy
+with the ` + "`--plan`" + ` option, in which case the bundle is only deployed if the
+changes it requires still match the plan exactly:
+
+    juju deploy mybundle --dry-run --format=yaml > plan.yaml
+    juju deploy mybundle --plan plan.yaml
+
 When charms that include LXD profiles are deployed the profiles are validated
 for security purposes by allowing only certain configurations and devices. Use
 the ` + "`--force`" + ` option to bypass this check. Doing so is not recommended as it
@@ -653,6 +685,8 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.Base, "base", "", "The base on which to deploy")
 	f.IntVar(&c.Revision, "revision", -1, "The revision to deploy")
 	f.BoolVar(&c.DryRun, "dry-run", false, "Just show what the deploy would do")
+	f.StringVar(&c.PlanFormat, "format", "", "Output a bundle dry run as a machine-readable plan: yaml|json")
+	f.StringVar(&c.PlanFile, "plan", "", "Only deploy a bundle if its changes match the plan in this file")
 	f.BoolVar(&c.Force, "force", false, "Allow a charm/bundle to be deployed which bypasses checks such as supported base or LXD profile allow list")
 	f.Var(storageFlag{&c.Storage, &c.BundleStorage}, "storage", "Charm storage directives")
 	f.Var(devicesFlag{&c.Devices, &c.BundleDevices}, "device", "Charm device constraints")
@@ -686,6 +720,15 @@ func (c *DeployCommand) Init(args []string) error {
 		return cmd.CheckEmpty(args[2:])
 	}
 
+	switch c.PlanFormat {
+	case "", "yaml", "json":
+	default:
+		return errors.Errorf("invalid value %q for option --format: expected yaml or json", c.PlanFormat)
+	}
+	if c.PlanFormat != "" && !c.DryRun {
+		return errors.New("--format requires --dry-run")
+	}
+
 	useExisting, mapping, err := parseMachineMap(c.machineMap)
 	if err != nil {
 		return errors.Annotate(err, "error in --map-machines")
@@ -807,6 +850,18 @@ func (c *DeployCommand) Run(ctx *cmd.Context) error {
 		return c.NewDownloadClient(ctx)
 	}
 
+	if c.PlanFile != "" {
+		data, err := os.ReadFile(ctx.AbsPath(c.PlanFile))
+		if err != nil {
+			return errors.Annotate(err, "cannot read plan")
+		}
+		plan, err := bundlechanges.ParsePlan(data)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.plan = &plan
+	}
+
 	charmAPIClient := c.NewCharmsAPI(c.apiRoot)
 	charmAdaptor := c.NewResolver(charmAPIClient, downloadClientFn)
 
@@ -868,6 +923,8 @@ func (c *DeployCommand) getDeployerFactory(base corebase.Base, defaultCharmSchem
 		ModelConstraints:   c.ModelConstraints,
 		Devices:            c.Devices,
 		DryRun:             c.DryRun,
+		Plan:               c.plan,
+		PlanFormat:         c.PlanFormat,
 		FlagSet:            c.flagSet,
 		Force:              c.Force,
 		NumUnits:           c.NumUnits,
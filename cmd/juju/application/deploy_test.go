@@ -125,6 +125,12 @@ var initErrorTests = []struct {
 	}, {
 		args: []string{"bundle", "--map-machines", "foo"},
 		err:  `error in --map-machines: expected "existing" or "<bundle-id>=<machine-id>", got "foo"`,
+	}, {
+		args: []string{"bundle", "--dry-run", "--format", "xml"},
+		err:  `invalid value "xml" for option --format: expected yaml or json`,
+	}, {
+		args: []string{"bundle", "--format", "yaml"},
+		err:  `--format requires --dry-run`,
 	},
 }
 
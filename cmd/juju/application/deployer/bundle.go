@@ -19,14 +19,17 @@ import (
 	"github.com/juju/juju/core/devices"
 	"github.com/juju/juju/core/storage"
 	"github.com/juju/juju/domain/deployment/charm"
+	bundlechanges "github.com/juju/juju/internal/bundle/changes"
 )
 
 type deployBundle struct {
 	model ModelCommand
 
-	dryRun bool
-	force  bool
-	trust  bool
+	dryRun     bool
+	plan       *bundlechanges.Plan
+	planFormat string
+	force      bool
+	trust      bool
 
 	bundleDataSource  charm.BundleDataSource
 	bundleDir         string
@@ -223,6 +226,8 @@ func (d *deployBundle) makeBundleDeploySpec(ctx *cmd.Context, apiRoot DeployerAP
 		filesystem:           d.model.Filesystem(),
 		modelType:            modelType,
 		dryRun:               d.dryRun,
+		plan:                 d.plan,
+		planFormat:           d.planFormat,
 		force:                d.force,
 		trust:                d.trust,
 		bundleDataSource:     d.bundleDataSource,
@@ -49,10 +49,12 @@ type bundleDeploySpec struct {
 	ctx        *cmd.Context
 	filesystem modelcmd.Filesystem
 
-	modelType model.ModelType
-	dryRun    bool
-	force     bool
-	trust     bool
+	modelType  model.ModelType
+	dryRun     bool
+	plan       *bundlechanges.Plan
+	planFormat string
+	force      bool
+	trust      bool
 
 	bundleDataSource  charm.BundleDataSource
 	bundleDir         string
@@ -98,6 +100,9 @@ func bundleDeploy(ctx context.Context, defaultCharmSchema charm.Schema, bundleDa
 	if err := h.getChanges(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if err := h.verifyPlan(); err != nil {
+		return errors.Trace(err)
+	}
 	if err := h.handleChanges(ctx); err != nil {
 		return errors.Trace(err)
 	}
@@ -111,6 +116,14 @@ type bundleHandler struct {
 	trust     bool
 	modelType model.ModelType
 
+	// plan, when set, holds the reviewed plan that the changes must match
+	// before any of them are applied.
+	plan *bundlechanges.Plan
+	// planFormat is the format in which a dry run outputs the changes as
+	// a machine-readable plan. When empty, the changes are described in
+	// human readable form.
+	planFormat string
+
 	clock jujuclock.Clock
 
 	// bundleDir is the path where the bundle file is located for local bundles.
@@ -214,6 +227,8 @@ func makeBundleHandler(defaultCharmSchema charm.Schema, bundleData *charm.Bundle
 
 		modelType:            spec.modelType,
 		dryRun:               spec.dryRun,
+		plan:                 spec.plan,
+		planFormat:           spec.planFormat,
 		force:                spec.force,
 		trust:                spec.trust,
 		bundleDir:            spec.bundleDir,
@@ -495,7 +510,38 @@ func (h *bundleHandler) getChanges(ctx context.Context) error {
 	return nil
 }
 
+// verifyPlan checks that the changes required to deploy the bundle are
+// exactly those of the plan being applied, if any.
+func (h *bundleHandler) verifyPlan() error {
+	if h.plan == nil {
+		return nil
+	}
+	if err := h.plan.Verify(h.changes); err != nil {
+		return errors.Annotate(err, "bundle changes do not match the plan, the bundle or the model has changed since the plan was made")
+	}
+	return nil
+}
+
+// writePlan outputs the changes as a machine-readable plan.
+func (h *bundleHandler) writePlan() error {
+	plan, err := bundlechanges.NewPlan(h.changes)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	switch h.planFormat {
+	case "yaml":
+		return cmd.FormatYaml(h.ctx.Stdout, plan)
+	case "json":
+		return cmd.FormatJson(h.ctx.Stdout, plan)
+	default:
+		return errors.NotValidf("plan format %q", h.planFormat)
+	}
+}
+
 func (h *bundleHandler) handleChanges(ctx context.Context) error {
+	if h.dryRun && h.planFormat != "" {
+		return h.writePlan()
+	}
 	if len(h.changes) == 0 {
 		h.ctx.Infof("No changes to apply.")
 		return nil
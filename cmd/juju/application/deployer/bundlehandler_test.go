@@ -808,6 +808,102 @@ func (s *BundleDeployRepositorySuite) testExistingModel(c *tc.C, dryRun bool) {
 	c.Check(s.output.String(), tc.Equals, expectedOutput+changeOutput)
 }
 
+func (s *BundleDeployRepositorySuite) TestDryRunPlanJSON(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	plan := s.dryRunPlan(c, "json", wordpressBundle)
+	s.assertWordpressPlan(c, plan)
+	c.Check(s.deployArgs, tc.HasLen, 0)
+}
+
+func (s *BundleDeployRepositorySuite) TestDryRunPlanYAML(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	plan := s.dryRunPlan(c, "yaml", wordpressBundle)
+	s.assertWordpressPlan(c, plan)
+	c.Check(s.deployArgs, tc.HasLen, 0)
+}
+
+func (s *BundleDeployRepositorySuite) TestDeployWithPlan(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	plan := s.dryRunPlan(c, "yaml", wordpressBundle)
+
+	s.expectEmptyModelToStart(c)
+	mysqlCurl := charm.MustParseURL("ch:mysql")
+	wordpressCurl := charm.MustParseURL("ch:wordpress")
+	s.setupCharmUnits([]charmUnit{
+		{
+			curl:                 mysqlCurl,
+			machine:              "0",
+			machineUbuntuVersion: "20.04",
+		},
+		{
+			curl:                 wordpressCurl,
+			machine:              "1",
+			machineUbuntuVersion: "20.04",
+		},
+	})
+	s.expectAddRelation([]string{"wordpress:db", "mysql:db"})
+
+	spec := s.bundleDeploySpec(c)
+	spec.plan = &plan
+	s.runDeployWithSpec(c, wordpressBundle, spec)
+
+	c.Assert(s.deployArgs, tc.HasLen, 2)
+	s.assertDeployArgs(c, wordpressCurl.String(), "wordpress", "ubuntu", "20.04")
+	s.assertDeployArgs(c, mysqlCurl.String(), "mysql", "ubuntu", "20.04")
+}
+
+func (s *BundleDeployRepositorySuite) TestDeployWithPlanMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	plan := s.dryRunPlan(c, "json", wordpressBundle)
+
+	s.expectEmptyModelToStart(c)
+
+	bundleData, err := charm.ReadBundleData(strings.NewReader(strings.Replace(wordpressBundle, "foo: bar", "foo: baz", 1)))
+	c.Assert(err, tc.ErrorIsNil)
+	spec := s.bundleDeploySpec(c)
+	spec.plan = &plan
+	err = bundleDeploy(c.Context(), charm.CharmHub, bundleData, spec)
+	c.Assert(err, tc.ErrorMatches, `bundle changes do not match the plan, the bundle or the model has changed since the plan was made: arguments of change "deploy-1" differ from the plan`)
+	c.Check(s.deployArgs, tc.HasLen, 0)
+}
+
+// dryRunPlan runs a dry run of the bundle deployment against an empty model
+// and returns the plan that it outputs in the given format.
+func (s *BundleDeployRepositorySuite) dryRunPlan(c *tc.C, format, bundle string) bundlechanges.Plan {
+	s.expectEmptyModelToStart(c)
+	s.expectResolveCharm(nil)
+
+	stdout := &bytes.Buffer{}
+	spec := s.bundleDeploySpec(c)
+	spec.ctx.Stdout = stdout
+	spec.dryRun = true
+	spec.planFormat = format
+	s.runDeployWithSpec(c, bundle, spec)
+
+	plan, err := bundlechanges.ParsePlan(stdout.Bytes())
+	c.Assert(err, tc.ErrorIsNil)
+	return plan
+}
+
+func (s *BundleDeployRepositorySuite) assertWordpressPlan(c *tc.C, plan bundlechanges.Plan) {
+	ids := make([]string, len(plan.Changes))
+	for i, change := range plan.Changes {
+		ids[i] = change.Id
+	}
+	c.Check(ids, tc.DeepEquals, []string{
+		"addCharm-0", "deploy-1", "addCharm-2", "deploy-3",
+		"addMachines-4", "addMachines-5", "addRelation-6",
+		"addUnit-7", "addUnit-8",
+	})
+	c.Check(plan.Changes[1].Method, tc.Equals, "deploy")
+	c.Check(plan.Changes[1].Args["application"], tc.Equals, "mysql")
+	c.Check(plan.Changes[1].Args["options"], tc.DeepEquals, map[string]any{"foo": "bar"})
+	c.Check(plan.Changes[1].Requires, tc.DeepEquals, []string{"addCharm-0"})
+	c.Check(plan.Changes[6].Requires, tc.SameContents, []string{"deploy-1", "deploy-3"})
+}
+
 const charmWithResourcesBundle = `
 applications:
     django:
@@ -219,7 +219,7 @@ or use remove-application to remove the existing one and try again.`,
 var (
 	// BundleOnlyFlags represents what flags are used for bundles only.
 	BundleOnlyFlags = []string{
-		"overlay", "map-machines",
+		"overlay", "map-machines", "format", "plan",
 	}
 )
 
@@ -338,6 +338,7 @@ func (l *localCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerAPI, _
 type repositoryCharm struct {
 	deployCharm
 	userRequestedURL               *charm.URL
+	ociReference                   string
 	clock                          jujuclock.Clock
 	uploadExistingPendingResources UploadExistingPendingResourcesFunc
 }
@@ -393,6 +394,11 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 	}
 
 	charmName := c.userRequestedURL.Name
+	if c.ociReference != "" {
+		// The controller needs the full reference to locate the charm in
+		// the registry.
+		charmName = c.ociReference
+	}
 	info, localPendingResources, errs := deployAPI.DeployFromRepository(ctx, application.DeployFromRepositoryArg{
 		CharmName:        charmName,
 		ApplicationName:  c.applicationName,
@@ -434,17 +440,20 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 			info.Name, uploadErr)
 	}
 
-	ctx.Infof("%s", formatDeployedText(c.dryRun, charmName, info))
+	ctx.Infof("%s", formatDeployedText(c.dryRun, c.id.Origin.Source, charmName, info))
 	return nil
 }
 
-func formatDeployedText(dryRun bool, charmName string, info application.DeployInfo) string {
+func formatDeployedText(dryRun bool, source commoncharm.OriginSource, charmName string, info application.DeployInfo) string {
+	if source != commoncharm.OriginOCI {
+		source = commoncharm.OriginCharmHub
+	}
 	if dryRun {
-		return fmt.Sprintf("%q from charm-hub charm %q, revision %d in channel %s on %s would be deployed",
-			info.Name, charmName, info.Revision, info.Channel, info.Base.String())
+		return fmt.Sprintf("%q from %s charm %q, revision %d in channel %s on %s would be deployed",
+			info.Name, source, charmName, info.Revision, info.Channel, info.Base.String())
 	}
-	return fmt.Sprintf("Deployed %q from charm-hub charm %q, revision %d in channel %s on %s",
-		info.Name, charmName, info.Revision, info.Channel, info.Base.String())
+	return fmt.Sprintf("Deployed %q from %s charm %q, revision %d in channel %s on %s",
+		info.Name, source, charmName, info.Revision, info.Channel, info.Base.String())
 }
 
 func isEmptyOrigin(origin commoncharm.Origin, source commoncharm.OriginSource) bool {
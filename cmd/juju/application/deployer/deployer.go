@@ -33,7 +33,9 @@ import (
 	"github.com/juju/juju/domain/deployment/charm"
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/environs/config"
+	bundlechanges "github.com/juju/juju/internal/bundle/changes"
 	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/ocicharm"
 )
 
 var logger = internallogger.GetLogger("juju.cmd.juju.application.deployer")
@@ -76,6 +78,9 @@ type repositoryBundleDeployerKind struct {
 type repositoryCharmDeployerKind struct {
 	deployCharm deployCharm
 	charmURL    *charm.URL
+	// ociReference is the full "oci://" reference of a charm stored in an
+	// OCI registry, empty for charms from Charmhub.
+	ociReference string
 }
 
 // NewDeployerFactory returns a factory setup with the API and
@@ -133,6 +138,12 @@ func (d *factory) GetDeployer(ctx context.Context, cfg DeployerConfig, deployAPI
 		if dk, localPreDeployedCharmErr = d.localPreDeployedCharmDeployer(ctx, deployAPI); localPreDeployedCharmErr != nil {
 			return nil, errors.Trace(localPreDeployedCharmErr)
 		}
+	} else if isOCIReference(d.charmOrBundle) {
+		// Go for a charm stored in an OCI registry
+		var ociCharmErr error
+		if dk, ociCharmErr = d.ociCharmDeployer(); ociCharmErr != nil {
+			return nil, errors.Trace(ociCharmErr)
+		}
 	} else {
 		// Repository charm or bundle
 		userCharmURL, resolveCharmErr := resolveCharmURL(d.charmOrBundle, d.defaultCharmSchema)
@@ -184,7 +195,38 @@ func (d *factory) repoCharmDeployer(userCharmURL *charm.URL, origin commoncharm.
 	deployCharm.id = application.CharmID{
 		Origin: origin,
 	}
-	return &repositoryCharmDeployerKind{deployCharm, userCharmURL}, nil
+	return &repositoryCharmDeployerKind{deployCharm: deployCharm, charmURL: userCharmURL}, nil
+}
+
+// ociCharmDeployer returns a deployer for a charm stored in an OCI registry.
+// The tag or digest of the reference takes the place of the channel.
+func (d *factory) ociCharmDeployer() (DeployerKind, error) {
+	if !d.channel.Empty() {
+		return nil, errors.Errorf("--channel is not supported for OCI charms, use a tag in the reference instead")
+	}
+	if _, err := ocicharm.ParseReference(d.charmOrBundle); err != nil {
+		return nil, errors.Trace(err)
+	}
+	userCharmURL, err := charm.ParseURL(d.charmOrBundle)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	platform := utils.MakePlatform(d.constraints, d.base, d.modelConstraints)
+	origin, err := utils.MakeOrigin(charm.OCI, d.revision, charm.Channel{}, platform)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	deployCharm := d.newDeployCharm()
+	deployCharm.id = application.CharmID{
+		Origin: origin,
+	}
+	return &repositoryCharmDeployerKind{
+		deployCharm:  deployCharm,
+		charmURL:     userCharmURL,
+		ociReference: d.charmOrBundle,
+	}, nil
 }
 
 func (d *factory) repoBundleDeployer(ctx context.Context, userCharmURL *charm.URL, origin commoncharm.Origin, resolver Resolver, charmHubSchemaCheck bool) (DeployerKind, error) {
@@ -365,6 +407,8 @@ func (d *factory) setConfig(cfg DeployerConfig) {
 	d.base = cfg.Base
 	d.force = cfg.Force
 	d.dryRun = cfg.DryRun
+	d.plan = cfg.Plan
+	d.planFormat = cfg.PlanFormat
 	d.applicationName = cfg.ApplicationName
 	d.configOptions = cfg.ConfigOptions
 	d.constraints = cfg.Constraints
@@ -416,6 +460,8 @@ type DeployerConfig struct {
 	Devices              map[string]devices.Constraints
 	DeployResources      DeployResourcesFunc
 	DryRun               bool
+	Plan                 *bundlechanges.Plan
+	PlanFormat           string
 	FlagSet              *gnuflag.FlagSet
 	Force                bool
 	NewConsumeDetailsAPI func(url *crossmodel.OfferURL) (ConsumeDetails, error)
@@ -451,6 +497,8 @@ type factory struct {
 	base               corebase.Base
 	force              bool
 	dryRun             bool
+	plan               *bundlechanges.Plan
+	planFormat         string
 	applicationName    string
 	configOptions      common.ConfigFlag
 	constraints        constraints.Value
@@ -527,6 +575,8 @@ func (d *factory) newDeployBundle(_ charm.Schema, ds charm.BundleDataSource) dep
 	return deployBundle{
 		model:                d.model,
 		dryRun:               d.dryRun,
+		plan:                 d.plan,
+		planFormat:           d.planFormat,
 		force:                d.force,
 		trust:                d.trust,
 		bundleDataSource:     ds,
@@ -575,6 +625,7 @@ func (dk *repositoryCharmDeployerKind) CreateDeployer(_ context.Context, d facto
 	return &repositoryCharm{
 		deployCharm:                    dk.deployCharm,
 		userRequestedURL:               dk.charmURL,
+		ociReference:                   dk.ociReference,
 		clock:                          d.clock,
 		uploadExistingPendingResources: UploadExistingPendingResources,
 	}, nil
@@ -615,6 +666,10 @@ func resolveCharmURL(path string, defaultSchema charm.Schema) (*charm.URL, error
 	return charm.ParseURL(path)
 }
 
+func isOCIReference(u string) bool {
+	return strings.HasPrefix(u, ocicharm.Scheme+"://")
+}
+
 func isLocalSchema(u string) bool {
 	raw, err := url.Parse(u)
 	if err != nil {
@@ -196,6 +196,38 @@ func (s *deployerSuite) testGetDeployerRepositoryCharmWithRevision(c *tc.C, curl
 	return factory.GetDeployer(c.Context(), cfg, s.charmDeployAPI, s.resolver)
 }
 
+func (s *deployerSuite) TestGetDeployerOCICharm(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	ref := "oci://registry.example.com/charms/test-charm:stable"
+
+	cfg := s.basicDeployerConfig()
+	s.expectStat(ref, errors.NotFoundf("file"))
+	cfg.CharmOrBundle = ref
+
+	factory := s.newDeployerFactory()
+	deployer, err := factory.GetDeployer(c.Context(), cfg, s.charmDeployAPI, s.resolver)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(deployer.String(), tc.Equals, "deploy charm: oci:test-charm")
+
+	repoCharm, ok := deployer.(*repositoryCharm)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(repoCharm.ociReference, tc.Equals, ref)
+	c.Check(repoCharm.id.Origin.Source, tc.Equals, commoncharm.OriginOCI)
+}
+
+func (s *deployerSuite) TestGetDeployerOCICharmWithChannel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	ref := "oci://registry.example.com/charms/test-charm:stable"
+
+	cfg := s.channelDeployerConfig()
+	s.expectStat(ref, errors.NotFoundf("file"))
+	cfg.CharmOrBundle = ref
+
+	factory := s.newDeployerFactory()
+	_, err := factory.GetDeployer(c.Context(), cfg, s.charmDeployAPI, s.resolver)
+	c.Assert(err, tc.ErrorMatches, "--channel is not supported for OCI charms, use a tag in the reference instead")
+}
+
 func (s *deployerSuite) TestBaseOverride(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	s.expectModelType()
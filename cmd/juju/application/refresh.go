@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/juju/clock"
@@ -103,12 +104,14 @@ type CharmRefreshClient interface {
 	GetCharmURLOrigin(context.Context, string) (*charm.URL, commoncharm.Origin, error)
 	Get(context.Context, string) (*params.ApplicationGetResults, error)
 	SetCharm(context.Context, application.SetCharmConfig) error
+	ContinueRefresh(context.Context, string) error
 }
 
 // NewCharmAdderFunc is the type of a function used to construct
-// a new CharmAdder.
+// a new CharmAdder for refreshing the named application.
 type NewCharmAdderFunc func(
 	api.Connection,
+	string,
 ) (store.CharmAdder, error)
 
 // NewCharmResolverFunc returns a client implementing CharmResolver.
@@ -172,8 +175,24 @@ type refreshCommand struct {
 	// That is, hooks run by the charm can access cloud credentials and other
 	// trusted access credentials.
 	Trust *bool
+
+	// BatchSize is the number or percentage of units to refresh at a time,
+	// e.g. "2" or "25%". If empty, all units are refreshed at once.
+	BatchSize    string
+	BatchTimeout time.Duration
+	// RollbackOnError reverts the application to its previous charm if a
+	// batch of a staged refresh fails, rather than pausing the refresh.
+	RollbackOnError bool
+	// Continue resumes a paused staged refresh.
+	Continue bool
+
+	rollout *application.RefreshRollout
 }
 
+// defaultBatchTimeout is how long each batch of a staged refresh has to
+// become active, if not set with --batch-timeout.
+const defaultBatchTimeout = 10 * time.Minute
+
 const refreshDoc = `
 When no options are set, the application's charm will be refreshed to the latest revision
 in its current channel. An explicit revision can be chosen with the --revision option.
@@ -253,7 +272,8 @@ cause unexpected behavior.
 
 The ` + "`--switch`" + ` option allows you to replace the charm with an entirely different one.
 The new charm's URL and revision are inferred as they would be when running a
-deploy command.
+deploy command. For a charm from an OCI registry, use ` + "`--switch`" + ` with a full
+` + "`oci://`" + ` reference to follow a different tag or pin a digest.
 
 Please note that ` + "`--switch`" + ` is dangerous, because juju only has limited
 information with which to determine compatibility; the operation will succeed,
@@ -290,6 +310,24 @@ behavior.
 On machines, charm upgrades happen at the same time on all units of an application.
 However, on Kubernetes, because Juju deploys applications as ` + "`StatefulSets`" + `
 with rolling updates, charm upgrades happen sequentially, unit by unit.
+
+### Staged refresh
+
+The ` + "`--batch-size`" + ` option refreshes the units of an application a batch at a
+time instead of all at once. The batch size is either a number of units, or a
+percentage of the application's units, such as ` + "`25%`" + `. Each batch must have
+its workload status return to active within ` + "`--batch-timeout`" + ` (10 minutes by
+default) before the next batch is refreshed. Units added during the refresh run
+the new charm straight away.
+
+If a refreshed unit goes into error, or a batch does not become active in time,
+the refresh is paused. The progress of the refresh is shown by ` + "`juju status`" + `.
+Once the problem has been dealt with, resume the refresh with:
+
+    juju refresh foo --continue
+
+With ` + "`--rollback-on-error`" + `, a failed refresh instead reverts the application to
+the charm revision it was running before the refresh started.
 `
 
 const refreshExamples = `
@@ -310,6 +348,19 @@ To pin a resource to a specific Charmhub revision:
 	juju refresh foo --resource bar=42
 
 Where ` + "`bar`" + ` and ` + "`baz`" + ` are resources named in the metadata for the ` + "`foo`" + ` charm.
+
+To refresh application ` + "`foo`" + ` two units at a time, reverting the refresh if
+a unit goes into error:
+
+	juju refresh foo --batch-size 2 --rollback-on-error
+
+To refresh a quarter of the units of application ` + "`foo`" + ` at a time:
+
+	juju refresh foo --batch-size 25% --batch-timeout 30m
+
+To resume a paused staged refresh of application ` + "`foo`" + `:
+
+	juju refresh foo --continue
 `
 
 const upgradedApplicationHasUnitsMessage = `
@@ -348,6 +399,10 @@ func (c *refreshCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(&c.ConfigOptions, "config", "Either a path to yaml-formatted application config file or a key=value pair ")
 	f.StringVar(&c.BindToSpaces, "bind", "", "Configure application endpoint bindings to spaces")
 	f.Var(newOptBoolValue(&c.Trust), "trust", "Allows charm to run hooks that require access credentials")
+	f.StringVar(&c.BatchSize, "batch-size", "", "Refresh units in batches of this many units, or this percentage of units (e.g. 25%)")
+	f.DurationVar(&c.BatchTimeout, "batch-timeout", defaultBatchTimeout, "How long each batch of a staged refresh has to become active")
+	f.BoolVar(&c.RollbackOnError, "rollback-on-error", false, "Revert to the previous charm if a staged refresh fails, rather than pausing it")
+	f.BoolVar(&c.Continue, "continue", false, "Resume a paused staged refresh")
 }
 
 type optBoolValue struct {
@@ -402,6 +457,57 @@ func (c *refreshCommand) Init(args []string) error {
 	if c.SwitchURL != "" && c.CharmPath != "" {
 		return errors.Errorf("--switch and --path are mutually exclusive")
 	}
+	if c.Continue {
+		return c.validateContinue()
+	}
+	return c.parseRollout()
+}
+
+// validateContinue checks that no other refresh options are combined with
+// --continue, which only resumes a paused staged refresh.
+func (c *refreshCommand) validateContinue() error {
+	if c.SwitchURL != "" || c.CharmPath != "" || c.Revision != -1 || c.channelStr != "" ||
+		c.Base != "" || c.BatchSize != "" || c.BatchTimeout != defaultBatchTimeout || c.RollbackOnError ||
+		c.Force || c.ForceBase || c.ForceUnits || c.BindToSpaces != "" || c.Trust != nil ||
+		len(c.Resources) > 0 || len(c.Storage) > 0 || c.ConfigOptions.String() != "" {
+		return errors.Errorf("--continue cannot be combined with other refresh options")
+	}
+	return nil
+}
+
+// parseRollout parses the staged refresh options, if any.
+func (c *refreshCommand) parseRollout() error {
+	if c.BatchSize == "" {
+		if c.BatchTimeout != defaultBatchTimeout || c.RollbackOnError {
+			return errors.Errorf("--batch-timeout and --rollback-on-error require --batch-size")
+		}
+		return nil
+	}
+	if c.ForceUnits {
+		return errors.Errorf("--batch-size and --force-units are mutually exclusive")
+	}
+
+	rollout := &application.RefreshRollout{
+		BatchTimeout:    c.BatchTimeout,
+		RollbackOnError: c.RollbackOnError,
+	}
+	if percent, ok := strings.CutSuffix(c.BatchSize, "%"); ok {
+		n, err := strconv.Atoi(percent)
+		if err != nil || n < 1 || n > 100 {
+			return errors.Errorf("invalid --batch-size %q: percentage must be between 1%% and 100%%", c.BatchSize)
+		}
+		rollout.BatchPercent = n
+	} else {
+		n, err := strconv.Atoi(c.BatchSize)
+		if err != nil || n < 1 {
+			return errors.Errorf("invalid --batch-size %q: must be a positive number of units or a percentage", c.BatchSize)
+		}
+		rollout.BatchSize = n
+	}
+	if rollout.BatchTimeout <= 0 {
+		return errors.Errorf("--batch-timeout must be positive")
+	}
+	c.rollout = rollout
 	return nil
 }
 
@@ -416,6 +522,14 @@ func (c *refreshCommand) Run(ctx *cmd.Context) error {
 
 	charmRefreshClient := c.NewCharmRefreshClient(apiRoot)
 
+	if c.Continue {
+		if err := charmRefreshClient.ContinueRefresh(ctx, c.ApplicationName); err != nil {
+			return block.ProcessBlockedError(err, block.BlockChange)
+		}
+		ctx.Infof("Continuing refresh of application %q", c.ApplicationName)
+		return nil
+	}
+
 	// There is a timing window where deploy has been called and the charm
 	// is not yet downloaded. Check here to verify the origin has an ID,
 	// otherwise refresh result may be in accurate.
@@ -612,6 +726,7 @@ func (c *refreshCommand) Run(ctx *cmd.Context) error {
 		ResourceIDs:        resourceIDs,
 		StorageDirectives:  c.Storage,
 		EndpointBindings:   c.Bindings,
+		Rollout:            c.rollout,
 	}
 
 	err = charmRefreshClient.SetCharm(ctx, charmCfg)
@@ -724,6 +839,7 @@ func (c *refreshCommand) upgradeResources(
 
 func newCharmAdder(
 	conn api.Connection,
+	appName string,
 ) (store.CharmAdder, error) {
 	localCharmsClient, err := apicharms.NewLocalCharmClient(conn)
 	if err != nil {
@@ -734,6 +850,7 @@ func newCharmAdder(
 		charmsClient:      apicharms.NewClient(conn),
 		localCharmsClient: localCharmsClient,
 		modelConfigClient: modelconfig.NewClient(conn),
+		appName:           appName,
 	}, nil
 }
 
@@ -741,7 +858,14 @@ type charmAdderShim struct {
 	*charmsClient
 	*localCharmsClient
 	*modelConfigClient
-	api *apiclient.Client
+	api     *apiclient.Client
+	appName string
+}
+
+// AddCharm adds the charm for refreshing the shim's application, so that
+// users with only a refresh grant on the application can add it.
+func (c *charmAdderShim) AddCharm(ctx context.Context, curl *charm.URL, origin commoncharm.Origin, force bool) (commoncharm.Origin, error) {
+	return c.charmsClient.AddCharmForApplication(ctx, curl, origin, force, c.appName)
 }
 
 func (c *charmAdderShim) AddLocalCharm(ctx context.Context, curl *charm.URL, ch charm.Charm, force bool) (*charm.URL, error) {
@@ -780,7 +904,7 @@ func (c *refreshCommand) getRefresherFactory(ctx context.Context, apiRoot api.Co
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	charmAdder, err := c.NewCharmAdder(apiRoot)
+	charmAdder, err := c.NewCharmAdder(apiRoot, c.ApplicationName)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -214,8 +215,8 @@ func (s *BaseRefreshSuite) refreshCommand() cmd.Command {
 			s.AddCall("NewCharmResolver")
 			return &s.resolveCharm
 		},
-		func(conn api.Connection) (store.CharmAdder, error) {
-			s.AddCall("NewCharmAdder", conn)
+		func(conn api.Connection, appName string) (store.CharmAdder, error) {
+			s.AddCall("NewCharmAdder", conn, appName)
 			s.PopNoErr()
 			return &s.charmAdder, nil
 		},
@@ -274,6 +275,72 @@ func (s *RefreshSuite) TestStorageDirectives(c *tc.C) {
 	})
 }
 
+func (s *RefreshSuite) TestBatchSize(c *tc.C) {
+	_, err := s.runRefresh(c, "foo", "--batch-size", "2", "--rollback-on-error")
+	c.Assert(err, tc.ErrorIsNil)
+	s.charmAPIClient.CheckCallNames(c, "GetCharmURLOrigin", "Get", "SetCharm")
+
+	s.charmAPIClient.CheckCall(c, 2, "SetCharm", application.SetCharmConfig{
+		ApplicationName: "foo",
+		CharmID: application.CharmID{
+			URL: s.resolvedCharmURL.String(),
+			Origin: commoncharm.Origin{
+				ID:           "testing",
+				Source:       "charm-hub",
+				Risk:         "stable",
+				Architecture: arch.DefaultArchitecture,
+				Base:         s.testBase,
+			},
+		},
+		ConfigSettings:   map[string]string{},
+		EndpointBindings: map[string]string{},
+		Rollout: &application.RefreshRollout{
+			BatchSize:       2,
+			BatchTimeout:    10 * time.Minute,
+			RollbackOnError: true,
+		},
+	})
+}
+
+func (s *RefreshSuite) TestBatchPercent(c *tc.C) {
+	_, err := s.runRefresh(c, "foo", "--batch-size", "25%", "--batch-timeout", "30m")
+	c.Assert(err, tc.ErrorIsNil)
+	s.charmAPIClient.CheckCallNames(c, "GetCharmURLOrigin", "Get", "SetCharm")
+
+	cfg := s.charmAPIClient.Calls()[2].Args[0].(application.SetCharmConfig)
+	c.Check(cfg.Rollout, tc.DeepEquals, &application.RefreshRollout{
+		BatchPercent: 25,
+		BatchTimeout: 30 * time.Minute,
+	})
+}
+
+func (s *RefreshSuite) TestInvalidBatchSize(c *tc.C) {
+	_, err := s.runRefresh(c, "foo", "--batch-size", "0")
+	c.Check(err, tc.ErrorMatches, `invalid --batch-size "0": must be a positive number of units or a percentage`)
+
+	_, err = s.runRefresh(c, "foo", "--batch-size", "101%")
+	c.Check(err, tc.ErrorMatches, `invalid --batch-size "101%": percentage must be between 1% and 100%`)
+
+	_, err = s.runRefresh(c, "foo", "--batch-size", "2", "--force-units")
+	c.Check(err, tc.ErrorMatches, "--batch-size and --force-units are mutually exclusive")
+
+	_, err = s.runRefresh(c, "foo", "--rollback-on-error")
+	c.Check(err, tc.ErrorMatches, "--batch-timeout and --rollback-on-error require --batch-size")
+}
+
+func (s *RefreshSuite) TestContinue(c *tc.C) {
+	ctx, err := s.runRefresh(c, "foo", "--continue")
+	c.Assert(err, tc.ErrorIsNil)
+	s.charmAPIClient.CheckCallNames(c, "ContinueRefresh")
+	s.charmAPIClient.CheckCall(c, 0, "ContinueRefresh", "foo")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Continuing refresh of application \"foo\"\n")
+}
+
+func (s *RefreshSuite) TestContinueWithOtherOptions(c *tc.C) {
+	_, err := s.runRefresh(c, "foo", "--continue", "--revision", "2")
+	c.Assert(err, tc.ErrorMatches, "--continue cannot be combined with other refresh options")
+}
+
 func (s *RefreshSuite) TestConfigSettings(c *tc.C) {
 	tempdir := c.MkDir()
 	configFile := filepath.Join(tempdir, "config.yaml")
@@ -512,6 +579,11 @@ func (s *RefreshSuite) TestUpgradeWithChannel(c *tc.C) {
 	origin.Architecture = arch.DefaultArchitecture
 	origin.Base = s.testBase
 	s.charmAdder.CheckCall(c, 0, "AddCharm", s.resolvedCharmURL, origin, false)
+	for _, call := range s.Calls() {
+		if call.FuncName == "NewCharmAdder" {
+			c.Check(call.Args[1], tc.Equals, "foo")
+		}
+	}
 	s.charmAPIClient.CheckCallNames(c, "GetCharmURLOrigin", "Get", "SetCharm")
 	s.charmAPIClient.CheckCall(c, 2, "SetCharm", application.SetCharmConfig{
 		ApplicationName: "foo",
@@ -1276,6 +1348,11 @@ func (m *mockCharmRefreshClient) SetCharm(ctx context.Context, cfg application.S
 	return m.NextErr()
 }
 
+func (m *mockCharmRefreshClient) ContinueRefresh(ctx context.Context, appName string) error {
+	m.MethodCall(m, "ContinueRefresh", appName)
+	return m.NextErr()
+}
+
 func (m *mockCharmRefreshClient) Get(ctx context.Context, applicationName string) (*params.ApplicationGetResults, error) {
 	m.MethodCall(m, "Get", applicationName)
 	return &params.ApplicationGetResults{
@@ -19,6 +19,7 @@ import (
 	corebase "github.com/juju/juju/core/base"
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/internal/ocicharm"
 )
 
 // ErrExhausted reveals if a refresher was exhausted in it's task. If so, then
@@ -66,6 +67,7 @@ func NewRefresherFactory(deps RefresherDependencies) RefresherFactory {
 	d.refreshers = []RefresherFn{
 		d.maybeReadLocal(deps.CharmAdder, defaultCharmRepo{}),
 		d.maybeCharmHub(deps.CharmAdder, deps.CharmResolver),
+		d.maybeOCI(deps.CharmAdder, deps.CharmResolver),
 	}
 	return d
 }
@@ -146,6 +148,26 @@ func (d *factory) maybeCharmHub(charmAdder store.CharmAdder, charmResolver Charm
 	}
 }
 
+func (d *factory) maybeOCI(charmAdder store.CharmAdder, charmResolver CharmResolver) func(RefresherConfig) (Refresher, error) {
+	return func(cfg RefresherConfig) (Refresher, error) {
+		return &ociRefresher{
+			baseRefresher: baseRefresher{
+				charmAdder:      charmAdder,
+				charmResolver:   charmResolver,
+				resolveOriginFn: ociOriginResolver(cfg.CharmRef),
+				charmURL:        cfg.CharmURL,
+				charmOrigin:     cfg.CharmOrigin,
+				charmRef:        cfg.CharmRef,
+				channel:         cfg.Channel,
+				switchCharm:     cfg.Switch,
+				force:           cfg.Force,
+				forceBase:       cfg.ForceBase,
+				logger:          cfg.Logger,
+			},
+		}, nil
+	}
+}
+
 type localCharmRefresher struct {
 	charmAdder  store.CharmAdder
 	charmRepo   CharmRepository
@@ -391,3 +413,80 @@ func (r *charmHubRefresher) Refresh(ctx context.Context) (*CharmID, error) {
 func (r *charmHubRefresher) String() string {
 	return fmt.Sprintf("attempting to refresh Charmhub charm %q", r.charmRef)
 }
+
+// ociOriginResolver returns a function that resolves the origin of a charm in
+// an OCI registry. A full "oci://" reference moves the application to the
+// referenced repository and tag or digest, otherwise the tag is taken from the
+// track of the requested channel.
+func ociOriginResolver(charmRef string) ResolveOriginFunc {
+	return func(curl *charm.URL, origin corecharm.Origin, channel charm.Channel) (commoncharm.Origin, error) {
+		origin.Source = corecharm.OCI
+		origin.Hash = ""
+		origin.Revision = nil
+		if curl.Revision != -1 {
+			origin.Revision = &curl.Revision
+		}
+
+		if strings.HasPrefix(charmRef, ocicharm.Scheme+"://") {
+			ref, err := ocicharm.ParseReference(charmRef)
+			if err != nil {
+				return commoncharm.Origin{}, errors.Trace(err)
+			}
+			origin.ID = ref.Locator()
+			origin.Channel = nil
+			if ref.Digest != "" {
+				origin.ID = ref.Locator() + "@" + ref.Digest
+			} else if ref.Tag != "" {
+				origin.Channel = &charm.Channel{Track: ref.Tag, Risk: charm.Stable}
+			}
+			return commoncharm.CoreCharmOrigin(origin)
+		}
+
+		// Applications pinned by digest have no tag to follow.
+		if channel.Track != "" && !strings.Contains(origin.ID, "@") {
+			origin.Channel = &charm.Channel{Track: channel.Track, Risk: charm.Stable}
+		}
+		return commoncharm.CoreCharmOrigin(origin)
+	}
+}
+
+type ociRefresher struct {
+	baseRefresher
+}
+
+// Allowed will attempt to check if the charm is allowed to refresh.
+// Only charms from OCI registries are allowed.
+func (r *ociRefresher) Allowed(ctx context.Context, cfg RefresherConfig) (bool, error) {
+	curl, err := charm.ParseURL(cfg.CharmRef)
+	if err != nil {
+		return false, nil
+	}
+	return charm.OCI.Matches(curl.Schema), nil
+}
+
+// Refresh a given OCI charm.
+func (r *ociRefresher) Refresh(ctx context.Context) (*CharmID, error) {
+	newURL, origin, err := r.ResolveCharm(ctx)
+	if errors.Is(err, ErrAlreadyUpToDate) {
+		return &CharmID{
+			URL:    newURL,
+			Origin: origin.CoreCharmOrigin(),
+		}, err
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	curl, actualOrigin, err := store.AddCharmFromURL(ctx, r.charmAdder, newURL, origin, r.force)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &CharmID{
+		URL:    curl,
+		Origin: actualOrigin.CoreCharmOrigin(),
+	}, nil
+}
+
+func (r *ociRefresher) String() string {
+	return fmt.Sprintf("attempting to refresh OCI charm %q", r.charmRef)
+}
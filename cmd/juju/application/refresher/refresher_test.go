@@ -642,3 +642,107 @@ type fakeLogger struct {
 func (fakeLogger) Infof(_ string, _ ...any)    {}
 func (fakeLogger) Warningf(_ string, _ ...any) {}
 func (fakeLogger) Verbosef(_ string, _ ...any) {}
+
+type ociCharmRefresherSuite struct{}
+
+func TestOCICharmRefresherSuite(t *testing.T) {
+	tc.Run(t, &ociCharmRefresherSuite{})
+}
+
+func (s *ociCharmRefresherSuite) TestAllowed(c *tc.C) {
+	for _, test := range []struct {
+		ref     string
+		allowed bool
+	}{
+		{ref: "oci:meshuggah", allowed: true},
+		{ref: "oci://registry.example.com/bands/meshuggah:stable", allowed: true},
+		{ref: "ch:meshuggah", allowed: false},
+		{ref: "meshuggah", allowed: false},
+	} {
+		c.Logf("ref %q", test.ref)
+
+		refresher := (&factory{}).maybeOCI(nil, nil)
+		cfg := RefresherConfig{CharmRef: test.ref}
+		task, err := refresher(cfg)
+		c.Assert(err, tc.ErrorIsNil)
+
+		allowed, err := task.Allowed(c.Context(), cfg)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(allowed, tc.Equals, test.allowed)
+	}
+}
+
+func (s *ociCharmRefresherSuite) TestRefresh(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ref := "oci:meshuggah"
+	curl := charm.MustParseURL("oci:meshuggah-1")
+	newCurl := charm.MustParseURL("oci:meshuggah-2")
+
+	cfg := refresherConfigWithOrigin(curl, ref, corecharm.MustParsePlatform("amd64/ubuntu/22.04"))
+	cfg.CharmOrigin.Source = corecharm.OCI
+	cfg.CharmOrigin.ID = "registry.example.com/bands/meshuggah"
+	cfg.CharmOrigin.Channel = &charm.Channel{Track: "stable", Risk: charm.Stable}
+	cfg.CharmOrigin.Revision = &curl.Revision
+	cfg.Channel = charm.Channel{Track: "stable", Risk: charm.Stable}
+
+	origin := commoncharm.Origin{
+		Source:       commoncharm.OriginOCI,
+		ID:           "registry.example.com/bands/meshuggah",
+		Track:        new("stable"),
+		Risk:         "stable",
+		Architecture: "amd64",
+		Base:         corebase.MakeDefaultBase("ubuntu", "22.04"),
+	}
+	actualOrigin := origin
+	actualOrigin.Revision = &newCurl.Revision
+
+	charmResolver := NewMockCharmResolver(ctrl)
+	charmResolver.EXPECT().ResolveCharm(gomock.Any(), charm.MustParseURL(ref), origin, false).Return(newCurl, actualOrigin, []corebase.Base{}, nil)
+
+	charmAdder := NewMockCharmAdder(ctrl)
+	charmAdder.EXPECT().AddCharm(gomock.Any(), newCurl, actualOrigin, false).Return(actualOrigin, nil)
+
+	task, err := (&factory{}).maybeOCI(charmAdder, charmResolver)(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+
+	charmID, err := task.Refresh(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(charmID, tc.DeepEquals, &CharmID{
+		URL:    newCurl,
+		Origin: actualOrigin.CoreCharmOrigin(),
+	})
+}
+
+func (s *ociCharmRefresherSuite) TestOCIResolveOriginSwitchReference(c *tc.C) {
+	ref := "oci://registry.example.com/bands/gojira:1.2"
+	origin := corecharm.Origin{
+		Source:   corecharm.OCI,
+		ID:       "registry.example.com/bands/meshuggah",
+		Channel:  &charm.Channel{Track: "stable", Risk: charm.Stable},
+		Revision: new(1),
+		Hash:     "deadbeef",
+	}
+	result, err := ociOriginResolver(ref)(charm.MustParseURL(ref), origin, charm.Channel{})
+	c.Assert(err, tc.ErrorIsNil)
+	coreOrigin, err := commoncharm.CoreCharmOrigin(corecharm.Origin{
+		Source:  corecharm.OCI,
+		ID:      "registry.example.com/bands/gojira",
+		Channel: &charm.Channel{Track: "1.2", Risk: charm.Stable},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, coreOrigin)
+}
+
+func (s *ociCharmRefresherSuite) TestOCIResolveOriginPinnedByDigest(c *tc.C) {
+	origin := corecharm.Origin{
+		Source: corecharm.OCI,
+		ID:     "registry.example.com/bands/meshuggah@sha256:1111",
+	}
+	result, err := ociOriginResolver("oci:meshuggah")(charm.MustParseURL("oci:meshuggah"), origin, charm.Channel{Track: "stable"})
+	c.Assert(err, tc.ErrorIsNil)
+	coreOrigin, err := commoncharm.CoreCharmOrigin(origin)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, coreOrigin)
+}
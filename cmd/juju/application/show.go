@@ -184,6 +184,7 @@ type ApplicationInfo struct {
 	Remote           bool                       `yaml:"remote" json:"remote"`
 	Life             string                     `yaml:"life,omitempty" json:"life,omitempty"`
 	EndpointBindings map[string]string          `yaml:"endpoint-bindings,omitempty" json:"endpoint-bindings,omitempty"`
+	CharmSigner      string                     `yaml:"charm-signer,omitempty" json:"charm-signer,omitempty"`
 }
 
 // ExposedEndpoint defines the serialization behavior of the expose settings
@@ -226,6 +227,7 @@ func createApplicationInfo(details params.ApplicationResult) (names.ApplicationT
 		Remote:           details.Remote,
 		Life:             details.Life,
 		EndpointBindings: details.EndpointBindings,
+		CharmSigner:      details.CharmSigner,
 	}
 	return tag, info, nil
 }
@@ -198,6 +198,37 @@ wordpress:
 	})
 }
 
+func (s *ShowSuite) TestShowCharmSigner(c *tc.C) {
+	s.mockAPI.applicationsInfoFunc = func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
+		app := s.createTestApplicationInfo("wordpress", "")
+		app.CharmSigner = "release"
+		return []params.ApplicationInfoResult{
+			{Result: app},
+		}, nil
+	}
+	s.assertRunShow(c, showTest{
+		args: []string{"wordpress"},
+		stdout: `
+wordpress:
+  charm: charm-wordpress
+  base: ubuntu@12.10
+  channel: development
+  constraints:
+    arch: amd64
+    cores: 1
+    mem: 4096
+    root-disk: 8192
+  principal: true
+  exposed: false
+  remote: false
+  life: alive
+  endpoint-bindings:
+    juju-info: myspace
+  charm-signer: release
+`[1:],
+	})
+}
+
 func (s *ShowSuite) TestShowJSON(c *tc.C) {
 	s.mockAPI.applicationsInfoFunc = func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
 		return []params.ApplicationInfoResult{
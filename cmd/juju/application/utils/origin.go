@@ -41,6 +41,11 @@ func MakeOrigin(schema charm.Schema, revision int, channel charm.Channel, platfo
 			Branch:       branch,
 			Architecture: platform.Architecture,
 		}
+	case charm.OCI:
+		origin = commoncharm.Origin{
+			Source:       commoncharm.OriginOCI,
+			Architecture: platform.Architecture,
+		}
 	default:
 		return commoncharm.Origin{}, errors.NotSupportedf("charm source %q", schema)
 	}
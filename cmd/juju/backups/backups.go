@@ -28,6 +28,16 @@ type APIClient interface {
 	Create(nctx context.Context, otes string, noDownload bool) (*params.BackupsMetadataResult, error)
 	// Download pulls the backup archive file.
 	Download(ctx context.Context, filename string) (io.ReadCloser, error)
+	// List gets the metadata of all the backups stored on the controller.
+	List(ctx context.Context) (*params.BackupsListResult, error)
+	// Info gets the metadata of a backup stored on the controller.
+	Info(ctx context.Context, id string) (*params.BackupsMetadataResult, error)
+	// Remove removes backups stored on the controller.
+	Remove(ctx context.Context, ids ...string) ([]params.ErrorResult, error)
+	// Upload stores a backup archive on the controller.
+	Upload(ctx context.Context, archive io.Reader) (string, error)
+	// Restore restores a backup stored on the controller.
+	Restore(ctx context.Context, id string) (*params.BackupsMetadataResult, error)
 }
 
 // CommandBase is the base type for backups sub-commands.
@@ -12,21 +12,23 @@
 // The controller creates the backup file in a gzipped tar file with the following structure, then streams it to the local user's disk:
 // juju-backup/
 //     metadata.json - the backup metadata for the archive.
-//     root.tar      - the bundle of state-related files (exluding mongo).
-//     dump/         - all the files dumped from the DB (using mongodump).
+//     root.tar      - the bundle of the controller's object store files.
+//     dump/         - an SQL dump of each of the controller's databases,
+//                     named after the database namespace.
 
 // At present we do not include any sort of manifest/index file in the
 // archive.
 
 // For more information, see:
-//   - state/backups/db/dump.go     - how the DB is dumped;
-//   - state/backups/files/files.go - which files are included in root.tar.
+//   - internal/database/dump.go   - how each database is dumped and loaded;
+//   - internal/backups/backups.go - how the archive is built and restored.
 
 // The current backup process doesn't block state changes, meaning the database dump
 // might be slightly outdated by the time all state-related files are gathered,
 // though the risk is minimal.
 
-// In terms of the restore process, please see "[juju-restore tool]".
-// [juju-restore tool]: https://github.com/juju/juju-restore
+// Stored backups can be listed, shown, removed and restored onto the
+// controller with the backups, show-backup, remove-backup and
+// restore-backup commands.
 
 package backups
@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const listDoc = `
+Lists the IDs of the backups stored on the controller.
+
+Use ` + "`--verbose`" + ` to see the metadata of each backup.
+
+Backups are stored on the controller machine that created or received them,
+and are not replicated. On a highly available controller, only the backups
+stored on the controller machine serving the request are listed.
+`
+
+const listExamples = `
+    juju backups
+    juju backups --verbose
+`
+
+// NewListCommand returns a command used to list backups.
+func NewListCommand() cmd.Command {
+	return modelcmd.Wrap(&listCommand{})
+}
+
+// listCommand is the sub-command for listing the stored backups.
+type listCommand struct {
+	CommandBase
+}
+
+// Info implements Command.Info.
+func (c *listCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "backups",
+		Purpose:  "List the backups stored on the controller.",
+		Doc:      listDoc,
+		Aliases:  []string{"list-backups"},
+		Examples: listExamples,
+		SeeAlso: []string{
+			"create-backup",
+			"show-backup",
+			"remove-backup",
+			"restore-backup",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *listCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *listCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	result, err := client.List(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if len(result.List) == 0 {
+		ctx.Infof("No backups to display.")
+		return nil
+	}
+	for _, meta := range result.List {
+		if c.verbose {
+			fmt.Fprintln(ctx.Stdout, c.metadata(&meta))
+		} else {
+			fmt.Fprintln(ctx.Stdout, meta.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+)
+
+type listSuite struct {
+	BaseBackupsSuite
+}
+
+func TestListSuite(t *testing.T) {
+	tc.Run(t, &listSuite{})
+}
+
+func (s *listSuite) TestList(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, backups.NewListCommandForTest(s.store))
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "List")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "backup-id\n")
+}
+
+func (s *listSuite) TestListVerbose(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.createCommandForGlobalOptionTesting(backups.NewListCommandForTest(s.store)), "backups", "--verbose")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "List")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, MetaResultString)
+}
+
+func (s *listSuite) TestListTooManyArgs(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, backups.NewListCommandForTest(s.store), "foo")
+	c.Check(err, tc.ErrorMatches, `unrecognized args: \["foo"\]`)
+}
+
+func (s *listSuite) TestListError(c *tc.C) {
+	s.setFailure("failed!")
+	_, err := cmdtesting.RunCommand(c, backups.NewListCommandForTest(s.store))
+	c.Check(err, tc.ErrorMatches, "failed!")
+}
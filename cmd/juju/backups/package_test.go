@@ -183,6 +183,56 @@ func (c *fakeAPIClient) Download(_ context.Context, id string) (io.ReadCloser, e
 	return c.archive, nil
 }
 
+func (c *fakeAPIClient) List(_ context.Context) (*params.BackupsListResult, error) {
+	c.calls = append(c.calls, "List")
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &params.BackupsListResult{List: []params.BackupsMetadataResult{*c.metaresult}}, nil
+}
+
+func (c *fakeAPIClient) Info(_ context.Context, id string) (*params.BackupsMetadataResult, error) {
+	c.calls = append(c.calls, "Info")
+	c.args = append(c.args, id)
+	c.idArg = id
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.metaresult, nil
+}
+
+func (c *fakeAPIClient) Remove(_ context.Context, ids ...string) ([]params.ErrorResult, error) {
+	c.calls = append(c.calls, "Remove")
+	c.args = append(c.args, ids...)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return make([]params.ErrorResult, len(ids)), nil
+}
+
+func (c *fakeAPIClient) Upload(_ context.Context, archive io.Reader) (string, error) {
+	c.calls = append(c.calls, "Upload")
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		return "", err
+	}
+	c.args = append(c.args, string(data))
+	if c.err != nil {
+		return "", c.err
+	}
+	return c.metaresult.ID, nil
+}
+
+func (c *fakeAPIClient) Restore(_ context.Context, id string) (*params.BackupsMetadataResult, error) {
+	c.calls = append(c.calls, "Restore")
+	c.args = append(c.args, id)
+	c.idArg = id
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.metaresult, nil
+}
+
 func (c *fakeAPIClient) Close() error {
 	return nil
 }
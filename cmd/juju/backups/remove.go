@@ -0,0 +1,90 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const removeDoc = `
+Removes one or more backups stored on the controller.
+`
+
+const removeExamples = `
+    juju remove-backup juju-backup-20261017-100405-1a2b3c4d.tar.gz
+`
+
+// NewRemoveCommand returns a command used to remove backups.
+func NewRemoveCommand() cmd.Command {
+	return modelcmd.Wrap(&removeCommand{})
+}
+
+// removeCommand is the sub-command for removing stored backups.
+type removeCommand struct {
+	CommandBase
+	// IDs are the backup IDs to remove.
+	IDs []string
+}
+
+// Info implements Command.Info.
+func (c *removeCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-backup",
+		Args:     "<backup ID> [<backup ID>...]",
+		Purpose:  "Remove backups stored on the controller.",
+		Doc:      removeDoc,
+		Examples: removeExamples,
+		SeeAlso: []string{
+			"backups",
+			"create-backup",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("missing backup ID")
+	}
+	c.IDs = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *removeCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	results, err := client.Remove(ctx, c.IDs...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var failed bool
+	for i, result := range results {
+		if result.Error != nil {
+			ctx.Errorf("failed to remove backup %q: %v", c.IDs[i], result.Error)
+			failed = true
+			continue
+		}
+		ctx.Infof("Removed backup %q", c.IDs[i])
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
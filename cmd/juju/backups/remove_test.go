@@ -0,0 +1,45 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+)
+
+type removeSuite struct {
+	BaseBackupsSuite
+}
+
+func TestRemoveSuite(t *testing.T) {
+	tc.Run(t, &removeSuite{})
+}
+
+func (s *removeSuite) TestRemove(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, backups.NewRemoveCommandForTest(s.store), "one", "two")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "Remove")
+	client.CheckArgs(c, "one", "two")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, `
+Removed backup "one"
+Removed backup "two"
+`[1:])
+}
+
+func (s *removeSuite) TestRemoveMissingID(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, backups.NewRemoveCommandForTest(s.store))
+	c.Check(err, tc.ErrorMatches, "missing backup ID")
+}
+
+func (s *removeSuite) TestRemoveError(c *tc.C) {
+	s.setFailure("failed!")
+	_, err := cmdtesting.RunCommand(c, backups.NewRemoveCommandForTest(s.store), "one")
+	c.Check(err, tc.ErrorMatches, "failed!")
+}
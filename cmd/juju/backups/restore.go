@@ -0,0 +1,140 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const restoreDoc = `
+Restores a backup onto the controller, replacing the contents of the
+controller and model databases, and of the object store, with those held in
+the backup.
+
+The backup is either one stored on the controller, identified by its ID, or
+a local backup archive given with ` + "`--file`" + `, which is uploaded to the
+controller before it is restored.
+
+A backup can only be restored onto a controller running the same major and
+minor version of Juju as the one that created it, and no older patch
+version. The controller keeps its own identity, certificates, controller
+nodes, leases and schema history, so a backup can be restored onto a freshly
+bootstrapped controller. Model databases that do not yet exist on the
+controller are created.
+
+Once the restore has completed, the controller agents on every controller
+machine must be restarted so that they pick up the restored state.
+`
+
+const restoreExamples = `
+    juju restore-backup juju-backup-20261017-100405-1a2b3c4d.tar.gz
+    juju restore-backup --file ./juju-backup-20261017-100405-1a2b3c4d.tar.gz
+`
+
+// NewRestoreCommand returns a command used to restore backups.
+func NewRestoreCommand() cmd.Command {
+	return modelcmd.Wrap(&restoreCommand{})
+}
+
+// restoreCommand is the sub-command for restoring a backup.
+type restoreCommand struct {
+	CommandBase
+	modelcmd.DestroyConfirmationCommandBase
+
+	// ID is the ID of the backup, stored on the controller, to restore.
+	ID string
+	// Filename is the local backup archive to upload and restore.
+	Filename string
+}
+
+// Info implements Command.Info.
+func (c *restoreCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "restore-backup",
+		Args:     "[<backup ID>]",
+		Purpose:  "Restore a backup onto the controller.",
+		Doc:      restoreDoc,
+		Examples: restoreExamples,
+		SeeAlso: []string{
+			"backups",
+			"create-backup",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *restoreCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	c.DestroyConfirmationCommandBase.SetFlags(f)
+	f.StringVar(&c.Filename, "file", "", "Upload and restore a local backup archive")
+}
+
+// Init implements Command.Init.
+func (c *restoreCommand) Init(args []string) error {
+	if err := c.CommandBase.Init(args); err != nil {
+		return err
+	}
+	id, err := cmd.ZeroOrOneArgs(args)
+	if err != nil {
+		return err
+	}
+	if id == "" && c.Filename == "" {
+		return errors.New("missing backup ID or --file")
+	}
+	if id != "" && c.Filename != "" {
+		return errors.New("cannot specify both a backup ID and --file")
+	}
+	c.ID = id
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *restoreCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if c.NeedsConfirmation() {
+		fmt.Fprintln(ctx.Stderr, "WARNING! This command replaces the state of every model in the controller with that held in the backup.")
+		if err := jujucmd.UserConfirmYes(ctx); err != nil {
+			return errors.Annotate(err, "restore")
+		}
+	}
+
+	id := c.ID
+	if c.Filename != "" {
+		archive, err := c.Filesystem().Open(c.Filename)
+		if err != nil {
+			return errors.Annotatef(err, "opening backup archive %q", c.Filename)
+		}
+		defer func() { _ = archive.Close() }()
+
+		if id, err = client.Upload(ctx, archive); err != nil {
+			return errors.Annotate(err, "uploading backup")
+		}
+		ctx.Infof("Uploaded backup %q", id)
+	}
+
+	result, err := client.Restore(ctx, id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !c.quiet {
+		fmt.Fprintln(ctx.Stdout, c.metadata(result))
+	}
+	ctx.Infof("Restored backup %q. Restart the controller agents on all controller machines to complete the restore.", id)
+	return nil
+}
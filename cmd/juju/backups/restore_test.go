@@ -0,0 +1,101 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+)
+
+type restoreSuite struct {
+	BaseBackupsSuite
+	wrappedCommand cmd.Command
+	command        *backups.RestoreCommand
+}
+
+func TestRestoreSuite(t *testing.T) {
+	tc.Run(t, &restoreSuite{})
+}
+
+func (s *restoreSuite) SetUpTest(c *tc.C) {
+	s.BaseBackupsSuite.SetUpTest(c)
+	s.wrappedCommand, s.command = backups.NewRestoreCommandForTest(s.store)
+}
+
+func (s *restoreSuite) TestArgParsing(c *tc.C) {
+	for i, test := range []struct {
+		args     []string
+		errMatch string
+		id       string
+		filename string
+	}{{
+		args:     []string{},
+		errMatch: "missing backup ID or --file",
+	}, {
+		args: []string{"backup-id"},
+		id:   "backup-id",
+	}, {
+		args:     []string{"--file", "backup.tar.gz"},
+		filename: "backup.tar.gz",
+	}, {
+		args:     []string{"backup-id", "--file", "backup.tar.gz"},
+		errMatch: "cannot specify both a backup ID and --file",
+	}, {
+		args:     []string{"one", "two"},
+		errMatch: `unrecognized args: \["two"\]`,
+	}} {
+		c.Logf("%d: %v", i, test.args)
+		wrapped, command := backups.NewRestoreCommandForTest(s.store)
+		err := cmdtesting.InitCommand(wrapped, test.args)
+		if test.errMatch != "" {
+			c.Check(err, tc.ErrorMatches, test.errMatch)
+			continue
+		}
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(command.ID, tc.Equals, test.id)
+		c.Check(command.Filename, tc.Equals, test.filename)
+	}
+}
+
+func (s *restoreSuite) TestRestore(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand, "backup-id", "--no-prompt")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.Check(c, "backup-id", "", "Restore")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, MetaResultString)
+	c.Check(cmdtesting.Stderr(ctx), tc.Matches, `Restored backup "backup-id". Restart the controller agents .*\n`)
+}
+
+func (s *restoreSuite) TestRestoreFile(c *tc.C) {
+	err := os.WriteFile("backup.tar.gz", []byte(s.data), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand, "--file", "backup.tar.gz", "--no-prompt")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.CheckCalls(c, "Upload", "Restore")
+	client.CheckArgs(c, s.data, "backup-id")
+	c.Check(cmdtesting.Stderr(ctx), tc.Matches, `Uploaded backup "backup-id"\nRestored backup "backup-id".*\n`)
+}
+
+func (s *restoreSuite) TestRestoreNotConfirmed(c *tc.C) {
+	client := s.setSuccess()
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("n\n")
+	err := cmdtesting.InitCommand(s.wrappedCommand, []string{"backup-id"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.wrappedCommand.Run(ctx)
+	c.Check(err, tc.ErrorMatches, "restore: aborted")
+	client.CheckCalls(c)
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/backups"
+)
+
+type showSuite struct {
+	BaseBackupsSuite
+}
+
+func TestShowSuite(t *testing.T) {
+	tc.Run(t, &showSuite{})
+}
+
+func (s *showSuite) TestShow(c *tc.C) {
+	client := s.setSuccess()
+	ctx, err := cmdtesting.RunCommand(c, backups.NewShowCommandForTest(s.store), "backup-id")
+	c.Assert(err, tc.ErrorIsNil)
+
+	client.Check(c, "backup-id", "", "Info")
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, MetaResultString)
+}
+
+func (s *showSuite) TestShowMissingID(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, backups.NewShowCommandForTest(s.store))
+	c.Check(err, tc.ErrorMatches, "missing backup ID")
+}
+
+func (s *showSuite) TestShowError(c *tc.C) {
+	s.setFailure("failed!")
+	_, err := cmdtesting.RunCommand(c, backups.NewShowCommandForTest(s.store), "backup-id")
+	c.Check(err, tc.ErrorMatches, "failed!")
+}
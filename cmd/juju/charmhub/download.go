@@ -26,6 +26,7 @@ import (
 	"github.com/juju/juju/core/version"
 	"github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmhub/seed"
 	"github.com/juju/juju/internal/charmhub/transport"
 )
 
@@ -44,11 +45,19 @@ which cannot be used together with ` + "`--arch`" + `, ` + "`--base`" + ` or ` +
 
 Adding a hyphen as the second argument allows the download to be piped
 to ` + "`stdout`" + `.
+
+To pre-seed the Charmhub mirror of a controller that cannot reach Charmhub,
+use ` + "`--seed`" + `. Rather than the charm itself, a seed tarball holding the
+charm and the details Charmhub returned for it is written. The seed is
+imported on the controller by posting it to the ` + "`/charm-mirror/seed`" + `
+endpoint of the controller's control socket. Resources are not held in
+seeds, so ` + "`--seed`" + ` cannot be used together with ` + "`--resources`" + `.
 `
 
 	downloadExamples = `
     juju download postgresql
     juju download postgresql --no-progress - > postgresql.charm
+    juju download postgresql --channel 14/stable --seed
 `
 )
 
@@ -71,6 +80,7 @@ type downloadCommand struct {
 	pipeToStdout  bool
 	noProgress    bool
 	resources     bool
+	seed          bool
 }
 
 // Info returns help related download about the command, it implements
@@ -102,6 +112,7 @@ func (c *downloadCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.archivePath, "filepath", "", "Specify the filepath location of the charm to download to")
 	f.BoolVar(&c.noProgress, "no-progress", false, "Disable the progress bar")
 	f.BoolVar(&c.resources, "resources", false, "Download the resources associated with the charm (will be DEPRECATED and default behaviour in 4.0)")
+	f.BoolVar(&c.seed, "seed", false, "Write a seed for a controller's Charmhub mirror instead of the charm")
 }
 
 // Init initializes the download command, including validating the provided
@@ -132,6 +143,13 @@ func (c *downloadCommand) Init(args []string) error {
 		return errors.Errorf("cannot pipe to stdout and download resources: do not pass --resources to download to stdout")
 	}
 
+	if c.seed && c.pipeToStdout {
+		return errors.Errorf("cannot pipe a seed to stdout: do not pass --seed to download to stdout")
+	}
+	if c.seed && c.resources {
+		return errors.Errorf("cannot download resources into a seed: do not pass --resources with --seed")
+	}
+
 	curl, err := c.validateCharmOrBundle(args[0])
 	if err != nil {
 		return errors.Trace(err)
@@ -220,6 +238,16 @@ func (c *downloadCommand) Run(cmdContext *cmd.Context) error {
 	if path == "" {
 		// Use the revision number to create a unique path for every download.
 		path = fmt.Sprintf("%s_r%d.%s", entity.Name, entity.Revision, entityType)
+		if c.seed {
+			path = fmt.Sprintf("%s_r%d.seed.tar.gz", entity.Name, entity.Revision)
+		}
+	}
+	// When writing a seed, the archive is downloaded next to the seed and
+	// removed once it has been added to the seed.
+	seedPath := path
+	if c.seed {
+		path = seedPath + ".download"
+		defer func() { _ = c.Filesystem().RemoveAll(path) }()
 	}
 
 	if c.revision == -1 {
@@ -266,6 +294,27 @@ Expected:   %s
 Calculated: %s`, c.charmOrBundle, entitySHA, calculatedHash)
 	}
 
+	if c.seed {
+		var channel string
+		if c.revision == -1 {
+			channel = result.EffectiveChannel
+			if channel == "" {
+				channel = normChannel.String()
+			}
+		}
+		entry := seed.NewEntry(result, channel)
+		if digest != nil && digest.Size > 0 {
+			entry.Size = digest.Size
+		}
+		if err := c.writeSeed(seedPath, path, entry); err != nil {
+			return errors.Trace(err)
+		}
+		cmdContext.Infof(`
+Seed the Charmhub mirror with the %q %s by posting %s
+to the /charm-mirror/seed endpoint of the controller's control socket.`[1:], entity.Name, entityType, seedPath)
+		return nil
+	}
+
 	rscPaths := make(map[string]string)
 	if c.resources {
 		dir := filepath.Dir(path)
@@ -331,6 +380,31 @@ Install the %q %s with:
 	return nil
 }
 
+// writeSeed writes a seed holding the archive at archivePath to seedPath.
+func (c *downloadCommand) writeSeed(seedPath, archivePath string, entry seed.Entry) (err error) {
+	archive, err := c.Filesystem().Open(archivePath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	file, err := c.Filesystem().Create(seedPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errors.Trace(closeErr)
+		}
+	}()
+
+	writer := seed.NewWriter(file)
+	if err := writer.Add(entry, archive); err != nil {
+		return errors.Annotatef(err, "writing seed %q", seedPath)
+	}
+	return errors.Annotatef(writer.Close(), "writing seed %q", seedPath)
+}
+
 func (c *downloadCommand) calculateHashFromDigest(path string, digest *charmhub.Digest) (string, error) {
 	if digest == nil {
 		return c.calculateHash(path)
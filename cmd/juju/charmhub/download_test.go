@@ -5,7 +5,10 @@ package charmhub
 
 import (
 	"context"
+	"io"
 	"net/url"
+	"os"
+	"path/filepath"
 	stdtesting "testing"
 
 	"github.com/canonical/gomock/gomock"
@@ -18,6 +21,7 @@ import (
 	"github.com/juju/juju/cmd/juju/charmhub/mocks"
 	"github.com/juju/juju/core/arch"
 	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmhub/seed"
 	"github.com/juju/juju/internal/charmhub/transport"
 	"github.com/juju/juju/internal/testing"
 )
@@ -306,6 +310,96 @@ Install the "test" charm with:
 `[1:])
 }
 
+func (s *downloadSuite) TestInitSeedWithStdout(c *tc.C) {
+	command := &downloadCommand{
+		charmHubCommand: s.newCharmHubCommand(),
+	}
+	err := cmdtesting.InitCommand(command, []string{"test", "-", "--seed"})
+	c.Assert(err, tc.ErrorMatches, "cannot pipe a seed to stdout.*")
+}
+
+func (s *downloadSuite) TestInitSeedWithResources(c *tc.C) {
+	command := &downloadCommand{
+		charmHubCommand: s.newCharmHubCommand(),
+	}
+	err := cmdtesting.InitCommand(command, []string{"test", "--seed", "--resources"})
+	c.Assert(err, tc.ErrorMatches, "cannot download resources into a seed.*")
+}
+
+func (s *downloadSuite) TestRunWithSeed(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	downloadURL := "http://example.org/"
+	seedPath := filepath.Join(c.MkDir(), "test.seed.tar.gz")
+
+	s.charmHubAPI.EXPECT().Refresh(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, cfg charmhub.RefreshConfig) ([]transport.RefreshResponse, error) {
+		return []transport.RefreshResponse{{
+			InstanceKey:      charmhub.ExtractConfigInstanceKey(cfg),
+			ID:               "test-id",
+			Name:             "test",
+			EffectiveChannel: "latest/stable",
+			Entity: transport.RefreshEntity{
+				Type: transport.CharmType,
+				ID:   "test-id",
+				Name: "test",
+				Download: transport.Download{
+					// The SHA256 hash of "archive".
+					HashSHA256: "0eb3e36bfb24dcd9bb1d1bece1531216b59539a8fde17ee80224af0653c92aa3",
+					Size:       7,
+					URL:        downloadURL,
+				},
+				Revision:     123,
+				MetadataYAML: "name: test\n",
+			},
+		}}, nil
+	})
+	resourceURL, err := url.Parse(downloadURL)
+	c.Assert(err, tc.ErrorIsNil)
+	s.charmHubAPI.EXPECT().Download(gomock.Any(), resourceURL, seedPath+".download", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *url.URL, path string, _ ...charmhub.DownloadOption) (*charmhub.Digest, error) {
+			err := os.WriteFile(path, []byte("archive"), 0644)
+			c.Assert(err, tc.ErrorIsNil)
+			return &charmhub.Digest{
+				SHA256: "0eb3e36bfb24dcd9bb1d1bece1531216b59539a8fde17ee80224af0653c92aa3",
+				Size:   7,
+			}, nil
+		})
+
+	command := &downloadCommand{
+		charmHubCommand: s.newCharmHubCommand(),
+	}
+	err = cmdtesting.InitCommand(command, []string{"test", "--seed", "--filepath", seedPath})
+	c.Assert(err, tc.ErrorIsNil)
+
+	ctx := commandContextForTest(c)
+	err = command.Run(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The downloaded archive is only kept in the seed.
+	_, err = os.Stat(seedPath + ".download")
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+
+	f, err := os.Open(seedPath)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	var entries []seed.Entry
+	err = seed.Read(f, func(entry seed.Entry, archive io.Reader) error {
+		data, err := io.ReadAll(archive)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(string(data), tc.Equals, "archive")
+		entries = append(entries, entry)
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 1)
+	c.Check(entries[0].Name, tc.Equals, "test")
+	c.Check(entries[0].Revision, tc.Equals, 123)
+	c.Check(entries[0].Channel, tc.Equals, "latest/stable")
+	c.Check(entries[0].Size, tc.Equals, int64(7))
+	c.Check(entries[0].MetadataYAML, tc.Equals, "name: test\n")
+}
+
 func (s *downloadSuite) newCharmHubCommand() *charmHubCommand {
 	return &charmHubCommand{
 		arches: arch.AllArches(),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,6 +34,7 @@ import (
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
 	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/logtailer"
 )
 
 // defaultLineCount is the default number of lines to
@@ -65,6 +67,16 @@ with the prefix will match.
 
 The ` + "`--include-labels`" + ` and ` + "`--exclude-labels`" + ` options filter by logging labels.
 
+The ` + "`--label`" + ` option filters by a logging label, such as ` + "`charm`" + `,
+` + "`http-request-id`" + ` or ` + "`trace_id`" + `. It takes the form ` + "`key=value`" + `, ` + "`key!=value`" + `,
+` + "`key=~regexp`" + ` or ` + "`key!~regexp`" + `, where a regular expression must match the whole
+label value. Messages without the label match the negated forms.
+
+The ` + "`--grep`" + ` and ` + "`--exclude-grep`" + ` options filter by regular expressions matched
+against the log message.
+
+Filtering happens on the controller, so only matching messages are sent.
+
 The filtering options combine as follows:
 * All ` + "`--include`" + ` options are logically ORed together.
 * All ` + "`--exclude`" + ` options are logically ORed together.
@@ -72,9 +84,17 @@ The filtering options combine as follows:
 * All ` + "`--exclude-module`" + ` options are logically ORed together.
 * All ` + "`--include-labels`" + ` options are logically ORed together.
 * All ` + "`--exclude-labels`" + ` options are logically ORed together.
+* All ` + "`--label`" + ` options are logically ANDed together.
+* All ` + "`--grep`" + ` options are logically ANDed together.
+* All ` + "`--exclude-grep`" + ` options are logically ORed together.
 * The combined ` + "`--include`" + `, ` + "`--exclude`" + `, ` + "`--include-module`" + `, ` + "`--exclude-module`" + `,
-  ` + "`--include-labels`" + ` and ` + "`--exclude-labels`" + ` selections are logically ANDed to form
-  the complete filter.
+  ` + "`--include-labels`" + `, ` + "`--exclude-labels`" + `, ` + "`--label`" + `, ` + "`--grep`" + ` and ` + "`--exclude-grep`" + `
+  selections are logically ANDed to form the complete filter.
+
+When the controller forwards logs to Loki, the controller queries Loki for the
+matching log messages, and replies with them after a notice holding the LogQL
+query which selects the same log messages in Loki. New log messages are not
+followed. Without a start time, the last 24 hours of logs are queried.
 
 The ` + "`--tail`" + ` option waits for and continuously prints new log lines after displaying the most recent log lines.
 
@@ -98,6 +118,25 @@ Given the above, the following flag combinations are incompatible and cannot be
 * ` + "`--no-tail`" + ` and ` + "`--lines (-n)`" + `
 * ` + "`--limit`" + ` and ` + "`--lines (-n)`" + `
 * ` + "`--replay`" + ` and ` + "`--lines (-n)`" + `
+
+The ` + "`--since`" + ` and ` + "`--until`" + ` options bound the log lines displayed by time. Each
+takes either an RFC3339 timestamp, or a duration such as ` + "`2h`" + ` meaning that long
+ago. Logs which have since been rotated and compressed by the controller are
+included, so days of history can be read:
+* ` + "`--since`" + ` on its own displays the log lines from that time onwards.
+* ` + "`--until`" + ` displays log lines up to that time and then exits. On its own, or
+  with ` + "`--lines`" + ` or ` + "`--limit`" + `, the lines counted are the ones before that time.
+
+The ` + "`--from-offset`" + ` option resumes reading at a given log line. The offset of
+each line is shown with ` + "`--show-offset`" + `:
+* ` + "`--from-offset`" + ` on its own displays the log lines after that line.
+* ` + "`--from-offset`" + ` with ` + "`--lines`" + ` or ` + "`--limit`" + ` displays that many lines before that
+  line and exits. Passing the offset of the first line displayed pages further
+  back through the log.
+
+The following flag combinations are also incompatible:
+* ` + "`--replay`" + ` and ` + "`--from-offset`" + `
+* ` + "`--tail`" + ` and ` + "`--until`" + `
 `
 
 const usageDebugLogExamples = `
@@ -140,6 +179,16 @@ View all logs on the ` + "`cmr`" + ` topic (label):
 
     juju debug-log --include-labels cmr
 
+View the messages logged while handling an HTTP request for any ` + "`mysql`" + `
+charm, except for connection errors:
+
+    juju debug-log --replay --label charm=~mysql.* --label http-request-id=42 \
+        --exclude-grep "connection (refused|reset)"
+
+Show failed hooks from the last 1000 lines:
+
+    juju debug-log --limit 1000 --grep "hook .* failed"
+
 Progressively exclude more content from the entire log:
 
     juju debug-log --replay --exclude-module juju.state.apiserver
@@ -182,6 +231,16 @@ Show all messages from the ` + "`juju.worker.uniter`" + ` module, except those s
         --include-module juju.worker.uniter \
         --exclude machine-3 \
         --exclude machine-4
+
+Show the messages logged in the two hours before an incident, including the
+logs which have since been rotated:
+
+    juju debug-log --since 2026-10-16T08:00:00Z --until 2026-10-16T10:00:00Z
+
+Page backwards through the log, 500 lines at a time:
+
+    juju debug-log --limit 500 --show-offset
+    juju debug-log --limit 500 --show-offset --from-offset <offset of first line>
 `
 
 func (c *debugLogCommand) Info() *cmd.Info {
@@ -235,6 +294,10 @@ type debugLogCommand struct {
 
 	includeLabels []string
 	excludeLabels []string
+
+	since      string
+	until      string
+	showOffset bool
 }
 
 func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
@@ -247,6 +310,9 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModule), "exclude-module", "Do not show log messages for these logging modules")
 	f.Var(cmd.NewAppendStringsValue(&c.includeLabels), "include-labels", "Only show log messages for these logging label key values")
 	f.Var(cmd.NewAppendStringsValue(&c.excludeLabels), "exclude-labels", "Do not show log messages for these logging label key values")
+	f.Var(cmd.NewAppendStringsValue(&c.params.Labels), "label", "Only show log messages whose labels match key=value, key!=value, key=~regexp or key!~regexp")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeMessage), "grep", "Only show log messages matching this regular expression")
+	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeMessage), "exclude-grep", "Do not show log messages matching this regular expression")
 
 	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
 	f.StringVar(&c.level, "level", "", "")
@@ -261,6 +327,10 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(c.limitFlag, "limit", "Show this many of the most recent logs and then exit")
 
 	f.BoolVar(&c.params.Replay, "replay", false, "Show the entire log and continue to append new ones")
+	f.StringVar(&c.since, "since", "", "Only show log messages from this time, as an RFC3339 timestamp or a duration ago")
+	f.StringVar(&c.until, "until", "", "Only show log messages up to this time, as an RFC3339 timestamp or a duration ago, and then exit")
+	f.StringVar(&c.params.FromOffset, "from-offset", "", "Show log messages after the message with this offset, or before it with --lines or --limit")
+	f.BoolVar(&c.showOffset, "show-offset", false, "Show the offset of each log message in text output")
 
 	f.BoolVar(&c.noTail, "no-tail", false, "Show existing log messages and then exit")
 	f.BoolVar(&c.tail, "tail", false, "Show existing log messages and continue to append new ones")
@@ -307,16 +377,48 @@ func (c *debugLogCommand) Init(args []string) error {
 	if c.params.Replay && c.backLogFlag.IsSet() {
 		return errors.NotValidf("setting --replay and --lines")
 	}
+	if c.params.Replay && c.params.FromOffset != "" {
+		return errors.NotValidf("setting --replay and --from-offset")
+	}
+	if c.tail && c.until != "" {
+		return errors.NotValidf("setting --tail and --until")
+	}
 	if c.retryDelay < 0 {
 		return errors.NotValidf("negative retry delay")
 	}
-	if c.limitFlag.IsSet() {
+	now := time.Now()
+	if c.since != "" {
+		since, err := parseLogTime(c.since, now)
+		if err != nil {
+			return errors.Annotate(err, "invalid --since value")
+		}
+		c.params.StartTime = since
+	}
+	if c.until != "" {
+		until, err := parseLogTime(c.until, now)
+		if err != nil {
+			return errors.Annotate(err, "invalid --until value")
+		}
+		if until.Before(c.params.StartTime) {
+			return errors.NotValidf("--until before --since")
+		}
+		c.params.EndTime = until
+	}
+	if c.params.FromOffset != "" {
+		if _, err := logtailer.ParseOffset(c.params.FromOffset); err != nil {
+			return errors.Annotate(err, "invalid --from-offset value")
+		}
+	}
+	if c.limitFlag.IsSet() || c.until != "" {
 		c.noTail = true
 	}
 	if c.backLogFlag.IsSet() {
 		c.tail = true
 	}
-	if !c.backLogFlag.IsSet() && !c.limitFlag.IsSet() && !c.params.Replay {
+	// Reading forwards from a time or an offset shows every line from
+	// there, rather than the most recent ones.
+	forwards := c.params.Replay || c.since != "" || c.params.FromOffset != ""
+	if !c.backLogFlag.IsSet() && !c.limitFlag.IsSet() && !forwards {
 		*c.backLogFlag.value = defaultLineCount
 	}
 	if c.utc {
@@ -353,10 +455,33 @@ func (c *debugLogCommand) Init(args []string) error {
 		}
 		c.params.ExcludeLabels[parts[0]] = parts[1]
 	}
+	for _, label := range c.params.Labels {
+		if _, err := logtailer.ParseLabelMatcher(label); err != nil {
+			return errors.Annotate(err, "invalid --label value")
+		}
+	}
+	for _, pattern := range append(c.params.IncludeMessage, c.params.ExcludeMessage...) {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.NotValidf("regular expression %q", pattern)
+		}
+	}
 
 	return cmd.CheckEmpty(args)
 }
 
+// parseLogTime parses a time given either as an RFC3339 timestamp, or as
+// a duration before now.
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, errors.NotValidf("time %q, expected an RFC3339 timestamp or a duration", value)
+	}
+	return now.Add(-d).UTC(), nil
+}
+
 func (c *debugLogCommand) parseEntity(entity string) string {
 	tag, err := names.ParseTag(entity)
 	switch {
@@ -470,6 +595,11 @@ func (c *debugLogCommand) getDebugLogClients(ctx context.Context, warningLogger
 
 	var clients []DebugLogAPI
 	for _, details := range controllers {
+		if len(details.APIEndpoints) == 0 {
+			// The controller is still coming up, so it has no logs to
+			// serve yet.
+			continue
+		}
 		client, err := getDebugLogClientForAddresses(ctx, c, details.APIEndpoints)
 		if len(controllers) > 1 && errors.Is(err, api.ConnectionFailure) {
 			warningLogger.Warningf("cannot connect to debug log client for controller %q at addresses %v: %v", details.ControllerID, details.APIEndpoints, err)
@@ -673,6 +803,9 @@ func (c *debugLogCommand) writeText(w io.Writer, v any) error {
 		return fmt.Errorf("expected log message of type %T, got %t", common.LogMessage{}, v)
 	}
 	ts := r.Time.In(c.tz).Format(c.format)
+	if c.showOffset {
+		fmt.Fprintf(w, "%s ", logtailer.OffsetOf(*r))
+	}
 	if c.params.Firehose {
 		fmt.Fprintf(w, "%s: ", r.ModelUUID)
 	}
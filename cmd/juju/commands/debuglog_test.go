@@ -176,6 +176,68 @@ func (s *DebugLogSuite) TestArgParsing(c *tc.C) {
 		}, {
 			args:     []string{"--lines", "30", "--no-tail", "--limit", "50"},
 			errMatch: `setting --no-tail and --lines not valid`,
+		}, {
+			args: []string{"--since", "2026-10-16T08:00:00Z"},
+			expected: common.DebugLogParams{
+				StartTime: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC),
+			},
+		}, {
+			args: []string{"--since", "2026-10-16T08:00:00Z", "--until", "2026-10-16T10:00:00Z"},
+			expected: common.DebugLogParams{
+				StartTime: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+			},
+		}, {
+			args: []string{"--until", "2026-10-16T10:00:00Z"},
+			expected: common.DebugLogParams{
+				Backlog: 10,
+				EndTime: time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+			},
+		}, {
+			args:     []string{"--since", "yesterday"},
+			errMatch: `invalid --since value: time "yesterday", expected an RFC3339 timestamp or a duration not valid`,
+		}, {
+			args:     []string{"--since", "2026-10-16T10:00:00Z", "--until", "2026-10-16T08:00:00Z"},
+			errMatch: `--until before --since not valid`,
+		}, {
+			args:     []string{"--until", "1h", "--tail"},
+			errMatch: `setting --tail and --until not valid`,
+		}, {
+			args: []string{"--from-offset", "1792137600000000000-0badf00d"},
+			expected: common.DebugLogParams{
+				FromOffset: "1792137600000000000-0badf00d",
+			},
+		}, {
+			args: []string{"--from-offset", "1792137600000000000-0badf00d", "--limit", "500"},
+			expected: common.DebugLogParams{
+				FromOffset: "1792137600000000000-0badf00d",
+				Limit:      500,
+			},
+		}, {
+			args:     []string{"--from-offset", "somewhere"},
+			errMatch: `invalid --from-offset value: offset "somewhere" not valid`,
+		}, {
+			args:     []string{"--replay", "--from-offset", "1792137600000000000-0badf00d"},
+			errMatch: `setting --replay and --from-offset not valid`,
+		}, {
+			args: []string{"--label", "charm=~mysql.*", "--label", "http-request-id!=42"},
+			expected: common.DebugLogParams{
+				Backlog: 10,
+				Labels:  []string{"charm=~mysql.*", "http-request-id!=42"},
+			},
+		}, {
+			args:     []string{"--label", "charm"},
+			errMatch: `invalid --label value: label matcher "charm" not valid`,
+		}, {
+			args: []string{"--grep", "hook .* failed", "--grep", "mysql", "--exclude-grep", "refused"},
+			expected: common.DebugLogParams{
+				Backlog:        10,
+				IncludeMessage: []string{"hook .* failed", "mysql"},
+				ExcludeMessage: []string{"refused"},
+			},
+		}, {
+			args:     []string{"--exclude-grep", "(refused"},
+			errMatch: `regular expression "\(refused" not valid`,
 		},
 	} {
 		c.Logf("test %v", i)
@@ -274,6 +336,9 @@ func (s *DebugLogSuite) TestLogOutput(c *tc.C) {
 	checkOutput(
 		"--location",
 		"machine-0: 14:15:23 INFO test.module somefile.go:123 this is the log output\n")
+	checkOutput(
+		"--show-offset",
+		"1476000923345000000-a4290af6 machine-0: 14:15:23 INFO test.module this is the log output\n")
 	checkOutput(
 		"--format", "json",
 		`{"model-uuid":"model-uuid","timestamp":"2016-10-09T08:15:23.345Z","entity":"machine-0","level":"INFO","module":"test.module","location":"somefile.go:123","message":"this is the log output"}`+"\n")
@@ -372,6 +437,11 @@ func (s *DebugLogSuite) TestAllControllersAgainstFacadeVersion3(c *tc.C) {
 					ControllerID: "1",
 					APIEndpoints: []string{"address-668"},
 				},
+				// A controller still coming up has no addresses, and is
+				// skipped rather than queried through the default address.
+				"2": {
+					ControllerID: "2",
+				},
 			},
 			apiVersion: 3,
 		}, nil
@@ -42,6 +42,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/juju/subnet"
 	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/cmd/juju/waitfor"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/internal/featureflag"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -364,6 +365,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(status.NewStatusCommand())
 	r.Register(newSwitchCommand())
 	r.Register(status.NewStatusHistoryCommand())
+	r.Register(waitfor.NewWaitForCommand())
 
 	// Error resolution and debugging commands.
 	r.Register(action.NewExecCommand(nil))
@@ -390,6 +392,10 @@ func registerCommands(r commandRegistry) {
 	// Manage backups.
 	r.Register(backups.NewCreateCommand())
 	r.Register(backups.NewDownloadCommand())
+	r.Register(backups.NewListCommand())
+	r.Register(backups.NewShowCommand())
+	r.Register(backups.NewRemoveCommand())
+	r.Register(backups.NewRestoreCommand())
 
 	// Manage authorized ssh keys.
 	r.Register(sshkeys.NewAddKeysCommand())
@@ -408,6 +414,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(user.NewLogoutCommand())
 	r.Register(user.NewRemoveCommand())
 	r.Register(user.NewWhoAmICommand())
+	r.Register(user.NewAddGroupCommand())
+	r.Register(user.NewAddToGroupCommand())
+	r.Register(user.NewRemoveFromGroupCommand())
 
 	// Manage machines
 	r.Register(machine.NewAddCommand())
@@ -425,6 +434,10 @@ func registerCommands(r commandRegistry) {
 	r.Register(model.NewRevokeCommand())
 	r.Register(model.NewShowCommand())
 	r.Register(model.NewModelCredentialCommand())
+	r.Register(model.NewSnapshotConfigCommand())
+	r.Register(model.NewConfigSnapshotsCommand())
+	r.Register(model.NewRemoveConfigSnapshotCommand())
+	r.Register(model.NewRollbackConfigCommand())
 
 	r.Register(newMigrateCommand())
 	r.Register(model.NewExportBundleCommand())
@@ -442,6 +455,9 @@ func registerCommands(r commandRegistry) {
 	r.Register(action.NewListOperationsCommand())
 	r.Register(action.NewShowOperationCommand())
 	r.Register(action.NewShowTaskCommand())
+	r.Register(action.NewScheduleActionCommand())
+	r.Register(action.NewListScheduledActionsCommand())
+	r.Register(action.NewRemoveScheduledActionCommand())
 
 	// Manage and control applications
 	r.Register(application.NewAddUnitCommand())
@@ -494,8 +510,10 @@ func registerCommands(r commandRegistry) {
 	r.Register(controller.NewRegisterCommand())
 	r.Register(controller.NewUnregisterCommand(jujuclient.NewFileClientStore()))
 	r.Register(controller.NewEnableDestroyControllerCommand())
+	r.Register(controller.NewEnableHACommand())
 	r.Register(controller.NewShowControllerCommand())
 	r.Register(controller.NewConfigCommand())
+	r.Register(controller.NewAuditLogCommand())
 
 	// Manage clouds and credentials
 	r.Register(cloud.NewUpdateCloudCommand(&cloudToCommandAdaptor{}))
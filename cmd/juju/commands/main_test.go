@@ -202,6 +202,7 @@ var commandNames = []string{
 	"actions",
 	"add-cloud",
 	"add-credential",
+	"add-group",
 	"add-k8s",
 	"add-machine",
 	"add-model",
@@ -210,11 +211,14 @@ var commandNames = []string{
 	"add-space",
 	"add-ssh-key",
 	"add-storage",
+	"add-to-group",
 	"add-unit",
 	"add-user",
 	"attach-resource",
 	"attach-storage",
+	"audit-log",
 	"autoload-credentials",
+	"backups",
 	"bind",
 	"bootstrap",
 	"cancel-task",
@@ -222,6 +226,7 @@ var commandNames = []string{
 	"charm-resources",
 	"clouds",
 	"config",
+	"config-snapshots",
 	"constraints",
 	"consume",
 	"controller-config",
@@ -249,6 +254,7 @@ var commandNames = []string{
 	"download",
 	"enable-command",
 	"enable-destroy-controller",
+	"enable-ha",
 	"enable-user",
 	"exec",
 	"export-bundle",
@@ -268,6 +274,7 @@ var commandNames = []string{
 	"integrate",
 	"kill-controller",
 	"list-actions",
+	"list-backups",
 	"list-charm-resources",
 	"list-clouds",
 	"list-controllers",
@@ -280,6 +287,7 @@ var commandNames = []string{
 	"list-operations",
 	"list-regions",
 	"list-resources",
+	"list-scheduled-actions",
 	"list-secret-backends",
 	"list-secrets",
 	"list-spaces",
@@ -308,13 +316,17 @@ var commandNames = []string{
 	"relate", // alias for integrate
 	"reload-spaces",
 	"remove-application",
+	"remove-backup",
 	"remove-cloud",
+	"remove-config-snapshot",
 	"remove-credential",
+	"remove-from-group",
 	"remove-k8s",
 	"remove-machine",
 	"remove-offer",
 	"remove-relation",
 	"remove-saas",
+	"remove-scheduled-action",
 	"remove-secret-backend",
 	"remove-secret",
 	"remove-space",
@@ -328,13 +340,17 @@ var commandNames = []string{
 	"resolve",
 	"resolved",
 	"resources",
+	"restore-backup",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke-cloud",
 	"revoke-secret",
 	"revoke",
+	"rollback-config",
 	"run",
 	"scale-application",
+	"schedule-action",
+	"scheduled-actions",
 	"scp",
 	"secret-backends",
 	"secrets",
@@ -346,6 +362,7 @@ var commandNames = []string{
 	"set-model-constraints",
 	"show-action",
 	"show-application",
+	"show-backup",
 	"show-cloud",
 	"show-controller",
 	"show-credential",
@@ -362,6 +379,7 @@ var commandNames = []string{
 	"show-task",
 	"show-unit",
 	"show-user",
+	"snapshot-config",
 	"spaces",
 	"ssh-keys",
 	"ssh",
@@ -387,6 +405,7 @@ var commandNames = []string{
 	"upgrade-model",
 	"users",
 	"version",
+	"wait-for",
 	"whoami",
 }
 
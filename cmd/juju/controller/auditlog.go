@@ -0,0 +1,287 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/auditlog"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+const auditLogDoc = `
+Shows the API requests recorded in the audit log of the controller, oldest
+first. Requests are recorded while the ` + "`auditing-enabled`" + ` controller config
+is true, and are kept for the duration set by the ` + "`audit-log-retention`" + `
+controller config. Requests to read-only methods are only recorded as
+configured by ` + "`audit-log-exclude-methods`" + `.
+
+The requests shown can be filtered by the user making them, the model they
+were made to, the facade and method called, the time they were made and
+their outcome. The outcome of a request is one of:
+
+    succeeded   the request completed without errors
+    failed      the request, or part of a bulk request, returned an error
+    unknown     no response to the request has been recorded
+
+The ` + "`--since`" + ` and ` + "`--until`" + ` options each take either an RFC3339
+timestamp, or a duration such as ` + "`2h`" + ` meaning that long ago.
+
+By default the 100 most recent matching requests are shown. Use ` + "`--limit 0`" + `
+to show all of them.
+
+Only controller superusers can view the audit log.
+`
+
+const auditLogExamples = `
+Show the most recent requests:
+
+    juju audit-log
+
+Show the failed requests made by alice over the last day:
+
+    juju audit-log --user alice --outcome failed --since 24h
+
+Show the applications deployed to a model during a maintenance window, as
+JSON:
+
+    juju audit-log --model admin/prod --facade Application --method Deploy \
+        --since 2026-10-16T08:00:00Z --until 2026-10-16T10:00:00Z --format json
+`
+
+// defaultAuditLogLimit is the number of audit log entries shown when no
+// limit is given.
+const defaultAuditLogLimit = 100
+
+// AuditLogAPI defines the API methods used by the audit-log command.
+type AuditLogAPI interface {
+	Close() error
+	Query(ctx context.Context, args params.AuditLogQueryArgs) ([]params.AuditLogEntry, error)
+}
+
+// NewAuditLogCommand returns a command which shows the audit log of a
+// controller.
+func NewAuditLogCommand() cmd.Command {
+	return modelcmd.WrapController(&auditLogCommand{})
+}
+
+type auditLogCommand struct {
+	modelcmd.ControllerCommandBase
+	api AuditLogAPI
+	out cmd.Output
+
+	user    string
+	model   string
+	facade  string
+	method  string
+	since   string
+	until   string
+	outcome string
+	limit   int
+
+	args params.AuditLogQueryArgs
+}
+
+// auditLogEntry is the output of an audit log entry.
+type auditLogEntry struct {
+	Time           time.Time       `yaml:"time" json:"time"`
+	User           string          `yaml:"user" json:"user"`
+	Model          string          `yaml:"model,omitempty" json:"model,omitempty"`
+	ModelUUID      string          `yaml:"model-uuid,omitempty" json:"model-uuid,omitempty"`
+	Command        string          `yaml:"command,omitempty" json:"command,omitempty"`
+	ConversationID string          `yaml:"conversation-id" json:"conversation-id"`
+	ConnectionID   string          `yaml:"connection-id" json:"connection-id"`
+	RequestID      uint64          `yaml:"request-id" json:"request-id"`
+	Facade         string          `yaml:"facade" json:"facade"`
+	Method         string          `yaml:"method" json:"method"`
+	Version        int             `yaml:"version" json:"version"`
+	Args           string          `yaml:"args,omitempty" json:"args,omitempty"`
+	Outcome        string          `yaml:"outcome" json:"outcome"`
+	RespondedAt    *time.Time      `yaml:"responded-at,omitempty" json:"responded-at,omitempty"`
+	Errors         []auditLogError `yaml:"errors,omitempty" json:"errors,omitempty"`
+}
+
+// auditLogError is the output of an error returned by an audited request.
+type auditLogError struct {
+	Code    string `yaml:"code,omitempty" json:"code,omitempty"`
+	Message string `yaml:"message" json:"message"`
+}
+
+// Info implements Command.Info.
+func (c *auditLogCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "audit-log",
+		Purpose:  "Shows the audit log of API requests made to a controller.",
+		Doc:      auditLogDoc,
+		Examples: auditLogExamples,
+		SeeAlso: []string{
+			"controller-config",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *auditLogCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.user, "user", "", "Only show requests made by this user")
+	f.StringVar(&c.model, "model", "", "Only show requests made to this model, by qualified name or UUID")
+	f.StringVar(&c.facade, "facade", "", "Only show requests to this facade")
+	f.StringVar(&c.method, "method", "", "Only show requests to this facade method")
+	f.StringVar(&c.since, "since", "", "Only show requests made from this time, as an RFC3339 timestamp or a duration ago")
+	f.StringVar(&c.until, "until", "", "Only show requests made before this time, as an RFC3339 timestamp or a duration ago")
+	f.StringVar(&c.outcome, "outcome", "", "Only show requests with this outcome: succeeded|failed|unknown")
+	f.IntVar(&c.limit, "limit", defaultAuditLogLimit, "Show at most this many of the most recent requests, or 0 for all")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatAuditLogTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *auditLogCommand) Init(args []string) error {
+	switch c.outcome {
+	case "", "succeeded", "failed", "unknown":
+	default:
+		return errors.Errorf("invalid value %q for option --outcome: expected succeeded, failed or unknown", c.outcome)
+	}
+	if c.limit < 0 {
+		return errors.Errorf("invalid value %d for option --limit: expected a positive number or 0", c.limit)
+	}
+	c.args = params.AuditLogQueryArgs{
+		User:    c.user,
+		Model:   c.model,
+		Facade:  c.facade,
+		Method:  c.method,
+		Outcome: c.outcome,
+		Limit:   c.limit,
+	}
+
+	now := time.Now()
+	if c.since != "" {
+		since, err := parseAuditLogTime(c.since, now)
+		if err != nil {
+			return errors.Annotate(err, "invalid --since value")
+		}
+		c.args.Since = &since
+	}
+	if c.until != "" {
+		until, err := parseAuditLogTime(c.until, now)
+		if err != nil {
+			return errors.Annotate(err, "invalid --until value")
+		}
+		if c.args.Since != nil && !until.After(*c.args.Since) {
+			return errors.New("--until must be after --since")
+		}
+		c.args.Until = &until
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// parseAuditLogTime parses a time given either as an RFC3339 timestamp, or
+// as a duration before now.
+func parseAuditLogTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, errors.NotValidf("time %q, expected an RFC3339 timestamp or a duration", value)
+	}
+	return now.Add(-d).UTC(), nil
+}
+
+func (c *auditLogCommand) getAPI(ctx context.Context) (AuditLogAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return auditlog.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *auditLogCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	entries, err := client.Query(ctx, c.args)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(entries) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No audit log entries to display.")
+		return nil
+	}
+
+	result := make([]auditLogEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = auditLogEntry{
+			Time:           entry.When,
+			User:           entry.Who,
+			Model:          entry.ModelName,
+			ModelUUID:      entry.ModelUUID,
+			Command:        entry.What,
+			ConversationID: entry.ConversationID,
+			ConnectionID:   entry.ConnectionID,
+			RequestID:      entry.RequestID,
+			Facade:         entry.Facade,
+			Method:         entry.Method,
+			Version:        entry.Version,
+			Args:           entry.Args,
+			Outcome:        entry.Outcome,
+			RespondedAt:    entry.RespondedAt,
+		}
+		for _, e := range entry.Errors {
+			result[i].Errors = append(result[i].Errors, auditLogError{
+				Code:    e.Code,
+				Message: e.Message,
+			})
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+func formatAuditLogTabular(writer io.Writer, value any) error {
+	entries, ok := value.([]auditLogEntry)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+	tw := output.TabWriter(writer)
+	fmt.Fprintln(tw, "Time\tUser\tModel\tRequest\tOutcome\tError")
+	for _, entry := range entries {
+		var message string
+		if len(entry.Errors) > 0 {
+			message = entry.Errors[0].Message
+			if len(entry.Errors) > 1 {
+				message += fmt.Sprintf(" (and %d more)", len(entry.Errors)-1)
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s.%s\t%s\t%s\n",
+			entry.Time.Local().Format(time.RFC3339),
+			entry.User,
+			entry.Model,
+			entry.Facade,
+			entry.Method,
+			entry.Outcome,
+			message,
+		)
+	}
+	return tw.Flush()
+}
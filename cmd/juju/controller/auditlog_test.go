@@ -0,0 +1,223 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/rpc/params"
+)
+
+type AuditLogSuite struct {
+	baseControllerSuite
+	api *fakeAuditLogAPI
+}
+
+func TestAuditLogSuite(t *testing.T) {
+	tc.Run(t, &AuditLogSuite{})
+}
+
+func (s *AuditLogSuite) SetUpTest(c *tc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+	s.createTestClientStore(c)
+
+	when := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	responded := when.Add(time.Second)
+	s.api = &fakeAuditLogAPI{
+		entries: []params.AuditLogEntry{{
+			ConversationID: "0123456789abcdef",
+			ConnectionID:   "1A",
+			Who:            "alice",
+			What:           "juju deploy mysql",
+			ModelName:      "admin/foo",
+			ModelUUID:      "foo-uuid",
+			RequestID:      1,
+			Facade:         "Application",
+			Method:         "Deploy",
+			Version:        20,
+			Args:           `{"applications":[]}`,
+			When:           when,
+			Outcome:        "failed",
+			RespondedAt:    &responded,
+			Errors: []params.AuditLogError{
+				{Code: "not found", Message: "charm not found"},
+				{Message: "boom"},
+			},
+		}, {
+			ConversationID: "fedcba9876543210",
+			ConnectionID:   "2B",
+			Who:            "bob",
+			ModelName:      "admin/bar",
+			ModelUUID:      "bar-uuid",
+			RequestID:      3,
+			Facade:         "Client",
+			Method:         "FullStatus",
+			Version:        8,
+			When:           when.Add(time.Minute),
+			Outcome:        "unknown",
+		}},
+	}
+}
+
+func (s *AuditLogSuite) TestInit(c *tc.C) {
+	tests := []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--outcome", "failed", "--limit", "0"},
+	}, {
+		args: []string{"--since", "2h", "--until", "1h"},
+	}, {
+		args: []string{"extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}, {
+		args: []string{"--outcome", "maybe"},
+		err:  `invalid value "maybe" for option --outcome: expected succeeded, failed or unknown`,
+	}, {
+		args: []string{"--limit", "-1"},
+		err:  `invalid value -1 for option --limit: expected a positive number or 0`,
+	}, {
+		args: []string{"--since", "yesterday"},
+		err:  `invalid --since value: time "yesterday", expected an RFC3339 timestamp or a duration not valid`,
+	}, {
+		args: []string{"--until", "-1h"},
+		err:  `invalid --until value: time "-1h", expected an RFC3339 timestamp or a duration not valid`,
+	}, {
+		args: []string{"--since", "1h", "--until", "2h"},
+		err:  `--until must be after --since`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %v", i, test.args)
+		err := cmdtesting.InitCommand(controller.NewAuditLogCommandForTest(s.api, s.store), test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *AuditLogSuite) TestQueryArgs(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store),
+		"--user", "alice",
+		"--model", "admin/foo",
+		"--facade", "Application",
+		"--method", "Deploy",
+		"--since", "2026-03-01T12:00:00Z",
+		"--until", "2026-03-01T14:00:00+01:00",
+		"--outcome", "failed",
+		"--limit", "10",
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Assert(s.api.args.Since, tc.NotNil)
+	c.Assert(s.api.args.Until, tc.NotNil)
+	c.Check(s.api.args.Since.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)), tc.IsTrue)
+	c.Check(s.api.args.Until.Equal(time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)), tc.IsTrue)
+	c.Check(s.api.args.User, tc.Equals, "alice")
+	c.Check(s.api.args.Model, tc.Equals, "admin/foo")
+	c.Check(s.api.args.Facade, tc.Equals, "Application")
+	c.Check(s.api.args.Method, tc.Equals, "Deploy")
+	c.Check(s.api.args.Outcome, tc.Equals, "failed")
+	c.Check(s.api.args.Limit, tc.Equals, 10)
+	c.Check(s.api.closed, tc.IsTrue)
+}
+
+func (s *AuditLogSuite) TestQueryDefaultLimit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.args, tc.DeepEquals, params.AuditLogQueryArgs{Limit: 100})
+}
+
+func (s *AuditLogSuite) TestQueryDurationSince(c *tc.C) {
+	before := time.Now()
+	_, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store), "--since", "1h")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.api.args.Since, tc.NotNil)
+	c.Check(s.api.args.Since.Before(before.Add(-time.Hour)), tc.IsFalse)
+	c.Check(s.api.args.Since.After(time.Now().Add(-time.Hour)), tc.IsFalse)
+}
+
+func (s *AuditLogSuite) TestTabular(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Times are shown in the local time zone, so only the layout of the
+	// table is checked here.
+	lines := strings.Split(cmdtesting.Stdout(ctx), "\n")
+	c.Assert(lines, tc.HasLen, 4)
+	c.Check(lines[0], tc.Matches, `Time +User +Model +Request +Outcome +Error`)
+	c.Check(lines[1], tc.Matches, `2026-03-01T\S+ +alice +admin/foo +Application\.Deploy +failed +charm not found \(and 1 more\)`)
+	c.Check(lines[2], tc.Matches, `2026-03-01T\S+ +bob +admin/bar +Client\.FullStatus +unknown *`)
+	c.Check(lines[3], tc.Equals, "")
+}
+
+func (s *AuditLogSuite) TestYAML(c *tc.C) {
+	s.api.entries = s.api.entries[1:]
+	ctx, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store), "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+- time: 2026-03-01T12:01:00Z
+  user: bob
+  model: admin/bar
+  model-uuid: bar-uuid
+  conversation-id: fedcba9876543210
+  connection-id: 2B
+  request-id: 3
+  facade: Client
+  method: FullStatus
+  version: 8
+  outcome: unknown
+`[1:])
+}
+
+func (s *AuditLogSuite) TestJSONErrors(c *tc.C) {
+	s.api.entries = s.api.entries[:1]
+	ctx, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store), "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `[{"time":"2026-03-01T12:00:00Z","user":"alice","model":"admin/foo",`+
+		`"model-uuid":"foo-uuid","command":"juju deploy mysql","conversation-id":"0123456789abcdef",`+
+		`"connection-id":"1A","request-id":1,"facade":"Application","method":"Deploy","version":20,`+
+		`"args":"{\"applications\":[]}","outcome":"failed","responded-at":"2026-03-01T12:00:01Z",`+
+		`"errors":[{"code":"not found","message":"charm not found"},{"message":"boom"}]}]`+"\n")
+}
+
+func (s *AuditLogSuite) TestNoEntries(c *tc.C) {
+	s.api.entries = nil
+	ctx, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No audit log entries to display.\n")
+}
+
+func (s *AuditLogSuite) TestQueryError(c *tc.C) {
+	s.api.err = errors.New("permission denied")
+	_, err := cmdtesting.RunCommand(c, controller.NewAuditLogCommandForTest(s.api, s.store))
+	c.Check(err, tc.ErrorMatches, "permission denied")
+}
+
+type fakeAuditLogAPI struct {
+	entries []params.AuditLogEntry
+	err     error
+	args    params.AuditLogQueryArgs
+	closed  bool
+}
+
+func (f *fakeAuditLogAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
+func (f *fakeAuditLogAPI) Query(_ context.Context, args params.AuditLogQueryArgs) ([]params.AuditLogEntry, error) {
+	f.args = args
+	return f.entries, f.err
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/highavailability"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/bootstrap"
+	"github.com/juju/juju/rpc/params"
+)
+
+const enableHASummary = `Ensure that sufficient controllers exist to provide redundancy.`
+
+const enableHADoc = `
+To ensure highly available controllers, a minimum of 3 controllers are needed.
+Any more than that should be an odd number, so the Dqlite cluster backing the
+controllers can always elect a leader.
+
+The number of controllers is set with -n. If not specified, or specified as 0,
+it defaults to 3. Controllers can only be added with this command, to reduce
+the number of controllers remove the controller machines.
+
+New controller machines use the constraints given with --constraints, which
+also become the constraints of the controller application.
+
+The --to option accepts a comma-separated list of placement directives, one
+per new controller, used in order. A directive is either an existing machine
+in the controller model, which is converted into a controller, or a provider
+specific placement directive (such as a zone). Containers are not supported.
+Any controllers without a directive are placed by the provider.
+`
+
+const enableHAExamples = `
+Ensure that the controller is still in highly available mode. If
+there is only 1 controller running, this will ensure there
+are 3 running. If more than 3 controllers are running, give
+their number with -n, since without it 3 are requested and
+enable-ha does not remove controllers, so the command fails.
+
+    juju enable-ha
+
+Ensure that 5 controllers are available:
+
+    juju enable-ha -n 5
+
+Ensure that 7 controllers are available, with newly created
+controller machines having at least 8GB RAM:
+
+    juju enable-ha -n 7 --constraints mem=8G
+
+Ensure that 7 controllers are available, with machines server1 and
+server2 used first, and if necessary, newly created controller
+machines having at least 8GB RAM:
+
+    juju enable-ha -n 7 --to server1,server2 --constraints mem=8G
+`
+
+// NewEnableHACommand returns a command that ensures the controller has the
+// requested number of controllers.
+func NewEnableHACommand() cmd.Command {
+	return modelcmd.WrapController(&enableHACommand{})
+}
+
+// enableHACommand makes the controller highly available.
+type enableHACommand struct {
+	modelcmd.ControllerCommandBase
+	out cmd.Output
+
+	// newHAClientFunc returns the API used to enable HA. It is overridden
+	// in tests.
+	newHAClientFunc func(ctx context.Context) (MakeHAClient, error)
+
+	// NumControllers specifies the number of controllers to make available.
+	NumControllers int
+
+	// ConstraintsStr contains the constraints for new controller machines.
+	ConstraintsStr string
+
+	// Constraints holds the parsed constraints for new controller machines.
+	Constraints constraints.Value
+
+	// PlacementSpec holds the unparsed placement directives.
+	PlacementSpec string
+
+	// Placement holds the placement directives, one per new controller.
+	Placement []string
+}
+
+// MakeHAClient defines the methods on the client API used by the enable-ha
+// command.
+type MakeHAClient interface {
+	Close() error
+	EnableHA(
+		ctx context.Context, numControllers int, cons constraints.Value, placement []string,
+	) (params.ControllersChanges, error)
+}
+
+// Info implements Command.Info.
+func (c *enableHACommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "enable-ha",
+		Purpose:  enableHASummary,
+		Doc:      enableHADoc,
+		Examples: enableHAExamples,
+		SeeAlso: []string{
+			"show-controller",
+			"remove-machine",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *enableHACommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.IntVar(&c.NumControllers, "n", 0, "Number of controllers to make available")
+	f.StringVar(&c.PlacementSpec, "to", "", "The machine(s) to become controllers, or placement directive(s) for new controllers")
+	f.StringVar(&c.ConstraintsStr, "constraints", "", "Additional machine constraints")
+	c.out.AddFlags(f, "simple", map[string]cmd.Formatter{
+		"yaml":   cmd.FormatYaml,
+		"json":   cmd.FormatJson,
+		"simple": formatSimple,
+	})
+}
+
+// Init implements Command.Init.
+func (c *enableHACommand) Init(args []string) error {
+	if c.NumControllers < 0 || (c.NumControllers%2 != 1 && c.NumControllers != 0) {
+		return errors.New("must specify a number of controllers odd and non-negative")
+	}
+	if c.PlacementSpec != "" {
+		placementSpecs := strings.Split(c.PlacementSpec, ",")
+		c.Placement = make([]string, len(placementSpecs))
+		for i, spec := range placementSpecs {
+			if err := validatePlacement(spec); err != nil {
+				return errors.Trace(err)
+			}
+			c.Placement[i] = spec
+		}
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// validatePlacement checks that the placement directive names either an
+// existing machine or is a provider specific directive.
+func validatePlacement(spec string) error {
+	p, err := instance.ParsePlacement(spec)
+	if errors.Is(err, instance.ErrPlacementScopeMissing) {
+		// The directive is handed to the provider as is.
+		return nil
+	} else if err != nil {
+		return errors.Errorf("invalid --to parameter %q", spec)
+	}
+	if p.Scope != instance.MachineScope {
+		return errors.Errorf("unsupported enable-ha placement directive %q", spec)
+	}
+	if names.IsContainerMachine(p.Directive) {
+		return errors.Errorf("unsupported enable-ha placement directive %q, containers are not supported", spec)
+	}
+	return nil
+}
+
+func (c *enableHACommand) getHAClient(ctx context.Context) (MakeHAClient, error) {
+	if c.newHAClientFunc != nil {
+		return c.newHAClientFunc(ctx)
+	}
+	root, err := c.NewModelAPIRoot(ctx, bootstrap.ControllerModelName)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot get API connection")
+	}
+	return highavailability.NewClient(root), nil
+}
+
+// availabilityInfo defines the serialization behaviour of the controller
+// changes made by enable-ha.
+type availabilityInfo struct {
+	Maintained []string `json:"maintained,omitempty" yaml:"maintained,flow,omitempty"`
+	Removed    []string `json:"removed,omitempty" yaml:"removed,flow,omitempty"`
+	Added      []string `json:"added,omitempty" yaml:"added,flow,omitempty"`
+	Converted  []string `json:"converted,omitempty" yaml:"converted,flow,omitempty"`
+}
+
+// Run implements Command.Run.
+func (c *enableHACommand) Run(ctx *cmd.Context) error {
+	var err error
+	c.Constraints, err = common.ParseConstraints(ctx, c.ConstraintsStr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	haClient, err := c.getHAClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer haClient.Close()
+
+	result, err := haClient.EnableHA(ctx, c.NumControllers, c.Constraints, c.Placement)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+
+	return c.out.Write(ctx, availabilityInfo{
+		Maintained: result.Maintained,
+		Removed:    result.Removed,
+		Added:      result.Added,
+		Converted:  result.Converted,
+	})
+}
+
+// formatSimple marshals value to a human readable summary of the changes
+// made to the controllers.
+func formatSimple(writer io.Writer, value interface{}) error {
+	info, ok := value.(availabilityInfo)
+	if !ok {
+		return errors.Errorf("unexpected result type for enable-ha call: %T", value)
+	}
+
+	for _, machineList := range []struct {
+		message string
+		list    []string
+	}{
+		{"maintaining machines: %s\n", info.Maintained},
+		{"removing machines: %s\n", info.Removed},
+		{"adding machines: %s\n", info.Added},
+		{"converting machines: %s\n", info.Converted},
+	} {
+		if len(machineList.list) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(writer, machineList.message, strings.Join(machineList.list, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
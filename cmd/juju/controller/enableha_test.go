@@ -0,0 +1,153 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/rpc/params"
+)
+
+type enableHASuite struct {
+	baseControllerSuite
+	fake  *fakeHAClient
+	store *jujuclient.MemStore
+}
+
+func TestEnableHASuite(t *testing.T) {
+	tc.Run(t, &enableHASuite{})
+}
+
+func (s *enableHASuite) SetUpTest(c *tc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+
+	s.fake = &fakeHAClient{
+		result: params.ControllersChanges{
+			Maintained: []string{"0"},
+			Added:      []string{"1", "2"},
+		},
+	}
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "fake"
+	s.store.Controllers["fake"] = jujuclient.ControllerDetails{}
+}
+
+func (s *enableHASuite) runEnableHA(c *tc.C, args ...string) (*cmd.Context, error) {
+	command := controller.NewEnableHACommandForTest(s.fake, s.store)
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *enableHASuite) TestEnableHADefault(c *tc.C) {
+	ctx, err := s.runEnableHA(c)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+maintaining machines: 0
+adding machines: 1, 2
+`[1:])
+
+	c.Check(s.fake.numControllers, tc.Equals, 0)
+	c.Check(s.fake.cons, tc.DeepEquals, constraints.Value{})
+	c.Check(s.fake.placement, tc.HasLen, 0)
+}
+
+func (s *enableHASuite) TestEnableHAWithArgs(c *tc.C) {
+	s.fake.result = params.ControllersChanges{
+		Maintained: []string{"0", "1", "2"},
+		Added:      []string{"4"},
+		Converted:  []string{"3"},
+	}
+	ctx, err := s.runEnableHA(c, "-n", "5", "--constraints", "mem=8G", "--to", "3,zone=us-east-1a")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+maintaining machines: 0, 1, 2
+adding machines: 4
+converting machines: 3
+`[1:])
+
+	c.Check(s.fake.numControllers, tc.Equals, 5)
+	c.Check(s.fake.cons, tc.DeepEquals, constraints.MustParse("mem=8G"))
+	c.Check(s.fake.placement, tc.DeepEquals, []string{"3", "zone=us-east-1a"})
+}
+
+func (s *enableHASuite) TestEnableHAYaml(c *tc.C) {
+	ctx, err := s.runEnableHA(c, "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+maintained: ["0"]
+added: ["1", "2"]
+`[1:])
+}
+
+func (s *enableHASuite) TestEnableHAJson(c *tc.C) {
+	ctx, err := s.runEnableHA(c, "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `{"maintained":["0"],"added":["1","2"]}`+"\n")
+}
+
+func (s *enableHASuite) TestEnableHAInvalidNumControllers(c *tc.C) {
+	for _, n := range []string{"-1", "2", "4"} {
+		_, err := s.runEnableHA(c, "-n", n)
+		c.Check(err, tc.ErrorMatches, "must specify a number of controllers odd and non-negative")
+	}
+	c.Check(s.fake.called, tc.IsFalse)
+}
+
+func (s *enableHASuite) TestEnableHAInvalidPlacement(c *tc.C) {
+	_, err := s.runEnableHA(c, "--to", "0/lxd/1")
+	c.Check(err, tc.ErrorMatches, `unsupported enable-ha placement directive "0/lxd/1", containers are not supported`)
+
+	_, err = s.runEnableHA(c, "--to", "lxd:0")
+	c.Check(err, tc.ErrorMatches, `unsupported enable-ha placement directive "lxd:0"`)
+
+	c.Check(s.fake.called, tc.IsFalse)
+}
+
+func (s *enableHASuite) TestEnableHAInvalidConstraints(c *tc.C) {
+	_, err := s.runEnableHA(c, "--constraints", "invalid=foo")
+	c.Check(err, tc.ErrorMatches, `unknown constraint "invalid"`)
+	c.Check(s.fake.called, tc.IsFalse)
+}
+
+func (s *enableHASuite) TestEnableHAUnrecognizedArg(c *tc.C) {
+	_, err := s.runEnableHA(c, "whoops")
+	c.Check(err, tc.ErrorMatches, `unrecognized args: \["whoops"\]`)
+}
+
+func (s *enableHASuite) TestEnableHAError(c *tc.C) {
+	s.fake.err = errors.NotSupportedf("removing controllers with enable-ha")
+	_, err := s.runEnableHA(c, "-n", "1")
+	c.Check(err, tc.ErrorMatches, "removing controllers with enable-ha not supported")
+}
+
+type fakeHAClient struct {
+	called         bool
+	numControllers int
+	cons           constraints.Value
+	placement      []string
+	result         params.ControllersChanges
+	err            error
+}
+
+func (f *fakeHAClient) Close() error {
+	return nil
+}
+
+func (f *fakeHAClient) EnableHA(
+	_ context.Context, numControllers int, cons constraints.Value, placement []string,
+) (params.ControllersChanges, error) {
+	f.called = true
+	f.numControllers = numControllers
+	f.cons = cons
+	f.placement = placement
+	return f.result, f.err
+}
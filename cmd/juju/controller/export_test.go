@@ -32,11 +32,13 @@ func NewShowControllerCommandForTest(
 	testStore jujuclient.ClientStore,
 	api func(string) ControllerAccessAPI,
 	modelConfigAPI func(controllerName string) ModelConfigAPI,
+	controllerNodesAPI func(controllerName string, controllerModel base.UserModel) ControllerNodesAPI,
 ) *showControllerCommand {
 	return &showControllerCommand{
-		store:          testStore,
-		api:            api,
-		modelConfigAPI: modelConfigAPI,
+		store:              testStore,
+		api:                api,
+		modelConfigAPI:     modelConfigAPI,
+		controllerNodesAPI: controllerNodesAPI,
 	}
 }
 
@@ -188,3 +190,23 @@ func NewData(ctx context.Context, api destroyControllerAPI, ctrUUID string) (env
 var (
 	NoModelsMessage = noModelsMessage
 )
+
+// NewEnableHACommandForTest returns an enableHACommand with the HA client
+// mocked out.
+func NewEnableHACommandForTest(client MakeHAClient, store jujuclient.ClientStore) cmd.Command {
+	c := &enableHACommand{
+		newHAClientFunc: func(context.Context) (MakeHAClient, error) {
+			return client, nil
+		},
+	}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+// NewAuditLogCommandForTest returns an audit-log command with the api
+// provided as specified.
+func NewAuditLogCommandForTest(api AuditLogAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &auditLogCommand{api: api}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/client/highavailability"
 	"github.com/juju/juju/api/client/modelconfig"
 	"github.com/juju/juju/api/controller/controller"
 	"github.com/juju/juju/api/jujuclient"
@@ -33,6 +34,10 @@ var usageShowControllerDetails = `
 Shows extended information about a controller(s) as well as related models
 and user login details.
 
+For users with superuser access, the Dqlite cluster membership of each
+controller node is also shown, along with its role in the cluster (voter,
+standby or spare).
+
 `[1:]
 
 const usageShowControllerExamples = `
@@ -50,6 +55,8 @@ type showControllerCommand struct {
 
 	modelConfigAPI func(controllerName string) ModelConfigAPI
 
+	controllerNodesAPI func(controllerName string, controllerModel base.UserModel) ControllerNodesAPI
+
 	controllerNames []string
 	showPasswords   bool
 }
@@ -113,6 +120,13 @@ type ModelConfigAPI interface {
 	Close() error
 }
 
+// ControllerNodesAPI defines a subset of the high availability API.
+type ControllerNodesAPI interface {
+	BestAPIVersion() int
+	ControllerDetails(ctx context.Context) (map[string]highavailability.ControllerDetails, error)
+	Close() error
+}
+
 func (c *showControllerCommand) getAPI(ctx context.Context, controllerName string) (ControllerAccessAPI, error) {
 	if c.api != nil {
 		return c.api(controllerName), nil
@@ -135,6 +149,20 @@ func (c *showControllerCommand) getModelConfigAPI(ctx context.Context, controlle
 	return modelconfig.NewClient(api), nil
 }
 
+func (c *showControllerCommand) getControllerNodesAPI(
+	ctx context.Context, controllerName string, controllerModel base.UserModel,
+) (ControllerNodesAPI, error) {
+	if c.api != nil {
+		return c.controllerNodesAPI(controllerName, controllerModel), nil
+	}
+	modelName := jujuclient.QualifyModelName(controllerModel.Qualifier.String(), controllerModel.Name)
+	api, err := c.NewAPIRoot(ctx, c.store, controllerName, modelName)
+	if err != nil {
+		return nil, fmt.Errorf("opening API connection for controller model %q: %w", modelName, err)
+	}
+	return highavailability.NewClient(api), nil
+}
+
 // Run implements Command.Run
 func (c *showControllerCommand) Run(ctx *cmd.Context) error {
 	controllerNames := c.controllerNames
@@ -224,13 +252,17 @@ func (c *showControllerCommand) Run(ctx *cmd.Context) error {
 		}
 
 		modelTags := make([]names.ModelTag, len(allModels))
-		var controllerModelUUID string
+		var controllerModel *base.UserModel
 		for i, m := range allModels {
 			modelTags[i] = names.NewModelTag(m.UUID)
 			if m.Name == bootstrap.ControllerModelName {
-				controllerModelUUID = m.UUID
+				controllerModel = &allModels[i]
 			}
 		}
+		var controllerModelUUID string
+		if controllerModel != nil {
+			controllerModelUUID = controllerModel.UUID
+		}
 		modelStatusResults, err := client.ModelStatus(ctx.Context, modelTags...)
 		if err != nil {
 			details.Errors = append(details.Errors, err.Error())
@@ -260,6 +292,9 @@ func (c *showControllerCommand) Run(ctx *cmd.Context) error {
 
 		c.convertControllerForShow(&details, controllerName, one, access, allModels,
 			modelStatusResults, controllerVersion, agentGitCommit, identityURL)
+		if controllerModel != nil {
+			c.convertDqliteClusterForShow(ctx, &details, controllerName, *controllerModel)
+		}
 		controllers[controllerName] = details
 	}
 	return c.out.Write(ctx, controllers)
@@ -308,6 +343,10 @@ type ShowControllerDetails struct {
 	// Nodes is a collection of all k8s pods forming the controller cluster.
 	Nodes map[string]MachineDetails `yaml:"controller-nodes,omitempty" json:"controller-nodes,omitempty"`
 
+	// DqliteCluster reports the membership of each controller node in the
+	// Dqlite cluster, keyed by controller ID.
+	DqliteCluster map[string]DqliteNodeDetails `yaml:"dqlite-cluster,omitempty" json:"dqlite-cluster,omitempty"`
+
 	// Models is a collection of all models for this controller.
 	Models map[string]ModelDetails `yaml:"models,omitempty" json:"models,omitempty"`
 
@@ -367,6 +406,22 @@ type MachineDetails struct {
 	InstanceID string `yaml:"instance-id,omitempty" json:"instance-id,omitempty"`
 }
 
+// DqliteNodeDetails holds the Dqlite cluster membership of a controller node.
+type DqliteNodeDetails struct {
+	// Member is true if the controller node has joined the Dqlite cluster.
+	Member bool `yaml:"member" json:"member"`
+
+	// NodeID holds the ID of the node in the Dqlite cluster.
+	NodeID uint64 `yaml:"node-id,omitempty" json:"node-id,omitempty"`
+
+	// Address holds the address the Dqlite node is bound to.
+	Address string `yaml:"address,omitempty" json:"address,omitempty"`
+
+	// Role holds the role of the node in the Dqlite cluster, one of voter,
+	// standby or spare.
+	Role string `yaml:"role,omitempty" json:"role,omitempty"`
+}
+
 // ModelDetails holds details of a model to show.
 type ModelDetails struct {
 	// ModelUUID holds the details of a model.
@@ -537,3 +592,44 @@ func (c *showControllerCommand) convertMachinesForShow(
 		nodes[m.Id] = details
 	}
 }
+
+// convertDqliteClusterForShow reports the Dqlite cluster membership of
+// the controller nodes. Only superusers can see the controller model, and
+// controllers that predate the reporting of Dqlite roles are skipped.
+func (c *showControllerCommand) convertDqliteClusterForShow(
+	ctx context.Context,
+	controller *ShowControllerDetails,
+	controllerName string,
+	controllerModel base.UserModel,
+) {
+	client, err := c.getControllerNodesAPI(ctx, controllerName, controllerModel)
+	if err != nil {
+		controller.Errors = append(controller.Errors, err.Error())
+		return
+	}
+	defer client.Close()
+
+	if client.BestAPIVersion() < 4 {
+		return
+	}
+	nodes, err := client.ControllerDetails(ctx)
+	if errors.Is(err, errors.NotSupported) || errors.Is(err, errors.NotImplemented) {
+		return
+	} else if err != nil {
+		controller.Errors = append(controller.Errors, err.Error())
+		return
+	}
+	if len(nodes) == 0 {
+		return
+	}
+
+	controller.DqliteCluster = make(map[string]DqliteNodeDetails)
+	for id, node := range nodes {
+		controller.DqliteCluster[id] = DqliteNodeDetails{
+			Member:  node.DqliteRole != "",
+			NodeID:  node.DqliteNodeID,
+			Address: node.DqliteAddress,
+			Role:    node.DqliteRole,
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/client/highavailability"
 	apicontroller "github.com/juju/juju/api/controller/controller"
 	"github.com/juju/juju/api/jujuclient"
 	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
@@ -25,10 +26,11 @@ import (
 
 type ShowControllerSuite struct {
 	baseControllerSuite
-	fakeController *fakeController
-	api            func(string) controller.ControllerAccessAPI
-	setAccess      func(permission.Access)
-	modelConfigAPI func(controllerName string) controller.ModelConfigAPI
+	fakeController  *fakeController
+	api             func(string) controller.ControllerAccessAPI
+	setAccess       func(permission.Access)
+	modelConfigAPI  func(controllerName string) controller.ModelConfigAPI
+	controllerNodes *fakeControllerNodes
 }
 
 func TestShowControllerSuite(t *testing.T) {
@@ -67,6 +69,7 @@ func (s *ShowControllerSuite) SetUpTest(c *tc.C) {
 	s.modelConfigAPI = func(controllerName string) controller.ModelConfigAPI {
 		return &fakeModelConfig{}
 	}
+	s.controllerNodes = &fakeControllerNodes{version: 4}
 }
 
 func (s *ShowControllerSuite) TestShowOneControllerOneInStore(c *tc.C) {
@@ -526,7 +529,12 @@ mallards:
 }
 func (s *ShowControllerSuite) runShowController(c *tc.C, args ...string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, controller.NewShowControllerCommandForTest(
-		s.store, s.api, s.modelConfigAPI), args...)
+		s.store, s.api, s.modelConfigAPI,
+		func(controllerName string, controllerModel base.UserModel) controller.ControllerNodesAPI {
+			s.controllerNodes.controllerModel = controllerModel
+			return s.controllerNodes
+		},
+	), args...)
 }
 
 func (s *ShowControllerSuite) assertShowControllerFailed(c *tc.C, args ...string) {
@@ -602,6 +610,120 @@ aws-test:
 	s.assertShowController(c, "aws-test")
 }
 
+func (s *ShowControllerSuite) TestShowControllerDqliteCluster(c *tc.C) {
+	_ = s.createTestClientStore(c)
+	s.controllerNodes.details = map[string]highavailability.ControllerDetails{
+		"0": {ControllerID: "0", DqliteNodeID: 1, DqliteAddress: "10.0.0.1", DqliteRole: "voter"},
+		"1": {ControllerID: "1", DqliteNodeID: 2, DqliteAddress: "10.0.0.2", DqliteRole: "standby"},
+		"2": {ControllerID: "2", DqliteNodeID: 3, DqliteAddress: "10.0.0.3", DqliteRole: "spare"},
+		"3": {ControllerID: "3"},
+	}
+	s.expectedOutput = `
+aws-test:
+  details:
+    controller-uuid: this-is-the-aws-test-uuid
+    api-endpoints: [this-is-aws-test-of-many-api-endpoints]
+    cloud: aws
+    region: us-east-1
+    agent-version: 999.99.99
+    agent-git-commit: badf00d0badf00d0badf00d0badf00d0badf00d0
+    controller-model-version: 999.99.99
+    ca-cert: this-is-aws-test-ca-cert
+  controller-machines:
+    "0":
+      instance-id: id-0
+    "1":
+      instance-id: id-1
+    "2":
+      instance-id: id-2
+    "3":
+      instance-id: id-3
+  dqlite-cluster:
+    "0":
+      member: true
+      node-id: 1
+      address: 10.0.0.1
+      role: voter
+    "1":
+      member: true
+      node-id: 2
+      address: 10.0.0.2
+      role: standby
+    "2":
+      member: true
+      node-id: 3
+      address: 10.0.0.3
+      role: spare
+    "3":
+      member: false
+  models:
+    controller:
+      model-uuid: ghi
+      machine-count: 2
+      core-count: 4
+  current-model: prod/controller
+  account:
+    user: admin
+    access: superuser
+`[1:]
+
+	s.assertShowController(c, "aws-test")
+	c.Check(s.controllerNodes.controllerModel.UUID, tc.Equals, "ghi")
+}
+
+func (s *ShowControllerSuite) TestShowControllerDqliteClusterOldController(c *tc.C) {
+	_ = s.createTestClientStore(c)
+	s.controllerNodes.version = 3
+	s.controllerNodes.details = map[string]highavailability.ControllerDetails{
+		"0": {ControllerID: "0", APIEndpoints: []string{"10.0.0.1:17070"}},
+	}
+
+	ctx, err := s.runShowController(c, "aws-test")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Not(tc.Contains), "dqlite-cluster")
+}
+
+func (s *ShowControllerSuite) TestShowControllerDqliteClusterError(c *tc.C) {
+	_ = s.createTestClientStore(c)
+	s.controllerNodes.err = errors.New("boom")
+
+	ctx, err := s.runShowController(c, "aws-test")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Contains, `
+  errors:
+  - boom
+`[1:])
+}
+
+func (s *ShowControllerSuite) TestShowControllerDqliteClusterNotSuperuser(c *tc.C) {
+	_ = s.createTestClientStore(c)
+	s.setAccess(permission.LoginAccess)
+	s.controllerNodes.err = errors.New("should not be called")
+
+	ctx, err := s.runShowController(c, "aws-test")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Not(tc.Contains), "should not be called")
+}
+
+type fakeControllerNodes struct {
+	version         int
+	controllerModel base.UserModel
+	details         map[string]highavailability.ControllerDetails
+	err             error
+}
+
+func (f *fakeControllerNodes) BestAPIVersion() int {
+	return f.version
+}
+
+func (f *fakeControllerNodes) ControllerDetails(context.Context) (map[string]highavailability.ControllerDetails, error) {
+	return f.details, f.err
+}
+
+func (*fakeControllerNodes) Close() error {
+	return nil
+}
+
 type fakeController struct {
 	controllerName    string
 	machines          map[string][]base.Machine
@@ -14,17 +14,40 @@ import (
 	"github.com/juju/juju/core/output"
 )
 
+const (
+	ingressDirection = "ingress"
+	egressDirection  = "egress"
+)
+
 type firewallRule struct {
-	KnownService   firewall.WellKnownServiceType `yaml:"known-service" json:"known-service"`
+	KnownService   firewall.WellKnownServiceType `yaml:"known-service,omitempty" json:"known-service,omitempty"`
+	PortRange      string                        `yaml:"port-range,omitempty" json:"port-range,omitempty"`
+	Direction      string                        `yaml:"direction" json:"direction"`
 	WhitelistCIDRS []string                      `yaml:"allowlist-subnets,omitempty" json:"allowlist-subnets,omitempty"`
 }
 
+// name returns the well known service or the port range of the rule.
+func (r firewallRule) name() string {
+	if r.KnownService != "" {
+		return string(r.KnownService)
+	}
+	return r.PortRange
+}
+
 type firewallRules []firewallRule
 
 func (o firewallRules) Len() int      { return len(o) }
 func (o firewallRules) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
 func (o firewallRules) Less(i, j int) bool {
-	return o[i].KnownService < o[j].KnownService
+	// Well known services are listed before port ranges, and ingress
+	// rules before egress rules.
+	if (o[i].KnownService == "") != (o[j].KnownService == "") {
+		return o[i].KnownService != ""
+	}
+	if o[i].Direction != o[j].Direction {
+		return o[i].Direction == ingressDirection
+	}
+	return o[i].name() < o[j].name()
 }
 
 func formatListTabular(writer io.Writer, value any) error {
@@ -43,9 +66,9 @@ func formatFirewallRulesTabular(writer io.Writer, rules firewallRules) {
 
 	sort.Sort(rules)
 
-	w.Println("Service", "Allowlist subnets")
+	w.Println("Service", "Direction", "Allowlist subnets")
 	for _, rule := range rules {
-		w.Println(rule.KnownService, strings.Join(rule.WhitelistCIDRS, ","))
+		w.Println(rule.name(), rule.Direction, strings.Join(rule.WhitelistCIDRS, ","))
 	}
 	tw.Flush()
 }
@@ -23,7 +23,8 @@ Prints the firewall rules.`[1:]
 
 var listRulesHelpDetails = `
 Lists the firewall rules which control ingress to well known services
-within a Juju model.
+within a Juju model, along with the ingress and egress rules for port
+ranges applied to all machines in the model.
 
 DEPRECATION WARNING: %v
 
@@ -110,10 +111,26 @@ func (c *listFirewallRulesCommand) Run(ctx *cmd.Context) error {
 
 	rules := []firewallRule{{
 		KnownService:   firewall.SSHRule,
+		Direction:      ingressDirection,
 		WhitelistCIDRS: cfg.SSHAllow(),
 	}, {
 		KnownService:   firewall.JujuApplicationOfferRule,
+		Direction:      ingressDirection,
 		WhitelistCIDRS: cfg.SAASIngressAllow(),
 	}}
+	for _, rule := range cfg.FirewallIngressRules() {
+		rules = append(rules, firewallRule{
+			PortRange:      rule.PortRange.String(),
+			Direction:      ingressDirection,
+			WhitelistCIDRS: rule.SourceCIDRs.SortedValues(),
+		})
+	}
+	for _, rule := range cfg.FirewallEgressRules() {
+		rules = append(rules, firewallRule{
+			PortRange:      rule.PortRange.String(),
+			Direction:      egressDirection,
+			WhitelistCIDRS: rule.DestinationCIDRs.SortedValues(),
+		})
+	}
 	return c.out.Write(ctx, rules)
 }
@@ -45,9 +45,9 @@ func (s *ListSuite) TestListTabular(c *tc.C) {
 		c,
 		[]string{"--format", "tabular"},
 		`
-Service                 Allowlist subnets
-juju-application-offer  0.0.0.0/0
-ssh                     192.168.1.0/16,10.0.0.0/8
+Service                 Direction  Allowlist subnets
+juju-application-offer  ingress    0.0.0.0/0
+ssh                     ingress    192.168.1.0/16,10.0.0.0/8
 `[1:],
 		"",
 	)
@@ -59,10 +59,12 @@ func (s *ListSuite) TestListYAML(c *tc.C) {
 		[]string{"--format", "yaml"},
 		`
 - known-service: ssh
+  direction: ingress
   allowlist-subnets:
   - 192.168.1.0/16
   - 10.0.0.0/8
 - known-service: juju-application-offer
+  direction: ingress
   allowlist-subnets:
   - 0.0.0.0/0
 `[1:],
@@ -76,15 +78,57 @@ func (s *ListSuite) TestListEmpty(c *tc.C) {
 		c,
 		[]string{"--format", "tabular"},
 		`
-Service                 Allowlist subnets
-juju-application-offer  0.0.0.0/0
-ssh                     
+Service                 Direction  Allowlist subnets
+juju-application-offer  ingress    0.0.0.0/0
+ssh                     ingress    
 `[1:],
 		"",
 	)
 
 }
 
+func (s *ListSuite) TestListPortRanges(c *tc.C) {
+	s.mockAPI.ingressRules = "9100/tcp from 10.0.0.0/8; 8000-8100/udp"
+	s.mockAPI.egressRules = "443/tcp to 192.168.0.0/16"
+	s.assertValidList(
+		c,
+		[]string{"--format", "tabular"},
+		`
+Service                 Direction  Allowlist subnets
+juju-application-offer  ingress    0.0.0.0/0
+ssh                     ingress    192.168.1.0/16,10.0.0.0/8
+8000-8100/udp           ingress    0.0.0.0/0,::/0
+9100/tcp                ingress    10.0.0.0/8
+443/tcp                 egress     192.168.0.0/16
+`[1:],
+		"",
+	)
+}
+
+func (s *ListSuite) TestListPortRangesYAML(c *tc.C) {
+	s.mockAPI.egressRules = "443/tcp to 192.168.0.0/16"
+	s.assertValidList(
+		c,
+		[]string{"--format", "yaml"},
+		`
+- known-service: ssh
+  direction: ingress
+  allowlist-subnets:
+  - 192.168.1.0/16
+  - 10.0.0.0/8
+- known-service: juju-application-offer
+  direction: ingress
+  allowlist-subnets:
+  - 0.0.0.0/0
+- port-range: 443/tcp
+  direction: egress
+  allowlist-subnets:
+  - 192.168.0.0/16
+`[1:],
+		"",
+	)
+}
+
 func (s *ListSuite) runList(c *tc.C, args []string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, firewall.NewListRulesCommandForTest(s.mockAPI), args...)
 }
@@ -101,8 +145,10 @@ func (s *ListSuite) assertValidList(c *tc.C, args []string, expectedValid, expec
 }
 
 type mockListAPI struct {
-	rules string
-	err   error
+	rules        string
+	ingressRules string
+	egressRules  string
+	err          error
 }
 
 func (s *mockListAPI) Close() error {
@@ -114,7 +160,9 @@ func (s *mockListAPI) ModelGet(ctx context.Context) (map[string]any, error) {
 		return nil, s.err
 	}
 	return testing.FakeConfig().Merge(testing.Attrs{
-		config.SSHAllowKey:         s.rules,
-		config.SAASIngressAllowKey: "0.0.0.0/0",
+		config.SSHAllowKey:             s.rules,
+		config.SAASIngressAllowKey:     "0.0.0.0/0",
+		config.FirewallIngressRulesKey: s.ingressRules,
+		config.FirewallEgressRulesKey:  s.egressRules,
 	}), nil
 }
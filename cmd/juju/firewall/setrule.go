@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/cmd/cmd"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/environs/config"
 )
@@ -32,11 +33,25 @@ The currently supported services are:
 - ssh
 - juju-application-offer
 
+A rule may also be set for an arbitrary port range, such as
+9100/tcp or 8000-8100/udp, in which case it is applied to all
+machines in the model. Without an allowlist, the port range is
+opened to all networks. With --egress, the rule allows outgoing
+traffic to the allowlisted subnets instead; egress rules are only
+supported by some providers. Once any egress rule is set, all other
+outgoing traffic is blocked, except traffic between the machines of
+the model, to the controller API and to the DNS resolver of the cloud.
+Setting a rule for a port range replaces any existing rule for the
+same port range, and --remove removes it.
+
 DEPRECATION WARNING: %v
 `
 
 const setRuleHelpExamples = `
     juju set-firewall-rule ssh --allowlist 192.168.1.0/16
+    juju set-firewall-rule 9100/tcp --allowlist 10.0.0.0/8
+    juju set-firewall-rule 443/tcp --egress --allowlist 10.0.0.0/8
+    juju set-firewall-rule 9100/tcp --remove
 `
 
 // NewSetFirewallRuleCommand returns a command to set firewall rules.
@@ -57,8 +72,11 @@ type setFirewallRuleCommand struct {
 	modelcmd.ModelCommandBase
 	modelcmd.IAASOnlyCommand
 	service   firewall.WellKnownServiceType
+	portRange network.PortRange
 	allowlist string
 	whitelist string
+	egress    bool
+	remove    bool
 
 	newAPIFunc func(ctx context.Context) (SetFirewallRuleAPI, error)
 }
@@ -67,7 +85,7 @@ type setFirewallRuleCommand struct {
 func (c *setFirewallRuleCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "set-firewall-rule",
-		Args:     "<service-name>, --allowlist <cidr>[,<cidr>...]",
+		Args:     "<service-name>|<port-range>, --allowlist <cidr>[,<cidr>...]",
 		Purpose:  setRuleHelpSummary,
 		Doc:      fmt.Sprintf(setRuleHelpDetails, deprecationWarning),
 		Examples: setRuleHelpExamples,
@@ -81,20 +99,25 @@ func (c *setFirewallRuleCommand) Info() *cmd.Info {
 func (c *setFirewallRuleCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.allowlist, "allowlist", "", "list of subnets to allowlist")
 	f.StringVar(&c.whitelist, "whitelist", "", "")
+	f.BoolVar(&c.egress, "egress", false, "set an egress rule for a port range")
+	f.BoolVar(&c.remove, "remove", false, "remove the rule for a port range")
 }
 
 // Init implements cmd.Command.
 func (c *setFirewallRuleCommand) Init(args []string) (err error) {
 	if len(args) == 1 {
-		c.service = firewall.WellKnownServiceType(args[0])
-		if c.allowlist == "" && c.whitelist == "" {
-			return errors.New("no allowlist subnets specified")
-		}
 		if c.allowlist != "" && c.whitelist != "" {
 			return errors.New("cannot specify both whitelist and allowlist")
 		}
 		if args[0] != "ssh" && args[0] != "juju-application-offer" {
-			return errors.NotSupportedf("service %q", args[0])
+			return c.initPortRange(args[0])
+		}
+		c.service = firewall.WellKnownServiceType(args[0])
+		if c.egress || c.remove {
+			return errors.Errorf("--egress and --remove are only valid for a port range")
+		}
+		if c.allowlist == "" && c.whitelist == "" {
+			return errors.New("no allowlist subnets specified")
 		}
 		if err := c.validateCIDRS(c.allowlist + c.whitelist); err != nil {
 			return errors.Trace(err)
@@ -106,6 +129,20 @@ func (c *setFirewallRuleCommand) Init(args []string) (err error) {
 	return cmd.CheckEmpty(args[1:])
 }
 
+func (c *setFirewallRuleCommand) initPortRange(arg string) (err error) {
+	if c.portRange, err = network.ParsePortRange(arg); err != nil {
+		return errors.Errorf("%q is neither a well known service nor a valid port range", arg)
+	}
+	allowlist := c.allowlist + c.whitelist
+	if c.remove && allowlist != "" {
+		return errors.New("cannot specify an allowlist with --remove")
+	}
+	if allowlist == "" {
+		return nil
+	}
+	return errors.Trace(c.validateCIDRS(allowlist))
+}
+
 func (c *setFirewallRuleCommand) validateCIDRS(value string) error {
 	rawValues := strings.SplitSeq(value, ",")
 	for cidrStr := range rawValues {
@@ -120,13 +157,15 @@ func (c *setFirewallRuleCommand) validateCIDRS(value string) error {
 // SetFirewallRuleAPI defines the API methods that the set firewall rules command uses.
 type SetFirewallRuleAPI interface {
 	Close() error
+	ModelGet(ctx context.Context) (map[string]any, error)
 	ModelSet(ctx context.Context, config map[string]any) error
 }
 
 var deprecationWarning = `
-Firewall rules have been moved to model configuration settings ` + "`ssh-allow`" + ` and
-` + "`saas-ingress-allow`" + `. This command is deprecated in favour of
-reading/writing directly to these settings.
+Firewall rules have been moved to model configuration settings ` + "`ssh-allow`" + `,
+` + "`saas-ingress-allow`" + `, ` + "`firewall-ingress-rules`" + ` and ` + "`firewall-egress-rules`" + `.
+This command is deprecated in favour of reading/writing directly to these
+settings.
 `[1:]
 
 func (c *setFirewallRuleCommand) Run(ctx *cmd.Context) error {
@@ -143,6 +182,8 @@ func (c *setFirewallRuleCommand) Run(ctx *cmd.Context) error {
 	defer client.Close()
 
 	switch c.service {
+	case "":
+		err = c.setPortRangeRule(ctx, client)
 	case firewall.SSHRule:
 		err = client.ModelSet(ctx, map[string]any{config.SSHAllowKey: c.allowlist})
 	case firewall.JujuApplicationOfferRule:
@@ -152,3 +193,45 @@ func (c *setFirewallRuleCommand) Run(ctx *cmd.Context) error {
 	}
 	return block.ProcessBlockedError(err, block.BlockChange)
 }
+
+// setPortRangeRule replaces the rule for the port range in the model ingress
+// or egress rules, leaving the rules for other port ranges untouched.
+func (c *setFirewallRuleCommand) setPortRangeRule(ctx context.Context, client SetFirewallRuleAPI) error {
+	attrs, err := client.ModelGet(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cfg, err := config.New(config.NoDefaults, attrs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var cidrs []string
+	if c.allowlist != "" {
+		for cidr := range strings.SplitSeq(c.allowlist, ",") {
+			cidrs = append(cidrs, strings.TrimSpace(cidr))
+		}
+	}
+
+	var rules []string
+	if c.egress {
+		for _, rule := range cfg.FirewallEgressRules() {
+			if rule.PortRange != c.portRange {
+				rules = append(rules, rule.String())
+			}
+		}
+		if !c.remove {
+			rules = append(rules, firewall.NewEgressRule(c.portRange, cidrs...).String())
+		}
+		return client.ModelSet(ctx, map[string]any{config.FirewallEgressRulesKey: strings.Join(rules, ";")})
+	}
+	for _, rule := range cfg.FirewallIngressRules() {
+		if rule.PortRange != c.portRange {
+			rules = append(rules, rule.String())
+		}
+	}
+	if !c.remove {
+		rules = append(rules, firewall.NewIngressRule(c.portRange, cidrs...).String())
+	}
+	return client.ModelSet(ctx, map[string]any{config.FirewallIngressRulesKey: strings.Join(rules, ";")})
+}
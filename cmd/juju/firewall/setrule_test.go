@@ -64,20 +64,81 @@ func (s *SetRuleSuite) TestSetError(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, ".*fail.*")
 }
 
+func (s *SetRuleSuite) TestInitInvalidService(c *tc.C) {
+	_, err := s.runSetRule(c, "http", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, tc.ErrorMatches, `"http" is neither a well known service nor a valid port range`)
+}
+
+func (s *SetRuleSuite) TestInitServiceEgress(c *tc.C) {
+	_, err := s.runSetRule(c, "ssh", "--egress", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, tc.ErrorMatches, "--egress and --remove are only valid for a port range")
+}
+
+func (s *SetRuleSuite) TestInitRemoveWithAllowlist(c *tc.C) {
+	_, err := s.runSetRule(c, "9100/tcp", "--remove", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, tc.ErrorMatches, "cannot specify an allowlist with --remove")
+}
+
+func (s *SetRuleSuite) TestInitPortRangeInvalidCIDR(c *tc.C) {
+	_, err := s.runSetRule(c, "9100/tcp", "--allowlist", "10.0.0.0")
+	c.Assert(err, tc.ErrorMatches, "10.0.0.0 not valid")
+}
+
+func (s *SetRuleSuite) TestSetRulePortRange(c *tc.C) {
+	s.mockAPI.ingressRules = "9100/tcp from 192.168.0.0/16; 8080"
+	_, err := s.runSetRule(c, "9100/tcp", "--allowlist", "10.0.0.0/8,172.16.0.0/12")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.mockAPI.ingressRules, tc.Equals, "8080/tcp from 0.0.0.0/0,::/0;9100/tcp from 10.0.0.0/8,172.16.0.0/12")
+}
+
+func (s *SetRuleSuite) TestSetRulePortRangeAllNetworks(c *tc.C) {
+	_, err := s.runSetRule(c, "8000-8100/udp")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.mockAPI.ingressRules, tc.Equals, "8000-8100/udp")
+}
+
+func (s *SetRuleSuite) TestSetRulePortRangeEgress(c *tc.C) {
+	s.mockAPI.ingressRules = "9100/tcp"
+	s.mockAPI.egressRules = "53/udp to 10.0.0.2/32"
+	_, err := s.runSetRule(c, "443/tcp", "--egress", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.mockAPI.egressRules, tc.Equals, "53/udp to 10.0.0.2/32;443/tcp to 10.0.0.0/8")
+	c.Assert(s.mockAPI.ingressRules, tc.Equals, "9100/tcp")
+}
+
+func (s *SetRuleSuite) TestRemoveRulePortRange(c *tc.C) {
+	s.mockAPI.ingressRules = "9100/tcp from 192.168.0.0/16; 8080/tcp from 10.0.0.0/8"
+	_, err := s.runSetRule(c, "9100/tcp", "--remove")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.mockAPI.ingressRules, tc.Equals, "8080/tcp from 10.0.0.0/8")
+}
+
 func (s *SetRuleSuite) runSetRule(c *tc.C, args ...string) (*cmd.Context, error) {
 	return cmdtesting.RunCommand(c, firewall.NewSetRulesCommandForTest(s.mockAPI), args...)
 }
 
 type mockSetRuleAPI struct {
-	sshRule  string
-	saasRule string
-	err      error
+	sshRule      string
+	saasRule     string
+	ingressRules string
+	egressRules  string
+	err          error
 }
 
 func (s *mockSetRuleAPI) Close() error {
 	return nil
 }
 
+func (s *mockSetRuleAPI) ModelGet(ctx context.Context) (map[string]any, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return testing.FakeConfig().Merge(testing.Attrs{
+		config.FirewallIngressRulesKey: s.ingressRules,
+		config.FirewallEgressRulesKey:  s.egressRules,
+	}), nil
+}
+
 func (s *mockSetRuleAPI) ModelSet(ctx context.Context, cfg map[string]any) error {
 	if s.err != nil {
 		return s.err
@@ -90,6 +151,14 @@ func (s *mockSetRuleAPI) ModelSet(ctx context.Context, cfg map[string]any) error
 	if ok {
 		s.saasRule = saasRule
 	}
+	ingressRules, ok := cfg[config.FirewallIngressRulesKey].(string)
+	if ok {
+		s.ingressRules = ingressRules
+	}
+	egressRules, ok := cfg[config.FirewallEgressRulesKey].(string)
+	if ok {
+		s.egressRules = egressRules
+	}
 
 	return nil
 }
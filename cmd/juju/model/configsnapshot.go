@@ -0,0 +1,401 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/configsnapshot"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ConfigSnapshotAPI defines the API methods used by the config snapshot
+// commands.
+type ConfigSnapshotAPI interface {
+	Close() error
+	CreateSnapshot(ctx context.Context, name string, replace bool) error
+	ListSnapshots(ctx context.Context) ([]params.ConfigSnapshotInfo, error)
+	RemoveSnapshot(ctx context.Context, name string) error
+	RollbackConfig(ctx context.Context, name string, dryRun bool) ([]params.ConfigSnapshotChange, error)
+}
+
+// configSnapshotCommandBase holds the API plumbing shared by the config
+// snapshot commands.
+type configSnapshotCommandBase struct {
+	modelcmd.ModelCommandBase
+	api ConfigSnapshotAPI
+}
+
+func (c *configSnapshotCommandBase) getAPI(ctx context.Context) (ConfigSnapshotAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return configsnapshot.NewClient(root), nil
+}
+
+// parseSnapshotName checks that exactly one snapshot name was given.
+func parseSnapshotName(args []string) (string, error) {
+	switch len(args) {
+	case 0:
+		return "", errors.New("no snapshot name specified")
+	case 1:
+		return args[0], nil
+	default:
+		return "", errors.New("only one snapshot name can be specified")
+	}
+}
+
+const snapshotConfigDoc = `
+Records the configuration of the model under the given name, so that it can
+be restored later with ` + "`juju rollback-config`" + `.
+
+A snapshot holds the model config set for the model, and for each
+application its charm revision, config, trust setting, constraints,
+endpoint bindings and expose settings. Secret model config attributes are
+not recorded.
+
+Snapshot names consist of lower case letters, digits, dots, underscores and
+hyphens, and start with a letter or a digit. Use --replace to overwrite an
+existing snapshot with the same name.
+`
+
+const snapshotConfigExamples = `
+    juju snapshot-config before-upgrade
+    juju snapshot-config before-upgrade --replace
+`
+
+// NewSnapshotConfigCommand returns a command which records the
+// configuration of a model as a named snapshot.
+func NewSnapshotConfigCommand() cmd.Command {
+	return modelcmd.Wrap(&snapshotConfigCommand{})
+}
+
+type snapshotConfigCommand struct {
+	configSnapshotCommandBase
+
+	name    string
+	replace bool
+}
+
+// Info implements Command.Info.
+func (c *snapshotConfigCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "snapshot-config",
+		Args:     "<snapshot name>",
+		Purpose:  "Records the configuration of a model as a named snapshot.",
+		Doc:      snapshotConfigDoc,
+		Examples: snapshotConfigExamples,
+		SeeAlso: []string{
+			"config-snapshots",
+			"rollback-config",
+			"remove-config-snapshot",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *snapshotConfigCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.replace, "replace", false, "Replace an existing snapshot with the same name")
+}
+
+// Init implements Command.Init.
+func (c *snapshotConfigCommand) Init(args []string) (err error) {
+	c.name, err = parseSnapshotName(args)
+	return err
+}
+
+// Run implements Command.Run.
+func (c *snapshotConfigCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.CreateSnapshot(ctx, c.name, c.replace); err != nil {
+		if params.IsCodeAlreadyExists(err) {
+			return errors.Errorf("config snapshot %q already exists, use --replace to overwrite it", c.name)
+		}
+		return errors.Trace(err)
+	}
+	ctx.Infof("Config snapshot %q created.", c.name)
+	return nil
+}
+
+const configSnapshotsDoc = `
+Lists the config snapshots of the model, oldest first.
+`
+
+const configSnapshotsExamples = `
+    juju config-snapshots
+    juju config-snapshots --format yaml
+`
+
+// NewConfigSnapshotsCommand returns a command which lists the config
+// snapshots of a model.
+func NewConfigSnapshotsCommand() cmd.Command {
+	return modelcmd.Wrap(&configSnapshotsCommand{})
+}
+
+type configSnapshotsCommand struct {
+	configSnapshotCommandBase
+
+	out cmd.Output
+}
+
+// configSnapshotInfo is the output of a config snapshot.
+type configSnapshotInfo struct {
+	Name      string    `yaml:"name" json:"name"`
+	CreatedAt time.Time `yaml:"created-at" json:"created-at"`
+}
+
+// Info implements Command.Info.
+func (c *configSnapshotsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "config-snapshots",
+		Purpose:  "Lists the config snapshots of a model.",
+		Doc:      configSnapshotsDoc,
+		Examples: configSnapshotsExamples,
+		SeeAlso: []string{
+			"snapshot-config",
+			"rollback-config",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *configSnapshotsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatConfigSnapshotsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *configSnapshotsCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *configSnapshotsCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	snapshots, err := client.ListSnapshots(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(snapshots) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No config snapshots to display.")
+		return nil
+	}
+
+	result := make([]configSnapshotInfo, len(snapshots))
+	for i, snapshot := range snapshots {
+		result[i] = configSnapshotInfo{
+			Name:      snapshot.Name,
+			CreatedAt: snapshot.CreatedAt,
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+func formatConfigSnapshotsTabular(writer io.Writer, value any) error {
+	snapshots, ok := value.([]configSnapshotInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", snapshots, value)
+	}
+	tw := output.TabWriter(writer)
+	fmt.Fprintln(tw, "Name\tCreated")
+	for _, snapshot := range snapshots {
+		fmt.Fprintf(tw, "%s\t%s\n", snapshot.Name, snapshot.CreatedAt.Local().Format(time.RFC3339))
+	}
+	return tw.Flush()
+}
+
+const removeConfigSnapshotExamples = `
+    juju remove-config-snapshot before-upgrade
+`
+
+// NewRemoveConfigSnapshotCommand returns a command which removes a config
+// snapshot of a model.
+func NewRemoveConfigSnapshotCommand() cmd.Command {
+	return modelcmd.Wrap(&removeConfigSnapshotCommand{})
+}
+
+type removeConfigSnapshotCommand struct {
+	configSnapshotCommandBase
+
+	name string
+}
+
+// Info implements Command.Info.
+func (c *removeConfigSnapshotCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-config-snapshot",
+		Args:     "<snapshot name>",
+		Purpose:  "Removes a config snapshot of a model.",
+		Examples: removeConfigSnapshotExamples,
+		SeeAlso: []string{
+			"snapshot-config",
+			"config-snapshots",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeConfigSnapshotCommand) Init(args []string) (err error) {
+	c.name, err = parseSnapshotName(args)
+	return err
+}
+
+// Run implements Command.Run.
+func (c *removeConfigSnapshotCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.RemoveSnapshot(ctx, c.name); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Config snapshot %q removed.", c.name)
+	return nil
+}
+
+const rollbackConfigDoc = `
+Rolls the configuration of the model back to a snapshot taken with
+` + "`juju snapshot-config`" + `, by comparing the snapshot with the current
+configuration and applying the differences.
+
+The model config is checked before anything is changed. Applications on a
+different charm revision than in the snapshot are then refreshed back to
+that revision, which must still be available in the model. Next, the
+config, trust setting, constraints, endpoint bindings and expose settings
+of the applications are rolled back together: either all of these changes
+are made or none of them are. The model config is rolled back last.
+
+Applications deployed after the snapshot was taken are left untouched.
+Applications removed since the snapshot was taken are reported, but are
+not deployed again.
+
+Use --dry-run to show the changes without making them.
+`
+
+const rollbackConfigExamples = `
+    juju rollback-config before-upgrade --dry-run
+    juju rollback-config before-upgrade
+`
+
+// NewRollbackConfigCommand returns a command which rolls the configuration
+// of a model back to a config snapshot.
+func NewRollbackConfigCommand() cmd.Command {
+	return modelcmd.Wrap(&rollbackConfigCommand{})
+}
+
+type rollbackConfigCommand struct {
+	configSnapshotCommandBase
+
+	name   string
+	dryRun bool
+}
+
+// Info implements Command.Info.
+func (c *rollbackConfigCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rollback-config",
+		Args:     "<snapshot name>",
+		Purpose:  "Rolls the configuration of a model back to a config snapshot.",
+		Doc:      rollbackConfigDoc,
+		Examples: rollbackConfigExamples,
+		SeeAlso: []string{
+			"snapshot-config",
+			"config-snapshots",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *rollbackConfigCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.dryRun, "dry-run", false, "Show the changes without making them")
+}
+
+// Init implements Command.Init.
+func (c *rollbackConfigCommand) Init(args []string) (err error) {
+	c.name, err = parseSnapshotName(args)
+	return err
+}
+
+// Run implements Command.Run.
+func (c *rollbackConfigCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	changes, err := client.RollbackConfig(ctx, c.name, c.dryRun)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	if len(changes) == 0 {
+		ctx.Infof("Configuration already matches config snapshot %q.", c.name)
+		return nil
+	}
+
+	if err := writeConfigChanges(ctx.Stdout, changes); err != nil {
+		return errors.Trace(err)
+	}
+	if c.dryRun {
+		ctx.Infof("Dry run, no changes made.")
+	}
+	return nil
+}
+
+// writeConfigChanges writes a table of the changes made by rolling back
+// to a config snapshot.
+func writeConfigChanges(writer io.Writer, changes []params.ConfigSnapshotChange) error {
+	tw := output.TabWriter(writer)
+	fmt.Fprintln(tw, "Kind\tApplication\tKey\tCurrent\tTarget")
+	for _, change := range changes {
+		target := change.Target
+		switch {
+		case change.Kind == "application":
+			target = change.Target + " (removed, deploy again)"
+		case change.Unset:
+			target = "(unset)"
+		}
+		key := change.Key
+		if key == "" && change.Kind == "binding" {
+			key = "(default)"
+		} else if key == "" && change.Kind == "expose" {
+			key = "(all)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", change.Kind, change.Application, key, change.Current, target)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"context"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type configSnapshotSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	fake *fakeConfigSnapshotClient
+}
+
+func TestConfigSnapshotSuite(t *stdtesting.T) {
+	tc.Run(t, &configSnapshotSuite{})
+}
+
+type fakeConfigSnapshotClient struct {
+	name      string
+	replace   bool
+	dryRun    bool
+	snapshots []params.ConfigSnapshotInfo
+	changes   []params.ConfigSnapshotChange
+	err       error
+}
+
+func (f *fakeConfigSnapshotClient) Close() error {
+	return nil
+}
+
+func (f *fakeConfigSnapshotClient) CreateSnapshot(ctx context.Context, name string, replace bool) error {
+	f.name, f.replace = name, replace
+	return f.err
+}
+
+func (f *fakeConfigSnapshotClient) ListSnapshots(ctx context.Context) ([]params.ConfigSnapshotInfo, error) {
+	return f.snapshots, f.err
+}
+
+func (f *fakeConfigSnapshotClient) RemoveSnapshot(ctx context.Context, name string) error {
+	f.name = name
+	return f.err
+}
+
+func (f *fakeConfigSnapshotClient) RollbackConfig(ctx context.Context, name string, dryRun bool) ([]params.ConfigSnapshotChange, error) {
+	f.name, f.dryRun = name, dryRun
+	return f.changes, f.err
+}
+
+func (s *configSnapshotSuite) SetUpTest(c *tc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.fake = &fakeConfigSnapshotClient{}
+}
+
+func (s *configSnapshotSuite) TestSnapshotConfigInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, model.NewSnapshotConfigCommandForTest(s.fake))
+	c.Check(err, tc.ErrorMatches, "no snapshot name specified")
+
+	_, err = cmdtesting.RunCommand(c, model.NewSnapshotConfigCommandForTest(s.fake), "foo", "bar")
+	c.Check(err, tc.ErrorMatches, "only one snapshot name can be specified")
+}
+
+func (s *configSnapshotSuite) TestSnapshotConfig(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, model.NewSnapshotConfigCommandForTest(s.fake), "before-upgrade", "--replace")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.fake.name, tc.Equals, "before-upgrade")
+	c.Check(s.fake.replace, tc.IsTrue)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Config snapshot \"before-upgrade\" created.\n")
+}
+
+func (s *configSnapshotSuite) TestSnapshotConfigAlreadyExists(c *tc.C) {
+	s.fake.err = &params.Error{Code: params.CodeAlreadyExists, Message: "already exists"}
+
+	_, err := cmdtesting.RunCommand(c, model.NewSnapshotConfigCommandForTest(s.fake), "before-upgrade")
+	c.Check(err, tc.ErrorMatches, `config snapshot "before-upgrade" already exists, use --replace to overwrite it`)
+}
+
+func (s *configSnapshotSuite) TestConfigSnapshots(c *tc.C) {
+	s.fake.snapshots = []params.ConfigSnapshotInfo{{
+		Name:      "before-upgrade",
+		CreatedAt: time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+	}}
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewConfigSnapshotsCommandForTest(s.fake), "--format", "yaml")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+- name: before-upgrade
+  created-at: 2026-10-17T12:00:00Z
+`[1:])
+}
+
+func (s *configSnapshotSuite) TestConfigSnapshotsEmpty(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, model.NewConfigSnapshotsCommandForTest(s.fake))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "No config snapshots to display.\n")
+}
+
+func (s *configSnapshotSuite) TestRemoveConfigSnapshot(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, model.NewRemoveConfigSnapshotCommandForTest(s.fake), "before-upgrade")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.fake.name, tc.Equals, "before-upgrade")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Config snapshot \"before-upgrade\" removed.\n")
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigDryRun(c *tc.C) {
+	s.fake.changes = []params.ConfigSnapshotChange{{
+		Kind:    "model-config",
+		Key:     "logging-config",
+		Current: "<root>=DEBUG",
+		Target:  "<root>=INFO",
+	}, {
+		Kind:        "binding",
+		Application: "mysql",
+		Current:     "beta",
+		Target:      "alpha",
+	}, {
+		Kind:        "config",
+		Application: "mysql",
+		Key:         "port",
+		Current:     "3307",
+		Unset:       true,
+	}, {
+		Kind:        "application",
+		Application: "wordpress",
+		Target:      "ch:wordpress-3",
+	}}
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewRollbackConfigCommandForTest(s.fake), "before-upgrade", "--dry-run")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.fake.name, tc.Equals, "before-upgrade")
+	c.Check(s.fake.dryRun, tc.IsTrue)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+Kind          Application  Key             Current       Target
+model-config               logging-config  <root>=DEBUG  <root>=INFO
+binding       mysql        (default)       beta          alpha
+config        mysql        port            3307          (unset)
+application   wordpress                                  ch:wordpress-3 (removed, deploy again)
+`[1:])
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Dry run, no changes made.\n")
+}
+
+func (s *configSnapshotSuite) TestRollbackConfigNoChanges(c *tc.C) {
+	ctx, err := cmdtesting.RunCommand(c, model.NewRollbackConfigCommandForTest(s.fake), "before-upgrade")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.fake.dryRun, tc.IsFalse)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Configuration already matches config snapshot \"before-upgrade\".\n")
+}
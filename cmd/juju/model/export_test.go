@@ -113,11 +113,33 @@ func NewGrantCommandForTest(modelsApi GrantModelAPI, offersAPI GrantOfferAPI, st
 	cmd := &grantCommand{
 		modelsApi: modelsApi,
 		offersApi: offersAPI,
+		clock:     jujuclock.WallClock,
 	}
 	cmd.SetClientStore(store)
 	return modelcmd.WrapController(cmd), &GrantCommand{cmd}
 }
 
+// NewGrantGroupCommandForTest returns a GrantCommand with the group api
+// provided as specified.
+func NewGrantGroupCommandForTest(groupsApi GrantGroupAPI, store jujuclient.ClientStore) (cmd.Command, *GrantCommand) {
+	cmd := &grantCommand{
+		groupsApi: groupsApi,
+		clock:     jujuclock.WallClock,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd), &GrantCommand{cmd}
+}
+
+// NewRevokeGroupCommandForTest returns a RevokeCommand with the group api
+// provided as specified.
+func NewRevokeGroupCommandForTest(groupsApi RevokeGroupAPI, store jujuclient.ClientStore) (cmd.Command, *RevokeCommand) {
+	cmd := &revokeCommand{
+		groupsApi: groupsApi,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd), &RevokeCommand{cmd}
+}
+
 // NewRevokeCommandForTest returns an revokeCommand with the api provided as specified.
 func NewRevokeCommandForTest(modelsApi RevokeModelAPI, offersAPI RevokeOfferAPI, store jujuclient.ClientStore) (cmd.Command, *RevokeCommand) {
 	cmd := &revokeCommand{
@@ -180,3 +202,35 @@ func NewModelCredentialCommandForTest(modelClient ModelCredentialAPI, cloudClien
 	cmd.SetClientStore(store)
 	return modelcmd.Wrap(cmd)
 }
+
+// NewSnapshotConfigCommandForTest returns a snapshotConfigCommand with the
+// api provided as specified.
+func NewSnapshotConfigCommandForTest(api ConfigSnapshotAPI) cmd.Command {
+	cmd := &snapshotConfigCommand{configSnapshotCommandBase: configSnapshotCommandBase{api: api}}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(cmd)
+}
+
+// NewConfigSnapshotsCommandForTest returns a configSnapshotsCommand with the
+// api provided as specified.
+func NewConfigSnapshotsCommandForTest(api ConfigSnapshotAPI) cmd.Command {
+	cmd := &configSnapshotsCommand{configSnapshotCommandBase: configSnapshotCommandBase{api: api}}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(cmd)
+}
+
+// NewRemoveConfigSnapshotCommandForTest returns a
+// removeConfigSnapshotCommand with the api provided as specified.
+func NewRemoveConfigSnapshotCommandForTest(api ConfigSnapshotAPI) cmd.Command {
+	cmd := &removeConfigSnapshotCommand{configSnapshotCommandBase: configSnapshotCommandBase{api: api}}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(cmd)
+}
+
+// NewRollbackConfigCommandForTest returns a rollbackConfigCommand with the
+// api provided as specified.
+func NewRollbackConfigCommandForTest(api ConfigSnapshotAPI) cmd.Command {
+	cmd := &rollbackConfigCommand{configSnapshotCommandBase: configSnapshotCommandBase{api: api}}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(cmd)
+}
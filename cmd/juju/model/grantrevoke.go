@@ -6,8 +6,11 @@ package model
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/client/applicationoffers"
@@ -21,7 +24,7 @@ import (
 )
 
 var usageGrantSummary = `
-Grants access level to a Juju user for a model, controller, or application offer.`[1:]
+Grants access level to a Juju user for a model, application, controller, or application offer.`[1:]
 
 func filterAccessLevels(accessLevels []permission.Access, filter func(permission.Access) error) []string {
 	ret := []string{}
@@ -40,16 +43,47 @@ Valid access levels for models are:
 Valid access levels for controllers are:
     ` + strings.Join(filterAccessLevels(permission.AllAccessLevels, permission.ValidateControllerAccess), "\n    ") + `
 
+Valid access levels for applications are:
+    ` + strings.Join(filterAccessLevels(permission.AllAccessLevels, permission.ValidateApplicationAccess), "\n    ") + `
+
 Valid access levels for application offers are:
     ` + strings.Join(filterAccessLevels(permission.AllAccessLevels, permission.ValidateOfferAccess), "\n    ")
 
-var usageGrantDetails = `
-By default, the controller is the current controller.
+var usageGrantDetails = `By default, the controller is the current controller.
 
 Users with read access are limited in what they can do with models:
 ` + "`juju models`, `juju machines`, and `juju status`" + `.
 
-`[1:] + validAccessLevels
+Access may also be granted on a single application, by specifying the
+application as ` + "`<model name>/<application name>`" + `. Write access on an
+application allows all of the operations below on that application, while
+the ` + "`run-action`, `config`, `refresh`, and `scale`" + ` access levels each allow
+only running actions, changing configuration, refreshing the charm, and
+adding or removing units respectively. Operation access levels may be
+combined: each is granted and revoked separately, alongside any read or write
+access to the application. Access on an application is removed along with
+the application or its model, and isn't passed on to a later application of
+the same name. Users with application access also need read access to the
+model in order to connect to it. A ` + "`refresh`" + ` grant allows adding a new
+charm revision from a charm repository to refresh the application, but
+refreshing to a local charm still requires write access to the model.
+
+Access to models, applications, and the controller may be granted for a
+limited time with ` + "`--expires`" + `, given as a duration, or ` + "`--until`" + `, given
+as an RFC3339 timestamp. When the grant expires the user reverts to the
+access they held before it was made, and the revocation is recorded in the
+audit log. Granting the same access again changes when it expires, while
+granting it without an expiry makes it permanent. Time-limited access is
+listed by ` + "`juju show-user`" + `.
+
+Access to models and the controller may also be granted to a user group,
+created with ` + "`juju add-group`" + `, by giving ` + "`group:<group name>`" + ` in place
+of the user name. Members of the group have the greater of the access
+granted to them directly and the access granted to any of their groups.
+Groups may not be given access to applications or application offers, nor
+be granted time-limited access.
+
+` + validAccessLevels
 
 const usageGrantExamples = `
 Grant user ` + "`joe`" + ` ` + "`read`" + ` access to model ` + "`mymodel`" + `:
@@ -76,10 +110,30 @@ Grant user ` + "`sam`" + ` ` + "`read`" + ` access to application offers ` + "`f
 
     juju grant sam read fred/prod.hosted-mysql mary/test.hosted-mysql
 
+Grant user ` + "`joe`" + ` ` + "`write`" + ` access to application ` + "`mysql`" + ` in model ` + "`mymodel`" + `:
+
+    juju grant joe write mymodel/mysql
+
+Grant user ` + "`jim`" + ` access to run actions on application ` + "`mysql`" + ` in model ` + "`fred/prod`" + `:
+
+    juju grant jim run-action fred/prod/mysql
+
+Grant user ` + "`ann`" + ` ` + "`admin`" + ` access to model ` + "`mymodel`" + ` for four hours:
+
+    juju grant ann admin mymodel --expires 4h
+
+Grant user ` + "`ann`" + ` ` + "`superuser`" + ` access to the controller until the given time:
+
+    juju grant ann superuser --until 2025-06-01T18:00:00Z
+
+Grant the members of group ` + "`sre`" + ` ` + "`admin`" + ` access to model ` + "`mymodel`" + `:
+
+    juju grant group:sre admin mymodel
+
 `
 
 var usageRevokeSummary = `
-Revokes access from a Juju user for a model, controller, or application offer.`[1:]
+Revokes access from a Juju user for a model, application, controller, or application offer.`[1:]
 
 var usageRevokeDetails = `
 By default, the controller is the current controller.
@@ -106,19 +160,86 @@ Revoke ` + "`read`" + ` (and ` + "`write`" + `) access from user ` + "`joe`" + `
 Revoke ` + "`consume`" + ` access from user ` + "`sam`" + ` for models ` + "`fred/prod.hosted-mysql`" + ` and ` + "`mary/test.hosted-mysql`" + `:
 
     juju revoke sam consume fred/prod.hosted-mysql mary/test.hosted-mysql
+
+Revoke ` + "`write`" + ` access from user ` + "`joe`" + ` for application ` + "`mysql`" + ` in model ` + "`mymodel`" + `,
+leaving ` + "`read`" + ` access:
+
+    juju revoke joe write mymodel/mysql
+
+Revoke ` + "`admin`" + ` access from group ` + "`sre`" + ` for model ` + "`mymodel`" + `,
+leaving ` + "`write`" + ` access:
+
+    juju revoke group:sre admin mymodel
 `
 
 type accessCommand struct {
 	modelcmd.ControllerCommandBase
 
-	User       string
-	ModelNames []string
-	OfferURLs  []crossmodel.OfferURL
-	Access     string
+	User string
+	// Group is the name of the user group given as group:<group name> in
+	// place of a user name, or empty if access is changed for a user.
+	Group        string
+	ModelNames   []string
+	Applications []applicationTarget
+	OfferURLs    []crossmodel.OfferURL
+	Access       string
 }
 
+// applicationTarget identifies an application in a model.
+type applicationTarget struct {
+	ModelName string
+	ModelUUID string
+	Name      string
+}
+
+// isApplicationOnlyAccess returns true if the access is only valid for
+// applications, and not for models.
+func isApplicationOnlyAccess(access permission.Access) bool {
+	return permission.ValidateApplicationAccess(access) == nil &&
+		permission.ValidateModelAccess(access) != nil
+}
+
+// parseApplicationTarget parses an application given as
+// <model name>/<application name>, where the model name may be qualified.
+func parseApplicationTarget(arg string) (applicationTarget, error) {
+	i := strings.LastIndex(arg, "/")
+	if i < 0 {
+		return applicationTarget{}, errors.NotValidf("application %q, expected <model name>/<application name>", arg)
+	}
+	target := applicationTarget{ModelName: arg[:i], Name: arg[i+1:]}
+	modelName := target.ModelName
+	if jujuclient.IsQualifiedModelName(modelName) {
+		var err error
+		modelName, _, err = jujuclient.SplitFullyQualifiedModelName(modelName)
+		if err != nil {
+			return applicationTarget{}, errors.Annotatef(err, "validating model name %q", target.ModelName)
+		}
+	}
+	if !names.IsValidModelName(modelName) {
+		return applicationTarget{}, errors.NotValidf("model name %q", modelName)
+	}
+	if !names.IsValidApplication(target.Name) {
+		return applicationTarget{}, errors.NotValidf("application name %q", target.Name)
+	}
+	return target, nil
+}
+
+// groupPrefix is the prefix of a subject naming a user group rather than a
+// user.
+const groupPrefix = "group:"
+
 // Init implements cmd.Command.
 func (c *accessCommand) Init(args []string) error {
+	if err := c.parseArgs(args); err != nil {
+		return err
+	}
+	if c.Group != "" && (len(c.Applications) > 0 || len(c.OfferURLs) > 0) {
+		return errors.New("groups may only be given access to models and the controller")
+	}
+	return nil
+}
+
+func (c *accessCommand) parseArgs(args []string) error {
 	if len(args) < 1 {
 		return errors.New("no user specified")
 	}
@@ -128,14 +249,31 @@ func (c *accessCommand) Init(args []string) error {
 	}
 
 	c.User = args[0]
+	if group, ok := strings.CutPrefix(c.User, groupPrefix); ok {
+		if group == "" {
+			return errors.New("no group name specified")
+		}
+		c.Group = group
+	}
 	c.Access = args[1]
-	// The remaining args are either model names or offer names.
+	applicationOnly := isApplicationOnlyAccess(permission.Access(c.Access))
+	// The remaining args are either model names, applications or offer
+	// names. An argument of the form a/b is either a qualified model name
+	// or an application in a model, which is resolved when the command runs.
 	for _, arg := range args[2:] {
 		url, err := crossmodel.ParseOfferURL(arg)
 		if err == nil {
 			c.OfferURLs = append(c.OfferURLs, url)
 			continue
 		}
+		if applicationOnly || strings.Count(arg, "/") > 1 {
+			target, err := parseApplicationTarget(arg)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			c.Applications = append(c.Applications, target)
+			continue
+		}
 		maybeModelName := arg
 		if jujuclient.IsQualifiedModelName(maybeModelName) {
 			var err error
@@ -152,17 +290,28 @@ func (c *accessCommand) Init(args []string) error {
 	if len(c.ModelNames) > 0 && len(c.OfferURLs) > 0 {
 		return errors.New("either specify model names or offer URLs but not both")
 	}
+	if len(c.Applications) > 0 && len(c.OfferURLs) > 0 {
+		return errors.New("either specify applications or offer URLs but not both")
+	}
 
-	if len(c.ModelNames) > 0 || len(c.OfferURLs) > 0 {
+	if len(c.ModelNames) > 0 || len(c.Applications) > 0 || len(c.OfferURLs) > 0 {
 		if err := permission.ValidateControllerAccess(permission.Access(c.Access)); err == nil {
 			return errors.Errorf("You have specified a controller access permission %q.\n"+
 				"If you intended to change controller access, do not specify any model names or offer URLs.\n"+
 				"See 'juju help grant'.", c.Access)
 		}
 	}
+	if len(c.Applications) > 0 {
+		if err := permission.ValidateApplicationAccess(permission.Access(c.Access)); err != nil {
+			return err
+		}
+	}
 	if len(c.ModelNames) > 0 {
 		return permission.ValidateModelAccess(permission.Access(c.Access))
 	}
+	if len(c.Applications) > 0 {
+		return nil
+	}
 	if len(c.OfferURLs) > 0 {
 		return permission.ValidateOfferAccess(permission.Access(c.Access))
 	}
@@ -171,12 +320,126 @@ func (c *accessCommand) Init(args []string) error {
 			"If you intended to change model access, you need to specify one or more model names.\n"+
 			"See 'juju help grant'.", c.Access)
 	}
+	if applicationOnly {
+		return errors.Errorf("You have specified an application access permission %q.\n"+
+			"You need to specify one or more applications as <model name>/<application name>.\n"+
+			"See 'juju help grant'.", c.Access)
+	}
 	return nil
 }
 
+// resolveTargets resolves the model names and applications given on the
+// command line, returning the UUIDs of the models and the applications along
+// with the UUIDs of their models. A model name of the form a/b names the
+// application b in model a if there is no model a/b.
+func (c *accessCommand) resolveTargets(ctx context.Context) ([]string, []applicationTarget, error) {
+	var modelNames []string
+	applications := c.Applications
+	for _, name := range c.ModelNames {
+		isApplication, err := c.isApplicationTarget(ctx, name)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		if !isApplication {
+			modelNames = append(modelNames, name)
+			continue
+		}
+		target, err := parseApplicationTarget(name)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		applications = append(applications, target)
+	}
+
+	models, err := c.ModelUUIDs(ctx, modelNames)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	resolved := make([]applicationTarget, len(applications))
+	for i, app := range applications {
+		modelUUIDs, err := c.ModelUUIDs(ctx, []string{app.ModelName})
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		app.ModelUUID = modelUUIDs[0]
+		resolved[i] = app
+	}
+	return models, resolved, nil
+}
+
+// groupTargets resolves the models given on the command line to the targets
+// of a change to the access of a group, which is the controller if there are
+// no models.
+func (c *accessCommand) groupTargets(ctx context.Context) ([]names.Tag, error) {
+	if len(c.ModelNames) == 0 {
+		controllerName, err := c.ControllerName()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		details, err := c.ClientStore().ControllerByName(controllerName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []names.Tag{names.NewControllerTag(details.ControllerUUID)}, nil
+	}
+
+	models, applications, err := c.resolveTargets(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(applications) > 0 {
+		return nil, errors.New("groups may only be given access to models and the controller")
+	}
+	targets := make([]names.Tag, len(models))
+	for i, modelUUID := range models {
+		targets[i] = names.NewModelTag(modelUUID)
+	}
+	return targets, nil
+}
+
+// isApplicationTarget returns true if the qualified model name a/b does not
+// name a model, but the model a exists.
+func (c *accessCommand) isApplicationTarget(ctx context.Context, name string) (bool, error) {
+	if !jujuclient.IsQualifiedModelName(name) {
+		return false, nil
+	}
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	store := c.ClientStore()
+	modelName, _, _ := strings.Cut(name, "/")
+	exists := func(name string) (bool, error) {
+		_, err := store.ModelByName(controllerName, name)
+		if errors.Is(err, errors.NotFound) {
+			return false, nil
+		}
+		return err == nil, errors.Trace(err)
+	}
+
+	for refreshed := false; ; refreshed = true {
+		if found, err := exists(name); err != nil || found {
+			return false, err
+		}
+		if found, err := exists(modelName); err != nil || found {
+			return found, err
+		}
+		if refreshed {
+			return false, nil
+		}
+		// Neither model is known locally, so query the models available
+		// in the controller.
+		if err := c.RefreshModels(ctx, store, controllerName); err != nil {
+			return false, errors.Annotatef(err, "refreshing model %q", name)
+		}
+	}
+}
+
 // NewGrantCommand returns a new grant command.
 func NewGrantCommand() cmd.Command {
-	return modelcmd.WrapController(&grantCommand{})
+	return modelcmd.WrapController(&grantCommand{
+		clock: clock.WallClock,
+	})
 }
 
 // grantCommand represents the command to grant a user access to one or more models.
@@ -184,24 +447,79 @@ type grantCommand struct {
 	accessCommand
 	modelsApi GrantModelAPI
 	offersApi GrantOfferAPI
+	groupsApi GrantGroupAPI
+	clock     clock.Clock
+
+	expires time.Duration
+	until   string
+
+	// Expiry is the time at which the granted access expires, or nil if
+	// the access is permanent.
+	Expiry *time.Time
 }
 
 // Info implements Command.Info.
 func (c *grantCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "grant",
-		Args:     "<user name> <permission> [<model name> ... | <offer url> ...]",
+		Args:     "<user name> <permission> [<model name> | <model name>/<application name> ... | <offer url> ...]",
 		Purpose:  usageGrantSummary,
 		Doc:      usageGrantDetails,
 		Examples: usageGrantExamples,
 		SeeAlso: []string{
 			"revoke",
 			"add-user",
+			"add-group",
 			"grant-cloud",
 		},
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *grantCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.DurationVar(&c.expires, "expires", 0, "Revoke the granted access after this duration")
+	f.StringVar(&c.until, "until", "", "Revoke the granted access at this time, as an RFC3339 timestamp")
+}
+
+// Init implements cmd.Command.
+func (c *grantCommand) Init(args []string) error {
+	if err := c.accessCommand.Init(args); err != nil {
+		return err
+	}
+	if c.expires == 0 && c.until == "" {
+		return nil
+	}
+	if c.expires != 0 && c.until != "" {
+		return errors.New("either specify --expires or --until but not both")
+	}
+	if len(c.OfferURLs) > 0 {
+		return errors.New("time-limited access to application offers is not supported")
+	}
+	if c.Group != "" {
+		return errors.New("time-limited access for groups is not supported")
+	}
+	now := c.clock.Now()
+	var expiry time.Time
+	if c.until != "" {
+		var err error
+		if expiry, err = time.Parse(time.RFC3339, c.until); err != nil {
+			return errors.NotValidf("--until %q, expected an RFC3339 timestamp", c.until)
+		}
+		if !expiry.After(now) {
+			return errors.New("--until must be in the future")
+		}
+	} else {
+		if c.expires < 0 {
+			return errors.New("--expires must be a positive duration")
+		}
+		expiry = now.Add(c.expires)
+	}
+	expiry = expiry.UTC()
+	c.Expiry = &expiry
+	return nil
+}
+
 func (c *grantCommand) getModelAPI(ctx context.Context) (GrantModelAPI, error) {
 	if c.modelsApi != nil {
 		return c.modelsApi, nil
@@ -213,6 +531,13 @@ func (c *grantCommand) getControllerAPI(ctx context.Context) (GrantControllerAPI
 	return c.NewControllerAPIClient(ctx)
 }
 
+func (c *grantCommand) getGroupAPI(ctx context.Context) (GrantGroupAPI, error) {
+	if c.groupsApi != nil {
+		return c.groupsApi, nil
+	}
+	return c.NewUserManagerAPIClient(ctx)
+}
+
 func (c *grantCommand) getOfferAPI(ctx context.Context) (GrantOfferAPI, error) {
 	if c.offersApi != nil {
 		return c.offersApi, nil
@@ -228,12 +553,16 @@ func (c *grantCommand) getOfferAPI(ctx context.Context) (GrantOfferAPI, error) {
 type GrantModelAPI interface {
 	Close() error
 	GrantModel(ctx context.Context, user, access string, modelUUIDs ...string) error
+	GrantModelUntil(ctx context.Context, user, access string, expiry time.Time, modelUUIDs ...string) error
+	GrantApplication(ctx context.Context, user, access, modelUUID, application string) error
+	GrantApplicationUntil(ctx context.Context, user, access, modelUUID, application string, expiry time.Time) error
 }
 
 // GrantControllerAPI defines the API functions used by the grant command.
 type GrantControllerAPI interface {
 	Close() error
 	GrantController(ctx context.Context, user, access string) error
+	GrantControllerUntil(ctx context.Context, user, access string, expiry time.Time) error
 }
 
 // GrantOfferAPI defines the API functions used by the grant command.
@@ -242,9 +571,19 @@ type GrantOfferAPI interface {
 	GrantOffer(ctx context.Context, user, access string, offerURLs ...string) error
 }
 
+// GrantGroupAPI defines the API functions used by the grant command to grant
+// access to groups.
+type GrantGroupAPI interface {
+	Close() error
+	GrantGroup(ctx context.Context, group, access string, targets ...names.Tag) error
+}
+
 // Run implements cmd.Command.
 func (c *grantCommand) Run(ctx *cmd.Context) error {
-	if len(c.ModelNames) > 0 {
+	if c.Group != "" {
+		return c.runForGroup(ctx)
+	}
+	if len(c.ModelNames) > 0 || len(c.Applications) > 0 {
 		return c.runForModel(ctx)
 	}
 	if len(c.OfferURLs) > 0 {
@@ -256,6 +595,21 @@ func (c *grantCommand) Run(ctx *cmd.Context) error {
 	return c.runForController(ctx)
 }
 
+func (c *grantCommand) runForGroup(ctx context.Context) error {
+	client, err := c.getGroupAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	targets, err := c.groupTargets(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.GrantGroup(ctx, c.Group, c.Access, targets...)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
 func (c *grantCommand) runForController(ctx context.Context) error {
 	client, err := c.getControllerAPI(ctx)
 	if err != nil {
@@ -263,7 +617,12 @@ func (c *grantCommand) runForController(ctx context.Context) error {
 	}
 	defer client.Close()
 
-	return block.ProcessBlockedError(client.GrantController(ctx, c.User, c.Access), block.BlockChange)
+	if c.Expiry != nil {
+		err = client.GrantControllerUntil(ctx, c.User, c.Access, *c.Expiry)
+	} else {
+		err = client.GrantController(ctx, c.User, c.Access)
+	}
+	return block.ProcessBlockedError(err, block.BlockChange)
 }
 
 func (c *grantCommand) runForModel(ctx context.Context) error {
@@ -273,11 +632,31 @@ func (c *grantCommand) runForModel(ctx context.Context) error {
 	}
 	defer client.Close()
 
-	models, err := c.ModelUUIDs(ctx, c.ModelNames)
+	models, applications, err := c.resolveTargets(ctx)
 	if err != nil {
 		return err
 	}
-	return block.ProcessBlockedError(client.GrantModel(ctx, c.User, c.Access, models...), block.BlockChange)
+	if len(models) > 0 {
+		if c.Expiry != nil {
+			err = client.GrantModelUntil(ctx, c.User, c.Access, *c.Expiry, models...)
+		} else {
+			err = client.GrantModel(ctx, c.User, c.Access, models...)
+		}
+		if err != nil {
+			return block.ProcessBlockedError(err, block.BlockChange)
+		}
+	}
+	for _, app := range applications {
+		if c.Expiry != nil {
+			err = client.GrantApplicationUntil(ctx, c.User, c.Access, app.ModelUUID, app.Name, *c.Expiry)
+		} else {
+			err = client.GrantApplication(ctx, c.User, c.Access, app.ModelUUID, app.Name)
+		}
+		if err != nil {
+			return block.ProcessBlockedError(err, block.BlockChange)
+		}
+	}
+	return nil
 }
 
 func (c *grantCommand) runForOffers(ctx context.Context) error {
@@ -305,13 +684,14 @@ type revokeCommand struct {
 	accessCommand
 	modelsApi RevokeModelAPI
 	offersApi RevokeOfferAPI
+	groupsApi RevokeGroupAPI
 }
 
 // Info implements cmd.Command.
 func (c *revokeCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "revoke",
-		Args:     "<user name> <permission> [<model name> ... | <offer url> ...]",
+		Args:     "<user name> <permission> [<model name> | <model name>/<application name> ... | <offer url> ...]",
 		Purpose:  usageRevokeSummary,
 		Doc:      usageRevokeDetails,
 		Examples: usageRevokeExamples,
@@ -332,6 +712,13 @@ func (c *revokeCommand) getControllerAPI(ctx context.Context) (RevokeControllerA
 	return c.NewControllerAPIClient(ctx)
 }
 
+func (c *revokeCommand) getGroupAPI(ctx context.Context) (RevokeGroupAPI, error) {
+	if c.groupsApi != nil {
+		return c.groupsApi, nil
+	}
+	return c.NewUserManagerAPIClient(ctx)
+}
+
 func (c *revokeCommand) getOfferAPI(ctx context.Context) (RevokeOfferAPI, error) {
 	if c.offersApi != nil {
 		return c.offersApi, nil
@@ -347,6 +734,7 @@ func (c *revokeCommand) getOfferAPI(ctx context.Context) (RevokeOfferAPI, error)
 type RevokeModelAPI interface {
 	Close() error
 	RevokeModel(ctx context.Context, user, access string, modelUUIDs ...string) error
+	RevokeApplication(ctx context.Context, user, access, modelUUID, application string) error
 }
 
 // RevokeControllerAPI defines the API functions used by the revoke command.
@@ -361,9 +749,19 @@ type RevokeOfferAPI interface {
 	RevokeOffer(ctx context.Context, user, access string, offerURLs ...string) error
 }
 
+// RevokeGroupAPI defines the API functions used by the revoke command to
+// revoke access from groups.
+type RevokeGroupAPI interface {
+	Close() error
+	RevokeGroup(ctx context.Context, group, access string, targets ...names.Tag) error
+}
+
 // Run implements cmd.Command.
 func (c *revokeCommand) Run(ctx *cmd.Context) error {
-	if len(c.ModelNames) > 0 {
+	if c.Group != "" {
+		return c.runForGroup(ctx)
+	}
+	if len(c.ModelNames) > 0 || len(c.Applications) > 0 {
 		return c.runForModel(ctx)
 	}
 	if len(c.OfferURLs) > 0 {
@@ -375,6 +773,21 @@ func (c *revokeCommand) Run(ctx *cmd.Context) error {
 	return c.runForController(ctx)
 }
 
+func (c *revokeCommand) runForGroup(ctx context.Context) error {
+	client, err := c.getGroupAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	targets, err := c.groupTargets(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.RevokeGroup(ctx, c.Group, c.Access, targets...)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
 func (c *revokeCommand) runForController(ctx context.Context) error {
 	client, err := c.getControllerAPI(ctx)
 	if err != nil {
@@ -392,11 +805,21 @@ func (c *revokeCommand) runForModel(ctx context.Context) error {
 	}
 	defer client.Close()
 
-	models, err := c.ModelUUIDs(ctx, c.ModelNames)
+	models, applications, err := c.resolveTargets(ctx)
 	if err != nil {
 		return err
 	}
-	return block.ProcessBlockedError(client.RevokeModel(ctx, c.User, c.Access, models...), block.BlockChange)
+	if len(models) > 0 {
+		if err := client.RevokeModel(ctx, c.User, c.Access, models...); err != nil {
+			return block.ProcessBlockedError(err, block.BlockChange)
+		}
+	}
+	for _, app := range applications {
+		if err := client.RevokeApplication(ctx, c.User, c.Access, app.ModelUUID, app.Name); err != nil {
+			return block.ProcessBlockedError(err, block.BlockChange)
+		}
+	}
+	return nil
 }
 
 type accountDetailsGetter interface {
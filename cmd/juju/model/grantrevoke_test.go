@@ -7,7 +7,9 @@ import (
 	"context"
 	"strings"
 	stdtesting "testing"
+	"time"
 
+	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/jujuclient"
@@ -124,6 +126,36 @@ func (s *grantRevokeSuite) TestModelBlockGrant(c *tc.C) {
 	testing.AssertOperationWasBlocked(c, err, ".*TestBlockGrant.*")
 }
 
+func (s *grantRevokeSuite) TestApplicationAccess(c *tc.C) {
+	_, err := s.run(c, "sam", "run-action", "foo/mysql", "bob/model1/wordpress")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.fakeModelAPI.modelUUIDs, tc.HasLen, 0)
+	c.Assert(s.fakeModelAPI.applications, tc.DeepEquals, []fakeApplicationAccess{{
+		user: "sam", access: "run-action", modelUUID: fooModelUUID, application: "mysql",
+	}, {
+		user: "sam", access: "run-action", modelUUID: model1ModelUUID, application: "wordpress",
+	}})
+}
+
+func (s *grantRevokeSuite) TestModelAndApplicationAccess(c *tc.C) {
+	// bob/foo names a model, while foo/mysql names an application
+	// in model foo.
+	_, err := s.run(c, "sam", "write", "bob/foo", "foo/mysql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.fakeModelAPI.user, tc.Equals, "sam")
+	c.Assert(s.fakeModelAPI.access, tc.Equals, "write")
+	c.Assert(s.fakeModelAPI.modelUUIDs, tc.DeepEquals, []string{fooModelUUID})
+	c.Assert(s.fakeModelAPI.applications, tc.DeepEquals, []fakeApplicationAccess{{
+		user: "sam", access: "write", modelUUID: fooModelUUID, application: "mysql",
+	}})
+}
+
+func (s *grantRevokeSuite) TestApplicationBlockGrant(c *tc.C) {
+	s.fakeModelAPI.err = apiservererrors.OperationBlockedError("TestBlockGrant")
+	_, err := s.run(c, "sam", "scale", "foo/mysql")
+	testing.AssertOperationWasBlocked(c, err, ".*TestBlockGrant.*")
+}
+
 type grantSuite struct {
 	grantRevokeSuite
 }
@@ -203,6 +235,87 @@ func (s *revokeSuite) TestInit(c *tc.C) {
 
 }
 
+func (s *grantSuite) TestInitApplications(c *tc.C) {
+	wrappedCmd, grantCmd := model.NewGrantCommandForTest(nil, nil, s.store)
+	err := cmdtesting.InitCommand(wrappedCmd, []string{"bob", "config", "model1/mysql", "bob/model2/wordpress"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(grantCmd.ModelNames, tc.HasLen, 0)
+	c.Assert(grantCmd.Applications, tc.HasLen, 2)
+
+	// An ambiguous name is resolved when the command runs.
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"bob", "write", "model1/mysql"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(grantCmd.ModelNames, tc.DeepEquals, []string{"model1/mysql"})
+}
+
+func (s *grantSuite) TestInitApplicationsInvalid(c *tc.C) {
+	wrappedCmd, _ := model.NewGrantCommandForTest(nil, nil, s.store)
+	err := cmdtesting.InitCommand(wrappedCmd, []string{"bob", "refresh", "model1"})
+	c.Assert(err, tc.ErrorMatches, `application "model1", expected <model name>/<application name> not valid`)
+
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"bob", "consume", "bob/model1/mysql"})
+	c.Assert(err, tc.ErrorMatches, `"consume" application access not valid`)
+
+	err = cmdtesting.InitCommand(wrappedCmd, []string{"bob", "scale", "bob/model1/mysql", "fred/prod.mysql"})
+	c.Assert(err, tc.ErrorMatches, "either specify applications or offer URLs but not both")
+}
+
+func (s *grantSuite) TestApplicationAccessForController(c *tc.C) {
+	wrappedCmd, _ := model.NewGrantCommandForTest(nil, nil, s.store)
+	err := cmdtesting.InitCommand(wrappedCmd, []string{"bob", "run-action"})
+	msg := strings.Replace(err.Error(), "\n", "", -1)
+	c.Check(msg, tc.Matches, `You have specified an application access permission "run-action".*`)
+}
+
+func (s *grantSuite) TestModelAccessWithExpiry(c *tc.C) {
+	before := time.Now()
+	_, err := s.run(c, "sam", "admin", "foo", "foo/mysql", "--expires", "4h")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.fakeModelAPI.modelUUIDs, tc.DeepEquals, []string{fooModelUUID})
+	expiry := s.fakeModelAPI.expiry
+	c.Assert(expiry, tc.NotNil)
+	c.Check(expiry.Before(before.Add(4*time.Hour)), tc.IsFalse)
+	c.Check(expiry.After(time.Now().Add(4*time.Hour)), tc.IsFalse)
+	c.Assert(s.fakeModelAPI.applications, tc.DeepEquals, []fakeApplicationAccess{{
+		user: "sam", access: "admin", modelUUID: fooModelUUID, application: "mysql", expiry: expiry,
+	}})
+}
+
+func (s *grantSuite) TestModelAccessUntil(c *tc.C) {
+	_, err := s.run(c, "sam", "write", "foo", "--until", "2099-06-01T20:00:00+02:00")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.fakeModelAPI.modelUUIDs, tc.DeepEquals, []string{fooModelUUID})
+	expiry := time.Date(2099, 6, 1, 18, 0, 0, 0, time.UTC)
+	c.Assert(s.fakeModelAPI.expiry, tc.DeepEquals, &expiry)
+}
+
+func (s *grantSuite) TestInitExpiryInvalid(c *tc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"bob", "read", "model1", "--expires", "1h", "--until", "2099-01-01T00:00:00Z"},
+		err:  "either specify --expires or --until but not both",
+	}, {
+		args: []string{"bob", "read", "model1", "--expires", "-1h"},
+		err:  "--expires must be a positive duration",
+	}, {
+		args: []string{"bob", "read", "model1", "--until", "tomorrow"},
+		err:  `--until "tomorrow", expected an RFC3339 timestamp not valid`,
+	}, {
+		args: []string{"bob", "read", "model1", "--until", "2000-01-01T00:00:00Z"},
+		err:  "--until must be in the future",
+	}, {
+		args: []string{"bob", "consume", "fred/prod.hosted-mysql", "--expires", "1h"},
+		err:  "time-limited access to application offers is not supported",
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		wrappedCmd, _ := model.NewGrantCommandForTest(nil, nil, s.store)
+		err := cmdtesting.InitCommand(wrappedCmd, test.args)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
 func (s *grantSuite) TestModelAccessForController(c *tc.C) {
 	wrappedCmd, _ := model.NewRevokeCommandForTest(nil, nil, s.store)
 	err := cmdtesting.InitCommand(wrappedCmd, []string{"bob", "write"})
@@ -224,11 +337,84 @@ func (s *grantSuite) TestControllerAccessForOffer(c *tc.C) {
 	c.Check(msg, tc.Matches, `You have specified a controller access permission "superuser".*`)
 }
 
+func (s *grantSuite) TestGroupModelAccess(c *tc.C) {
+	api := &fakeGroupGrantRevokeAPI{}
+	wrappedCmd, grantCmd := model.NewGrantGroupCommandForTest(api, s.store)
+	_, err := cmdtesting.RunCommand(c, wrappedCmd, "group:sre", "admin", "foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(grantCmd.Group, tc.Equals, "sre")
+	c.Check(api.action, tc.Equals, "grant")
+	c.Check(api.group, tc.Equals, "sre")
+	c.Check(api.access, tc.Equals, "admin")
+	c.Check(api.targets, tc.DeepEquals, []names.Tag{
+		names.NewModelTag(fooModelUUID),
+		names.NewModelTag(barModelUUID),
+	})
+}
+
+func (s *grantSuite) TestGroupControllerAccess(c *tc.C) {
+	s.store.Controllers["test-master"] = jujuclient.ControllerDetails{
+		ControllerUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+	}
+	api := &fakeGroupGrantRevokeAPI{}
+	wrappedCmd, _ := model.NewGrantGroupCommandForTest(api, s.store)
+	_, err := cmdtesting.RunCommand(c, wrappedCmd, "group:sre", "superuser")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.targets, tc.DeepEquals, []names.Tag{
+		names.NewControllerTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"),
+	})
+}
+
+func (s *grantSuite) TestGroupInitInvalid(c *tc.C) {
+	for i, test := range []struct {
+		args     []string
+		errMatch string
+	}{{
+		args:     []string{"group:", "read", "foo"},
+		errMatch: "no group name specified",
+	}, {
+		args:     []string{"group:sre", "run-action", "foo/mysql"},
+		errMatch: "groups may only be given access to models and the controller",
+	}, {
+		args:     []string{"group:sre", "consume", "fred/model.offer1"},
+		errMatch: "groups may only be given access to models and the controller",
+	}, {
+		args:     []string{"group:sre", "read", "foo", "--expires", "1h"},
+		errMatch: "time-limited access for groups is not supported",
+	}} {
+		c.Logf("test %d, args %v", i, test.args)
+		wrappedCmd, _ := model.NewGrantGroupCommandForTest(nil, s.store)
+		err := cmdtesting.InitCommand(wrappedCmd, test.args)
+		c.Check(err, tc.ErrorMatches, test.errMatch)
+	}
+}
+
+func (s *revokeSuite) TestGroupModelAccess(c *tc.C) {
+	api := &fakeGroupGrantRevokeAPI{}
+	wrappedCmd, _ := model.NewRevokeGroupCommandForTest(api, s.store)
+	_, err := cmdtesting.RunCommand(c, wrappedCmd, "group:sre", "write", "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(api.action, tc.Equals, "revoke")
+	c.Check(api.group, tc.Equals, "sre")
+	c.Check(api.access, tc.Equals, "write")
+	c.Check(api.targets, tc.DeepEquals, []names.Tag{names.NewModelTag(fooModelUUID)})
+}
+
 type fakeModelGrantRevokeAPI struct {
-	err        error
-	user       string
-	access     string
-	modelUUIDs []string
+	err          error
+	user         string
+	access       string
+	modelUUIDs   []string
+	expiry       *time.Time
+	applications []fakeApplicationAccess
+}
+
+type fakeApplicationAccess struct {
+	user        string
+	access      string
+	modelUUID   string
+	application string
+	expiry      *time.Time
 }
 
 func (f *fakeModelGrantRevokeAPI) Close() error { return nil }
@@ -237,10 +423,41 @@ func (f *fakeModelGrantRevokeAPI) GrantModel(ctx context.Context, user, access s
 	return f.fake(user, access, modelUUIDs...)
 }
 
+func (f *fakeModelGrantRevokeAPI) GrantModelUntil(ctx context.Context, user, access string, expiry time.Time, modelUUIDs ...string) error {
+	f.expiry = &expiry
+	return f.fake(user, access, modelUUIDs...)
+}
+
 func (f *fakeModelGrantRevokeAPI) RevokeModel(ctx context.Context, user, access string, modelUUIDs ...string) error {
 	return f.fake(user, access, modelUUIDs...)
 }
 
+func (f *fakeModelGrantRevokeAPI) GrantApplication(ctx context.Context, user, access, modelUUID, application string) error {
+	return f.fakeApplication(user, access, modelUUID, application)
+}
+
+func (f *fakeModelGrantRevokeAPI) GrantApplicationUntil(ctx context.Context, user, access, modelUUID, application string, expiry time.Time) error {
+	if err := f.fakeApplication(user, access, modelUUID, application); err != nil {
+		return err
+	}
+	f.applications[len(f.applications)-1].expiry = &expiry
+	return nil
+}
+
+func (f *fakeModelGrantRevokeAPI) RevokeApplication(ctx context.Context, user, access, modelUUID, application string) error {
+	return f.fakeApplication(user, access, modelUUID, application)
+}
+
+func (f *fakeModelGrantRevokeAPI) fakeApplication(user, access, modelUUID, application string) error {
+	f.applications = append(f.applications, fakeApplicationAccess{
+		user:        user,
+		access:      access,
+		modelUUID:   modelUUID,
+		application: application,
+	})
+	return f.err
+}
+
 func (f *fakeModelGrantRevokeAPI) fake(user, access string, modelUUIDs ...string) error {
 	f.user = user
 	f.access = access
@@ -271,3 +488,28 @@ func (f *fakeOffersGrantRevokeAPI) fake(user, access string, offerURLs ...string
 	f.offerURLs = append(f.offerURLs, offerURLs...)
 	return f.err
 }
+
+type fakeGroupGrantRevokeAPI struct {
+	action  string
+	group   string
+	access  string
+	targets []names.Tag
+}
+
+func (f *fakeGroupGrantRevokeAPI) Close() error { return nil }
+
+func (f *fakeGroupGrantRevokeAPI) GrantGroup(ctx context.Context, group, access string, targets ...names.Tag) error {
+	return f.fake("grant", group, access, targets)
+}
+
+func (f *fakeGroupGrantRevokeAPI) RevokeGroup(ctx context.Context, group, access string, targets ...names.Tag) error {
+	return f.fake("revoke", group, access, targets)
+}
+
+func (f *fakeGroupGrantRevokeAPI) fake(action, group, access string, targets []names.Tag) error {
+	f.action = action
+	f.group = group
+	f.access = access
+	f.targets = targets
+	return nil
+}
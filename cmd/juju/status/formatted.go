@@ -139,6 +139,17 @@ type applicationStatus struct {
 	Units            map[string]unitStatus                  `json:"units,omitempty" yaml:"units,omitempty"`
 	Version          string                                 `json:"version,omitempty" yaml:"version,omitempty"`
 	EndpointBindings map[string]string                      `json:"endpoint-bindings,omitempty" yaml:"endpoint-bindings,omitempty"`
+	RefreshRollout   *refreshRolloutStatus                  `json:"refresh-rollout,omitempty" yaml:"refresh-rollout,omitempty"`
+}
+
+type refreshRolloutStatus struct {
+	Status           string `json:"status" yaml:"status"`
+	Message          string `json:"message,omitempty" yaml:"message,omitempty"`
+	CharmRev         int    `json:"charm-rev" yaml:"charm-rev"`
+	PreviousCharmRev int    `json:"previous-charm-rev" yaml:"previous-charm-rev"`
+	UnitsRefreshed   int    `json:"units-refreshed" yaml:"units-refreshed"`
+	UnitsTotal       int    `json:"units-total" yaml:"units-total"`
+	BatchSize        string `json:"batch-size" yaml:"batch-size"`
 }
 
 type applicationStatusRelation struct {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 
 	"github.com/juju/names/v6"
 
@@ -196,6 +197,30 @@ func (sf *statusFormatter) formatMachine(machine params.MachineStatus) machineSt
 	return out
 }
 
+func formatRefreshRollout(rollout *params.RefreshRolloutStatus) *refreshRolloutStatus {
+	if rollout == nil {
+		return nil
+	}
+	batchSize := strconv.Itoa(rollout.BatchSize)
+	if rollout.BatchSize == 0 {
+		batchSize = fmt.Sprintf("%d%%", rollout.BatchPercent)
+	}
+	out := &refreshRolloutStatus{
+		Status:         rollout.Status,
+		Message:        rollout.Message,
+		UnitsRefreshed: rollout.UnitsRefreshed,
+		UnitsTotal:     rollout.UnitsTotal,
+		BatchSize:      batchSize,
+	}
+	if curl, err := charm.ParseURL(rollout.Charm); err == nil {
+		out.CharmRev = curl.Revision
+	}
+	if curl, err := charm.ParseURL(rollout.PreviousCharm); err == nil {
+		out.PreviousCharmRev = curl.Revision
+	}
+	return out
+}
+
 func (sf *statusFormatter) formatApplication(name string, application params.ApplicationStatus) applicationStatus {
 	var (
 		charmAlias  = ""
@@ -250,6 +275,7 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 		StatusInfo:       sf.getApplicationStatusInfo(application),
 		Version:          application.WorkloadVersion,
 		EndpointBindings: application.EndpointBindings,
+		RefreshRollout:   formatRefreshRollout(application.RefreshRollout),
 	}
 
 	for k, m := range application.Units {
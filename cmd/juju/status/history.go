@@ -368,6 +368,11 @@ func (c *statusHistoryCommand) getStatusHistoryClients(ctx context.Context, warn
 
 	var clients []HistoryAPI
 	for _, details := range controllers {
+		if len(details.APIEndpoints) == 0 {
+			// The controller is still coming up, so it has no status
+			// history to serve yet.
+			continue
+		}
 		client, err := getStatusHistoryClientForAddresses(ctx, c, details.APIEndpoints)
 		if len(controllers) > 1 && errors.Is(err, api.ConnectionFailure) {
 			warningLogger.Warningf("cannot connect to status history client for controller %q at addresses %v: %v", details.ControllerID, details.APIEndpoints, err)
@@ -130,6 +130,20 @@ func endSection(tw *ansiterm.TabWriter) {
 	tw.Flush()
 }
 
+// refreshRolloutMessage prefixes an application's status message with the
+// progress of its staged refresh.
+func refreshRolloutMessage(rollout *refreshRolloutStatus, message string) string {
+	progress := fmt.Sprintf("refresh to rev %d %s (%d/%d units)",
+		rollout.CharmRev, strings.ReplaceAll(rollout.Status, "-", " "), rollout.UnitsRefreshed, rollout.UnitsTotal)
+	if rollout.Message != "" {
+		progress += ": " + rollout.Message
+	}
+	if message == "" {
+		return progress
+	}
+	return progress + "; " + message
+}
+
 func printApplications(tw *ansiterm.TabWriter, fs formattedStatus) {
 	maxVersionWidth := iaasMaxVersionWidth
 	if fs.Model.Type == caasModelType {
@@ -225,7 +239,11 @@ func printApplications(tw *ansiterm.TabWriter, fs formattedStatus) {
 			w.Print("no")
 		}
 
-		w.PrintColorNoTab(output.EmphasisHighlight.Gray, truncateMessage(app.StatusInfo.Message))
+		message := app.StatusInfo.Message
+		if app.RefreshRollout != nil {
+			message = refreshRolloutMessage(app.RefreshRollout, message)
+		}
+		w.PrintColorNoTab(output.EmphasisHighlight.Gray, truncateMessage(message))
 		w.Println()
 		maps.Copy(units, app.Units)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/tc"
 
 	jujutesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
 )
 
 func TestOutputTabularSuite(t *testing.T) {
@@ -49,3 +50,38 @@ func (s *outputTabularSuite) TestFormatOnelinePortsGroupedNumerically(c *tc.C) {
 
 	c.Assert(buff.String(), tc.Contains, "9998-10000,10002-10004/tcp")
 }
+
+func (s *outputTabularSuite) TestFormatTabularRefreshRollout(c *tc.C) {
+	fs := formattedStatus{
+		Applications: map[string]applicationStatus{
+			"app": {
+				StatusInfo: statusInfoContents{
+					Message: "ready",
+				},
+				RefreshRollout: formatRefreshRollout(&params.RefreshRolloutStatus{
+					Status:         "paused",
+					Message:        "unit app/1 is in error",
+					Charm:          "ch:amd64/app-7",
+					PreviousCharm:  "ch:amd64/app-6",
+					UnitsRefreshed: 2,
+					UnitsTotal:     4,
+					BatchPercent:   50,
+				}),
+			},
+		},
+	}
+	c.Check(fs.Applications["app"].RefreshRollout, tc.DeepEquals, &refreshRolloutStatus{
+		Status:           "paused",
+		Message:          "unit app/1 is in error",
+		CharmRev:         7,
+		PreviousCharmRev: 6,
+		UnitsRefreshed:   2,
+		UnitsTotal:       4,
+		BatchSize:        "50%",
+	})
+
+	buff := &bytes.Buffer{}
+	err := FormatTabular(buff, false, fs)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buff.String(), tc.Contains, "refresh to rev 7 paused (2/4 units): unit app/1 is in error; ready")
+}
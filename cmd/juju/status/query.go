@@ -0,0 +1,403 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/cmd/internal/query"
+)
+
+// queryFilter filters a formatted status down to the units matching a
+// query, along with the applications, machines, relations and offers
+// related to them.
+type queryFilter struct {
+	query  query.Query
+	status formattedStatus
+	model  query.Scope
+
+	units        map[string]bool
+	applications map[string]bool
+	machines     map[string]bool
+}
+
+// filterStatus returns the status of the units matching the query. Each unit,
+// including subordinate units, is evaluated in a scope holding the fields of
+// the unit as they are named in the JSON output, along with:
+//
+//	name         the name of the unit
+//	principal    the name of the principal unit of a subordinate, or null
+//	application  the fields of the application of the unit, and its name
+//	machine      the fields of the machine hosting the unit, and its id
+//	model        the fields of the model
+//
+// An application without any units is evaluated once, in a scope in which
+// the name and every field of the unit are null, so that it can be matched
+// by the fields of the application and the model.
+func filterStatus(status formattedStatus, q query.Query) (formattedStatus, error) {
+	model, err := newScope(status.Model)
+	if err != nil {
+		return formattedStatus{}, errors.Trace(err)
+	}
+	f := &queryFilter{
+		query:        q,
+		status:       status,
+		model:        model,
+		units:        make(map[string]bool),
+		applications: make(map[string]bool),
+		machines:     make(map[string]bool),
+	}
+	if err := f.matchUnits(); err != nil {
+		return formattedStatus{}, errors.Trace(err)
+	}
+	if err := f.matchApplicationsWithoutUnits(); err != nil {
+		return formattedStatus{}, errors.Trace(err)
+	}
+	return f.filtered(), nil
+}
+
+// projectStatus evaluates the fields for each unit in the status, including
+// subordinate units, in the scope used by filterStatus. The result is keyed
+// on the unit name, and then on the field as it was input. Applications
+// without any units are keyed on the application name.
+func projectStatus(status formattedStatus, fields []query.Query) (map[string]map[string]any, error) {
+	model, err := newScope(status.Model)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	f := &queryFilter{
+		status: status,
+		model:  model,
+	}
+
+	result := make(map[string]map[string]any)
+	project := func(key string, scope query.Scope) error {
+		values := make(map[string]any)
+		for _, field := range fields {
+			value, err := field.Eval(scope)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			values[field.String()] = value
+		}
+		result[key] = values
+		return nil
+	}
+
+	for appName, app := range status.Applications {
+		for unitName, unit := range app.Units {
+			scope, err := f.unitScope(unitName, appName, unit, "", unit.Machine)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if err := project(unitName, scope); err != nil {
+				return nil, errors.Trace(err)
+			}
+			for subName, sub := range unit.Subordinates {
+				subAppName, _ := names.UnitApplication(subName)
+				scope, err := f.unitScope(subName, subAppName, sub, unitName, unit.Machine)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				if err := project(subName, scope); err != nil {
+					return nil, errors.Trace(err)
+				}
+			}
+		}
+	}
+	for _, appName := range f.applicationsWithoutUnits() {
+		scope, err := f.unitScope("", appName, unitStatus{}, "", "")
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := project(appName, scope); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return result, nil
+}
+
+func (f *queryFilter) matchUnits() error {
+	for appName, app := range f.status.Applications {
+		for unitName, unit := range app.Units {
+			matched, err := f.match(unitName, appName, unit, "", unit.Machine)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for subName, sub := range unit.Subordinates {
+				subAppName, _ := names.UnitApplication(subName)
+				subMatched, err := f.match(subName, subAppName, sub, unitName, unit.Machine)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				if subMatched {
+					f.keepUnit(subName, unit.Machine)
+					matched = true
+				}
+			}
+			if matched {
+				f.keepUnit(unitName, unit.Machine)
+			}
+		}
+	}
+	return nil
+}
+
+func (f *queryFilter) matchApplicationsWithoutUnits() error {
+	for _, appName := range f.applicationsWithoutUnits() {
+		matched, err := f.match("", appName, unitStatus{}, "", "")
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if matched {
+			f.applications[appName] = true
+		}
+	}
+	return nil
+}
+
+// applicationsWithoutUnits returns the names of the applications that have
+// no units, neither as principal units nor as subordinates of other units.
+func (f *queryFilter) applicationsWithoutUnits() []string {
+	withUnits := make(map[string]bool)
+	for appName, app := range f.status.Applications {
+		for _, unit := range app.Units {
+			withUnits[appName] = true
+			for subName := range unit.Subordinates {
+				if subAppName, err := names.UnitApplication(subName); err == nil {
+					withUnits[subAppName] = true
+				}
+			}
+		}
+	}
+	var result []string
+	for appName := range f.status.Applications {
+		if !withUnits[appName] {
+			result = append(result, appName)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (f *queryFilter) keepUnit(unitName, machineID string) {
+	f.units[unitName] = true
+	if appName, err := names.UnitApplication(unitName); err == nil {
+		f.applications[appName] = true
+	}
+	f.keepMachine(machineID)
+}
+
+// keepMachine keeps the machine with the given id, and the machines it is a
+// container of.
+func (f *queryFilter) keepMachine(id string) {
+	for id != "" {
+		f.machines[id] = true
+		if !names.IsContainerMachine(id) {
+			return
+		}
+		id = names.NewMachineTag(id).Parent().Id()
+	}
+}
+
+func (f *queryFilter) match(unitName, appName string, unit unitStatus, principal, machineID string) (bool, error) {
+	scope, err := f.unitScope(unitName, appName, unit, principal, machineID)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return f.query.Run(scope)
+}
+
+// unitScope returns the scope in which a unit is evaluated. The unit name is
+// empty when evaluating an application without units.
+func (f *queryFilter) unitScope(unitName, appName string, unit unitStatus, principal, machineID string) (query.Scope, error) {
+	scope, err := newScope(unit)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	scope["name"] = nil
+	if unitName != "" {
+		scope["name"] = unitName
+	}
+	scope["principal"] = nil
+	if principal != "" {
+		scope["principal"] = principal
+	}
+	scope["model"] = f.model
+
+	scope["application"] = nil
+	if app, ok := f.status.Applications[appName]; ok {
+		appScope, err := newScope(app)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		appScope["name"] = appName
+		scope["application"] = map[string]any(appScope)
+	}
+
+	scope["machine"] = nil
+	if machine, ok := findMachine(f.status.Machines, machineID); ok {
+		machineScope, err := newScope(machine)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		machineScope["id"] = machineID
+		scope["machine"] = map[string]any(machineScope)
+	}
+	return scope, nil
+}
+
+func (f *queryFilter) filtered() formattedStatus {
+	out := f.status
+	out.Applications = make(map[string]applicationStatus)
+	for appName, app := range f.status.Applications {
+		if !f.applications[appName] {
+			continue
+		}
+		if len(app.Units) > 0 {
+			app.Units = f.filterUnits(app.Units)
+		}
+		out.Applications[appName] = app
+	}
+	out.Machines = f.filterMachines(f.status.Machines)
+
+	if f.status.Offers != nil {
+		out.Offers = make(map[string]offerStatus)
+		for name, offer := range f.status.Offers {
+			if f.applications[offer.ApplicationName] {
+				out.Offers[name] = offer
+			}
+		}
+	}
+
+	if f.status.RemoteApplications != nil {
+		out.RemoteApplications = make(map[string]remoteApplicationStatus)
+		for name, app := range f.status.RemoteApplications {
+			if f.relatedToKept(app.Relations) {
+				out.RemoteApplications[name] = app
+			}
+		}
+	}
+
+	// Only show relations between the applications which are kept.
+	out.Relations = nil
+	for _, rel := range f.status.Relations {
+		provider, requirer := endpointApplication(rel.Provider), endpointApplication(rel.Requirer)
+		if f.isKept(out, provider) && f.isKept(out, requirer) {
+			out.Relations = append(out.Relations, rel)
+		}
+	}
+	return out
+}
+
+func (f *queryFilter) filterUnits(units map[string]unitStatus) map[string]unitStatus {
+	out := make(map[string]unitStatus)
+	for name, unit := range units {
+		if !f.units[name] {
+			continue
+		}
+		if len(unit.Subordinates) > 0 {
+			unit.Subordinates = f.filterUnits(unit.Subordinates)
+		}
+		out[name] = unit
+	}
+	return out
+}
+
+func (f *queryFilter) filterMachines(machines map[string]machineStatus) map[string]machineStatus {
+	out := make(map[string]machineStatus)
+	for id, machine := range machines {
+		if !f.machines[id] {
+			continue
+		}
+		if len(machine.Containers) > 0 {
+			machine.Containers = f.filterMachines(machine.Containers)
+		}
+		out[id] = machine
+	}
+	return out
+}
+
+func (f *queryFilter) isKept(out formattedStatus, app string) bool {
+	if f.applications[app] {
+		return true
+	}
+	_, ok := out.RemoteApplications[app]
+	return ok
+}
+
+func (f *queryFilter) relatedToKept(relations map[string][]string) bool {
+	for _, apps := range relations {
+		for _, app := range apps {
+			if f.applications[app] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// endpointApplication returns the application of an endpoint formatted as
+// <application>:<endpoint>.
+func endpointApplication(endpoint string) string {
+	app, _, _ := strings.Cut(endpoint, ":")
+	return app
+}
+
+func findMachine(machines map[string]machineStatus, id string) (machineStatus, bool) {
+	if id == "" {
+		return machineStatus{}, false
+	}
+	if machine, ok := machines[id]; ok {
+		return machine, true
+	}
+	for _, machine := range machines {
+		if found, ok := findMachine(machine.Containers, id); ok {
+			return found, true
+		}
+	}
+	return machineStatus{}, false
+}
+
+// newScope returns the fields of an entity, named as they are in the JSON
+// output, as a query scope. Fields omitted from the output because they are
+// empty are included, so that queries can refer to them: booleans are false,
+// numbers are 0 and all other fields are null.
+func newScope(entity any) (query.Scope, error) {
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	scope := make(query.Scope)
+	if err := json.Unmarshal(data, &scope); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	t := reflect.TypeOf(entity)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if _, ok := scope[name]; ok {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.Bool:
+			scope[name] = false
+		case reflect.Int, reflect.Int32, reflect.Int64, reflect.Float64:
+			scope[name] = float64(0)
+		default:
+			scope[name] = nil
+		}
+	}
+	return scope, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"encoding/json"
+
+	"github.com/juju/tc"
+)
+
+func (s *StatusSuite) TestStatusWithQuery(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+
+	code, stdout, stderr := runStatus(c, ctx, "--no-color", "--relations", "--query", `workload-status.current=="error"`)
+	c.Check(code, tc.Equals, 0)
+	c.Check(stderr, tc.Equals, "")
+
+	output := substituteFakeTimestamp(c, stdout, false)
+	output = substituteSpacingBetweenTimestampAndNotes(c, output)
+	c.Check(output, tc.Equals, `
+Model       Controller  Cloud/Region        Version  Timestamp       Notes
+controller  kontroll    dummy/dummy-region  1.2.3    15:04:05+07:00  upgrade available: 1.2.4
+
+App      Version          Status       Scale  Charm    Channel  Rev  Exposed  Message
+logging  a bit too lo...  error            1  logging  stable     1  yes      somehow lost in all those logs
+mysql    5.7.13           maintenance      1  mysql    stable     1  yes      installing all the things
+
+Unit          Workload     Agent  Machine  Public address  Ports  Message
+mysql/0*      maintenance  idle   2        10.0.2.1               installing all the things
+  logging/1*  error        idle            10.0.2.1               somehow lost in all those logs
+
+Machine  State    Address   Inst id       Base          AZ  Message
+2        started  10.0.2.1  controller-2  ubuntu@12.10      
+
+Offer         Application  Charm  Rev  Connected  Endpoint  Interface  Role
+hosted-mysql  mysql        mysql  1    1/1        server    mysql      provider
+
+Relation provider  Requirer      Interface  Type         Message
+mysql:juju-info    logging:info  juju-info  subordinate  
+`[1:])
+}
+
+func (s *StatusSuite) TestStatusWithQueryMachine(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+	ctx.api.expectIncludeStorage = true
+
+	code, stdout, stderr := runStatus(c, ctx, "--format", "json", "--query", `machine.dns-name=="10.0.1.1" && leader`)
+	c.Check(code, tc.Equals, 0)
+	c.Check(stderr, tc.Equals, "")
+
+	var out struct {
+		Applications map[string]struct {
+			Units map[string]any `json:"units"`
+		} `json:"applications"`
+		Machines map[string]any `json:"machines"`
+	}
+	c.Assert(json.Unmarshal([]byte(stdout), &out), tc.ErrorIsNil)
+	c.Assert(out.Applications, tc.HasLen, 1)
+	c.Check(out.Applications["wordpress"].Units, tc.HasLen, 1)
+	c.Check(out.Applications["wordpress"].Units["wordpress/0"], tc.NotNil)
+	c.Assert(out.Machines, tc.HasLen, 1)
+	c.Check(out.Machines["1"], tc.NotNil)
+}
+
+func (s *StatusSuite) TestStatusWithQueryNoMatch(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+
+	code, stdout, stderr := runStatus(c, ctx, "--no-color", "--query", `name=="mysql/9"`)
+	c.Check(code, tc.Equals, 0)
+	c.Check(stdout, tc.Equals, "")
+	c.Check(stderr, tc.Equals, "Nothing matched query \"name==\\\"mysql/9\\\"\".\n")
+}
+
+func (s *StatusSuite) TestStatusWithQueryApplicationWithoutUnits(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+	ctx.api.expectIncludeStorage = true
+	addApplication{name: "riak", charm: "riak"}.step(c, ctx)
+
+	code, stdout, stderr := runStatus(c, ctx, "--format", "json", "--query", `application.name=="riak" || workload-status.current=="error"`)
+	c.Check(code, tc.Equals, 0)
+	c.Check(stderr, tc.Equals, "")
+
+	var out struct {
+		Applications map[string]any `json:"applications"`
+	}
+	c.Assert(json.Unmarshal([]byte(stdout), &out), tc.ErrorIsNil)
+	c.Check(out.Applications, tc.HasLen, 3)
+	c.Check(out.Applications["riak"], tc.NotNil)
+	c.Check(out.Applications["logging"], tc.NotNil)
+	c.Check(out.Applications["mysql"], tc.NotNil)
+}
+
+func (s *StatusSuite) TestStatusWithFields(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+	ctx.api.expectIncludeStorage = true
+	addApplication{name: "riak", charm: "riak"}.step(c, ctx)
+
+	code, stdout, stderr := runStatus(c, ctx, "--format", "json",
+		"--query", `leader || application.name=="riak"`,
+		"--fields", "name, workload-status.current,machine.dns-name")
+	c.Check(code, tc.Equals, 0)
+	c.Check(stderr, tc.Equals, "")
+
+	var out map[string]map[string]any
+	c.Assert(json.Unmarshal([]byte(stdout), &out), tc.ErrorIsNil)
+	c.Check(out, tc.DeepEquals, map[string]map[string]any{
+		"mysql/0": {
+			"name":                    "mysql/0",
+			"workload-status.current": "maintenance",
+			"machine.dns-name":        "10.0.2.1",
+		},
+		"logging/1": {
+			"name":                    "logging/1",
+			"workload-status.current": "error",
+			"machine.dns-name":        "10.0.2.1",
+		},
+		"wordpress/0": {
+			"name":                    "wordpress/0",
+			"workload-status.current": "active",
+			"machine.dns-name":        "10.0.1.1",
+		},
+		"riak": {
+			"name":                    nil,
+			"workload-status.current": nil,
+			"machine.dns-name":        nil,
+		},
+	})
+}
+
+func (s *StatusSuite) TestStatusWithFieldsTabular(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+
+	code, _, stderr := runStatus(c, ctx, "--fields", "name")
+	c.Check(code, tc.Equals, 2)
+	c.Check(stderr, tc.Equals, "ERROR --fields requires yaml or json output, not tabular\n")
+}
+
+func (s *StatusSuite) TestStatusWithQueryInvalidIdentifier(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+
+	code, _, stderr := runStatus(c, ctx, "--no-color", "--query", `zone=="a"`)
+	c.Check(code, tc.Equals, 1)
+	c.Check(stderr, tc.Matches, `ERROR running query "zone==\\"a\\"": invalid identifier "zone", expected one of .*\n`)
+}
+
+func (s *StatusSuite) TestStatusWithInvalidQuery(c *tc.C) {
+	ctx := s.prepareTabularData(c)
+
+	code, _, stderr := runStatus(c, ctx, "--query", `name==`)
+	c.Check(code, tc.Equals, 2)
+	c.Check(stderr, tc.Equals, "ERROR parsing query \"name==\": unexpected end of query at position 6, expected value\n")
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/api/client/client"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/internal/query"
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/output"
@@ -52,6 +53,10 @@ type statusCommand struct {
 	modelcmd.ModelCommandBase
 	out       cmd.Output
 	patterns  []string
+	queryStr  string
+	query     query.Query
+	fieldsStr string
+	fields    []query.Query
 	isoTime   bool
 	statusAPI statusAPI
 	clock     Clock
@@ -86,6 +91,33 @@ When selectors are present, filter the report to exclude entities that do not
 match.
 
 
+### Querying the status
+
+The ` + "`--query`" + ` option filters the report to the units matching a query
+expression, along with the applications and machines they belong to. The query
+is evaluated for each unit, including subordinate units, and can refer to the
+fields of the unit as they are named in the ` + "`JSON`" + ` output, such as
+` + "`workload-status`" + ` and ` + "`leader`" + `, and to:
+
+- ` + "`name`" + `: the name of the unit
+- ` + "`principal`" + `: the principal unit of a subordinate unit, or null
+- ` + "`application`" + `: the fields of the application of the unit
+- ` + "`machine`" + `: the fields of the machine hosting the unit
+- ` + "`model`" + `: the fields of the model
+
+Fields are compared with ` + "`==`, `!=`, `<`, `<=`, `>` and `>=`" + `, strings
+are matched against a regular expression with ` + "`=~`" + `, and the results
+are combined with ` + "`&&`, `||` and `!`" + `. Fields of nested objects are
+selected with a dot, such as ` + "`workload-status.current`" + `. Fields which
+are not set are null, or false for flags. An application without units is
+evaluated once, with the name and the fields of the unit set to null.
+
+The ` + "`--fields`" + ` option reports only the given comma separated fields of
+each unit, in ` + "`yaml`" + ` or ` + "`json`" + ` output. Fields are named as in queries,
+and the result is keyed on the name of each unit, or on the name of the
+application for an application without units.
+
+
 ### Altering the output format
 
 The ` + "`--format`" + ` option allows you to specify how the status report is formatted.
@@ -124,6 +156,18 @@ Include information about storage and relations in output:
 Provide output as valid ` + "`JSON`" + `:
 
     juju status --format=json
+
+Report the status of units in error whose machine is in zone ` + "`a`" + `:
+
+    juju status --query 'workload-status.current=="error" && machine.hardware=~"availability-zone=a"'
+
+Report the status of the leader units of exposed applications:
+
+    juju status --query 'leader && application.exposed'
+
+Report the workload status message and machine address of units in error:
+
+    juju status --format=yaml --query 'workload-status.current=="error"' --fields workload-status.message,machine.dns-name
 `
 
 func (c *statusCommand) Info() *cmd.Info {
@@ -151,6 +195,8 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.integrations, "integrations", false, "Same as `--relations`")
 	f.BoolVar(&c.relations, "relations", false, "Show relations section in tabular output")
 	f.BoolVar(&c.storage, "storage", false, "Show storage section in tabular output")
+	f.StringVar(&c.queryStr, "query", "", "Only show the units matching a query, and the applications and machines they belong to")
+	f.StringVar(&c.fieldsStr, "fields", "", "Only show the given comma separated fields of each unit, in yaml or json output")
 
 	f.IntVar(&c.retryCount, "retry-count", 3, "Number of times to retry API failures")
 	f.DurationVar(&c.retryDelay, "retry-delay", 100*time.Millisecond, "Time to wait between retry attempts")
@@ -205,6 +251,27 @@ func (c *statusCommand) Init(args []string) error {
 		return errors.Errorf("cannot mix --no-color and --color")
 	}
 
+	if c.queryStr != "" {
+		q, err := query.Parse(c.queryStr)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.query = q
+	}
+
+	if c.fieldsStr != "" {
+		if format := c.out.Name(); format != "yaml" && format != "json" {
+			return errors.Errorf("--fields requires yaml or json output, not %s", format)
+		}
+		for _, field := range strings.Split(c.fieldsStr, ",") {
+			q, err := query.Parse(strings.TrimSpace(field))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			c.fields = append(c.fields, q)
+		}
+	}
+
 	return nil
 }
 
@@ -314,6 +381,23 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if c.queryStr != "" {
+		if formatted, err = filterStatus(formatted, c.query); err != nil {
+			return errors.Trace(err)
+		}
+		if len(formatted.Applications) == 0 && !status.IsEmpty() {
+			ctx.Infof("Nothing matched query %q.", c.queryStr)
+			return nil
+		}
+	}
+
+	if len(c.fields) > 0 {
+		projected, err := projectStatus(formatted, c.fields)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return c.out.Write(ctx, projected)
+	}
 
 	if err = c.out.Write(ctx, formatted); err != nil {
 		return err
@@ -134,3 +134,35 @@ func NewLoginCommandWithSessionLoginFactory(factory modelcmd.SessionLoginFactory
 	c.SetSessionLoginFactory(factory)
 	return modelcmd.WrapController(&c, modelcmd.WrapControllerSkipControllerFlags)
 }
+
+type AddGroupCommand struct {
+	*addGroupCommand
+}
+
+type GroupMembershipBase struct {
+	*groupMembershipBase
+}
+
+// NewAddGroupCommandForTest returns an add-group command with the api
+// provided as specified.
+func NewAddGroupCommandForTest(api AddGroupAPI, store jujuclient.ClientStore) (cmd.Command, *AddGroupCommand) {
+	c := &addGroupCommand{api: api}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c), &AddGroupCommand{c}
+}
+
+// NewAddToGroupCommandForTest returns an add-to-group command with the api
+// provided as specified.
+func NewAddToGroupCommandForTest(api GroupMembershipAPI, store jujuclient.ClientStore) (cmd.Command, *GroupMembershipBase) {
+	c := &addToGroupCommand{groupMembershipBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c), &GroupMembershipBase{&c.groupMembershipBase}
+}
+
+// NewRemoveFromGroupCommandForTest returns a remove-from-group command with
+// the api provided as specified.
+func NewRemoveFromGroupCommandForTest(api GroupMembershipAPI, store jujuclient.ClientStore) (cmd.Command, *GroupMembershipBase) {
+	c := &removeFromGroupCommand{groupMembershipBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c), &GroupMembershipBase{&c.groupMembershipBase}
+}
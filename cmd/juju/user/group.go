@@ -0,0 +1,243 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var usageAddGroupSummary = `
+Adds a user group to the controller.`[1:]
+
+var usageAddGroupDetails = `
+A user group collects Juju users so that access to models and the controller
+can be granted to all of them at once. Access is granted to a group with
+` + "`juju grant group:<group name>`" + `, and each member of the group has the
+greater of the access granted to them directly and the access granted to any
+of their groups.
+
+Only controller superusers may add groups.
+`[1:]
+
+const usageAddGroupExamples = `
+    juju add-group sre
+    juju add-to-group sre alice bob
+    juju grant group:sre admin mymodel
+`
+
+var usageAddToGroupSummary = `
+Adds Juju users to a user group.`[1:]
+
+var usageAddToGroupDetails = `
+The users gain any access granted to the group. Only controller superusers
+may change the membership of groups.
+`[1:]
+
+const usageAddToGroupExamples = `
+    juju add-to-group sre alice
+    juju add-to-group sre alice bob
+`
+
+var usageRemoveFromGroupSummary = `
+Removes Juju users from a user group.`[1:]
+
+var usageRemoveFromGroupDetails = `
+The users lose any access granted to the group, while keeping any access
+granted to them directly. Only controller superusers may change the
+membership of groups.
+`[1:]
+
+const usageRemoveFromGroupExamples = `
+    juju remove-from-group sre alice
+`
+
+// AddGroupAPI defines the API methods that the add-group command uses.
+type AddGroupAPI interface {
+	AddGroup(ctx context.Context, name string) error
+	Close() error
+}
+
+// NewAddGroupCommand returns a command to add a user group.
+func NewAddGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&addGroupCommand{})
+}
+
+// addGroupCommand adds a user group to the controller.
+type addGroupCommand struct {
+	modelcmd.ControllerCommandBase
+	api   AddGroupAPI
+	Group string
+}
+
+// Info implements Command.Info.
+func (c *addGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-group",
+		Args:     "<group name>",
+		Purpose:  usageAddGroupSummary,
+		Doc:      usageAddGroupDetails,
+		Examples: usageAddGroupExamples,
+		SeeAlso: []string{
+			"add-to-group",
+			"remove-from-group",
+			"grant",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *addGroupCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no group name supplied")
+	}
+	c.Group = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *addGroupCommand) Run(ctx *cmd.Context) error {
+	if c.api == nil {
+		api, err := c.NewUserManagerAPIClient(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.api = api
+		defer c.api.Close()
+	}
+
+	if err := c.api.AddGroup(ctx, c.Group); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Group %q added", c.Group)
+	return nil
+}
+
+// GroupMembershipAPI defines the API methods that the add-to-group and
+// remove-from-group commands use.
+type GroupMembershipAPI interface {
+	AddToGroup(ctx context.Context, group string, usernames ...string) error
+	RemoveFromGroup(ctx context.Context, group string, usernames ...string) error
+	Close() error
+}
+
+// groupMembershipBase holds the code common to the add-to-group and
+// remove-from-group commands.
+type groupMembershipBase struct {
+	modelcmd.ControllerCommandBase
+	api   GroupMembershipAPI
+	Group string
+	Users []string
+}
+
+// Init implements Command.Init.
+func (c *groupMembershipBase) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no group name supplied")
+	}
+	if len(args) == 1 {
+		return errors.New("no username supplied")
+	}
+	c.Group = args[0]
+	for _, username := range args[1:] {
+		if !names.IsValidUser(username) {
+			return errors.NotValidf("username %q", username)
+		}
+	}
+	c.Users = args[1:]
+	return nil
+}
+
+func (c *groupMembershipBase) getAPI(ctx context.Context) (GroupMembershipAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewUserManagerAPIClient(ctx)
+}
+
+// NewAddToGroupCommand returns a command to add users to a user group.
+func NewAddToGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&addToGroupCommand{})
+}
+
+// addToGroupCommand adds users to a user group.
+type addToGroupCommand struct {
+	groupMembershipBase
+}
+
+// Info implements Command.Info.
+func (c *addToGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-to-group",
+		Args:     "<group name> <user name> ...",
+		Purpose:  usageAddToGroupSummary,
+		Doc:      usageAddToGroupDetails,
+		Examples: usageAddToGroupExamples,
+		SeeAlso: []string{
+			"add-group",
+			"remove-from-group",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *addToGroupCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddToGroup(ctx, c.Group, c.Users...); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return nil
+}
+
+// NewRemoveFromGroupCommand returns a command to remove users from a user
+// group.
+func NewRemoveFromGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&removeFromGroupCommand{})
+}
+
+// removeFromGroupCommand removes users from a user group.
+type removeFromGroupCommand struct {
+	groupMembershipBase
+}
+
+// Info implements Command.Info.
+func (c *removeFromGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-from-group",
+		Args:     "<group name> <user name> ...",
+		Purpose:  usageRemoveFromGroupSummary,
+		Doc:      usageRemoveFromGroupDetails,
+		Examples: usageRemoveFromGroupExamples,
+		SeeAlso: []string{
+			"add-group",
+			"add-to-group",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *removeFromGroupCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemoveFromGroup(ctx, c.Group, c.Users...); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/juju/user"
+)
+
+type GroupSuite struct {
+	BaseSuite
+	mock *mockGroupAPI
+}
+
+func TestGroupSuite(t *testing.T) {
+	tc.Run(t, &GroupSuite{})
+}
+
+func (s *GroupSuite) SetUpTest(c *tc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.mock = &mockGroupAPI{}
+}
+
+func (s *GroupSuite) TestAddGroupInit(c *tc.C) {
+	wrappedCommand, command := user.NewAddGroupCommandForTest(nil, s.store)
+	err := cmdtesting.InitCommand(wrappedCommand, nil)
+	c.Assert(err, tc.ErrorMatches, "no group name supplied")
+
+	wrappedCommand, command = user.NewAddGroupCommandForTest(nil, s.store)
+	err = cmdtesting.InitCommand(wrappedCommand, []string{"sre", "ops"})
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["ops"\]`)
+
+	wrappedCommand, command = user.NewAddGroupCommandForTest(nil, s.store)
+	err = cmdtesting.InitCommand(wrappedCommand, []string{"sre"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(command.Group, tc.Equals, "sre")
+}
+
+func (s *GroupSuite) TestAddGroup(c *tc.C) {
+	addGroupCommand, _ := user.NewAddGroupCommandForTest(s.mock, s.store)
+	ctx, err := cmdtesting.RunCommand(c, addGroupCommand, "sre")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.mock.added, tc.Equals, "sre")
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "Group \"sre\" added\n")
+}
+
+func (s *GroupSuite) TestAddGroupError(c *tc.C) {
+	s.mock.err = errors.New("group already exists")
+	addGroupCommand, _ := user.NewAddGroupCommandForTest(s.mock, s.store)
+	_, err := cmdtesting.RunCommand(c, addGroupCommand, "sre")
+	c.Assert(err, tc.ErrorMatches, "group already exists")
+}
+
+func (s *GroupSuite) TestGroupMembershipInit(c *tc.C) {
+	for i, test := range []struct {
+		args     []string
+		errMatch string
+		users    []string
+	}{
+		{
+			errMatch: "no group name supplied",
+		}, {
+			args:     []string{"sre"},
+			errMatch: "no username supplied",
+		}, {
+			args:     []string{"sre", "not/valid"},
+			errMatch: `username "not/valid" not valid`,
+		}, {
+			args:  []string{"sre", "alice", "bob"},
+			users: []string{"alice", "bob"},
+		},
+	} {
+		c.Logf("test %d, args %v", i, test.args)
+		wrappedCommand, command := user.NewAddToGroupCommandForTest(nil, s.store)
+		err := cmdtesting.InitCommand(wrappedCommand, test.args)
+		if test.errMatch == "" {
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(command.Group, tc.Equals, "sre")
+			c.Check(command.Users, tc.DeepEquals, test.users)
+		} else {
+			c.Assert(err, tc.ErrorMatches, test.errMatch)
+		}
+	}
+}
+
+func (s *GroupSuite) TestAddToGroup(c *tc.C) {
+	addToGroupCommand, _ := user.NewAddToGroupCommandForTest(s.mock, s.store)
+	_, err := cmdtesting.RunCommand(c, addToGroupCommand, "sre", "alice", "bob")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.mock.group, tc.Equals, "sre")
+	c.Check(s.mock.addedUsers, tc.DeepEquals, []string{"alice", "bob"})
+}
+
+func (s *GroupSuite) TestRemoveFromGroup(c *tc.C) {
+	removeFromGroupCommand, _ := user.NewRemoveFromGroupCommandForTest(s.mock, s.store)
+	_, err := cmdtesting.RunCommand(c, removeFromGroupCommand, "sre", "alice")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.mock.group, tc.Equals, "sre")
+	c.Check(s.mock.removedUsers, tc.DeepEquals, []string{"alice"})
+}
+
+type mockGroupAPI struct {
+	added        string
+	group        string
+	addedUsers   []string
+	removedUsers []string
+	err          error
+}
+
+func (m *mockGroupAPI) Close() error {
+	return nil
+}
+
+func (m *mockGroupAPI) AddGroup(ctx context.Context, name string) error {
+	m.added = name
+	return m.err
+}
+
+func (m *mockGroupAPI) AddToGroup(ctx context.Context, group string, usernames ...string) error {
+	m.group = group
+	m.addedUsers = usernames
+	return m.err
+}
+
+func (m *mockGroupAPI) RemoveFromGroup(ctx context.Context, group string, usernames ...string) error {
+	m.group = group
+	m.removedUsers = usernames
+	return m.err
+}
@@ -5,6 +5,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
@@ -23,10 +24,12 @@ import (
 var helpSummary = `
 Show information about a user.`[1:]
 
-var helpDetails = `
-By default, the ` + "`YAML`" + ` format is used and the user name is the current
+var helpDetails = `By default, the ` + "`YAML`" + ` format is used and the user name is the current
 user.
-`[1:]
+
+Access granted for a limited time with ` + "`juju grant --expires`" + ` is listed
+along with the time at which it expires.
+`
 
 const helpExamples = `
     juju show-user
@@ -76,6 +79,17 @@ type UserInfo struct {
 	DateCreated    string `yaml:"date-created,omitempty" json:"date-created,omitempty"`
 	LastConnection string `yaml:"last-connection,omitempty" json:"last-connection,omitempty"`
 	Disabled       bool   `yaml:"disabled,omitempty" json:"disabled,omitempty"`
+
+	TimeLimitedAccess []TimeLimitedAccess `yaml:"time-limited-access,omitempty" json:"time-limited-access,omitempty"`
+}
+
+// TimeLimitedAccess defines the serialization behaviour of an access grant
+// which expires automatically.
+type TimeLimitedAccess struct {
+	Type    string `yaml:"type" json:"type"`
+	Target  string `yaml:"target" json:"target"`
+	Access  string `yaml:"access" json:"access"`
+	Expires string `yaml:"expires" json:"expires"`
 }
 
 // Info implements Command.Info.
@@ -152,6 +166,14 @@ func (c *infoCommandBase) apiUsersToUserInfoSlice(users []params.UserInfo) []Use
 			Access:      info.Access,
 			Disabled:    info.Disabled,
 		}
+		for _, access := range info.TimeLimitedAccess {
+			outInfo.TimeLimitedAccess = append(outInfo.TimeLimitedAccess, TimeLimitedAccess{
+				Type:    access.ObjectType,
+				Target:  access.Key,
+				Access:  access.Access,
+				Expires: access.Expiry.UTC().Format(time.RFC3339),
+			})
+		}
 		// TODO(wallyworld) record login information about external users.
 		if names.NewUserTag(info.Username).IsLocal() {
 			outInfo.LastConnection = common.LastConnection(info.LastConnection, now, c.exactTime)
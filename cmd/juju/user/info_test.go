@@ -66,6 +66,15 @@ func (*fakeUserInfoAPI) UserInfo(ctx context.Context, usernames []string, all us
 		info.Username = "fred@external"
 		info.DisplayName = "Fred External"
 		info.Access = "add-model"
+	case "contractor":
+		info.Username = "contractor"
+		info.Access = "login"
+		info.TimeLimitedAccess = []params.TimeLimitedAccess{{
+			ObjectType: "model",
+			Key:        "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+			Access:     "admin",
+			Expiry:     time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC),
+		}}
 	default:
 		return nil, apiservererrors.ErrPerm
 	}
@@ -112,6 +121,21 @@ access: add-model
 `)
 }
 
+func (s *UserInfoCommandSuite) TestUserInfoTimeLimitedAccess(c *tc.C) {
+	context, err := cmdtesting.RunCommand(c, s.NewShowUserCommand(), "contractor")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(context), tc.Equals, `user-name: contractor
+access: login
+date-created: "1981-02-27"
+last-connection: "2014-01-01"
+time-limited-access:
+- type: model
+  target: deadbeef-0bad-400d-8000-4b1d0d06f00d
+  access: admin
+  expires: "2030-01-01T12:00:00Z"
+`)
+}
+
 func (s *UserInfoCommandSuite) TestUserInfoUserDoesNotExist(c *tc.C) {
 	_, err := cmdtesting.RunCommand(c, s.NewShowUserCommand(), "barfoo")
 	c.Assert(err, tc.ErrorMatches, "permission denied")
@@ -60,6 +60,12 @@ After login, a token ('macaroon') will become active. It has an expiration
 time of 24 hours. Upon expiration, no further ` + "`juju`" + ` commands can be issued
 and the user will be prompted to log in again.
 
+If the ` + "`--oidc`" + ` option is provided, the ` + "`juju login`" + ` command will log
+into the controller through the OpenID Connect identity provider configured
+with the ` + "`oidc-issuer-url`" + ` controller configuration key. The user is
+asked to visit a URL and enter a code to complete the login, after which the
+identity provider's session token is used for subsequent commands.
+
 ### Aliases
 
 Public controller aliases are provided by a directory service
@@ -72,6 +78,7 @@ const loginExamples = `
     juju login somepubliccontroller
     juju login jimm.jujucharms.com
     juju login -u bob
+    juju login --oidc
 `
 
 // Functions defined as variables so they can be overridden in tests.
@@ -102,6 +109,7 @@ type loginCommand struct {
 	noPrompt         bool
 	noPromptPassword string
 	trust            bool
+	oidc             bool
 	pollster         *interact.Pollster
 
 	// controllerName holds the name of the current controller.
@@ -141,6 +149,7 @@ func (c *loginCommand) SetFlags(fset *gnuflag.FlagSet) {
 	fset.StringVar(&c.username, "user", "", "")
 	fset.BoolVar(&c.noPrompt, "no-prompt", false, "Don't prompt for password just read a line from `stdin`")
 	fset.BoolVar(&c.trust, "trust", false, "Automatically trust controller CA certificate")
+	fset.BoolVar(&c.oidc, "oidc", false, "Log in through the controller's OpenID Connect identity provider")
 }
 
 // Init implements Command.Init.
@@ -150,6 +159,12 @@ func (c *loginCommand) Init(args []string) error {
 		return errors.Trace(err)
 	}
 	c.domain = domain
+	if c.oidc && c.username != "" {
+		return errors.New("cannot specify a username with --oidc, the username is returned by the identity provider")
+	}
+	if c.oidc && c.domain != "" {
+		return errors.New("cannot log into a public controller with --oidc, use -c to choose an existing controller")
+	}
 	return nil
 }
 
@@ -269,6 +284,14 @@ use "juju unregister %s" to remove the existing controller.`[1:], c.domain, c.co
 		}
 	}
 
+	if c.oidc && !controllerDetails.OIDCLogin {
+		// Subsequent commands log in with the session token.
+		controllerDetails.OIDCLogin = true
+		if err := store.UpdateController(c.controllerName, *controllerDetails); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	if accountDetails == nil {
 		return errors.Trace(errors.New("failed to receive new account details"))
 	}
@@ -302,14 +325,35 @@ use "juju unregister %s" to remove the existing controller.`[1:], c.domain, c.co
 }
 
 func (c *loginCommand) existingControllerLogin(ctx *cmd.Context, store jujuclient.ClientStore, controllerName string, currentAccountDetails *jujuclient.AccountDetails) (api.Connection, *jujuclient.AccountDetails, error) {
+	var sessionToken string
+	if c.oidc && currentAccountDetails != nil {
+		sessionToken = currentAccountDetails.SessionToken
+	}
 	dial := func(accountDetails *jujuclient.AccountDetails) (api.Connection, error) {
 		args, err := c.NewAPIConnectionParams(store, controllerName, "", accountDetails)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		if c.oidc {
+			// Log in through the identity provider's device flow, unless
+			// the current session token is still valid.
+			args.DialOpts.LoginProvider = c.SessionTokenLoginFactory().NewLoginProvider(
+				sessionToken,
+				ctx.Stderr,
+				func(t string) {
+					accountDetails.SessionToken = t
+				},
+			)
+			accountDetails.SessionToken = sessionToken
+		}
 		return newAPIConnection(ctx, args)
 	}
 
+	if c.oidc {
+		// Don't try the password of any current account, the user
+		// logs in as whoever the identity provider says they are.
+		currentAccountDetails = nil
+	}
 	return c.login(ctx, currentAccountDetails, dial)
 }
 
@@ -75,6 +75,12 @@ func (s *LoginCommandSuite) TestInitError(c *tc.C) {
 	}, {
 		args:   []string{"foobar", "extra"},
 		stderr: `ERROR unrecognized args: \["extra"\]\n`,
+	}, {
+		args:   []string{"--oidc", "-u", "bob"},
+		stderr: `ERROR cannot specify a username with --oidc, the username is returned by the identity provider\n`,
+	}, {
+		args:   []string{"--oidc", "mycontroller.com"},
+		stderr: `ERROR cannot log into a public controller with --oidc, use -c to choose an existing controller\n`,
 	}} {
 		c.Logf("test %d", i)
 		stdout, stderr, code := runLogin(c, "", test.args...)
@@ -561,6 +567,44 @@ func (s *LoginCommandSuite) TestLoginToPublicControllerWithOIDC(c *tc.C) {
 	c.Assert(acc.User, tc.Equals, "user@external")
 }
 
+// TestLoginWithOIDCFlag verifies that login with --oidc to a controller known
+// to the client store uses the device flow, replacing the current account and
+// marking the controller as using OIDC.
+func (s *LoginCommandSuite) TestLoginWithOIDCFlag(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	sessionLoginFactory := NewMockSessionLoginFactory(ctrl)
+	sessionLoginProvider := NewMockLoginProvider(ctrl)
+
+	s.PatchValue(user.NewAPIConnection, func(ctx context.Context, p juju.NewAPIConnectionParams) (api.Connection, error) {
+		c.Check(p.AccountDetails.Password, tc.Equals, "")
+		_, err := p.DialOpts.LoginProvider.Login(ctx, nil)
+		c.Check(err, tc.ErrorIsNil)
+		return s.apiConnection, nil
+	})
+
+	var tokenCallbackFunc func(string)
+	sessionLoginFactory.EXPECT().NewLoginProvider("", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, _ io.Writer, f func(string)) api.LoginProvider {
+			tokenCallbackFunc = f
+			return sessionLoginProvider
+		})
+	sessionLoginProvider.EXPECT().Login(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ apibase.APICaller) (*api.LoginResultParams, error) {
+			tokenCallbackFunc("session-token")
+			return nil, nil
+		})
+
+	_, stderr, code := runLoginWithFakeSessionLoginProvider(c, sessionLoginFactory, "--oidc")
+	c.Assert(code, tc.Equals, 0, tc.Commentf("stderr: %s", stderr))
+
+	acc := s.store.Accounts["testing"]
+	c.Check(acc.SessionToken, tc.Equals, "session-token")
+	c.Check(acc.User, tc.Equals, "user@external")
+	c.Check(acc.Password, tc.Equals, "")
+	c.Check(s.store.Controllers["testing"].OIDCLogin, tc.IsTrue)
+}
+
 func runLoginWithFakeSessionLoginProvider(c *tc.C, factory modelcmd.SessionLoginFactory, args ...string) (stdout, stderr string, errCode int) {
 	loginCmd := user.NewLoginCommandWithSessionLoginFactory(factory)
 	return run(c, "", loginCmd, args...)
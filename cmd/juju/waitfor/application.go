@@ -0,0 +1,123 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/internal/query"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+const waitForApplicationDoc = `
+Wait for an application to reach a goal state. The goal state is described by
+the --query option, which defaults to waiting for the application to be alive
+and active. If the application has not yet been deployed, the command waits
+for it to appear. An application which is removed while waiting is treated as
+dead.
+
+The following fields of the application can be queried:
+
+    name            the name of the application
+    life            the life of the application: alive, dying or dead
+    status          the status of the application
+    message         the status message of the application
+    charm           the name of the charm of the application
+    charm-revision  the revision of the charm of the application
+    exposed         whether the application is exposed
+    subordinate     whether the application is a subordinate
+    scale           the number of units the application should have
+    units           the number of units the application has
+`
+
+const waitForApplicationExamples = `
+Wait for the mysql application to be active:
+
+    juju wait-for application mysql
+
+Wait for the mysql application to be removed:
+
+    juju wait-for application mysql --query 'life=="dead"'
+
+Wait for the mysql application to have all of its units, for up to an hour:
+
+    juju wait-for application mysql --query 'units>=scale' --timeout 1h
+`
+
+func newApplicationCommand() cmd.Command {
+	return modelcmd.Wrap(&applicationCommand{})
+}
+
+// applicationCommand waits for an application to reach a goal state.
+type applicationCommand struct {
+	waitForCommandBase
+	name string
+}
+
+// Info implements Command.Info.
+func (c *applicationCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "application",
+		Args:     "<name>",
+		Purpose:  "Wait for an application to reach a specified state.",
+		Doc:      waitForApplicationDoc,
+		Examples: waitForApplicationExamples,
+		SeeAlso: []string{
+			"status",
+			"show-application",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *applicationCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.setFlags(f, `life=="alive" && status=="active"`)
+}
+
+// Init implements Command.Init.
+func (c *applicationCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no application name specified")
+	}
+	name, args := args[0], args[1:]
+	if !names.IsValidApplication(name) {
+		return errors.NotValidf("application name %q", name)
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.name = name
+	return c.initQuery()
+}
+
+// Run implements Command.Run.
+func (c *applicationCommand) Run(ctx *cmd.Context) error {
+	return c.waitFor(ctx, "application", c.name, func(status params.WaitForStatus) (query.Scope, bool) {
+		app, ok := status.Applications[c.name]
+		if !ok {
+			return nil, false
+		}
+		return applicationScope(app), true
+	})
+}
+
+func applicationScope(app params.WaitForApplicationStatus) query.Scope {
+	return query.Scope{
+		"name":           app.Name,
+		"life":           app.Life,
+		"status":         app.Status,
+		"message":        app.Message,
+		"charm":          app.Charm,
+		"charm-revision": app.CharmRevision,
+		"exposed":        app.Exposed,
+		"subordinate":    app.Subordinate,
+		"scale":          app.Scale,
+		"units":          len(app.Units),
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+func newCommandBaseForTest(api WaitForAPI, clock clock.Clock, store jujuclient.ClientStore) waitForCommandBase {
+	base := waitForCommandBase{
+		newAPIFunc: func(context.Context) (WaitForAPI, error) {
+			return api, nil
+		},
+		clock: clock,
+	}
+	base.SetClientStore(store)
+	return base
+}
+
+// NewModelCommandForTest returns a wait-for model command with the API
+// and clock provided.
+func NewModelCommandForTest(api WaitForAPI, clock clock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&modelCommand{waitForCommandBase: newCommandBaseForTest(api, clock, store)})
+}
+
+// NewApplicationCommandForTest returns a wait-for application command with
+// the API and clock provided.
+func NewApplicationCommandForTest(api WaitForAPI, clock clock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&applicationCommand{waitForCommandBase: newCommandBaseForTest(api, clock, store)})
+}
+
+// NewUnitCommandForTest returns a wait-for unit command with the API and
+// clock provided.
+func NewUnitCommandForTest(api WaitForAPI, clock clock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&unitCommand{waitForCommandBase: newCommandBaseForTest(api, clock, store)})
+}
+
+// NewMachineCommandForTest returns a wait-for machine command with the API
+// and clock provided.
+func NewMachineCommandForTest(api WaitForAPI, clock clock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&machineCommand{waitForCommandBase: newCommandBaseForTest(api, clock, store)})
+}
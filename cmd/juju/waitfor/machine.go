@@ -0,0 +1,118 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/internal/query"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+const waitForMachineDoc = `
+Wait for a machine to reach a goal state. The goal state is described by the
+--query option, which defaults to waiting for the machine to be alive and
+started. If the machine has not yet been added, the command waits for it to
+appear. A machine which is removed while waiting is treated as dead.
+
+The following fields of the machine can be queried:
+
+    name              the name of the machine
+    life              the life of the machine: alive, dying or dead
+    status            the status of the machine agent
+    message           the status message of the machine agent
+    instance-status   the status of the instance of the machine
+    instance-message  the status message of the instance of the machine
+    instance-id       the provider id of the instance, if provisioned
+    hostname          the hostname of the machine, if known
+`
+
+const waitForMachineExamples = `
+Wait for machine 0 to be started:
+
+    juju wait-for machine 0
+
+Wait for machine 0 to be provisioned, for up to 15 minutes:
+
+    juju wait-for machine 0 --query 'instance-id!=null' --timeout 15m
+
+Wait for machine 0/lxd/0 to be removed:
+
+    juju wait-for machine 0/lxd/0 --query 'life=="dead"'
+`
+
+func newMachineCommand() cmd.Command {
+	return modelcmd.Wrap(&machineCommand{})
+}
+
+// machineCommand waits for a machine to reach a goal state.
+type machineCommand struct {
+	waitForCommandBase
+	name string
+}
+
+// Info implements Command.Info.
+func (c *machineCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "machine",
+		Args:     "<name>",
+		Purpose:  "Wait for a machine to reach a specified state.",
+		Doc:      waitForMachineDoc,
+		Examples: waitForMachineExamples,
+		SeeAlso: []string{
+			"status",
+			"show-machine",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *machineCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.setFlags(f, `life=="alive" && status=="started"`)
+}
+
+// Init implements Command.Init.
+func (c *machineCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no machine name specified")
+	}
+	name, args := args[0], args[1:]
+	if !names.IsValidMachine(name) {
+		return errors.NotValidf("machine name %q", name)
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.name = name
+	return c.initQuery()
+}
+
+// Run implements Command.Run.
+func (c *machineCommand) Run(ctx *cmd.Context) error {
+	return c.waitFor(ctx, "machine", c.name, func(status params.WaitForStatus) (query.Scope, bool) {
+		machine, ok := status.Machines[c.name]
+		if !ok {
+			return nil, false
+		}
+		return machineScope(machine), true
+	})
+}
+
+func machineScope(machine params.WaitForMachineStatus) query.Scope {
+	return query.Scope{
+		"name":             machine.Name,
+		"life":             machine.Life,
+		"status":           machine.Status,
+		"message":          machine.Message,
+		"instance-status":  machine.InstanceStatus,
+		"instance-message": machine.InstanceMessage,
+		"instance-id":      optional(machine.InstanceID),
+		"hostname":         optional(machine.Hostname),
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/internal/query"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+const waitForModelDoc = `
+Wait for the current model, or the model given with -m, to reach a goal
+state. The goal state is described by the --query option, which defaults to
+waiting for the model to be available.
+
+The following fields of the model can be queried:
+
+    name      the name of the model
+    type      the type of the model, either iaas or caas
+    status    the status of the model
+    message   the status message of the model
+`
+
+const waitForModelExamples = `
+Wait for the current model to be available:
+
+    juju wait-for model
+
+Wait for the model named "prod" to be available, for up to 5 minutes:
+
+    juju wait-for model -m prod --timeout 5m
+
+Wait for the model to be suspended:
+
+    juju wait-for model --query 'status=="suspended"'
+`
+
+func newModelCommand() cmd.Command {
+	return modelcmd.Wrap(&modelCommand{})
+}
+
+// modelCommand waits for a model to reach a goal state.
+type modelCommand struct {
+	waitForCommandBase
+}
+
+// Info implements Command.Info.
+func (c *modelCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "model",
+		Purpose:  "Wait for a model to reach a specified state.",
+		Doc:      waitForModelDoc,
+		Examples: waitForModelExamples,
+		SeeAlso: []string{
+			"status",
+			"show-model",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *modelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.setFlags(f, `status=="available"`)
+}
+
+// Init implements Command.Init.
+func (c *modelCommand) Init(args []string) error {
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	return c.initQuery()
+}
+
+// Run implements Command.Run.
+func (c *modelCommand) Run(ctx *cmd.Context) error {
+	name, err := c.ModelIdentifier()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.waitFor(ctx, "model", name, func(status params.WaitForStatus) (query.Scope, bool) {
+		return modelScope(status.Model), true
+	})
+}
+
+func modelScope(model params.WaitForModelStatus) query.Scope {
+	return query.Scope{
+		"name":    model.Name,
+		"type":    model.Type,
+		"status":  model.Status,
+		"message": model.Message,
+	}
+}
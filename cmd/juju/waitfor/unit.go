@@ -0,0 +1,122 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/internal/query"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+const waitForUnitDoc = `
+Wait for a unit to reach a goal state. The goal state is described by the
+--query option, which defaults to waiting for the unit to be alive with an
+active workload. If the unit has not yet been added, the command waits for it
+to appear. A unit which is removed while waiting is treated as dead.
+
+The following fields of the unit can be queried:
+
+    name              the name of the unit
+    application       the name of the application of the unit
+    life              the life of the unit: alive, dying or dead
+    workload-status   the workload status of the unit
+    workload-message  the workload status message of the unit
+    agent-status      the status of the agent of the unit
+    agent-message     the status message of the agent of the unit
+    machine           the machine the unit is deployed to, if any
+    leader            whether the unit is the leader of its application
+    principal         the principal unit of a subordinate unit, if any
+`
+
+const waitForUnitExamples = `
+Wait for the mysql/0 unit to be active:
+
+    juju wait-for unit mysql/0
+
+Wait for the mysql/0 unit to be idle and the leader:
+
+    juju wait-for unit mysql/0 --query 'agent-status=="idle" && leader'
+
+Wait for the mysql/0 unit to be blocked or in error, for up to 5 minutes:
+
+    juju wait-for unit mysql/0 --query 'workload-status=="blocked" || workload-status=="error"' --timeout 5m
+`
+
+func newUnitCommand() cmd.Command {
+	return modelcmd.Wrap(&unitCommand{})
+}
+
+// unitCommand waits for a unit to reach a goal state.
+type unitCommand struct {
+	waitForCommandBase
+	name string
+}
+
+// Info implements Command.Info.
+func (c *unitCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "unit",
+		Args:     "<name>",
+		Purpose:  "Wait for a unit to reach a specified state.",
+		Doc:      waitForUnitDoc,
+		Examples: waitForUnitExamples,
+		SeeAlso: []string{
+			"status",
+			"show-unit",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *unitCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.setFlags(f, `life=="alive" && workload-status=="active"`)
+}
+
+// Init implements Command.Init.
+func (c *unitCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no unit name specified")
+	}
+	name, args := args[0], args[1:]
+	if !names.IsValidUnit(name) {
+		return errors.NotValidf("unit name %q", name)
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.name = name
+	return c.initQuery()
+}
+
+// Run implements Command.Run.
+func (c *unitCommand) Run(ctx *cmd.Context) error {
+	return c.waitFor(ctx, "unit", c.name, func(status params.WaitForStatus) (query.Scope, bool) {
+		unit, ok := status.Units[c.name]
+		if !ok {
+			return nil, false
+		}
+		return unitScope(unit), true
+	})
+}
+
+func unitScope(unit params.WaitForUnitStatus) query.Scope {
+	return query.Scope{
+		"name":             unit.Name,
+		"application":      unit.Application,
+		"life":             unit.Life,
+		"workload-status":  unit.WorkloadStatus,
+		"workload-message": unit.WorkloadMessage,
+		"agent-status":     unit.AgentStatus,
+		"agent-message":    unit.AgentMessage,
+		"machine":          optional(unit.Machine),
+		"leader":           unit.Leader,
+		"principal":        optional(unit.Principal),
+	}
+}
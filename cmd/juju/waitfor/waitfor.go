@@ -0,0 +1,195 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/waitfor"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/internal/query"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+const waitForDoc = `
+The wait-for set of commands wait for an entity in a model to reach a goal
+state, described by a query expression. Each time the model changes, the
+query is evaluated against the current state of the entity, and the command
+exits once the query is satisfied. If the goal state is not reached before the
+timeout expires, the command exits with an error.
+
+Queries compare the fields of an entity using ==, !=, <, <=, > and >=, match
+strings against a regular expression with =~, and combine the results with
+&&, || and !. Strings are quoted with either single or double quotes, and the
+literals true, false and null are supported. For example:
+
+    life=="alive" && (workload-status=="active" || workload-status=="idle")
+
+The fields available for each kind of entity are listed in the help for its
+subcommand.
+`
+
+const defaultTimeout = 10 * time.Minute
+
+// NewWaitForCommand returns the wait-for super command, under which a
+// subcommand is registered for each kind of entity that can be waited for.
+func NewWaitForCommand() cmd.Command {
+	waitFor := jujucmd.NewSuperCommand(cmd.SuperCommandParams{
+		Name:        "wait-for",
+		UsagePrefix: "juju",
+		Doc:         waitForDoc,
+		Purpose:     "Wait for an entity to reach a specified state.",
+	})
+	waitFor.Register(newApplicationCommand())
+	waitFor.Register(newMachineCommand())
+	waitFor.Register(newModelCommand())
+	waitFor.Register(newUnitCommand())
+	return waitFor
+}
+
+// WaitForAPI defines the API methods used by the wait-for commands.
+type WaitForAPI interface {
+	Close() error
+	WatchStatus(ctx context.Context) (watcher.NotifyWatcher, error)
+	Status(ctx context.Context) (params.WaitForStatus, error)
+}
+
+// waitForCommandBase holds the behaviour shared by the wait-for
+// subcommands.
+type waitForCommandBase struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(ctx context.Context) (WaitForAPI, error)
+	clock      clock.Clock
+
+	queryStr string
+	timeout  time.Duration
+	goal     query.Query
+}
+
+// lookupFunc returns the fields of the entity being waited for from the
+// status of the model, and whether it was found.
+type lookupFunc func(status params.WaitForStatus) (query.Scope, bool)
+
+func (c *waitForCommandBase) setFlags(f *gnuflag.FlagSet, defaultQuery string) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.queryStr, "query", defaultQuery, "Query the goal state of the entity")
+	f.DurationVar(&c.timeout, "timeout", defaultTimeout, "How long to wait before timing out")
+}
+
+func (c *waitForCommandBase) initQuery() error {
+	if c.timeout <= 0 {
+		return errors.NotValidf("timeout %v", c.timeout)
+	}
+	goal, err := query.Parse(c.queryStr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.goal = goal
+	return nil
+}
+
+func (c *waitForCommandBase) newAPI(ctx context.Context) (WaitForAPI, error) {
+	if c.newAPIFunc != nil {
+		return c.newAPIFunc(ctx)
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return waitfor.NewClient(root), nil
+}
+
+// waitFor blocks until the entity described by kind and name satisfies the
+// goal query, or the timeout expires. The status of the model is read each
+// time the server reports a change, rather than polled.
+func (c *waitForCommandBase) waitFor(ctx *cmd.Context, kind, name string, lookup lookupFunc) error {
+	client, err := c.newAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = client.Close() }()
+
+	w, err := client.WatchStatus(ctx)
+	if err != nil {
+		return errors.Annotate(err, "watching model status")
+	}
+	defer w.Kill()
+
+	clk := c.clock
+	if clk == nil {
+		clk = clock.WallClock
+	}
+	timeout := clk.After(c.timeout)
+
+	// lastSeen holds the last known state of the entity, so that its
+	// removal from the model can be reported as it becoming dead.
+	var lastSeen query.Scope
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return errors.Errorf("timed out waiting for %s %q to reach goal state %q", kind, name, c.goal)
+		case _, ok := <-w.Changes():
+			if !ok {
+				return errors.Annotate(w.Wait(), "model status watcher stopped")
+			}
+		}
+
+		status, err := client.Status(ctx)
+		if err != nil {
+			return errors.Annotate(err, "getting model status")
+		}
+
+		scope, found := lookup(status)
+		if found {
+			lastSeen = scope
+		} else if lastSeen != nil {
+			scope = removedScope(lastSeen)
+		} else {
+			// The entity may not have been created yet.
+			continue
+		}
+
+		done, err := c.goal.Run(scope)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if done {
+			ctx.Infof("%s %q reached goal state", kind, name)
+			return nil
+		}
+	}
+}
+
+// removedScope returns the scope of an entity which has been removed from the
+// model, which is treated as being dead.
+func removedScope(lastSeen query.Scope) query.Scope {
+	scope := make(query.Scope, len(lastSeen))
+	for k, v := range lastSeen {
+		scope[k] = v
+	}
+	if _, ok := scope["life"]; ok {
+		scope["life"] = "dead"
+	}
+	return scope
+}
+
+// optional returns null for an unset value, so that queries can test for its
+// presence with !=null.
+func optional(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/cmd/cmd"
+	"github.com/juju/juju/cmd/cmd/cmdtesting"
+	"github.com/juju/juju/cmd/internal/query"
+	"github.com/juju/juju/cmd/juju/waitfor"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	jujutesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type waitForSuite struct {
+	api   *fakeWaitForAPI
+	clock *testclock.Clock
+	store *jujuclient.MemStore
+}
+
+func TestWaitForSuite(t *testing.T) {
+	tc.Run(t, &waitForSuite{})
+}
+
+func (s *waitForSuite) SetUpTest(c *tc.C) {
+	s.api = &fakeWaitForAPI{}
+	s.clock = testclock.NewClock(time.Now())
+	s.store = jujuclienttesting.MinimalStore()
+}
+
+func (s *waitForSuite) TestWaitForModel(c *tc.C) {
+	s.api.statuses = []params.WaitForStatus{
+		{Model: params.WaitForModelStatus{Name: "king", Status: "busy"}},
+		{Model: params.WaitForModelStatus{Name: "king", Status: "available"}},
+	}
+
+	ctx, err := cmdtesting.RunCommand(c, waitfor.NewModelCommandForTest(s.api, s.clock, s.store))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), tc.Equals, "model \"king/sword\" reached goal state\n")
+	c.Check(s.api.calls, tc.Equals, 2)
+	c.Check(s.api.closed, tc.IsTrue)
+}
+
+func (s *waitForSuite) TestWaitForApplication(c *tc.C) {
+	s.api.statuses = []params.WaitForStatus{
+		{},
+		{Applications: map[string]params.WaitForApplicationStatus{
+			"mysql": {Name: "mysql", Life: "alive", Status: "waiting", Scale: 2, Units: []string{"mysql/0"}},
+		}},
+		{Applications: map[string]params.WaitForApplicationStatus{
+			"mysql": {Name: "mysql", Life: "alive", Status: "active", Scale: 2, Units: []string{"mysql/0", "mysql/1"}},
+		}},
+	}
+
+	_, err := cmdtesting.RunCommand(c, waitfor.NewApplicationCommandForTest(s.api, s.clock, s.store),
+		"mysql", "--query", `status=="active" && units>=scale`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.calls, tc.Equals, 3)
+}
+
+func (s *waitForSuite) TestWaitForUnitRemoved(c *tc.C) {
+	s.api.statuses = []params.WaitForStatus{
+		{Units: map[string]params.WaitForUnitStatus{
+			"mysql/0": {Name: "mysql/0", Life: "dying", WorkloadStatus: "maintenance"},
+		}},
+		{},
+	}
+
+	_, err := cmdtesting.RunCommand(c, waitfor.NewUnitCommandForTest(s.api, s.clock, s.store),
+		"mysql/0", "--query", `life=="dead"`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.calls, tc.Equals, 2)
+}
+
+func (s *waitForSuite) TestWaitForMachine(c *tc.C) {
+	s.api.statuses = []params.WaitForStatus{
+		{Machines: map[string]params.WaitForMachineStatus{
+			"0": {Name: "0", Life: "alive", Status: "pending"},
+		}},
+		{Machines: map[string]params.WaitForMachineStatus{
+			"0": {Name: "0", Life: "alive", Status: "pending", InstanceID: "i-0"},
+		}},
+	}
+
+	_, err := cmdtesting.RunCommand(c, waitfor.NewMachineCommandForTest(s.api, s.clock, s.store),
+		"0", "--query", `instance-id!=null`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.api.calls, tc.Equals, 2)
+}
+
+func (s *waitForSuite) TestWaitForTimeout(c *tc.C) {
+	s.api.statuses = []params.WaitForStatus{
+		{Units: map[string]params.WaitForUnitStatus{
+			"mysql/0": {Name: "mysql/0", Life: "alive", WorkloadStatus: "waiting"},
+		}},
+	}
+
+	go func() {
+		_ = s.clock.WaitAdvance(time.Minute, jujutesting.LongWait, 1)
+	}()
+	_, err := cmdtesting.RunCommand(c, waitfor.NewUnitCommandForTest(s.api, s.clock, s.store),
+		"mysql/0", "--timeout", "1m")
+	c.Assert(err, tc.ErrorMatches, `timed out waiting for unit "mysql/0" to reach goal state "life==\\"alive\\" && workload-status==\\"active\\""`)
+}
+
+func (s *waitForSuite) TestWaitForInvalidIdentifier(c *tc.C) {
+	s.api.statuses = []params.WaitForStatus{
+		{Units: map[string]params.WaitForUnitStatus{
+			"mysql/0": {Name: "mysql/0", Life: "alive"},
+		}},
+	}
+
+	_, err := cmdtesting.RunCommand(c, waitfor.NewUnitCommandForTest(s.api, s.clock, s.store),
+		"mysql/0", "--query", `status=="active"`)
+	c.Assert(err, tc.ErrorIs, query.InvalidIdentifier)
+}
+
+func (s *waitForSuite) TestWaitForWatchError(c *tc.C) {
+	s.api.watchErr = errors.New("boom")
+
+	_, err := cmdtesting.RunCommand(c, waitfor.NewModelCommandForTest(s.api, s.clock, s.store))
+	c.Assert(err, tc.ErrorMatches, "watching model status: boom")
+}
+
+func (s *waitForSuite) TestInitErrors(c *tc.C) {
+	tests := []struct {
+		command func(waitfor.WaitForAPI, *testclock.Clock, jujuclient.ClientStore) cmd.Command
+		args    []string
+		err     string
+	}{{
+		command: newApplicationCommand,
+		err:     "no application name specified",
+	}, {
+		command: newApplicationCommand,
+		args:    []string{"mysql/0"},
+		err:     `application name "mysql/0" not valid`,
+	}, {
+		command: newUnitCommand,
+		args:    []string{"mysql"},
+		err:     `unit name "mysql" not valid`,
+	}, {
+		command: newMachineCommand,
+		args:    []string{"0", "1"},
+		err:     `unrecognized args: \["1"\]`,
+	}, {
+		command: newModelCommand,
+		args:    []string{"--query", `status==`},
+		err:     `parsing query "status==": unexpected end of query at position 8, expected value`,
+	}, {
+		command: newModelCommand,
+		args:    []string{"--timeout", "0s"},
+		err:     `timeout 0s not valid`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %v", i, test.args)
+		_, err := cmdtesting.RunCommand(c, test.command(s.api, s.clock, s.store), test.args...)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func newModelCommand(api waitfor.WaitForAPI, clock *testclock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return waitfor.NewModelCommandForTest(api, clock, store)
+}
+
+func newApplicationCommand(api waitfor.WaitForAPI, clock *testclock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return waitfor.NewApplicationCommandForTest(api, clock, store)
+}
+
+func newUnitCommand(api waitfor.WaitForAPI, clock *testclock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return waitfor.NewUnitCommandForTest(api, clock, store)
+}
+
+func newMachineCommand(api waitfor.WaitForAPI, clock *testclock.Clock, store jujuclient.ClientStore) cmd.Command {
+	return waitfor.NewMachineCommandForTest(api, clock, store)
+}
+
+// fakeWaitForAPI reports a change for each of its statuses, which are
+// returned in order by Status.
+type fakeWaitForAPI struct {
+	statuses []params.WaitForStatus
+	watchErr error
+
+	calls  int
+	closed bool
+}
+
+func (f *fakeWaitForAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
+func (f *fakeWaitForAPI) WatchStatus(context.Context) (watcher.NotifyWatcher, error) {
+	if f.watchErr != nil {
+		return nil, f.watchErr
+	}
+	ch := make(chan struct{}, len(f.statuses))
+	for range f.statuses {
+		ch <- struct{}{}
+	}
+	return watchertest.NewMockNotifyWatcher(ch), nil
+}
+
+func (f *fakeWaitForAPI) Status(context.Context) (params.WaitForStatus, error) {
+	status := f.statuses[f.calls]
+	f.calls++
+	return status, nil
+}
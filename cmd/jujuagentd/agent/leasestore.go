@@ -0,0 +1,56 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package agent
+
+import (
+	"context"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/lease"
+)
+
+// introspectionLeaseStore exposes the lease store of the lease manager to
+// the introspection worker. The introspection worker is started outside of
+// the dependency engine, so the lease store is set once the lease manager
+// has been started within the engine.
+type introspectionLeaseStore struct {
+	mu    sync.Mutex
+	store lease.Store
+}
+
+// Set sets the lease store to report on.
+func (s *introspectionLeaseStore) Set(store lease.Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store = store
+}
+
+func (s *introspectionLeaseStore) get() (lease.Store, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.store == nil {
+		return nil, errors.NotYetAvailablef("lease store")
+	}
+	return s.store, nil
+}
+
+// Leases is part of the introspection.LeaseStore interface.
+func (s *introspectionLeaseStore) Leases(ctx context.Context, keys ...lease.Key) (map[lease.Key]lease.Info, error) {
+	store, err := s.get()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return store.Leases(ctx, keys...)
+}
+
+// Pinned is part of the introspection.LeaseStore interface.
+func (s *introspectionLeaseStore) Pinned(ctx context.Context) (map[lease.Key][]string, error) {
+	store, err := s.get()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return store.Pinned(ctx)
+}
@@ -668,6 +668,8 @@ func (a *MachineAgent) makeEngineCreator(
 			})
 		}
 
+		leaseStore := &introspectionLeaseStore{}
+
 		registerIntrospectionHandlers := func(handle func(path string, h http.Handler)) {
 			handle("/metrics/", promhttp.HandlerFor(a.prometheusRegistry, promhttp.HandlerOpts{}))
 		}
@@ -711,12 +713,14 @@ func (a *MachineAgent) makeEngineCreator(
 			FlightRecorder:                    flightRecorder,
 			ValidateMigration:                 a.validateMigration,
 			PrometheusRegisterer:              a.prometheusRegistry,
+			PrometheusGatherer:                a.prometheusRegistry,
 			UpdateLoggerConfig:                updateAgentConfLogging,
 			NewAgentStatusSetter:              a.statusSetter,
 			ControllerLeaseDuration:           time.Minute,
 			TransactionPruneInterval:          time.Hour,
 			MachineLock:                       a.machineLock,
 			RegisterIntrospectionHTTPHandlers: registerIntrospectionHandlers,
+			SetIntrospectionLeaseStore:        leaseStore.Set,
 			NewModelWorker:                    a.startModelWorkers,
 			MuxShutdownWait:                   1 * time.Minute,
 			NewBrokerFunc:                     newBroker,
@@ -752,6 +756,7 @@ func (a *MachineAgent) makeEngineCreator(
 			MachineLock:        a.machineLock,
 			PrometheusGatherer: a.prometheusRegistry,
 			FlightRecorder:     flightRecorder,
+			LeaseStore:         leaseStore,
 			WorkerFunc:         introspection.NewWorker,
 			Clock:              c,
 			Logger:             logger.Child("introspection"),
@@ -879,6 +884,8 @@ func (a *MachineAgent) startModelWorkers(cfg modelworkermanager.NewModelConfig)
 		NewContainerBrokerFunc:        newCAASBroker,
 		NewMigrationMaster:            migrationmaster.NewWorker,
 		OperationPrunerInterval:       24 * time.Hour,
+		OperationSchedulerInterval:    10 * time.Second,
+		RefreshRolloutInterval:        10 * time.Second,
 		DomainServices:                cfg.DomainServices,
 		DomainServicesGetter:          cfg.DomainServicesGetter,
 		ProviderServicesGetter:        cfg.ProviderServicesGetter,
@@ -30,6 +30,7 @@ import (
 	"github.com/juju/juju/core/flightrecorder"
 	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/lease"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/model"
@@ -59,6 +60,7 @@ import (
 	"github.com/juju/juju/internal/worker/apiserver"
 	"github.com/juju/juju/internal/worker/apiservercertwatcher"
 	"github.com/juju/juju/internal/worker/auditconfigupdater"
+	"github.com/juju/juju/internal/worker/auditlogpruner"
 	"github.com/juju/juju/internal/worker/authenticationworker"
 	"github.com/juju/juju/internal/worker/bootstrap"
 	"github.com/juju/juju/internal/worker/caasupgrader"
@@ -99,12 +101,15 @@ import (
 	"github.com/juju/juju/internal/worker/machiner"
 	"github.com/juju/juju/internal/worker/migrationflag"
 	"github.com/juju/juju/internal/worker/migrationminion"
+	"github.com/juju/juju/internal/worker/modelmetrics"
 	"github.com/juju/juju/internal/worker/modelworkermanager"
 	"github.com/juju/juju/internal/worker/objectstore"
 	"github.com/juju/juju/internal/worker/objectstoredrainer"
 	"github.com/juju/juju/internal/worker/objectstorefacade"
 	"github.com/juju/juju/internal/worker/objectstores3caller"
 	"github.com/juju/juju/internal/worker/objectstoreservices"
+	"github.com/juju/juju/internal/worker/otelexport"
+	"github.com/juju/juju/internal/worker/permissionexpiry"
 	"github.com/juju/juju/internal/worker/providerservices"
 	"github.com/juju/juju/internal/worker/providertracker"
 	"github.com/juju/juju/internal/worker/proxyupdater"
@@ -283,6 +288,11 @@ type ManifoldsConfig struct {
 	// by workers to register Prometheus metric collectors.
 	PrometheusRegisterer prometheus.Registerer
 
+	// PrometheusGatherer is the prometheus.Gatherer for the metrics
+	// registered with PrometheusRegisterer, used to export them to an
+	// OTEL collector.
+	PrometheusGatherer prometheus.Gatherer
+
 	// UpdateLoggerConfig is a function that will save the specified
 	// config value as the logging config in the agent.conf file.
 	UpdateLoggerConfig func(string) error
@@ -304,6 +314,11 @@ type ManifoldsConfig struct {
 	// alter the path as it sees fit, e.g. by adding a prefix.
 	RegisterIntrospectionHTTPHandlers func(func(path string, _ http.Handler))
 
+	// SetIntrospectionLeaseStore is called with the lease store used by
+	// the lease manager, so that the introspection worker can report on
+	// the leases held in the controller database.
+	SetIntrospectionLeaseStore func(lease.Store)
+
 	// NewModelWorker returns a new worker for managing the model with
 	// the specified UUID and type.
 	NewModelWorker modelworkermanager.NewModelWorkerFunc
@@ -667,6 +682,18 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewTracerWorker:   trace.NewTracerWorker,
 		}),
 
+		// The OTEL export worker pushes the controller's metrics and logs
+		// to the collector configured for workload tracing.
+		otelExportName: ifController(otelexport.Manifold(otelexport.ManifoldConfig{
+			Tag:               agentConfig.Tag(),
+			TraceServicesName: traceServicesName,
+			Gatherer:          config.PrometheusGatherer,
+			Clock:             config.Clock,
+			Logger:            internallogger.GetLogger("juju.worker.otelexport"),
+			GetTracingService: otelexport.GetTracingService,
+			NewWorker:         otelexport.NewWorker,
+		})),
+
 		traceName: trace.Manifold(trace.ManifoldConfig{
 			AgentName:          agentName,
 			AgentConfigChanged: config.AgentConfigChanged,
@@ -865,6 +892,42 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			DomainServicesName:         domainServicesName,
 			NewWorker:                  auditconfigupdater.NewWorker,
 			GetControllerConfigService: auditconfigupdater.GetControllerConfigService,
+			GetAuditLogService:         auditconfigupdater.GetAuditLogService,
+			PrometheusRegisterer:       config.PrometheusRegisterer,
+			Clock:                      config.Clock,
+			Logger:                     internallogger.GetLogger("juju.worker.auditconfigupdater"),
+		})),
+
+		// The audit log pruner removes audit log records older than
+		// the audit-log-retention controller config value.
+		auditLogPrunerName: ifPrimaryController(auditlogpruner.Manifold(auditlogpruner.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			Clock:              config.Clock,
+			Logger:             internallogger.GetLogger("juju.worker.auditlogpruner"),
+			PruneInterval:      time.Hour,
+		})),
+
+		// The permission expiry worker reverts time-limited permission
+		// grants once they have expired, recording each in the audit log.
+		permissionExpiryName: ifPrimaryController(permissionexpiry.Manifold(permissionexpiry.ManifoldConfig{
+			DomainServicesName:     domainServicesName,
+			AuditConfigUpdaterName: auditConfigUpdaterName,
+			Clock:                  config.Clock,
+			Logger:                 internallogger.GetLogger("juju.worker.permissionexpiry"),
+			CheckInterval:          time.Minute,
+		})),
+
+		// The model metrics worker reports the workload state of every
+		// model on the controller as prometheus metrics.
+		modelMetricsName: ifPrimaryController(modelmetrics.Manifold(modelmetrics.ManifoldConfig{
+			DomainServicesName:      domainServicesName,
+			Clock:                   config.Clock,
+			Logger:                  internallogger.GetLogger("juju.worker.modelmetrics"),
+			Interval:                modelmetrics.DefaultInterval,
+			PrometheusRegisterer:    config.PrometheusRegisterer,
+			NewWorker:               modelmetrics.NewWorker,
+			NewMetricsCollector:     modelmetrics.NewMetricsCollector,
+			GetDomainServicesGetter: modelmetrics.GetDomainServicesGetter,
 		})),
 
 		// The lease expiry worker constantly deletes
@@ -891,6 +954,8 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewWorker:            leasemanager.NewWorker,
 			NewStore:             leasemanager.NewStore,
 			NewSecretaryFinder:   internallease.NewSecretaryFinder,
+
+			SetIntrospectionStore: config.SetIntrospectionLeaseStore,
 		}),
 
 		// TODO (thumper): It doesn't really make sense in a machine manifold as
@@ -1127,6 +1192,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 		jwtParserName: ifController(jwtparser.Manifold(jwtparser.ManifoldConfig{
 			GetControllerConfigService: jwtparser.GetControllerConfigService,
 			DomainServicesName:         domainServicesName,
+			Clock:                      config.Clock,
 		})),
 
 		apiAddressSetterName: ifPrimaryController(apiaddresssetter.Manifold(apiaddresssetter.ManifoldConfig{
@@ -1675,6 +1741,7 @@ const (
 	apiRemoteCallerName                = "api-remote-caller"
 	apiRemoteRelationCallerName        = "api-remote-relation-caller"
 	auditConfigUpdaterName             = "audit-config-updater"
+	auditLogPrunerName                 = "audit-log-pruner"
 	authenticationWorkerName           = "ssh-authkeys-updater"
 	brokerTrackerName                  = "broker-tracker"
 	certificateUpdaterName             = "certificate-updater"
@@ -1714,6 +1781,7 @@ const (
 	lxdContainerProvisioner            = "lxd-container-provisioner"
 	machineActionName                  = "machine-action-runner"
 	machinerName                       = "machiner"
+	modelMetricsName                   = "model-metrics"
 	modelWorkerManagerName             = "model-worker-manager"
 	objectStoreName                    = "object-store"
 	objectStoreS3CallerName            = "object-store-s3-caller"
@@ -1721,6 +1789,7 @@ const (
 	objectStoreFortressName            = "object-store-fortress"
 	objectStoreFacadeName              = "object-store-facade"
 	objectStoreDrainerName             = "object-store-drainer"
+	permissionExpiryName               = "permission-expiry"
 	providerDomainServicesName         = "provider-services"
 	providerTrackerName                = "provider-tracker"
 	proxyConfigUpdater                 = "proxy-config-updater"
@@ -1735,6 +1804,7 @@ const (
 	storageRegistryName                = "storage-registry"
 	toolsVersionCheckerName            = "tools-version-checker"
 	controllerTraceName                = "controller-trace"
+	otelExportName                     = "otel-export"
 	traceName                          = "trace"
 	traceServicesName                  = "trace-services"
 	validCredentialFlagName            = "valid-credential-flag"
@@ -91,6 +91,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"api-remote-relation-caller",
 			"api-server",
 			"audit-config-updater",
+			"audit-log-pruner",
 			"bootstrap",
 			"broker-tracker",
 			"certificate-updater",
@@ -107,6 +108,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"controller-proxy-ready-flag",
 			"controller-proxy-ready-gate",
 			"controller-trace",
+			"permission-expiry",
 			"trace-services",
 			"db-accessor",
 			"deployer",
@@ -141,6 +143,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"migration-fortress",
 			"migration-inactive-flag",
 			"migration-minion",
+			"model-metrics",
 			"model-worker-manager",
 			"object-store-fortress",
 			"object-store-facade",
@@ -148,6 +151,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *tc.C) {
 			"object-store-s3-caller",
 			"object-store-services",
 			"object-store",
+			"otel-export",
 			"provider-services",
 			"provider-tracker",
 			"proxy-config-updater",
@@ -202,6 +206,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"api-remote-relation-caller",
 			"api-server",
 			"audit-config-updater",
+			"audit-log-pruner",
 			"bootstrap",
 			"certificate-watcher",
 			"change-stream-pruner",
@@ -216,6 +221,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"controller-proxy-ready-flag",
 			"controller-proxy-ready-gate",
 			"controller-trace",
+			"permission-expiry",
 			"trace-services",
 			"db-accessor",
 			"domain-services",
@@ -242,6 +248,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"migration-fortress",
 			"migration-inactive-flag",
 			"migration-minion",
+			"model-metrics",
 			"model-worker-manager",
 			"object-store-fortress",
 			"object-store-facade",
@@ -249,6 +256,7 @@ func (s *ManifoldsSuite) TestManifoldNamesCAAS(c *tc.C) {
 			"object-store-s3-caller",
 			"object-store-services",
 			"object-store",
+			"otel-export",
 			"provider-services",
 			"provider-tracker",
 			"proxy-config-updater",
@@ -311,6 +319,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"api-remote-relation-caller",
 		"api-server",
 		"audit-config-updater",
+		"audit-log-pruner",
 		"bootstrap",
 		"certificate-updater",
 		"certificate-watcher",
@@ -326,6 +335,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"controller-proxy-ready-flag",
 		"controller-proxy-ready-gate",
 		"controller-trace",
+		"permission-expiry",
 		"trace-services",
 		"db-accessor",
 		"deployer",
@@ -352,6 +362,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"migration-fortress",
 		"migration-inactive-flag",
 		"migration-minion",
+		"model-metrics",
 		"model-worker-manager",
 		"object-store-fortress",
 		"object-store-facade",
@@ -359,6 +370,7 @@ func (s *ManifoldsSuite) TestMigrationGuardsUsed(c *tc.C) {
 		"object-store-s3-caller",
 		"object-store-services",
 		"object-store",
+		"otel-export",
 		"provider-services",
 		"provider-tracker",
 		"query-logger",
@@ -417,6 +429,7 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *tc.C) {
 		"is-primary-controller-flag",
 		"jwt-parser",
 		"log-sink",
+		"otel-export",
 		"query-logger",
 		"ssh-server",
 		"ssh-tunneler",
@@ -430,10 +443,13 @@ func (*ManifoldsSuite) TestSingularGuardsUsed(c *tc.C) {
 
 	primaryControllerWorkers := set.NewStrings(
 		"api-address-setter",
+		"audit-log-pruner",
 		"change-stream-pruner",
 		"external-controller-updater",
 		"lease-expiry",
+		"model-metrics",
 		"object-store-drainer",
+		"permission-expiry",
 		"secret-backend-rotate",
 	)
 
@@ -1186,6 +1202,47 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
 	},
+	"audit-log-pruner": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"api-remote-caller",
+		"change-stream",
+		"controller-agent-config",
+		"controller-log-sink",
+		"controller-trace",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-not-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"controller-log-router",
+		"log-router",
+		"log-sink",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"non-controller-log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace-services",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
 	"bootstrap": {
 		"agent",
 		"api-caller",
@@ -1444,6 +1501,17 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"state-config-watcher",
 		"trace-services",
 	},
+	"otel-export": {
+		"agent",
+		"change-stream",
+		"controller-agent-config",
+		"db-accessor",
+		"file-notify-watcher",
+		"is-controller-flag",
+		"query-logger",
+		"state-config-watcher",
+		"trace-services",
+	},
 	"db-accessor": {
 		"agent",
 		"controller-agent-config",
@@ -1891,6 +1959,47 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
 	},
+	"model-metrics": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"api-remote-caller",
+		"change-stream",
+		"controller-agent-config",
+		"controller-log-sink",
+		"controller-trace",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-not-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"controller-log-router",
+		"log-router",
+		"log-sink",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"non-controller-log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace-services",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
 	"model-worker-manager": {
 		"agent",
 		"api-caller",
@@ -2035,6 +2144,48 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"query-logger",
 		"state-config-watcher",
 	},
+	"permission-expiry": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"api-remote-caller",
+		"audit-config-updater",
+		"change-stream",
+		"controller-agent-config",
+		"controller-log-sink",
+		"controller-trace",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-not-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"controller-log-router",
+		"log-router",
+		"log-sink",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"non-controller-log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace-services",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
 	"provider-services": {
 		"agent",
 		"change-stream",
@@ -2793,6 +2944,47 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
 	},
+	"audit-log-pruner": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"api-remote-caller",
+		"change-stream",
+		"controller-agent-config",
+		"controller-log-sink",
+		"controller-trace",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-not-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"controller-log-router",
+		"log-router",
+		"log-sink",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"non-controller-log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace-services",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
 	"bootstrap": {
 		"agent",
 		"api-caller",
@@ -2999,6 +3191,17 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"state-config-watcher",
 		"trace-services",
 	},
+	"otel-export": {
+		"agent",
+		"change-stream",
+		"controller-agent-config",
+		"db-accessor",
+		"file-notify-watcher",
+		"is-controller-flag",
+		"query-logger",
+		"state-config-watcher",
+		"trace-services",
+	},
 	"db-accessor": {
 		"agent",
 		"controller-agent-config",
@@ -3351,6 +3554,47 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"upgrade-steps-flag",
 		"upgrade-steps-gate",
 	},
+	"model-metrics": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"api-remote-caller",
+		"change-stream",
+		"controller-agent-config",
+		"controller-log-sink",
+		"controller-trace",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-not-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"controller-log-router",
+		"log-router",
+		"log-sink",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"non-controller-log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace-services",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
 	"model-worker-manager": {
 		"agent",
 		"api-caller",
@@ -3494,6 +3738,48 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"query-logger",
 		"state-config-watcher",
 	},
+	"permission-expiry": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"api-remote-caller",
+		"audit-config-updater",
+		"change-stream",
+		"controller-agent-config",
+		"controller-log-sink",
+		"controller-trace",
+		"db-accessor",
+		"domain-services",
+		"file-notify-watcher",
+		"http-client",
+		"is-controller-flag",
+		"is-not-controller-flag",
+		"is-primary-controller-flag",
+		"lease-manager",
+		"controller-log-router",
+		"log-router",
+		"log-sink",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"non-controller-log-sink",
+		"object-store",
+		"object-store-facade",
+		"object-store-fortress",
+		"object-store-s3-caller",
+		"object-store-services",
+		"provider-services",
+		"provider-tracker",
+		"query-logger",
+		"state-config-watcher",
+		"storage-registry",
+		"trace-services",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-database-flag",
+		"upgrade-database-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
 	"provider-services": {
 		"agent",
 		"change-stream",
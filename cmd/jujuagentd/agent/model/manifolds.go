@@ -45,7 +45,9 @@ import (
 	"github.com/juju/juju/internal/worker/modellife"
 	"github.com/juju/juju/internal/worker/modelworkermanager"
 	"github.com/juju/juju/internal/worker/operationpruner"
+	"github.com/juju/juju/internal/worker/operationscheduler"
 	"github.com/juju/juju/internal/worker/providertracker"
+	"github.com/juju/juju/internal/worker/refreshrollout"
 	"github.com/juju/juju/internal/worker/remoterelationconsumer"
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/consumerunitrelations"
 	"github.com/juju/juju/internal/worker/remoterelationconsumer/offererrelations"
@@ -97,6 +99,14 @@ type ManifoldsConfig struct {
 	// OperationPrunerInterval determines how often the operations are pruned
 	OperationPrunerInterval time.Duration
 
+	// OperationSchedulerInterval determines how often the model is checked
+	// for scheduled actions which have fallen due.
+	OperationSchedulerInterval time.Duration
+
+	// RefreshRolloutInterval determines how often staged refreshes of
+	// application charms are advanced.
+	RefreshRolloutInterval time.Duration
+
 	// ProviderServicesGetter is used to access the provider service.
 	ProviderServicesGetter modelworkermanager.ProviderServicesGetter
 
@@ -313,6 +323,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			HTTPClientName:     httpClientName,
 			NewHTTPClient:      charmrevisioner.NewHTTPClient,
 			NewCharmhubClient:  charmrevisioner.NewCharmhubClient,
+			NewOCIRepository:   charmrevisioner.NewOCIRepository,
 			Period:             config.CharmRevisionUpdateInterval,
 			NewWorker:          charmrevisioner.NewWorker,
 			ModelTag:           config.ModelTag,
@@ -402,6 +413,26 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Clock:              config.Clock,
 		}))),
 
+		// The operationScheduler worker runs the actions scheduled with
+		// `juju schedule-action` when they fall due.
+		operationSchedulerName: ifResponsible(ifNotMigrating(operationscheduler.Manifold(operationscheduler.ManifoldConfig{
+			ModelUUID:          model.UUID(config.ModelUUID),
+			DomainServicesName: domainServicesName,
+			Interval:           config.OperationSchedulerInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.operationscheduler"),
+			Clock:              config.Clock,
+		}))),
+
+		// The refreshRollout worker releases the units of applications with a
+		// staged refresh to the new charm a batch at a time, and pauses or
+		// rolls back the refresh when a batch fails.
+		refreshRolloutName: ifResponsible(ifNotMigrating(refreshrollout.Manifold(refreshrollout.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			Interval:           config.RefreshRolloutInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.refreshrollout"),
+			Clock:              config.Clock,
+		}))),
+
 		changeStreamPrunerName: ifResponsible(ifNotMigrating(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
 			DomainServiceName:      domainServicesName,
 			Clock:                  config.Clock,
@@ -626,12 +657,14 @@ const (
 	httpClientName               = "http-client"
 	instancePollerName           = "instance-poller"
 	operationPrunerName          = "operation-pruner"
+	operationSchedulerName       = "operation-scheduler"
 	leaseManagerName             = "lease-manager"
 	loggingConfigUpdaterName     = "logging-config-updater"
 	lokiEndpointUpdaterName      = "loki-endpoint-updater"
 	machineUndertakerName        = "machine-undertaker"
 	providerServiceFactoriesName = "provider-service-factories"
 	providerTrackerName          = "provider-tracker"
+	refreshRolloutName           = "refresh-rollout"
 	remoteRelationConsumerName   = "remote-relation-consumer"
 	remoteRelationOffererName    = "remote-relation-offerer"
 	removalName                  = "removal"
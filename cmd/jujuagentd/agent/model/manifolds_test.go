@@ -56,8 +56,10 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"migration-master",
 		"not-dead-flag",
 		"operation-pruner",
+		"operation-scheduler",
 		"provider-service-factories",
 		"provider-tracker",
+		"refresh-rollout",
 		"remote-relation-consumer",
 		"removal",
 		"secrets-pruner",
@@ -97,8 +99,10 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"migration-master",
 		"not-dead-flag",
 		"operation-pruner",
+		"operation-scheduler",
 		"provider-service-factories",
 		"provider-tracker",
+		"refresh-rollout",
 		"remote-relation-consumer",
 		"removal",
 		"secrets-pruner",
@@ -333,8 +337,26 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"operation-scheduler": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"provider-service-factories": {},
 
+	"refresh-rollout": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"remote-relation-consumer": {
 		"api-remote-relation-caller",
 		"domain-services",
@@ -540,8 +562,26 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"operation-scheduler": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"provider-service-factories": {},
 
+	"refresh-rollout": {
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"remote-relation-consumer": {
 		"api-remote-relation-caller",
 		"domain-services",
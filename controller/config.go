@@ -5,9 +5,11 @@ package controller
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/configschema"
 	"github.com/juju/juju/internal/pki"
 )
@@ -51,6 +54,23 @@ const (
 	// the token bucket, in milliseconds (ms).
 	AgentRateLimitRate = "agent-ratelimit-rate"
 
+	// APIRateLimitUserMax is the maximum size of the token bucket used to
+	// ratelimit the API requests made by each user. Use a value of 0 to
+	// disable rate limiting of user requests. Requests to watchers, and
+	// those keeping the connection alive, are never limited.
+	APIRateLimitUserMax = "api-ratelimit-user-max"
+
+	// APIRateLimitUserRate is the interval at which a new token is added to
+	// the token bucket of each user.
+	APIRateLimitUserRate = "api-ratelimit-user-rate"
+
+	// APIRateLimitFacades limits the API requests each user makes to
+	// individual facades, as a comma-separated list of facade=max/rate
+	// entries, eg "Client=10/1s,ModelManager=5/500ms". Each user has a token
+	// bucket of size max for each facade listed, with a new token added
+	// every rate.
+	APIRateLimitFacades = "api-ratelimit-facades"
+
 	// AuditingEnabled determines whether the controller will record
 	// auditing information.
 	AuditingEnabled = "auditing-enabled"
@@ -74,6 +94,64 @@ const (
 	// interesting calls though.)
 	AuditLogExcludeMethods = "audit-log-exclude-methods"
 
+	// AuditLogRetention is how long audit log records are kept in the
+	// controller database before being pruned, eg "720h".
+	AuditLogRetention = "audit-log-retention"
+
+	// AuditLogSyslogAddress is the host:port of an RFC5424 syslog
+	// receiver that audit records are forwarded to over TCP.
+	AuditLogSyslogAddress = "audit-log-syslog-address"
+
+	// AuditLogSyslogTLS determines whether audit records are forwarded
+	// to the syslog receiver over TLS.
+	AuditLogSyslogTLS = "audit-log-syslog-tls"
+
+	// AuditLogWebhookURL is the URL that batches of audit records are
+	// posted to as JSON.
+	AuditLogWebhookURL = "audit-log-webhook-url"
+
+	// AuditLogLokiURL is the URL of the Loki push API that audit records
+	// are forwarded to.
+	AuditLogLokiURL = "audit-log-loki-url"
+
+	// AuditLogSinkCACert is a PEM encoded CA certificate used to verify
+	// the TLS certificates of the audit log sinks, in addition to the
+	// system roots.
+	AuditLogSinkCACert = "audit-log-sink-ca-cert"
+
+	// CharmhubMirror determines whether the controller serves a mirror of
+	// the Charmhub API, which other controllers can use as their
+	// charmhub-url by setting it to
+	// https://<controller>:<api-port>/charmhub-mirror. Those controllers
+	// must trust the certificate served by this controller, and
+	// authenticate as a mirror client added through the control socket,
+	// holding its credential as the charm registry credential for
+	// <controller>:<api-port>. Resources are not mirrored, so charms
+	// declaring resources can't be deployed from the mirror.
+	CharmhubMirror = "charmhub-mirror"
+
+	// CharmhubMirrorUpstream is the URL of the Charmhub API that the mirror
+	// fetches the charms it does not hold from. If empty, the mirror only
+	// serves the charms it has been seeded with.
+	CharmhubMirrorUpstream = "charmhub-mirror-upstream"
+
+	// CharmhubMirrorMaxArchiveSize is the maximum size of a charm or bundle
+	// archive the mirror fetches from its upstream, eg "1G".
+	CharmhubMirrorMaxArchiveSize = "charmhub-mirror-max-archive-size"
+
+	// CharmhubMirrorMaxSize is the maximum total size of the archives the
+	// mirror caches from its upstream, eg "20G". When it is exceeded, the
+	// revisions cached longest ago are evicted. Seeded revisions are never
+	// evicted.
+	CharmhubMirrorMaxSize = "charmhub-mirror-max-size"
+
+	// CharmSigningKeys holds the public keys, in authorized_keys or PEM
+	// format, that are trusted to sign charm and resource archives. The
+	// comment of an authorized_keys key names the signer; a PEM key, such as
+	// a cosign.pub, is named by its fingerprint. How unsigned or untrusted archives are
+	// treated is decided by the charm-signature-policy model config.
+	CharmSigningKeys = "charm-signing-keys"
+
 	// ReadOnlyMethodsWildcard is the special value that can be added
 	// to the exclude-methods list that represents all of the read
 	// only methods (see apiserver/observer/auditfilter.go). This
@@ -94,6 +172,30 @@ const (
 	// permissions model.
 	LoginTokenRefreshURL = "login-token-refresh-url"
 
+	// OIDCIssuerURL sets the URL of an OpenID Connect identity provider
+	// whose ID tokens users can log in with, eg "https://idp.example.com".
+	// The provider's configuration is discovered from the
+	// .well-known/openid-configuration document below the URL. Users are
+	// identified by the subject of their tokens, in a user domain made of
+	// the host, port and path of the URL joined with "+", eg
+	// "1234@idp.example.com".
+	OIDCIssuerURL = "oidc-issuer-url"
+
+	// OIDCClientID is the client ID the controller is registered with at
+	// the OpenID Connect identity provider. ID tokens must be issued to
+	// this client to be accepted.
+	OIDCClientID = "oidc-client-id"
+
+	// OIDCGroupsClaim is the name of the ID token claim holding the groups
+	// of the identity provider that the user is a member of.
+	OIDCGroupsClaim = "oidc-groups-claim"
+
+	// OIDCGroupMapping maps the groups of the OpenID Connect identity
+	// provider to Juju user groups, as a comma-separated list of
+	// idp-group=juju-group pairs, eg "platform-admins=admins,dev=developers".
+	// Groups of the identity provider which are not mapped are ignored.
+	OIDCGroupMapping = "oidc-group-mapping"
+
 	// IdentityURL sets the URL of the identity manager.
 	// Use this when users should be managed externally rather than
 	// created locally on the controller.
@@ -289,6 +391,14 @@ const (
 	// second. A token is added to the ratelimit token bucket every 250ms.
 	DefaultAgentRateLimitRate = 250 * time.Millisecond
 
+	// DefaultAPIRateLimitUserMax disables the rate limiting of user API
+	// requests.
+	DefaultAPIRateLimitUserMax = 0
+
+	// DefaultAPIRateLimitUserRate will allow ten API requests from each user
+	// every second once the bucket is empty.
+	DefaultAPIRateLimitUserRate = 100 * time.Millisecond
+
 	// DefaultAuditingEnabled contains the default value for the
 	// AuditingEnabled config value.
 	DefaultAuditingEnabled = true
@@ -305,6 +415,30 @@ const (
 	// keep.
 	DefaultAuditLogMaxBackups = 10
 
+	// DefaultAuditLogRetention is the default length of time audit log
+	// records are kept in the controller database.
+	DefaultAuditLogRetention = 30 * 24 * time.Hour
+
+	// DefaultAuditLogSyslogTLS is the default for the AuditLogSyslogTLS
+	// setting (which is to connect without TLS).
+	DefaultAuditLogSyslogTLS = false
+
+	// DefaultCharmhubMirror is the default for the CharmhubMirror setting
+	// (which is not to serve a mirror).
+	DefaultCharmhubMirror = false
+
+	// DefaultCharmhubMirrorMaxArchiveSize is the default size in MB of the
+	// largest archive the Charmhub mirror fetches from its upstream.
+	DefaultCharmhubMirrorMaxArchiveSize = 1024
+
+	// DefaultCharmhubMirrorMaxSize is the default size in MB of the archives
+	// the Charmhub mirror caches from its upstream.
+	DefaultCharmhubMirrorMaxSize = 20 * 1024
+
+	// DefaultOIDCGroupsClaim is the default ID token claim holding the
+	// groups of a user.
+	DefaultOIDCGroupsClaim = "groups"
+
 	// DefaultNUMAControlPolicy should not be used by default.
 	// Only use numactl if user specifically requests it
 	DefaultNUMAControlPolicy = false
@@ -423,6 +557,9 @@ var (
 		AllowModelAccessKey,
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		APIRateLimitUserMax,
+		APIRateLimitUserRate,
+		APIRateLimitFacades,
 		APIPort,
 		IdleConnectionTimeout,
 		HTTPServerReadTimeout,
@@ -433,6 +570,10 @@ var (
 		ControllerName,
 		ControllerUUIDKey,
 		LoginTokenRefreshURL,
+		OIDCIssuerURL,
+		OIDCClientID,
+		OIDCGroupsClaim,
+		OIDCGroupMapping,
 		IdentityPublicKey,
 		IdentityURL,
 		SetNUMAControlPolicyKey,
@@ -453,8 +594,19 @@ var (
 		AuditLogMaxSize,
 		AuditLogMaxBackups,
 		AuditLogExcludeMethods,
+		AuditLogRetention,
+		AuditLogSyslogAddress,
+		AuditLogSyslogTLS,
+		AuditLogWebhookURL,
+		AuditLogLokiURL,
+		AuditLogSinkCACert,
 		CAASOperatorImagePath,
 		CAASImageRepo,
+		CharmhubMirror,
+		CharmhubMirrorUpstream,
+		CharmhubMirrorMaxArchiveSize,
+		CharmhubMirrorMaxSize,
+		CharmSigningKeys,
 		Features,
 		MaxCharmStateSize,
 		MaxAgentStateSize,
@@ -489,6 +641,9 @@ var (
 		AgentLogfileMaxSize,
 		AgentRateLimitMax,
 		AgentRateLimitRate,
+		APIRateLimitUserMax,
+		APIRateLimitUserRate,
+		APIRateLimitFacades,
 		IdleConnectionTimeout,
 		HTTPServerReadTimeout,
 		HTTPServerWriteTimeout,
@@ -498,7 +653,18 @@ var (
 		AuditLogExcludeMethods,
 		AuditLogMaxBackups,
 		AuditLogMaxSize,
+		AuditLogRetention,
+		AuditLogSyslogAddress,
+		AuditLogSyslogTLS,
+		AuditLogWebhookURL,
+		AuditLogLokiURL,
+		AuditLogSinkCACert,
 		CAASImageRepo,
+		CharmhubMirror,
+		CharmhubMirrorUpstream,
+		CharmhubMirrorMaxArchiveSize,
+		CharmhubMirrorMaxSize,
+		CharmSigningKeys,
 		ControllerResourceDownloadLimit,
 		Features,
 		JujuManagementSpace,
@@ -717,6 +883,72 @@ func (c Config) AgentRateLimitRate() time.Duration {
 	return c.durationOrDefault(AgentRateLimitRate, DefaultAgentRateLimitRate)
 }
 
+// APIRateLimitUserMax is the size of the token bucket that is used to rate
+// limit the API requests of each user. A value of 0 disables rate limiting.
+func (c Config) APIRateLimitUserMax() int {
+	switch v := c[APIRateLimitUserMax].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		// nil type shows up here
+	}
+	return DefaultAPIRateLimitUserMax
+}
+
+// APIRateLimitUserRate is the time taken to add a token into the token bucket
+// that is used to rate limit the API requests of each user.
+func (c Config) APIRateLimitUserRate() time.Duration {
+	return c.durationOrDefault(APIRateLimitUserRate, DefaultAPIRateLimitUserRate)
+}
+
+// APIRateLimit describes a token bucket used to rate limit API requests.
+type APIRateLimit struct {
+	// Max is the size of the token bucket.
+	Max int
+	// Rate is the interval at which a new token is added to the bucket.
+	Rate time.Duration
+}
+
+// APIRateLimitFacades returns the rate limits applied to the API requests
+// each user makes to individual facades, keyed by facade name.
+func (c Config) APIRateLimitFacades() map[string]APIRateLimit {
+	limits, _ := parseAPIRateLimitFacades(c.asString(APIRateLimitFacades))
+	return limits
+}
+
+// parseAPIRateLimitFacades parses a comma-separated list of facade=max/rate
+// entries.
+func parseAPIRateLimitFacades(v string) (map[string]APIRateLimit, error) {
+	limits := make(map[string]APIRateLimit)
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		facade, limit, ok := strings.Cut(entry, "=")
+		maxValue, rateValue, ok2 := strings.Cut(limit, "/")
+		facade = strings.TrimSpace(facade)
+		if !ok || !ok2 || facade == "" {
+			return nil, errors.Errorf("expected facade=max/rate, got %q", entry)
+		}
+		if _, ok := limits[facade]; ok {
+			return nil, errors.Errorf("facade %q limited more than once", facade)
+		}
+		maxTokens, err := strconv.Atoi(strings.TrimSpace(maxValue))
+		if err != nil || maxTokens <= 0 {
+			return nil, errors.Errorf("expected a positive max for facade %q, got %q", facade, maxValue)
+		}
+		rate, err := time.ParseDuration(strings.TrimSpace(rateValue))
+		if err != nil || rate <= 0 || rate > time.Minute {
+			return nil, errors.Errorf("expected a rate between 0..1m for facade %q, got %q", facade, rateValue)
+		}
+		limits[facade] = APIRateLimit{Max: maxTokens, Rate: rate}
+	}
+	return limits, nil
+}
+
 // AuditingEnabled returns whether or not auditing has been enabled
 // for the environment. The default is false.
 func (c Config) AuditingEnabled() bool {
@@ -758,6 +990,79 @@ func (c Config) AuditLogExcludeMethods() set.Strings {
 	return set.NewStrings(strings.Split(v, ",")...)
 }
 
+// AuditLogRetention returns how long audit log records are kept in the
+// controller database.
+func (c Config) AuditLogRetention() time.Duration {
+	return c.durationOrDefault(AuditLogRetention, DefaultAuditLogRetention)
+}
+
+// AuditLogSyslogAddress returns the host:port of the syslog receiver that
+// audit records are forwarded to, or "" if they are not.
+func (c Config) AuditLogSyslogAddress() string {
+	return c.asString(AuditLogSyslogAddress)
+}
+
+// AuditLogSyslogTLS returns whether audit records are forwarded to the
+// syslog receiver over TLS.
+func (c Config) AuditLogSyslogTLS() bool {
+	if v, ok := c[AuditLogSyslogTLS]; ok {
+		return v.(bool)
+	}
+	return DefaultAuditLogSyslogTLS
+}
+
+// AuditLogWebhookURL returns the URL that audit records are posted to, or
+// "" if they are not.
+func (c Config) AuditLogWebhookURL() string {
+	return c.asString(AuditLogWebhookURL)
+}
+
+// AuditLogLokiURL returns the URL of the Loki push API that audit records
+// are forwarded to, or "" if they are not.
+func (c Config) AuditLogLokiURL() string {
+	return c.asString(AuditLogLokiURL)
+}
+
+// CharmhubMirror returns whether the controller serves a mirror of the
+// Charmhub API.
+func (c Config) CharmhubMirror() bool {
+	if v, ok := c[CharmhubMirror]; ok {
+		return v.(bool)
+	}
+	return DefaultCharmhubMirror
+}
+
+// CharmhubMirrorUpstream returns the URL of the Charmhub API the mirror
+// fetches charms it does not hold from, or "" if the mirror is offline.
+func (c Config) CharmhubMirrorUpstream() string {
+	return c.asString(CharmhubMirrorUpstream)
+}
+
+// CharmhubMirrorMaxArchiveSizeMB returns the size in MB of the largest
+// archive the Charmhub mirror fetches from its upstream.
+func (c Config) CharmhubMirrorMaxArchiveSizeMB() int {
+	return c.sizeMBOrDefault(CharmhubMirrorMaxArchiveSize, DefaultCharmhubMirrorMaxArchiveSize)
+}
+
+// CharmhubMirrorMaxSizeMB returns the size in MB of the archives the
+// Charmhub mirror caches from its upstream before evicting the oldest.
+func (c Config) CharmhubMirrorMaxSizeMB() int {
+	return c.sizeMBOrDefault(CharmhubMirrorMaxSize, DefaultCharmhubMirrorMaxSize)
+}
+
+// CharmSigningKeys returns the public keys, in authorized_keys or PEM format,
+// that are trusted to sign charm and resource archives.
+func (c Config) CharmSigningKeys() string {
+	return c.asString(CharmSigningKeys)
+}
+
+// AuditLogSinkCACert returns the PEM encoded CA certificate used to verify
+// the TLS certificates of the audit log sinks, or "" if only the system
+// roots are used.
+func (c Config) AuditLogSinkCACert() string {
+	return c.asString(AuditLogSinkCACert)
+}
+
 // Features returns the controller config set features flags.
 func (c Config) Features() set.Strings {
 	v := c.asString(Features)
@@ -828,6 +1133,57 @@ func (c Config) LoginTokenRefreshURL() string {
 	return c.asString(LoginTokenRefreshURL)
 }
 
+// OIDCIssuerURL returns the URL of the OpenID Connect identity provider
+// users can log in with, or "" if there is none.
+func (c Config) OIDCIssuerURL() string {
+	return c.asString(OIDCIssuerURL)
+}
+
+// OIDCClientID returns the client ID the controller is registered with at
+// the OpenID Connect identity provider.
+func (c Config) OIDCClientID() string {
+	return c.asString(OIDCClientID)
+}
+
+// OIDCGroupsClaim returns the name of the ID token claim holding the groups
+// a user is a member of.
+func (c Config) OIDCGroupsClaim() string {
+	if v := c.asString(OIDCGroupsClaim); v != "" {
+		return v
+	}
+	return DefaultOIDCGroupsClaim
+}
+
+// OIDCGroupMapping returns the Juju user group each group of the OpenID
+// Connect identity provider maps to, keyed by the group of the identity
+// provider.
+func (c Config) OIDCGroupMapping() map[string]string {
+	mapping, _ := parseOIDCGroupMapping(c.asString(OIDCGroupMapping))
+	return mapping
+}
+
+// parseOIDCGroupMapping parses a comma-separated list of idp-group=juju-group
+// pairs.
+func parseOIDCGroupMapping(v string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(pair, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, errors.Errorf("expected idp-group=juju-group, got %q", pair)
+		}
+		if _, ok := mapping[from]; ok {
+			return nil, errors.Errorf("group %q mapped more than once", from)
+		}
+		mapping[from] = to
+	}
+	return mapping, nil
+}
+
 // JujudControllerSnapSource returns the source of the jujud-controller snap.
 func (c Config) JujudControllerSnapSource() string {
 	if src, ok := c[JujudControllerSnapSource]; ok {
@@ -1019,6 +1375,25 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[OIDCIssuerURL].(string); ok && v != "" {
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", OIDCIssuerURL)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid %s in configuration: expected an http or https URL, got %q", OIDCIssuerURL, v)
+		}
+		if c.OIDCClientID() == "" {
+			return errors.Errorf("%s is required when %s is set", OIDCClientID, OIDCIssuerURL)
+		}
+	}
+
+	if v, ok := c[OIDCGroupMapping].(string); ok {
+		if _, err := parseOIDCGroupMapping(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", OIDCGroupMapping)
+		}
+	}
+
 	caCert, caCertOK := c.CACert()
 	if !caCertOK {
 		return errors.Errorf("missing CA certificate")
@@ -1063,6 +1438,26 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[APIRateLimitUserMax].(int); ok {
+		if v < 0 {
+			return errors.NotValidf("negative %s (%d)", APIRateLimitUserMax, v)
+		}
+	}
+
+	if v, err := parseDuration(c, APIRateLimitUserRate); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "parsing %s in configuration", APIRateLimitUserRate)
+	} else if err == nil {
+		if v <= 0 || v > time.Minute {
+			return errors.Errorf("%s must be between 0..1m", APIRateLimitUserRate)
+		}
+	}
+
+	if v, ok := c[APIRateLimitFacades].(string); ok {
+		if _, err := parseAPIRateLimitFacades(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", APIRateLimitFacades)
+		}
+	}
+
 	if v, err := parseDuration(c, MaxDebugLogDuration); err != nil && !errors.Is(err, errors.NotFound) {
 		return errors.Annotatef(err, "parsing %s in configuration", MaxDebugLogDuration)
 	} else if err == nil {
@@ -1071,6 +1466,14 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, err := parseDuration(c, AuditLogRetention); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Annotatef(err, "parsing %s in configuration", AuditLogRetention)
+	} else if err == nil {
+		if v <= 0 {
+			return errors.Errorf("%s must be a positive duration", AuditLogRetention)
+		}
+	}
+
 	if v, ok := c[AgentLogfileMaxBackups].(int); ok {
 		if v < 0 {
 			return errors.NotValidf("negative %s", AgentLogfileMaxBackups)
@@ -1101,6 +1504,20 @@ func Validate(c Config) error {
 		}
 	}
 
+	for _, key := range []string{CharmhubMirrorMaxArchiveSize, CharmhubMirrorMaxSize} {
+		v, ok := c[key].(string)
+		if !ok {
+			continue
+		}
+		mb, err := utils.ParseSize(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", key)
+		}
+		if mb < 1 {
+			return errors.NotValidf("%s less than 1 MB", key)
+		}
+	}
+
 	if v, ok := c[MaxTxnLogSize].(string); ok {
 		if _, err := utils.ParseSize(v); err != nil {
 			return errors.Annotate(err, "invalid max txn log size in configuration")
@@ -1152,6 +1569,40 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[AuditLogSyslogAddress].(string); ok && v != "" {
+		if _, _, err := net.SplitHostPort(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", AuditLogSyslogAddress)
+		}
+	}
+
+	for _, key := range []string{AuditLogWebhookURL, AuditLogLokiURL, CharmhubMirrorUpstream} {
+		v, ok := c[key].(string)
+		if !ok || v == "" {
+			continue
+		}
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", key)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid %s in configuration: expected an http or https URL, got %q", key, v)
+		}
+	}
+
+	if v, ok := c[CharmSigningKeys].(string); ok && v != "" {
+		if _, err := charmsignature.ParseTrustedKeys(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", CharmSigningKeys)
+		}
+	}
+
+	if v, ok := c[AuditLogSinkCACert].(string); ok && v != "" {
+		if ok, err := pki.IsPemCA([]byte(v)); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", AuditLogSinkCACert)
+		} else if !ok {
+			return errors.Errorf("invalid %s in configuration: certificate is not a CA", AuditLogSinkCACert)
+		}
+	}
+
 	if v, ok := c[ControllerName].(string); ok {
 		if !names.IsValidControllerName(v) {
 			return errors.Errorf("%s value must be a valid controller name (lowercase or digit with non-leading hyphen), got %q", ControllerName, v)
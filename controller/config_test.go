@@ -203,6 +203,66 @@ var newConfigTests = []struct {
 		controller.MaxDebugLogDuration: time.Duration(0),
 	},
 	expectError: `max-debug-log-duration cannot be zero`,
+}, {
+	about: "audit-log-retention not valid",
+	config: controller.Config{
+		controller.AuditLogRetention: -time.Hour,
+	},
+	expectError: `audit-log-retention must be a positive duration`,
+}, {
+	about: "audit-log-syslog-address not valid",
+	config: controller.Config{
+		controller.AuditLogSyslogAddress: "syslog.example.com",
+	},
+	expectError: `invalid audit-log-syslog-address in configuration: address syslog.example.com: missing port in address`,
+}, {
+	about: "audit-log-webhook-url not valid",
+	config: controller.Config{
+		controller.AuditLogWebhookURL: "ftp://audit.example.com",
+	},
+	expectError: `invalid audit-log-webhook-url in configuration: expected an http or https URL, got "ftp://audit.example.com"`,
+}, {
+	about: "audit-log-loki-url not valid",
+	config: controller.Config{
+		controller.AuditLogLokiURL: "loki:3100",
+	},
+	expectError: `invalid audit-log-loki-url in configuration: expected an http or https URL, got "loki:3100"`,
+}, {
+	about: "charmhub-mirror-upstream not valid",
+	config: controller.Config{
+		controller.CharmhubMirrorUpstream: "api.charmhub.io",
+	},
+	expectError: `invalid charmhub-mirror-upstream in configuration: expected an http or https URL, got "api.charmhub.io"`,
+}, {
+	about: "charmhub-mirror-max-size not valid",
+	config: controller.Config{
+		controller.CharmhubMirrorMaxSize: "lots",
+	},
+	expectError: `invalid charmhub-mirror-max-size in configuration: .*`,
+}, {
+	about: "charmhub-mirror-max-archive-size less than 1 MB",
+	config: controller.Config{
+		controller.CharmhubMirrorMaxArchiveSize: "0",
+	},
+	expectError: `charmhub-mirror-max-archive-size less than 1 MB not valid`,
+}, {
+	about: "charm-signing-keys not valid",
+	config: controller.Config{
+		controller.CharmSigningKeys: "ssh-ed25519 not-a-key",
+	},
+	expectError: `invalid charm-signing-keys in configuration: parsing signing key on line 1: .*`,
+}, {
+	about: "audit-log-sink-ca-cert not valid",
+	config: controller.Config{
+		controller.AuditLogSinkCACert: "foo",
+	},
+	expectError: `invalid audit-log-sink-ca-cert in configuration: .*`,
+}, {
+	about: "audit-log-sink-ca-cert not a CA",
+	config: controller.Config{
+		controller.AuditLogSinkCACert: testing.ServerCert,
+	},
+	expectError: `invalid audit-log-sink-ca-cert in configuration: certificate is not a CA`,
 }, {
 	about: "agent-logfile-max-backups not valid",
 	config: controller.Config{
@@ -269,6 +329,36 @@ var newConfigTests = []struct {
 		controller.AgentRateLimitRate: "4h",
 	},
 	expectError: `agent-ratelimit-rate must be between 0..1m`,
+}, {
+	about: "api-ratelimit-user-max negative",
+	config: controller.Config{
+		controller.APIRateLimitUserMax: "-5",
+	},
+	expectError: `negative api-ratelimit-user-max \(-5\) not valid`,
+}, {
+	about: "api-ratelimit-user-rate zero",
+	config: controller.Config{
+		controller.APIRateLimitUserRate: "0s",
+	},
+	expectError: `api-ratelimit-user-rate must be between 0..1m`,
+}, {
+	about: "api-ratelimit-facades missing rate",
+	config: controller.Config{
+		controller.APIRateLimitFacades: "Client=10",
+	},
+	expectError: `invalid api-ratelimit-facades in configuration: expected facade=max/rate, got "Client=10"`,
+}, {
+	about: "api-ratelimit-facades bad max",
+	config: controller.Config{
+		controller.APIRateLimitFacades: "Client=0/1s",
+	},
+	expectError: `invalid api-ratelimit-facades in configuration: expected a positive max for facade "Client", got "0"`,
+}, {
+	about: "api-ratelimit-facades duplicate",
+	config: controller.Config{
+		controller.APIRateLimitFacades: "Client=10/1s,Client=5/1s",
+	},
+	expectError: `invalid api-ratelimit-facades in configuration: facade "Client" limited more than once`,
 }, {
 	about: "max-charm-state-size non-int",
 	config: controller.Config{
@@ -335,6 +425,37 @@ var newConfigTests = []struct {
 		controller.LoginTokenRefreshURL: `xxxx`,
 	},
 	expectError: `logic token refresh URL "xxxx" not valid`,
+}, {
+	about: "oidc issuer url",
+	config: controller.Config{
+		controller.OIDCIssuerURL: "https://idp.example.com",
+		controller.OIDCClientID:  "juju",
+	},
+}, {
+	about: "oidc issuer url not valid",
+	config: controller.Config{
+		controller.OIDCIssuerURL: "idp.example.com",
+		controller.OIDCClientID:  "juju",
+	},
+	expectError: `invalid oidc-issuer-url in configuration: expected an http or https URL, got "idp.example.com"`,
+}, {
+	about: "oidc issuer url without client id",
+	config: controller.Config{
+		controller.OIDCIssuerURL: "https://idp.example.com",
+	},
+	expectError: `oidc-client-id is required when oidc-issuer-url is set`,
+}, {
+	about: "oidc group mapping not valid",
+	config: controller.Config{
+		controller.OIDCGroupMapping: "admins=admins,developers",
+	},
+	expectError: `invalid oidc-group-mapping in configuration: expected idp-group=juju-group, got "developers"`,
+}, {
+	about: "oidc group mapping duplicate",
+	config: controller.Config{
+		controller.OIDCGroupMapping: "admins=admins,admins=sre",
+	},
+	expectError: `invalid oidc-group-mapping in configuration: group "admins" mapped more than once`,
 }, {
 	about: "invalid query tracing value",
 	config: controller.Config{
@@ -680,6 +801,120 @@ func (s *ConfigSuite) TestMaxDebugLogDurationSchemaCoerce(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `max-debug-log-duration: conversion to duration: time: missing unit in duration "?12"?`)
 }
 
+func (s *ConfigSuite) TestAuditLogRetention(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"audit-log-retention": "168h",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cfg.AuditLogRetention(), tc.Equals, 7*24*time.Hour)
+}
+
+func (s *ConfigSuite) TestAuditLogSinks(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"audit-log-syslog-address": "syslog.example.com:6514",
+			"audit-log-syslog-tls":     true,
+			"audit-log-webhook-url":    "https://audit.example.com/events",
+			"audit-log-loki-url":       "http://loki:3100/loki/api/v1/push",
+			"audit-log-sink-ca-cert":   testing.CACert,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.AuditLogSyslogAddress(), tc.Equals, "syslog.example.com:6514")
+	c.Check(cfg.AuditLogSyslogTLS(), tc.IsTrue)
+	c.Check(cfg.AuditLogWebhookURL(), tc.Equals, "https://audit.example.com/events")
+	c.Check(cfg.AuditLogLokiURL(), tc.Equals, "http://loki:3100/loki/api/v1/push")
+	c.Check(cfg.AuditLogSinkCACert(), tc.Equals, testing.CACert)
+}
+
+func (s *ConfigSuite) TestCharmhubMirror(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"charmhub-mirror":                  true,
+			"charmhub-mirror-upstream":         "https://api.charmhub.io",
+			"charmhub-mirror-max-archive-size": "100M",
+			"charmhub-mirror-max-size":         "2G",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.CharmhubMirror(), tc.IsTrue)
+	c.Check(cfg.CharmhubMirrorUpstream(), tc.Equals, "https://api.charmhub.io")
+	c.Check(cfg.CharmhubMirrorMaxArchiveSizeMB(), tc.Equals, 100)
+	c.Check(cfg.CharmhubMirrorMaxSizeMB(), tc.Equals, 2048)
+}
+
+func (s *ConfigSuite) TestCharmSigningKeys(c *tc.C) {
+	keys := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAsTPebwnCM4FvJFZXZZ+8TvjK9VhDSrOgTNXi8Rb+24 release-team"
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"charm-signing-keys": keys,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.CharmSigningKeys(), tc.Equals, keys)
+}
+
+func (s *ConfigSuite) TestOIDC(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"oidc-issuer-url":    "https://idp.example.com",
+			"oidc-client-id":     "juju",
+			"oidc-groups-claim":  "roles",
+			"oidc-group-mapping": "platform-admins=admins, dev = developers",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.OIDCIssuerURL(), tc.Equals, "https://idp.example.com")
+	c.Check(cfg.OIDCClientID(), tc.Equals, "juju")
+	c.Check(cfg.OIDCGroupsClaim(), tc.Equals, "roles")
+	c.Check(cfg.OIDCGroupMapping(), tc.DeepEquals, map[string]string{
+		"platform-admins": "admins",
+		"dev":             "developers",
+	})
+}
+
+func (s *ConfigSuite) TestAPIRateLimits(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]any{
+			"api-ratelimit-user-max":  20,
+			"api-ratelimit-user-rate": "50ms",
+			"api-ratelimit-facades":   "Client=10/1s, ModelManager = 5/500ms",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.APIRateLimitUserMax(), tc.Equals, 20)
+	c.Check(cfg.APIRateLimitUserRate(), tc.Equals, 50*time.Millisecond)
+	c.Check(cfg.APIRateLimitFacades(), tc.DeepEquals, map[string]controller.APIRateLimit{
+		"Client":       {Max: 10, Rate: time.Second},
+		"ModelManager": {Max: 5, Rate: 500 * time.Millisecond},
+	})
+}
+
+func (s *ConfigSuite) TestAPIRateLimitsDefaults(c *tc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.APIRateLimitUserMax(), tc.Equals, 0)
+	c.Check(cfg.APIRateLimitUserRate(), tc.Equals, 100*time.Millisecond)
+	c.Check(cfg.APIRateLimitFacades(), tc.HasLen, 0)
+
+	cfg[controller.APIRateLimitUserMax] = 0
+	c.Check(cfg.APIRateLimitUserMax(), tc.Equals, 0)
+}
+
 func (s *ConfigSuite) TestFeatureFlags(c *tc.C) {
 	cfg, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
@@ -702,6 +937,17 @@ func (s *ConfigSuite) TestDefaults(c *tc.C) {
 	c.Assert(cfg.AgentRateLimitMax(), tc.Equals, controller.DefaultAgentRateLimitMax)
 	c.Assert(cfg.AgentRateLimitRate(), tc.Equals, controller.DefaultAgentRateLimitRate)
 	c.Assert(cfg.MaxDebugLogDuration(), tc.Equals, controller.DefaultMaxDebugLogDuration)
+	c.Assert(cfg.AuditLogRetention(), tc.Equals, controller.DefaultAuditLogRetention)
+	c.Assert(cfg.AuditLogSyslogTLS(), tc.Equals, controller.DefaultAuditLogSyslogTLS)
+	c.Assert(cfg.AuditLogSyslogAddress(), tc.Equals, "")
+	c.Assert(cfg.CharmhubMirror(), tc.Equals, controller.DefaultCharmhubMirror)
+	c.Assert(cfg.CharmhubMirrorUpstream(), tc.Equals, "")
+	c.Assert(cfg.CharmhubMirrorMaxArchiveSizeMB(), tc.Equals, controller.DefaultCharmhubMirrorMaxArchiveSize)
+	c.Assert(cfg.CharmhubMirrorMaxSizeMB(), tc.Equals, controller.DefaultCharmhubMirrorMaxSize)
+	c.Assert(cfg.CharmSigningKeys(), tc.Equals, "")
+	c.Assert(cfg.OIDCIssuerURL(), tc.Equals, "")
+	c.Assert(cfg.OIDCGroupsClaim(), tc.Equals, controller.DefaultOIDCGroupsClaim)
+	c.Assert(cfg.OIDCGroupMapping(), tc.HasLen, 0)
 	c.Assert(cfg.AgentLogfileMaxBackups(), tc.Equals, controller.DefaultAgentLogfileMaxBackups)
 	c.Assert(cfg.AgentLogfileMaxSizeMB(), tc.Equals, controller.DefaultAgentLogfileMaxSize)
 	c.Assert(cfg.ModelLogfileMaxBackups(), tc.Equals, controller.DefaultModelLogfileMaxBackups)
@@ -14,14 +14,32 @@ import (
 var configChecker = schema.FieldMap(schema.Fields{
 	AgentRateLimitMax:                schema.ForceInt(),
 	AgentRateLimitRate:               schema.TimeDurationString(),
+	APIRateLimitUserMax:              schema.ForceInt(),
+	APIRateLimitUserRate:             schema.TimeDurationString(),
+	APIRateLimitFacades:              schema.String(),
 	AuditingEnabled:                  schema.Bool(),
 	AuditLogCaptureArgs:              schema.Bool(),
 	AuditLogMaxSize:                  schema.String(),
 	AuditLogMaxBackups:               schema.ForceInt(),
 	AuditLogExcludeMethods:           schema.String(),
+	AuditLogRetention:                schema.TimeDurationString(),
+	AuditLogSyslogAddress:            schema.String(),
+	AuditLogSyslogTLS:                schema.Bool(),
+	AuditLogWebhookURL:               schema.String(),
+	AuditLogLokiURL:                  schema.String(),
+	AuditLogSinkCACert:               schema.String(),
+	CharmhubMirror:                   schema.Bool(),
+	CharmhubMirrorUpstream:           schema.String(),
+	CharmhubMirrorMaxArchiveSize:     schema.String(),
+	CharmhubMirrorMaxSize:            schema.String(),
+	CharmSigningKeys:                 schema.String(),
 	APIPort:                          schema.ForceInt(),
 	ControllerName:                   schema.NonEmptyString(ControllerName),
 	LoginTokenRefreshURL:             schema.String(),
+	OIDCIssuerURL:                    schema.String(),
+	OIDCClientID:                     schema.String(),
+	OIDCGroupsClaim:                  schema.String(),
+	OIDCGroupMapping:                 schema.String(),
 	IdentityURL:                      schema.String(),
 	IdentityPublicKey:                schema.String(),
 	IdleConnectionTimeout:            schema.TimeDuration(),
@@ -61,6 +79,9 @@ var configChecker = schema.FieldMap(schema.Fields{
 }, schema.Defaults{
 	AgentRateLimitMax:                schema.Omit,
 	AgentRateLimitRate:               schema.Omit,
+	APIRateLimitUserMax:              schema.Omit,
+	APIRateLimitUserRate:             schema.Omit,
+	APIRateLimitFacades:              schema.Omit,
 	APIPort:                          DefaultAPIPort,
 	ControllerName:                   schema.Omit,
 	AuditingEnabled:                  DefaultAuditingEnabled,
@@ -68,7 +89,22 @@ var configChecker = schema.FieldMap(schema.Fields{
 	AuditLogMaxSize:                  fmt.Sprintf("%vM", DefaultAuditLogMaxSizeMB),
 	AuditLogMaxBackups:               DefaultAuditLogMaxBackups,
 	AuditLogExcludeMethods:           DefaultAuditLogExcludeMethods,
+	AuditLogRetention:                DefaultAuditLogRetention,
+	AuditLogSyslogAddress:            schema.Omit,
+	AuditLogSyslogTLS:                DefaultAuditLogSyslogTLS,
+	AuditLogWebhookURL:               schema.Omit,
+	AuditLogLokiURL:                  schema.Omit,
+	AuditLogSinkCACert:               schema.Omit,
+	CharmhubMirror:                   DefaultCharmhubMirror,
+	CharmhubMirrorUpstream:           schema.Omit,
+	CharmhubMirrorMaxArchiveSize:     fmt.Sprintf("%vM", DefaultCharmhubMirrorMaxArchiveSize),
+	CharmhubMirrorMaxSize:            fmt.Sprintf("%vM", DefaultCharmhubMirrorMaxSize),
+	CharmSigningKeys:                 schema.Omit,
 	LoginTokenRefreshURL:             schema.Omit,
+	OIDCIssuerURL:                    schema.Omit,
+	OIDCClientID:                     schema.Omit,
+	OIDCGroupsClaim:                  DefaultOIDCGroupsClaim,
+	OIDCGroupMapping:                 schema.Omit,
 	IdentityURL:                      schema.Omit,
 	IdentityPublicKey:                schema.Omit,
 	IdleConnectionTimeout:            DefaultIdleConnectionTimeout,
@@ -126,6 +162,18 @@ var ConfigSchema = configschema.Fields{
 		Description: "The time taken to add a new token to the ratelimit bucket",
 		Type:        configschema.Tstring,
 	},
+	APIRateLimitUserMax: {
+		Description: "The maximum size of the token bucket used to ratelimit the API requests of each user",
+		Type:        configschema.Tint,
+	},
+	APIRateLimitUserRate: {
+		Description: "The time taken to add a new token to the ratelimit bucket of each user",
+		Type:        configschema.Tstring,
+	},
+	APIRateLimitFacades: {
+		Description: `A comma-separated list of facade=max/rate entries rate limiting the API requests of each user to individual facades`,
+		Type:        configschema.Tstring,
+	},
 	AuditingEnabled: {
 		Description: "Determines if the controller records auditing information",
 		Type:        configschema.Tbool,
@@ -146,6 +194,50 @@ var ConfigSchema = configschema.Fields{
 		Type:        configschema.Tstring,
 		Description: "A comma-delimited list of Facade.Method names that aren't interesting for audit logging purposes.",
 	},
+	AuditLogRetention: {
+		Type:        configschema.Tstring,
+		Description: "How long audit log records are kept in the controller database before being pruned",
+	},
+	AuditLogSyslogAddress: {
+		Type:        configschema.Tstring,
+		Description: "The host:port of an RFC5424 syslog receiver that audit records are forwarded to over TCP",
+	},
+	AuditLogSyslogTLS: {
+		Type:        configschema.Tbool,
+		Description: "Determines if audit records are forwarded to the syslog receiver over TLS",
+	},
+	AuditLogWebhookURL: {
+		Type:        configschema.Tstring,
+		Description: "The URL that batches of audit records are posted to as JSON",
+	},
+	AuditLogLokiURL: {
+		Type:        configschema.Tstring,
+		Description: "The URL of the Loki push API that audit records are forwarded to",
+	},
+	AuditLogSinkCACert: {
+		Type:        configschema.Tstring,
+		Description: "A PEM encoded CA certificate used to verify the TLS certificates of the audit log sinks, in addition to the system roots",
+	},
+	CharmhubMirror: {
+		Type:        configschema.Tbool,
+		Description: "Determines if the controller serves a mirror of the Charmhub API, which other controllers can use as their charmhub-url",
+	},
+	CharmhubMirrorUpstream: {
+		Type:        configschema.Tstring,
+		Description: "The URL of the Charmhub API that the Charmhub mirror fetches the charms it does not hold from",
+	},
+	CharmhubMirrorMaxArchiveSize: {
+		Type:        configschema.Tstring,
+		Description: "The maximum size of a charm or bundle archive the Charmhub mirror fetches from its upstream",
+	},
+	CharmhubMirrorMaxSize: {
+		Type:        configschema.Tstring,
+		Description: "The maximum total size of the archives the Charmhub mirror caches from its upstream, after which the oldest are evicted",
+	},
+	CharmSigningKeys: {
+		Type:        configschema.Tstring,
+		Description: "The public keys, in authorized_keys or PEM format, trusted to sign charm and resource archives",
+	},
 	APIPort: {
 		Type:        configschema.Tint,
 		Description: "The port used for api connections",
@@ -177,6 +269,22 @@ A zero value means no timeout. The default is 0 (no timeout). Set to a non-zero
 		Type:        configschema.Tstring,
 		Description: `The url of the jwt well known endpoint`,
 	},
+	OIDCIssuerURL: {
+		Type:        configschema.Tstring,
+		Description: `The URL of an OpenID Connect identity provider that users can log in with`,
+	},
+	OIDCClientID: {
+		Type:        configschema.Tstring,
+		Description: `The client ID the controller is registered with at the OpenID Connect identity provider`,
+	},
+	OIDCGroupsClaim: {
+		Type:        configschema.Tstring,
+		Description: `The ID token claim holding the groups a user is a member of`,
+	},
+	OIDCGroupMapping: {
+		Type:        configschema.Tstring,
+		Description: `A comma-separated list of idp-group=juju-group pairs mapping identity provider groups to Juju user groups`,
+	},
 	IdentityURL: {
 		Type:        configschema.Tstring,
 		Description: `The url of the identity manager`,
@@ -245,6 +245,46 @@ func (a *auditLogFile) addRecord(r Record) error {
 	return errors.Capture(err)
 }
 
+type teeLog struct {
+	logs []AuditLog
+}
+
+// NewTee returns an audit entry sink which writes each record to all of
+// the given sinks. A record is written to every sink even if writing it to
+// an earlier sink fails, and the errors from all sinks are returned
+// together.
+func NewTee(logs ...AuditLog) AuditLog {
+	return &teeLog{logs: logs}
+}
+
+// AddConversation implements AuditLog.
+func (t *teeLog) AddConversation(c Conversation) error {
+	return t.each(func(l AuditLog) error { return l.AddConversation(c) })
+}
+
+// AddRequest implements AuditLog.
+func (t *teeLog) AddRequest(m Request) error {
+	return t.each(func(l AuditLog) error { return l.AddRequest(m) })
+}
+
+// AddResponse implements AuditLog.
+func (t *teeLog) AddResponse(m ResponseErrors) error {
+	return t.each(func(l AuditLog) error { return l.AddResponse(m) })
+}
+
+// Close implements AuditLog.
+func (t *teeLog) Close() error {
+	return t.each(func(l AuditLog) error { return l.Close() })
+}
+
+func (t *teeLog) each(f func(AuditLog) error) error {
+	var errs []error
+	for _, l := range t.logs {
+		errs = append(errs, f(l))
+	}
+	return errors.Join(errs...)
+}
+
 func idString(id uint64) string {
 	return fmt.Sprintf("%X", id)
 }
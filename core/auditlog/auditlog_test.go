@@ -14,6 +14,7 @@ import (
 
 	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/paths"
+	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/testhelpers"
 )
 
@@ -143,6 +144,31 @@ func (s *AuditLogSuite) TestRecorder(c *tc.C) {
 	})
 }
 
+func (s *AuditLogSuite) TestTee(c *tc.C) {
+	var first, second fakeLog
+	first.stub.SetErrors(nil, errors.New("disk full"))
+	second.stub.SetErrors(nil, errors.New("database locked"))
+	log := auditlog.NewTee(&first, &second)
+
+	conversation := auditlog.Conversation{ConversationID: "0123456789abcdef"}
+	err := log.AddConversation(conversation)
+	c.Assert(err, tc.ErrorIsNil)
+
+	request := auditlog.Request{ConversationID: "0123456789abcdef", RequestID: 1}
+	err = log.AddRequest(request)
+	c.Assert(err, tc.ErrorMatches, "disk full\ndatabase locked")
+
+	c.Assert(log.Close(), tc.ErrorIsNil)
+
+	for _, l := range []*fakeLog{&first, &second} {
+		l.stub.CheckCalls(c, []testhelpers.StubCall{
+			{FuncName: "AddConversation", Args: []any{conversation}},
+			{FuncName: "AddRequest", Args: []any{request}},
+			{FuncName: "Close"},
+		})
+	}
+}
+
 type fakeLog struct {
 	stub testhelpers.Stub
 }
@@ -31,10 +31,37 @@ type Config struct {
 	// consists of these method calls we won't log it.
 	ExcludeMethods set.Strings
 
+	// Sinks holds the settings of the remote sinks that entries are
+	// forwarded to, in addition to Target.
+	Sinks SinkConfig
+
 	// Target is the AuditLog entries should be written to.
 	Target AuditLog
 }
 
+// SinkConfig holds the settings of the remote sinks that audit log entries
+// are forwarded to. A sink is disabled when its address is empty.
+type SinkConfig struct {
+	// SyslogAddress is the host:port of an RFC5424 syslog receiver
+	// accepting messages over TCP.
+	SyslogAddress string
+
+	// SyslogTLS says whether to connect to the syslog receiver using
+	// TLS.
+	SyslogTLS bool
+
+	// WebhookURL is the URL that batches of entries are posted to as
+	// JSON.
+	WebhookURL string
+
+	// LokiURL is the URL of the Loki push API.
+	LokiURL string
+
+	// CACert is a PEM encoded CA certificate used to verify the TLS
+	// certificates of the sinks, in addition to the system roots.
+	CACert string
+}
+
 // Validate checks the audit logging configuration.
 func (cfg Config) Validate() error {
 	if cfg.Enabled && cfg.Target == nil {
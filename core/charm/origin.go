@@ -29,6 +29,8 @@ const (
 	Local Source = "local"
 	// CharmHub represents a charm from the new charmHub.
 	CharmHub Source = "charm-hub"
+	// OCI represents a charm pulled from an OCI registry.
+	OCI Source = "oci"
 )
 
 // MinSHA256PrefixLength is the minimum length of a SHA256 prefix. This value
@@ -63,7 +65,7 @@ type Origin struct {
 
 // Validate returns an error if the origin is invalid.
 func (o Origin) Validate() error {
-	if CharmHub.Matches(o.Source.String()) && o.Platform.Architecture == "" {
+	if (CharmHub.Matches(o.Source.String()) || OCI.Matches(o.Source.String())) && o.Platform.Architecture == "" {
 		return errors.Errorf("empty architecture %w", coreerrors.NotValid)
 	}
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/domain/deployment/charm"
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmsignature"
 )
 
 // Repository describes an API for querying charm/bundle information and
@@ -129,4 +130,14 @@ type DownloadInfo struct {
 
 	// DownloadSize is the size of the charm to be downloaded.
 	DownloadSize int64
+
+	// SignedDigest is the digest that the signatures published for the
+	// charm are made over. For a charm in an OCI registry, it is the digest
+	// of the artifact manifest, which pins the digest of the charm archive.
+	SignedDigest string
+
+	// Signatures are the cosign signatures published for the charm. Charmhub
+	// doesn't publish signatures, so only charms in OCI registries may have
+	// them.
+	Signatures []charmsignature.CosignSignature
 }
@@ -19,8 +19,12 @@ type ControllerModelInfo struct {
 	Users []ModelUser
 	// ModelCredential is the model's cloud credential, or nil if it has none.
 	ModelCredential *ModelCloudCredential
-	// Permissions are the model and offer permission grants for the model.
+	// Permissions are the model, application and offer permission grants for
+	// the model.
 	Permissions []ModelPermission
+	// GroupPermissions are the model, application and offer permission grants
+	// made to user groups for the model.
+	GroupPermissions []ModelGroupPermission
 	// AuthorizedKeys are the SSH keys authorised for the model.
 	AuthorizedKeys []ModelAuthorizedKey
 	// SecretBackend is the secret backend the model uses, or nil for the default.
@@ -80,8 +84,8 @@ type ModelCloudCredential struct {
 	InvalidReason string
 }
 
-// ModelPermission is a single permission grant on the model or on an offer in
-// the model, with the grantee carried by username.
+// ModelPermission is a single permission grant on the model, or on an
+// application or an offer in the model, with the grantee carried by username.
 type ModelPermission struct {
 	ObjectType  string
 	GrantOn     string
@@ -89,6 +93,18 @@ type ModelPermission struct {
 	Access      string
 }
 
+// ModelGroupPermission is a single permission grant to a user group on the
+// model, or on an application or an offer in the model, with the group carried
+// by name. GroupCreatedBy is the username of the group's creator, used to
+// create the group on a target controller which does not already have it.
+type ModelGroupPermission struct {
+	ObjectType     string
+	GrantOn        string
+	GroupName      string
+	GroupCreatedBy string
+	Access         string
+}
+
 // ModelAuthorizedKey is an SSH public key authorised for the model, carried by
 // username and key material.
 type ModelAuthorizedKey struct {
@@ -0,0 +1,145 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/errors"
+)
+
+// EgressRule represents a rule for allowing traffic to a set of destination
+// CIDRs on a particular port range.
+type EgressRule struct {
+	// The destination port range for the outgoing traffic.
+	PortRange network.PortRange
+
+	// A set of CIDRs that describe the destination of outgoing traffic. An
+	// implicit 0.0.0.0/0 CIDR is assumed if no CIDRs are specified.
+	DestinationCIDRs set.Strings
+}
+
+// NewEgressRule creates a new EgressRule for allowing access to portRange
+// on the list of destinationCIDRs. If no destinationCIDRs are specified,
+// the rule will implicitly apply to all networks.
+func NewEgressRule(portRange network.PortRange, destinationCIDRs ...string) EgressRule {
+	return EgressRule{
+		PortRange:        portRange,
+		DestinationCIDRs: set.NewStrings(destinationCIDRs...),
+	}
+}
+
+// Validate ensures that the egress rule contains valid destination
+// parameters.
+func (r EgressRule) Validate() error {
+	if err := r.PortRange.Validate(); err != nil {
+		return errors.Errorf("invalid destination for egress rule: %w", err)
+	}
+
+	for dstCIDR := range r.DestinationCIDRs {
+		if _, _, err := net.ParseCIDR(dstCIDR); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	return nil
+}
+
+// String is the string representation of EgressRule.
+func (r EgressRule) String() string {
+	dst := strings.Join(r.DestinationCIDRs.SortedValues(), ",")
+	if dst == "" || dst == AllNetworksIPV4CIDR || dst == AllNetworksIPV6CIDR {
+		return r.PortRange.String()
+	}
+	return fmt.Sprintf("%s to %s", r.PortRange, dst)
+}
+
+// EgressRules represents a collection of EgressRule instances.
+type EgressRules []EgressRule
+
+// ParseEgressRules parses a list of egress rules separated by semicolons.
+// Each rule has the form "<port-range> [to <cidr>[,<cidr>...]]", as
+// returned by EgressRule.String, eg "443/tcp to 10.0.0.0/8". A rule
+// without destination CIDRs allows traffic to all networks.
+func ParseEgressRules(value string) (EgressRules, error) {
+	var rules EgressRules
+	for text := range strings.SplitSeq(value, ";") {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		portRange, cidrs, err := parseRule(text, "to")
+		if err != nil {
+			return nil, errors.Errorf("parsing egress rule %q: %w", strings.TrimSpace(text), err)
+		}
+		rules = append(rules, NewEgressRule(portRange, cidrs...))
+	}
+	return rules, nil
+}
+
+// Sort the rule list by port range and then by destination CIDRs.
+func (rules EgressRules) Sort() {
+	ingress := rules.asIngress()
+	ingress.Sort()
+	copy(rules, egressRules(ingress))
+}
+
+// Validate the list of egress rules.
+func (rules EgressRules) Validate() error {
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff returns a list of EgressRules to open and/or close so that this
+// set of egress rules matches the target.
+func (rules EgressRules) Diff(target EgressRules) (toOpen, toClose EgressRules) {
+	ingressOpen, ingressClose := rules.asIngress().Diff(target.asIngress())
+	return egressRules(ingressOpen), egressRules(ingressClose)
+}
+
+// RemoveCIDRsMatchingAddressType returns a new list of rules where any CIDR
+// whose address type corresponds to the specified AddressType argument has
+// been removed.
+func (rules EgressRules) RemoveCIDRsMatchingAddressType(removeAddrType network.AddressType) EgressRules {
+	return egressRules(rules.asIngress().RemoveCIDRsMatchingAddressType(removeAddrType))
+}
+
+// asIngress returns the rules as ingress rules, whose source CIDRs are the
+// destination CIDRs of the egress rules. Both kinds of rule are keyed by
+// port range in the same way, so the ingress rule operations apply as is.
+func (rules EgressRules) asIngress() IngressRules {
+	if rules == nil {
+		return nil
+	}
+	result := make(IngressRules, len(rules))
+	for i, rule := range rules {
+		result[i] = IngressRule{
+			PortRange:   rule.PortRange,
+			SourceCIDRs: rule.DestinationCIDRs,
+		}
+	}
+	return result
+}
+
+func egressRules(rules IngressRules) EgressRules {
+	if rules == nil {
+		return nil
+	}
+	result := make(EgressRules, len(rules))
+	for i, rule := range rules {
+		result[i] = EgressRule{
+			PortRange:        rule.PortRange,
+			DestinationCIDRs: rule.SourceCIDRs,
+		}
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+func TestEgressRuleSuite(t *testing.T) {
+	tc.Run(t, &EgressRuleSuite{})
+}
+
+type EgressRuleSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func (s *EgressRuleSuite) TestRuleFormatting(c *tc.C) {
+	pr := network.MustParsePortRange("443/tcp")
+	c.Check(NewEgressRule(pr).String(), tc.Equals, "443/tcp")
+	c.Check(NewEgressRule(pr, "0.0.0.0/0").String(), tc.Equals, "443/tcp")
+	c.Check(NewEgressRule(pr, "10.0.0.0/8", "192.168.0.0/16").String(), tc.Equals, "443/tcp to 10.0.0.0/8,192.168.0.0/16")
+}
+
+func (s *EgressRuleSuite) TestRuleValidation(c *tc.C) {
+	rule := NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8")
+	c.Check(rule.Validate(), tc.ErrorIsNil)
+
+	rule.DestinationCIDRs.Add("bogus")
+	c.Check(rule.Validate(), tc.ErrorMatches, "invalid CIDR address: bogus")
+}
+
+func (s *EgressRuleSuite) TestParseEgressRules(c *tc.C) {
+	rules, err := ParseEgressRules("443/tcp to 10.0.0.0/8; 53/udp")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		NewEgressRule(network.MustParsePortRange("53/udp"), AllNetworksIPV4CIDR, AllNetworksIPV6CIDR),
+	})
+
+	_, err = ParseEgressRules("443/tcp from 10.0.0.0/8")
+	c.Check(err, tc.ErrorMatches, `parsing egress rule "443/tcp from 10.0.0.0/8": expected <port-range> \[to <cidr>\[,<cidr>...\]\]`)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *EgressRuleSuite) TestSort(c *tc.C) {
+	rules := EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		NewEgressRule(network.MustParsePortRange("53/udp")),
+		NewEgressRule(network.MustParsePortRange("22/tcp")),
+	}
+	rules.Sort()
+	c.Check(rules, tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("22/tcp")),
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		NewEgressRule(network.MustParsePortRange("53/udp")),
+	})
+}
+
+func (s *EgressRuleSuite) TestDiff(c *tc.C) {
+	current := EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+		NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+	}
+	target := EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8", "172.16.0.0/12"),
+		NewEgressRule(network.MustParsePortRange("123/udp"), "10.0.0.3/32"),
+	}
+	toOpen, toClose := current.Diff(target)
+	c.Check(toOpen, tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "172.16.0.0/12"),
+		NewEgressRule(network.MustParsePortRange("123/udp"), "10.0.0.3/32"),
+	})
+	c.Check(toClose, tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "192.168.0.0/16"),
+		NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.2/32"),
+	})
+}
+
+func (s *EgressRuleSuite) TestRemoveCIDRsMatchingAddressType(c *tc.C) {
+	rules := EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8", "2002::/16"),
+		NewEgressRule(network.MustParsePortRange("53/udp"), "::/0"),
+	}
+	c.Check(rules.RemoveCIDRsMatchingAddressType(network.IPv6Address), tc.DeepEquals, EgressRules{
+		NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+	})
+}
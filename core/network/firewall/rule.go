@@ -12,6 +12,7 @@ import (
 
 	"github.com/juju/collections/set"
 
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/internal/errors"
 )
@@ -110,6 +111,50 @@ func (r IngressRule) EqualTo(other IngressRule) bool {
 // IngressRules represents a collection of IngressRule instances.
 type IngressRules []IngressRule
 
+// ParseIngressRules parses a list of ingress rules separated by semicolons.
+// Each rule has the form "<port-range> [from <cidr>[,<cidr>...]]", as
+// returned by IngressRule.String, eg "9100/tcp from 10.0.0.0/8". A rule
+// without source CIDRs allows traffic from all networks.
+func ParseIngressRules(value string) (IngressRules, error) {
+	var rules IngressRules
+	for text := range strings.SplitSeq(value, ";") {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		portRange, cidrs, err := parseRule(text, "from")
+		if err != nil {
+			return nil, errors.Errorf("parsing ingress rule %q: %w", strings.TrimSpace(text), err)
+		}
+		rules = append(rules, NewIngressRule(portRange, cidrs...))
+	}
+	return rules, nil
+}
+
+// parseRule parses a rule of the form "<port-range> [<keyword> <cidrs>]",
+// where the CIDRs are separated by commas. A rule without CIDRs applies to
+// all IPv4 and IPv6 networks.
+func parseRule(text, keyword string) (network.PortRange, []string, error) {
+	fields := strings.Fields(text)
+	if len(fields) != 1 && (len(fields) != 3 || fields[1] != keyword) {
+		return network.PortRange{}, nil, errors.Errorf("expected <port-range> [%s <cidr>[,<cidr>...]]", keyword).Add(coreerrors.NotValid)
+	}
+	portRange, err := network.ParsePortRange(fields[0])
+	if err != nil {
+		return network.PortRange{}, nil, errors.Capture(err)
+	}
+	if len(fields) == 1 {
+		return portRange, []string{AllNetworksIPV4CIDR, AllNetworksIPV6CIDR}, nil
+	}
+	var cidrs []string
+	for cidr := range strings.SplitSeq(fields[2], ",") {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return network.PortRange{}, nil, errors.Errorf("invalid CIDR %q", cidr).Add(coreerrors.NotValid)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return portRange, cidrs, nil
+}
+
 // Sort the rule list by port range and then by source CIDRs.
 func (rules IngressRules) Sort() {
 	sort.Slice(rules, func(i, j int) bool {
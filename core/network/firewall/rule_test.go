@@ -276,3 +276,45 @@ func (s *IngressRuleSuite) TestRemoveCIDRsMatchingAddressType(c *tc.C) {
 		NewIngressRule(network.MustParsePortRange("81/tcp"), "35.187.1.35/32"),
 	})
 }
+
+func (s *IngressRuleSuite) TestParseIngressRules(c *tc.C) {
+	rules, err := ParseIngressRules("9100/tcp from 10.0.0.0/8,192.168.0.0/16; 8000-8100/udp;;")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, IngressRules{
+		NewIngressRule(network.MustParsePortRange("9100/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+		NewIngressRule(network.MustParsePortRange("8000-8100/udp"), AllNetworksIPV4CIDR, AllNetworksIPV6CIDR),
+	})
+
+	// Rules round trip through their string representation.
+	for _, rule := range rules {
+		parsed, err := ParseIngressRules(rule.String())
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(parsed, tc.DeepEquals, IngressRules{rule})
+	}
+
+	rules, err = ParseIngressRules("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.HasLen, 0)
+}
+
+func (s *IngressRuleSuite) TestParseIngressRulesInvalid(c *tc.C) {
+	for _, test := range []struct {
+		value string
+		err   string
+	}{{
+		value: "9100/tcp 10.0.0.0/8",
+		err:   `parsing ingress rule "9100/tcp 10.0.0.0/8": expected <port-range> \[from <cidr>\[,<cidr>...\]\]`,
+	}, {
+		value: "9100/tcp to 10.0.0.0/8",
+		err:   `parsing ingress rule "9100/tcp to 10.0.0.0/8": expected .*`,
+	}, {
+		value: "99999/tcp",
+		err:   `parsing ingress rule "99999/tcp": .*`,
+	}, {
+		value: "22; 9100/tcp from 10.0.0.0/88",
+		err:   `parsing ingress rule "9100/tcp from 10.0.0.0/88": invalid CIDR "10.0.0.0/88"`,
+	}} {
+		_, err := ParseIngressRules(test.value)
+		c.Check(err, tc.ErrorMatches, test.err, tc.Commentf("value %q", test.value))
+	}
+}
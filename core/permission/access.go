@@ -4,6 +4,8 @@
 package permission
 
 import (
+	"strings"
+
 	"github.com/juju/names/v6"
 
 	coreerrors "github.com/juju/juju/core/errors"
@@ -54,6 +56,19 @@ const (
 
 	// SuperuserAccess allows user unrestricted permissions in the subject.
 	SuperuserAccess Access = "superuser"
+
+	// RunActionAccess allows a user to run actions on an application.
+	RunActionAccess Access = "run-action"
+
+	// ConfigAccess allows a user to change the configuration of an
+	// application.
+	ConfigAccess Access = "config"
+
+	// RefreshAccess allows a user to refresh the charm of an application.
+	RefreshAccess Access = "refresh"
+
+	// ScaleAccess allows a user to add and remove units of an application.
+	ScaleAccess Access = "scale"
 )
 
 // AllAccessLevels is a list of all access levels.
@@ -66,13 +81,18 @@ var AllAccessLevels = []Access{
 	LoginAccess,
 	AddModelAccess,
 	SuperuserAccess,
+	RunActionAccess,
+	ConfigAccess,
+	RefreshAccess,
+	ScaleAccess,
 }
 
 // Validate returns error if the current is not a valid access level.
 func (a Access) Validate() error {
 	switch a {
 	case NoAccess, AdminAccess, ReadAccess, WriteAccess,
-		LoginAccess, AddModelAccess, SuperuserAccess, ConsumeAccess:
+		LoginAccess, AddModelAccess, SuperuserAccess, ConsumeAccess,
+		RunActionAccess, ConfigAccess, RefreshAccess, ScaleAccess:
 		return nil
 	}
 	return errors.Errorf("access level %s %w", a, coreerrors.NotValid)
@@ -88,17 +108,18 @@ type ObjectType string
 
 // These values must match the values in the permission_object_type table.
 const (
-	Cloud      ObjectType = "cloud"
-	Controller ObjectType = "controller"
-	Model      ObjectType = "model"
-	Offer      ObjectType = "offer"
+	Cloud       ObjectType = "cloud"
+	Controller  ObjectType = "controller"
+	Model       ObjectType = "model"
+	Offer       ObjectType = "offer"
+	Application ObjectType = "application"
 )
 
 // Validate returns an error if the object type is not in the
 // list of valid object types above.
 func (o ObjectType) Validate() error {
 	switch o {
-	case Cloud, Controller, Model, Offer:
+	case Cloud, Controller, Model, Offer, Application:
 	default:
 		return errors.Errorf("object type %q %w", o, coreerrors.NotValid)
 	}
@@ -138,12 +159,69 @@ func (i ID) ValidateAccess(access Access) error {
 		err = ValidateModelAccess(access)
 	case Offer:
 		err = ValidateOfferAccess(access)
+	case Application:
+		err = validateApplicationObjectAccess(i.Key, access)
 	default:
 		err = errors.Errorf("access type %q %w", i.ObjectType, coreerrors.NotValid)
 	}
 	return err
 }
 
+// ApplicationID returns the ID of the permission object for the application
+// with the given UUID in the given model. Read, write and admin access to the
+// application are granted on it. The key is the model UUID and application
+// UUID separated by a colon; the application is identified by its UUID so
+// that access granted to it isn't inherited by a later application with the
+// same name.
+func ApplicationID(modelUUID, appUUID string) ID {
+	return ID{
+		ObjectType: Application,
+		Key:        modelUUID + ":" + appUUID,
+	}
+}
+
+// ApplicationOperationID returns the ID of the permission object for an
+// operation specific access level, such as run-action, on the application
+// with the given UUID in the given model. Each operation has its own
+// permission object, so that a user may be granted several of them on the
+// same application. The key is the key of the application followed by a
+// colon and the operation.
+func ApplicationOperationID(modelUUID, appUUID string, operation Access) ID {
+	return ID{
+		ObjectType: Application,
+		Key:        modelUUID + ":" + appUUID + ":" + string(operation),
+	}
+}
+
+// ApplicationAccessID returns the ID of the permission object the access is
+// granted on for the application with the given UUID in the given model:
+// the object for the operation if the access is operation specific, and the
+// application object otherwise.
+func ApplicationAccessID(modelUUID, appUUID string, access Access) ID {
+	if access.isApplicationVerb() {
+		return ApplicationOperationID(modelUUID, appUUID, access)
+	}
+	return ApplicationID(modelUUID, appUUID)
+}
+
+// ParseApplicationKey splits the key of an application permission object
+// into its model UUID, application UUID and, for the object of an operation
+// specific access level, the operation.
+func ParseApplicationKey(key string) (string, string, Access, error) {
+	parts := strings.Split(key, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", errors.Errorf("application key %q %w", key, coreerrors.NotValid)
+	}
+	var operation Access
+	if len(parts) == 3 {
+		operation = Access(parts[2])
+		if !operation.isApplicationVerb() {
+			return "", "", "", errors.Errorf("application key %q operation %w", key, coreerrors.NotValid)
+		}
+	}
+	return parts[0], parts[1], operation, nil
+}
+
 // ParseTagForID returns an ID of a permission object and must
 // conform to the known object types.
 func ParseTagForID(tag names.Tag) (ID, error) {
@@ -206,6 +284,35 @@ func ValidateControllerAccess(access Access) error {
 	return errors.Errorf("%q controller access %w", access, coreerrors.NotValid)
 }
 
+// ValidateApplicationAccess returns error if the passed access is not a valid
+// application access level.
+func ValidateApplicationAccess(access Access) error {
+	switch access {
+	case ReadAccess, WriteAccess, AdminAccess,
+		RunActionAccess, ConfigAccess, RefreshAccess, ScaleAccess:
+		return nil
+	}
+	return errors.Errorf("%q application access %w", access, coreerrors.NotValid)
+}
+
+// validateApplicationObjectAccess returns an error if the access can't be
+// granted on the application permission object with the given key. Read,
+// write and admin access are granted on the application object, and each
+// operation specific access on the object for that operation.
+func validateApplicationObjectAccess(key string, access Access) error {
+	if err := ValidateApplicationAccess(access); err != nil {
+		return err
+	}
+	_, _, operation, err := ParseApplicationKey(key)
+	if err != nil {
+		return err
+	}
+	if operation != access && (operation != "" || access.isApplicationVerb()) {
+		return errors.Errorf("%q access on application object %q %w", access, key, coreerrors.NotValid)
+	}
+	return nil
+}
+
 func (a Access) controllerValue() int {
 	switch a {
 	case NoAccess:
@@ -332,6 +439,48 @@ func (a Access) GreaterOfferAccessThan(access Access) bool {
 	return v1 > v2
 }
 
+// isApplicationVerb returns true if the access is one of the operation
+// specific application access levels.
+func (a Access) isApplicationVerb() bool {
+	switch a {
+	case RunActionAccess, ConfigAccess, RefreshAccess, ScaleAccess:
+		return true
+	}
+	return false
+}
+
+func (a Access) applicationValue() int {
+	switch {
+	case a == NoAccess:
+		return 0
+	case a == ReadAccess:
+		return 1
+	case a.isApplicationVerb():
+		return 2
+	case a == WriteAccess:
+		return 3
+	case a == AdminAccess:
+		return 4
+	default:
+		return -1
+	}
+}
+
+// EqualOrGreaterApplicationAccessThan returns true if the current access is
+// equal or greater than the passed in access level. The operation specific
+// access levels only satisfy themselves, read and no access; write and admin
+// satisfy all of them.
+func (a Access) EqualOrGreaterApplicationAccessThan(access Access) bool {
+	v1, v2 := a.applicationValue(), access.applicationValue()
+	if v1 < 0 || v2 < 0 {
+		return false
+	}
+	if v1 == v2 && a.isApplicationVerb() {
+		return a == access
+	}
+	return v1 >= v2
+}
+
 // modelRevoke provides the logic of revoking
 // model access. Revoking:
 // * AddModel gets you Write
@@ -364,6 +513,24 @@ func offerRevoke(a Access) Access {
 	}
 }
 
+// applicationRevoke provides the logic of revoking
+// application access. Revoking:
+// * Admin gets you Write
+// * Write gets you Read
+// * Read gets you NoAccess
+// * An operation specific access gets you NoAccess, as it is held on an
+// object of its own
+func applicationRevoke(a Access) Access {
+	switch a {
+	case AdminAccess:
+		return WriteAccess
+	case WriteAccess:
+		return ReadAccess
+	default:
+		return NoAccess
+	}
+}
+
 // controllerRevoke provides the logic of revoking
 // controller access. Revoking:
 // * Superuser gets you Login
@@ -402,6 +569,8 @@ func (a AccessSpec) EqualOrGreaterThan(access Access) bool {
 		return a.Access.EqualOrGreaterModelAccessThan(access)
 	case Offer:
 		return a.Access.EqualOrGreaterOfferAccessThan(access)
+	case Application:
+		return a.Access.EqualOrGreaterApplicationAccessThan(access)
 	default:
 		return false
 	}
@@ -238,6 +238,42 @@ func (*accessSuite) TestEqualOrGreaterCloudAccessThan(c *tc.C) {
 	c.Check(admin.EqualOrGreaterCloudAccessThan(admin), tc.IsTrue)
 }
 
+func (*accessSuite) TestEqualOrGreaterApplicationAccessThan(c *tc.C) {
+	var (
+		noaccess  = permission.NoAccess
+		read      = permission.ReadAccess
+		write     = permission.WriteAccess
+		admin     = permission.AdminAccess
+		runAction = permission.RunActionAccess
+		config    = permission.ConfigAccess
+		refresh   = permission.RefreshAccess
+		scale     = permission.ScaleAccess
+		consume   = permission.ConsumeAccess
+	)
+	verbs := []permission.Access{runAction, config, refresh, scale}
+
+	for _, verb := range verbs {
+		c.Check(verb.EqualOrGreaterApplicationAccessThan(verb), tc.IsTrue)
+		c.Check(verb.EqualOrGreaterApplicationAccessThan(read), tc.IsTrue)
+		c.Check(verb.EqualOrGreaterApplicationAccessThan(noaccess), tc.IsTrue)
+		c.Check(verb.EqualOrGreaterApplicationAccessThan(write), tc.IsFalse)
+		c.Check(write.EqualOrGreaterApplicationAccessThan(verb), tc.IsTrue)
+		c.Check(admin.EqualOrGreaterApplicationAccessThan(verb), tc.IsTrue)
+		c.Check(read.EqualOrGreaterApplicationAccessThan(verb), tc.IsFalse)
+	}
+	// Operation specific access levels don't imply each other.
+	c.Check(config.EqualOrGreaterApplicationAccessThan(runAction), tc.IsFalse)
+	c.Check(scale.EqualOrGreaterApplicationAccessThan(refresh), tc.IsFalse)
+
+	c.Check(admin.EqualOrGreaterApplicationAccessThan(write), tc.IsTrue)
+	c.Check(write.EqualOrGreaterApplicationAccessThan(admin), tc.IsFalse)
+	c.Check(read.EqualOrGreaterApplicationAccessThan(noaccess), tc.IsTrue)
+
+	// No comparison against an offer permission will return true.
+	c.Check(admin.EqualOrGreaterApplicationAccessThan(consume), tc.IsFalse)
+	c.Check(consume.EqualOrGreaterApplicationAccessThan(read), tc.IsFalse)
+}
+
 var validateObjectTypeTest = []struct {
 	access     permission.Access
 	objectType permission.ObjectType
@@ -251,6 +287,11 @@ var validateObjectTypeTest = []struct {
 	{access: permission.ConsumeAccess, objectType: permission.Model, fail: true},
 	{access: permission.ConsumeAccess, objectType: permission.Offer},
 	{access: permission.AddModelAccess, objectType: permission.Offer, fail: true},
+	{access: permission.WriteAccess, objectType: permission.Application},
+	{access: permission.RunActionAccess, objectType: permission.Application},
+	{access: permission.ScaleAccess, objectType: permission.Application},
+	{access: permission.ConsumeAccess, objectType: permission.Application, fail: true},
+	{access: permission.ConfigAccess, objectType: permission.Model, fail: true},
 	{access: permission.AddModelAccess, objectType: "failme", fail: true},
 }
 
@@ -259,6 +300,9 @@ func (*accessSuite) TestValidateAccessForObjectType(c *tc.C) {
 	for i, test := range validateObjectTypeTest {
 		c.Logf("Running test %d of %d", i, size)
 		id := permission.ID{ObjectType: test.objectType}
+		if test.objectType == permission.Application {
+			id = permission.ApplicationAccessID("deadbeef", "f00dfeed", test.access)
+		}
 		err := id.ValidateAccess(test.access)
 		if test.fail {
 			c.Assert(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("test %d", i))
@@ -280,3 +324,51 @@ func (*accessSuite) TestParseTagForIDFail(c *tc.C) {
 	_, err = permission.ParseTagForID(names.NewUserTag("testcloud"))
 	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
 }
+
+func (*accessSuite) TestApplicationID(c *tc.C) {
+	id := permission.ApplicationID("deadbeef", "f00dfeed")
+	c.Check(id, tc.DeepEquals, permission.ID{
+		ObjectType: permission.Application,
+		Key:        "deadbeef:f00dfeed",
+	})
+	c.Check(permission.ApplicationAccessID("deadbeef", "f00dfeed", permission.WriteAccess), tc.DeepEquals, id)
+
+	modelUUID, appUUID, operation, err := permission.ParseApplicationKey(id.Key)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(modelUUID, tc.Equals, "deadbeef")
+	c.Check(appUUID, tc.Equals, "f00dfeed")
+	c.Check(operation, tc.Equals, permission.NoAccess)
+}
+
+func (*accessSuite) TestApplicationOperationID(c *tc.C) {
+	id := permission.ApplicationOperationID("deadbeef", "f00dfeed", permission.RunActionAccess)
+	c.Check(id, tc.DeepEquals, permission.ID{
+		ObjectType: permission.Application,
+		Key:        "deadbeef:f00dfeed:run-action",
+	})
+	c.Check(permission.ApplicationAccessID("deadbeef", "f00dfeed", permission.RunActionAccess), tc.DeepEquals, id)
+
+	modelUUID, appUUID, operation, err := permission.ParseApplicationKey(id.Key)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(modelUUID, tc.Equals, "deadbeef")
+	c.Check(appUUID, tc.Equals, "f00dfeed")
+	c.Check(operation, tc.Equals, permission.RunActionAccess)
+}
+
+func (*accessSuite) TestValidateAccessApplicationObject(c *tc.C) {
+	appID := permission.ApplicationID("deadbeef", "f00dfeed")
+	c.Check(appID.ValidateAccess(permission.WriteAccess), tc.ErrorIsNil)
+	c.Check(appID.ValidateAccess(permission.ScaleAccess), tc.ErrorIs, coreerrors.NotValid)
+
+	scaleID := permission.ApplicationOperationID("deadbeef", "f00dfeed", permission.ScaleAccess)
+	c.Check(scaleID.ValidateAccess(permission.ScaleAccess), tc.ErrorIsNil)
+	c.Check(scaleID.ValidateAccess(permission.ConfigAccess), tc.ErrorIs, coreerrors.NotValid)
+	c.Check(scaleID.ValidateAccess(permission.WriteAccess), tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (*accessSuite) TestParseApplicationKeyFail(c *tc.C) {
+	for _, key := range []string{"", "mysql", ":mysql", "deadbeef:", "deadbeef:f00dfeed:write", "deadbeef:f00dfeed:scale:config"} {
+		_, _, _, err := permission.ParseApplicationKey(key)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid, tc.Commentf("key %q", key))
+	}
+}
@@ -47,6 +47,8 @@ func (a AccessSpec) RevokeAccess() Access {
 		return modelRevoke(a.Access)
 	case Offer:
 		return offerRevoke(a.Access)
+	case Application:
+		return applicationRevoke(a.Access)
 	default:
 		return NoAccess
 	}
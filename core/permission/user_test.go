@@ -51,6 +51,18 @@ var validateRevokeAccessTest = []struct {
 	}, {
 		spec:     permission.AccessSpec{Target: permission.ID{ObjectType: permission.Cloud}, Access: permission.AddModelAccess},
 		expected: permission.NoAccess,
+	}, {
+		spec:     permission.AccessSpec{Target: permission.ID{ObjectType: permission.Application}, Access: permission.AdminAccess},
+		expected: permission.WriteAccess,
+	}, {
+		spec:     permission.AccessSpec{Target: permission.ID{ObjectType: permission.Application}, Access: permission.WriteAccess},
+		expected: permission.ReadAccess,
+	}, {
+		spec:     permission.AccessSpec{Target: permission.ID{ObjectType: permission.Application}, Access: permission.RunActionAccess},
+		expected: permission.NoAccess,
+	}, {
+		spec:     permission.AccessSpec{Target: permission.ID{ObjectType: permission.Application}, Access: permission.ReadAccess},
+		expected: permission.NoAccess,
 	},
 }
 
@@ -30,6 +30,9 @@ type UserAccess struct {
 	DisplayName string
 	// UserName is the actual username for this access.
 	UserName user.Name
+	// ExpiresAt is the time at which a time-limited access expires, nil if
+	// the access is permanent.
+	ExpiresAt *time.Time
 }
 
 // IsEmptyUserAccess returns true if the passed UserAccess instance
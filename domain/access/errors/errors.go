@@ -83,4 +83,24 @@ const (
 	// LastModelAdmin describes an error that occurs if an attempt is made to remove a
 	// user and models would be left without an admin user.
 	LastModelAdmin = errors.ConstError("user is the last model admin for 1 or more models")
+
+	// GroupNotFound describes an error that occurs when the user group being
+	// requested does not exist.
+	GroupNotFound = errors.ConstError("group not found")
+
+	// GroupAlreadyExists describes an error that occurs when the user group
+	// being created already exists.
+	GroupAlreadyExists = errors.ConstError("group already exists")
+
+	// GroupNameNotValid describes an error that occurs when a supplied group
+	// name is not valid.
+	GroupNameNotValid = errors.ConstError("group name not valid")
+
+	// GroupMemberAlreadyExists describes an error that occurs when the user
+	// being added to a group is already a member of it.
+	GroupMemberAlreadyExists = errors.ConstError("user is already a member of the group")
+
+	// GroupMemberNotFound describes an error that occurs when the user being
+	// removed from a group is not a member of it.
+	GroupMemberNotFound = errors.ConstError("user is not a member of the group")
 )
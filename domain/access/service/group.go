@@ -0,0 +1,163 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// GroupService provides the API for working with user groups.
+type GroupService struct {
+	st GroupState
+}
+
+// NewGroupService returns a new GroupService for interacting with the
+// underlying group state.
+func NewGroupService(st GroupState) *GroupService {
+	return &GroupService{
+		st: st,
+	}
+}
+
+// AddGroup adds a new user group with the given name, created by the given
+// user.
+// The following error types are possible from this function:
+//   - accesserrors.GroupNameNotValid: When the group name is not valid.
+//   - accesserrors.UserNameNotValid: When the creator name is not valid.
+//   - accesserrors.UserNotFound: When the creator does not exist.
+//   - accesserrors.GroupAlreadyExists: When a group with the name already
+//     exists.
+func (s *GroupService) AddGroup(ctx context.Context, name string, creator user.Name) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !access.IsValidGroupName(name) {
+		return errors.Errorf("group name %q %w", name, accesserrors.GroupNameNotValid)
+	}
+	if creator.IsZero() {
+		return errors.Errorf("empty creator %w", accesserrors.UserNameNotValid)
+	}
+
+	groupUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("generating group uuid: %w", err)
+	}
+	return errors.Capture(s.st.AddGroup(ctx, groupUUID, name, creator))
+}
+
+// UpdateGroupPermission grants or revokes the access of a user group on a
+// target. A NotValid error is returned if the arguments are not valid. Any
+// errors from the state layer are passed through.
+func (s *GroupService) UpdateGroupPermission(ctx context.Context, args access.UpdateGroupPermissionArgs) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := args.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.UpdateGroupPermission(ctx, args))
+}
+
+// ReadGroupAccessLevelForTarget returns the greatest access level any of the
+// named user groups has on the target. A NotValid error is returned if the
+// target is not valid.
+// If none of the groups have access to the target then
+// [accesserrors.AccessNotFound] is returned.
+func (s *GroupService) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target corepermission.ID) (corepermission.Access, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := target.Validate(); err != nil {
+		return "", errors.Capture(err)
+	}
+	if len(groups) == 0 {
+		return "", errors.Errorf("%w for no groups on %q", accesserrors.AccessNotFound, target.Key)
+	}
+	access, err := s.st.ReadGroupAccessLevelForTarget(ctx, groups, target)
+	return access, errors.Capture(err)
+}
+
+// AddUserToGroup adds the user as a member of the named group.
+// The following error types are possible from this function:
+//   - accesserrors.GroupNameNotValid: When the group name is not valid.
+//   - accesserrors.UserNameNotValid: When the user name is not valid.
+//   - accesserrors.GroupNotFound: When the group does not exist.
+//   - accesserrors.UserNotFound: When the user does not exist.
+//   - accesserrors.GroupMemberAlreadyExists: When the user is already a
+//     member of the group.
+func (s *GroupService) AddUserToGroup(ctx context.Context, group string, name user.Name) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateGroupMember(group, name); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.AddUserToGroup(ctx, group, name))
+}
+
+// RemoveUserFromGroup removes the user from the members of the named group.
+// The following error types are possible from this function:
+//   - accesserrors.GroupNameNotValid: When the group name is not valid.
+//   - accesserrors.UserNameNotValid: When the user name is not valid.
+//   - accesserrors.GroupNotFound: When the group does not exist.
+//   - accesserrors.UserNotFound: When the user does not exist.
+//   - accesserrors.GroupMemberNotFound: When the user is not a member of the
+//     group.
+func (s *GroupService) RemoveUserFromGroup(ctx context.Context, group string, name user.Name) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateGroupMember(group, name); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.RemoveUserFromGroup(ctx, group, name))
+}
+
+// GetUserGroups returns the names of the groups the user is a member of, in
+// name order.
+// If the user name is not valid, accesserrors.UserNameNotValid is returned.
+func (s *GroupService) GetUserGroups(ctx context.Context, name user.Name) ([]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if name.IsZero() {
+		return nil, errors.Errorf("empty user name %w", accesserrors.UserNameNotValid)
+	}
+	groups, err := s.st.GetUserGroups(ctx, name)
+	return groups, errors.Capture(err)
+}
+
+// ReadUserGroupAccessLevelForTarget returns the greatest access level any
+// of the groups the user is a member of has on the target. A NotValid error
+// is returned if the target is not valid.
+// If none of the user's groups have access to the target then
+// [accesserrors.AccessNotFound] is returned.
+func (s *GroupService) ReadUserGroupAccessLevelForTarget(ctx context.Context, name user.Name, target corepermission.ID) (corepermission.Access, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	groups, err := s.GetUserGroups(ctx, name)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return s.ReadGroupAccessLevelForTarget(ctx, groups, target)
+}
+
+func validateGroupMember(group string, name user.Name) error {
+	if !access.IsValidGroupName(group) {
+		return errors.Errorf("group name %q %w", group, accesserrors.GroupNameNotValid)
+	}
+	if name.IsZero() {
+		return errors.Errorf("empty user name %w", accesserrors.UserNameNotValid)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type groupServiceSuite struct {
+	testhelpers.IsolationSuite
+
+	state *MockState
+}
+
+func TestGroupServiceSuite(t *testing.T) {
+	tc.Run(t, &groupServiceSuite{})
+}
+
+func (s *groupServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	return ctrl
+}
+
+func (s *groupServiceSuite) TestAddGroup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	creator := usertesting.GenNewName(c, "admin")
+	s.state.EXPECT().AddGroup(gomock.Any(), gomock.AssignableToTypeOf(uuid.UUID{}), "sre", creator).Return(nil)
+
+	err := NewGroupService(s.state).AddGroup(c.Context(), "sre", creator)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestAddGroupNameNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewGroupService(s.state).AddGroup(c.Context(), "-sre", usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupNameNotValid)
+}
+
+func (s *groupServiceSuite) TestAddGroupAlreadyExists(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.state.EXPECT().AddGroup(gomock.Any(), gomock.Any(), "sre", gomock.Any()).Return(accesserrors.GroupAlreadyExists)
+
+	err := NewGroupService(s.state).AddGroup(c.Context(), "sre", usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupAlreadyExists)
+}
+
+func (s *groupServiceSuite) TestUpdateGroupPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	args := access.UpdateGroupPermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Access: corepermission.ReadAccess,
+			Target: corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()},
+		},
+		Change: corepermission.Grant,
+		Group:  "sre",
+	}
+	s.state.EXPECT().UpdateGroupPermission(gomock.Any(), args).Return(nil)
+
+	err := NewGroupService(s.state).UpdateGroupPermission(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestUpdateGroupPermissionNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewGroupService(s.state).UpdateGroupPermission(c.Context(), access.UpdateGroupPermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Access: corepermission.ReadAccess,
+			Target: corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()},
+		},
+		Change: corepermission.Grant,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *groupServiceSuite) TestReadGroupAccessLevelForTarget(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	target := corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()}
+	s.state.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"sre", "dev"}, target).Return(corepermission.AdminAccess, nil)
+
+	got, err := NewGroupService(s.state).ReadGroupAccessLevelForTarget(c.Context(), []string{"sre", "dev"}, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.Equals, corepermission.AdminAccess)
+}
+
+func (s *groupServiceSuite) TestReadGroupAccessLevelForTargetNoGroups(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	target := corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()}
+
+	_, err := NewGroupService(s.state).ReadGroupAccessLevelForTarget(c.Context(), nil, target)
+	c.Assert(err, tc.ErrorIs, accesserrors.AccessNotFound)
+}
+
+func (s *groupServiceSuite) TestAddUserToGroup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	bob := usertesting.GenNewName(c, "bob")
+	s.state.EXPECT().AddUserToGroup(gomock.Any(), "sre", bob).Return(nil)
+
+	err := NewGroupService(s.state).AddUserToGroup(c.Context(), "sre", bob)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestAddUserToGroupNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewGroupService(s.state).AddUserToGroup(c.Context(), "-sre", usertesting.GenNewName(c, "bob"))
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupNameNotValid)
+
+	err = NewGroupService(s.state).AddUserToGroup(c.Context(), "sre", user.Name{})
+	c.Assert(err, tc.ErrorIs, accesserrors.UserNameNotValid)
+}
+
+func (s *groupServiceSuite) TestRemoveUserFromGroup(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	bob := usertesting.GenNewName(c, "bob")
+	s.state.EXPECT().RemoveUserFromGroup(gomock.Any(), "sre", bob).Return(accesserrors.GroupMemberNotFound)
+
+	err := NewGroupService(s.state).RemoveUserFromGroup(c.Context(), "sre", bob)
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupMemberNotFound)
+}
+
+func (s *groupServiceSuite) TestReadUserGroupAccessLevelForTarget(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	bob := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()}
+	s.state.EXPECT().GetUserGroups(gomock.Any(), bob).Return([]string{"dev", "sre"}, nil)
+	s.state.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"dev", "sre"}, target).Return(corepermission.WriteAccess, nil)
+
+	got, err := NewGroupService(s.state).ReadUserGroupAccessLevelForTarget(c.Context(), bob, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.Equals, corepermission.WriteAccess)
+}
+
+func (s *groupServiceSuite) TestReadUserGroupAccessLevelForTargetNoGroups(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	bob := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()}
+	s.state.EXPECT().GetUserGroups(gomock.Any(), bob).Return(nil, nil)
+
+	_, err := NewGroupService(s.state).ReadUserGroupAccessLevelForTarget(c.Context(), bob, target)
+	c.Assert(err, tc.ErrorIs, accesserrors.AccessNotFound)
+}
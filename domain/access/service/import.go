@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"slices"
 
 	"github.com/juju/collections/set"
 
@@ -181,8 +182,9 @@ func (s *UserService) ImportLastModelLogins(
 	return nil
 }
 
-// ImportModelPermissions writes the model and offer permission grants carried
-// by the envelope. Model grants are written individually; offer grants are
+// ImportModelPermissions writes the model, application and offer permission
+// grants carried by the envelope. Model and application grants are written
+// individually; offer grants are
 // grouped by offer UUID and written in a single ImportOfferAccess call. Users
 // in inactiveUsers (see [UserService.ImportModelUsers]) are skipped: they have
 // no active target identity to grant live permission state to. It returns the
@@ -226,6 +228,23 @@ func (s *PermissionService) ImportModelPermissions(
 				return nil, errors.Errorf(
 					"granting %q access to %q on model: %w", p.Access, p.SubjectName, err)
 			}
+		case corepermission.Application:
+			// Application grants are keyed on the model and application
+			// UUIDs, both of which are kept by the migration.
+			subject, err := user.NewName(p.SubjectName)
+			if err != nil {
+				return nil, errors.Errorf("invalid permission subject %q: %w", p.SubjectName, err)
+			}
+			if _, err := s.CreatePermission(ctx, corepermission.UserAccessSpec{
+				AccessSpec: corepermission.AccessSpec{
+					Access: corepermission.Access(p.Access),
+					Target: corepermission.ID{ObjectType: corepermission.Application, Key: p.GrantOn},
+				},
+				User: subject,
+			}); err != nil {
+				return nil, errors.Errorf(
+					"granting %q access to %q on application: %w", p.Access, p.SubjectName, err)
+			}
 		case corepermission.Offer:
 			if _, ok := offerAccess[p.GrantOn]; !ok {
 				offerUUIDs = append(offerUUIDs, p.GrantOn)
@@ -253,3 +272,77 @@ func (s *PermissionService) ImportModelPermissions(
 
 	return offerUUIDs, nil
 }
+
+// ImportModelGroupPermissions writes the model, application and offer
+// permission grants made to user groups carried by the envelope. A group which
+// does not exist on the target controller is created on behalf of its creator
+// on the source controller. When that creator is in inactiveUsers (see
+// [UserService.ImportModelUsers]) the group cannot be created, so unless it
+// already exists on the target its grants are skipped. It returns the offer
+// UUIDs granted, for the caller to record against the import claim.
+//
+// It is called directly by the v8 migration import driver in
+// internal/migration.
+func (s *GroupService) ImportModelGroupPermissions(
+	ctx context.Context,
+	perms []coremodelmigration.ModelGroupPermission,
+	inactiveUsers set.Strings,
+) ([]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if len(perms) == 0 {
+		return nil, nil
+	}
+
+	// Groups which have been created, or found to be missing, on the target.
+	created := set.NewStrings()
+	missing := set.NewStrings()
+	var offerUUIDs []string
+
+	for _, p := range perms {
+		if missing.Contains(p.GroupName) {
+			continue
+		}
+
+		objectType := corepermission.ObjectType(p.ObjectType)
+		switch objectType {
+		case corepermission.Model, corepermission.Application, corepermission.Offer:
+		default:
+			return nil, errors.Errorf("unknown group permission object type %q", p.ObjectType)
+		}
+
+		if !created.Contains(p.GroupName) && !inactiveUsers.Contains(p.GroupCreatedBy) {
+			creator, err := user.NewName(p.GroupCreatedBy)
+			if err != nil {
+				return nil, errors.Errorf("invalid creator %q of group %q: %w", p.GroupCreatedBy, p.GroupName, err)
+			}
+			err = s.AddGroup(ctx, p.GroupName, creator)
+			if err != nil && !errors.Is(err, accesserrors.GroupAlreadyExists) {
+				return nil, errors.Errorf("adding group %q: %w", p.GroupName, err)
+			}
+			created.Add(p.GroupName)
+		}
+
+		err := s.UpdateGroupPermission(ctx, access.UpdateGroupPermissionArgs{
+			AccessSpec: corepermission.AccessSpec{
+				Access: corepermission.Access(p.Access),
+				Target: corepermission.ID{ObjectType: objectType, Key: p.GrantOn},
+			},
+			Change: corepermission.Grant,
+			Group:  p.GroupName,
+		})
+		if errors.Is(err, accesserrors.GroupNotFound) {
+			missing.Add(p.GroupName)
+			continue
+		} else if err != nil {
+			return nil, errors.Errorf(
+				"granting %q access to group %q on %s: %w", p.Access, p.GroupName, p.ObjectType, err)
+		}
+
+		if objectType == corepermission.Offer && !slices.Contains(offerUUIDs, p.GrantOn) {
+			offerUUIDs = append(offerUUIDs, p.GrantOn)
+		}
+	}
+	return offerUUIDs, nil
+}
@@ -214,17 +214,28 @@ func (s *importServiceSuite) TestImportModelUsersEmpty(c *tc.C) {
 	c.Check(inactive.IsEmpty(), tc.IsTrue)
 }
 
-// TestImportModelPermissions verifies model grants are written individually,
-// offer grants grouped, and inactive users skipped.
+// TestImportModelPermissions verifies model and application grants are
+// written individually, offer grants grouped, and inactive users skipped.
 func (s *importServiceSuite) TestImportModelPermissions(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	modelUUID := tc.Must0(c, coremodel.NewUUID)
 	offerUUID := uuid.MustNewUUID()
 
+	appTarget := permission.ApplicationOperationID(modelUUID.String(), uuid.MustNewUUID().String(), permission.RunActionAccess)
+
 	s.state.EXPECT().CreatePermission(
 		gomock.Any(), gomock.AssignableToTypeOf(uuid.UUID{}), gomock.AssignableToTypeOf(permission.UserAccessSpec{}),
 	).Return(permission.UserAccess{}, nil)
+	s.state.EXPECT().CreatePermission(
+		gomock.Any(), gomock.AssignableToTypeOf(uuid.UUID{}), permission.UserAccessSpec{
+			AccessSpec: permission.AccessSpec{
+				Target: appTarget,
+				Access: permission.RunActionAccess,
+			},
+			User: usertesting.GenNewName(c, "carol"),
+		},
+	).Return(permission.UserAccess{}, nil)
 	s.state.EXPECT().ImportOfferAccess(gomock.Any(), []access.OfferImportAccess{{
 		UUID:   offerUUID,
 		Access: map[string]permission.Access{"bob": permission.ConsumeAccess},
@@ -240,6 +251,11 @@ func (s *importServiceSuite) TestImportModelPermissions(c *tc.C) {
 		ObjectType:  string(permission.Offer),
 		Access:      string(permission.ConsumeAccess),
 		GrantOn:     offerUUID.String(),
+	}, {
+		SubjectName: "carol",
+		ObjectType:  string(permission.Application),
+		Access:      string(permission.RunActionAccess),
+		GrantOn:     appTarget.Key,
 	}, {
 		SubjectName: "inactiveuser",
 		ObjectType:  string(permission.Model),
@@ -258,6 +274,93 @@ func (s *importServiceSuite) TestImportModelPermissionsEmpty(c *tc.C) {
 	c.Check(offerUUIDs, tc.HasLen, 0)
 }
 
+// TestImportModelGroupPermissions verifies that groups missing on the target
+// are created by their source creator before their grants are written, and
+// that the offer UUIDs granted are returned.
+func (s *importServiceSuite) TestImportModelGroupPermissions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	offerUUID := uuid.MustNewUUID()
+	appTarget := permission.ApplicationOperationID(modelUUID.String(), uuid.MustNewUUID().String(), permission.RunActionAccess)
+
+	s.state.EXPECT().AddGroup(gomock.Any(), gomock.AssignableToTypeOf(uuid.UUID{}), "ops", usertesting.GenNewName(c, "alice")).Return(nil)
+	s.state.EXPECT().AddGroup(gomock.Any(), gomock.AssignableToTypeOf(uuid.UUID{}), "sre", usertesting.GenNewName(c, "alice")).Return(accesserrors.GroupAlreadyExists)
+	s.state.EXPECT().UpdateGroupPermission(gomock.Any(), access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ID{ObjectType: permission.Model, Key: modelUUID.String()},
+			Access: permission.WriteAccess,
+		},
+		Change: permission.Grant,
+		Group:  "ops",
+	}).Return(nil)
+	s.state.EXPECT().UpdateGroupPermission(gomock.Any(), access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: appTarget,
+			Access: permission.RunActionAccess,
+		},
+		Change: permission.Grant,
+		Group:  "ops",
+	}).Return(nil)
+	s.state.EXPECT().UpdateGroupPermission(gomock.Any(), access.UpdateGroupPermissionArgs{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ID{ObjectType: permission.Offer, Key: offerUUID.String()},
+			Access: permission.ConsumeAccess,
+		},
+		Change: permission.Grant,
+		Group:  "sre",
+	}).Return(nil)
+
+	offerUUIDs, err := s.service().ImportModelGroupPermissions(c.Context(), []coremodelmigration.ModelGroupPermission{{
+		GroupName:      "ops",
+		GroupCreatedBy: "alice",
+		ObjectType:     string(permission.Model),
+		Access:         string(permission.WriteAccess),
+		GrantOn:        modelUUID.String(),
+	}, {
+		GroupName:      "ops",
+		GroupCreatedBy: "alice",
+		ObjectType:     string(permission.Application),
+		Access:         string(permission.RunActionAccess),
+		GrantOn:        appTarget.Key,
+	}, {
+		GroupName:      "sre",
+		GroupCreatedBy: "alice",
+		ObjectType:     string(permission.Offer),
+		Access:         string(permission.ConsumeAccess),
+		GrantOn:        offerUUID.String(),
+	}}, set.NewStrings())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(offerUUIDs, tc.DeepEquals, []string{offerUUID.String()})
+}
+
+// TestImportModelGroupPermissionsInactiveCreator verifies that a group whose
+// creator has no active target identity is not created, and that its grants
+// are skipped when the group does not already exist on the target.
+func (s *importServiceSuite) TestImportModelGroupPermissionsInactiveCreator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+
+	s.state.EXPECT().UpdateGroupPermission(gomock.Any(), gomock.Any()).Return(accesserrors.GroupNotFound)
+
+	offerUUIDs, err := s.service().ImportModelGroupPermissions(c.Context(), []coremodelmigration.ModelGroupPermission{{
+		GroupName:      "ops",
+		GroupCreatedBy: "inactiveuser",
+		ObjectType:     string(permission.Model),
+		Access:         string(permission.ReadAccess),
+		GrantOn:        modelUUID.String(),
+	}, {
+		GroupName:      "ops",
+		GroupCreatedBy: "inactiveuser",
+		ObjectType:     string(permission.Model),
+		Access:         string(permission.WriteAccess),
+		GrantOn:        modelUUID.String(),
+	}}, set.NewStrings("inactiveuser"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(offerUUIDs, tc.HasLen, 0)
+}
+
 // TestImportLastModelLogins verifies last-login times are set for active users
 // who logged in, and skipped for inactive users or those who never logged in.
 func (s *importServiceSuite) TestImportLastModelLogins(c *tc.C) {
@@ -5,26 +5,32 @@ package service
 
 import (
 	"context"
+	"time"
+
+	"github.com/juju/clock"
 
 	coreerrors "github.com/juju/juju/core/errors"
 	corepermission "github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/uuid"
 )
 
 // PermissionService provides the API for working with permissions.
 type PermissionService struct {
-	st PermissionState
+	st    PermissionState
+	clock clock.Clock
 }
 
 // NewPermissionService returns a new PermissionService for interacting with the underlying
 // permission state.
-func NewPermissionService(st PermissionState) *PermissionService {
+func NewPermissionService(st PermissionState, clock clock.Clock) *PermissionService {
 	return &PermissionService{
-		st: st,
+		st:    st,
+		clock: clock,
 	}
 }
 
@@ -180,15 +186,37 @@ func (s *PermissionService) ReadAllAccessForUserAndObjectType(ctx context.Contex
 // exist in the users table.
 // [accesserrors.PermissionAccessGreater] is returned if the user is being
 // granted an access level greater or equal to what they already have.
+// If the grant has an expiry, the access is granted until then, after which
+// the user reverts to the access they held before.
+// [accesserrors.PermissionNotValid] is returned if the expiry has passed.
 func (s *PermissionService) UpdatePermission(ctx context.Context, args access.UpdatePermissionArgs) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 	if err := args.Validate(); err != nil {
 		return errors.Capture(err)
 	}
+	if args.ExpiresAt != nil {
+		if !args.ExpiresAt.After(s.clock.Now()) {
+			return errors.Errorf("expiry %s has passed: %w", args.ExpiresAt.UTC().Format(time.RFC3339), accesserrors.PermissionNotValid)
+		}
+		// Expiry times are stored, and compared, in UTC.
+		expiresAt := args.ExpiresAt.UTC()
+		args.ExpiresAt = &expiresAt
+	}
 	return errors.Capture(s.st.UpdatePermission(ctx, args))
 }
 
+// RevokeExpiredPermissions revokes the time-limited permissions which have
+// expired. Each user reverts to the access they held before the permission
+// was granted, or loses access to the target if they held none. The revoked
+// permissions are returned.
+func (s *PermissionService) RevokeExpiredPermissions(ctx context.Context) ([]access.ExpiredPermission, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+	expired, err := s.st.RevokeExpiredPermissions(ctx, s.clock.Now().UTC())
+	return expired, errors.Capture(err)
+}
+
 // AllModelAccessForOwner returns the model access for all activated models
 // across every cloud credential owned by owner, grouped by credential key.
 func (s *PermissionService) AllModelAccessForOwner(ctx context.Context, owner user.Name) ([]access.OwnerModelAccessByCredential, error) {
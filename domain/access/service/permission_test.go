@@ -4,10 +4,13 @@
 package service
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/canonical/gomock/gomock"
 	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/core/credential"
@@ -116,6 +119,75 @@ func (s *serviceSuite) TestUpsertPermission(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestUpdatePermissionWithExpiry(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(4 * time.Hour).In(time.FixedZone("test", 3600))
+	args := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Access: corepermission.WriteAccess,
+			Target: corepermission.ID{
+				ObjectType: corepermission.Model,
+				Key:        "model-uuid",
+			},
+		},
+		Change:    corepermission.Grant,
+		Subject:   usertesting.GenNewName(c, "testme"),
+		ExpiresAt: &expiresAt,
+	}
+	s.state.EXPECT().UpdatePermission(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, got access.UpdatePermissionArgs) error {
+			c.Check(*got.ExpiresAt, tc.Equals, now.Add(4*time.Hour))
+			return nil
+		})
+
+	err := NewService(s.state, testclock.NewClock(now)).UpdatePermission(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestUpdatePermissionExpiryPassed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	args := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Access: corepermission.WriteAccess,
+			Target: corepermission.ID{
+				ObjectType: corepermission.Model,
+				Key:        "model-uuid",
+			},
+		},
+		Change:    corepermission.Grant,
+		Subject:   usertesting.GenNewName(c, "testme"),
+		ExpiresAt: &now,
+	}
+
+	err := NewService(s.state, testclock.NewClock(now)).UpdatePermission(c.Context(), args)
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionNotValid)
+}
+
+func (s *serviceSuite) TestRevokeExpiredPermissions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	expired := []access.ExpiredPermission{{
+		Subject: usertesting.GenNewName(c, "testme"),
+		Target: corepermission.ID{
+			ObjectType: corepermission.Model,
+			Key:        "model-uuid",
+		},
+		Access:     corepermission.AdminAccess,
+		RevertedTo: corepermission.ReadAccess,
+		ExpiresAt:  now.Add(-time.Minute),
+	}}
+	s.state.EXPECT().RevokeExpiredPermissions(gomock.Any(), now).Return(expired, nil)
+
+	got, err := NewService(s.state, testclock.NewClock(now)).RevokeExpiredPermissions(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, expired)
+}
+
 func (s *serviceSuite) TestReadUserAccessForTarget(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().ReadUserAccessForTarget(gomock.Any(), usertesting.GenNewName(c, "testme"), gomock.AssignableToTypeOf(corepermission.ID{})).Return(corepermission.UserAccess{}, nil)
@@ -21,6 +21,7 @@ import (
 type State interface {
 	UserState
 	PermissionState
+	GroupState
 }
 
 // UserState describes retrieval and persistence methods for user identify and
@@ -218,12 +219,54 @@ type PermissionState interface {
 	// AllModelAccessForOwner returns the model access for all activated models
 	// across every cloud credential owned by owner, grouped by credential key.
 	AllModelAccessForOwner(ctx context.Context, owner user.Name) ([]access.OwnerModelAccessByCredential, error)
+
+	// RevokeExpiredPermissions revokes the time-limited permissions which
+	// expired at or before the given time, returning them.
+	RevokeExpiredPermissions(ctx context.Context, now time.Time) ([]access.ExpiredPermission, error)
+}
+
+// GroupState describes retrieval and persistence methods for user groups
+// and the permissions granted to them.
+type GroupState interface {
+	// AddGroup adds a new user group with the given name, created by the
+	// given user. If the creator does not exist, accesserrors.UserNotFound is
+	// returned. If a group with the name already exists,
+	// accesserrors.GroupAlreadyExists is returned.
+	AddGroup(ctx context.Context, groupUUID uuid.UUID, name string, creator user.Name) error
+
+	// UpdateGroupPermission grants or revokes the access of a user group on
+	// a target. If the group does not exist, accesserrors.GroupNotFound is
+	// returned.
+	UpdateGroupPermission(ctx context.Context, args access.UpdateGroupPermissionArgs) error
+
+	// ReadGroupAccessLevelForTarget returns the greatest access level any of
+	// the named user groups has on the target. If none of the groups have
+	// access to the target then accesserrors.AccessNotFound is returned.
+	ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error)
+
+	// AddUserToGroup adds the user as a member of the named group. If the
+	// group does not exist, accesserrors.GroupNotFound is returned. If the
+	// user does not exist, accesserrors.UserNotFound is returned. If the user
+	// is already a member, accesserrors.GroupMemberAlreadyExists is returned.
+	AddUserToGroup(ctx context.Context, group string, name user.Name) error
+
+	// RemoveUserFromGroup removes the user from the members of the named
+	// group. If the group does not exist, accesserrors.GroupNotFound is
+	// returned. If the user does not exist, accesserrors.UserNotFound is
+	// returned. If the user is not a member,
+	// accesserrors.GroupMemberNotFound is returned.
+	RemoveUserFromGroup(ctx context.Context, group string, name user.Name) error
+
+	// GetUserGroups returns the names of the groups the user is a member
+	// of, in name order.
+	GetUserGroups(ctx context.Context, name user.Name) ([]string, error)
 }
 
 // Service provides the API for working with users.
 type Service struct {
 	*UserService
 	*PermissionService
+	*GroupService
 }
 
 // NewService returns a new Service for interacting with the underlying access
@@ -231,6 +274,7 @@ type Service struct {
 func NewService(st State, clock clock.Clock) *Service {
 	return &Service{
 		UserService:       NewUserService(st, clock),
-		PermissionService: NewPermissionService(st),
+		PermissionService: NewPermissionService(st, clock),
+		GroupService:      NewGroupService(st),
 	}
 }
@@ -32,7 +32,9 @@ type MockState struct {
 // MockStateMockRecorder is the mock recorder for MockState.
 type MockStateMockRecorder struct {
 	mock                                     *MockState
+	addGroupExpects                          []*gomock.Call4_1[context.Context, uuid.UUID, string, user.Name, error]
 	addUserExpects                           []*gomock.Call6_1[context.Context, user.UUID, user.Name, string, bool, user.UUID, error]
+	addUserToGroupExpects                    []*gomock.Call3_1[context.Context, string, user.Name, error]
 	addUserWithActivationKeyExpects          []*gomock.Call7_1[context.Context, user.UUID, user.Name, string, user.UUID, permission.AccessSpec, []byte, error]
 	addUserWithCreatedAtExpects              []*gomock.Call6_1[context.Context, user.UUID, user.Name, string, user.UUID, time.Time, error]
 	addUserWithPasswordHashExpects           []*gomock.Call8_1[context.Context, user.UUID, user.Name, string, user.UUID, permission.AccessSpec, string, []byte, error]
@@ -48,17 +50,22 @@ type MockStateMockRecorder struct {
 	getUserExpects                           []*gomock.Call2_2[context.Context, user.UUID, user.User, error]
 	getUserByAuthExpects                     []*gomock.Call3_2[context.Context, user.Name, auth.Password, user.User, error]
 	getUserByNameExpects                     []*gomock.Call2_2[context.Context, user.Name, user.User, error]
+	getUserGroupsExpects                     []*gomock.Call2_2[context.Context, user.Name, []string, error]
 	getUserUUIDByNameExpects                 []*gomock.Call2_2[context.Context, user.Name, user.UUID, error]
 	importOfferAccessExpects                 []*gomock.Call2_1[context.Context, []access.OfferImportAccess, error]
 	lastModelLoginExpects                    []*gomock.Call3_2[context.Context, user.Name, model.UUID, time.Time, error]
 	readAllAccessForUserAndObjectTypeExpects []*gomock.Call3_2[context.Context, user.Name, permission.ObjectType, []permission.UserAccess, error]
 	readAllUserAccessForTargetExpects        []*gomock.Call2_2[context.Context, permission.ID, []permission.UserAccess, error]
 	readAllUserAccessForUserExpects          []*gomock.Call2_2[context.Context, user.Name, []permission.UserAccess, error]
+	readGroupAccessLevelForTargetExpects     []*gomock.Call3_2[context.Context, []string, permission.ID, permission.Access, error]
 	readUserAccessForTargetExpects           []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.UserAccess, error]
 	readUserAccessLevelForTargetExpects      []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
 	removeUserExpects                        []*gomock.Call2_1[context.Context, user.Name, error]
+	removeUserFromGroupExpects               []*gomock.Call3_1[context.Context, string, user.Name, error]
+	revokeExpiredPermissionsExpects          []*gomock.Call2_2[context.Context, time.Time, []access.ExpiredPermission, error]
 	setActivationKeyExpects                  []*gomock.Call3_1[context.Context, user.Name, []byte, error]
 	setPasswordHashExpects                   []*gomock.Call4_1[context.Context, user.Name, string, []byte, error]
+	updateGroupPermissionExpects             []*gomock.Call2_1[context.Context, access.UpdateGroupPermissionArgs, error]
 	updateLastModelLoginExpects              []*gomock.Call4_1[context.Context, user.Name, model.UUID, time.Time, error]
 	updatePermissionExpects                  []*gomock.Call2_1[context.Context, access.UpdatePermissionArgs, error]
 }
@@ -75,6 +82,24 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddGroup mocks base method.
+func (m *MockState) AddGroup(ctx context.Context, groupUUID uuid.UUID, name string, creator user.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.addGroupExpects, m.ctrl, m, "AddGroup", ctx, groupUUID, name, creator)
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockStateMockRecorder) AddGroup(ctx, groupUUID, name, creator any) *MockStateAddGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, uuid.UUID, string, user.Name, error](mr.mock.ctrl.T, mr.mock, "AddGroup", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(groupUUID), gomock.EnsureMatcher(name), gomock.EnsureMatcher(creator))
+	mr.addGroupExpects = append(mr.addGroupExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddGroupCall is the typed call wrapper for AddGroup.
+type MockStateAddGroupCall = gomock.Call4_1[context.Context, uuid.UUID, string, user.Name, error]
+
 // AddUser mocks base method.
 func (m *MockState) AddUser(ctx context.Context, arg1 user.UUID, name user.Name, displayName string, external bool, creatorUUID user.UUID) error {
 	m.ctrl.T.Helper()
@@ -93,6 +118,24 @@ func (mr *MockStateMockRecorder) AddUser(ctx, arg1, name, displayName, external,
 // MockStateAddUserCall is the typed call wrapper for AddUser.
 type MockStateAddUserCall = gomock.Call6_1[context.Context, user.UUID, user.Name, string, bool, user.UUID, error]
 
+// AddUserToGroup mocks base method.
+func (m *MockState) AddUserToGroup(ctx context.Context, group string, name user.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.addUserToGroupExpects, m.ctrl, m, "AddUserToGroup", ctx, group, name)
+}
+
+// AddUserToGroup indicates an expected call of AddUserToGroup.
+func (mr *MockStateMockRecorder) AddUserToGroup(ctx, group, name any) *MockStateAddUserToGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, user.Name, error](mr.mock.ctrl.T, mr.mock, "AddUserToGroup", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(group), gomock.EnsureMatcher(name))
+	mr.addUserToGroupExpects = append(mr.addUserToGroupExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddUserToGroupCall is the typed call wrapper for AddUserToGroup.
+type MockStateAddUserToGroupCall = gomock.Call3_1[context.Context, string, user.Name, error]
+
 // AddUserWithActivationKey mocks base method.
 func (m *MockState) AddUserWithActivationKey(ctx context.Context, arg1 user.UUID, name user.Name, displayName string, creatorUUID user.UUID, arg5 permission.AccessSpec, activationKey []byte) error {
 	m.ctrl.T.Helper()
@@ -363,6 +406,24 @@ func (mr *MockStateMockRecorder) GetUserByName(ctx, name any) *MockStateGetUserB
 // MockStateGetUserByNameCall is the typed call wrapper for GetUserByName.
 type MockStateGetUserByNameCall = gomock.Call2_2[context.Context, user.Name, user.User, error]
 
+// GetUserGroups mocks base method.
+func (m *MockState) GetUserGroups(ctx context.Context, name user.Name) ([]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getUserGroupsExpects, m.ctrl, m, "GetUserGroups", ctx, name)
+}
+
+// GetUserGroups indicates an expected call of GetUserGroups.
+func (mr *MockStateMockRecorder) GetUserGroups(ctx, name any) *MockStateGetUserGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, user.Name, []string, error](mr.mock.ctrl.T, mr.mock, "GetUserGroups", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getUserGroupsExpects = append(mr.getUserGroupsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetUserGroupsCall is the typed call wrapper for GetUserGroups.
+type MockStateGetUserGroupsCall = gomock.Call2_2[context.Context, user.Name, []string, error]
+
 // GetUserUUIDByName mocks base method.
 func (m *MockState) GetUserUUIDByName(ctx context.Context, name user.Name) (user.UUID, error) {
 	m.ctrl.T.Helper()
@@ -471,6 +532,24 @@ func (mr *MockStateMockRecorder) ReadAllUserAccessForUser(ctx, subject any) *Moc
 // MockStateReadAllUserAccessForUserCall is the typed call wrapper for ReadAllUserAccessForUser.
 type MockStateReadAllUserAccessForUserCall = gomock.Call2_2[context.Context, user.Name, []permission.UserAccess, error]
 
+// ReadGroupAccessLevelForTarget mocks base method.
+func (m *MockState) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readGroupAccessLevelForTargetExpects, m.ctrl, m, "ReadGroupAccessLevelForTarget", ctx, groups, target)
+}
+
+// ReadGroupAccessLevelForTarget indicates an expected call of ReadGroupAccessLevelForTarget.
+func (mr *MockStateMockRecorder) ReadGroupAccessLevelForTarget(ctx, groups, target any) *MockStateReadGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, []string, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadGroupAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(groups), gomock.EnsureMatcher(target))
+	mr.readGroupAccessLevelForTargetExpects = append(mr.readGroupAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateReadGroupAccessLevelForTargetCall is the typed call wrapper for ReadGroupAccessLevelForTarget.
+type MockStateReadGroupAccessLevelForTargetCall = gomock.Call3_2[context.Context, []string, permission.ID, permission.Access, error]
+
 // ReadUserAccessForTarget mocks base method.
 func (m *MockState) ReadUserAccessForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.UserAccess, error) {
 	m.ctrl.T.Helper()
@@ -525,6 +604,42 @@ func (mr *MockStateMockRecorder) RemoveUser(arg0, arg1 any) *MockStateRemoveUser
 // MockStateRemoveUserCall is the typed call wrapper for RemoveUser.
 type MockStateRemoveUserCall = gomock.Call2_1[context.Context, user.Name, error]
 
+// RemoveUserFromGroup mocks base method.
+func (m *MockState) RemoveUserFromGroup(ctx context.Context, group string, name user.Name) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.removeUserFromGroupExpects, m.ctrl, m, "RemoveUserFromGroup", ctx, group, name)
+}
+
+// RemoveUserFromGroup indicates an expected call of RemoveUserFromGroup.
+func (mr *MockStateMockRecorder) RemoveUserFromGroup(ctx, group, name any) *MockStateRemoveUserFromGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, user.Name, error](mr.mock.ctrl.T, mr.mock, "RemoveUserFromGroup", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(group), gomock.EnsureMatcher(name))
+	mr.removeUserFromGroupExpects = append(mr.removeUserFromGroupExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRemoveUserFromGroupCall is the typed call wrapper for RemoveUserFromGroup.
+type MockStateRemoveUserFromGroupCall = gomock.Call3_1[context.Context, string, user.Name, error]
+
+// RevokeExpiredPermissions mocks base method.
+func (m *MockState) RevokeExpiredPermissions(ctx context.Context, now time.Time) ([]access.ExpiredPermission, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.revokeExpiredPermissionsExpects, m.ctrl, m, "RevokeExpiredPermissions", ctx, now)
+}
+
+// RevokeExpiredPermissions indicates an expected call of RevokeExpiredPermissions.
+func (mr *MockStateMockRecorder) RevokeExpiredPermissions(ctx, now any) *MockStateRevokeExpiredPermissionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, time.Time, []access.ExpiredPermission, error](mr.mock.ctrl.T, mr.mock, "RevokeExpiredPermissions", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(now))
+	mr.revokeExpiredPermissionsExpects = append(mr.revokeExpiredPermissionsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRevokeExpiredPermissionsCall is the typed call wrapper for RevokeExpiredPermissions.
+type MockStateRevokeExpiredPermissionsCall = gomock.Call2_2[context.Context, time.Time, []access.ExpiredPermission, error]
+
 // SetActivationKey mocks base method.
 func (m *MockState) SetActivationKey(arg0 context.Context, arg1 user.Name, arg2 []byte) error {
 	m.ctrl.T.Helper()
@@ -561,6 +676,24 @@ func (mr *MockStateMockRecorder) SetPasswordHash(arg0, arg1, arg2, arg3 any) *Mo
 // MockStateSetPasswordHashCall is the typed call wrapper for SetPasswordHash.
 type MockStateSetPasswordHashCall = gomock.Call4_1[context.Context, user.Name, string, []byte, error]
 
+// UpdateGroupPermission mocks base method.
+func (m *MockState) UpdateGroupPermission(ctx context.Context, args access.UpdateGroupPermissionArgs) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateGroupPermissionExpects, m.ctrl, m, "UpdateGroupPermission", ctx, args)
+}
+
+// UpdateGroupPermission indicates an expected call of UpdateGroupPermission.
+func (mr *MockStateMockRecorder) UpdateGroupPermission(ctx, args any) *MockStateUpdateGroupPermissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, access.UpdateGroupPermissionArgs, error](mr.mock.ctrl.T, mr.mock, "UpdateGroupPermission", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.updateGroupPermissionExpects = append(mr.updateGroupPermissionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateUpdateGroupPermissionCall is the typed call wrapper for UpdateGroupPermission.
+type MockStateUpdateGroupPermissionCall = gomock.Call2_1[context.Context, access.UpdateGroupPermissionArgs, error]
+
 // UpdateLastModelLogin mocks base method.
 func (m *MockState) UpdateLastModelLogin(arg0 context.Context, arg1 user.Name, arg2 model.UUID, arg3 time.Time) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,471 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"strings"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/clock"
+
+	coredatabase "github.com/juju/juju/core/database"
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// GroupState describes retrieval and persistence methods for user groups
+// and the permissions granted to them.
+type GroupState struct {
+	*domain.StateBase
+
+	clock clock.Clock
+}
+
+// NewGroupState returns a new state reference.
+func NewGroupState(factory coredatabase.TxnRunnerFactory, clock clock.Clock) *GroupState {
+	return &GroupState{
+		StateBase: domain.NewStateBase(factory),
+		clock:     clock,
+	}
+}
+
+// AddGroup adds a new user group with the given name, created by the given
+// user.
+// If the creator does not exist or is removed, accesserrors.UserNotFound is
+// returned.
+// If a group with the name already exists, accesserrors.GroupAlreadyExists
+// is returned.
+func (st *GroupState) AddGroup(ctx context.Context, groupUUID uuid.UUID, name string, creator user.Name) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	creatorStmt, err := st.Prepare(`
+SELECT &nameAndUUID.*
+FROM   v_user_auth
+WHERE  name = $nameAndUUID.name
+AND    removed = false
+`, nameAndUUID{})
+	if err != nil {
+		return errors.Errorf("preparing select group creator statement: %w", err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO user_group (*) VALUES ($dbUserGroup.*)
+`, dbUserGroup{})
+	if err != nil {
+		return errors.Errorf("preparing insert group statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		creatorUser := nameAndUUID{Name: creator.Name()}
+		err := tx.Query(ctx, creatorStmt, creatorUser).Get(&creatorUser)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("group creator %q: %w", creator, accesserrors.UserNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting group creator %q: %w", creator, err)
+		}
+
+		group := dbUserGroup{
+			UUID:        groupUUID.String(),
+			Name:        name,
+			CreatorUUID: creatorUser.UUID,
+			CreatedAt:   st.clock.Now().UTC(),
+		}
+		err = tx.Query(ctx, insertStmt, group).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return errors.Errorf("group %q: %w", name, accesserrors.GroupAlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("adding group %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// UpdateGroupPermission grants or revokes the access of a user group on a
+// target. Granting access the group already has, or greater, returns an
+// error satisfying accesserrors.PermissionAccessGreater. Revoking access
+// lowers the access of the group to that below the access revoked, removing
+// the permission altogether if there is none.
+// If the group does not exist, accesserrors.GroupNotFound is returned.
+// If the target of a grant does not exist, accesserrors.PermissionTargetInvalid
+// is returned.
+func (st *GroupState) UpdateGroupPermission(ctx context.Context, args access.UpdateGroupPermissionArgs) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		groupUUID, err := st.getGroupUUID(ctx, tx, args.Group)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		current, err := st.getGroupPermission(ctx, tx, groupUUID, args.AccessSpec.Target.Key)
+		if err != nil && !errors.Is(err, accesserrors.PermissionNotFound) {
+			return errors.Capture(err)
+		}
+		exists := err == nil
+
+		switch args.Change {
+		case corepermission.Grant:
+			return errors.Capture(st.grantGroupPermission(ctx, tx, groupUUID, current, exists, args))
+		case corepermission.Revoke:
+			if !exists {
+				return nil
+			}
+			return errors.Capture(st.revokeGroupPermission(ctx, tx, current, args))
+		default:
+			return errors.Errorf("change type %q %w", args.Change, coreerrors.NotValid)
+		}
+	})
+}
+
+// ReadGroupAccessLevelForTarget returns the greatest access level any of the
+// named user groups has on the target. Groups which do not exist are
+// ignored.
+// If none of the groups have access to the target then
+// accesserrors.AccessNotFound is returned.
+func (st *GroupState) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target corepermission.ID) (corepermission.Access, error) {
+	if len(groups) == 0 {
+		return corepermission.NoAccess, errors.Errorf("%w for groups on %q", accesserrors.AccessNotFound, target.Key)
+	}
+
+	db, err := st.DB(ctx)
+	if err != nil {
+		return corepermission.NoAccess, errors.Capture(err)
+	}
+
+	perm := dbGroupPermission{
+		GrantOn:    target.Key,
+		ObjectType: string(target.ObjectType),
+	}
+	stmt, err := st.Prepare(`
+SELECT &dbGroupPermission.access_type
+FROM   v_user_group_permission
+WHERE  grant_on = $dbGroupPermission.grant_on
+AND    object_type = $dbGroupPermission.object_type
+AND    group_name IN ($groupNames[:])
+`, perm, groupNames{})
+	if err != nil {
+		return corepermission.NoAccess, errors.Errorf("preparing select group access level statement: %w", err)
+	}
+
+	var perms []dbGroupPermission
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, perm, groupNames(groups)).GetAll(&perms)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w for groups %q on %q",
+				accesserrors.AccessNotFound, strings.Join(groups, ", "), target.Key)
+		} else if err != nil {
+			return errors.Errorf("reading group access level for target: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return corepermission.NoAccess, errors.Capture(err)
+	}
+
+	result := corepermission.NoAccess
+	for _, p := range perms {
+		access := corepermission.Access(p.AccessType)
+		if result == corepermission.NoAccess || !(corepermission.AccessSpec{Target: target, Access: result}).EqualOrGreaterThan(access) {
+			result = access
+		}
+	}
+	return result, nil
+}
+
+// AddUserToGroup adds the user as a member of the named group.
+// If the group does not exist, accesserrors.GroupNotFound is returned.
+// If the user does not exist or is removed, accesserrors.UserNotFound is
+// returned.
+// If the user is already a member of the group,
+// accesserrors.GroupMemberAlreadyExists is returned.
+func (st *GroupState) AddUserToGroup(ctx context.Context, group string, name user.Name) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO user_group_member (*) VALUES ($dbGroupMember.*)
+`, dbGroupMember{})
+	if err != nil {
+		return errors.Errorf("preparing insert group member statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		member, err := st.getGroupMember(ctx, tx, group, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		err = tx.Query(ctx, insertStmt, member).Run()
+		if internaldatabase.IsErrConstraintPrimaryKey(err) {
+			return errors.Errorf("user %q in group %q: %w", name, group, accesserrors.GroupMemberAlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("adding user %q to group %q: %w", name, group, err)
+		}
+		return nil
+	})
+}
+
+// RemoveUserFromGroup removes the user from the members of the named group.
+// If the group does not exist, accesserrors.GroupNotFound is returned.
+// If the user does not exist or is removed, accesserrors.UserNotFound is
+// returned.
+// If the user is not a member of the group, accesserrors.GroupMemberNotFound
+// is returned.
+func (st *GroupState) RemoveUserFromGroup(ctx context.Context, group string, name user.Name) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	deleteStmt, err := st.Prepare(`
+DELETE FROM user_group_member
+WHERE  group_uuid = $dbGroupMember.group_uuid
+AND    user_uuid = $dbGroupMember.user_uuid
+`, dbGroupMember{})
+	if err != nil {
+		return errors.Errorf("preparing delete group member statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		member, err := st.getGroupMember(ctx, tx, group, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, deleteStmt, member).Get(&outcome); err != nil {
+			return errors.Errorf("removing user %q from group %q: %w", name, group, err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("removing user %q from group %q: %w", name, group, err)
+		} else if affected == 0 {
+			return errors.Errorf("user %q in group %q: %w", name, group, accesserrors.GroupMemberNotFound)
+		}
+		return nil
+	})
+}
+
+// GetUserGroups returns the names of the groups the user is a member of,
+// in name order. Removed and disabled users are members of no groups.
+func (st *GroupState) GetUserGroups(ctx context.Context, name user.Name) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	userName := nameAndUUID{Name: name.Name()}
+	stmt, err := st.Prepare(`
+SELECT &dbGroupName.*
+FROM   v_user_group_member
+WHERE  user_name = $nameAndUUID.name
+ORDER BY group_name
+`, dbGroupName{}, userName)
+	if err != nil {
+		return nil, errors.Errorf("preparing select user groups statement: %w", err)
+	}
+
+	var groups []dbGroupName
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, userName).GetAll(&groups)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting groups of user %q: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]string, len(groups))
+	for i, g := range groups {
+		result[i] = g.Name
+	}
+	return result, nil
+}
+
+// getGroupMember returns the membership of the user in the named group,
+// checking that both exist.
+func (st *GroupState) getGroupMember(ctx context.Context, tx *sqlair.TX, group string, name user.Name) (dbGroupMember, error) {
+	groupUUID, err := st.getGroupUUID(ctx, tx, group)
+	if err != nil {
+		return dbGroupMember{}, errors.Capture(err)
+	}
+	userUUID, err := GetUserUUIDByName(ctx, tx, name)
+	if err != nil {
+		return dbGroupMember{}, errors.Capture(err)
+	}
+	return dbGroupMember{
+		GroupUUID: groupUUID,
+		UserUUID:  userUUID.String(),
+	}, nil
+}
+
+// getGroupUUID returns the UUID of the named group.
+func (st *GroupState) getGroupUUID(ctx context.Context, tx *sqlair.TX, name string) (string, error) {
+	stmt, err := st.Prepare(`
+SELECT &nameAndUUID.*
+FROM   user_group
+WHERE  name = $nameAndUUID.name
+`, nameAndUUID{})
+	if err != nil {
+		return "", errors.Errorf("preparing select group statement: %w", err)
+	}
+
+	group := nameAndUUID{Name: name}
+	err = tx.Query(ctx, stmt, group).Get(&group)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Errorf("group %q: %w", name, accesserrors.GroupNotFound)
+	} else if err != nil {
+		return "", errors.Errorf("getting group %q: %w", name, err)
+	}
+	return group.UUID, nil
+}
+
+// getGroupPermission returns the permission of the group on the target.
+// accesserrors.PermissionNotFound is returned if the group has no
+// permission on the target.
+func (st *GroupState) getGroupPermission(ctx context.Context, tx *sqlair.TX, groupUUID, grantOn string) (dbGroupPermission, error) {
+	in := dbGroupPermission{
+		GrantTo: groupUUID,
+		GrantOn: grantOn,
+	}
+	stmt, err := st.Prepare(`
+SELECT &dbGroupPermission.*
+FROM   v_user_group_permission
+WHERE  grant_to = $dbGroupPermission.grant_to
+AND    grant_on = $dbGroupPermission.grant_on
+`, in)
+	if err != nil {
+		return dbGroupPermission{}, errors.Errorf("preparing select group permission statement: %w", err)
+	}
+
+	var perm dbGroupPermission
+	err = tx.Query(ctx, stmt, in).Get(&perm)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return dbGroupPermission{}, errors.Errorf("%q on %q: %w", groupUUID, grantOn, accesserrors.PermissionNotFound)
+	} else if err != nil {
+		return dbGroupPermission{}, errors.Capture(err)
+	}
+	return perm, nil
+}
+
+func (st *GroupState) grantGroupPermission(
+	ctx context.Context, tx *sqlair.TX, groupUUID string, current dbGroupPermission, exists bool, args access.UpdateGroupPermissionArgs,
+) error {
+	spec := args.AccessSpec
+	if !exists {
+		if err := targetExists(ctx, tx, spec.Target); err != nil {
+			return errors.Capture(err)
+		}
+		newUUID, err := uuid.NewUUID()
+		if err != nil {
+			return errors.Errorf("generating new UUID: %w", err)
+		}
+		return errors.Capture(st.insertGroupPermission(ctx, tx, dbGroupPermission{
+			UUID:       newUUID.String(),
+			GrantOn:    spec.Target.Key,
+			GrantTo:    groupUUID,
+			AccessType: string(spec.Access),
+			ObjectType: string(spec.Target.ObjectType),
+		}))
+	}
+
+	currentSpec := corepermission.AccessSpec{Target: spec.Target, Access: corepermission.Access(current.AccessType)}
+	if currentSpec.EqualOrGreaterThan(spec.Access) {
+		return errors.Errorf("group %q already has %q %w", args.Group, spec.Access, accesserrors.PermissionAccessGreater)
+	}
+	current.AccessType = string(spec.Access)
+	return errors.Capture(st.setGroupPermissionAccess(ctx, tx, current))
+}
+
+func (st *GroupState) revokeGroupPermission(
+	ctx context.Context, tx *sqlair.TX, current dbGroupPermission, args access.UpdateGroupPermissionArgs,
+) error {
+	newAccess := args.AccessSpec.RevokeAccess()
+	if newAccess == corepermission.NoAccess {
+		stmt, err := st.Prepare(`
+DELETE FROM user_group_permission
+WHERE  uuid = $dbGroupPermission.uuid
+`, current)
+		if err != nil {
+			return errors.Errorf("preparing delete group permission statement: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, current).Run(); err != nil {
+			return errors.Errorf("revoking %q of group %q: %w", args.AccessSpec.Access, args.Group, err)
+		}
+		return nil
+	}
+
+	// Revoking access never raises the access of the group.
+	newSpec := corepermission.AccessSpec{Target: args.AccessSpec.Target, Access: newAccess}
+	if newSpec.EqualOrGreaterThan(corepermission.Access(current.AccessType)) {
+		return nil
+	}
+	current.AccessType = string(newAccess)
+	return errors.Capture(st.setGroupPermissionAccess(ctx, tx, current))
+}
+
+func (st *GroupState) insertGroupPermission(ctx context.Context, tx *sqlair.TX, perm dbGroupPermission) error {
+	stmt, err := st.Prepare(`
+INSERT INTO user_group_permission (uuid, access_type_id, object_type_id, grant_to, grant_on)
+SELECT $dbGroupPermission.uuid,
+       at.id,
+       ot.id,
+       $dbGroupPermission.grant_to,
+       $dbGroupPermission.grant_on
+FROM   permission_access_type at,
+       permission_object_type ot
+WHERE  at.type = $dbGroupPermission.access_type
+AND    ot.type = $dbGroupPermission.object_type
+`, perm)
+	if err != nil {
+		return errors.Errorf("preparing insert group permission statement: %w", err)
+	}
+
+	err = tx.Query(ctx, stmt, perm).Run()
+	if internaldatabase.IsErrConstraintUnique(err) {
+		return errors.Errorf("%q on %q: %w", perm.GrantTo, perm.GrantOn, accesserrors.PermissionAlreadyExists)
+	} else if internaldatabase.IsErrConstraintForeignKey(err) {
+		return errors.Errorf("%q on %q %w", perm.AccessType, perm.ObjectType, accesserrors.PermissionAccessInvalid)
+	} else if err != nil {
+		return errors.Errorf("adding permission %q for group %q on %q: %w",
+			perm.AccessType, perm.GrantTo, perm.GrantOn, err)
+	}
+	return nil
+}
+
+func (st *GroupState) setGroupPermissionAccess(ctx context.Context, tx *sqlair.TX, perm dbGroupPermission) error {
+	stmt, err := st.Prepare(`
+UPDATE user_group_permission
+SET    access_type_id = (
+           SELECT id
+           FROM   permission_access_type
+           WHERE  type = $dbGroupPermission.access_type
+       )
+WHERE  uuid = $dbGroupPermission.uuid
+`, perm)
+	if err != nil {
+		return errors.Errorf("preparing update group permission statement: %w", err)
+	}
+	if err := tx.Query(ctx, stmt, perm).Run(); err != nil {
+		return errors.Errorf("setting access of group permission %q to %q: %w", perm.UUID, perm.AccessType, err)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	coremodel "github.com/juju/juju/core/model"
+	corepermission "github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	modeltesting "github.com/juju/juju/domain/model/state/testing"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type groupStateSuite struct {
+	schematesting.ControllerSuite
+
+	modelUUID coremodel.UUID
+}
+
+func TestGroupStateSuite(t *testing.T) {
+	tc.Run(t, &groupStateSuite{})
+}
+
+func (s *groupStateSuite) SetUpTest(c *tc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.SeedControllerUUID(c)
+	s.modelUUID = modeltesting.CreateTestModel(c, s.TxnRunnerFactory(), "test-model")
+
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO user (uuid, name, display_name, external, removed, created_by_uuid, created_at)
+			VALUES ('42', 'admin', 'admin', false, false, '42', ?)
+		`, time.Now())
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *groupStateSuite) TestAddGroup(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+
+	groupUUID := tc.Must(c, uuid.NewUUID)
+	err := st.AddGroup(c.Context(), groupUUID, "sre", usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	var name, creatorUUID string
+	row := s.DB().QueryRowContext(c.Context(), `SELECT name, created_by_uuid FROM user_group WHERE uuid = ?`, groupUUID.String())
+	c.Assert(row.Scan(&name, &creatorUUID), tc.ErrorIsNil)
+	c.Check(name, tc.Equals, "sre")
+	c.Check(creatorUUID, tc.Equals, "42")
+}
+
+func (s *groupStateSuite) TestAddGroupAlreadyExists(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	err := st.AddGroup(c.Context(), tc.Must(c, uuid.NewUUID), "sre", usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupAlreadyExists)
+}
+
+func (s *groupStateSuite) TestAddGroupCreatorNotFound(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+
+	err := st.AddGroup(c.Context(), tc.Must(c, uuid.NewUUID), "sre", usertesting.GenNewName(c, "bob"))
+	c.Assert(err, tc.ErrorIs, accesserrors.UserNotFound)
+}
+
+func (s *groupStateSuite) TestUpdateGroupPermissionGrant(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	err := st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.ReadAccess))
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkGroupAccess(c, st, []string{"sre"}, corepermission.ReadAccess)
+
+	err = st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.AdminAccess))
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkGroupAccess(c, st, []string{"sre"}, corepermission.AdminAccess)
+
+	err = st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.WriteAccess))
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionAccessGreater)
+}
+
+func (s *groupStateSuite) TestUpdateGroupPermissionGrantGroupNotFound(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+
+	err := st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.ReadAccess))
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupNotFound)
+}
+
+func (s *groupStateSuite) TestUpdateGroupPermissionGrantTargetNotFound(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	err := st.UpdateGroupPermission(c.Context(), access.UpdateGroupPermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Access: corepermission.ReadAccess,
+			Target: corepermission.ID{ObjectType: corepermission.Model, Key: tc.Must(c, uuid.NewUUID).String()},
+		},
+		Change: corepermission.Grant,
+		Group:  "sre",
+	})
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionTargetInvalid)
+}
+
+func (s *groupStateSuite) TestUpdateGroupPermissionRevoke(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	err := st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.AdminAccess))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Revoking admin leaves write access.
+	err = st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Revoke, corepermission.AdminAccess))
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkGroupAccess(c, st, []string{"sre"}, corepermission.WriteAccess)
+
+	// Revoking read removes the permission.
+	err = st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Revoke, corepermission.ReadAccess))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.ReadGroupAccessLevelForTarget(c.Context(), []string{"sre"}, s.modelTarget())
+	c.Assert(err, tc.ErrorIs, accesserrors.AccessNotFound)
+}
+
+func (s *groupStateSuite) TestUpdateGroupPermissionRevokeDoesNotRaiseAccess(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	err := st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.ReadAccess))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Revoke, corepermission.AdminAccess))
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkGroupAccess(c, st, []string{"sre"}, corepermission.ReadAccess)
+}
+
+func (s *groupStateSuite) TestReadGroupAccessLevelForTargetGreatest(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+	s.addGroup(c, st, "dev")
+
+	err := st.UpdateGroupPermission(c.Context(), s.modelChange("sre", corepermission.Grant, corepermission.AdminAccess))
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.UpdateGroupPermission(c.Context(), s.modelChange("dev", corepermission.Grant, corepermission.ReadAccess))
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.checkGroupAccess(c, st, []string{"dev"}, corepermission.ReadAccess)
+	s.checkGroupAccess(c, st, []string{"dev", "sre", "unknown"}, corepermission.AdminAccess)
+}
+
+func (s *groupStateSuite) TestReadGroupAccessLevelForTargetNotFound(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	_, err := st.ReadGroupAccessLevelForTarget(c.Context(), []string{"sre", "unknown"}, s.modelTarget())
+	c.Assert(err, tc.ErrorIs, accesserrors.AccessNotFound)
+
+	_, err = st.ReadGroupAccessLevelForTarget(c.Context(), nil, s.modelTarget())
+	c.Assert(err, tc.ErrorIs, accesserrors.AccessNotFound)
+}
+
+func (s *groupStateSuite) TestAddUserToGroup(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+	s.addGroup(c, st, "dba")
+	admin := usertesting.GenNewName(c, "admin")
+
+	err := st.AddUserToGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddUserToGroup(c.Context(), "dba", admin)
+	c.Assert(err, tc.ErrorIsNil)
+
+	groups, err := st.GetUserGroups(c.Context(), admin)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(groups, tc.DeepEquals, []string{"dba", "sre"})
+}
+
+func (s *groupStateSuite) TestAddUserToGroupAlreadyMember(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+	admin := usertesting.GenNewName(c, "admin")
+
+	err := st.AddUserToGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddUserToGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupMemberAlreadyExists)
+}
+
+func (s *groupStateSuite) TestAddUserToGroupNotFound(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+
+	err := st.AddUserToGroup(c.Context(), "dba", usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupNotFound)
+
+	err = st.AddUserToGroup(c.Context(), "sre", usertesting.GenNewName(c, "bob"))
+	c.Assert(err, tc.ErrorIs, accesserrors.UserNotFound)
+}
+
+func (s *groupStateSuite) TestRemoveUserFromGroup(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+	admin := usertesting.GenNewName(c, "admin")
+	err := st.AddUserToGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.RemoveUserFromGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIsNil)
+
+	groups, err := st.GetUserGroups(c.Context(), admin)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(groups, tc.HasLen, 0)
+
+	err = st.RemoveUserFromGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIs, accesserrors.GroupMemberNotFound)
+}
+
+func (s *groupStateSuite) addGroup(c *tc.C, st *GroupState, name string) {
+	err := st.AddGroup(c.Context(), tc.Must(c, uuid.NewUUID), name, usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *groupStateSuite) modelTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Model, Key: s.modelUUID.String()}
+}
+
+func (s *groupStateSuite) modelChange(group string, change corepermission.AccessChange, accessLevel corepermission.Access) access.UpdateGroupPermissionArgs {
+	return access.UpdateGroupPermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Access: accessLevel,
+			Target: s.modelTarget(),
+		},
+		Change: change,
+		Group:  group,
+	}
+}
+
+func (s *groupStateSuite) checkGroupAccess(c *tc.C, st *GroupState, groups []string, expected corepermission.Access) {
+	got, err := st.ReadGroupAccessLevelForTarget(c.Context(), groups, s.modelTarget())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.Equals, expected)
+}
+
+func (s *groupStateSuite) TestGetUserGroupsDisabledUser(c *tc.C) {
+	st := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+	s.addGroup(c, st, "sre")
+	admin := usertesting.GenNewName(c, "admin")
+	err := st.AddUserToGroup(c.Context(), "sre", admin)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().ExecContext(c.Context(), `
+		INSERT INTO user_authentication (user_uuid, disabled) VALUES ('42', true)
+	`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	groups, err := st.GetUserGroups(c.Context(), admin)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(groups, tc.HasLen, 0)
+}
@@ -5,10 +5,12 @@ package state
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"maps"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/clock"
@@ -151,8 +153,8 @@ func (st *PermissionState) DeletePermission(ctx context.Context, subject user.Na
 	return errors.Capture(err)
 }
 
-// DeletePermissionsByGrantOnUUID removes the removes permissions by given
-// GrantOn UUIDs.
+// DeletePermissionsByGrantOnUUID removes the permissions, including those
+// granted to user groups, by given GrantOn UUIDs.
 func (st *PermissionState) DeletePermissionsByGrantOnUUID(ctx context.Context, permissionUUIDs []string) error {
 	db, err := st.DB(ctx)
 	if err != nil {
@@ -163,6 +165,12 @@ func (st *PermissionState) DeletePermissionsByGrantOnUUID(ctx context.Context, p
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		deletePermissionStmt, err := st.Prepare(`
 DELETE FROM permission WHERE grant_on IN ($uuids[:])
+`, uuids{})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		deleteGroupPermissionStmt, err := st.Prepare(`
+DELETE FROM user_group_permission WHERE grant_on IN ($uuids[:])
 `, uuids{})
 		if err != nil {
 			return errors.Capture(err)
@@ -172,6 +180,10 @@ DELETE FROM permission WHERE grant_on IN ($uuids[:])
 		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return errors.Errorf("deleting permissions of %s: %w", strings.Join(permissionUUIDs, ", "), err)
 		}
+		err = tx.Query(ctx, deleteGroupPermissionStmt, uuids(permissionUUIDs)).Run()
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("deleting group permissions of %s: %w", strings.Join(permissionUUIDs, ", "), err)
+		}
 		return nil
 
 	})
@@ -402,16 +414,10 @@ func (st *PermissionState) ReadAllUserAccessForUser(ctx context.Context, subject
 	query := `
 SELECT (u.uuid, u.name, u.display_name, u.external, u.created_at, u.disabled) AS (&dbPermissionUser.*),
        creator.name AS &dbPermissionUser.created_by_name,
-       p.uuid AS &dbPermission.uuid,
-       p.grant_on AS &dbPermission.grant_on,
-       p.grant_to AS &dbPermission.grant_to,
-       pat.type AS &dbPermission.access_type,
-       pot.type AS &dbPermission.object_type
+       (p.*) AS (&dbPermission.*)
 FROM   v_user_auth u
        JOIN user AS creator ON u.created_by_uuid = creator.uuid
-       LEFT JOIN permission p ON u.uuid = p.grant_to
-       LEFT JOIN permission_access_type pat ON p.access_type_id = pat.id
-       LEFT JOIN permission_object_type pot ON p.object_type_id = pot.id
+       LEFT JOIN v_permission p ON u.uuid = p.grant_to
 WHERE  u.removed = false
        AND u.name = $userName.name
 `
@@ -794,9 +800,11 @@ WHERE  u.removed = false
 }
 
 // targetExists returns an error if the target does not exist in neither the
-// cloud nor model tables and is not a controller.
+// cloud nor model tables and is not a controller. For applications, the model
+// of the application must exist.
 func targetExists(ctx context.Context, tx *sqlair.TX, target corepermission.ID) error {
 	var targetExists string
+	grantOn := target.Key
 	switch target.ObjectType {
 	case coredatabase.ControllerNS:
 		targetExists = `
@@ -818,6 +826,19 @@ WHERE   name = $M.grant_on
 `
 	case corepermission.Offer:
 		return nil
+	case corepermission.Application:
+		// The application lives in the model database, so only the model
+		// it belongs to can be verified here.
+		modelUUID, _, _, err := corepermission.ParseApplicationKey(target.Key)
+		if err != nil {
+			return errors.Errorf("%q %w", target, accesserrors.PermissionTargetInvalid)
+		}
+		grantOn = modelUUID
+		targetExists = `
+SELECT  uuid AS &M.found
+FROM    model
+WHERE   uuid = $M.grant_on
+`
 	default:
 		return errors.Errorf("object type %q %w", target.ObjectType, coreerrors.NotValid)
 	}
@@ -828,7 +849,7 @@ WHERE   name = $M.grant_on
 	}
 
 	m := sqlair.M{}
-	err = tx.Query(ctx, targetExistsStmt, sqlair.M{"grant_on": target.Key}).Get(&m)
+	err = tx.Query(ctx, targetExistsStmt, sqlair.M{"grant_on": grantOn}).Get(&m)
 	if errors.Is(err, sqlair.ErrNoRows) {
 		return errors.Errorf("%q %w", target, accesserrors.PermissionTargetInvalid)
 	} else if err != nil {
@@ -883,33 +904,17 @@ AND    u.removed = false
 }
 
 func (st *PermissionState) grantPermission(ctx context.Context, tx *sqlair.TX, subjectUUID user.UUID, args access.UpdatePermissionArgs) error {
-	inOut := permInOut{
-		Name:    args.Subject.Name(),
-		GrantOn: args.AccessSpec.Target.Key,
-	}
-
-	readQuery := `
-SELECT  p.access_type AS &permInOut.*
-FROM    v_permission p
-        LEFT JOIN v_user_auth u ON u.uuid = p.grant_to
-WHERE   u.name = $permInOut.name
-AND     u.disabled = false
-AND     u.removed = false
-AND     p.grant_on = $permInOut.grant_on
-`
-	readStmt, err := st.Prepare(readQuery, inOut)
-	if err != nil {
-		return errors.Errorf("preparing select existsing user access statement: %w", err)
+	spec := args.AccessSpec
+	if err := st.expirePermission(ctx, tx, subjectUUID, spec.Target.Key); err != nil {
+		return errors.Capture(err)
 	}
 
-	// Check the access level only if it exists, it may not for grant.
-	err = tx.Query(ctx, readStmt, inOut).Get(&inOut)
-	if errors.Is(err, sqlair.ErrNoRows) {
+	current, err := st.getPermissionGrant(ctx, tx, subjectUUID, spec.Target.Key)
+	if errors.Is(err, accesserrors.PermissionNotFound) {
 		newUUID, err := uuid.NewUUID()
 		if err != nil {
 			return errors.Errorf("generating new UUID: %w", err)
 		}
-		spec := args.AccessSpec
 		perm := dbPermission{
 			UUID:       newUUID.String(),
 			GrantOn:    spec.Target.Key,
@@ -917,27 +922,224 @@ AND     p.grant_on = $permInOut.grant_on
 			AccessType: string(spec.Access),
 			ObjectType: string(spec.Target.ObjectType),
 		}
+		if args.ExpiresAt != nil {
+			perm.ExpiresAt = dbExpiresAt{Null: sql.Null[time.Time]{V: *args.ExpiresAt, Valid: true}}
+		}
 		err = insertPermission(ctx, tx, perm)
 		return errors.Capture(err)
 	} else if err != nil {
 		return errors.Errorf("getting current access for grant: %w", err)
 	}
 
-	aSpec := corepermission.AccessSpec{
-		Target: args.AccessSpec.Target,
-		Access: corepermission.Access(inOut.Access),
+	updated, err := current.grant(spec.Target, spec.Access, args.ExpiresAt)
+	if err != nil {
+		return errors.Errorf("user %q %w", args.Subject, err)
 	}
+	if err := st.setPermissionGrant(ctx, tx, updated); err != nil {
+		return errors.Errorf("updating current access during grant: %w", err)
+	}
+	return nil
+}
 
-	grantAccess := args.AccessSpec.Access
-	if aSpec.EqualOrGreaterThan(grantAccess) {
-		return errors.Errorf("user %q already has %q %w", args.Subject, grantAccess, accesserrors.PermissionAccessGreater)
+// getPermissionGrant returns the permission of the subject on the target as
+// it is stored. [accesserrors.PermissionNotFound] is returned if the subject
+// has no permission on the target, or is disabled or removed.
+func (st *PermissionState) getPermissionGrant(ctx context.Context, tx *sqlair.TX, subjectUUID user.UUID, grantOn string) (dbPermissionGrant, error) {
+	in := dbPermission{
+		GrantTo: subjectUUID.String(),
+		GrantOn: grantOn,
 	}
-	if err := st.updatePermission(ctx, tx, args.Subject.Name(), args.AccessSpec.Target.Key, grantAccess.String()); err != nil {
-		return errors.Errorf("updating current access during grant: %w", err)
+	stmt, err := st.Prepare(`
+SELECT  (p.uuid, p.expires_at) AS (&dbPermissionGrant.*),
+        at.type AS &dbPermissionGrant.access_type,
+        rat.type AS &dbPermissionGrant.revert_access_type
+FROM    permission AS p
+        JOIN v_user_auth AS u ON u.uuid = p.grant_to
+        JOIN permission_access_type AS at ON at.id = p.access_type_id
+        LEFT JOIN permission_access_type AS rat ON rat.id = p.revert_access_type_id
+WHERE   p.grant_to = $dbPermission.grant_to
+AND     p.grant_on = $dbPermission.grant_on
+AND     u.disabled = false
+AND     u.removed = false
+`, in, dbPermissionGrant{})
+	if err != nil {
+		return dbPermissionGrant{}, errors.Errorf("preparing select permission statement: %w", err)
+	}
+
+	var grant dbPermissionGrant
+	err = tx.Query(ctx, stmt, in).Get(&grant)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return dbPermissionGrant{}, errors.Errorf("%q on %q: %w", subjectUUID, grantOn, accesserrors.PermissionNotFound)
+	} else if err != nil {
+		return dbPermissionGrant{}, errors.Capture(err)
+	}
+	return grant, nil
+}
+
+// setPermissionGrant sets the access, and the expiry of time-limited access,
+// of an existing permission.
+func (st *PermissionState) setPermissionGrant(ctx context.Context, tx *sqlair.TX, grant dbPermissionGrant) error {
+	stmt, err := st.Prepare(`
+UPDATE permission
+SET    access_type_id = (
+           SELECT id
+           FROM   permission_access_type
+           WHERE  type = $dbPermissionGrant.access_type
+       ),
+       revert_access_type_id = (
+           SELECT id
+           FROM   permission_access_type
+           WHERE  type = $dbPermissionGrant.revert_access_type
+       ),
+       expires_at = $dbPermissionGrant.expires_at
+WHERE  uuid = $dbPermissionGrant.uuid
+`, grant)
+	if err != nil {
+		return errors.Errorf("preparing update permission statement: %w", err)
+	}
+	if err := tx.Query(ctx, stmt, grant).Run(); err != nil {
+		return errors.Errorf("setting access of permission %q to %q: %w", grant.UUID, grant.AccessType, err)
 	}
 	return nil
 }
 
+// expirePermission applies the expiry of the subject's permission on the
+// target, if it is time-limited and has expired, so that the access stored
+// is the access in effect.
+func (st *PermissionState) expirePermission(ctx context.Context, tx *sqlair.TX, subjectUUID user.UUID, grantOn string) error {
+	in := dbPermission{
+		GrantTo: subjectUUID.String(),
+		GrantOn: grantOn,
+	}
+	now := dbExpiryTime{Now: st.clock.Now().UTC()}
+
+	deleteStmt, err := st.Prepare(`
+DELETE FROM permission
+WHERE  grant_to = $dbPermission.grant_to
+AND    grant_on = $dbPermission.grant_on
+AND    revert_access_type_id IS NULL
+AND    JULIANDAY(expires_at) <= JULIANDAY($dbExpiryTime.now)
+`, in, now)
+	if err != nil {
+		return errors.Errorf("preparing delete expired permission statement: %w", err)
+	}
+	revertStmt, err := st.Prepare(`
+UPDATE permission
+SET    access_type_id = revert_access_type_id,
+       revert_access_type_id = NULL,
+       expires_at = NULL
+WHERE  grant_to = $dbPermission.grant_to
+AND    grant_on = $dbPermission.grant_on
+AND    JULIANDAY(expires_at) <= JULIANDAY($dbExpiryTime.now)
+`, in, now)
+	if err != nil {
+		return errors.Errorf("preparing revert expired permission statement: %w", err)
+	}
+
+	if err := tx.Query(ctx, deleteStmt, in, now).Run(); err != nil {
+		return errors.Errorf("deleting expired permission on %q: %w", grantOn, err)
+	}
+	if err := tx.Query(ctx, revertStmt, in, now).Run(); err != nil {
+		return errors.Errorf("reverting expired permission on %q: %w", grantOn, err)
+	}
+	return nil
+}
+
+// RevokeExpiredPermissions revokes the time-limited permissions which
+// expired at or before the given time. Each permission reverts to the access
+// held before it was granted, or is removed if there was none. The revoked
+// permissions are returned.
+func (st *PermissionState) RevokeExpiredPermissions(ctx context.Context, now time.Time) ([]access.ExpiredPermission, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	expiry := dbExpiryTime{Now: now}
+	selectStmt, err := st.Prepare(`
+SELECT  (p.uuid, p.grant_on, p.expires_at) AS (&dbExpiredPermission.*),
+        u.name AS &dbExpiredPermission.subject_name,
+        ot.type AS &dbExpiredPermission.object_type,
+        at.type AS &dbExpiredPermission.access_type,
+        rat.type AS &dbExpiredPermission.revert_access_type
+FROM    permission AS p
+        JOIN user AS u ON u.uuid = p.grant_to
+        JOIN permission_object_type AS ot ON ot.id = p.object_type_id
+        JOIN permission_access_type AS at ON at.id = p.access_type_id
+        LEFT JOIN permission_access_type AS rat ON rat.id = p.revert_access_type_id
+WHERE   JULIANDAY(p.expires_at) <= JULIANDAY($dbExpiryTime.now)
+`, expiry, dbExpiredPermission{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select expired permissions statement: %w", err)
+	}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM permission
+WHERE  uuid IN ($permissionUUIDs[:])
+AND    revert_access_type_id IS NULL
+`, permissionUUIDs{})
+	if err != nil {
+		return nil, errors.Errorf("preparing delete expired permissions statement: %w", err)
+	}
+	revertStmt, err := st.Prepare(`
+UPDATE permission
+SET    access_type_id = revert_access_type_id,
+       revert_access_type_id = NULL,
+       expires_at = NULL
+WHERE  uuid IN ($permissionUUIDs[:])
+`, permissionUUIDs{})
+	if err != nil {
+		return nil, errors.Errorf("preparing revert expired permissions statement: %w", err)
+	}
+
+	var expired []dbExpiredPermission
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		expired = nil
+		err := tx.Query(ctx, selectStmt, expiry).GetAll(&expired)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting expired permissions: %w", err)
+		}
+
+		expiredUUIDs := transform.Slice(expired, func(p dbExpiredPermission) string {
+			return p.UUID
+		})
+		if err := tx.Query(ctx, deleteStmt, permissionUUIDs(expiredUUIDs)).Run(); err != nil {
+			return errors.Errorf("deleting expired permissions: %w", err)
+		}
+		if err := tx.Query(ctx, revertStmt, permissionUUIDs(expiredUUIDs)).Run(); err != nil {
+			return errors.Errorf("reverting expired permissions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]access.ExpiredPermission, len(expired))
+	for i, p := range expired {
+		name, err := user.NewName(p.SubjectName)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		revertedTo := corepermission.NoAccess
+		if p.RevertAccessType.Valid {
+			revertedTo = corepermission.Access(p.RevertAccessType.V)
+		}
+		result[i] = access.ExpiredPermission{
+			Subject: name,
+			Target: corepermission.ID{
+				ObjectType: corepermission.ObjectType(p.ObjectType),
+				Key:        p.GrantOn,
+			},
+			Access:     corepermission.Access(p.AccessType),
+			RevertedTo: revertedTo,
+			ExpiresAt:  p.ExpiresAt.UTC(),
+		}
+	}
+	return result, nil
+}
+
 func (st *PermissionState) checkPotentiallyOrhpanedModel(ctx context.Context, tx *sqlair.TX, revokedUserUUID, modelUUID string) error {
 	model := dbModelUUID{UUID: modelUUID}
 	user := userUUID{UUID: revokedUserUUID}
@@ -946,8 +1148,9 @@ SELECT ua.uuid AS &userUUID.uuid
 FROM   v_user_auth ua
 JOIN   permission p ON ua.uuid = p.grant_to
 WHERE  p.grant_on=$dbModelUUID.uuid
--- admin permission
+-- admin permission, which is not time-limited
 AND    p.access_type_id = 3
+AND    p.expires_at IS NULL
 AND    ua.disabled = false
 AND    ua.removed = false
 AND    ua.uuid <> $userUUID.uuid
@@ -983,7 +1186,17 @@ func (st *PermissionState) revokePermission(ctx context.Context, tx *sqlair.TX,
 		}
 		return nil
 	}
-	if err := st.updatePermission(ctx, tx, args.Subject.Name(), args.AccessSpec.Target.Key, newAccess.String()); err != nil {
+
+	if err := st.expirePermission(ctx, tx, subjectUUID, args.AccessSpec.Target.Key); err != nil {
+		return errors.Capture(err)
+	}
+	current, err := st.getPermissionGrant(ctx, tx, subjectUUID, args.AccessSpec.Target.Key)
+	if errors.Is(err, accesserrors.PermissionNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Errorf("getting current access for revoke: %w", err)
+	}
+	if err := st.setPermissionGrant(ctx, tx, current.revoke(args.AccessSpec.Target, newAccess)); err != nil {
 		return errors.Errorf("updating current access during revoke: %w", err)
 	}
 	return nil
@@ -1018,39 +1231,6 @@ AND    grant_to IN (
 	return nil
 }
 
-func (st *PermissionState) updatePermission(ctx context.Context, tx *sqlair.TX, subjectName, grantOn, access string) error {
-	updateQuery := `
-UPDATE permission
-SET    access_type_id = (
-           SELECT id
-           FROM   permission_access_type
-           WHERE  type = $permInOut.access_type
-       )
-WHERE  grant_on = $permInOut.grant_on
-AND    grant_to IN (
-           SELECT uuid
-           FROM   v_user_auth
-           WHERE  name = $permInOut.name
-           AND    removed = false
-           AND    disabled = false
-       )
-`
-	updateQueryStmt, err := st.Prepare(updateQuery, permInOut{})
-	if err != nil {
-		return errors.Errorf("preparing updatePermission query: %w", err)
-	}
-
-	in := permInOut{
-		Name:    subjectName,
-		GrantOn: grantOn,
-		Access:  access,
-	}
-	if err := tx.Query(ctx, updateQueryStmt, in).Run(); err != nil {
-		return errors.Errorf("updating access on %q for %q to %q: %w", grantOn, subjectName, access, err)
-	}
-	return nil
-}
-
 func (st *PermissionState) baseExternalAccessForTarget(ctx context.Context, tx *sqlair.TX, target corepermission.ID) (dbPermission, error) {
 	user := dbPermissionUser{
 		Name: corepermission.EveryoneUserName.Name(),
@@ -1181,13 +1361,15 @@ func insertPermission(ctx context.Context, tx *sqlair.TX, perm dbPermission) err
 	// * id of object type as object_type_id
 	// * uuid of the user (spec.User) as grant_to
 	// * spec.Target.Key as grant_on
+	// * the expiry of time-limited access as expires_at
 	newPermission := `
-INSERT INTO permission (uuid, access_type_id, object_type_id, grant_to, grant_on)
+INSERT INTO permission (uuid, access_type_id, object_type_id, grant_to, grant_on, expires_at)
 SELECT $dbPermission.uuid,
        at.id,
        ot.id,
        u.uuid,
-       $dbPermission.grant_on
+       $dbPermission.grant_on,
+       $dbPermission.expires_at
 FROM   v_user_auth u,
        permission_access_type at,
        permission_object_type ot
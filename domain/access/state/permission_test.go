@@ -141,6 +141,48 @@ func (s *permissionStateSuite) TestCreatePermissionController(c *tc.C) {
 	s.checkPermissionRow(c, userAccess.UserID, spec)
 }
 
+func (s *permissionStateSuite) TestCreatePermissionApplication(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	appUUID := "f00dfeed-0bad-400d-8000-4b1d0d06f00d"
+	spec := corepermission.UserAccessSpec{
+		User: name,
+		AccessSpec: corepermission.AccessSpec{
+			Target: corepermission.ApplicationOperationID(s.modelUUID.String(), appUUID, corepermission.RunActionAccess),
+			Access: corepermission.RunActionAccess,
+		},
+	}
+	userAccess, err := st.CreatePermission(c.Context(), uuid.MustNewUUID(), spec)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(userAccess.UserName, tc.Equals, name)
+	c.Check(userAccess.Object.ObjectType, tc.Equals, corepermission.Application)
+	c.Check(userAccess.Object.Key, tc.Equals, s.modelUUID.String()+":"+appUUID+":run-action")
+	c.Check(userAccess.Access, tc.Equals, corepermission.RunActionAccess)
+
+	s.checkPermissionRow(c, userAccess.UserID, spec)
+
+	access, err := st.ReadUserAccessLevelForTarget(c.Context(), name, spec.Target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, corepermission.RunActionAccess)
+}
+
+func (s *permissionStateSuite) TestCreatePermissionForApplicationWithBadModel(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	// model "foo-bar" is not created in this test suite, thus invalid.
+	name := usertesting.GenNewName(c, "bob")
+	_, err := st.CreatePermission(c.Context(), uuid.MustNewUUID(), corepermission.UserAccessSpec{
+		User: name,
+		AccessSpec: corepermission.AccessSpec{
+			Target: corepermission.ApplicationOperationID("foo-bar", "f00dfeed-0bad-400d-8000-4b1d0d06f00d", corepermission.ConfigAccess),
+			Access: corepermission.ConfigAccess,
+		},
+	})
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionTargetInvalid)
+}
+
 func (s *permissionStateSuite) TestCreatePermissionForModelWithBadInfo(c *tc.C) {
 	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
 
@@ -1179,6 +1221,339 @@ func (s *permissionStateSuite) TestUpdatePermissionRevoke(c *tc.C) {
 	c.Check(obtainedUserAccess.Access, tc.Equals, corepermission.AddModelAccess)
 }
 
+func (s *permissionStateSuite) TestUpdatePermissionApplication(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	appUUID := "f00dfeed-0bad-400d-8000-4b1d0d06f00d"
+	scaleTarget := corepermission.ApplicationOperationID(s.modelUUID.String(), appUUID, corepermission.ScaleAccess)
+	grant := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: scaleTarget,
+			Access: corepermission.ScaleAccess,
+		},
+		Change:  corepermission.Grant,
+		Subject: name,
+	}
+	err := st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	obtained, err := st.ReadUserAccessLevelForTarget(c.Context(), name, scaleTarget)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.Equals, corepermission.ScaleAccess)
+
+	// Operation specific access levels are held on objects of their own, so
+	// they can be combined with each other and with read or write access.
+	configTarget := corepermission.ApplicationOperationID(s.modelUUID.String(), appUUID, corepermission.ConfigAccess)
+	err = st.UpdatePermission(c.Context(), access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: configTarget,
+			Access: corepermission.ConfigAccess,
+		},
+		Change:  corepermission.Grant,
+		Subject: name,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	target := corepermission.ApplicationID(s.modelUUID.String(), appUUID)
+	err = st.UpdatePermission(c.Context(), access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.WriteAccess,
+		},
+		Change:  corepermission.Grant,
+		Subject: name,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, t := range []corepermission.ID{scaleTarget, configTarget, target} {
+		_, err = st.ReadUserAccessForTarget(c.Context(), name, t)
+		c.Check(err, tc.ErrorIsNil, tc.Commentf("target %v", t))
+	}
+
+	// Revoking write leaves read, revoking read removes the permission.
+	revoke := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.WriteAccess,
+		},
+		Change:  corepermission.Revoke,
+		Subject: name,
+	}
+	err = st.UpdatePermission(c.Context(), revoke)
+	c.Assert(err, tc.ErrorIsNil)
+	obtained, err = st.ReadUserAccessLevelForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.Equals, corepermission.ReadAccess)
+
+	revoke.AccessSpec.Access = corepermission.ReadAccess
+	err = st.UpdatePermission(c.Context(), revoke)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionNotFound)
+
+	// Revoking an operation specific access level removes only that one.
+	grant.Change = corepermission.Revoke
+	err = st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = st.ReadUserAccessForTarget(c.Context(), name, scaleTarget)
+	c.Assert(err, tc.ErrorIs, accesserrors.PermissionNotFound)
+	obtained, err = st.ReadUserAccessLevelForTarget(c.Context(), name, configTarget)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.Equals, corepermission.ConfigAccess)
+}
+
+func (s *permissionStateSuite) TestUpdatePermissionGrantTimeLimited(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{
+		ObjectType: corepermission.Model,
+		Key:        s.modelUUID.String(),
+	}
+	s.ensurePermission(c, "123", target.Key, corepermission.ReadAccess, corepermission.Model)
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	err := st.UpdatePermission(c.Context(), access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.AdminAccess,
+		},
+		Change:    corepermission.Grant,
+		Subject:   name,
+		ExpiresAt: &expiresAt,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	obtained, err := st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained.Access, tc.Equals, corepermission.AdminAccess)
+	c.Assert(obtained.ExpiresAt, tc.NotNil)
+	c.Check(obtained.ExpiresAt.Equal(expiresAt), tc.IsTrue)
+
+	// Once expired, the access reverts to that held before the grant.
+	s.expirePermissions(c)
+	level, err := st.ReadUserAccessLevelForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(level, tc.Equals, corepermission.ReadAccess)
+
+	expired, err := st.RevokeExpiredPermissions(c.Context(), time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(expired, tc.HasLen, 1)
+	c.Check(expired[0].Subject, tc.Equals, name)
+	c.Check(expired[0].Target, tc.Equals, target)
+	c.Check(expired[0].Access, tc.Equals, corepermission.AdminAccess)
+	c.Check(expired[0].RevertedTo, tc.Equals, corepermission.ReadAccess)
+
+	obtained, err = st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained.Access, tc.Equals, corepermission.ReadAccess)
+	c.Check(obtained.ExpiresAt, tc.IsNil)
+	s.checkPermissionRow(c, "123", corepermission.UserAccessSpec{
+		AccessSpec: corepermission.AccessSpec{Target: target, Access: corepermission.ReadAccess},
+		User:       name,
+	})
+}
+
+func (s *permissionStateSuite) TestRevokeExpiredPermissionsRemovesPermission(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	target := corepermission.ApplicationOperationID(s.modelUUID.String(), "f00dfeed-0bad-400d-8000-4b1d0d06f00d", corepermission.RunActionAccess)
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	err := st.UpdatePermission(c.Context(), access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.RunActionAccess,
+		},
+		Change:    corepermission.Grant,
+		Subject:   name,
+		ExpiresAt: &expiresAt,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Nothing has expired yet.
+	expired, err := st.RevokeExpiredPermissions(c.Context(), time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(expired, tc.HasLen, 0)
+
+	s.expirePermissions(c)
+	_, err = st.ReadUserAccessLevelForTarget(c.Context(), name, target)
+	c.Check(err, tc.ErrorIs, accesserrors.AccessNotFound)
+
+	expired, err = st.RevokeExpiredPermissions(c.Context(), time.Now().UTC())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(expired, tc.HasLen, 1)
+	c.Check(expired[0].Access, tc.Equals, corepermission.RunActionAccess)
+	c.Check(expired[0].RevertedTo, tc.Equals, corepermission.NoAccess)
+	_, err = st.ReadAllUserAccessForUser(c.Context(), name)
+	c.Check(err, tc.ErrorIs, accesserrors.PermissionNotFound)
+}
+
+func (s *permissionStateSuite) TestUpdatePermissionGrantTimeLimitedOverTimeLimited(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{
+		ObjectType: corepermission.Model,
+		Key:        s.modelUUID.String(),
+	}
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	grant := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.AdminAccess,
+		},
+		Change:    corepermission.Grant,
+		Subject:   name,
+		ExpiresAt: &expiresAt,
+	}
+	err := st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Granting the same access again changes the expiry.
+	extended := expiresAt.Add(time.Hour)
+	grant.ExpiresAt = &extended
+	err = st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+	obtained, err := st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(obtained.ExpiresAt, tc.NotNil)
+	c.Check(obtained.ExpiresAt.Equal(extended), tc.IsTrue)
+
+	// Less access is not granted.
+	grant.AccessSpec.Access = corepermission.WriteAccess
+	err = st.UpdatePermission(c.Context(), grant)
+	c.Check(err, tc.ErrorIs, accesserrors.PermissionAccessGreater)
+
+	// A permanent grant of less access is kept for when the time-limited
+	// access expires.
+	grant.ExpiresAt = nil
+	err = st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+	s.expirePermissions(c)
+	level, err := st.ReadUserAccessLevelForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(level, tc.Equals, corepermission.WriteAccess)
+}
+
+func (s *permissionStateSuite) TestUpdatePermissionGrantPermanentOverTimeLimited(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{
+		ObjectType: corepermission.Controller,
+		Key:        s.controllerUUID,
+	}
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	grant := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.SuperuserAccess,
+		},
+		Change:    corepermission.Grant,
+		Subject:   name,
+		ExpiresAt: &expiresAt,
+	}
+	err := st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	grant.ExpiresAt = nil
+	err = st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.expirePermissions(c)
+	obtained, err := st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained.Access, tc.Equals, corepermission.SuperuserAccess)
+	c.Check(obtained.ExpiresAt, tc.IsNil)
+}
+
+func (s *permissionStateSuite) TestUpdatePermissionGrantAfterExpiry(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{
+		ObjectType: corepermission.Model,
+		Key:        s.modelUUID.String(),
+	}
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	grant := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.WriteAccess,
+		},
+		Change:    corepermission.Grant,
+		Subject:   name,
+		ExpiresAt: &expiresAt,
+	}
+	err := st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The expired permission has yet to be revoked, but does not prevent
+	// a new grant of less access.
+	s.expirePermissions(c)
+	grant.AccessSpec.Access = corepermission.ReadAccess
+	grant.ExpiresAt = nil
+	err = st.UpdatePermission(c.Context(), grant)
+	c.Assert(err, tc.ErrorIsNil)
+
+	obtained, err := st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained.Access, tc.Equals, corepermission.ReadAccess)
+	c.Check(obtained.ExpiresAt, tc.IsNil)
+}
+
+func (s *permissionStateSuite) TestUpdatePermissionRevokeTimeLimited(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	name := usertesting.GenNewName(c, "bob")
+	target := corepermission.ID{
+		ObjectType: corepermission.Model,
+		Key:        s.modelUUID.String(),
+	}
+	// The model must keep an admin.
+	s.ensurePermission(c, "42", target.Key, corepermission.AdminAccess, corepermission.Model)
+	s.ensurePermission(c, "123", target.Key, corepermission.ReadAccess, corepermission.Model)
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	err := st.UpdatePermission(c.Context(), access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.AdminAccess,
+		},
+		Change:    corepermission.Grant,
+		Subject:   name,
+		ExpiresAt: &expiresAt,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Revoking admin leaves write until the expiry.
+	revoke := access.UpdatePermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: target,
+			Access: corepermission.AdminAccess,
+		},
+		Change:  corepermission.Revoke,
+		Subject: name,
+	}
+	err = st.UpdatePermission(c.Context(), revoke)
+	c.Assert(err, tc.ErrorIsNil)
+	obtained, err := st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained.Access, tc.Equals, corepermission.WriteAccess)
+	c.Check(obtained.ExpiresAt, tc.NotNil)
+
+	// Revoking write leaves the read access held before the grant, which
+	// is permanent.
+	revoke.AccessSpec.Access = corepermission.WriteAccess
+	err = st.UpdatePermission(c.Context(), revoke)
+	c.Assert(err, tc.ErrorIsNil)
+	obtained, err = st.ReadUserAccessForTarget(c.Context(), name, target)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained.Access, tc.Equals, corepermission.ReadAccess)
+	c.Check(obtained.ExpiresAt, tc.IsNil)
+}
+
 func (s *permissionStateSuite) TestUpdatePermissionRevokeLastAdmin(c *tc.C) {
 	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
 
@@ -1408,6 +1783,46 @@ func (s *permissionStateSuite) TestDeletePermissionsByGrantOnUUID(c *tc.C) {
 	s.checkRowCount(c, "v_permission_offer", 0)
 }
 
+func (s *permissionStateSuite) TestDeletePermissionsByGrantOnUUIDGroup(c *tc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+	groupSt := NewGroupState(s.TxnRunnerFactory(), clock.WallClock)
+
+	// Arrange
+	offerUUID := tc.Must(c, offer.NewUUID).String()
+	err := groupSt.AddGroup(c.Context(), uuid.MustNewUUID(), "ops", usertesting.GenNewName(c, "admin"))
+	c.Assert(err, tc.ErrorIsNil)
+	err = groupSt.UpdateGroupPermission(c.Context(), access.UpdateGroupPermissionArgs{
+		AccessSpec: corepermission.AccessSpec{
+			Target: corepermission.ID{ObjectType: corepermission.Offer, Key: offerUUID},
+			Access: corepermission.ConsumeAccess,
+		},
+		Change: corepermission.Grant,
+		Group:  "ops",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	err = st.DeletePermissionsByGrantOnUUID(c.Context(), []string{offerUUID})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	s.checkRowCount(c, "user_group_permission", 0)
+}
+
+// expirePermissions sets the expiry of all time-limited permissions to a
+// time which has passed.
+func (s *permissionStateSuite) expirePermissions(c *tc.C) {
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+UPDATE permission
+SET    expires_at = ?
+WHERE  expires_at IS NOT NULL
+`, time.Now().Add(-time.Minute).UTC())
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 // checkRowCount checks that the given table has the expected number of rows.
 func (s *permissionStateSuite) checkRowCount(c *tc.C, table string, expected int) {
 	obtained := -1
@@ -11,11 +11,12 @@ import (
 )
 
 // State represents a type for interacting with the underlying state.
-// Composes both user and permission state, so we can interact with both
-// from the single state, whilst also keeping the concerns separate.
+// Composes user, permission and group state, so we can interact with all
+// of them from the single state, whilst also keeping the concerns separate.
 type State struct {
 	*UserState
 	*PermissionState
+	*GroupState
 }
 
 // NewState returns a new State for interacting with the underlying state.
@@ -23,5 +24,6 @@ func NewState(factory database.TxnRunnerFactory, clock clock.Clock, logger logge
 	return &State{
 		UserState:       NewUserState(factory, clock),
 		PermissionState: NewPermissionState(factory, clock, logger),
+		GroupState:      NewGroupState(factory, clock),
 	}
 }
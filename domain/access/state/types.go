@@ -4,12 +4,14 @@
 package state
 
 import (
+	"database/sql"
 	"time"
 
 	coremodel "github.com/juju/juju/core/model"
 	corepermission "github.com/juju/juju/core/permission"
 	coreuser "github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -160,6 +162,46 @@ type dbPermission struct {
 
 	// ObjectType is a string version of core permission ObjectType.
 	ObjectType string `db:"object_type"`
+
+	// ExpiresAt is the time at which a time-limited permission expires.
+	ExpiresAt dbExpiresAt `db:"expires_at"`
+}
+
+// expiresAtFormats are the formats in which the database returns the
+// expiry of a permission. The expiry shown by the permission views is an
+// expression rather than a column, so it is returned as text rather than
+// as a time.
+var expiresAtFormats = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+}
+
+// dbExpiresAt is the expiry of a time-limited permission, which may be read
+// from the database either as a time or as text.
+type dbExpiresAt struct {
+	sql.Null[time.Time]
+}
+
+// Scan implements sql.Scanner.
+func (e *dbExpiresAt) Scan(value any) error {
+	var text string
+	switch v := value.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return e.Null.Scan(value)
+	}
+	for _, format := range expiresAtFormats {
+		if t, err := time.Parse(format, text); err == nil {
+			e.V, e.Valid = t, true
+			return nil
+		}
+	}
+	return errors.Errorf("parsing permission expiry %q", text)
 }
 
 // toUserAccess combines a dbPermission with a user to create
@@ -176,9 +218,128 @@ func (r dbPermission) toUserAccess(u dbPermissionUser) (corepermission.UserAcces
 		Key:        r.GrantOn,
 	}
 	userAccess.Access = corepermission.Access(r.AccessType)
+	if r.ExpiresAt.Valid {
+		expiresAt := r.ExpiresAt.V.UTC()
+		userAccess.ExpiresAt = &expiresAt
+	}
 	return userAccess, nil
 }
 
+// dbPermissionGrant is a permission as it is stored, including the expiry
+// of time-limited access, which may have passed.
+type dbPermissionGrant struct {
+	// UUID is the unique identifier for the permission.
+	UUID string `db:"uuid"`
+
+	// AccessType is the access granted by the permission.
+	AccessType string `db:"access_type"`
+
+	// RevertAccessType is the access the permission reverts to on expiry.
+	// If it is not valid the permission is removed on expiry.
+	RevertAccessType sql.Null[string] `db:"revert_access_type"`
+
+	// ExpiresAt is the time at which a time-limited permission expires.
+	// Permanent permissions have no expiry.
+	ExpiresAt sql.Null[time.Time] `db:"expires_at"`
+}
+
+// grant returns the permission resulting from granting the access to the
+// target on top of this permission. If expiresAt is set the access granted
+// is time-limited, and reverts to the current access on expiry.
+// [accesserrors.PermissionAccessGreater] is returned if the grant would not
+// change the access held.
+func (g dbPermissionGrant) grant(target corepermission.ID, access corepermission.Access, expiresAt *time.Time) (dbPermissionGrant, error) {
+	current := corepermission.Access(g.AccessType)
+	covers := func(a, b corepermission.Access) bool {
+		return corepermission.AccessSpec{Target: target, Access: a}.EqualOrGreaterThan(b)
+	}
+	result := g
+
+	switch {
+	case expiresAt == nil && !g.ExpiresAt.Valid:
+		if covers(current, access) {
+			return g, errors.Errorf("already has %q %w", access, accesserrors.PermissionAccessGreater)
+		}
+		result.AccessType = access.String()
+
+	case expiresAt == nil:
+		// A permanent grant on top of a time-limited one either replaces
+		// it, or becomes the access it reverts to.
+		if covers(access, current) {
+			result.AccessType = access.String()
+			result.RevertAccessType = sql.Null[string]{}
+			result.ExpiresAt = sql.Null[time.Time]{}
+		} else if !g.RevertAccessType.Valid || !covers(corepermission.Access(g.RevertAccessType.V), access) {
+			result.RevertAccessType = sql.Null[string]{V: access.String(), Valid: true}
+		} else {
+			return g, errors.Errorf("already has %q %w", access, accesserrors.PermissionAccessGreater)
+		}
+
+	case !g.ExpiresAt.Valid:
+		if covers(current, access) {
+			return g, errors.Errorf("already has %q %w", access, accesserrors.PermissionAccessGreater)
+		}
+		result.AccessType = access.String()
+		result.RevertAccessType = sql.Null[string]{V: current.String(), Valid: true}
+		result.ExpiresAt = sql.Null[time.Time]{V: *expiresAt, Valid: true}
+
+	default:
+		// A time-limited grant on top of a time-limited one keeps the
+		// access it reverts to. Granting the same access again changes
+		// when it expires.
+		if current != access && covers(current, access) {
+			return g, errors.Errorf("already has %q %w", access, accesserrors.PermissionAccessGreater)
+		}
+		result.AccessType = access.String()
+		result.ExpiresAt = sql.Null[time.Time]{V: *expiresAt, Valid: true}
+	}
+	return result, nil
+}
+
+// revoke returns the permission resulting from reducing the access held to
+// the given access. The access a time-limited permission reverts to is
+// reduced likewise, and the permission becomes permanent if its access
+// would not change on expiry.
+func (g dbPermissionGrant) revoke(target corepermission.ID, access corepermission.Access) dbPermissionGrant {
+	result := g
+	result.AccessType = access.String()
+	if !g.ExpiresAt.Valid || !g.RevertAccessType.Valid {
+		return result
+	}
+
+	revert := corepermission.Access(g.RevertAccessType.V)
+	if !(corepermission.AccessSpec{Target: target, Access: access}).EqualOrGreaterThan(revert) {
+		revert = access
+	}
+	if revert == access {
+		result.RevertAccessType = sql.Null[string]{}
+		result.ExpiresAt = sql.Null[time.Time]{}
+		return result
+	}
+	result.RevertAccessType = sql.Null[string]{V: revert.String(), Valid: true}
+	return result
+}
+
+// dbExpiredPermission is a time-limited permission which has expired.
+type dbExpiredPermission struct {
+	UUID             string           `db:"uuid"`
+	SubjectName      string           `db:"subject_name"`
+	GrantOn          string           `db:"grant_on"`
+	ObjectType       string           `db:"object_type"`
+	AccessType       string           `db:"access_type"`
+	RevertAccessType sql.Null[string] `db:"revert_access_type"`
+	ExpiresAt        time.Time        `db:"expires_at"`
+}
+
+// permissionUUIDs is used to pass a slice of permission UUIDs to SQL.
+type permissionUUIDs []string
+
+// dbExpiryTime is used to pass the time at which permissions are checked
+// for expiry to SQL.
+type dbExpiryTime struct {
+	Now time.Time `db:"now"`
+}
+
 // userName is used to pass a user's name as an argument to SQL.
 type userName struct {
 	Name string `db:"name"`
@@ -269,3 +430,54 @@ type userNameAndActivationKey struct {
 	Name          string `db:"name"`
 	ActivationKey []byte `db:"activation_key"`
 }
+
+// dbUserGroup represents a user group in the system.
+type dbUserGroup struct {
+	// UUID is the unique identifier for the group.
+	UUID string `db:"uuid"`
+
+	// Name is the name of the group.
+	Name string `db:"name"`
+
+	// CreatorUUID is the user that created the group.
+	CreatorUUID string `db:"created_by_uuid"`
+
+	// CreatedAt is the time that the group was created at.
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// dbGroupPermission represents a permission granted to a user group.
+type dbGroupPermission struct {
+	// UUID is the unique identifier for the permission.
+	UUID string `db:"uuid"`
+
+	// GrantOn is the unique identifier of the permission target.
+	// A name or UUID depending on the ObjectType.
+	GrantOn string `db:"grant_on"`
+
+	// GrantTo is the UUID of the group the permission is granted to.
+	GrantTo string `db:"grant_to"`
+
+	// AccessType is a string version of core permission AccessType.
+	AccessType string `db:"access_type"`
+
+	// ObjectType is a string version of core permission ObjectType.
+	ObjectType string `db:"object_type"`
+}
+
+// groupNames is used to pass a slice of group names to SQL.
+type groupNames []string
+
+// dbGroupMember represents the membership of a user in a user group.
+type dbGroupMember struct {
+	// GroupUUID is the unique identifier of the group.
+	GroupUUID string `db:"group_uuid"`
+
+	// UserUUID is the unique identifier of the member.
+	UserUUID string `db:"user_uuid"`
+}
+
+// dbGroupName is used to read the name of a user group.
+type dbGroupName struct {
+	Name string `db:"group_name"`
+}
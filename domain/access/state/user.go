@@ -474,8 +474,9 @@ WHERE NOT EXISTS (
     FROM v_user_auth AS ua
     JOIN permission AS p ON ua.uuid = p.grant_to
     WHERE p.grant_on = m.uuid
-      -- admin permission
-      AND p.access_type_id = 3 
+      -- admin permission, which is not time-limited
+      AND p.access_type_id = 3
+      AND p.expires_at IS NULL
       AND ua.disabled = false
       AND ua.removed = false
       AND ua.uuid <> $userUUID.uuid
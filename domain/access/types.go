@@ -4,6 +4,9 @@
 package access
 
 import (
+	"regexp"
+	"time"
+
 	"github.com/juju/juju/core/credential"
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/model"
@@ -24,6 +27,9 @@ type UpdatePermissionArgs struct {
 	Change permission.AccessChange
 	// Subject is the subject of the permission, e.g. user.
 	Subject user.Name
+	// ExpiresAt, if set, makes a granted access time-limited. Once it has
+	// passed, the subject reverts to the access they held before the grant.
+	ExpiresAt *time.Time
 }
 
 func (args UpdatePermissionArgs) Validate() error {
@@ -36,9 +42,64 @@ func (args UpdatePermissionArgs) Validate() error {
 	if args.Change != permission.Grant && args.Change != permission.Revoke {
 		return errors.Errorf("change %q %w", args.Change, coreerrors.NotValid)
 	}
+	if args.ExpiresAt != nil && args.Change != permission.Grant {
+		return errors.Errorf("expiry for change %q %w", args.Change, coreerrors.NotValid)
+	}
 	return nil
 }
 
+// validGroupName matches valid user group names.
+var validGroupName = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`)
+
+// IsValidGroupName returns whether the given name is a valid user group name.
+func IsValidGroupName(name string) bool {
+	return validGroupName.MatchString(name)
+}
+
+// UpdateGroupPermissionArgs are necessary arguments to change the access of
+// a user group on a target.
+type UpdateGroupPermissionArgs struct {
+	// AccessSpec is what the permission access should change to
+	// combined with the target the group's permission to is being
+	// updated on.
+	AccessSpec permission.AccessSpec
+	// What type of change to access is needed, grant or revoke?
+	Change permission.AccessChange
+	// Group is the name of the group the permission is granted to.
+	Group string
+}
+
+// Validate returns an error satisfying [coreerrors.NotValid] if the
+// arguments are not valid.
+func (args UpdateGroupPermissionArgs) Validate() error {
+	if !IsValidGroupName(args.Group) {
+		return errors.Errorf("group name %q %w", args.Group, coreerrors.NotValid)
+	}
+	if err := args.AccessSpec.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	if args.Change != permission.Grant && args.Change != permission.Revoke {
+		return errors.Errorf("change %q %w", args.Change, coreerrors.NotValid)
+	}
+	return nil
+}
+
+// ExpiredPermission describes a time-limited permission which was revoked
+// once it expired.
+type ExpiredPermission struct {
+	// Subject is the user the permission was granted to.
+	Subject user.Name
+	// Target is the object the permission was granted on.
+	Target permission.ID
+	// Access is the access which expired.
+	Access permission.Access
+	// RevertedTo is the access the subject was left with, NoAccess if the
+	// permission was removed.
+	RevertedTo permission.Access
+	// ExpiresAt is the time at which the permission expired.
+	ExpiresAt time.Time
+}
+
 // OwnerModelAccess describes the owner's access level on a single model
 // associated with one of their cloud credentials.
 type OwnerModelAccess struct {
@@ -5,6 +5,7 @@ package access
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/tc"
 
@@ -43,9 +44,64 @@ func (s *typesSuite) TestUpsertPermissionArgsValidationFail(c *tc.C) {
 			},
 			Change:  "testing",
 			Subject: usertesting.GenNewName(c, "testme"),
+		}, { // Expiry on revoke
+			AccessSpec: permission.AccessSpec{
+				Access: permission.ReadAccess,
+				Target: permission.ID{
+					ObjectType: permission.Model,
+					Key:        "aws",
+				},
+			},
+			Change:    permission.Revoke,
+			Subject:   usertesting.GenNewName(c, "testme"),
+			ExpiresAt: &time.Time{},
+		}}
+	for i, args := range argsToTest {
+		c.Logf("Test %d", i)
+		c.Check(args.Validate(), tc.ErrorIs, coreerrors.NotValid)
+	}
+}
+
+func (s *typesSuite) TestIsValidGroupName(c *tc.C) {
+	for _, name := range []string{"sre", "SRE", "team-a", "team_a", "ops.eu", "a", "42"} {
+		c.Check(IsValidGroupName(name), tc.IsTrue, tc.Commentf("%q", name))
+	}
+	for _, name := range []string{"", "-sre", "sre-", "team a", "sre@external", "/sre"} {
+		c.Check(IsValidGroupName(name), tc.IsFalse, tc.Commentf("%q", name))
+	}
+}
+
+func (s *typesSuite) TestUpdateGroupPermissionArgsValidationFail(c *tc.C) {
+	spec := permission.AccessSpec{
+		Access: permission.ReadAccess,
+		Target: permission.ID{
+			ObjectType: permission.Model,
+			Key:        "aws",
+		},
+	}
+	argsToTest := []UpdateGroupPermissionArgs{
+		{}, { // Missing Group
+			AccessSpec: spec,
+			Change:     permission.Grant,
+		}, { // Invalid Group
+			AccessSpec: spec,
+			Change:     permission.Grant,
+			Group:      "not valid",
+		}, { // Missing Target
+			Change: permission.Grant,
+			Group:  "sre",
+		}, { // Invalid Change
+			AccessSpec: spec,
+			Change:     "testing",
+			Group:      "sre",
 		}}
 	for i, args := range argsToTest {
 		c.Logf("Test %d", i)
 		c.Check(args.Validate(), tc.ErrorIs, coreerrors.NotValid)
 	}
+	c.Check(UpdateGroupPermissionArgs{
+		AccessSpec: spec,
+		Change:     permission.Grant,
+		Group:      "sre",
+	}.Validate(), tc.ErrorIsNil)
 }
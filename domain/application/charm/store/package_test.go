@@ -4,3 +4,4 @@
 package store
 
 //go:generate go run github.com/canonical/gomock/mockgen -package store -destination store_mock_test.go github.com/juju/juju/core/objectstore ObjectStore,ModelObjectStoreGetter
+//go:generate go run github.com/canonical/gomock/mockgen -package store -destination verifier_mock_test.go github.com/juju/juju/domain/application/charm/store SignatureVerifier
@@ -15,6 +15,7 @@ import (
 
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
 	"github.com/juju/juju/internal/uuid"
@@ -44,6 +45,7 @@ type Digest struct {
 type StoreResult struct {
 	UniqueName      string
 	ObjectStoreUUID objectstore.UUID
+	Verification    charmsignature.Verification
 }
 
 // CharmReader is an interface that combines the io.Reader, io.ReaderAt, and
@@ -60,19 +62,61 @@ type StoreFromReaderResult struct {
 	Charm           CharmReader
 	UniqueName      string
 	ObjectStoreUUID objectstore.UUID
+	Verification    charmsignature.Verification
+}
+
+// SignatureVerifier verifies the detached signature of an archive, according
+// to the model's signature policy.
+type SignatureVerifier interface {
+	// Verify checks the signature over the archive read from r, in the given
+	// namespace. A nil signature means the archive is unsigned.
+	Verify(ctx context.Context, namespace string, r io.Reader, signature []byte) (charmsignature.Verification, error)
+
+	// VerifyCosign checks the cosign signatures published for a charm, which
+	// are made over the given digest. No signatures means the charm is
+	// unsigned.
+	VerifyCosign(ctx context.Context, digest string, signatures []charmsignature.CosignSignature) (charmsignature.Verification, error)
+}
+
+// StoreOption configures how a charm archive is stored.
+type StoreOption func(*storeOptions)
+
+type storeOptions struct {
+	verify    bool
+	signature []byte
+}
+
+// WithSignatureVerification verifies the charm archive against the detached
+// signature before it is put into the object store. A nil signature marks
+// the archive as unsigned.
+func WithSignatureVerification(signature []byte) StoreOption {
+	return func(o *storeOptions) {
+		o.verify = true
+		o.signature = signature
+	}
+}
+
+func newStoreOptions(opts []StoreOption) storeOptions {
+	var o storeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
 }
 
 // CharmStore provides an API for storing and retrieving charm blobs.
 type CharmStore struct {
 	objectStoreGetter objectstore.ModelObjectStoreGetter
+	verifier          SignatureVerifier
 	encoder           *base64.Encoding
 	logger            logger.Logger
 }
 
 // NewCharmStore returns a new charm store instance.
-func NewCharmStore(objectStoreGetter objectstore.ModelObjectStoreGetter, logger logger.Logger) *CharmStore {
+func NewCharmStore(objectStoreGetter objectstore.ModelObjectStoreGetter, verifier SignatureVerifier, logger logger.Logger) *CharmStore {
 	return &CharmStore{
 		objectStoreGetter: objectStoreGetter,
+		verifier:          verifier,
 		encoder:           base64.StdEncoding.WithPadding(base64.NoPadding),
 		logger:            logger,
 	}
@@ -81,7 +125,9 @@ func NewCharmStore(objectStoreGetter objectstore.ModelObjectStoreGetter, logger
 // Store the charm at the specified path into the object store. It is expected
 // that the archive already exists at the specified path. If the file isn't
 // found, a [ErrNotFound] is returned.
-func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384 string) (StoreResult, error) {
+func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384 string, opts ...StoreOption) (StoreResult, error) {
+	options := newStoreOptions(opts)
+
 	file, err := os.Open(path)
 	if errors.Is(err, os.ErrNotExist) {
 		return StoreResult{}, errors.Errorf("%q: %w", path, ErrNotFound)
@@ -92,6 +138,11 @@ func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384
 	// Ensure that we close any open handles to the file.
 	defer file.Close()
 
+	verification, err := s.verify(ctx, file, options)
+	if err != nil {
+		return StoreResult{}, errors.Capture(err)
+	}
+
 	// Generate a unique path for the file.
 	unique, err := uuid.NewUUID()
 	if err != nil {
@@ -112,13 +163,16 @@ func (s *CharmStore) Store(ctx context.Context, path string, size int64, sha384
 	return StoreResult{
 		UniqueName:      uniqueName,
 		ObjectStoreUUID: uuid,
+		Verification:    verification,
 	}, nil
 }
 
 // StoreFromReader stores the charm from the provided reader into the object
 // store. The caller is expected to remove the temporary file after the call.
 // This does not check the integrity of the charm hash.
-func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hashPrefix string) (_ StoreFromReaderResult, _ Digest, err error) {
+func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hashPrefix string, opts ...StoreOption) (_ StoreFromReaderResult, _ Digest, err error) {
+	options := newStoreOptions(opts)
+
 	file, err := os.CreateTemp("", "charm-")
 	if err != nil {
 		return StoreFromReaderResult{}, Digest{}, errors.Errorf("creating temporary file: %w", err)
@@ -169,6 +223,11 @@ func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hash
 		return StoreFromReaderResult{}, Digest{}, ErrCharmHashMismatch
 	}
 
+	verification, err := s.verify(ctx, file, options)
+	if err != nil {
+		return StoreFromReaderResult{}, Digest{}, errors.Capture(err)
+	}
+
 	uuid, err := objectStore.PutAndCheckHash(ctx, uniqueName, file, size, sha384)
 	if err != nil {
 		return StoreFromReaderResult{}, Digest{}, errors.Errorf("putting charm: %w", err)
@@ -179,17 +238,18 @@ func (s *CharmStore) StoreFromReader(ctx context.Context, reader io.Reader, hash
 	}
 
 	return StoreFromReaderResult{
-			Charm: &charmReaderCloser{
-				file:   file,
-				logger: s.logger,
-			},
-			UniqueName:      uniqueName,
-			ObjectStoreUUID: uuid,
-		}, Digest{
-			SHA256: sha256,
-			SHA384: sha384,
-			Size:   size,
-		}, nil
+		Charm: &charmReaderCloser{
+			file:   file,
+			logger: s.logger,
+		},
+		UniqueName:      uniqueName,
+		ObjectStoreUUID: uuid,
+		Verification:    verification,
+	}, Digest{
+		SHA256: sha256,
+		SHA384: sha384,
+		Size:   size,
+	}, nil
 }
 
 // Get retrieves a ReadCloser for the charm archive at the give path from
@@ -228,6 +288,35 @@ func (s *CharmStore) GetBySHA256Prefix(ctx context.Context, sha256Prefix string)
 	return reader, digest, nil
 }
 
+// VerifyPublished checks the cosign signatures published by a repository for
+// a charm that is yet to be downloaded, according to the model's signature
+// policy. The signatures are made over the digest, which pins the digest of
+// the charm archive. No signatures means the charm is unsigned.
+func (s *CharmStore) VerifyPublished(ctx context.Context, digest string, signatures []charmsignature.CosignSignature) (charmsignature.Verification, error) {
+	verification, err := s.verifier.VerifyCosign(ctx, digest, signatures)
+	if err != nil {
+		return charmsignature.Verification{}, errors.Errorf("verifying charm: %w", err)
+	}
+	return verification, nil
+}
+
+// verify checks the signature of the charm archive, if requested, leaving the
+// file positioned at the start.
+func (s *CharmStore) verify(ctx context.Context, file *os.File, options storeOptions) (charmsignature.Verification, error) {
+	if !options.verify {
+		return charmsignature.Verification{}, nil
+	}
+
+	verification, err := s.verifier.Verify(ctx, charmsignature.CharmNamespace, file, options.signature)
+	if err != nil {
+		return charmsignature.Verification{}, errors.Errorf("verifying charm: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return charmsignature.Verification{}, errors.Errorf("seeking charm file: %w", err)
+	}
+	return verification, nil
+}
+
 type charmReaderCloser struct {
 	file   *os.File
 	logger logger.Logger
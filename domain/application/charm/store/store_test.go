@@ -19,6 +19,7 @@ import (
 
 	"github.com/juju/juju/core/objectstore"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
@@ -30,6 +31,7 @@ type storeSuite struct {
 
 	objectStore       *MockObjectStore
 	objectStoreGetter *MockModelObjectStoreGetter
+	verifier          *MockSignatureVerifier
 }
 
 func TestStoreSuite(t *testing.T) {
@@ -60,7 +62,7 @@ func (s *storeSuite) TestStore(c *tc.C) {
 			return uuid, nil
 		})
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	storeResult, err := storage.Store(c.Context(), path, contentDigest.Size, contentDigest.SHA384)
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -88,7 +90,7 @@ func (s *storeSuite) TestStoreFileClosed(c *tc.C) {
 			return uuid, nil
 		})
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, err := storage.Store(c.Context(), path, contentDigest.Size, contentDigest.SHA384)
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -103,7 +105,7 @@ func (s *storeSuite) TestStoreFileNotFound(c *tc.C) {
 
 	dir := c.MkDir()
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, err := storage.Store(c.Context(), filepath.Join(dir, "foo"), 12, "hash")
 	c.Assert(err, tc.ErrorIs, ErrNotFound)
 }
@@ -118,7 +120,7 @@ func (s *storeSuite) TestStoreFailed(c *tc.C) {
 		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
 		Return("", errors.Errorf("boom"))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, err := storage.Store(c.Context(), path, contentDigest.Size, contentDigest.SHA384)
 	c.Assert(err, tc.ErrorMatches, ".*boom")
 }
@@ -149,7 +151,7 @@ func (s *storeSuite) TestStoreFromReader(c *tc.C) {
 			return uuid, nil
 		})
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	storeResult, digest, err := storage.StoreFromReader(c.Context(), reader, contentDigest.SHA256[:7])
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -162,6 +164,88 @@ func (s *storeSuite) TestStoreFromReader(c *tc.C) {
 	c.Check(contents, tc.Equals, "hello world")
 }
 
+func (s *storeSuite) TestStoreWithSignatureVerification(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+	verification := charmsignature.Verification{
+		Signer:      "release-team",
+		Fingerprint: "SHA256:fingerprint",
+	}
+
+	s.verifier.EXPECT().Verify(gomock.Any(), charmsignature.CharmNamespace, gomock.Any(), []byte("sig")).
+		DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ []byte) (charmsignature.Verification, error) {
+			data, err := io.ReadAll(r)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "hello world")
+			return verification, nil
+		})
+
+	// The file must be rewound after verification.
+	var contents string
+	s.objectStore.EXPECT().
+		PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), contentDigest.Size, contentDigest.SHA384).
+		DoAndReturn(func(_ context.Context, _ string, reader io.Reader, _ int64, _ string) (objectstore.UUID, error) {
+			data, err := io.ReadAll(reader)
+			c.Assert(err, tc.ErrorIsNil)
+			contents = string(data)
+			return uuid, nil
+		})
+
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
+	storeResult, err := storage.Store(c.Context(), path, contentDigest.Size, contentDigest.SHA384, WithSignatureVerification([]byte("sig")))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(storeResult.Verification, tc.Equals, verification)
+	c.Check(contents, tc.Equals, "hello world")
+}
+
+func (s *storeSuite) TestStoreFromReaderSignatureRejected(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	path, contentDigest := s.createTempFile(c, dir, "hello world")
+	reader, err := os.Open(path)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The archive must never reach the object store.
+	s.verifier.EXPECT().Verify(gomock.Any(), charmsignature.CharmNamespace, gomock.Any(), nil).
+		Return(charmsignature.Verification{}, charmsignature.ErrSignatureNotFound)
+
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
+	_, _, err = storage.StoreFromReader(c.Context(), reader, contentDigest.SHA256[:7], WithSignatureVerification(nil))
+	c.Assert(err, tc.ErrorIs, charmsignature.ErrSignatureNotFound)
+}
+
+func (s *storeSuite) TestVerifyPublished(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	signatures := []charmsignature.CosignSignature{{Payload: []byte("payload"), Signature: []byte("sig")}}
+	verification := charmsignature.Verification{
+		Signer:      "release-team",
+		Fingerprint: "SHA256:abc",
+	}
+	s.verifier.EXPECT().VerifyCosign(gomock.Any(), "sha256:abc", signatures).Return(verification, nil)
+
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
+	result, err := storage.VerifyPublished(c.Context(), "sha256:abc", signatures)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, verification)
+}
+
+func (s *storeSuite) TestVerifyPublishedRejected(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.verifier.EXPECT().VerifyCosign(gomock.Any(), "", nil).
+		Return(charmsignature.Verification{}, charmsignature.ErrSignatureNotFound)
+
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
+	_, err := storage.VerifyPublished(c.Context(), "", nil)
+	c.Assert(err, tc.ErrorIs, charmsignature.ErrSignatureNotFound)
+}
+
 func (s *storeSuite) TestStoreFromReaderEmptyReader(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -169,7 +253,7 @@ func (s *storeSuite) TestStoreFromReaderEmptyReader(c *tc.C) {
 	_, contentDigest := s.createTempFile(c, dir, "hello world")
 	reader := io.NopCloser(strings.NewReader(""))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, _, err := storage.StoreFromReader(c.Context(), reader, contentDigest.SHA256[:7])
 	c.Assert(err, tc.ErrorIs, ErrCharmHashMismatch)
 }
@@ -182,7 +266,7 @@ func (s *storeSuite) TestStoreFromReaderInvalidHash(c *tc.C) {
 	reader, err := os.Open(path)
 	c.Assert(err, tc.ErrorIsNil)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, _, err = storage.StoreFromReader(c.Context(), reader, "blah")
 	c.Assert(err, tc.ErrorIs, ErrCharmHashMismatch)
 }
@@ -194,7 +278,7 @@ func (s *storeSuite) TestGet(c *tc.C) {
 	s.objectStore.EXPECT().Get(gomock.Any(), "foo").
 		Return(archive, objectstore.Digest{}, nil)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	reader, err := storage.Get(c.Context(), "foo")
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -208,7 +292,7 @@ func (s *storeSuite) TestGetFailed(c *tc.C) {
 
 	s.objectStore.EXPECT().Get(gomock.Any(), "foo").Return(nil, objectstore.Digest{}, errors.Errorf("boom"))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 
 	_, err := storage.Get(c.Context(), "foo")
 	c.Assert(err, tc.ErrorMatches, ".*boom")
@@ -220,7 +304,7 @@ func (s *storeSuite) TestGetNotFound(c *tc.C) {
 	s.objectStore.EXPECT().Get(gomock.Any(), "foo").
 		Return(nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, err := storage.Get(c.Context(), "foo")
 	c.Assert(err, tc.ErrorIs, ErrNotFound)
 }
@@ -235,7 +319,7 @@ func (s *storeSuite) TestGetBySHA256Prefix(c *tc.C) {
 			Size:   13,
 		}, nil)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	reader, digest, err := storage.GetBySHA256Prefix(c.Context(), "02638299")
 	c.Assert(err, tc.ErrorIsNil)
 	content, err := io.ReadAll(reader)
@@ -251,7 +335,7 @@ func (s *storeSuite) TestGetBySHA256PrefixFailed(c *tc.C) {
 	s.objectStore.EXPECT().GetBySHA256Prefix(gomock.Any(), "02638299").
 		Return(nil, objectstore.Digest{}, errors.Errorf("boom"))
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, _, err := storage.GetBySHA256Prefix(c.Context(), "02638299")
 	c.Assert(err, tc.ErrorMatches, ".*boom")
 }
@@ -262,7 +346,7 @@ func (s *storeSuite) TestGetBySHA256NotFound(c *tc.C) {
 	s.objectStore.EXPECT().GetBySHA256Prefix(gomock.Any(), "02638299").
 		Return(nil, objectstore.Digest{}, objectstoreerrors.ObjectNotFound)
 
-	storage := NewCharmStore(s.objectStoreGetter, loggertesting.WrapCheckLog(c))
+	storage := NewCharmStore(s.objectStoreGetter, s.verifier, loggertesting.WrapCheckLog(c))
 	_, _, err := storage.GetBySHA256Prefix(c.Context(), "02638299")
 	c.Assert(err, tc.ErrorIs, ErrNotFound)
 }
@@ -272,6 +356,7 @@ func (s *storeSuite) setupMocks(c *tc.C) *gomock.Controller {
 
 	s.objectStore = NewMockObjectStore(ctrl)
 	s.objectStoreGetter = NewMockModelObjectStoreGetter(ctrl)
+	s.verifier = NewMockSignatureVerifier(ctrl)
 
 	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil).AnyTimes()
 
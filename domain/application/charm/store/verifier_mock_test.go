@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/application/charm/store (interfaces: SignatureVerifier)
+//
+// Generated by this command:
+//
+//	mockgen -package store -destination verifier_mock_test.go github.com/juju/juju/domain/application/charm/store SignatureVerifier
+//
+
+// Package store is a generated GoMock package.
+package store
+
+import (
+	context "context"
+	io "io"
+
+	gomock "github.com/canonical/gomock/gomock"
+	charmsignature "github.com/juju/juju/internal/charmsignature"
+)
+
+// MockSignatureVerifier is a mock of SignatureVerifier interface.
+type MockSignatureVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockSignatureVerifierMockRecorder is the mock recorder for MockSignatureVerifier.
+type MockSignatureVerifierMockRecorder struct {
+	mock                *MockSignatureVerifier
+	verifyExpects       []*gomock.Call4_2[context.Context, string, io.Reader, []byte, charmsignature.Verification, error]
+	verifyCosignExpects []*gomock.Call3_2[context.Context, string, []charmsignature.CosignSignature, charmsignature.Verification, error]
+}
+
+// NewMockSignatureVerifier creates a new mock instance.
+func NewMockSignatureVerifier(ctrl *gomock.Controller) *MockSignatureVerifier {
+	mock := &MockSignatureVerifier{ctrl: ctrl}
+	mock.recorder = &MockSignatureVerifierMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureVerifier) EXPECT() *MockSignatureVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockSignatureVerifier) Verify(ctx context.Context, namespace string, r io.Reader, signature []byte) (charmsignature.Verification, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_2(&m.recorder.verifyExpects, m.ctrl, m, "Verify", ctx, namespace, r, signature)
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockSignatureVerifierMockRecorder) Verify(ctx, namespace, r, signature any) *MockSignatureVerifierVerifyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_2[context.Context, string, io.Reader, []byte, charmsignature.Verification, error](mr.mock.ctrl.T, mr.mock, "Verify", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(namespace), gomock.EnsureMatcher(r), gomock.EnsureMatcher(signature))
+	mr.verifyExpects = append(mr.verifyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSignatureVerifierVerifyCall is the typed call wrapper for Verify.
+type MockSignatureVerifierVerifyCall = gomock.Call4_2[context.Context, string, io.Reader, []byte, charmsignature.Verification, error]
+
+// VerifyCosign mocks base method.
+func (m *MockSignatureVerifier) VerifyCosign(ctx context.Context, digest string, signatures []charmsignature.CosignSignature) (charmsignature.Verification, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.verifyCosignExpects, m.ctrl, m, "VerifyCosign", ctx, digest, signatures)
+}
+
+// VerifyCosign indicates an expected call of VerifyCosign.
+func (mr *MockSignatureVerifierMockRecorder) VerifyCosign(ctx, digest, signatures any) *MockSignatureVerifierVerifyCosignCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, []charmsignature.CosignSignature, charmsignature.Verification, error](mr.mock.ctrl.T, mr.mock, "VerifyCosign", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(digest), gomock.EnsureMatcher(signatures))
+	mr.verifyCosignExpects = append(mr.verifyCosignExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSignatureVerifierVerifyCosignCall is the typed call wrapper for VerifyCosign.
+type MockSignatureVerifierVerifyCosignCall = gomock.Call3_2[context.Context, string, []charmsignature.CosignSignature, charmsignature.Verification, error]
@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	internalcharm "github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -43,17 +44,27 @@ const (
 	// CMRSource represents a CMR synthetic charm source, used for
 	// cross-model relations.
 	CMRSource CharmSource = "cmr"
+	// OCISource represents a charm pulled from an OCI registry.
+	OCISource CharmSource = "oci"
 )
 
+// IsDownloadable reports whether charms from the source are downloaded by
+// the controller from a remote store, and so carry download information.
+func (s CharmSource) IsDownloadable() bool {
+	return s == CharmHubSource || s == OCISource
+}
+
 // ParseCharmSchema creates a CharmSource from a  string.
 // It will map the string "ch" (representing the CharmHub URL scheme) to
-// CharmHubSource and "local" to LocalSource.
+// CharmHubSource, "oci" to OCISource and "local" to LocalSource.
 func ParseCharmSchema(source internalcharm.Schema) (CharmSource, error) {
 	switch source {
 	case internalcharm.Local:
 		return LocalSource, nil
 	case internalcharm.CharmHub:
 		return CharmHubSource, nil
+	case internalcharm.OCI:
+		return OCISource, nil
 	default:
 		return "", errors.Errorf("%w: %v", applicationerrors.CharmSourceNotValid, source)
 	}
@@ -97,6 +108,23 @@ type AddCharmArgs struct {
 	// current charm sequence in state. This will reflect on the charms
 	// revision in state.
 	RequiresSequencing bool
+	// Signature is the verified signature of the charm archive, if any.
+	Signature *Signature
+	// PublishedSignatures are the signatures published by the repository
+	// for a charm that is yet to be downloaded. They are verified before the
+	// charm is added.
+	PublishedSignatures *PublishedSignatures
+}
+
+// PublishedSignatures holds the signatures published by a repository for a
+// charm that is yet to be downloaded.
+type PublishedSignatures struct {
+	// Digest is the digest that the signatures are made over. For a charm in
+	// an OCI registry, it is the digest of the artifact manifest, which pins
+	// the digest of the charm archive.
+	Digest string
+	// Signatures are the cosign signatures over the digest.
+	Signatures []charmsignature.CosignSignature
 }
 
 // ReserveCharmRevisionArgs holds the arguments for the ReserveCharmRevision method.
@@ -141,6 +169,9 @@ type ResolveUploadCharm struct {
 	SHA256Prefix string
 	// Reader is the reader to read the charm from.
 	Reader io.Reader
+	// Signature is the armored detached signature of the charm archive. It
+	// is nil if the charm archive is unsigned.
+	Signature []byte
 }
 
 // ResolvedMigratingUploadedCharm holds the arguments for the
@@ -206,6 +237,17 @@ type Charm struct {
 	Architecture architecture.Architecture
 	// Available indicates whether the charm is available and ready to use.
 	Available bool
+	// Signature is the verified signature of the charm archive. It is nil
+	// if the charm archive was not signed by a trusted key.
+	Signature *Signature
+}
+
+// Signature describes the verified signature of a charm archive.
+type Signature struct {
+	// Signer is the name of the trusted key that signed the charm archive.
+	Signer string
+	// Fingerprint is the SHA256 fingerprint of the trusted key.
+	Fingerprint string
 }
 
 // CharmLocator represents the parts of a charm that are needed to locate it
@@ -348,6 +348,18 @@ const (
 	// has changed and no longer matches a pre-existing assumption about the
 	// Unit's Machine.
 	UnitMachineChanged = errors.ConstError("unit machine has changed")
+
+	// RefreshRolloutNotFound describes an error that occurs when an
+	// application has no staged refresh.
+	RefreshRolloutNotFound = errors.ConstError("refresh rollout not found")
+
+	// RefreshRolloutInProgress describes an error that occurs when a staged
+	// refresh is started while another is still rolling out or paused.
+	RefreshRolloutInProgress = errors.ConstError("refresh rollout in progress")
+
+	// RefreshRolloutNotPaused describes an error that occurs when continuing a
+	// staged refresh that isn't paused.
+	RefreshRolloutNotPaused = errors.ConstError("refresh rollout not paused")
 )
 
 const (
@@ -0,0 +1,146 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"time"
+
+	coreapplication "github.com/juju/juju/core/application"
+	coreerrors "github.com/juju/juju/core/errors"
+	coreunit "github.com/juju/juju/core/unit"
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RefreshRolloutStatus is the status of a staged refresh of an application's
+// units.
+type RefreshRolloutStatus string
+
+const (
+	// RefreshRolloutRolling indicates that units are being released to the
+	// new charm a batch at a time.
+	RefreshRolloutRolling RefreshRolloutStatus = "rolling"
+
+	// RefreshRolloutPaused indicates that a batch of units failed to become
+	// active, and no more units will be released until the rollout is
+	// continued.
+	RefreshRolloutPaused RefreshRolloutStatus = "paused"
+
+	// RefreshRolloutRolledBack indicates that a batch of units failed to become
+	// active, and the application was reverted to its previous charm.
+	RefreshRolloutRolledBack RefreshRolloutStatus = "rolled-back"
+)
+
+// IsActive returns true if the rollout hasn't finished, and units not yet
+// released must keep running the previous charm.
+func (s RefreshRolloutStatus) IsActive() bool {
+	return s == RefreshRolloutRolling || s == RefreshRolloutPaused
+}
+
+// RefreshRolloutParams describes how the refresh of an application's charm is
+// staged across its units.
+type RefreshRolloutParams struct {
+	// BatchSize is the number of units refreshed at a time.
+	BatchSize int
+
+	// BatchPercent is the percentage of the application's units refreshed at
+	// a time. It is only used if BatchSize is zero.
+	BatchPercent int
+
+	// BatchTimeout is how long the units of a batch have to become active
+	// before the batch is considered failed.
+	BatchTimeout time.Duration
+
+	// RollbackOnError reverts the application to its previous charm when a
+	// batch fails. Otherwise the rollout is paused.
+	RollbackOnError bool
+}
+
+// Validate returns an error satisfying [coreerrors.NotValid] if the params
+// are not valid.
+func (p RefreshRolloutParams) Validate() error {
+	if p.BatchSize < 0 {
+		return errors.Errorf("batch size %d must not be negative", p.BatchSize).Add(coreerrors.NotValid)
+	}
+	if p.BatchPercent < 0 || p.BatchPercent > 100 {
+		return errors.Errorf("batch percentage %d must be between 1 and 100", p.BatchPercent).Add(coreerrors.NotValid)
+	}
+	if (p.BatchSize == 0) == (p.BatchPercent == 0) {
+		return errors.Errorf("exactly one of batch size and batch percentage must be set").Add(coreerrors.NotValid)
+	}
+	if p.BatchTimeout <= 0 {
+		return errors.Errorf("batch timeout must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// UnitsPerBatch returns the number of units released in each batch, for an
+// application with the given number of units. At least one unit is always
+// released.
+func (p RefreshRolloutParams) UnitsPerBatch(units int) int {
+	if p.BatchSize > 0 {
+		return p.BatchSize
+	}
+	return max((units*p.BatchPercent+99)/100, 1)
+}
+
+// RefreshRollout is a staged refresh of an application's units.
+type RefreshRollout struct {
+	RefreshRolloutParams
+
+	// ApplicationUUID is the UUID of the application being refreshed.
+	ApplicationUUID coreapplication.UUID
+
+	// ApplicationName is the name of the application being refreshed.
+	ApplicationName string
+
+	// Status is the status of the rollout.
+	Status RefreshRolloutStatus
+
+	// Message describes why the rollout was paused or rolled back.
+	Message string
+
+	// Charm is the charm the units are being refreshed to.
+	Charm domaincharm.CharmLocator
+
+	// PreviousCharm is the charm the application was running before the
+	// rollout started.
+	PreviousCharm domaincharm.CharmLocator
+
+	// PreviousChannel is the channel of the previous charm, if any.
+	PreviousChannel *deployment.Channel
+
+	// UnitsRefreshed is the number of units running the new charm.
+	UnitsRefreshed int
+
+	// UnitsTotal is the number of units of the application.
+	UnitsTotal int
+
+	// BatchStartedAt is the time the current batch of units was released, or
+	// the rollout was last continued. It is zero before the first batch.
+	BatchStartedAt time.Time
+}
+
+// RefreshRolloutUnit is the state of a unit during a staged refresh.
+type RefreshRolloutUnit struct {
+	// UUID is the UUID of the unit.
+	UUID coreunit.UUID
+
+	// Name is the name of the unit.
+	Name coreunit.Name
+
+	// Released is true if the unit has been released to the new charm.
+	Released bool
+
+	// Refreshed is true if the unit is running the new charm.
+	Refreshed bool
+
+	// AgentStatus is the status of the unit's agent.
+	AgentStatus status.UnitAgentStatusType
+
+	// WorkloadStatus is the status of the unit's workload.
+	WorkloadStatus status.WorkloadStatusType
+}
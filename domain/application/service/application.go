@@ -7,6 +7,7 @@ import (
 	"context"
 	"maps"
 	"strconv"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
@@ -174,6 +175,42 @@ type ApplicationState interface {
 	// the provided parameters and validates changes.
 	SetApplicationCharm(ctx context.Context, appUUID coreapplication.UUID, charmID corecharm.ID, params application.SetCharmStateParams) error
 
+	// GetRefreshRollout returns the refresh rollout of the application,
+	// returning an error satisfying [applicationerrors.RefreshRolloutNotFound]
+	// if it has none.
+	GetRefreshRollout(ctx context.Context, appUUID coreapplication.UUID) (application.RefreshRollout, error)
+
+	// GetRefreshRollouts returns the refresh rollouts of all applications in
+	// the model.
+	GetRefreshRollouts(ctx context.Context) ([]application.RefreshRollout, error)
+
+	// GetRefreshRolloutUnits returns the alive units of an application with a
+	// refresh rollout, returning an error satisfying
+	// [applicationerrors.RefreshRolloutNotFound] if it has none.
+	GetRefreshRolloutUnits(ctx context.Context, appUUID coreapplication.UUID) ([]application.RefreshRolloutUnit, error)
+
+	// ReleaseRefreshRolloutUnits releases the given units of the application
+	// to the new charm of its refresh rollout, and records the time the batch
+	// was started.
+	ReleaseRefreshRolloutUnits(ctx context.Context, appUUID coreapplication.UUID, units []coreunit.UUID, startedAt time.Time) error
+
+	// SetRefreshRolloutStatus sets the status of the refresh rollout of the
+	// application, along with a message describing why.
+	SetRefreshRolloutStatus(ctx context.Context, appUUID coreapplication.UUID, status application.RefreshRolloutStatus, message string) error
+
+	// ContinueRefreshRollout resumes the paused refresh rollout of the
+	// application, starting the timeout of the current batch again at
+	// startedAt.
+	ContinueRefreshRollout(ctx context.Context, appUUID coreapplication.UUID, startedAt time.Time) error
+
+	// DeleteRefreshRollout removes the refresh rollout of the application.
+	DeleteRefreshRollout(ctx context.Context, appUUID coreapplication.UUID) error
+
+	// GetUnitRefreshCharmID returns the ID of the charm the unit should be
+	// running, taking into account any unfinished refresh rollout of its
+	// application.
+	GetUnitRefreshCharmID(ctx context.Context, name coreunit.Name) (corecharm.ID, error)
+
 	// GetApplicationUUIDByUnitName returns the application UUID for the named unit,
 	// returning an error satisfying [applicationerrors.UnitNotFound] if the
 	// unit doesn't exist.
@@ -257,6 +294,10 @@ type ApplicationState interface {
 		settings application.UpdateApplicationSettingsArg,
 	) error
 
+	// UpdateApplicationsConfiguration applies the changes to the
+	// configuration of the applications in a single transaction.
+	UpdateApplicationsConfiguration(ctx context.Context, args []application.UpdateApplicationConfigurationArg) error
+
 	// UnsetApplicationConfigKeys removes the specified keys from the application
 	// config. If the key does not exist, it is ignored.
 	// If no application is found, an error satisfying
@@ -336,6 +377,10 @@ type ApplicationState interface {
 	// for application watchers.
 	NamespaceForWatchApplication() string
 
+	// NamespaceForWatchApplicationRefreshRollout returns the namespace
+	// identifier for the units released by application refresh rollouts.
+	NamespaceForWatchApplicationRefreshRollout() string
+
 	// NamespaceForWatchApplicationConfig returns the namespace string identifier
 	// for application configuration changes.
 	NamespaceForWatchApplicationConfig() string
@@ -555,9 +600,9 @@ func validateDownloadInfoParams(
 	source corecharm.Source,
 	downloadInfo *charm.DownloadInfo,
 ) error {
-	// If the origin is from charmhub, then we require the download info
-	// to deploy.
-	if source != corecharm.CharmHub {
+	// If the origin is from charmhub or an OCI registry, then we require the
+	// download info to deploy.
+	if source != corecharm.CharmHub && source != corecharm.OCI {
 		return nil
 	}
 	if downloadInfo == nil {
@@ -1162,7 +1207,9 @@ func (s *Service) ResolveCharmDownload(ctx context.Context, appUUID coreapplicat
 
 	// Use the hash from the reservation, incase the caller has the wrong hash.
 	// The resulting objectStoreUUID will enable RI between the charm and the
-	// object store.
+	// object store. The charm was checked against the model's charm signature
+	// policy when it was added, and the sha256 hash checked above is the one
+	// that any signature pinned, so there is nothing more to verify.
 	result, err := s.charmStore.Store(ctx, resolve.Path, resolve.Size, resolve.SHA384)
 	if errors.Is(err, objectstoreerrors.ErrHashAndSizeAlreadyExists) {
 		// If the hash already exists but has a different size, then we've
@@ -1390,6 +1437,22 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appUUID coreappli
 		return errors.Errorf("application UUID: %w", err)
 	}
 
+	encodedConfig, trust, err := s.encodeApplicationConfig(ctx, appUUID, newConfig)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return s.st.UpdateApplicationConfigAndSettings(ctx, appUUID, encodedConfig, application.UpdateApplicationSettingsArg{
+		Trust: trust,
+	})
+}
+
+// encodeApplicationConfig validates the application config against the
+// config of the application's charm, returning the config encoded for
+// storage and the trust setting held in the config.
+func (s *Service) encodeApplicationConfig(
+	ctx context.Context, appUUID coreapplication.UUID, newConfig map[string]string,
+) (map[string]application.AddApplicationConfig, *bool, error) {
 	// Get the charm config. This should be safe to do outside of a singular
 	// transaction, as the charm config is immutable. So it will either be there
 	// or not, and if it's not there we can return an error stating that.
@@ -1402,42 +1465,39 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appUUID coreappli
 
 	_, cfg, err := s.st.GetCharmConfigByApplicationUUID(ctx, appUUID)
 	if err != nil {
-		return errors.Capture(err)
+		return nil, nil, errors.Capture(err)
 	}
 
 	charmConfig, err := application.DecodeConfig(cfg)
 	if err != nil {
-		return errors.Capture(err)
+		return nil, nil, errors.Capture(err)
 	}
 
 	// Grab the application settings, which is currently just the trust setting.
 	trust, err := getTrustSettingFromConfig(newConfig)
 	if err != nil {
-		return errors.Capture(err)
+		return nil, nil, errors.Capture(err)
 	}
 
 	// Everything else from the newConfig is just application config. Treat it
 	// as such.
 	coercedConfig, err := charmConfig.ParseSettingsStrings(newConfig)
 	if errors.Is(err, internalcharm.ErrUnknownOption) {
-		return errors.Errorf("%w: %w", applicationerrors.InvalidApplicationConfig, err)
+		return nil, nil, errors.Errorf("%w: %w", applicationerrors.InvalidApplicationConfig, err)
 	} else if err != nil {
-		return errors.Capture(err)
+		return nil, nil, errors.Capture(err)
 	}
 
 	// Validate the secret config.
 	if err := validateSecretConfig(charmConfig, coercedConfig); err != nil {
-		return errors.Capture(err)
+		return nil, nil, errors.Capture(err)
 	}
 
 	encodedConfig, err := application.EncodeApplicationConfig(coercedConfig, cfg)
 	if err != nil {
-		return errors.Capture(err)
+		return nil, nil, errors.Capture(err)
 	}
-
-	return s.st.UpdateApplicationConfigAndSettings(ctx, appUUID, encodedConfig, application.UpdateApplicationSettingsArg{
-		Trust: trust,
-	})
+	return encodedConfig, trust, nil
 }
 
 // GetApplicationConstraints returns the application constraints for the
@@ -1632,10 +1692,31 @@ func overrideStorageDirectives(
 // SetApplicationCharm sets a new charm for the application, validating that aspects such
 // as storage are still viable with the new charm. It reconciles existing application
 // storage directives with the new charm's storage requirements.
+//
+// If params.Rollout is set, the application's units are refreshed a batch at a
+// time. An error satisfying [applicationerrors.RefreshRolloutInProgress] is
+// returned if the application already has an unfinished staged refresh.
 func (s *ProviderService) SetApplicationCharm(ctx context.Context, appName string, charmLocator charm.CharmLocator, params application.SetCharmParams) error {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	if params.Rollout != nil {
+		if err := params.Rollout.Validate(); err != nil {
+			return errors.Errorf("validating refresh rollout: %w", err)
+		}
+	}
+	return s.setApplicationCharm(ctx, appName, charmLocator, params, nil)
+}
+
+// setApplicationCharm sets a new charm for the application. If rollback is
+// set, the charm is being reverted by a failed refresh rollout.
+func (s *ProviderService) setApplicationCharm(
+	ctx context.Context,
+	appName string,
+	charmLocator charm.CharmLocator,
+	params application.SetCharmParams,
+	rollback *refreshRolloutRollback,
+) error {
 	appUUID, err := s.st.GetApplicationUUIDByName(ctx, appName)
 	if err != nil {
 		return errors.Errorf("getting application UUID: %w", err)
@@ -1740,6 +1821,10 @@ func (s *ProviderService) SetApplicationCharm(ctx context.Context, appName strin
 	if err != nil {
 		return errors.Capture(err)
 	}
+	if rollback != nil {
+		paramsState.Channel = rollback.channel
+		paramsState.RolloutRollback = &rollback.reason
+	}
 
 	err = s.st.SetApplicationCharm(ctx, appUUID, charmID, paramsState)
 	if err != nil {
@@ -1872,6 +1957,8 @@ func decodeCharmSource(source charm.CharmSource) (corecharm.Source, error) {
 		return corecharm.CharmHub, nil
 	case charm.LocalSource:
 		return corecharm.Local, nil
+	case charm.OCISource:
+		return corecharm.OCI, nil
 	default:
 		return "", errors.Errorf("unsupported charm source type %q", source)
 	}
@@ -2028,5 +2115,6 @@ func makeSetCharmStateArg(setCharmParams application.SetCharmParams,
 		EndpointBindings:          setCharmParams.EndpointBindings,
 		StorageDirectivesToCreate: toCreate,
 		StorageDirectivesToUpdate: toUpdate,
+		Rollout:                   setCharmParams.Rollout,
 	}, nil
 }
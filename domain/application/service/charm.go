@@ -19,6 +19,7 @@ import (
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	internalcharm "github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/domain/deployment/charm/resource"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -123,6 +124,11 @@ type CharmState interface {
 	// found.
 	GetCharmDownloadInfo(ctx context.Context, id corecharm.ID) (*charm.DownloadInfo, error)
 
+	// GetCharmSignature returns the verified signature of the charm using the
+	// charm ID. Nil is returned if the charm archive was not signed by a
+	// trusted key.
+	GetCharmSignature(ctx context.Context, id corecharm.ID) (*charm.Signature, error)
+
 	// GetAvailableCharmArchiveSHA256 returns the SHA256 hash of the charm
 	// archive for the given charm id. If the charm is not available,
 	// [applicationerrors.CharmNotResolved] is returned. Returns
@@ -159,14 +165,19 @@ type CharmStore interface {
 	// Store the charm at the specified path into the object store. It is
 	// expected that the archive already exists at the specified path. If the
 	// file isn't found, a [ErrNotFound] is returned.
-	Store(ctx context.Context, path string, size int64, hash string) (store.StoreResult, error)
+	Store(ctx context.Context, path string, size int64, hash string, opts ...store.StoreOption) (store.StoreResult, error)
 
 	// StoreFromReader stores the charm from the provided reader into the object
 	// store. The caller is expected to remove the temporary file after the
 	// call.
 	// sha256Prefix is the prefix characters of the SHA256 hash of the charm
 	// archive.
-	StoreFromReader(ctx context.Context, reader io.Reader, sha256Prefix string) (store.StoreFromReaderResult, store.Digest, error)
+	StoreFromReader(ctx context.Context, reader io.Reader, sha256Prefix string, opts ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error)
+
+	// VerifyPublished checks the cosign signatures published by a repository
+	// for a charm that is yet to be downloaded, according to the model's
+	// signature policy. No signatures means the charm is unsigned.
+	VerifyPublished(ctx context.Context, digest string, signatures []charmsignature.CosignSignature) (charmsignature.Verification, error)
 
 	// Get retrieves a ReadCloser for the charm archive at the give path
 	// from the underlying storage.
@@ -187,8 +198,8 @@ func (s *Service) getCharmID(ctx context.Context, args charm.GetCharmArgs) (core
 		return "", applicationerrors.CharmNameNotValid
 	}
 
-	// Validate the source, it can only be charmhub or local.
-	if args.Source != charm.CharmHubSource && args.Source != charm.LocalSource {
+	// Validate the source, it can only be charmhub, oci or local.
+	if args.Source != charm.CharmHubSource && args.Source != charm.OCISource && args.Source != charm.LocalSource {
 		return "", applicationerrors.CharmSourceNotValid
 	}
 
@@ -649,10 +660,23 @@ func (s *Service) SetCharmAvailable(ctx context.Context, locator charm.CharmLoca
 // state.
 // If there are any non-blocking issues with the charm metadata, actions,
 // config or manifest, a set of warnings will be returned.
+//
+// A charm that is yet to be downloaded from Charmhub or an OCI registry is
+// checked against the model's charm signature policy first, returning
+// [charmsignature.ErrSignatureNotFound], [charmsignature.ErrSignatureNotValid]
+// or [charmsignature.ErrSignerNotTrusted] if the policy rejects it.
 func (s *Service) AddCharm(ctx context.Context, args charm.AddCharmArgs) (corecharm.ID, []string, error) {
 	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
 
+	if requiresDownload(args.Source, args.ArchivePath) {
+		signature, err := s.verifyPublishedSignatures(ctx, args.PublishedSignatures)
+		if err != nil {
+			return "", nil, errors.Capture(err)
+		}
+		args.Signature = signature
+	}
+
 	result, warnings, err := s.addCharm(ctx, args)
 	if err != nil {
 		return "", nil, errors.Capture(err)
@@ -688,6 +712,22 @@ func (s *Service) GetCharmDownloadInfo(ctx context.Context, locator charm.CharmL
 	return s.st.GetCharmDownloadInfo(ctx, id)
 }
 
+// GetCharmSignature returns the verified signature of the charm for the given
+// charm name, source and revision. Nil is returned if the charm archive was
+// not signed by a trusted key. Returns [applicationerrors.CharmNotFound] if
+// the charm is not found.
+func (s *Service) GetCharmSignature(ctx context.Context, locator charm.CharmLocator) (*charm.Signature, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	args := argsFromLocator(locator)
+	id, err := s.getCharmID(ctx, args)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.st.GetCharmSignature(ctx, id)
+}
+
 // GetAvailableCharmArchiveSHA256 returns the SHA256 hash of the charm archive
 // for the given charm name, source and revision. If the charm is not available,
 // [applicationerrors.CharmNotResolved] is returned.
@@ -724,7 +764,7 @@ func (s *Service) ResolveUploadCharm(ctx context.Context, args charm.ResolveUplo
 	}
 
 	switch args.Source {
-	case corecharm.CharmHub:
+	case corecharm.CharmHub, corecharm.OCI:
 		if !importing {
 			return charm.CharmLocator{}, applicationerrors.NonLocalCharmImporting
 		}
@@ -746,8 +786,9 @@ func (s *Service) ResolveUploadCharm(ctx context.Context, args charm.ResolveUplo
 }
 
 func (s *Service) resolveLocalUploadedCharm(ctx context.Context, args charm.ResolveUploadCharm) (charm.CharmLocator, error) {
-	// Store the charm and validate it against the sha256 prefix.
-	result, digest, err := s.charmStore.StoreFromReader(ctx, args.Reader, args.SHA256Prefix)
+	// Store the charm and validate it against the sha256 prefix and the
+	// signature supplied with the upload.
+	result, digest, err := s.charmStore.StoreFromReader(ctx, args.Reader, args.SHA256Prefix, store.WithSignatureVerification(args.Signature))
 	if err != nil {
 		return charm.CharmLocator{}, errors.Errorf("resolving uploaded charm: %w", err)
 	}
@@ -791,6 +832,8 @@ func (s *Service) resolveLocalUploadedCharm(ctx context.Context, args charm.Reso
 		Revision:           -1,
 		RequiresSequencing: true,
 
+		Signature: encodeSignature(result.Verification),
+
 		// This is correct, we want to use the unique name of the stored charm
 		// as the archive path. Once every blob is storing the UUID, we can
 		// remove the archive path, until, just use the unique name.
@@ -893,8 +936,9 @@ func (s *Service) addCharm(ctx context.Context, args charm.AddCharmArgs) (addCha
 		return addCharmResult{}, nil, errors.Errorf("reference name: %w", applicationerrors.CharmNameNotValid)
 	}
 
-	// If the origin is from charmhub, then we require the download info.
-	if args.Source == corecharm.CharmHub {
+	// If the origin is from charmhub or an OCI registry, then we require the
+	// download info.
+	if args.Source == corecharm.CharmHub || args.Source == corecharm.OCI {
 		if args.DownloadInfo == nil {
 			return addCharmResult{}, nil, applicationerrors.CharmDownloadInfoNotFound
 		}
@@ -927,6 +971,7 @@ func (s *Service) addCharm(ctx context.Context, args charm.AddCharmArgs) (addCha
 	ch.ObjectStoreUUID = args.ObjectStoreUUID
 	ch.Available = args.ArchivePath != ""
 	ch.Architecture = architecture
+	ch.Signature = args.Signature
 
 	charmID, locator, err := s.st.AddCharm(ctx, ch, args.DownloadInfo, args.RequiresSequencing)
 	return addCharmResult{
@@ -961,13 +1006,8 @@ func (s *Service) ReserveCharmRevision(ctx context.Context, args charm.ReserveCh
 		DownloadInfo:  args.DownloadInfo,
 	})
 	if errors.Is(err, applicationerrors.CharmAlreadyExists) {
-		var charmSource charm.CharmSource
-		switch args.Source {
-		case corecharm.CharmHub:
-			charmSource = charm.CharmHubSource
-		case corecharm.Local:
-			charmSource = charm.LocalSource
-		default:
+		charmSource, encodeErr := encodeCharmSource(args.Source)
+		if encodeErr != nil {
 			return "", nil, applicationerrors.CharmSourceNotValid
 		}
 
@@ -1006,6 +1046,67 @@ func (s *WatchableService) WatchCharms(ctx context.Context) (watcher.StringsWatc
 	)
 }
 
+// requiresDownload reports whether a charm from the source, with the given
+// archive path, is yet to be downloaded from a repository.
+func requiresDownload(source corecharm.Source, archivePath string) bool {
+	return (source == corecharm.CharmHub || source == corecharm.OCI) && archivePath == ""
+}
+
+// verifyPublishedSignatures checks the signatures published for a charm that
+// is yet to be downloaded, against the model's charm signature policy. This
+// is done before the charm is added, so that a charm the policy rejects is
+// refused straight away, rather than failing later in the async charm
+// downloader. Charmhub doesn't publish signatures, so its charms are unsigned.
+func (s *Service) verifyPublishedSignatures(ctx context.Context, published *charm.PublishedSignatures) (*charm.Signature, error) {
+	var (
+		digest     string
+		signatures []charmsignature.CosignSignature
+	)
+	if published != nil {
+		digest = published.Digest
+		signatures = published.Signatures
+	}
+	verification, err := s.charmStore.VerifyPublished(ctx, digest, signatures)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return encodeSignature(verification), nil
+}
+
+// verifyApplicationCharm checks a charm that is yet to be downloaded, for an
+// application that is being created, against the model's charm signature
+// policy. If the repository published no signatures, but the charm was
+// already added and verified, such as by AddCharm before a deploy, the
+// recorded signature is used.
+func (s *Service) verifyApplicationCharm(ctx context.Context, ch charm.Charm, published *charm.PublishedSignatures) (*charm.Signature, error) {
+	if published == nil || len(published.Signatures) == 0 {
+		id, err := s.st.GetCharmID(ctx, ch.ReferenceName, ch.Revision, ch.Source)
+		if err != nil && !errors.Is(err, applicationerrors.CharmNotFound) {
+			return nil, errors.Capture(err)
+		} else if err == nil {
+			signature, err := s.st.GetCharmSignature(ctx, id)
+			if err != nil {
+				return nil, errors.Capture(err)
+			} else if signature != nil {
+				return signature, nil
+			}
+		}
+	}
+	return s.verifyPublishedSignatures(ctx, published)
+}
+
+// encodeSignature returns the charm signature of a verification, or nil if
+// the charm archive wasn't verified.
+func encodeSignature(verification charmsignature.Verification) *charm.Signature {
+	if verification.IsZero() {
+		return nil
+	}
+	return &charm.Signature{
+		Signer:      verification.Signer,
+		Fingerprint: verification.Fingerprint,
+	}
+}
+
 type addCharmResult struct {
 	ID      corecharm.ID
 	Locator charm.CharmLocator
@@ -28,6 +28,7 @@ import (
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	internalcharm "github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/domain/deployment/charm/resource"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/testcharms"
 )
@@ -771,6 +772,8 @@ func (s *charmServiceSuite) TestAddCharmCharmhubWithNoDownloadInfo(c *tc.C) {
 		Architectures: []string{"amd64"},
 	}}}).MinTimes(1)
 
+	s.charmStore.EXPECT().VerifyPublished(gomock.Any(), "", nil).Return(charmsignature.Verification{}, nil)
+
 	var downloadInfo *charm.DownloadInfo
 
 	_, _, err := s.service.AddCharm(c.Context(), charm.AddCharmArgs{
@@ -808,6 +811,7 @@ func (s *charmServiceSuite) TestAddCharmCharmhub(c *tc.C) {
 		DownloadSize:       42,
 	}
 
+	s.charmStore.EXPECT().VerifyPublished(gomock.Any(), "", nil).Return(charmsignature.Verification{}, nil)
 	s.state.EXPECT().AddCharm(gomock.Any(), charm.Charm{
 		Metadata: charm.Metadata{
 			Name:  "foo",
@@ -838,6 +842,92 @@ func (s *charmServiceSuite) TestAddCharmCharmhub(c *tc.C) {
 	c.Check(got, tc.DeepEquals, id)
 }
 
+func (s *charmServiceSuite) TestAddCharmOCIVerifiesPublishedSignatures(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+
+	s.charm.EXPECT().Actions().Return(&internalcharm.Actions{})
+	s.charm.EXPECT().Config().Return(&internalcharm.ConfigSpec{})
+	s.charm.EXPECT().Meta().Return(&internalcharm.Meta{
+		Name: "foo",
+	}).Times(2)
+	s.charm.EXPECT().Manifest().Return(&internalcharm.Manifest{Bases: []internalcharm.Base{{
+		Name:          "ubuntu",
+		Channel:       internalcharm.Channel{Risk: internalcharm.Stable},
+		Architectures: []string{"amd64"},
+	}}}).MinTimes(1)
+
+	downloadInfo := &charm.DownloadInfo{
+		Provenance:         charm.ProvenanceDownload,
+		CharmhubIdentifier: "registry.example.com/charms/foo",
+		DownloadURL:        "oci://registry.example.com/charms/foo@sha256:abc",
+		DownloadSize:       42,
+	}
+	published := &charm.PublishedSignatures{
+		Digest: "sha256:def",
+		Signatures: []charmsignature.CosignSignature{{
+			Payload:   []byte("payload"),
+			Signature: []byte("sig"),
+		}},
+	}
+
+	s.charmStore.EXPECT().VerifyPublished(gomock.Any(), "sha256:def", published.Signatures).Return(charmsignature.Verification{
+		Signer:      "release-team",
+		Fingerprint: "SHA256:abc",
+	}, nil)
+	s.state.EXPECT().AddCharm(gomock.Any(), charm.Charm{
+		Metadata: charm.Metadata{
+			Name:  "foo",
+			RunAs: "default",
+		},
+		Manifest:      s.minimalManifest(),
+		ReferenceName: "foo",
+		Source:        charm.OCISource,
+		Revision:      1,
+		Architecture:  architecture.AMD64,
+		Signature: &charm.Signature{
+			Signer:      "release-team",
+			Fingerprint: "SHA256:abc",
+		},
+	}, downloadInfo, false).Return(id, charm.CharmLocator{}, nil)
+
+	got, _, err := s.service.AddCharm(c.Context(), charm.AddCharmArgs{
+		Charm:               s.charm,
+		Source:              corecharm.OCI,
+		ReferenceName:       "foo",
+		Revision:            1,
+		Architecture:        arch.AMD64,
+		DownloadInfo:        downloadInfo,
+		PublishedSignatures: published,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, id)
+}
+
+func (s *charmServiceSuite) TestAddCharmCharmhubSignatureRejected(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The charm must never reach state.
+	s.charmStore.EXPECT().VerifyPublished(gomock.Any(), "", nil).
+		Return(charmsignature.Verification{}, charmsignature.ErrSignatureNotFound)
+
+	_, _, err := s.service.AddCharm(c.Context(), charm.AddCharmArgs{
+		Charm:         s.charm,
+		Source:        corecharm.CharmHub,
+		ReferenceName: "foo",
+		Revision:      1,
+		Architecture:  arch.AMD64,
+		DownloadInfo: &charm.DownloadInfo{
+			Provenance:         charm.ProvenanceDownload,
+			CharmhubIdentifier: "foo",
+			DownloadURL:        "http://example.com/foo",
+			DownloadSize:       42,
+		},
+	})
+	c.Assert(err, tc.ErrorIs, charmsignature.ErrSignatureNotFound)
+}
+
 func (s *charmServiceSuite) TestAddCharmNoName(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1406,20 +1496,20 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImporting(c *tc.C
 	}
 
 	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(false, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, tc.ErrorIsNil)
 
 			return store.StoreFromReaderResult{
-					Charm:           file,
-					ObjectStoreUUID: objectStoreUUID,
-					UniqueName:      "unique-name",
-				}, store.Digest{
-					SHA256: "sha-256",
-					SHA384: "sha-384",
-					Size:   stat.Size(),
-				}, nil
+				Charm:           file,
+				ObjectStoreUUID: objectStoreUUID,
+				UniqueName:      "unique-name",
+			}, store.Digest{
+				SHA256: "sha-256",
+				SHA384: "sha-384",
+				Size:   stat.Size(),
+			}, nil
 		})
 	s.state.EXPECT().AddCharm(gomock.Any(), gomock.Any(), downloadInfo, true).DoAndReturn(func(_ context.Context, ch charm.Charm, _ *charm.DownloadInfo, _ bool) (corecharm.ID, charm.CharmLocator, error) {
 		c.Check(ch.Metadata.Name, tc.Equals, "dummy")
@@ -1448,13 +1538,71 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImporting(c *tc.C
 	})
 }
 
+func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmSigned(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dst := c.MkDir()
+	path := testcharms.Repo.CharmArchivePath(dst, "dummy")
+	file, err := os.Open(path)
+	c.Assert(err, tc.ErrorIsNil)
+	stat, err := file.Stat()
+	c.Assert(err, tc.ErrorIsNil)
+
+	charmID := charmtesting.GenCharmID(c)
+	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
+
+	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(false, nil)
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error) {
+			_, err := file.Seek(0, io.SeekStart)
+			c.Assert(err, tc.ErrorIsNil)
+
+			return store.StoreFromReaderResult{
+				Charm:           file,
+				ObjectStoreUUID: objectStoreUUID,
+				UniqueName:      "unique-name",
+				Verification: charmsignature.Verification{
+					Signer:      "release",
+					Fingerprint: "SHA256:abc",
+				},
+			}, store.Digest{
+				SHA256: "sha-256",
+				SHA384: "sha-384",
+				Size:   stat.Size(),
+			}, nil
+		})
+	s.state.EXPECT().AddCharm(gomock.Any(), gomock.Any(), gomock.Any(), true).DoAndReturn(func(_ context.Context, ch charm.Charm, _ *charm.DownloadInfo, _ bool) (corecharm.ID, charm.CharmLocator, error) {
+		c.Check(ch.Signature, tc.DeepEquals, &charm.Signature{
+			Signer:      "release",
+			Fingerprint: "SHA256:abc",
+		})
+		return charmID, charm.CharmLocator{
+			Name:         "test",
+			Revision:     1,
+			Source:       charm.LocalSource,
+			Architecture: architecture.AMD64,
+		}, nil
+	})
+
+	_, err = s.service.ResolveUploadCharm(c.Context(), charm.ResolveUploadCharm{
+		Source:       corecharm.Local,
+		Reader:       file,
+		SHA256Prefix: "abc",
+		Architecture: arch.AMD64,
+		Revision:     -1,
+		Name:         "test",
+		Signature:    []byte("signature"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImportingFailedRead(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
 
 	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(false, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").Return(store.StoreFromReaderResult{
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).Return(store.StoreFromReaderResult{
 		ObjectStoreUUID: objectStoreUUID,
 		UniqueName:      "unique-name",
 	}, store.Digest{
@@ -1492,20 +1640,20 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmNotImportingFailedAd
 	}
 
 	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(false, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, tc.ErrorIsNil)
 
 			return store.StoreFromReaderResult{
-					Charm:           file,
-					ObjectStoreUUID: objectStoreUUID,
-					UniqueName:      "unique-name",
-				}, store.Digest{
-					SHA256: "sha-256",
-					SHA384: "sha-384",
-					Size:   stat.Size(),
-				}, nil
+				Charm:           file,
+				ObjectStoreUUID: objectStoreUUID,
+				UniqueName:      "unique-name",
+			}, store.Digest{
+				SHA256: "sha-256",
+				SHA384: "sha-384",
+				Size:   stat.Size(),
+			}, nil
 		})
 	s.state.EXPECT().AddCharm(gomock.Any(), gomock.Any(), downloadInfo, true).DoAndReturn(func(_ context.Context, _ charm.Charm, _ *charm.DownloadInfo, _ bool) (corecharm.ID, charm.CharmLocator, error) {
 		return charmID, charm.CharmLocator{}, errors.Errorf("failed to set charm %w", coreerrors.NotValid)
@@ -1545,20 +1693,20 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmImporting(c *tc.C) {
 	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(true, nil)
 
 	s.state.EXPECT().GetCharmID(gomock.Any(), "test", 1, charm.LocalSource).Return(charmID, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, tc.ErrorIsNil)
 
 			return store.StoreFromReaderResult{
-					Charm:           file,
-					ObjectStoreUUID: objectStoreUUID,
-					UniqueName:      "unique-name",
-				}, store.Digest{
-					SHA256: "sha-256",
-					SHA384: "sha-384",
-					Size:   stat.Size(),
-				}, nil
+				Charm:           file,
+				ObjectStoreUUID: objectStoreUUID,
+				UniqueName:      "unique-name",
+			}, store.Digest{
+				SHA256: "sha-256",
+				SHA384: "sha-384",
+				Size:   stat.Size(),
+			}, nil
 		})
 	s.state.EXPECT().ResolveMigratingUploadedCharm(gomock.Any(), charmID, charm.ResolvedMigratingUploadedCharm{
 		ObjectStoreUUID: objectStoreUUID,
@@ -1628,7 +1776,7 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmImportingFailedStore
 
 	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(true, nil)
 	s.state.EXPECT().GetCharmID(gomock.Any(), "test", 1, charm.LocalSource).Return(charmID, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").Return(store.StoreFromReaderResult{
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).Return(store.StoreFromReaderResult{
 		ObjectStoreUUID: objectStoreUUID,
 		UniqueName:      "unique-name",
 	}, store.Digest{
@@ -1667,20 +1815,20 @@ func (s *charmServiceSuite) TestResolveUploadCharmLocalCharmImportingFailedResol
 
 	s.state.EXPECT().IsImportingModel(gomock.Any()).Return(true, nil)
 	s.state.EXPECT().GetCharmID(gomock.Any(), "test", 1, charm.LocalSource).Return(charmID, nil)
-	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc").
-		DoAndReturn(func(ctx context.Context, r io.Reader, s string) (store.StoreFromReaderResult, store.Digest, error) {
+	s.charmStore.EXPECT().StoreFromReader(gomock.Any(), gomock.Not(gomock.Nil()), "abc", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, r io.Reader, s string, _ ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error) {
 			_, err := file.Seek(0, io.SeekStart)
 			c.Assert(err, tc.ErrorIsNil)
 
 			return store.StoreFromReaderResult{
-					Charm:           file,
-					ObjectStoreUUID: objectStoreUUID,
-					UniqueName:      "unique-name",
-				}, store.Digest{
-					SHA256: "sha-256",
-					SHA384: "sha-384",
-					Size:   stat.Size(),
-				}, nil
+				Charm:           file,
+				ObjectStoreUUID: objectStoreUUID,
+				UniqueName:      "unique-name",
+			}, store.Digest{
+				SHA256: "sha-256",
+				SHA384: "sha-384",
+				Size:   stat.Size(),
+			}, nil
 		})
 	s.state.EXPECT().ResolveMigratingUploadedCharm(gomock.Any(), charmID, charm.ResolvedMigratingUploadedCharm{
 		ObjectStoreUUID: objectStoreUUID,
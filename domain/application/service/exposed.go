@@ -107,6 +107,19 @@ func (s *Service) MergeExposeSettings(
 		return errors.Capture(err)
 	}
 
+	validatedExposedEndpoints, err := s.validateExposeSettings(ctx, appID, exposedEndpoints)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.MergeExposeSettings(ctx, appID, validatedExposedEndpoints))
+}
+
+// validateExposeSettings checks that the endpoints and the spaces they are
+// exposed to exist, returning the expose settings with the implicit defaults
+// filled in.
+func (s *Service) validateExposeSettings(
+	ctx context.Context, appID coreapplication.UUID, exposedEndpoints map[string]application.ExposedEndpoint,
+) (map[string]application.ExposedEndpoint, error) {
 	// First check that the endpoints actually exist.
 	endpointNames := set.NewStrings()
 	for endpoint := range exposedEndpoints {
@@ -115,7 +128,7 @@ func (s *Service) MergeExposeSettings(
 		}
 	}
 	if err := s.st.EndpointsExist(ctx, appID, endpointNames); err != nil {
-		return errors.Capture(err)
+		return nil, errors.Capture(err)
 	}
 	// Then we need to make sure that the spaces that endpoints are exposed
 	// to (if any) actually exist.
@@ -124,7 +137,7 @@ func (s *Service) MergeExposeSettings(
 		spaceUUIDStr = append(spaceUUIDStr, exposedEndpoint.ExposeToSpaceIDs.Values()...)
 	}
 	if err := s.st.SpacesExist(ctx, set.NewStrings(spaceUUIDStr...)); err != nil {
-		return errors.Errorf("validating exposed endpoints to spaces %+v: %w",
+		return nil, errors.Errorf("validating exposed endpoints to spaces %+v: %w",
 			set.NewStrings(spaceUUIDStr...).Values(), err)
 	}
 
@@ -148,6 +161,5 @@ func (s *Service) MergeExposeSettings(
 			validatedExposedEndpoints[endpoint] = exposedEndpoint
 		}
 	}
-
-	return errors.Capture(s.st.MergeExposeSettings(ctx, appID, validatedExposedEndpoints))
+	return validatedExposedEndpoints, nil
 }
@@ -75,8 +75,8 @@ func (s *MigrationService) GetCharmID(ctx context.Context, args charm.GetCharmAr
 		return "", applicationerrors.CharmNameNotValid
 	}
 
-	// Validate the source, it can only be charmhub or local.
-	if args.Source != charm.CharmHubSource && args.Source != charm.LocalSource {
+	// Validate the source, it can only be charmhub, oci or local.
+	if args.Source != charm.CharmHubSource && args.Source != charm.OCISource && args.Source != charm.LocalSource {
 		return "", applicationerrors.CharmSourceNotValid
 	}
 
@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	io "io"
+	time "time"
 
 	gomock "github.com/canonical/gomock/gomock"
 	set "github.com/juju/collections/set"
@@ -42,6 +43,7 @@ import (
 	network0 "github.com/juju/juju/domain/network"
 	storage0 "github.com/juju/juju/domain/storage"
 	environs "github.com/juju/juju/environs"
+	charmsignature "github.com/juju/juju/internal/charmsignature"
 	statushistory "github.com/juju/juju/internal/statushistory"
 )
 
@@ -162,8 +164,9 @@ type MockCharmStoreMockRecorder struct {
 	mock                     *MockCharmStore
 	getExpects               []*gomock.Call2_2[context.Context, string, io.ReadCloser, error]
 	getBySHA256PrefixExpects []*gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
-	storeExpects             []*gomock.Call4_2[context.Context, string, int64, string, store.StoreResult, error]
-	storeFromReaderExpects   []*gomock.Call3_3[context.Context, io.Reader, string, store.StoreFromReaderResult, store.Digest, error]
+	storeExpects             []*gomock.Call4V_2[context.Context, string, int64, string, store.StoreOption, store.StoreResult, error]
+	storeFromReaderExpects   []*gomock.Call3V_3[context.Context, io.Reader, string, store.StoreOption, store.StoreFromReaderResult, store.Digest, error]
+	verifyPublishedExpects   []*gomock.Call3_2[context.Context, string, []charmsignature.CosignSignature, charmsignature.Verification, error]
 }
 
 // NewMockCharmStore creates a new mock instance.
@@ -215,40 +218,60 @@ func (mr *MockCharmStoreMockRecorder) GetBySHA256Prefix(ctx, sha256Prefix any) *
 type MockCharmStoreGetBySHA256PrefixCall = gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
 
 // Store mocks base method.
-func (m *MockCharmStore) Store(ctx context.Context, path string, size int64, hash string) (store.StoreResult, error) {
+func (m *MockCharmStore) Store(ctx context.Context, path string, size int64, hash string, opts ...store.StoreOption) (store.StoreResult, error) {
 	m.ctrl.T.Helper()
-	return gomock.Dispatch4_2(&m.recorder.storeExpects, m.ctrl, m, "Store", ctx, path, size, hash)
+	return gomock.Dispatch4V_2(&m.recorder.storeExpects, m.ctrl, m, "Store", ctx, path, size, hash, opts...)
 }
 
 // Store indicates an expected call of Store.
-func (mr *MockCharmStoreMockRecorder) Store(ctx, path, size, hash any) *MockCharmStoreStoreCall {
+func (mr *MockCharmStoreMockRecorder) Store(ctx, path, size, hash any, opts ...any) *MockCharmStoreStoreCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall4_2[context.Context, string, int64, string, store.StoreResult, error](mr.mock.ctrl.T, mr.mock, "Store", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(path), gomock.EnsureMatcher(size), gomock.EnsureMatcher(hash))
+	varArgs := gomock.EnsureVariadicMatcher(opts)
+	call := gomock.NewCall4V_2[context.Context, string, int64, string, store.StoreOption, store.StoreResult, error](mr.mock.ctrl.T, mr.mock, "Store", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(path), gomock.EnsureMatcher(size), gomock.EnsureMatcher(hash), varArgs)
 	mr.storeExpects = append(mr.storeExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockCharmStoreStoreCall is the typed call wrapper for Store.
-type MockCharmStoreStoreCall = gomock.Call4_2[context.Context, string, int64, string, store.StoreResult, error]
+type MockCharmStoreStoreCall = gomock.Call4V_2[context.Context, string, int64, string, store.StoreOption, store.StoreResult, error]
 
 // StoreFromReader mocks base method.
-func (m *MockCharmStore) StoreFromReader(ctx context.Context, reader io.Reader, sha256Prefix string) (store.StoreFromReaderResult, store.Digest, error) {
+func (m *MockCharmStore) StoreFromReader(ctx context.Context, reader io.Reader, sha256Prefix string, opts ...store.StoreOption) (store.StoreFromReaderResult, store.Digest, error) {
 	m.ctrl.T.Helper()
-	return gomock.Dispatch3_3(&m.recorder.storeFromReaderExpects, m.ctrl, m, "StoreFromReader", ctx, reader, sha256Prefix)
+	return gomock.Dispatch3V_3(&m.recorder.storeFromReaderExpects, m.ctrl, m, "StoreFromReader", ctx, reader, sha256Prefix, opts...)
 }
 
 // StoreFromReader indicates an expected call of StoreFromReader.
-func (mr *MockCharmStoreMockRecorder) StoreFromReader(ctx, reader, sha256Prefix any) *MockCharmStoreStoreFromReaderCall {
+func (mr *MockCharmStoreMockRecorder) StoreFromReader(ctx, reader, sha256Prefix any, opts ...any) *MockCharmStoreStoreFromReaderCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall3_3[context.Context, io.Reader, string, store.StoreFromReaderResult, store.Digest, error](mr.mock.ctrl.T, mr.mock, "StoreFromReader", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(reader), gomock.EnsureMatcher(sha256Prefix))
+	varArgs := gomock.EnsureVariadicMatcher(opts)
+	call := gomock.NewCall3V_3[context.Context, io.Reader, string, store.StoreOption, store.StoreFromReaderResult, store.Digest, error](mr.mock.ctrl.T, mr.mock, "StoreFromReader", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(reader), gomock.EnsureMatcher(sha256Prefix), varArgs)
 	mr.storeFromReaderExpects = append(mr.storeFromReaderExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockCharmStoreStoreFromReaderCall is the typed call wrapper for StoreFromReader.
-type MockCharmStoreStoreFromReaderCall = gomock.Call3_3[context.Context, io.Reader, string, store.StoreFromReaderResult, store.Digest, error]
+type MockCharmStoreStoreFromReaderCall = gomock.Call3V_3[context.Context, io.Reader, string, store.StoreOption, store.StoreFromReaderResult, store.Digest, error]
+
+// VerifyPublished mocks base method.
+func (m *MockCharmStore) VerifyPublished(ctx context.Context, digest string, signatures []charmsignature.CosignSignature) (charmsignature.Verification, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.verifyPublishedExpects, m.ctrl, m, "VerifyPublished", ctx, digest, signatures)
+}
+
+// VerifyPublished indicates an expected call of VerifyPublished.
+func (mr *MockCharmStoreMockRecorder) VerifyPublished(ctx, digest, signatures any) *MockCharmStoreVerifyPublishedCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, []charmsignature.CosignSignature, charmsignature.Verification, error](mr.mock.ctrl.T, mr.mock, "VerifyPublished", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(digest), gomock.EnsureMatcher(signatures))
+	mr.verifyPublishedExpects = append(mr.verifyPublishedExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmStoreVerifyPublishedCall is the typed call wrapper for VerifyPublished.
+type MockCharmStoreVerifyPublishedCall = gomock.Call3_2[context.Context, string, []charmsignature.CosignSignature, charmsignature.Verification, error]
 
 // MockProvider is a mock of Provider interface.
 type MockProvider struct {
@@ -373,8 +396,10 @@ type MockStateMockRecorder struct {
 	attachStorageInstanceToUnitExpects                        []*gomock.Call3_1[context.Context, unit.UUID, storage0.AttachStorageInstanceToUnitArg, error]
 	checkApplicationsForMigrationExpects                      []*gomock.Call1_1[context.Context, error]
 	clearApplicationHasK8sResourcesExpects                    []*gomock.Call2_1[context.Context, application.UUID, error]
+	continueRefreshRolloutExpects                             []*gomock.Call3_1[context.Context, application.UUID, time.Time, error]
 	createCAASApplicationExpects                              []*gomock.Call4_2[context.Context, string, application0.AddCAASApplicationArg, []application0.AddCAASUnitArg, application.UUID, error]
 	createIAASApplicationExpects                              []*gomock.Call4_3[context.Context, string, application0.AddIAASApplicationArg, []application0.AddIAASUnitArg, application.UUID, []machine.Name, error]
+	deleteRefreshRolloutExpects                               []*gomock.Call2_1[context.Context, application.UUID, error]
 	endpointsExistExpects                                     []*gomock.Call3_1[context.Context, application.UUID, set.Strings, error]
 	getAddressesHashExpects                                   []*gomock.Call3_2[context.Context, application.UUID, string, string, error]
 	getAllEndpointBindingsExpects                             []*gomock.Call1_2[context.Context, map[string]map[string]string, error]
@@ -425,6 +450,7 @@ type MockStateMockRecorder struct {
 	getCharmMetadataResourcesExpects                          []*gomock.Call2_2[context.Context, charm.ID, map[string]charm0.Resource, error]
 	getCharmMetadataStorageExpects                            []*gomock.Call2_2[context.Context, charm.ID, map[string]charm0.Storage, error]
 	getCharmModifiedVersionExpects                            []*gomock.Call2_2[context.Context, application.UUID, int, error]
+	getCharmSignatureExpects                                  []*gomock.Call2_2[context.Context, charm.ID, *charm0.Signature, error]
 	getDeviceConstraintsExpects                               []*gomock.Call2_2[context.Context, application.UUID, map[string]devices.Constraints, error]
 	getExposedEndpointsExpects                                []*gomock.Call2_2[context.Context, application.UUID, map[string]application0.ExposedEndpoint, error]
 	getIAASUnitContextExpects                                 []*gomock.Call2_2[context.Context, string, internal.IAASUnitContext, error]
@@ -436,6 +462,9 @@ type MockStateMockRecorder struct {
 	getModelStoragePoolsExpects                               []*gomock.Call1_2[context.Context, internal.ModelStoragePools, error]
 	getModelTypeExpects                                       []*gomock.Call1_2[context.Context, model.ModelType, error]
 	getNetNodeUUIDByUnitNameExpects                           []*gomock.Call2_2[context.Context, unit.Name, string, error]
+	getRefreshRolloutExpects                                  []*gomock.Call2_2[context.Context, application.UUID, application0.RefreshRollout, error]
+	getRefreshRolloutUnitsExpects                             []*gomock.Call2_2[context.Context, application.UUID, []application0.RefreshRolloutUnit, error]
+	getRefreshRolloutsExpects                                 []*gomock.Call1_2[context.Context, []application0.RefreshRollout, error]
 	getSpaceUUIDByNameExpects                                 []*gomock.Call2_2[context.Context, string, network.SpaceUUID, error]
 	getStorageAddInfoByUnitUUIDExpects                        []*gomock.Call3_2[context.Context, unit.UUID, storage.Name, internal.StorageInfoForAdd, error]
 	getStorageAttachInfoByUnitUUIDAndStorageUUIDExpects       []*gomock.Call3_2[context.Context, unit.UUID, storage0.StorageInstanceUUID, storage0.StorageInstanceInfoForUnitAttach, error]
@@ -452,6 +481,7 @@ type MockStateMockRecorder struct {
 	getUnitOwnedStorageInstancesExpects                       []*gomock.Call2_3[context.Context, unit.UUID, []storage0.StorageInstanceInfoForAttach, []storage0.StorageAttachmentComposition, error]
 	getUnitPrincipalExpects                                   []*gomock.Call2_3[context.Context, unit.Name, unit.Name, bool, error]
 	getUnitRefreshAttributesExpects                           []*gomock.Call2_2[context.Context, unit.Name, application0.UnitAttributes, error]
+	getUnitRefreshCharmIDExpects                              []*gomock.Call2_2[context.Context, unit.Name, charm.ID, error]
 	getUnitStorageRefreshArgsExpects                          []*gomock.Call3_2[context.Context, unit.UUID, charm.ID, internal.UnitStorageRefreshArgs, error]
 	getUnitSubordinatesExpects                                []*gomock.Call2_2[context.Context, unit.Name, []unit.Name, error]
 	getUnitUUIDAndNetNodeForNameExpects                       []*gomock.Call2_3[context.Context, unit.Name, unit.UUID, network0.NetNodeUUID, error]
@@ -482,12 +512,14 @@ type MockStateMockRecorder struct {
 	namespaceForWatchApplicationExpects                       []*gomock.Call0_1[string]
 	namespaceForWatchApplicationConfigExpects                 []*gomock.Call0_1[string]
 	namespaceForWatchApplicationExposedExpects                []*gomock.Call0_2[string, string]
+	namespaceForWatchApplicationRefreshRolloutExpects         []*gomock.Call0_1[string]
 	namespaceForWatchApplicationScaleExpects                  []*gomock.Call0_1[string]
 	namespaceForWatchApplicationSettingExpects                []*gomock.Call0_1[string]
 	namespaceForWatchCharmExpects                             []*gomock.Call0_1[string]
 	namespaceForWatchNetNodeAddressExpects                    []*gomock.Call0_1[string]
 	namespaceForWatchUnitForLegacyUniterExpects               []*gomock.Call0_3[string, string, string]
 	registerCAASUnitExpects                                   []*gomock.Call3_1[context.Context, string, application0.RegisterCAASUnitArg, error]
+	releaseRefreshRolloutUnitsExpects                         []*gomock.Call4_1[context.Context, application.UUID, []unit.UUID, time.Time, error]
 	resolveCharmDownloadExpects                               []*gomock.Call3_1[context.Context, charm.ID, application0.ResolvedCharmDownload, error]
 	resolveMigratingUploadedCharmExpects                      []*gomock.Call3_2[context.Context, charm.ID, charm0.ResolvedMigratingUploadedCharm, charm0.CharmLocator, error]
 	setApplicationCharmExpects                                []*gomock.Call4_1[context.Context, application.UUID, charm.ID, application0.SetCharmStateParams, error]
@@ -496,6 +528,7 @@ type MockStateMockRecorder struct {
 	setApplicationScalingStateExpects                         []*gomock.Call4_1[context.Context, string, int, bool, error]
 	setCharmAvailableExpects                                  []*gomock.Call2_1[context.Context, charm.ID, error]
 	setDesiredApplicationScaleExpects                         []*gomock.Call3_1[context.Context, application.UUID, int, error]
+	setRefreshRolloutStatusExpects                            []*gomock.Call4_1[context.Context, application.UUID, application0.RefreshRolloutStatus, string, error]
 	setUnitWorkloadVersionExpects                             []*gomock.Call3_1[context.Context, unit.Name, string, error]
 	shouldAllowCharmUpgradeOnErrorExpects                     []*gomock.Call2_2[context.Context, string, bool, error]
 	spacesExistExpects                                        []*gomock.Call2_1[context.Context, set.Strings, error]
@@ -504,6 +537,7 @@ type MockStateMockRecorder struct {
 	unsetExposeSettingsExpects                                []*gomock.Call3_1[context.Context, application.UUID, set.Strings, error]
 	updateApplicationConfigAndSettingsExpects                 []*gomock.Call4_1[context.Context, application.UUID, map[string]application0.AddApplicationConfig, application0.UpdateApplicationSettingsArg, error]
 	updateApplicationScaleExpects                             []*gomock.Call3_2[context.Context, application.UUID, int, int, error]
+	updateApplicationsConfigurationExpects                    []*gomock.Call2_1[context.Context, []application0.UpdateApplicationConfigurationArg, error]
 	updateCAASUnitExpects                                     []*gomock.Call3_1[context.Context, unit.Name, application0.UpdateCAASUnitParams, error]
 	updateUnitCharmExpects                                    []*gomock.Call2_1[context.Context, internal.UpdateUnitCharmArg, error]
 	upsertK8sServiceExpects                                   []*gomock.Call4_1[context.Context, string, string, network.ProviderAddresses, error]
@@ -667,6 +701,24 @@ func (mr *MockStateMockRecorder) ClearApplicationHasK8sResources(ctx, appUUID an
 // MockStateClearApplicationHasK8sResourcesCall is the typed call wrapper for ClearApplicationHasK8sResources.
 type MockStateClearApplicationHasK8sResourcesCall = gomock.Call2_1[context.Context, application.UUID, error]
 
+// ContinueRefreshRollout mocks base method.
+func (m *MockState) ContinueRefreshRollout(ctx context.Context, appUUID application.UUID, startedAt time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.continueRefreshRolloutExpects, m.ctrl, m, "ContinueRefreshRollout", ctx, appUUID, startedAt)
+}
+
+// ContinueRefreshRollout indicates an expected call of ContinueRefreshRollout.
+func (mr *MockStateMockRecorder) ContinueRefreshRollout(ctx, appUUID, startedAt any) *MockStateContinueRefreshRolloutCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, application.UUID, time.Time, error](mr.mock.ctrl.T, mr.mock, "ContinueRefreshRollout", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID), gomock.EnsureMatcher(startedAt))
+	mr.continueRefreshRolloutExpects = append(mr.continueRefreshRolloutExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateContinueRefreshRolloutCall is the typed call wrapper for ContinueRefreshRollout.
+type MockStateContinueRefreshRolloutCall = gomock.Call3_1[context.Context, application.UUID, time.Time, error]
+
 // CreateCAASApplication mocks base method.
 func (m *MockState) CreateCAASApplication(arg0 context.Context, arg1 string, arg2 application0.AddCAASApplicationArg, arg3 []application0.AddCAASUnitArg) (application.UUID, error) {
 	m.ctrl.T.Helper()
@@ -703,6 +755,24 @@ func (mr *MockStateMockRecorder) CreateIAASApplication(arg0, arg1, arg2, arg3 an
 // MockStateCreateIAASApplicationCall is the typed call wrapper for CreateIAASApplication.
 type MockStateCreateIAASApplicationCall = gomock.Call4_3[context.Context, string, application0.AddIAASApplicationArg, []application0.AddIAASUnitArg, application.UUID, []machine.Name, error]
 
+// DeleteRefreshRollout mocks base method.
+func (m *MockState) DeleteRefreshRollout(ctx context.Context, appUUID application.UUID) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.deleteRefreshRolloutExpects, m.ctrl, m, "DeleteRefreshRollout", ctx, appUUID)
+}
+
+// DeleteRefreshRollout indicates an expected call of DeleteRefreshRollout.
+func (mr *MockStateMockRecorder) DeleteRefreshRollout(ctx, appUUID any) *MockStateDeleteRefreshRolloutCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, application.UUID, error](mr.mock.ctrl.T, mr.mock, "DeleteRefreshRollout", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID))
+	mr.deleteRefreshRolloutExpects = append(mr.deleteRefreshRolloutExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateDeleteRefreshRolloutCall is the typed call wrapper for DeleteRefreshRollout.
+type MockStateDeleteRefreshRolloutCall = gomock.Call2_1[context.Context, application.UUID, error]
+
 // EndpointsExist mocks base method.
 func (m *MockState) EndpointsExist(ctx context.Context, appUUID application.UUID, endpoints set.Strings) error {
 	m.ctrl.T.Helper()
@@ -1604,6 +1674,24 @@ func (mr *MockStateMockRecorder) GetCharmModifiedVersion(ctx, id any) *MockState
 // MockStateGetCharmModifiedVersionCall is the typed call wrapper for GetCharmModifiedVersion.
 type MockStateGetCharmModifiedVersionCall = gomock.Call2_2[context.Context, application.UUID, int, error]
 
+// GetCharmSignature mocks base method.
+func (m *MockState) GetCharmSignature(ctx context.Context, id charm.ID) (*charm0.Signature, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getCharmSignatureExpects, m.ctrl, m, "GetCharmSignature", ctx, id)
+}
+
+// GetCharmSignature indicates an expected call of GetCharmSignature.
+func (mr *MockStateMockRecorder) GetCharmSignature(ctx, id any) *MockStateGetCharmSignatureCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, charm.ID, *charm0.Signature, error](mr.mock.ctrl.T, mr.mock, "GetCharmSignature", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(id))
+	mr.getCharmSignatureExpects = append(mr.getCharmSignatureExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetCharmSignatureCall is the typed call wrapper for GetCharmSignature.
+type MockStateGetCharmSignatureCall = gomock.Call2_2[context.Context, charm.ID, *charm0.Signature, error]
+
 // GetDeviceConstraints mocks base method.
 func (m *MockState) GetDeviceConstraints(ctx context.Context, appUUID application.UUID) (map[string]devices.Constraints, error) {
 	m.ctrl.T.Helper()
@@ -1802,6 +1890,60 @@ func (mr *MockStateMockRecorder) GetNetNodeUUIDByUnitName(ctx, name any) *MockSt
 // MockStateGetNetNodeUUIDByUnitNameCall is the typed call wrapper for GetNetNodeUUIDByUnitName.
 type MockStateGetNetNodeUUIDByUnitNameCall = gomock.Call2_2[context.Context, unit.Name, string, error]
 
+// GetRefreshRollout mocks base method.
+func (m *MockState) GetRefreshRollout(ctx context.Context, appUUID application.UUID) (application0.RefreshRollout, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getRefreshRolloutExpects, m.ctrl, m, "GetRefreshRollout", ctx, appUUID)
+}
+
+// GetRefreshRollout indicates an expected call of GetRefreshRollout.
+func (mr *MockStateMockRecorder) GetRefreshRollout(ctx, appUUID any) *MockStateGetRefreshRolloutCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, application.UUID, application0.RefreshRollout, error](mr.mock.ctrl.T, mr.mock, "GetRefreshRollout", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID))
+	mr.getRefreshRolloutExpects = append(mr.getRefreshRolloutExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetRefreshRolloutCall is the typed call wrapper for GetRefreshRollout.
+type MockStateGetRefreshRolloutCall = gomock.Call2_2[context.Context, application.UUID, application0.RefreshRollout, error]
+
+// GetRefreshRolloutUnits mocks base method.
+func (m *MockState) GetRefreshRolloutUnits(ctx context.Context, appUUID application.UUID) ([]application0.RefreshRolloutUnit, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getRefreshRolloutUnitsExpects, m.ctrl, m, "GetRefreshRolloutUnits", ctx, appUUID)
+}
+
+// GetRefreshRolloutUnits indicates an expected call of GetRefreshRolloutUnits.
+func (mr *MockStateMockRecorder) GetRefreshRolloutUnits(ctx, appUUID any) *MockStateGetRefreshRolloutUnitsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, application.UUID, []application0.RefreshRolloutUnit, error](mr.mock.ctrl.T, mr.mock, "GetRefreshRolloutUnits", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID))
+	mr.getRefreshRolloutUnitsExpects = append(mr.getRefreshRolloutUnitsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetRefreshRolloutUnitsCall is the typed call wrapper for GetRefreshRolloutUnits.
+type MockStateGetRefreshRolloutUnitsCall = gomock.Call2_2[context.Context, application.UUID, []application0.RefreshRolloutUnit, error]
+
+// GetRefreshRollouts mocks base method.
+func (m *MockState) GetRefreshRollouts(ctx context.Context) ([]application0.RefreshRollout, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getRefreshRolloutsExpects, m.ctrl, m, "GetRefreshRollouts", ctx)
+}
+
+// GetRefreshRollouts indicates an expected call of GetRefreshRollouts.
+func (mr *MockStateMockRecorder) GetRefreshRollouts(ctx any) *MockStateGetRefreshRolloutsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []application0.RefreshRollout, error](mr.mock.ctrl.T, mr.mock, "GetRefreshRollouts", gomock.EnsureMatcher(ctx))
+	mr.getRefreshRolloutsExpects = append(mr.getRefreshRolloutsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetRefreshRolloutsCall is the typed call wrapper for GetRefreshRollouts.
+type MockStateGetRefreshRolloutsCall = gomock.Call1_2[context.Context, []application0.RefreshRollout, error]
+
 // GetSpaceUUIDByName mocks base method.
 func (m *MockState) GetSpaceUUIDByName(ctx context.Context, name string) (network.SpaceUUID, error) {
 	m.ctrl.T.Helper()
@@ -2090,6 +2232,24 @@ func (mr *MockStateMockRecorder) GetUnitRefreshAttributes(arg0, arg1 any) *MockS
 // MockStateGetUnitRefreshAttributesCall is the typed call wrapper for GetUnitRefreshAttributes.
 type MockStateGetUnitRefreshAttributesCall = gomock.Call2_2[context.Context, unit.Name, application0.UnitAttributes, error]
 
+// GetUnitRefreshCharmID mocks base method.
+func (m *MockState) GetUnitRefreshCharmID(ctx context.Context, name unit.Name) (charm.ID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getUnitRefreshCharmIDExpects, m.ctrl, m, "GetUnitRefreshCharmID", ctx, name)
+}
+
+// GetUnitRefreshCharmID indicates an expected call of GetUnitRefreshCharmID.
+func (mr *MockStateMockRecorder) GetUnitRefreshCharmID(ctx, name any) *MockStateGetUnitRefreshCharmIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, unit.Name, charm.ID, error](mr.mock.ctrl.T, mr.mock, "GetUnitRefreshCharmID", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getUnitRefreshCharmIDExpects = append(mr.getUnitRefreshCharmIDExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetUnitRefreshCharmIDCall is the typed call wrapper for GetUnitRefreshCharmID.
+type MockStateGetUnitRefreshCharmIDCall = gomock.Call2_2[context.Context, unit.Name, charm.ID, error]
+
 // GetUnitStorageRefreshArgs mocks base method.
 func (m *MockState) GetUnitStorageRefreshArgs(ctx context.Context, arg1 unit.UUID, next charm.ID) (internal.UnitStorageRefreshArgs, error) {
 	m.ctrl.T.Helper()
@@ -2632,6 +2792,24 @@ func (mr *MockStateMockRecorder) NamespaceForWatchApplicationExposed() *MockStat
 // MockStateNamespaceForWatchApplicationExposedCall is the typed call wrapper for NamespaceForWatchApplicationExposed.
 type MockStateNamespaceForWatchApplicationExposedCall = gomock.Call0_2[string, string]
 
+// NamespaceForWatchApplicationRefreshRollout mocks base method.
+func (m *MockState) NamespaceForWatchApplicationRefreshRollout() string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.namespaceForWatchApplicationRefreshRolloutExpects, m.ctrl, m, "NamespaceForWatchApplicationRefreshRollout")
+}
+
+// NamespaceForWatchApplicationRefreshRollout indicates an expected call of NamespaceForWatchApplicationRefreshRollout.
+func (mr *MockStateMockRecorder) NamespaceForWatchApplicationRefreshRollout() *MockStateNamespaceForWatchApplicationRefreshRolloutCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[string](mr.mock.ctrl.T, mr.mock, "NamespaceForWatchApplicationRefreshRollout")
+	mr.namespaceForWatchApplicationRefreshRolloutExpects = append(mr.namespaceForWatchApplicationRefreshRolloutExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateNamespaceForWatchApplicationRefreshRolloutCall is the typed call wrapper for NamespaceForWatchApplicationRefreshRollout.
+type MockStateNamespaceForWatchApplicationRefreshRolloutCall = gomock.Call0_1[string]
+
 // NamespaceForWatchApplicationScale mocks base method.
 func (m *MockState) NamespaceForWatchApplicationScale() string {
 	m.ctrl.T.Helper()
@@ -2740,6 +2918,24 @@ func (mr *MockStateMockRecorder) RegisterCAASUnit(arg0, arg1, arg2 any) *MockSta
 // MockStateRegisterCAASUnitCall is the typed call wrapper for RegisterCAASUnit.
 type MockStateRegisterCAASUnitCall = gomock.Call3_1[context.Context, string, application0.RegisterCAASUnitArg, error]
 
+// ReleaseRefreshRolloutUnits mocks base method.
+func (m *MockState) ReleaseRefreshRolloutUnits(ctx context.Context, appUUID application.UUID, units []unit.UUID, startedAt time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.releaseRefreshRolloutUnitsExpects, m.ctrl, m, "ReleaseRefreshRolloutUnits", ctx, appUUID, units, startedAt)
+}
+
+// ReleaseRefreshRolloutUnits indicates an expected call of ReleaseRefreshRolloutUnits.
+func (mr *MockStateMockRecorder) ReleaseRefreshRolloutUnits(ctx, appUUID, units, startedAt any) *MockStateReleaseRefreshRolloutUnitsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, application.UUID, []unit.UUID, time.Time, error](mr.mock.ctrl.T, mr.mock, "ReleaseRefreshRolloutUnits", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID), gomock.EnsureMatcher(units), gomock.EnsureMatcher(startedAt))
+	mr.releaseRefreshRolloutUnitsExpects = append(mr.releaseRefreshRolloutUnitsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateReleaseRefreshRolloutUnitsCall is the typed call wrapper for ReleaseRefreshRolloutUnits.
+type MockStateReleaseRefreshRolloutUnitsCall = gomock.Call4_1[context.Context, application.UUID, []unit.UUID, time.Time, error]
+
 // ResolveCharmDownload mocks base method.
 func (m *MockState) ResolveCharmDownload(ctx context.Context, charmID charm.ID, info application0.ResolvedCharmDownload) error {
 	m.ctrl.T.Helper()
@@ -2884,6 +3080,24 @@ func (mr *MockStateMockRecorder) SetDesiredApplicationScale(arg0, arg1, arg2 any
 // MockStateSetDesiredApplicationScaleCall is the typed call wrapper for SetDesiredApplicationScale.
 type MockStateSetDesiredApplicationScaleCall = gomock.Call3_1[context.Context, application.UUID, int, error]
 
+// SetRefreshRolloutStatus mocks base method.
+func (m *MockState) SetRefreshRolloutStatus(ctx context.Context, appUUID application.UUID, arg2 application0.RefreshRolloutStatus, message string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.setRefreshRolloutStatusExpects, m.ctrl, m, "SetRefreshRolloutStatus", ctx, appUUID, arg2, message)
+}
+
+// SetRefreshRolloutStatus indicates an expected call of SetRefreshRolloutStatus.
+func (mr *MockStateMockRecorder) SetRefreshRolloutStatus(ctx, appUUID, arg2, message any) *MockStateSetRefreshRolloutStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, application.UUID, application0.RefreshRolloutStatus, string, error](mr.mock.ctrl.T, mr.mock, "SetRefreshRolloutStatus", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(appUUID), gomock.EnsureMatcher(arg2), gomock.EnsureMatcher(message))
+	mr.setRefreshRolloutStatusExpects = append(mr.setRefreshRolloutStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateSetRefreshRolloutStatusCall is the typed call wrapper for SetRefreshRolloutStatus.
+type MockStateSetRefreshRolloutStatusCall = gomock.Call4_1[context.Context, application.UUID, application0.RefreshRolloutStatus, string, error]
+
 // SetUnitWorkloadVersion mocks base method.
 func (m *MockState) SetUnitWorkloadVersion(ctx context.Context, unitName unit.Name, version string) error {
 	m.ctrl.T.Helper()
@@ -3028,6 +3242,24 @@ func (mr *MockStateMockRecorder) UpdateApplicationScale(ctx, appUUID, delta any)
 // MockStateUpdateApplicationScaleCall is the typed call wrapper for UpdateApplicationScale.
 type MockStateUpdateApplicationScaleCall = gomock.Call3_2[context.Context, application.UUID, int, int, error]
 
+// UpdateApplicationsConfiguration mocks base method.
+func (m *MockState) UpdateApplicationsConfiguration(ctx context.Context, args []application0.UpdateApplicationConfigurationArg) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.updateApplicationsConfigurationExpects, m.ctrl, m, "UpdateApplicationsConfiguration", ctx, args)
+}
+
+// UpdateApplicationsConfiguration indicates an expected call of UpdateApplicationsConfiguration.
+func (mr *MockStateMockRecorder) UpdateApplicationsConfiguration(ctx, args any) *MockStateUpdateApplicationsConfigurationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, []application0.UpdateApplicationConfigurationArg, error](mr.mock.ctrl.T, mr.mock, "UpdateApplicationsConfiguration", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(args))
+	mr.updateApplicationsConfigurationExpects = append(mr.updateApplicationsConfigurationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateUpdateApplicationsConfigurationCall is the typed call wrapper for UpdateApplicationsConfiguration.
+type MockStateUpdateApplicationsConfigurationCall = gomock.Call2_1[context.Context, []application0.UpdateApplicationConfigurationArg, error]
+
 // UpdateCAASUnit mocks base method.
 func (m *MockState) UpdateCAASUnit(arg0 context.Context, arg1 unit.Name, arg2 application0.UpdateCAASUnitParams) error {
 	m.ctrl.T.Helper()
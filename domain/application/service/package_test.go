@@ -26,6 +26,7 @@ import (
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination leader_mock_test.go github.com/juju/juju/core/leadership Ensurer
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination caas_mock_test.go github.com/juju/juju/caas Application
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination storage_mock_test.go github.com/juju/juju/domain/application/service StorageService
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination signature_mock_test.go github.com/juju/juju/domain/application/service ControllerConfigService,ModelConfigService
 
 type baseSuite struct {
 	testhelpers.IsolationSuite
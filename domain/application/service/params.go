@@ -4,6 +4,8 @@
 package service
 
 import (
+	"github.com/juju/collections/set"
+
 	coreapplication "github.com/juju/juju/core/application"
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/constraints"
@@ -56,6 +58,11 @@ type AddApplicationArgs struct {
 	// DownloadInfo contains the download information for the charm.
 	DownloadInfo *domaincharm.DownloadInfo
 
+	// PublishedSignatures are the signatures published by the repository
+	// for a charm that is yet to be downloaded. They are verified against
+	// the model's charm signature policy before the application is created.
+	PublishedSignatures *domaincharm.PublishedSignatures
+
 	// ResolvedResources contains a list of ResolvedResource instances,
 	// which allows to define a revision and an origin for each resource.
 	ResolvedResources ResolvedResources
@@ -307,3 +314,30 @@ type ApplicationConfig struct {
 	CharmName         string
 	Principal         bool
 }
+
+// UpdateApplicationConfigurationParams holds changes to the configuration of
+// an application.
+type UpdateApplicationConfigurationParams struct {
+	// UUID is the UUID of the application.
+	UUID coreapplication.UUID
+
+	// ConfigUpdates holds the application config values to set.
+	ConfigUpdates map[string]string
+
+	// ConfigRemoves holds the application config keys to remove.
+	ConfigRemoves []string
+
+	// Constraints, if not nil, replaces the application constraints.
+	Constraints *constraints.Value
+
+	// EndpointBindings maps endpoint names to the names of the spaces to
+	// bind them to.
+	EndpointBindings map[string]network.SpaceName
+
+	// ExposeUpdates holds the expose settings to merge, indexed by
+	// endpoint name.
+	ExposeUpdates map[string]application.ExposedEndpoint
+
+	// ExposeRemoves holds the names of the endpoints to unexpose.
+	ExposeRemoves set.Strings
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/logger"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/providertracker"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/trace"
@@ -279,6 +280,72 @@ func (s *ProviderService) SetApplicationConstraints(
 	return s.st.SetApplicationConstraints(ctx, appID, constraints.DecodeConstraints(cons))
 }
 
+// UpdateApplicationsConfiguration applies changes to the configuration of
+// applications in a single transaction, so that either all of the changes are
+// made or none of them are. The changes are validated as they are by the
+// methods making each change on its own.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if an application doesn't exist.
+// - [applicationerrors.InvalidApplicationConfig] if application config is
+// not valid.
+// - [applicationerrors.InvalidApplicationConstraints] if constraints are not
+// valid.
+func (s *ProviderService) UpdateApplicationsConfiguration(ctx context.Context, params []UpdateApplicationConfigurationParams) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	args := make([]application.UpdateApplicationConfigurationArg, len(params))
+	for i, app := range params {
+		arg, err := s.makeUpdateApplicationConfigurationArg(ctx, app)
+		if err != nil {
+			return errors.Errorf("application %q: %w", app.UUID, err)
+		}
+		args[i] = arg
+	}
+	return s.st.UpdateApplicationsConfiguration(ctx, args)
+}
+
+func (s *ProviderService) makeUpdateApplicationConfigurationArg(
+	ctx context.Context, params UpdateApplicationConfigurationParams,
+) (application.UpdateApplicationConfigurationArg, error) {
+	if err := params.UUID.Validate(); err != nil {
+		return application.UpdateApplicationConfigurationArg{}, errors.Errorf("application UUID: %w", err)
+	}
+
+	arg := application.UpdateApplicationConfigurationArg{
+		UUID:          params.UUID,
+		ConfigRemoves: params.ConfigRemoves,
+		ExposeRemoves: params.ExposeRemoves,
+		EndpointBindings: transform.Map(params.EndpointBindings, func(k string, v network.SpaceName) (string, string) {
+			return k, v.String()
+		}),
+	}
+	if len(params.ConfigUpdates) > 0 {
+		config, trust, err := s.encodeApplicationConfig(ctx, params.UUID, params.ConfigUpdates)
+		if err != nil {
+			return application.UpdateApplicationConfigurationArg{}, errors.Capture(err)
+		}
+		arg.ConfigUpdates = config
+		arg.Settings.Trust = trust
+	}
+	if params.Constraints != nil {
+		if err := s.validateConstraints(ctx, *params.Constraints); err != nil {
+			return application.UpdateApplicationConfigurationArg{}, errors.Capture(err)
+		}
+		cons := constraints.DecodeConstraints(*params.Constraints)
+		arg.Constraints = &cons
+	}
+	if len(params.ExposeUpdates) > 0 {
+		exposed, err := s.validateExposeSettings(ctx, params.UUID, params.ExposeUpdates)
+		if err != nil {
+			return application.UpdateApplicationConfigurationArg{}, errors.Capture(err)
+		}
+		arg.ExposeUpdates = exposed
+	}
+	return arg, nil
+}
+
 // AddIAASUnits adds the specified units to the IAAS application, returning an
 // error satisfying [applicationerrors.ApplicationNotFound] if the
 // application doesn't exist. If no units are provided, it will return nil.
@@ -861,6 +928,11 @@ func (s *ProviderService) makeApplicationArg(
 	if err != nil {
 		return "", application.BaseAddApplicationArg{}, errors.Errorf("creating application args: %w", err)
 	}
+	if requiresDownload(origin.Source, args.CharmStoragePath) {
+		if appArg.Charm.Signature, err = s.verifyApplicationCharm(ctx, appArg.Charm, args.PublishedSignatures); err != nil {
+			return "", application.BaseAddApplicationArg{}, errors.Capture(err)
+		}
+	}
 	// We know that the charm name is valid, so we can use it as the application
 	// name if that is not provided.
 	if name == "" {
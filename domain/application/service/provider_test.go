@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/core/assumes"
 	corebase "github.com/juju/juju/core/base"
 	corecharm "github.com/juju/juju/core/charm"
+	charmtesting "github.com/juju/juju/core/charm/testing"
 	coreconstraints "github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
 	coreerrors "github.com/juju/juju/core/errors"
@@ -45,6 +46,7 @@ import (
 	"github.com/juju/juju/domain/status"
 	domainstorage "github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
@@ -60,6 +62,7 @@ func TestProviderServiceSuite(t *testing.T) {
 func (s *providerServiceSuite) TestCreateCAASApplication(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
@@ -230,6 +233,7 @@ func (s *providerServiceSuite) TestCreateCAASApplication(c *tc.C) {
 func (s *providerServiceSuite) TestCreateIAASApplicationWithApplicationStatus(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
@@ -296,6 +300,7 @@ func (s *providerServiceSuite) TestCreateIAASApplicationWithApplicationStatus(c
 func (s *providerServiceSuite) TestCreateIAASApplication(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
@@ -396,6 +401,7 @@ func (s *providerServiceSuite) TestCreateIAASApplication(c *tc.C) {
 func (s *providerServiceSuite) TestCreateIAASApplicationWithConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
@@ -520,6 +526,7 @@ func (s *providerServiceSuite) TestCreateIAASApplicationWithConfig(c *tc.C) {
 func (s *providerServiceSuite) TestCreateIAASApplicationMachineScope(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
@@ -948,6 +955,7 @@ func (s *providerServiceSuite) TestCreateIAASApplicationMachineScope(c *tc.C) {
 func (s *providerServiceSuite) TestCreateIAASApplicationPrecheckFailure(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
 	preCheckError := errors.New("precheck failure error")
@@ -1012,6 +1020,7 @@ func (s *providerServiceSuite) TestCreateIAASApplicationPrecheckFailure(c *tc.C)
 func (s *providerServiceSuite) TestCreateIAASApplicationPendingResources(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 	objectStoreUUID := objectstoretesting.GenObjectStoreUUID(c)
@@ -1447,6 +1456,7 @@ func (s *providerServiceSuite) TestCreateIAASApplicationWithInvalidApplicationCo
 func (s *providerServiceSuite) TestCreateIAASApplicationError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+	s.expectUnsignedCharm()
 
 	id := tc.Must(c, coreapplication.NewUUID)
 
@@ -1484,6 +1494,114 @@ func (s *providerServiceSuite) TestCreateIAASApplicationError(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, `creating application "foo": boom`)
 }
 
+func (s *providerServiceSuite) TestCreateIAASApplicationSignatureRejected(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+
+	s.provider.EXPECT().ConstraintsValidator(gomock.Any()).Return(nil, nil)
+	s.state.EXPECT().GetModelConstraints(gomock.Any()).Return(constraints.Constraints{}, nil)
+
+	// The application must not be created when the model's charm signature
+	// policy rejects the charm, rather than failing later in the async charm
+	// downloader.
+	published := &applicationcharm.PublishedSignatures{
+		Digest: "sha256:abc",
+		Signatures: []charmsignature.CosignSignature{{
+			Payload:   []byte("payload"),
+			Signature: []byte("sig"),
+		}},
+	}
+	s.charmStore.EXPECT().VerifyPublished(gomock.Any(), "sha256:abc", published.Signatures).
+		Return(charmsignature.Verification{}, charmsignature.ErrSignerNotTrusted)
+
+	s.charm.EXPECT().Meta().Return(&internalcharm.Meta{
+		Name: "foo",
+	}).MinTimes(1)
+	s.charm.EXPECT().Manifest().Return(&internalcharm.Manifest{Bases: []internalcharm.Base{{
+		Name:          "ubuntu",
+		Channel:       internalcharm.Channel{Risk: internalcharm.Beta},
+		Architectures: []string{"arm64"},
+	}}}).MinTimes(1)
+	s.charm.EXPECT().Actions().Return(&internalcharm.Actions{})
+	s.charm.EXPECT().Config().Return(&internalcharm.ConfigSpec{}).MinTimes(1)
+
+	_, err := s.service.CreateIAASApplication(c.Context(), "foo", s.charm, corecharm.Origin{
+		Source:   corecharm.OCI,
+		Platform: corecharm.MustParsePlatform("arm64/ubuntu/24.04"),
+	}, AddApplicationArgs{
+		ReferenceName: "foo",
+		DownloadInfo: &applicationcharm.DownloadInfo{
+			Provenance:         applicationcharm.ProvenanceDownload,
+			CharmhubIdentifier: "registry.example.com/charms/foo",
+			DownloadURL:        "oci://registry.example.com/charms/foo@sha256:def",
+			DownloadSize:       42,
+		},
+		PublishedSignatures: published,
+		Constraints:         coreconstraints.MustParse("arch=arm64"),
+	})
+	c.Assert(err, tc.ErrorIs, charmsignature.ErrSignerNotTrusted)
+}
+
+func (s *providerServiceSuite) TestCreateIAASApplicationUsesRecordedSignature(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	setCreateApplicationNoopStorageExpects(c, s.state, s.storageService)
+
+	id := tc.Must(c, coreapplication.NewUUID)
+	charmID := charmtesting.GenCharmID(c)
+	signature := &applicationcharm.Signature{
+		Signer:      "release-team",
+		Fingerprint: "SHA256:abc",
+	}
+
+	s.provider.EXPECT().ConstraintsValidator(gomock.Any()).Return(nil, nil)
+	s.state.EXPECT().GetModelConstraints(gomock.Any()).Return(constraints.Constraints{}, nil)
+
+	// The charm was verified when it was added, so the recorded signature is
+	// used rather than checking the charm as unsigned.
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 7, applicationcharm.OCISource).Return(charmID, nil)
+	s.state.EXPECT().GetCharmSignature(gomock.Any(), charmID).Return(signature, nil)
+	s.state.EXPECT().CreateIAASApplication(gomock.Any(), "foo", gomock.Any(), []application.AddIAASUnitArg{}).
+		DoAndReturn(func(_ context.Context, _ string, a application.AddIAASApplicationArg, _ []application.AddIAASUnitArg) (coreapplication.UUID, []coremachine.Name, error) {
+			c.Check(a.Charm.Signature, tc.DeepEquals, signature)
+			return id, nil, nil
+		})
+
+	s.charm.EXPECT().Meta().Return(&internalcharm.Meta{
+		Name: "foo",
+	}).MinTimes(1)
+	s.charm.EXPECT().Manifest().Return(&internalcharm.Manifest{Bases: []internalcharm.Base{{
+		Name:          "ubuntu",
+		Channel:       internalcharm.Channel{Risk: internalcharm.Beta},
+		Architectures: []string{"arm64"},
+	}}}).MinTimes(1)
+	s.charm.EXPECT().Actions().Return(&internalcharm.Actions{})
+	s.charm.EXPECT().Config().Return(&internalcharm.ConfigSpec{}).MinTimes(1)
+
+	_, err := s.service.CreateIAASApplication(c.Context(), "foo", s.charm, corecharm.Origin{
+		Source:   corecharm.OCI,
+		Platform: corecharm.MustParsePlatform("arm64/ubuntu/24.04"),
+		Revision: new(7),
+	}, AddApplicationArgs{
+		ReferenceName: "foo",
+		DownloadInfo: &applicationcharm.DownloadInfo{
+			Provenance:         applicationcharm.ProvenanceDownload,
+			CharmhubIdentifier: "registry.example.com/charms/foo",
+			DownloadURL:        "oci://registry.example.com/charms/foo@sha256:def",
+			DownloadSize:       42,
+		},
+		Constraints: coreconstraints.MustParse("arch=arm64"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// expectUnsignedCharm expects a charm that is yet to be downloaded, and
+// hasn't been added before, to be checked against the model's charm signature
+// policy as unsigned, and accepted.
+func (s *providerServiceSuite) expectUnsignedCharm() {
+	s.state.EXPECT().GetCharmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", applicationerrors.CharmNotFound)
+	s.charmStore.EXPECT().VerifyPublished(gomock.Any(), "", nil).Return(charmsignature.Verification{}, nil)
+}
+
 // func (s *providerServiceSuite) TestCreateIAASApplicationWithStorageBlock(c *tc.C) {
 //	defer s.setupMocks(c).Finish()
 //
@@ -3518,3 +3636,46 @@ func (s *providerServiceSuite) TestPrepareUnitAddStorageCAASNotSupported(c *tc.C
 	)
 	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
 }
+
+func (s *providerServiceSuite) TestUpdateApplicationsConfiguration(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetCharmConfigByApplicationUUID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{
+			"foo": {
+				Type: applicationcharm.OptionString,
+			},
+		},
+	}, nil)
+	s.state.EXPECT().UpdateApplicationsConfiguration(gomock.Any(), []application.UpdateApplicationConfigurationArg{{
+		UUID: appUUID,
+		ConfigUpdates: map[string]application.AddApplicationConfig{
+			"foo": {
+				Type:  applicationcharm.OptionString,
+				Value: "bar",
+			},
+		},
+		ConfigRemoves:    []string{"baz"},
+		EndpointBindings: map[string]string{"db": "alpha"},
+	}}).Return(nil)
+
+	err := s.service.UpdateApplicationsConfiguration(c.Context(), []UpdateApplicationConfigurationParams{{
+		UUID:             appUUID,
+		ConfigUpdates:    map[string]string{"foo": "bar"},
+		ConfigRemoves:    []string{"baz"},
+		EndpointBindings: map[string]network.SpaceName{"db": "alpha"},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestUpdateApplicationsConfigurationInvalidApplicationUUID(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	err := s.service.UpdateApplicationsConfiguration(c.Context(), []UpdateApplicationConfigurationParams{{
+		UUID: "!!!",
+	}})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
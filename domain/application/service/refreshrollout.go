@@ -0,0 +1,210 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juju/juju/core/trace"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/internal/errors"
+)
+
+// refreshRolloutRollback describes the reversion of an application's charm by
+// a failed refresh rollout.
+type refreshRolloutRollback struct {
+	channel *deployment.Channel
+	reason  string
+}
+
+// GetRefreshRollout returns the staged refresh of the named application.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application doesn't
+//     exist.
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     staged refresh.
+func (s *Service) GetRefreshRollout(ctx context.Context, appName string) (application.RefreshRollout, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	appUUID, err := s.st.GetApplicationUUIDByName(ctx, appName)
+	if err != nil {
+		return application.RefreshRollout{}, errors.Capture(err)
+	}
+	rollout, err := s.st.GetRefreshRollout(ctx, appUUID)
+	if err != nil {
+		return application.RefreshRollout{}, errors.Capture(err)
+	}
+	return rollout, nil
+}
+
+// GetAllRefreshRollouts returns the staged refreshes of all applications in
+// the model, keyed by application name.
+func (s *Service) GetAllRefreshRollouts(ctx context.Context) (map[string]application.RefreshRollout, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	rollouts, err := s.st.GetRefreshRollouts(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	result := make(map[string]application.RefreshRollout, len(rollouts))
+	for _, rollout := range rollouts {
+		result[rollout.ApplicationName] = rollout
+	}
+	return result, nil
+}
+
+// ContinueRefreshRollout resumes the paused staged refresh of the named
+// application. The units of the current batch are given the batch timeout
+// again to become active.
+//
+// The following errors may be returned:
+//   - [applicationerrors.ApplicationNotFound] if the application doesn't
+//     exist.
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     staged refresh.
+//   - [applicationerrors.RefreshRolloutNotPaused] if the staged refresh is not
+//     paused.
+func (s *Service) ContinueRefreshRollout(ctx context.Context, appName string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	appUUID, err := s.st.GetApplicationUUIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.ContinueRefreshRollout(ctx, appUUID, s.clock.Now()); err != nil {
+		return errors.Errorf("continuing refresh of application %q: %w", appName, err)
+	}
+	return nil
+}
+
+// GetUnitRefreshCharmLocator returns the locator of the charm the named unit
+// should be running. This is the charm of its application, unless a staged
+// refresh of the application has not yet released the unit.
+//
+// The following errors may be returned:
+//   - [coreunit.InvalidUnitName] if the unit name is invalid.
+//   - [applicationerrors.UnitNotFound] if the unit doesn't exist.
+func (s *Service) GetUnitRefreshCharmLocator(ctx context.Context, unitName coreunit.Name) (charm.CharmLocator, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := unitName.Validate(); err != nil {
+		return charm.CharmLocator{}, errors.Capture(err)
+	}
+	charmID, err := s.st.GetUnitRefreshCharmID(ctx, unitName)
+	if err != nil {
+		return charm.CharmLocator{}, errors.Capture(err)
+	}
+	locator, err := s.getCharmLocatorByID(ctx, charmID)
+	return locator, errors.Capture(err)
+}
+
+// AdvanceRefreshRollouts moves every rolling staged refresh in the model
+// forward. When all units released so far are refreshed and active, the next
+// batch of units is released, or the rollout is completed if there are none
+// left. If a released unit goes into error, or the batch doesn't become active
+// before its timeout, the rollout is either paused or rolled back to the
+// previous charm.
+func (s *ProviderService) AdvanceRefreshRollouts(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	rollouts, err := s.st.GetRefreshRollouts(ctx)
+	if err != nil {
+		return errors.Errorf("getting refresh rollouts: %w", err)
+	}
+	for _, rollout := range rollouts {
+		if rollout.Status != application.RefreshRolloutRolling {
+			continue
+		}
+		// A failure to advance one application shouldn't hold up the others.
+		if err := s.advanceRefreshRollout(ctx, rollout); err != nil {
+			s.logger.Errorf(ctx, "advancing refresh of application %q: %v", rollout.ApplicationName, err)
+		}
+	}
+	return nil
+}
+
+func (s *ProviderService) advanceRefreshRollout(ctx context.Context, rollout application.RefreshRollout) error {
+	units, err := s.st.GetRefreshRolloutUnits(ctx, rollout.ApplicationUUID)
+	if errors.Is(err, applicationerrors.RefreshRolloutNotFound) {
+		// The application was refreshed again since we looked.
+		return nil
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	var pending, unreleased []application.RefreshRolloutUnit
+	for _, unit := range units {
+		if !unit.Released {
+			// Units added since the rollout started are already running the
+			// new charm.
+			if !unit.Refreshed {
+				unreleased = append(unreleased, unit)
+			}
+			continue
+		}
+		if unit.AgentStatus == status.UnitAgentStatusError || unit.WorkloadStatus == status.WorkloadStatusError {
+			return s.failRefreshRollout(ctx, rollout, fmt.Sprintf("unit %s is in error", unit.Name))
+		}
+		if !unit.Refreshed || unit.WorkloadStatus != status.WorkloadStatusActive {
+			pending = append(pending, unit)
+		}
+	}
+
+	now := s.clock.Now()
+	if len(pending) > 0 {
+		if now.Before(rollout.BatchStartedAt.Add(rollout.BatchTimeout)) {
+			return nil
+		}
+		names := make([]string, len(pending))
+		for i, unit := range pending {
+			names[i] = unit.Name.String()
+		}
+		return s.failRefreshRollout(ctx, rollout, fmt.Sprintf(
+			"%s not active after %s", strings.Join(names, ", "), rollout.BatchTimeout,
+		))
+	}
+
+	if len(unreleased) == 0 {
+		s.logger.Infof(ctx, "refresh of application %q to %s completed",
+			rollout.ApplicationName, rollout.Charm.Name)
+		return s.st.DeleteRefreshRollout(ctx, rollout.ApplicationUUID)
+	}
+
+	batch := unreleased[:min(rollout.UnitsPerBatch(len(units)), len(unreleased))]
+	uuids := make([]coreunit.UUID, len(batch))
+	for i, unit := range batch {
+		uuids[i] = unit.UUID
+	}
+	s.logger.Debugf(ctx, "releasing %d unit(s) of application %q to refresh", len(uuids), rollout.ApplicationName)
+	return s.st.ReleaseRefreshRolloutUnits(ctx, rollout.ApplicationUUID, uuids, now)
+}
+
+func (s *ProviderService) failRefreshRollout(ctx context.Context, rollout application.RefreshRollout, reason string) error {
+	if !rollout.RollbackOnError {
+		s.logger.Warningf(ctx, "pausing refresh of application %q: %s", rollout.ApplicationName, reason)
+		return s.st.SetRefreshRolloutStatus(ctx, rollout.ApplicationUUID, application.RefreshRolloutPaused, reason)
+	}
+
+	s.logger.Warningf(ctx, "rolling back refresh of application %q: %s", rollout.ApplicationName, reason)
+	return s.setApplicationCharm(ctx, rollout.ApplicationName, rollout.PreviousCharm, application.SetCharmParams{
+		// The previous charm was already running on the application's base.
+		ForceBase: true,
+	}, &refreshRolloutRollback{
+		channel: rollout.PreviousChannel,
+		reason:  reason,
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	coreapplication "github.com/juju/juju/core/application"
+	corecharm "github.com/juju/juju/core/charm"
+	charmtesting "github.com/juju/juju/core/charm/testing"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/model"
+	coreunit "github.com/juju/juju/core/unit"
+	coreunittesting "github.com/juju/juju/core/unit/testing"
+	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/application/internal"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/status"
+)
+
+type refreshRolloutSuite struct {
+	baseSuite
+}
+
+func TestRefreshRolloutSuite(t *testing.T) {
+	tc.Run(t, &refreshRolloutSuite{})
+}
+
+func (s *refreshRolloutSuite) TestSetApplicationCharmRolloutNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationCharm(c.Context(), "foo", applicationcharm.CharmLocator{}, application.SetCharmParams{
+		Rollout: &application.RefreshRolloutParams{
+			BatchSize:    1,
+			BatchPercent: 10,
+			BatchTimeout: time.Minute,
+		},
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsReleasesBatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	units := []application.RefreshRolloutUnit{
+		s.unit(c, "foo/0", true, true, status.WorkloadStatusActive),
+		s.unit(c, "foo/1", false, false, status.WorkloadStatusActive),
+		s.unit(c, "foo/2", false, false, status.WorkloadStatusActive),
+		s.unit(c, "foo/3", false, false, status.WorkloadStatusActive),
+	}
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+	s.state.EXPECT().GetRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID).Return(units, nil)
+	s.state.EXPECT().ReleaseRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID,
+		[]coreunit.UUID{units[1].UUID, units[2].UUID}, s.clock.Now()).Return(nil)
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsWaitsForBatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	rollout.BatchStartedAt = s.clock.Now().Add(-time.Minute)
+	units := []application.RefreshRolloutUnit{
+		s.unit(c, "foo/0", true, true, status.WorkloadStatusMaintenance),
+		s.unit(c, "foo/1", false, false, status.WorkloadStatusActive),
+	}
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+	s.state.EXPECT().GetRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID).Return(units, nil)
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsCompletes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	units := []application.RefreshRolloutUnit{
+		s.unit(c, "foo/0", true, true, status.WorkloadStatusActive),
+		// A unit added since the rollout started runs the new charm.
+		s.unit(c, "foo/1", false, true, status.WorkloadStatusWaiting),
+	}
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+	s.state.EXPECT().GetRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID).Return(units, nil)
+	s.state.EXPECT().DeleteRefreshRollout(gomock.Any(), rollout.ApplicationUUID).Return(nil)
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsSkipsPaused(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	rollout.Status = application.RefreshRolloutPaused
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsPausesOnError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	units := []application.RefreshRolloutUnit{
+		s.unit(c, "foo/0", true, true, status.WorkloadStatusError),
+		s.unit(c, "foo/1", false, false, status.WorkloadStatusActive),
+	}
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+	s.state.EXPECT().GetRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID).Return(units, nil)
+	s.state.EXPECT().SetRefreshRolloutStatus(gomock.Any(), rollout.ApplicationUUID,
+		application.RefreshRolloutPaused, "unit foo/0 is in error").Return(nil)
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsPausesOnTimeout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	rollout.BatchStartedAt = s.clock.Now().Add(-time.Hour)
+	units := []application.RefreshRolloutUnit{
+		s.unit(c, "foo/0", true, false, status.WorkloadStatusActive),
+		s.unit(c, "foo/1", false, false, status.WorkloadStatusActive),
+	}
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+	s.state.EXPECT().GetRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID).Return(units, nil)
+	s.state.EXPECT().SetRefreshRolloutStatus(gomock.Any(), rollout.ApplicationUUID,
+		application.RefreshRolloutPaused, "foo/0 not active after 5m0s").Return(nil)
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestAdvanceRefreshRolloutsRollsBack(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	rollout.RollbackOnError = true
+	units := []application.RefreshRolloutUnit{
+		s.unit(c, "foo/0", true, true, status.WorkloadStatusActive),
+	}
+	units[0].AgentStatus = status.UnitAgentStatusError
+	previousCharmID := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+	s.state.EXPECT().GetRefreshRolloutUnits(gomock.Any(), rollout.ApplicationUUID).Return(units, nil)
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(rollout.ApplicationUUID, nil)
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 1, applicationcharm.CharmHubSource).Return(previousCharmID, nil)
+	s.state.EXPECT().GetCharmMetadataStorage(gomock.Any(), previousCharmID).Return(map[string]applicationcharm.Storage{}, nil)
+	s.state.EXPECT().GetCharmByApplicationUUID(gomock.Any(), rollout.ApplicationUUID).Return(makeCharmWithStorage(nil), nil)
+	s.state.EXPECT().GetModelType(gomock.Any()).Return(model.IAAS, nil)
+	s.storageService.EXPECT().GetApplicationStorageDirectives(gomock.Any(), rollout.ApplicationUUID).Return([]internal.StorageDirective{}, nil)
+	s.storageService.EXPECT().ReconcileStorageDirectivesAgainstCharmStorage(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, nil)
+	s.storageService.EXPECT().ValidateApplicationStorageDirectiveOverrides(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	s.state.EXPECT().SetApplicationCharm(gomock.Any(), rollout.ApplicationUUID, previousCharmID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ coreapplication.UUID, _ corecharm.ID, params application.SetCharmStateParams) error {
+			c.Check(params.Channel, tc.DeepEquals, rollout.PreviousChannel)
+			c.Check(params.Rollout, tc.IsNil)
+			c.Assert(params.RolloutRollback, tc.NotNil)
+			c.Check(*params.RolloutRollback, tc.Equals, "unit foo/0 is in error")
+			return nil
+		})
+
+	err := s.service.AdvanceRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestContinueRefreshRollout(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().ContinueRefreshRollout(gomock.Any(), appUUID, s.clock.Now()).Return(nil)
+
+	err := s.service.ContinueRefreshRollout(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshRolloutSuite) TestContinueRefreshRolloutNotPaused(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := tc.Must(c, coreapplication.NewUUID)
+	s.state.EXPECT().GetApplicationUUIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().ContinueRefreshRollout(gomock.Any(), appUUID, s.clock.Now()).
+		Return(applicationerrors.RefreshRolloutNotPaused)
+
+	err := s.service.ContinueRefreshRollout(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, applicationerrors.RefreshRolloutNotPaused)
+}
+
+func (s *refreshRolloutSuite) TestGetAllRefreshRollouts(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	rollout := s.rollout(c)
+	s.state.EXPECT().GetRefreshRollouts(gomock.Any()).Return([]application.RefreshRollout{rollout}, nil)
+
+	rollouts, err := s.service.GetAllRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rollouts, tc.DeepEquals, map[string]application.RefreshRollout{"foo": rollout})
+}
+
+func (s *refreshRolloutSuite) TestGetUnitRefreshCharmLocator(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	charmID := charmtesting.GenCharmID(c)
+	locator := applicationcharm.CharmLocator{
+		Name:     "foo",
+		Revision: 1,
+		Source:   applicationcharm.CharmHubSource,
+	}
+	s.state.EXPECT().GetUnitRefreshCharmID(gomock.Any(), coreunit.Name("foo/0")).Return(charmID, nil)
+	s.state.EXPECT().GetCharmLocatorByCharmID(gomock.Any(), charmID).Return(locator, nil)
+
+	obtained, err := s.service.GetUnitRefreshCharmLocator(c.Context(), "foo/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, locator)
+}
+
+func (s *refreshRolloutSuite) TestGetUnitRefreshCharmLocatorInvalidName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetUnitRefreshCharmLocator(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, coreunit.InvalidUnitName)
+}
+
+func (s *refreshRolloutSuite) rollout(c *tc.C) application.RefreshRollout {
+	return application.RefreshRollout{
+		RefreshRolloutParams: application.RefreshRolloutParams{
+			BatchPercent: 50,
+			BatchTimeout: 5 * time.Minute,
+		},
+		ApplicationUUID: tc.Must(c, coreapplication.NewUUID),
+		ApplicationName: "foo",
+		Status:          application.RefreshRolloutRolling,
+		Charm: applicationcharm.CharmLocator{
+			Name:     "foo",
+			Revision: 2,
+			Source:   applicationcharm.CharmHubSource,
+		},
+		PreviousCharm: applicationcharm.CharmLocator{
+			Name:     "foo",
+			Revision: 1,
+			Source:   applicationcharm.CharmHubSource,
+		},
+		PreviousChannel: &deployment.Channel{
+			Track: "latest",
+			Risk:  deployment.RiskStable,
+		},
+		BatchStartedAt: s.clock.Now(),
+	}
+}
+
+func (s *refreshRolloutSuite) unit(
+	c *tc.C, name coreunit.Name, released, refreshed bool, workloadStatus status.WorkloadStatusType,
+) application.RefreshRolloutUnit {
+	return application.RefreshRolloutUnit{
+		UUID:           coreunittesting.GenUnitUUID(c),
+		Name:           name,
+		Released:       released,
+		Refreshed:      refreshed,
+		AgentStatus:    status.UnitAgentStatusIdle,
+		WorkloadStatus: workloadStatus,
+	}
+}
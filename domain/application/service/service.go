@@ -439,7 +439,7 @@ type indexedChanged struct {
 }
 
 // WatchApplication watches for changes to the specified application in the
-// application table.
+// application table, and to the units released by a staged refresh of it.
 // If the application does not exist an error satisfying
 // [applicationerrors.NotFound] will be returned.
 func (s *WatchableService) WatchApplication(ctx context.Context, name string) (watcher.NotifyWatcher, error) {
@@ -458,6 +458,13 @@ func (s *WatchableService) WatchApplication(ctx context.Context, name string) (w
 			changestream.All,
 			eventsource.EqualsPredicate(uuid.String()),
 		),
+		// Units waiting on a staged refresh learn of their release to the
+		// new charm through the application watcher.
+		eventsource.PredicateFilter(
+			s.st.NamespaceForWatchApplicationRefreshRollout(),
+			changestream.All,
+			eventsource.EqualsPredicate(uuid.String()),
+		),
 	)
 }
 
@@ -862,8 +869,10 @@ func encodeCharmSource(source corecharm.Source) (charm.CharmSource, error) {
 		return charm.LocalSource, nil
 	case corecharm.CharmHub:
 		return charm.CharmHubSource, nil
+	case corecharm.OCI:
+		return charm.OCISource, nil
 	default:
-		return "", errors.Errorf("unknown source %q, expected local or charmhub: %w", source, applicationerrors.CharmSourceNotValid)
+		return "", errors.Errorf("unknown source %q, expected local, charmhub or oci: %w", source, applicationerrors.CharmSourceNotValid)
 	}
 }
 
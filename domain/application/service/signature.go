@@ -0,0 +1,61 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/charmsignature"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ControllerConfigService provides the controller config, which holds the
+// keys trusted to sign charms.
+type ControllerConfigService interface {
+	// ControllerConfig returns the config values for the controller.
+	ControllerConfig(ctx context.Context) (controller.Config, error)
+}
+
+// ModelConfigService provides the model config, which holds the model's
+// charm signature policy.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(ctx context.Context) (*config.Config, error)
+}
+
+// SignaturePolicyGetter provides the model's charm signature policy and the
+// controller's trusted signing keys, for verifying charm and resource
+// archives.
+type SignaturePolicyGetter struct {
+	controllerConfig ControllerConfigService
+	modelConfig      ModelConfigService
+}
+
+// NewSignaturePolicyGetter returns a new SignaturePolicyGetter.
+func NewSignaturePolicyGetter(controllerConfig ControllerConfigService, modelConfig ModelConfigService) *SignaturePolicyGetter {
+	return &SignaturePolicyGetter{
+		controllerConfig: controllerConfig,
+		modelConfig:      modelConfig,
+	}
+}
+
+// SignaturePolicy returns the model's charm signature policy and the
+// controller's trusted signing keys.
+func (g *SignaturePolicyGetter) SignaturePolicy(ctx context.Context) (charmsignature.Policy, []charmsignature.TrustedKey, error) {
+	modelConfig, err := g.modelConfig.ModelConfig(ctx)
+	if err != nil {
+		return "", nil, errors.Errorf("getting model config: %w", err)
+	}
+	controllerConfig, err := g.controllerConfig.ControllerConfig(ctx)
+	if err != nil {
+		return "", nil, errors.Errorf("getting controller config: %w", err)
+	}
+	keys, err := charmsignature.ParseTrustedKeys(controllerConfig.CharmSigningKeys())
+	if err != nil {
+		return "", nil, errors.Errorf("parsing charm signing keys: %w", err)
+	}
+	return modelConfig.CharmSignaturePolicy(), keys, nil
+}
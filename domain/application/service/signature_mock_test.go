@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/application/service (interfaces: ControllerConfigService,ModelConfigService)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination signature_mock_test.go github.com/juju/juju/domain/application/service ControllerConfigService,ModelConfigService
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	controller "github.com/juju/juju/controller"
+	config "github.com/juju/juju/environs/config"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock                    *MockControllerConfigService
+	controllerConfigExpects []*gomock.Call1_2[context.Context, controller.Config, error]
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(ctx context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.controllerConfigExpects, m.ctrl, m, "ControllerConfig", ctx)
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(ctx any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, controller.Config, error](mr.mock.ctrl.T, mr.mock, "ControllerConfig", gomock.EnsureMatcher(ctx))
+	mr.controllerConfigExpects = append(mr.controllerConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerConfigServiceControllerConfigCall is the typed call wrapper for ControllerConfig.
+type MockControllerConfigServiceControllerConfigCall = gomock.Call1_2[context.Context, controller.Config, error]
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock               *MockModelConfigService
+	modelConfigExpects []*gomock.Call1_2[context.Context, *config.Config, error]
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(ctx context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.modelConfigExpects, m.ctrl, m, "ModelConfig", ctx)
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(ctx any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, *config.Config, error](mr.mock.ctrl.T, mr.mock, "ModelConfig", gomock.EnsureMatcher(ctx))
+	mr.modelConfigExpects = append(mr.modelConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelConfigServiceModelConfigCall is the typed call wrapper for ModelConfig.
+type MockModelConfigServiceModelConfigCall = gomock.Call1_2[context.Context, *config.Config, error]
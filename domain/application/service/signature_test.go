@@ -0,0 +1,63 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/charmsignature"
+	"github.com/juju/juju/internal/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type signaturePolicyGetterSuite struct {
+	controllerConfig *MockControllerConfigService
+	modelConfig      *MockModelConfigService
+}
+
+func TestSignaturePolicyGetterSuite(t *testing.T) {
+	tc.Run(t, &signaturePolicyGetterSuite{})
+}
+
+const releaseKey = `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAsTPebwnCM4FvJFZXZZ+8TvjK9VhDSrOgTNXi8Rb+24 release-team`
+
+func (s *signaturePolicyGetterSuite) TestSignaturePolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.CharmSignaturePolicyKey: "enforce",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	s.modelConfig.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.controllerConfig.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.CharmSigningKeys: releaseKey,
+	}, nil)
+
+	policy, keys, err := NewSignaturePolicyGetter(s.controllerConfig, s.modelConfig).SignaturePolicy(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.Equals, charmsignature.PolicyEnforce)
+	c.Assert(keys, tc.HasLen, 1)
+	c.Check(keys[0].Name, tc.Equals, "release-team")
+}
+
+func (s *signaturePolicyGetterSuite) TestSignaturePolicyModelConfigError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelConfig.EXPECT().ModelConfig(gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, _, err := NewSignaturePolicyGetter(s.controllerConfig, s.modelConfig).SignaturePolicy(c.Context())
+	c.Assert(err, tc.ErrorMatches, "getting model config: boom")
+}
+
+func (s *signaturePolicyGetterSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.controllerConfig = NewMockControllerConfigService(ctrl)
+	s.modelConfig = NewMockModelConfigService(ctrl)
+	return ctrl
+}
@@ -408,7 +408,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 		func(ctx context.Context) (service.CloudInfoProvider, error) {
 			return cloudInfoProvider{}, nil
 		},
-		nil,
+		domaintesting.NewCharmStore(nil),
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
 		modelUUID,
 		clock.WallClock,
@@ -1827,6 +1827,12 @@ WHERE  uuid = $entityUUID.uuid
 			return errors.Capture(err)
 		}
 
+		// The refresh rollout records the previous charm of the application,
+		// so it must be set before the charm is updated.
+		if err := st.setRefreshRollout(ctx, tx, appID, chID, params); err != nil {
+			return errors.Errorf("setting refresh rollout: %w", err)
+		}
+
 		if err := tx.Query(ctx, setAppCharmStmt, appAndCharmPair).Run(); err != nil {
 			return errors.Errorf("setting application charm: %w", err)
 		}
@@ -2128,8 +2134,8 @@ WHERE v.application_uuid = $entityUUID.uuid
 		return application.CharmDownloadInfo{}, errors.Errorf("reserving charm download for application %q: %w", appID, err)
 	}
 
-	// We can only reserve charms from CharmHub charms.
-	if source := charm.CharmSource(info.Source); source != charm.CharmHubSource {
+	// We can only reserve charms from CharmHub or OCI registry charms.
+	if source := charm.CharmSource(info.Source); !source.IsDownloadable() {
 		return application.CharmDownloadInfo{}, errors.Errorf("unexpected charm source for %q: %w", appID, applicationerrors.CharmProvenanceNotValid)
 	}
 
@@ -2222,8 +2228,8 @@ WHERE  uuid = $entityUUID.uuid;`
 }
 
 // GetApplicationsForRevisionUpdater returns all the applications for the
-// revision updater. This will only return charmhub charms, for applications
-// that are alive.
+// revision updater. This will only return charmhub and OCI registry charms,
+// for applications that are alive.
 // This will return an empty slice if there are no applications.
 func (st *State) GetApplicationsForRevisionUpdater(ctx context.Context) ([]application.RevisionUpdaterApplication, error) {
 	db, err := st.DB(ctx)
@@ -2298,12 +2304,17 @@ FROM   v_revision_updater_application_unit
 		}
 		osType := deployment.OSType(r.PlatformOSID.V)
 
+		source, err := decodeRevisionUpdaterCharmSource(r.CharmSourceID)
+		if err != nil {
+			return application.RevisionUpdaterApplication{}, errors.Capture(err)
+		}
+
 		return application.RevisionUpdaterApplication{
 			Name: r.Name,
 			CharmLocator: charm.CharmLocator{
 				Name:         r.ReferenceName,
 				Revision:     r.Revision,
-				Source:       charm.CharmHubSource,
+				Source:       source,
 				Architecture: charmArch,
 			},
 			Origin: application.Origin{
@@ -2325,6 +2336,17 @@ FROM   v_revision_updater_application_unit
 	})
 }
 
+func decodeRevisionUpdaterCharmSource(id int) (charm.CharmSource, error) {
+	switch id {
+	case 1:
+		return charm.CharmHubSource, nil
+	case 3:
+		return charm.OCISource, nil
+	default:
+		return "", errors.Errorf("unsupported charm source id %d for revision updater", id)
+	}
+}
+
 // GetApplicationConfigAndSettings returns the application config and settings
 // attributes for the application UUID.
 //
@@ -2494,6 +2516,25 @@ func (st *State) UpdateApplicationConfigAndSettings(
 		return errors.Capture(err)
 	}
 
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+		return st.updateApplicationConfigAndSettings(ctx, tx, appID, config, settings)
+	})
+	if err != nil {
+		return errors.Errorf("updating application config: %w", err)
+	}
+	return nil
+}
+
+func (st *State) updateApplicationConfigAndSettings(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appID coreapplication.UUID,
+	config map[string]application.AddApplicationConfig,
+	settings application.UpdateApplicationSettingsArg,
+) error {
 	ident := entityUUID{UUID: appID.String()}
 
 	upsertQuery := `
@@ -2532,33 +2573,23 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 		})
 	}
 
-	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
-			return errors.Capture(err)
-		}
-
-		if len(upserts) > 0 {
-			if err := tx.Query(ctx, upsertStmt, upserts).Run(); err != nil {
-				return errors.Errorf("upserting config: %w", err)
-			}
+	if len(upserts) > 0 {
+		if err := tx.Query(ctx, upsertStmt, upserts).Run(); err != nil {
+			return errors.Errorf("upserting config: %w", err)
 		}
+	}
 
-		if settings.Trust != nil {
-			if err := tx.Query(ctx, upsertSettingsStmt, setApplicationSettings{
-				ApplicationUUID: appID.String(),
-				Trust:           *settings.Trust,
-			}).Run(); err != nil {
-				return errors.Errorf("upserting settings: %w", err)
-			}
+	if settings.Trust != nil {
+		if err := tx.Query(ctx, upsertSettingsStmt, setApplicationSettings{
+			ApplicationUUID: appID.String(),
+			Trust:           *settings.Trust,
+		}).Run(); err != nil {
+			return errors.Errorf("upserting settings: %w", err)
 		}
+	}
 
-		if err := st.updateConfigHash(ctx, tx, ident); err != nil {
-			return errors.Errorf("refreshing config hash: %w", err)
-		}
-		return nil
-	})
-	if err != nil {
-		return errors.Errorf("updating application config: %w", err)
+	if err := st.updateConfigHash(ctx, tx, ident); err != nil {
+		return errors.Errorf("refreshing config hash: %w", err)
 	}
 	return nil
 }
@@ -2573,6 +2604,18 @@ func (st *State) UnsetApplicationConfigKeys(ctx context.Context, appID coreappli
 		return errors.Capture(err)
 	}
 
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return st.unsetApplicationConfigKeys(ctx, tx, appID, keys)
+	})
+	if err != nil {
+		return errors.Errorf("removing application config: %w", err)
+	}
+	return nil
+}
+
+func (st *State) unsetApplicationConfigKeys(
+	ctx context.Context, tx *sqlair.TX, appID coreapplication.UUID, keys []string,
+) error {
 	ident := entityUUID{UUID: appID.String()}
 
 	// This isn't ideal, as we could request this in one query, but we need to
@@ -2615,35 +2658,29 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	}
 	removeTrust := slices.Contains(keys, "trust")
 
-	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if err := tx.Query(ctx, appStmt, ident).Get(&ident); errors.Is(err, sqlair.ErrNoRows) {
-			return applicationerrors.ApplicationNotFound
-		} else if err != nil {
-			return errors.Errorf("querying application: %w", err)
-		}
-
-		if err := tx.Query(ctx, deleteStmt, removals, ident).Run(); internaldatabase.IsErrConstraintForeignKey(err) {
-			return applicationerrors.ApplicationNotFound
-		} else if err != nil {
-			return errors.Errorf("deleting config: %w", err)
-		}
-
-		if !removeTrust {
-			return nil
-		}
+	if err := tx.Query(ctx, appStmt, ident).Get(&ident); errors.Is(err, sqlair.ErrNoRows) {
+		return applicationerrors.ApplicationNotFound
+	} else if err != nil {
+		return errors.Errorf("querying application: %w", err)
+	}
 
-		if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
-			ApplicationUUID: ident.UUID,
-			Trust:           false,
-		}).Run(); err != nil {
-			return errors.Errorf("deleting setting: %w", err)
-		}
+	if err := tx.Query(ctx, deleteStmt, removals, ident).Run(); internaldatabase.IsErrConstraintForeignKey(err) {
+		return applicationerrors.ApplicationNotFound
+	} else if err != nil {
+		return errors.Errorf("deleting config: %w", err)
+	}
 
+	if !removeTrust {
 		return nil
-	})
-	if err != nil {
-		return errors.Errorf("removing application config: %w", err)
 	}
+
+	if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
+		ApplicationUUID: ident.UUID,
+		Trust:           false,
+	}).Run(); err != nil {
+		return errors.Errorf("deleting setting: %w", err)
+	}
+
 	return nil
 }
 
@@ -840,6 +840,48 @@ func (s *State) GetCharmDownloadInfo(ctx context.Context, id corecharm.ID) (*cha
 	return downloadInfo, nil
 }
 
+// GetCharmSignature returns the verified signature of the charm using the
+// charm ID. Nil is returned if the charm archive was not signed by a trusted
+// key.
+func (s *State) GetCharmSignature(ctx context.Context, id corecharm.ID) (*charm.Signature, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: id.String()}
+
+	query := `
+SELECT &charmSignature.*
+FROM charm_signature
+WHERE charm_uuid = $entityUUID.uuid;
+`
+	stmt, err := s.Prepare(query, charmSignature{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing query: %w", err)
+	}
+
+	var signature *charm.Signature
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var result charmSignature
+		err := tx.Query(ctx, stmt, ident).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		signature = &charm.Signature{
+			Signer:      result.Signer,
+			Fingerprint: result.KeyFingerprint,
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Errorf("getting charm signature: %w", err)
+	}
+
+	return signature, nil
+}
+
 // GetAvailableCharmArchiveSHA256 returns the SHA256 hash of the charm archive
 // for the given charm id. If the charm is not available,
 // [applicationerrors.CharmNotResolved] is returned. Returns
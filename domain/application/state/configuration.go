@@ -0,0 +1,81 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/internal/errors"
+)
+
+// UpdateApplicationsConfiguration applies the changes to the configuration of
+// the applications in a single transaction, so that either all of the changes
+// are made or none of them are.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if an application doesn't exist.
+// - [applicationerrors.ApplicationIsDead] if an application is dead.
+func (st *State) UpdateApplicationsConfiguration(ctx context.Context, args []application.UpdateApplicationConfigurationArg) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, app := range args {
+			if err := st.updateApplicationConfiguration(ctx, tx, app); err != nil {
+				return errors.Errorf("updating application %q: %w", app.UUID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (st *State) updateApplicationConfiguration(
+	ctx context.Context, tx *sqlair.TX, arg application.UpdateApplicationConfigurationArg,
+) error {
+	if err := st.checkApplicationNotDead(ctx, tx, arg.UUID); err != nil {
+		return errors.Capture(err)
+	}
+
+	if len(arg.ConfigRemoves) > 0 {
+		if err := st.unsetApplicationConfigKeys(ctx, tx, arg.UUID, arg.ConfigRemoves); err != nil {
+			return errors.Errorf("unsetting config: %w", err)
+		}
+	}
+	// Setting the config refreshes the config hash, which must also change
+	// when only keys are removed.
+	if len(arg.ConfigUpdates) > 0 || arg.Settings.Trust != nil || len(arg.ConfigRemoves) > 0 {
+		if err := st.updateApplicationConfigAndSettings(ctx, tx, arg.UUID, arg.ConfigUpdates, arg.Settings); err != nil {
+			return errors.Errorf("setting config: %w", err)
+		}
+	}
+
+	if arg.Constraints != nil {
+		if err := st.setApplicationConstraints(ctx, tx, arg.UUID.String(), *arg.Constraints); err != nil {
+			return errors.Errorf("setting constraints: %w", err)
+		}
+	}
+
+	if len(arg.EndpointBindings) > 0 {
+		if err := st.mergeApplicationEndpointBindings(ctx, tx, arg.UUID.String(), arg.EndpointBindings, false); err != nil {
+			return errors.Errorf("setting endpoint bindings: %w", err)
+		}
+	}
+
+	if len(arg.ExposeRemoves) > 0 {
+		if err := st.unsetExposedEndpoints(ctx, tx, arg.UUID, arg.ExposeRemoves.Values()...); err != nil {
+			return errors.Errorf("unexposing endpoints: %w", err)
+		}
+	}
+	if len(arg.ExposeUpdates) > 0 {
+		if err := st.mergeExposeSettings(ctx, tx, arg.UUID, arg.ExposeUpdates); err != nil {
+			return errors.Errorf("exposing endpoints: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strings"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/quota"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/constraints"
+	"github.com/juju/juju/domain/life"
+)
+
+func (s *applicationStateSuite) TestUpdateApplicationsConfiguration(c *tc.C) {
+	id := s.createIAASApplication(c, "foo", life.Alive)
+
+	mem := uint64(4096)
+	err := s.state.UpdateApplicationsConfiguration(c.Context(), []application.UpdateApplicationConfigurationArg{{
+		UUID: id,
+		ConfigUpdates: map[string]application.AddApplicationConfig{
+			"key": {
+				Type:  charm.OptionString,
+				Value: "value",
+			},
+		},
+		Constraints: &constraints.Constraints{Mem: &mem},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	config, _, err := s.state.GetApplicationConfigAndSettings(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config, tc.DeepEquals, map[string]application.ApplicationConfig{
+		"key": {
+			Type:  charm.OptionString,
+			Value: new("value"),
+		},
+	})
+
+	cons, err := s.state.GetApplicationConstraints(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cons.Mem, tc.DeepEquals, &mem)
+}
+
+func (s *applicationStateSuite) TestUpdateApplicationsConfigurationRollsBackOnError(c *tc.C) {
+	foo := s.createIAASApplication(c, "foo", life.Alive)
+	bar := s.createIAASApplication(c, "bar", life.Alive)
+
+	err := s.state.UpdateApplicationsConfiguration(c.Context(), []application.UpdateApplicationConfigurationArg{{
+		UUID: foo,
+		ConfigUpdates: map[string]application.AddApplicationConfig{
+			"key": {
+				Type:  charm.OptionString,
+				Value: "value",
+			},
+		},
+	}, {
+		UUID: bar,
+		ConfigUpdates: map[string]application.AddApplicationConfig{
+			"key": {
+				Type:  charm.OptionString,
+				Value: strings.Repeat("a", quota.MaxApplicationConfigSize+1),
+			},
+		},
+	}})
+	c.Assert(err, tc.ErrorIs, coreerrors.QuotaLimitExceeded)
+
+	// None of the changes are applied.
+	config, _, err := s.state.GetApplicationConfigAndSettings(c.Context(), foo)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config, tc.DeepEquals, map[string]application.ApplicationConfig{})
+}
@@ -229,29 +229,35 @@ func (st *State) MergeExposeSettings(ctx context.Context, appID coreapplication.
 	}
 
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		// First unset the exposed endpoints that have been provided as input.
-		endpoints := make([]string, 0, len(exposedEndpoints))
-		for endpoint := range exposedEndpoints {
-			endpoints = append(endpoints, endpoint)
-		}
-		if err := st.unsetExposedEndpoints(ctx, tx, appID, endpoints...); err != nil {
-			return errors.Capture(err)
-		}
-
-		for endpoint, exposedEndpoint := range exposedEndpoints {
-			if err := st.upsertExposedCIDRs(ctx, tx, appID, endpoint, exposedEndpoint.ExposeToCIDRs); err != nil {
-				return errors.Capture(err)
-			}
-			if err := st.upsertExposedSpaces(ctx, tx, appID, endpoint, exposedEndpoint.ExposeToSpaceIDs); err != nil {
-				return errors.Capture(err)
-			}
-		}
-		return nil
+		return st.mergeExposeSettings(ctx, tx, appID, exposedEndpoints)
 	})
 
 	return errors.Capture(err)
 }
 
+func (st *State) mergeExposeSettings(
+	ctx context.Context, tx *sqlair.TX, appID coreapplication.UUID, exposedEndpoints map[string]application.ExposedEndpoint,
+) error {
+	// First unset the exposed endpoints that have been provided as input.
+	endpoints := make([]string, 0, len(exposedEndpoints))
+	for endpoint := range exposedEndpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	if err := st.unsetExposedEndpoints(ctx, tx, appID, endpoints...); err != nil {
+		return errors.Capture(err)
+	}
+
+	for endpoint, exposedEndpoint := range exposedEndpoints {
+		if err := st.upsertExposedCIDRs(ctx, tx, appID, endpoint, exposedEndpoint.ExposeToCIDRs); err != nil {
+			return errors.Capture(err)
+		}
+		if err := st.upsertExposedSpaces(ctx, tx, appID, endpoint, exposedEndpoint.ExposeToSpaceIDs); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	return nil
+}
+
 func (st *State) unsetAllExposedEndpoints(ctx context.Context, tx *sqlair.TX, appID coreapplication.UUID) error {
 	applicationID := entityUUID{UUID: appID.String()}
 
@@ -0,0 +1,716 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coreapplication "github.com/juju/juju/core/application"
+	corecharm "github.com/juju/juju/core/charm"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/internal/errors"
+)
+
+// refreshRollout is a row of the application_refresh_rollout table.
+type refreshRollout struct {
+	ApplicationUUID   string              `db:"application_uuid"`
+	CharmUUID         string              `db:"charm_uuid"`
+	PreviousCharmUUID string              `db:"previous_charm_uuid"`
+	PreviousTrack     sql.Null[string]    `db:"previous_track"`
+	PreviousRisk      sql.Null[string]    `db:"previous_risk"`
+	PreviousBranch    sql.Null[string]    `db:"previous_branch"`
+	BatchSize         int                 `db:"batch_size"`
+	BatchPercent      int                 `db:"batch_percent"`
+	BatchTimeout      int64               `db:"batch_timeout"`
+	RollbackOnError   bool                `db:"rollback_on_error"`
+	StatusID          int                 `db:"status_id"`
+	Message           sql.Null[string]    `db:"message"`
+	BatchStartedAt    sql.Null[time.Time] `db:"batch_started_at"`
+}
+
+// refreshRolloutDetails holds the values of a refresh rollout that are not
+// stored in the application_refresh_rollout table.
+type refreshRolloutDetails struct {
+	ApplicationName        string          `db:"application_name"`
+	CharmReferenceName     string          `db:"charm_reference_name"`
+	CharmRevision          int             `db:"charm_revision"`
+	CharmSource            string          `db:"charm_source"`
+	CharmArchitectureID    sql.Null[int64] `db:"charm_architecture_id"`
+	PreviousReferenceName  string          `db:"previous_reference_name"`
+	PreviousRevision       int             `db:"previous_revision"`
+	PreviousSource         string          `db:"previous_source"`
+	PreviousArchitectureID sql.Null[int64] `db:"previous_architecture_id"`
+	UnitsTotal             int             `db:"units_total"`
+	UnitsRefreshed         int             `db:"units_refreshed"`
+}
+
+// refreshRolloutUnit is the state of a unit during a refresh rollout.
+type refreshRolloutUnit struct {
+	UUID             string `db:"uuid"`
+	Name             string `db:"name"`
+	Released         bool   `db:"released"`
+	Refreshed        bool   `db:"refreshed"`
+	AgentStatusID    int    `db:"agent_status_id"`
+	WorkloadStatusID int    `db:"workload_status_id"`
+}
+
+// refreshRolloutUnitUUID is a row of the application_refresh_rollout_unit
+// table.
+type refreshRolloutUnitUUID struct {
+	UnitUUID        string `db:"unit_uuid"`
+	ApplicationUUID string `db:"application_uuid"`
+}
+
+// refreshRolloutUpdate holds the values updated when the status of a refresh
+// rollout changes.
+type refreshRolloutUpdate struct {
+	ApplicationUUID string              `db:"application_uuid"`
+	StatusID        int                 `db:"status_id"`
+	Message         sql.Null[string]    `db:"message"`
+	BatchStartedAt  sql.Null[time.Time] `db:"batch_started_at"`
+}
+
+const refreshRolloutQuery = `
+SELECT r.* AS &refreshRollout.*,
+       a.name AS &refreshRolloutDetails.application_name,
+       c.reference_name AS &refreshRolloutDetails.charm_reference_name,
+       c.revision AS &refreshRolloutDetails.charm_revision,
+       cs.name AS &refreshRolloutDetails.charm_source,
+       c.architecture_id AS &refreshRolloutDetails.charm_architecture_id,
+       pc.reference_name AS &refreshRolloutDetails.previous_reference_name,
+       pc.revision AS &refreshRolloutDetails.previous_revision,
+       pcs.name AS &refreshRolloutDetails.previous_source,
+       pc.architecture_id AS &refreshRolloutDetails.previous_architecture_id,
+       COALESCE(uc.total, 0) AS &refreshRolloutDetails.units_total,
+       COALESCE(uc.refreshed, 0) AS &refreshRolloutDetails.units_refreshed
+FROM   application_refresh_rollout AS r
+JOIN   application AS a ON r.application_uuid = a.uuid
+JOIN   charm AS c ON r.charm_uuid = c.uuid
+JOIN   charm_source AS cs ON c.source_id = cs.id
+JOIN   charm AS pc ON r.previous_charm_uuid = pc.uuid
+JOIN   charm_source AS pcs ON pc.source_id = pcs.id
+LEFT JOIN (
+    SELECT u.application_uuid,
+           COUNT(*) AS total,
+           SUM(u.charm_uuid = ur.charm_uuid) AS refreshed
+    FROM   unit AS u
+    JOIN   application_refresh_rollout AS ur ON u.application_uuid = ur.application_uuid
+    WHERE  u.life_id = 0
+    GROUP BY u.application_uuid
+) AS uc ON r.application_uuid = uc.application_uuid
+`
+
+// GetRefreshRollout returns the refresh rollout of the application.
+//
+// The following errors may be returned:
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     refresh rollout.
+func (st *State) GetRefreshRollout(ctx context.Context, appUUID coreapplication.UUID) (application.RefreshRollout, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return application.RefreshRollout{}, errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: appUUID.String()}
+	stmt, err := st.Prepare(refreshRolloutQuery+`WHERE r.application_uuid = $entityUUID.uuid`,
+		refreshRollout{}, refreshRolloutDetails{}, ident)
+	if err != nil {
+		return application.RefreshRollout{}, errors.Errorf("preparing refresh rollout query: %w", err)
+	}
+
+	var (
+		row     refreshRollout
+		details refreshRolloutDetails
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).Get(&row, &details)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return applicationerrors.RefreshRolloutNotFound
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return application.RefreshRollout{}, errors.Errorf("getting refresh rollout: %w", err)
+	}
+	return decodeRefreshRollout(row, details)
+}
+
+// GetRefreshRollouts returns the refresh rollouts of all applications in the
+// model.
+func (st *State) GetRefreshRollouts(ctx context.Context) ([]application.RefreshRollout, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(refreshRolloutQuery, refreshRollout{}, refreshRolloutDetails{})
+	if err != nil {
+		return nil, errors.Errorf("preparing refresh rollouts query: %w", err)
+	}
+
+	var (
+		rows    []refreshRollout
+		details []refreshRolloutDetails
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows, &details)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return nil, errors.Errorf("getting refresh rollouts: %w", err)
+	}
+
+	result := make([]application.RefreshRollout, len(rows))
+	for i, row := range rows {
+		result[i], err = decodeRefreshRollout(row, details[i])
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	return result, nil
+}
+
+// GetRefreshRolloutUnits returns the alive units of an application with a
+// refresh rollout, along with whether they have been released to, and are
+// running, the new charm.
+//
+// The following errors may be returned:
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     refresh rollout.
+func (st *State) GetRefreshRolloutUnits(ctx context.Context, appUUID coreapplication.UUID) ([]application.RefreshRolloutUnit, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: appUUID.String()}
+	stmt, err := st.Prepare(`
+SELECT u.uuid AS &refreshRolloutUnit.uuid,
+       u.name AS &refreshRolloutUnit.name,
+       ru.unit_uuid IS NOT NULL AS &refreshRolloutUnit.released,
+       u.charm_uuid = r.charm_uuid AS &refreshRolloutUnit.refreshed,
+       COALESCE(uas.status_id, 0) AS &refreshRolloutUnit.agent_status_id,
+       COALESCE(uws.status_id, 0) AS &refreshRolloutUnit.workload_status_id
+FROM   application_refresh_rollout AS r
+JOIN   unit AS u ON r.application_uuid = u.application_uuid
+LEFT JOIN application_refresh_rollout_unit AS ru ON u.uuid = ru.unit_uuid
+LEFT JOIN unit_agent_status AS uas ON u.uuid = uas.unit_uuid
+LEFT JOIN unit_workload_status AS uws ON u.uuid = uws.unit_uuid
+WHERE  r.application_uuid = $entityUUID.uuid
+AND    u.life_id = 0
+ORDER BY u.name
+`, refreshRolloutUnit{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing refresh rollout units query: %w", err)
+	}
+
+	var units []refreshRolloutUnit
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if _, err := st.getRefreshRolloutStatusID(ctx, tx, appUUID); err != nil {
+			return errors.Capture(err)
+		}
+		err := tx.Query(ctx, stmt, ident).GetAll(&units)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return nil, errors.Errorf("getting refresh rollout units: %w", err)
+	}
+
+	result := make([]application.RefreshRolloutUnit, len(units))
+	for i, u := range units {
+		agentStatus, err := status.DecodeAgentStatus(u.AgentStatusID)
+		if err != nil {
+			return nil, errors.Errorf("decoding agent status of unit %q: %w", u.Name, err)
+		}
+		workloadStatus, err := status.DecodeWorkloadStatus(u.WorkloadStatusID)
+		if err != nil {
+			return nil, errors.Errorf("decoding workload status of unit %q: %w", u.Name, err)
+		}
+		result[i] = application.RefreshRolloutUnit{
+			UUID:           coreunit.UUID(u.UUID),
+			Name:           coreunit.Name(u.Name),
+			Released:       u.Released,
+			Refreshed:      u.Refreshed,
+			AgentStatus:    agentStatus,
+			WorkloadStatus: workloadStatus,
+		}
+	}
+	return result, nil
+}
+
+// ReleaseRefreshRolloutUnits releases the given units of the application to
+// the new charm of its refresh rollout, and records the time the batch was
+// started.
+//
+// The following errors may be returned:
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     refresh rollout.
+func (st *State) ReleaseRefreshRolloutUnits(
+	ctx context.Context,
+	appUUID coreapplication.UUID,
+	units []coreunit.UUID,
+	startedAt time.Time,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO application_refresh_rollout_unit (*)
+VALUES ($refreshRolloutUnitUUID.*)
+ON CONFLICT (unit_uuid) DO NOTHING
+`, refreshRolloutUnitUUID{})
+	if err != nil {
+		return errors.Errorf("preparing insert refresh rollout unit: %w", err)
+	}
+
+	update := refreshRolloutUpdate{
+		ApplicationUUID: appUUID.String(),
+		BatchStartedAt:  sql.Null[time.Time]{V: startedAt, Valid: true},
+	}
+	updateStmt, err := st.Prepare(`
+UPDATE application_refresh_rollout
+SET    batch_started_at = $refreshRolloutUpdate.batch_started_at
+WHERE  application_uuid = $refreshRolloutUpdate.application_uuid
+`, update)
+	if err != nil {
+		return errors.Errorf("preparing update refresh rollout: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		statusID, err := st.getRefreshRolloutStatusID(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if statusID != refreshRolloutStatusRolling {
+			return errors.Errorf("refresh rollout of application %q is not rolling", appUUID)
+		}
+
+		for _, unit := range units {
+			arg := refreshRolloutUnitUUID{
+				UnitUUID:        unit.String(),
+				ApplicationUUID: appUUID.String(),
+			}
+			if err := tx.Query(ctx, insertStmt, arg).Run(); err != nil {
+				return errors.Errorf("releasing unit %q: %w", unit, err)
+			}
+		}
+
+		if err := tx.Query(ctx, updateStmt, update).Run(); err != nil {
+			return errors.Errorf("updating refresh rollout batch: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetRefreshRolloutStatus sets the status of the refresh rollout of the
+// application, along with a message describing why.
+//
+// The following errors may be returned:
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     refresh rollout.
+func (st *State) SetRefreshRolloutStatus(
+	ctx context.Context,
+	appUUID coreapplication.UUID,
+	rolloutStatus application.RefreshRolloutStatus,
+	message string,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	statusID, err := encodeRefreshRolloutStatus(rolloutStatus)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	update := refreshRolloutUpdate{
+		ApplicationUUID: appUUID.String(),
+		StatusID:        statusID,
+		Message:         sql.Null[string]{V: message, Valid: message != ""},
+	}
+	stmt, err := st.Prepare(`
+UPDATE application_refresh_rollout
+SET    status_id = $refreshRolloutUpdate.status_id,
+       message = $refreshRolloutUpdate.message
+WHERE  application_uuid = $refreshRolloutUpdate.application_uuid
+`, update)
+	if err != nil {
+		return errors.Errorf("preparing update refresh rollout status: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if _, err := st.getRefreshRolloutStatusID(ctx, tx, appUUID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, update).Run(); err != nil {
+			return errors.Errorf("setting refresh rollout status: %w", err)
+		}
+		return nil
+	})
+}
+
+// ContinueRefreshRollout resumes the paused refresh rollout of the
+// application. The units of the current batch are given until the batch
+// timeout from startedAt to become active.
+//
+// The following errors may be returned:
+//   - [applicationerrors.RefreshRolloutNotFound] if the application has no
+//     refresh rollout.
+//   - [applicationerrors.RefreshRolloutNotPaused] if the rollout is not paused.
+func (st *State) ContinueRefreshRollout(ctx context.Context, appUUID coreapplication.UUID, startedAt time.Time) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	update := refreshRolloutUpdate{
+		ApplicationUUID: appUUID.String(),
+		StatusID:        refreshRolloutStatusRolling,
+		BatchStartedAt:  sql.Null[time.Time]{V: startedAt, Valid: true},
+	}
+	stmt, err := st.Prepare(`
+UPDATE application_refresh_rollout
+SET    status_id = $refreshRolloutUpdate.status_id,
+       message = NULL,
+       batch_started_at = $refreshRolloutUpdate.batch_started_at
+WHERE  application_uuid = $refreshRolloutUpdate.application_uuid
+`, update)
+	if err != nil {
+		return errors.Errorf("preparing continue refresh rollout: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		statusID, err := st.getRefreshRolloutStatusID(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if statusID != refreshRolloutStatusPaused {
+			return errors.Errorf("continuing refresh of application %q", appUUID).
+				Add(applicationerrors.RefreshRolloutNotPaused)
+		}
+		if err := tx.Query(ctx, stmt, update).Run(); err != nil {
+			return errors.Errorf("continuing refresh rollout: %w", err)
+		}
+		return nil
+	})
+}
+
+// DeleteRefreshRollout removes the refresh rollout of the application, once
+// all of its units have been refreshed. It is not an error if the application
+// has no refresh rollout.
+func (st *State) DeleteRefreshRollout(ctx context.Context, appUUID coreapplication.UUID) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return st.deleteRefreshRollout(ctx, tx, appUUID.String())
+	})
+}
+
+// GetUnitRefreshCharmID returns the ID of the charm the unit should be
+// running. This is the charm of its application, unless the application has
+// an unfinished refresh rollout that has not yet released the unit, in which
+// case the unit keeps running the previous charm.
+//
+// The following errors may be returned:
+//   - [applicationerrors.UnitNotFound] if the unit does not exist.
+func (st *State) GetUnitRefreshCharmID(ctx context.Context, name coreunit.Name) (corecharm.ID, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	ident := unitName{Name: name.String()}
+	stmt, err := st.Prepare(`
+SELECT CASE
+           WHEN r.application_uuid IS NOT NULL
+           AND  ru.unit_uuid IS NULL
+           AND  u.charm_uuid = r.previous_charm_uuid
+           THEN r.previous_charm_uuid
+           ELSE a.charm_uuid
+       END AS &charmUUID.charm_uuid
+FROM   unit AS u
+JOIN   application AS a ON u.application_uuid = a.uuid
+LEFT JOIN application_refresh_rollout AS r
+       ON a.uuid = r.application_uuid AND r.status_id IN (0, 1)
+LEFT JOIN application_refresh_rollout_unit AS ru ON u.uuid = ru.unit_uuid
+WHERE  u.name = $unitName.name
+`, charmUUID{}, ident)
+	if err != nil {
+		return "", errors.Errorf("preparing unit refresh charm query: %w", err)
+	}
+
+	var result charmUUID
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("unit %q not found", name).Add(applicationerrors.UnitNotFound)
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return "", errors.Errorf("getting refresh charm of unit %q: %w", name, err)
+	}
+	return corecharm.ID(result.UUID), nil
+}
+
+// NamespaceForWatchApplicationRefreshRollout returns the namespace identifier
+// for the units released by application refresh rollouts.
+func (*State) NamespaceForWatchApplicationRefreshRollout() string {
+	return "application_refresh_rollout_unit"
+}
+
+// setRefreshRollout records the refresh rollout of an application whose charm
+// is being set. It must be called before the application's charm is updated,
+// so that the previous charm can be recorded.
+//
+// If params.RolloutRollback is set, an unfinished rollout is marked as rolled
+// back. Otherwise any existing rollout is replaced by the one described by
+// params.Rollout, if any.
+func (st *State) setRefreshRollout(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appUUID coreapplication.UUID,
+	chID corecharm.ID,
+	params application.SetCharmStateParams,
+) error {
+	if params.RolloutRollback != nil {
+		if err := st.deleteRefreshRolloutUnits(ctx, tx, appUUID.String()); err != nil {
+			return errors.Capture(err)
+		}
+		update := refreshRolloutUpdate{
+			ApplicationUUID: appUUID.String(),
+			StatusID:        refreshRolloutStatusRolledBack,
+			Message:         sql.Null[string]{V: *params.RolloutRollback, Valid: true},
+		}
+		stmt, err := st.Prepare(`
+UPDATE application_refresh_rollout
+SET    status_id = $refreshRolloutUpdate.status_id,
+       message = $refreshRolloutUpdate.message
+WHERE  application_uuid = $refreshRolloutUpdate.application_uuid
+`, update)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, update).Run(); err != nil {
+			return errors.Errorf("rolling back refresh rollout: %w", err)
+		}
+		return nil
+	}
+
+	if params.Rollout != nil {
+		statusID, err := st.getRefreshRolloutStatusID(ctx, tx, appUUID)
+		if err == nil && statusID != refreshRolloutStatusRolledBack {
+			return errors.Errorf("refreshing application %q", appUUID).
+				Add(applicationerrors.RefreshRolloutInProgress)
+		} else if err != nil && !errors.Is(err, applicationerrors.RefreshRolloutNotFound) {
+			return errors.Capture(err)
+		}
+	}
+
+	if err := st.deleteRefreshRollout(ctx, tx, appUUID.String()); err != nil {
+		return errors.Capture(err)
+	}
+	if params.Rollout == nil {
+		return nil
+	}
+
+	previousCharmUUID, err := st.getCharmIDByApplicationUUID(ctx, tx, appUUID.String())
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rollout := refreshRollout{
+		ApplicationUUID:   appUUID.String(),
+		CharmUUID:         chID.String(),
+		PreviousCharmUUID: previousCharmUUID,
+		BatchSize:         params.Rollout.BatchSize,
+		BatchPercent:      params.Rollout.BatchPercent,
+		BatchTimeout:      int64(params.Rollout.BatchTimeout / time.Second),
+		RollbackOnError:   params.Rollout.RollbackOnError,
+		StatusID:          refreshRolloutStatusRolling,
+	}
+
+	channel := applicationChannel{ApplicationID: appUUID.String()}
+	channelStmt, err := st.Prepare(`
+SELECT &applicationChannel.*
+FROM   application_channel
+WHERE  application_uuid = $applicationChannel.application_uuid
+`, channel)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = tx.Query(ctx, channelStmt, channel).Get(&channel)
+	if err == nil {
+		rollout.PreviousTrack = sql.Null[string]{V: channel.Track, Valid: true}
+		rollout.PreviousRisk = sql.Null[string]{V: channel.Risk, Valid: true}
+		rollout.PreviousBranch = sql.Null[string]{V: channel.Branch, Valid: true}
+	} else if !errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Errorf("getting application channel: %w", err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO application_refresh_rollout (*)
+VALUES ($refreshRollout.*)
+`, rollout)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, insertStmt, rollout).Run(); err != nil {
+		return errors.Errorf("inserting refresh rollout: %w", err)
+	}
+	return nil
+}
+
+func (st *State) getRefreshRolloutStatusID(ctx context.Context, tx *sqlair.TX, appUUID coreapplication.UUID) (int, error) {
+	row := refreshRolloutUpdate{ApplicationUUID: appUUID.String()}
+	stmt, err := st.Prepare(`
+SELECT &refreshRolloutUpdate.status_id
+FROM   application_refresh_rollout
+WHERE  application_uuid = $refreshRolloutUpdate.application_uuid
+`, row)
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+	err = tx.Query(ctx, stmt, row).Get(&row)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return -1, errors.Errorf("application %q", appUUID).Add(applicationerrors.RefreshRolloutNotFound)
+	} else if err != nil {
+		return -1, errors.Errorf("getting refresh rollout status: %w", err)
+	}
+	return row.StatusID, nil
+}
+
+func (st *State) deleteRefreshRollout(ctx context.Context, tx *sqlair.TX, appUUID string) error {
+	if err := st.deleteRefreshRolloutUnits(ctx, tx, appUUID); err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := entityUUID{UUID: appUUID}
+	stmt, err := st.Prepare(`
+DELETE FROM application_refresh_rollout
+WHERE  application_uuid = $entityUUID.uuid
+`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, ident).Run(); err != nil {
+		return errors.Errorf("deleting refresh rollout: %w", err)
+	}
+	return nil
+}
+
+func (st *State) deleteRefreshRolloutUnits(ctx context.Context, tx *sqlair.TX, appUUID string) error {
+	ident := entityUUID{UUID: appUUID}
+	stmt, err := st.Prepare(`
+DELETE FROM application_refresh_rollout_unit
+WHERE  application_uuid = $entityUUID.uuid
+`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, ident).Run(); err != nil {
+		return errors.Errorf("deleting refresh rollout units: %w", err)
+	}
+	return nil
+}
+
+const (
+	refreshRolloutStatusRolling    = 0
+	refreshRolloutStatusPaused     = 1
+	refreshRolloutStatusRolledBack = 2
+)
+
+func encodeRefreshRolloutStatus(s application.RefreshRolloutStatus) (int, error) {
+	switch s {
+	case application.RefreshRolloutRolling:
+		return refreshRolloutStatusRolling, nil
+	case application.RefreshRolloutPaused:
+		return refreshRolloutStatusPaused, nil
+	case application.RefreshRolloutRolledBack:
+		return refreshRolloutStatusRolledBack, nil
+	default:
+		return -1, errors.Errorf("unknown refresh rollout status %q", s)
+	}
+}
+
+func decodeRefreshRolloutStatus(id int) (application.RefreshRolloutStatus, error) {
+	switch id {
+	case refreshRolloutStatusRolling:
+		return application.RefreshRolloutRolling, nil
+	case refreshRolloutStatusPaused:
+		return application.RefreshRolloutPaused, nil
+	case refreshRolloutStatusRolledBack:
+		return application.RefreshRolloutRolledBack, nil
+	default:
+		return "", errors.Errorf("unknown refresh rollout status id %d", id)
+	}
+}
+
+func decodeRefreshRollout(row refreshRollout, details refreshRolloutDetails) (application.RefreshRollout, error) {
+	rolloutStatus, err := decodeRefreshRolloutStatus(row.StatusID)
+	if err != nil {
+		return application.RefreshRollout{}, errors.Capture(err)
+	}
+	locator, err := decodeCharmLocator(charmLocator{
+		ReferenceName:  details.CharmReferenceName,
+		Revision:       details.CharmRevision,
+		Source:         details.CharmSource,
+		ArchitectureID: details.CharmArchitectureID,
+	})
+	if err != nil {
+		return application.RefreshRollout{}, errors.Capture(err)
+	}
+	previousLocator, err := decodeCharmLocator(charmLocator{
+		ReferenceName:  details.PreviousReferenceName,
+		Revision:       details.PreviousRevision,
+		Source:         details.PreviousSource,
+		ArchitectureID: details.PreviousArchitectureID,
+	})
+	if err != nil {
+		return application.RefreshRollout{}, errors.Capture(err)
+	}
+
+	var previousChannel *deployment.Channel
+	if row.PreviousRisk.Valid {
+		previousChannel = &deployment.Channel{
+			Track:  row.PreviousTrack.V,
+			Risk:   deployment.ChannelRisk(row.PreviousRisk.V),
+			Branch: row.PreviousBranch.V,
+		}
+	}
+
+	return application.RefreshRollout{
+		RefreshRolloutParams: application.RefreshRolloutParams{
+			BatchSize:       row.BatchSize,
+			BatchPercent:    row.BatchPercent,
+			BatchTimeout:    time.Duration(row.BatchTimeout) * time.Second,
+			RollbackOnError: row.RollbackOnError,
+		},
+		ApplicationUUID: coreapplication.UUID(row.ApplicationUUID),
+		ApplicationName: details.ApplicationName,
+		Status:          rolloutStatus,
+		Message:         row.Message.V,
+		Charm:           locator,
+		PreviousCharm:   previousLocator,
+		PreviousChannel: previousChannel,
+		UnitsRefreshed:  details.UnitsRefreshed,
+		UnitsTotal:      details.UnitsTotal,
+		BatchStartedAt:  row.BatchStartedAt.V,
+	}, nil
+}
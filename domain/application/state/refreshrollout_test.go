@@ -0,0 +1,320 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreapplication "github.com/juju/juju/core/application"
+	corecharm "github.com/juju/juju/core/charm"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/architecture"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/status"
+)
+
+var rolloutParams = application.RefreshRolloutParams{
+	BatchSize:       1,
+	BatchTimeout:    5 * time.Minute,
+	RollbackOnError: true,
+}
+
+func (s *applicationRefreshSuite) TestSetApplicationCharmStartsRollout(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	s.addUnit(c, "foo/0", appID)
+	s.addUnit(c, "foo/1", appID)
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+
+	// Act
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	rollout, err := s.state.GetRefreshRollout(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rollout, tc.DeepEquals, application.RefreshRollout{
+		RefreshRolloutParams: rolloutParams,
+		ApplicationUUID:      appID,
+		ApplicationName:      "foo",
+		Status:               application.RefreshRolloutRolling,
+		Charm: charm.CharmLocator{
+			Name:         "foo",
+			Revision:     43,
+			Source:       charm.LocalSource,
+			Architecture: architecture.AMD64,
+		},
+		PreviousCharm: charm.CharmLocator{
+			Name:         "foo",
+			Revision:     42,
+			Source:       charm.LocalSource,
+			Architecture: architecture.AMD64,
+		},
+		PreviousChannel: &deployment.Channel{
+			Track:  "track",
+			Risk:   "stable",
+			Branch: "branch",
+		},
+		UnitsTotal: 2,
+	})
+
+	rollouts, err := s.state.GetRefreshRollouts(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rollouts, tc.DeepEquals, []application.RefreshRollout{rollout})
+}
+
+func (s *applicationRefreshSuite) TestSetApplicationCharmRolloutInProgress(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	err = s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, applicationerrors.RefreshRolloutInProgress)
+}
+
+func (s *applicationRefreshSuite) TestSetApplicationCharmAbandonsRollout(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	unitUUID := s.addUnit(c, "foo/0", appID)
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.ReleaseRefreshRolloutUnits(c.Context(), appID, []coreunit.UUID{unitUUID}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	err = s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.GetRefreshRollout(c.Context(), appID)
+	c.Check(err, tc.ErrorIs, applicationerrors.RefreshRolloutNotFound)
+}
+
+func (s *applicationRefreshSuite) TestGetUnitRefreshCharmID(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	unit0 := s.addUnit(c, "foo/0", appID)
+	s.addUnit(c, "foo/1", appID)
+	previousCharmUUID := s.getApplicationCharmUUID(c, appID)
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	err = s.state.ReleaseRefreshRolloutUnits(c.Context(), appID, []coreunit.UUID{unit0}, time.Now())
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	id, err := s.state.GetUnitRefreshCharmID(c.Context(), "foo/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, charmUUID)
+	id, err = s.state.GetUnitRefreshCharmID(c.Context(), "foo/1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, previousCharmUUID)
+}
+
+func (s *applicationRefreshSuite) TestGetUnitRefreshCharmIDNoRollout(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	s.addUnit(c, "foo/0", appID)
+
+	// Act
+	id, err := s.state.GetUnitRefreshCharmID(c.Context(), "foo/0")
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, s.getApplicationCharmUUID(c, appID))
+}
+
+func (s *applicationRefreshSuite) TestGetUnitRefreshCharmIDUnitNotFound(c *tc.C) {
+	// Act
+	_, err := s.state.GetUnitRefreshCharmID(c.Context(), "foo/0")
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *applicationRefreshSuite) TestGetRefreshRolloutUnits(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	unit0 := s.addUnit(c, "foo/0", appID)
+	unit1 := s.addUnit(c, "foo/1", appID)
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.ReleaseRefreshRolloutUnits(c.Context(), appID, []coreunit.UUID{unit0}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	units, err := s.state.GetRefreshRolloutUnits(c.Context(), appID)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(units, tc.DeepEquals, []application.RefreshRolloutUnit{{
+		UUID:           unit0,
+		Name:           "foo/0",
+		Released:       true,
+		AgentStatus:    status.UnitAgentStatusAllocating,
+		WorkloadStatus: status.WorkloadStatusUnset,
+	}, {
+		UUID:           unit1,
+		Name:           "foo/1",
+		AgentStatus:    status.UnitAgentStatusAllocating,
+		WorkloadStatus: status.WorkloadStatusUnset,
+	}})
+}
+
+func (s *applicationRefreshSuite) TestGetRefreshRolloutUnitsNotFound(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+
+	// Act
+	_, err := s.state.GetRefreshRolloutUnits(c.Context(), appID)
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, applicationerrors.RefreshRolloutNotFound)
+}
+
+func (s *applicationRefreshSuite) TestRollbackRefreshRollout(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	unit0 := s.addUnit(c, "foo/0", appID)
+	previousCharmUUID := s.getApplicationCharmUUID(c, appID)
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.ReleaseRefreshRolloutUnits(c.Context(), appID, []coreunit.UUID{unit0}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	reason := "unit foo/0 is in error"
+	err = s.state.SetApplicationCharm(c.Context(), appID, previousCharmUUID, application.SetCharmStateParams{
+		RolloutRollback: &reason,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	rollout, err := s.state.GetRefreshRollout(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rollout.Status, tc.Equals, application.RefreshRolloutRolledBack)
+	c.Check(rollout.Message, tc.Equals, reason)
+
+	id, err := s.state.GetUnitRefreshCharmID(c.Context(), "foo/0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, previousCharmUUID)
+
+	// A rolled back rollout doesn't prevent a new staged refresh.
+	err = s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationRefreshSuite) TestContinueRefreshRollout(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.SetRefreshRolloutStatus(c.Context(), appID, application.RefreshRolloutPaused, "timed out")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	now := time.Now().UTC().Truncate(time.Second)
+	err = s.state.ContinueRefreshRollout(c.Context(), appID, now)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	rollout, err := s.state.GetRefreshRollout(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rollout.Status, tc.Equals, application.RefreshRolloutRolling)
+	c.Check(rollout.Message, tc.Equals, "")
+	c.Check(rollout.BatchStartedAt.Equal(now), tc.IsTrue)
+}
+
+func (s *applicationRefreshSuite) TestContinueRefreshRolloutNotPaused(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	err = s.state.ContinueRefreshRollout(c.Context(), appID, time.Now())
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, applicationerrors.RefreshRolloutNotPaused)
+}
+
+func (s *applicationRefreshSuite) TestContinueRefreshRolloutNotFound(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+
+	// Act
+	err := s.state.ContinueRefreshRollout(c.Context(), appID, time.Now())
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, applicationerrors.RefreshRolloutNotFound)
+}
+
+func (s *applicationRefreshSuite) TestDeleteRefreshRollout(c *tc.C) {
+	// Arrange
+	appID := s.createApplication(c, createApplicationArgs{appName: "foo"})
+	unit0 := s.addUnit(c, "foo/0", appID)
+	charmUUID := s.createCharm(c, createCharmArgs{name: "foo"})
+	err := s.state.SetApplicationCharm(c.Context(), appID, charmUUID, application.SetCharmStateParams{
+		Rollout: &rolloutParams,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.ReleaseRefreshRolloutUnits(c.Context(), appID, []coreunit.UUID{unit0}, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Act
+	err = s.state.DeleteRefreshRollout(c.Context(), appID)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.GetRefreshRollout(c.Context(), appID)
+	c.Check(err, tc.ErrorIs, applicationerrors.RefreshRolloutNotFound)
+}
+
+func (s *applicationRefreshSuite) getApplicationCharmUUID(c *tc.C, appID coreapplication.UUID) corecharm.ID {
+	var id corecharm.ID
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT charm_uuid FROM application WHERE uuid = ?", appID).Scan(&id)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return id
+}
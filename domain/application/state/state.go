@@ -174,13 +174,18 @@ func (s *State) addCharm(ctx context.Context, tx *sqlair.TX, uuid corecharm.ID,
 		}
 	}
 
-	// Insert the download info if the charm is from CharmHub.
-	if ch.Source == charm.CharmHubSource {
+	// Insert the download info if the charm is from CharmHub or an OCI
+	// registry.
+	if ch.Source.IsDownloadable() {
 		if err := s.addCharmDownloadInfo(ctx, tx, uuid, downloadInfo); err != nil {
 			return errors.Capture(err)
 		}
 	}
 
+	if err := s.addCharmSignature(ctx, tx, uuid, ch.Signature); err != nil {
+		return errors.Capture(err)
+	}
+
 	return nil
 }
 
@@ -277,6 +282,30 @@ ON CONFLICT DO NOTHING;`
 	return nil
 }
 
+func (s *State) addCharmSignature(ctx context.Context, tx *sqlair.TX, id corecharm.ID, signature *charm.Signature) error {
+	if signature == nil {
+		return nil
+	}
+
+	signatureState := charmSignature{
+		CharmUUID:      id.String(),
+		Signer:         signature.Signer,
+		KeyFingerprint: signature.Fingerprint,
+	}
+
+	query := `INSERT INTO charm_signature (*) VALUES ($charmSignature.*);`
+	stmt, err := s.Prepare(query, signatureState)
+	if err != nil {
+		return errors.Errorf("preparing query: %w", err)
+	}
+
+	if err := tx.Query(ctx, stmt, signatureState).Run(); err != nil {
+		return errors.Errorf("inserting charm signature: %w", err)
+	}
+
+	return nil
+}
+
 func (s *State) addCharmMetadata(
 	ctx context.Context,
 	tx *sqlair.TX,
@@ -650,14 +679,14 @@ func (s *State) getCharm(ctx context.Context, tx *sqlair.TX, ident entityUUID) (
 		return ch, nil, errors.Capture(err)
 	}
 
-	// Download information should only be recorded for charmhub charms.
-	// If it's not present, ensure we report it as not found.
+	// Download information should only be recorded for charmhub and OCI
+	// charms. If it's not present, ensure we report it as not found.
 	var downloadInfo *charm.DownloadInfo
 	if info, err := s.getCharmDownloadInfo(ctx, tx, ident); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return ch, nil, errors.Capture(err)
 	} else if err == nil {
 		downloadInfo = &info
-	} else if ch.Source == charm.CharmHubSource {
+	} else if ch.Source.IsDownloadable() {
 		return ch, nil, applicationerrors.CharmDownloadInfoNotFound
 	}
 	return ch, downloadInfo, nil
@@ -727,7 +756,7 @@ FROM charm AS c
 JOIN charm_download_info AS cdi ON c.uuid = cdi.charm_uuid
 JOIN charm_provenance AS cp ON cp.id = cdi.provenance_id
 WHERE c.uuid = $entityUUID.uuid
-AND c.source_id = 1;
+AND c.source_id IN (1, 3);
 `
 
 	stmt, err := s.Prepare(query, charmDownloadInfo{}, ident)
@@ -1456,6 +1485,8 @@ func encodeCharmSource(source charm.CharmSource) (int, error) {
 		return 0, nil
 	case charm.CharmHubSource:
 		return 1, nil
+	case charm.OCISource:
+		return 3, nil
 	default:
 		return 0, errors.Errorf("unsupported source type: %s", source)
 	}
@@ -365,6 +365,13 @@ type setCharmDownloadInfo struct {
 	DownloadSize       int64  `db:"download_size"`
 }
 
+// charmSignature is used to set and get the verified signature of a charm.
+type charmSignature struct {
+	CharmUUID      string `db:"charm_uuid"`
+	Signer         string `db:"signer"`
+	KeyFingerprint string `db:"key_fingerprint"`
+}
+
 // charmMetadata is used to get the metadata of a charm.
 type charmMetadata struct {
 	Name           string `db:"name"`
@@ -756,6 +763,7 @@ type revisionUpdaterApplication struct {
 	Name                   string          `db:"name"`
 	ReferenceName          string          `db:"reference_name"`
 	Revision               int             `db:"revision"`
+	CharmSourceID          int             `db:"charm_source_id"`
 	CharmArchitectureID    sql.Null[int64] `db:"charm_architecture_id"`
 	ChannelTrack           string          `db:"channel_track"`
 	ChannelRisk            string          `db:"channel_risk"`
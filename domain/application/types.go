@@ -415,6 +415,29 @@ type ExposedEndpoint struct {
 	ExposeToCIDRs set.Strings
 }
 
+// UpdateApplicationConfigurationArg holds changes to the configuration of an
+// application.
+type UpdateApplicationConfigurationArg struct {
+	// UUID is the UUID of the application.
+	UUID application.UUID
+	// ConfigUpdates holds the application config values to set.
+	ConfigUpdates map[string]AddApplicationConfig
+	// ConfigRemoves holds the application config keys to remove.
+	ConfigRemoves []string
+	// Settings holds the application settings to set.
+	Settings UpdateApplicationSettingsArg
+	// Constraints, if not nil, replaces the application constraints.
+	Constraints *constraints.Constraints
+	// EndpointBindings maps endpoint names to the names of the spaces to
+	// bind them to.
+	EndpointBindings map[string]string
+	// ExposeUpdates holds the expose settings to merge, indexed by
+	// endpoint name.
+	ExposeUpdates map[string]ExposedEndpoint
+	// ExposeRemoves holds the names of the endpoints to unexpose.
+	ExposeRemoves set.Strings
+}
+
 // ImportUnitArg is used to import a unit.
 type ImportUnitArg struct {
 	UnitStatusArg
@@ -531,6 +554,10 @@ type SetCharmParams struct {
 	// StorageDirectiveOverrides is a map of storage names to storage directives to
 	// update during the upgrade.
 	StorageDirectiveOverrides map[string]ApplicationStorageDirectiveOverride
+
+	// Rollout, if set, stages the refresh of the application's units a batch
+	// at a time, rather than refreshing them all at once.
+	Rollout *RefreshRolloutParams
 }
 
 // SetCharmStateParams contains the parameters for updating
@@ -555,6 +582,16 @@ type SetCharmStateParams struct {
 	// StorageDirectivesToUpdate contains storage directives that need to be
 	// applied based on the new charm's storage requirements.
 	StorageDirectivesToUpdate []domainstorage.DirectiveArg
+
+	// Rollout, if set, starts a staged refresh of the application's units.
+	// Otherwise any staged refresh of the application is abandoned, and all
+	// units follow the new charm.
+	Rollout *RefreshRolloutParams
+
+	// RolloutRollback, if set, is the reason a staged refresh is being
+	// reverted to its previous charm. The staged refresh is kept, recorded as
+	// rolled back.
+	RolloutRollback *string
 }
 
 // ApplicationDetails contains details about an application.
@@ -1638,7 +1638,7 @@ func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory)
 		providerGetter,
 		caasProviderGetter,
 		cloudInfoGetter,
-		nil,
+		domaintesting.NewCharmStore(nil),
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
 		model.UUID(s.ModelUUID()),
 		clock.WallClock,
@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package auditlog defines the domain model for the audit log held in the
+// controller database.
+//
+// The API server records audit information as a sequence of conversations,
+// one per client connection, each holding the API requests made over the
+// connection and their responses (see [github.com/juju/juju/core/auditlog]).
+// When auditing is enabled these records are written to the audit log file
+// of the controller that received them and to the controller database,
+// where the records of every controller can be queried together.
+//
+// Each request is stored with the conversation it belongs to and its
+// outcome, so that the log can be filtered by user, model, facade and
+// method, time and outcome. Records older than the audit-log-retention
+// controller config value are pruned periodically.
+package auditlog
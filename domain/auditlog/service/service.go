@@ -0,0 +1,189 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+
+	coreauditlog "github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/auditlog"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State describes retrieval and persistence methods for the audit log.
+type State interface {
+	// AddConversation records the start of a conversation.
+	AddConversation(ctx context.Context, c auditlog.Conversation) error
+
+	// AddRequest records a request made in a conversation.
+	AddRequest(ctx context.Context, r auditlog.Request) error
+
+	// AddResponse records the outcome of a request, along with the errors
+	// returned in its response.
+	AddResponse(ctx context.Context, r auditlog.Response) error
+
+	// Query returns the audit log entries matching the filter, ordered by
+	// the time their request was made.
+	Query(ctx context.Context, f auditlog.Filter) ([]auditlog.Entry, error)
+
+	// Prune removes the audit log records made before the given time,
+	// returning the number of requests removed.
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Service provides the API for working with the audit log.
+type Service struct {
+	st    State
+	clock clock.Clock
+}
+
+// NewService returns a new service reference wrapping the given audit log
+// state.
+func NewService(st State, clock clock.Clock) *Service {
+	return &Service{
+		st:    st,
+		clock: clock,
+	}
+}
+
+// AddConversation records the start of a conversation.
+func (s *Service) AddConversation(ctx context.Context, c coreauditlog.Conversation) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	when, err := parseWhen(c.When)
+	if err != nil {
+		return errors.Errorf("audit log conversation %q: %w", c.ConversationID, err)
+	}
+	return errors.Capture(s.st.AddConversation(ctx, auditlog.Conversation{
+		ConversationID: c.ConversationID,
+		ConnectionID:   c.ConnectionID,
+		Who:            c.Who,
+		What:           c.What,
+		ModelName:      c.ModelName,
+		ModelUUID:      c.ModelUUID,
+		When:           when,
+	}))
+}
+
+// AddRequest records a request made in a conversation. Requests of
+// conversations that have not been recorded are ignored.
+func (s *Service) AddRequest(ctx context.Context, r coreauditlog.Request) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	when, err := parseWhen(r.When)
+	if err != nil {
+		return errors.Errorf("audit log request %d of conversation %q: %w", r.RequestID, r.ConversationID, err)
+	}
+	return errors.Capture(s.st.AddRequest(ctx, auditlog.Request{
+		ConversationID: r.ConversationID,
+		RequestID:      r.RequestID,
+		Facade:         r.Facade,
+		Method:         r.Method,
+		Version:        r.Version,
+		Args:           r.Args,
+		When:           when,
+	}))
+}
+
+// AddResponse records the response to a request. The request is recorded
+// as failed if the response holds any errors, and as succeeded otherwise.
+// Responses to requests that have not been recorded are ignored.
+func (s *Service) AddResponse(ctx context.Context, r coreauditlog.ResponseErrors) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	when, err := parseWhen(r.When)
+	if err != nil {
+		return errors.Errorf("audit log response %d of conversation %q: %w", r.RequestID, r.ConversationID, err)
+	}
+
+	// Bulk API calls return one result per item, with a nil error for the
+	// items that succeeded.
+	var responseErrors []auditlog.Error
+	for _, e := range r.Errors {
+		if e == nil {
+			continue
+		}
+		responseErrors = append(responseErrors, auditlog.Error{
+			Code:    e.Code,
+			Message: e.Message,
+		})
+	}
+	outcome := auditlog.OutcomeSucceeded
+	if len(responseErrors) > 0 {
+		outcome = auditlog.OutcomeFailed
+	}
+
+	return errors.Capture(s.st.AddResponse(ctx, auditlog.Response{
+		ConversationID: r.ConversationID,
+		RequestID:      r.RequestID,
+		When:           when,
+		Outcome:        outcome,
+		Errors:         responseErrors,
+	}))
+}
+
+// Query returns the audit log entries matching the filter, ordered by the
+// time their request was made. If the filter has a limit, the most recent
+// entries are returned.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] if the filter is not valid.
+func (s *Service) Query(ctx context.Context, filter auditlog.Filter) ([]auditlog.Entry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if filter.Outcome != "" && !filter.Outcome.IsValid() {
+		return nil, errors.Errorf("audit log outcome %q not valid", filter.Outcome).Add(coreerrors.NotValid)
+	}
+	if filter.Limit < 0 {
+		return nil, errors.Errorf("audit log limit %d not valid", filter.Limit).Add(coreerrors.NotValid)
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return nil, errors.Errorf("audit log time range %s to %s not valid", filter.Since, filter.Until).Add(coreerrors.NotValid)
+	}
+	// Times are recorded in UTC, and are compared as such.
+	if !filter.Since.IsZero() {
+		filter.Since = filter.Since.UTC()
+	}
+	if !filter.Until.IsZero() {
+		filter.Until = filter.Until.UTC()
+	}
+
+	entries, err := s.st.Query(ctx, filter)
+	return entries, errors.Capture(err)
+}
+
+// Prune removes the audit log records older than maxAge, returning the
+// number of requests removed.
+//
+// The following errors may be returned:
+// - [coreerrors.NotValid] if maxAge is not positive.
+func (s *Service) Prune(ctx context.Context, maxAge time.Duration) (int64, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if maxAge <= 0 {
+		return 0, errors.Errorf("audit log max age %s not valid", maxAge).Add(coreerrors.NotValid)
+	}
+	pruned, err := s.st.Prune(ctx, s.clock.Now().UTC().Add(-maxAge))
+	return pruned, errors.Capture(err)
+}
+
+// parseWhen parses the time of an audit log record, which is formatted as
+// RFC 3339.
+func parseWhen(when string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, when)
+	if err != nil {
+		return time.Time{}, errors.Errorf("parsing time %q: %w", when, err)
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	gomock "github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreauditlog "github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/auditlog"
+)
+
+type serviceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceSuite) service() *Service {
+	return NewService(s.state, s.clock)
+}
+
+func (s *serviceSuite) TestAddConversation(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddConversation(gomock.Any(), auditlog.Conversation{
+		ConversationID: "dea1",
+		ConnectionID:   "1A",
+		Who:            "alice",
+		What:           "juju deploy mysql",
+		ModelName:      "admin/foo",
+		ModelUUID:      "model-uuid",
+		When:           time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC),
+	}).Return(nil)
+
+	err := s.service().AddConversation(c.Context(), coreauditlog.Conversation{
+		ConversationID: "dea1",
+		ConnectionID:   "1A",
+		Who:            "alice",
+		What:           "juju deploy mysql",
+		ModelName:      "admin/foo",
+		ModelUUID:      "model-uuid",
+		When:           "2026-10-17T12:00:00+02:00",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddConversationWhenNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service().AddConversation(c.Context(), coreauditlog.Conversation{
+		ConversationID: "dea1",
+		When:           "yesterday",
+	})
+	c.Assert(err, tc.ErrorMatches, `audit log conversation "dea1": parsing time "yesterday": .*`)
+}
+
+func (s *serviceSuite) TestAddRequest(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddRequest(gomock.Any(), auditlog.Request{
+		ConversationID: "dea1",
+		RequestID:      3,
+		Facade:         "Application",
+		Method:         "Deploy",
+		Version:        20,
+		Args:           `{"applications":[]}`,
+		When:           time.Date(2026, 10, 17, 12, 0, 1, 0, time.UTC),
+	}).Return(nil)
+
+	err := s.service().AddRequest(c.Context(), coreauditlog.Request{
+		ConversationID: "dea1",
+		ConnectionID:   "1A",
+		RequestID:      3,
+		When:           "2026-10-17T12:00:01Z",
+		Facade:         "Application",
+		Method:         "Deploy",
+		Version:        20,
+		Args:           `{"applications":[]}`,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddResponseSucceeded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddResponse(gomock.Any(), auditlog.Response{
+		ConversationID: "dea1",
+		RequestID:      3,
+		When:           time.Date(2026, 10, 17, 12, 0, 2, 0, time.UTC),
+		Outcome:        auditlog.OutcomeSucceeded,
+	}).Return(nil)
+
+	err := s.service().AddResponse(c.Context(), coreauditlog.ResponseErrors{
+		ConversationID: "dea1",
+		ConnectionID:   "1A",
+		RequestID:      3,
+		When:           "2026-10-17T12:00:02Z",
+		Errors:         []*coreauditlog.Error{nil, nil},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddResponseFailed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddResponse(gomock.Any(), auditlog.Response{
+		ConversationID: "dea1",
+		RequestID:      3,
+		When:           time.Date(2026, 10, 17, 12, 0, 2, 0, time.UTC),
+		Outcome:        auditlog.OutcomeFailed,
+		Errors:         []auditlog.Error{{Code: "not found", Message: "application not found"}},
+	}).Return(nil)
+
+	err := s.service().AddResponse(c.Context(), coreauditlog.ResponseErrors{
+		ConversationID: "dea1",
+		RequestID:      3,
+		When:           "2026-10-17T12:00:02Z",
+		Errors: []*coreauditlog.Error{
+			nil,
+			{Code: "not found", Message: "application not found"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestQuery(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	since := time.Date(2026, 10, 17, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	entries := []auditlog.Entry{{Outcome: auditlog.OutcomeFailed}}
+	s.state.EXPECT().Query(gomock.Any(), auditlog.Filter{
+		User:    "alice",
+		Since:   since.UTC(),
+		Outcome: auditlog.OutcomeFailed,
+		Limit:   10,
+	}).Return(entries, nil)
+
+	result, err := s.service().Query(c.Context(), auditlog.Filter{
+		User:    "alice",
+		Since:   since,
+		Outcome: auditlog.OutcomeFailed,
+		Limit:   10,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, entries)
+}
+
+func (s *serviceSuite) TestQueryFilterNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	for _, filter := range []auditlog.Filter{
+		{Outcome: "exploded"},
+		{Limit: -1},
+		{Since: now, Until: now.Add(-time.Hour)},
+	} {
+		_, err := s.service().Query(c.Context(), filter)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	}
+}
+
+func (s *serviceSuite) TestPrune(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().Prune(gomock.Any(), s.clock.Now().Add(-24*time.Hour)).Return(int64(5), nil)
+
+	pruned, err := s.service().Prune(c.Context(), 24*time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(5))
+}
+
+func (s *serviceSuite) TestPruneMaxAgeNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service().Prune(c.Context(), 0)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
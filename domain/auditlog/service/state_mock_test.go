@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/auditlog/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination state_mock_test.go github.com/juju/juju/domain/auditlog/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	time "time"
+
+	gomock "github.com/canonical/gomock/gomock"
+	auditlog "github.com/juju/juju/domain/auditlog"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+	isgomock struct{}
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock                   *MockState
+	addConversationExpects []*gomock.Call2_1[context.Context, auditlog.Conversation, error]
+	addRequestExpects      []*gomock.Call2_1[context.Context, auditlog.Request, error]
+	addResponseExpects     []*gomock.Call2_1[context.Context, auditlog.Response, error]
+	pruneExpects           []*gomock.Call2_2[context.Context, time.Time, int64, error]
+	queryExpects           []*gomock.Call2_2[context.Context, auditlog.Filter, []auditlog.Entry, error]
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// AddConversation mocks base method.
+func (m *MockState) AddConversation(ctx context.Context, c auditlog.Conversation) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addConversationExpects, m.ctrl, m, "AddConversation", ctx, c)
+}
+
+// AddConversation indicates an expected call of AddConversation.
+func (mr *MockStateMockRecorder) AddConversation(ctx, c any) *MockStateAddConversationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, auditlog.Conversation, error](mr.mock.ctrl.T, mr.mock, "AddConversation", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(c))
+	mr.addConversationExpects = append(mr.addConversationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddConversationCall is the typed call wrapper for AddConversation.
+type MockStateAddConversationCall = gomock.Call2_1[context.Context, auditlog.Conversation, error]
+
+// AddRequest mocks base method.
+func (m *MockState) AddRequest(ctx context.Context, r auditlog.Request) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addRequestExpects, m.ctrl, m, "AddRequest", ctx, r)
+}
+
+// AddRequest indicates an expected call of AddRequest.
+func (mr *MockStateMockRecorder) AddRequest(ctx, r any) *MockStateAddRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, auditlog.Request, error](mr.mock.ctrl.T, mr.mock, "AddRequest", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(r))
+	mr.addRequestExpects = append(mr.addRequestExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddRequestCall is the typed call wrapper for AddRequest.
+type MockStateAddRequestCall = gomock.Call2_1[context.Context, auditlog.Request, error]
+
+// AddResponse mocks base method.
+func (m *MockState) AddResponse(ctx context.Context, r auditlog.Response) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addResponseExpects, m.ctrl, m, "AddResponse", ctx, r)
+}
+
+// AddResponse indicates an expected call of AddResponse.
+func (mr *MockStateMockRecorder) AddResponse(ctx, r any) *MockStateAddResponseCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, auditlog.Response, error](mr.mock.ctrl.T, mr.mock, "AddResponse", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(r))
+	mr.addResponseExpects = append(mr.addResponseExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddResponseCall is the typed call wrapper for AddResponse.
+type MockStateAddResponseCall = gomock.Call2_1[context.Context, auditlog.Response, error]
+
+// Prune mocks base method.
+func (m *MockState) Prune(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.pruneExpects, m.ctrl, m, "Prune", ctx, before)
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockStateMockRecorder) Prune(ctx, before any) *MockStatePruneCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, time.Time, int64, error](mr.mock.ctrl.T, mr.mock, "Prune", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(before))
+	mr.pruneExpects = append(mr.pruneExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatePruneCall is the typed call wrapper for Prune.
+type MockStatePruneCall = gomock.Call2_2[context.Context, time.Time, int64, error]
+
+// Query mocks base method.
+func (m *MockState) Query(ctx context.Context, f auditlog.Filter) ([]auditlog.Entry, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.queryExpects, m.ctrl, m, "Query", ctx, f)
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockStateMockRecorder) Query(ctx, f any) *MockStateQueryCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, auditlog.Filter, []auditlog.Entry, error](mr.mock.ctrl.T, mr.mock, "Query", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(f))
+	mr.queryExpects = append(mr.queryExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateQueryCall is the typed call wrapper for Query.
+type MockStateQueryCall = gomock.Call2_2[context.Context, auditlog.Filter, []auditlog.Entry, error]
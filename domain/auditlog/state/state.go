@@ -0,0 +1,353 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/auditlog"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State represents database interactions dealing with the audit log.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new audit log state based on the input database
+// factory method.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddConversation records the start of a conversation. Recording the same
+// conversation more than once has no effect.
+func (st *State) AddConversation(ctx context.Context, c auditlog.Conversation) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO audit_log_conversation (*) VALUES ($conversation.*)
+ON CONFLICT (conversation_id) DO NOTHING;`, conversation{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, conversation{
+			ConversationID: c.ConversationID,
+			ConnectionID:   c.ConnectionID,
+			Who:            c.Who,
+			What:           c.What,
+			ModelName:      c.ModelName,
+			ModelUUID:      c.ModelUUID,
+			CreatedAt:      c.When,
+		}).Run(); err != nil {
+			return errors.Errorf("inserting audit log conversation %q: %w", c.ConversationID, err)
+		}
+		return nil
+	})
+}
+
+// AddRequest records a request made in a conversation. Requests of
+// conversations that have not been recorded are ignored.
+func (st *State) AddRequest(ctx context.Context, r auditlog.Request) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO audit_log_request (conversation_id, request_id, facade, method, version, args, created_at)
+SELECT $request.conversation_id, $request.request_id, $request.facade, $request.method,
+       $request.version, $request.args, $request.created_at
+FROM   audit_log_conversation
+WHERE  conversation_id = $request.conversation_id
+ON CONFLICT (conversation_id, request_id) DO NOTHING;`, request{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, request{
+			ConversationID: r.ConversationID,
+			RequestID:      int64(r.RequestID),
+			Facade:         r.Facade,
+			Method:         r.Method,
+			Version:        r.Version,
+			Args:           r.Args,
+			CreatedAt:      r.When,
+		}).Run(); err != nil {
+			return errors.Errorf("inserting audit log request %d of conversation %q: %w", r.RequestID, r.ConversationID, err)
+		}
+		return nil
+	})
+}
+
+// AddResponse records the outcome of a request, along with the errors
+// returned in its response. Responses to requests that have not been
+// recorded are ignored.
+func (st *State) AddResponse(ctx context.Context, r auditlog.Response) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	outcomeID, ok := outcomeIDs[r.Outcome]
+	if !ok {
+		return errors.Errorf("unknown audit log outcome %q", r.Outcome)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE audit_log_request
+SET    outcome_id = $response.outcome_id,
+       responded_at = $response.responded_at
+WHERE  conversation_id = $response.conversation_id
+AND    request_id = $response.request_id;`, response{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertErrorsStmt, err := st.Prepare(`
+INSERT INTO audit_log_request_error (*) VALUES ($requestError.*)
+ON CONFLICT (conversation_id, request_id, idx) DO NOTHING;`, requestError{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	errorRows := make([]requestError, len(r.Errors))
+	for i, e := range r.Errors {
+		errorRows[i] = requestError{
+			ConversationID: r.ConversationID,
+			RequestID:      int64(r.RequestID),
+			Index:          i,
+			Code:           e.Code,
+			Message:        e.Message,
+		}
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, updateStmt, response{
+			ConversationID: r.ConversationID,
+			RequestID:      int64(r.RequestID),
+			OutcomeID:      outcomeID,
+			RespondedAt:    r.When,
+		}).Get(&outcome); err != nil {
+			return errors.Errorf("updating audit log request %d of conversation %q: %w", r.RequestID, r.ConversationID, err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if affected == 0 || len(errorRows) == 0 {
+			return nil
+		}
+		if err := tx.Query(ctx, insertErrorsStmt, errorRows).Run(); err != nil {
+			return errors.Errorf("inserting errors of audit log request %d of conversation %q: %w", r.RequestID, r.ConversationID, err)
+		}
+		return nil
+	})
+}
+
+// Query returns the audit log entries matching the filter, ordered by the
+// time their request was made. If the filter has a limit, the most recent
+// entries are returned.
+func (st *State) Query(ctx context.Context, f auditlog.Filter) ([]auditlog.Entry, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	input := filter{
+		User:    f.User,
+		Model:   f.Model,
+		Facade:  f.Facade,
+		Method:  f.Method,
+		Since:   f.Since,
+		Until:   f.Until,
+		Outcome: string(f.Outcome),
+		Limit:   f.Limit,
+	}
+	var conditions []string
+	if f.User != "" {
+		conditions = append(conditions, "c.who = $filter.who")
+	}
+	if f.Model != "" {
+		conditions = append(conditions, "(c.model_name = $filter.model OR c.model_uuid = $filter.model)")
+	}
+	if f.Facade != "" {
+		conditions = append(conditions, "r.facade = $filter.facade")
+	}
+	if f.Method != "" {
+		conditions = append(conditions, "r.method = $filter.method")
+	}
+	if !f.Since.IsZero() {
+		conditions = append(conditions, "r.created_at >= $filter.since")
+	}
+	if !f.Until.IsZero() {
+		conditions = append(conditions, "r.created_at < $filter.until")
+	}
+	if f.Outcome != "" {
+		conditions = append(conditions, "o.name = $filter.outcome")
+	}
+
+	query := `
+SELECT    &entry.*
+FROM      (
+    SELECT c.conversation_id, c.connection_id, c.who, c.what, c.model_name, c.model_uuid,
+           c.created_at AS started_at,
+           r.request_id, r.facade, r.method, r.version, r.args, r.created_at, r.responded_at,
+           o.name AS outcome
+    FROM   audit_log_request AS r
+    JOIN   audit_log_conversation AS c ON r.conversation_id = c.conversation_id
+    JOIN   audit_log_outcome AS o ON r.outcome_id = o.id`
+	if len(conditions) > 0 {
+		query += `
+    WHERE  ` + strings.Join(conditions, "\n    AND    ")
+	}
+	query += `
+)
+ORDER BY  created_at DESC, conversation_id DESC, request_id DESC`
+	if f.Limit > 0 {
+		query += `
+LIMIT     $filter.limit`
+	}
+
+	// The filter may only be passed when the query refers to it.
+	var args []any
+	if len(conditions) > 0 || f.Limit > 0 {
+		args = append(args, input)
+	}
+	stmt, err := st.Prepare(query, append([]any{entry{}}, args...)...)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	errorsStmt, err := st.Prepare(`
+SELECT   &requestError.*
+FROM     audit_log_request_error
+WHERE    conversation_id IN ($conversationIDs[:])
+ORDER BY conversation_id, request_id, idx;`, requestError{}, conversationIDs{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		rows      []entry
+		errorRows []requestError
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, args...).GetAll(&rows); errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("querying audit log: %w", err)
+		}
+
+		ids := make(map[string]struct{})
+		for _, row := range rows {
+			ids[row.ConversationID] = struct{}{}
+		}
+		var conversations conversationIDs
+		for id := range ids {
+			conversations = append(conversations, id)
+		}
+		if err := tx.Query(ctx, errorsStmt, conversations).GetAll(&errorRows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying audit log errors: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	type requestKey struct {
+		conversationID string
+		requestID      int64
+	}
+	requestErrors := make(map[requestKey][]auditlog.Error)
+	for _, row := range errorRows {
+		key := requestKey{conversationID: row.ConversationID, requestID: row.RequestID}
+		requestErrors[key] = append(requestErrors[key], auditlog.Error{
+			Code:    row.Code,
+			Message: row.Message,
+		})
+	}
+
+	// The most recent entries were selected first, so that the limit keeps
+	// them, but entries are returned in the order they were made.
+	slices.Reverse(rows)
+	result := make([]auditlog.Entry, len(rows))
+	for i, row := range rows {
+		result[i] = row.toEntry()
+		result[i].Errors = requestErrors[requestKey{
+			conversationID: row.ConversationID,
+			requestID:      row.RequestID,
+		}]
+	}
+	return result, nil
+}
+
+// Prune removes the requests made before the given time, along with
+// conversations started before it that no longer hold any requests. It
+// returns the number of requests removed.
+func (st *State) Prune(ctx context.Context, before time.Time) (int64, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	deleteErrorsStmt, err := st.Prepare(`
+DELETE FROM audit_log_request_error
+WHERE  (conversation_id, request_id) IN (
+    SELECT conversation_id, request_id
+    FROM   audit_log_request
+    WHERE  created_at < $cutoff.cutoff
+);`, cutoff{})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	deleteRequestsStmt, err := st.Prepare(`
+DELETE FROM audit_log_request
+WHERE  created_at < $cutoff.cutoff;`, cutoff{})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	deleteConversationsStmt, err := st.Prepare(`
+DELETE FROM audit_log_conversation
+WHERE  created_at < $cutoff.cutoff
+AND    conversation_id NOT IN (
+    SELECT conversation_id FROM audit_log_request
+);`, cutoff{})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	input := cutoff{Time: before}
+	var pruned int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, deleteErrorsStmt, input).Run(); err != nil {
+			return errors.Errorf("pruning audit log request errors: %w", err)
+		}
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, deleteRequestsStmt, input).Get(&outcome); err != nil {
+			return errors.Errorf("pruning audit log requests: %w", err)
+		}
+		var err error
+		if pruned, err = outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, deleteConversationsStmt, input).Run(); err != nil {
+			return errors.Errorf("pruning audit log conversations: %w", err)
+		}
+		return nil
+	})
+	return pruned, errors.Capture(err)
+}
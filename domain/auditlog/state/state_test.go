@@ -0,0 +1,221 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/auditlog"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+
+	now time.Time
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) SetUpTest(c *tc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *stateSuite) addConversation(c *tc.C, st *State, id, who, modelName string, when time.Time) {
+	err := st.AddConversation(c.Context(), auditlog.Conversation{
+		ConversationID: id,
+		ConnectionID:   "1A",
+		Who:            who,
+		What:           "juju deploy mysql",
+		ModelName:      modelName,
+		ModelUUID:      modelName + "-uuid",
+		When:           when,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) addRequest(c *tc.C, st *State, conversationID string, requestID uint64, facade, method string, when time.Time) {
+	err := st.AddRequest(c.Context(), auditlog.Request{
+		ConversationID: conversationID,
+		RequestID:      requestID,
+		Facade:         facade,
+		Method:         method,
+		Version:        1,
+		Args:           `{"foo":"bar"}`,
+		When:           when,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) addResponse(c *tc.C, st *State, conversationID string, requestID uint64, when time.Time, errs ...auditlog.Error) {
+	outcome := auditlog.OutcomeSucceeded
+	if len(errs) > 0 {
+		outcome = auditlog.OutcomeFailed
+	}
+	err := st.AddResponse(c.Context(), auditlog.Response{
+		ConversationID: conversationID,
+		RequestID:      requestID,
+		When:           when,
+		Outcome:        outcome,
+		Errors:         errs,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// populate adds two conversations: alice deploying to model foo, with one
+// successful and one failed request, and bob querying status of model bar.
+func (s *stateSuite) populate(c *tc.C, st *State) {
+	s.addConversation(c, st, "c1", "alice", "admin/foo", s.now)
+	s.addRequest(c, st, "c1", 1, "Application", "Deploy", s.now.Add(time.Second))
+	s.addResponse(c, st, "c1", 1, s.now.Add(2*time.Second))
+	s.addRequest(c, st, "c1", 2, "Application", "DestroyApplication", s.now.Add(3*time.Second))
+	s.addResponse(c, st, "c1", 2, s.now.Add(4*time.Second), auditlog.Error{Code: "not found", Message: "application not found"})
+
+	s.addConversation(c, st, "c2", "bob", "admin/bar", s.now.Add(time.Minute))
+	s.addRequest(c, st, "c2", 1, "Client", "FullStatus", s.now.Add(time.Minute+time.Second))
+}
+
+func (s *stateSuite) TestQueryAll(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	s.populate(c, st)
+
+	entries, err := st.Query(c.Context(), auditlog.Filter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 3)
+
+	c.Check(entries[0].Conversation, tc.DeepEquals, auditlog.Conversation{
+		ConversationID: "c1",
+		ConnectionID:   "1A",
+		Who:            "alice",
+		What:           "juju deploy mysql",
+		ModelName:      "admin/foo",
+		ModelUUID:      "admin/foo-uuid",
+		When:           s.now,
+	})
+	c.Check(entries[0].Request, tc.DeepEquals, auditlog.Request{
+		ConversationID: "c1",
+		RequestID:      1,
+		Facade:         "Application",
+		Method:         "Deploy",
+		Version:        1,
+		Args:           `{"foo":"bar"}`,
+		When:           s.now.Add(time.Second),
+	})
+	c.Check(entries[0].Outcome, tc.Equals, auditlog.OutcomeSucceeded)
+	c.Assert(entries[0].RespondedAt, tc.NotNil)
+	c.Check(entries[0].RespondedAt.Equal(s.now.Add(2*time.Second)), tc.IsTrue)
+	c.Check(entries[0].Errors, tc.HasLen, 0)
+
+	c.Check(entries[1].Request.Method, tc.Equals, "DestroyApplication")
+	c.Check(entries[1].Outcome, tc.Equals, auditlog.OutcomeFailed)
+	c.Check(entries[1].Errors, tc.DeepEquals, []auditlog.Error{{Code: "not found", Message: "application not found"}})
+
+	c.Check(entries[2].Conversation.Who, tc.Equals, "bob")
+	c.Check(entries[2].Outcome, tc.Equals, auditlog.OutcomeUnknown)
+	c.Check(entries[2].RespondedAt, tc.IsNil)
+}
+
+func (s *stateSuite) TestQueryEmpty(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	entries, err := st.Query(c.Context(), auditlog.Filter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestQueryFilters(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	s.populate(c, st)
+
+	tests := []struct {
+		filter  auditlog.Filter
+		methods []string
+	}{{
+		filter:  auditlog.Filter{User: "alice"},
+		methods: []string{"Deploy", "DestroyApplication"},
+	}, {
+		filter:  auditlog.Filter{Model: "admin/bar"},
+		methods: []string{"FullStatus"},
+	}, {
+		filter:  auditlog.Filter{Model: "admin/foo-uuid"},
+		methods: []string{"Deploy", "DestroyApplication"},
+	}, {
+		filter:  auditlog.Filter{Facade: "Application", Method: "DestroyApplication"},
+		methods: []string{"DestroyApplication"},
+	}, {
+		filter:  auditlog.Filter{Since: s.now.Add(3 * time.Second)},
+		methods: []string{"DestroyApplication", "FullStatus"},
+	}, {
+		filter:  auditlog.Filter{Until: s.now.Add(3 * time.Second)},
+		methods: []string{"Deploy"},
+	}, {
+		filter:  auditlog.Filter{Outcome: auditlog.OutcomeFailed},
+		methods: []string{"DestroyApplication"},
+	}, {
+		filter:  auditlog.Filter{Outcome: auditlog.OutcomeUnknown},
+		methods: []string{"FullStatus"},
+	}, {
+		filter:  auditlog.Filter{Limit: 2},
+		methods: []string{"DestroyApplication", "FullStatus"},
+	}, {
+		filter:  auditlog.Filter{User: "carol"},
+		methods: []string{},
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %+v", i, test.filter)
+		entries, err := st.Query(c.Context(), test.filter)
+		c.Assert(err, tc.ErrorIsNil)
+		methods := make([]string, len(entries))
+		for j, entry := range entries {
+			methods[j] = entry.Request.Method
+		}
+		c.Check(methods, tc.DeepEquals, test.methods)
+	}
+}
+
+func (s *stateSuite) TestAddConversationIdempotent(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	s.addConversation(c, st, "c1", "alice", "admin/foo", s.now)
+	s.addConversation(c, st, "c1", "alice", "admin/foo", s.now)
+}
+
+func (s *stateSuite) TestAddRequestUnknownConversation(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	s.addRequest(c, st, "c1", 1, "Application", "Deploy", s.now)
+	s.addResponse(c, st, "c1", 1, s.now, auditlog.Error{Message: "boom"})
+
+	entries, err := st.Query(c.Context(), auditlog.Filter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestPrune(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	s.populate(c, st)
+
+	pruned, err := st.Prune(c.Context(), s.now.Add(30*time.Second))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(2))
+
+	entries, err := st.Query(c.Context(), auditlog.Filter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(entries, tc.HasLen, 1)
+	c.Check(entries[0].Request.Method, tc.Equals, "FullStatus")
+
+	for _, table := range []string{"audit_log_conversation", "audit_log_request_error"} {
+		var count int
+		row := s.DB().QueryRow("SELECT COUNT(*) FROM " + table)
+		c.Assert(row.Scan(&count), tc.ErrorIsNil)
+		c.Check(count, tc.Equals, map[string]int{
+			"audit_log_conversation":  1,
+			"audit_log_request_error": 0,
+		}[table], tc.Commentf("table %s", table))
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/juju/juju/domain/auditlog"
+)
+
+// conversation represents a row from the audit_log_conversation table.
+type conversation struct {
+	ConversationID string    `db:"conversation_id"`
+	ConnectionID   string    `db:"connection_id"`
+	Who            string    `db:"who"`
+	What           string    `db:"what"`
+	ModelName      string    `db:"model_name"`
+	ModelUUID      string    `db:"model_uuid"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// request represents a row from the audit_log_request table.
+type request struct {
+	ConversationID string    `db:"conversation_id"`
+	RequestID      int64     `db:"request_id"`
+	Facade         string    `db:"facade"`
+	Method         string    `db:"method"`
+	Version        int       `db:"version"`
+	Args           string    `db:"args"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// response holds the columns of the audit_log_request table set when the
+// response to a request is recorded.
+type response struct {
+	ConversationID string    `db:"conversation_id"`
+	RequestID      int64     `db:"request_id"`
+	OutcomeID      int       `db:"outcome_id"`
+	RespondedAt    time.Time `db:"responded_at"`
+}
+
+// requestError represents a row from the audit_log_request_error table.
+type requestError struct {
+	ConversationID string `db:"conversation_id"`
+	RequestID      int64  `db:"request_id"`
+	Index          int    `db:"idx"`
+	Code           string `db:"code"`
+	Message        string `db:"message"`
+}
+
+// entry holds a request joined with its conversation and outcome.
+type entry struct {
+	ConversationID string       `db:"conversation_id"`
+	ConnectionID   string       `db:"connection_id"`
+	Who            string       `db:"who"`
+	What           string       `db:"what"`
+	ModelName      string       `db:"model_name"`
+	ModelUUID      string       `db:"model_uuid"`
+	StartedAt      time.Time    `db:"started_at"`
+	RequestID      int64        `db:"request_id"`
+	Facade         string       `db:"facade"`
+	Method         string       `db:"method"`
+	Version        int          `db:"version"`
+	Args           string       `db:"args"`
+	CreatedAt      time.Time    `db:"created_at"`
+	RespondedAt    sql.NullTime `db:"responded_at"`
+	Outcome        string       `db:"outcome"`
+}
+
+// filter holds the input values of an audit log query.
+type filter struct {
+	User    string    `db:"who"`
+	Model   string    `db:"model"`
+	Facade  string    `db:"facade"`
+	Method  string    `db:"method"`
+	Since   time.Time `db:"since"`
+	Until   time.Time `db:"until"`
+	Outcome string    `db:"outcome"`
+	Limit   int       `db:"limit"`
+}
+
+// cutoff holds the time before which audit records are pruned.
+type cutoff struct {
+	Time time.Time `db:"cutoff"`
+}
+
+// conversationIDs holds the IDs of a set of conversations.
+type conversationIDs []string
+
+// outcomeIDs maps outcomes to their ID in the audit_log_outcome table.
+var outcomeIDs = map[auditlog.Outcome]int{
+	auditlog.OutcomeUnknown:   0,
+	auditlog.OutcomeSucceeded: 1,
+	auditlog.OutcomeFailed:    2,
+}
+
+func (e entry) toEntry() auditlog.Entry {
+	result := auditlog.Entry{
+		Conversation: auditlog.Conversation{
+			ConversationID: e.ConversationID,
+			ConnectionID:   e.ConnectionID,
+			Who:            e.Who,
+			What:           e.What,
+			ModelName:      e.ModelName,
+			ModelUUID:      e.ModelUUID,
+			When:           e.StartedAt,
+		},
+		Request: auditlog.Request{
+			ConversationID: e.ConversationID,
+			RequestID:      uint64(e.RequestID),
+			Facade:         e.Facade,
+			Method:         e.Method,
+			Version:        e.Version,
+			Args:           e.Args,
+			When:           e.CreatedAt,
+		},
+		Outcome: auditlog.Outcome(e.Outcome),
+	}
+	if e.RespondedAt.Valid {
+		respondedAt := e.RespondedAt.Time
+		result.RespondedAt = &respondedAt
+	}
+	return result
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog
+
+import "time"
+
+// Outcome describes the result of an audited API request.
+type Outcome string
+
+const (
+	// OutcomeUnknown is the outcome of a request for which no response has
+	// been recorded.
+	OutcomeUnknown Outcome = "unknown"
+
+	// OutcomeSucceeded is the outcome of a request whose response held no
+	// errors.
+	OutcomeSucceeded Outcome = "succeeded"
+
+	// OutcomeFailed is the outcome of a request whose response held at
+	// least one error.
+	OutcomeFailed Outcome = "failed"
+)
+
+// IsValid reports whether the outcome is one of the known outcomes.
+func (o Outcome) IsValid() bool {
+	switch o {
+	case OutcomeUnknown, OutcomeSucceeded, OutcomeFailed:
+		return true
+	}
+	return false
+}
+
+// Conversation is the audit record of a client connection to the API.
+type Conversation struct {
+	ConversationID string
+	ConnectionID   string
+	Who            string
+	What           string
+	ModelName      string
+	ModelUUID      string
+	When           time.Time
+}
+
+// Request is the audit record of an API request made in a conversation.
+type Request struct {
+	ConversationID string
+	RequestID      uint64
+	Facade         string
+	Method         string
+	Version        int
+	Args           string
+	When           time.Time
+}
+
+// Response is the audit record of the response to an API request.
+type Response struct {
+	ConversationID string
+	RequestID      uint64
+	When           time.Time
+	Outcome        Outcome
+	Errors         []Error
+}
+
+// Error is an error returned in the response to an API request.
+type Error struct {
+	Code    string
+	Message string
+}
+
+// Entry is a single API request in the audit log, along with the
+// conversation it was made in and its outcome.
+type Entry struct {
+	Conversation Conversation
+	Request      Request
+	Outcome      Outcome
+	RespondedAt  *time.Time
+	Errors       []Error
+}
+
+// Filter selects the entries returned by an audit log query. Zero valued
+// fields do not restrict the entries returned.
+type Filter struct {
+	// User restricts entries to conversations held by the given user.
+	User string
+	// Model restricts entries to conversations with the model with the
+	// given name or UUID.
+	Model string
+	// Facade restricts entries to requests to the given facade.
+	Facade string
+	// Method restricts entries to requests to the given method.
+	Method string
+	// Since restricts entries to requests made at or after this time.
+	Since time.Time
+	// Until restricts entries to requests made before this time.
+	Until time.Time
+	// Outcome restricts entries to requests with the given outcome.
+	Outcome Outcome
+	// Limit is the maximum number of entries returned, the most recent
+	// being kept.
+	Limit int
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// CursorExpired describes an error that occurs when the changes after a
+	// cursor have been pruned from the change log, so the changes can no
+	// longer be read from it.
+	CursorExpired = errors.ConstError("change log cursor expired")
+
+	// ConsumerNotFound describes an error that occurs when a change log
+	// export consumer does not exist and the reader is not allowed to
+	// create it.
+	ConsumerNotFound = errors.ConstError("change log export consumer not found")
+
+	// ConsumerLimitExceeded describes an error that occurs when creating a
+	// change log export consumer would exceed the number of consumers a
+	// user may have.
+	ConsumerLimitExceeded = errors.ConstError("change log export consumer limit exceeded")
+)
@@ -10,11 +10,26 @@ import (
 	"github.com/juju/juju/domain/changestream"
 )
 
+const (
+	// DefaultChangesLimit is the number of changes returned by Changes
+	// when no limit is requested.
+	DefaultChangesLimit = 100
+
+	// MaxChangesLimit is the largest number of changes returned by a
+	// single call to Changes.
+	MaxChangesLimit = 1000
+)
+
 // State defines an interface for interacting with the underlying state.
 type State interface {
 	// Prune prunes the change log up to the lowest watermark across all
 	// controllers. It returns the number of rows pruned.
 	Prune(ctx context.Context, currentWindow changestream.Window) (changestream.Window, int64, error)
+
+	// ReadChanges returns up to limit changes from the change log with an
+	// ID greater than after, optionally filtered by namespace, and moves
+	// the consumer's export cursor.
+	ReadChanges(ctx context.Context, consumer changestream.Consumer, after int64, limit int, namespaces []string) (changestream.ChangePage, error)
 }
 
 // Service defines a service for interacting with the underlying state.
@@ -37,3 +52,35 @@ func (s *Service) Prune(ctx context.Context, currentWindow changestream.Window)
 
 	return s.st.Prune(ctx, currentWindow)
 }
+
+// Changes returns a page of changes from the change log with an ID greater
+// than after. If after is negative, the changes are read from the oldest
+// change retained in the change log. If limit is not positive,
+// [DefaultChangesLimit] changes are returned at most, and never more than
+// [MaxChangesLimit]. If namespaces is not empty, only changes in those
+// namespaces are returned.
+//
+// If the consumer has a name, the change log is retained from after until
+// the consumer reads it, so that the consumer can resume reading from the
+// returned cursor without missing changes.
+//
+// The following errors may be returned:
+// - [changestreamerrors.CursorExpired] if changes after the cursor have
+// already been pruned from the change log.
+// - [changestreamerrors.ConsumerNotFound] if the consumer does not exist
+// and may not be created.
+// - [changestreamerrors.ConsumerLimitExceeded] if the consumer's owner
+// already has [changestream.MaxConsumersPerOwner] consumers.
+func (s *Service) Changes(
+	ctx context.Context, consumer changestream.Consumer, after int64, limit int, namespaces []string,
+) (changestream.ChangePage, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if limit <= 0 {
+		limit = DefaultChangesLimit
+	} else if limit > MaxChangesLimit {
+		limit = MaxChangesLimit
+	}
+	return s.st.ReadChanges(ctx, consumer, after, limit, namespaces)
+}
@@ -44,6 +44,42 @@ func (s *serviceSuite) TestPrune(c *tc.C) {
 	})
 }
 
+func (s *serviceSuite) TestChanges(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	page := changestream.ChangePage{
+		Events: []changestream.ChangeEvent{{
+			ID:         43,
+			Namespace:  "application",
+			Changed:    "foo",
+			ChangeType: "create",
+		}},
+		Cursor: 43,
+	}
+	consumer := changestream.Consumer{Owner: "admin", Name: "cmdb", CanCreate: true}
+	s.state.EXPECT().ReadChanges(gomock.Any(), consumer, int64(42), 10, []string{"application"}).Return(page, nil)
+
+	svc := NewService(s.state)
+
+	result, err := svc.Changes(c.Context(), consumer, 42, 10, []string{"application"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, page)
+}
+
+func (s *serviceSuite) TestChangesLimit(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ReadChanges(gomock.Any(), changestream.Consumer{}, int64(-1), DefaultChangesLimit, nil).Return(changestream.ChangePage{}, nil)
+	s.state.EXPECT().ReadChanges(gomock.Any(), changestream.Consumer{}, int64(-1), MaxChangesLimit, nil).Return(changestream.ChangePage{}, nil)
+
+	svc := NewService(s.state)
+
+	_, err := svc.Changes(c.Context(), changestream.Consumer{}, -1, 0, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = svc.Changes(c.Context(), changestream.Consumer{}, -1, MaxChangesLimit+1, nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
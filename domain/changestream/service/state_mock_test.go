@@ -25,8 +25,9 @@ type MockState struct {
 
 // MockStateMockRecorder is the mock recorder for MockState.
 type MockStateMockRecorder struct {
-	mock         *MockState
-	pruneExpects []*gomock.Call2_3[context.Context, changestream.Window, changestream.Window, int64, error]
+	mock               *MockState
+	pruneExpects       []*gomock.Call2_3[context.Context, changestream.Window, changestream.Window, int64, error]
+	readChangesExpects []*gomock.Call5_2[context.Context, changestream.Consumer, int64, int, []string, changestream.ChangePage, error]
 }
 
 // NewMockState creates a new mock instance.
@@ -58,3 +59,21 @@ func (mr *MockStateMockRecorder) Prune(ctx, currentWindow any) *MockStatePruneCa
 
 // MockStatePruneCall is the typed call wrapper for Prune.
 type MockStatePruneCall = gomock.Call2_3[context.Context, changestream.Window, changestream.Window, int64, error]
+
+// ReadChanges mocks base method.
+func (m *MockState) ReadChanges(ctx context.Context, consumer changestream.Consumer, after int64, limit int, namespaces []string) (changestream.ChangePage, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_2(&m.recorder.readChangesExpects, m.ctrl, m, "ReadChanges", ctx, consumer, after, limit, namespaces)
+}
+
+// ReadChanges indicates an expected call of ReadChanges.
+func (mr *MockStateMockRecorder) ReadChanges(ctx, consumer, after, limit, namespaces any) *MockStateReadChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_2[context.Context, changestream.Consumer, int64, int, []string, changestream.ChangePage, error](mr.mock.ctrl.T, mr.mock, "ReadChanges", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(consumer), gomock.EnsureMatcher(after), gomock.EnsureMatcher(limit), gomock.EnsureMatcher(namespaces))
+	mr.readChangesExpects = append(mr.readChangesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateReadChangesCall is the typed call wrapper for ReadChanges.
+type MockStateReadChangesCall = gomock.Call5_2[context.Context, changestream.Consumer, int64, int, []string, changestream.ChangePage, error]
@@ -0,0 +1,207 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/domain/changestream"
+	changestreamerrors "github.com/juju/juju/domain/changestream/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ReadChanges returns up to limit changes from the change log with an ID
+// greater than after, ordered by ID. If after is negative, the changes are
+// read from the oldest change retained in the change log. If
+// namespaceFilter is not empty, only changes in those namespaces are
+// returned.
+//
+// If the consumer has a name, the consumer's export cursor is moved to the
+// position the changes are read from, so that the changes after it are
+// retained in the change log until the consumer reads them, or stops reading
+// for longer than the export retention period. The cursor is only created
+// if the consumer may be created and its owner has fewer than
+// [changestream.MaxConsumersPerOwner] cursors.
+//
+// The following errors may be returned:
+// - [changestreamerrors.CursorExpired] if changes after the cursor have
+// already been pruned from the change log.
+// - [changestreamerrors.ConsumerNotFound] if the consumer's cursor does not
+// exist and the consumer may not be created.
+// - [changestreamerrors.ConsumerLimitExceeded] if the consumer's owner
+// already has the maximum number of cursors.
+func (st *State) ReadChanges(
+	ctx context.Context, consumer changestream.Consumer, after int64, limit int, namespaceFilter []string,
+) (changestream.ChangePage, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	boundsQuery, err := st.Prepare(`
+SELECT COALESCE((SELECT MIN(id) FROM change_log), 0) AS &changeLogBounds.lower,
+       COALESCE((SELECT seq FROM sqlite_sequence WHERE name = 'change_log'), 0) AS &changeLogBounds.upper;
+`, changeLogBounds{})
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	existsCursorQuery, err := st.Prepare(`
+SELECT &exportCursor.*
+FROM   change_log_export_cursor
+WHERE  owner = $exportCursor.owner
+AND    consumer = $exportCursor.consumer;
+`, exportCursor{})
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	countCursorsQuery, err := st.Prepare(`
+SELECT COUNT(*) AS &cursorCount.count
+FROM   change_log_export_cursor
+WHERE  owner = $cursorOwner.owner;
+`, cursorCount{}, cursorOwner{})
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	upsertCursorQuery, err := st.Prepare(`
+INSERT INTO change_log_export_cursor (*) VALUES ($exportCursor.*)
+ON CONFLICT (owner, consumer) DO UPDATE SET
+    position = excluded.position,
+    updated_at = excluded.updated_at;
+`, exportCursor{})
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	selectStmt := `
+SELECT    (cl.id, cl.changed, cl.created_at) AS (&changeEvent.*),
+          n.namespace AS &changeEvent.namespace,
+          et.edit_type AS &changeEvent.edit_type
+FROM      change_log AS cl
+JOIN      change_log_namespace AS n ON cl.namespace_id = n.id
+JOIN      change_log_edit_type AS et ON cl.edit_type_id = et.id
+WHERE     cl.id > $readArgs.after`
+	selectTypes := []any{changeEvent{}, readArgs{}}
+	if len(namespaceFilter) > 0 {
+		selectStmt += `
+AND       n.namespace IN ($namespaces[:])`
+		selectTypes = append(selectTypes, namespaces{})
+	}
+	selectStmt += `
+ORDER BY  cl.id
+LIMIT     $readArgs.limit;`
+	selectQuery, err := st.Prepare(selectStmt, selectTypes...)
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	var (
+		bounds changeLogBounds
+		from   int64
+		events []changeEvent
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, boundsQuery).Get(&bounds); err != nil {
+			return errors.Errorf("getting change log bounds: %w", err)
+		}
+
+		// An empty change log retains nothing, so any change after the
+		// cursor up to the last allocated ID has been pruned.
+		if after >= 0 && ((bounds.Lower == 0 && bounds.Upper > after) || bounds.Lower > after+1) {
+			return errors.Errorf("reading changes after %d: %w", after, changestreamerrors.CursorExpired)
+		}
+
+		// Without a cursor, read from the oldest retained change, or from
+		// the head of the change log if nothing is retained.
+		from = after
+		if after < 0 && bounds.Lower > 0 {
+			from = bounds.Lower - 1
+		} else if after < 0 {
+			from = bounds.Upper
+		}
+
+		if consumer.Name != "" {
+			cursor := exportCursor{
+				Owner:     consumer.Owner,
+				Consumer:  consumer.Name,
+				Position:  from,
+				UpdatedAt: st.clock.Now().UTC(),
+			}
+			err := tx.Query(ctx, existsCursorQuery, cursor).Get(&exportCursor{})
+			if errors.Is(err, sqlair.ErrNoRows) {
+				if err := st.checkCanCreateCursor(ctx, tx, countCursorsQuery, consumer); err != nil {
+					return errors.Capture(err)
+				}
+			} else if err != nil {
+				return errors.Errorf("getting export cursor for %q: %w", consumer.Name, err)
+			}
+
+			if err := tx.Query(ctx, upsertCursorQuery, cursor).Run(); err != nil {
+				return errors.Errorf("updating export cursor for %q: %w", consumer.Name, err)
+			}
+		}
+
+		// Read one more change than requested to know whether there are
+		// more changes after the page.
+		args := []any{readArgs{After: from, Limit: limit + 1}}
+		if len(namespaceFilter) > 0 {
+			args = append(args, namespaces(namespaceFilter))
+		}
+		err := tx.Query(ctx, selectQuery, args...).GetAll(&events)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("reading changes after %d: %w", from, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return changestream.ChangePage{}, errors.Capture(err)
+	}
+
+	page := changestream.ChangePage{
+		Cursor: max(from, bounds.Upper),
+	}
+	if len(events) > limit {
+		events = events[:limit]
+		page.More = true
+	}
+	if len(events) > 0 {
+		page.Cursor = events[len(events)-1].ID
+	}
+	page.Events = make([]changestream.ChangeEvent, len(events))
+	for i, event := range events {
+		page.Events[i] = changestream.ChangeEvent{
+			ID:         event.ID,
+			Namespace:  event.Namespace,
+			Changed:    event.Changed,
+			ChangeType: event.ChangeType,
+			CreatedAt:  event.CreatedAt,
+		}
+	}
+	return page, nil
+}
+
+// checkCanCreateCursor checks that the consumer's export cursor may be
+// created.
+func (st *State) checkCanCreateCursor(
+	ctx context.Context, tx *sqlair.TX, countQuery *sqlair.Statement, consumer changestream.Consumer,
+) error {
+	if !consumer.CanCreate {
+		return errors.Errorf("consumer %q of %q: %w", consumer.Name, consumer.Owner, changestreamerrors.ConsumerNotFound)
+	}
+
+	var count cursorCount
+	if err := tx.Query(ctx, countQuery, cursorOwner{Owner: consumer.Owner}).Get(&count); err != nil {
+		return errors.Errorf("counting export cursors of %q: %w", consumer.Owner, err)
+	}
+	if count.Count >= changestream.MaxConsumersPerOwner {
+		return errors.Errorf(
+			"%q already has %d consumers: %w", consumer.Owner, count.Count, changestreamerrors.ConsumerLimitExceeded,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain/changestream"
+	changestreamerrors "github.com/juju/juju/domain/changestream/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func (s *stateSuite) TestReadChanges(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	now := time.Now().Truncate(time.Second).UTC()
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 5, now)
+
+	page, err := st.ReadChanges(c.Context(), changestream.Consumer{}, 1001, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Cursor, tc.Equals, int64(1003))
+	c.Check(page.More, tc.IsTrue)
+	c.Assert(page.Events, tc.HasLen, 2)
+	c.Check(page.Events[0].ID, tc.Equals, int64(1002))
+	c.Check(page.Events[0].Namespace, tc.Equals, "controller_config")
+	c.Check(page.Events[0].Changed, tc.Equals, "0")
+	c.Check(page.Events[0].ChangeType, tc.Equals, "delete")
+	c.Check(page.Events[0].CreatedAt.Equal(now), tc.IsTrue)
+	c.Check(page.Events[1].ID, tc.Equals, int64(1003))
+
+	page, err = st.ReadChanges(c.Context(), changestream.Consumer{}, page.Cursor, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Cursor, tc.Equals, int64(1004))
+	c.Check(page.More, tc.IsFalse)
+	c.Assert(page.Events, tc.HasLen, 1)
+	c.Check(page.Events[0].ID, tc.Equals, int64(1004))
+
+	page, err = st.ReadChanges(c.Context(), changestream.Consumer{}, page.Cursor, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Cursor, tc.Equals, int64(1004))
+	c.Check(page.More, tc.IsFalse)
+	c.Check(page.Events, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestReadChangesFromOldestRetained(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 3, time.Now())
+
+	page, err := st.ReadChanges(c.Context(), changestream.Consumer{}, -1, 10, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Cursor, tc.Equals, int64(1002))
+	c.Assert(page.Events, tc.HasLen, 3)
+	c.Check(page.Events[0].ID, tc.Equals, int64(1000))
+}
+
+func (s *stateSuite) TestReadChangesEmptyChangeLog(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 3, time.Now())
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	// Reading from the start of an empty change log starts at its head.
+	page, err := st.ReadChanges(c.Context(), changestream.Consumer{}, -1, 10, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Cursor, tc.Equals, int64(1002))
+	c.Check(page.Events, tc.HasLen, 0)
+
+	page, err = st.ReadChanges(c.Context(), changestream.Consumer{}, 1002, 10, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Cursor, tc.Equals, int64(1002))
+	c.Check(page.Events, tc.HasLen, 0)
+
+	_, err = st.ReadChanges(c.Context(), changestream.Consumer{}, 1001, 10, nil)
+	c.Check(err, tc.ErrorIs, changestreamerrors.CursorExpired)
+}
+
+func (s *stateSuite) TestReadChangesCursorExpired(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 5, 10, time.Now())
+
+	_, err := st.ReadChanges(c.Context(), changestream.Consumer{}, 1003, 10, nil)
+	c.Check(err, tc.ErrorIs, changestreamerrors.CursorExpired)
+
+	page, err := st.ReadChanges(c.Context(), changestream.Consumer{}, 1004, 10, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Events, tc.HasLen, 5)
+}
+
+func (s *stateSuite) TestReadChangesNamespaceFilter(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 3, time.Now())
+
+	page, err := st.ReadChanges(c.Context(), changestream.Consumer{}, -1, 10, []string{"controller_config"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Events, tc.HasLen, 3)
+
+	page, err = st.ReadChanges(c.Context(), changestream.Consumer{}, -1, 10, []string{"cloud"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(page.Events, tc.HasLen, 0)
+	c.Check(page.Cursor, tc.Equals, int64(1002))
+}
+
+func (s *stateSuite) TestReadChangesUpdatesExportCursor(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 5, time.Now())
+
+	consumer := changestream.Consumer{Owner: "admin", Name: "cmdb", CanCreate: true}
+	_, err := st.ReadChanges(c.Context(), consumer, -1, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	s.expectExportCursors(c, s.TxnRunner(), map[string]int64{"admin/cmdb": 999})
+
+	// Once created, the consumer can be read without creating it.
+	consumer.CanCreate = false
+	_, err = st.ReadChanges(c.Context(), consumer, 1001, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	s.expectExportCursors(c, s.TxnRunner(), map[string]int64{"admin/cmdb": 1001})
+}
+
+func (s *stateSuite) TestReadChangesExportCursorScopedToOwner(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 5, time.Now())
+
+	_, err := st.ReadChanges(c.Context(), changestream.Consumer{Owner: "admin", Name: "cmdb", CanCreate: true}, 1001, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Another user using the same consumer name doesn't move the admin's
+	// cursor, and can't create a consumer of their own.
+	_, err = st.ReadChanges(c.Context(), changestream.Consumer{Owner: "bob", Name: "cmdb"}, -1, 2, nil)
+	c.Check(err, tc.ErrorIs, changestreamerrors.ConsumerNotFound)
+	s.expectExportCursors(c, s.TxnRunner(), map[string]int64{"admin/cmdb": 1001})
+}
+
+func (s *stateSuite) TestReadChangesExportCursorLimit(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 5, time.Now())
+
+	expected := make(map[string]int64)
+	for i := range changestream.MaxConsumersPerOwner {
+		name := fmt.Sprintf("consumer-%d", i)
+		_, err := st.ReadChanges(c.Context(), changestream.Consumer{Owner: "admin", Name: name, CanCreate: true}, 1001, 2, nil)
+		c.Assert(err, tc.ErrorIsNil)
+		expected["admin/"+name] = 1001
+	}
+
+	_, err := st.ReadChanges(c.Context(), changestream.Consumer{Owner: "admin", Name: "one-too-many", CanCreate: true}, 1001, 2, nil)
+	c.Check(err, tc.ErrorIs, changestreamerrors.ConsumerLimitExceeded)
+
+	// Existing consumers can still be read, and other users can still
+	// create consumers.
+	_, err = st.ReadChanges(c.Context(), changestream.Consumer{Owner: "admin", Name: "consumer-0", CanCreate: true}, 1002, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	expected["admin/consumer-0"] = 1002
+	_, err = st.ReadChanges(c.Context(), changestream.Consumer{Owner: "alice", Name: "consumer-0", CanCreate: true}, 1001, 2, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	expected["alice/consumer-0"] = 1001
+	s.expectExportCursors(c, s.TxnRunner(), expected)
+}
+
+func (s *stateSuite) TestPruneRetainsChangeLogForExportCursor(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	now := time.Now().Truncate(time.Second)
+
+	s.insertChangeLogWitness(c, s.TxnRunner(), Watermark{ControllerID: "0", LowerBound: 1008, UpdatedAt: now})
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 10, now)
+	s.insertExportCursor(c, s.TxnRunner(), exportCursor{Owner: "admin", Consumer: "audit", Position: 1004, UpdatedAt: now.Add(-time.Hour)})
+
+	_, pruned, err := st.Prune(c.Context(), changestream.Window{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(5))
+
+	s.expectChangeLogItems(c, s.TxnRunner(), 5, 1005, 1009)
+	s.expectExportCursors(c, s.TxnRunner(), map[string]int64{"admin/audit": 1004})
+}
+
+func (s *stateSuite) TestPruneDiscardsExpiredExportCursor(c *tc.C) {
+	s.truncateChangeLog(c, s.TxnRunner())
+
+	st := NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	now := time.Now().Truncate(time.Second)
+
+	s.insertChangeLogWitness(c, s.TxnRunner(), Watermark{ControllerID: "0", LowerBound: 1008, UpdatedAt: now})
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 10, now)
+	s.insertExportCursor(c, s.TxnRunner(),
+		exportCursor{Owner: "admin", Consumer: "audit", Position: 1004, UpdatedAt: now.Add(-(defaultExportRetention + time.Minute))},
+		exportCursor{Owner: "admin", Consumer: "cmdb", Position: 1006, UpdatedAt: now},
+	)
+
+	_, pruned, err := st.Prune(c.Context(), changestream.Window{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.Equals, int64(7))
+
+	s.expectChangeLogItems(c, s.TxnRunner(), 3, 1007, 1009)
+	s.expectExportCursors(c, s.TxnRunner(), map[string]int64{"admin/cmdb": 1006})
+}
+
+func (s *stateSuite) insertExportCursor(c *tc.C, runner coredatabase.TxnRunner, cursors ...exportCursor) {
+	query, err := sqlair.Prepare(`
+INSERT INTO change_log_export_cursor (*) VALUES ($exportCursor.*);
+`, exportCursor{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = runner.Txn(c.Context(), func(ctx context.Context, tx *sqlair.TX) error {
+		for _, cursor := range cursors {
+			if err := tx.Query(ctx, query, cursor).Run(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) expectExportCursors(c *tc.C, runner coredatabase.TxnRunner, expected map[string]int64) {
+	query, err := sqlair.Prepare(`SELECT &exportCursor.* FROM change_log_export_cursor;`, exportCursor{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var got []exportCursor
+	err = runner.Txn(c.Context(), func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, query).GetAll(&got)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	positions := make(map[string]int64, len(got))
+	for _, cursor := range got {
+		positions[cursor.Owner+"/"+cursor.Consumer] = cursor.Position
+	}
+	c.Check(positions, tc.DeepEquals, expected)
+}
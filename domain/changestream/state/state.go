@@ -24,6 +24,12 @@ const (
 	// watermarks are outside of this window, they will not be selected and the
 	// pruner will discard those watermarks.
 	defaultWindowDuration = time.Minute * 10
+
+	// defaultExportRetention is the duration for which the change log is
+	// retained for an export consumer that has stopped reading it. Once a
+	// consumer's cursor hasn't been updated within this period, the cursor
+	// is discarded and the change log is no longer retained for it.
+	defaultExportRetention = time.Hour * 24
 )
 
 // State represents database interactions dealing with the changestream.
@@ -68,8 +74,19 @@ func (st *State) Prune(ctx context.Context, currentWindow changestream.Window) (
 		// change stream is keeping up with the pruner.
 		newWindow = window
 
+		// Retain the change log for export consumers that are still
+		// reading it, even if they are behind the controllers.
+		lowerBound := lowest.LowerBound
+		position, ok, err := st.locateLowestExportCursor(ctx, tx)
+		if err != nil {
+			return errors.Errorf("locating lowest export cursor: %w", err)
+		}
+		if ok && position < lowerBound {
+			lowerBound = position
+		}
+
 		// Prune the change log, using the lowest watermark.
-		pruned, err = st.deleteChangeLog(ctx, tx, lowest)
+		pruned, err = st.deleteChangeLog(ctx, tx, lowerBound)
 		if err != nil {
 			return errors.Errorf("deleting change log: %w", err)
 		}
@@ -127,7 +144,60 @@ func (st *State) locateLowestWatermark(ctx context.Context, tx *sqlair.TX, curre
 	return sorted[0], watermarkView, nil
 }
 
-func (st *State) deleteChangeLog(ctx context.Context, tx *sqlair.TX, lowest Watermark) (int64, error) {
+// locateLowestExportCursor discards the export cursors that haven't been
+// updated within the retention period and returns the lowest position of
+// the remaining ones. It returns false if there are no export cursors.
+func (st *State) locateLowestExportCursor(ctx context.Context, tx *sqlair.TX) (int64, bool, error) {
+	selectQuery, err := st.Prepare(`SELECT &exportCursor.* FROM change_log_export_cursor;`, exportCursor{})
+	if err != nil {
+		return -1, false, errors.Capture(err)
+	}
+	deleteQuery, err := st.Prepare(`
+DELETE FROM change_log_export_cursor
+WHERE owner = $exportCursor.owner
+AND   consumer = $exportCursor.consumer;`, exportCursor{})
+	if err != nil {
+		return -1, false, errors.Capture(err)
+	}
+
+	var cursors []exportCursor
+	if err := tx.Query(ctx, selectQuery).GetAll(&cursors); errors.Is(err, sqlair.ErrNoRows) {
+		return -1, false, nil
+	} else if err != nil {
+		return -1, false, errors.Capture(err)
+	}
+
+	var (
+		expired []exportCursor
+		names   []string
+		lowest  int64
+		found   bool
+	)
+	expiry := st.clock.Now().Add(-defaultExportRetention)
+	for _, cursor := range cursors {
+		if cursor.UpdatedAt.Before(expiry) {
+			expired = append(expired, cursor)
+			names = append(names, cursor.Owner+"/"+cursor.Consumer)
+			continue
+		}
+		if !found || cursor.Position < lowest {
+			lowest = cursor.Position
+			found = true
+		}
+	}
+
+	if len(expired) > 0 {
+		st.logger.Infof(ctx, "discarding expired change log export cursors %q", names)
+	}
+	for _, cursor := range expired {
+		if err := tx.Query(ctx, deleteQuery, cursor).Run(); err != nil {
+			return -1, false, errors.Errorf("deleting expired export cursor %q: %w", cursor.Consumer, err)
+		}
+	}
+	return lowest, found, nil
+}
+
+func (st *State) deleteChangeLog(ctx context.Context, tx *sqlair.TX, lowerBound int64) (int64, error) {
 	deleteQuery, err := st.Prepare(`DELETE FROM change_log WHERE id <= $M.id;`, sqlair.M{})
 	if err != nil {
 		return -1, errors.Capture(err)
@@ -135,7 +205,7 @@ func (st *State) deleteChangeLog(ctx context.Context, tx *sqlair.TX, lowest Wate
 
 	// Delete all the change logs that are lower than the lowest watermark.
 	var outcome sqlair.Outcome
-	if err := tx.Query(ctx, deleteQuery, sqlair.M{"id": lowest.LowerBound}).Get(&outcome); err != nil {
+	if err := tx.Query(ctx, deleteQuery, sqlair.M{"id": lowerBound}).Get(&outcome); err != nil {
 		return -1, errors.Capture(err)
 	}
 	pruned, err := outcome.Result().RowsAffected()
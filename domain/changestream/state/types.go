@@ -17,3 +17,47 @@ type Watermark struct {
 	LowerBound   int64     `db:"lower_bound"`
 	UpdatedAt    time.Time `db:"updated_at"`
 }
+
+// changeLogBounds holds the lowest retained and the highest allocated IDs
+// of the change log.
+type changeLogBounds struct {
+	Lower int64 `db:"lower"`
+	Upper int64 `db:"upper"`
+}
+
+// changeEvent represents a row from the change_log table, joined with its
+// namespace and edit type.
+type changeEvent struct {
+	ID         int64     `db:"id"`
+	Namespace  string    `db:"namespace"`
+	Changed    string    `db:"changed"`
+	ChangeType string    `db:"edit_type"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// exportCursor represents a row from the change_log_export_cursor table.
+type exportCursor struct {
+	Owner     string    `db:"owner"`
+	Consumer  string    `db:"consumer"`
+	Position  int64     `db:"position"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// readArgs holds the arguments of a change log read.
+type readArgs struct {
+	After int64 `db:"after"`
+	Limit int   `db:"limit"`
+}
+
+// namespaces is a list of change log namespaces.
+type namespaces []string
+
+// cursorOwner holds the owner of change log export cursors.
+type cursorOwner struct {
+	Owner string `db:"owner"`
+}
+
+// cursorCount holds a number of change log export cursors.
+type cursorCount struct {
+	Count int `db:"count"`
+}
@@ -29,3 +29,53 @@ func (w Window) Equals(o Window) bool {
 func (w Window) String() string {
 	return fmt.Sprintf("start: %s, end: %s", w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339))
 }
+
+// ChangeEvent is a change recorded in the change log, as exposed to
+// consumers outside of the controller.
+type ChangeEvent struct {
+	// ID is the position of the change in the change log. IDs are
+	// monotonically increasing.
+	ID int64
+	// Namespace is the namespace of the change, typically the table that
+	// was changed.
+	Namespace string
+	// Changed is the value that was changed, typically the primary key of
+	// the changed row.
+	Changed string
+	// ChangeType is one of create, update or delete.
+	ChangeType string
+	// CreatedAt is the time the change was recorded.
+	CreatedAt time.Time
+}
+
+// ChangePage is a page of changes read from the change log.
+type ChangePage struct {
+	// Events are the changes, ordered by ID.
+	Events []ChangeEvent
+	// Cursor is the position to read the next page after. It is the ID of
+	// the last event in the page, or the current head of the change log if
+	// the page is empty.
+	Cursor int64
+	// More is true if there are more changes after the cursor.
+	More bool
+}
+
+// MaxConsumersPerOwner is the maximum number of change log export consumers
+// a user may have in a model.
+const MaxConsumersPerOwner = 5
+
+// Consumer identifies a consumer reading the change log from outside of the
+// controller, for which the change log is retained.
+type Consumer struct {
+	// Owner is the name of the user the consumer belongs to. Consumers
+	// are scoped to their owner, so users cannot move each other's
+	// cursors.
+	Owner string
+	// Name is the name of the consumer, unique for its owner. If empty,
+	// no change log is retained for the reader.
+	Name string
+	// CanCreate reports whether the consumer may be created if it does
+	// not exist yet. Only model and controller admins may create
+	// consumers, as they hold back the pruning of the change log.
+	CanCreate bool
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package charmmirror holds the charm and bundle revisions served by the
+// controller's Charmhub mirror. The mirror answers the subset of the Charmhub
+// API used to deploy and refresh charms, so that other controllers can use
+// it in place of Charmhub when they cannot reach it.
+//
+// Revisions are added to the mirror either by importing a seed produced by
+// "juju download --seed", or by caching revisions fetched from an upstream
+// Charmhub when the mirror is configured with one. Archives are held in the
+// controller object store. Cached revisions are evicted, oldest first, once
+// they exceed the configured size; seeded revisions are never evicted.
+//
+// The mirror is only served to its clients, which are other controllers
+// authenticating with a name and password. Only a salted hash of each
+// client's password is held.
+//
+// Resources are not mirrored, so charms declaring resources are rejected.
+package charmmirror
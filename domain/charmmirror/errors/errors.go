@@ -0,0 +1,48 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// EntityNotFound is returned when the mirror holds no revisions of a
+	// charm or bundle.
+	EntityNotFound = errors.ConstError("charm mirror entity not found")
+
+	// RevisionNotFound is returned when the mirror holds a charm or bundle,
+	// but no revision matching the requested channel, base or revision.
+	RevisionNotFound = errors.ConstError("charm mirror revision not found")
+
+	// RevisionConflict is returned when adding a revision that the mirror
+	// already holds with a different archive.
+	RevisionConflict = errors.ConstError("charm mirror revision conflict")
+
+	// ArchiveNotFound is returned when the mirror holds no archive with the
+	// requested hash.
+	ArchiveNotFound = errors.ConstError("charm mirror archive not found")
+
+	// HashMismatch is returned when an archive does not match its expected
+	// hash.
+	HashMismatch = errors.ConstError("charm mirror archive hash mismatch")
+
+	// ArchiveTooLarge is returned when an archive is larger than the mirror
+	// caches from its upstream.
+	ArchiveTooLarge = errors.ConstError("charm mirror archive too large")
+
+	// ResourcesNotSupported is returned when adding a charm with resources,
+	// which the mirror cannot serve.
+	ResourcesNotSupported = errors.ConstError("charm mirror does not support resources")
+
+	// ClientNotFound is returned when the mirror has no client with the
+	// requested name.
+	ClientNotFound = errors.ConstError("charm mirror client not found")
+
+	// ClientUnauthorized is returned when a client of the mirror does not
+	// exist or gives the wrong password.
+	ClientUnauthorized = errors.ConstError("charm mirror client unauthorized")
+
+	// ObjectNotFound is returned when no object exists in the object store
+	// for a path.
+	ObjectNotFound = errors.ConstError("charm mirror object not found")
+)
@@ -0,0 +1,85 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/internal/auth"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetClient allows the client with the given name, which is another
+// controller, to use the mirror by authenticating with the password. If the
+// client exists, its password is replaced. Only a salted hash of the password
+// is held.
+func (s *Service) SetClient(ctx context.Context, name string, password auth.Password) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateClientName(name); err != nil {
+		return errors.Capture(err)
+	}
+	salt, err := auth.NewSalt()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	hash, err := auth.HashPassword(password, salt)
+	if err != nil {
+		return errors.Errorf("password for charm mirror client %q: %w", name, err).Add(coreerrors.NotValid)
+	}
+	return s.st.SetClient(ctx, name, hash, salt)
+}
+
+// RemoveClient stops the client with the given name from using the mirror.
+// If there is no such client, an error satisfying
+// [charmmirrorerrors.ClientNotFound] is returned.
+func (s *Service) RemoveClient(ctx context.Context, name string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateClientName(name); err != nil {
+		return errors.Capture(err)
+	}
+	return s.st.RemoveClient(ctx, name)
+}
+
+// AuthenticateClient checks the password of the client with the given name.
+// If there is no such client, or the password is wrong, an error satisfying
+// [charmmirrorerrors.ClientUnauthorized] is returned.
+func (s *Service) AuthenticateClient(ctx context.Context, name string, password auth.Password) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	hash, salt, err := s.st.GetClientPassword(ctx, name)
+	if errors.Is(err, charmmirrorerrors.ClientNotFound) {
+		return errors.Errorf("charm mirror client %q", name).Add(charmmirrorerrors.ClientUnauthorized)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	// HashPassword only fails for passwords that can't be valid.
+	got, err := auth.HashPassword(password, salt)
+	if err != nil || subtle.ConstantTimeCompare([]byte(got), []byte(hash)) != 1 {
+		return errors.Errorf("charm mirror client %q", name).Add(charmmirrorerrors.ClientUnauthorized)
+	}
+	return nil
+}
+
+// validateClientName checks the name of a client can be used as the user of
+// basic auth credentials.
+func validateClientName(name string) error {
+	if name == "" {
+		return errors.New("empty charm mirror client name").Add(coreerrors.NotValid)
+	}
+	if strings.ContainsAny(name, ": /") {
+		return errors.Errorf("charm mirror client name %q must not contain a colon, space or slash", name).Add(coreerrors.NotValid)
+	}
+	return nil
+}
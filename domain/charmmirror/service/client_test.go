@@ -0,0 +1,79 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/internal/auth"
+)
+
+func (s *serviceSuite) TestSetClient(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().SetClient(gomock.Any(), "downstream", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, hash string, salt []byte) error {
+			c.Check(salt, tc.Not(tc.HasLen), 0)
+			expected, err := auth.HashPassword(auth.NewPassword("sekrit-password"), salt)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(hash, tc.Equals, expected)
+			return nil
+		})
+
+	err := s.newService(c).SetClient(c.Context(), "downstream", auth.NewPassword("sekrit-password"))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetClientNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.newService(c)
+	err := svc.SetClient(c.Context(), "", auth.NewPassword("sekrit-password"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	err = svc.SetClient(c.Context(), "down:stream", auth.NewPassword("sekrit-password"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	err = svc.SetClient(c.Context(), "downstream", auth.NewPassword(""))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestRemoveClient(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveClient(gomock.Any(), "downstream").Return(charmmirrorerrors.ClientNotFound)
+
+	err := s.newService(c).RemoveClient(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ClientNotFound)
+}
+
+func (s *serviceSuite) TestAuthenticateClient(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	salt, err := auth.NewSalt()
+	c.Assert(err, tc.ErrorIsNil)
+	hash, err := auth.HashPassword(auth.NewPassword("sekrit-password"), salt)
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetClientPassword(gomock.Any(), "downstream").Return(hash, salt, nil).Times(3)
+
+	svc := s.newService(c)
+	err = svc.AuthenticateClient(c.Context(), "downstream", auth.NewPassword("sekrit-password"))
+	c.Check(err, tc.ErrorIsNil)
+	err = svc.AuthenticateClient(c.Context(), "downstream", auth.NewPassword("wrong-password"))
+	c.Check(err, tc.ErrorIs, charmmirrorerrors.ClientUnauthorized)
+	err = svc.AuthenticateClient(c.Context(), "downstream", auth.NewPassword(""))
+	c.Check(err, tc.ErrorIs, charmmirrorerrors.ClientUnauthorized)
+}
+
+func (s *serviceSuite) TestAuthenticateClientNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetClientPassword(gomock.Any(), "downstream").Return("", nil, charmmirrorerrors.ClientNotFound)
+
+	err := s.newService(c).AuthenticateClient(c.Context(), "downstream", auth.NewPassword("sekrit-password"))
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ClientUnauthorized)
+}
@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/core/objectstore (interfaces: NamespacedObjectStoreGetter,ObjectStore)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore NamespacedObjectStoreGetter,ObjectStore
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+
+	gomock "github.com/canonical/gomock/gomock"
+	objectstore "github.com/juju/juju/core/objectstore"
+)
+
+// MockNamespacedObjectStoreGetter is a mock of NamespacedObjectStoreGetter interface.
+type MockNamespacedObjectStoreGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockNamespacedObjectStoreGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockNamespacedObjectStoreGetterMockRecorder is the mock recorder for MockNamespacedObjectStoreGetter.
+type MockNamespacedObjectStoreGetterMockRecorder struct {
+	mock                  *MockNamespacedObjectStoreGetter
+	getObjectStoreExpects []*gomock.Call1_2[context.Context, objectstore.ObjectStore, error]
+}
+
+// NewMockNamespacedObjectStoreGetter creates a new mock instance.
+func NewMockNamespacedObjectStoreGetter(ctrl *gomock.Controller) *MockNamespacedObjectStoreGetter {
+	mock := &MockNamespacedObjectStoreGetter{ctrl: ctrl}
+	mock.recorder = &MockNamespacedObjectStoreGetterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNamespacedObjectStoreGetter) EXPECT() *MockNamespacedObjectStoreGetterMockRecorder {
+	return m.recorder
+}
+
+// GetObjectStore mocks base method.
+func (m *MockNamespacedObjectStoreGetter) GetObjectStore(arg0 context.Context) (objectstore.ObjectStore, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getObjectStoreExpects, m.ctrl, m, "GetObjectStore", arg0)
+}
+
+// GetObjectStore indicates an expected call of GetObjectStore.
+func (mr *MockNamespacedObjectStoreGetterMockRecorder) GetObjectStore(arg0 any) *MockNamespacedObjectStoreGetterGetObjectStoreCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, objectstore.ObjectStore, error](mr.mock.ctrl.T, mr.mock, "GetObjectStore", gomock.EnsureMatcher(arg0))
+	mr.getObjectStoreExpects = append(mr.getObjectStoreExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockNamespacedObjectStoreGetterGetObjectStoreCall is the typed call wrapper for GetObjectStore.
+type MockNamespacedObjectStoreGetterGetObjectStoreCall = gomock.Call1_2[context.Context, objectstore.ObjectStore, error]
+
+// MockObjectStore is a mock of ObjectStore interface.
+type MockObjectStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockObjectStoreMockRecorder is the mock recorder for MockObjectStore.
+type MockObjectStoreMockRecorder struct {
+	mock                     *MockObjectStore
+	getExpects               []*gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
+	getBySHA256Expects       []*gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
+	getBySHA256PrefixExpects []*gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
+	putExpects               []*gomock.Call4_2[context.Context, string, io.Reader, int64, objectstore.UUID, error]
+	putAndCheckHashExpects   []*gomock.Call5_2[context.Context, string, io.Reader, int64, string, objectstore.UUID, error]
+	removeExpects            []*gomock.Call2_1[context.Context, string, error]
+}
+
+// NewMockObjectStore creates a new mock instance.
+func NewMockObjectStore(ctrl *gomock.Controller) *MockObjectStore {
+	mock := &MockObjectStore{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStore) EXPECT() *MockObjectStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockObjectStore) Get(arg0 context.Context, arg1 string) (io.ReadCloser, objectstore.Digest, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_3(&m.recorder.getExpects, m.ctrl, m, "Get", arg0, arg1)
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockObjectStoreMockRecorder) Get(arg0, arg1 any) *MockObjectStoreGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error](mr.mock.ctrl.T, mr.mock, "Get", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.getExpects = append(mr.getExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockObjectStoreGetCall is the typed call wrapper for Get.
+type MockObjectStoreGetCall = gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
+
+// GetBySHA256 mocks base method.
+func (m *MockObjectStore) GetBySHA256(arg0 context.Context, arg1 string) (io.ReadCloser, objectstore.Digest, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_3(&m.recorder.getBySHA256Expects, m.ctrl, m, "GetBySHA256", arg0, arg1)
+}
+
+// GetBySHA256 indicates an expected call of GetBySHA256.
+func (mr *MockObjectStoreMockRecorder) GetBySHA256(arg0, arg1 any) *MockObjectStoreGetBySHA256Call {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error](mr.mock.ctrl.T, mr.mock, "GetBySHA256", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.getBySHA256Expects = append(mr.getBySHA256Expects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockObjectStoreGetBySHA256Call is the typed call wrapper for GetBySHA256.
+type MockObjectStoreGetBySHA256Call = gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
+
+// GetBySHA256Prefix mocks base method.
+func (m *MockObjectStore) GetBySHA256Prefix(arg0 context.Context, arg1 string) (io.ReadCloser, objectstore.Digest, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_3(&m.recorder.getBySHA256PrefixExpects, m.ctrl, m, "GetBySHA256Prefix", arg0, arg1)
+}
+
+// GetBySHA256Prefix indicates an expected call of GetBySHA256Prefix.
+func (mr *MockObjectStoreMockRecorder) GetBySHA256Prefix(arg0, arg1 any) *MockObjectStoreGetBySHA256PrefixCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error](mr.mock.ctrl.T, mr.mock, "GetBySHA256Prefix", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.getBySHA256PrefixExpects = append(mr.getBySHA256PrefixExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockObjectStoreGetBySHA256PrefixCall is the typed call wrapper for GetBySHA256Prefix.
+type MockObjectStoreGetBySHA256PrefixCall = gomock.Call2_3[context.Context, string, io.ReadCloser, objectstore.Digest, error]
+
+// Put mocks base method.
+func (m *MockObjectStore) Put(ctx context.Context, path string, r io.Reader, size int64) (objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_2(&m.recorder.putExpects, m.ctrl, m, "Put", ctx, path, r, size)
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockObjectStoreMockRecorder) Put(ctx, path, r, size any) *MockObjectStorePutCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_2[context.Context, string, io.Reader, int64, objectstore.UUID, error](mr.mock.ctrl.T, mr.mock, "Put", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(path), gomock.EnsureMatcher(r), gomock.EnsureMatcher(size))
+	mr.putExpects = append(mr.putExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockObjectStorePutCall is the typed call wrapper for Put.
+type MockObjectStorePutCall = gomock.Call4_2[context.Context, string, io.Reader, int64, objectstore.UUID, error]
+
+// PutAndCheckHash mocks base method.
+func (m *MockObjectStore) PutAndCheckHash(ctx context.Context, path string, r io.Reader, size int64, sha384 string) (objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch5_2(&m.recorder.putAndCheckHashExpects, m.ctrl, m, "PutAndCheckHash", ctx, path, r, size, sha384)
+}
+
+// PutAndCheckHash indicates an expected call of PutAndCheckHash.
+func (mr *MockObjectStoreMockRecorder) PutAndCheckHash(ctx, path, r, size, sha384 any) *MockObjectStorePutAndCheckHashCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall5_2[context.Context, string, io.Reader, int64, string, objectstore.UUID, error](mr.mock.ctrl.T, mr.mock, "PutAndCheckHash", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(path), gomock.EnsureMatcher(r), gomock.EnsureMatcher(size), gomock.EnsureMatcher(sha384))
+	mr.putAndCheckHashExpects = append(mr.putAndCheckHashExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockObjectStorePutAndCheckHashCall is the typed call wrapper for PutAndCheckHash.
+type MockObjectStorePutAndCheckHashCall = gomock.Call5_2[context.Context, string, io.Reader, int64, string, objectstore.UUID, error]
+
+// Remove mocks base method.
+func (m *MockObjectStore) Remove(ctx context.Context, path string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.removeExpects, m.ctrl, m, "Remove", ctx, path)
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockObjectStoreMockRecorder) Remove(ctx, path any) *MockObjectStoreRemoveCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "Remove", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(path))
+	mr.removeExpects = append(mr.removeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockObjectStoreRemoveCall is the typed call wrapper for Remove.
+type MockObjectStoreRemoveCall = gomock.Call2_1[context.Context, string, error]
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination state_mock_test.go github.com/juju/juju/domain/charmmirror/service State
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore NamespacedObjectStoreGetter,ObjectStore
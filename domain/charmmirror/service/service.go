@@ -0,0 +1,528 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	cryptosha256 "crypto/sha256"
+	cryptosha512 "crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/juju/clock"
+	"gopkg.in/yaml.v3"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/domain/deployment/charm"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/charmhub/seed"
+	"github.com/juju/juju/internal/errors"
+	intobjectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+// State defines an interface for interacting with the underlying state.
+type State interface {
+	// GetObjectUUID returns the object store UUID for the given path. If no
+	// object exists for the path, an error satisfying
+	// [charmmirrorerrors.ObjectNotFound] is returned.
+	GetObjectUUID(ctx context.Context, path string) (objectstore.UUID, error)
+
+	// AddEntity records a revision held in the object store under
+	// objectStoreUUID. A revision cached from the upstream records when it
+	// was cached in cachedAt, a seeded one has a zero cachedAt and is never
+	// evicted. If the revision is already held, its bases and channels are
+	// merged with those of the existing revision. If the existing revision
+	// has a different archive, an error satisfying
+	// [charmmirrorerrors.RevisionConflict] is returned.
+	AddEntity(ctx context.Context, entity charmmirror.Entity, objectStoreUUID objectstore.UUID, cachedAt time.Time) error
+
+	// EvictCachedEntities removes the revisions cached from the upstream,
+	// oldest first, until the archives of the remaining cached revisions
+	// total no more than maxSize bytes. The object store paths of the
+	// archives no longer held by any revision are returned.
+	EvictCachedEntities(ctx context.Context, maxSize int64) ([]string, error)
+
+	// GetEntities returns every revision held for the charm or bundle with
+	// the given name or Charmhub ID, ordered by descending revision. If none
+	// are held, an error satisfying [charmmirrorerrors.EntityNotFound] is
+	// returned.
+	GetEntities(ctx context.Context, name string) ([]charmmirror.Entity, error)
+
+	// FindEntities returns every revision held for the charms and bundles
+	// whose name contains the query, ordered by name and descending
+	// revision.
+	FindEntities(ctx context.Context, query string) ([]charmmirror.Entity, error)
+
+	// HasArchive reports whether the mirror holds a revision whose archive
+	// has the given SHA256 hash.
+	HasArchive(ctx context.Context, sha256 string) (bool, error)
+
+	// SetClient sets the hash and salt of the password of the mirror client
+	// with the given name, adding the client if it does not exist.
+	SetClient(ctx context.Context, name, passwordHash string, passwordSalt []byte) error
+
+	// GetClientPassword returns the hash and salt of the password of the
+	// mirror client with the given name. If there is no such client, an
+	// error satisfying [charmmirrorerrors.ClientNotFound] is returned.
+	GetClientPassword(ctx context.Context, name string) (string, []byte, error)
+
+	// RemoveClient removes the mirror client with the given name. If there
+	// is no such client, an error satisfying
+	// [charmmirrorerrors.ClientNotFound] is returned.
+	RemoveClient(ctx context.Context, name string) error
+}
+
+// Service provides access to the charm and bundle revisions served by the
+// controller's Charmhub mirror.
+type Service struct {
+	st                State
+	objectStoreGetter objectstore.NamespacedObjectStoreGetter
+	clock             clock.Clock
+	logger            logger.Logger
+}
+
+// NewService returns a new Service for interacting with the underlying state
+// and the controller object store.
+func NewService(
+	st State,
+	objectStoreGetter objectstore.NamespacedObjectStoreGetter,
+	clock clock.Clock,
+	logger logger.Logger,
+) *Service {
+	return &Service{
+		st:                st,
+		objectStoreGetter: objectStoreGetter,
+		clock:             clock,
+		logger:            logger,
+	}
+}
+
+// ImportSeed adds every revision held in the seed read from r to the mirror,
+// returning the revisions added. An error satisfying [coreerrors.NotValid] is
+// returned if the seed is malformed, and one satisfying
+// [charmmirrorerrors.HashMismatch] if an archive does not match its entry.
+func (s *Service) ImportSeed(ctx context.Context, r io.Reader) ([]charmmirror.Entity, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	var added []charmmirror.Entity
+	if err := seed.Read(r, func(entry seed.Entry, archive io.Reader) error {
+		entity := entityFromSeed(entry)
+		if err := s.AddEntity(ctx, entity, archive); err != nil {
+			return errors.Capture(err)
+		}
+		added = append(added, entity)
+		return nil
+	}); err != nil {
+		return added, errors.Errorf("importing seed: %w", err)
+	}
+	return added, nil
+}
+
+// AddEntity adds a seeded revision, with its archive read from r, to the
+// mirror. Seeded revisions are never evicted. If the entity has a SHA256 hash
+// or size, the archive is checked against them and an error satisfying
+// [charmmirrorerrors.HashMismatch] is returned if it does not match. If the
+// mirror already holds the revision with a different archive, an error
+// satisfying [charmmirrorerrors.RevisionConflict] is returned. Charms
+// declaring resources are rejected with an error satisfying
+// [charmmirrorerrors.ResourcesNotSupported].
+func (s *Service) AddEntity(ctx context.Context, entity charmmirror.Entity, r io.Reader) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.addEntity(ctx, entity, r, time.Time{})
+}
+
+// CacheEntity adds a revision fetched from the upstream, with its archive
+// read from r, to the mirror, and then evicts the revisions cached longest
+// ago until the cached archives fit within limits.MaxSize. An archive larger
+// than limits.MaxArchiveSize is rejected with an error satisfying
+// [charmmirrorerrors.ArchiveTooLarge]. Otherwise, it fails as
+// [Service.AddEntity] does.
+func (s *Service) CacheEntity(ctx context.Context, entity charmmirror.Entity, r io.Reader, limits charmmirror.CacheLimits) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if entity.Size > limits.MaxArchiveSize {
+		return errors.Errorf(
+			"archive for %q revision %d is %d bytes, more than the %d allowed",
+			entity.Name, entity.Revision, entity.Size, limits.MaxArchiveSize,
+		).Add(charmmirrorerrors.ArchiveTooLarge)
+	}
+	// Read one byte more than allowed, so that an archive without a known
+	// size which is too large can be told apart from one which fits.
+	archive := &maxSizeReader{
+		r:        io.LimitReader(r, limits.MaxArchiveSize+1),
+		max:      limits.MaxArchiveSize,
+		name:     entity.Name,
+		revision: entity.Revision,
+	}
+	if err := s.addEntity(ctx, entity, archive, s.clock.Now()); err != nil {
+		return errors.Capture(err)
+	}
+
+	paths, err := s.st.EvictCachedEntities(ctx, limits.MaxSize)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return errors.Errorf("getting object store: %w", err)
+	}
+	for _, path := range paths {
+		s.logger.Debugf(ctx, "evicting archive %q from the charm mirror", path)
+		if err := objectStore.Remove(ctx, path); err != nil && !errors.Is(err, objectstoreerrors.ErrNotFound) {
+			s.logger.Errorf(ctx, "removing evicted archive %q from the charm mirror: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) addEntity(ctx context.Context, entity charmmirror.Entity, r io.Reader, cachedAt time.Time) error {
+	if err := validateEntity(entity); err != nil {
+		return errors.Capture(err)
+	}
+	channels := make([]charmmirror.Channel, len(entity.Channels))
+	for i, ch := range entity.Channels {
+		name, err := normaliseChannel(ch.Name)
+		if err != nil {
+			return errors.Errorf("%q revision %d: %w", entity.Name, entity.Revision, err)
+		}
+		channels[i] = charmmirror.Channel{Name: name, ReleasedAt: ch.ReleasedAt}
+	}
+	entity.Channels = channels
+
+	data, size, sha256, sha384, err := tmpCacheAndHash(r)
+	if err != nil {
+		return errors.Errorf("hashing archive for %q revision %d: %w", entity.Name, entity.Revision, err)
+	}
+	defer func() { _ = data.Close() }()
+
+	if entity.SHA256 != "" && entity.SHA256 != sha256 {
+		return errors.Errorf(
+			"archive for %q revision %d: expected sha256 %q, got %q",
+			entity.Name, entity.Revision, entity.SHA256, sha256,
+		).Add(charmmirrorerrors.HashMismatch)
+	}
+	if entity.Size > 0 && entity.Size != size {
+		return errors.Errorf(
+			"archive for %q revision %d: expected %d bytes, got %d",
+			entity.Name, entity.Revision, entity.Size, size,
+		).Add(charmmirrorerrors.HashMismatch)
+	}
+	entity.SHA256, entity.SHA384, entity.Size = sha256, sha384, size
+
+	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return errors.Errorf("getting object store: %w", err)
+	}
+
+	path := fmt.Sprintf("charm-mirror/%s", sha384)
+	uuid, err := objectStore.PutAndCheckHash(ctx, path, data, size, sha384)
+	added := err == nil
+	switch {
+	case errors.Is(err, objectstoreerrors.ErrHashAndSizeAlreadyExists):
+		uuid, err = s.st.GetObjectUUID(ctx, path)
+		if err != nil {
+			return errors.Errorf("getting object store UUID for %q: %w", path, err)
+		}
+	case errors.Is(err, objectstore.ErrHashMismatch):
+		return errors.Errorf("archive for %q revision %d does not match its hash", entity.Name, entity.Revision).Add(charmmirrorerrors.HashMismatch)
+	case err != nil:
+		return errors.Errorf("putting archive for %q revision %d in the object store: %w", entity.Name, entity.Revision, err)
+	}
+
+	s.logger.Debugf(ctx, "adding %s %q revision %d to the charm mirror", entity.Type, entity.Name, entity.Revision)
+
+	if err := s.st.AddEntity(ctx, entity, uuid, cachedAt); err != nil {
+		if added {
+			if err := objectStore.Remove(ctx, path); err != nil && !errors.Is(err, objectstoreerrors.ErrNotFound) {
+				s.logger.Errorf(ctx, "removing archive %q after failing to add it to the charm mirror: %v", path, err)
+			}
+		}
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// GetEntities returns every revision held for the charm or bundle with the
+// given name or Charmhub ID, ordered by descending revision. If none are
+// held, an error satisfying [charmmirrorerrors.EntityNotFound] is returned.
+func (s *Service) GetEntities(ctx context.Context, name string) ([]charmmirror.Entity, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if name == "" {
+		return nil, errors.New("empty name").Add(coreerrors.NotValid)
+	}
+	return s.st.GetEntities(ctx, name)
+}
+
+// FindEntities returns every revision held for the charms and bundles whose
+// name contains the query, ordered by name and descending revision.
+func (s *Service) FindEntities(ctx context.Context, query string) ([]charmmirror.Entity, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.FindEntities(ctx, query)
+}
+
+// Resolve returns the revision matching the arguments. If a revision is
+// requested, that revision is returned, otherwise the revision most recently
+// released to the channel is. Only revisions supporting the requested base
+// are considered. If the mirror does not hold the charm or bundle, an error
+// satisfying [charmmirrorerrors.EntityNotFound] is returned; if it does, but
+// no revision matches, one satisfying [charmmirrorerrors.RevisionNotFound] is.
+func (s *Service) Resolve(ctx context.Context, args charmmirror.ResolveArgs) (charmmirror.Resolved, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if args.Name == "" {
+		return charmmirror.Resolved{}, errors.New("empty name").Add(coreerrors.NotValid)
+	}
+	channel, err := normaliseChannel(args.Channel)
+	if err != nil {
+		return charmmirror.Resolved{}, errors.Capture(err)
+	}
+
+	entities, err := s.st.GetEntities(ctx, args.Name)
+	if err != nil {
+		return charmmirror.Resolved{}, errors.Capture(err)
+	}
+
+	if args.Revision != nil {
+		for _, entity := range entities {
+			if entity.Revision == *args.Revision && supportsBase(entity, args.Base) {
+				return charmmirror.Resolved{Entity: entity}, nil
+			}
+		}
+		return charmmirror.Resolved{}, errors.Errorf(
+			"%q revision %d", args.Name, *args.Revision,
+		).Add(charmmirrorerrors.RevisionNotFound)
+	}
+
+	var (
+		result charmmirror.Resolved
+		found  bool
+	)
+	for _, entity := range entities {
+		if !supportsBase(entity, args.Base) {
+			continue
+		}
+		for _, ch := range entity.Channels {
+			if ch.Name != channel {
+				continue
+			}
+			// Entities are ordered by descending revision, so the first
+			// revision released at a given time wins.
+			if !found || ch.ReleasedAt.After(result.Channel.ReleasedAt) {
+				result = charmmirror.Resolved{Entity: entity, Channel: ch}
+				found = true
+			}
+		}
+	}
+	if !found {
+		return charmmirror.Resolved{}, errors.Errorf(
+			"%q in channel %q", args.Name, channel,
+		).Add(charmmirrorerrors.RevisionNotFound)
+	}
+	return result, nil
+}
+
+// OpenArchive returns a reader for the mirrored archive with the given SHA256
+// hash, along with its size. If the mirror holds no such archive, an error
+// satisfying [charmmirrorerrors.ArchiveNotFound] is returned.
+func (s *Service) OpenArchive(ctx context.Context, sha256 string) (io.ReadCloser, int64, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	// Only archives held by the mirror are served, the controller object
+	// store holds other objects which must not be exposed.
+	exists, err := s.st.HasArchive(ctx, sha256)
+	if err != nil {
+		return nil, -1, errors.Capture(err)
+	} else if !exists {
+		return nil, -1, errors.Errorf("archive %q", sha256).Add(charmmirrorerrors.ArchiveNotFound)
+	}
+
+	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return nil, -1, errors.Errorf("getting object store: %w", err)
+	}
+	reader, digest, err := objectStore.GetBySHA256(ctx, sha256)
+	if errors.Is(err, intobjectstoreerrors.ObjectNotFound) {
+		return nil, -1, errors.Errorf("archive %q", sha256).Add(charmmirrorerrors.ArchiveNotFound)
+	} else if err != nil {
+		return nil, -1, errors.Errorf("getting archive %q: %w", sha256, err)
+	}
+	return reader, digest.Size, nil
+}
+
+func validateEntity(entity charmmirror.Entity) error {
+	switch {
+	case entity.Name == "":
+		return errors.New("empty name").Add(coreerrors.NotValid)
+	case entity.ID == "":
+		return errors.Errorf("%q: empty id", entity.Name).Add(coreerrors.NotValid)
+	case entity.Type != "charm" && entity.Type != "bundle":
+		return errors.Errorf("%q: invalid type %q", entity.Name, entity.Type).Add(coreerrors.NotValid)
+	case entity.Revision < 0:
+		return errors.Errorf("%q: invalid revision %d", entity.Name, entity.Revision).Add(coreerrors.NotValid)
+	}
+
+	// Resources are not mirrored, so a charm declaring them could not be
+	// deployed from the mirror.
+	var meta struct {
+		Resources map[string]any `yaml:"resources"`
+	}
+	if err := yaml.Unmarshal([]byte(entity.MetadataYAML), &meta); err != nil {
+		return errors.Errorf("%q: parsing metadata: %w", entity.Name, err).Add(coreerrors.NotValid)
+	}
+	if len(meta.Resources) > 0 {
+		return errors.Errorf("%q has resources", entity.Name).Add(charmmirrorerrors.ResourcesNotSupported)
+	}
+	return nil
+}
+
+// normaliseChannel returns the channel in the form used by Charmhub for
+// effective channels, with both a track and a risk.
+func normaliseChannel(s string) (string, error) {
+	if s == "" {
+		return "latest/stable", nil
+	}
+	ch, err := charm.ParseChannelNormalize(s)
+	if err != nil {
+		return "", errors.Errorf("parsing channel %q: %w", s, err).Add(coreerrors.NotValid)
+	}
+	if ch.Track == "" {
+		ch.Track = "latest"
+	}
+	return ch.String(), nil
+}
+
+func supportsBase(entity charmmirror.Entity, want charmmirror.Base) bool {
+	if want == (charmmirror.Base{}) || len(entity.Bases) == 0 {
+		return true
+	}
+	for _, b := range entity.Bases {
+		if want.Architecture != "" && b.Architecture != "all" && b.Architecture != want.Architecture {
+			continue
+		}
+		if want.OS != "" && b.OS != want.OS {
+			continue
+		}
+		if want.Channel != "" && b.Channel != want.Channel {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func entityFromSeed(entry seed.Entry) charmmirror.Entity {
+	entity := charmmirror.Entity{
+		ID:           entry.ID,
+		Name:         entry.Name,
+		Type:         entry.Type,
+		Revision:     entry.Revision,
+		Version:      entry.Version,
+		Summary:      entry.Summary,
+		License:      entry.License,
+		Publisher:    entry.Publisher,
+		CreatedAt:    entry.CreatedAt,
+		MetadataYAML: entry.MetadataYAML,
+		ConfigYAML:   entry.ConfigYAML,
+		ActionsYAML:  entry.ActionsYAML,
+		SHA256:       entry.SHA256,
+		Size:         entry.Size,
+	}
+	for _, b := range entry.Bases {
+		entity.Bases = append(entity.Bases, charmmirror.Base{
+			Architecture: b.Architecture,
+			OS:           b.Name,
+			Channel:      b.Channel,
+		})
+	}
+	if entry.Channel != "" {
+		entity.Channels = []charmmirror.Channel{{
+			Name:       entry.Channel,
+			ReleasedAt: entry.ReleasedAt,
+		}}
+	}
+	return entity
+}
+
+// tmpCacheAndHash copies r to a temporary file, so that its hashes can be
+// computed before it is put in the object store, which requires them up
+// front. The returned reader removes the file when closed.
+func tmpCacheAndHash(r io.Reader) (_ io.ReadCloser, _ int64, _ string, _ string, err error) {
+	tmpFile, err := os.CreateTemp("", "juju-charm-mirror*.tmp")
+	if err != nil {
+		return nil, -1, "", "", errors.Capture(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+		}
+	}()
+
+	hasher256 := cryptosha256.New()
+	hasher384 := cryptosha512.New384()
+	size, err := io.Copy(tmpFile, io.TeeReader(r, io.MultiWriter(hasher256, hasher384)))
+	if err != nil {
+		return nil, -1, "", "", errors.Errorf("writing archive to temp file: %w", err)
+	}
+	if _, err = tmpFile.Seek(0, io.SeekStart); err != nil {
+		return nil, -1, "", "", errors.Capture(err)
+	}
+	return &cleanupReadCloser{
+		ReadCloser: tmpFile,
+		cleanup:    func() { _ = os.Remove(tmpFile.Name()) },
+	}, size, hex.EncodeToString(hasher256.Sum(nil)), hex.EncodeToString(hasher384.Sum(nil)), nil
+}
+
+// maxSizeReader fails once more than max bytes have been read from r.
+type maxSizeReader struct {
+	r        io.Reader
+	max      int64
+	read     int64
+	name     string
+	revision int
+}
+
+func (m *maxSizeReader) Read(p []byte) (int, error) {
+	n, err := m.r.Read(p)
+	m.read += int64(n)
+	if m.read > m.max {
+		return n, errors.Errorf(
+			"archive for %q revision %d is more than the %d bytes allowed",
+			m.name, m.revision, m.max,
+		).Add(charmmirrorerrors.ArchiveTooLarge)
+	}
+	return n, err
+}
+
+type cleanupReadCloser struct {
+	io.ReadCloser
+	cleanup func()
+}
+
+func (c *cleanupReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	c.cleanup()
+	return err
+}
@@ -0,0 +1,356 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/charmhub/seed"
+	"github.com/juju/juju/internal/charmhub/transport"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	intobjectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+const archive = "postgresql-archive"
+
+type serviceSuite struct {
+	state             *MockState
+	objectStoreGetter *MockNamespacedObjectStoreGetter
+	objectStore       *MockObjectStore
+	clock             *testclock.Clock
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) TestAddEntity(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sha256Sum, sha384Sum := hashes(archive)
+	path := "charm-mirror/" + sha384Sum
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().PutAndCheckHash(gomock.Any(), path, gomock.Any(), int64(len(archive)), sha384Sum).
+		Return(objectstore.UUID("object-uuid"), nil)
+
+	entity := s.entity()
+	entity.Channels[0].Name = "stable"
+	expected := s.entity()
+	expected.SHA256, expected.SHA384, expected.Size = sha256Sum, sha384Sum, int64(len(archive))
+	s.state.EXPECT().AddEntity(gomock.Any(), expected, objectstore.UUID("object-uuid"), time.Time{}).Return(nil)
+
+	err := s.newService(c).AddEntity(c.Context(), entity, strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddEntityExistingArchive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, sha384Sum := hashes(archive)
+	path := "charm-mirror/" + sha384Sum
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().PutAndCheckHash(gomock.Any(), path, gomock.Any(), int64(len(archive)), sha384Sum).
+		Return("", objectstoreerrors.ErrHashAndSizeAlreadyExists)
+	s.state.EXPECT().GetObjectUUID(gomock.Any(), path).Return(objectstore.UUID("object-uuid"), nil)
+	s.state.EXPECT().AddEntity(gomock.Any(), gomock.Any(), objectstore.UUID("object-uuid"), time.Time{}).Return(nil)
+
+	err := s.newService(c).AddEntity(c.Context(), s.entity(), strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddEntityHashMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	entity := s.entity()
+	entity.SHA256 = "other"
+	err := s.newService(c).AddEntity(c.Context(), entity, strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.HashMismatch)
+}
+
+func (s *serviceSuite) TestAddEntityConflictRemovesArchive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, sha384Sum := hashes(archive)
+	path := "charm-mirror/" + sha384Sum
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().PutAndCheckHash(gomock.Any(), path, gomock.Any(), int64(len(archive)), sha384Sum).
+		Return(objectstore.UUID("object-uuid"), nil)
+	s.state.EXPECT().AddEntity(gomock.Any(), gomock.Any(), objectstore.UUID("object-uuid"), time.Time{}).
+		Return(charmmirrorerrors.RevisionConflict)
+	s.objectStore.EXPECT().Remove(gomock.Any(), path).Return(nil)
+
+	err := s.newService(c).AddEntity(c.Context(), s.entity(), strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.RevisionConflict)
+}
+
+func (s *serviceSuite) TestAddEntityInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	entity := s.entity()
+	entity.Type = "snap"
+	err := s.newService(c).AddEntity(c.Context(), entity, strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestAddEntityWithResources(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	entity := s.entity()
+	entity.MetadataYAML = "name: postgresql\nresources:\n  image:\n    type: oci-image\n"
+	err := s.newService(c).AddEntity(c.Context(), entity, strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ResourcesNotSupported)
+}
+
+func (s *serviceSuite) TestCacheEntity(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, sha384Sum := hashes(archive)
+	path := "charm-mirror/" + sha384Sum
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil).Times(2)
+	s.objectStore.EXPECT().PutAndCheckHash(gomock.Any(), path, gomock.Any(), int64(len(archive)), sha384Sum).
+		Return(objectstore.UUID("object-uuid"), nil)
+	s.state.EXPECT().AddEntity(gomock.Any(), gomock.Any(), objectstore.UUID("object-uuid"), s.clock.Now()).Return(nil)
+	s.state.EXPECT().EvictCachedEntities(gomock.Any(), int64(1000)).Return([]string{"charm-mirror/old"}, nil)
+	s.objectStore.EXPECT().Remove(gomock.Any(), "charm-mirror/old").Return(nil)
+
+	err := s.newService(c).CacheEntity(c.Context(), s.entity(), strings.NewReader(archive), charmmirror.CacheLimits{
+		MaxArchiveSize: 100,
+		MaxSize:        1000,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCacheEntityTooLarge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	entity := s.entity()
+	entity.Size = int64(len(archive))
+	err := s.newService(c).CacheEntity(c.Context(), entity, strings.NewReader(archive), charmmirror.CacheLimits{
+		MaxArchiveSize: 5,
+		MaxSize:        1000,
+	})
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ArchiveTooLarge)
+}
+
+func (s *serviceSuite) TestCacheEntityTooLargeUnknownSize(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.newService(c).CacheEntity(c.Context(), s.entity(), strings.NewReader(archive), charmmirror.CacheLimits{
+		MaxArchiveSize: 5,
+		MaxSize:        1000,
+	})
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ArchiveTooLarge)
+}
+
+func (s *serviceSuite) TestImportSeed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sha256Sum, _ := hashes(archive)
+	var buf bytes.Buffer
+	w := seed.NewWriter(&buf)
+	err := w.Add(seed.Entry{
+		ID:         "id-postgresql",
+		Name:       "postgresql",
+		Type:       "charm",
+		Revision:   42,
+		Bases:      []transport.Base{{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"}},
+		Channel:    "14/stable",
+		ReleasedAt: releasedAt,
+		SHA256:     sha256Sum,
+		Size:       int64(len(archive)),
+	}, strings.NewReader(archive))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(w.Close(), tc.ErrorIsNil)
+
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().PutAndCheckHash(gomock.Any(), gomock.Any(), gomock.Any(), int64(len(archive)), gomock.Any()).
+		Return(objectstore.UUID("object-uuid"), nil)
+	s.state.EXPECT().AddEntity(gomock.Any(), gomock.Any(), objectstore.UUID("object-uuid"), time.Time{}).
+		DoAndReturn(func(_ context.Context, entity charmmirror.Entity, _ objectstore.UUID, _ time.Time) error {
+			c.Check(entity.Name, tc.Equals, "postgresql")
+			c.Check(entity.Bases, tc.DeepEquals, []charmmirror.Base{{Architecture: "amd64", OS: "ubuntu", Channel: "22.04"}})
+			c.Check(entity.Channels, tc.DeepEquals, []charmmirror.Channel{{Name: "14/stable", ReleasedAt: releasedAt}})
+			return nil
+		})
+
+	added, err := s.newService(c).ImportSeed(c.Context(), &buf)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(added, tc.HasLen, 1)
+	c.Check(added[0].Revision, tc.Equals, 42)
+}
+
+func (s *serviceSuite) TestResolveChannel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	later := releasedAt.Add(time.Hour)
+	s.state.EXPECT().GetEntities(gomock.Any(), "postgresql").Return([]charmmirror.Entity{
+		s.revision(3, "amd64", charmmirror.Channel{Name: "latest/edge", ReleasedAt: later}),
+		s.revision(2, "amd64", charmmirror.Channel{Name: "latest/stable", ReleasedAt: releasedAt}),
+		s.revision(1, "amd64", charmmirror.Channel{Name: "latest/stable", ReleasedAt: later}),
+	}, nil)
+
+	resolved, err := s.newService(c).Resolve(c.Context(), charmmirror.ResolveArgs{
+		Name: "postgresql",
+		Base: charmmirror.Base{Architecture: "amd64", OS: "ubuntu", Channel: "22.04"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(resolved.Revision, tc.Equals, 1)
+	c.Check(resolved.Channel, tc.DeepEquals, charmmirror.Channel{Name: "latest/stable", ReleasedAt: later})
+}
+
+func (s *serviceSuite) TestResolveBaseNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetEntities(gomock.Any(), "postgresql").Return([]charmmirror.Entity{
+		s.revision(1, "amd64", charmmirror.Channel{Name: "latest/stable", ReleasedAt: releasedAt}),
+	}, nil)
+
+	_, err := s.newService(c).Resolve(c.Context(), charmmirror.ResolveArgs{
+		Name:    "postgresql",
+		Channel: "stable",
+		Base:    charmmirror.Base{Architecture: "arm64"},
+	})
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.RevisionNotFound)
+}
+
+func (s *serviceSuite) TestResolveRevision(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetEntities(gomock.Any(), "postgresql").Return([]charmmirror.Entity{
+		s.revision(2, "all"),
+		s.revision(1, "amd64"),
+	}, nil).Times(2)
+
+	rev := 2
+	resolved, err := s.newService(c).Resolve(c.Context(), charmmirror.ResolveArgs{
+		Name:     "postgresql",
+		Revision: &rev,
+		Base:     charmmirror.Base{Architecture: "arm64"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(resolved.Revision, tc.Equals, 2)
+	c.Check(resolved.Channel, tc.DeepEquals, charmmirror.Channel{})
+
+	rev = 3
+	_, err = s.newService(c).Resolve(c.Context(), charmmirror.ResolveArgs{
+		Name:     "postgresql",
+		Revision: &rev,
+	})
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.RevisionNotFound)
+}
+
+func (s *serviceSuite) TestResolveEntityNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetEntities(gomock.Any(), "postgresql").Return(nil, charmmirrorerrors.EntityNotFound)
+
+	_, err := s.newService(c).Resolve(c.Context(), charmmirror.ResolveArgs{Name: "postgresql"})
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.EntityNotFound)
+}
+
+func (s *serviceSuite) TestOpenArchive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().HasArchive(gomock.Any(), "abc").Return(true, nil)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().GetBySHA256(gomock.Any(), "abc").
+		Return(io.NopCloser(strings.NewReader(archive)), objectstore.Digest{Size: int64(len(archive))}, nil)
+
+	reader, size, err := s.newService(c).OpenArchive(c.Context(), "abc")
+	c.Assert(err, tc.ErrorIsNil)
+	defer reader.Close()
+	c.Check(size, tc.Equals, int64(len(archive)))
+}
+
+func (s *serviceSuite) TestOpenArchiveNotMirrored(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().HasArchive(gomock.Any(), "abc").Return(false, nil)
+
+	_, _, err := s.newService(c).OpenArchive(c.Context(), "abc")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ArchiveNotFound)
+}
+
+func (s *serviceSuite) TestOpenArchiveMissingObject(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().HasArchive(gomock.Any(), "abc").Return(true, nil)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil)
+	s.objectStore.EXPECT().GetBySHA256(gomock.Any(), "abc").
+		Return(nil, objectstore.Digest{}, intobjectstoreerrors.ObjectNotFound)
+
+	_, _, err := s.newService(c).OpenArchive(c.Context(), "abc")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ArchiveNotFound)
+}
+
+var releasedAt = time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.objectStoreGetter = NewMockNamespacedObjectStoreGetter(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+	s.clock = testclock.NewClock(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceSuite) newService(c *tc.C) *Service {
+	return NewService(s.state, s.objectStoreGetter, s.clock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *serviceSuite) entity() charmmirror.Entity {
+	return charmmirror.Entity{
+		ID:       "id-postgresql",
+		Name:     "postgresql",
+		Type:     "charm",
+		Revision: 42,
+		Bases: []charmmirror.Base{{
+			Architecture: "amd64",
+			OS:           "ubuntu",
+			Channel:      "22.04",
+		}},
+		Channels: []charmmirror.Channel{{
+			Name:       "latest/stable",
+			ReleasedAt: releasedAt,
+		}},
+	}
+}
+
+func (s *serviceSuite) revision(revision int, arch string, channels ...charmmirror.Channel) charmmirror.Entity {
+	return charmmirror.Entity{
+		ID:       "id-postgresql",
+		Name:     "postgresql",
+		Type:     "charm",
+		Revision: revision,
+		Bases: []charmmirror.Base{{
+			Architecture: arch,
+			OS:           "ubuntu",
+			Channel:      "22.04",
+		}},
+		Channels: channels,
+	}
+}
+
+func hashes(data string) (string, string) {
+	sum256 := sha256.Sum256([]byte(data))
+	sum384 := sha512.Sum384([]byte(data))
+	return hex.EncodeToString(sum256[:]), hex.EncodeToString(sum384[:])
+}
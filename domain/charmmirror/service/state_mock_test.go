@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/charmmirror/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination state_mock_test.go github.com/juju/juju/domain/charmmirror/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	time "time"
+
+	gomock "github.com/canonical/gomock/gomock"
+	objectstore "github.com/juju/juju/core/objectstore"
+	charmmirror "github.com/juju/juju/domain/charmmirror"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+	isgomock struct{}
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock                       *MockState
+	addEntityExpects           []*gomock.Call4_1[context.Context, charmmirror.Entity, objectstore.UUID, time.Time, error]
+	evictCachedEntitiesExpects []*gomock.Call2_2[context.Context, int64, []string, error]
+	findEntitiesExpects        []*gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+	getClientPasswordExpects   []*gomock.Call2_3[context.Context, string, string, []byte, error]
+	getEntitiesExpects         []*gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+	getObjectUUIDExpects       []*gomock.Call2_2[context.Context, string, objectstore.UUID, error]
+	hasArchiveExpects          []*gomock.Call2_2[context.Context, string, bool, error]
+	removeClientExpects        []*gomock.Call2_1[context.Context, string, error]
+	setClientExpects           []*gomock.Call4_1[context.Context, string, string, []byte, error]
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// AddEntity mocks base method.
+func (m *MockState) AddEntity(ctx context.Context, entity charmmirror.Entity, objectStoreUUID objectstore.UUID, cachedAt time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.addEntityExpects, m.ctrl, m, "AddEntity", ctx, entity, objectStoreUUID, cachedAt)
+}
+
+// AddEntity indicates an expected call of AddEntity.
+func (mr *MockStateMockRecorder) AddEntity(ctx, entity, objectStoreUUID, cachedAt any) *MockStateAddEntityCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, charmmirror.Entity, objectstore.UUID, time.Time, error](mr.mock.ctrl.T, mr.mock, "AddEntity", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(entity), gomock.EnsureMatcher(objectStoreUUID), gomock.EnsureMatcher(cachedAt))
+	mr.addEntityExpects = append(mr.addEntityExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddEntityCall is the typed call wrapper for AddEntity.
+type MockStateAddEntityCall = gomock.Call4_1[context.Context, charmmirror.Entity, objectstore.UUID, time.Time, error]
+
+// EvictCachedEntities mocks base method.
+func (m *MockState) EvictCachedEntities(ctx context.Context, maxSize int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.evictCachedEntitiesExpects, m.ctrl, m, "EvictCachedEntities", ctx, maxSize)
+}
+
+// EvictCachedEntities indicates an expected call of EvictCachedEntities.
+func (mr *MockStateMockRecorder) EvictCachedEntities(ctx, maxSize any) *MockStateEvictCachedEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, int64, []string, error](mr.mock.ctrl.T, mr.mock, "EvictCachedEntities", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(maxSize))
+	mr.evictCachedEntitiesExpects = append(mr.evictCachedEntitiesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateEvictCachedEntitiesCall is the typed call wrapper for EvictCachedEntities.
+type MockStateEvictCachedEntitiesCall = gomock.Call2_2[context.Context, int64, []string, error]
+
+// FindEntities mocks base method.
+func (m *MockState) FindEntities(ctx context.Context, query string) ([]charmmirror.Entity, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.findEntitiesExpects, m.ctrl, m, "FindEntities", ctx, query)
+}
+
+// FindEntities indicates an expected call of FindEntities.
+func (mr *MockStateMockRecorder) FindEntities(ctx, query any) *MockStateFindEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, []charmmirror.Entity, error](mr.mock.ctrl.T, mr.mock, "FindEntities", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(query))
+	mr.findEntitiesExpects = append(mr.findEntitiesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateFindEntitiesCall is the typed call wrapper for FindEntities.
+type MockStateFindEntitiesCall = gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+
+// GetClientPassword mocks base method.
+func (m *MockState) GetClientPassword(ctx context.Context, name string) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_3(&m.recorder.getClientPasswordExpects, m.ctrl, m, "GetClientPassword", ctx, name)
+}
+
+// GetClientPassword indicates an expected call of GetClientPassword.
+func (mr *MockStateMockRecorder) GetClientPassword(ctx, name any) *MockStateGetClientPasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_3[context.Context, string, string, []byte, error](mr.mock.ctrl.T, mr.mock, "GetClientPassword", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getClientPasswordExpects = append(mr.getClientPasswordExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetClientPasswordCall is the typed call wrapper for GetClientPassword.
+type MockStateGetClientPasswordCall = gomock.Call2_3[context.Context, string, string, []byte, error]
+
+// GetEntities mocks base method.
+func (m *MockState) GetEntities(ctx context.Context, name string) ([]charmmirror.Entity, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getEntitiesExpects, m.ctrl, m, "GetEntities", ctx, name)
+}
+
+// GetEntities indicates an expected call of GetEntities.
+func (mr *MockStateMockRecorder) GetEntities(ctx, name any) *MockStateGetEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, []charmmirror.Entity, error](mr.mock.ctrl.T, mr.mock, "GetEntities", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getEntitiesExpects = append(mr.getEntitiesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetEntitiesCall is the typed call wrapper for GetEntities.
+type MockStateGetEntitiesCall = gomock.Call2_2[context.Context, string, []charmmirror.Entity, error]
+
+// GetObjectUUID mocks base method.
+func (m *MockState) GetObjectUUID(ctx context.Context, path string) (objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getObjectUUIDExpects, m.ctrl, m, "GetObjectUUID", ctx, path)
+}
+
+// GetObjectUUID indicates an expected call of GetObjectUUID.
+func (mr *MockStateMockRecorder) GetObjectUUID(ctx, path any) *MockStateGetObjectUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, objectstore.UUID, error](mr.mock.ctrl.T, mr.mock, "GetObjectUUID", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(path))
+	mr.getObjectUUIDExpects = append(mr.getObjectUUIDExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetObjectUUIDCall is the typed call wrapper for GetObjectUUID.
+type MockStateGetObjectUUIDCall = gomock.Call2_2[context.Context, string, objectstore.UUID, error]
+
+// HasArchive mocks base method.
+func (m *MockState) HasArchive(ctx context.Context, sha256 string) (bool, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.hasArchiveExpects, m.ctrl, m, "HasArchive", ctx, sha256)
+}
+
+// HasArchive indicates an expected call of HasArchive.
+func (mr *MockStateMockRecorder) HasArchive(ctx, sha256 any) *MockStateHasArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, bool, error](mr.mock.ctrl.T, mr.mock, "HasArchive", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(sha256))
+	mr.hasArchiveExpects = append(mr.hasArchiveExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateHasArchiveCall is the typed call wrapper for HasArchive.
+type MockStateHasArchiveCall = gomock.Call2_2[context.Context, string, bool, error]
+
+// RemoveClient mocks base method.
+func (m *MockState) RemoveClient(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.removeClientExpects, m.ctrl, m, "RemoveClient", ctx, name)
+}
+
+// RemoveClient indicates an expected call of RemoveClient.
+func (mr *MockStateMockRecorder) RemoveClient(ctx, name any) *MockStateRemoveClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "RemoveClient", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.removeClientExpects = append(mr.removeClientExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRemoveClientCall is the typed call wrapper for RemoveClient.
+type MockStateRemoveClientCall = gomock.Call2_1[context.Context, string, error]
+
+// SetClient mocks base method.
+func (m *MockState) SetClient(ctx context.Context, name, passwordHash string, passwordSalt []byte) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.setClientExpects, m.ctrl, m, "SetClient", ctx, name, passwordHash, passwordSalt)
+}
+
+// SetClient indicates an expected call of SetClient.
+func (mr *MockStateMockRecorder) SetClient(ctx, name, passwordHash, passwordSalt any) *MockStateSetClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, string, string, []byte, error](mr.mock.ctrl.T, mr.mock, "SetClient", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(passwordHash), gomock.EnsureMatcher(passwordSalt))
+	mr.setClientExpects = append(mr.setClientExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateSetClientCall is the typed call wrapper for SetClient.
+type MockStateSetClientCall = gomock.Call4_1[context.Context, string, string, []byte, error]
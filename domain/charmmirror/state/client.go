@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetClient sets the hash and salt of the password of the mirror client with
+// the given name, adding the client if it does not exist.
+func (st *State) SetClient(ctx context.Context, name, passwordHash string, passwordSalt []byte) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO charm_mirror_client (*) VALUES ($client.*)
+ON CONFLICT (name) DO UPDATE SET
+    password_hash = excluded.password_hash,
+    password_salt = excluded.password_salt`, client{})
+	if err != nil {
+		return errors.Errorf("preparing insert statement: %w", err)
+	}
+
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, stmt, client{
+			Name:         name,
+			PasswordHash: passwordHash,
+			PasswordSalt: passwordSalt,
+		}).Run())
+	}); err != nil {
+		return errors.Errorf("setting charm mirror client %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetClientPassword returns the hash and salt of the password of the mirror
+// client with the given name. If there is no such client, an error satisfying
+// [charmmirrorerrors.ClientNotFound] is returned.
+func (st *State) GetClientPassword(ctx context.Context, name string) (string, []byte, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", nil, errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &client.* FROM charm_mirror_client
+WHERE name = $clientName.name`, client{}, clientName{})
+	if err != nil {
+		return "", nil, errors.Errorf("preparing select statement: %w", err)
+	}
+
+	var result client
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, clientName{Name: name}).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return charmmirrorerrors.ClientNotFound
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return "", nil, errors.Errorf("getting charm mirror client %q: %w", name, err)
+	}
+	return result.PasswordHash, result.PasswordSalt, nil
+}
+
+// RemoveClient removes the mirror client with the given name. If there is no
+// such client, an error satisfying [charmmirrorerrors.ClientNotFound] is
+// returned.
+func (st *State) RemoveClient(ctx context.Context, name string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+DELETE FROM charm_mirror_client
+WHERE name = $clientName.name`, clientName{})
+	if err != nil {
+		return errors.Errorf("preparing delete statement: %w", err)
+	}
+
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, clientName{Name: name}).Get(&outcome); err != nil {
+			return errors.Capture(err)
+		}
+		if n, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if n == 0 {
+			return charmmirrorerrors.ClientNotFound
+		}
+		return nil
+	}); err != nil {
+		return errors.Errorf("removing charm mirror client %q: %w", name, err)
+	}
+	return nil
+}
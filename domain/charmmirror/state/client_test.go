@@ -0,0 +1,54 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/tc"
+
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+)
+
+func (s *stateSuite) TestSetAndGetClient(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.SetClient(c.Context(), "downstream", "hash-1", []byte("salt-1"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	hash, salt, err := st.GetClientPassword(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(hash, tc.Equals, "hash-1")
+	c.Check(salt, tc.DeepEquals, []byte("salt-1"))
+
+	// Setting the client again replaces its password.
+	err = st.SetClient(c.Context(), "downstream", "hash-2", []byte("salt-2"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	hash, salt, err = st.GetClientPassword(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(hash, tc.Equals, "hash-2")
+	c.Check(salt, tc.DeepEquals, []byte("salt-2"))
+}
+
+func (s *stateSuite) TestGetClientPasswordNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, _, err := st.GetClientPassword(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ClientNotFound)
+}
+
+func (s *stateSuite) TestRemoveClient(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.SetClient(c.Context(), "downstream", "hash", []byte("salt"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.RemoveClient(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = st.GetClientPassword(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ClientNotFound)
+
+	err = st.RemoveClient(c.Context(), "downstream")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ClientNotFound)
+}
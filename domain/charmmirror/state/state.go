@@ -0,0 +1,458 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// State implements persistence for the controller's Charmhub mirror.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new state reference.
+func NewState(factory database.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// GetObjectUUID returns the object store UUID for the given path. If no
+// object exists for the path, an error satisfying
+// [charmmirrorerrors.ObjectNotFound] is returned.
+func (st *State) GetObjectUUID(ctx context.Context, path string) (objectstore.UUID, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT metadata_uuid AS &objectStorePath.metadata_uuid
+FROM   object_store_metadata_path
+WHERE  path = $objectStorePath.path`, objectStorePath{})
+	if err != nil {
+		return "", errors.Errorf("preparing select statement: %w", err)
+	}
+
+	result := objectStorePath{Path: path}
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, result).Get(&result); errors.Is(err, sqlair.ErrNoRows) {
+			return charmmirrorerrors.ObjectNotFound
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		return nil
+	}); err != nil {
+		return "", errors.Errorf("getting object with path %q: %w", path, err)
+	}
+	return objectstore.UUID(result.MetadataUUID), nil
+}
+
+// AddEntity records a revision held in the object store under
+// objectStoreUUID. A revision cached from the upstream records when it was
+// cached in cachedAt, a seeded one has a zero cachedAt and is never evicted.
+// If the revision is already held, its bases and channels are merged with
+// those of the existing revision, and unless it was seeded it is recorded as
+// cached at cachedAt. If the existing revision has a different archive, an
+// error satisfying [charmmirrorerrors.RevisionConflict] is returned.
+func (st *State) AddEntity(
+	ctx context.Context, e charmmirror.Entity, objectStoreUUID objectstore.UUID, cachedAt time.Time,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Errorf("getting database: %w", err)
+	}
+
+	existingStmt, err := st.Prepare(`
+SELECT &entity.* FROM charm_mirror_entity
+WHERE name = $entity.name AND revision = $entity.revision`, entity{})
+	if err != nil {
+		return errors.Errorf("preparing select statement: %w", err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO charm_mirror_entity (*) VALUES ($entity.*)`, entity{})
+	if err != nil {
+		return errors.Errorf("preparing insert entity statement: %w", err)
+	}
+	updateCachedAtStmt, err := st.Prepare(`
+UPDATE charm_mirror_entity
+SET    cached_at = $entity.cached_at
+WHERE  uuid = $entity.uuid`, entity{})
+	if err != nil {
+		return errors.Errorf("preparing update cached at statement: %w", err)
+	}
+	insertBaseStmt, err := st.Prepare(`
+INSERT INTO charm_mirror_base (*) VALUES ($base.*)
+ON CONFLICT DO NOTHING`, base{})
+	if err != nil {
+		return errors.Errorf("preparing insert base statement: %w", err)
+	}
+	insertChannelStmt, err := st.Prepare(`
+INSERT INTO charm_mirror_channel (*) VALUES ($channel.*)
+ON CONFLICT (entity_uuid, channel) DO UPDATE SET
+    released_at = excluded.released_at`, channel{})
+	if err != nil {
+		return errors.Errorf("preparing insert channel statement: %w", err)
+	}
+
+	arg := entity{
+		CharmhubID:      e.ID,
+		Name:            e.Name,
+		Type:            e.Type,
+		Revision:        e.Revision,
+		Version:         e.Version,
+		Summary:         e.Summary,
+		License:         e.License,
+		Publisher:       e.Publisher,
+		MetadataYAML:    e.MetadataYAML,
+		ConfigYAML:      e.ConfigYAML,
+		ActionsYAML:     e.ActionsYAML,
+		CreatedAt:       e.CreatedAt,
+		ObjectStoreUUID: objectStoreUUID.String(),
+		CachedAt: sql.NullTime{
+			Time:  cachedAt.UTC(),
+			Valid: !cachedAt.IsZero(),
+		},
+	}
+
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		existing := arg
+		err := tx.Query(ctx, existingStmt, arg).Get(&existing)
+		switch {
+		case errors.Is(err, sqlair.ErrNoRows):
+			entityUUID, err := uuid.NewUUID()
+			if err != nil {
+				return errors.Capture(err)
+			}
+			arg.UUID = entityUUID.String()
+			if err := tx.Query(ctx, insertStmt, arg).Run(); err != nil {
+				return errors.Errorf("inserting entity: %w", err)
+			}
+		case err != nil:
+			return errors.Capture(err)
+		case existing.ObjectStoreUUID != arg.ObjectStoreUUID:
+			return charmmirrorerrors.RevisionConflict
+		default:
+			arg.UUID = existing.UUID
+			// A seeded revision stays pinned, otherwise the revision was
+			// either cached again or has now been seeded.
+			if existing.CachedAt.Valid {
+				if err := tx.Query(ctx, updateCachedAtStmt, arg).Run(); err != nil {
+					return errors.Errorf("updating cached at: %w", err)
+				}
+			}
+		}
+
+		for _, b := range e.Bases {
+			if err := tx.Query(ctx, insertBaseStmt, base{
+				EntityUUID:   arg.UUID,
+				Architecture: b.Architecture,
+				OS:           b.OS,
+				Channel:      b.Channel,
+			}).Run(); err != nil {
+				return errors.Errorf("inserting base: %w", err)
+			}
+		}
+		for _, ch := range e.Channels {
+			if err := tx.Query(ctx, insertChannelStmt, channel{
+				EntityUUID: arg.UUID,
+				Channel:    ch.Name,
+				ReleasedAt: ch.ReleasedAt,
+			}).Run(); err != nil {
+				return errors.Errorf("inserting channel: %w", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Errorf("adding %q revision %d: %w", e.Name, e.Revision, err)
+	}
+	return nil
+}
+
+// GetEntities returns every revision held for the charm or bundle with the
+// given name or Charmhub ID, ordered by descending revision. If none are
+// held, an error satisfying [charmmirrorerrors.EntityNotFound] is returned.
+func (st *State) GetEntities(ctx context.Context, name string) ([]charmmirror.Entity, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &entityView.* FROM v_charm_mirror_entity
+WHERE name = $entityName.name OR charmhub_id = $entityName.name
+ORDER BY revision DESC`, entityView{}, entityName{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select statement: %w", err)
+	}
+
+	var result []charmmirror.Entity
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var rows []entityView
+		if err := tx.Query(ctx, stmt, entityName{Name: name}).GetAll(&rows); errors.Is(err, sqlair.ErrNoRows) {
+			return charmmirrorerrors.EntityNotFound
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		result, err = st.decorateEntities(ctx, tx, rows)
+		return errors.Capture(err)
+	}); err != nil {
+		return nil, errors.Errorf("getting %q: %w", name, err)
+	}
+	return result, nil
+}
+
+// FindEntities returns every revision held for the charms and bundles whose
+// name contains the query, ordered by name and descending revision.
+func (st *State) FindEntities(ctx context.Context, query string) ([]charmmirror.Entity, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &entityView.* FROM v_charm_mirror_entity
+WHERE instr(name, $entityQuery.query) > 0
+ORDER BY name, revision DESC`, entityView{}, entityQuery{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select statement: %w", err)
+	}
+
+	var result []charmmirror.Entity
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var rows []entityView
+		if err := tx.Query(ctx, stmt, entityQuery{Query: query}).GetAll(&rows); errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		result, err = st.decorateEntities(ctx, tx, rows)
+		return errors.Capture(err)
+	}); err != nil {
+		return nil, errors.Errorf("finding %q: %w", query, err)
+	}
+	return result, nil
+}
+
+// HasArchive reports whether the mirror holds a revision whose archive has
+// the given SHA256 hash.
+func (st *State) HasArchive(ctx context.Context, sha256 string) (bool, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return false, errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &archiveHash.sha_256 FROM v_charm_mirror_entity
+WHERE sha_256 = $archiveHash.sha_256
+LIMIT 1`, archiveHash{})
+	if err != nil {
+		return false, errors.Errorf("preparing select statement: %w", err)
+	}
+
+	var found bool
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		result := archiveHash{SHA256: sha256}
+		if err := tx.Query(ctx, stmt, result).Get(&result); errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		found = true
+		return nil
+	}); err != nil {
+		return false, errors.Errorf("checking archive %q: %w", sha256, err)
+	}
+	return found, nil
+}
+
+// EvictCachedEntities removes the revisions cached from the upstream, oldest
+// first, until the archives of the remaining cached revisions total no more
+// than maxSize bytes. Seeded revisions are never evicted. The object store
+// paths of the archives no longer held by any revision are returned, so that
+// they can be removed from the object store.
+func (st *State) EvictCachedEntities(ctx context.Context, maxSize int64) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting database: %w", err)
+	}
+
+	sizeStmt, err := st.Prepare(`
+SELECT COALESCE(SUM(size), 0) AS &cacheSize.size
+FROM (
+    SELECT DISTINCT osm.uuid, osm.size
+    FROM   charm_mirror_entity AS e
+    JOIN   object_store_metadata AS osm ON e.object_store_uuid = osm.uuid
+    WHERE  e.cached_at IS NOT NULL
+)`, cacheSize{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select size statement: %w", err)
+	}
+	oldestStmt, err := st.Prepare(`
+SELECT &entity.* FROM charm_mirror_entity
+WHERE  cached_at IS NOT NULL
+ORDER BY cached_at
+LIMIT 1`, entity{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select oldest statement: %w", err)
+	}
+	deleteBasesStmt, err := st.Prepare(`
+DELETE FROM charm_mirror_base WHERE entity_uuid = $entity.uuid`, entity{})
+	if err != nil {
+		return nil, errors.Errorf("preparing delete bases statement: %w", err)
+	}
+	deleteChannelsStmt, err := st.Prepare(`
+DELETE FROM charm_mirror_channel WHERE entity_uuid = $entity.uuid`, entity{})
+	if err != nil {
+		return nil, errors.Errorf("preparing delete channels statement: %w", err)
+	}
+	deleteEntityStmt, err := st.Prepare(`
+DELETE FROM charm_mirror_entity WHERE uuid = $entity.uuid`, entity{})
+	if err != nil {
+		return nil, errors.Errorf("preparing delete entity statement: %w", err)
+	}
+	refsStmt, err := st.Prepare(`
+SELECT COUNT(*) AS &objectRefs.count
+FROM   charm_mirror_entity
+WHERE  object_store_uuid = $objectRefs.object_store_uuid`, objectRefs{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select references statement: %w", err)
+	}
+	pathStmt, err := st.Prepare(`
+SELECT &objectStorePath.* FROM object_store_metadata_path
+WHERE  metadata_uuid = $objectStorePath.metadata_uuid`, objectStorePath{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select path statement: %w", err)
+	}
+
+	var paths []string
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		paths = nil
+		for {
+			var size cacheSize
+			if err := tx.Query(ctx, sizeStmt).Get(&size); err != nil {
+				return errors.Errorf("getting cache size: %w", err)
+			}
+			if size.Size <= maxSize {
+				return nil
+			}
+
+			var oldest entity
+			if err := tx.Query(ctx, oldestStmt).Get(&oldest); errors.Is(err, sqlair.ErrNoRows) {
+				return nil
+			} else if err != nil {
+				return errors.Errorf("getting oldest cached revision: %w", err)
+			}
+			for _, stmt := range []*sqlair.Statement{deleteBasesStmt, deleteChannelsStmt, deleteEntityStmt} {
+				if err := tx.Query(ctx, stmt, oldest).Run(); err != nil {
+					return errors.Errorf("evicting %q revision %d: %w", oldest.Name, oldest.Revision, err)
+				}
+			}
+
+			refs := objectRefs{ObjectStoreUUID: oldest.ObjectStoreUUID}
+			if err := tx.Query(ctx, refsStmt, refs).Get(&refs); err != nil {
+				return errors.Errorf("counting references to archive: %w", err)
+			}
+			if refs.Count > 0 {
+				continue
+			}
+			var objectPaths []objectStorePath
+			if err := tx.Query(ctx, pathStmt, objectStorePath{
+				MetadataUUID: oldest.ObjectStoreUUID,
+			}).GetAll(&objectPaths); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf("getting archive paths: %w", err)
+			}
+			for _, p := range objectPaths {
+				paths = append(paths, p.Path)
+			}
+		}
+	}); err != nil {
+		return nil, errors.Errorf("evicting cached revisions: %w", err)
+	}
+	return paths, nil
+}
+
+func (st *State) decorateEntities(ctx context.Context, tx *sqlair.TX, rows []entityView) ([]charmmirror.Entity, error) {
+	uuids := make(entityUUIDs, len(rows))
+	for i, row := range rows {
+		uuids[i] = row.UUID
+	}
+
+	basesStmt, err := st.Prepare(`
+SELECT &base.* FROM charm_mirror_base
+WHERE entity_uuid IN ($entityUUIDs[:])
+ORDER BY architecture, os, channel`, base{}, uuids)
+	if err != nil {
+		return nil, errors.Errorf("preparing select bases statement: %w", err)
+	}
+	channelsStmt, err := st.Prepare(`
+SELECT &channel.* FROM charm_mirror_channel
+WHERE entity_uuid IN ($entityUUIDs[:])
+ORDER BY channel`, channel{}, uuids)
+	if err != nil {
+		return nil, errors.Errorf("preparing select channels statement: %w", err)
+	}
+
+	var bases []base
+	if err := tx.Query(ctx, basesStmt, uuids).GetAll(&bases); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("getting bases: %w", err)
+	}
+	var channels []channel
+	if err := tx.Query(ctx, channelsStmt, uuids).GetAll(&channels); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("getting channels: %w", err)
+	}
+
+	basesByEntity := make(map[string][]charmmirror.Base)
+	for _, b := range bases {
+		basesByEntity[b.EntityUUID] = append(basesByEntity[b.EntityUUID], charmmirror.Base{
+			Architecture: b.Architecture,
+			OS:           b.OS,
+			Channel:      b.Channel,
+		})
+	}
+	channelsByEntity := make(map[string][]charmmirror.Channel)
+	for _, ch := range channels {
+		channelsByEntity[ch.EntityUUID] = append(channelsByEntity[ch.EntityUUID], charmmirror.Channel{
+			Name:       ch.Channel,
+			ReleasedAt: ch.ReleasedAt,
+		})
+	}
+
+	result := make([]charmmirror.Entity, len(rows))
+	for i, row := range rows {
+		result[i] = charmmirror.Entity{
+			ID:           row.CharmhubID,
+			Name:         row.Name,
+			Type:         row.Type,
+			Revision:     row.Revision,
+			Version:      row.Version,
+			Summary:      row.Summary,
+			License:      row.License,
+			Publisher:    row.Publisher,
+			CreatedAt:    row.CreatedAt,
+			MetadataYAML: row.MetadataYAML,
+			ConfigYAML:   row.ConfigYAML,
+			ActionsYAML:  row.ActionsYAML,
+			Bases:        basesByEntity[row.UUID],
+			Channels:     channelsByEntity[row.UUID],
+			SHA256:       row.SHA256,
+			SHA384:       row.SHA384,
+			Size:         row.Size,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) TestGetObjectUUID(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	objectUUID := s.addObject(c, "charm-mirror/foo", "sha256-foo")
+
+	got, err := st.GetObjectUUID(c.Context(), "charm-mirror/foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.Equals, objectUUID)
+
+	_, err = st.GetObjectUUID(c.Context(), "charm-mirror/bar")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.ObjectNotFound)
+}
+
+func (s *stateSuite) TestAddAndGetEntities(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	rev1 := s.entity("postgresql", 1, "latest/stable")
+	rev2 := s.entity("postgresql", 2, "latest/edge")
+	err := st.AddEntity(c.Context(), rev1, s.addObject(c, "charm-mirror/1", "sha256-1"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), rev2, s.addObject(c, "charm-mirror/2", "sha256-2"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetEntities(c.Context(), "postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	rev1.SHA256, rev1.SHA384, rev1.Size = "sha256-1", "sha384-sha256-1", 42
+	rev2.SHA256, rev2.SHA384, rev2.Size = "sha256-2", "sha384-sha256-2", 42
+	c.Check(got, tc.DeepEquals, []charmmirror.Entity{rev2, rev1})
+
+	byID, err := st.GetEntities(c.Context(), "id-postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(byID, tc.DeepEquals, got)
+}
+
+func (s *stateSuite) TestGetEntitiesNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetEntities(c.Context(), "postgresql")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.EntityNotFound)
+}
+
+func (s *stateSuite) TestAddEntityMergesChannels(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	objectUUID := s.addObject(c, "charm-mirror/1", "sha256-1")
+	err := st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/edge"), objectUUID, time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), objectUUID, time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetEntities(c.Context(), "postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got, tc.HasLen, 1)
+	c.Check(got[0].Channels, tc.DeepEquals, []charmmirror.Channel{
+		{Name: "latest/edge", ReleasedAt: s.releasedAt()},
+		{Name: "latest/stable", ReleasedAt: s.releasedAt()},
+	})
+	c.Check(got[0].Bases, tc.HasLen, 1)
+}
+
+func (s *stateSuite) TestAddEntityConflict(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), s.addObject(c, "charm-mirror/1", "sha256-1"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), s.addObject(c, "charm-mirror/2", "sha256-2"), time.Time{})
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.RevisionConflict)
+}
+
+func (s *stateSuite) TestFindEntities(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), s.addObject(c, "charm-mirror/1", "sha256-1"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), s.entity("mysql", 3, "latest/stable"), s.addObject(c, "charm-mirror/2", "sha256-2"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.FindEntities(c.Context(), "sql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got, tc.HasLen, 2)
+	c.Check(got[0].Name, tc.Equals, "mysql")
+	c.Check(got[1].Name, tc.Equals, "postgresql")
+
+	got, err = st.FindEntities(c.Context(), "postgres")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got, tc.HasLen, 1)
+	c.Check(got[0].Name, tc.Equals, "postgresql")
+
+	got, err = st.FindEntities(c.Context(), "wordpress")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestHasArchive(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.addObject(c, "agent-binaries/foo", "sha256-agent")
+	err := st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), s.addObject(c, "charm-mirror/1", "sha256-1"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	found, err := st.HasArchive(c.Context(), "sha256-1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsTrue)
+
+	// Objects not held by the mirror are not reported.
+	found, err = st.HasArchive(c.Context(), "sha256-agent")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(found, tc.IsFalse)
+}
+
+func (s *stateSuite) TestEvictCachedEntities(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	cachedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	err := st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), s.addObject(c, "charm-mirror/1", "sha256-1"), cachedAt)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), s.entity("postgresql", 2, "latest/stable"), s.addObject(c, "charm-mirror/2", "sha256-2"), cachedAt.Add(time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), s.entity("mysql", 1, "latest/stable"), s.addObject(c, "charm-mirror/3", "sha256-3"), cachedAt.Add(2*time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+	// Seeded revisions are neither counted nor evicted.
+	err = st.AddEntity(c.Context(), s.entity("wordpress", 1, "latest/stable"), s.addObject(c, "charm-mirror/4", "sha256-4"), time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Each archive is 42 bytes, so only two cached revisions fit.
+	paths, err := st.EvictCachedEntities(c.Context(), 84)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(paths, tc.DeepEquals, []string{"charm-mirror/1"})
+
+	got, err := st.GetEntities(c.Context(), "postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got, tc.HasLen, 1)
+	c.Check(got[0].Revision, tc.Equals, 2)
+
+	paths, err = st.EvictCachedEntities(c.Context(), 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(paths, tc.DeepEquals, []string{"charm-mirror/2", "charm-mirror/3"})
+
+	_, err = st.GetEntities(c.Context(), "mysql")
+	c.Assert(err, tc.ErrorIs, charmmirrorerrors.EntityNotFound)
+	_, err = st.GetEntities(c.Context(), "wordpress")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestEvictCachedEntitiesSeededPinsRevision(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	objectUUID := s.addObject(c, "charm-mirror/1", "sha256-1")
+	err := st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), objectUUID, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddEntity(c.Context(), s.entity("postgresql", 1, "latest/stable"), objectUUID, time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	paths, err := st.EvictCachedEntities(c.Context(), 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(paths, tc.HasLen, 0)
+
+	_, err = st.GetEntities(c.Context(), "postgresql")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) entity(name string, revision int, channel string) charmmirror.Entity {
+	return charmmirror.Entity{
+		ID:           "id-" + name,
+		Name:         name,
+		Type:         "charm",
+		Revision:     revision,
+		Summary:      name + " summary",
+		Publisher:    "Canonical",
+		CreatedAt:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		MetadataYAML: "name: " + name + "\n",
+		Bases: []charmmirror.Base{{
+			Architecture: "amd64",
+			OS:           "ubuntu",
+			Channel:      "22.04",
+		}},
+		Channels: []charmmirror.Channel{{
+			Name:       channel,
+			ReleasedAt: s.releasedAt(),
+		}},
+	}
+}
+
+func (s *stateSuite) releasedAt() time.Time {
+	return time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *stateSuite) addObject(c *tc.C, path, sha256 string) objectstore.UUID {
+	objectUUID := uuid.MustNewUUID().String()
+	_, err := s.DB().ExecContext(c.Context(), `
+INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size)
+VALUES (?, ?, ?, 42)`, objectUUID, sha256, "sha384-"+sha256)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO object_store_metadata_path (path, metadata_uuid)
+VALUES (?, ?)`, path, objectUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	return objectstore.UUID(objectUUID)
+}
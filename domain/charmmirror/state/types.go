@@ -0,0 +1,97 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+)
+
+type entity struct {
+	UUID            string       `db:"uuid"`
+	CharmhubID      string       `db:"charmhub_id"`
+	Name            string       `db:"name"`
+	Type            string       `db:"type"`
+	Revision        int          `db:"revision"`
+	Version         string       `db:"version"`
+	Summary         string       `db:"summary"`
+	License         string       `db:"license"`
+	Publisher       string       `db:"publisher"`
+	MetadataYAML    string       `db:"metadata_yaml"`
+	ConfigYAML      string       `db:"config_yaml"`
+	ActionsYAML     string       `db:"actions_yaml"`
+	CreatedAt       time.Time    `db:"created_at"`
+	ObjectStoreUUID string       `db:"object_store_uuid"`
+	CachedAt        sql.NullTime `db:"cached_at"`
+}
+
+type entityView struct {
+	UUID         string    `db:"uuid"`
+	CharmhubID   string    `db:"charmhub_id"`
+	Name         string    `db:"name"`
+	Type         string    `db:"type"`
+	Revision     int       `db:"revision"`
+	Version      string    `db:"version"`
+	Summary      string    `db:"summary"`
+	License      string    `db:"license"`
+	Publisher    string    `db:"publisher"`
+	MetadataYAML string    `db:"metadata_yaml"`
+	ConfigYAML   string    `db:"config_yaml"`
+	ActionsYAML  string    `db:"actions_yaml"`
+	CreatedAt    time.Time `db:"created_at"`
+	SHA256       string    `db:"sha_256"`
+	SHA384       string    `db:"sha_384"`
+	Size         int64     `db:"size"`
+}
+
+type base struct {
+	EntityUUID   string `db:"entity_uuid"`
+	Architecture string `db:"architecture"`
+	OS           string `db:"os"`
+	Channel      string `db:"channel"`
+}
+
+type channel struct {
+	EntityUUID string    `db:"entity_uuid"`
+	Channel    string    `db:"channel"`
+	ReleasedAt time.Time `db:"released_at"`
+}
+
+type entityName struct {
+	Name string `db:"name"`
+}
+
+type entityQuery struct {
+	Query string `db:"query"`
+}
+
+type archiveHash struct {
+	SHA256 string `db:"sha_256"`
+}
+
+type objectStorePath struct {
+	Path         string `db:"path"`
+	MetadataUUID string `db:"metadata_uuid"`
+}
+
+type cacheSize struct {
+	Size int64 `db:"size"`
+}
+
+type objectRefs struct {
+	ObjectStoreUUID string `db:"object_store_uuid"`
+	Count           int    `db:"count"`
+}
+
+type entityUUIDs []string
+
+type client struct {
+	Name         string `db:"name"`
+	PasswordHash string `db:"password_hash"`
+	PasswordSalt []byte `db:"password_salt"`
+}
+
+type clientName struct {
+	Name string `db:"name"`
+}
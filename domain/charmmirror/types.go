@@ -0,0 +1,93 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmmirror
+
+import "time"
+
+// Entity is a single revision of a charm or bundle held by the mirror.
+type Entity struct {
+	// ID is the Charmhub ID of the charm or bundle.
+	ID string
+	// Name is the name of the charm or bundle.
+	Name string
+	// Type is either "charm" or "bundle".
+	Type string
+	// Revision is the revision of the charm or bundle.
+	Revision int
+	// Version is the version string of the revision, if any.
+	Version string
+	// Summary is the one line summary of the charm or bundle.
+	Summary string
+	// License is the license of the charm or bundle.
+	License string
+	// Publisher is the display name of the publisher.
+	Publisher string
+	// CreatedAt is when the revision was created.
+	CreatedAt time.Time
+
+	// MetadataYAML, ConfigYAML and ActionsYAML hold the minimal metadata
+	// required to deploy the charm.
+	MetadataYAML string
+	ConfigYAML   string
+	ActionsYAML  string
+
+	// Bases are the bases the revision supports.
+	Bases []Base
+	// Channels are the channels the revision has been released to.
+	Channels []Channel
+
+	// SHA256 and SHA384 are the hashes of the archive.
+	SHA256 string
+	SHA384 string
+	// Size is the size of the archive in bytes.
+	Size int64
+}
+
+// Base is a base supported by a revision.
+type Base struct {
+	Architecture string
+	OS           string
+	Channel      string
+}
+
+// Channel is a channel a revision has been released to.
+type Channel struct {
+	// Name is the normalised channel name, for example "latest/stable".
+	Name string
+	// ReleasedAt is when the revision was released to the channel.
+	ReleasedAt time.Time
+}
+
+// ResolveArgs describes the revision to resolve from the mirror.
+type ResolveArgs struct {
+	// Name is the name or Charmhub ID of the charm or bundle.
+	Name string
+	// Revision is the revision to resolve. If nil, the most recently
+	// released revision in Channel is resolved.
+	Revision *int
+	// Channel is the channel to resolve from. If empty, "latest/stable" is
+	// used.
+	Channel string
+	// Base is the base the revision must support. Empty fields match any
+	// value.
+	Base Base
+}
+
+// Resolved is a revision resolved from the mirror.
+type Resolved struct {
+	Entity
+	// Channel is the channel the revision was resolved from. It is empty when
+	// the revision was resolved explicitly.
+	Channel Channel
+}
+
+// CacheLimits bounds the archives the mirror caches from its upstream.
+type CacheLimits struct {
+	// MaxArchiveSize is the size in bytes of the largest archive that is
+	// cached.
+	MaxArchiveSize int64
+	// MaxSize is the total size in bytes of the cached archives. Once it is
+	// exceeded, the revisions cached longest ago are evicted.
+	MaxSize int64
+}
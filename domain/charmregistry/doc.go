@@ -0,0 +1,14 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package charmregistry holds the credentials the controller uses to pull
+// charms and bundles from OCI registries, and from Charmhub mirrors served by
+// other controllers. Credentials are keyed by registry host, and optional
+// port, and are only ever read by the controller when it authenticates with
+// a registry; they are never returned to clients.
+//
+// Each credential is held in a secret kept for the controller in the
+// controller model, so it is stored by the controller model's active secret
+// backend. The controller model's users can't see or edit these secrets. The
+// controller database only records the URI of the secret for each registry.
+package charmregistry
@@ -0,0 +1,16 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// CredentialNotFound is returned when no credential is held for a
+	// registry.
+	CredentialNotFound = errors.ConstError("charm registry credential not found")
+
+	// CredentialAlreadyExists is returned when a credential is added for a
+	// registry that already has one.
+	CredentialAlreadyExists = errors.ConstError("charm registry credential already exists")
+)
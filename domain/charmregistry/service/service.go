@@ -0,0 +1,202 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/charmregistry"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// usernameKey and passwordKey are the keys of the credential held in
+	// a registry's secret.
+	usernameKey = "username"
+	passwordKey = "password"
+)
+
+// State defines an interface for interacting with the underlying state.
+type State interface {
+	// AddCredential records the secret holding the credential for a
+	// registry. If a credential is already held for the registry, an error
+	// satisfying [charmregistryerrors.CredentialAlreadyExists] is returned.
+	AddCredential(ctx context.Context, registry string, secretURI *secrets.URI) error
+
+	// GetCredentialSecret returns the URI of the secret holding the
+	// credential for a registry. If no credential is held for the registry,
+	// an error satisfying [charmregistryerrors.CredentialNotFound] is
+	// returned.
+	GetCredentialSecret(ctx context.Context, registry string) (*secrets.URI, error)
+
+	// ListRegistries returns the registries that credentials are held for.
+	ListRegistries(ctx context.Context) ([]string, error)
+
+	// RemoveCredential removes the credential for a registry, returning the
+	// URI of the secret which held it. If no credential is held for the
+	// registry, an error satisfying
+	// [charmregistryerrors.CredentialNotFound] is returned.
+	RemoveCredential(ctx context.Context, registry string) (*secrets.URI, error)
+}
+
+// SecretStore stores secrets kept for the controller in the controller
+// model. These secrets are not visible to, nor editable by, users of the
+// controller model.
+type SecretStore interface {
+	// CreateControllerSecret creates a secret holding the value, returning
+	// its URI.
+	CreateControllerSecret(ctx context.Context, description string, value secrets.SecretValue) (*secrets.URI, error)
+
+	// UpdateControllerSecret adds a revision holding the value to a secret.
+	UpdateControllerSecret(ctx context.Context, uri *secrets.URI, value secrets.SecretValue) error
+
+	// GetControllerSecretValue returns the value held by the latest revision
+	// of a secret.
+	GetControllerSecretValue(ctx context.Context, uri *secrets.URI) (secrets.SecretValue, error)
+
+	// DeleteControllerSecret removes a secret and all of its revisions.
+	DeleteControllerSecret(ctx context.Context, uri *secrets.URI) error
+}
+
+// Service provides access to the credentials used to pull charms from OCI
+// registries.
+type Service struct {
+	st      State
+	secrets SecretStore
+}
+
+// NewService returns a new Service for interacting with the underlying state
+// and the secrets holding the credentials.
+func NewService(st State, secrets SecretStore) *Service {
+	return &Service{
+		st:      st,
+		secrets: secrets,
+	}
+}
+
+// SetCredential sets the credential for a registry, replacing any existing
+// credential for it. The registry is the host, and optional port, of the
+// registry and must not include a scheme or path. The credential is stored in
+// a secret; replacing a credential adds a new revision to its secret.
+func (s *Service) SetCredential(ctx context.Context, cred charmregistry.Credential) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateRegistry(cred.Registry); err != nil {
+		return errors.Capture(err)
+	}
+	if cred.Username == "" {
+		return errors.Errorf("empty username for registry %q", cred.Registry).Add(coreerrors.NotValid)
+	}
+	if cred.Password == "" {
+		return errors.Errorf("empty password for registry %q", cred.Registry).Add(coreerrors.NotValid)
+	}
+	value := secrets.NewSecretValue(map[string]string{
+		usernameKey: base64.StdEncoding.EncodeToString([]byte(cred.Username)),
+		passwordKey: base64.StdEncoding.EncodeToString([]byte(cred.Password)),
+	})
+
+	uri, err := s.st.GetCredentialSecret(ctx, cred.Registry)
+	if err == nil {
+		if err := s.secrets.UpdateControllerSecret(ctx, uri, value); err != nil {
+			return errors.Errorf("updating secret for registry %q: %w", cred.Registry, err)
+		}
+		return nil
+	} else if !errors.Is(err, charmregistryerrors.CredentialNotFound) {
+		return errors.Capture(err)
+	}
+
+	uri, err = s.secrets.CreateControllerSecret(ctx, fmt.Sprintf("credential for charm registry %s", cred.Registry), value)
+	if err != nil {
+		return errors.Errorf("creating secret for registry %q: %w", cred.Registry, err)
+	}
+	if err := s.st.AddCredential(ctx, cred.Registry, uri); err != nil {
+		// Don't leave behind a secret which nothing refers to.
+		if deleteErr := s.secrets.DeleteControllerSecret(ctx, uri); deleteErr != nil {
+			return errors.Errorf("%w (removing secret %q: %v)", err, uri, deleteErr)
+		}
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// GetCredential returns the credential for a registry, read from its secret.
+// If no credential is held for the registry, an error satisfying
+// [charmregistryerrors.CredentialNotFound] is returned.
+func (s *Service) GetCredential(ctx context.Context, registry string) (charmregistry.Credential, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateRegistry(registry); err != nil {
+		return charmregistry.Credential{}, errors.Capture(err)
+	}
+	uri, err := s.st.GetCredentialSecret(ctx, registry)
+	if err != nil {
+		return charmregistry.Credential{}, errors.Capture(err)
+	}
+	value, err := s.secrets.GetControllerSecretValue(ctx, uri)
+	if err != nil {
+		return charmregistry.Credential{}, errors.Errorf("reading secret for registry %q: %w", registry, err)
+	}
+	username, err := value.KeyValue(usernameKey)
+	if err != nil {
+		return charmregistry.Credential{}, errors.Errorf("reading username for registry %q: %w", registry, err)
+	}
+	password, err := value.KeyValue(passwordKey)
+	if err != nil {
+		return charmregistry.Credential{}, errors.Errorf("reading password for registry %q: %w", registry, err)
+	}
+	return charmregistry.Credential{
+		Registry: registry,
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+// ListRegistries returns the registries that credentials are held for.
+func (s *Service) ListRegistries(ctx context.Context) ([]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.ListRegistries(ctx)
+}
+
+// RemoveCredential removes the credential for a registry, along with the
+// secret holding it. If no credential is held for the registry, an error
+// satisfying [charmregistryerrors.CredentialNotFound] is returned.
+func (s *Service) RemoveCredential(ctx context.Context, registry string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := validateRegistry(registry); err != nil {
+		return errors.Capture(err)
+	}
+	uri, err := s.st.RemoveCredential(ctx, registry)
+	if errors.Is(err, charmregistryerrors.CredentialNotFound) {
+		return errors.Errorf("registry %q: %w", registry, err)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.secrets.DeleteControllerSecret(ctx, uri); err != nil {
+		return errors.Errorf("removing secret for registry %q: %w", registry, err)
+	}
+	return nil
+}
+
+func validateRegistry(registry string) error {
+	if registry == "" {
+		return errors.New("empty registry").Add(coreerrors.NotValid)
+	}
+	if strings.ContainsAny(registry, "/@ ") {
+		return errors.Errorf("registry %q must be a host, with an optional port", registry).Add(coreerrors.NotValid)
+	}
+	return nil
+}
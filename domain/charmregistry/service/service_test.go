@@ -0,0 +1,183 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/charmregistry"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+type serviceSuite struct {
+	st      *MockState
+	secrets *MockSecretStore
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) TestSetCredentialCreatesSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.st.EXPECT().GetCredentialSecret(gomock.Any(), "registry.example.com:5000").Return(nil, charmregistryerrors.CredentialNotFound)
+	s.secrets.EXPECT().CreateControllerSecret(gomock.Any(), "credential for charm registry registry.example.com:5000", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, value secrets.SecretValue) (*secrets.URI, error) {
+			s.checkValue(c, value, "user", "pass")
+			return uri, nil
+		})
+	s.st.EXPECT().AddCredential(gomock.Any(), "registry.example.com:5000", uri).Return(nil)
+
+	err := s.newService().SetCredential(c.Context(), charmregistry.Credential{
+		Registry: "registry.example.com:5000",
+		Username: "user",
+		Password: "pass",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetCredentialUpdatesSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.st.EXPECT().GetCredentialSecret(gomock.Any(), "registry.example.com").Return(uri, nil)
+	s.secrets.EXPECT().UpdateControllerSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *secrets.URI, value secrets.SecretValue) error {
+			s.checkValue(c, value, "robot", "token")
+			return nil
+		})
+
+	err := s.newService().SetCredential(c.Context(), charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "robot",
+		Password: "token",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetCredentialRemovesSecretOnAddError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.st.EXPECT().GetCredentialSecret(gomock.Any(), "registry.example.com").Return(nil, charmregistryerrors.CredentialNotFound)
+	s.secrets.EXPECT().CreateControllerSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(uri, nil)
+	s.st.EXPECT().AddCredential(gomock.Any(), "registry.example.com", uri).Return(charmregistryerrors.CredentialAlreadyExists)
+	s.secrets.EXPECT().DeleteControllerSecret(gomock.Any(), uri).Return(nil)
+
+	err := s.newService().SetCredential(c.Context(), charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "user",
+		Password: "pass",
+	})
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialAlreadyExists)
+}
+
+func (s *serviceSuite) TestSetCredentialInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.newService()
+	for _, cred := range []charmregistry.Credential{{
+		Username: "user",
+		Password: "pass",
+	}, {
+		Registry: "https://registry.example.com",
+		Username: "user",
+		Password: "pass",
+	}, {
+		Registry: "registry.example.com",
+		Password: "pass",
+	}, {
+		Registry: "registry.example.com",
+		Username: "user",
+	}} {
+		err := svc.SetCredential(c.Context(), cred)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	}
+}
+
+func (s *serviceSuite) TestGetCredential(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.st.EXPECT().GetCredentialSecret(gomock.Any(), "registry.example.com").Return(uri, nil)
+	s.secrets.EXPECT().GetControllerSecretValue(gomock.Any(), uri).Return(secrets.NewSecretValue(map[string]string{
+		"username": "dXNlcg==",
+		"password": "cGFzcw==",
+	}), nil)
+
+	got, err := s.newService().GetCredential(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "user",
+		Password: "pass",
+	})
+}
+
+func (s *serviceSuite) TestGetCredentialSecretError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.st.EXPECT().GetCredentialSecret(gomock.Any(), "registry.example.com").Return(uri, nil)
+	s.secrets.EXPECT().GetControllerSecretValue(gomock.Any(), uri).Return(nil, errors.New("backend unavailable"))
+
+	_, err := s.newService().GetCredential(c.Context(), "registry.example.com")
+	c.Check(err, tc.ErrorMatches, `reading secret for registry "registry.example.com": backend unavailable`)
+}
+
+func (s *serviceSuite) TestRemoveCredential(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := secrets.NewURI()
+	s.st.EXPECT().RemoveCredential(gomock.Any(), "registry.example.com").Return(uri, nil)
+	s.secrets.EXPECT().DeleteControllerSecret(gomock.Any(), uri).Return(nil)
+
+	err := s.newService().RemoveCredential(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRemoveCredentialNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().RemoveCredential(gomock.Any(), "registry.example.com").Return(nil, charmregistryerrors.CredentialNotFound)
+
+	err := s.newService().RemoveCredential(c.Context(), "registry.example.com")
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialNotFound)
+}
+
+func (s *serviceSuite) checkValue(c *tc.C, value secrets.SecretValue, username, password string) {
+	values, err := value.Values()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(values, tc.DeepEquals, map[string]string{
+		"username": username,
+		"password": password,
+	})
+}
+
+func (s *serviceSuite) newService() *Service {
+	return NewService(s.st, s.secrets)
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.st = NewMockState(ctrl)
+	s.secrets = NewMockSecretStore(ctrl)
+
+	c.Cleanup(func() {
+		s.st = nil
+		s.secrets = nil
+	})
+
+	return ctrl
+}
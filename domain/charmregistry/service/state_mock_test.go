@@ -0,0 +1,217 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/charmregistry/service (interfaces: State,SecretStore)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination state_mock_test.go github.com/juju/juju/domain/charmregistry/service State,SecretStore
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	secrets "github.com/juju/juju/core/secrets"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+	isgomock struct{}
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock                       *MockState
+	addCredentialExpects       []*gomock.Call3_1[context.Context, string, *secrets.URI, error]
+	getCredentialSecretExpects []*gomock.Call2_2[context.Context, string, *secrets.URI, error]
+	listRegistriesExpects      []*gomock.Call1_2[context.Context, []string, error]
+	removeCredentialExpects    []*gomock.Call2_2[context.Context, string, *secrets.URI, error]
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// AddCredential mocks base method.
+func (m *MockState) AddCredential(ctx context.Context, registry string, secretURI *secrets.URI) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.addCredentialExpects, m.ctrl, m, "AddCredential", ctx, registry, secretURI)
+}
+
+// AddCredential indicates an expected call of AddCredential.
+func (mr *MockStateMockRecorder) AddCredential(ctx, registry, secretURI any) *MockStateAddCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, *secrets.URI, error](mr.mock.ctrl.T, mr.mock, "AddCredential", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(registry), gomock.EnsureMatcher(secretURI))
+	mr.addCredentialExpects = append(mr.addCredentialExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddCredentialCall is the typed call wrapper for AddCredential.
+type MockStateAddCredentialCall = gomock.Call3_1[context.Context, string, *secrets.URI, error]
+
+// GetCredentialSecret mocks base method.
+func (m *MockState) GetCredentialSecret(ctx context.Context, registry string) (*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getCredentialSecretExpects, m.ctrl, m, "GetCredentialSecret", ctx, registry)
+}
+
+// GetCredentialSecret indicates an expected call of GetCredentialSecret.
+func (mr *MockStateMockRecorder) GetCredentialSecret(ctx, registry any) *MockStateGetCredentialSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, *secrets.URI, error](mr.mock.ctrl.T, mr.mock, "GetCredentialSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(registry))
+	mr.getCredentialSecretExpects = append(mr.getCredentialSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetCredentialSecretCall is the typed call wrapper for GetCredentialSecret.
+type MockStateGetCredentialSecretCall = gomock.Call2_2[context.Context, string, *secrets.URI, error]
+
+// ListRegistries mocks base method.
+func (m *MockState) ListRegistries(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.listRegistriesExpects, m.ctrl, m, "ListRegistries", ctx)
+}
+
+// ListRegistries indicates an expected call of ListRegistries.
+func (mr *MockStateMockRecorder) ListRegistries(ctx any) *MockStateListRegistriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []string, error](mr.mock.ctrl.T, mr.mock, "ListRegistries", gomock.EnsureMatcher(ctx))
+	mr.listRegistriesExpects = append(mr.listRegistriesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateListRegistriesCall is the typed call wrapper for ListRegistries.
+type MockStateListRegistriesCall = gomock.Call1_2[context.Context, []string, error]
+
+// RemoveCredential mocks base method.
+func (m *MockState) RemoveCredential(ctx context.Context, registry string) (*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.removeCredentialExpects, m.ctrl, m, "RemoveCredential", ctx, registry)
+}
+
+// RemoveCredential indicates an expected call of RemoveCredential.
+func (mr *MockStateMockRecorder) RemoveCredential(ctx, registry any) *MockStateRemoveCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, *secrets.URI, error](mr.mock.ctrl.T, mr.mock, "RemoveCredential", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(registry))
+	mr.removeCredentialExpects = append(mr.removeCredentialExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRemoveCredentialCall is the typed call wrapper for RemoveCredential.
+type MockStateRemoveCredentialCall = gomock.Call2_2[context.Context, string, *secrets.URI, error]
+
+// MockSecretStore is a mock of SecretStore interface.
+type MockSecretStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretStoreMockRecorder is the mock recorder for MockSecretStore.
+type MockSecretStoreMockRecorder struct {
+	mock                            *MockSecretStore
+	createControllerSecretExpects   []*gomock.Call3_2[context.Context, string, secrets.SecretValue, *secrets.URI, error]
+	deleteControllerSecretExpects   []*gomock.Call2_1[context.Context, *secrets.URI, error]
+	getControllerSecretValueExpects []*gomock.Call2_2[context.Context, *secrets.URI, secrets.SecretValue, error]
+	updateControllerSecretExpects   []*gomock.Call3_1[context.Context, *secrets.URI, secrets.SecretValue, error]
+}
+
+// NewMockSecretStore creates a new mock instance.
+func NewMockSecretStore(ctrl *gomock.Controller) *MockSecretStore {
+	mock := &MockSecretStore{ctrl: ctrl}
+	mock.recorder = &MockSecretStoreMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretStore) EXPECT() *MockSecretStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateControllerSecret mocks base method.
+func (m *MockSecretStore) CreateControllerSecret(ctx context.Context, description string, value secrets.SecretValue) (*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.createControllerSecretExpects, m.ctrl, m, "CreateControllerSecret", ctx, description, value)
+}
+
+// CreateControllerSecret indicates an expected call of CreateControllerSecret.
+func (mr *MockSecretStoreMockRecorder) CreateControllerSecret(ctx, description, value any) *MockSecretStoreCreateControllerSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, secrets.SecretValue, *secrets.URI, error](mr.mock.ctrl.T, mr.mock, "CreateControllerSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(description), gomock.EnsureMatcher(value))
+	mr.createControllerSecretExpects = append(mr.createControllerSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretStoreCreateControllerSecretCall is the typed call wrapper for CreateControllerSecret.
+type MockSecretStoreCreateControllerSecretCall = gomock.Call3_2[context.Context, string, secrets.SecretValue, *secrets.URI, error]
+
+// DeleteControllerSecret mocks base method.
+func (m *MockSecretStore) DeleteControllerSecret(ctx context.Context, uri *secrets.URI) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.deleteControllerSecretExpects, m.ctrl, m, "DeleteControllerSecret", ctx, uri)
+}
+
+// DeleteControllerSecret indicates an expected call of DeleteControllerSecret.
+func (mr *MockSecretStoreMockRecorder) DeleteControllerSecret(ctx, uri any) *MockSecretStoreDeleteControllerSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, *secrets.URI, error](mr.mock.ctrl.T, mr.mock, "DeleteControllerSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri))
+	mr.deleteControllerSecretExpects = append(mr.deleteControllerSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretStoreDeleteControllerSecretCall is the typed call wrapper for DeleteControllerSecret.
+type MockSecretStoreDeleteControllerSecretCall = gomock.Call2_1[context.Context, *secrets.URI, error]
+
+// GetControllerSecretValue mocks base method.
+func (m *MockSecretStore) GetControllerSecretValue(ctx context.Context, uri *secrets.URI) (secrets.SecretValue, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getControllerSecretValueExpects, m.ctrl, m, "GetControllerSecretValue", ctx, uri)
+}
+
+// GetControllerSecretValue indicates an expected call of GetControllerSecretValue.
+func (mr *MockSecretStoreMockRecorder) GetControllerSecretValue(ctx, uri any) *MockSecretStoreGetControllerSecretValueCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, *secrets.URI, secrets.SecretValue, error](mr.mock.ctrl.T, mr.mock, "GetControllerSecretValue", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri))
+	mr.getControllerSecretValueExpects = append(mr.getControllerSecretValueExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretStoreGetControllerSecretValueCall is the typed call wrapper for GetControllerSecretValue.
+type MockSecretStoreGetControllerSecretValueCall = gomock.Call2_2[context.Context, *secrets.URI, secrets.SecretValue, error]
+
+// UpdateControllerSecret mocks base method.
+func (m *MockSecretStore) UpdateControllerSecret(ctx context.Context, uri *secrets.URI, value secrets.SecretValue) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.updateControllerSecretExpects, m.ctrl, m, "UpdateControllerSecret", ctx, uri, value)
+}
+
+// UpdateControllerSecret indicates an expected call of UpdateControllerSecret.
+func (mr *MockSecretStoreMockRecorder) UpdateControllerSecret(ctx, uri, value any) *MockSecretStoreUpdateControllerSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, *secrets.URI, secrets.SecretValue, error](mr.mock.ctrl.T, mr.mock, "UpdateControllerSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(value))
+	mr.updateControllerSecretExpects = append(mr.updateControllerSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretStoreUpdateControllerSecretCall is the typed call wrapper for UpdateControllerSecret.
+type MockSecretStoreUpdateControllerSecretCall = gomock.Call3_1[context.Context, *secrets.URI, secrets.SecretValue, error]
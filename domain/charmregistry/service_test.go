@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmregistry_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/charmregistry"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
+	servicestesting "github.com/juju/juju/domain/services/testing"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+)
+
+type serviceSuite struct {
+	servicestesting.DomainServicesSuite
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+// TestCredentialHeldInControllerModelSecret checks that a registry's
+// credential is stored in a secret owned by the controller model, and that
+// the controller database only refers to it.
+func (s *serviceSuite) TestCredentialHeldInControllerModelSecret(c *tc.C) {
+	svc := s.ControllerDomainServices(c).CharmRegistry()
+
+	err := svc.SetCredential(c.Context(), charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "user",
+		Password: "s3cret",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	var secretURI string
+	err = s.ControllerTxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT secret_uri FROM charm_registry_credential WHERE registry = ?`, "registry.example.com").Scan(&secretURI)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	uri, err := secrets.ParseURI(secretURI)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var owned int
+	err = s.ModelTxnRunner(c, s.ControllerModelUUID.String()).StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT COUNT(*) FROM secret_model_owner WHERE secret_id = ?`, uri.ID).Scan(&owned)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(owned, tc.Equals, 1)
+
+	cred, err := svc.GetCredential(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cred, tc.DeepEquals, charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "user",
+		Password: "s3cret",
+	})
+
+	// Replacing the credential adds a revision to the same secret.
+	err = svc.SetCredential(c.Context(), charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "robot",
+		Password: "token",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	cred, err = svc.GetCredential(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cred.Username, tc.Equals, "robot")
+	c.Check(cred.Password, tc.Equals, "token")
+
+	err = svc.RemoveCredential(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = svc.GetCredential(c.Context(), "registry.example.com")
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialNotFound)
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State implements persistence for the secrets holding charm registry
+// credentials.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new state reference.
+func NewState(factory database.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddCredential records the secret holding the credential for a registry.
+// If a credential is already held for the registry, an error satisfying
+// [charmregistryerrors.CredentialAlreadyExists] is returned.
+func (st *State) AddCredential(ctx context.Context, name string, secretURI *secrets.URI) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO charm_registry_credential (*) VALUES ($credential.*)`, credential{})
+	if err != nil {
+		return errors.Errorf("preparing insert statement: %w", err)
+	}
+
+	cred := credential{
+		Registry:  name,
+		SecretURI: secretURI.String(),
+	}
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, cred).Run()
+		if internaldatabase.IsErrConstraintPrimaryKey(err) {
+			return charmregistryerrors.CredentialAlreadyExists
+		}
+		return errors.Capture(err)
+	}); err != nil {
+		return errors.Errorf("adding credential for registry %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetCredentialSecret returns the URI of the secret holding the credential
+// for a registry. If no credential is held for the registry, an error
+// satisfying [charmregistryerrors.CredentialNotFound] is returned.
+func (st *State) GetCredentialSecret(ctx context.Context, name string) (*secrets.URI, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &credential.* FROM charm_registry_credential
+WHERE registry = $credential.registry`, credential{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select statement: %w", err)
+	}
+
+	var result credential
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		result, err = getCredential(ctx, tx, stmt, name)
+		return err
+	}); err != nil {
+		return nil, errors.Errorf("getting credential for registry %q: %w", name, err)
+	}
+	return parseSecretURI(result)
+}
+
+// ListRegistries returns the registries that credentials are held for.
+func (st *State) ListRegistries(ctx context.Context) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting database: %w", err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &registry.* FROM charm_registry_credential
+ORDER BY registry`, registry{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select statement: %w", err)
+	}
+
+	var rows []registry
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt).GetAll(&rows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Errorf("listing charm registries: %w", err)
+	}
+
+	registries := make([]string, len(rows))
+	for i, row := range rows {
+		registries[i] = row.Registry
+	}
+	return registries, nil
+}
+
+// RemoveCredential removes the credential for a registry, returning the URI
+// of the secret which held it. If no credential is held for the registry, an
+// error satisfying [charmregistryerrors.CredentialNotFound] is returned.
+func (st *State) RemoveCredential(ctx context.Context, name string) (*secrets.URI, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting database: %w", err)
+	}
+
+	selectStmt, err := st.Prepare(`
+SELECT &credential.* FROM charm_registry_credential
+WHERE registry = $credential.registry`, credential{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select statement: %w", err)
+	}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM charm_registry_credential
+WHERE registry = $registry.registry`, registry{})
+	if err != nil {
+		return nil, errors.Errorf("preparing delete statement: %w", err)
+	}
+
+	var result credential
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		result, err = getCredential(ctx, tx, selectStmt, name)
+		if err != nil {
+			return err
+		}
+		return errors.Capture(tx.Query(ctx, deleteStmt, registry{Registry: name}).Run())
+	}); err != nil {
+		return nil, errors.Errorf("removing credential for registry %q: %w", name, err)
+	}
+	return parseSecretURI(result)
+}
+
+func getCredential(ctx context.Context, tx *sqlair.TX, stmt *sqlair.Statement, name string) (credential, error) {
+	result := credential{Registry: name}
+	if err := tx.Query(ctx, stmt, result).Get(&result); errors.Is(err, sqlair.ErrNoRows) {
+		return credential{}, charmregistryerrors.CredentialNotFound
+	} else if err != nil {
+		return credential{}, errors.Capture(err)
+	}
+	return result, nil
+}
+
+func parseSecretURI(cred credential) (*secrets.URI, error) {
+	uri, err := secrets.ParseURI(cred.SecretURI)
+	if err != nil {
+		return nil, errors.Errorf("parsing secret URI for registry %q: %w", cred.Registry, err)
+	}
+	return uri, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/secrets"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) TestAddCredential(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	uri := secrets.NewURI()
+	err := st.AddCredential(c.Context(), "registry.example.com", uri)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetCredentialSecret(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.ID, tc.Equals, uri.ID)
+}
+
+func (s *stateSuite) TestAddCredentialAlreadyExists(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddCredential(c.Context(), "registry.example.com", secrets.NewURI())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.AddCredential(c.Context(), "registry.example.com", secrets.NewURI())
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialAlreadyExists)
+}
+
+func (s *stateSuite) TestCredentialHoldsNoSecretContent(c *tc.C) {
+	rows, err := s.DB().QueryContext(c.Context(), "SELECT name FROM pragma_table_info('charm_registry_credential') ORDER BY cid")
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		c.Assert(rows.Scan(&name), tc.ErrorIsNil)
+		columns = append(columns, name)
+	}
+	c.Assert(rows.Err(), tc.ErrorIsNil)
+	c.Check(columns, tc.DeepEquals, []string{"registry", "secret_uri"})
+}
+
+func (s *stateSuite) TestGetCredentialSecretNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetCredentialSecret(c.Context(), "registry.example.com")
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialNotFound)
+}
+
+func (s *stateSuite) TestListRegistries(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	registries, err := st.ListRegistries(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(registries, tc.HasLen, 0)
+
+	for _, name := range []string{"zot.internal:5000", "harbor.internal"} {
+		err := st.AddCredential(c.Context(), name, secrets.NewURI())
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	registries, err = st.ListRegistries(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(registries, tc.DeepEquals, []string{"harbor.internal", "zot.internal:5000"})
+}
+
+func (s *stateSuite) TestRemoveCredential(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	uri := secrets.NewURI()
+	err := st.AddCredential(c.Context(), "registry.example.com", uri)
+	c.Assert(err, tc.ErrorIsNil)
+
+	removed, err := st.RemoveCredential(c.Context(), "registry.example.com")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(removed.ID, tc.Equals, uri.ID)
+
+	_, err = st.GetCredentialSecret(c.Context(), "registry.example.com")
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialNotFound)
+
+	_, err = st.RemoveCredential(c.Context(), "registry.example.com")
+	c.Check(err, tc.ErrorIs, charmregistryerrors.CredentialNotFound)
+}
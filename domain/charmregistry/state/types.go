@@ -0,0 +1,13 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+type credential struct {
+	Registry  string `db:"registry"`
+	SecretURI string `db:"secret_uri"`
+}
+
+type registry struct {
+	Registry string `db:"registry"`
+}
@@ -0,0 +1,16 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmregistry
+
+// Credential holds the credentials for an OCI registry.
+type Credential struct {
+	// Registry is the host, and optional port, of the registry.
+	Registry string
+
+	// Username is the user to authenticate as.
+	Username string
+
+	// Password is the password or access token of the user.
+	Password string
+}
@@ -750,6 +750,14 @@ WHERE  grant_on = $dbCloudName.name
 		return errors.Errorf("preparing delete cloud from permissions statement: %w", err)
 	}
 
+	groupPermissionsStmt, err := st.Prepare(`
+DELETE FROM user_group_permission
+WHERE  grant_on = $dbCloudName.name
+`, dbCloudName{})
+	if err != nil {
+		return errors.Errorf("preparing delete cloud from group permissions statement: %w", err)
+	}
+
 	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		// Check if any model references this cloud.
 		var count countResult
@@ -787,6 +795,10 @@ WHERE  grant_on = $dbCloudName.name
 		if err != nil {
 			return errors.Errorf("deleting permissions on cloud: %w", err)
 		}
+		err = tx.Query(ctx, groupPermissionsStmt, cloudName).Run()
+		if err != nil {
+			return errors.Errorf("deleting group permissions on cloud: %w", err)
+		}
 		var outcome sqlair.Outcome
 		err = tx.Query(ctx, cloudDeleteStmt, cloudName).Get(&outcome)
 		if err != nil {
@@ -0,0 +1,195 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ChangeKind identifies the part of the configuration a change applies to.
+type ChangeKind string
+
+const (
+	// ModelConfigChange is a change to a model config attribute.
+	ModelConfigChange ChangeKind = "model-config"
+	// MissingApplication is an application in the snapshot which has since
+	// been removed. It can't be rolled back; the application must be
+	// deployed again.
+	MissingApplication ChangeKind = "application"
+	// CharmChange is a change to the charm revision of an application.
+	CharmChange ChangeKind = "charm"
+	// ApplicationConfigChange is a change to an application config key.
+	ApplicationConfigChange ChangeKind = "config"
+	// ConstraintsChange is a change to the constraints of an application.
+	ConstraintsChange ChangeKind = "constraints"
+	// BindingChange is a change to the space an endpoint is bound to.
+	BindingChange ChangeKind = "binding"
+	// ExposeChange is a change to the expose settings of an endpoint.
+	ExposeChange ChangeKind = "expose"
+)
+
+// Change is a difference between the current configuration and the one of
+// a snapshot.
+type Change struct {
+	// Kind is the part of the configuration that changed.
+	Kind ChangeKind
+	// Application is the name of the application the change applies to,
+	// or empty for model config changes.
+	Application string
+	// Key is the model or application config key, or the endpoint name for
+	// binding and expose changes.
+	Key string
+	// Current is the current value.
+	Current string
+	// Target is the value in the snapshot.
+	Target string
+	// Unset is true if the key isn't set in the snapshot, so rolling back
+	// unsets it.
+	Unset bool
+}
+
+// Diff returns the changes required to roll the current configuration back
+// to the target snapshot, ordered by model config first, then by
+// application. Applications which were deployed after the target snapshot
+// was taken are left untouched, so there are no changes for them.
+func Diff(current, target Snapshot) []Change {
+	changes := diffConfig(ModelConfigChange, "", current.ModelConfig, target.ModelConfig)
+
+	for _, appName := range slices.Sorted(maps.Keys(target.Applications)) {
+		targetApp := target.Applications[appName]
+		currentApp, ok := current.Applications[appName]
+		if !ok {
+			changes = append(changes, Change{
+				Kind:        MissingApplication,
+				Application: appName,
+				Target:      targetApp.Charm.String(),
+			})
+			continue
+		}
+		changes = append(changes, diffApplication(appName, currentApp, targetApp)...)
+	}
+	return changes
+}
+
+func diffApplication(appName string, current, target Application) []Change {
+	var changes []Change
+	if current.Charm != target.Charm {
+		changes = append(changes, Change{
+			Kind:        CharmChange,
+			Application: appName,
+			Current:     current.Charm.String(),
+			Target:      target.Charm.String(),
+		})
+	}
+
+	changes = append(changes, diffConfig(ApplicationConfigChange, appName, current.Config, target.Config)...)
+
+	if current.Constraints != target.Constraints {
+		changes = append(changes, Change{
+			Kind:        ConstraintsChange,
+			Application: appName,
+			Current:     current.Constraints,
+			Target:      target.Constraints,
+		})
+	}
+
+	// Endpoints can't be unbound, so only the endpoints bound in the
+	// snapshot are compared. Endpoints added by a later charm revision
+	// keep their binding.
+	for _, endpoint := range slices.Sorted(maps.Keys(target.EndpointBindings)) {
+		space := target.EndpointBindings[endpoint]
+		if currentSpace := current.EndpointBindings[endpoint]; currentSpace != space {
+			changes = append(changes, Change{
+				Kind:        BindingChange,
+				Application: appName,
+				Key:         endpoint,
+				Current:     currentSpace,
+				Target:      space,
+			})
+		}
+	}
+
+	endpoints := make(map[string]struct{})
+	for endpoint := range current.ExposedEndpoints {
+		endpoints[endpoint] = struct{}{}
+	}
+	for endpoint := range target.ExposedEndpoints {
+		endpoints[endpoint] = struct{}{}
+	}
+	for _, endpoint := range slices.Sorted(maps.Keys(endpoints)) {
+		currentExposed, currentOK := current.ExposedEndpoints[endpoint]
+		targetExposed, targetOK := target.ExposedEndpoints[endpoint]
+		if currentOK == targetOK && currentExposed.equal(targetExposed) {
+			continue
+		}
+		change := Change{
+			Kind:        ExposeChange,
+			Application: appName,
+			Key:         endpoint,
+			Unset:       !targetOK,
+		}
+		if currentOK {
+			change.Current = currentExposed.String()
+		}
+		if targetOK {
+			change.Target = targetExposed.String()
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func diffConfig(kind ChangeKind, appName string, current, target map[string]string) []Change {
+	keys := make(map[string]struct{})
+	for key := range current {
+		keys[key] = struct{}{}
+	}
+	for key := range target {
+		keys[key] = struct{}{}
+	}
+
+	var changes []Change
+	for _, key := range slices.Sorted(maps.Keys(keys)) {
+		currentValue, currentOK := current[key]
+		targetValue, targetOK := target[key]
+		if currentOK == targetOK && currentValue == targetValue {
+			continue
+		}
+		changes = append(changes, Change{
+			Kind:        kind,
+			Application: appName,
+			Key:         key,
+			Current:     currentValue,
+			Target:      targetValue,
+			Unset:       !targetOK,
+		})
+	}
+	return changes
+}
+
+// String returns a description of the expose settings.
+func (e ExposedEndpoint) String() string {
+	var parts []string
+	if len(e.Spaces) > 0 {
+		parts = append(parts, fmt.Sprintf("spaces=%s", strings.Join(sortedCopy(e.Spaces), ",")))
+	}
+	if len(e.CIDRs) > 0 {
+		parts = append(parts, fmt.Sprintf("cidrs=%s", strings.Join(sortedCopy(e.CIDRs), ",")))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (e ExposedEndpoint) equal(o ExposedEndpoint) bool {
+	return slices.Equal(sortedCopy(e.Spaces), sortedCopy(o.Spaces)) &&
+		slices.Equal(sortedCopy(e.CIDRs), sortedCopy(o.CIDRs))
+}
+
+func sortedCopy(s []string) []string {
+	c := slices.Clone(s)
+	slices.Sort(c)
+	return c
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+)
+
+type diffSuite struct{}
+
+func TestDiffSuite(t *testing.T) {
+	tc.Run(t, &diffSuite{})
+}
+
+func (s *diffSuite) TestDiffNoChanges(c *tc.C) {
+	snapshot := Snapshot{
+		ModelConfig: map[string]string{"logging-config": "<root>=INFO"},
+		Applications: map[string]Application{
+			"mysql": {
+				Charm:            CharmRef{Name: "mysql", Source: "charmhub", Revision: 5},
+				Config:           map[string]string{"port": "3306"},
+				Constraints:      "mem=4G",
+				EndpointBindings: map[string]string{"": "alpha", "db": "alpha"},
+				ExposedEndpoints: map[string]ExposedEndpoint{
+					"db": {CIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+				},
+			},
+		},
+	}
+	reordered := snapshot
+	reordered.Applications = map[string]Application{
+		"mysql": {
+			Charm:            CharmRef{Name: "mysql", Source: "charmhub", Revision: 5},
+			Config:           map[string]string{"port": "3306"},
+			Constraints:      "mem=4G",
+			EndpointBindings: map[string]string{"": "alpha", "db": "alpha"},
+			ExposedEndpoints: map[string]ExposedEndpoint{
+				"db": {CIDRs: []string{"192.168.0.0/16", "10.0.0.0/8"}},
+			},
+		},
+	}
+
+	c.Check(Diff(snapshot, reordered), tc.HasLen, 0)
+}
+
+func (s *diffSuite) TestDiffModelConfig(c *tc.C) {
+	current := Snapshot{
+		ModelConfig: map[string]string{
+			"logging-config": "<root>=DEBUG",
+			"ftp-proxy":      "http://proxy",
+		},
+	}
+	target := Snapshot{
+		ModelConfig: map[string]string{
+			"logging-config": "<root>=INFO",
+			"http-proxy":     "http://proxy",
+		},
+	}
+
+	c.Check(Diff(current, target), tc.DeepEquals, []Change{{
+		Kind:    ModelConfigChange,
+		Key:     "ftp-proxy",
+		Current: "http://proxy",
+		Unset:   true,
+	}, {
+		Kind:   ModelConfigChange,
+		Key:    "http-proxy",
+		Target: "http://proxy",
+	}, {
+		Kind:    ModelConfigChange,
+		Key:     "logging-config",
+		Current: "<root>=DEBUG",
+		Target:  "<root>=INFO",
+	}})
+}
+
+func (s *diffSuite) TestDiffApplication(c *tc.C) {
+	current := Snapshot{
+		Applications: map[string]Application{
+			"mysql": {
+				Charm:            CharmRef{Name: "mysql", Source: "charmhub", Revision: 7},
+				Config:           map[string]string{"port": "3307", "trust": "true"},
+				Constraints:      "mem=8G",
+				EndpointBindings: map[string]string{"": "alpha", "db": "beta", "admin": "beta"},
+				ExposedEndpoints: map[string]ExposedEndpoint{
+					"":      {CIDRs: []string{"0.0.0.0/0"}},
+					"admin": {Spaces: []string{"beta"}},
+				},
+			},
+			"wordpress": {
+				Charm: CharmRef{Name: "wordpress", Source: "local", Revision: 1},
+			},
+		},
+	}
+	target := Snapshot{
+		Applications: map[string]Application{
+			"mysql": {
+				Charm:            CharmRef{Name: "mysql", Source: "charmhub", Revision: 5},
+				Config:           map[string]string{"port": "3306"},
+				Constraints:      "mem=4G",
+				EndpointBindings: map[string]string{"": "alpha", "db": "alpha"},
+				ExposedEndpoints: map[string]ExposedEndpoint{
+					"db": {Spaces: []string{"alpha"}, CIDRs: []string{"10.0.0.0/8"}},
+				},
+			},
+			"postgresql": {
+				Charm: CharmRef{Name: "postgresql", Source: "charmhub", Revision: 3},
+			},
+		},
+	}
+
+	c.Check(Diff(current, target), tc.DeepEquals, []Change{{
+		Kind:        CharmChange,
+		Application: "mysql",
+		Current:     "ch:mysql-7",
+		Target:      "ch:mysql-5",
+	}, {
+		Kind:        ApplicationConfigChange,
+		Application: "mysql",
+		Key:         "port",
+		Current:     "3307",
+		Target:      "3306",
+	}, {
+		Kind:        ApplicationConfigChange,
+		Application: "mysql",
+		Key:         "trust",
+		Current:     "true",
+		Unset:       true,
+	}, {
+		Kind:        ConstraintsChange,
+		Application: "mysql",
+		Current:     "mem=8G",
+		Target:      "mem=4G",
+	}, {
+		Kind:        BindingChange,
+		Application: "mysql",
+		Key:         "db",
+		Current:     "beta",
+		Target:      "alpha",
+	}, {
+		Kind:        ExposeChange,
+		Application: "mysql",
+		Key:         "",
+		Current:     "cidrs=0.0.0.0/0",
+		Unset:       true,
+	}, {
+		Kind:        ExposeChange,
+		Application: "mysql",
+		Key:         "admin",
+		Current:     "spaces=beta",
+		Unset:       true,
+	}, {
+		Kind:        ExposeChange,
+		Application: "mysql",
+		Key:         "db",
+		Target:      "spaces=alpha cidrs=10.0.0.0/8",
+	}, {
+		Kind:        MissingApplication,
+		Application: "postgresql",
+		Target:      "ch:postgresql-3",
+	}})
+}
+
+func (s *diffSuite) TestIsValidSnapshotName(c *tc.C) {
+	for _, name := range []string{"before-upgrade", "2026.10.17", "a", "v1_0"} {
+		c.Check(IsValidSnapshotName(name), tc.IsTrue, tc.Commentf("name %q", name))
+	}
+	for _, name := range []string{"", "-foo", "Foo", "foo bar", "foo/bar"} {
+		c.Check(IsValidSnapshotName(name), tc.IsFalse, tc.Commentf("name %q", name))
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package configsnapshot defines the domain model for named snapshots of
+// the configuration of a model.
+//
+// A snapshot records the configuration an operator controls for the model
+// and each of its applications at a point in time:
+//   - the model config set for the model,
+//   - the charm revision deployed for each application,
+//   - application config and trust,
+//   - application constraints,
+//   - endpoint bindings,
+//   - and expose settings.
+//
+// Rolling back to a snapshot compares it with the current configuration,
+// using [Diff], and applies the changes through the services owning each
+// part of the configuration, so that the usual validation applies. The
+// configsnapshot domain only stores snapshots; it is the client facade in
+// apiserver/facades/client/configsnapshot that captures and applies them.
+//
+// Snapshots are not carried across model migrations.
+package configsnapshot
@@ -0,0 +1,20 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// SnapshotNotFound describes an error that occurs when the config
+	// snapshot being operated on does not exist.
+	SnapshotNotFound = errors.ConstError("config snapshot not found")
+
+	// SnapshotAlreadyExists describes an error that occurs when a config
+	// snapshot with the same name already exists.
+	SnapshotAlreadyExists = errors.ConstError("config snapshot already exists")
+
+	// SnapshotNameNotValid describes an error that occurs when the name of
+	// a config snapshot is not valid.
+	SnapshotNameNotValid = errors.ConstError("config snapshot name not valid")
+)
@@ -0,0 +1,105 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/configsnapshot"
+	configsnapshoterrors "github.com/juju/juju/domain/configsnapshot/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// State describes retrieval and persistence methods for config snapshots.
+type State interface {
+	// CreateSnapshot stores the snapshot with the given UUID. If replace is
+	// true, any existing snapshot with the same name is replaced.
+	CreateSnapshot(ctx context.Context, uuid string, snapshot configsnapshot.Snapshot, replace bool) error
+
+	// GetSnapshot returns the snapshot with the given name.
+	GetSnapshot(ctx context.Context, name string) (configsnapshot.Snapshot, error)
+
+	// ListSnapshots returns the snapshots of the model, ordered by creation
+	// time.
+	ListSnapshots(ctx context.Context) ([]configsnapshot.SnapshotInfo, error)
+
+	// DeleteSnapshot removes the snapshot with the given name.
+	DeleteSnapshot(ctx context.Context, name string) error
+}
+
+// Service provides the API for working with config snapshots.
+type Service struct {
+	st    State
+	clock clock.Clock
+}
+
+// NewService returns a new service reference wrapping the given config
+// snapshot state.
+func NewService(st State, clock clock.Clock) *Service {
+	return &Service{
+		st:    st,
+		clock: clock,
+	}
+}
+
+// CreateSnapshot records the given configuration as a snapshot, taken now. If replace is true, any existing snapshot with the
+// same name is replaced.
+//
+// The following errors may be returned:
+// - [configsnapshoterrors.SnapshotNameNotValid] if the name is not valid.
+// - [configsnapshoterrors.SnapshotAlreadyExists] if a snapshot with the
+// same name exists and replace is false.
+func (s *Service) CreateSnapshot(ctx context.Context, snapshot configsnapshot.Snapshot, replace bool) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !configsnapshot.IsValidSnapshotName(snapshot.Name) {
+		return errors.Errorf("config snapshot name %q: %w", snapshot.Name, configsnapshoterrors.SnapshotNameNotValid)
+	}
+
+	snapshotUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	snapshot.CreatedAt = s.clock.Now().UTC()
+	return errors.Capture(s.st.CreateSnapshot(ctx, snapshotUUID.String(), snapshot, replace))
+}
+
+// GetSnapshot returns the snapshot with the given name.
+//
+// The following errors may be returned:
+// - [configsnapshoterrors.SnapshotNotFound] if the snapshot doesn't exist.
+func (s *Service) GetSnapshot(ctx context.Context, name string) (configsnapshot.Snapshot, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	snapshot, err := s.st.GetSnapshot(ctx, name)
+	return snapshot, errors.Capture(err)
+}
+
+// ListSnapshots returns the snapshots of the model, ordered by creation
+// time.
+func (s *Service) ListSnapshots(ctx context.Context) ([]configsnapshot.SnapshotInfo, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	infos, err := s.st.ListSnapshots(ctx)
+	return infos, errors.Capture(err)
+}
+
+// DeleteSnapshot removes the snapshot with the given name.
+//
+// The following errors may be returned:
+// - [configsnapshoterrors.SnapshotNotFound] if the snapshot doesn't exist.
+func (s *Service) DeleteSnapshot(ctx context.Context, name string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return errors.Capture(s.st.DeleteSnapshot(ctx, name))
+}
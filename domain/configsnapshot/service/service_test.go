@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	gomock "github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/configsnapshot"
+	configsnapshoterrors "github.com/juju/juju/domain/configsnapshot/errors"
+)
+
+type serviceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+}
+
+func TestServiceSuite(t *testing.T) {
+	tc.Run(t, &serviceSuite{})
+}
+
+func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceSuite) service() *Service {
+	return NewService(s.state, s.clock)
+}
+
+func (s *serviceSuite) TestCreateSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{Name: "before-upgrade"},
+		ModelConfig:  map[string]string{"logging-config": "<root>=INFO"},
+	}
+	expected := snapshot
+	expected.CreatedAt = s.clock.Now()
+
+	s.state.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any(), expected, true).Return(nil)
+
+	err := s.service().CreateSnapshot(c.Context(), snapshot, true)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCreateSnapshotNameNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{Name: "Not Valid"},
+	}
+	err := s.service().CreateSnapshot(c.Context(), snapshot, false)
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotNameNotValid)
+}
+
+func (s *serviceSuite) TestCreateSnapshotAlreadyExists(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().CreateSnapshot(gomock.Any(), gomock.Any(), gomock.Any(), false).
+		Return(configsnapshoterrors.SnapshotAlreadyExists)
+
+	snapshot := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{Name: "foo"},
+	}
+	err := s.service().CreateSnapshot(c.Context(), snapshot, false)
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotAlreadyExists)
+}
+
+func (s *serviceSuite) TestGetSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	snapshot := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{Name: "foo"},
+	}
+	s.state.EXPECT().GetSnapshot(gomock.Any(), "foo").Return(snapshot, nil)
+
+	got, err := s.service().GetSnapshot(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, snapshot)
+}
+
+func (s *serviceSuite) TestListSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	infos := []configsnapshot.SnapshotInfo{{Name: "foo"}, {Name: "bar"}}
+	s.state.EXPECT().ListSnapshots(gomock.Any()).Return(infos, nil)
+
+	got, err := s.service().ListSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, infos)
+}
+
+func (s *serviceSuite) TestDeleteSnapshot(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteSnapshot(gomock.Any(), "foo").Return(configsnapshoterrors.SnapshotNotFound)
+
+	err := s.service().DeleteSnapshot(c.Context(), "foo")
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotNotFound)
+}
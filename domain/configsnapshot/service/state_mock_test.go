@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/configsnapshot/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination state_mock_test.go github.com/juju/juju/domain/configsnapshot/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	configsnapshot "github.com/juju/juju/domain/configsnapshot"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+	isgomock struct{}
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock                  *MockState
+	createSnapshotExpects []*gomock.Call4_1[context.Context, string, configsnapshot.Snapshot, bool, error]
+	deleteSnapshotExpects []*gomock.Call2_1[context.Context, string, error]
+	getSnapshotExpects    []*gomock.Call2_2[context.Context, string, configsnapshot.Snapshot, error]
+	listSnapshotsExpects  []*gomock.Call1_2[context.Context, []configsnapshot.SnapshotInfo, error]
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockState) CreateSnapshot(ctx context.Context, uuid string, snapshot configsnapshot.Snapshot, replace bool) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.createSnapshotExpects, m.ctrl, m, "CreateSnapshot", ctx, uuid, snapshot, replace)
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockStateMockRecorder) CreateSnapshot(ctx, uuid, snapshot, replace any) *MockStateCreateSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, string, configsnapshot.Snapshot, bool, error](mr.mock.ctrl.T, mr.mock, "CreateSnapshot", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(uuid), gomock.EnsureMatcher(snapshot), gomock.EnsureMatcher(replace))
+	mr.createSnapshotExpects = append(mr.createSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateCreateSnapshotCall is the typed call wrapper for CreateSnapshot.
+type MockStateCreateSnapshotCall = gomock.Call4_1[context.Context, string, configsnapshot.Snapshot, bool, error]
+
+// DeleteSnapshot mocks base method.
+func (m *MockState) DeleteSnapshot(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.deleteSnapshotExpects, m.ctrl, m, "DeleteSnapshot", ctx, name)
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockStateMockRecorder) DeleteSnapshot(ctx, name any) *MockStateDeleteSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "DeleteSnapshot", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.deleteSnapshotExpects = append(mr.deleteSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateDeleteSnapshotCall is the typed call wrapper for DeleteSnapshot.
+type MockStateDeleteSnapshotCall = gomock.Call2_1[context.Context, string, error]
+
+// GetSnapshot mocks base method.
+func (m *MockState) GetSnapshot(ctx context.Context, name string) (configsnapshot.Snapshot, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getSnapshotExpects, m.ctrl, m, "GetSnapshot", ctx, name)
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockStateMockRecorder) GetSnapshot(ctx, name any) *MockStateGetSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, configsnapshot.Snapshot, error](mr.mock.ctrl.T, mr.mock, "GetSnapshot", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.getSnapshotExpects = append(mr.getSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSnapshotCall is the typed call wrapper for GetSnapshot.
+type MockStateGetSnapshotCall = gomock.Call2_2[context.Context, string, configsnapshot.Snapshot, error]
+
+// ListSnapshots mocks base method.
+func (m *MockState) ListSnapshots(ctx context.Context) ([]configsnapshot.SnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.listSnapshotsExpects, m.ctrl, m, "ListSnapshots", ctx)
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockStateMockRecorder) ListSnapshots(ctx any) *MockStateListSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []configsnapshot.SnapshotInfo, error](mr.mock.ctrl.T, mr.mock, "ListSnapshots", gomock.EnsureMatcher(ctx))
+	mr.listSnapshotsExpects = append(mr.listSnapshotsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateListSnapshotsCall is the typed call wrapper for ListSnapshots.
+type MockStateListSnapshotsCall = gomock.Call1_2[context.Context, []configsnapshot.SnapshotInfo, error]
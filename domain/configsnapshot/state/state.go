@@ -0,0 +1,350 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/configsnapshot"
+	configsnapshoterrors "github.com/juju/juju/domain/configsnapshot/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// childTables are the tables holding the contents of a snapshot, ordered
+// so that rows can be deleted without violating foreign keys.
+var childTables = []string{
+	"config_snapshot_application_exposed_cidr",
+	"config_snapshot_application_exposed_space",
+	"config_snapshot_application_exposed_endpoint",
+	"config_snapshot_application_binding",
+	"config_snapshot_application_config",
+	"config_snapshot_application",
+	"config_snapshot_model_config",
+}
+
+// State represents database interactions dealing with config snapshots.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new config snapshot state based on the input database
+// factory method.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// CreateSnapshot stores the snapshot with the given UUID. If replace is
+// true, any existing snapshot with the same name is replaced.
+//
+// The following errors may be returned:
+// - [configsnapshoterrors.SnapshotAlreadyExists] if a snapshot with the
+// same name exists and replace is false.
+func (st *State) CreateSnapshot(ctx context.Context, uuid string, s configsnapshot.Snapshot, replace bool) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertSnapshotStmt, err := st.Prepare(`
+INSERT INTO config_snapshot (*) VALUES ($snapshot.*);`, snapshot{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertModelConfigStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_model_config (*) VALUES ($modelConfig.*);`, modelConfig{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertApplicationStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_application (*) VALUES ($application.*);`, application{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertApplicationConfigStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_application_config (*) VALUES ($applicationConfig.*);`, applicationConfig{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertBindingStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_application_binding (*) VALUES ($applicationBinding.*);`, applicationBinding{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertExposedEndpointStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_application_exposed_endpoint (*) VALUES ($exposedEndpoint.*);`, exposedEndpoint{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertExposedSpaceStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_application_exposed_space (*) VALUES ($exposedSpace.*);`, exposedSpace{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertExposedCIDRStmt, err := st.Prepare(`
+INSERT INTO config_snapshot_application_exposed_cidr (*) VALUES ($exposedCIDR.*);`, exposedCIDR{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rows := encodeSnapshot(uuid, s)
+	// Bulk inserts can't be run with no rows, so empty tables are skipped.
+	inserts := []struct {
+		stmt *sqlair.Statement
+		rows any
+		n    int
+	}{
+		{insertModelConfigStmt, rows.modelConfig, len(rows.modelConfig)},
+		{insertApplicationStmt, rows.applications, len(rows.applications)},
+		{insertApplicationConfigStmt, rows.applicationConfig, len(rows.applicationConfig)},
+		{insertBindingStmt, rows.bindings, len(rows.bindings)},
+		{insertExposedEndpointStmt, rows.exposedEndpoints, len(rows.exposedEndpoints)},
+		{insertExposedSpaceStmt, rows.exposedSpaces, len(rows.exposedSpaces)},
+		{insertExposedCIDRStmt, rows.exposedCIDRs, len(rows.exposedCIDRs)},
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		existing, err := st.getSnapshotUUID(ctx, tx, s.Name)
+		if errors.Is(err, configsnapshoterrors.SnapshotNotFound) {
+			// Nothing to replace.
+		} else if err != nil {
+			return errors.Capture(err)
+		} else if !replace {
+			return errors.Errorf("config snapshot %q: %w", s.Name, configsnapshoterrors.SnapshotAlreadyExists)
+		} else if err := st.deleteSnapshot(ctx, tx, existing); err != nil {
+			return errors.Errorf("replacing config snapshot %q: %w", s.Name, err)
+		}
+
+		if err := tx.Query(ctx, insertSnapshotStmt, snapshot{
+			UUID:      uuid,
+			Name:      s.Name,
+			CreatedAt: s.CreatedAt,
+		}).Run(); err != nil {
+			return errors.Errorf("inserting config snapshot %q: %w", s.Name, err)
+		}
+		for _, insert := range inserts {
+			if insert.n == 0 {
+				continue
+			}
+			if err := tx.Query(ctx, insert.stmt, insert.rows).Run(); err != nil {
+				return errors.Errorf("inserting config snapshot %q: %w", s.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetSnapshot returns the snapshot with the given name.
+//
+// The following errors may be returned:
+// - [configsnapshoterrors.SnapshotNotFound] if the snapshot doesn't exist.
+func (st *State) GetSnapshot(ctx context.Context, name string) (configsnapshot.Snapshot, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+
+	snapshotStmt, err := st.Prepare(`
+SELECT &snapshot.*
+FROM   config_snapshot
+WHERE  name = $snapshotName.name;`, snapshot{}, snapshotName{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	modelConfigStmt, err := st.Prepare(`
+SELECT &modelConfig.*
+FROM   config_snapshot_model_config
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, modelConfig{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	applicationStmt, err := st.Prepare(`
+SELECT &application.*
+FROM   config_snapshot_application
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, application{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	applicationConfigStmt, err := st.Prepare(`
+SELECT &applicationConfig.*
+FROM   config_snapshot_application_config
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, applicationConfig{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	bindingStmt, err := st.Prepare(`
+SELECT &applicationBinding.*
+FROM   config_snapshot_application_binding
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, applicationBinding{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	exposedEndpointStmt, err := st.Prepare(`
+SELECT &exposedEndpoint.*
+FROM   config_snapshot_application_exposed_endpoint
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, exposedEndpoint{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	exposedSpaceStmt, err := st.Prepare(`
+SELECT &exposedSpace.*
+FROM   config_snapshot_application_exposed_space
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, exposedSpace{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+	exposedCIDRStmt, err := st.Prepare(`
+SELECT &exposedCIDR.*
+FROM   config_snapshot_application_exposed_cidr
+WHERE  snapshot_uuid = $snapshotUUID.snapshot_uuid;`, exposedCIDR{}, snapshotUUID{})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+
+	var (
+		snap  snapshot
+		rows  snapshotRows
+		query = func(ctx context.Context, tx *sqlair.TX, stmt *sqlair.Statement, uuid snapshotUUID, dest any) error {
+			err := tx.Query(ctx, stmt, uuid).GetAll(dest)
+			if errors.Is(err, sqlair.ErrNoRows) {
+				return nil
+			}
+			return errors.Capture(err)
+		}
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, snapshotStmt, snapshotName{Name: name}).Get(&snap)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("config snapshot %q: %w", name, configsnapshoterrors.SnapshotNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		uuid := snapshotUUID{UUID: snap.UUID}
+		for _, q := range []struct {
+			stmt *sqlair.Statement
+			dest any
+		}{
+			{modelConfigStmt, &rows.modelConfig},
+			{applicationStmt, &rows.applications},
+			{applicationConfigStmt, &rows.applicationConfig},
+			{bindingStmt, &rows.bindings},
+			{exposedEndpointStmt, &rows.exposedEndpoints},
+			{exposedSpaceStmt, &rows.exposedSpaces},
+			{exposedCIDRStmt, &rows.exposedCIDRs},
+		} {
+			if err := query(ctx, tx, q.stmt, uuid, q.dest); err != nil {
+				return errors.Errorf("reading config snapshot %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return configsnapshot.Snapshot{}, errors.Capture(err)
+	}
+
+	return decodeSnapshot(snap, rows), nil
+}
+
+// ListSnapshots returns the snapshots of the model, ordered by creation
+// time.
+func (st *State) ListSnapshots(ctx context.Context) ([]configsnapshot.SnapshotInfo, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT   &snapshot.*
+FROM     config_snapshot
+ORDER BY created_at, name;`, snapshot{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var snapshots []snapshot
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&snapshots)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]configsnapshot.SnapshotInfo, len(snapshots))
+	for i, s := range snapshots {
+		result[i] = configsnapshot.SnapshotInfo{
+			Name:      s.Name,
+			CreatedAt: s.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// DeleteSnapshot removes the snapshot with the given name.
+//
+// The following errors may be returned:
+// - [configsnapshoterrors.SnapshotNotFound] if the snapshot doesn't exist.
+func (st *State) DeleteSnapshot(ctx context.Context, name string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		uuid, err := st.getSnapshotUUID(ctx, tx, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return st.deleteSnapshot(ctx, tx, uuid)
+	})
+}
+
+func (st *State) getSnapshotUUID(ctx context.Context, tx *sqlair.TX, name string) (string, error) {
+	stmt, err := st.Prepare(`
+SELECT &snapshot.uuid
+FROM   config_snapshot
+WHERE  name = $snapshotName.name;`, snapshot{}, snapshotName{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var snap snapshot
+	err = tx.Query(ctx, stmt, snapshotName{Name: name}).Get(&snap)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Errorf("config snapshot %q: %w", name, configsnapshoterrors.SnapshotNotFound)
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	return snap.UUID, nil
+}
+
+func (st *State) deleteSnapshot(ctx context.Context, tx *sqlair.TX, uuid string) error {
+	for _, table := range childTables {
+		stmt, err := st.Prepare(`DELETE FROM `+table+` WHERE snapshot_uuid = $snapshotUUID.snapshot_uuid;`, snapshotUUID{})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, snapshotUUID{UUID: uuid}).Run(); err != nil {
+			return errors.Errorf("deleting from %s: %w", table, err)
+		}
+	}
+
+	stmt, err := st.Prepare(`DELETE FROM config_snapshot WHERE uuid = $snapshotUUID.snapshot_uuid;`, snapshotUUID{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, snapshotUUID{UUID: uuid}).Run(); err != nil {
+		return errors.Errorf("deleting config snapshot: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/configsnapshot"
+	configsnapshoterrors "github.com/juju/juju/domain/configsnapshot/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestStateSuite(t *testing.T) {
+	tc.Run(t, &stateSuite{})
+}
+
+func (s *stateSuite) snapshot(name string, createdAt time.Time) configsnapshot.Snapshot {
+	return configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{
+			Name:      name,
+			CreatedAt: createdAt,
+		},
+		ModelConfig: map[string]string{
+			"logging-config": "<root>=INFO",
+		},
+		Applications: map[string]configsnapshot.Application{
+			"mysql": {
+				Charm:            configsnapshot.CharmRef{Name: "mysql", Source: "charmhub", Revision: 5},
+				Config:           map[string]string{"port": "3306", "trust": "true"},
+				Constraints:      "mem=4G",
+				EndpointBindings: map[string]string{"": "alpha", "db": "beta"},
+				ExposedEndpoints: map[string]configsnapshot.ExposedEndpoint{
+					"":   {CIDRs: []string{"10.0.0.0/8"}},
+					"db": {Spaces: []string{"alpha"}, CIDRs: []string{"192.168.0.0/16"}},
+				},
+			},
+			"wordpress": {
+				Charm:            configsnapshot.CharmRef{Name: "wordpress", Source: "local", Revision: 2},
+				Config:           map[string]string{},
+				EndpointBindings: map[string]string{},
+				ExposedEndpoints: map[string]configsnapshot.ExposedEndpoint{},
+			},
+		},
+	}
+}
+
+func (s *stateSuite) TestCreateAndGetSnapshot(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	now := time.Now().UTC().Truncate(time.Second)
+	snapshot := s.snapshot("before-upgrade", now)
+
+	err := st.CreateSnapshot(c.Context(), "uuid-1", snapshot, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetSnapshot(c.Context(), "before-upgrade")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.CreatedAt.Equal(now), tc.IsTrue)
+	got.CreatedAt = snapshot.CreatedAt
+	c.Check(got, tc.DeepEquals, snapshot)
+}
+
+func (s *stateSuite) TestCreateSnapshotEmpty(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	snapshot := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{
+			Name:      "empty",
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+
+	err := st.CreateSnapshot(c.Context(), "uuid-1", snapshot, false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetSnapshot(c.Context(), "empty")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.ModelConfig, tc.HasLen, 0)
+	c.Check(got.Applications, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestCreateSnapshotAlreadyExists(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	now := time.Now().UTC()
+
+	err := st.CreateSnapshot(c.Context(), "uuid-1", s.snapshot("foo", now), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.CreateSnapshot(c.Context(), "uuid-2", s.snapshot("foo", now), false)
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotAlreadyExists)
+}
+
+func (s *stateSuite) TestCreateSnapshotReplace(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	now := time.Now().UTC()
+
+	err := st.CreateSnapshot(c.Context(), "uuid-1", s.snapshot("foo", now), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	replacement := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{
+			Name:      "foo",
+			CreatedAt: now.Add(time.Hour),
+		},
+		ModelConfig: map[string]string{"ftp-proxy": "http://proxy"},
+	}
+	err = st.CreateSnapshot(c.Context(), "uuid-2", replacement, true)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := st.GetSnapshot(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.ModelConfig, tc.DeepEquals, replacement.ModelConfig)
+	c.Check(got.Applications, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetSnapshotNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetSnapshot(c.Context(), "foo")
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotNotFound)
+}
+
+func (s *stateSuite) TestListSnapshots(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	now := time.Now().UTC().Truncate(time.Second)
+
+	err := st.CreateSnapshot(c.Context(), "uuid-1", s.snapshot("second", now.Add(time.Minute)), false)
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.CreateSnapshot(c.Context(), "uuid-2", s.snapshot("first", now), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	infos, err := st.ListSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(infos, tc.HasLen, 2)
+	c.Check(infos[0].Name, tc.Equals, "first")
+	c.Check(infos[0].CreatedAt.Equal(now), tc.IsTrue)
+	c.Check(infos[1].Name, tc.Equals, "second")
+}
+
+func (s *stateSuite) TestListSnapshotsEmpty(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	infos, err := st.ListSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(infos, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestDeleteSnapshot(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.CreateSnapshot(c.Context(), "uuid-1", s.snapshot("foo", time.Now().UTC()), false)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.DeleteSnapshot(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.GetSnapshot(c.Context(), "foo")
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotNotFound)
+
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM config_snapshot_application_exposed_cidr")
+	c.Assert(row.Scan(&count), tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (s *stateSuite) TestDeleteSnapshotNotFound(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.DeleteSnapshot(c.Context(), "foo")
+	c.Check(err, tc.ErrorIs, configsnapshoterrors.SnapshotNotFound)
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/juju/domain/configsnapshot"
+)
+
+// snapshot represents a row from the config_snapshot table.
+type snapshot struct {
+	UUID      string    `db:"uuid"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// snapshotName holds the name of a snapshot.
+type snapshotName struct {
+	Name string `db:"name"`
+}
+
+// snapshotUUID holds the UUID of a snapshot.
+type snapshotUUID struct {
+	UUID string `db:"snapshot_uuid"`
+}
+
+// modelConfig represents a row from the config_snapshot_model_config
+// table.
+type modelConfig struct {
+	SnapshotUUID string `db:"snapshot_uuid"`
+	Key          string `db:"key"`
+	Value        string `db:"value"`
+}
+
+// application represents a row from the config_snapshot_application table.
+type application struct {
+	SnapshotUUID    string `db:"snapshot_uuid"`
+	ApplicationName string `db:"application_name"`
+	CharmName       string `db:"charm_name"`
+	CharmSource     string `db:"charm_source"`
+	CharmRevision   int    `db:"charm_revision"`
+	Constraints     string `db:"constraints"`
+}
+
+// applicationConfig represents a row from the
+// config_snapshot_application_config table.
+type applicationConfig struct {
+	SnapshotUUID    string `db:"snapshot_uuid"`
+	ApplicationName string `db:"application_name"`
+	Key             string `db:"key"`
+	Value           string `db:"value"`
+}
+
+// applicationBinding represents a row from the
+// config_snapshot_application_binding table.
+type applicationBinding struct {
+	SnapshotUUID    string `db:"snapshot_uuid"`
+	ApplicationName string `db:"application_name"`
+	Endpoint        string `db:"endpoint"`
+	SpaceName       string `db:"space_name"`
+}
+
+// exposedEndpoint represents a row from the
+// config_snapshot_application_exposed_endpoint table.
+type exposedEndpoint struct {
+	SnapshotUUID    string `db:"snapshot_uuid"`
+	ApplicationName string `db:"application_name"`
+	Endpoint        string `db:"endpoint"`
+}
+
+// exposedSpace represents a row from the
+// config_snapshot_application_exposed_space table.
+type exposedSpace struct {
+	SnapshotUUID    string `db:"snapshot_uuid"`
+	ApplicationName string `db:"application_name"`
+	Endpoint        string `db:"endpoint"`
+	SpaceName       string `db:"space_name"`
+}
+
+// exposedCIDR represents a row from the
+// config_snapshot_application_exposed_cidr table.
+type exposedCIDR struct {
+	SnapshotUUID    string `db:"snapshot_uuid"`
+	ApplicationName string `db:"application_name"`
+	Endpoint        string `db:"endpoint"`
+	CIDR            string `db:"cidr"`
+}
+
+// snapshotRows holds the rows recording the contents of a snapshot.
+type snapshotRows struct {
+	modelConfig       []modelConfig
+	applications      []application
+	applicationConfig []applicationConfig
+	bindings          []applicationBinding
+	exposedEndpoints  []exposedEndpoint
+	exposedSpaces     []exposedSpace
+	exposedCIDRs      []exposedCIDR
+}
+
+func encodeSnapshot(uuid string, s configsnapshot.Snapshot) snapshotRows {
+	var rows snapshotRows
+	for key, value := range s.ModelConfig {
+		rows.modelConfig = append(rows.modelConfig, modelConfig{
+			SnapshotUUID: uuid,
+			Key:          key,
+			Value:        value,
+		})
+	}
+	for appName, app := range s.Applications {
+		rows.applications = append(rows.applications, application{
+			SnapshotUUID:    uuid,
+			ApplicationName: appName,
+			CharmName:       app.Charm.Name,
+			CharmSource:     app.Charm.Source,
+			CharmRevision:   app.Charm.Revision,
+			Constraints:     app.Constraints,
+		})
+		for key, value := range app.Config {
+			rows.applicationConfig = append(rows.applicationConfig, applicationConfig{
+				SnapshotUUID:    uuid,
+				ApplicationName: appName,
+				Key:             key,
+				Value:           value,
+			})
+		}
+		for endpoint, space := range app.EndpointBindings {
+			rows.bindings = append(rows.bindings, applicationBinding{
+				SnapshotUUID:    uuid,
+				ApplicationName: appName,
+				Endpoint:        endpoint,
+				SpaceName:       space,
+			})
+		}
+		for endpoint, exposed := range app.ExposedEndpoints {
+			rows.exposedEndpoints = append(rows.exposedEndpoints, exposedEndpoint{
+				SnapshotUUID:    uuid,
+				ApplicationName: appName,
+				Endpoint:        endpoint,
+			})
+			for _, space := range exposed.Spaces {
+				rows.exposedSpaces = append(rows.exposedSpaces, exposedSpace{
+					SnapshotUUID:    uuid,
+					ApplicationName: appName,
+					Endpoint:        endpoint,
+					SpaceName:       space,
+				})
+			}
+			for _, cidr := range exposed.CIDRs {
+				rows.exposedCIDRs = append(rows.exposedCIDRs, exposedCIDR{
+					SnapshotUUID:    uuid,
+					ApplicationName: appName,
+					Endpoint:        endpoint,
+					CIDR:            cidr,
+				})
+			}
+		}
+	}
+	return rows
+}
+
+func decodeSnapshot(snap snapshot, rows snapshotRows) configsnapshot.Snapshot {
+	result := configsnapshot.Snapshot{
+		SnapshotInfo: configsnapshot.SnapshotInfo{
+			Name:      snap.Name,
+			CreatedAt: snap.CreatedAt,
+		},
+		ModelConfig:  make(map[string]string, len(rows.modelConfig)),
+		Applications: make(map[string]configsnapshot.Application, len(rows.applications)),
+	}
+	for _, row := range rows.modelConfig {
+		result.ModelConfig[row.Key] = row.Value
+	}
+	for _, row := range rows.applications {
+		result.Applications[row.ApplicationName] = configsnapshot.Application{
+			Charm: configsnapshot.CharmRef{
+				Name:     row.CharmName,
+				Source:   row.CharmSource,
+				Revision: row.CharmRevision,
+			},
+			Constraints:      row.Constraints,
+			Config:           make(map[string]string),
+			EndpointBindings: make(map[string]string),
+			ExposedEndpoints: make(map[string]configsnapshot.ExposedEndpoint),
+		}
+	}
+	for _, row := range rows.applicationConfig {
+		result.Applications[row.ApplicationName].Config[row.Key] = row.Value
+	}
+	for _, row := range rows.bindings {
+		result.Applications[row.ApplicationName].EndpointBindings[row.Endpoint] = row.SpaceName
+	}
+	for _, row := range rows.exposedEndpoints {
+		result.Applications[row.ApplicationName].ExposedEndpoints[row.Endpoint] = configsnapshot.ExposedEndpoint{}
+	}
+	for _, row := range rows.exposedSpaces {
+		exposed := result.Applications[row.ApplicationName].ExposedEndpoints[row.Endpoint]
+		exposed.Spaces = append(exposed.Spaces, row.SpaceName)
+		result.Applications[row.ApplicationName].ExposedEndpoints[row.Endpoint] = exposed
+	}
+	for _, row := range rows.exposedCIDRs {
+		exposed := result.Applications[row.ApplicationName].ExposedEndpoints[row.Endpoint]
+		exposed.CIDRs = append(exposed.CIDRs, row.CIDR)
+		result.Applications[row.ApplicationName].ExposedEndpoints[row.Endpoint] = exposed
+	}
+	return result
+}
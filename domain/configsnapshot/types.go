@@ -0,0 +1,86 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package configsnapshot
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// validSnapshotName matches the names config snapshots can be given.
+var validSnapshotName = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// IsValidSnapshotName reports whether the name is a valid config snapshot
+// name. Names start with a lower case letter or a digit, followed by lower
+// case letters, digits, dots, underscores and hyphens.
+func IsValidSnapshotName(name string) bool {
+	return validSnapshotName.MatchString(name)
+}
+
+// SnapshotInfo describes a config snapshot.
+type SnapshotInfo struct {
+	// Name is the unique name of the snapshot within the model.
+	Name string
+	// CreatedAt is the time the snapshot was taken.
+	CreatedAt time.Time
+}
+
+// Snapshot is the configuration of a model at a point in time.
+type Snapshot struct {
+	SnapshotInfo
+
+	// ModelConfig holds the model config attributes set for the model,
+	// rather than inherited from defaults.
+	ModelConfig map[string]string
+	// Applications holds the configuration of each application, indexed by
+	// application name.
+	Applications map[string]Application
+}
+
+// Application is the configuration of an application at a point in time.
+type Application struct {
+	// Charm is the charm the application was running.
+	Charm CharmRef
+	// Config holds the application config set by the operator, including
+	// the trust setting.
+	Config map[string]string
+	// Constraints are the application constraints, in the form accepted by
+	// constraints.Parse.
+	Constraints string
+	// EndpointBindings maps endpoint names to the name of the space they
+	// are bound to. The empty endpoint name holds the default space of the
+	// application.
+	EndpointBindings map[string]string
+	// ExposedEndpoints holds the expose settings of the exposed endpoints.
+	// The empty endpoint name holds the settings for all the endpoints.
+	ExposedEndpoints map[string]ExposedEndpoint
+}
+
+// CharmRef identifies a charm revision.
+type CharmRef struct {
+	// Name is the name of the charm.
+	Name string
+	// Source is the charm source, charmhub or local.
+	Source string
+	// Revision is the charm revision.
+	Revision int
+}
+
+// String returns the charm reference in the form of a charm URL.
+func (c CharmRef) String() string {
+	schema := c.Source
+	if schema == "charmhub" {
+		schema = "ch"
+	}
+	return fmt.Sprintf("%s:%s-%d", schema, c.Name, c.Revision)
+}
+
+// ExposedEndpoint holds the expose settings of an endpoint.
+type ExposedEndpoint struct {
+	// Spaces holds the names of the spaces the endpoint is exposed to.
+	Spaces []string
+	// CIDRs holds the CIDRs the endpoint is exposed to.
+	CIDRs []string
+}
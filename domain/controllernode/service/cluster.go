@@ -0,0 +1,70 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/controllernode"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ClusterService provides the API for reporting the membership of the
+// controller nodes in the Dqlite cluster.
+type ClusterService struct {
+	st               State
+	clusterDescriber database.ClusterDescriber
+	logger           logger.Logger
+}
+
+// NewClusterService returns a new service reference wrapping the input state
+// and the describer of the Dqlite cluster.
+func NewClusterService(st State, clusterDescriber database.ClusterDescriber, logger logger.Logger) *ClusterService {
+	return &ClusterService{
+		st:               st,
+		clusterDescriber: clusterDescriber,
+		logger:           logger,
+	}
+}
+
+// GetControllerNodes returns the controller nodes ordered by controller ID,
+// along with the role of each of them in the Dqlite cluster. Nodes that are
+// not members of the cluster, either because they are still joining or
+// because they have been removed from it, are returned without a role.
+//
+// The following errors may be returned:
+//   - [controllernodeerrors.EmptyControllerIDs] if there are no controller
+//     nodes.
+func (s *ClusterService) GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	nodes, err := s.st.GetControllerNodes(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// The cluster details come directly from Dqlite, so they reflect the
+	// current roles, which are assigned by Dqlite itself and are not recorded
+	// in the database.
+	members, err := s.clusterDescriber.ClusterDetails(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting cluster details: %w", err)
+	}
+	roles := make(map[uint64]database.NodeRole, len(members))
+	for _, member := range members {
+		roles[member.ID] = member.Role
+	}
+
+	for i, node := range nodes {
+		if node.DqliteNodeID == 0 {
+			continue
+		}
+		nodes[i].Role = roles[node.DqliteNodeID]
+	}
+	return nodes, nil
+}
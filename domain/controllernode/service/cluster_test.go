@@ -0,0 +1,95 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain/controllernode"
+	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type clusterServiceSuite struct {
+	testhelpers.IsolationSuite
+
+	state            *MockState
+	clusterDescriber *MockClusterDescriber
+}
+
+func TestClusterServiceSuite(t *testing.T) {
+	tc.Run(t, &clusterServiceSuite{})
+}
+
+func (s *clusterServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.clusterDescriber = NewMockClusterDescriber(ctrl)
+
+	c.Cleanup(func() {
+		s.state = nil
+		s.clusterDescriber = nil
+	})
+
+	return ctrl
+}
+
+func (s *clusterServiceSuite) service(c *tc.C) *ClusterService {
+	return NewClusterService(s.state, s.clusterDescriber, loggertesting.WrapCheckLog(c))
+}
+
+func (s *clusterServiceSuite) TestGetControllerNodes(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetControllerNodes(gomock.Any()).Return([]controllernode.ControllerNode{
+		{ControllerID: "0", DqliteNodeID: 1, DqliteBindAddress: "10.0.0.1"},
+		{ControllerID: "1", DqliteNodeID: 2, DqliteBindAddress: "10.0.0.2"},
+		{ControllerID: "2", DqliteNodeID: 3, DqliteBindAddress: "10.0.0.3"},
+		{ControllerID: "3"},
+	}, nil)
+	s.clusterDescriber.EXPECT().ClusterDetails(gomock.Any()).Return([]database.ClusterNodeInfo{
+		{ID: 1, Role: database.Voter},
+		{ID: 2, Role: database.Standby},
+		{ID: 4, Role: database.Spare},
+	}, nil)
+
+	nodes, err := s.service(c).GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(nodes, tc.DeepEquals, []controllernode.ControllerNode{
+		{ControllerID: "0", DqliteNodeID: 1, DqliteBindAddress: "10.0.0.1", Role: database.Voter},
+		{ControllerID: "1", DqliteNodeID: 2, DqliteBindAddress: "10.0.0.2", Role: database.Standby},
+		// Node 3 has been removed from the cluster.
+		{ControllerID: "2", DqliteNodeID: 3, DqliteBindAddress: "10.0.0.3"},
+		// Controller 3 has not yet joined the cluster.
+		{ControllerID: "3"},
+	})
+}
+
+func (s *clusterServiceSuite) TestGetControllerNodesEmpty(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetControllerNodes(gomock.Any()).Return(nil, controllernodeerrors.EmptyControllerIDs)
+
+	_, err := s.service(c).GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIs, controllernodeerrors.EmptyControllerIDs)
+}
+
+func (s *clusterServiceSuite) TestGetControllerNodesClusterError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetControllerNodes(gomock.Any()).Return([]controllernode.ControllerNode{
+		{ControllerID: "0", DqliteNodeID: 1, DqliteBindAddress: "10.0.0.1"},
+	}, nil)
+	s.clusterDescriber.EXPECT().ClusterDetails(gomock.Any()).Return(nil, internalerrors.New("boom"))
+
+	_, err := s.service(c).GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorMatches, "getting cluster details: boom")
+}
@@ -0,0 +1,60 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/core/database (interfaces: ClusterDescriber)
+//
+// Generated by this command:
+//
+//	mockgen -package service -destination database_mock_test.go github.com/juju/juju/core/database ClusterDescriber
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	database "github.com/juju/juju/core/database"
+)
+
+// MockClusterDescriber is a mock of ClusterDescriber interface.
+type MockClusterDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterDescriberMockRecorder
+	isgomock struct{}
+}
+
+// MockClusterDescriberMockRecorder is the mock recorder for MockClusterDescriber.
+type MockClusterDescriberMockRecorder struct {
+	mock                  *MockClusterDescriber
+	clusterDetailsExpects []*gomock.Call1_2[context.Context, []database.ClusterNodeInfo, error]
+}
+
+// NewMockClusterDescriber creates a new mock instance.
+func NewMockClusterDescriber(ctrl *gomock.Controller) *MockClusterDescriber {
+	mock := &MockClusterDescriber{ctrl: ctrl}
+	mock.recorder = &MockClusterDescriberMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterDescriber) EXPECT() *MockClusterDescriberMockRecorder {
+	return m.recorder
+}
+
+// ClusterDetails mocks base method.
+func (m *MockClusterDescriber) ClusterDetails(arg0 context.Context) ([]database.ClusterNodeInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.clusterDetailsExpects, m.ctrl, m, "ClusterDetails", arg0)
+}
+
+// ClusterDetails indicates an expected call of ClusterDetails.
+func (mr *MockClusterDescriberMockRecorder) ClusterDetails(arg0 any) *MockClusterDescriberClusterDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []database.ClusterNodeInfo, error](mr.mock.ctrl.T, mr.mock, "ClusterDetails", gomock.EnsureMatcher(arg0))
+	mr.clusterDetailsExpects = append(mr.clusterDetailsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockClusterDescriberClusterDetailsCall is the typed call wrapper for ClusterDetails.
+type MockClusterDescriberClusterDetailsCall = gomock.Call1_2[context.Context, []database.ClusterNodeInfo, error]
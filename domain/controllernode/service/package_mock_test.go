@@ -35,6 +35,7 @@ type MockStateMockRecorder struct {
 	getAPIAddressesForClientsExpects               []*gomock.Call1_2[context.Context, map[string]controllernode.APIAddresses, error]
 	getAllCloudLocalAPIAddressesExpects            []*gomock.Call1_2[context.Context, []string, error]
 	getControllerIDsExpects                        []*gomock.Call1_2[context.Context, []string, error]
+	getControllerNodesExpects                      []*gomock.Call1_2[context.Context, []controllernode.ControllerNode, error]
 	namespaceForWatchControllerAPIAddressesExpects []*gomock.Call0_1[string]
 	namespaceForWatchControllerNodesExpects        []*gomock.Call0_1[string]
 	selectDatabaseNamespaceExpects                 []*gomock.Call2_2[context.Context, string, string, error]
@@ -162,6 +163,24 @@ func (mr *MockStateMockRecorder) GetControllerIDs(ctx any) *MockStateGetControll
 // MockStateGetControllerIDsCall is the typed call wrapper for GetControllerIDs.
 type MockStateGetControllerIDsCall = gomock.Call1_2[context.Context, []string, error]
 
+// GetControllerNodes mocks base method.
+func (m *MockState) GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerNodesExpects, m.ctrl, m, "GetControllerNodes", ctx)
+}
+
+// GetControllerNodes indicates an expected call of GetControllerNodes.
+func (mr *MockStateMockRecorder) GetControllerNodes(ctx any) *MockStateGetControllerNodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []controllernode.ControllerNode, error](mr.mock.ctrl.T, mr.mock, "GetControllerNodes", gomock.EnsureMatcher(ctx))
+	mr.getControllerNodesExpects = append(mr.getControllerNodesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetControllerNodesCall is the typed call wrapper for GetControllerNodes.
+type MockStateGetControllerNodesCall = gomock.Call1_2[context.Context, []controllernode.ControllerNode, error]
+
 // NamespaceForWatchControllerAPIAddresses mocks base method.
 func (m *MockState) NamespaceForWatchControllerAPIAddresses() string {
 	m.ctrl.T.Helper()
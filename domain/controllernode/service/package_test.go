@@ -4,3 +4,4 @@
 package service
 
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/controllernode/service State,WatcherFactory
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination database_mock_test.go github.com/juju/juju/core/database ClusterDescriber
@@ -65,6 +65,10 @@ type State interface {
 	// node records.
 	GetControllerIDs(ctx context.Context) ([]string, error)
 
+	// GetControllerNodes returns the controller nodes along with the details
+	// of the Dqlite node running on each of them, ordered by controller ID.
+	GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error)
+
 	// GetAPIAddressesForAgents returns all APIAddresses available
 	// for agents, divided by controller node.
 	GetAPIAddressesForAgents(ctx context.Context) (map[string]controllernode.APIAddresses, error)
@@ -487,6 +487,56 @@ FROM controller_node
 	return res, nil
 }
 
+// GetControllerNodes returns the controller nodes along with the details of
+// the Dqlite node running on each of them, ordered by controller ID. If there
+// are no controller nodes, an error satisfying
+// [controllernodeerrors.EmptyControllerIDs] is returned.
+func (st *State) GetControllerNodes(ctx context.Context) ([]controllernode.ControllerNode, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT   &dbControllerNodeMember.*
+FROM     controller_node
+ORDER BY controller_id
+`, dbControllerNodeMember{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var nodes []dbControllerNodeMember
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&nodes)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return controllernodeerrors.EmptyControllerIDs
+		} else if err != nil {
+			return errors.Errorf("getting controller nodes: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	res := make([]controllernode.ControllerNode, len(nodes))
+	for i, n := range nodes {
+		res[i] = controllernode.ControllerNode{
+			ControllerID:      n.ControllerID,
+			DqliteBindAddress: n.DqliteBindAddress.V,
+		}
+		if !n.DqliteNodeID.Valid {
+			continue
+		}
+		// See AddDqliteNode for why the node ID is stored as text.
+		res[i].DqliteNodeID, err = strconv.ParseUint(n.DqliteNodeID.V, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("parsing Dqlite node ID for controller %q: %w", n.ControllerID, err)
+		}
+	}
+	return res, nil
+}
+
 func (st *State) getAllAPIAddressesForClients(ctx context.Context, tx *sqlair.TX) ([]controllerAPIAddress, error) {
 	stmt, err := st.Prepare(`
 SELECT &controllerAPIAddress.* 
@@ -611,6 +611,28 @@ func (s *stateSuite) TestGetControllerIDsEmpty(c *tc.C) {
 	c.Check(controllerIDs, tc.HasLen, 0)
 }
 
+func (s *stateSuite) TestGetControllerNodes(c *tc.C) {
+	nodeID := uint64(15237855465837235027)
+	err := s.state.AddDqliteNode(c.Context(), "1", nodeID, "10.0.0.1")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Controller 0 has not yet joined the Dqlite cluster.
+	_, err = s.DB().ExecContext(c.Context(), "INSERT INTO controller_node (controller_id) VALUES ('0')")
+	c.Assert(err, tc.ErrorIsNil)
+
+	nodes, err := s.state.GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(nodes, tc.DeepEquals, []controllernode.ControllerNode{
+		{ControllerID: "0"},
+		{ControllerID: "1", DqliteNodeID: nodeID, DqliteBindAddress: "10.0.0.1"},
+	})
+}
+
+func (s *stateSuite) TestGetControllerNodesEmpty(c *tc.C) {
+	_, err := s.state.GetControllerNodes(c.Context())
+	c.Assert(err, tc.ErrorIs, controllernodeerrors.EmptyControllerIDs)
+}
+
 func (s *stateSuite) TestGetAPIAddressesForAgents(c *tc.C) {
 	// Arrange: 2 controller nodes
 	controllerID1 := "1"
@@ -3,6 +3,8 @@
 
 package state
 
+import "database/sql"
+
 // dbControllerNode is the database representation of a controller node.
 type dbControllerNode struct {
 	// ControllerID is the nodes controller ID.
@@ -17,6 +19,14 @@ type dbControllerNode struct {
 	DqliteBindAddress string `db:"dqlite_bind_address"`
 }
 
+// dbControllerNodeMember is the database representation of a controller node
+// that may not yet have joined the Dqlite cluster.
+type dbControllerNodeMember struct {
+	ControllerID      string           `db:"controller_id"`
+	DqliteNodeID      sql.Null[string] `db:"dqlite_node_id"`
+	DqliteBindAddress sql.Null[string] `db:"dqlite_bind_address"`
+}
+
 type dbControllerNodeCount struct {
 	Count int `db:"count"`
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 
+	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/logger"
@@ -24,6 +25,25 @@ type SetAPIAddressArgs struct {
 	APIAddresses map[string]network.SpaceHostPorts
 }
 
+// ControllerNode describes a controller node and its membership of the
+// Dqlite cluster.
+type ControllerNode struct {
+	// ControllerID is the ID of the controller node.
+	ControllerID string
+
+	// DqliteNodeID is the ID of the node in the Dqlite cluster. It is zero if
+	// the controller node has not yet joined the cluster.
+	DqliteNodeID uint64
+
+	// DqliteBindAddress is the hostname or IP address that Dqlite is bound to
+	// on the controller node.
+	DqliteBindAddress string
+
+	// Role is the role of the node in the Dqlite cluster. It is empty if the
+	// node is not a member of the cluster.
+	Role database.NodeRole
+}
+
 // APIAddress represents one of the API addresses, accessible for clients
 // and/or agents.
 type APIAddress struct {
@@ -18,6 +18,7 @@ import (
 	corecharm "github.com/juju/juju/core/charm"
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/logger"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
 	"github.com/juju/juju/domain/deployment/charm"
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/internal/charmhub"
@@ -41,6 +42,25 @@ type CharmHubClient interface {
 	Refresh(ctx context.Context, config charmhub.RefreshConfig) ([]transport.RefreshResponse, error)
 }
 
+// CharmhubCredentials returns a function providing the credentials held by
+// the controller for a Charmhub mirror served by another controller. They
+// are held as the charm registry credential for the host, and optional port,
+// of the mirror. Hosts without a credential are accessed anonymously.
+func CharmhubCredentials(getter RegistryCredentialGetter) charmhub.CredentialsFunc {
+	return func(ctx context.Context, host string) (charmhub.Credentials, error) {
+		cred, err := getter.GetCredential(ctx, host)
+		if internalerrors.Is(err, charmregistryerrors.CredentialNotFound) {
+			return charmhub.Credentials{}, nil
+		} else if err != nil {
+			return charmhub.Credentials{}, internalerrors.Capture(err)
+		}
+		return charmhub.Credentials{
+			Username: cred.Username,
+			Password: cred.Password,
+		}, nil
+	}
+}
+
 // CharmHubRepositoryConfig holds the config options require to construct a
 // CharmHubRepository.
 type CharmHubRepositoryConfig struct {
@@ -50,6 +70,11 @@ type CharmHubRepositoryConfig struct {
 	// CharmHubURL is the URL to use for CharmHub API calls.
 	CharmhubURL string
 
+	// Credentials returns the credentials to use when the CharmhubURL is
+	// a Charmhub mirror served by another controller. If nil, no
+	// credentials are sent.
+	Credentials charmhub.CredentialsFunc
+
 	Logger logger.Logger
 }
 
@@ -57,9 +82,10 @@ type CharmHubRepositoryConfig struct {
 // charmhub client.
 func NewCharmHubRepository(cfg CharmHubRepositoryConfig) (*CharmHubRepository, error) {
 	chClient, err := charmhub.NewClient(charmhub.Config{
-		URL:        cfg.CharmhubURL,
-		HTTPClient: cfg.CharmhubHTTPClient,
-		Logger:     cfg.Logger.Child("charmhub"),
+		URL:         cfg.CharmhubURL,
+		HTTPClient:  cfg.CharmhubHTTPClient,
+		Credentials: cfg.Credentials,
+		Logger:      cfg.Logger.Child("charmhub"),
 	})
 	if err != nil {
 		return nil, internalerrors.Capture(err)
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/deployment/charm/repository (interfaces: OCIClient)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/oci_client_mock.go github.com/juju/juju/domain/deployment/charm/repository OCIClient
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	charmhub "github.com/juju/juju/internal/charmhub"
+	charmsignature "github.com/juju/juju/internal/charmsignature"
+	ocicharm "github.com/juju/juju/internal/ocicharm"
+)
+
+// MockOCIClient is a mock of OCIClient interface.
+type MockOCIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOCIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockOCIClientMockRecorder is the mock recorder for MockOCIClient.
+type MockOCIClientMockRecorder struct {
+	mock                *MockOCIClient
+	downloadBlobExpects []*gomock.Call3_2[context.Context, ocicharm.Reference, string, *charmhub.Digest, error]
+	resolveExpects      []*gomock.Call2_2[context.Context, ocicharm.Reference, ocicharm.Artifact, error]
+	signaturesExpects   []*gomock.Call2_2[context.Context, ocicharm.Reference, []charmsignature.CosignSignature, error]
+}
+
+// NewMockOCIClient creates a new mock instance.
+func NewMockOCIClient(ctrl *gomock.Controller) *MockOCIClient {
+	mock := &MockOCIClient{ctrl: ctrl}
+	mock.recorder = &MockOCIClientMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOCIClient) EXPECT() *MockOCIClientMockRecorder {
+	return m.recorder
+}
+
+// DownloadBlob mocks base method.
+func (m *MockOCIClient) DownloadBlob(ctx context.Context, ref ocicharm.Reference, path string) (*charmhub.Digest, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.downloadBlobExpects, m.ctrl, m, "DownloadBlob", ctx, ref, path)
+}
+
+// DownloadBlob indicates an expected call of DownloadBlob.
+func (mr *MockOCIClientMockRecorder) DownloadBlob(ctx, ref, path any) *MockOCIClientDownloadBlobCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, ocicharm.Reference, string, *charmhub.Digest, error](mr.mock.ctrl.T, mr.mock, "DownloadBlob", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(ref), gomock.EnsureMatcher(path))
+	mr.downloadBlobExpects = append(mr.downloadBlobExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOCIClientDownloadBlobCall is the typed call wrapper for DownloadBlob.
+type MockOCIClientDownloadBlobCall = gomock.Call3_2[context.Context, ocicharm.Reference, string, *charmhub.Digest, error]
+
+// Resolve mocks base method.
+func (m *MockOCIClient) Resolve(ctx context.Context, ref ocicharm.Reference) (ocicharm.Artifact, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.resolveExpects, m.ctrl, m, "Resolve", ctx, ref)
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockOCIClientMockRecorder) Resolve(ctx, ref any) *MockOCIClientResolveCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, ocicharm.Reference, ocicharm.Artifact, error](mr.mock.ctrl.T, mr.mock, "Resolve", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(ref))
+	mr.resolveExpects = append(mr.resolveExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOCIClientResolveCall is the typed call wrapper for Resolve.
+type MockOCIClientResolveCall = gomock.Call2_2[context.Context, ocicharm.Reference, ocicharm.Artifact, error]
+
+// Signatures mocks base method.
+func (m *MockOCIClient) Signatures(ctx context.Context, ref ocicharm.Reference) ([]charmsignature.CosignSignature, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.signaturesExpects, m.ctrl, m, "Signatures", ctx, ref)
+}
+
+// Signatures indicates an expected call of Signatures.
+func (mr *MockOCIClientMockRecorder) Signatures(ctx, ref any) *MockOCIClientSignaturesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, ocicharm.Reference, []charmsignature.CosignSignature, error](mr.mock.ctrl.T, mr.mock, "Signatures", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(ref))
+	mr.signaturesExpects = append(mr.signaturesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOCIClientSignaturesCall is the typed call wrapper for Signatures.
+type MockOCIClientSignaturesCall = gomock.Call2_2[context.Context, ocicharm.Reference, []charmsignature.CosignSignature, error]
@@ -0,0 +1,415 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package repository
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/juju/juju/core/arch"
+	corecharm "github.com/juju/juju/core/charm"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/charmregistry"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
+	"github.com/juju/juju/domain/deployment/charm"
+	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
+	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmsignature"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/ocicharm"
+)
+
+// OCIClient describes the API exposed by the OCI registry client.
+type OCIClient interface {
+	// Resolve resolves the reference to a charm or bundle artifact, pinned
+	// to the digest of its manifest.
+	Resolve(ctx context.Context, ref ocicharm.Reference) (ocicharm.Artifact, error)
+
+	// DownloadBlob downloads the blob with the digest of the reference to
+	// the given path, verifying the content against the digest.
+	DownloadBlob(ctx context.Context, ref ocicharm.Reference, path string) (*charmhub.Digest, error)
+
+	// Signatures returns the cosign signatures of the manifest the reference
+	// is pinned to.
+	Signatures(ctx context.Context, ref ocicharm.Reference) ([]charmsignature.CosignSignature, error)
+}
+
+// RegistryCredentialGetter provides the credentials held by the controller
+// for OCI registries and Charmhub mirrors.
+type RegistryCredentialGetter interface {
+	// GetCredential returns the credential for the registry.
+	GetCredential(ctx context.Context, registry string) (charmregistry.Credential, error)
+}
+
+// OCICredentials returns a function providing the credentials held by the
+// controller for a registry. Registries without a credential are accessed
+// anonymously.
+func OCICredentials(getter RegistryCredentialGetter) ocicharm.CredentialsFunc {
+	return func(ctx context.Context, registry string) (ocicharm.Credentials, error) {
+		cred, err := getter.GetCredential(ctx, registry)
+		if internalerrors.Is(err, charmregistryerrors.CredentialNotFound) {
+			return ocicharm.Credentials{}, nil
+		} else if err != nil {
+			return ocicharm.Credentials{}, internalerrors.Capture(err)
+		}
+		return ocicharm.Credentials{
+			Username: cred.Username,
+			Password: cred.Password,
+		}, nil
+	}
+}
+
+// OCIRepositoryConfig holds the config options required to construct an
+// OCIRepository.
+type OCIRepositoryConfig struct {
+	// HTTPClient is used to make requests to the registries.
+	HTTPClient ocicharm.HTTPClient
+
+	// Credentials returns the credentials to use for a registry.
+	Credentials ocicharm.CredentialsFunc
+
+	Logger logger.Logger
+}
+
+// NewOCIRepository returns a new repository instance for charms stored in
+// OCI registries.
+func NewOCIRepository(cfg OCIRepositoryConfig) (*OCIRepository, error) {
+	client, err := ocicharm.NewClient(ocicharm.Config{
+		HTTPClient:  cfg.HTTPClient,
+		Credentials: cfg.Credentials,
+		Logger:      cfg.Logger.Child("ocicharm"),
+	})
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return newOCIRepository(client, cfg.Logger), nil
+}
+
+func newOCIRepository(client OCIClient, log logger.Logger) *OCIRepository {
+	return &OCIRepository{
+		logger: log.Child("ocirepo", logger.CHARMHUB),
+		client: client,
+	}
+}
+
+// OCIRepository provides an API for charm-related operations using charms
+// stored as artifacts in OCI registries.
+//
+// Registries only describe charms through the archive itself, so resolving a
+// charm downloads the archive to read its metadata.
+type OCIRepository struct {
+	logger logger.Logger
+	client OCIClient
+}
+
+// OCIOrigin returns the origin of a charm in an OCI registry, for the given
+// reference and platform. The origin ID is the location of the charm in the
+// registry, including the digest if the reference is pinned, and the tag is
+// carried as the track of the channel.
+func OCIOrigin(ref ocicharm.Reference, platform corecharm.Platform) corecharm.Origin {
+	origin := corecharm.Origin{
+		Source:   corecharm.OCI,
+		ID:       ref.Locator(),
+		Platform: platform,
+	}
+	if ref.Digest != "" {
+		origin.ID = ref.Locator() + "@" + ref.Digest
+	}
+	if ref.Tag != "" {
+		origin.Channel = &charm.Channel{
+			Track: ref.Tag,
+			Risk:  charm.Stable,
+		}
+	}
+	return origin
+}
+
+// OCIReference returns the reference addressed by an origin from an OCI
+// registry. A digest in the origin ID takes precedence over the tag held in
+// the channel.
+func OCIReference(origin corecharm.Origin) (ocicharm.Reference, error) {
+	if origin.Source != corecharm.OCI {
+		return ocicharm.Reference{}, internalerrors.Errorf("origin source %q is not %q", origin.Source, corecharm.OCI).Add(coreerrors.NotValid)
+	}
+	if origin.ID == "" {
+		return ocicharm.Reference{}, internalerrors.New("origin has no OCI reference").Add(coreerrors.NotValid)
+	}
+	ref, err := ocicharm.ParseReference(origin.ID)
+	if err != nil {
+		return ocicharm.Reference{}, internalerrors.Capture(err)
+	}
+	if ref.Digest == "" && origin.Channel != nil && origin.Channel.Track != "" {
+		ref = ref.WithTag(origin.Channel.Track)
+	}
+	return ref, nil
+}
+
+// ResolveWithPreferredChannel resolves the tag or digest held in the origin
+// against the registry, returning the charm URL with the revision of the
+// artifact.
+func (c *OCIRepository) ResolveWithPreferredChannel(ctx context.Context, charmName string, argOrigin corecharm.Origin) (corecharm.ResolvedData, error) {
+	c.logger.Tracef(ctx, "Resolving OCI charm %q with origin %+v", charmName, argOrigin)
+
+	curl, essMeta, platforms, err := c.resolve(ctx, charmName, argOrigin)
+	if err != nil {
+		return corecharm.ResolvedData{}, internalerrors.Capture(err)
+	}
+	return corecharm.ResolvedData{
+		URL:               curl,
+		EssentialMetadata: essMeta,
+		Origin:            essMeta.ResolvedOrigin,
+		Platform:          platforms,
+	}, nil
+}
+
+// ResolveForDeploy resolves the charm in the same way as
+// ResolveWithPreferredChannel, and fetches the cosign signatures of the
+// artifact so that they can be verified before the charm is deployed. Charms
+// from OCI registries have no repository resources, so none are returned.
+func (c *OCIRepository) ResolveForDeploy(ctx context.Context, arg corecharm.CharmID) (corecharm.ResolvedDataForDeploy, error) {
+	c.logger.Tracef(ctx, "Resolving OCI charm %q with origin %+v", arg.URL, arg.Origin)
+
+	curl, essMeta, _, err := c.resolve(ctx, arg.URL.Name, arg.Origin)
+	if err != nil {
+		return corecharm.ResolvedDataForDeploy{}, internalerrors.Capture(err)
+	}
+
+	ref, err := OCIReference(arg.Origin)
+	if err != nil {
+		return corecharm.ResolvedDataForDeploy{}, internalerrors.Capture(err)
+	}
+	signatures, err := c.client.Signatures(ctx, ref.WithDigest(essMeta.DownloadInfo.SignedDigest))
+	if err != nil {
+		return corecharm.ResolvedDataForDeploy{}, internalerrors.Errorf("fetching signatures of %q: %w", ref, err)
+	}
+	essMeta.DownloadInfo.Signatures = signatures
+
+	return corecharm.ResolvedDataForDeploy{
+		URL:               curl,
+		EssentialMetadata: essMeta,
+	}, nil
+}
+
+func (c *OCIRepository) resolve(ctx context.Context, charmName string, requestedOrigin corecharm.Origin) (*charm.URL, corecharm.EssentialMetadata, []corecharm.Platform, error) {
+	ref, err := OCIReference(requestedOrigin)
+	if err != nil {
+		return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Capture(err)
+	}
+	if name := ref.Name(); name != charmName {
+		return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Errorf(
+			"charm name %q does not match OCI repository %q", charmName, ref.Repository,
+		).Add(coreerrors.NotValid)
+	}
+
+	artifact, err := c.client.Resolve(ctx, ref)
+	if internalerrors.Is(err, ocicharm.ArtifactNotFound) {
+		return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Errorf("resolving %q: %w", ref, err).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Errorf("resolving %q: %w", ref, err)
+	}
+	if artifact.Revision < 0 {
+		return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Errorf(
+			"OCI artifact %q has no %q annotation", ref, ocicharm.RevisionAnnotation,
+		).Add(coreerrors.NotValid)
+	}
+	if rev := requestedOrigin.Revision; rev != nil && *rev >= 0 && *rev != artifact.Revision {
+		return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Errorf(
+			"OCI artifact %q is revision %d, not %d; pin the revision by digest instead", ref, artifact.Revision, *rev,
+		).Add(coreerrors.NotFound)
+	}
+
+	revision := artifact.Revision
+	resolvedOrigin := requestedOrigin
+	resolvedOrigin.Type = string(artifact.Type)
+	resolvedOrigin.Revision = &revision
+	resolvedOrigin.Hash = artifact.ArchiveSHA256()
+	if resolvedOrigin.Channel == nil && ref.Tag != "" {
+		resolvedOrigin.Channel = &charm.Channel{
+			Track: ref.Tag,
+			Risk:  charm.Stable,
+		}
+	}
+	if resolvedOrigin.Platform.Architecture == "" {
+		resolvedOrigin.Platform.Architecture = arch.DefaultArchitecture
+	}
+
+	essMeta := corecharm.EssentialMetadata{
+		DownloadInfo: corecharm.DownloadInfo{
+			CharmhubIdentifier: requestedOrigin.ID,
+			DownloadURL:        artifact.ArchiveReference().URL(),
+			DownloadSize:       artifact.Archive.Size,
+			SignedDigest:       artifact.Reference.Digest,
+		},
+	}
+
+	var platforms []corecharm.Platform
+	if artifact.Type == ocicharm.CharmArtifact {
+		if essMeta, platforms, err = c.readMetadata(ctx, artifact, resolvedOrigin.Platform.Architecture, essMeta); err != nil {
+			return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Capture(err)
+		}
+		if essMeta.Meta.Name != charmName {
+			return nil, corecharm.EssentialMetadata{}, nil, internalerrors.Errorf(
+				"OCI artifact %q holds charm %q, not %q", ref, essMeta.Meta.Name, charmName,
+			).Add(coreerrors.NotValid)
+		}
+		if resolvedOrigin.Platform.Channel == "" && len(platforms) > 0 {
+			resolvedOrigin.Platform.OS = platforms[0].OS
+			resolvedOrigin.Platform.Channel = platforms[0].Channel
+		}
+	}
+	essMeta.ResolvedOrigin = resolvedOrigin
+
+	curl := &charm.URL{
+		Schema:       charm.OCI.String(),
+		Name:         charmName,
+		Revision:     revision,
+		Architecture: resolvedOrigin.Platform.Architecture,
+	}
+	c.logger.Tracef(ctx, "Resolved OCI charm %q with origin %v", curl, resolvedOrigin)
+	return curl, essMeta, platforms, nil
+}
+
+// readMetadata downloads the charm archive of the artifact to read the
+// essential metadata, and the platforms supported for the architecture.
+func (c *OCIRepository) readMetadata(
+	ctx context.Context, artifact ocicharm.Artifact, architecture string, essMeta corecharm.EssentialMetadata,
+) (corecharm.EssentialMetadata, []corecharm.Platform, error) {
+	dir, err := os.MkdirTemp("", "oci-charm-")
+	if err != nil {
+		return essMeta, nil, internalerrors.Capture(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "charm.zip")
+	if _, err := c.client.DownloadBlob(ctx, artifact.ArchiveReference(), path); err != nil {
+		return essMeta, nil, internalerrors.Errorf("downloading charm archive: %w", err)
+	}
+	archive, err := charm.ReadCharmArchive(path)
+	if err != nil {
+		return essMeta, nil, internalerrors.Errorf("reading charm archive: %w", err)
+	}
+
+	essMeta.Meta = archive.Meta()
+	essMeta.Config = archive.Config()
+	essMeta.Manifest = archive.Manifest()
+	essMeta.Actions = archive.Actions()
+	if essMeta.Config == nil {
+		essMeta.Config = charm.NewConfig()
+	}
+	if essMeta.Actions == nil {
+		essMeta.Actions = charm.NewActions()
+	}
+
+	var platforms []corecharm.Platform
+	if essMeta.Manifest != nil {
+		for _, base := range essMeta.Manifest.Bases {
+			if len(base.Architectures) > 0 && !slices.Contains(base.Architectures, architecture) {
+				continue
+			}
+			platforms = append(platforms, corecharm.Platform{
+				Architecture: architecture,
+				OS:           base.Name,
+				Channel:      base.Channel.Track,
+			})
+		}
+	}
+	return essMeta, platforms, nil
+}
+
+// ResolveRevision returns the revision of the charm currently addressed by
+// the tag or digest of the origin. Only the manifest is fetched, so this is
+// cheap enough to poll.
+func (c *OCIRepository) ResolveRevision(ctx context.Context, origin corecharm.Origin) (int, error) {
+	ref, err := OCIReference(origin)
+	if err != nil {
+		return -1, internalerrors.Capture(err)
+	}
+	artifact, err := c.client.Resolve(ctx, ref)
+	if err != nil {
+		return -1, internalerrors.Errorf("resolving %q: %w", ref, err)
+	}
+	if artifact.Revision < 0 {
+		return -1, internalerrors.Errorf(
+			"OCI artifact %q has no %q annotation", ref, ocicharm.RevisionAnnotation,
+		).Add(coreerrors.NotValid)
+	}
+	return artifact.Revision, nil
+}
+
+// GetDownloadURL returns the "oci://" URL of the archive of the charm,
+// pinned to its digest. The returned origin holds the hash of the archive.
+func (c *OCIRepository) GetDownloadURL(ctx context.Context, charmName string, requestedOrigin corecharm.Origin) (*url.URL, corecharm.Origin, error) {
+	c.logger.Tracef(ctx, "GetDownloadURL %q, origin: %q", charmName, requestedOrigin)
+
+	ref, err := OCIReference(requestedOrigin)
+	if err != nil {
+		return nil, corecharm.Origin{}, internalerrors.Capture(err)
+	}
+	artifact, err := c.client.Resolve(ctx, ref)
+	if err != nil {
+		return nil, corecharm.Origin{}, internalerrors.Errorf("resolving %q: %w", ref, err)
+	}
+
+	resOrigin := requestedOrigin
+	resOrigin.Hash = artifact.ArchiveSHA256()
+
+	durl, err := url.Parse(artifact.ArchiveReference().URL())
+	if err != nil {
+		return nil, corecharm.Origin{}, internalerrors.Capture(err)
+	}
+	return durl, resOrigin, nil
+}
+
+// Download retrieves the charm archive from the registry and saves it to
+// the given path.
+func (c *OCIRepository) Download(ctx context.Context, name string, requestedOrigin corecharm.Origin, path string) (corecharm.Origin, *charmhub.Digest, error) {
+	c.logger.Tracef(ctx, "Download %q, origin: %q", name, requestedOrigin)
+
+	ref, err := OCIReference(requestedOrigin)
+	if err != nil {
+		return corecharm.Origin{}, nil, internalerrors.Capture(err)
+	}
+	artifact, err := c.client.Resolve(ctx, ref)
+	if err != nil {
+		return corecharm.Origin{}, nil, internalerrors.Errorf("resolving %q: %w", ref, err)
+	}
+
+	digest, err := c.client.DownloadBlob(ctx, artifact.ArchiveReference(), path)
+	if err != nil {
+		return corecharm.Origin{}, nil, internalerrors.Capture(err)
+	}
+
+	// Verify the hash if the requested origin has supplied one.
+	if requestedOrigin.Hash != "" && digest.SHA256 != requestedOrigin.Hash {
+		return corecharm.Origin{}, nil, internalerrors.Errorf("downloaded charm hash %q does not match expected hash %q", digest.SHA256, requestedOrigin.Hash)
+	}
+
+	actualOrigin := requestedOrigin
+	actualOrigin.Hash = digest.SHA256
+	return actualOrigin, digest, nil
+}
+
+// ListResources returns no resources, as charms in OCI registries can only
+// use uploaded resources.
+func (c *OCIRepository) ListResources(ctx context.Context, charmName string, origin corecharm.Origin) ([]charmresource.Resource, error) {
+	return nil, nil
+}
+
+// ResolveResources returns the given resources unchanged. Charms in OCI
+// registries have no repository resources, so any resource must have been
+// uploaded.
+func (c *OCIRepository) ResolveResources(ctx context.Context, resources []charmresource.Resource, id corecharm.CharmID) ([]charmresource.Resource, error) {
+	for _, res := range resources {
+		if res.Origin != charmresource.OriginUpload {
+			return nil, internalerrors.Errorf(
+				"resource %q of OCI charm %q must be uploaded", res.Name, id.URL.Name,
+			).Add(coreerrors.NotSupported)
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package repository
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/arch"
+	corecharm "github.com/juju/juju/core/charm"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/deployment/charm"
+	"github.com/juju/juju/domain/deployment/charm/repository/mocks"
+	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
+	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmsignature"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/ocicharm"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+const (
+	ociManifestDigest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	ociArchiveDigest  = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+type ociRepositorySuite struct {
+	testhelpers.IsolationSuite
+
+	client *mocks.MockOCIClient
+}
+
+func TestOCIRepositorySuite(t *testing.T) {
+	tc.Run(t, &ociRepositorySuite{})
+}
+
+func (s *ociRepositorySuite) TestOCIOriginAndReference(c *tc.C) {
+	ref, err := ocicharm.ParseReference("oci://registry.example.com/charms/wordpress:1.2")
+	c.Assert(err, tc.ErrorIsNil)
+
+	origin := OCIOrigin(ref, corecharm.Platform{Architecture: arch.DefaultArchitecture})
+	c.Check(origin.Source, tc.Equals, corecharm.OCI)
+	c.Check(origin.ID, tc.Equals, "registry.example.com/charms/wordpress")
+	c.Check(origin.Channel, tc.DeepEquals, &charm.Channel{Track: "1.2", Risk: charm.Stable})
+
+	got, err := OCIReference(origin)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, ref)
+}
+
+func (s *ociRepositorySuite) TestOCIReferencePinnedByDigest(c *tc.C) {
+	ref, err := ocicharm.ParseReference("registry.example.com/charms/wordpress@" + ociManifestDigest)
+	c.Assert(err, tc.ErrorIsNil)
+
+	origin := OCIOrigin(ref, corecharm.Platform{})
+	c.Check(origin.ID, tc.Equals, "registry.example.com/charms/wordpress@"+ociManifestDigest)
+	c.Check(origin.Channel, tc.IsNil)
+
+	got, err := OCIReference(origin)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got.Digest, tc.Equals, ociManifestDigest)
+}
+
+func (s *ociRepositorySuite) TestOCIReferenceNotOCI(c *tc.C) {
+	_, err := OCIReference(corecharm.Origin{Source: corecharm.CharmHub, ID: "foo"})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *ociRepositorySuite) TestResolveWithPreferredChannel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, 7)
+	s.expectDownloadBlob(c)
+
+	origin := OCIOrigin(ref, corecharm.Platform{Architecture: arch.DefaultArchitecture})
+	resolved, err := s.newRepository(c).ResolveWithPreferredChannel(c.Context(), "wordpress", origin)
+	c.Assert(err, tc.ErrorIsNil)
+
+	rev := 7
+	c.Check(resolved.URL, tc.DeepEquals, &charm.URL{
+		Schema:       "oci",
+		Name:         "wordpress",
+		Revision:     7,
+		Architecture: arch.DefaultArchitecture,
+	})
+	c.Check(resolved.Origin, tc.DeepEquals, corecharm.Origin{
+		Source:   corecharm.OCI,
+		Type:     "charm",
+		ID:       "registry.example.com/charms/wordpress",
+		Hash:     "2222222222222222222222222222222222222222222222222222222222222222",
+		Revision: &rev,
+		Channel:  &charm.Channel{Track: "stable", Risk: charm.Stable},
+		Platform: corecharm.Platform{
+			Architecture: arch.DefaultArchitecture,
+			OS:           "ubuntu",
+			Channel:      "22.04",
+		},
+	})
+	c.Check(resolved.Platform, tc.DeepEquals, []corecharm.Platform{{
+		Architecture: arch.DefaultArchitecture,
+		OS:           "ubuntu",
+		Channel:      "22.04",
+	}})
+	c.Check(resolved.EssentialMetadata.Meta.Name, tc.Equals, "wordpress")
+	c.Check(resolved.EssentialMetadata.DownloadInfo, tc.DeepEquals, corecharm.DownloadInfo{
+		CharmhubIdentifier: "registry.example.com/charms/wordpress",
+		DownloadURL:        "oci://registry.example.com/charms/wordpress@" + ociArchiveDigest,
+		DownloadSize:       42,
+		SignedDigest:       ociManifestDigest,
+	})
+}
+
+func (s *ociRepositorySuite) TestResolveForDeployFetchesSignatures(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, 7)
+	s.expectDownloadBlob(c)
+	signatures := []charmsignature.CosignSignature{{
+		Payload:   []byte("payload"),
+		Signature: []byte("signature"),
+	}}
+	s.client.EXPECT().Signatures(gomock.Any(), ref.WithDigest(ociManifestDigest)).Return(signatures, nil)
+
+	origin := OCIOrigin(ref, corecharm.Platform{Architecture: arch.DefaultArchitecture})
+	resolved, err := s.newRepository(c).ResolveForDeploy(c.Context(), corecharm.CharmID{
+		URL:    &charm.URL{Schema: "oci", Name: "wordpress"},
+		Origin: origin,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(resolved.EssentialMetadata.DownloadInfo.SignedDigest, tc.Equals, ociManifestDigest)
+	c.Check(resolved.EssentialMetadata.DownloadInfo.Signatures, tc.DeepEquals, signatures)
+}
+
+func (s *ociRepositorySuite) TestResolveNameMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref, err := ocicharm.ParseReference("registry.example.com/charms/wordpress:stable")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.newRepository(c).ResolveWithPreferredChannel(c.Context(), "mysql", OCIOrigin(ref, corecharm.Platform{}))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *ociRepositorySuite) TestResolveNoRevision(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, -1)
+
+	_, err := s.newRepository(c).ResolveWithPreferredChannel(c.Context(), "wordpress", OCIOrigin(ref, corecharm.Platform{}))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *ociRepositorySuite) TestResolveRevisionMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, 7)
+
+	origin := OCIOrigin(ref, corecharm.Platform{})
+	rev := 6
+	origin.Revision = &rev
+	_, err := s.newRepository(c).ResolveWithPreferredChannel(c.Context(), "wordpress", origin)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *ociRepositorySuite) TestResolveNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.client.EXPECT().Resolve(gomock.Any(), gomock.Any()).Return(ocicharm.Artifact{}, ocicharm.ArtifactNotFound)
+
+	ref, err := ocicharm.ParseReference("registry.example.com/charms/wordpress:stable")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.newRepository(c).ResolveWithPreferredChannel(c.Context(), "wordpress", OCIOrigin(ref, corecharm.Platform{}))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *ociRepositorySuite) TestResolveRevision(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, 7)
+
+	rev, err := s.newRepository(c).ResolveRevision(c.Context(), OCIOrigin(ref, corecharm.Platform{}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rev, tc.Equals, 7)
+}
+
+func (s *ociRepositorySuite) TestGetDownloadURL(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, 7)
+
+	durl, origin, err := s.newRepository(c).GetDownloadURL(c.Context(), "wordpress", OCIOrigin(ref, corecharm.Platform{}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(durl.String(), tc.Equals, "oci://registry.example.com/charms/wordpress@"+ociArchiveDigest)
+	c.Check(origin.Hash, tc.Equals, "2222222222222222222222222222222222222222222222222222222222222222")
+}
+
+func (s *ociRepositorySuite) TestDownloadHashMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ref := s.expectResolve(c, 7)
+	s.client.EXPECT().DownloadBlob(gomock.Any(), gomock.Any(), "/tmp/charm.zip").Return(&charmhub.Digest{SHA256: "other"}, nil)
+
+	origin := OCIOrigin(ref, corecharm.Platform{})
+	origin.Hash = "expected"
+	_, _, err := s.newRepository(c).Download(c.Context(), "wordpress", origin, "/tmp/charm.zip")
+	c.Assert(err, tc.ErrorMatches, `downloaded charm hash "other" does not match expected hash "expected"`)
+}
+
+func (s *ociRepositorySuite) TestResolveResourcesRequiresUpload(c *tc.C) {
+	repo := newOCIRepository(nil, loggertesting.WrapCheckLog(c))
+	id := corecharm.CharmID{URL: &charm.URL{Name: "wordpress"}}
+
+	uploaded := []charmresource.Resource{{
+		Meta:   charmresource.Meta{Name: "data"},
+		Origin: charmresource.OriginUpload,
+	}}
+	resolved, err := repo.ResolveResources(c.Context(), uploaded, id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(resolved, tc.DeepEquals, uploaded)
+
+	_, err = repo.ResolveResources(c.Context(), []charmresource.Resource{{
+		Meta:   charmresource.Meta{Name: "data"},
+		Origin: charmresource.OriginStore,
+	}}, id)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *ociRepositorySuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.client = mocks.NewMockOCIClient(ctrl)
+	return ctrl
+}
+
+func (s *ociRepositorySuite) newRepository(c *tc.C) *OCIRepository {
+	return newOCIRepository(s.client, loggertesting.WrapCheckLog(c))
+}
+
+func (s *ociRepositorySuite) expectResolve(c *tc.C, revision int) ocicharm.Reference {
+	ref, err := ocicharm.ParseReference("registry.example.com/charms/wordpress:stable")
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.client.EXPECT().Resolve(gomock.Any(), ref).Return(ocicharm.Artifact{
+		Reference: ref.WithDigest(ociManifestDigest),
+		Type:      ocicharm.CharmArtifact,
+		Revision:  revision,
+		Archive: ocicharm.Descriptor{
+			MediaType: ocicharm.CharmLayerMediaType,
+			Digest:    ociArchiveDigest,
+			Size:      42,
+		},
+	}, nil)
+	return ref
+}
+
+func (s *ociRepositorySuite) expectDownloadBlob(c *tc.C) {
+	s.client.EXPECT().DownloadBlob(gomock.Any(), ocicharm.Reference{
+		Registry:   "registry.example.com",
+		Repository: "charms/wordpress",
+		Digest:     ociArchiveDigest,
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ ocicharm.Reference, path string) (*charmhub.Digest, error) {
+		writeCharmArchive(c, path, map[string]string{
+			"metadata.yaml": "name: wordpress\nsummary: blog\ndescription: blog\n",
+			"manifest.yaml": "bases:\n- name: ubuntu\n  channel: \"22.04\"\n  architectures: [amd64]\n",
+		})
+		return &charmhub.Digest{SHA256: "2222222222222222222222222222222222222222222222222222222222222222"}, nil
+	})
+}
+
+func writeCharmArchive(c *tc.C, path string, files map[string]string) {
+	f, err := os.Create(path)
+	c.Assert(err, tc.ErrorIsNil)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(filepath.ToSlash(name))
+		c.Assert(err, tc.ErrorIsNil)
+		_, err = fw.Write([]byte(content))
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	c.Assert(w.Close(), tc.ErrorIsNil)
+}
@@ -4,3 +4,4 @@
 package repository
 
 //go:generate go run github.com/canonical/gomock/mockgen -package mocks -destination mocks/charmhub_client_mock.go github.com/juju/juju/domain/deployment/charm/repository CharmHubClient
+//go:generate go run github.com/canonical/gomock/mockgen -package mocks -destination mocks/oci_client_mock.go github.com/juju/juju/domain/deployment/charm/repository OCIClient
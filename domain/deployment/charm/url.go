@@ -25,6 +25,10 @@ const (
 
 	// CharmHub schema represents the charmhub charm repository.
 	CharmHub Schema = "ch"
+
+	// OCI schema represents a charm stored as an artifact in an OCI
+	// registry.
+	OCI Schema = "oci"
 )
 
 // Prefix creates a url with the given prefix, useful for typed schemas.
@@ -53,8 +57,14 @@ type Location interface {
 //	local:oneiric/wordpress
 //	ch:wordpress
 //	ch:amd64/jammy/wordpress-30
+//	oci:amd64/wordpress-30
+//
+// A charm in an OCI registry is referred to by its full reference, such as
+// oci://registry.example.com/charms/wordpress:stable, which is parsed to
+// the name of the charm. The location in the registry is carried by the
+// charm origin.
 type URL struct {
-	Schema       string // "ch" or "local".
+	Schema       string // "ch", "oci" or "local".
 	Name         string // "wordpress".
 	Revision     int    // -1 if unset, N otherwise.
 	series       string // "precise" or "" if unset; "bundle" if it's a bundle.
@@ -70,11 +80,12 @@ var (
 //
 // Valid schemas for the URL are:
 // - ch: charm hub
+// - oci: OCI registry
 // - local: local file
 
 func ValidateSchema(schema string) error {
 	switch schema {
-	case CharmHub.String(), Local.String():
+	case CharmHub.String(), OCI.String(), Local.String():
 		return nil
 	}
 	return internalerrors.Errorf("schema %q not valid", schema).Add(coreerrors.NotValid)
@@ -149,6 +160,8 @@ func ParseURL(url string) (*URL, error) {
 	case CharmHub.Matches(u.Scheme):
 		// Handle talking to the new style of the schema.
 		curl, err = parseCharmhubURL(u)
+	case OCI.Matches(u.Scheme):
+		curl, err = parseOCIURL(u)
 	case u.Opaque != "":
 		u.Path = u.Opaque
 		curl, err = parseLocalURL(u, url)
@@ -368,6 +381,44 @@ func parseCharmhubURL(url *gourl.URL) (*URL, error) {
 	return &r, nil
 }
 
+// parseOCIURL parses either the full reference of a charm in an OCI registry,
+// of the form oci://registry/namespace/name[:tag][@digest], or the charm
+// identifier form oci:[architecture/]name[-revision] used once the charm is
+// resolved.
+func parseOCIURL(url *gourl.URL) (*URL, error) {
+	if url.Opaque != "" {
+		curl, err := parseCharmhubURL(url)
+		if err != nil {
+			return nil, internalerrors.Capture(err)
+		}
+		curl.Schema = OCI.String()
+		return curl, nil
+	}
+
+	if url.Host == "" {
+		return nil, internalerrors.Errorf("OCI charm URL %q has no registry", url).Add(coreerrors.NotValid)
+	}
+	path := strings.Trim(url.Path, "/")
+	if path == "" {
+		return nil, internalerrors.Errorf("OCI charm URL %q has no repository", url).Add(coreerrors.NotValid)
+	}
+
+	// The charm name is the last component of the repository, without any
+	// tag or digest.
+	name := path[strings.LastIndex(path, "/")+1:]
+	if i := strings.IndexAny(name, ":@"); i >= 0 {
+		name = name[:i]
+	}
+	if err := ValidateName(name); err != nil {
+		return nil, internalerrors.Errorf("cannot parse OCI charm URL %q: %w", url, err)
+	}
+	return &URL{
+		Schema:   OCI.String(),
+		Name:     name,
+		Revision: -1,
+	}, nil
+}
+
 // EnsureSchema will ensure that the scheme for a given URL is correct and
 // valid. If the url does not specify a schema, the provided defaultSchema
 // will be injected to it.
@@ -377,7 +428,7 @@ func EnsureSchema(url string, defaultSchema Schema) (string, error) {
 		return "", internalerrors.Errorf("cannot parse charm or bundle URL: %q", url)
 	}
 	switch Schema(u.Scheme) {
-	case CharmHub, Local:
+	case CharmHub, OCI, Local:
 		return url, nil
 	case Schema(""):
 		// If the schema is empty, we fall back to the default schema.
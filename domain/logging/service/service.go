@@ -105,6 +105,11 @@ func (s *Service) SetLokiConfig(ctx context.Context, config logging.LokiConfig)
 	if u.Scheme == "" || u.Host == "" {
 		return errors.Errorf("loki endpoint %q missing scheme or host", config.Endpoint).Add(coreerrors.NotValid)
 	}
+	// Logs are sent to an OTLP collector through the workload tracing
+	// config, so only Loki's own schemes are accepted here.
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.Errorf("loki endpoint %q has unsupported scheme %q", config.Endpoint, u.Scheme).Add(coreerrors.NotValid)
+	}
 
 	id, err := uuid.NewUUID()
 	if err != nil {
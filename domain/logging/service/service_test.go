@@ -54,6 +54,15 @@ func (s *serviceSuite) TestSetLokiConfigInvalidURLReturnsError(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
 }
 
+func (s *serviceSuite) TestSetLokiConfigUnsupportedSchemeReturnsError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewWatchableService(s.st, s.watcherFactory).SetLokiConfig(c.Context(), logging.LokiConfig{
+		Endpoint: "otlp+https://collector:4318",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *serviceSuite) TestSetLokiConfigInsecureSkipVerifyTrue(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
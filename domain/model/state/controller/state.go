@@ -872,7 +872,7 @@ WHERE model_type.type = $dbInitialModel.model_type
 // - Secret backends
 // - Secret backend ref counting
 // - Model agent information
-// - Model permissions
+// - Model and application permissions, including those granted to groups
 // - Model login information
 func (s *State) Delete(
 	ctx context.Context,
@@ -890,6 +890,15 @@ func (s *State) Delete(
 		`DELETE FROM secret_backend_reference WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM model_authorized_keys WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM permission WHERE grant_on = $dbUUID.uuid`,
+		`
+DELETE FROM permission
+WHERE object_type_id = (SELECT id FROM permission_object_type WHERE type = 'application')
+AND grant_on LIKE $dbUUID.uuid || ':%'`,
+		`DELETE FROM user_group_permission WHERE grant_on = $dbUUID.uuid`,
+		`
+DELETE FROM user_group_permission
+WHERE object_type_id = (SELECT id FROM permission_object_type WHERE type = 'application')
+AND grant_on LIKE $dbUUID.uuid || ':%'`,
 		`DELETE FROM model_last_login WHERE model_uuid = $dbUUID.uuid`,
 	}
 
@@ -910,6 +910,14 @@ func (m *stateSuite) TestDeleteModel(c *tc.C) {
 	)
 	c.Assert(err, tc.ErrorIsNil)
 
+	db := m.DB()
+	// Grant run-action on an application in the model.
+	_, err = db.ExecContext(c.Context(), `
+INSERT INTO permission (uuid, access_type_id, object_type_id, grant_on, grant_to)
+VALUES (?, 7, 4, ?, ?)
+`, uuid.MustNewUUID().String(), m.uuid.String()+":mysql", m.userUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
 	modelSt := NewState(m.TxnRunnerFactory())
 	err = modelSt.Delete(
 		c.Context(),
@@ -917,7 +925,6 @@ func (m *stateSuite) TestDeleteModel(c *tc.C) {
 	)
 	c.Assert(err, tc.ErrorIsNil)
 
-	db := m.DB()
 	row := db.QueryRowContext(
 		c.Context(),
 		"SELECT uuid FROM model WHERE uuid = ?",
@@ -939,6 +946,13 @@ WHERE model_uuid = ?
 	// ErrNoRows is not returned by row.Err, it is deferred until row.Scan
 	// is called.
 	c.Assert(row.Scan(nil), tc.ErrorIs, sql.ErrNoRows)
+
+	row = db.QueryRow(`
+SELECT grant_on
+FROM permission
+WHERE grant_on LIKE ? || ':%'
+	`, m.uuid)
+	c.Assert(row.Scan(nil), tc.ErrorIs, sql.ErrNoRows)
 }
 
 func (m *stateSuite) TestDeleteModelNotFound(c *tc.C) {
@@ -375,11 +375,53 @@ func (s *Service) UpdateModelConfig(
 		return nil
 	}
 
-	updates, err := s.reconcileRemovedAttributes(ctx, removeAttrs)
+	rawCfgUpdate, err := s.validateModelConfigUpdate(ctx, updateAttrs, removeAttrs, s.validatorForUpdateModelConfig())
 	if err != nil {
 		return errors.Capture(err)
 	}
 
+	err = s.st.UpdateModelConfig(ctx, rawCfgUpdate, removeAttrs)
+	if err != nil {
+		return errors.Errorf("updating model config: %w", err)
+	}
+	return nil
+}
+
+// ValidateModelConfigUpdate validates a set of updated and removed attributes
+// with the validations run by [Service.UpdateModelConfig], followed by the
+// additional validators given, without applying them. It allows a caller to
+// check an update before making other changes that the update is applied
+// along with.
+func (s *Service) ValidateModelConfigUpdate(
+	ctx context.Context,
+	updateAttrs map[string]any,
+	removeAttrs []string,
+	additionalValidators ...config.Validator,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if len(updateAttrs) == 0 && len(removeAttrs) == 0 {
+		return nil
+	}
+	_, err := s.validateModelConfigUpdate(ctx, updateAttrs, removeAttrs, s.validatorForUpdateModelConfig(additionalValidators...))
+	return errors.Capture(err)
+}
+
+// validateModelConfigUpdate validates a set of updated and removed
+// attributes with the given validator, returning the attributes to set,
+// coerced for storage.
+func (s *Service) validateModelConfigUpdate(
+	ctx context.Context,
+	updateAttrs map[string]any,
+	removeAttrs []string,
+	validator config.Validator,
+) (map[string]string, error) {
+	updates, err := s.reconcileRemovedAttributes(ctx, removeAttrs)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
 	// It's important here that we apply the user updates over the top of the
 	// calculated ones. This way we always take the user's supplied key value
 	// over defaults.
@@ -387,12 +429,12 @@ func (s *Service) UpdateModelConfig(
 
 	newCfg, currCfg, err := s.buildUpdatedModelConfig(ctx, updates, removeAttrs)
 	if err != nil {
-		return errors.Errorf("making updated model configuration: %w", err)
+		return nil, errors.Errorf("making updated model configuration: %w", err)
 	}
 
-	validatedCfg, err := s.validatorForUpdateModelConfig().Validate(ctx, newCfg, currCfg)
+	validatedCfg, err := validator.Validate(ctx, newCfg, currCfg)
 	if err != nil {
-		return errors.Errorf("validating updated model configuration: %w", err)
+		return nil, errors.Errorf("validating updated model configuration: %w", err)
 	}
 
 	// We need to walk through all of the updates and potentially find any
@@ -409,14 +451,9 @@ func (s *Service) UpdateModelConfig(
 
 	rawCfgUpdate, err := CoerceConfigForStorage(validatedUpdates)
 	if err != nil {
-		return errors.Errorf("coercing new configuration for persistence: %w", err)
-	}
-
-	err = s.st.UpdateModelConfig(ctx, rawCfgUpdate, removeAttrs)
-	if err != nil {
-		return errors.Errorf("updating model config: %w", err)
+		return nil, errors.Errorf("coercing new configuration for persistence: %w", err)
 	}
-	return nil
+	return rawCfgUpdate, nil
 }
 
 // GetModelConfigSchemaForCloudType returns the schema of the model config for
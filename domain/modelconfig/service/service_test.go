@@ -171,6 +171,40 @@ func (s *serviceSuite) TestUpdateModelConfigNoAgentStreamChange(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+// TestValidateModelConfigUpdate checks that the update is validated, along
+// with the additional validators, without being persisted.
+func (s *serviceSuite) TestValidateModelConfigUpdate(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockState.EXPECT().ModelConfig(gomock.Any()).Return(
+		map[string]string{
+			"name": "wallyworld",
+			"uuid": "a677bdfd-3c96-46b2-912f-38e25faceaf7",
+			"type": "testprovider",
+		}, nil,
+	)
+
+	s.mockModelConfigProvider.EXPECT().ConfigSchema().Return(schema.Fields{})
+
+	svc := NewService(noopDefaultsProvider(), config.ModelValidator(), s.modelConfigProviderFunc, s.mockState)
+	err := svc.ValidateModelConfigUpdate(
+		c.Context(),
+		map[string]any{
+			"logging-config": "<root>=INFO",
+		},
+		nil,
+		config.ValidatorFunc(func(_ context.Context, cfg, _ *config.Config) (*config.Config, error) {
+			return cfg, &config.ValidationError{
+				InvalidAttrs: []string{"logging-config"},
+				Reason:       "not today",
+			}
+		}),
+	)
+	var validationError *config.ValidationError
+	c.Assert(errors.As(err, &validationError), tc.IsTrue)
+	c.Check(validationError.Reason, tc.Equals, "not today")
+}
+
 func (s *serviceSuite) TestGetModelConfigSchema(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1149,7 +1149,10 @@ func (s *State) GetControllerModelInfo(
 		if info.AuthorizedKeys, err = s.getAuthorizedKeys(ctx, tx, modelUUID); err != nil {
 			return errors.Capture(err)
 		}
-		names := modelUserNames(info.ModelInfo, info.Permissions, info.AuthorizedKeys)
+		if info.GroupPermissions, err = s.getGroupPermissions(ctx, tx, modelUUID, offerUUIDs); err != nil {
+			return errors.Capture(err)
+		}
+		names := modelUserNames(info.ModelInfo, info.Permissions, info.GroupPermissions, info.AuthorizedKeys)
 		if info.Users, err = s.getUsers(ctx, tx, modelUUID, names); err != nil {
 			return errors.Capture(err)
 		}
@@ -1226,8 +1229,8 @@ WHERE  m.uuid = $modelUUIDArg.model_uuid
 	}, nil
 }
 
-// getPermissions reads the model permission grants and, when the model hosts
-// offers, the offer permission grants in the same statement.
+// getPermissions reads the model and application permission grants and, when
+// the model hosts offers, the offer permission grants in the same statement.
 func (s *State) getPermissions(
 	ctx context.Context, tx *sqlair.TX, modelUUID string, offerUUIDs []string,
 ) ([]coremodelmigration.ModelPermission, error) {
@@ -1249,6 +1252,7 @@ JOIN   permission_object_type AS pot ON pot.id = p.object_type_id
 JOIN   permission_access_type AS pat ON pat.id = p.access_type_id
 JOIN   user AS u ON u.uuid = p.grant_to
 WHERE  (pot.type = 'model' AND p.grant_on = $modelUUIDArg.model_uuid)
+OR     (pot.type = 'application' AND p.grant_on LIKE $modelUUIDArg.model_uuid || ':%')
 OR     (pot.type = 'offer' AND p.grant_on IN ($grantOnList[:]))
 `, mUUID, permissionRow{}, grantOnList{})
 		args = []any{mUUID, grantOnList(offerUUIDs)}
@@ -1262,7 +1266,8 @@ FROM   permission AS p
 JOIN   permission_object_type AS pot ON pot.id = p.object_type_id
 JOIN   permission_access_type AS pat ON pat.id = p.access_type_id
 JOIN   user AS u ON u.uuid = p.grant_to
-WHERE  pot.type = 'model' AND p.grant_on = $modelUUIDArg.model_uuid
+WHERE  (pot.type = 'model' AND p.grant_on = $modelUUIDArg.model_uuid)
+OR     (pot.type = 'application' AND p.grant_on LIKE $modelUUIDArg.model_uuid || ':%')
 `, mUUID, permissionRow{})
 		args = []any{mUUID}
 	}
@@ -1287,6 +1292,63 @@ WHERE  pot.type = 'model' AND p.grant_on = $modelUUIDArg.model_uuid
 	return perms, nil
 }
 
+// getGroupPermissions reads the model, application and offer permission
+// grants made to user groups, with each group carried by name together with
+// the name of its creator.
+func (s *State) getGroupPermissions(
+	ctx context.Context, tx *sqlair.TX, modelUUID string, offerUUIDs []string,
+) ([]coremodelmigration.ModelGroupPermission, error) {
+	mUUID := modelUUIDArg{ModelUUID: modelUUID}
+
+	query := `
+SELECT pot.type AS &groupPermissionRow.object_type,
+       p.grant_on AS &groupPermissionRow.grant_on,
+       g.name AS &groupPermissionRow.group_name,
+       u.name AS &groupPermissionRow.group_created_by,
+       pat.type AS &groupPermissionRow.access
+FROM   user_group_permission AS p
+JOIN   permission_object_type AS pot ON pot.id = p.object_type_id
+JOIN   permission_access_type AS pat ON pat.id = p.access_type_id
+JOIN   user_group AS g ON g.uuid = p.grant_to
+JOIN   user AS u ON u.uuid = g.created_by_uuid
+WHERE  (pot.type = 'model' AND p.grant_on = $modelUUIDArg.model_uuid)
+OR     (pot.type = 'application' AND p.grant_on LIKE $modelUUIDArg.model_uuid || ':%')
+`
+	var (
+		stmt *sqlair.Statement
+		err  error
+		args []any
+	)
+	if len(offerUUIDs) > 0 {
+		stmt, err = s.Prepare(query+`OR     (pot.type = 'offer' AND p.grant_on IN ($grantOnList[:]))
+`, mUUID, groupPermissionRow{}, grantOnList{})
+		args = []any{mUUID, grantOnList(offerUUIDs)}
+	} else {
+		stmt, err = s.Prepare(query, mUUID, groupPermissionRow{})
+		args = []any{mUUID}
+	}
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []groupPermissionRow
+	if err := getAll(ctx, tx, stmt, &rows, args...); err != nil {
+		return nil, errors.Errorf("querying model group permissions: %w", err)
+	}
+
+	perms := make([]coremodelmigration.ModelGroupPermission, 0, len(rows))
+	for _, p := range rows {
+		perms = append(perms, coremodelmigration.ModelGroupPermission{
+			ObjectType:     p.ObjectType,
+			GrantOn:        p.GrantOn,
+			GroupName:      p.GroupName,
+			GroupCreatedBy: p.GroupCreatedBy,
+			Access:         p.Access,
+		})
+	}
+	return perms, nil
+}
+
 // getModelCredential reads the model's cloud credential by natural key
 // together with its auth attributes, or nil when the model has no credential.
 func (s *State) getModelCredential(
@@ -1662,6 +1724,7 @@ WHERE  controller_uuid IN ($uuidList[:])
 func modelUserNames(
 	identity coremodelmigration.ModelIdentityInfo,
 	perms []coremodelmigration.ModelPermission,
+	groupPerms []coremodelmigration.ModelGroupPermission,
 	authKeys []coremodelmigration.ModelAuthorizedKey,
 ) []string {
 	seen := make(map[string]struct{})
@@ -1682,6 +1745,9 @@ func modelUserNames(
 	for _, p := range perms {
 		add(p.SubjectName)
 	}
+	for _, p := range groupPerms {
+		add(p.GroupCreatedBy)
+	}
 	for _, k := range authKeys {
 		add(k.Username)
 	}
@@ -2090,16 +2156,47 @@ WITH offer_uuids AS (
 DELETE FROM permission
 WHERE object_type_id = (SELECT id FROM permission_object_type WHERE type = 'offer')
 AND   grant_on IN (SELECT offer_uuid FROM offer_uuids)
+`, migrationUUIDArg{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteOfferGroupPermsStmt, err := s.Prepare(`
+WITH offer_uuids AS (
+    SELECT offer_uuid FROM model_migration_export_offer
+    WHERE migration_uuid = $migrationUUIDArg.migration_uuid
+)
+DELETE FROM user_group_permission
+WHERE object_type_id = (SELECT id FROM permission_object_type WHERE type = 'offer')
+AND   grant_on IN (SELECT offer_uuid FROM offer_uuids)
 `, migrationUUIDArg{})
 	if err != nil {
 		return errors.Capture(err)
 	}
 
-	// Delete model permission rows.
+	// Delete model permission rows, along with the permission rows of the
+	// applications in the model.
 	deleteModelPermsStmt, err := s.Prepare(`
 DELETE FROM permission
-WHERE object_type_id = (SELECT id FROM permission_object_type WHERE type = 'model')
-AND   grant_on = $modelUUIDArg.model_uuid
+WHERE (
+    object_type_id = (SELECT id FROM permission_object_type WHERE type = 'model')
+    AND grant_on = $modelUUIDArg.model_uuid
+) OR (
+    object_type_id = (SELECT id FROM permission_object_type WHERE type = 'application')
+    AND grant_on LIKE $modelUUIDArg.model_uuid || ':%'
+)
+`, modelUUIDArg{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteModelGroupPermsStmt, err := s.Prepare(`
+DELETE FROM user_group_permission
+WHERE (
+    object_type_id = (SELECT id FROM permission_object_type WHERE type = 'model')
+    AND grant_on = $modelUUIDArg.model_uuid
+) OR (
+    object_type_id = (SELECT id FROM permission_object_type WHERE type = 'application')
+    AND grant_on LIKE $modelUUIDArg.model_uuid || ':%'
+)
 `, modelUUIDArg{})
 	if err != nil {
 		return errors.Capture(err)
@@ -2284,14 +2381,20 @@ WHERE  migration_uuid = $migrationUUIDArg.migration_uuid
 			return errors.Errorf("reading export migration %q: %w", migrationUUID, err)
 		}
 
-		// 1. Delete offer permission rows.
+		// 1. Delete offer permission rows, for users and groups.
 		if err := tx.Query(ctx, deleteOfferPermsStmt, migArg).Run(); err != nil {
 			return errors.Errorf("deleting offer permissions for migration %q: %w", migrationUUID, err)
 		}
-		// 2. Delete model permission rows.
+		if err := tx.Query(ctx, deleteOfferGroupPermsStmt, migArg).Run(); err != nil {
+			return errors.Errorf("deleting offer group permissions for migration %q: %w", migrationUUID, err)
+		}
+		// 2. Delete model permission rows, for users and groups.
 		if err := tx.Query(ctx, deleteModelPermsStmt, modArg).Run(); err != nil {
 			return errors.Errorf("deleting model permissions for model %q: %w", modelUUID, err)
 		}
+		if err := tx.Query(ctx, deleteModelGroupPermsStmt, modArg).Run(); err != nil {
+			return errors.Errorf("deleting model group permissions for model %q: %w", modelUUID, err)
+		}
 		// 3. Delete lease_pin then lease.
 		if err := tx.Query(ctx, deleteLeasePinsStmt, modArg).Run(); err != nil {
 			return errors.Errorf("deleting lease pins for model %q: %w", modelUUID, err)
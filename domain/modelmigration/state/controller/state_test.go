@@ -1538,6 +1538,101 @@ func (s *stateSuite) TestGetControllerModelInfoFullSet(c *tc.C) {
 
 // TestGetControllerModelInfoExternalModelMissing verifies model DB offerer
 // selectors must be backed by source controller DB external_model rows.
+// TestGetControllerModelInfoIncludesApplicationPermissions verifies that the
+// permission grants on the applications of the model travel with it, and
+// those on the applications of other models do not.
+func (s *stateSuite) TestGetControllerModelInfoIncludesApplicationPermissions(c *tc.C) {
+	st := New(s.TxnRunnerFactory(), clock.WallClock)
+	db := s.DB()
+	modelUUID := s.modelUUID.String()
+	userUUID := s.userUUID.String()
+
+	appKey := modelUUID + ":" + uuid.MustNewUUID().String()
+	// Write access to an application of the model, and run-action on it.
+	_, err := db.ExecContext(c.Context(), `INSERT INTO permission (uuid, access_type_id, object_type_id, grant_on, grant_to)
+	      VALUES (?, 1, 4, ?, ?)`, uuid.MustNewUUID().String(), appKey, userUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = db.ExecContext(c.Context(), `INSERT INTO permission (uuid, access_type_id, object_type_id, grant_on, grant_to)
+	      VALUES (?, 7, 4, ?, ?)`, uuid.MustNewUUID().String(), appKey+":run-action", userUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	info, err := st.GetControllerModelInfo(c.Context(), modelUUID, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var appPerms []coremodelmigration.ModelPermission
+	for _, p := range info.Permissions {
+		if p.ObjectType == "application" {
+			appPerms = append(appPerms, p)
+		}
+	}
+	c.Check(appPerms, tc.SameContents, []coremodelmigration.ModelPermission{{
+		ObjectType:  "application",
+		GrantOn:     appKey,
+		SubjectName: "test-user",
+		Access:      "write",
+	}, {
+		ObjectType:  "application",
+		GrantOn:     appKey + ":run-action",
+		SubjectName: "test-user",
+		Access:      "run-action",
+	}})
+}
+
+// TestGetControllerModelInfoIncludesGroupPermissions verifies that the
+// permission grants made to user groups on the model, its applications and
+// its offers travel with it, together with the creator of each group.
+func (s *stateSuite) TestGetControllerModelInfoIncludesGroupPermissions(c *tc.C) {
+	st := New(s.TxnRunnerFactory(), clock.WallClock)
+	db := s.DB()
+	modelUUID := s.modelUUID.String()
+
+	groupUUID := uuid.MustNewUUID().String()
+	_, err := db.ExecContext(c.Context(), `INSERT INTO user_group (uuid, name, created_by_uuid, created_at)
+	      VALUES (?, 'ops', ?, datetime('now'))`, groupUUID, s.userUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	appKey := modelUUID + ":" + uuid.MustNewUUID().String()
+	offerUUID := uuid.MustNewUUID().String()
+	otherOfferUUID := uuid.MustNewUUID().String()
+	for _, grant := range []struct {
+		accessType, objectType int
+		grantOn                string
+	}{
+		{0, 2, modelUUID},
+		{7, 4, appKey + ":run-action"},
+		{2, 3, offerUUID},
+		{2, 3, otherOfferUUID},
+		{0, 2, uuid.MustNewUUID().String()},
+	} {
+		_, err = db.ExecContext(c.Context(), `INSERT INTO user_group_permission (uuid, access_type_id, object_type_id, grant_on, grant_to)
+	      VALUES (?, ?, ?, ?, ?)`, uuid.MustNewUUID().String(), grant.accessType, grant.objectType, grant.grantOn, groupUUID)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	info, err := st.GetControllerModelInfo(c.Context(), modelUUID, []string{offerUUID}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(info.GroupPermissions, tc.SameContents, []coremodelmigration.ModelGroupPermission{{
+		ObjectType:     "model",
+		GrantOn:        modelUUID,
+		GroupName:      "ops",
+		GroupCreatedBy: "test-user",
+		Access:         "read",
+	}, {
+		ObjectType:     "application",
+		GrantOn:        appKey + ":run-action",
+		GroupName:      "ops",
+		GroupCreatedBy: "test-user",
+		Access:         "run-action",
+	}, {
+		ObjectType:     "offer",
+		GrantOn:        offerUUID,
+		GroupName:      "ops",
+		GroupCreatedBy: "test-user",
+		Access:         "consume",
+	}})
+}
+
 func (s *stateSuite) TestGetControllerModelInfoExternalModelMissing(c *tc.C) {
 	st := New(s.TxnRunnerFactory(), clock.WallClock)
 	db := s.DB()
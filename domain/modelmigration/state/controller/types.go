@@ -178,6 +178,16 @@ type permissionRow struct {
 	Access      string `db:"access"`
 }
 
+// groupPermissionRow is a single model, application or offer permission grant
+// to a user group, with the group and its creator resolved to their names.
+type groupPermissionRow struct {
+	ObjectType     string `db:"object_type"`
+	GrantOn        string `db:"grant_on"`
+	GroupName      string `db:"group_name"`
+	GroupCreatedBy string `db:"group_created_by"`
+	Access         string `db:"access"`
+}
+
 // userRow is the non-authentication profile of a user, with the user's
 // last login against the model joined in (null when never logged in).
 type userRow struct {
@@ -17,9 +17,12 @@
 //   - execute operations on specific targets (applications, machines or units)
 //   - query the status of operations in batch or through filters
 //   - manage operations (cancel, prune)
+//   - schedule actions to run repeatedly, on a cron-style schedule
 //
 // The operation domain is consumed by client facades and worker such as:
 //   - apiserver/facades/client/action to list, query, and manage operations.
 //   - internal/worker/uniter to execute tasks on units.
 //   - internal/worker/machineactions to execute tasks on machines.
+//   - internal/worker/operationscheduler to run scheduled actions when they
+//     fall due.
 package operation
@@ -20,6 +20,14 @@ const (
 	// TaskNotPending describes an error that occurs when a pending task
 	// is queried and does not have a pending status.
 	TaskNotPending = errors.ConstError("task not pending")
+
+	// ScheduleNotFound describes an error that occurs when the given
+	// schedule does not exist.
+	ScheduleNotFound = errors.ConstError("schedule not found")
+
+	// ScheduleAlreadyExists describes an error that occurs when a schedule
+	// with the given name already exists.
+	ScheduleAlreadyExists = errors.ConstError("schedule already exists")
 )
 
 // ActionNotDefined describes an error that occurs when the given charm does
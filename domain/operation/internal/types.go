@@ -10,6 +10,8 @@ import (
 	coreoperation "github.com/juju/juju/core/operation"
 	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/operation"
 )
 
 // TaskLogMessage is a timestamped message logged for a task.
@@ -91,3 +93,17 @@ type ReceiversWithResolvedLeaders struct {
 	Units        []unit.Name
 	LeaderUnits  []unit.Name
 }
+
+// DueSchedule is a scheduled action which has fallen due to run.
+type DueSchedule struct {
+	UUID      string
+	Name      string
+	Schedule  string
+	Receivers []operation.ActionReceiver
+	Task      operation.TaskArgs
+	CreatedBy user.Name
+
+	// CreatorGroups are the user groups the identity provider reported the
+	// creator to be a member of when the schedule was added.
+	CreatorGroups []string
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/operation/service (interfaces: State,LeadershipService)
+// Source: github.com/juju/juju/domain/operation/service (interfaces: State,LeadershipService,ScheduleAuthorizer)
 //
 // Generated by this command:
 //
-//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/operation/service State,LeadershipService
+//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/operation/service State,LeadershipService,ScheduleAuthorizer
 //
 
 // Package service is a generated GoMock package.
@@ -16,6 +16,7 @@ import (
 	gomock "github.com/canonical/gomock/gomock"
 	machine "github.com/juju/juju/core/machine"
 	unit "github.com/juju/juju/core/unit"
+	user "github.com/juju/juju/core/user"
 	operation "github.com/juju/juju/domain/operation"
 	internal "github.com/juju/juju/domain/operation/internal"
 	uuid "github.com/juju/juju/internal/uuid"
@@ -34,10 +35,12 @@ type MockStateMockRecorder struct {
 	addActionOperationExpects                      []*gomock.Call4_2[context.Context, uuid.UUID, []unit.Name, operation.TaskArgs, operation.RunResult, error]
 	addExecOperationExpects                        []*gomock.Call4_2[context.Context, uuid.UUID, internal.ReceiversWithResolvedLeaders, operation.ExecArgs, operation.RunResult, error]
 	addExecOperationOnAllMachinesExpects           []*gomock.Call3_2[context.Context, uuid.UUID, operation.ExecArgs, operation.RunResult, error]
+	addScheduleExpects                             []*gomock.Call4_1[context.Context, string, operation.ScheduleArgs, time.Time, error]
 	cancelTaskExpects                              []*gomock.Call2_2[context.Context, string, operation.Task, error]
 	filterTaskUUIDsForMachineExpects               []*gomock.Call3_2[context.Context, []string, string, []string, error]
 	filterTaskUUIDsForUnitExpects                  []*gomock.Call3_2[context.Context, []string, string, []string, error]
 	finishTaskExpects                              []*gomock.Call2_1[context.Context, internal.CompletedTask, error]
+	getDueSchedulesExpects                         []*gomock.Call2_2[context.Context, time.Time, []internal.DueSchedule, error]
 	getIDsForAbortingTaskOfReceiverExpects         []*gomock.Call2_2[context.Context, uuid.UUID, []string, error]
 	getLatestTaskLogsByUUIDExpects                 []*gomock.Call3_3[context.Context, string, time.Time, []internal.TaskLogMessage, time.Time, error]
 	getMachineTaskIDsWithStatusExpects             []*gomock.Call3_2[context.Context, string, string, []string, error]
@@ -45,7 +48,9 @@ type MockStateMockRecorder struct {
 	getOperationByIDExpects                        []*gomock.Call2_2[context.Context, uint64, operation.OperationInfo, error]
 	getOperationsExpects                           []*gomock.Call2_2[context.Context, operation.QueryArgs, operation.QueryResult, error]
 	getReceiverFromTaskIDExpects                   []*gomock.Call2_2[context.Context, string, string, error]
+	getSchedulesExpects                            []*gomock.Call1_2[context.Context, []operation.Schedule, error]
 	getTaskExpects                                 []*gomock.Call2_3[context.Context, string, operation.Task, *string, error]
+	getTaskCountsByStatusExpects                   []*gomock.Call1_2[context.Context, map[string]int, error]
 	getTaskIDsByUUIDsFilteredByReceiverUUIDExpects []*gomock.Call3_2[context.Context, uuid.UUID, []string, []string, error]
 	getTaskStatusByIDExpects                       []*gomock.Call2_2[context.Context, string, string, error]
 	getTaskUUIDByIDExpects                         []*gomock.Call2_2[context.Context, string, string, error]
@@ -57,6 +62,8 @@ type MockStateMockRecorder struct {
 	namespaceForTaskAbortingWatcherExpects         []*gomock.Call0_1[string]
 	namespaceForTaskLogWatcherExpects              []*gomock.Call0_1[string]
 	pruneOperationsExpects                         []*gomock.Call3_2[context.Context, time.Duration, int, []string, error]
+	recordScheduleRunExpects                       []*gomock.Call6_1[context.Context, string, string, user.Name, time.Time, time.Time, error]
+	removeScheduleExpects                          []*gomock.Call2_1[context.Context, string, error]
 	startTaskExpects                               []*gomock.Call2_1[context.Context, string, error]
 }
 
@@ -126,6 +133,24 @@ func (mr *MockStateMockRecorder) AddExecOperationOnAllMachines(ctx, operationUUI
 // MockStateAddExecOperationOnAllMachinesCall is the typed call wrapper for AddExecOperationOnAllMachines.
 type MockStateAddExecOperationOnAllMachinesCall = gomock.Call3_2[context.Context, uuid.UUID, operation.ExecArgs, operation.RunResult, error]
 
+// AddSchedule mocks base method.
+func (m *MockState) AddSchedule(ctx context.Context, arg1 string, args operation.ScheduleArgs, nextRun time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.addScheduleExpects, m.ctrl, m, "AddSchedule", ctx, arg1, args, nextRun)
+}
+
+// AddSchedule indicates an expected call of AddSchedule.
+func (mr *MockStateMockRecorder) AddSchedule(ctx, arg1, args, nextRun any) *MockStateAddScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, string, operation.ScheduleArgs, time.Time, error](mr.mock.ctrl.T, mr.mock, "AddSchedule", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(args), gomock.EnsureMatcher(nextRun))
+	mr.addScheduleExpects = append(mr.addScheduleExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateAddScheduleCall is the typed call wrapper for AddSchedule.
+type MockStateAddScheduleCall = gomock.Call4_1[context.Context, string, operation.ScheduleArgs, time.Time, error]
+
 // CancelTask mocks base method.
 func (m *MockState) CancelTask(ctx context.Context, taskID string) (operation.Task, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +223,24 @@ func (mr *MockStateMockRecorder) FinishTask(arg0, arg1 any) *MockStateFinishTask
 // MockStateFinishTaskCall is the typed call wrapper for FinishTask.
 type MockStateFinishTaskCall = gomock.Call2_1[context.Context, internal.CompletedTask, error]
 
+// GetDueSchedules mocks base method.
+func (m *MockState) GetDueSchedules(ctx context.Context, now time.Time) ([]internal.DueSchedule, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getDueSchedulesExpects, m.ctrl, m, "GetDueSchedules", ctx, now)
+}
+
+// GetDueSchedules indicates an expected call of GetDueSchedules.
+func (mr *MockStateMockRecorder) GetDueSchedules(ctx, now any) *MockStateGetDueSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, time.Time, []internal.DueSchedule, error](mr.mock.ctrl.T, mr.mock, "GetDueSchedules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(now))
+	mr.getDueSchedulesExpects = append(mr.getDueSchedulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetDueSchedulesCall is the typed call wrapper for GetDueSchedules.
+type MockStateGetDueSchedulesCall = gomock.Call2_2[context.Context, time.Time, []internal.DueSchedule, error]
+
 // GetIDsForAbortingTaskOfReceiver mocks base method.
 func (m *MockState) GetIDsForAbortingTaskOfReceiver(ctx context.Context, receiverUUID uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +367,24 @@ func (mr *MockStateMockRecorder) GetReceiverFromTaskID(ctx, taskID any) *MockSta
 // MockStateGetReceiverFromTaskIDCall is the typed call wrapper for GetReceiverFromTaskID.
 type MockStateGetReceiverFromTaskIDCall = gomock.Call2_2[context.Context, string, string, error]
 
+// GetSchedules mocks base method.
+func (m *MockState) GetSchedules(ctx context.Context) ([]operation.Schedule, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getSchedulesExpects, m.ctrl, m, "GetSchedules", ctx)
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *MockStateMockRecorder) GetSchedules(ctx any) *MockStateGetSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []operation.Schedule, error](mr.mock.ctrl.T, mr.mock, "GetSchedules", gomock.EnsureMatcher(ctx))
+	mr.getSchedulesExpects = append(mr.getSchedulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetSchedulesCall is the typed call wrapper for GetSchedules.
+type MockStateGetSchedulesCall = gomock.Call1_2[context.Context, []operation.Schedule, error]
+
 // GetTask mocks base method.
 func (m *MockState) GetTask(ctx context.Context, taskID string) (operation.Task, *string, error) {
 	m.ctrl.T.Helper()
@@ -342,6 +403,24 @@ func (mr *MockStateMockRecorder) GetTask(ctx, taskID any) *MockStateGetTaskCall
 // MockStateGetTaskCall is the typed call wrapper for GetTask.
 type MockStateGetTaskCall = gomock.Call2_3[context.Context, string, operation.Task, *string, error]
 
+// GetTaskCountsByStatus mocks base method.
+func (m *MockState) GetTaskCountsByStatus(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getTaskCountsByStatusExpects, m.ctrl, m, "GetTaskCountsByStatus", ctx)
+}
+
+// GetTaskCountsByStatus indicates an expected call of GetTaskCountsByStatus.
+func (mr *MockStateMockRecorder) GetTaskCountsByStatus(ctx any) *MockStateGetTaskCountsByStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]int, error](mr.mock.ctrl.T, mr.mock, "GetTaskCountsByStatus", gomock.EnsureMatcher(ctx))
+	mr.getTaskCountsByStatusExpects = append(mr.getTaskCountsByStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetTaskCountsByStatusCall is the typed call wrapper for GetTaskCountsByStatus.
+type MockStateGetTaskCountsByStatusCall = gomock.Call1_2[context.Context, map[string]int, error]
+
 // GetTaskIDsByUUIDsFilteredByReceiverUUID mocks base method.
 func (m *MockState) GetTaskIDsByUUIDsFilteredByReceiverUUID(ctx context.Context, receiverUUID uuid.UUID, taskUUIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -540,6 +619,42 @@ func (mr *MockStateMockRecorder) PruneOperations(ctx, maxAge, maxSizeMB any) *Mo
 // MockStatePruneOperationsCall is the typed call wrapper for PruneOperations.
 type MockStatePruneOperationsCall = gomock.Call3_2[context.Context, time.Duration, int, []string, error]
 
+// RecordScheduleRun mocks base method.
+func (m *MockState) RecordScheduleRun(ctx context.Context, scheduleUUID, operationID string, runBy user.Name, ranAt, nextRun time.Time) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch6_1(&m.recorder.recordScheduleRunExpects, m.ctrl, m, "RecordScheduleRun", ctx, scheduleUUID, operationID, runBy, ranAt, nextRun)
+}
+
+// RecordScheduleRun indicates an expected call of RecordScheduleRun.
+func (mr *MockStateMockRecorder) RecordScheduleRun(ctx, scheduleUUID, operationID, runBy, ranAt, nextRun any) *MockStateRecordScheduleRunCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall6_1[context.Context, string, string, user.Name, time.Time, time.Time, error](mr.mock.ctrl.T, mr.mock, "RecordScheduleRun", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(scheduleUUID), gomock.EnsureMatcher(operationID), gomock.EnsureMatcher(runBy), gomock.EnsureMatcher(ranAt), gomock.EnsureMatcher(nextRun))
+	mr.recordScheduleRunExpects = append(mr.recordScheduleRunExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRecordScheduleRunCall is the typed call wrapper for RecordScheduleRun.
+type MockStateRecordScheduleRunCall = gomock.Call6_1[context.Context, string, string, user.Name, time.Time, time.Time, error]
+
+// RemoveSchedule mocks base method.
+func (m *MockState) RemoveSchedule(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.removeScheduleExpects, m.ctrl, m, "RemoveSchedule", ctx, name)
+}
+
+// RemoveSchedule indicates an expected call of RemoveSchedule.
+func (mr *MockStateMockRecorder) RemoveSchedule(ctx, name any) *MockStateRemoveScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "RemoveSchedule", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.removeScheduleExpects = append(mr.removeScheduleExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateRemoveScheduleCall is the typed call wrapper for RemoveSchedule.
+type MockStateRemoveScheduleCall = gomock.Call2_1[context.Context, string, error]
+
 // StartTask mocks base method.
 func (m *MockState) StartTask(ctx context.Context, taskID string) error {
 	m.ctrl.T.Helper()
@@ -600,3 +715,46 @@ func (mr *MockLeadershipServiceMockRecorder) ApplicationLeader(appName any) *Moc
 
 // MockLeadershipServiceApplicationLeaderCall is the typed call wrapper for ApplicationLeader.
 type MockLeadershipServiceApplicationLeaderCall = gomock.Call1_2[string, string, error]
+
+// MockScheduleAuthorizer is a mock of ScheduleAuthorizer interface.
+type MockScheduleAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleAuthorizerMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleAuthorizerMockRecorder is the mock recorder for MockScheduleAuthorizer.
+type MockScheduleAuthorizerMockRecorder struct {
+	mock                 *MockScheduleAuthorizer
+	canRunActionsExpects []*gomock.Call3_2[context.Context, user.Name, []string, bool, error]
+}
+
+// NewMockScheduleAuthorizer creates a new mock instance.
+func NewMockScheduleAuthorizer(ctrl *gomock.Controller) *MockScheduleAuthorizer {
+	mock := &MockScheduleAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockScheduleAuthorizerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleAuthorizer) EXPECT() *MockScheduleAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// CanRunActions mocks base method.
+func (m *MockScheduleAuthorizer) CanRunActions(ctx context.Context, name user.Name, groups []string) (bool, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.canRunActionsExpects, m.ctrl, m, "CanRunActions", ctx, name, groups)
+}
+
+// CanRunActions indicates an expected call of CanRunActions.
+func (mr *MockScheduleAuthorizerMockRecorder) CanRunActions(ctx, name, groups any) *MockScheduleAuthorizerCanRunActionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, user.Name, []string, bool, error](mr.mock.ctrl.T, mr.mock, "CanRunActions", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(groups))
+	mr.canRunActionsExpects = append(mr.canRunActionsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockScheduleAuthorizerCanRunActionsCall is the typed call wrapper for CanRunActions.
+type MockScheduleAuthorizerCanRunActionsCall = gomock.Call3_2[context.Context, user.Name, []string, bool, error]
@@ -3,5 +3,5 @@
 
 package service
 
-//go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/operation/service State,LeadershipService
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/operation/service State,LeadershipService,ScheduleAuthorizer
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ModelObjectStoreGetter,ObjectStore
@@ -149,3 +149,21 @@ func (s *Service) GetMachineTaskIDsWithStatus(ctx context.Context,
 
 	return ids, nil
 }
+
+// GetTaskCountsByStatus returns the number of tasks in the model with each
+// status. Statuses without any tasks are omitted.
+func (s *Service) GetTaskCountsByStatus(ctx context.Context) (map[corestatus.Status]int, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	counts, err := s.st.GetTaskCountsByStatus(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make(map[corestatus.Status]int, len(counts))
+	for status, count := range counts {
+		result[corestatus.Status(status)] = count
+	}
+	return result, nil
+}
@@ -103,6 +103,42 @@ func (s *querySuite) TestGetMachineTaskIDsWithStatusStateError(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, stateErr)
 }
 
+// TestGetTaskCountsByStatus verifies that the task counts from state are
+// returned keyed by status.
+func (s *querySuite) TestGetTaskCountsByStatus(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	s.state.EXPECT().GetTaskCountsByStatus(gomock.Any()).Return(map[string]int{
+		"pending": 3,
+		"running": 1,
+	}, nil)
+
+	// Act
+	counts, err := s.service(c).GetTaskCountsByStatus(c.Context())
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(counts, tc.DeepEquals, map[corestatus.Status]int{
+		corestatus.Pending: 3,
+		corestatus.Running: 1,
+	})
+}
+
+// TestGetTaskCountsByStatusStateError ensures that state errors are returned.
+func (s *querySuite) TestGetTaskCountsByStatusStateError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	s.state.EXPECT().GetTaskCountsByStatus(gomock.Any()).Return(nil, errors.New("boom"))
+
+	// Act
+	_, err := s.service(c).GetTaskCountsByStatus(c.Context())
+
+	// Assert
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
 func (s *querySuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.state = NewMockState(ctrl)
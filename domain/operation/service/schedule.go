@@ -0,0 +1,168 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/operation"
+	"github.com/juju/juju/internal/cron"
+	"github.com/juju/juju/internal/errors"
+	internaluuid "github.com/juju/juju/internal/uuid"
+)
+
+// AddSchedule adds a schedule to run an action repeatedly, whenever the
+// given cron expression falls due. The receivers must all belong to the same
+// application. The action is run on behalf of the user creating the schedule.
+//
+// The following errors may be returned:
+//   - [coreerrors.NotValid] if the arguments are not valid.
+//   - [operationerrors.ScheduleAlreadyExists] if a schedule with the same name
+//     already exists.
+//   - [operationerrors.ActionNotDefined] if the charm does not define the
+//     action.
+//   - [applicationerrors.ApplicationNotFound] if the application does not
+//     exist.
+func (s *Service) AddSchedule(ctx context.Context, args operation.ScheduleArgs) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if args.Name == "" {
+		return errors.Errorf("schedule name is empty").Add(coreerrors.NotValid)
+	}
+	if args.Task.ActionName == "" {
+		return errors.Errorf("action name is empty").Add(coreerrors.NotValid)
+	}
+	if args.CreatedBy.IsZero() {
+		return errors.Errorf("schedule creator is empty").Add(coreerrors.NotValid)
+	}
+	schedule, err := cron.Parse(args.Schedule)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(args.Receivers) == 0 {
+		return errors.Errorf("no receivers provided").Add(coreerrors.NotValid)
+	}
+	var appName string
+	for _, r := range args.Receivers {
+		if err := r.Validate(); err != nil {
+			return errors.Errorf("validating action receiver %v: %w", r, err).Add(coreerrors.NotValid)
+		}
+		name := r.LeaderUnit
+		if r.Unit != "" {
+			name = r.Unit.Application()
+		}
+		if appName == "" {
+			appName = name
+		} else if name != appName {
+			return errors.Errorf(
+				"receivers must belong to the same application, got %q and %q", appName, name,
+			).Add(coreerrors.NotValid)
+		}
+	}
+
+	uuid, err := internaluuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("generating schedule UUID: %w", err)
+	}
+
+	nextRun := schedule.Next(s.clock.Now().UTC())
+	if err := s.st.AddSchedule(ctx, uuid.String(), args, nextRun); err != nil {
+		return errors.Errorf("adding schedule %q: %w", args.Name, err)
+	}
+	return nil
+}
+
+// GetSchedules returns all the schedules in the model, ordered by name.
+func (s *Service) GetSchedules(ctx context.Context) ([]operation.Schedule, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	schedules, err := s.st.GetSchedules(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return schedules, nil
+}
+
+// RemoveSchedule removes the named schedule. Operations already added by the
+// schedule are kept.
+//
+// The following errors may be returned:
+//   - [operationerrors.ScheduleNotFound] if the schedule does not exist.
+func (s *Service) RemoveSchedule(ctx context.Context, name string) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.st.RemoveSchedule(ctx, name); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// RunDueSchedules adds an action operation for each schedule which has fallen
+// due, and records when the schedule next falls due. Runs missed while the
+// schedule could not be run, for example while the controller was down, are
+// not caught up: a schedule runs at most once per call.
+//
+// Each action is run on behalf of the user who created the schedule, and only
+// if the authorizer reports that they may still run actions in the model. A
+// schedule whose creator no longer has access, or whose action cannot be
+// started, is still considered to have run, so that it does not retry until
+// it next falls due. The failure is logged.
+func (s *Service) RunDueSchedules(ctx context.Context, authorizer ScheduleAuthorizer) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	now := s.clock.Now().UTC()
+	due, err := s.st.GetDueSchedules(ctx, now)
+	if err != nil {
+		return errors.Errorf("getting due schedules: %w", err)
+	}
+
+	var errs []error
+	for _, d := range due {
+		// A zero next run time stops the schedule from running again.
+		var nextRun time.Time
+		if schedule, err := cron.Parse(d.Schedule); err != nil {
+			// This should never happen, as the expression was validated
+			// when the schedule was added.
+			s.logger.Errorf(ctx, "schedule %q has an invalid expression: %v", d.Name, err)
+		} else {
+			nextRun = schedule.Next(now)
+		}
+
+		allowed, err := authorizer.CanRunActions(ctx, d.CreatedBy, d.CreatorGroups)
+		if err != nil {
+			// The schedule is left due, so that the check is retried.
+			errs = append(errs, errors.Errorf("checking access of %q for schedule %q: %w", d.CreatedBy, d.Name, err))
+			continue
+		}
+
+		var operationID string
+		if !allowed {
+			s.logger.Warningf(ctx, "not running action %q for schedule %q: %q no longer has access to run actions",
+				d.Task.ActionName, d.Name, d.CreatedBy)
+		} else if result, err := s.AddActionOperation(ctx, d.Receivers, d.Task); err != nil {
+			s.logger.Warningf(ctx, "running action %q for schedule %q: %v", d.Task.ActionName, d.Name, err)
+		} else {
+			operationID = result.OperationID
+			for _, u := range result.Units {
+				if u.Error != nil {
+					s.logger.Warningf(ctx, "running action %q on unit %q for schedule %q: %v",
+						d.Task.ActionName, u.ReceiverName, d.Name, u.Error)
+				}
+			}
+			s.logger.Infof(ctx, "schedule %q added operation %s", d.Name, operationID)
+		}
+
+		if err := s.st.RecordScheduleRun(ctx, d.UUID, operationID, d.CreatedBy, now, nextRun); err != nil {
+			errs = append(errs, errors.Errorf("recording run of schedule %q: %w", d.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
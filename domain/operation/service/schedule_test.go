@@ -0,0 +1,239 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/unit"
+	coreuser "github.com/juju/juju/core/user"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/operation"
+	operationerrors "github.com/juju/juju/domain/operation/errors"
+	"github.com/juju/juju/domain/operation/internal"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type scheduleSuite struct {
+	state                 *MockState
+	clock                 *testclock.Clock
+	mockLeadershipService *MockLeadershipService
+	authorizer            *MockScheduleAuthorizer
+}
+
+func TestScheduleSuite(t *testing.T) {
+	tc.Run(t, &scheduleSuite{})
+}
+
+func (s *scheduleSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2026, 3, 4, 10, 15, 0, 0, time.UTC))
+	s.mockLeadershipService = NewMockLeadershipService(ctrl)
+	s.authorizer = NewMockScheduleAuthorizer(ctrl)
+	return ctrl
+}
+
+func (s *scheduleSuite) service(c *tc.C) *Service {
+	return NewService(s.state, s.clock, loggertesting.WrapCheckLog(c), nil, s.mockLeadershipService)
+}
+
+func (s *scheduleSuite) TestAddSchedule(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := operation.ScheduleArgs{
+		Name:     "nightly",
+		Schedule: "0 2 * * *",
+		Receivers: []operation.ActionReceiver{
+			{Unit: "app/0"},
+			{LeaderUnit: "app"},
+		},
+		Task:      operation.TaskArgs{ActionName: "backup"},
+		CreatedBy: usertesting.GenNewName(c, "fred"),
+	}
+	s.state.EXPECT().AddSchedule(gomock.Any(), gomock.Any(), args,
+		time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)).Return(nil)
+
+	err := s.service(c).AddSchedule(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *scheduleSuite) TestAddScheduleInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	valid := operation.ScheduleArgs{
+		Name:      "nightly",
+		Schedule:  "0 2 * * *",
+		Receivers: []operation.ActionReceiver{{Unit: "app/0"}},
+		Task:      operation.TaskArgs{ActionName: "backup"},
+		CreatedBy: usertesting.GenNewName(c, "fred"),
+	}
+	tests := []struct {
+		about  string
+		modify func(*operation.ScheduleArgs)
+		err    string
+	}{{
+		about:  "no name",
+		modify: func(a *operation.ScheduleArgs) { a.Name = "" },
+		err:    "schedule name is empty",
+	}, {
+		about:  "no action",
+		modify: func(a *operation.ScheduleArgs) { a.Task.ActionName = "" },
+		err:    "action name is empty",
+	}, {
+		about:  "no creator",
+		modify: func(a *operation.ScheduleArgs) { a.CreatedBy = coreuser.Name{} },
+		err:    "schedule creator is empty",
+	}, {
+		about:  "bad expression",
+		modify: func(a *operation.ScheduleArgs) { a.Schedule = "0 25 * * *" },
+		err:    "cron expression: value 25 out of range 0-23 in hour field",
+	}, {
+		about:  "no receivers",
+		modify: func(a *operation.ScheduleArgs) { a.Receivers = nil },
+		err:    "no receivers provided",
+	}, {
+		about: "mixed applications",
+		modify: func(a *operation.ScheduleArgs) {
+			a.Receivers = append(a.Receivers, operation.ActionReceiver{LeaderUnit: "other"})
+		},
+		err: `receivers must belong to the same application, got "app" and "other"`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.about)
+		args := valid
+		args.Receivers = append([]operation.ActionReceiver(nil), valid.Receivers...)
+		test.modify(&args)
+		err := s.service(c).AddSchedule(c.Context(), args)
+		c.Check(err, tc.ErrorMatches, test.err)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	}
+}
+
+func (s *scheduleSuite) TestRemoveScheduleNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveSchedule(gomock.Any(), "nightly").Return(
+		errors.Errorf("schedule %q", "nightly").Add(operationerrors.ScheduleNotFound))
+
+	err := s.service(c).RemoveSchedule(c.Context(), "nightly")
+	c.Check(err, tc.ErrorIs, operationerrors.ScheduleNotFound)
+}
+
+func (s *scheduleSuite) TestRunDueSchedules(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	task := operation.TaskArgs{
+		ActionName: "backup",
+		Parameters: map[string]any{"target": "s3"},
+	}
+	s.state.EXPECT().GetDueSchedules(gomock.Any(), now).Return([]internal.DueSchedule{{
+		UUID:          "schedule-uuid",
+		Name:          "nightly",
+		Schedule:      "0 2 * * *",
+		Receivers:     []operation.ActionReceiver{{LeaderUnit: "app"}},
+		Task:          task,
+		CreatedBy:     usertesting.GenNewName(c, "fred"),
+		CreatorGroups: []string{"ops"},
+	}}, nil)
+	s.authorizer.EXPECT().CanRunActions(gomock.Any(), usertesting.GenNewName(c, "fred"), []string{"ops"}).Return(true, nil)
+	s.mockLeadershipService.EXPECT().ApplicationLeader("app").Return("app/1", nil)
+	s.state.EXPECT().AddActionOperation(gomock.Any(), gomock.Any(), []unit.Name{"app/1"}, task).Return(
+		operation.RunResult{
+			OperationID: "42",
+			Units: []operation.UnitTaskResult{{
+				ReceiverName: "app/1",
+				TaskInfo:     operation.TaskInfo{ID: "43"},
+			}},
+		}, nil)
+	s.state.EXPECT().RecordScheduleRun(gomock.Any(), "schedule-uuid", "42", usertesting.GenNewName(c, "fred"), now,
+		time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)).Return(nil)
+
+	err := s.service(c).RunDueSchedules(c.Context(), s.authorizer)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *scheduleSuite) TestRunDueSchedulesActionFails(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	task := operation.TaskArgs{ActionName: "backup"}
+	s.state.EXPECT().GetDueSchedules(gomock.Any(), now).Return([]internal.DueSchedule{{
+		UUID:      "schedule-uuid",
+		Name:      "hourly",
+		Schedule:  "@hourly",
+		Receivers: []operation.ActionReceiver{{Unit: "app/0"}},
+		Task:      task,
+		CreatedBy: usertesting.GenNewName(c, "fred"),
+	}}, nil)
+	s.authorizer.EXPECT().CanRunActions(gomock.Any(), usertesting.GenNewName(c, "fred"), nil).Return(true, nil)
+	s.state.EXPECT().AddActionOperation(gomock.Any(), gomock.Any(), []unit.Name{"app/0"}, task).Return(
+		operation.RunResult{}, errors.New("boom"))
+	// The schedule is still recorded as having run, so that it next runs
+	// when it falls due again.
+	s.state.EXPECT().RecordScheduleRun(gomock.Any(), "schedule-uuid", "", usertesting.GenNewName(c, "fred"), now,
+		time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC)).Return(nil)
+
+	err := s.service(c).RunDueSchedules(c.Context(), s.authorizer)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *scheduleSuite) TestRunDueSchedulesCreatorAccessRevoked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	s.state.EXPECT().GetDueSchedules(gomock.Any(), now).Return([]internal.DueSchedule{{
+		UUID:      "schedule-uuid",
+		Name:      "hourly",
+		Schedule:  "@hourly",
+		Receivers: []operation.ActionReceiver{{Unit: "app/0"}},
+		Task:      operation.TaskArgs{ActionName: "backup"},
+		CreatedBy: usertesting.GenNewName(c, "fred"),
+	}}, nil)
+	s.authorizer.EXPECT().CanRunActions(gomock.Any(), usertesting.GenNewName(c, "fred"), nil).Return(false, nil)
+	// No operation is added, but the schedule moves on to its next run, so
+	// that it runs again if the creator's access is restored.
+	s.state.EXPECT().RecordScheduleRun(gomock.Any(), "schedule-uuid", "", usertesting.GenNewName(c, "fred"), now,
+		time.Date(2026, 3, 4, 11, 0, 0, 0, time.UTC)).Return(nil)
+
+	err := s.service(c).RunDueSchedules(c.Context(), s.authorizer)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *scheduleSuite) TestRunDueSchedulesCreatorAccessError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	s.state.EXPECT().GetDueSchedules(gomock.Any(), now).Return([]internal.DueSchedule{{
+		UUID:      "schedule-uuid",
+		Name:      "hourly",
+		Schedule:  "@hourly",
+		Receivers: []operation.ActionReceiver{{Unit: "app/0"}},
+		Task:      operation.TaskArgs{ActionName: "backup"},
+		CreatedBy: usertesting.GenNewName(c, "fred"),
+	}}, nil)
+	s.authorizer.EXPECT().CanRunActions(gomock.Any(), usertesting.GenNewName(c, "fred"), nil).Return(false, errors.New("boom"))
+
+	// The run is not recorded, so the schedule is still due when the check
+	// is retried.
+	err := s.service(c).RunDueSchedules(c.Context(), s.authorizer)
+	c.Assert(err, tc.ErrorMatches, `checking access of "fred" for schedule "hourly": boom`)
+}
+
+func (s *scheduleSuite) TestRunDueSchedulesNone(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetDueSchedules(gomock.Any(), s.clock.Now()).Return(nil, nil)
+
+	err := s.service(c).RunDueSchedules(c.Context(), s.authorizer)
+	c.Assert(err, tc.ErrorIsNil)
+}
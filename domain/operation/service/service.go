@@ -13,6 +13,7 @@ import (
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/objectstore"
 	coreunit "github.com/juju/juju/core/unit"
+	coreuser "github.com/juju/juju/core/user"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/operation"
@@ -72,6 +73,10 @@ type State interface {
 	GetMachineTaskIDsWithStatus(ctx context.Context, machineName string, statusFilter string) ([]string,
 		error)
 
+	// GetTaskCountsByStatus returns the number of tasks in the model with
+	// each status, keyed by status.
+	GetTaskCountsByStatus(ctx context.Context) (map[string]int, error)
+
 	// GetTaskIDsByUUIDsFilteredByReceiverUUID returns task IDs of the tasks
 	// provided having the given receiverUUID.
 	GetTaskIDsByUUIDsFilteredByReceiverUUID(
@@ -144,6 +149,25 @@ type State interface {
 
 	// InsertMigratingOperations inserts a new operation and its tasks.
 	InsertMigratingOperations(ctx context.Context, args internal.ImportOperationsArgs) error
+
+	// AddSchedule adds a schedule to run an action repeatedly, next falling
+	// due at nextRun.
+	AddSchedule(ctx context.Context, uuid string, args operation.ScheduleArgs, nextRun time.Time) error
+
+	// GetSchedules returns all the schedules in the model, ordered by name.
+	GetSchedules(ctx context.Context) ([]operation.Schedule, error)
+
+	// RemoveSchedule removes the named schedule.
+	RemoveSchedule(ctx context.Context, name string) error
+
+	// GetDueSchedules returns the schedules which are due to run at the
+	// given time.
+	GetDueSchedules(ctx context.Context, now time.Time) ([]internal.DueSchedule, error)
+
+	// RecordScheduleRun records that the schedule ran at the given time, and
+	// when it next falls due, linking it to the operation added on behalf of
+	// runBy, if any.
+	RecordScheduleRun(ctx context.Context, scheduleUUID string, operationID string, runBy coreuser.Name, ranAt, nextRun time.Time) error
 }
 
 // LeadershipService describes the methods for managing (application)
@@ -153,6 +177,15 @@ type LeadershipService interface {
 	ApplicationLeader(appName string) (string, error)
 }
 
+// ScheduleAuthorizer checks that the user who created a schedule may still
+// run actions in the model.
+type ScheduleAuthorizer interface {
+	// CanRunActions reports whether the user has permission to run actions
+	// in the model, taking into account the access of the given user groups
+	// the identity provider reported the user to be a member of.
+	CanRunActions(ctx context.Context, name coreuser.Name, groups []string) (bool, error)
+}
+
 // Service provides the API for managing operation
 type Service struct {
 	st                State
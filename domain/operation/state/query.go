@@ -12,6 +12,7 @@ import (
 
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/unit"
+	coreuser "github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/operation"
 	operationerrors "github.com/juju/juju/domain/operation/errors"
 	"github.com/juju/juju/internal/errors"
@@ -191,14 +192,18 @@ func (st *State) getFullTasksForOperation(ctx context.Context, tx *sqlair.TX, op
 func (st *State) getOperation(ctx context.Context, tx *sqlair.TX, oID uint64) (operationResult, error) {
 	ident := operationID{OperationID: oID}
 	query := `
-SELECT uuid AS &operationResult.uuid,
-       operation_id AS &operationResult.operation_id,
-       summary AS &operationResult.summary,
-       enqueued_at AS &operationResult.enqueued_at,
-       started_at AS &operationResult.started_at,
-       completed_at AS &operationResult.completed_at
-FROM   operation
-WHERE  operation_id = $operationID.operation_id
+SELECT o.uuid AS &operationResult.uuid,
+       o.operation_id AS &operationResult.operation_id,
+       o.summary AS &operationResult.summary,
+       o.enqueued_at AS &operationResult.enqueued_at,
+       o.started_at AS &operationResult.started_at,
+       o.completed_at AS &operationResult.completed_at,
+       os.name AS &operationResult.schedule,
+       osr.run_by AS &operationResult.run_by
+FROM   operation AS o
+LEFT JOIN operation_schedule_run AS osr ON o.uuid = osr.operation_uuid
+LEFT JOIN operation_schedule AS os ON osr.schedule_uuid = os.uuid
+WHERE  o.operation_id = $operationID.operation_id
 `
 	var op operationResult
 	stmt, err := st.Prepare(query, operationResult{}, ident)
@@ -235,6 +240,16 @@ func encodeOperationInfo(
 	if op.CompletedAt.Valid {
 		opInfo.Completed = op.CompletedAt.Time
 	}
+	if op.Schedule.Valid {
+		opInfo.Schedule = op.Schedule.String
+	}
+	if op.RunBy.Valid {
+		runBy, err := coreuser.NewName(op.RunBy.String)
+		if err != nil {
+			return operation.OperationInfo{}, errors.Errorf("parsing user who scheduled operation %d: %w", op.OperationID, err)
+		}
+		opInfo.ScheduledBy = runBy
+	}
 
 	var machines []operation.MachineTaskResult
 	var units []operation.UnitTaskResult
@@ -285,8 +300,12 @@ SELECT DISTINCT
     o.summary AS &operationResult.summary,
     o.enqueued_at AS &operationResult.enqueued_at,
     o.started_at AS &operationResult.started_at,
-    o.completed_at AS &operationResult.completed_at
+    o.completed_at AS &operationResult.completed_at,
+    os.name AS &operationResult.schedule,
+    osr.run_by AS &operationResult.run_by
 FROM operation AS o
+LEFT JOIN operation_schedule_run AS osr ON o.uuid = osr.operation_uuid
+LEFT JOIN operation_schedule AS os ON osr.schedule_uuid = os.uuid
 LEFT JOIN operation_action AS oa ON o.uuid = oa.operation_uuid
 LEFT JOIN operation_task AS t ON o.uuid = t.operation_uuid
 LEFT JOIN operation_task_status AS ts ON t.uuid = ts.task_uuid
@@ -0,0 +1,518 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/transform"
+
+	coreunit "github.com/juju/juju/core/unit"
+	coreuser "github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/operation"
+	operationerrors "github.com/juju/juju/domain/operation/errors"
+	"github.com/juju/juju/domain/operation/internal"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddSchedule adds a schedule to run an action repeatedly. The receivers
+// must all belong to the same application, whose charm must define the
+// action.
+//
+// The following errors may be returned:
+//   - [operationerrors.ScheduleAlreadyExists] if a schedule with the same name
+//     already exists.
+//   - [operationerrors.ActionNotDefined] if the charm does not define the
+//     action.
+//   - [applicationerrors.ApplicationNotFound] if the application does not
+//     exist.
+func (st *State) AddSchedule(
+	ctx context.Context,
+	uuid string,
+	args operation.ScheduleArgs,
+	nextRun time.Time,
+) error {
+	if len(args.Receivers) == 0 {
+		return errors.Errorf("no receivers provided")
+	}
+
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := schedule{
+		UUID:       uuid,
+		Name:       args.Name,
+		Schedule:   args.Schedule,
+		ActionName: args.Task.ActionName,
+		Parallel:   args.Task.IsParallel,
+		ExecutionGroup: sql.NullString{
+			String: args.Task.ExecutionGroup,
+			Valid:  args.Task.ExecutionGroup != "",
+		},
+		CreatedBy: args.CreatedBy.Name(),
+		CreatedAt: st.clock.Now().UTC(),
+		NextRunAt: nullTime(nextRun),
+	}
+	receivers := transform.Slice(args.Receivers, func(r operation.ActionReceiver) scheduleReceiver {
+		if r.LeaderUnit != "" {
+			return scheduleReceiver{ScheduleUUID: uuid, Receiver: r.LeaderUnit, IsLeader: true}
+		}
+		return scheduleReceiver{ScheduleUUID: uuid, Receiver: r.Unit.String()}
+	})
+	creatorGroups := transform.Slice(args.CreatorGroups, func(g string) scheduleCreatorGroup {
+		return scheduleCreatorGroup{ScheduleUUID: uuid, GroupName: g}
+	})
+	var parameters []scheduleParameter
+	for key, value := range args.Task.Parameters {
+		parameters = append(parameters, scheduleParameter{
+			ScheduleUUID: uuid,
+			Key:          key,
+			Value:        encodeParameterValue(value),
+		})
+	}
+
+	existsStmt, err := st.Prepare(`
+SELECT &schedule.uuid
+FROM   operation_schedule
+WHERE  name = $schedule.name`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO operation_schedule (*)
+VALUES ($schedule.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertReceiverStmt, err := st.Prepare(`
+INSERT INTO operation_schedule_receiver (*)
+VALUES ($scheduleReceiver.*)`, scheduleReceiver{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertParameterStmt, err := st.Prepare(`
+INSERT INTO operation_schedule_parameter (*)
+VALUES ($scheduleParameter.*)`, scheduleParameter{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertCreatorGroupStmt, err := st.Prepare(`
+INSERT INTO operation_schedule_creator_group (*)
+VALUES ($scheduleCreatorGroup.*)`, scheduleCreatorGroup{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	appName := receiverApplication(args.Receivers[0])
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing schedule
+		err := tx.Query(ctx, existsStmt, row).Get(&existing)
+		if err == nil {
+			return errors.Errorf("schedule %q", args.Name).Add(operationerrors.ScheduleAlreadyExists)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("checking for schedule %q: %w", args.Name, err)
+		}
+
+		charmUUID, err := st.getCharmUUIDByApplication(ctx, tx, appName)
+		if err != nil {
+			return errors.Errorf("getting charm UUID for application %q: %w", appName, err)
+		}
+		err = st.checkActionDefined(ctx, tx, charmUUID, args.Task.ActionName)
+		if notDefined, ok := errors.AsType[errActionNotDefined](err); ok {
+			return operationerrors.ActionNotDefined{
+				UnitName:   receivers[0].Receiver,
+				CharmName:  notDefined.CharmName,
+				HasActions: notDefined.HasActions,
+			}
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
+			return errors.Errorf("inserting schedule %q: %w", args.Name, err)
+		}
+		if err := tx.Query(ctx, insertReceiverStmt, receivers).Run(); err != nil {
+			return errors.Errorf("inserting receivers of schedule %q: %w", args.Name, err)
+		}
+		if len(parameters) > 0 {
+			if err := tx.Query(ctx, insertParameterStmt, parameters).Run(); err != nil {
+				return errors.Errorf("inserting parameters of schedule %q: %w", args.Name, err)
+			}
+		}
+		if len(creatorGroups) > 0 {
+			if err := tx.Query(ctx, insertCreatorGroupStmt, creatorGroups).Run(); err != nil {
+				return errors.Errorf("inserting creator groups of schedule %q: %w", args.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetSchedules returns all the schedules in the model, ordered by name.
+func (st *State) GetSchedules(ctx context.Context) ([]operation.Schedule, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT s.uuid AS &scheduleResult.uuid,
+       s.name AS &scheduleResult.name,
+       s.schedule AS &scheduleResult.schedule,
+       s.action_name AS &scheduleResult.action_name,
+       s.parallel AS &scheduleResult.parallel,
+       s.execution_group AS &scheduleResult.execution_group,
+       s.created_by AS &scheduleResult.created_by,
+       s.created_at AS &scheduleResult.created_at,
+       s.next_run_at AS &scheduleResult.next_run_at,
+       s.last_run_at AS &scheduleResult.last_run_at,
+       r.operation_id AS &scheduleResult.operation_id
+FROM   operation_schedule AS s
+LEFT JOIN (
+    SELECT sr.schedule_uuid, MAX(o.operation_id) AS operation_id
+    FROM   operation_schedule_run AS sr
+    JOIN   operation AS o ON sr.operation_uuid = o.uuid
+    GROUP BY sr.schedule_uuid
+) AS r ON s.uuid = r.schedule_uuid
+ORDER BY s.name`, scheduleResult{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		rows       []scheduleResult
+		receivers  map[string][]operation.ActionReceiver
+		parameters map[string]map[string]any
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		uuids := transform.Slice(rows, func(r scheduleResult) string { return r.UUID })
+		receivers, parameters, err = st.getScheduleReceiversAndParameters(ctx, tx, uuids)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	schedules := make([]operation.Schedule, len(rows))
+	for i, r := range rows {
+		createdBy, err := coreuser.NewName(r.CreatedBy)
+		if err != nil {
+			return nil, errors.Errorf("parsing creator of schedule %q: %w", r.Name, err)
+		}
+		s := operation.Schedule{
+			Name:      r.Name,
+			Schedule:  r.Schedule,
+			Receivers: receivers[r.UUID],
+			Task: operation.TaskArgs{
+				ActionName:     r.ActionName,
+				ExecutionGroup: r.ExecutionGroup.String,
+				IsParallel:     r.Parallel,
+				Parameters:     parameters[r.UUID],
+			},
+			CreatedBy: createdBy,
+			Created:   r.CreatedAt,
+		}
+		if r.NextRunAt.Valid {
+			s.NextRun = r.NextRunAt.Time
+		}
+		if r.LastRunAt.Valid {
+			s.LastRun = r.LastRunAt.Time
+		}
+		if r.LastOperationID.Valid {
+			s.LastOperationID = strconv.FormatInt(r.LastOperationID.Int64, 10)
+		}
+		schedules[i] = s
+	}
+	return schedules, nil
+}
+
+// RemoveSchedule removes the named schedule. Operations already added by
+// the schedule are kept.
+//
+// The following errors may be returned:
+//   - [operationerrors.ScheduleNotFound] if the schedule does not exist.
+func (st *State) RemoveSchedule(ctx context.Context, name string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := schedule{Name: name}
+	stmt, err := st.Prepare(`
+SELECT &schedule.uuid
+FROM   operation_schedule
+WHERE  name = $schedule.name`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var found schedule
+		err := tx.Query(ctx, stmt, ident).Get(&found)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("schedule %q", name).Add(operationerrors.ScheduleNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting schedule %q: %w", name, err)
+		}
+
+		for _, table := range []string{
+			"operation_schedule_run",
+			"operation_schedule_receiver",
+			"operation_schedule_parameter",
+			"operation_schedule_creator_group",
+		} {
+			if err := st.removeByUUIDs(ctx, tx, table, "schedule_uuid", []string{found.UUID}); err != nil {
+				return errors.Errorf("deleting %s of schedule %q: %w", table, name, err)
+			}
+		}
+		if err := st.removeByUUIDs(ctx, tx, "operation_schedule", "uuid", []string{found.UUID}); err != nil {
+			return errors.Errorf("deleting schedule %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// GetDueSchedules returns the schedules which are due to run at the given
+// time.
+func (st *State) GetDueSchedules(ctx context.Context, now time.Time) ([]internal.DueSchedule, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	arg := scheduleDueArg{Now: now.UTC()}
+	stmt, err := st.Prepare(`
+SELECT &schedule.*
+FROM   operation_schedule
+WHERE  next_run_at IS NOT NULL
+AND    next_run_at <= $scheduleDueArg.now
+ORDER BY next_run_at, name`, schedule{}, arg)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		rows          []schedule
+		receivers     map[string][]operation.ActionReceiver
+		parameters    map[string]map[string]any
+		creatorGroups map[string][]string
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, arg).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		uuids := transform.Slice(rows, func(r schedule) string { return r.UUID })
+		receivers, parameters, err = st.getScheduleReceiversAndParameters(ctx, tx, uuids)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		creatorGroups, err = st.getScheduleCreatorGroups(ctx, tx, uuids)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	due := make([]internal.DueSchedule, len(rows))
+	for i, r := range rows {
+		createdBy, err := coreuser.NewName(r.CreatedBy)
+		if err != nil {
+			return nil, errors.Errorf("parsing creator of schedule %q: %w", r.Name, err)
+		}
+		due[i] = internal.DueSchedule{
+			UUID:      r.UUID,
+			Name:      r.Name,
+			Schedule:  r.Schedule,
+			Receivers: receivers[r.UUID],
+			Task: operation.TaskArgs{
+				ActionName:     r.ActionName,
+				ExecutionGroup: r.ExecutionGroup.String,
+				IsParallel:     r.Parallel,
+				Parameters:     parameters[r.UUID],
+			},
+			CreatedBy:     createdBy,
+			CreatorGroups: creatorGroups[r.UUID],
+		}
+	}
+	return due, nil
+}
+
+// RecordScheduleRun records that the schedule ran at the given time, and
+// when it next falls due. A zero nextRun means that the schedule will never
+// fall due again. If the run added an operation, its ID is given so that the
+// operation is linked to the schedule, along with the user on whose behalf it
+// was added.
+//
+// The following errors may be returned:
+//   - [operationerrors.ScheduleNotFound] if the schedule does not exist.
+//   - [operationerrors.OperationNotFound] if the operation does not exist.
+func (st *State) RecordScheduleRun(
+	ctx context.Context,
+	scheduleUUID string,
+	operationID string,
+	runBy coreuser.Name,
+	ranAt, nextRun time.Time,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	times := scheduleRunTimes{
+		UUID:      scheduleUUID,
+		LastRunAt: nullTime(ranAt),
+		NextRunAt: nullTime(nextRun),
+	}
+	updateStmt, err := st.Prepare(`
+UPDATE operation_schedule
+SET    last_run_at = $scheduleRunTimes.last_run_at,
+       next_run_at = $scheduleRunTimes.next_run_at
+WHERE  uuid = $scheduleRunTimes.uuid`, times)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertRunStmt, err := st.Prepare(`
+INSERT INTO operation_schedule_run (*)
+VALUES ($scheduleRun.*)`, scheduleRun{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, updateStmt, times).Get(&outcome); err != nil {
+			return errors.Errorf("updating schedule %q: %w", scheduleUUID, err)
+		}
+		if err := verifyOneOutcome(outcome, errors.Errorf("schedule %q", scheduleUUID).Add(operationerrors.ScheduleNotFound)); err != nil {
+			return err
+		}
+
+		if operationID == "" {
+			return nil
+		}
+		id, err := strconv.ParseUint(operationID, 10, 64)
+		if err != nil {
+			return errors.Errorf("parsing operation ID %q: %w", operationID, err)
+		}
+		op, err := st.getOperation(ctx, tx, id)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		run := scheduleRun{
+			OperationUUID: op.UUID,
+			ScheduleUUID:  scheduleUUID,
+			RunBy:         runBy.Name(),
+		}
+		if err := tx.Query(ctx, insertRunStmt, run).Run(); err != nil {
+			return errors.Errorf("linking operation %q to schedule %q: %w", operationID, scheduleUUID, err)
+		}
+		return nil
+	})
+}
+
+// getScheduleReceiversAndParameters returns the receivers and parameters of
+// the given schedules, keyed by schedule UUID.
+func (st *State) getScheduleReceiversAndParameters(
+	ctx context.Context,
+	tx *sqlair.TX,
+	scheduleUUIDs []string,
+) (map[string][]operation.ActionReceiver, map[string]map[string]any, error) {
+	ids := uuids(scheduleUUIDs)
+	receiverStmt, err := st.Prepare(`
+SELECT &scheduleReceiver.*
+FROM   operation_schedule_receiver
+WHERE  schedule_uuid IN ($uuids[:])
+ORDER BY receiver`, scheduleReceiver{}, ids)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	parameterStmt, err := st.Prepare(`
+SELECT &scheduleParameter.*
+FROM   operation_schedule_parameter
+WHERE  schedule_uuid IN ($uuids[:])`, scheduleParameter{}, ids)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+
+	var receiverRows []scheduleReceiver
+	if err := tx.Query(ctx, receiverStmt, ids).GetAll(&receiverRows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil, errors.Errorf("getting schedule receivers: %w", err)
+	}
+	var parameterRows []scheduleParameter
+	if err := tx.Query(ctx, parameterStmt, ids).GetAll(&parameterRows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil, errors.Errorf("getting schedule parameters: %w", err)
+	}
+
+	receivers := accumulateToMap(receiverRows, func(r scheduleReceiver) (string, operation.ActionReceiver) {
+		if r.IsLeader {
+			return r.ScheduleUUID, operation.ActionReceiver{LeaderUnit: r.Receiver}
+		}
+		return r.ScheduleUUID, operation.ActionReceiver{Unit: coreunit.Name(r.Receiver)}
+	})
+	parameters := make(map[string]map[string]any)
+	for _, p := range parameterRows {
+		if parameters[p.ScheduleUUID] == nil {
+			parameters[p.ScheduleUUID] = make(map[string]any)
+		}
+		parameters[p.ScheduleUUID][p.Key] = decodeParameterValue(p.Value)
+	}
+	return receivers, parameters, nil
+}
+
+// getScheduleCreatorGroups returns the creator groups of the given
+// schedules, keyed by schedule UUID.
+func (st *State) getScheduleCreatorGroups(
+	ctx context.Context,
+	tx *sqlair.TX,
+	scheduleUUIDs []string,
+) (map[string][]string, error) {
+	ids := uuids(scheduleUUIDs)
+	stmt, err := st.Prepare(`
+SELECT &scheduleCreatorGroup.*
+FROM   operation_schedule_creator_group
+WHERE  schedule_uuid IN ($uuids[:])
+ORDER BY group_name`, scheduleCreatorGroup{}, ids)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []scheduleCreatorGroup
+	if err := tx.Query(ctx, stmt, ids).GetAll(&rows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("getting schedule creator groups: %w", err)
+	}
+	return accumulateToMap(rows, func(g scheduleCreatorGroup) (string, string) {
+		return g.ScheduleUUID, g.GroupName
+	}), nil
+}
+
+// receiverApplication returns the name of the application the receiver
+// belongs to.
+func receiverApplication(r operation.ActionReceiver) string {
+	if r.LeaderUnit != "" {
+		return r.LeaderUnit
+	}
+	return r.Unit.Application()
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
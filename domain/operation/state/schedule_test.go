@@ -0,0 +1,246 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/unit"
+	usertesting "github.com/juju/juju/core/user/testing"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/operation"
+	operationerrors "github.com/juju/juju/domain/operation/errors"
+	internaluuid "github.com/juju/juju/internal/uuid"
+)
+
+type scheduleSuite struct {
+	baseSuite
+}
+
+func TestScheduleSuite(t *testing.T) {
+	tc.Run(t, &scheduleSuite{})
+}
+
+func (s *scheduleSuite) scheduleArgs(c *tc.C, name string) operation.ScheduleArgs {
+	return operation.ScheduleArgs{
+		Name:     name,
+		Schedule: "0 2 * * *",
+		Receivers: []operation.ActionReceiver{
+			{Unit: "app/0"},
+			{LeaderUnit: "app"},
+		},
+		Task: operation.TaskArgs{
+			ActionName:     "test-action",
+			ExecutionGroup: "backups",
+			IsParallel:     true,
+			Parameters: map[string]any{
+				"target": "s3",
+				"keep":   7,
+			},
+		},
+		CreatedBy:     usertesting.GenNewName(c, "fred"),
+		CreatorGroups: []string{"ops", "admins"},
+	}
+}
+
+func (s *scheduleSuite) addSchedule(c *tc.C, name string, nextRun time.Time) string {
+	uuid := internaluuid.MustNewUUID().String()
+	err := s.state.AddSchedule(c.Context(), uuid, s.scheduleArgs(c, name), nextRun)
+	c.Assert(err, tc.ErrorIsNil)
+	return uuid
+}
+
+func (s *scheduleSuite) TestAddAndGetSchedules(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addCharmAction(c, charmUUID)
+	s.addUnitWithName(c, charmUUID, "app/0")
+
+	nextRun := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	s.addSchedule(c, "nightly", nextRun)
+
+	schedules, err := s.state.GetSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(schedules, tc.HasLen, 1)
+	c.Check(schedules[0].Created.IsZero(), tc.IsFalse)
+	schedules[0].Created = time.Time{}
+	c.Check(schedules[0], tc.DeepEquals, operation.Schedule{
+		Name:     "nightly",
+		Schedule: "0 2 * * *",
+		Receivers: []operation.ActionReceiver{
+			{LeaderUnit: "app"},
+			{Unit: "app/0"},
+		},
+		Task: operation.TaskArgs{
+			ActionName:     "test-action",
+			ExecutionGroup: "backups",
+			IsParallel:     true,
+			Parameters: map[string]any{
+				"target": "s3",
+				"keep":   int64(7),
+			},
+		},
+		CreatedBy: usertesting.GenNewName(c, "fred"),
+		NextRun:   nextRun,
+	})
+}
+
+func (s *scheduleSuite) TestGetSchedulesNone(c *tc.C) {
+	schedules, err := s.state.GetSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schedules, tc.HasLen, 0)
+}
+
+func (s *scheduleSuite) TestAddScheduleAlreadyExists(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addCharmAction(c, charmUUID)
+	s.addUnitWithName(c, charmUUID, "app/0")
+	s.addSchedule(c, "nightly", time.Now())
+
+	err := s.state.AddSchedule(c.Context(), internaluuid.MustNewUUID().String(), s.scheduleArgs(c, "nightly"), time.Now())
+	c.Check(err, tc.ErrorIs, operationerrors.ScheduleAlreadyExists)
+}
+
+func (s *scheduleSuite) TestAddScheduleActionNotDefined(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addUnitWithName(c, charmUUID, "app/0")
+
+	err := s.state.AddSchedule(c.Context(), internaluuid.MustNewUUID().String(), s.scheduleArgs(c, "nightly"), time.Now())
+	c.Check(err, tc.ErrorIs, operationerrors.ActionNotDefined{CharmName: charmUUID,
+		UnitName: "app/0", HasActions: false})
+	c.Check(s.getRowCount(c, "operation_schedule"), tc.Equals, 0)
+}
+
+func (s *scheduleSuite) TestAddScheduleApplicationNotFound(c *tc.C) {
+	err := s.state.AddSchedule(c.Context(), internaluuid.MustNewUUID().String(), s.scheduleArgs(c, "nightly"), time.Now())
+	c.Check(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *scheduleSuite) TestGetDueSchedules(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addCharmAction(c, charmUUID)
+	s.addUnitWithName(c, charmUUID, "app/0")
+
+	now := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	dueUUID := s.addSchedule(c, "due", now.Add(-time.Minute))
+	s.addSchedule(c, "later", now.Add(time.Minute))
+	s.addSchedule(c, "never", time.Time{})
+
+	due, err := s.state.GetDueSchedules(c.Context(), now)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(due, tc.HasLen, 1)
+	c.Check(due[0].UUID, tc.Equals, dueUUID)
+	c.Check(due[0].Name, tc.Equals, "due")
+	c.Check(due[0].Schedule, tc.Equals, "0 2 * * *")
+	c.Check(due[0].Receivers, tc.SameContents, []operation.ActionReceiver{
+		{Unit: "app/0"},
+		{LeaderUnit: "app"},
+	})
+	c.Check(due[0].Task.ActionName, tc.Equals, "test-action")
+	c.Check(due[0].Task.Parameters, tc.DeepEquals, map[string]any{
+		"target": "s3",
+		"keep":   int64(7),
+	})
+	c.Check(due[0].CreatedBy, tc.Equals, usertesting.GenNewName(c, "fred"))
+	c.Check(due[0].CreatorGroups, tc.DeepEquals, []string{"admins", "ops"})
+}
+
+func (s *scheduleSuite) TestRecordScheduleRun(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addCharmAction(c, charmUUID)
+	s.addUnitWithName(c, charmUUID, "app/0")
+
+	now := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	scheduleUUID := s.addSchedule(c, "nightly", now)
+
+	result, err := s.state.AddActionOperation(c.Context(), internaluuid.MustNewUUID(),
+		[]unit.Name{"app/0"}, operation.TaskArgs{ActionName: "test-action"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	nextRun := now.Add(24 * time.Hour)
+	err = s.state.RecordScheduleRun(c.Context(), scheduleUUID, result.OperationID, usertesting.GenNewName(c, "fred"), now, nextRun)
+	c.Assert(err, tc.ErrorIsNil)
+
+	schedules, err := s.state.GetSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(schedules, tc.HasLen, 1)
+	c.Check(schedules[0].LastRun, tc.Equals, now)
+	c.Check(schedules[0].NextRun, tc.Equals, nextRun)
+	c.Check(schedules[0].LastOperationID, tc.Equals, result.OperationID)
+
+	// The operation is reported as added by the schedule.
+	id, err := strconv.ParseUint(result.OperationID, 10, 64)
+	c.Assert(err, tc.ErrorIsNil)
+	op, err := s.state.GetOperationByID(c.Context(), id)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(op.Schedule, tc.Equals, "nightly")
+	c.Check(op.ScheduledBy, tc.Equals, usertesting.GenNewName(c, "fred"))
+
+	ops, err := s.state.GetOperations(c.Context(), operation.QueryArgs{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(ops.Operations, tc.HasLen, 1)
+	c.Check(ops.Operations[0].Schedule, tc.Equals, "nightly")
+	c.Check(ops.Operations[0].ScheduledBy, tc.Equals, usertesting.GenNewName(c, "fred"))
+}
+
+func (s *scheduleSuite) TestRecordScheduleRunWithoutOperation(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addCharmAction(c, charmUUID)
+	s.addUnitWithName(c, charmUUID, "app/0")
+
+	now := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	scheduleUUID := s.addSchedule(c, "nightly", now)
+
+	err := s.state.RecordScheduleRun(c.Context(), scheduleUUID, "", usertesting.GenNewName(c, "fred"), now, time.Time{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	schedules, err := s.state.GetSchedules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(schedules, tc.HasLen, 1)
+	c.Check(schedules[0].LastRun, tc.Equals, now)
+	c.Check(schedules[0].NextRun.IsZero(), tc.IsTrue)
+	c.Check(schedules[0].LastOperationID, tc.Equals, "")
+}
+
+func (s *scheduleSuite) TestRecordScheduleRunNotFound(c *tc.C) {
+	err := s.state.RecordScheduleRun(c.Context(), internaluuid.MustNewUUID().String(), "", usertesting.GenNewName(c, "fred"), time.Now(), time.Now())
+	c.Check(err, tc.ErrorIs, operationerrors.ScheduleNotFound)
+}
+
+func (s *scheduleSuite) TestRemoveSchedule(c *tc.C) {
+	charmUUID := s.addCharm(c)
+	s.addCharmAction(c, charmUUID)
+	s.addUnitWithName(c, charmUUID, "app/0")
+
+	now := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	scheduleUUID := s.addSchedule(c, "nightly", now)
+	result, err := s.state.AddActionOperation(c.Context(), internaluuid.MustNewUUID(),
+		[]unit.Name{"app/0"}, operation.TaskArgs{ActionName: "test-action"})
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.RecordScheduleRun(c.Context(), scheduleUUID, result.OperationID, usertesting.GenNewName(c, "fred"), now, now.Add(time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.RemoveSchedule(c.Context(), "nightly")
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, table := range []string{
+		"operation_schedule",
+		"operation_schedule_receiver",
+		"operation_schedule_parameter",
+		"operation_schedule_creator_group",
+		"operation_schedule_run",
+	} {
+		c.Check(s.getRowCount(c, table), tc.Equals, 0, tc.Commentf("table %q", table))
+	}
+	// The operation added by the schedule is kept.
+	c.Check(s.getRowCount(c, "operation"), tc.Equals, 1)
+}
+
+func (s *scheduleSuite) TestRemoveScheduleNotFound(c *tc.C) {
+	err := s.state.RemoveSchedule(c.Context(), "nightly")
+	c.Check(err, tc.ErrorIs, operationerrors.ScheduleNotFound)
+}
@@ -203,6 +203,7 @@ func (st *State) deleteOperationByUUIDs(ctx context.Context, tx *sqlair.TX, toDe
 	for _, table := range []string{
 		"operation_action",
 		"operation_parameter",
+		"operation_schedule_run",
 	} {
 		if err := st.removeByUUIDs(ctx, tx, table, "operation_uuid", toDelete); err != nil {
 			return nil, errors.Errorf("deleting %s by operation UUIDs: %w", table, err)
@@ -49,6 +49,42 @@ func (st *State) GetTask(ctx context.Context, taskID string) (operation.Task, *s
 	return result, outputPath, nil
 }
 
+// GetTaskCountsByStatus returns the number of tasks in the model with each
+// status, keyed by status. Statuses without any tasks are omitted.
+func (s *State) GetTaskCountsByStatus(ctx context.Context) (map[string]int, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT   status.status AS &taskStatusCount.status,
+         COUNT(*) AS &taskStatusCount.count
+FROM     operation_task_status AS ots
+JOIN     operation_task_status_value AS status ON ots.status_id = status.id
+GROUP BY status.status`, taskStatusCount{})
+	if err != nil {
+		return nil, errors.Errorf("preparing statement for counting tasks by status: %w", err)
+	}
+
+	var counts []taskStatusCount
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if qerr := tx.Query(ctx, stmt).GetAll(&counts); qerr != nil && !errors.Is(qerr, sqlair.ErrNoRows) {
+			return errors.Capture(qerr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("counting tasks by status: %w", err)
+	}
+
+	result := make(map[string]int, len(counts))
+	for _, count := range counts {
+		result[count.Status] = count.Count
+	}
+	return result, nil
+}
+
 // GetMachineTaskIDsWithStatus retrieves all task IDs for a machine specified by
 // name and a status filter.
 func (s *State) GetMachineTaskIDsWithStatus(ctx context.Context, machineName string, statusFilter string) ([]string, error) {
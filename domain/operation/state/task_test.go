@@ -373,6 +373,35 @@ func (s *taskSuite) TestGetMachineTaskIDsWithStatusFiltersByMachineAndStatus(c *
 	c.Check(ids, tc.SameContents, []string{"running-id-1", "running-id-2"})
 }
 
+func (s *taskSuite) TestGetTaskCountsByStatus(c *tc.C) {
+	// Arrange
+	op := s.addOperation(c)
+	s.addOperationTaskWithID(c, op, "pending-1", corestatus.Pending.String())
+	s.addOperationTaskWithID(c, op, "pending-2", corestatus.Pending.String())
+	s.addOperationTaskWithID(c, op, "running-1", corestatus.Running.String())
+	s.addOperationTaskWithID(c, op, "completed-1", corestatus.Completed.String())
+
+	// Act
+	counts, err := s.state.GetTaskCountsByStatus(c.Context())
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(counts, tc.DeepEquals, map[string]int{
+		corestatus.Pending.String():   2,
+		corestatus.Running.String():   1,
+		corestatus.Completed.String(): 1,
+	})
+}
+
+func (s *taskSuite) TestGetTaskCountsByStatusNoTasks(c *tc.C) {
+	// Act
+	counts, err := s.state.GetTaskCountsByStatus(c.Context())
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(counts, tc.HasLen, 0)
+}
+
 func (s *taskSuite) TestGetMachineTaskIDsWithStatusNoMatch(c *tc.C) {
 	// Arrange
 	m0 := s.addMachine(c, "0")
@@ -70,6 +70,8 @@ type operationResult struct {
 	EnqueuedAt  time.Time      `db:"enqueued_at"`
 	StartedAt   sql.NullTime   `db:"started_at"`
 	CompletedAt sql.NullTime   `db:"completed_at"`
+	Schedule    sql.NullString `db:"schedule"`
+	RunBy       sql.NullString `db:"run_by"`
 }
 
 // taskIdent represents a task ID parameter for queries.
@@ -77,6 +79,12 @@ type taskIdent struct {
 	ID string `db:"task_id"`
 }
 
+// taskStatusCount represents the number of tasks with a given status.
+type taskStatusCount struct {
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
+
 // taskStatus represents a task status for queries on the
 // operation_task_status table.
 type taskStatus struct {
@@ -181,3 +189,75 @@ type queryFlags struct {
 	HasMachines     bool `db:"has_machines"`
 	HasUnits        bool `db:"has_units"`
 }
+
+// schedule represents a row of the operation_schedule table.
+type schedule struct {
+	UUID           string         `db:"uuid"`
+	Name           string         `db:"name"`
+	Schedule       string         `db:"schedule"`
+	ActionName     string         `db:"action_name"`
+	Parallel       bool           `db:"parallel"`
+	ExecutionGroup sql.NullString `db:"execution_group"`
+	CreatedBy      string         `db:"created_by"`
+	CreatedAt      time.Time      `db:"created_at"`
+	NextRunAt      sql.NullTime   `db:"next_run_at"`
+	LastRunAt      sql.NullTime   `db:"last_run_at"`
+}
+
+// scheduleResult represents a schedule along with the ID of the operation it
+// last added.
+type scheduleResult struct {
+	UUID            string         `db:"uuid"`
+	Name            string         `db:"name"`
+	Schedule        string         `db:"schedule"`
+	ActionName      string         `db:"action_name"`
+	Parallel        bool           `db:"parallel"`
+	ExecutionGroup  sql.NullString `db:"execution_group"`
+	CreatedBy       string         `db:"created_by"`
+	CreatedAt       time.Time      `db:"created_at"`
+	NextRunAt       sql.NullTime   `db:"next_run_at"`
+	LastRunAt       sql.NullTime   `db:"last_run_at"`
+	LastOperationID sql.NullInt64  `db:"operation_id"`
+}
+
+// scheduleReceiver represents a row of the operation_schedule_receiver table.
+type scheduleReceiver struct {
+	ScheduleUUID string `db:"schedule_uuid"`
+	Receiver     string `db:"receiver"`
+	IsLeader     bool   `db:"is_leader"`
+}
+
+// scheduleCreatorGroup represents a row of the
+// operation_schedule_creator_group table.
+type scheduleCreatorGroup struct {
+	ScheduleUUID string `db:"schedule_uuid"`
+	GroupName    string `db:"group_name"`
+}
+
+// scheduleParameter represents a row of the operation_schedule_parameter
+// table.
+type scheduleParameter struct {
+	ScheduleUUID string `db:"schedule_uuid"`
+	Key          string `db:"key"`
+	Value        string `db:"value"`
+}
+
+// scheduleRun represents a row of the operation_schedule_run table.
+type scheduleRun struct {
+	OperationUUID string `db:"operation_uuid"`
+	ScheduleUUID  string `db:"schedule_uuid"`
+	RunBy         string `db:"run_by"`
+}
+
+// scheduleRunTimes holds the times recorded when a schedule runs.
+type scheduleRunTimes struct {
+	UUID      string       `db:"uuid"`
+	NextRunAt sql.NullTime `db:"next_run_at"`
+	LastRunAt sql.NullTime `db:"last_run_at"`
+}
+
+// scheduleDueArg holds the time against which schedules are checked to see
+// if they are due.
+type scheduleDueArg struct {
+	Now time.Time `db:"now"`
+}
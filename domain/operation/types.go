@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/core/machine"
 	corestatus "github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -83,6 +84,14 @@ type OperationInfo struct {
 	Machines    []MachineTaskResult
 	Units       []UnitTaskResult
 	Error       error
+
+	// Schedule is the name of the schedule which added the operation, if
+	// any.
+	Schedule string
+
+	// ScheduledBy is the user on whose behalf the schedule added the
+	// operation, if it was added by a schedule.
+	ScheduledBy user.Name
 }
 
 // ExecArgs represents the parameters used for running exec commands.
@@ -181,3 +190,50 @@ func (a *ActionReceiver) Validate() error {
 	}
 	return nil
 }
+
+// ScheduleArgs represents the parameters used for scheduling an action to run
+// repeatedly.
+type ScheduleArgs struct {
+	// Name uniquely identifies the schedule within the model.
+	Name string
+
+	// Schedule is the cron expression describing when the action runs.
+	Schedule string
+
+	// Receivers are the units, or application leaders, which run the action.
+	// They must all belong to the same application.
+	Receivers []ActionReceiver
+
+	// Task holds the action and its parameters.
+	Task TaskArgs
+
+	// CreatedBy is the user creating the schedule. The action is run on
+	// their behalf each time the schedule falls due.
+	CreatedBy user.Name
+
+	// CreatorGroups are the user groups the identity provider reported the
+	// creator to be a member of, if any.
+	CreatorGroups []string
+}
+
+// Schedule represents an action scheduled to run repeatedly.
+type Schedule struct {
+	Name      string
+	Schedule  string
+	Receivers []ActionReceiver
+	Task      TaskArgs
+
+	CreatedBy user.Name
+	Created   time.Time
+
+	// NextRun is the time the schedule next falls due. It is the zero time
+	// if the schedule will never fall due again.
+	NextRun time.Time
+
+	// LastRun is the time the schedule last ran, if it has.
+	LastRun time.Time
+
+	// LastOperationID is the ID of the operation added the last time the
+	// schedule ran, if it has and the operation has not been pruned.
+	LastOperationID string
+}
@@ -75,6 +75,14 @@ type ApplicationState interface {
 	GetCharmForApplication(ctx context.Context, appUUID string) (string, error)
 }
 
+// ControllerApplicationState describes persistence methods for application
+// removal in the controller database.
+type ControllerApplicationState interface {
+	// DeleteApplicationAccess removes the access permissions for an
+	// application in the model.
+	DeleteApplicationAccess(ctx context.Context, modelUUID, appUUID string) error
+}
+
 // RemoveApplication checks if a application with the input application UUID
 // exists. If it does, the application is guaranteed after this call to:
 // - Not be alive.
@@ -414,6 +422,13 @@ func (s *Service) processApplicationRemovalJob(ctx context.Context, job removal.
 		return errors.Errorf("deleting application %q: %w", job.EntityUUID, err)
 	}
 
+	if err := s.controllerState.DeleteApplicationAccess(ctx, s.modelUUID.String(), job.EntityUUID); err != nil {
+		// Log the error but do not fail the removal job. Permissions are
+		// indexed by application UUID, so they are never inherited by a later
+		// application of the same name.
+		s.logger.Warningf(ctx, "deleting access for application %q: %v", job.EntityUUID, err)
+	}
+
 	// Try to delete any orphaned resources associated with the charm.
 	if err := s.modelState.DeleteOrphanedResources(ctx, charmUUID); err != nil {
 		// Log the error but do not fail the removal job.
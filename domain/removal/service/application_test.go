@@ -513,6 +513,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationDyingForceSkipsDeadGate(c
 	exp.GetApplicationOwnedSecretRevisionRefs(gomock.Any(), j.EntityUUID).Return(nil, nil)
 	exp.DeleteApplicationOwnedSecrets(gomock.Any(), j.EntityUUID).Return(nil)
 	exp.DeleteApplication(gomock.Any(), j.EntityUUID, true).Return(nil)
+	s.controllerState.EXPECT().DeleteApplicationAccess(gomock.Any(), s.modelUUID.String(), j.EntityUUID).Return(nil)
 	exp.DeleteCharmIfUnused(gomock.Any(), gomock.Any()).Return(nil)
 	exp.DeleteOrphanedResources(gomock.Any(), gomock.Any()).Return(nil)
 
@@ -541,6 +542,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationDyingDeleteApplication(c
 	exp.GetApplicationOwnedSecretRevisionRefs(gomock.Any(), j.EntityUUID).Return(nil, nil)
 	exp.DeleteApplicationOwnedSecrets(gomock.Any(), j.EntityUUID).Return(nil)
 	exp.DeleteApplication(gomock.Any(), j.EntityUUID, false).Return(nil)
+	s.controllerState.EXPECT().DeleteApplicationAccess(gomock.Any(), s.modelUUID.String(), j.EntityUUID).Return(nil)
 	exp.DeleteCharmIfUnused(gomock.Any(), gomock.Any()).Return(nil)
 	exp.DeleteOrphanedResources(gomock.Any(), gomock.Any()).Return(nil)
 	exp.DeleteJob(gomock.Any(), j.UUID.String()).Return(nil)
@@ -569,6 +571,7 @@ func (s *applicationSuite) TestDeleteCharmForApplicationFails(c *tc.C) {
 	exp.GetApplicationOwnedSecretRevisionRefs(gomock.Any(), j.EntityUUID).Return(nil, nil)
 	exp.DeleteApplicationOwnedSecrets(gomock.Any(), j.EntityUUID).Return(nil)
 	exp.DeleteApplication(gomock.Any(), j.EntityUUID, false).Return(nil)
+	s.controllerState.EXPECT().DeleteApplicationAccess(gomock.Any(), s.modelUUID.String(), j.EntityUUID).Return(nil)
 	exp.GetCharmForApplication(gomock.Any(), j.EntityUUID).Return(tc.Must(c, coreapplication.NewUUID).String(), nil)
 	exp.DeleteCharmIfUnused(gomock.Any(), gomock.Any()).Return(errors.Errorf("the charm is still in use"))
 	exp.DeleteOrphanedResources(gomock.Any(), gomock.Any()).Return(nil)
@@ -600,6 +603,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationDyingJujuSecretsDeleteApp
 	exp.GetCharmForApplication(gomock.Any(), j.EntityUUID).Return(tc.Must(c, coreapplication.NewUUID).String(), nil)
 	exp.DeleteCharmIfUnused(gomock.Any(), gomock.Any()).Return(errors.Errorf("the charm is still in use"))
 	exp.DeleteApplication(gomock.Any(), j.EntityUUID, false).Return(nil)
+	s.controllerState.EXPECT().DeleteApplicationAccess(gomock.Any(), s.modelUUID.String(), j.EntityUUID).Return(nil)
 	exp.DeleteOrphanedResources(gomock.Any(), gomock.Any()).Return(nil)
 	exp.DeleteJob(gomock.Any(), j.UUID.String()).Return(nil)
 
@@ -629,6 +633,7 @@ func (s *applicationSuite) TestExecuteJobForApplicationDyingExternalSecretsDelet
 	exp.GetCharmForApplication(gomock.Any(), j.EntityUUID).Return(tc.Must(c, coreapplication.NewUUID).String(), nil)
 	exp.DeleteCharmIfUnused(gomock.Any(), gomock.Any()).Return(errors.Errorf("the charm is still in use"))
 	exp.DeleteApplication(gomock.Any(), j.EntityUUID, false).Return(nil)
+	s.controllerState.EXPECT().DeleteApplicationAccess(gomock.Any(), s.modelUUID.String(), j.EntityUUID).Return(nil)
 	exp.DeleteOrphanedResources(gomock.Any(), gomock.Any()).Return(nil)
 	exp.DeleteJob(gomock.Any(), j.UUID.String()).Return(nil)
 
@@ -33,6 +33,7 @@ type MockControllerDBState struct {
 // MockControllerDBStateMockRecorder is the mock recorder for MockControllerDBState.
 type MockControllerDBStateMockRecorder struct {
 	mock                                      *MockControllerDBState
+	deleteApplicationAccessExpects            []*gomock.Call3_1[context.Context, string, string, error]
 	deleteModelExpects                        []*gomock.Call2_1[context.Context, string, error]
 	deleteOfferAccessExpects                  []*gomock.Call2_1[context.Context, string, error]
 	ensureModelNotAliveUnlessMigratingExpects []*gomock.Call3_1[context.Context, string, bool, error]
@@ -58,6 +59,24 @@ func (m *MockControllerDBState) EXPECT() *MockControllerDBStateMockRecorder {
 	return m.recorder
 }
 
+// DeleteApplicationAccess mocks base method.
+func (m *MockControllerDBState) DeleteApplicationAccess(ctx context.Context, modelUUID, appUUID string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.deleteApplicationAccessExpects, m.ctrl, m, "DeleteApplicationAccess", ctx, modelUUID, appUUID)
+}
+
+// DeleteApplicationAccess indicates an expected call of DeleteApplicationAccess.
+func (mr *MockControllerDBStateMockRecorder) DeleteApplicationAccess(ctx, modelUUID, appUUID any) *MockControllerDBStateDeleteApplicationAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, string, error](mr.mock.ctrl.T, mr.mock, "DeleteApplicationAccess", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(modelUUID), gomock.EnsureMatcher(appUUID))
+	mr.deleteApplicationAccessExpects = append(mr.deleteApplicationAccessExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerDBStateDeleteApplicationAccessCall is the typed call wrapper for DeleteApplicationAccess.
+type MockControllerDBStateDeleteApplicationAccessCall = gomock.Call3_1[context.Context, string, string, error]
+
 // DeleteModel mocks base method.
 func (m *MockControllerDBState) DeleteModel(ctx context.Context, modelUUID string) error {
 	m.ctrl.T.Helper()
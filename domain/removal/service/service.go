@@ -75,6 +75,7 @@ type ModelDBState interface {
 type ControllerDBState interface {
 	ControllerState
 	ControllerOfferState
+	ControllerApplicationState
 
 	// GetActiveModelSecretBackend returns the active secret backend ID and
 	// config for the model with the input UUID.
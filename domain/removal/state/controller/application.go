@@ -0,0 +1,53 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/internal/errors"
+)
+
+// DeleteApplicationAccess removes the permissions granted to users and groups
+// on the application with the input UUID in the input model, including those
+// granted for specific operations on it.
+func (st *State) DeleteApplicationAccess(ctx context.Context, mUUID, aUUID string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	grantOn := grantOnKey{Key: permission.ApplicationID(mUUID, aUUID).Key}
+	stmt, err := st.Prepare(`
+DELETE FROM permission
+WHERE grant_on = $grantOnKey.key
+OR    grant_on LIKE $grantOnKey.key || ':%'
+`, grantOn)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	groupStmt, err := st.Prepare(`
+DELETE FROM user_group_permission
+WHERE grant_on = $grantOnKey.key
+OR    grant_on LIKE $grantOnKey.key || ':%'
+`, grantOn)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, grantOn).Run(); err != nil {
+			return errors.Errorf("deleting application access: %w", err)
+		}
+		if err := tx.Query(ctx, groupStmt, grantOn).Run(); err != nil {
+			return errors.Errorf("deleting application group access: %w", err)
+		}
+		return nil
+	})
+	return errors.Capture(err)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/permission"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type applicationSuite struct {
+	baseSuite
+}
+
+func TestApplicationSuite(t *testing.T) {
+	tc.Run(t, &applicationSuite{})
+}
+
+func (s *applicationSuite) TestDeleteApplicationAccessNoop(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.DeleteApplicationAccess(c.Context(), s.uuid.String(), "some-app-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestDeleteApplicationAccess(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	modelUUID := s.uuid.String()
+	appUUID := tc.Must(c, uuid.NewUUID).String()
+	otherAppUUID := tc.Must(c, uuid.NewUUID).String()
+
+	s.addApplicationPermission(c, modelUUID, appUUID, permission.ReadAccess)
+	s.addApplicationPermission(c, modelUUID, appUUID, permission.RefreshAccess)
+	s.addApplicationPermission(c, modelUUID, otherAppUUID, permission.RefreshAccess)
+
+	err := st.DeleteApplicationAccess(c.Context(), modelUUID, appUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.applicationPermissionKeys(c), tc.DeepEquals, []string{
+		permission.ApplicationOperationID(modelUUID, otherAppUUID, permission.RefreshAccess).Key,
+	})
+}
+
+func (s *applicationSuite) TestDeleteModelDeletesApplicationAccess(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	modelUUID := s.uuid.String()
+	appUUID := tc.Must(c, uuid.NewUUID).String()
+	s.addApplicationPermission(c, modelUUID, appUUID, permission.ReadAccess)
+	s.addApplicationPermission(c, modelUUID, appUUID, permission.RefreshAccess)
+	c.Assert(s.applicationPermissionKeys(c), tc.HasLen, 2)
+
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "UPDATE model SET life_id = 2 WHERE uuid = ?", modelUUID)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.DeleteModel(c.Context(), modelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.applicationPermissionKeys(c), tc.HasLen, 0)
+}
+
+// addApplicationPermission grants the admin user the access on the
+// application with the input UUID.
+func (s *applicationSuite) addApplicationPermission(c *tc.C, modelUUID, appUUID string, access permission.Access) {
+	// The access type IDs are those of the permission_access_type table.
+	accessTypeIDs := map[permission.Access]int{
+		permission.ReadAccess:    0,
+		permission.RefreshAccess: 9,
+	}
+	target := permission.ApplicationAccessID(modelUUID, appUUID, access)
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO permission (uuid, access_type_id, object_type_id, grant_on, grant_to)
+SELECT ?, ?, 4, ?, uuid FROM user WHERE name = 'admin'`,
+			tc.Must(c, uuid.NewUUID).String(), accessTypeIDs[access], target.Key)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) applicationPermissionKeys(c *tc.C) []string {
+	var keys []string
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT grant_on FROM permission WHERE object_type_id = 4")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key string
+			if err := rows.Scan(&key); err != nil {
+				return err
+			}
+			keys = append(keys, key)
+		}
+		return rows.Err()
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return keys
+}
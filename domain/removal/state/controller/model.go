@@ -240,9 +240,21 @@ WHERE namespace = $entityUUID.uuid;
 		return errors.Capture(err)
 	}
 
+	// Permissions on the model's applications are granted on keys prefixed
+	// with the model UUID and a colon.
 	deletePermissionsStmt, err := st.Prepare(`
 DELETE FROM permission
-WHERE grant_on = $entityUUID.uuid;
+WHERE grant_on = $entityUUID.uuid
+OR    grant_on LIKE $entityUUID.uuid || ':%';
+`, modelUUIDParam)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	deleteGroupPermissionsStmt, err := st.Prepare(`
+DELETE FROM user_group_permission
+WHERE grant_on = $entityUUID.uuid
+OR    grant_on LIKE $entityUUID.uuid || ':%';
 `, modelUUIDParam)
 	if err != nil {
 		return errors.Capture(err)
@@ -273,6 +285,9 @@ WHERE grant_on = $entityUUID.uuid;
 		if err := tx.Query(ctx, deletePermissionsStmt, modelUUIDParam).Run(); err != nil {
 			return errors.Errorf("deleting model permissions: %w", err)
 		}
+		if err := tx.Query(ctx, deleteGroupPermissionsStmt, modelUUIDParam).Run(); err != nil {
+			return errors.Errorf("deleting model group permissions: %w", err)
+		}
 
 		// Delete the model row.
 		if err := tx.Query(ctx, deleteModelStmt, modelUUIDParam).Run(); err != nil {
@@ -11,7 +11,8 @@ import (
 	"github.com/juju/juju/internal/errors"
 )
 
-// DeleteOfferAccess removes the permissions granted for the given offer.
+// DeleteOfferAccess removes the permissions granted to users and groups for
+// the given offer.
 func (st *State) DeleteOfferAccess(ctx context.Context, oUUID string) error {
 	db, err := st.DB(ctx)
 	if err != nil {
@@ -27,10 +28,21 @@ WHERE grant_on = $entityUUID.uuid
 		return errors.Capture(err)
 	}
 
+	groupStmt, err := st.Prepare(`
+DELETE FROM user_group_permission
+WHERE grant_on = $entityUUID.uuid
+`, offerUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		if err := tx.Query(ctx, stmt, offerUUID).Run(); err != nil {
 			return errors.Errorf("deleting offer access: %w", err)
 		}
+		if err := tx.Query(ctx, groupStmt, offerUUID).Run(); err != nil {
+			return errors.Errorf("deleting offer group access: %w", err)
+		}
 		return nil
 	})
 	return errors.Capture(err)
@@ -14,6 +14,11 @@ type entityLife struct {
 	Life int `db:"life_id"`
 }
 
+// grantOnKey holds the key of the object a permission is granted on.
+type grantOnKey struct {
+	Key string `db:"key"`
+}
+
 type count struct {
 	Count int `db:"count"`
 }
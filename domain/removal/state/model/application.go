@@ -546,6 +546,8 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"DELETE FROM application_workload_version WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM device_constraint WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_k8s_resources_managed WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_refresh_rollout_unit WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_refresh_rollout WHERE application_uuid = $entityUUID.uuid",
 	} {
 		deleteApplicationReferenceStmt, err := st.Prepare(table, app)
 		if err != nil {
@@ -838,6 +840,15 @@ WHERE  charm_uuid = $entityAssociationCount.uuid`, uuidCount)
 		return errors.Errorf("preparing unit charm usage query: %w", err)
 	}
 
+	rolloutStmt, err := st.Prepare(`
+SELECT COUNT(*) AS &entityAssociationCount.count
+FROM   application_refresh_rollout
+WHERE  charm_uuid = $entityAssociationCount.uuid
+OR     previous_charm_uuid = $entityAssociationCount.uuid`, uuidCount)
+	if err != nil {
+		return errors.Errorf("preparing refresh rollout charm usage query: %w", err)
+	}
+
 	if err := tx.Query(ctx, appStmt, uuidCount).Get(&uuidCount); err != nil {
 		return errors.Errorf("running application charm usage query: %w", err)
 	} else if uuidCount.Count > 0 {
@@ -852,6 +863,13 @@ WHERE  charm_uuid = $entityAssociationCount.uuid`, uuidCount)
 		return nil
 	}
 
+	if err := tx.Query(ctx, rolloutStmt, uuidCount).Get(&uuidCount); err != nil {
+		return errors.Errorf("running refresh rollout charm usage query: %w", err)
+	} else if uuidCount.Count > 0 {
+		st.logger.Infof(ctx, "charm %q is still used by %d refresh rollout(s), not deleting", charmUUID, uuidCount.Count)
+		return nil
+	}
+
 	return st.deleteCharm(ctx, tx, charmUUID)
 }
 
@@ -876,6 +894,7 @@ func (st *State) deleteCharm(ctx context.Context, tx *sqlair.TX, cUUID string) e
 		"DELETE FROM charm_hash WHERE charm_uuid = $entityUUID.uuid",
 		"DELETE FROM charm_metadata WHERE charm_uuid = $entityUUID.uuid",
 		"DELETE FROM charm_download_info WHERE charm_uuid = $entityUUID.uuid",
+		"DELETE FROM charm_signature WHERE charm_uuid = $entityUUID.uuid",
 	} {
 		deleteApplicationReferenceStmt, err := st.Prepare(table, charmUUID)
 		if err != nil {
@@ -253,6 +253,7 @@ func (st *State) deleteOperationByUUIDs(ctx context.Context, tx *sqlair.TX, toDe
 	for _, query := range []string{
 		`DELETE FROM operation_action WHERE operation_uuid IN ($uuids[:])`,
 		`DELETE FROM operation_parameter WHERE operation_uuid IN ($uuids[:])`,
+		`DELETE FROM operation_schedule_run WHERE operation_uuid IN ($uuids[:])`,
 		`DELETE FROM operation WHERE uuid IN ($uuids[:])`,
 	} {
 		stmt, err := st.Prepare(query, operations)
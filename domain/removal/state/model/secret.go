@@ -497,6 +497,7 @@ WHERE revision_uuid IN ($uuids[:])`,
 		`DELETE FROM secret_unit_owner WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_application_owner WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_model_owner WHERE secret_id = $secretID.secret_id`,
+		`DELETE FROM secret_controller_owned WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_unit_consumer WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_remote_unit_consumer WHERE secret_id = $secretID.secret_id`,
 		`DELETE FROM secret_reference WHERE secret_id = $secretID.secret_id`,
@@ -241,7 +241,7 @@ func (s *baseSuite) setupApplicationService(c *tc.C) *applicationservice.Provide
 		providerGetter,
 		caasProviderGetter,
 		cloudInfoGetter,
-		nil,
+		domaintesting.NewCharmStore(nil),
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
 		coremodel.UUID(s.ModelUUID()),
 		clock.WallClock,
@@ -1429,7 +1429,7 @@ func (s *baseSuite) setupStorageApplicationService(c *tc.C) *applicationservice.
 		providerGetter,
 		caasProviderGetter,
 		cloudInfoGetter,
-		nil,
+		domaintesting.NewCharmStore(nil),
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
 		coremodel.UUID(s.ModelUUID()),
 		clock.WallClock,
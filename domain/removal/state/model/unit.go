@@ -965,6 +965,7 @@ func (st *State) deleteForeignKeyUnitReferences(ctx context.Context, tx *sqlair.
 		"DELETE FROM secret_unit_consumer WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM secret_reservation WHERE unit_uuid = $entityUUID.uuid",
 		"DELETE FROM unit_virtual_ssh_host_key WHERE unit_uuid = $entityUUID.uuid",
+		"DELETE FROM application_refresh_rollout_unit WHERE unit_uuid = $entityUUID.uuid",
 	} {
 		deleteUnitReferenceStmt, err := st.Prepare(table, unitUUIDRec)
 		if err != nil {
@@ -227,7 +227,7 @@ func (s *watcherSuite) setupApplicationService(c *tc.C, factory domain.Watchable
 		providerGetter,
 		caasProviderGetter,
 		cloudInfoGetter,
-		nil,
+		domaintesting.NewCharmStore(nil),
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
 		model.UUID(s.ModelUUID()),
 		clock.WallClock,
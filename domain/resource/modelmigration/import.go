@@ -69,6 +69,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 			i.logger,
 		),
 		nil,
+		nil,
 		i.logger)
 	return nil
 }
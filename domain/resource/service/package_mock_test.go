@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/resource/service (interfaces: State,ResourceStoreGetter)
+// Source: github.com/juju/juju/domain/resource/service (interfaces: State,ResourceStoreGetter,SignatureVerifier)
 //
 // Generated by this command:
 //
-//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/resource/service State,ResourceStoreGetter
+//	mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/resource/service State,ResourceStoreGetter,SignatureVerifier
 //
 
 // Package service is a generated GoMock package.
@@ -11,6 +11,7 @@ package service
 
 import (
 	context "context"
+	io "io"
 
 	gomock "github.com/canonical/gomock/gomock"
 	application "github.com/juju/juju/core/application"
@@ -19,6 +20,7 @@ import (
 	unit "github.com/juju/juju/core/unit"
 	resource0 "github.com/juju/juju/domain/deployment/charm/resource"
 	resource1 "github.com/juju/juju/domain/resource"
+	charmsignature "github.com/juju/juju/internal/charmsignature"
 )
 
 // MockState is a mock of State interface.
@@ -467,3 +469,65 @@ func (mr *MockResourceStoreGetterMockRecorder) GetResourceStore(arg0, arg1 any)
 
 // MockResourceStoreGetterGetResourceStoreCall is the typed call wrapper for GetResourceStore.
 type MockResourceStoreGetterGetResourceStoreCall = gomock.Call2_2[context.Context, resource0.Type, store.ResourceStore, error]
+
+// MockSignatureVerifier is a mock of SignatureVerifier interface.
+type MockSignatureVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignatureVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockSignatureVerifierMockRecorder is the mock recorder for MockSignatureVerifier.
+type MockSignatureVerifierMockRecorder struct {
+	mock           *MockSignatureVerifier
+	enabledExpects []*gomock.Call1_2[context.Context, bool, error]
+	verifyExpects  []*gomock.Call4_2[context.Context, string, io.Reader, []byte, charmsignature.Verification, error]
+}
+
+// NewMockSignatureVerifier creates a new mock instance.
+func NewMockSignatureVerifier(ctrl *gomock.Controller) *MockSignatureVerifier {
+	mock := &MockSignatureVerifier{ctrl: ctrl}
+	mock.recorder = &MockSignatureVerifierMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignatureVerifier) EXPECT() *MockSignatureVerifierMockRecorder {
+	return m.recorder
+}
+
+// Enabled mocks base method.
+func (m *MockSignatureVerifier) Enabled(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.enabledExpects, m.ctrl, m, "Enabled", ctx)
+}
+
+// Enabled indicates an expected call of Enabled.
+func (mr *MockSignatureVerifierMockRecorder) Enabled(ctx any) *MockSignatureVerifierEnabledCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, bool, error](mr.mock.ctrl.T, mr.mock, "Enabled", gomock.EnsureMatcher(ctx))
+	mr.enabledExpects = append(mr.enabledExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSignatureVerifierEnabledCall is the typed call wrapper for Enabled.
+type MockSignatureVerifierEnabledCall = gomock.Call1_2[context.Context, bool, error]
+
+// Verify mocks base method.
+func (m *MockSignatureVerifier) Verify(ctx context.Context, namespace string, r io.Reader, signature []byte) (charmsignature.Verification, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_2(&m.recorder.verifyExpects, m.ctrl, m, "Verify", ctx, namespace, r, signature)
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockSignatureVerifierMockRecorder) Verify(ctx, namespace, r, signature any) *MockSignatureVerifierVerifyCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_2[context.Context, string, io.Reader, []byte, charmsignature.Verification, error](mr.mock.ctrl.T, mr.mock, "Verify", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(namespace), gomock.EnsureMatcher(r), gomock.EnsureMatcher(signature))
+	mr.verifyExpects = append(mr.verifyExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSignatureVerifierVerifyCall is the typed call wrapper for Verify.
+type MockSignatureVerifierVerifyCall = gomock.Call4_2[context.Context, string, io.Reader, []byte, charmsignature.Verification, error]
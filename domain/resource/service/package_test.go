@@ -3,5 +3,5 @@
 
 package service
 
-//go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/resource/service State,ResourceStoreGetter
+//go:generate go run github.com/canonical/gomock/mockgen -package service -destination package_mock_test.go github.com/juju/juju/domain/resource/service State,ResourceStoreGetter,SignatureVerifier
 //go:generate go run github.com/canonical/gomock/mockgen -package service -destination resource_store_mock_test.go github.com/juju/juju/core/resource/store ResourceStore
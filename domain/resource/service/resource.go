@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"io"
+	"os"
 	"regexp"
 
 	coreapplication "github.com/juju/juju/core/application"
@@ -18,6 +19,7 @@ import (
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/domain/resource"
 	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
 )
@@ -184,6 +186,17 @@ type ResourceStoreGetter interface {
 	GetResourceStore(context.Context, charmresource.Type) (coreresourcestore.ResourceStore, error)
 }
 
+// SignatureVerifier verifies the detached signature of a resource blob,
+// according to the model's signature policy.
+type SignatureVerifier interface {
+	// Enabled reports whether signatures are checked at all.
+	Enabled(ctx context.Context) (bool, error)
+
+	// Verify checks the signature over the blob read from r, in the given
+	// namespace. A nil signature means the blob is unsigned.
+	Verify(ctx context.Context, namespace string, r io.Reader, signature []byte) (charmsignature.Verification, error)
+}
+
 const (
 	// applicationSnippet is a non-compiled regexp that can be composed with
 	// other snippets to form a valid application regexp.
@@ -200,17 +213,20 @@ type Service struct {
 	logger logger.Logger
 
 	resourceStoreGetter ResourceStoreGetter
+	signatureVerifier   SignatureVerifier
 }
 
 // NewService returns a new service reference wrapping the input state.
 func NewService(
 	st State,
 	resourceStoreGetter ResourceStoreGetter,
+	signatureVerifier SignatureVerifier,
 	logger logger.Logger,
 ) *Service {
 	return &Service{
 		st:                  st,
 		resourceStoreGetter: resourceStoreGetter,
+		signatureVerifier:   signatureVerifier,
 		logger:              logger,
 	}
 }
@@ -467,11 +483,17 @@ func (s *Service) storeResource(
 		return errors.Errorf("getting resource store for %s: %w", resourceType.String(), err)
 	}
 
+	reader, closer, err := s.verifyResource(ctx, args)
+	if err != nil {
+		return errors.Errorf("resource %q: %w", resName, err)
+	}
+	defer closer()
+
 	path := args.ResourceUUID.String()
 	storageUUID, size, fingerprint, err := store.Put(
 		ctx,
 		path,
-		args.Reader,
+		reader,
 		args.Size,
 		coreresourcestore.NewFingerprint(args.Fingerprint.Fingerprint),
 	)
@@ -511,6 +533,60 @@ func (s *Service) storeResource(
 	return err
 }
 
+// verifyResource checks the signature of the resource blob before it is
+// stored. The blob is buffered to a temporary file to be verified, so the
+// returned reader must be used in place of the one in the args, and the
+// returned function called once it's no longer needed.
+func (s *Service) verifyResource(ctx context.Context, args resource.StoreResourceArgs) (_ io.Reader, _ func(), err error) {
+	noop := func() {}
+	if args.SkipSignatureVerification {
+		return args.Reader, noop, nil
+	}
+	if enabled, err := s.signatureVerifier.Enabled(ctx); err != nil {
+		return nil, noop, errors.Capture(err)
+	} else if !enabled {
+		return args.Reader, noop, nil
+	}
+
+	file, err := os.CreateTemp("", "resource-")
+	if err != nil {
+		return nil, noop, errors.Errorf("creating temporary file: %w", err)
+	}
+	closer := func() {
+		if err := file.Close(); err != nil {
+			s.logger.Errorf(ctx, "closing temporary file: %v", err)
+		}
+		if err := os.Remove(file.Name()); err != nil {
+			s.logger.Errorf(ctx, "removing temporary file: %v", err)
+		}
+	}
+	defer func() {
+		if err != nil {
+			closer()
+		}
+	}()
+
+	if _, err := io.Copy(file, args.Reader); err != nil {
+		return nil, noop, errors.Errorf("buffering resource: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, noop, errors.Errorf("seeking temporary file: %w", err)
+	}
+
+	verification, err := s.signatureVerifier.Verify(ctx, charmsignature.ResourceNamespace, file, args.Signature)
+	if err != nil {
+		return nil, noop, errors.Errorf("verifying resource: %w", err)
+	}
+	if !verification.IsZero() {
+		s.logger.Infof(ctx, "resource %s signed by %q (%s)", args.ResourceUUID, verification.Signer, verification.Fingerprint)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, noop, errors.Errorf("seeking temporary file: %w", err)
+	}
+	return file, closer, nil
+}
+
 // OpenResource returns the details of and a reader for the resource.
 //
 // The following error types can be expected to be returned:
@@ -5,6 +5,7 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	charmresource "github.com/juju/juju/domain/deployment/charm/resource"
 	"github.com/juju/juju/domain/resource"
 	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
@@ -39,6 +41,7 @@ type resourceServiceSuite struct {
 	state               *MockState
 	resourceStoreGetter *MockResourceStoreGetter
 	resourceStore       *MockResourceStore
+	signatureVerifier   *MockSignatureVerifier
 
 	service *Service
 }
@@ -336,6 +339,7 @@ func (s *resourceServiceSuite) TestStoreResource(c *tc.C) {
 		"", resourceType.String(), nil,
 	)
 	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), resourceType).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(false, nil)
 	s.resourceStore.EXPECT().Put(
 		gomock.Any(),
 		resourceUUID.String(),
@@ -403,6 +407,7 @@ func (s *resourceServiceSuite) TestStoreResourceRemovedOnRecordError(c *tc.C) {
 		"", resourceType.String(), nil,
 	)
 	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), resourceType).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(false, nil)
 	s.resourceStore.EXPECT().Put(
 		gomock.Any(),
 		resourceUUID.String(),
@@ -441,6 +446,121 @@ func (s *resourceServiceSuite) TestStoreResourceRemovedOnRecordError(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, expectedErr)
 }
 
+func (s *resourceServiceSuite) TestStoreResourceVerifiesSignature(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resourceUUID := resourcetesting.GenResourceUUID(c)
+	resourceType := charmresource.TypeFile
+
+	fp, err := charmresource.NewFingerprint(fingerprint)
+	c.Assert(err, tc.ErrorIsNil)
+	storeFP := coreresourcestore.NewFingerprint(fp.Fingerprint)
+	size := int64(12)
+
+	storageID := storetesting.GenFileResourceStoreID(c, objectstoretesting.GenObjectStoreUUID(c))
+	s.state.EXPECT().GetResourceNameAndType(gomock.Any(), resourceUUID).Return(
+		"", resourceType.String(), nil,
+	)
+	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), resourceType).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(true, nil)
+	s.signatureVerifier.EXPECT().Verify(gomock.Any(), charmsignature.ResourceNamespace, gomock.Any(), []byte("sig")).
+		DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ []byte) (charmsignature.Verification, error) {
+			data, err := io.ReadAll(r)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "spamspamspam")
+			return charmsignature.Verification{Signer: "release-team"}, nil
+		})
+
+	// The verified blob is stored, not the drained reader.
+	var stored string
+	s.resourceStore.EXPECT().Put(gomock.Any(), resourceUUID.String(), gomock.Any(), size, storeFP).
+		DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ int64, _ coreresourcestore.Fingerprint) (coreresourcestore.ID, int64, coreresourcestore.Fingerprint, error) {
+			data, err := io.ReadAll(r)
+			c.Assert(err, tc.ErrorIsNil)
+			stored = string(data)
+			return storageID, size, storeFP, nil
+		})
+	s.state.EXPECT().RecordStoredResource(gomock.Any(), gomock.Any())
+	s.state.EXPECT().GetResourceWithoutApplication(gomock.Any(), resourceUUID).Return(coreresource.Resource{}, nil)
+
+	_, err = s.service.StoreResource(
+		c.Context(),
+		resource.StoreResourceArgs{
+			ResourceUUID: resourceUUID,
+			Reader:       bytes.NewBufferString("spamspamspam"),
+			Size:         size,
+			Fingerprint:  fp,
+			Signature:    []byte("sig"),
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(stored, tc.Equals, "spamspamspam")
+}
+
+func (s *resourceServiceSuite) TestStoreResourceSignatureRejected(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resourceUUID := resourcetesting.GenResourceUUID(c)
+	resourceType := charmresource.TypeFile
+
+	fp, err := charmresource.NewFingerprint(fingerprint)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The blob must never reach the resource store.
+	s.state.EXPECT().GetResourceNameAndType(gomock.Any(), resourceUUID).Return(
+		"", resourceType.String(), nil,
+	)
+	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), resourceType).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(true, nil)
+	s.signatureVerifier.EXPECT().Verify(gomock.Any(), charmsignature.ResourceNamespace, gomock.Any(), nil).
+		Return(charmsignature.Verification{}, charmsignature.ErrSignatureNotFound)
+
+	_, err = s.service.StoreResource(
+		c.Context(),
+		resource.StoreResourceArgs{
+			ResourceUUID: resourceUUID,
+			Reader:       bytes.NewBufferString("spamspamspam"),
+			Size:         12,
+			Fingerprint:  fp,
+		},
+	)
+	c.Assert(err, tc.ErrorIs, charmsignature.ErrSignatureNotFound)
+}
+
+func (s *resourceServiceSuite) TestStoreResourceSkipSignatureVerification(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resourceUUID := resourcetesting.GenResourceUUID(c)
+	resourceType := charmresource.TypeFile
+
+	reader := bytes.NewBufferString("spamspamspam")
+	fp, err := charmresource.NewFingerprint(fingerprint)
+	c.Assert(err, tc.ErrorIsNil)
+	storeFP := coreresourcestore.NewFingerprint(fp.Fingerprint)
+	size := int64(12)
+
+	storageID := storetesting.GenFileResourceStoreID(c, objectstoretesting.GenObjectStoreUUID(c))
+	s.state.EXPECT().GetResourceNameAndType(gomock.Any(), resourceUUID).Return(
+		"", resourceType.String(), nil,
+	)
+	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), resourceType).Return(s.resourceStore, nil)
+	s.resourceStore.EXPECT().Put(gomock.Any(), resourceUUID.String(), reader, size, storeFP).Return(storageID, size, storeFP, nil)
+	s.state.EXPECT().RecordStoredResource(gomock.Any(), gomock.Any())
+	s.state.EXPECT().GetResourceWithoutApplication(gomock.Any(), resourceUUID).Return(coreresource.Resource{}, nil)
+
+	_, err = s.service.StoreResource(
+		c.Context(),
+		resource.StoreResourceArgs{
+			ResourceUUID:              resourceUUID,
+			Reader:                    reader,
+			Size:                      size,
+			Fingerprint:               fp,
+			SkipSignatureVerification: true,
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *resourceServiceSuite) TestStoreResourceDoesNotStoreIdenticalBlobContainer(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	// Arrange: We only expect a call to GetResource, not to
@@ -456,6 +576,7 @@ func (s *resourceServiceSuite) TestStoreResourceDoesNotStoreIdenticalBlobContain
 	)
 
 	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), charmresource.TypeContainerImage).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(false, nil)
 	s.resourceStore.EXPECT().Put(
 		gomock.Any(),
 		resourceUUID.String(),
@@ -493,6 +614,7 @@ func (s *resourceServiceSuite) TestStoreResourceDoesNotStoreIdenticalBlobFile(c
 	)
 
 	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), charmresource.TypeFile).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(false, nil)
 	s.resourceStore.EXPECT().Put(
 		gomock.Any(),
 		resourceUUID.String(),
@@ -648,6 +770,7 @@ func (s *resourceServiceSuite) TestStoreResourceAndIncrementCharmModifiedVersion
 		"", resourceType.String(), nil,
 	)
 	s.resourceStoreGetter.EXPECT().GetResourceStore(gomock.Any(), resourceType).Return(s.resourceStore, nil)
+	s.signatureVerifier.EXPECT().Enabled(gomock.Any()).Return(false, nil)
 	s.resourceStore.EXPECT().Put(
 		gomock.Any(),
 		resourceUUID.String(),
@@ -1541,13 +1664,15 @@ func (s *resourceServiceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.state = NewMockState(ctrl)
 	s.resourceStoreGetter = NewMockResourceStoreGetter(ctrl)
 	s.resourceStore = NewMockResourceStore(ctrl)
+	s.signatureVerifier = NewMockSignatureVerifier(ctrl)
 
-	s.service = NewService(s.state, s.resourceStoreGetter, loggertesting.WrapCheckLog(c))
+	s.service = NewService(s.state, s.resourceStoreGetter, s.signatureVerifier, loggertesting.WrapCheckLog(c))
 
 	c.Cleanup(func() {
 		s.state = nil
 		s.resourceStoreGetter = nil
 		s.resourceStore = nil
+		s.signatureVerifier = nil
 		s.service = nil
 	})
 
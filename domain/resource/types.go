@@ -72,6 +72,13 @@ type StoreResourceArgs struct {
 	Size int64
 	// Fingerprint is the hash of the resource blob.
 	Fingerprint charmresource.Fingerprint
+	// Signature is the armored detached signature of the resource blob. It
+	// is nil if the resource blob is unsigned.
+	Signature []byte
+	// SkipSignatureVerification stores the resource blob without checking
+	// its signature. This is only for blobs that were accepted by another
+	// model, such as during migration.
+	SkipSignatureVerification bool
 }
 
 // Validate ensures that all values in StoreResourceArgs
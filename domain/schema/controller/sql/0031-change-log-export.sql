@@ -0,0 +1,15 @@
+-- The change log export cursor table tracks the position of consumers
+-- reading the change log from outside of the controller. The change log is
+-- retained for a consumer as long as it keeps reading it, up to the export
+-- retention period, after which the cursor is discarded. Consumers are
+-- scoped to the user that created them.
+CREATE TABLE change_log_export_cursor (
+    owner TEXT NOT NULL,
+    consumer TEXT NOT NULL,
+    position INT NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
+    PRIMARY KEY (owner, consumer)
+);
+
+CREATE INDEX idx_change_log_export_cursor_position
+ON change_log_export_cursor (position, updated_at);
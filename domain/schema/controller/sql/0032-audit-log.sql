@@ -0,0 +1,69 @@
+-- The audit log tables hold the audit records of API conversations held
+-- with the controller. A conversation is a single client connection, and
+-- each API request made in it is recorded along with its outcome. Records
+-- are pruned once they are older than the audit log retention period.
+CREATE TABLE audit_log_conversation (
+    conversation_id TEXT NOT NULL PRIMARY KEY,
+    connection_id TEXT NOT NULL,
+    who TEXT NOT NULL,
+    what TEXT NOT NULL,
+    model_name TEXT NOT NULL,
+    model_uuid TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE INDEX idx_audit_log_conversation_who
+ON audit_log_conversation (who);
+
+CREATE INDEX idx_audit_log_conversation_created_at
+ON audit_log_conversation (created_at);
+
+CREATE TABLE audit_log_outcome (
+    id INT PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_audit_log_outcome_name
+ON audit_log_outcome (name);
+
+INSERT INTO audit_log_outcome VALUES
+(0, 'unknown'),
+(1, 'succeeded'),
+(2, 'failed');
+
+CREATE TABLE audit_log_request (
+    conversation_id TEXT NOT NULL,
+    request_id INT NOT NULL,
+    facade TEXT NOT NULL,
+    method TEXT NOT NULL,
+    version INT NOT NULL,
+    args TEXT NOT NULL DEFAULT '',
+    outcome_id INT NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL,
+    responded_at DATETIME,
+    PRIMARY KEY (conversation_id, request_id),
+    CONSTRAINT fk_audit_log_request_conversation
+    FOREIGN KEY (conversation_id)
+    REFERENCES audit_log_conversation (conversation_id),
+    CONSTRAINT fk_audit_log_request_outcome
+    FOREIGN KEY (outcome_id)
+    REFERENCES audit_log_outcome (id)
+);
+
+CREATE INDEX idx_audit_log_request_created_at
+ON audit_log_request (created_at);
+
+CREATE INDEX idx_audit_log_request_facade_method
+ON audit_log_request (facade, method);
+
+CREATE TABLE audit_log_request_error (
+    conversation_id TEXT NOT NULL,
+    request_id INT NOT NULL,
+    idx INT NOT NULL,
+    code TEXT NOT NULL,
+    message TEXT NOT NULL,
+    PRIMARY KEY (conversation_id, request_id, idx),
+    CONSTRAINT fk_audit_log_request_error_request
+    FOREIGN KEY (conversation_id, request_id)
+    REFERENCES audit_log_request (conversation_id, request_id)
+);
@@ -0,0 +1,37 @@
+-- Application permissions grant access to a single application within a
+-- model. Read, write and admin access are granted on the application, whose
+-- grant_on value is the model UUID and the application UUID separated by a
+-- colon. Each of the operation specific access types below is granted on an
+-- object of its own, whose grant_on value is that of the application followed
+-- by a colon and the access type, so that they can be combined.
+INSERT INTO permission_access_type VALUES
+(7, 'run-action'),
+(8, 'config'),
+(9, 'refresh'),
+(10, 'scale');
+
+INSERT INTO permission_object_type VALUES
+(4, 'application');
+
+INSERT INTO permission_object_access VALUES
+(10, 0, 4), -- read, application
+(11, 1, 4), -- write, application
+(12, 3, 4), -- admin, application
+(13, 7, 4), -- run-action, application
+(14, 8, 4), -- config, application
+(15, 9, 4), -- refresh, application
+(16, 10, 4); -- scale, application
+
+-- All application permissions, verifying the model of the application does
+-- exist. The application itself lives in the model database, so it is NOT
+-- verified here.
+CREATE VIEW v_permission_application AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type
+FROM v_permission AS p
+JOIN model ON SUBSTR(p.grant_on, 1, INSTR(p.grant_on, ':') - 1) = model.uuid
+WHERE p.object_type = 'application';
@@ -0,0 +1,52 @@
+-- A user group is a named set of users which permissions can be granted to
+-- as a whole. Users external to the controller, authenticated by an
+-- identity provider, are placed in groups by the claims of their token.
+CREATE TABLE user_group (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_by_uuid TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    CONSTRAINT fk_user_group_created_by_user
+    FOREIGN KEY (created_by_uuid)
+    REFERENCES user (uuid)
+);
+
+CREATE UNIQUE INDEX idx_user_group_name
+ON user_group (name);
+
+-- Permissions granted to a group are held by every user in the group, in
+-- addition to the permissions granted to the user directly. The grant_on
+-- column holds the same values as that of the permission table.
+CREATE TABLE user_group_permission (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    access_type_id INT NOT NULL,
+    object_type_id INT NOT NULL,
+    grant_on TEXT NOT NULL, -- name or uuid of the object
+    grant_to TEXT NOT NULL,
+    CONSTRAINT fk_user_group_permission_group
+    FOREIGN KEY (grant_to)
+    REFERENCES user_group (uuid),
+    CONSTRAINT fk_user_group_permission_object_access
+    FOREIGN KEY (access_type_id, object_type_id)
+    REFERENCES permission_object_access (access_type_id, object_type_id)
+);
+
+-- Allow only 1 combination of grant_on and grant_to
+CREATE UNIQUE INDEX idx_user_group_permission_grant_on_grant_to
+ON user_group_permission (grant_on, grant_to);
+
+CREATE INDEX idx_user_group_permission_grant_to
+ON user_group_permission (grant_to);
+
+CREATE VIEW v_user_group_permission AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    g.name AS group_name,
+    at.type AS access_type,
+    ot.type AS object_type
+FROM user_group_permission AS p
+JOIN user_group AS g ON p.grant_to = g.uuid
+JOIN permission_access_type AS at ON p.access_type_id = at.id
+JOIN permission_object_type AS ot ON p.object_type_id = ot.id;
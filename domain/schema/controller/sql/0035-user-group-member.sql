@@ -0,0 +1,30 @@
+-- The members of a user group hold every permission granted to the group,
+-- in addition to the permissions granted to them directly.
+CREATE TABLE user_group_member (
+    group_uuid TEXT NOT NULL,
+    user_uuid TEXT NOT NULL,
+    CONSTRAINT fk_user_group_member_group
+    FOREIGN KEY (group_uuid)
+    REFERENCES user_group (uuid),
+    CONSTRAINT fk_user_group_member_user
+    FOREIGN KEY (user_uuid)
+    REFERENCES user (uuid),
+    PRIMARY KEY (group_uuid, user_uuid)
+);
+
+CREATE INDEX idx_user_group_member_user
+ON user_group_member (user_uuid);
+
+-- Removed and disabled users hold no permissions through their groups.
+CREATE VIEW v_user_group_member AS
+SELECT
+    g.uuid AS group_uuid,
+    g.name AS group_name,
+    u.uuid AS user_uuid,
+    u.name AS user_name
+FROM user_group_member AS m
+JOIN user_group AS g ON m.group_uuid = g.uuid
+JOIN v_user_auth AS u ON m.user_uuid = u.uuid
+WHERE
+    u.removed = false
+    AND COALESCE(u.disabled, false) = false;
@@ -0,0 +1,12 @@
+-- Credentials used by the controller to pull charms and bundles from OCI
+-- registries. Registries without an entry are accessed anonymously. The
+-- username and password are held in a secret kept for the controller in the
+-- controller model, which its users can't see or edit, so they are stored by
+-- the controller model's secret backend; only the secret's URI is kept here.
+CREATE TABLE charm_registry_credential (
+    registry TEXT NOT NULL PRIMARY KEY,
+    secret_uri TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_charm_registry_credential_secret_uri
+ON charm_registry_credential (secret_uri);
@@ -0,0 +1,93 @@
+-- Charm and bundle revisions served by the controller's Charmhub mirror.
+-- The archive for each revision is held in the controller's object store.
+-- Revisions cached from the upstream record when they were last cached, and
+-- are evicted oldest first once the mirror is over its size limit. Seeded
+-- revisions have no cached_at and are never evicted.
+CREATE TABLE charm_mirror_entity (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    charmhub_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    revision INT NOT NULL,
+    version TEXT NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    license TEXT NOT NULL DEFAULT '',
+    publisher TEXT NOT NULL DEFAULT '',
+    metadata_yaml TEXT NOT NULL DEFAULT '',
+    config_yaml TEXT NOT NULL DEFAULT '',
+    actions_yaml TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL,
+    object_store_uuid TEXT NOT NULL,
+    cached_at DATETIME,
+    CONSTRAINT chk_charm_mirror_entity_type
+    CHECK (type IN ('charm', 'bundle')),
+    CONSTRAINT fk_charm_mirror_entity_object_store_metadata
+    FOREIGN KEY (object_store_uuid)
+    REFERENCES object_store_metadata (uuid)
+);
+
+CREATE UNIQUE INDEX idx_charm_mirror_entity_name_revision
+ON charm_mirror_entity (name, revision);
+
+CREATE INDEX idx_charm_mirror_entity_charmhub_id
+ON charm_mirror_entity (charmhub_id);
+
+CREATE INDEX idx_charm_mirror_entity_object_store_uuid
+ON charm_mirror_entity (object_store_uuid);
+
+CREATE INDEX idx_charm_mirror_entity_cached_at
+ON charm_mirror_entity (cached_at);
+
+-- The bases a mirrored revision supports.
+CREATE TABLE charm_mirror_base (
+    entity_uuid TEXT NOT NULL,
+    architecture TEXT NOT NULL,
+    os TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    PRIMARY KEY (entity_uuid, architecture, os, channel),
+    CONSTRAINT fk_charm_mirror_base_entity
+    FOREIGN KEY (entity_uuid)
+    REFERENCES charm_mirror_entity (uuid)
+);
+
+-- The channels a mirrored revision has been released to. A channel resolves
+-- to the most recently released revision in it.
+CREATE TABLE charm_mirror_channel (
+    entity_uuid TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    released_at DATETIME NOT NULL,
+    PRIMARY KEY (entity_uuid, channel),
+    CONSTRAINT fk_charm_mirror_channel_entity
+    FOREIGN KEY (entity_uuid)
+    REFERENCES charm_mirror_entity (uuid)
+);
+
+-- The clients allowed to use the mirror, which are other controllers. A
+-- client authenticates with basic auth as its name and password; only the
+-- salted hash of the password is held.
+CREATE TABLE charm_mirror_client (
+    name TEXT NOT NULL PRIMARY KEY,
+    password_hash TEXT NOT NULL,
+    password_salt TEXT NOT NULL
+);
+
+CREATE VIEW v_charm_mirror_entity AS
+SELECT
+    e.uuid,
+    e.charmhub_id,
+    e.name,
+    e.type,
+    e.revision,
+    e.version,
+    e.summary,
+    e.license,
+    e.publisher,
+    e.metadata_yaml,
+    e.config_yaml,
+    e.actions_yaml,
+    e.created_at,
+    osm.sha_256,
+    osm.sha_384,
+    osm.size
+FROM charm_mirror_entity AS e
+JOIN object_store_metadata AS osm ON e.object_store_uuid = osm.uuid;
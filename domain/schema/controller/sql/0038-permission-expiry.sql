@@ -0,0 +1,151 @@
+-- A time-limited permission expires at expires_at, after which the access
+-- reverts to revert_access_type_id, or is removed if it is NULL. Permanent
+-- permissions have a NULL expires_at.
+ALTER TABLE permission ADD COLUMN expires_at DATETIME;
+
+ALTER TABLE permission ADD COLUMN revert_access_type_id INT
+CONSTRAINT fk_permission_revert_access_type
+REFERENCES permission_access_type (id)
+CONSTRAINT chk_permission_revert_expires
+CHECK (revert_access_type_id IS NULL OR expires_at IS NOT NULL);
+
+CREATE INDEX idx_permission_expires_at
+ON permission (expires_at);
+
+-- A column added to an existing table can not take part in a composite
+-- foreign key, so the access a permission reverts to is verified against
+-- the object type of the permission here instead.
+CREATE TRIGGER trg_permission_revert_object_access_insert
+BEFORE INSERT ON permission
+FOR EACH ROW
+WHEN NEW.revert_access_type_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM permission_object_access
+    WHERE
+        access_type_id = NEW.revert_access_type_id
+        AND object_type_id = NEW.object_type_id
+)
+BEGIN
+    SELECT RAISE(FAIL, 'revert access type is not valid for the object type');
+END;
+
+CREATE TRIGGER trg_permission_revert_object_access_update
+BEFORE UPDATE ON permission
+FOR EACH ROW
+WHEN NEW.revert_access_type_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM permission_object_access
+    WHERE
+        access_type_id = NEW.revert_access_type_id
+        AND object_type_id = NEW.object_type_id
+)
+BEGIN
+    SELECT RAISE(FAIL, 'revert access type is not valid for the object type');
+END;
+
+-- The permission views are recreated with the expiry of each permission.
+DROP VIEW v_everyone_external;
+DROP VIEW v_permission_application;
+DROP VIEW v_permission_offer;
+DROP VIEW v_permission_controller;
+DROP VIEW v_permission_cloud;
+DROP VIEW v_permission_model;
+DROP VIEW v_permission;
+
+-- All permissions in effect. A time-limited permission which has expired,
+-- but has yet to be revoked by the controller, is shown with the access it
+-- reverts to, or not at all if it is to be removed.
+CREATE VIEW v_permission AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    at.type AS access_type,
+    ot.type AS object_type,
+    -- The expiry is only shown while it has yet to pass.
+    CASE
+        WHEN JULIANDAY(p.expires_at) > JULIANDAY('now') THEN p.expires_at
+    END AS expires_at
+FROM permission AS p
+JOIN permission_access_type AS at ON at.id = CASE
+    WHEN JULIANDAY(p.expires_at) <= JULIANDAY('now') THEN p.revert_access_type_id
+    ELSE p.access_type_id
+END
+JOIN permission_object_type AS ot ON p.object_type_id = ot.id;
+
+-- All model permissions, verifying the model does exist.
+CREATE VIEW v_permission_model AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type,
+    p.expires_at
+FROM v_permission AS p
+JOIN model ON p.grant_on = model.uuid
+WHERE p.object_type = 'model';
+
+-- All controller cloud, verifying the cloud does exist.
+CREATE VIEW v_permission_cloud AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type,
+    p.expires_at
+FROM v_permission AS p
+JOIN cloud ON p.grant_on = cloud.name
+WHERE p.object_type = 'cloud';
+
+-- All controller permissions, verifying the controller does exists.
+CREATE VIEW v_permission_controller AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type,
+    p.expires_at
+FROM v_permission AS p
+JOIN controller ON p.grant_on = controller.uuid
+WHERE p.object_type = 'controller';
+
+-- All offer permissions, NOT verifying the offer does exist.
+CREATE VIEW v_permission_offer AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type,
+    p.expires_at
+FROM v_permission AS p
+WHERE p.object_type = 'offer';
+
+-- All application permissions, verifying the model of the application does
+-- exist. The application itself lives in the model database, so it is NOT
+-- verified here.
+CREATE VIEW v_permission_application AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type,
+    p.expires_at
+FROM v_permission AS p
+JOIN model ON SUBSTR(p.grant_on, 1, INSTR(p.grant_on, ':') - 1) = model.uuid
+WHERE p.object_type = 'application';
+
+-- The permissions for the special user everyone@external.
+CREATE VIEW v_everyone_external AS
+SELECT
+    p.uuid,
+    p.grant_on,
+    p.grant_to,
+    p.access_type,
+    p.object_type,
+    p.expires_at
+FROM v_permission AS p
+JOIN user AS u ON p.grant_to = u.uuid
+WHERE u.name = 'everyone@external';
@@ -100,6 +100,7 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"change_log_edit_type",
 		"change_log_namespace",
 		"change_log_witness",
+		"change_log_export_cursor",
 
 		// Cloud
 		"cloud",
@@ -193,6 +194,11 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"permission_object_type",
 		"permission",
 
+		// User groups
+		"user_group",
+		"user_group_permission",
+		"user_group_member",
+
 		// Secret backends
 		"secret_backend",
 		"secret_backend_config",
@@ -223,6 +229,21 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		// SSH host keys
 		"ssh_key_algorithm_type",
 		"controller_ssh_host_key",
+
+		// Audit log
+		"audit_log_conversation",
+		"audit_log_outcome",
+		"audit_log_request",
+		"audit_log_request_error",
+
+		// Charm registries
+		"charm_registry_credential",
+
+		// Charm mirror
+		"charm_mirror_entity",
+		"charm_mirror_base",
+		"charm_mirror_channel",
+		"charm_mirror_client",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -265,17 +286,23 @@ func (s *controllerSchemaSuite) TestControllerViews(c *tc.C) {
 
 		// Permissions
 		"v_permission",
+		"v_permission_application",
 		"v_permission_cloud",
 		"v_permission_controller",
 		"v_permission_model",
 		"v_permission_offer",
 		"v_everyone_external",
+		"v_user_group_permission",
+		"v_user_group_member",
 
 		// Object store metadata
 		"v_object_store_metadata",
 
 		// Agent binary store
 		"v_agent_binary_store",
+
+		// Charm mirror
+		"v_charm_mirror_entity",
 	)
 	c.Assert(readEntityNames(c, s.DB(), "view"), tc.SameContents, expected.SortedValues())
 }
@@ -388,6 +415,8 @@ func (s *controllerSchemaSuite) TestControllerTriggers(c *tc.C) {
 	additional := set.NewStrings(
 		"trg_secret_backend_immutable_update",
 		"trg_secret_backend_immutable_delete",
+		"trg_permission_revert_object_access_insert",
+		"trg_permission_revert_object_access_update",
 	)
 	got := readEntityNames(c, s.DB(), "trigger")
 	wanted := expected.Union(additional)
@@ -424,6 +453,29 @@ func (s *controllerSchemaSuite) TestControllerTriggersForImmutableTables(c *tc.C
 		"built-in secret backends or secret backends with type controller or kubernetes are immutable", backendUUID2)
 }
 
+func (s *controllerSchemaSuite) TestPermissionRevertAccessMatchesObjectType(c *tc.C) {
+	s.applyDDL(c, ControllerDDL())
+
+	userUUID := utils.MustNewUUID().String()
+	s.assertExecSQL(c,
+		"INSERT INTO user (uuid, name, display_name, external, removed, created_by_uuid, created_at) VALUES (?, 'fred', 'Fred', FALSE, FALSE, ?, DATETIME('now'));",
+		userUUID, userUUID)
+
+	// Admin access to a model (3, 2) reverting to read access (0).
+	permUUID := utils.MustNewUUID().String()
+	s.assertExecSQL(c,
+		"INSERT INTO permission (uuid, access_type_id, object_type_id, grant_on, grant_to, expires_at, revert_access_type_id) VALUES (?, 3, 2, 'model-uuid', ?, DATETIME('now', '+1 day'), 0);",
+		permUUID, userUUID)
+
+	// Superuser (6) is not an access level of a model.
+	s.assertExecSQLError(c,
+		"UPDATE permission SET revert_access_type_id = 6 WHERE uuid = ?;",
+		"revert access type is not valid for the object type", permUUID)
+	s.assertExecSQLError(c,
+		"INSERT INTO permission (uuid, access_type_id, object_type_id, grant_on, grant_to, expires_at, revert_access_type_id) VALUES (?, 3, 2, 'other-model-uuid', ?, DATETIME('now', '+1 day'), 6);",
+		"revert access type is not valid for the object type", utils.MustNewUUID().String(), userUUID)
+}
+
 // TestVModelStateMigratingForImportPhases asserts that v_model_state.migrating
 // is true while a model_migration_import claim exists in any of its phases
 // (importing, activating, aborting) and false once the claim is deleted. The
@@ -20,14 +20,14 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/network-triggers.gen.go -package=triggers -tables=subnet,ip_address
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-triggers.gen.go -package=triggers -tables=machine,machine_lxd_profile,machine_cloud_instance,machine_requires_reboot,machine_reprovision
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/ssh-connection-request-triggers.gen.go -package=triggers -tables=ssh_connection_request
-//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/application-triggers.gen.go -package=triggers -tables=application,application_config_hash,application_setting,charm,application_scale,port_range,application_exposed_endpoint_space,application_exposed_endpoint_cidr
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/application-triggers.gen.go -package=triggers -tables=application,application_config_hash,application_setting,charm,application_scale,port_range,application_exposed_endpoint_space,application_exposed_endpoint_cidr,application_refresh_rollout_unit
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/unit-triggers.gen.go -package triggers -tables=unit,unit_principal,unit_resolved
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/relation-triggers.gen.go -package=triggers -tables=relation_application_settings_hash,relation_unit_settings_hash,relation_unit,relation,application_endpoint
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/cleanup-triggers.gen.go -package=triggers -tables=removal
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/operation-triggers.gen.go -package=triggers -tables=operation_task_log
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/crossmodelrelation-triggers.gen.go -package=triggers -tables=application_remote_offerer,application_remote_consumer,relation_network_ingress,relation_network_egress
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/offer-triggers.gen.go -package=triggers -tables=offer
-//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status,machine_status,machine_cloud_instance_status
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -110,6 +110,9 @@ const (
 	tableRelationNetworkEgress
 	tableModelMigrating
 	tableMachineReprovision
+	tableMachineStatus
+	tableMachineCloudInstanceStatus
+	tableApplicationRefreshRolloutUnit
 )
 
 // modelPostPatchFilesByVersion is used to categorise the post patch files
@@ -193,9 +196,12 @@ func ModelDDLForVersion(version semversion.Number) *schema.Schema {
 			tableCrossModelRelationApplicationRemoteConsumers),
 		triggers.ChangeLogTriggersForOffer("uuid", tableOffer),
 		triggers.ChangeLogTriggersForApplicationStatus("application_uuid", tableApplicationStatus),
+		triggers.ChangeLogTriggersForMachineStatus("machine_uuid", tableMachineStatus),
+		triggers.ChangeLogTriggersForMachineCloudInstanceStatus("machine_uuid", tableMachineCloudInstanceStatus),
 		triggers.ChangeLogTriggersForRelationNetworkIngress("relation_uuid", tableRelationNetworkIngress),
 		triggers.ChangeLogTriggersForRelationNetworkEgress("relation_uuid", tableRelationNetworkEgress),
 		triggers.ChangeLogTriggersForModelMigrating("model_uuid", tableModelMigrating),
+		triggers.ChangeLogTriggersForApplicationRefreshRolloutUnit("application_uuid", tableApplicationRefreshRolloutUnit),
 	)
 
 	// Generic triggers.
@@ -23,7 +23,8 @@ ON charm_source (name);
 INSERT INTO charm_source VALUES
 (0, 'local'),
 (1, 'charmhub'),
-(2, 'cmr');
+(2, 'cmr'),
+(3, 'oci');
 
 -- The charm table exists as the nexus to all charm data. 
 --
@@ -82,9 +83,9 @@ CREATE TABLE charm (
     FOREIGN KEY (object_store_uuid)
     REFERENCES object_store_metadata (uuid),
 
-    -- Ensure we have an architecture if the source is local or charmhub.
+    -- Ensure we have an architecture if the source is local, charmhub or oci.
     CONSTRAINT chk_charm_architecture
-    CHECK (((source_id = 0 OR source_id = 1) AND architecture_id >= 0) OR (source_id = 2 AND architecture_id IS NULL)),
+    CHECK (((source_id = 0 OR source_id = 1 OR source_id = 3) AND architecture_id >= 0) OR (source_id = 2 AND architecture_id IS NULL)),
 
     -- Ensure we don't have an empty reference
     CONSTRAINT chk_charm_reference_name
@@ -139,6 +140,18 @@ CREATE TABLE charm_download_info (
     REFERENCES charm (uuid)
 );
 
+-- charm_signature records the trusted key that signed the charm archive. A
+-- charm without a row here was not signed, or its signature could not be
+-- verified and was accepted under the "warn" charm-signature-policy.
+CREATE TABLE charm_signature (
+    charm_uuid TEXT NOT NULL PRIMARY KEY,
+    signer TEXT NOT NULL,
+    key_fingerprint TEXT NOT NULL,
+    CONSTRAINT fk_charm_signature_charm
+    FOREIGN KEY (charm_uuid)
+    REFERENCES charm (uuid)
+);
+
 CREATE VIEW v_application_charm_download_info AS
 SELECT
     a.uuid AS application_uuid,
@@ -4,6 +4,7 @@ SELECT
     a.name,
     c.reference_name,
     c.revision,
+    c.source_id AS charm_source_id,
     c.architecture_id AS charm_architecture_id,
     ac.track AS channel_track,
     ac.risk AS channel_risk,
@@ -17,7 +18,7 @@ LEFT JOIN charm AS c ON a.charm_uuid = c.uuid
 LEFT JOIN application_channel AS ac ON a.uuid = ac.application_uuid
 LEFT JOIN application_platform AS ap ON a.uuid = ap.application_uuid
 LEFT JOIN charm_download_info AS cdi ON c.uuid = cdi.charm_uuid
-WHERE a.life_id = 0 AND c.source_id = 1;
+WHERE a.life_id = 0 AND c.source_id IN (1, 3);
 
 CREATE VIEW v_revision_updater_application_unit AS
 SELECT
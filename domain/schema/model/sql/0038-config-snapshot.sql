@@ -0,0 +1,92 @@
+-- A config snapshot records the operator controlled configuration of the
+-- model at a point in time, so that it can be rolled back to. Applications
+-- are referenced by name rather than by UUID, as a snapshot outlives the
+-- applications it records.
+CREATE TABLE config_snapshot (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at DATETIME NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_config_snapshot_name
+ON config_snapshot (name);
+
+CREATE TABLE config_snapshot_model_config (
+    snapshot_uuid TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_model_config_snapshot
+    FOREIGN KEY (snapshot_uuid)
+    REFERENCES config_snapshot (uuid),
+    PRIMARY KEY (snapshot_uuid, "key")
+);
+
+CREATE TABLE config_snapshot_application (
+    snapshot_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    charm_name TEXT NOT NULL,
+    charm_source TEXT NOT NULL,
+    charm_revision INT NOT NULL,
+    constraints TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_application_snapshot
+    FOREIGN KEY (snapshot_uuid)
+    REFERENCES config_snapshot (uuid),
+    PRIMARY KEY (snapshot_uuid, application_name)
+);
+
+CREATE TABLE config_snapshot_application_config (
+    snapshot_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_application_config_application
+    FOREIGN KEY (snapshot_uuid, application_name)
+    REFERENCES config_snapshot_application (snapshot_uuid, application_name),
+    PRIMARY KEY (snapshot_uuid, application_name, "key")
+);
+
+-- An empty endpoint name records the default space of the application.
+CREATE TABLE config_snapshot_application_binding (
+    snapshot_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    space_name TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_application_binding_application
+    FOREIGN KEY (snapshot_uuid, application_name)
+    REFERENCES config_snapshot_application (snapshot_uuid, application_name),
+    PRIMARY KEY (snapshot_uuid, application_name, endpoint)
+);
+
+-- An empty endpoint name records the exposure of all the endpoints of the
+-- application.
+CREATE TABLE config_snapshot_application_exposed_endpoint (
+    snapshot_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_application_exposed_endpoint_application
+    FOREIGN KEY (snapshot_uuid, application_name)
+    REFERENCES config_snapshot_application (snapshot_uuid, application_name),
+    PRIMARY KEY (snapshot_uuid, application_name, endpoint)
+);
+
+CREATE TABLE config_snapshot_application_exposed_space (
+    snapshot_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    space_name TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_application_exposed_space_endpoint
+    FOREIGN KEY (snapshot_uuid, application_name, endpoint)
+    REFERENCES config_snapshot_application_exposed_endpoint (snapshot_uuid, application_name, endpoint),
+    PRIMARY KEY (snapshot_uuid, application_name, endpoint, space_name)
+);
+
+CREATE TABLE config_snapshot_application_exposed_cidr (
+    snapshot_uuid TEXT NOT NULL,
+    application_name TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    cidr TEXT NOT NULL,
+    CONSTRAINT fk_config_snapshot_application_exposed_cidr_endpoint
+    FOREIGN KEY (snapshot_uuid, application_name, endpoint)
+    REFERENCES config_snapshot_application_exposed_endpoint (snapshot_uuid, application_name, endpoint),
+    PRIMARY KEY (snapshot_uuid, application_name, endpoint, cidr)
+);
@@ -0,0 +1,67 @@
+-- A refresh rollout stages the refresh of an application's charm across its
+-- units, a batch at a time. The application is set to the new charm when the
+-- rollout starts, but units only follow it once they have been released into
+-- a batch. Until then they keep running the previous charm. A rollout is
+-- removed once all units are refreshed; a rolled back rollout is kept until the
+-- application is next refreshed, so that the reason can be reported.
+CREATE TABLE application_refresh_rollout_status (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_application_refresh_rollout_status
+ON application_refresh_rollout_status (status);
+
+INSERT INTO application_refresh_rollout_status VALUES
+(0, 'rolling'),
+(1, 'paused'),
+(2, 'rolled-back');
+
+CREATE TABLE application_refresh_rollout (
+    application_uuid TEXT NOT NULL PRIMARY KEY,
+    charm_uuid TEXT NOT NULL,
+    previous_charm_uuid TEXT NOT NULL,
+    -- The channel of the previous charm, restored if the rollout is rolled
+    -- back.
+    previous_track TEXT,
+    previous_risk TEXT,
+    previous_branch TEXT,
+    -- Either the number of units or the percentage of the application's units
+    -- released in each batch.
+    batch_size INT NOT NULL,
+    batch_percent INT NOT NULL,
+    -- The number of seconds the units in a batch have to become active.
+    batch_timeout INT NOT NULL,
+    rollback_on_error BOOLEAN NOT NULL,
+    status_id INT NOT NULL,
+    message TEXT,
+    -- The time the last batch was released, or the rollout was continued.
+    batch_started_at DATETIME,
+    CONSTRAINT fk_application_refresh_rollout_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_application_refresh_rollout_charm
+    FOREIGN KEY (charm_uuid)
+    REFERENCES charm (uuid),
+    CONSTRAINT fk_application_refresh_rollout_previous_charm
+    FOREIGN KEY (previous_charm_uuid)
+    REFERENCES charm (uuid),
+    CONSTRAINT fk_application_refresh_rollout_status
+    FOREIGN KEY (status_id)
+    REFERENCES application_refresh_rollout_status (id)
+);
+
+-- The units released to refresh to the charm of the rollout.
+CREATE TABLE application_refresh_rollout_unit (
+    unit_uuid TEXT NOT NULL PRIMARY KEY,
+    application_uuid TEXT NOT NULL,
+    CONSTRAINT fk_application_refresh_rollout_unit_unit
+    FOREIGN KEY (unit_uuid)
+    REFERENCES unit (uuid),
+    CONSTRAINT fk_application_refresh_rollout_unit_rollout
+    FOREIGN KEY (application_uuid)
+    REFERENCES application_refresh_rollout (application_uuid)
+);
+
+CREATE INDEX idx_application_refresh_rollout_unit_application
+ON application_refresh_rollout_unit (application_uuid);
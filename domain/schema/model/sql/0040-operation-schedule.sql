@@ -0,0 +1,84 @@
+-- An operation_schedule runs an action on a cron-style schedule. Each time
+-- the schedule falls due, a new action operation is added, exactly as if the
+-- action had been run by the user who created the schedule. The operations
+-- added by a schedule are linked to it through operation_schedule_run.
+CREATE TABLE operation_schedule (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    -- schedule is the cron expression describing when the action runs.
+    schedule TEXT NOT NULL,
+    action_name TEXT NOT NULL,
+    parallel BOOLEAN DEFAULT false,
+    execution_group TEXT,
+    -- created_by is the name of the user who created the schedule. The
+    -- schedule only runs while the user has write access to the model.
+    created_by TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    -- next_run_at is NULL if the schedule will never fall due again.
+    next_run_at DATETIME,
+    last_run_at DATETIME
+);
+
+CREATE UNIQUE INDEX idx_operation_schedule_name
+ON operation_schedule (name);
+
+CREATE INDEX idx_operation_schedule_next_run_at
+ON operation_schedule (next_run_at);
+
+-- operation_schedule_receiver holds the receivers of the scheduled action.
+-- Receivers are recorded by name rather than by reference, since the units
+-- are resolved each time the schedule runs. The receiver is either a unit
+-- name or, if is_leader is true, the name of an application whose leader
+-- unit is to run the action.
+CREATE TABLE operation_schedule_receiver (
+    schedule_uuid TEXT NOT NULL,
+    receiver TEXT NOT NULL,
+    is_leader BOOLEAN NOT NULL DEFAULT false,
+    PRIMARY KEY (schedule_uuid, receiver, is_leader),
+    CONSTRAINT fk_operation_schedule_receiver_schedule
+    FOREIGN KEY (schedule_uuid)
+    REFERENCES operation_schedule (uuid)
+);
+
+-- operation_schedule_parameter holds the parameters passed to the action each
+-- time the schedule runs.
+CREATE TABLE operation_schedule_parameter (
+    schedule_uuid TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (schedule_uuid, "key"),
+    CONSTRAINT fk_operation_schedule_parameter_schedule
+    FOREIGN KEY (schedule_uuid)
+    REFERENCES operation_schedule (uuid)
+);
+
+-- operation_schedule_creator_group holds the user groups the identity provider
+-- reported the creator of the schedule to be a member of when the schedule was
+-- added. Access granted to these groups counts towards the creator's access
+-- each time the schedule falls due, as the groups can't be checked again with
+-- the identity provider then.
+CREATE TABLE operation_schedule_creator_group (
+    schedule_uuid TEXT NOT NULL,
+    group_name TEXT NOT NULL,
+    PRIMARY KEY (schedule_uuid, group_name),
+    CONSTRAINT fk_operation_schedule_creator_group_schedule
+    FOREIGN KEY (schedule_uuid)
+    REFERENCES operation_schedule (uuid)
+);
+
+-- operation_schedule_run links an operation to the schedule that added it,
+-- and records the user on whose behalf the operation was added.
+CREATE TABLE operation_schedule_run (
+    operation_uuid TEXT NOT NULL PRIMARY KEY,
+    schedule_uuid TEXT NOT NULL,
+    run_by TEXT NOT NULL,
+    CONSTRAINT fk_operation_schedule_run_operation
+    FOREIGN KEY (operation_uuid)
+    REFERENCES operation (uuid),
+    CONSTRAINT fk_operation_schedule_run_schedule
+    FOREIGN KEY (schedule_uuid)
+    REFERENCES operation_schedule (uuid)
+);
+
+CREATE INDEX idx_operation_schedule_run_schedule
+ON operation_schedule_run (schedule_uuid);
@@ -0,0 +1,11 @@
+-- secret_controller_owned records the user secrets of the controller model
+-- which are held on behalf of the controller itself, such as the credentials
+-- it uses to pull charms from OCI registries. The model is not granted manage
+-- access to these secrets, and they are not listed to the model's users, so
+-- only the controller can read, change or remove them.
+CREATE TABLE secret_controller_owned (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    CONSTRAINT fk_secret_controller_owned_secret_metadata
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
@@ -160,6 +160,42 @@ END;`, columnName, namespaceID))
 	}
 }
 
+// ChangeLogTriggersForApplicationRefreshRolloutUnit generates the triggers for the
+// application_refresh_rollout_unit table.
+func ChangeLogTriggersForApplicationRefreshRolloutUnit(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for ApplicationRefreshRolloutUnit
+INSERT INTO change_log_namespace VALUES (%[2]d, 'application_refresh_rollout_unit', 'ApplicationRefreshRolloutUnit changes based on %[1]s');
+
+-- insert trigger for ApplicationRefreshRolloutUnit
+CREATE TRIGGER trg_log_application_refresh_rollout_unit_insert
+AFTER INSERT ON application_refresh_rollout_unit FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now', 'utc'));
+END;
+
+-- update trigger for ApplicationRefreshRolloutUnit
+CREATE TRIGGER trg_log_application_refresh_rollout_unit_update
+AFTER UPDATE ON application_refresh_rollout_unit FOR EACH ROW
+WHEN 
+	NEW.unit_uuid != OLD.unit_uuid OR
+	NEW.application_uuid != OLD.application_uuid
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;
+-- delete trigger for ApplicationRefreshRolloutUnit
+CREATE TRIGGER trg_log_application_refresh_rollout_unit_delete
+AFTER DELETE ON application_refresh_rollout_unit FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForApplicationScale generates the triggers for the
 // application_scale table.
 func ChangeLogTriggersForApplicationScale(columnName string, namespaceID int) func() schema.Patch {
@@ -48,3 +48,81 @@ END;`, columnName, namespaceID))
 	}
 }
 
+// ChangeLogTriggersForMachineCloudInstanceStatus generates the triggers for the
+// machine_cloud_instance_status table.
+func ChangeLogTriggersForMachineCloudInstanceStatus(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for MachineCloudInstanceStatus
+INSERT INTO change_log_namespace VALUES (%[2]d, 'machine_cloud_instance_status', 'MachineCloudInstanceStatus changes based on %[1]s');
+
+-- insert trigger for MachineCloudInstanceStatus
+CREATE TRIGGER trg_log_machine_cloud_instance_status_insert
+AFTER INSERT ON machine_cloud_instance_status FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now', 'utc'));
+END;
+
+-- update trigger for MachineCloudInstanceStatus
+CREATE TRIGGER trg_log_machine_cloud_instance_status_update
+AFTER UPDATE ON machine_cloud_instance_status FOR EACH ROW
+WHEN 
+	NEW.machine_uuid != OLD.machine_uuid OR
+	NEW.status_id != OLD.status_id OR
+	(NEW.message != OLD.message OR (NEW.message IS NOT NULL AND OLD.message IS NULL) OR (NEW.message IS NULL AND OLD.message IS NOT NULL)) OR
+	(NEW.data != OLD.data OR (NEW.data IS NOT NULL AND OLD.data IS NULL) OR (NEW.data IS NULL AND OLD.data IS NOT NULL)) OR
+	(NEW.updated_at != OLD.updated_at OR (NEW.updated_at IS NOT NULL AND OLD.updated_at IS NULL) OR (NEW.updated_at IS NULL AND OLD.updated_at IS NOT NULL))
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;
+-- delete trigger for MachineCloudInstanceStatus
+CREATE TRIGGER trg_log_machine_cloud_instance_status_delete
+AFTER DELETE ON machine_cloud_instance_status FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;`, columnName, namespaceID))
+	}
+}
+
+// ChangeLogTriggersForMachineStatus generates the triggers for the
+// machine_status table.
+func ChangeLogTriggersForMachineStatus(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for MachineStatus
+INSERT INTO change_log_namespace VALUES (%[2]d, 'machine_status', 'MachineStatus changes based on %[1]s');
+
+-- insert trigger for MachineStatus
+CREATE TRIGGER trg_log_machine_status_insert
+AFTER INSERT ON machine_status FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now', 'utc'));
+END;
+
+-- update trigger for MachineStatus
+CREATE TRIGGER trg_log_machine_status_update
+AFTER UPDATE ON machine_status FOR EACH ROW
+WHEN 
+	NEW.machine_uuid != OLD.machine_uuid OR
+	NEW.status_id != OLD.status_id OR
+	(NEW.message != OLD.message OR (NEW.message IS NOT NULL AND OLD.message IS NULL) OR (NEW.message IS NULL AND OLD.message IS NOT NULL)) OR
+	(NEW.data != OLD.data OR (NEW.data IS NOT NULL AND OLD.data IS NULL) OR (NEW.data IS NULL AND OLD.data IS NOT NULL)) OR
+	(NEW.updated_at != OLD.updated_at OR (NEW.updated_at IS NOT NULL AND OLD.updated_at IS NULL) OR (NEW.updated_at IS NULL AND OLD.updated_at IS NOT NULL))
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;
+-- delete trigger for MachineStatus
+CREATE TRIGGER trg_log_machine_status_delete
+AFTER DELETE ON machine_status FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now', 'utc'));
+END;`, columnName, namespaceID))
+	}
+}
+
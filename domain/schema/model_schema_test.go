@@ -94,6 +94,9 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"application_exposed_endpoint_space",
 		"application_k8s_resources_managed",
 		"application_platform",
+		"application_refresh_rollout",
+		"application_refresh_rollout_status",
+		"application_refresh_rollout_unit",
 		"application_scale",
 		"application_setting",
 		"application_status",
@@ -129,6 +132,17 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"change_log_edit_type",
 		"change_log_namespace",
 		"change_log_witness",
+		"change_log_export_cursor",
+
+		// Config snapshot
+		"config_snapshot",
+		"config_snapshot_model_config",
+		"config_snapshot_application",
+		"config_snapshot_application_config",
+		"config_snapshot_application_binding",
+		"config_snapshot_application_exposed_endpoint",
+		"config_snapshot_application_exposed_space",
+		"config_snapshot_application_exposed_cidr",
 
 		// Model
 		"model",
@@ -251,6 +265,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"charm_resource_kind",
 		"charm_resource",
 		"charm_run_as_kind",
+		"charm_signature",
 		"charm_source",
 		"charm_storage_kind",
 		"charm_storage_property",
@@ -330,6 +345,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_revision_obsolete",
 		"secret_revision_expire",
 		"secret_application_owner",
+		"secret_controller_owned",
 		"secret_model_owner",
 		"secret_unit_owner",
 		"secret_unit_consumer",
@@ -392,6 +408,11 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"operation_task_status_value",
 		"operation_unit_task",
 		"operation_parameter",
+		"operation_schedule",
+		"operation_schedule_creator_group",
+		"operation_schedule_parameter",
+		"operation_schedule_receiver",
+		"operation_schedule_run",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -509,6 +530,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_application_exposed_endpoint_space_insert",
 		"trg_log_application_exposed_endpoint_space_update",
 
+		"trg_log_application_refresh_rollout_unit_delete",
+		"trg_log_application_refresh_rollout_unit_insert",
+		"trg_log_application_refresh_rollout_unit_update",
+
 		"trg_log_application_scale_delete",
 		"trg_log_application_scale_insert",
 		"trg_log_application_scale_update",
@@ -749,6 +774,14 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_application_status_insert",
 		"trg_log_application_status_update",
 
+		"trg_log_machine_status_delete",
+		"trg_log_machine_status_insert",
+		"trg_log_machine_status_update",
+
+		"trg_log_machine_cloud_instance_status_delete",
+		"trg_log_machine_cloud_instance_status_insert",
+		"trg_log_machine_cloud_instance_status_update",
+
 		"trg_log_custom_k8s_pod_status_delete",
 		"trg_log_custom_k8s_pod_status_insert",
 		"trg_log_custom_k8s_pod_status_update",
@@ -62,6 +62,11 @@ func (s *operationSchemaSuite) TestOperationTablesPresent(c *tc.C) {
 		"operation_task_status",
 		"operation_task_status_value",
 		"operation_task_log",
+		"operation_schedule",
+		"operation_schedule_creator_group",
+		"operation_schedule_receiver",
+		"operation_schedule_parameter",
+		"operation_schedule_run",
 	}
 
 	for _, table := range expectedTables {
@@ -118,6 +123,9 @@ func (s *operationSchemaSuite) TestOperationIndexesPresent(c *tc.C) {
 		"idx_operation_action_charm_action_key_operation_uuid",
 		"idx_task_id",
 		"idx_operation_task_log_id",
+		"idx_operation_schedule_name",
+		"idx_operation_schedule_next_run_at",
+		"idx_operation_schedule_run_schedule",
 	}
 
 	for _, name := range expectedIndexes {
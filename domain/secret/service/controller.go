@@ -0,0 +1,182 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/leadership"
+	corelease "github.com/juju/juju/core/lease"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
+	internalsecrets "github.com/juju/juju/internal/secrets"
+)
+
+// NewControllerSecretService returns a secret service for the controller
+// model, used to hold secrets on behalf of the controller. These secrets are
+// never owned by an application, so no leadership is needed to manage them.
+func NewControllerSecretService(
+	secretState State,
+	secretBackendState SecretBackendState,
+	logger logger.Logger,
+) *SecretService {
+	return NewSecretService(secretState, secretBackendState, noLeadership{}, logger)
+}
+
+// CreateControllerSecret creates a secret holding the value on behalf of the
+// controller, returning its URI. The secret is stored by the model's active
+// secret backend, but the model's users can't list, read, change, grant or
+// remove it.
+func (s *SecretService) CreateControllerSecret(
+	ctx context.Context, description string, value secrets.SecretValue,
+) (*secrets.URI, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if value.IsEmpty() {
+		return nil, errors.Errorf("empty secret value %w", coreerrors.NotValid)
+	}
+	accessor, err := s.controllerSecretAccessor(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	checksum, err := value.Checksum()
+	if err != nil {
+		return nil, errors.Errorf("calculating secret checksum: %w", err)
+	}
+
+	autoPrune := true
+	uri := secrets.NewURI().WithSource(accessor.ID)
+	err = s.createUserSecret(ctx, uri, CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor:    accessor,
+			Description: &description,
+			Data:        value.EncodedValues(),
+			Checksum:    checksum,
+			AutoPrune:   &autoPrune,
+		},
+		Version: internalsecrets.Version,
+	}, s.secretState.CreateControllerSecret)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return uri, nil
+}
+
+// UpdateControllerSecret adds a revision holding the value to a secret held
+// on behalf of the controller. If the secret isn't held on behalf of the
+// controller, an error satisfying [secreterrors.SecretNotFound] is returned.
+func (s *SecretService) UpdateControllerSecret(ctx context.Context, uri *secrets.URI, value secrets.SecretValue) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if value.IsEmpty() {
+		return errors.Errorf("empty secret value %w", coreerrors.NotValid)
+	}
+	if err := s.checkControllerSecret(ctx, uri); err != nil {
+		return errors.Capture(err)
+	}
+	accessor, err := s.controllerSecretAccessor(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	checksum, err := value.Checksum()
+	if err != nil {
+		return errors.Errorf("calculating secret checksum: %w", err)
+	}
+	// The model isn't granted manage access to the secret, so it's passed
+	// on to be included in the secrets the backend is accessed for.
+	return s.updateUserSecret(ctx, uri, UpdateUserSecretParams{
+		Accessor: accessor,
+		Data:     value.EncodedValues(),
+		Checksum: checksum,
+	}, uri.ID)
+}
+
+// GetControllerSecretValue returns the value held by the latest revision of a
+// secret held on behalf of the controller, reading it from the backend which
+// stores it. If the secret isn't held on behalf of the controller, an error
+// satisfying [secreterrors.SecretNotFound] is returned.
+func (s *SecretService) GetControllerSecretValue(ctx context.Context, uri *secrets.URI) (secrets.SecretValue, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.checkControllerSecret(ctx, uri); err != nil {
+		return nil, errors.Capture(err)
+	}
+	md, err := s.secretState.GetSecret(ctx, uri)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.GetSecretContentFromBackend(ctx, uri, md.LatestRevision)
+}
+
+// DeleteControllerSecret schedules removal of a secret held on behalf of the
+// controller, along with all of its revisions. If the secret isn't held on
+// behalf of the controller, an error satisfying [secreterrors.SecretNotFound]
+// is returned.
+func (s *SecretService) DeleteControllerSecret(ctx context.Context, uri *secrets.URI) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.checkControllerSecret(ctx, uri); err != nil {
+		return errors.Capture(err)
+	}
+	return s.scheduleUserSecretRemoval(ctx, uri, nil)
+}
+
+// checkControllerSecret returns an error satisfying
+// [secreterrors.SecretNotFound] if the secret isn't held on behalf of the
+// controller.
+func (s *SecretService) checkControllerSecret(ctx context.Context, uri *secrets.URI) error {
+	ids, err := s.secretState.GetControllerSecretIDs(ctx)
+	if err != nil {
+		return errors.Errorf("getting controller secrets: %w", err)
+	}
+	if !set.NewStrings(ids...).Contains(uri.ID) {
+		return errors.Errorf("controller secret %q not found", uri.ID).Add(secreterrors.SecretNotFound)
+	}
+	return nil
+}
+
+// controllerSecretAccessor returns the model as the accessor used to store the
+// content of the secrets held on behalf of the controller.
+func (s *SecretService) controllerSecretAccessor(ctx context.Context) (domainsecret.SecretAccessor, error) {
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return domainsecret.SecretAccessor{}, errors.Errorf("getting model UUID: %w", err)
+	}
+	return domainsecret.SecretAccessor{
+		Kind: domainsecret.ModelAccessor,
+		ID:   modelUUID.String(),
+	}, nil
+}
+
+// noLeadership is the leadership of secrets held on behalf of the controller,
+// which no unit can hold.
+type noLeadership struct{}
+
+// LeadershipCheck is part of the [leadership.Checker] interface.
+func (noLeadership) LeadershipCheck(applicationName, unitName string) leadership.Token {
+	return noLeadershipToken{}
+}
+
+// WithLeader is part of the [leadership.Ensurer] interface.
+func (noLeadership) WithLeader(context.Context, string, string, func(context.Context) error) error {
+	return errors.New("secret is not owned by an application").Add(corelease.ErrNotHeld)
+}
+
+type noLeadershipToken struct{}
+
+// Check is part of the [leadership.Token] interface.
+func (noLeadershipToken) Check() error {
+	return errors.New("secret is not owned by an application").Add(corelease.ErrNotHeld)
+}
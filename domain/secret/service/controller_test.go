@@ -0,0 +1,138 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func (s *serviceSuite) TestCreateControllerSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	backendConfig := &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      s.modelID.String(),
+		ModelName:      "controller",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "active-type",
+		},
+	}
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil).AnyTimes()
+	s.secretBackendState.EXPECT().GetActiveModelSecretBackend(gomock.Any(), s.modelID).Return("backend-id", backendConfig, nil)
+	s.secretsBackendProvider.EXPECT().Initialise(backendConfig).Return(nil)
+	s.state.EXPECT().ListGrantedSecretsForBackend(gomock.Any(), "backend-id", gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.secretsBackendProvider.EXPECT().IssuesTokens().Return(false)
+	s.secretsBackendProvider.EXPECT().RestrictedConfig(
+		gomock.Any(), backendConfig, true, false, "", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&backendConfig.BackendConfig, nil)
+	s.secretsBackendProvider.EXPECT().NewBackend(gomock.Any()).Return(s.secretsBackend, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), gomock.Any(), 1, coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"})).
+		Return("", errors.Errorf("not supported %w", coreerrors.NotSupported))
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String(), gomock.Any()).
+		Return(func() error { return nil }, nil)
+
+	var created *coresecrets.URI
+	s.state.EXPECT().CreateControllerSecret(gomock.Any(), 1, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ int, uri *coresecrets.URI, p domainsecret.UpsertSecretParams) error {
+			created = uri
+			c.Check(p.Description, tc.DeepEquals, new("registry credential"))
+			c.Check(p.Data, tc.DeepEquals, coresecrets.SecretData{"foo": "YmFy"})
+			return nil
+		})
+
+	uri, err := s.service.CreateControllerSecret(c.Context(), "registry credential",
+		coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(uri, tc.DeepEquals, created)
+	c.Check(uri.SourceUUID, tc.Equals, s.modelID.String())
+}
+
+func (s *serviceSuite) TestCreateControllerSecretEmpty(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.CreateControllerSecret(c.Context(), "registry credential", coresecrets.NewSecretValue(nil))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateControllerSecretNotControllerSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return([]string{coresecrets.NewURI().ID}, nil)
+
+	err := s.service.UpdateControllerSecret(c.Context(), uri, coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *serviceSuite) TestGetControllerSecretValueNotControllerSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
+
+	_, err := s.service.GetControllerSecretValue(c.Context(), uri)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *serviceSuite) TestDeleteControllerSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return([]string{uri.ID}, nil)
+	s.state.EXPECT().ScheduleUserSecretRemoval(gomock.Any(), gomock.Any(), uri, []int(nil), gomock.Any()).Return(nil)
+
+	err := s.service.DeleteControllerSecret(c.Context(), uri)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteControllerSecretNotControllerSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
+
+	err := s.service.DeleteControllerSecret(c.Context(), uri)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *serviceSuite) TestListSecretsExcludesControllerSecrets(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	userURI := coresecrets.NewURI()
+	controllerURI := coresecrets.NewURI()
+	md := []*coresecrets.SecretMetadata{{URI: userURI}, {URI: controllerURI}}
+	revs := [][]*coresecrets.SecretRevisionMetadata{{{Revision: 1}}, {{Revision: 2}}}
+
+	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return([]string{controllerURI.ID}, nil)
+	s.state.EXPECT().ListAllSecrets(gomock.Any()).Return(md, revs, nil)
+
+	gotMDs, gotRevs, err := s.service.ListSecrets(c.Context(), nil, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(gotMDs, tc.DeepEquals, []*coresecrets.SecretMetadata{{URI: userURI}})
+	c.Assert(gotRevs, tc.HasLen, 1)
+	c.Check(gotRevs[0][0].Revision, tc.Equals, 1)
+}
+
+func (s *serviceSuite) TestListSecretsByURIControllerSecret(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return([]string{uri.ID}, nil)
+
+	_, _, err := s.service.ListSecrets(c.Context(), uri, nil, nil)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
+}
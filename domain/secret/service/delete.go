@@ -42,26 +42,30 @@ func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, para
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) error {
-		// validate if provided revisions exist before scheduling job
-		if len(params.Revisions) > 0 {
-			for _, revision := range params.Revisions {
-				if _, err := s.secretState.GetSecretRevisionUUID(ctx, uri, revision); err != nil {
-					return errors.Capture(err)
-				}
-			}
-		}
+		return s.scheduleUserSecretRemoval(innerCtx, uri, params.Revisions)
+	})
+}
 
-		jobID, err := uuid.NewUUID()
-		if err != nil {
+// scheduleUserSecretRemoval schedules removal of the specified user secret or
+// specific revisions of it.
+func (s *SecretService) scheduleUserSecretRemoval(ctx context.Context, uri *secrets.URI, revisions []int) error {
+	// validate if provided revisions exist before scheduling job
+	for _, revision := range revisions {
+		if _, err := s.secretState.GetSecretRevisionUUID(ctx, uri, revision); err != nil {
 			return errors.Capture(err)
 		}
+	}
 
-		err = s.secretState.ScheduleUserSecretRemoval(ctx, jobID.String(), uri, params.Revisions, s.clock.Now().UTC())
-		if err != nil {
-			return errors.Errorf("scheduling job for removal of user secret %q: %w", uri.String(), err)
-		}
+	jobID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
 
-		s.logger.Infof(ctx, "scheduled removal of user secret %q", uri.String())
-		return nil
-	})
+	err = s.secretState.ScheduleUserSecretRemoval(ctx, jobID.String(), uri, revisions, s.clock.Now().UTC())
+	if err != nil {
+		return errors.Errorf("scheduling job for removal of user secret %q: %w", uri.String(), err)
+	}
+
+	s.logger.Infof(ctx, "scheduled removal of user secret %q", uri.String())
+	return nil
 }
@@ -52,6 +52,15 @@ type State interface {
 	// CreateUserSecret creates a new user-owned secret.
 	CreateUserSecret(ctx context.Context, version int, uri *secrets.URI, secret domainsecret.UpsertSecretParams) error
 
+	// CreateControllerSecret creates a new secret held on behalf of the
+	// controller. It is owned by the model, but the model is not granted
+	// manage access to it.
+	CreateControllerSecret(ctx context.Context, version int, uri *secrets.URI, secret domainsecret.UpsertSecretParams) error
+
+	// GetControllerSecretIDs returns the IDs of the secrets held on behalf
+	// of the controller.
+	GetControllerSecretIDs(ctx context.Context) ([]string, error)
+
 	// GetSecret returns metadata for the secret identified by URI.
 	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
 
@@ -85,6 +94,9 @@ type State interface {
 	// ListAllSecrets returns all secrets in the model.
 	ListAllSecrets(ctx context.Context) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 
+	// CountSecrets returns the number of secrets in the model.
+	CountSecrets(ctx context.Context) (int, error)
+
 	// ListCharmSecrets returns all charm-owned secrets for the given
 	// application and unit owners.
 	ListCharmSecrets(ctx context.Context,
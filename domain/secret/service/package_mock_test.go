@@ -42,11 +42,14 @@ type MockStateMockRecorder struct {
 	allSecretGrantsExpects                                      []*gomock.Call1_2[context.Context, map[string][]secret.GrantDetails, error]
 	allSecretRemoteConsumersExpects                             []*gomock.Call1_2[context.Context, map[string][]secret.ConsumerInfo, error]
 	changeSecretBackendExpects                                  []*gomock.Call4_1[context.Context, uuid.UUID, *secrets.ValueRef, secrets.SecretData, error]
+	countSecretsExpects                                         []*gomock.Call1_2[context.Context, int, error]
+	createControllerSecretExpects                               []*gomock.Call4_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, error]
 	createUserSecretExpects                                     []*gomock.Call4_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, error]
 	getApplicationUUIDExpects                                   []*gomock.Call2_2[context.Context, string, application.UUID, error]
 	getApplicationUUIDsForNamesExpects                          []*gomock.Call2_2[context.Context, secret.ApplicationOwners, []string, error]
 	getConsumedRemoteSecretURIsWithChangesExpects               []*gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
 	getConsumedSecretURIsWithChangesExpects                     []*gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
+	getControllerSecretIDsExpects                               []*gomock.Call1_2[context.Context, []string, error]
 	getLatestRevisionExpects                                    []*gomock.Call2_2[context.Context, *secrets.URI, int, error]
 	getLatestRevisionsExpects                                   []*gomock.Call2_2[context.Context, []*secrets.URI, map[string]int, error]
 	getModelUUIDExpects                                         []*gomock.Call1_2[context.Context, model.UUID, error]
@@ -198,6 +201,42 @@ func (mr *MockStateMockRecorder) ChangeSecretBackend(ctx, revisionID, valueRef,
 // MockStateChangeSecretBackendCall is the typed call wrapper for ChangeSecretBackend.
 type MockStateChangeSecretBackendCall = gomock.Call4_1[context.Context, uuid.UUID, *secrets.ValueRef, secrets.SecretData, error]
 
+// CountSecrets mocks base method.
+func (m *MockState) CountSecrets(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.countSecretsExpects, m.ctrl, m, "CountSecrets", ctx)
+}
+
+// CountSecrets indicates an expected call of CountSecrets.
+func (mr *MockStateMockRecorder) CountSecrets(ctx any) *MockStateCountSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, int, error](mr.mock.ctrl.T, mr.mock, "CountSecrets", gomock.EnsureMatcher(ctx))
+	mr.countSecretsExpects = append(mr.countSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateCountSecretsCall is the typed call wrapper for CountSecrets.
+type MockStateCountSecretsCall = gomock.Call1_2[context.Context, int, error]
+
+// CreateControllerSecret mocks base method.
+func (m *MockState) CreateControllerSecret(ctx context.Context, version int, uri *secrets.URI, arg3 secret.UpsertSecretParams) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch4_1(&m.recorder.createControllerSecretExpects, m.ctrl, m, "CreateControllerSecret", ctx, version, uri, arg3)
+}
+
+// CreateControllerSecret indicates an expected call of CreateControllerSecret.
+func (mr *MockStateMockRecorder) CreateControllerSecret(ctx, version, uri, arg3 any) *MockStateCreateControllerSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall4_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, error](mr.mock.ctrl.T, mr.mock, "CreateControllerSecret", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(version), gomock.EnsureMatcher(uri), gomock.EnsureMatcher(arg3))
+	mr.createControllerSecretExpects = append(mr.createControllerSecretExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateCreateControllerSecretCall is the typed call wrapper for CreateControllerSecret.
+type MockStateCreateControllerSecretCall = gomock.Call4_1[context.Context, int, *secrets.URI, secret.UpsertSecretParams, error]
+
 // CreateUserSecret mocks base method.
 func (m *MockState) CreateUserSecret(ctx context.Context, version int, uri *secrets.URI, arg3 secret.UpsertSecretParams) error {
 	m.ctrl.T.Helper()
@@ -290,6 +329,24 @@ func (mr *MockStateMockRecorder) GetConsumedSecretURIsWithChanges(ctx, unitName
 // MockStateGetConsumedSecretURIsWithChangesCall is the typed call wrapper for GetConsumedSecretURIsWithChanges.
 type MockStateGetConsumedSecretURIsWithChangesCall = gomock.Call2V_2[context.Context, unit.Name, string, []string, error]
 
+// GetControllerSecretIDs mocks base method.
+func (m *MockState) GetControllerSecretIDs(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getControllerSecretIDsExpects, m.ctrl, m, "GetControllerSecretIDs", ctx)
+}
+
+// GetControllerSecretIDs indicates an expected call of GetControllerSecretIDs.
+func (mr *MockStateMockRecorder) GetControllerSecretIDs(ctx any) *MockStateGetControllerSecretIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []string, error](mr.mock.ctrl.T, mr.mock, "GetControllerSecretIDs", gomock.EnsureMatcher(ctx))
+	mr.getControllerSecretIDsExpects = append(mr.getControllerSecretIDsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStateGetControllerSecretIDsCall is the typed call wrapper for GetControllerSecretIDs.
+type MockStateGetControllerSecretIDsCall = gomock.Call1_2[context.Context, []string, error]
+
 // GetLatestRevision mocks base method.
 func (m *MockState) GetLatestRevision(ctx context.Context, uri *secrets.URI) (int, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +279,15 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 	// TODO(secrets): Generate and reserve a secret URI, instead of accepting
 	// one via an argument.
 
+	return s.createUserSecret(ctx, uri, params, s.secretState.CreateUserSecret)
+}
+
+// createUserSecret saves the content of a new user secret to the model's
+// active backend and records the secret with the create function.
+func (s *SecretService) createUserSecret(
+	ctx context.Context, uri *secrets.URI, params CreateUserSecretParams,
+	create func(context.Context, int, *secrets.URI, domainsecret.UpsertSecretParams) error,
+) (errOut error) {
 	now := s.clock.Now()
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
@@ -342,7 +351,7 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 		}
 	}()
 
-	if err := s.secretState.CreateUserSecret(ctx, params.Version, uri, p); err != nil {
+	if err := create(ctx, params.Version, uri, p); err != nil {
 		return errors.Errorf("creating user secret: %w", err)
 	}
 	return nil
@@ -362,6 +371,18 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		return errors.Capture(err)
 	}
 
+	return withCaveat(ctx, func(innerCtx context.Context) error {
+		return s.updateUserSecret(innerCtx, uri, params)
+	})
+}
+
+// updateUserSecret saves any new content of a user secret to the model's
+// active backend and updates the secret. Any secrets the accessor isn't
+// granted manage access to, but which the backend must be accessed for, are
+// passed as otherSecretIDs.
+func (s *SecretService) updateUserSecret(
+	ctx context.Context, uri *secrets.URI, params UpdateUserSecretParams, otherSecretIDs ...string,
+) (errOut error) {
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
 		Label:       params.Label,
@@ -370,78 +391,88 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		UpdateTime:  s.clock.Now(),
 	}
 
-	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
-		// Take a copy as we may set it to nil below
-		// if the content is saved to a backend.
-		if len(params.Data) > 0 {
-			p.Data = make(map[string]string)
-			maps.Copy(p.Data, params.Data)
-
-			backend, backendID, err := s.getBackendForUserSecrets(innerCtx, params.Accessor)
-			if err != nil {
-				return errors.Capture(err)
-			}
+	// Take a copy as we may set it to nil below
+	// if the content is saved to a backend.
+	if len(params.Data) > 0 {
+		p.Data = make(map[string]string)
+		maps.Copy(p.Data, params.Data)
 
-			latestRevision, err := s.secretState.GetLatestRevision(innerCtx, uri)
-			if err != nil {
-				// Check if the uri exists or not.
-				return errors.Capture(err)
-			}
-			revId, err := backend.SaveContent(innerCtx, uri, latestRevision+1, secrets.NewSecretValue(params.Data))
-			if err != nil && !errors.Is(err, coreerrors.NotSupported) {
-				return errors.Errorf("saving secret content to backend: %w", err)
-			}
-			if err == nil {
-				defer func() {
-					if errOut != nil {
-						// If we failed to update the secret, we should delete the
-						// secret value from the backend for the new revision.
-						if err2 := backend.DeleteContent(innerCtx, revId); err2 != nil &&
-							!errors.Is(err2, coreerrors.NotSupported) &&
-							!errors.Is(err2, secreterrors.SecretRevisionNotFound) {
-							s.logger.Warningf(ctx, "failed to delete secret %q: %v", revId, err2)
-						}
-					}
-				}()
-				p.Data = nil
-				p.ValueRef = &secrets.ValueRef{
-					BackendID:  backendID,
-					RevisionID: revId,
-				}
-			}
+		backend, backendID, err := s.getBackendForUserSecrets(ctx, params.Accessor, otherSecretIDs...)
+		if err != nil {
+			return errors.Capture(err)
 		}
 
-		if p.ValueRef != nil || len(p.Data) != 0 {
-			revisionID, err := s.uuidGenerator()
-			if err != nil {
-				return errors.Capture(err)
-			}
-			p.RevisionUUID = new(revisionID.String())
-
-			modelID, err := s.secretState.GetModelUUID(innerCtx)
-			if err != nil {
-				return errors.Errorf("getting model uuid: %w", err)
-			}
-			rollBack, err := s.secretBackendState.AddSecretBackendReference(
-				innerCtx, p.ValueRef, modelID, revisionID.String(), uri.ID)
-			if err != nil {
-				return errors.Capture(err)
-			}
+		latestRevision, err := s.secretState.GetLatestRevision(ctx, uri)
+		if err != nil {
+			// Check if the uri exists or not.
+			return errors.Capture(err)
+		}
+		revId, err := backend.SaveContent(ctx, uri, latestRevision+1, secrets.NewSecretValue(params.Data))
+		if err != nil && !errors.Is(err, coreerrors.NotSupported) {
+			return errors.Errorf("saving secret content to backend: %w", err)
+		}
+		if err == nil {
 			defer func() {
 				if errOut != nil {
-					if err := rollBack(); err != nil {
-						s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+					// If we failed to update the secret, we should delete the
+					// secret value from the backend for the new revision.
+					if err2 := backend.DeleteContent(ctx, revId); err2 != nil &&
+						!errors.Is(err2, coreerrors.NotSupported) &&
+						!errors.Is(err2, secreterrors.SecretRevisionNotFound) {
+						s.logger.Warningf(ctx, "failed to delete secret %q: %v", revId, err2)
 					}
 				}
 			}()
+			p.Data = nil
+			p.ValueRef = &secrets.ValueRef{
+				BackendID:  backendID,
+				RevisionID: revId,
+			}
 		}
+	}
 
-		err := s.secretState.UpdateSecret(innerCtx, uri, p)
+	if p.ValueRef != nil || len(p.Data) != 0 {
+		revisionID, err := s.uuidGenerator()
 		if err != nil {
-			return errors.Errorf("updating user secret %q: %w", uri.ID, err)
+			return errors.Capture(err)
 		}
-		return nil
-	})
+		p.RevisionUUID = new(revisionID.String())
+
+		modelID, err := s.secretState.GetModelUUID(ctx)
+		if err != nil {
+			return errors.Errorf("getting model uuid: %w", err)
+		}
+		rollBack, err := s.secretBackendState.AddSecretBackendReference(
+			ctx, p.ValueRef, modelID, revisionID.String(), uri.ID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		defer func() {
+			if errOut != nil {
+				if err := rollBack(); err != nil {
+					s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+				}
+			}
+		}()
+	}
+
+	err := s.secretState.UpdateSecret(ctx, uri, p)
+	if err != nil {
+		return errors.Errorf("updating user secret %q: %w", uri.ID, err)
+	}
+	return nil
+}
+
+// CountSecrets returns the number of secrets in the model.
+func (s *SecretService) CountSecrets(ctx context.Context) (int, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	count, err := s.secretState.CountSecrets(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return count, nil
 }
 
 // ListSecrets returns the secrets matching the specified terms.
@@ -472,7 +503,31 @@ func (s *SecretService) ListSecrets(ctx context.Context, uri *secrets.URI,
 		}
 	}()
 
+	// Secrets held on behalf of the controller are never listed.
+	controllerSecretIDs, err := s.secretState.GetControllerSecretIDs(ctx)
+	if err != nil {
+		return nil, nil, errors.Errorf("getting controller secrets: %w", err)
+	}
+	controllerSecrets := set.NewStrings(controllerSecretIDs...)
+	defer func() {
+		if err != nil || controllerSecrets.IsEmpty() {
+			return
+		}
+		filteredMetadata, filteredRevisions := metadataList[:0], revisionsList[:0]
+		for i, md := range metadataList {
+			if !controllerSecrets.Contains(md.URI.ID) {
+				filteredMetadata = append(filteredMetadata, md)
+				filteredRevisions = append(filteredRevisions, revisionsList[i])
+			}
+		}
+		metadataList, revisionsList = filteredMetadata, filteredRevisions
+	}()
+
 	if uri != nil {
+		if controllerSecrets.Contains(uri.ID) {
+			return nil, nil, errors.Errorf("secret %q not found", uri.ID).Add(secreterrors.SecretNotFound)
+		}
+
 		var metadata *secrets.SecretMetadata
 		var revisions []*secrets.SecretRevisionMetadata
 
@@ -615,6 +615,16 @@ func (s *serviceSuite) TestGetSecret(c *tc.C) {
 	c.Assert(got, tc.DeepEquals, md)
 }
 
+func (s *serviceSuite) TestCountSecrets(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().CountSecrets(gomock.Any()).Return(3, nil)
+
+	count, err := s.service.CountSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(count, tc.Equals, 3)
+}
+
 func (s *serviceSuite) TestGetSecretValue(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -785,6 +795,7 @@ func (s *serviceSuite) TestListSecretsByURI(c *tc.C) {
 	revs := []*coresecrets.SecretRevisionMetadata{{Revision: 7}}
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, (*int)(nil)).Return(md, revs, nil)
 
 	gotMDs, gotRevs, err := s.service.ListSecrets(c.Context(), uri, nil, nil)
@@ -801,6 +812,7 @@ func (s *serviceSuite) TestListSecretsByURIError(c *tc.C) {
 	uri := coresecrets.NewURI()
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, (*int)(nil)).Return(nil, nil, errors.New("boom"))
 
 	md, revs, err := s.service.ListSecrets(c.Context(), uri, nil, nil)
@@ -833,6 +845,7 @@ func (s *serviceSuite) TestListSecretsByURIWithBackendName(c *tc.C) {
 	}
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(secretBackendsWithUUIDs, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, (*int)(nil)).Return(md, revs, nil)
 
 	expectedMDs := []*coresecrets.SecretMetadata{md}
@@ -873,6 +886,7 @@ func (s *serviceSuite) TestListSecretsByURIWithoutBackendName(c *tc.C) {
 		uuid.MustNewUUID().String(): "kubernetes",
 		uuid.MustNewUUID().String(): "internal",
 	}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, (*int)(nil)).Return(md, revs, nil)
 
 	_, gotRevs, err := s.service.ListSecrets(c.Context(), uri, nil, nil)
@@ -905,6 +919,7 @@ func (s *serviceSuite) TestListSecretsByURIWithRevision(c *tc.C) {
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{
 		backendUUID: "vault-one",
 	}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, &rev).Return(md, revs, nil)
 
 	gotMDs, gotRevs, err := s.service.ListSecrets(c.Context(), uri, &rev, nil)
@@ -923,6 +938,7 @@ func (s *serviceSuite) TestListSecretsByURIWithRevisionError(c *tc.C) {
 	rev := 3
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().GetSecretByURI(gomock.Any(), *uri, &rev).Return(nil, nil, errors.New("boom"))
 
 	md, revs, err := s.service.ListSecrets(c.Context(), uri, &rev, nil)
@@ -956,6 +972,7 @@ func (s *serviceSuite) TestListSecretsByLabels(c *tc.C) {
 	}
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(secretBackendsWithUUIDs, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListSecretsByLabels(gomock.Any(), labels, (*int)(nil)).Return(md, revs, nil)
 
 	gotMDs, gotRevs, err := s.service.ListSecrets(c.Context(), nil, nil, labels)
@@ -991,6 +1008,7 @@ func (s *serviceSuite) TestListSecretsByLabelsWithoutBackendName(c *tc.C) {
 		uuid.MustNewUUID().String(): "kubernetes",
 		uuid.MustNewUUID().String(): "internal",
 	}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListSecretsByLabels(gomock.Any(), labels, (*int)(nil)).Return(md, revs, nil)
 
 	_, gotRevs, err := s.service.ListSecrets(c.Context(), nil, nil, labels)
@@ -1013,6 +1031,7 @@ func (s *serviceSuite) TestListSecretsByLabelsError(c *tc.C) {
 
 	// Error getting secrets by labels from state.
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListSecretsByLabels(gomock.Any(), labels, (*int)(nil)).Return(nil, nil, errors.New("ListSecretsByLabels err"))
 	md, revs, err := s.service.ListSecrets(c.Context(), nil, nil, labels)
 	c.Assert(md, tc.IsNil)
@@ -1043,6 +1062,7 @@ func (s *serviceSuite) TestListSecretsByLabelsWithRevision(c *tc.C) {
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{
 		backendUUID: "vault-one",
 	}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListSecretsByLabels(gomock.Any(), labels, &rev).Return(md, revs, nil)
 
 	gotMDs, gotRevs, err := s.service.ListSecrets(c.Context(), nil, &rev, labels)
@@ -1062,6 +1082,7 @@ func (s *serviceSuite) TestListSecretsByLabelsWithRevisionError(c *tc.C) {
 	rev := 3
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListSecretsByLabels(gomock.Any(), labels, &rev).Return(nil, nil, errors.New("boom"))
 
 	md, revs, err := s.service.ListSecrets(c.Context(), nil, &rev, labels)
@@ -1075,6 +1096,7 @@ func (s *serviceSuite) TestListSecretsErrOnRevisionOnly(c *tc.C) {
 
 	rev := 2
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	_, _, err := s.service.ListSecrets(c.Context(), nil, &rev, nil)
 	c.Assert(err, tc.ErrorMatches, "cannot specify revision without URI or labels")
 }
@@ -1094,6 +1116,7 @@ func (s *serviceSuite) TestListSecretsAll(c *tc.C) {
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{
 		backendUUID: "vault-all",
 	}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListAllSecrets(gomock.Any()).Return(md, revs, nil)
 
 	gotMDs, gotRevs, err := s.service.ListSecrets(c.Context(), nil, nil, nil)
@@ -1106,6 +1129,7 @@ func (s *serviceSuite) TestListSecretsAllError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.secretBackendState.EXPECT().GetSecretBackendNamesByUUID(gomock.Any()).Return(map[string]string{}, nil)
+	s.state.EXPECT().GetControllerSecretIDs(gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().ListAllSecrets(gomock.Any()).Return(nil, nil, errors.New("boom"))
 
 	md, revs, err := s.service.ListSecrets(c.Context(), nil, nil, nil)
@@ -0,0 +1,113 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/database"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// NewControllerModelDBFactory returns a factory for the database of the
+// controller model, which holds the secrets kept on behalf of the controller.
+// The controller model is looked up in the controller database each time the
+// factory is called.
+func NewControllerModelDBFactory(dbGetter changestream.WatchableDBGetter) changestream.WatchableDBFactory {
+	controllerDB := changestream.NewWatchableDBFactoryForNamespace(dbGetter.GetWatchableDB, database.ControllerNS)
+	return func(ctx context.Context) (changestream.WatchableDB, error) {
+		db, err := controllerDB(ctx)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+
+		var result modelUUID
+		stmt, err := sqlair.Prepare(`
+SELECT model_uuid AS &modelUUID.uuid
+FROM   controller`, result)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+			return tx.Query(ctx, stmt).Get(&result)
+		})
+		if err != nil {
+			return nil, errors.Errorf("getting controller model UUID: %w", err)
+		}
+
+		modelDB, err := dbGetter.GetWatchableDB(ctx, result.UUID.String())
+		return modelDB, errors.Capture(err)
+	}
+}
+
+// CreateControllerSecret creates a secret held on behalf of the controller.
+// Like a user secret, it is owned by the model, but the model is not granted
+// manage access to it.
+func (st State) CreateControllerSecret(
+	ctx context.Context, version int, uri *coresecrets.URI, secret domainsecret.UpsertSecretParams,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_controller_owned (*)
+VALUES ($secretControllerOwned.*)`, secretControllerOwned{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.createSecret(ctx, tx, version, uri, secret); err != nil {
+			return errors.Errorf("inserting secret records: %w", err)
+		}
+		if err := st.upsertSecretModelOwner(ctx, tx, secretModelOwner{SecretID: uri.ID}); err != nil {
+			return errors.Errorf("inserting secret owner record: %w", err)
+		}
+		if err := tx.Query(ctx, insertStmt, secretControllerOwned{SecretID: uri.ID}).Run(); err != nil {
+			return errors.Errorf("marking secret as held for the controller: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetControllerSecretIDs returns the IDs of the secrets held on behalf of the
+// controller.
+func (st State) GetControllerSecretIDs(ctx context.Context) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &secretControllerOwned.*
+FROM   secret_controller_owned`, secretControllerOwned{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretControllerOwned
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting controller secrets: %w", err)
+	}
+
+	ids := make([]string, len(rows))
+	for i, r := range rows {
+		ids[i] = r.SecretID
+	}
+	return ids, nil
+}
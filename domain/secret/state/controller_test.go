@@ -0,0 +1,69 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"github.com/juju/tc"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/uuid"
+)
+
+func (s *stateSuite) TestCreateControllerSecret(c *tc.C) {
+	sp := domainsecret.UpsertSecretParams{
+		Description:  new("charm registry credential"),
+		Data:         coresecrets.SecretData{"foo": "bar"},
+		Checksum:     "checksum-1234",
+		AutoPrune:    new(true),
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := c.Context()
+	err := s.state.CreateControllerSecret(ctx, 1, uri, sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	owner := coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: s.modelUUID}
+	s.assertSecret(c, s.state, uri, sp, 1, owner)
+	data, ref, err := s.state.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ref, tc.IsNil)
+	c.Check(data, tc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+
+	// The model isn't granted access to the secret.
+	access, err := s.state.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelUUID,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(access, tc.Equals, "")
+}
+
+func (s *stateSuite) TestGetControllerSecretIDs(c *tc.C) {
+	ctx := c.Context()
+
+	controllerURI := coresecrets.NewURI()
+	err := s.state.CreateControllerSecret(ctx, 1, controllerURI, domainsecret.UpsertSecretParams{
+		Data:         coresecrets.SecretData{"foo": "bar"},
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	userURI := coresecrets.NewURI()
+	err = s.createUserSecret(c, 1, userURI, domainsecret.UpsertSecretParams{
+		Data:         coresecrets.SecretData{"foo": "baz"},
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	ids, err := s.state.GetControllerSecretIDs(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []string{controllerURI.ID})
+}
+
+func (s *stateSuite) TestGetControllerSecretIDsNone(c *tc.C) {
+	ids, err := s.state.GetControllerSecretIDs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.HasLen, 0)
+}
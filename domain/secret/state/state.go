@@ -1571,6 +1571,32 @@ func (st State) ListAllSecrets(ctx context.Context) ([]*coresecrets.SecretMetada
 	return secrets, revisions, nil
 }
 
+// CountSecrets returns the number of secrets in the model.
+func (st State) CountSecrets(ctx context.Context) (int, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	type secretCount struct {
+		Count int `db:"count"`
+	}
+	stmt, err := st.Prepare(`
+SELECT COUNT(*) AS &secretCount.count
+FROM   secret_metadata`, secretCount{})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var result secretCount
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt).Get(&result)
+	}); err != nil {
+		return 0, errors.Errorf("counting secrets: %w", err)
+	}
+	return result.Count, nil
+}
+
 func (st State) listSecretsRevisions(ctx context.Context, tx *sqlair.TX, secrets []*coresecrets.SecretMetadata,
 	revision *int) ([][]*coresecrets.SecretRevisionMetadata, error) {
 	result := make([][]*coresecrets.SecretRevisionMetadata, len(secrets))
@@ -784,6 +784,26 @@ func (s *stateSuite) TestListAllSecretsNone(c *tc.C) {
 	c.Check(len(revisions), tc.Equals, 0)
 }
 
+func (s *stateSuite) TestCountSecrets(c *tc.C) {
+	count, err := s.state.CountSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+
+	sp := domainsecret.UpsertSecretParams{
+		Data:         coresecrets.SecretData{"foo": "bar"},
+		RevisionUUID: new(uuid.MustNewUUID().String()),
+	}
+	err = s.createUserSecret(c, 1, coresecrets.NewURI(), sp)
+	c.Assert(err, tc.ErrorIsNil)
+	sp.RevisionUUID = new(uuid.MustNewUUID().String())
+	err = s.createUserSecret(c, 1, coresecrets.NewURI(), sp)
+	c.Assert(err, tc.ErrorIsNil)
+
+	count, err = s.state.CountSecrets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 2)
+}
+
 func (s *stateSuite) TestListAllSecrets(c *tc.C) {
 
 	sp := []domainsecret.UpsertSecretParams{{
@@ -104,6 +104,10 @@ type secretModelOwner struct {
 	Label    string `db:"label"`
 }
 
+type secretControllerOwned struct {
+	SecretID string `db:"secret_id"`
+}
+
 type secretApplicationOwner struct {
 	SecretID        string `db:"secret_id"`
 	ApplicationUUID string `db:"application_uuid"`
@@ -1025,7 +1025,7 @@ func (s *watcherSuite) setupUnits(c *tc.C, appName string) string {
 		func(ctx context.Context) (applicationservice.CloudInfoProvider, error) {
 			return nil, coreerrors.NotSupported
 		},
-		nil,
+		domaintesting.NewCharmStore(nil),
 		domain.NewStatusHistory(loggertesting.WrapCheckLog(c), clock.WallClock),
 		model.UUID(s.ModelUUID()),
 		clock.WallClock,
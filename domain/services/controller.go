@@ -17,10 +17,16 @@ import (
 	accessstate "github.com/juju/juju/domain/access/state"
 	agentbinaryservice "github.com/juju/juju/domain/agentbinary/service"
 	agentbinarystate "github.com/juju/juju/domain/agentbinary/state/controller"
+	auditlogservice "github.com/juju/juju/domain/auditlog/service"
+	auditlogstate "github.com/juju/juju/domain/auditlog/state"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
 	autocertcachestate "github.com/juju/juju/domain/autocert/state"
 	changestreamservice "github.com/juju/juju/domain/changestream/service"
 	changestreamstate "github.com/juju/juju/domain/changestream/state"
+	charmmirrorservice "github.com/juju/juju/domain/charmmirror/service"
+	charmmirrorstate "github.com/juju/juju/domain/charmmirror/state"
+	charmregistryservice "github.com/juju/juju/domain/charmregistry/service"
+	charmregistrystate "github.com/juju/juju/domain/charmregistry/state"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
 	cloudstate "github.com/juju/juju/domain/cloud/state"
 	controllerservice "github.com/juju/juju/domain/controller/service"
@@ -43,6 +49,8 @@ import (
 	statecontroller "github.com/juju/juju/domain/model/state/controller"
 	modeldefaultsservice "github.com/juju/juju/domain/modeldefaults/service"
 	modeldefaultsstate "github.com/juju/juju/domain/modeldefaults/state"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	secretstate "github.com/juju/juju/domain/secret/state"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
 	sshcontrollerservice "github.com/juju/juju/domain/ssh/service/controller"
@@ -58,15 +66,19 @@ import (
 type ControllerServices struct {
 	serviceFactoryBase
 
+	controllerModelDB     changestream.WatchableDBFactory
 	controllerObjectStore objectstore.NamespacedObjectStoreGetter
 	clock                 clock.Clock
 	loggerContextGetter   logger.LoggerContextGetter
 }
 
 // NewControllerServices returns a new registry which uses the provided controllerDB
-// function to obtain a controller database.
+// function to obtain a controller database. The controllerModelDB function
+// obtains the controller model's database, which holds the secrets owned by
+// the controller.
 func NewControllerServices(
 	controllerDB changestream.WatchableDBFactory,
+	controllerModelDB changestream.WatchableDBFactory,
 	controllerObjectStoreGetter objectstore.NamespacedObjectStoreGetter,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -77,6 +89,7 @@ func NewControllerServices(
 			controllerDB: controllerDB,
 			logger:       logger,
 		},
+		controllerModelDB:     controllerModelDB,
 		controllerObjectStore: controllerObjectStoreGetter,
 		clock:                 clock,
 		loggerContextGetter:   loggerContextGetter,
@@ -252,6 +265,41 @@ func (s *ControllerServices) SSHServerHostKey() *sshcontrollerservice.Service {
 	)
 }
 
+// AuditLog returns the audit log service, which records and queries the
+// audit log held in the controller database.
+func (s *ControllerServices) AuditLog() *auditlogservice.Service {
+	return auditlogservice.NewService(
+		auditlogstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clock,
+	)
+}
+
+// CharmRegistry returns the service holding the credentials used to pull
+// charms from OCI registries. The credentials are held in secrets kept for the
+// controller in the controller model, which its users can't see or edit.
+func (s *ControllerServices) CharmRegistry() *charmregistryservice.Service {
+	log := s.logger.Child("charmregistry")
+	return charmregistryservice.NewService(
+		charmregistrystate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		secretservice.NewControllerSecretService(
+			secretstate.NewState(changestream.NewTxnRunnerFactory(s.controllerModelDB), log, s.clock),
+			secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+			log,
+		),
+	)
+}
+
+// CharmMirror returns the service holding the charm and bundle revisions
+// served by the controller's Charmhub mirror.
+func (s *ControllerServices) CharmMirror() *charmmirrorservice.Service {
+	return charmmirrorservice.NewService(
+		charmmirrorstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.controllerObjectStore,
+		s.clock,
+		s.logger.Child("charmmirror"),
+	)
+}
+
 type statusHistoryGetter struct {
 	loggerContextGetter logger.LoggerContextGetter
 	clock               clock.Clock
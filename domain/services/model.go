@@ -42,8 +42,14 @@ import (
 	changestreamstate "github.com/juju/juju/domain/changestream/state"
 	cloudimagemetadataservice "github.com/juju/juju/domain/cloudimagemetadata/service"
 	cloudimagemetadatastate "github.com/juju/juju/domain/cloudimagemetadata/state"
+	configsnapshotservice "github.com/juju/juju/domain/configsnapshot/service"
+	configsnapshotstate "github.com/juju/juju/domain/configsnapshot/state"
 	containerimageresourcestoreservice "github.com/juju/juju/domain/containerimageresourcestore/service"
 	containerimageresourcestorestate "github.com/juju/juju/domain/containerimageresourcestore/state"
+	controllerconfigservice "github.com/juju/juju/domain/controllerconfig/service"
+	controllerconfigstate "github.com/juju/juju/domain/controllerconfig/state"
+	controllernodeservice "github.com/juju/juju/domain/controllernode/service"
+	controllernodestate "github.com/juju/juju/domain/controllernode/state"
 	controllerupgraderservice "github.com/juju/juju/domain/controllerupgrader/service"
 	controllerupgraderstate "github.com/juju/juju/domain/controllerupgrader/state"
 	credentialservice "github.com/juju/juju/domain/credential/service"
@@ -112,6 +118,7 @@ import (
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	envtools "github.com/juju/juju/environs/tools"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/resource/store"
 )
 
@@ -299,7 +306,7 @@ func (s *ModelServices) Application() *applicationservice.WatchableService {
 		providertracker.ProviderRunner[applicationservice.Provider](s.providerFactory, s.modelUUID.String()),
 		providertracker.ProviderRunner[applicationservice.CAASProvider](s.providerFactory, s.modelUUID.String()),
 		providertracker.ProviderRunner[applicationservice.CloudInfoProvider](s.providerFactory, s.modelUUID.String()),
-		charmstore.NewCharmStore(s.modelObjectStoreGetter, logger.Child("charmstore")),
+		charmstore.NewCharmStore(s.modelObjectStoreGetter, s.charmSignatureVerifier(), logger.Child("charmstore")),
 		domain.NewStatusHistory(logger, s.clock),
 		s.modelUUID,
 		s.clock,
@@ -389,6 +396,14 @@ func (s *ModelServices) Annotation() *annotationService.Service {
 	)
 }
 
+// ConfigSnapshot returns the model's config snapshot service.
+func (s *ModelServices) ConfigSnapshot() *configsnapshotservice.Service {
+	return configsnapshotservice.NewService(
+		configsnapshotstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.clock,
+	)
+}
+
 // Storage returns the model's storage service.
 func (s *ModelServices) Storage() *storageservice.Service {
 	return storageservice.NewService(
@@ -590,6 +605,7 @@ func (s *ModelServices) Resource() *resourceservice.Service {
 	return resourceservice.NewService(
 		resourcestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, log),
 		store.NewResourceStoreFactory(s.modelObjectStoreGetter, containerImageResourceStoreGetter),
+		s.charmSignatureVerifier(),
 		log,
 	)
 }
@@ -684,6 +700,16 @@ func (s *ModelServices) ChangeStream() *changestreamservice.Service {
 	)
 }
 
+// ControllerNodeCluster returns the service reporting the membership of the
+// controller nodes in the Dqlite cluster.
+func (s *ModelServices) ControllerNodeCluster() *controllernodeservice.ClusterService {
+	return controllernodeservice.NewClusterService(
+		controllernodestate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clusterDescriber,
+		s.logger.Child("controllernodecluster"),
+	)
+}
+
 // ControllerUpgrader returns the service for upgrading the controller and its
 // model.
 func (s *ModelServices) ControllerUpgrader() *controllerupgraderservice.Service {
@@ -707,3 +733,18 @@ func (s *ModelServices) ControllerUpgrader() *controllerupgraderservice.Service
 		controllerModelSt,
 	)
 }
+
+// charmSignatureVerifier returns a verifier of charm and resource archive
+// signatures, using the controller's trusted signing keys and the model's
+// signature policy.
+func (s *ModelServices) charmSignatureVerifier() *charmsignature.Verifier {
+	return charmsignature.NewVerifier(
+		applicationservice.NewSignaturePolicyGetter(
+			controllerconfigservice.NewService(
+				controllerconfigstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+			),
+			s.Config(),
+		),
+		s.logger.Child("charmsignature"),
+	)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/credential"
 	"github.com/juju/juju/core/database"
@@ -277,6 +278,11 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 		}
 		controllerServices := domainservices.NewControllerServices(
 			databasetesting.ConstFactory(s.TxnRunner()),
+			// The controller model's database is only opened when the
+			// controller services need it.
+			func(ctx context.Context) (changestream.WatchableDB, error) {
+				return databasetesting.ConstFactory(s.ModelTxnRunner(c, s.ControllerModelUUID.String()))(ctx)
+			},
 			modelObjectStoreGetter(func(ctx context.Context) (objectstore.ObjectStore, error) {
 				return objectStore, nil
 			}),
@@ -77,6 +77,7 @@ type MockModelStateMockRecorder struct {
 	getVolumesExpects                            []*gomock.Call2_2[context.Context, []storage.VolumeUUID, []status.Volume, error]
 	importRelationStatusExpects                  []*gomock.Call3_1[context.Context, relation.UUID, status.StatusInfo[status.RelationStatusType], error]
 	isControllerModelExpects                     []*gomock.Call1_2[context.Context, bool, error]
+	namespacesForWatchModelStatusExpects         []*gomock.Call0_1[[]string]
 	namespacesForWatchOfferStatusExpects         []*gomock.Call0_5[string, string, string, string, string]
 	setApplicationStatusExpects                  []*gomock.Call3_1[context.Context, application.UUID, status.StatusInfo[status.WorkloadStatusType], error]
 	setFilesystemStatusExpects                   []*gomock.Call3_1[context.Context, storage.FilesystemUUID, status.StatusInfo[status.StorageFilesystemStatusType], error]
@@ -897,6 +898,24 @@ func (mr *MockModelStateMockRecorder) IsControllerModel(ctx any) *MockModelState
 // MockModelStateIsControllerModelCall is the typed call wrapper for IsControllerModel.
 type MockModelStateIsControllerModelCall = gomock.Call1_2[context.Context, bool, error]
 
+// NamespacesForWatchModelStatus mocks base method.
+func (m *MockModelState) NamespacesForWatchModelStatus() []string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.namespacesForWatchModelStatusExpects, m.ctrl, m, "NamespacesForWatchModelStatus")
+}
+
+// NamespacesForWatchModelStatus indicates an expected call of NamespacesForWatchModelStatus.
+func (mr *MockModelStateMockRecorder) NamespacesForWatchModelStatus() *MockModelStateNamespacesForWatchModelStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[[]string](mr.mock.ctrl.T, mr.mock, "NamespacesForWatchModelStatus")
+	mr.namespacesForWatchModelStatusExpects = append(mr.namespacesForWatchModelStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelStateNamespacesForWatchModelStatusCall is the typed call wrapper for NamespacesForWatchModelStatus.
+type MockModelStateNamespacesForWatchModelStatusCall = gomock.Call0_1[[]string]
+
 // NamespacesForWatchOfferStatus mocks base method.
 func (m *MockModelState) NamespacesForWatchOfferStatus() (string, string, string, string, string) {
 	m.ctrl.T.Helper()
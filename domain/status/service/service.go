@@ -198,6 +198,11 @@ type ModelState interface {
 	// for application status changes.
 	NamespacesForWatchOfferStatus() (offer, application, unitAgent, unitWorkload, unitPod string)
 
+	// NamespacesForWatchModelStatus returns the namespace string identifiers
+	// for changes to the life and status of the model and its applications,
+	// units and machines.
+	NamespacesForWatchModelStatus() []string
+
 	// IsControllerModel returns if the model is a controller model.
 	IsControllerModel(ctx context.Context) (bool, error)
 }
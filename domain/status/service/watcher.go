@@ -153,3 +153,31 @@ func (s *WatchableService) WatchOfferStatus(ctx context.Context, offerUUID offer
 		),
 	)
 }
+
+// WatchModelStatus returns a watcher that notifies of any change to the life
+// or status of the model, or of the applications, units and machines in it.
+// Changes are coalesced, so consumers are expected to read the current status
+// of the entities they are interested in on each notification.
+func (s *WatchableService) WatchModelStatus(ctx context.Context) (watcher.NotifyWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	namespaces := s.modelState.NamespacesForWatchModelStatus()
+	filters := transform.Slice(namespaces, func(namespace string) eventsource.FilterOption {
+		return eventsource.NamespaceFilter(namespace, changestream.All)
+	})
+
+	var mapper eventsource.Mapper = func(ctx context.Context, events []changestream.ChangeEvent) ([]string, error) {
+		return transform.Slice(events, func(c changestream.ChangeEvent) string {
+			return c.Changed()
+		}), nil
+	}
+
+	return s.watcherFactory.NewNotifyMapperWatcher(
+		ctx,
+		"model status watcher",
+		mapper,
+		filters[0],
+		filters[1:]...,
+	)
+}
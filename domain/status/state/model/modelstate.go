@@ -2621,6 +2621,25 @@ func (s *ModelState) NamespacesForWatchOfferStatus() (offer, application, unitAg
 	return "offer", "application_status", "custom_unit_agent_status", "custom_unit_workload_status", "custom_k8s_pod_status"
 }
 
+// NamespacesForWatchModelStatus returns the namespace string identifiers
+// for changes to the life and status of the model and its applications,
+// units and machines.
+func (s *ModelState) NamespacesForWatchModelStatus() []string {
+	return []string{
+		"custom_model_life_model_uuid_lifecycle",
+		"application",
+		"application_scale",
+		"application_status",
+		"unit",
+		"custom_unit_agent_status",
+		"custom_unit_workload_status",
+		"custom_k8s_pod_status",
+		"machine",
+		"machine_status",
+		"machine_cloud_instance_status",
+	}
+}
+
 func encodeIPAddress(address machineSpaceAddress) (corenetwork.SpaceAddress, error) {
 	spaceUUID := corenetwork.AlphaSpaceId
 	if address.SpaceUUID.Valid {
@@ -229,6 +229,76 @@ func (s *watcherSuite) TestWatchOfferNotFound(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferNotFound)
 }
 
+func (s *watcherSuite) TestWatchModelStatus(c *tc.C) {
+	unitUUID := tc.Must(c, coreunit.NewUUID)
+	netNodeUUID := tc.Must(c, domainnetwork.NewNetNodeUUID)
+	s.createIAASApplication(c, "foo", life.Alive, application.AddIAASUnitArg{
+		AddUnitArg: application.AddUnitArg{
+			UnitUUID:    unitUUID,
+			NetNodeUUID: netNodeUUID,
+		},
+		MachineUUID:        tc.Must(c, coremachine.NewUUID),
+		MachineNetNodeUUID: netNodeUUID,
+	})
+
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "status")
+	svc := s.setupService(c, factory)
+
+	s.AssertChangeStreamIdle(c, "before watcher start")
+
+	watcher, err := svc.WatchModelStatus(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Assert that setting the status of the application triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetApplicationStatus(c.Context(), "foo", status.StatusInfo{
+			Status: status.Active,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the workload status of a unit triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetUnitWorkloadStatus(c.Context(), "foo/0", status.StatusInfo{
+			Status: status.Waiting,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that setting the status of a machine triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := svc.SetMachineStatus(c.Context(), "0", status.StatusInfo{
+			Status: status.Started,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that the life of a unit changing triggers the watcher.
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := s.ModelTxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, "UPDATE unit SET life_id = 1 WHERE uuid = ?", unitUUID)
+			return err
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) setupService(c *tc.C, factory domain.WatchableDBFactory) *service.WatchableService {
 	modelDB := func(ctx context.Context) (database.TxnRunner, error) {
 		return s.ModelTxnRunner(), nil
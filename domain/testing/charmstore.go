@@ -0,0 +1,31 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/application/charm/store"
+	"github.com/juju/juju/internal/charmsignature"
+	"github.com/juju/juju/internal/logger"
+)
+
+type warnPolicyGetter struct{}
+
+// SignaturePolicy returns the "warn" policy, with no trusted signing keys.
+func (warnPolicyGetter) SignaturePolicy(context.Context) (charmsignature.Policy, []charmsignature.TrustedKey, error) {
+	return charmsignature.PolicyWarn, nil, nil
+}
+
+// NewCharmStore returns a charm store backed by the object store getter,
+// which accepts charms without checking their signatures, as a model does
+// by default. The getter may be nil if no charm archives are stored.
+func NewCharmStore(objectStoreGetter objectstore.ModelObjectStoreGetter) *store.CharmStore {
+	return store.NewCharmStore(
+		objectStoreGetter,
+		charmsignature.NewVerifier(warnPolicyGetter{}, logger.Noop()),
+		logger.Noop(),
+	)
+}
@@ -24,6 +24,8 @@ const (
 	stackTracesKey           = "stack-traces"
 	sampleRatioKey           = "sample-ratio"
 	tailSamplingThresholdKey = "tail-sampling-threshold"
+	exportMetricsKey         = "export-metrics"
+	exportLogsKey            = "export-logs"
 )
 
 // State defines an interface for interacting with the underlying state.
@@ -108,6 +110,14 @@ type WorkloadTracingConfig struct {
 	OpenTelemetryStackTraces           *bool
 	OpenTelemetrySampleRatio           *float64
 	OpenTelemetryTailSamplingThreshold *string
+
+	// ExportMetrics indicates if the controller metrics should be exported
+	// to the same OTEL collector as the traces.
+	ExportMetrics *bool
+	// ExportLogs indicates if the logs of every agent should be sent to the
+	// same OTEL collector as the traces, instead of to Loki or the
+	// controller.
+	ExportLogs *bool
 }
 
 // SetCharmTracingConfig sets the charm tracing config. This method will
@@ -170,6 +180,16 @@ func (s *Service) SetWorkloadTracingConfig(ctx context.Context, config WorkloadT
 	} else {
 		deletions = append(deletions, tailSamplingThresholdKey)
 	}
+	if config.ExportMetrics != nil {
+		insertions[exportMetricsKey] = strconv.FormatBool(*config.ExportMetrics)
+	} else {
+		deletions = append(deletions, exportMetricsKey)
+	}
+	if config.ExportLogs != nil {
+		insertions[exportLogsKey] = strconv.FormatBool(*config.ExportLogs)
+	} else {
+		deletions = append(deletions, exportLogsKey)
+	}
 
 	return s.st.SetWorkloadTracingConfig(ctx, insertions, deletions)
 }
@@ -189,6 +209,8 @@ func (s *Service) GetWorkloadTracingConfig(ctx context.Context) (WorkloadTracing
 		openTelemetryStackTraces           *bool
 		openTelemetrySampleRatio           *float64
 		openTelemetryTailSamplingThreshold *string
+		exportMetrics                      *bool
+		exportLogs                         *bool
 	)
 
 	if value, ok := configMap[insecureSkipVerifyKey]; ok {
@@ -215,6 +237,20 @@ func (s *Service) GetWorkloadTracingConfig(ctx context.Context) (WorkloadTracing
 	if value, ok := configMap[tailSamplingThresholdKey]; ok {
 		openTelemetryTailSamplingThreshold = &value
 	}
+	if value, ok := configMap[exportMetricsKey]; ok {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return WorkloadTracingConfig{}, errors.Errorf("parsing %q: %w", exportMetricsKey, err)
+		}
+		exportMetrics = &parsed
+	}
+	if value, ok := configMap[exportLogsKey]; ok {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return WorkloadTracingConfig{}, errors.Errorf("parsing %q: %w", exportLogsKey, err)
+		}
+		exportLogs = &parsed
+	}
 
 	return WorkloadTracingConfig{
 		HTTPEndpoint:                       configMap[httpEndpointKey],
@@ -224,6 +260,8 @@ func (s *Service) GetWorkloadTracingConfig(ctx context.Context) (WorkloadTracing
 		OpenTelemetryStackTraces:           openTelemetryStackTraces,
 		OpenTelemetrySampleRatio:           openTelemetrySampleRatio,
 		OpenTelemetryTailSamplingThreshold: openTelemetryTailSamplingThreshold,
+		ExportMetrics:                      exportMetrics,
+		ExportLogs:                         exportLogs,
 	}, nil
 }
 
@@ -136,6 +136,8 @@ func (s *serviceSuite) TestSetWorkloadTracingConfigAllFields(c *tc.C) {
 	*openTelemetrySampleRatio = 0.42
 	openTelemetryTailSamplingThreshold := new(string)
 	*openTelemetryTailSamplingThreshold = "250ms"
+	exportMetrics := true
+	exportLogs := false
 
 	config := WorkloadTracingConfig{
 		HTTPEndpoint:                       "http://localhost:4318",
@@ -145,6 +147,8 @@ func (s *serviceSuite) TestSetWorkloadTracingConfigAllFields(c *tc.C) {
 		OpenTelemetryStackTraces:           openTelemetryStackTraces,
 		OpenTelemetrySampleRatio:           openTelemetrySampleRatio,
 		OpenTelemetryTailSamplingThreshold: openTelemetryTailSamplingThreshold,
+		ExportMetrics:                      &exportMetrics,
+		ExportLogs:                         &exportLogs,
 	}
 
 	s.st.EXPECT().SetWorkloadTracingConfig(gomock.Any(), map[string]string{
@@ -155,6 +159,8 @@ func (s *serviceSuite) TestSetWorkloadTracingConfigAllFields(c *tc.C) {
 		stackTracesKey:           "false",
 		sampleRatioKey:           "0.42",
 		tailSamplingThresholdKey: "250ms",
+		exportMetricsKey:         "true",
+		exportLogsKey:            "false",
 	}, []string{}).Return(nil)
 
 	err := NewService(s.st).SetWorkloadTracingConfig(c.Context(), config)
@@ -172,6 +178,8 @@ func (s *serviceSuite) TestSetWorkloadTracingConfigNoFields(c *tc.C) {
 		stackTracesKey,
 		sampleRatioKey,
 		tailSamplingThresholdKey,
+		exportMetricsKey,
+		exportLogsKey,
 	}).Return(nil)
 
 	err := NewService(s.st).SetWorkloadTracingConfig(c.Context(), WorkloadTracingConfig{})
@@ -201,6 +209,8 @@ func (s *serviceSuite) TestSetWorkloadTracingConfigPartialFields(c *tc.C) {
 		httpEndpointKey,
 		sampleRatioKey,
 		tailSamplingThresholdKey,
+		exportMetricsKey,
+		exportLogsKey,
 	}).Return(nil)
 
 	err := NewService(s.st).SetWorkloadTracingConfig(c.Context(), config)
@@ -259,6 +269,8 @@ func (s *serviceSuite) TestGetWorkloadTracingConfigAllFields(c *tc.C) {
 		stackTracesKey:           "true",
 		sampleRatioKey:           "0.5",
 		tailSamplingThresholdKey: "123ms",
+		exportMetricsKey:         "true",
+		exportLogsKey:            "true",
 	}, nil)
 
 	insecureSkipVerify := new(bool)
@@ -269,6 +281,8 @@ func (s *serviceSuite) TestGetWorkloadTracingConfigAllFields(c *tc.C) {
 	*openTelemetrySampleRatio = 0.5
 	openTelemetryTailSamplingThreshold := new(string)
 	*openTelemetryTailSamplingThreshold = "123ms"
+	exportMetrics := true
+	exportLogs := true
 
 	config, err := NewService(s.st).GetWorkloadTracingConfig(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
@@ -280,6 +294,8 @@ func (s *serviceSuite) TestGetWorkloadTracingConfigAllFields(c *tc.C) {
 		OpenTelemetryStackTraces:           openTelemetryStackTraces,
 		OpenTelemetrySampleRatio:           openTelemetrySampleRatio,
 		OpenTelemetryTailSamplingThreshold: openTelemetryTailSamplingThreshold,
+		ExportMetrics:                      &exportMetrics,
+		ExportLogs:                         &exportLogs,
 	})
 }
 
@@ -332,6 +348,17 @@ func (s *serviceSuite) TestGetWorkloadTracingConfigInvalidSampleRatio(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "parsing .*sample-ratio.*")
 }
 
+func (s *serviceSuite) TestGetWorkloadTracingConfigInvalidExportMetrics(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetWorkloadTracingConfig(gomock.Any()).Return(map[string]string{
+		exportMetricsKey: "not-a-bool",
+	}, nil)
+
+	_, err := NewService(s.st).GetWorkloadTracingConfig(c.Context())
+	c.Assert(err, tc.ErrorMatches, "parsing .*export-metrics.*")
+}
+
 func (s *serviceSuite) TestGetWorkloadTracingConfigStateError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -26,10 +26,12 @@ import (
 
 	corebase "github.com/juju/juju/core/base"
 	coremodelconfig "github.com/juju/juju/core/modelconfig"
+	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/featureflag"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/juju/osenv"
@@ -286,6 +288,21 @@ const (
 	// specifying what ingress can be applied to offers in this model
 	SAASIngressAllowKey = "saas-ingress-allow"
 
+	// FirewallIngressRulesKey is a semicolon separated list of ingress
+	// rules, eg "9100/tcp from 10.0.0.0/8", applied to all machines in this
+	// model.
+	FirewallIngressRulesKey = "firewall-ingress-rules"
+
+	// FirewallEgressRulesKey is a semicolon separated list of egress rules,
+	// eg "443/tcp to 10.0.0.0/8", applied to all machines in this model on
+	// providers that support egress rules.
+	FirewallEgressRulesKey = "firewall-egress-rules"
+
+	// CharmSignaturePolicyKey is the key for deciding what happens to charm
+	// and resource archives that are unsigned, or not signed by one of the
+	// controller's charm-signing-keys: "warn" or "enforce".
+	CharmSignaturePolicyKey = "charm-signature-policy"
+
 	//
 	// Deprecated Settings Attributes
 	//
@@ -524,8 +541,13 @@ var defaultConfigValues = map[string]any{
 	MaxActionResultsSize: DefaultActionResultsSize,
 
 	// Model firewall settings
-	SSHAllowKey:         "0.0.0.0/0,::/0",
-	SAASIngressAllowKey: "0.0.0.0/0,::/0",
+	SSHAllowKey:             "0.0.0.0/0,::/0",
+	SAASIngressAllowKey:     "0.0.0.0/0,::/0",
+	FirewallIngressRulesKey: "",
+	FirewallEgressRulesKey:  "",
+
+	// Charm signature settings
+	CharmSignaturePolicyKey: string(charmsignature.PolicyWarn),
 }
 
 // defaultLoggingConfig is the default value for logging-config if it is otherwise not set.
@@ -776,6 +798,14 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		return errors.Trace(err)
 	}
 
+	if err := cfg.validateFirewallRules(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if _, err := charmsignature.ParsePolicy(cfg.asString(CharmSignaturePolicyKey)); err != nil {
+		return errors.NotValidf("%s: %v", CharmSignaturePolicyKey, err)
+	}
+
 	if err := cfg.validateNumProvisionWorkers(); err != nil {
 		return errors.Trace(err)
 	}
@@ -1375,9 +1405,15 @@ func (c *Config) validateCharmHubURL() error {
 		if v == "" {
 			return errors.NotValidf("charm-hub url")
 		}
-		if _, err := url.ParseRequestURI(v); err != nil {
+		u, err := url.ParseRequestURI(v)
+		if err != nil {
 			return errors.NotValidf("charm-hub url %q", v)
 		}
+		// Model config can be read by every model user, so credentials
+		// for a Charmhub mirror are held by the controller instead.
+		if u.User != nil {
+			return errors.NotValidf("charm-hub url with credentials")
+		}
 	}
 	return nil
 }
@@ -1453,6 +1489,40 @@ func (c *Config) SAASIngressAllow() []string {
 	return strings.Split(allowList, ",")
 }
 
+// FirewallIngressRules returns the ingress rules applied to all machines in
+// this model, in addition to the ssh-allow rule.
+func (c *Config) FirewallIngressRules() firewall.IngressRules {
+	// The rules are validated when the config is created.
+	rules, _ := firewall.ParseIngressRules(c.asString(FirewallIngressRulesKey))
+	return rules
+}
+
+// FirewallEgressRules returns the egress rules applied to all machines in
+// this model, on providers that support egress rules.
+func (c *Config) FirewallEgressRules() firewall.EgressRules {
+	// The rules are validated when the config is created.
+	rules, _ := firewall.ParseEgressRules(c.asString(FirewallEgressRulesKey))
+	return rules
+}
+
+// CharmSignaturePolicy returns what happens to charm and resource archives
+// that are unsigned, or not signed by a trusted key.
+func (c *Config) CharmSignaturePolicy() charmsignature.Policy {
+	// The policy is validated when the config is created.
+	policy, _ := charmsignature.ParsePolicy(c.asString(CharmSignaturePolicyKey))
+	return policy
+}
+
+func (c *Config) validateFirewallRules() error {
+	if _, err := firewall.ParseIngressRules(c.asString(FirewallIngressRulesKey)); err != nil {
+		return errors.NotValidf("%s: %v", FirewallIngressRulesKey, err)
+	}
+	if _, err := firewall.ParseEgressRules(c.asString(FirewallEgressRulesKey)); err != nil {
+		return errors.NotValidf("%s: %v", FirewallEgressRulesKey, err)
+	}
+	return nil
+}
+
 func (c *Config) validateCIDRs(cidrs []string, allowEmpty bool) error {
 	if len(cidrs) == 0 && !allowEmpty {
 		return errors.NotValidf("empty cidrs")
@@ -1640,9 +1710,12 @@ var alwaysOptional = schema.Defaults{
 	StorageDefaultBlockSourceKey:      schema.Omit,
 	StorageDefaultFilesystemSourceKey: schema.Omit,
 
-	"firewall-mode":     schema.Omit,
-	SSHAllowKey:         schema.Omit,
-	SAASIngressAllowKey: schema.Omit,
+	"firewall-mode":         schema.Omit,
+	SSHAllowKey:             schema.Omit,
+	SAASIngressAllowKey:     schema.Omit,
+	FirewallIngressRulesKey: schema.Omit,
+	FirewallEgressRulesKey:  schema.Omit,
+	CharmSignaturePolicyKey: schema.Omit,
 
 	"logging-config":                schema.Omit,
 	NumProvisionWorkersKey:          schema.Omit,
@@ -7,6 +7,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/internal/charmsignature"
 	"github.com/juju/juju/internal/configschema"
 )
 
@@ -475,6 +476,36 @@ CIDRs specifying what ingress can be applied to offers in this model.`,
 		Type:  configschema.Tstring,
 		Group: configschema.EnvironGroup,
 	},
+	FirewallIngressRulesKey: {
+		Description: `Firewall ingress rules is a semicolon-separated list of rules
+allowing traffic to all machines in this model. Each rule has the form
+"<port-range> [from <cidr>[,<cidr>...]]", eg "9100/tcp from 10.0.0.0/8".
+A rule without CIDRs allows traffic from all networks.
+Currently only the aws, gce, and openstack providers support firewall-ingress-rules`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
+	FirewallEgressRulesKey: {
+		Description: `Firewall egress rules is a semicolon-separated list of rules
+allowing traffic from all machines in this model. Each rule has the form
+"<port-range> [to <cidr>[,<cidr>...]]", eg "443/tcp to 10.0.0.0/8".
+A rule without CIDRs allows traffic to all networks.
+Once any egress rule is set, all other outgoing traffic is blocked,
+except traffic between the machines of the model, to the controller
+API and to the DNS resolver of the cloud.
+Currently only the aws provider supports firewall-egress-rules`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
+	CharmSignaturePolicyKey: {
+		Description: `Charm signature policy decides what happens to charm and resource
+archives that are unsigned, or not signed by one of the controller's
+charm-signing-keys. With "warn" the archive is accepted and a warning
+is logged, with "enforce" the archive is rejected`,
+		Type:   configschema.Tstring,
+		Group:  configschema.EnvironGroup,
+		Values: []any{string(charmsignature.PolicyWarn), string(charmsignature.PolicyEnforce)},
+	},
 	TypeKey: {
 		Description: "Type of model, e.g. local, ec2",
 		Type:        configschema.Tstring,
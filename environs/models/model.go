@@ -25,3 +25,22 @@ type ModelFirewaller interface {
 	// If the model security group doesn't exist, return a NotFound error
 	ModelIngressRules(ctx context.Context) (firewall.IngressRules, error)
 }
+
+// ModelEgressFirewaller provides model-level egress firewall functionality.
+// It is implemented by providers whose model firewall supports rules for
+// outgoing traffic.
+type ModelEgressFirewaller interface {
+	// OpenModelEgressPorts allows outgoing traffic to the given port ranges
+	// on the model firewall.
+	OpenModelEgressPorts(ctx context.Context, rules firewall.EgressRules) error
+
+	// CloseModelEgressPorts removes the given egress rules from the model
+	// firewall.
+	CloseModelEgressPorts(ctx context.Context, rules firewall.EgressRules) error
+
+	// ModelEgressRules returns the set of egress rules added to the model
+	// firewall, sorted by port range. Egress rules the provider applies by
+	// default are not included.
+	// If the model security group doesn't exist, return a NotFound error
+	ModelEgressRules(ctx context.Context) (firewall.EgressRules, error)
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package auditsink forwards audit log records to remote sinks: an RFC5424
+// syslog receiver over TCP or TLS, an HTTP webhook receiving batches of
+// records as JSON, and Loki.
+//
+// Records are buffered in memory and delivered asynchronously, so that a
+// slow or unavailable sink never holds up the API requests being audited.
+// When the buffer of a sink is full, its oldest records are dropped. The
+// number of records sent and dropped, and of failed deliveries, are exposed
+// as Prometheus metrics for each sink.
+package auditsink
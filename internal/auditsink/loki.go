@@ -0,0 +1,59 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"github.com/juju/worker/v5"
+
+	"github.com/juju/juju/internal/loki"
+)
+
+// lokiServiceName is the service_name label of the records pushed to Loki,
+// which keeps them apart from the logs of the Juju agents.
+const lokiServiceName = "juju-audit"
+
+// lokiClient is the Loki push client surface used by the Loki sink.
+type lokiClient interface {
+	worker.Worker
+	loki.MetricsSource
+	Push(...loki.Record) error
+}
+
+// lokiSink pushes records to Loki. The Loki client does its own buffering,
+// batching and retrying.
+type lokiSink struct {
+	lokiClient
+	controllerUUID string
+}
+
+// newLokiSink returns a sink pushing records to Loki with the given client.
+func newLokiSink(client lokiClient, controllerUUID string) *lokiSink {
+	return &lokiSink{
+		lokiClient:     client,
+		controllerUUID: controllerUUID,
+	}
+}
+
+func (s *lokiSink) add(r record) {
+	// Push only fails once the client is stopping, in which case the
+	// record is lost along with any others still buffered.
+	_ = s.Push(loki.Record{
+		Timestamp:      r.when,
+		Line:           string(r.data),
+		ControllerUUID: s.controllerUUID,
+		ServiceName:    lokiServiceName,
+		Fields: map[string]string{
+			"kind":            r.kind,
+			"conversation_id": r.conversationID,
+		},
+	})
+}
+
+func (s *lokiSink) stats() stats {
+	return stats{
+		sent:    s.Sent(),
+		dropped: s.Dropped(),
+		errors:  s.PushErrors(),
+	}
+}
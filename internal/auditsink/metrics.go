@@ -0,0 +1,61 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "juju"
+	metricsSubsystem = "audit_log_sink"
+)
+
+// metrics holds the descriptions of the delivery metrics of the sinks.
+type metrics struct {
+	sentDesc    *prometheus.Desc
+	droppedDesc *prometheus.Desc
+	errorsDesc  *prometheus.Desc
+}
+
+func newMetrics() metrics {
+	return metrics{
+		sentDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "sent_total"),
+			"Total number of audit log records delivered to the sink.",
+			[]string{"sink"},
+			nil,
+		),
+		droppedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "dropped_total"),
+			"Total number of audit log records dropped because the buffer of the sink was full.",
+			[]string{"sink"},
+			nil,
+		),
+		errorsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "errors_total"),
+			"Total number of deliveries to the sink that failed after retrying.",
+			[]string{"sink"},
+			nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (s *Set) Describe(ch chan<- *prometheus.Desc) {
+	ch <- s.metrics.sentDesc
+	ch <- s.metrics.droppedDesc
+	ch <- s.metrics.errorsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (s *Set) Collect(ch chan<- prometheus.Metric) {
+	for name, st := range s.sinkStats() {
+		ch <- prometheus.MustNewConstMetric(s.metrics.sentDesc, prometheus.CounterValue, float64(st.sent), name)
+		ch <- prometheus.MustNewConstMetric(s.metrics.droppedDesc, prometheus.CounterValue, float64(st.dropped), name)
+		ch <- prometheus.MustNewConstMetric(s.metrics.errorsDesc, prometheus.CounterValue, float64(st.errors), name)
+	}
+}
+
+var _ prometheus.Collector = (*Set)(nil)
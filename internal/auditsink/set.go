@@ -0,0 +1,275 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"crypto/tls"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
+	"github.com/juju/juju/internal/loki"
+)
+
+const (
+	// The names of the sinks, as used in metrics and log messages.
+	syslogSinkName  = "syslog"
+	webhookSinkName = "webhook"
+	lokiSinkName    = "loki"
+
+	// httpTimeout bounds each request made to the webhook and Loki.
+	httpTimeout = 10 * time.Second
+)
+
+// SetConfig holds the settings of a Set.
+type SetConfig struct {
+	// ControllerUUID labels the records pushed to Loki.
+	ControllerUUID string
+
+	// Hostname is the HOSTNAME of the syslog messages.
+	Hostname string
+
+	Clock  clock.Clock
+	Logger logger.Logger
+}
+
+// Validate checks that the config is usable.
+func (c SetConfig) Validate() error {
+	if c.Clock == nil {
+		return errors.New("nil Clock").Add(coreerrors.NotValid)
+	}
+	if c.Logger == nil {
+		return errors.New("nil Logger").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// namedSink is a running sink, along with its name.
+type namedSink struct {
+	name string
+	sink sink
+}
+
+// Set is an audit log which forwards records to the remote sinks enabled
+// by its sink config. The sinks can be changed at any time with Update.
+//
+// Records are delivered asynchronously, and failing to deliver them is
+// never reported back to the caller, so that an unavailable sink cannot
+// fail the API requests being audited.
+//
+// Set is also a prometheus.Collector of the delivery metrics of its sinks.
+// The metrics of a sink accumulate across updates of the sink config.
+type Set struct {
+	cfg     SetConfig
+	batch   batchConfig
+	metrics metrics
+
+	mu       sync.RWMutex
+	current  auditlog.SinkConfig
+	live     []namedSink
+	stopping []namedSink
+	retired  map[string]stats
+}
+
+// NewSet returns a Set with no sinks enabled.
+func NewSet(cfg SetConfig) (*Set, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &Set{
+		cfg: cfg,
+		batch: batchConfig{
+			BufferSize:    1000,
+			BatchSize:     100,
+			FlushInterval: time.Second,
+			RetryAttempts: 3,
+			RetryDelay:    500 * time.Millisecond,
+			Clock:         cfg.Clock,
+			Logger:        cfg.Logger,
+		},
+		metrics: newMetrics(),
+		retired: make(map[string]stats),
+	}, nil
+}
+
+// Update starts the sinks enabled by the given config, and stops the sinks
+// which were running before. It does nothing if the config is unchanged.
+func (s *Set) Update(cfg auditlog.SinkConfig) error {
+	s.mu.Lock()
+	if cfg == s.current {
+		s.mu.Unlock()
+		return nil
+	}
+	sinks, err := s.newSinks(cfg)
+	if err != nil {
+		s.mu.Unlock()
+		return errors.Capture(err)
+	}
+	old := s.live
+	s.current = cfg
+	s.live = sinks
+	s.stopping = append(s.stopping, old...)
+	s.mu.Unlock()
+
+	s.stop(old)
+	return nil
+}
+
+func (s *Set) newSinks(cfg auditlog.SinkConfig) ([]namedSink, error) {
+	if cfg.SyslogAddress == "" && cfg.WebhookURL == "" && cfg.LokiURL == "" {
+		return nil, nil
+	}
+	tlsConfig, err := newTLSConfig(cfg.CACert)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var sinks []namedSink
+	if cfg.SyslogAddress != "" {
+		syslogCfg := syslogConfig{
+			batchConfig: s.batch,
+			Address:     cfg.SyslogAddress,
+			Hostname:    s.cfg.Hostname,
+		}
+		syslogCfg.Name = syslogSinkName
+		if cfg.SyslogTLS {
+			syslogCfg.TLSConfig = tlsConfig
+		}
+		sinks = append(sinks, namedSink{
+			name: syslogSinkName,
+			sink: newSyslogSink(syslogCfg),
+		})
+	}
+	if cfg.WebhookURL != "" {
+		webhookCfg := webhookConfig{
+			batchConfig: s.batch,
+			URL:         cfg.WebhookURL,
+			HTTPClient:  newHTTPClient(tlsConfig),
+		}
+		webhookCfg.Name = webhookSinkName
+		sinks = append(sinks, namedSink{
+			name: webhookSinkName,
+			sink: newWebhookSink(webhookCfg),
+		})
+	}
+	if cfg.LokiURL != "" {
+		lokiCfg := loki.DefaultConfig()
+		lokiCfg.BufferSize = s.batch.BufferSize
+		lokiCfg.BatchSize = s.batch.BatchSize
+		lokiCfg.FlushInterval = s.batch.FlushInterval
+		lokiCfg.Clock = s.cfg.Clock
+		lokiCfg.HTTPClient = newHTTPClient(tlsConfig)
+		lokiCfg.ServiceName = lokiServiceName
+		client, err := loki.NewClient(cfg.LokiURL, lokiCfg)
+		if err != nil {
+			s.stop(sinks)
+			return nil, errors.Capture(err)
+		}
+		sinks = append(sinks, namedSink{
+			name: lokiSinkName,
+			sink: newLokiSink(client, s.cfg.ControllerUUID),
+		})
+	}
+	return sinks, nil
+}
+
+// stop stops the given sinks, waiting for them to deliver the records
+// still buffered, and keeps their final delivery counts.
+func (s *Set) stop(sinks []namedSink) {
+	for _, n := range sinks {
+		n.sink.Kill()
+	}
+	for _, n := range sinks {
+		_ = n.sink.Wait()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, n := range sinks {
+		s.retired[n.name] = s.retired[n.name].plus(n.sink.stats())
+		for i, stopping := range s.stopping {
+			if stopping.sink == n.sink {
+				s.stopping = append(s.stopping[:i], s.stopping[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// AddConversation implements auditlog.AuditLog.
+func (s *Set) AddConversation(c auditlog.Conversation) error {
+	return s.add(kindConversation, c.ConversationID, c.When, auditlog.Record{Conversation: &c})
+}
+
+// AddRequest implements auditlog.AuditLog.
+func (s *Set) AddRequest(r auditlog.Request) error {
+	return s.add(kindRequest, r.ConversationID, r.When, auditlog.Record{Request: &r})
+}
+
+// AddResponse implements auditlog.AuditLog.
+func (s *Set) AddResponse(r auditlog.ResponseErrors) error {
+	return s.add(kindErrors, r.ConversationID, r.When, auditlog.Record{Errors: &r})
+}
+
+// Close implements auditlog.AuditLog. It stops all the sinks.
+func (s *Set) Close() error {
+	return s.Update(auditlog.SinkConfig{})
+}
+
+func (s *Set) add(kind, conversationID, when string, r auditlog.Record) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.live) == 0 {
+		return nil
+	}
+	rec, err := newRecord(kind, conversationID, when, s.cfg.Clock.Now(), r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	for _, n := range s.live {
+		n.sink.add(rec)
+	}
+	return nil
+}
+
+// sinkStats returns the delivery counts of every sink which has been
+// enabled, keyed by sink name.
+func (s *Set) sinkStats() map[string]stats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make(map[string]stats, len(s.retired)+len(s.live))
+	for name, st := range s.retired {
+		result[name] = st
+	}
+	for _, sinks := range [][]namedSink{s.stopping, s.live} {
+		for _, n := range sinks {
+			result[n.name] = result[n.name].plus(n.sink.stats())
+		}
+	}
+	return result
+}
+
+// newHTTPClient returns the client used by the webhook and Loki sinks,
+// which honours the proxy settings of the controller.
+func newHTTPClient(tlsConfig *tls.Config) *http.Client {
+	return &http.Client{
+		Timeout: httpTimeout,
+		Transport: jujuhttp.NewHTTPTLSTransport(jujuhttp.TransportConfig{
+			TLSConfig:           tlsConfig,
+			TLSHandshakeTimeout: httpTimeout,
+			Middlewares: []jujuhttp.TransportMiddleware{
+				jujuhttp.ProxyMiddleware,
+			},
+		}),
+	}
+}
+
+var _ auditlog.AuditLog = (*Set)(nil)
@@ -0,0 +1,219 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type setSuite struct{}
+
+func TestSetSuite(t *testing.T) {
+	tc.Run(t, &setSuite{})
+}
+
+func (s *setSuite) newSet(c *tc.C) *Set {
+	set, err := NewSet(SetConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		Hostname:       "controller-0",
+		Clock:          clock.WallClock,
+		Logger:         loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	set.batch.FlushInterval = 10 * time.Millisecond
+	set.batch.RetryDelay = time.Millisecond
+	return set
+}
+
+// webhookReceiver returns a server collecting the records posted to it.
+func webhookReceiver(c *tc.C) (*httptest.Server, <-chan json.RawMessage) {
+	records := make(chan json.RawMessage, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []json.RawMessage
+		c.Check(json.NewDecoder(r.Body).Decode(&batch), tc.ErrorIsNil)
+		for _, record := range batch {
+			records <- record
+		}
+	}))
+	return srv, records
+}
+
+func expectRecord(c *tc.C, records <-chan json.RawMessage) string {
+	select {
+	case r := <-records:
+		return string(r)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for webhook record")
+	}
+	return ""
+}
+
+func (s *setSuite) addRecords(c *tc.C, set *Set) {
+	err := set.AddConversation(auditlog.Conversation{
+		ConversationID: "c1",
+		Who:            "alice",
+		When:           "2026-03-01T12:00:00Z",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = set.AddRequest(auditlog.Request{
+		ConversationID: "c1",
+		RequestID:      1,
+		Facade:         "Application",
+		Method:         "Deploy",
+		When:           "2026-03-01T12:00:01Z",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = set.AddResponse(auditlog.ResponseErrors{
+		ConversationID: "c1",
+		RequestID:      1,
+		When:           "2026-03-01T12:00:02Z",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *setSuite) TestNoSinks(c *tc.C) {
+	set := s.newSet(c)
+	s.addRecords(c, set)
+	c.Check(testutil.CollectAndCount(set), tc.Equals, 0)
+	c.Check(set.Close(), tc.ErrorIsNil)
+}
+
+func (s *setSuite) TestForwardsToAllSinks(c *tc.C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer l.Close()
+	messages := acceptSyslog(l)
+
+	webhook, records := webhookReceiver(c)
+	defer webhook.Close()
+
+	lines := make(chan string, 10)
+	lokiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		c.Check(err, tc.ErrorIsNil)
+		lines <- string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer lokiServer.Close()
+
+	set := s.newSet(c)
+	err = set.Update(auditlog.SinkConfig{
+		SyslogAddress: l.Addr().String(),
+		WebhookURL:    webhook.URL,
+		LokiURL:       lokiServer.URL,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.addRecords(c, set)
+
+	c.Check(expectSyslogMessage(c, messages), tc.Matches,
+		`<110>1 2026-03-01T12:00:00.000000Z controller-0 juju-audit - conversation - \{"conversation":\{"who":"alice",.*\}\}`)
+	c.Check(expectSyslogMessage(c, messages), tc.Matches, `.* juju-audit - request - \{"request":.*"method":"Deploy".*\}`)
+	c.Check(expectSyslogMessage(c, messages), tc.Matches, `.* juju-audit - errors - \{"errors":.*\}`)
+
+	c.Check(expectRecord(c, records), tc.Matches, `\{"conversation":\{"who":"alice",.*\}\}`)
+	c.Check(expectRecord(c, records), tc.Matches, `\{"request":.*\}`)
+	c.Check(expectRecord(c, records), tc.Matches, `\{"errors":.*\}`)
+
+	var pushed strings.Builder
+	for strings.Count(pushed.String(), `\"conversation-id\":\"c1\"`) < 3 {
+		select {
+		case line := <-lines:
+			pushed.WriteString(line)
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for Loki push, got %s", pushed.String())
+		}
+	}
+	c.Check(pushed.String(), tc.Contains, `"service_name":"juju-audit"`)
+	c.Check(pushed.String(), tc.Contains, `"juju_controller":"`+coretesting.ControllerTag.Id()+`"`)
+
+	// Loki counts the records as sent once it has responded.
+	waitForStats(c, statsOf{set, lokiSinkName}, stats{sent: 3})
+	c.Assert(set.Close(), tc.ErrorIsNil)
+	err = testutil.CollectAndCompare(set, strings.NewReader(`
+# HELP juju_audit_log_sink_sent_total Total number of audit log records delivered to the sink.
+# TYPE juju_audit_log_sink_sent_total counter
+juju_audit_log_sink_sent_total{sink="loki"} 3
+juju_audit_log_sink_sent_total{sink="syslog"} 3
+juju_audit_log_sink_sent_total{sink="webhook"} 3
+`), "juju_audit_log_sink_sent_total")
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *setSuite) TestUpdateReplacesSinks(c *tc.C) {
+	first, firstRecords := webhookReceiver(c)
+	defer first.Close()
+	second, secondRecords := webhookReceiver(c)
+	defer second.Close()
+
+	set := s.newSet(c)
+	defer set.Close()
+
+	err := set.Update(auditlog.SinkConfig{WebhookURL: first.URL})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(set.AddConversation(auditlog.Conversation{ConversationID: "c1"}), tc.ErrorIsNil)
+	expectRecord(c, firstRecords)
+	waitForStats(c, statsOf{set, webhookSinkName}, stats{sent: 1})
+
+	// Updating with the same config keeps the running sink.
+	err = set.Update(auditlog.SinkConfig{WebhookURL: first.URL})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(set.live, tc.HasLen, 1)
+	running := set.live[0].sink
+
+	err = set.Update(auditlog.SinkConfig{WebhookURL: second.URL})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(set.live, tc.HasLen, 1)
+	c.Check(set.live[0].sink != running, tc.IsTrue)
+	c.Check(set.stopping, tc.HasLen, 0)
+
+	c.Assert(set.AddConversation(auditlog.Conversation{ConversationID: "c2"}), tc.ErrorIsNil)
+	c.Check(expectRecord(c, secondRecords), tc.Matches, `.*"conversation-id":"c2".*`)
+	c.Check(len(firstRecords), tc.Equals, 0)
+
+	// The metrics of the webhook sink carry over from the first sink.
+	waitForStats(c, statsOf{set, webhookSinkName}, stats{sent: 2})
+}
+
+func (s *setSuite) TestUpdateInvalidCACert(c *tc.C) {
+	set := s.newSet(c)
+	err := set.Update(auditlog.SinkConfig{
+		WebhookURL: "https://audit.example.com",
+		CACert:     "foo",
+	})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(set.live, tc.HasLen, 0)
+}
+
+func (s *setSuite) TestValidate(c *tc.C) {
+	_, err := NewSet(SetConfig{Logger: loggertesting.WrapCheckLog(c)})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	_, err = NewSet(SetConfig{Clock: clock.WallClock})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// statsOf reports the delivery counts of the named sink of a set.
+type statsOf struct {
+	set  *Set
+	name string
+}
+
+func (s statsOf) stats() stats {
+	return s.set.sinkStats()[s.name]
+}
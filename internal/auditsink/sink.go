@@ -0,0 +1,306 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/retry"
+	"github.com/juju/worker/v5"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
+)
+
+const (
+	// kindConversation, kindRequest and kindErrors identify the kind of
+	// audit log record being forwarded.
+	kindConversation = "conversation"
+	kindRequest      = "request"
+	kindErrors       = "errors"
+
+	// shutdownFlushTimeout bounds how long a stopping sink spends
+	// delivering the records still buffered.
+	shutdownFlushTimeout = 5 * time.Second
+
+	// maxRetryDelay bounds the delay between attempts to deliver a
+	// batch.
+	maxRetryDelay = 5 * time.Second
+)
+
+// record is an audit log record encoded for delivery to a sink.
+type record struct {
+	kind           string
+	conversationID string
+	when           time.Time
+	// data holds the record encoded as JSON, in the same form as it is
+	// written to the audit log file.
+	data []byte
+}
+
+// newRecord encodes an audit log record. The time of the record is parsed
+// from when, falling back to now if it is not an RFC3339 timestamp.
+func newRecord(kind, conversationID, when string, now time.Time, r auditlog.Record) (record, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return record{}, errors.Capture(err)
+	}
+	t, err := time.Parse(time.RFC3339, when)
+	if err != nil {
+		t = now
+	}
+	return record{
+		kind:           kind,
+		conversationID: conversationID,
+		when:           t,
+		data:           data,
+	}, nil
+}
+
+// stats holds the delivery counts of a sink.
+type stats struct {
+	// sent is the number of records delivered.
+	sent uint64
+	// dropped is the number of records dropped because the buffer of
+	// the sink was full.
+	dropped uint64
+	// errors is the number of deliveries that failed after retrying.
+	errors uint64
+}
+
+func (s stats) plus(o stats) stats {
+	return stats{
+		sent:    s.sent + o.sent,
+		dropped: s.dropped + o.dropped,
+		errors:  s.errors + o.errors,
+	}
+}
+
+// sink is a worker which delivers audit log records to a remote sink.
+type sink interface {
+	worker.Worker
+
+	// add queues a record for delivery. It never blocks; if the buffer of
+	// the sink is full, the oldest queued record is dropped.
+	add(record)
+
+	// stats returns the delivery counts of the sink.
+	stats() stats
+}
+
+// permanentError marks a delivery error which is not worth retrying.
+type permanentError struct {
+	error
+}
+
+// Unwrap returns the underlying error.
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+// batchConfig holds the settings of a batcher.
+type batchConfig struct {
+	// Name identifies the sink in log messages.
+	Name string
+
+	// BufferSize is the maximum number of records queued for delivery.
+	BufferSize int
+
+	// BatchSize is the maximum number of records delivered at once.
+	BatchSize int
+
+	// FlushInterval is how long a record may wait for its batch to fill
+	// before it is delivered.
+	FlushInterval time.Duration
+
+	// RetryAttempts is the number of times delivering a batch is
+	// attempted before it is given up.
+	RetryAttempts int
+
+	// RetryDelay is the delay before the first retry, doubled for each
+	// further retry.
+	RetryDelay time.Duration
+
+	Clock  clock.Clock
+	Logger logger.Logger
+}
+
+// batcher buffers records and delivers them in batches, using the send
+// function of a sink.
+type batcher struct {
+	tomb    tomb.Tomb
+	cfg     batchConfig
+	records chan record
+	send    func(context.Context, []record) error
+	cleanup func()
+
+	sent    atomic.Uint64
+	dropped atomic.Uint64
+	errors  atomic.Uint64
+}
+
+// newBatcher starts a batcher delivering records with send. The cleanup
+// function, if not nil, is called when the batcher stops.
+func newBatcher(cfg batchConfig, send func(context.Context, []record) error, cleanup func()) *batcher {
+	b := &batcher{
+		cfg:     cfg,
+		records: make(chan record, cfg.BufferSize),
+		send:    send,
+		cleanup: cleanup,
+	}
+	b.tomb.Go(b.loop)
+	return b
+}
+
+// Kill is part of the worker.Worker interface.
+func (b *batcher) Kill() {
+	b.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (b *batcher) Wait() error {
+	return b.tomb.Wait()
+}
+
+func (b *batcher) add(r record) {
+	for {
+		select {
+		case b.records <- r:
+			return
+		case <-b.tomb.Dying():
+			b.dropped.Add(1)
+			return
+		default:
+		}
+
+		select {
+		case <-b.records:
+			b.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+func (b *batcher) stats() stats {
+	return stats{
+		sent:    b.sent.Load(),
+		dropped: b.dropped.Load(),
+		errors:  b.errors.Load(),
+	}
+}
+
+func (b *batcher) loop() error {
+	if b.cleanup != nil {
+		defer b.cleanup()
+	}
+	ctx := b.tomb.Context(context.Background())
+
+	var (
+		batch []record
+		flush <-chan time.Time
+	)
+	for {
+		select {
+		case <-b.tomb.Dying():
+			b.flushRemaining(batch)
+			return tomb.ErrDying
+
+		case r := <-b.records:
+			batch = append(batch, r)
+			if len(batch) >= b.cfg.BatchSize {
+				batch, flush = b.deliver(ctx, batch, b.cfg.RetryAttempts), nil
+			} else if flush == nil {
+				flush = b.cfg.Clock.After(b.cfg.FlushInterval)
+			}
+
+		case <-flush:
+			batch, flush = b.deliver(ctx, batch, b.cfg.RetryAttempts), nil
+		}
+	}
+}
+
+// flushRemaining makes a single attempt to deliver the given records and
+// those still queued, once the batcher is stopping.
+func (b *batcher) flushRemaining(batch []record) {
+	for {
+		select {
+		case r := <-b.records:
+			batch = append(batch, r)
+			continue
+		default:
+		}
+		break
+	}
+	if len(batch) == 0 {
+		return
+	}
+	// The tomb context is already cancelled, so the records are
+	// delivered with a context of their own.
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+	defer cancel()
+	if batch := b.deliver(ctx, batch, 1); batch != nil {
+		b.errors.Add(1)
+		b.cfg.Logger.Warningf(ctx, "cannot deliver %d audit log records to %s before stopping", len(batch), b.cfg.Name)
+	}
+}
+
+// deliver sends a batch of records, retrying failed attempts with an
+// exponential backoff. If delivery is interrupted because the batcher is
+// stopping, the batch is returned so that it can be flushed with the
+// remaining records.
+func (b *batcher) deliver(ctx context.Context, batch []record, attempts int) []record {
+	err := retry.Call(retry.CallArgs{
+		Func: func() error {
+			return b.send(ctx, batch)
+		},
+		IsFatalError: func(err error) bool {
+			return errors.As(err, &permanentError{})
+		},
+		Attempts:    attempts,
+		Delay:       b.cfg.RetryDelay,
+		MaxDelay:    maxRetryDelay,
+		BackoffFunc: retry.DoubleDelay,
+		Clock:       b.cfg.Clock,
+		Stop:        ctx.Done(),
+	})
+	if err == nil {
+		b.sent.Add(uint64(len(batch)))
+		return nil
+	}
+	if ctx.Err() != nil {
+		return batch
+	}
+	if retry.IsAttemptsExceeded(err) {
+		err = retry.LastError(err)
+	}
+	b.errors.Add(1)
+	b.cfg.Logger.Warningf(ctx, "cannot deliver %d audit log records to %s: %v", len(batch), b.cfg.Name, err)
+	return nil
+}
+
+// newTLSConfig returns the TLS config used to connect to the sinks, which
+// trusts the given PEM encoded CA certificate in addition to the system
+// roots.
+func newTLSConfig(caCert string) (*tls.Config, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if caCert != "" && !pool.AppendCertsFromPEM([]byte(caCert)) {
+		return nil, errors.New("cannot parse audit log sink CA certificate").Add(coreerrors.NotValid)
+	}
+	cfg := jujuhttp.SecureTLSConfig()
+	cfg.RootCAs = pool
+	return cfg, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type batcherSuite struct {
+	sent chan []record
+}
+
+func TestBatcherSuite(t *testing.T) {
+	tc.Run(t, &batcherSuite{})
+}
+
+func (s *batcherSuite) SetUpTest(c *tc.C) {
+	s.sent = make(chan []record, 10)
+}
+
+func (s *batcherSuite) config(c *tc.C, clk clock.Clock) batchConfig {
+	return batchConfig{
+		Name:          "test",
+		BufferSize:    10,
+		BatchSize:     2,
+		FlushInterval: time.Minute,
+		RetryAttempts: 3,
+		RetryDelay:    time.Millisecond,
+		Clock:         clk,
+		Logger:        loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *batcherSuite) send(_ context.Context, batch []record) error {
+	s.sent <- batch
+	return nil
+}
+
+func (s *batcherSuite) expectBatch(c *tc.C, kinds ...string) {
+	select {
+	case batch := <-s.sent:
+		got := make([]string, len(batch))
+		for i, r := range batch {
+			got[i] = r.kind
+		}
+		c.Check(got, tc.DeepEquals, kinds)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for batch")
+	}
+}
+
+// waitForStats waits until the delivery counts of the sink are as
+// expected.
+func waitForStats(c *tc.C, s interface{ stats() stats }, expected stats) {
+	deadline := time.After(coretesting.LongWait)
+	for s.stats() != expected {
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-deadline:
+			c.Fatalf("timed out waiting for stats %+v, got %+v", expected, s.stats())
+		}
+	}
+}
+
+func testRecord(kind string) record {
+	return record{kind: kind, conversationID: "c1", data: []byte(`{}`)}
+}
+
+func (s *batcherSuite) TestDeliversFullBatch(c *tc.C) {
+	b := newBatcher(s.config(c, clock.WallClock), s.send, nil)
+	defer workertest.CleanKill(c, b)
+
+	b.add(testRecord("one"))
+	b.add(testRecord("two"))
+	s.expectBatch(c, "one", "two")
+	c.Check(b.stats(), tc.Equals, stats{sent: 2})
+}
+
+func (s *batcherSuite) TestDeliversAfterFlushInterval(c *tc.C) {
+	clk := testclock.NewDilatedWallClock(time.Millisecond)
+	cfg := s.config(c, clk)
+	cfg.BatchSize = 100
+	b := newBatcher(cfg, s.send, nil)
+	defer workertest.CleanKill(c, b)
+
+	b.add(testRecord("one"))
+	s.expectBatch(c, "one")
+}
+
+func (s *batcherSuite) TestDeliversRemainingWhenStopped(c *tc.C) {
+	cleanedUp := make(chan struct{})
+	cfg := s.config(c, clock.WallClock)
+	cfg.BatchSize = 100
+	b := newBatcher(cfg, s.send, func() { close(cleanedUp) })
+
+	b.add(testRecord("one"))
+	workertest.CleanKill(c, b)
+	s.expectBatch(c, "one")
+	c.Check(b.stats(), tc.Equals, stats{sent: 1})
+	select {
+	case <-cleanedUp:
+	default:
+		c.Fatalf("cleanup not called")
+	}
+}
+
+func (s *batcherSuite) TestDropsOldestWhenFull(c *tc.C) {
+	unblock := make(chan struct{})
+	cfg := s.config(c, clock.WallClock)
+	cfg.BufferSize = 1
+	cfg.BatchSize = 1
+	b := newBatcher(cfg, func(ctx context.Context, batch []record) error {
+		s.sent <- batch
+		<-unblock
+		return nil
+	}, nil)
+	defer workertest.CleanKill(c, b)
+
+	// The first record is being delivered, which blocks the batcher
+	// while the second is queued and then dropped for the third.
+	b.add(testRecord("one"))
+	s.expectBatch(c, "one")
+	b.add(testRecord("two"))
+	b.add(testRecord("three"))
+	c.Check(b.stats().dropped, tc.Equals, uint64(1))
+
+	close(unblock)
+	s.expectBatch(c, "three")
+}
+
+func (s *batcherSuite) TestDeliversInterruptedBatchWhenStopped(c *tc.C) {
+	started := make(chan struct{})
+	cfg := s.config(c, clock.WallClock)
+	cfg.BatchSize = 1
+	b := newBatcher(cfg, func(ctx context.Context, batch []record) error {
+		select {
+		case <-started:
+		default:
+			// Block the first attempt until the batcher is stopped.
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return s.send(ctx, batch)
+	}, nil)
+
+	b.add(testRecord("one"))
+	select {
+	case <-started:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for delivery")
+	}
+	workertest.CleanKill(c, b)
+	s.expectBatch(c, "one")
+	c.Check(b.stats(), tc.Equals, stats{sent: 1})
+}
+
+func (s *batcherSuite) TestRetries(c *tc.C) {
+	attempts := 0
+	b := newBatcher(s.config(c, clock.WallClock), func(ctx context.Context, batch []record) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("boom")
+		}
+		return s.send(ctx, batch)
+	}, nil)
+	defer workertest.CleanKill(c, b)
+
+	b.add(testRecord("one"))
+	b.add(testRecord("two"))
+	s.expectBatch(c, "one", "two")
+	c.Check(b.stats(), tc.Equals, stats{sent: 2})
+}
+
+func (s *batcherSuite) TestGivesUpAfterRetries(c *tc.C) {
+	attempts := make(chan struct{}, 10)
+	b := newBatcher(s.config(c, clock.WallClock), func(ctx context.Context, batch []record) error {
+		attempts <- struct{}{}
+		return errors.New("boom")
+	}, nil)
+
+	defer workertest.CleanKill(c, b)
+
+	b.add(testRecord("one"))
+	b.add(testRecord("two"))
+	waitForStats(c, b, stats{errors: 1})
+	c.Check(attempts, tc.HasLen, 3)
+}
+
+func (s *batcherSuite) TestPermanentErrorNotRetried(c *tc.C) {
+	attempts := make(chan struct{}, 10)
+	b := newBatcher(s.config(c, clock.WallClock), func(ctx context.Context, batch []record) error {
+		attempts <- struct{}{}
+		return permanentError{errors.New("bad request")}
+	}, nil)
+	defer workertest.CleanKill(c, b)
+
+	b.add(testRecord("one"))
+	b.add(testRecord("two"))
+	waitForStats(c, b, stats{errors: 1})
+	c.Check(attempts, tc.HasLen, 1)
+}
+
+func (s *batcherSuite) TestNewRecord(c *tc.C) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	r, err := newRecord(kindRequest, "c1", "2026-02-01T10:00:00Z", now, auditlog.Record{
+		Request: &auditlog.Request{ConversationID: "c1", RequestID: 2, Facade: "Client", Method: "FullStatus"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(r.kind, tc.Equals, kindRequest)
+	c.Check(r.conversationID, tc.Equals, "c1")
+	c.Check(r.when, tc.Equals, time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC))
+	c.Check(string(r.data), tc.Equals, `{"request":{"conversation-id":"c1","connection-id":"","request-id":2,"when":"","facade":"Client","method":"FullStatus","version":0}}`)
+
+	r, err = newRecord(kindRequest, "c1", "yesterday", now, auditlog.Record{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(r.when, tc.Equals, now)
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// syslogPriority is the priority of the syslog messages: the log
+	// audit facility (13) at informational severity (6).
+	syslogPriority = 13*8 + 6
+
+	// syslogAppName is the APP-NAME of the syslog messages.
+	syslogAppName = "juju-audit"
+
+	// syslogTimestampLayout is the RFC5424 timestamp layout, which allows
+	// at most microsecond precision.
+	syslogTimestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+	// syslogDialTimeout and syslogWriteTimeout bound connecting and
+	// writing to the syslog receiver.
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 10 * time.Second
+)
+
+// syslogConfig holds the settings of a syslog sink.
+type syslogConfig struct {
+	batchConfig
+
+	// Address is the host:port of the syslog receiver.
+	Address string
+
+	// TLSConfig is used to connect to the syslog receiver over TLS. If
+	// nil, plain TCP is used.
+	TLSConfig *tls.Config
+
+	// Hostname is the HOSTNAME of the syslog messages.
+	Hostname string
+}
+
+// syslogSink delivers records as RFC5424 syslog messages over TCP, framed
+// with octet counting as described in RFC6587 and RFC5425.
+type syslogSink struct {
+	*batcher
+	cfg syslogConfig
+
+	// conn is only used by the batcher loop, so needs no locking.
+	conn net.Conn
+}
+
+// newSyslogSink starts a sink delivering records to a syslog receiver.
+func newSyslogSink(cfg syslogConfig) *syslogSink {
+	s := &syslogSink{cfg: cfg}
+	s.batcher = newBatcher(cfg.batchConfig, s.send, s.closeConn)
+	return s
+}
+
+func (s *syslogSink) send(ctx context.Context, batch []record) error {
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return errors.Errorf("connecting to syslog receiver %s: %w", s.cfg.Address, err)
+		}
+		s.conn = conn
+	}
+
+	var buf bytes.Buffer
+	for _, r := range batch {
+		msg := s.format(r)
+		fmt.Fprintf(&buf, "%d %s", len(msg), msg)
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err != nil {
+		s.closeConn()
+		return errors.Capture(err)
+	}
+	if _, err := s.conn.Write(buf.Bytes()); err != nil {
+		// The receiver may have only seen part of the batch, but it is
+		// sent again whole on a new connection rather than lost.
+		s.closeConn()
+		return errors.Errorf("writing to syslog receiver %s: %w", s.cfg.Address, err)
+	}
+	return nil
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if s.cfg.TLSConfig == nil {
+		return dialer.DialContext(ctx, "tcp", s.cfg.Address)
+	}
+	tlsDialer := &tls.Dialer{
+		NetDialer: dialer,
+		Config:    s.cfg.TLSConfig,
+	}
+	return tlsDialer.DialContext(ctx, "tcp", s.cfg.Address)
+}
+
+func (s *syslogSink) closeConn() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// format returns the RFC5424 syslog message for a record. The message
+// holds the record as JSON, and its MSGID is the kind of the record.
+func (s *syslogSink) format(r record) []byte {
+	hostname := s.cfg.Hostname
+	if hostname == "" {
+		hostname = "-"
+	}
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s - ",
+		syslogPriority,
+		r.when.UTC().Format(syslogTimestampLayout),
+		hostname,
+		syslogAppName,
+		r.kind,
+	)
+	return append([]byte(header), r.data...)
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"bufio"
+	"crypto/tls"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type syslogSuite struct{}
+
+func TestSyslogSuite(t *testing.T) {
+	tc.Run(t, &syslogSuite{})
+}
+
+func (s *syslogSuite) config(c *tc.C, address string) syslogConfig {
+	return syslogConfig{
+		batchConfig: batchConfig{
+			Name:          syslogSinkName,
+			BufferSize:    10,
+			BatchSize:     2,
+			FlushInterval: time.Minute,
+			RetryAttempts: 3,
+			RetryDelay:    time.Millisecond,
+			Clock:         clock.WallClock,
+			Logger:        loggertesting.WrapCheckLog(c),
+		},
+		Address:  address,
+		Hostname: "controller-0",
+	}
+}
+
+// acceptSyslog returns a channel which receives the messages read from
+// the connections accepted by the listener, which are framed with octet
+// counting.
+func acceptSyslog(l net.Listener) <-chan string {
+	messages := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					length, err := r.ReadString(' ')
+					if err != nil {
+						return
+					}
+					n, err := strconv.Atoi(length[:len(length)-1])
+					if err != nil {
+						return
+					}
+					msg := make([]byte, n)
+					if _, err := io.ReadFull(r, msg); err != nil {
+						return
+					}
+					messages <- string(msg)
+				}
+			}()
+		}
+	}()
+	return messages
+}
+
+func expectSyslogMessage(c *tc.C, messages <-chan string) string {
+	select {
+	case msg := <-messages:
+		return msg
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for syslog message")
+	}
+	return ""
+}
+
+func (s *syslogSuite) TestSend(c *tc.C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	defer l.Close()
+	messages := acceptSyslog(l)
+
+	sink := newSyslogSink(s.config(c, l.Addr().String()))
+	defer workertest.CleanKill(c, sink)
+
+	when := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	sink.add(record{kind: kindConversation, conversationID: "c1", when: when, data: []byte(`{"conversation":{}}`)})
+	sink.add(record{kind: kindRequest, conversationID: "c1", when: when.Add(time.Second), data: []byte(`{"request":{}}`)})
+
+	c.Check(expectSyslogMessage(c, messages), tc.Equals,
+		`<110>1 2026-03-01T12:00:00.000000Z controller-0 juju-audit - conversation - {"conversation":{}}`)
+	c.Check(expectSyslogMessage(c, messages), tc.Equals,
+		`<110>1 2026-03-01T12:00:01.000000Z controller-0 juju-audit - request - {"request":{}}`)
+}
+
+func (s *syslogSuite) TestSendTLS(c *tc.C) {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{*coretesting.ServerTLSCert},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer l.Close()
+	messages := acceptSyslog(l)
+
+	tlsConfig, err := newTLSConfig(coretesting.CACert)
+	c.Assert(err, tc.ErrorIsNil)
+	tlsConfig.ServerName = coretesting.ServerTLSCert.Leaf.DNSNames[0]
+	cfg := s.config(c, l.Addr().String())
+	cfg.TLSConfig = tlsConfig
+	cfg.Hostname = ""
+	sink := newSyslogSink(cfg)
+	defer workertest.CleanKill(c, sink)
+
+	when := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	sink.add(record{kind: kindErrors, conversationID: "c1", when: when, data: []byte(`{"errors":{}}`)})
+	sink.add(record{kind: kindErrors, conversationID: "c1", when: when, data: []byte(`{"errors":{}}`)})
+
+	c.Check(expectSyslogMessage(c, messages), tc.Equals,
+		`<110>1 2026-03-01T12:00:00.000000Z - juju-audit - errors - {"errors":{}}`)
+	expectSyslogMessage(c, messages)
+}
+
+func (s *syslogSuite) TestReconnects(c *tc.C) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, tc.ErrorIsNil)
+	address := l.Addr().String()
+
+	// The first connection is closed by the receiver as soon as it is
+	// accepted.
+	accepted := make(chan struct{})
+	go func() {
+		conn, err := l.Accept()
+		if err == nil {
+			_ = conn.Close()
+		}
+		close(accepted)
+	}()
+
+	cfg := s.config(c, address)
+	cfg.BatchSize = 1
+	sink := newSyslogSink(cfg)
+	defer workertest.CleanKill(c, sink)
+
+	sink.add(record{kind: kindRequest, data: []byte(`{"request":{}}`)})
+	select {
+	case <-accepted:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for connection")
+	}
+	messages := acceptSyslog(l)
+	defer l.Close()
+
+	// Writes to the closed connection fail once the receiver's close is
+	// seen, after which the sink connects again.
+	deadline := time.After(coretesting.LongWait)
+	for {
+		sink.add(record{kind: kindRequest, data: []byte(`{"request":{}}`)})
+		select {
+		case msg := <-messages:
+			c.Check(msg, tc.Matches, `<110>1 .* juju-audit - request - \{"request":\{\}\}`)
+			return
+		case <-time.After(coretesting.ShortWait):
+		case <-deadline:
+			c.Fatalf("timed out waiting for syslog message")
+		}
+	}
+}
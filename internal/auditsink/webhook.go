@@ -0,0 +1,83 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// httpClient is the HTTP client surface used by the webhook and Loki
+// sinks.
+type httpClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// webhookConfig holds the settings of a webhook sink.
+type webhookConfig struct {
+	batchConfig
+
+	// URL is where batches of records are posted.
+	URL string
+
+	HTTPClient httpClient
+}
+
+// webhookSink delivers batches of records by posting them to a URL as a
+// JSON array, in the same form as they are written to the audit log file.
+type webhookSink struct {
+	*batcher
+	cfg webhookConfig
+}
+
+// newWebhookSink starts a sink posting records to a webhook.
+func newWebhookSink(cfg webhookConfig) *webhookSink {
+	s := &webhookSink{cfg: cfg}
+	s.batcher = newBatcher(cfg.batchConfig, s.send, nil)
+	return s
+}
+
+func (s *webhookSink) send(ctx context.Context, batch []record) error {
+	var body bytes.Buffer
+	body.WriteByte('[')
+	for i, r := range batch {
+		if i > 0 {
+			body.WriteByte(',')
+		}
+		body.Write(r.data)
+	}
+	body.WriteByte(']')
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, &body)
+	if err != nil {
+		return permanentError{errors.Errorf("creating webhook request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return errors.Errorf("posting to webhook: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = errors.Errorf("webhook returned %s: %s", resp.Status, bytes.TrimSpace(message))
+	// Client errors other than timeouts and rate limiting would fail
+	// again, so are not retried.
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return permanentError{err}
+	}
+	return err
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditsink
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type webhookSuite struct{}
+
+func TestWebhookSuite(t *testing.T) {
+	tc.Run(t, &webhookSuite{})
+}
+
+func (s *webhookSuite) config(c *tc.C, url string) webhookConfig {
+	return webhookConfig{
+		batchConfig: batchConfig{
+			Name:          webhookSinkName,
+			BufferSize:    10,
+			BatchSize:     2,
+			FlushInterval: time.Minute,
+			RetryAttempts: 3,
+			RetryDelay:    time.Millisecond,
+			Clock:         clock.WallClock,
+			Logger:        loggertesting.WrapCheckLog(c),
+		},
+		URL:        url,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+func (s *webhookSuite) TestSend(c *tc.C) {
+	bodies := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, tc.Equals, http.MethodPost)
+		c.Check(r.Header.Get("Content-Type"), tc.Equals, "application/json")
+		body, err := io.ReadAll(r.Body)
+		c.Check(err, tc.ErrorIsNil)
+		bodies <- string(body)
+	}))
+	defer srv.Close()
+
+	sink := newWebhookSink(s.config(c, srv.URL))
+	defer workertest.CleanKill(c, sink)
+
+	sink.add(record{kind: kindConversation, data: []byte(`{"conversation":{}}`)})
+	sink.add(record{kind: kindRequest, data: []byte(`{"request":{}}`)})
+
+	select {
+	case body := <-bodies:
+		c.Check(body, tc.Equals, `[{"conversation":{}},{"request":{}}]`)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for webhook request")
+	}
+}
+
+func (s *webhookSuite) TestRetriesServerErrors(c *tc.C) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	sink := newWebhookSink(s.config(c, srv.URL))
+	defer workertest.CleanKill(c, sink)
+	sink.add(record{data: []byte(`{}`)})
+	sink.add(record{data: []byte(`{}`)})
+
+	waitForStats(c, sink, stats{sent: 2})
+	c.Check(requests.Load(), tc.Equals, int32(2))
+}
+
+func (s *webhookSuite) TestClientErrorNotRetried(c *tc.C) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Error(w, "go away", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	sink := newWebhookSink(s.config(c, srv.URL))
+	defer workertest.CleanKill(c, sink)
+	sink.add(record{data: []byte(`{}`)})
+	sink.add(record{data: []byte(`{}`)})
+
+	waitForStats(c, sink, stats{errors: 1})
+	c.Check(requests.Load(), tc.Equals, int32(1))
+}
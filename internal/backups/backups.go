@@ -0,0 +1,640 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4/tar"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/domain/schema"
+	"github.com/juju/juju/internal/database"
+)
+
+const (
+	// BackupDirName is the directory, relative to the data directory, in
+	// which the backup archives of a controller are stored.
+	BackupDirName = "backups"
+
+	// dumpExtension is the extension of the database dump files within the
+	// dump directory of a backup archive. The name of each file is the
+	// namespace of the database that was dumped.
+	dumpExtension = ".sql"
+
+	// objectStoreDir is the directory, relative to the data directory,
+	// holding the file backed object store.
+	objectStoreDir = "objectstore"
+
+	// archiveExtension is the extension of a backup archive.
+	archiveExtension = ".tar.gz"
+)
+
+var (
+	// databaseLocalTables are the tables that are local to a running
+	// database: its change stream, and the schema patches that have been
+	// applied to it. They are neither backed up nor restored for any
+	// database, so that a backup taken at an older patch level does not
+	// overwrite the schema history of the database it is restored onto.
+	databaseLocalTables = []string{
+		"change_log",
+		"change_log_witness",
+		"schema",
+	}
+
+	// controllerLocalTables are the tables in the controller database that
+	// describe the topology and the runtime state of the controller that the
+	// database belongs to. A restored controller keeps its own values for
+	// these tables, including its identity, which must match the uuid,
+	// certificates and keys in the agent configuration of its nodes.
+	controllerLocalTables = append([]string{
+		"controller",
+		"controller_api_address",
+		"controller_node",
+		"controller_node_agent_version",
+		"controller_node_password",
+		"lease",
+		"lease_pin",
+	}, databaseLocalTables...)
+)
+
+// Backups creates, stores and restores backups of a controller.
+//
+// A backup consists of a dump of the controller database and of every
+// model database, along with the contents of the file backed object
+// store. A read transaction is opened on every database before any of them
+// is dumped, so the dumps are taken from the same point in time and the
+// archive is a consistent snapshot of the controller.
+//
+// Backup archives are stored on the local disk of the controller node that
+// created or received them; they are not replicated. In a highly available
+// controller, only the archives of the node that serves a request are
+// listed, fetched or restored.
+type Backups struct {
+	dbGetter coredatabase.DBGetter
+	paths    corebackups.Paths
+	logger   logger.Logger
+}
+
+// NewBackups returns a new Backups that reads and writes backup archives
+// in the backup directory of the input paths.
+func NewBackups(dbGetter coredatabase.DBGetter, paths corebackups.Paths, logger logger.Logger) *Backups {
+	return &Backups{
+		dbGetter: dbGetter,
+		paths:    paths,
+		logger:   logger,
+	}
+}
+
+// Create creates a new backup archive of the controller database, the
+// databases of the input models and the object store, and stores it in
+// the backup directory. The input metadata is completed with the size and
+// checksum of the resulting archive, and its ID is set to the name of the
+// archive.
+func (b *Backups) Create(ctx context.Context, meta *corebackups.Metadata, modelUUIDs []string) (string, error) {
+	if err := os.MkdirAll(b.paths.BackupDir, 0700); err != nil {
+		return "", errors.Annotate(err, "creating backup directory")
+	}
+
+	rootDir, err := os.MkdirTemp("", "juju-backups-")
+	if err != nil {
+		return "", errors.Annotate(err, "creating backup workspace")
+	}
+	defer func() { _ = os.RemoveAll(rootDir) }()
+
+	archivePaths := corebackups.NewNonCanonicalArchivePaths(rootDir)
+	if err := os.MkdirAll(archivePaths.DBDumpDir, 0700); err != nil {
+		return "", errors.Annotate(err, "creating dump directory")
+	}
+
+	namespaces := append([]string{coredatabase.ControllerNS}, modelUUIDs...)
+	if err := b.dumpDBs(ctx, namespaces, archivePaths.DBDumpDir); err != nil {
+		return "", errors.Trace(err)
+	}
+
+	if err := b.bundleFiles(archivePaths.FilesBundle); err != nil {
+		return "", errors.Annotate(err, "bundling object store files")
+	}
+
+	if err := writeMetadata(meta, archivePaths.MetadataFile); err != nil {
+		return "", errors.Annotate(err, "writing metadata")
+	}
+
+	filename, err := archiveName(meta.Started)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	archive := filepath.Join(b.paths.BackupDir, filename)
+	size, checksum, err := writeArchive(archive, archivePaths.ContentDir, rootDir)
+	if err != nil {
+		return "", errors.Annotate(err, "writing archive")
+	}
+
+	meta.SetID(filename)
+	if err := meta.MarkComplete(size, checksum); err != nil {
+		return "", errors.Trace(err)
+	}
+	return filename, nil
+}
+
+// List returns the metadata of all the backup archives stored in the backup
+// directory, ordered by the time they were started.
+func (b *Backups) List() ([]*corebackups.Metadata, error) {
+	entries, err := os.ReadDir(b.paths.BackupDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Annotate(err, "reading backup directory")
+	}
+
+	var result []*corebackups.Metadata
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, corebackups.FilenamePrefix) {
+			continue
+		}
+		meta, err := b.metadata(name)
+		if err != nil {
+			b.logger.Warningf(context.TODO(), "skipping backup %q: %v", name, err)
+			continue
+		}
+		result = append(result, meta)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Started.Before(result[j].Started)
+	})
+	return result, nil
+}
+
+// Get returns the metadata of the backup archive with the input ID along
+// with a reader for the archive. It is the responsibility of the caller to
+// close the reader.
+func (b *Backups) Get(id string) (*corebackups.Metadata, io.ReadCloser, error) {
+	meta, err := b.metadata(id)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	file, err := os.Open(filepath.Join(b.paths.BackupDir, id))
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return meta, file, nil
+}
+
+// Remove removes the backup archive with the input ID.
+func (b *Backups) Remove(id string) error {
+	path, err := b.archivePath(id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.Remove(path); os.IsNotExist(err) {
+		return errors.NotFoundf("backup %q", id)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// Add stores the backup archive read from the input reader in the backup
+// directory, so that it can be restored. The ID of the stored archive is
+// returned. An archive that is already stored is not added again.
+func (b *Backups) Add(r io.Reader) (string, error) {
+	if err := os.MkdirAll(b.paths.BackupDir, 0700); err != nil {
+		return "", errors.Annotate(err, "creating backup directory")
+	}
+
+	file, err := os.CreateTemp(b.paths.BackupDir, "upload-")
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	hasher := sha1.New()
+	if _, err := io.Copy(io.MultiWriter(file, hasher), r); err != nil {
+		return "", errors.Annotate(err, "storing backup")
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Trace(err)
+	}
+
+	meta, err := readMetadata(file)
+	if err != nil {
+		return "", errors.Annotate(err, "reading backup metadata")
+	}
+
+	stored, err := b.List()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	checksum := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+	for _, existing := range stored {
+		if existing.Checksum() == checksum {
+			return "", errors.AlreadyExistsf("backup %q", existing.ID())
+		}
+	}
+
+	id, err := archiveName(meta.Started)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := os.Rename(file.Name(), filepath.Join(b.paths.BackupDir, id)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return id, nil
+}
+
+// Restore restores the backup archive with the input ID onto the
+// controller. The controller must be running a version of Juju that is
+// compatible with the version that created the backup.
+//
+// The controller database is restored first, so that the model databases
+// are known to the controller before they are restored. Model databases
+// that do not exist on the controller, as is the case for a newly
+// bootstrapped controller, are created with the current model schema. The
+// controller takes on the identity of the backed up controller, but keeps
+// its own topology and leases. Restored changes are written to the change
+// log, so that watchers observe the restored state.
+func (b *Backups) Restore(ctx context.Context, id string, current semversion.Number) (*corebackups.Metadata, error) {
+	path, err := b.archivePath(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	archive, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("backup %q", id)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	ws, err := corebackups.NewArchiveWorkspaceReader(archive)
+	if err != nil {
+		return nil, errors.Annotate(err, "unpacking backup")
+	}
+	defer func() { _ = ws.Close() }()
+
+	meta, err := ws.Metadata()
+	if err != nil {
+		return nil, errors.Annotate(err, "reading backup metadata")
+	}
+	if err := CheckVersion(meta.Origin.Version, current); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	namespaces, err := dumpedNamespaces(ws.DBDumpDir)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, namespace := range namespaces {
+		b.logger.Infof(ctx, "restoring database %q", namespace)
+		if err := b.loadDB(ctx, namespace, ws.DBDumpDir); err != nil {
+			return nil, errors.Annotatef(err, "restoring database %q", namespace)
+		}
+	}
+
+	if _, err := os.Stat(ws.FilesBundle); err == nil {
+		b.logger.Infof(ctx, "restoring object store files")
+		if err := ws.UnpackFilesBundle(b.paths.DataDir); err != nil {
+			return nil, errors.Annotate(err, "restoring object store files")
+		}
+	}
+
+	meta.SetID(id)
+	return meta, nil
+}
+
+// CheckVersion returns an error if a backup created with the backup version
+// cannot be restored onto a controller running the current version. The
+// schema of the databases only changes between minor versions, so only
+// backups from the same major and minor version, and no newer than the
+// current version, can be restored.
+func CheckVersion(backup, current semversion.Number) error {
+	if backup.Major != current.Major || backup.Minor != current.Minor || current.Compare(backup) < 0 {
+		return errors.NotSupportedf("restoring backup from %s onto controller running %s", backup, current)
+	}
+	return nil
+}
+
+// dumpDBs dumps the databases of the input namespaces into the dump
+// directory. A read transaction is opened on each database in turn, and
+// its snapshot taken, before the next one is opened; the databases are
+// only dumped once all of the snapshots have been taken.
+func (b *Backups) dumpDBs(ctx context.Context, namespaces []string, dumpDir string) error {
+	txns := make([]*sql.Tx, len(namespaces))
+
+	var snapshot func(ctx context.Context, i int) error
+	snapshot = func(ctx context.Context, i int) error {
+		if i == len(namespaces) {
+			for j, namespace := range namespaces {
+				b.logger.Debugf(ctx, "dumping database %q", namespace)
+				if err := dumpDB(ctx, txns[j], namespace, dumpDir); err != nil {
+					return errors.Annotatef(err, "dumping database %q", namespace)
+				}
+			}
+			return nil
+		}
+
+		namespace := namespaces[i]
+		db, err := b.dbGetter.GetDB(ctx, namespace)
+		if err != nil {
+			return errors.Annotatef(err, "getting database %q", namespace)
+		}
+		return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			// A read transaction takes its snapshot at the first read, not
+			// when it begins.
+			var count int
+			row := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master")
+			if err := row.Scan(&count); err != nil {
+				return errors.Annotatef(err, "reading database %q", namespace)
+			}
+			txns[i] = tx
+			return snapshot(ctx, i+1)
+		})
+	}
+	return snapshot(ctx, 0)
+}
+
+// dumpDB writes the dump of the database of the input namespace, read with
+// the input transaction, into the dump directory. Any dump written by a
+// previous attempt is replaced.
+func dumpDB(ctx context.Context, tx *sql.Tx, namespace, dumpDir string) error {
+	file, err := os.Create(filepath.Join(dumpDir, namespace+dumpExtension))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	return database.DumpDB(ctx, tx, file, excludedTables(namespace)...)
+}
+
+func (b *Backups) loadDB(ctx context.Context, namespace, dumpDir string) error {
+	db, err := b.dbGetter.GetDB(ctx, namespace)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if namespace != coredatabase.ControllerNS {
+		if err := b.ensureModelSchema(ctx, db); err != nil {
+			return errors.Annotate(err, "applying model schema")
+		}
+	}
+
+	file, err := os.Open(filepath.Join(dumpDir, namespace+dumpExtension))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return errors.Trace(err)
+		}
+		return database.LoadDB(ctx, tx, file, excludedTables(namespace)...)
+	})
+}
+
+// ensureModelSchema applies the model schema to a model database that has
+// not yet been initialised, so that the dump can be loaded into it.
+func (b *Backups) ensureModelSchema(ctx context.Context, db coredatabase.TxnRunner) error {
+	var initialised bool
+	if err := db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, "SELECT 1 FROM sqlite_master WHERE name='change_log'")
+
+		var dummy int
+		if err := row.Scan(&dummy); err == nil {
+			initialised = true
+			return nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Trace(err)
+		}
+		return nil
+	}); err != nil {
+		return errors.Trace(err)
+	}
+	if initialised {
+		return nil
+	}
+	return errors.Trace(database.NewDBMigration(db, b.logger, schema.ModelDDL()).Apply(ctx))
+}
+
+// bundleFiles writes the contents of the file backed object store into a
+// tar file, with paths relative to the data directory.
+func (b *Backups) bundleFiles(bundle string) error {
+	file, err := os.Create(bundle)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var files []string
+	dir := filepath.Join(b.paths.DataDir, objectStoreDir)
+	if _, err := os.Stat(dir); err == nil {
+		files = append(files, dir)
+	} else if !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+
+	_, err = tar.TarFiles(files, file, b.paths.DataDir+string(os.PathSeparator))
+	return errors.Trace(err)
+}
+
+func (b *Backups) metadata(id string) (*corebackups.Metadata, error) {
+	path, err := b.archivePath(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("backup %q", id)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	meta, err := readMetadata(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Trace(err)
+	}
+	size, checksum, err := checksumFile(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// The metadata is written into the archive before the archive is
+	// complete, so the time it was finished is that of the archive file.
+	meta.SetID(id)
+	if err := meta.MarkComplete(size, checksum); err != nil {
+		return nil, errors.Trace(err)
+	}
+	finished := info.ModTime().UTC()
+	meta.Finished = &finished
+	return meta, nil
+}
+
+// archiveName returns a new name for a backup archive started at the input
+// time. The name includes a random suffix, so that backups started within
+// the same second do not collide.
+func archiveName(started time.Time) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", errors.Annotate(err, "generating backup name")
+	}
+	name := strings.TrimSuffix(started.Format(corebackups.FilenameTemplate), archiveExtension)
+	return name + "-" + hex.EncodeToString(suffix) + archiveExtension, nil
+}
+
+// archivePath returns the path of the backup archive with the input ID,
+// ensuring that the ID does not escape the backup directory.
+func (b *Backups) archivePath(id string) (string, error) {
+	if id == "" || id != filepath.Base(id) || !strings.HasPrefix(id, corebackups.FilenamePrefix) {
+		return "", errors.NotValidf("backup ID %q", id)
+	}
+	return filepath.Join(b.paths.BackupDir, id), nil
+}
+
+func excludedTables(namespace string) []string {
+	if namespace == coredatabase.ControllerNS {
+		return controllerLocalTables
+	}
+	return databaseLocalTables
+}
+
+// dumpedNamespaces returns the namespaces of the databases dumped into the
+// input directory, with the controller namespace first.
+func dumpedNamespaces(dumpDir string) ([]string, error) {
+	entries, err := os.ReadDir(dumpDir)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading dump directory")
+	}
+
+	var (
+		namespaces []string
+		controller bool
+	)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, dumpExtension) {
+			continue
+		}
+		namespace := strings.TrimSuffix(name, dumpExtension)
+		if namespace == coredatabase.ControllerNS {
+			controller = true
+			continue
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	if !controller {
+		return nil, errors.NotValidf("backup without controller database")
+	}
+	sort.Strings(namespaces)
+	return append([]string{coredatabase.ControllerNS}, namespaces...), nil
+}
+
+func writeMetadata(meta *corebackups.Metadata, path string) error {
+	buf, err := meta.AsJSONBuffer()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	_, err = io.Copy(file, buf)
+	return errors.Trace(err)
+}
+
+// readMetadata reads the metadata from a compressed backup archive, without
+// unpacking the rest of the archive.
+func readMetadata(archive io.Reader) (*corebackups.Metadata, error) {
+	gzr, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, errors.Annotate(err, "uncompressing archive")
+	}
+	defer func() { _ = gzr.Close() }()
+
+	_, metaFile, err := tar.FindFile(gzr, corebackups.NewCanonicalArchivePaths().MetadataFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta, err := corebackups.NewMetadataJSONReader(metaFile)
+	return meta, errors.Trace(err)
+}
+
+// writeArchive writes the compressed archive of the content directory to the
+// input path, returning the size and checksum of the archive. An existing
+// file at the path is never overwritten, and a partially written archive is
+// removed.
+func writeArchive(path, contentDir, rootDir string) (_ int64, _ string, err error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if os.IsExist(err) {
+		return 0, "", errors.AlreadyExistsf("backup %q", filepath.Base(path))
+	} else if err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	defer func() {
+		_ = file.Close()
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	hasher := sha1.New()
+	counter := &countingWriter{}
+	gzw := gzip.NewWriter(io.MultiWriter(file, hasher, counter))
+	if _, err := tar.TarFiles([]string{contentDir}, gzw, rootDir+string(os.PathSeparator)); err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	if err := gzw.Close(); err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	if err := file.Sync(); err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	return counter.n, base64.StdEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func checksumFile(r io.Reader) (int64, string, error) {
+	hasher := sha1.New()
+	size, err := io.Copy(hasher, r)
+	if err != nil {
+		return 0, "", errors.Trace(err)
+	}
+	return size, base64.StdEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,396 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coredatabase "github.com/juju/juju/core/database"
+	coreschema "github.com/juju/juju/core/database/schema"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/domain/schema"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/database/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+const modelUUID = "d3d7fa1c-6f1b-4d2f-8b3e-9a4c0f3e6b21"
+
+type backupsSuite struct {
+	testing.DqliteSuite
+
+	dbs      map[string]*sql.DB
+	runners  map[string]coredatabase.TxnRunner
+	paths    corebackups.Paths
+	backups  *Backups
+	objectFS string
+}
+
+func TestBackupsSuite(t *stdtesting.T) {
+	tc.Run(t, &backupsSuite{})
+}
+
+func (s *backupsSuite) SetUpTest(c *tc.C) {
+	s.DqliteSuite.SetUpTest(c)
+
+	s.dbs = make(map[string]*sql.DB)
+	s.runners = make(map[string]coredatabase.TxnRunner)
+	for _, namespace := range []string{coredatabase.ControllerNS, modelUUID} {
+		runner, db := s.OpenDBForNamespace(c, namespace, true)
+		s.dbs[namespace] = db
+		s.runners[namespace] = runner
+	}
+	s.exec(c, coredatabase.ControllerNS, `
+CREATE TABLE controller_node (id TEXT NOT NULL PRIMARY KEY);
+CREATE TABLE model (uuid TEXT NOT NULL PRIMARY KEY, name TEXT NOT NULL);
+INSERT INTO controller_node VALUES ('0');
+INSERT INTO model VALUES ('`+modelUUID+`', 'foo');
+`)
+	s.exec(c, modelUUID, `
+CREATE TABLE application (name TEXT NOT NULL PRIMARY KEY);
+CREATE TABLE change_log (id INT NOT NULL PRIMARY KEY);
+INSERT INTO application VALUES ('mysql');
+INSERT INTO change_log VALUES (1);
+`)
+
+	root := c.MkDir()
+	s.paths = corebackups.Paths{
+		BackupDir: filepath.Join(root, "backups"),
+		DataDir:   filepath.Join(root, "data"),
+	}
+	s.objectFS = filepath.Join(s.paths.DataDir, objectStoreDir, modelUUID)
+	c.Assert(os.MkdirAll(s.objectFS, 0700), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(s.objectFS, "blob"), []byte("charm"), 0600), tc.ErrorIsNil)
+
+	s.backups = NewBackups(s, s.paths, loggertesting.WrapCheckLog(c))
+}
+
+// GetDB is part of the coredatabase.DBGetter interface.
+func (s *backupsSuite) GetDB(_ context.Context, namespace string) (coredatabase.TxnRunner, error) {
+	runner, ok := s.runners[namespace]
+	if !ok {
+		return nil, errors.NotFoundf("database %q", namespace)
+	}
+	return runner, nil
+}
+
+func (s *backupsSuite) TestCreate(c *tc.C) {
+	meta := s.newMetadata()
+	id, err := s.backups.Create(c.Context(), meta, []string{modelUUID})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Matches, `juju-backup-20261017-100405-[0-9a-f]{8}\.tar\.gz`)
+	c.Check(meta.ID(), tc.Equals, id)
+	c.Check(meta.Size(), tc.Not(tc.Equals), int64(0))
+	c.Check(meta.Checksum(), tc.Not(tc.Equals), "")
+
+	list, err := s.backups.List()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(list, tc.HasLen, 1)
+	c.Check(list[0].ID(), tc.Equals, id)
+	c.Check(list[0].Size(), tc.Equals, meta.Size())
+	c.Check(list[0].Checksum(), tc.Equals, meta.Checksum())
+	c.Check(list[0].Notes, tc.Equals, "before upgrade")
+	c.Check(list[0].Finished, tc.NotNil)
+
+	_, rc, err := s.backups.Get(id)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rc.Close() }()
+
+	ws, err := corebackups.NewArchiveWorkspaceReader(rc)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = ws.Close() }()
+
+	controllerDump, err := os.ReadFile(filepath.Join(ws.DBDumpDir, "controller.sql"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(controllerDump), tc.Equals, `CREATE TABLE "model" ("uuid", "name");`+"\n"+
+		`INSERT INTO "model" ("uuid", "name") VALUES ('`+modelUUID+`', 'foo');`+"\n")
+
+	modelDump, err := os.ReadFile(filepath.Join(ws.DBDumpDir, modelUUID+".sql"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(modelDump), tc.Equals, `
+CREATE TABLE "application" ("name");
+INSERT INTO "application" ("name") VALUES ('mysql');
+`[1:])
+}
+
+func (s *backupsSuite) TestCreateSameSecond(c *tc.C) {
+	first, err := s.backups.Create(c.Context(), s.newMetadata(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	second, err := s.backups.Create(c.Context(), s.newMetadata(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(second, tc.Not(tc.Equals), first)
+
+	list, err := s.backups.List()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(list, tc.HasLen, 2)
+}
+
+func (s *backupsSuite) TestRestore(c *tc.C) {
+	id, err := s.backups.Create(c.Context(), s.newMetadata(), []string{modelUUID})
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.exec(c, coredatabase.ControllerNS, `
+INSERT INTO controller_node VALUES ('1');
+UPDATE model SET name = 'bar';
+`)
+	s.exec(c, modelUUID, `
+DELETE FROM application;
+INSERT INTO change_log VALUES (2);
+`)
+	c.Assert(os.RemoveAll(s.objectFS), tc.ErrorIsNil)
+
+	meta, err := s.backups.Restore(c.Context(), id, semversion.MustParse("4.0.3"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(meta.ID(), tc.Equals, id)
+
+	c.Check(s.query(c, coredatabase.ControllerNS, "SELECT name FROM model"), tc.DeepEquals, []string{"foo"})
+	// The topology of the controller is not restored.
+	c.Check(s.query(c, coredatabase.ControllerNS, "SELECT id FROM controller_node"), tc.DeepEquals, []string{"0", "1"})
+	c.Check(s.query(c, modelUUID, "SELECT name FROM application"), tc.DeepEquals, []string{"mysql"})
+	// Nor is the change stream.
+	c.Check(s.query(c, modelUUID, "SELECT id FROM change_log"), tc.DeepEquals, []string{"1", "2"})
+
+	blob, err := os.ReadFile(filepath.Join(s.objectFS, "blob"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(blob), tc.Equals, "charm")
+}
+
+func (s *backupsSuite) TestRestoreOntoNewController(c *tc.C) {
+	const newModelUUID = "0b5a4e3c-1f0e-4c39-9d3a-2b7c1e9f4a10"
+
+	// Back up a controller with a model, using the real schema.
+	sourceController, sourceDB := s.bootstrap(c, "source-controller", "source-uuid")
+	_, err := sourceDB.ExecContext(c.Context(), `INSERT INTO namespace_list VALUES (?)`, newModelUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	// The source controller is at an older patch level.
+	_, err = sourceDB.ExecContext(c.Context(), `DELETE FROM schema WHERE version = (SELECT MAX(version) FROM schema)`)
+	c.Assert(err, tc.ErrorIsNil)
+	sourceModel, sourceModelDB := s.OpenDBForNamespace(c, "source-model", true)
+	s.applySchema(c, sourceModel, schema.ModelDDL())
+	_, err = sourceModelDB.ExecContext(c.Context(), `INSERT INTO model_config VALUES ('logging-config', '<root>=DEBUG')`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	source := NewBackups(dbGetterFunc(func(_ context.Context, namespace string) (coredatabase.TxnRunner, error) {
+		return map[string]coredatabase.TxnRunner{
+			coredatabase.ControllerNS: sourceController,
+			newModelUUID:              sourceModel,
+		}[namespace], nil
+	}), s.paths, loggertesting.WrapCheckLog(c))
+	id, err := source.Create(c.Context(), s.newMetadata(), []string{newModelUUID})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Restore onto a newly bootstrapped controller, which has no model
+	// database. As with the database accessor, a model database is only
+	// available once it is in the namespace list of the controller.
+	targetController, targetDB := s.bootstrap(c, "target-controller", "target-uuid")
+	var targetModelDB *sql.DB
+	target := NewBackups(dbGetterFunc(func(ctx context.Context, namespace string) (coredatabase.TxnRunner, error) {
+		if namespace == coredatabase.ControllerNS {
+			return targetController, nil
+		}
+		var known int
+		row := targetDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM namespace_list WHERE namespace = ?`, namespace)
+		if err := row.Scan(&known); err != nil {
+			return nil, err
+		} else if known == 0 {
+			return nil, errors.NotFoundf("database %q", namespace)
+		}
+		var runner coredatabase.TxnRunner
+		runner, targetModelDB = s.OpenDBForNamespace(c, "target-model", true)
+		return runner, nil
+	}), s.paths, loggertesting.WrapCheckLog(c))
+
+	_, err = target.Restore(c.Context(), id, semversion.MustParse("4.0.3"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The controller keeps its own identity, which its agents are
+	// configured with.
+	c.Check(queryDB(c, targetDB, "SELECT uuid FROM controller"), tc.DeepEquals, []string{"target-uuid"})
+	c.Check(queryDB(c, targetDB, "SELECT cert FROM controller"), tc.DeepEquals, []string{"cert of target-uuid"})
+	// Nor is its schema history.
+	c.Check(queryDB(c, targetDB, "SELECT COUNT(*) FROM schema"), tc.DeepEquals,
+		[]string{strconv.Itoa(schema.ControllerDDL().Len())})
+	c.Assert(targetModelDB, tc.NotNil)
+	c.Check(queryDB(c, targetModelDB, "SELECT value FROM model_config WHERE key = 'logging-config'"),
+		tc.DeepEquals, []string{"<root>=DEBUG"})
+	// The restored model config is in the change log, for watchers to see.
+	c.Check(queryDB(c, targetModelDB, `
+SELECT changed FROM change_log
+WHERE  namespace_id = (SELECT id FROM change_log_namespace WHERE namespace = 'model_config')`),
+		tc.DeepEquals, []string{"logging-config"})
+}
+
+func (s *backupsSuite) TestCreateIsSnapshot(c *tc.C) {
+	// Every database is dumped while the transactions of all of them are
+	// open, so that the dumps are from the same point in time.
+	var open, maxOpen int
+	backups := NewBackups(dbGetterFunc(func(ctx context.Context, namespace string) (coredatabase.TxnRunner, error) {
+		runner, err := s.GetDB(ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &countingRunner{TxnRunner: runner, open: &open, maxOpen: &maxOpen}, nil
+	}), s.paths, loggertesting.WrapCheckLog(c))
+	_, err := backups.Create(c.Context(), s.newMetadata(), []string{modelUUID})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(maxOpen, tc.Equals, 2)
+	c.Check(open, tc.Equals, 0)
+}
+
+func (s *backupsSuite) TestRestoreIncompatibleVersion(c *tc.C) {
+	id, err := s.backups.Create(c.Context(), s.newMetadata(), []string{modelUUID})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.backups.Restore(c.Context(), id, semversion.MustParse("4.1.0"))
+	c.Assert(err, tc.ErrorMatches, `restoring backup from 4.0.2 onto controller running 4.1.0 not supported`)
+	c.Check(s.query(c, modelUUID, "SELECT name FROM application"), tc.DeepEquals, []string{"mysql"})
+}
+
+func (s *backupsSuite) TestRemove(c *tc.C) {
+	id, err := s.backups.Create(c.Context(), s.newMetadata(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.backups.Remove(id)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.backups.Remove(id)
+	c.Check(err, tc.Satisfies, errors.IsNotFound)
+
+	list, err := s.backups.List()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(list, tc.HasLen, 0)
+}
+
+func (s *backupsSuite) TestAdd(c *tc.C) {
+	id, err := s.backups.Create(c.Context(), s.newMetadata(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, rc, err := s.backups.Get(id)
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := io.ReadAll(rc)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rc.Close(), tc.ErrorIsNil)
+
+	_, err = s.backups.Add(bytes.NewReader(data))
+	c.Check(err, tc.ErrorMatches, `backup "`+regexp.QuoteMeta(id)+`" already exists`)
+
+	c.Assert(s.backups.Remove(id), tc.ErrorIsNil)
+	added, err := s.backups.Add(bytes.NewReader(data))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(added, tc.Matches, `juju-backup-20261017-100405-[0-9a-f]{8}\.tar\.gz`)
+
+	meta, rc, err := s.backups.Get(added)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rc.Close(), tc.ErrorIsNil)
+	c.Check(meta.Notes, tc.Equals, "before upgrade")
+}
+
+func (s *backupsSuite) TestInvalidID(c *tc.C) {
+	for _, id := range []string{"", "../juju-backup-x.tar.gz", "foo.tar.gz", "juju-backup-x/../../etc"} {
+		err := s.backups.Remove(id)
+		c.Check(err, tc.Satisfies, errors.IsNotValid, tc.Commentf("id %q", id))
+	}
+}
+
+func (s *backupsSuite) TestCheckVersion(c *tc.C) {
+	for _, t := range []struct {
+		backup, current string
+		ok              bool
+	}{
+		{"4.0.2", "4.0.2", true},
+		{"4.0.2", "4.0.3", true},
+		{"4.0.3", "4.0.2", false},
+		{"4.0.2", "4.1.0", false},
+		{"3.6.9", "4.0.0", false},
+	} {
+		err := CheckVersion(semversion.MustParse(t.backup), semversion.MustParse(t.current))
+		c.Check(err == nil, tc.Equals, t.ok, tc.Commentf("%s onto %s", t.backup, t.current))
+	}
+}
+
+func (s *backupsSuite) newMetadata() *corebackups.Metadata {
+	meta := corebackups.NewMetadata()
+	meta.Started = time.Date(2026, 10, 17, 10, 4, 5, 0, time.UTC)
+	meta.Notes = "before upgrade"
+	meta.Origin.Version = semversion.MustParse("4.0.2")
+	return meta
+}
+
+// bootstrap returns a controller database, with the controller schema
+// applied and the controller identity set to the input UUID.
+func (s *backupsSuite) bootstrap(c *tc.C, name, controllerUUID string) (coredatabase.TxnRunner, *sql.DB) {
+	runner, db := s.OpenDBForNamespace(c, name, true)
+	s.applySchema(c, runner, schema.ControllerDDL())
+	_, err := db.ExecContext(c.Context(), `
+INSERT INTO controller (uuid, model_uuid, target_version, cert) VALUES (?, ?, '4.0.2', ?)`,
+		controllerUUID, modelUUID, "cert of "+controllerUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	return runner, db
+}
+
+func (s *backupsSuite) applySchema(c *tc.C, runner coredatabase.TxnRunner, ddl *coreschema.Schema) {
+	err := database.NewDBMigration(runner, loggertesting.WrapCheckLog(c), ddl).Apply(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *backupsSuite) exec(c *tc.C, namespace, stmts string) {
+	_, err := s.dbs[namespace].ExecContext(c.Context(), stmts)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *backupsSuite) query(c *tc.C, namespace, stmt string) []string {
+	return queryDB(c, s.dbs[namespace], stmt)
+}
+
+func queryDB(c *tc.C, db *sql.DB, stmt string) []string {
+	rows, err := db.QueryContext(c.Context(), stmt)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+
+	var result []string
+	for rows.Next() {
+		var value string
+		c.Assert(rows.Scan(&value), tc.ErrorIsNil)
+		result = append(result, value)
+	}
+	c.Assert(rows.Err(), tc.ErrorIsNil)
+	return result
+}
+
+// countingRunner is a transaction runner that records the largest number of
+// its transactions that are open at the same time.
+type countingRunner struct {
+	coredatabase.TxnRunner
+	open, maxOpen *int
+}
+
+// StdTxn is part of the coredatabase.TxnRunner interface.
+func (r *countingRunner) StdTxn(ctx context.Context, fn func(context.Context, *sql.Tx) error) error {
+	return r.TxnRunner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		*r.open++
+		defer func() { *r.open-- }()
+		*r.maxOpen = max(*r.maxOpen, *r.open)
+		return fn(ctx, tx)
+	})
+}
+
+type dbGetterFunc func(context.Context, string) (coredatabase.TxnRunner, error)
+
+// GetDB is part of the coredatabase.DBGetter interface.
+func (f dbGetterFunc) GetDB(ctx context.Context, namespace string) (coredatabase.TxnRunner, error) {
+	return f(ctx, namespace)
+}
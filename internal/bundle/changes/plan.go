@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package bundlechanges
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Plan is a machine-readable record of the ordered changes required to
+// deploy a bundle. A plan can be saved for review and later verified
+// against the changes computed at deploy time, so that exactly the
+// reviewed changes are applied.
+type Plan struct {
+	// Changes holds the changes in the order they are applied.
+	Changes []PlanChange `json:"changes" yaml:"changes"`
+}
+
+// PlanChange is a single change of a plan.
+type PlanChange struct {
+	// Id is the unique identifier of the change.
+	Id string `json:"id" yaml:"id"`
+	// Method is the action performed to apply the change.
+	Method string `json:"method" yaml:"method"`
+	// Args holds the named arguments of the change.
+	Args map[string]any `json:"args" yaml:"args"`
+	// Requires holds the ids of the changes that must be applied before
+	// this one.
+	Requires []string `json:"requires" yaml:"requires"`
+	// Description is a human readable summary of the change. It is
+	// informational only and is not verified.
+	Description []string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// NewPlan returns the plan for the given changes.
+func NewPlan(changes []Change) (Plan, error) {
+	plan := Plan{
+		Changes: make([]PlanChange, len(changes)),
+	}
+	for i, change := range changes {
+		args, err := change.Args()
+		if err != nil {
+			return Plan{}, errors.Annotatef(err, "getting arguments of change %q", change.Id())
+		}
+		requires := change.Requires()
+		if requires == nil {
+			requires = []string{}
+		}
+		plan.Changes[i] = PlanChange{
+			Id:          change.Id(),
+			Method:      change.Method(),
+			Args:        args,
+			Requires:    requires,
+			Description: change.Description(),
+		}
+	}
+	return plan, nil
+}
+
+// ParsePlan parses a plan written in either YAML or JSON.
+func ParsePlan(data []byte) (Plan, error) {
+	var plan Plan
+	if err := yaml.Unmarshal(data, &plan); err != nil {
+		return Plan{}, errors.Annotate(err, "cannot parse plan")
+	}
+	for i, change := range plan.Changes {
+		if change.Id == "" || change.Method == "" {
+			return Plan{}, errors.Errorf("plan change %d has no id or method", i+1)
+		}
+	}
+	return plan, nil
+}
+
+// Verify checks that the given changes are exactly the changes recorded
+// in the plan, in the same order, with the same arguments and
+// requirements. The returned error describes the first difference found.
+func (p Plan) Verify(changes []Change) error {
+	current, err := NewPlan(changes)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(current.Changes) != len(p.Changes) {
+		return errors.Errorf("plan has %d changes, but deploying the bundle requires %d", len(p.Changes), len(current.Changes))
+	}
+	for i, want := range p.Changes {
+		got := current.Changes[i]
+		if got.Id != want.Id || got.Method != want.Method {
+			return errors.Errorf("change %d is %s %q, but the plan has %s %q", i+1, got.Method, got.Id, want.Method, want.Id)
+		}
+		if !slices.Equal(got.Requires, want.Requires) {
+			return errors.Errorf("change %q requires %v, but the plan has %v", got.Id, got.Requires, want.Requires)
+		}
+		same, err := sameArgs(got.Args, want.Args)
+		if err != nil {
+			return errors.Annotatef(err, "comparing arguments of change %q", got.Id)
+		}
+		if !same {
+			return errors.Errorf("arguments of change %q differ from the plan", got.Id)
+		}
+	}
+	return nil
+}
+
+// sameArgs reports whether two sets of change arguments are equal. The
+// arguments are compared in their canonical JSON form, so that values
+// decoded from either YAML or JSON compare equal to freshly computed ones.
+func sameArgs(a, b map[string]any) (bool, error) {
+	if len(a) == 0 && len(b) == 0 {
+		return true, nil
+	}
+	aData, err := json.Marshal(a)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	bData, err := json.Marshal(b)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return bytes.Equal(aData, bData), nil
+}
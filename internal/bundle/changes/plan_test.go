@@ -0,0 +1,156 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package bundlechanges_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+	"gopkg.in/yaml.v2"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/domain/deployment/charm"
+	bundlechanges "github.com/juju/juju/internal/bundle/changes"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type planSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestPlanSuite(t *testing.T) {
+	tc.Run(t, &planSuite{})
+}
+
+const planBundle = `
+applications:
+    django:
+        charm: django
+        revision: 42
+        channel: candidate
+        num_units: 1
+        expose: true
+    mysql:
+        charm: mysql
+relations:
+    - - django:db
+      - mysql:server
+`
+
+func (s *planSuite) changes(c *tc.C, content string) []bundlechanges.Change {
+	bundleSrc, err := charm.StreamBundleDataSource(strings.NewReader(content), "./")
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := charm.ReadAndMergeBundleData(bundleSrc)
+	c.Assert(err, tc.ErrorIsNil)
+	err = data.Verify(nil, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	changes, err := bundlechanges.FromData(c.Context(), bundlechanges.ChangesConfig{
+		Bundle: data,
+		Logger: loggertesting.WrapCheckLog(c),
+		CharmResolver: func(context.Context, string, corebase.Base, string, string, int) (string, int, error) {
+			return "stable", -1, nil
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return changes
+}
+
+func (s *planSuite) TestNewPlan(c *tc.C) {
+	changes := s.changes(c, planBundle)
+
+	plan, err := bundlechanges.NewPlan(changes)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(plan.Changes, tc.HasLen, len(changes))
+	for i, change := range changes {
+		args, err := change.Args()
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(plan.Changes[i].Id, tc.Equals, change.Id())
+		c.Check(plan.Changes[i].Method, tc.Equals, change.Method())
+		c.Check(plan.Changes[i].Args, tc.DeepEquals, args)
+		c.Check(plan.Changes[i].Requires, tc.NotNil)
+		c.Check(plan.Changes[i].Description, tc.DeepEquals, change.Description())
+	}
+	c.Check(plan.Changes[0].Id, tc.Equals, "addCharm-0")
+	c.Check(plan.Changes[0].Requires, tc.DeepEquals, []string{})
+}
+
+func (s *planSuite) TestVerifyRoundTripYAML(c *tc.C) {
+	changes := s.changes(c, planBundle)
+	plan, err := bundlechanges.NewPlan(changes)
+	c.Assert(err, tc.ErrorIsNil)
+
+	data, err := yaml.Marshal(plan)
+	c.Assert(err, tc.ErrorIsNil)
+	parsed, err := bundlechanges.ParsePlan(data)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = parsed.Verify(s.changes(c, planBundle))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *planSuite) TestVerifyRoundTripJSON(c *tc.C) {
+	changes := s.changes(c, planBundle)
+	plan, err := bundlechanges.NewPlan(changes)
+	c.Assert(err, tc.ErrorIsNil)
+
+	data, err := json.Marshal(plan)
+	c.Assert(err, tc.ErrorIsNil)
+	parsed, err := bundlechanges.ParsePlan(data)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = parsed.Verify(s.changes(c, planBundle))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *planSuite) TestVerifyChangeCountDiffers(c *tc.C) {
+	plan, err := bundlechanges.NewPlan(s.changes(c, planBundle))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = plan.Verify(s.changes(c, `
+applications:
+    django:
+        charm: django
+`))
+	c.Check(err, tc.ErrorMatches, `plan has \d+ changes, but deploying the bundle requires 2`)
+}
+
+func (s *planSuite) TestVerifyArgsDiffer(c *tc.C) {
+	plan, err := bundlechanges.NewPlan(s.changes(c, planBundle))
+	c.Assert(err, tc.ErrorIsNil)
+
+	changes := s.changes(c, strings.Replace(planBundle, "revision: 42", "revision: 43", 1))
+	err = plan.Verify(changes)
+	c.Check(err, tc.ErrorMatches, `arguments of change "addCharm-0" differ from the plan`)
+}
+
+func (s *planSuite) TestVerifyMethodDiffers(c *tc.C) {
+	plan, err := bundlechanges.NewPlan(s.changes(c, planBundle))
+	c.Assert(err, tc.ErrorIsNil)
+	plan.Changes[1].Method = "addMachines"
+
+	err = plan.Verify(s.changes(c, planBundle))
+	c.Check(err, tc.ErrorMatches, `change 2 is \w+ ".*", but the plan has addMachines ".*"`)
+}
+
+func (s *planSuite) TestVerifyRequiresDiffer(c *tc.C) {
+	plan, err := bundlechanges.NewPlan(s.changes(c, planBundle))
+	c.Assert(err, tc.ErrorIsNil)
+	plan.Changes[0].Requires = []string{"deploy-1"}
+
+	err = plan.Verify(s.changes(c, planBundle))
+	c.Check(err, tc.ErrorMatches, `change "addCharm-0" requires \[\], but the plan has \[deploy-1\]`)
+}
+
+func (s *planSuite) TestParsePlanInvalid(c *tc.C) {
+	_, err := bundlechanges.ParsePlan([]byte("changes: [{method: deploy}]"))
+	c.Check(err, tc.ErrorMatches, `plan change 1 has no id or method`)
+
+	_, err = bundlechanges.ParsePlan([]byte("changes: {"))
+	c.Check(err, tc.ErrorMatches, `cannot parse plan: .*`)
+}
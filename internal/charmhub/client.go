@@ -60,6 +60,10 @@ type Config struct {
 	// requests. If nil, use the default HTTP client.
 	HTTPClient HTTPClient
 
+	// Credentials returns the credentials to use for a Charmhub mirror
+	// served by another controller. If nil, no credentials are sent.
+	Credentials CredentialsFunc
+
 	// FileSystem represents the file system operations for downloading.
 	// If nil, use the real OS file system.
 	// This is only required for downloading of charms or bundles.
@@ -101,6 +105,10 @@ func NewClient(config Config) (*Client, error) {
 		httpClient = DefaultHTTPClient(logger)
 	}
 
+	if config.Credentials != nil {
+		httpClient = NewCredentialsHTTPClient(httpClient, config.Credentials)
+	}
+
 	fs := config.FileSystem
 	if fs == nil {
 		fs = fileSystem{}
@@ -109,6 +109,50 @@ func requestHTTPClient(recorder jujuhttp.RequestRecorder, policy jujuhttp.RetryP
 	}
 }
 
+// Credentials are the basic auth credentials sent to a Charmhub mirror
+// served by another controller.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// CredentialsFunc returns the credentials for the host, and optional port,
+// of a Charmhub mirror. Zero credentials are returned for a host which has
+// none.
+type CredentialsFunc func(ctx context.Context, host string) (Credentials, error)
+
+// NewCredentialsHTTPClient returns a client which sets the credentials
+// returned for the host of each HTTPS request as its basic auth credentials.
+// The credentials are looked up per request, so that they are not sent to
+// any other server a response refers to.
+func NewCredentialsHTTPClient(httpClient HTTPClient, credentials CredentialsFunc) HTTPClient {
+	return credentialsHTTPClient{
+		httpClient:  httpClient,
+		credentials: credentials,
+	}
+}
+
+type credentialsHTTPClient struct {
+	httpClient  HTTPClient
+	credentials CredentialsFunc
+}
+
+// Do performs the *http.Request and returns a *http.Response or an error.
+func (c credentialsHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	// Credentials are never sent in the clear.
+	if req.URL.Scheme != "https" || req.Header.Get("Authorization") != "" {
+		return c.httpClient.Do(req)
+	}
+	cred, err := c.credentials(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting credentials for %q", req.URL.Host)
+	}
+	if cred.Username != "" {
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+	return c.httpClient.Do(req)
+}
+
 // apiRequester creates a wrapper around the HTTPClient to allow for better
 // error handling.
 type apiRequester struct {
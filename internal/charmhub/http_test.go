@@ -324,6 +324,56 @@ func (s *RESTSuite) TestGetWithUnmarshalFailure(c *tc.C) {
 	c.Assert(err, tc.Not(tc.ErrorIsNil))
 }
 
+func (s *APIRequesterSuite) TestCredentialsHTTPClient(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockHTTPClient := NewMockHTTPClient(ctrl)
+	client := NewCredentialsHTTPClient(mockHTTPClient, func(_ context.Context, host string) (Credentials, error) {
+		if host == "10.0.0.1:17070" {
+			return Credentials{Username: "downstream", Password: "secret"}, nil
+		}
+		return Credentials{}, nil
+	})
+
+	mockHTTPClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		username, password, ok := req.BasicAuth()
+		c.Check(ok, tc.IsTrue)
+		c.Check(username, tc.Equals, "downstream")
+		c.Check(password, tc.Equals, "secret")
+		return emptyResponse(), nil
+	})
+	resp, err := client.Do(MustNewRequest(c, "https://10.0.0.1:17070/charmhub-mirror/download/sha256"))
+	c.Assert(err, tc.ErrorIsNil)
+	resp.Body.Close()
+
+	// Credentials are never sent to any other host, or in the clear.
+	mockHTTPClient.EXPECT().Do(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		_, _, ok := req.BasicAuth()
+		c.Check(ok, tc.IsFalse)
+		return emptyResponse(), nil
+	}).Times(2)
+	resp, err = client.Do(MustNewRequest(c, "https://api.charmhub.io/v2/charms/refresh"))
+	c.Assert(err, tc.ErrorIsNil)
+	resp.Body.Close()
+	resp, err = client.Do(MustNewRequest(c, "http://10.0.0.1:17070/charmhub-mirror/download/sha256"))
+	c.Assert(err, tc.ErrorIsNil)
+	resp.Body.Close()
+}
+
+func (s *APIRequesterSuite) TestCredentialsHTTPClientError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockHTTPClient := NewMockHTTPClient(ctrl)
+	client := NewCredentialsHTTPClient(mockHTTPClient, func(context.Context, string) (Credentials, error) {
+		return Credentials{}, errors.New("boom")
+	})
+
+	_, err := client.Do(MustNewRequest(c, "https://10.0.0.1:17070/charmhub-mirror/download/sha256"))
+	c.Assert(err, tc.ErrorMatches, `getting credentials for "10.0.0.1:17070": boom`)
+}
+
 func emptyResponse() *http.Response {
 	return &http.Response{
 		Header:     MakeContentTypeHeader("application/json"),
@@ -0,0 +1,246 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package seed reads and writes the tarballs used to seed a controller's
+// Charmhub mirror.
+//
+// A seed is a gzipped tar stream holding one or more entries. Each entry is
+// stored as a directory containing an "entry.json" file, describing the charm
+// or bundle as it was returned by Charmhub, followed by an "archive" file
+// holding the charm or bundle archive itself.
+package seed
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/charmhub/transport"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	entryFile   = "entry.json"
+	archiveFile = "archive"
+)
+
+// Entry describes a charm or bundle revision held in a seed.
+type Entry struct {
+	// ID is the Charmhub ID of the charm or bundle.
+	ID string `json:"id"`
+	// Name is the name of the charm or bundle.
+	Name string `json:"name"`
+	// Type is either "charm" or "bundle".
+	Type string `json:"type"`
+	// Revision is the revision of the archive.
+	Revision int `json:"revision"`
+	// Version is the version string of the revision, if any.
+	Version string `json:"version,omitempty"`
+	// Summary is the one line summary of the charm or bundle.
+	Summary string `json:"summary,omitempty"`
+	// License is the license of the charm or bundle.
+	License string `json:"license,omitempty"`
+	// Publisher is the display name of the publisher.
+	Publisher string `json:"publisher,omitempty"`
+	// Bases are the bases the revision supports.
+	Bases []transport.Base `json:"bases,omitempty"`
+	// Channel is the channel the revision was downloaded from. It is empty
+	// when a revision was downloaded explicitly.
+	Channel string `json:"channel,omitempty"`
+	// ReleasedAt is when the revision was released to the channel.
+	ReleasedAt time.Time `json:"released-at,omitzero"`
+	// CreatedAt is when the revision was created.
+	CreatedAt time.Time `json:"created-at,omitzero"`
+	// SHA256 is the SHA256 hash of the archive.
+	SHA256 string `json:"sha256"`
+	// Size is the size of the archive in bytes.
+	Size int64 `json:"size"`
+
+	// MetadataYAML, ConfigYAML and ActionsYAML hold the minimal metadata
+	// required to deploy the charm, as returned by Charmhub.
+	MetadataYAML string `json:"metadata-yaml,omitempty"`
+	ConfigYAML   string `json:"config-yaml,omitempty"`
+	ActionsYAML  string `json:"actions-yaml,omitempty"`
+}
+
+// Validate returns an error satisfying [coreerrors.NotValid] if the entry is
+// missing required fields.
+func (e Entry) Validate() error {
+	switch {
+	case e.Name == "":
+		return errors.New("empty name").Add(coreerrors.NotValid)
+	case e.ID == "":
+		return errors.Errorf("%q: empty id", e.Name).Add(coreerrors.NotValid)
+	case e.Type != string(transport.CharmType) && e.Type != string(transport.BundleType):
+		return errors.Errorf("%q: invalid type %q", e.Name, e.Type).Add(coreerrors.NotValid)
+	case e.Revision < 0:
+		return errors.Errorf("%q: invalid revision %d", e.Name, e.Revision).Add(coreerrors.NotValid)
+	case e.SHA256 == "":
+		return errors.Errorf("%q: empty sha256", e.Name).Add(coreerrors.NotValid)
+	case e.Size <= 0:
+		return errors.Errorf("%q: invalid size %d", e.Name, e.Size).Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// NewEntry returns the entry describing the result of a Charmhub refresh
+// request. The channel is the channel the revision was resolved from, and may
+// be empty.
+func NewEntry(resp transport.RefreshResponse, channel string) Entry {
+	entity := resp.Entity
+	entry := Entry{
+		ID:           entity.ID,
+		Name:         entity.Name,
+		Type:         string(entity.Type),
+		Revision:     entity.Revision,
+		Version:      entity.Version,
+		Summary:      entity.Summary,
+		License:      entity.License,
+		Publisher:    entity.Publisher["display-name"],
+		Bases:        entity.Bases,
+		CreatedAt:    entity.CreatedAt,
+		SHA256:       entity.Download.HashSHA256,
+		Size:         int64(entity.Download.Size),
+		MetadataYAML: entity.MetadataYAML,
+		ConfigYAML:   entity.ConfigYAML,
+		ActionsYAML:  entity.ActionsYAML,
+	}
+	if entry.ID == "" {
+		entry.ID = resp.ID
+	}
+	if channel != "" {
+		entry.Channel = channel
+		entry.ReleasedAt = resp.ReleasedAt
+	}
+	return entry
+}
+
+// Writer writes entries to a seed.
+type Writer struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+// NewWriter returns a Writer writing a seed to w. Close must be called once
+// all entries have been added.
+func NewWriter(w io.Writer) *Writer {
+	gz := gzip.NewWriter(w)
+	return &Writer{
+		gz: gz,
+		tw: tar.NewWriter(gz),
+	}
+}
+
+// Add adds an entry to the seed. The archive must contain exactly
+// entry.Size bytes.
+func (w *Writer) Add(entry Entry, archive io.Reader) error {
+	if err := entry.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Errorf("encoding entry %q: %w", entry.Name, err)
+	}
+
+	dir := fmt.Sprintf("%s_r%d", entry.Name, entry.Revision)
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:    path.Join(dir, entryFile),
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: entry.CreatedAt,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := w.tw.Write(data); err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:    path.Join(dir, archiveFile),
+		Mode:    0644,
+		Size:    entry.Size,
+		ModTime: entry.CreatedAt,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := io.Copy(w.tw, archive); err != nil {
+		return errors.Errorf("writing archive for %q: %w", entry.Name, err)
+	}
+	return nil
+}
+
+// Close flushes the seed. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return errors.Capture(err)
+	}
+	return w.gz.Close()
+}
+
+// Read reads the seed from r, calling fn for each entry with a reader for
+// its archive. The archive reader is only valid until fn returns. An error
+// satisfying [coreerrors.NotValid] is returned if the seed is malformed.
+func Read(r io.Reader, fn func(Entry, io.Reader) error) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Errorf("reading seed: %w", err).Add(coreerrors.NotValid)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	var (
+		entry    Entry
+		entryDir string
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Errorf("reading seed: %w", err).Add(coreerrors.NotValid)
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+
+		dir, file := path.Split(hdr.Name)
+		switch file {
+		case entryFile:
+			if entryDir != "" {
+				return errors.Errorf("entry %q has no archive", entryDir).Add(coreerrors.NotValid)
+			}
+			entry = Entry{}
+			if err := json.NewDecoder(tr).Decode(&entry); err != nil {
+				return errors.Errorf("decoding %q: %w", hdr.Name, err).Add(coreerrors.NotValid)
+			}
+			if err := entry.Validate(); err != nil {
+				return errors.Errorf("entry %q: %w", hdr.Name, err)
+			}
+			entryDir = dir
+
+		case archiveFile:
+			if entryDir == "" || dir != entryDir {
+				return errors.Errorf("archive %q has no entry", hdr.Name).Add(coreerrors.NotValid)
+			}
+			if hdr.Size != entry.Size {
+				return errors.Errorf("archive %q is %d bytes, expected %d", hdr.Name, hdr.Size, entry.Size).Add(coreerrors.NotValid)
+			}
+			if err := fn(entry, tr); err != nil {
+				return errors.Capture(err)
+			}
+			entryDir = ""
+
+		default:
+			return errors.Errorf("unexpected file %q", hdr.Name).Add(coreerrors.NotValid)
+		}
+	}
+	if entryDir != "" {
+		return errors.Errorf("entry %q has no archive", entryDir).Add(coreerrors.NotValid)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package seed
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/charmhub/transport"
+)
+
+type seedSuite struct{}
+
+func TestSeedSuite(t *testing.T) {
+	tc.Run(t, &seedSuite{})
+}
+
+func (s *seedSuite) TestRoundTrip(c *tc.C) {
+	entries := []Entry{
+		s.entry("postgresql", 42, "postgresql-archive"),
+		s.entry("mysql", 7, "mysql-archive"),
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, entry := range entries {
+		c.Assert(w.Add(entry, strings.NewReader(entry.Name+"-archive")), tc.ErrorIsNil)
+	}
+	c.Assert(w.Close(), tc.ErrorIsNil)
+
+	var (
+		got      []Entry
+		archives []string
+	)
+	err := Read(&buf, func(entry Entry, r io.Reader) error {
+		data, err := io.ReadAll(r)
+		c.Assert(err, tc.ErrorIsNil)
+		got = append(got, entry)
+		archives = append(archives, string(data))
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, entries)
+	c.Check(archives, tc.DeepEquals, []string{"postgresql-archive", "mysql-archive"})
+}
+
+func (s *seedSuite) TestReadNotGzip(c *tc.C) {
+	err := Read(strings.NewReader("not a seed"), func(Entry, io.Reader) error {
+		c.Fatalf("unexpected entry")
+		return nil
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *seedSuite) TestReadArchiveWithoutEntry(c *tc.C) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	c.Assert(tw.WriteHeader(&tar.Header{Name: "foo_r1/archive", Mode: 0644, Size: 3}), tc.ErrorIsNil)
+	_, err := tw.Write([]byte("foo"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(tw.Close(), tc.ErrorIsNil)
+	c.Assert(gz.Close(), tc.ErrorIsNil)
+
+	err = Read(&buf, func(Entry, io.Reader) error {
+		c.Fatalf("unexpected entry")
+		return nil
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, tc.ErrorMatches, `archive "foo_r1/archive" has no entry`)
+}
+
+func (s *seedSuite) TestWriteInvalidEntry(c *tc.C) {
+	entry := s.entry("postgresql", 42, "postgresql-archive")
+	entry.SHA256 = ""
+
+	err := NewWriter(io.Discard).Add(entry, strings.NewReader("postgresql-archive"))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *seedSuite) TestNewEntry(c *tc.C) {
+	released := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	entry := NewEntry(transport.RefreshResponse{
+		ID:         "id-postgresql",
+		ReleasedAt: released,
+		Entity: transport.RefreshEntity{
+			Type:      transport.CharmType,
+			Name:      "postgresql",
+			Revision:  42,
+			Publisher: map[string]string{"display-name": "Canonical"},
+			Bases:     []transport.Base{{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"}},
+			Download: transport.Download{
+				HashSHA256: "abc",
+				Size:       18,
+			},
+			MetadataYAML: "name: postgresql\n",
+		},
+	}, "14/stable")
+
+	c.Check(entry, tc.DeepEquals, Entry{
+		ID:           "id-postgresql",
+		Name:         "postgresql",
+		Type:         "charm",
+		Revision:     42,
+		Publisher:    "Canonical",
+		Bases:        []transport.Base{{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"}},
+		Channel:      "14/stable",
+		ReleasedAt:   released,
+		SHA256:       "abc",
+		Size:         18,
+		MetadataYAML: "name: postgresql\n",
+	})
+}
+
+func (s *seedSuite) entry(name string, revision int, archive string) Entry {
+	return Entry{
+		ID:         "id-" + name,
+		Name:       name,
+		Type:       "charm",
+		Revision:   revision,
+		Bases:      []transport.Base{{Architecture: "amd64", Name: "ubuntu", Channel: "22.04"}},
+		Channel:    "latest/stable",
+		ReleasedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		SHA256:     "sha256-" + name,
+		Size:       int64(len(archive)),
+	}
+}
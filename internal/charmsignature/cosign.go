@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// cosignPayloadType is the type of the payload signed by "cosign sign".
+const cosignPayloadType = "cosign container image signature"
+
+// CosignSignature is a signature made by "cosign sign --key" over an OCI
+// artifact. The signature is made over the payload, which names the digest of
+// the artifact manifest.
+type CosignSignature struct {
+	// Payload is the signed payload, in the cosign simple signing format.
+	Payload []byte
+
+	// Signature is the raw signature over the payload.
+	Signature []byte
+}
+
+// cosignPayload is the subset of the cosign simple signing payload that is
+// checked.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// verifyCosign returns the verification of the first signature over the
+// manifest digest that is made by a trusted key.
+func verifyCosign(digest string, signatures []CosignSignature, keys []TrustedKey) (Verification, error) {
+	if len(signatures) == 0 {
+		return Verification{}, ErrSignatureNotFound
+	}
+
+	var err error
+	for _, signature := range signatures {
+		var verification Verification
+		if verification, err = verifyCosignSignature(digest, signature, keys); err == nil {
+			return verification, nil
+		}
+	}
+	return Verification{}, err
+}
+
+func verifyCosignSignature(digest string, signature CosignSignature, keys []TrustedKey) (Verification, error) {
+	var payload cosignPayload
+	if err := json.Unmarshal(signature.Payload, &payload); err != nil {
+		return Verification{}, errors.Errorf("decoding cosign payload: %v: %w", err, ErrSignatureNotValid)
+	}
+	if payload.Critical.Type != cosignPayloadType {
+		return Verification{}, errors.Errorf("unexpected cosign payload type %q: %w", payload.Critical.Type, ErrSignatureNotValid)
+	}
+	if signed := payload.Critical.Image.DockerManifestDigest; signed != digest {
+		return Verification{}, errors.Errorf("cosign signature is for %q, not %q: %w", signed, digest, ErrSignatureNotValid)
+	}
+
+	// Cosign signatures don't name the key that made them, so each trusted
+	// key is tried in turn.
+	for _, key := range keys {
+		if verifyCosignKey(key.Key, signature.Payload, signature.Signature) {
+			return Verification{
+				Signer:      key.Name,
+				Fingerprint: ssh.FingerprintSHA256(key.Key),
+			}, nil
+		}
+	}
+	return Verification{}, errors.Errorf("cosign signature over %q: %w", digest, ErrSignerNotTrusted)
+}
+
+// verifyCosignKey reports whether the signature over the payload was made by
+// the key, using the algorithms of "cosign sign".
+func verifyCosignKey(key ssh.PublicKey, payload, signature []byte) bool {
+	cryptoKey, ok := key.(ssh.CryptoPublicKey)
+	if !ok {
+		return false
+	}
+	hash := sha256.Sum256(payload)
+	switch pub := cryptoKey.CryptoPublicKey().(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(pub, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, payload, signature)
+	default:
+		return false
+	}
+}
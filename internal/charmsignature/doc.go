@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package charmsignature verifies detached signatures over charm and resource
+// archives before they are accepted into a model's object store.
+//
+// Signatures use the SSH signature format, as produced by
+// "ssh-keygen -Y sign -n <namespace>". Charm archives are signed in the
+// [CharmNamespace] namespace and resource blobs in the [ResourceNamespace]
+// namespace, so that a signature over one can't be replayed as the other.
+//
+// Charms stored in OCI registries may instead be signed with
+// "cosign sign --key", which signs the digest of the artifact manifest. The
+// manifest pins the digest of the charm archive, so the signature covers the
+// archive too. Only key based cosign signatures are verified; keyless
+// signatures, which rely on Fulcio certificates and the Rekor transparency
+// log, are not.
+//
+// The keys trusted to sign archives are held in the controller config, in
+// authorized_keys format with the comment of each key naming the signer, or
+// as PEM encoded public keys such as cosign.pub. The
+// model config decides what happens when an archive is unsigned or its
+// signature can't be verified: with [PolicyWarn] the archive is accepted and a
+// warning is logged, with [PolicyEnforce] the archive is rejected.
+package charmsignature
@@ -0,0 +1,21 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// ErrSignatureNotFound is returned when an archive has no signature.
+	ErrSignatureNotFound = errors.ConstError("archive not signed")
+
+	// ErrSignatureNotValid is returned when a signature is malformed, or
+	// doesn't match the archive it was supplied with.
+	ErrSignatureNotValid = errors.ConstError("signature not valid")
+
+	// ErrSignerNotTrusted is returned when an archive is signed by a key that
+	// isn't one of the trusted signing keys.
+	ErrSignerNotTrusted = errors.ConstError("signer not trusted")
+)
@@ -0,0 +1,29 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	"encoding/base64"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// EncodeHeader encodes an armored signature so that it can be sent as a HTTP
+// header value.
+func EncodeHeader(armored []byte) string {
+	return base64.StdEncoding.EncodeToString(armored)
+}
+
+// DecodeHeader decodes an armored signature sent as a HTTP header value. An
+// empty value decodes to a nil signature.
+func DecodeHeader(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	armored, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.Errorf("decoding signature header: %v: %w", err, ErrSignatureNotValid)
+	}
+	return armored, nil
+}
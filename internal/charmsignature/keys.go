@@ -0,0 +1,114 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// TrustedKey is a public key trusted to sign charm and resource archives.
+type TrustedKey struct {
+	// Name identifies the signer. It is taken from the comment of the
+	// authorized_keys line, falling back to the key fingerprint.
+	Name string
+
+	// Key is the public key of the signer.
+	Key ssh.PublicKey
+}
+
+// pemPublicKeyType is the type of a PEM encoded public key, as written to
+// cosign.pub by "cosign generate-key-pair".
+const pemPublicKeyType = "PUBLIC KEY"
+
+// ParseTrustedKeys parses trusted signing keys. Keys are either held in
+// authorized_keys format, one key per line, or are PEM encoded public keys
+// such as those used by cosign. PEM encoded keys carry no comment, so they
+// are named by their fingerprint. Blank lines and lines starting with "#"
+// are ignored.
+func ParseTrustedKeys(in string) ([]TrustedKey, error) {
+	var keys []TrustedKey
+	lines := strings.Split(in, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "-----BEGIN ") {
+			key, end, err := parsePEMKey(lines, i)
+			if err != nil {
+				return nil, errors.Errorf("parsing signing key on line %d: %w", i+1, err)
+			}
+			keys = append(keys, TrustedKey{
+				Name: ssh.FingerprintSHA256(key),
+				Key:  key,
+			})
+			i = end
+			continue
+		}
+		key, comment, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, errors.Errorf("parsing signing key on line %d: %w", i+1, err)
+		}
+		name := strings.TrimSpace(comment)
+		if name == "" {
+			name = ssh.FingerprintSHA256(key)
+		}
+		keys = append(keys, TrustedKey{
+			Name: name,
+			Key:  key,
+		})
+	}
+	return keys, nil
+}
+
+// parsePEMKey parses the PEM encoded public key starting at the given line,
+// returning the key and the line the key ends on.
+func parsePEMKey(lines []string, start int) (ssh.PublicKey, int, error) {
+	end := start
+	for end < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[end]), "-----END ") {
+		end++
+	}
+	if end == len(lines) {
+		return nil, 0, errors.New("unterminated PEM block")
+	}
+
+	var buf bytes.Buffer
+	for _, line := range lines[start : end+1] {
+		buf.WriteString(strings.TrimSpace(line) + "\n")
+	}
+	block, _ := pem.Decode(buf.Bytes())
+	if block == nil {
+		return nil, 0, errors.New("invalid PEM block")
+	}
+	if block.Type != pemPublicKeyType {
+		return nil, 0, errors.Errorf("unexpected PEM block %q, expected %q", block.Type, pemPublicKeyType)
+	}
+	cryptoKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	key, err := ssh.NewPublicKey(cryptoKey)
+	if err != nil {
+		return nil, 0, errors.Capture(err)
+	}
+	return key, end, nil
+}
+
+// findTrustedKey returns the trusted key matching the public key, if any.
+func findTrustedKey(keys []TrustedKey, publicKey ssh.PublicKey) (TrustedKey, bool) {
+	wire := publicKey.Marshal()
+	for _, key := range keys {
+		if bytes.Equal(key.Key.Marshal(), wire) {
+			return key, true
+		}
+	}
+	return TrustedKey{}, false
+}
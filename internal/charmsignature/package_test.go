@@ -0,0 +1,25 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package charmsignature -destination policy_mock_test.go github.com/juju/juju/internal/charmsignature PolicyGetter
+
+const (
+	// releaseKey and releaseSig were generated with:
+	//
+	//   ssh-keygen -t ed25519 -C release-team -f key
+	//   printf 'charm archive' > archive
+	//   ssh-keygen -Y sign -f key -n juju-charm archive
+	releaseKey = `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAsTPebwnCM4FvJFZXZZ+8TvjK9VhDSrOgTNXi8Rb+24 release-team`
+	releaseSig = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgCxM95vCcIzgW8kVldln7xO+Mr1
+WENKs6BM1eLxFv7bgAAAAKanVqdS1jaGFybQAAAAAAAAAGc2hhNTEyAAAAUwAAAAtzc2gt
+ZWQyNTUxOQAAAEA3ZjUpDgoJwwfoPN8aXY1/ae1L0hp9EvcJQH+rpNJhKRjhqqLAWy4Trt
+RmoBRrSOBDjQQe6O7d365nV4Q6/2YJ
+-----END SSH SIGNATURE-----
+`
+	releaseArchive = "charm archive"
+
+	otherKey = `ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJULyIXBDZ7xHXy2uiygLKDW1bYCujN2RSXFjjFzKl2m other`
+)
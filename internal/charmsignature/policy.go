@@ -0,0 +1,34 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Policy describes what happens to an archive that is unsigned, or whose
+// signature can't be verified against the trusted signing keys.
+type Policy string
+
+const (
+	// PolicyWarn accepts the archive and logs a warning.
+	PolicyWarn Policy = "warn"
+
+	// PolicyEnforce rejects the archive.
+	PolicyEnforce Policy = "enforce"
+)
+
+// ParsePolicy parses a signature policy. An empty string is the default
+// policy, [PolicyWarn].
+func ParsePolicy(in string) (Policy, error) {
+	switch Policy(in) {
+	case "", PolicyWarn:
+		return PolicyWarn, nil
+	case PolicyEnforce:
+		return PolicyEnforce, nil
+	default:
+		return "", errors.Errorf("signature policy %q, expected %q or %q", in, PolicyWarn, PolicyEnforce).Add(coreerrors.NotValid)
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/charmsignature (interfaces: PolicyGetter)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package charmsignature -destination policy_mock_test.go github.com/juju/juju/internal/charmsignature PolicyGetter
+//
+
+// Package charmsignature is a generated GoMock package.
+package charmsignature
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPolicyGetter is a mock of PolicyGetter interface.
+type MockPolicyGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyGetterMockRecorder
+	isgomock struct{}
+}
+
+// MockPolicyGetterMockRecorder is the mock recorder for MockPolicyGetter.
+type MockPolicyGetterMockRecorder struct {
+	mock *MockPolicyGetter
+}
+
+// NewMockPolicyGetter creates a new mock instance.
+func NewMockPolicyGetter(ctrl *gomock.Controller) *MockPolicyGetter {
+	mock := &MockPolicyGetter{ctrl: ctrl}
+	mock.recorder = &MockPolicyGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyGetter) EXPECT() *MockPolicyGetterMockRecorder {
+	return m.recorder
+}
+
+// SignaturePolicy mocks base method.
+func (m *MockPolicyGetter) SignaturePolicy(ctx context.Context) (Policy, []TrustedKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignaturePolicy", ctx)
+	ret0, _ := ret[0].(Policy)
+	ret1, _ := ret[1].([]TrustedKey)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SignaturePolicy indicates an expected call of SignaturePolicy.
+func (mr *MockPolicyGetterMockRecorder) SignaturePolicy(ctx any) *MockPolicyGetterSignaturePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignaturePolicy", reflect.TypeOf((*MockPolicyGetter)(nil).SignaturePolicy), ctx)
+	return &MockPolicyGetterSignaturePolicyCall{Call: call}
+}
+
+// MockPolicyGetterSignaturePolicyCall wrap *gomock.Call
+type MockPolicyGetterSignaturePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPolicyGetterSignaturePolicyCall) Return(arg0 Policy, arg1 []TrustedKey, arg2 error) *MockPolicyGetterSignaturePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPolicyGetterSignaturePolicyCall) Do(f func(context.Context) (Policy, []TrustedKey, error)) *MockPolicyGetterSignaturePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPolicyGetterSignaturePolicyCall) DoAndReturn(f func(context.Context) (Policy, []TrustedKey, error)) *MockPolicyGetterSignaturePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// CharmNamespace is the signature namespace for charm archives.
+	CharmNamespace = "juju-charm"
+
+	// ResourceNamespace is the signature namespace for resource blobs.
+	ResourceNamespace = "juju-resource"
+
+	sigMagic   = "SSHSIG"
+	sigVersion = 1
+	sigPEMType = "SSH SIGNATURE"
+)
+
+// Signature is a parsed SSH signature over an archive.
+type Signature struct {
+	// PublicKey is the key the signature claims to be made by.
+	PublicKey ssh.PublicKey
+
+	namespace     string
+	hashAlgorithm string
+	signature     *ssh.Signature
+}
+
+// wireSignature is the SSHSIG blob, less the magic preamble.
+type wireSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// signedData is the data that the signature is made over.
+type signedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// ParseSignature parses an armored SSH signature. [ErrSignatureNotValid] is
+// returned if the signature is malformed.
+func ParseSignature(armored []byte) (*Signature, error) {
+	block, _ := pem.Decode(bytes.TrimSpace(armored))
+	if block == nil || block.Type != sigPEMType {
+		return nil, errors.Errorf("expected armored %q block: %w", sigPEMType, ErrSignatureNotValid)
+	}
+
+	blob, ok := bytes.CutPrefix(block.Bytes, []byte(sigMagic))
+	if !ok {
+		return nil, errors.Errorf("missing %q preamble: %w", sigMagic, ErrSignatureNotValid)
+	}
+
+	var wire wireSignature
+	if err := ssh.Unmarshal(blob, &wire); err != nil {
+		return nil, errors.Errorf("decoding signature: %v: %w", err, ErrSignatureNotValid)
+	}
+	if wire.Version != sigVersion {
+		return nil, errors.Errorf("unsupported signature version %d: %w", wire.Version, ErrSignatureNotValid)
+	}
+	if _, err := newHash(wire.HashAlgorithm); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(wire.PublicKey)
+	if err != nil {
+		return nil, errors.Errorf("parsing public key: %v: %w", err, ErrSignatureNotValid)
+	}
+
+	var sig ssh.Signature
+	if err := ssh.Unmarshal(wire.Signature, &sig); err != nil {
+		return nil, errors.Errorf("decoding signature blob: %v: %w", err, ErrSignatureNotValid)
+	}
+
+	return &Signature{
+		PublicKey:     publicKey,
+		namespace:     wire.Namespace,
+		hashAlgorithm: wire.HashAlgorithm,
+		signature:     &sig,
+	}, nil
+}
+
+// Verify checks that the signature was made over the content of r, in the
+// given namespace, by the signature's public key. [ErrSignatureNotValid] is
+// returned if it wasn't.
+func (s *Signature) Verify(namespace string, r io.Reader) error {
+	if s.namespace != namespace {
+		return errors.Errorf("signature namespace %q, expected %q: %w", s.namespace, namespace, ErrSignatureNotValid)
+	}
+
+	h, err := newHash(s.hashAlgorithm)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return errors.Errorf("hashing signed content: %w", err)
+	}
+
+	data := append([]byte(sigMagic), ssh.Marshal(signedData{
+		Namespace:     s.namespace,
+		HashAlgorithm: s.hashAlgorithm,
+		Hash:          h.Sum(nil),
+	})...)
+	if err := s.PublicKey.Verify(data, s.signature); err != nil {
+		return errors.Errorf("%v: %w", err, ErrSignatureNotValid)
+	}
+	return nil
+}
+
+func newHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	default:
+		return nil, errors.Errorf("unsupported hash algorithm %q: %w", algorithm, ErrSignatureNotValid)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmsignature
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Verification describes the outcome of verifying an archive. The zero value
+// describes an archive that wasn't verified.
+type Verification struct {
+	// Signer is the name of the trusted key that signed the archive.
+	Signer string
+
+	// Fingerprint is the SHA256 fingerprint of the trusted key that signed
+	// the archive.
+	Fingerprint string
+}
+
+// IsZero reports whether the archive wasn't verified.
+func (v Verification) IsZero() bool {
+	return v == Verification{}
+}
+
+// PolicyGetter provides the signature policy of a model, and the keys that
+// are trusted to sign archives.
+type PolicyGetter interface {
+	// SignaturePolicy returns the signature policy and the trusted signing
+	// keys.
+	SignaturePolicy(ctx context.Context) (Policy, []TrustedKey, error)
+}
+
+// Verifier verifies archive signatures according to a model's signature
+// policy.
+type Verifier struct {
+	policyGetter PolicyGetter
+	logger       logger.Logger
+}
+
+// NewVerifier returns a new Verifier.
+func NewVerifier(policyGetter PolicyGetter, logger logger.Logger) *Verifier {
+	return &Verifier{
+		policyGetter: policyGetter,
+		logger:       logger,
+	}
+}
+
+// Enabled reports whether archive signatures are checked at all. They aren't
+// when no signing keys are trusted and the policy is [PolicyWarn], which lets
+// callers avoid buffering archives needlessly.
+func (v *Verifier) Enabled(ctx context.Context) (bool, error) {
+	policy, keys, err := v.policyGetter.SignaturePolicy(ctx)
+	if err != nil {
+		return false, errors.Errorf("getting signature policy: %w", err)
+	}
+	return enabled(policy, keys), nil
+}
+
+// Verify checks the armored signature over the archive read from r, in the
+// given namespace. A nil signature means the archive is unsigned.
+//
+// If the signature is made by a trusted key, the signer is returned. Otherwise
+// the policy applies: with [PolicyWarn] a warning is logged and a zero
+// Verification is returned, with [PolicyEnforce] one of
+// [ErrSignatureNotFound], [ErrSignatureNotValid] or [ErrSignerNotTrusted] is
+// returned. When no signing keys are trusted and the policy is [PolicyWarn],
+// signatures aren't checked at all.
+func (v *Verifier) Verify(ctx context.Context, namespace string, r io.Reader, signature []byte) (Verification, error) {
+	return v.apply(ctx, namespace, func(keys []TrustedKey) (Verification, error) {
+		return verify(namespace, r, signature, keys)
+	})
+}
+
+// VerifyCosign checks cosign signatures published for a charm in an OCI
+// registry, which are made over the digest of the artifact manifest. No
+// signatures means the charm is unsigned. The policy applies in the same way
+// as for [Verifier.Verify].
+func (v *Verifier) VerifyCosign(ctx context.Context, digest string, signatures []CosignSignature) (Verification, error) {
+	return v.apply(ctx, CharmNamespace, func(keys []TrustedKey) (Verification, error) {
+		return verifyCosign(digest, signatures, keys)
+	})
+}
+
+// apply runs the verification against the trusted keys, and applies the
+// policy to the outcome.
+func (v *Verifier) apply(ctx context.Context, namespace string, verify func([]TrustedKey) (Verification, error)) (Verification, error) {
+	policy, keys, err := v.policyGetter.SignaturePolicy(ctx)
+	if err != nil {
+		return Verification{}, errors.Errorf("getting signature policy: %w", err)
+	}
+	if !enabled(policy, keys) {
+		return Verification{}, nil
+	}
+
+	verification, err := verify(keys)
+	if err == nil {
+		return verification, nil
+	}
+	if policy == PolicyEnforce {
+		return Verification{}, errors.Errorf("verifying %s signature: %w", namespace, err)
+	}
+	v.logger.Warningf(ctx, "accepting %s archive without a verified signature: %v", namespace, err)
+	return Verification{}, nil
+}
+
+func enabled(policy Policy, keys []TrustedKey) bool {
+	return policy == PolicyEnforce || len(keys) > 0
+}
+
+func verify(namespace string, r io.Reader, signature []byte, keys []TrustedKey) (Verification, error) {
+	if len(signature) == 0 {
+		return Verification{}, ErrSignatureNotFound
+	}
+	sig, err := ParseSignature(signature)
+	if err != nil {
+		return Verification{}, errors.Capture(err)
+	}
+
+	// Check the signer before the content, there's no point hashing the
+	// archive if the signature can't be trusted.
+	key, ok := findTrustedKey(keys, sig.PublicKey)
+	if !ok {
+		return Verification{}, errors.Errorf("key %s: %w", ssh.FingerprintSHA256(sig.PublicKey), ErrSignerNotTrusted)
+	}
+	if err := sig.Verify(namespace, r); err != nil {
+		return Verification{}, errors.Capture(err)
+	}
+	return Verification{
+		Signer:      key.Name,
+		Fingerprint: ssh.FingerprintSHA256(key.Key),
+	}, nil
+}
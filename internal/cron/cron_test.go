@@ -0,0 +1,132 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/cron"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+func TestCronSuite(t *testing.T) {
+	tc.Run(t, &cronSuite{})
+}
+
+type cronSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func mustTime(c *tc.C, value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	c.Assert(err, tc.ErrorIsNil)
+	return t
+}
+
+func (s *cronSuite) TestNext(c *tc.C) {
+	tests := []struct {
+		expr string
+		from string
+		next string
+	}{{
+		expr: "* * * * *",
+		from: "2026-03-04T10:15:30Z",
+		next: "2026-03-04T10:16:00Z",
+	}, {
+		expr: "0 2 * * *",
+		from: "2026-03-04T10:15:00Z",
+		next: "2026-03-05T02:00:00Z",
+	}, {
+		expr: "*/15 * * * *",
+		from: "2026-03-04T10:15:00Z",
+		next: "2026-03-04T10:30:00Z",
+	}, {
+		expr: "30 9-17/4 * * mon-fri",
+		from: "2026-03-06T17:30:00Z", // Friday
+		next: "2026-03-09T09:30:00Z",
+	}, {
+		expr: "0 0 1 jan,jul *",
+		from: "2026-03-04T00:00:00Z",
+		next: "2026-07-01T00:00:00Z",
+	}, {
+		expr: "0 0 29 2 *",
+		from: "2026-03-01T00:00:00Z",
+		next: "2028-02-29T00:00:00Z",
+	}, {
+		// Both day fields restricted: either matches.
+		expr: "0 12 13 * 5",
+		from: "2026-03-01T00:00:00Z",
+		next: "2026-03-06T12:00:00Z",
+	}, {
+		expr: "0 0 * * 7",
+		from: "2026-03-04T00:00:00Z",
+		next: "2026-03-08T00:00:00Z",
+	}, {
+		expr: "@hourly",
+		from: "2026-03-04T10:15:00Z",
+		next: "2026-03-04T11:00:00Z",
+	}, {
+		expr: "@weekly",
+		from: "2026-03-04T10:15:00Z",
+		next: "2026-03-08T00:00:00Z",
+	}, {
+		expr: "@yearly",
+		from: "2026-03-04T10:15:00Z",
+		next: "2027-01-01T00:00:00Z",
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %q", i, test.expr)
+		schedule, err := cron.Parse(test.expr)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(schedule.String(), tc.Equals, test.expr)
+		c.Check(schedule.Next(mustTime(c, test.from)), tc.Equals, mustTime(c, test.next))
+	}
+}
+
+func (s *cronSuite) TestNextNever(c *tc.C) {
+	schedule, err := cron.Parse("0 0 30 2 *")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(schedule.Next(mustTime(c, "2026-03-04T10:15:00Z")).IsZero(), tc.IsTrue)
+}
+
+func (s *cronSuite) TestParseInvalid(c *tc.C) {
+	tests := []struct {
+		expr string
+		err  string
+	}{{
+		expr: "",
+		err:  "cron expression: expected 5 fields, got 0",
+	}, {
+		expr: "* * * *",
+		err:  "cron expression: expected 5 fields, got 4",
+	}, {
+		expr: "60 * * * *",
+		err:  "cron expression: value 60 out of range 0-59 in minute field",
+	}, {
+		expr: "* * 0 * *",
+		err:  "cron expression: value 0 out of range 1-31 in day-of-month field",
+	}, {
+		expr: "* 5-2 * * *",
+		err:  `cron expression: invalid range "5-2" in hour field`,
+	}, {
+		expr: "*/0 * * * *",
+		err:  `cron expression: invalid step "0" in minute field`,
+	}, {
+		expr: "* * * foo *",
+		err:  `cron expression: invalid value "foo" in month field`,
+	}, {
+		expr: "@sometimes",
+		err:  `cron expression: unknown macro "@sometimes"`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %q", i, test.expr)
+		_, err := cron.Parse(test.expr)
+		c.Check(err, tc.ErrorMatches, test.err)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	}
+}
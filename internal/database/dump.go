@@ -0,0 +1,408 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+)
+
+// dumpTimeFormat is the format used to write time values into a dump. It
+// matches the format used by the database drivers when binding time values,
+// so loaded values are indistinguishable from those written by the
+// application.
+const dumpTimeFormat = "2006-01-02 15:04:05.999999999-07:00"
+
+// DumpDB writes the contents of every table in the database to the writer,
+// as a series of INSERT statements, one per row. Tables named in exclude
+// (and the internal sqlite tables) are skipped. Each dumped table is
+// preceded by a CREATE TABLE statement naming its columns; this describes
+// the dump, it is not the full schema, and it is never executed by LoadDB.
+//
+// The dump is consistent as long as the input transaction is held open for
+// the duration of the call.
+func DumpDB(ctx context.Context, tx *sql.Tx, w io.Writer, exclude ...string) error {
+	tables, err := dumpTables(ctx, tx, set.NewStrings(exclude...))
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, table := range tables {
+		columns, err := tableColumns(ctx, tx, table)
+		if err != nil {
+			return errors.Annotatef(err, "reading columns of table %q", table)
+		}
+		if _, err := fmt.Fprintf(bw, "%s%s);\n", createPrefix(table), quoteColumns(columns)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for _, table := range tables {
+		if err := dumpTable(ctx, tx, bw, table); err != nil {
+			return errors.Annotatef(err, "dumping table %q", table)
+		}
+	}
+	return errors.Trace(bw.Flush())
+}
+
+// LoadDB replaces the contents of the database with the statements read from
+// a dump written by DumpDB. The contents of every table, with the exception of
+// those named in exclude, are deleted before the dump is applied. The tables
+// described by the dump must exist in the database with the dumped columns;
+// the database is expected to have had its schema applied already.
+//
+// Triggers are dropped while the data is loaded and recreated afterwards, so
+// that loading the data does not cause any side effects. The change log
+// triggers are the exception: they are left in place so that the deleted and
+// loaded rows are written to the change log, and watchers observe the
+// loaded state. Foreign key constraints are checked when the transaction is
+// committed.
+func LoadDB(ctx context.Context, tx *sql.Tx, r io.Reader, exclude ...string) error {
+	excluded := set.NewStrings(exclude...)
+
+	if _, err := tx.ExecContext(ctx, "PRAGMA defer_foreign_keys = ON;"); err != nil {
+		return errors.Annotate(err, "deferring foreign keys")
+	}
+
+	triggers, err := dropTriggers(ctx, tx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	tables, err := dumpTables(ctx, tx, excluded)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, table := range tables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %q;", table)); err != nil {
+			return errors.Annotatef(err, "deleting contents of table %q", table)
+		}
+	}
+
+	existing := set.NewStrings(tables...)
+	known := set.NewStrings()
+	br := bufio.NewReader(r)
+	for {
+		stmt, err := nextStatement(br)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Annotate(err, "reading dump")
+		}
+
+		if strings.HasPrefix(stmt, createPrefix("")) {
+			table, err := checkDumpedTable(ctx, tx, existing, stmt)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			known.Add(table)
+			continue
+		}
+
+		table, err := insertTable(stmt)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !known.Contains(table) {
+			return errors.NotValidf("dump of unknown table %q", table)
+		}
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return errors.Annotatef(err, "loading table %q", table)
+		}
+	}
+
+	for _, trigger := range triggers {
+		if _, err := tx.ExecContext(ctx, trigger); err != nil {
+			return errors.Annotate(err, "recreating trigger")
+		}
+	}
+	return nil
+}
+
+func dumpTables(ctx context.Context, tx *sql.Tx, excluded set.Strings) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT name
+FROM   sqlite_master
+WHERE  type = 'table'
+AND    name NOT LIKE 'sqlite_%'
+ORDER BY name;`)
+	if err != nil {
+		return nil, errors.Annotate(err, "querying tables")
+	}
+	defer func() { _ = rows.Close() }()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if excluded.Contains(name) {
+			continue
+		}
+		tables = append(tables, name)
+	}
+	return tables, errors.Trace(rows.Err())
+}
+
+func dumpTable(ctx context.Context, tx *sql.Tx, w *bufio.Writer, table string) error {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT * FROM %q;", table))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	prefix := fmt.Sprintf("INSERT INTO %q (%s) VALUES (", table, quoteColumns(columns))
+
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	literals := make([]string, len(columns))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return errors.Trace(err)
+		}
+		for i, value := range values {
+			if literals[i], err = sqlLiteral(value); err != nil {
+				return errors.Annotatef(err, "column %q", columns[i])
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s%s);\n", prefix, strings.Join(literals, ", ")); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(rows.Err())
+}
+
+// tableColumns returns the names of the columns of a table, in the order in
+// which they are defined.
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM pragma_table_info(?) ORDER BY cid;", table)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.Trace(err)
+		}
+		columns = append(columns, name)
+	}
+	return columns, errors.Trace(rows.Err())
+}
+
+func quoteColumns(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = strconv.Quote(column)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// createPrefix returns the start of the statement describing a dumped table.
+func createPrefix(table string) string {
+	if table == "" {
+		return `CREATE TABLE "`
+	}
+	return fmt.Sprintf("CREATE TABLE %q (", table)
+}
+
+// checkDumpedTable parses a table description written by DumpDB and checks
+// that the table exists in the database with every dumped column. The name
+// of the table is returned.
+func checkDumpedTable(ctx context.Context, tx *sql.Tx, existing set.Strings, stmt string) (string, error) {
+	rest := stmt[len(createPrefix("")):]
+	end := strings.Index(rest, `" (`)
+	if end <= 0 || !strings.HasSuffix(rest, ");") {
+		return "", errors.NotValidf("statement %q", truncate(stmt))
+	}
+	table := rest[:end]
+	if !existing.Contains(table) {
+		return "", errors.NotValidf("dump of table %q missing from database schema", table)
+	}
+
+	columns, err := tableColumns(ctx, tx, table)
+	if err != nil {
+		return "", errors.Annotatef(err, "reading columns of table %q", table)
+	}
+	have := set.NewStrings(columns...)
+	for _, column := range strings.Split(rest[end+len(`" (`):len(rest)-len(");")], ", ") {
+		name, err := strconv.Unquote(column)
+		if err != nil {
+			return "", errors.NotValidf("statement %q", truncate(stmt))
+		}
+		if !have.Contains(name) {
+			return "", errors.NotValidf("dump of column %q missing from table %q", name, table)
+		}
+	}
+	return table, nil
+}
+
+// sqlLiteral returns the SQL literal representation of a value scanned from
+// the database.
+func sqlLiteral(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return "", errors.NotSupportedf("float value %v", v)
+		}
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			// Ensure the value is read back as a real, not an integer.
+			s += ".0"
+		}
+		return s, nil
+	case bool:
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case []byte:
+		return "X'" + hex.EncodeToString(v) + "'", nil
+	case string:
+		return quoteString(v), nil
+	case time.Time:
+		return quoteString(v.Format(dumpTimeFormat)), nil
+	default:
+		return "", errors.NotSupportedf("value of type %T", value)
+	}
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// nextStatement reads the next semicolon terminated statement from the
+// reader, taking care not to split on semicolons within quoted strings or
+// identifiers. io.EOF is returned when there are no more statements.
+func nextStatement(r *bufio.Reader) (string, error) {
+	var (
+		sb    strings.Builder
+		quote rune
+	)
+	for {
+		ch, _, err := r.ReadRune()
+		if err == io.EOF {
+			if rest := strings.TrimSpace(sb.String()); rest != "" {
+				return "", errors.Errorf("unterminated statement %q", rest)
+			}
+			return "", io.EOF
+		} else if err != nil {
+			return "", errors.Trace(err)
+		}
+
+		switch {
+		case quote != 0:
+			// Doubled quotes within a quoted string are an escaped quote,
+			// which toggles the state twice and so remains quoted.
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '\'' || ch == '"':
+			quote = ch
+		case ch == ';':
+			sb.WriteRune(ch)
+			return strings.TrimSpace(sb.String()), nil
+		}
+		sb.WriteRune(ch)
+	}
+}
+
+// insertTable returns the name of the table a dumped INSERT statement
+// targets. Any other kind of statement is rejected.
+func insertTable(stmt string) (string, error) {
+	const prefix = `INSERT INTO "`
+	if !strings.HasPrefix(stmt, prefix) {
+		return "", errors.NotValidf("statement %q", truncate(stmt))
+	}
+	rest := stmt[len(prefix):]
+	end := strings.Index(rest, `"`)
+	if end <= 0 {
+		return "", errors.NotValidf("statement %q", truncate(stmt))
+	}
+	return rest[:end], nil
+}
+
+func truncate(s string) string {
+	const max = 64
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "..."
+}
+
+// changeLogTriggerPrefix is the prefix of the triggers that write changes to
+// the change log.
+const changeLogTriggerPrefix = "trg_log_"
+
+// dropTriggers drops all the triggers in the database, with the exception of
+// the change log triggers, returning the statements required to recreate
+// them.
+func dropTriggers(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT name, sql
+FROM   sqlite_master
+WHERE  type = 'trigger';`)
+	if err != nil {
+		return nil, errors.Annotate(err, "querying triggers")
+	}
+
+	triggers := make(map[string]string)
+	for rows.Next() {
+		var name, stmt string
+		if err := rows.Scan(&name, &stmt); err != nil {
+			_ = rows.Close()
+			return nil, errors.Trace(err)
+		}
+		if strings.HasPrefix(name, changeLogTriggerPrefix) {
+			continue
+		}
+		triggers[name] = stmt
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return nil, errors.Trace(err)
+	}
+	_ = rows.Close()
+
+	names := make([]string, 0, len(triggers))
+	for name := range triggers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	stmts := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TRIGGER %q;", name)); err != nil {
+			return nil, errors.Annotatef(err, "dropping trigger %q", name)
+		}
+		stmts = append(stmts, triggers[name]+";")
+	}
+	return stmts, nil
+}
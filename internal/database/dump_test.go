@@ -0,0 +1,243 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package database
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/database/testing"
+)
+
+type dumpSuite struct {
+	testing.DqliteSuite
+}
+
+func TestDumpSuite(t *stdtesting.T) {
+	tc.Run(t, &dumpSuite{})
+}
+
+const dumpSchema = `
+CREATE TABLE band (
+    name TEXT NOT NULL PRIMARY KEY,
+    formed INT,
+    rating REAL,
+    logo BLOB
+);
+CREATE TABLE album (
+    title TEXT NOT NULL PRIMARY KEY,
+    band TEXT NOT NULL,
+    CONSTRAINT fk_album_band
+        FOREIGN KEY (band)
+        REFERENCES band (name)
+);
+CREATE TABLE audit (
+    message TEXT NOT NULL
+);
+CREATE TABLE ignored (
+    value TEXT NOT NULL
+);
+CREATE TABLE change_log (
+    changed TEXT NOT NULL
+);
+CREATE TRIGGER trg_band_immutable_delete
+    BEFORE DELETE ON band
+BEGIN
+    SELECT RAISE(FAIL, 'band is immutable');
+END;
+CREATE TRIGGER trg_album_insert
+    AFTER INSERT ON album
+BEGIN
+    INSERT INTO audit (message) VALUES ('inserted ' || NEW.title);
+END;
+CREATE TRIGGER trg_log_band_insert
+    AFTER INSERT ON band
+BEGIN
+    INSERT INTO change_log (changed) VALUES ('inserted ' || NEW.name);
+END;
+CREATE TRIGGER trg_log_band_delete
+    AFTER DELETE ON band
+BEGIN
+    INSERT INTO change_log (changed) VALUES ('deleted ' || OLD.name);
+END;
+`
+
+func (s *dumpSuite) SetUpTest(c *tc.C) {
+	s.DqliteSuite.SetUpTest(c)
+
+	_, err := s.DB().ExecContext(c.Context(), dumpSchema)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *dumpSuite) TestDumpDB(c *tc.C) {
+	s.exec(c, `
+INSERT INTO band VALUES ('Blood Incantation', 2011, 4.5, X'beef');
+INSERT INTO band VALUES ('Death; the band', NULL, 5, NULL);
+INSERT INTO album VALUES ('Hidden History of the Human Race', 'Blood Incantation');
+INSERT INTO ignored VALUES ('ignore me');
+`)
+
+	var buf bytes.Buffer
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return DumpDB(ctx, tx, &buf, "ignored", "change_log")
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buf.String(), tc.Equals, `
+CREATE TABLE "album" ("title", "band");
+CREATE TABLE "audit" ("message");
+CREATE TABLE "band" ("name", "formed", "rating", "logo");
+INSERT INTO "album" ("title", "band") VALUES ('Hidden History of the Human Race', 'Blood Incantation');
+INSERT INTO "audit" ("message") VALUES ('inserted Hidden History of the Human Race');
+INSERT INTO "band" ("name", "formed", "rating", "logo") VALUES ('Blood Incantation', 2011, 4.5, X'beef');
+INSERT INTO "band" ("name", "formed", "rating", "logo") VALUES ('Death; the band', NULL, 5.0, NULL);
+`[1:])
+}
+
+func (s *dumpSuite) TestLoadDB(c *tc.C) {
+	s.exec(c, `
+INSERT INTO band VALUES ('Tomb Mold', 2015, 3, NULL);
+INSERT INTO album VALUES ('Manor of Infinite Forms', 'Tomb Mold');
+INSERT INTO ignored VALUES ('keep me');
+DELETE FROM change_log;
+`)
+
+	dump := `
+CREATE TABLE "band" ("name", "formed", "rating", "logo");
+CREATE TABLE "album" ("title", "band");
+CREATE TABLE "audit" ("message");
+INSERT INTO "band" ("name", "formed", "rating", "logo") VALUES ('Death; the band', NULL, 5.0, X'beef');
+INSERT INTO "album" ("title", "band") VALUES ('Scream ''Bloody'' Gore', 'Death; the band');
+INSERT INTO "audit" ("message") VALUES ('restored');
+`
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, strings.NewReader(dump), "ignored", "change_log")
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(s.query(c, "SELECT name || ':' || hex(logo) FROM band"), tc.DeepEquals, []string{"Death; the band:BEEF"})
+	c.Check(s.query(c, "SELECT title FROM album"), tc.DeepEquals, []string{"Scream 'Bloody' Gore"})
+	// The insert trigger must not have fired while loading.
+	c.Check(s.query(c, "SELECT message FROM audit"), tc.DeepEquals, []string{"restored"})
+	c.Check(s.query(c, "SELECT value FROM ignored"), tc.DeepEquals, []string{"keep me"})
+	// The change log triggers must have fired, so watchers see the change.
+	c.Check(s.query(c, "SELECT changed FROM change_log ORDER BY rowid"), tc.DeepEquals, []string{
+		"deleted Tomb Mold",
+		"inserted Death; the band",
+	})
+
+	// The triggers must have been recreated.
+	_, err = s.DB().ExecContext(c.Context(), "DELETE FROM band")
+	c.Check(err, tc.ErrorMatches, ".*band is immutable.*")
+}
+
+func (s *dumpSuite) TestLoadDBRoundTrip(c *tc.C) {
+	s.exec(c, `
+INSERT INTO band VALUES ('Blood Incantation', 2011, 4.5, X'beef');
+INSERT INTO album VALUES ('Starspawn', 'Blood Incantation');
+`)
+
+	var buf bytes.Buffer
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return DumpDB(ctx, tx, &buf, "change_log")
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	dumped := buf.String()
+
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, &buf, "change_log")
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	buf.Reset()
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return DumpDB(ctx, tx, &buf, "change_log")
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(buf.String(), tc.Equals, dumped)
+}
+
+func (s *dumpSuite) TestLoadDBUnknownTable(c *tc.C) {
+	dump := `INSERT INTO "unknown" ("name") VALUES ('foo');`
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, strings.NewReader(dump))
+	})
+	c.Assert(err, tc.ErrorMatches, `dump of unknown table "unknown" not valid`)
+}
+
+func (s *dumpSuite) TestLoadDBUndescribedTable(c *tc.C) {
+	dump := `INSERT INTO "audit" ("message") VALUES ('foo');`
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, strings.NewReader(dump))
+	})
+	c.Assert(err, tc.ErrorMatches, `dump of unknown table "audit" not valid`)
+}
+
+func (s *dumpSuite) TestLoadDBMissingTable(c *tc.C) {
+	dump := `CREATE TABLE "unknown" ("name");`
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, strings.NewReader(dump))
+	})
+	c.Assert(err, tc.ErrorMatches, `dump of table "unknown" missing from database schema not valid`)
+}
+
+func (s *dumpSuite) TestLoadDBMissingColumn(c *tc.C) {
+	dump := `CREATE TABLE "audit" ("message", "level");`
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, strings.NewReader(dump))
+	})
+	c.Assert(err, tc.ErrorMatches, `dump of column "level" missing from table "audit" not valid`)
+}
+
+func (s *dumpSuite) TestLoadDBRejectsOtherStatements(c *tc.C) {
+	dump := `DROP TABLE band;`
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return LoadDB(ctx, tx, strings.NewReader(dump))
+	})
+	c.Assert(err, tc.ErrorMatches, `statement "DROP TABLE band;" not valid`)
+}
+
+func (s *dumpSuite) TestNextStatement(c *tc.C) {
+	r := bufio.NewReader(strings.NewReader(`
+INSERT INTO "a;b" VALUES ('x;''y');
+INSERT INTO "c" VALUES ('multi
+line');
+`))
+	stmt, err := nextStatement(r)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(stmt, tc.Equals, `INSERT INTO "a;b" VALUES ('x;''y');`)
+
+	stmt, err = nextStatement(r)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(stmt, tc.Equals, "INSERT INTO \"c\" VALUES ('multi\nline');")
+
+	_, err = nextStatement(r)
+	c.Assert(err, tc.Equals, io.EOF)
+}
+
+func (s *dumpSuite) exec(c *tc.C, stmts string) {
+	_, err := s.DB().ExecContext(c.Context(), stmts)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *dumpSuite) query(c *tc.C, stmt string) []string {
+	rows, err := s.DB().QueryContext(c.Context(), stmt)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+
+	var result []string
+	for rows.Next() {
+		var value string
+		c.Assert(rows.Scan(&value), tc.ErrorIsNil)
+		result = append(result, value)
+	}
+	c.Assert(rows.Err(), tc.ErrorIsNil)
+	return result
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// LabelOp is the comparison a LabelMatcher makes against a label value.
+type LabelOp string
+
+const (
+	// LabelEqual matches records whose label has the value.
+	LabelEqual LabelOp = "="
+	// LabelNotEqual matches records whose label does not have the value,
+	// including records without the label.
+	LabelNotEqual LabelOp = "!="
+	// LabelRegexp matches records whose label value matches the regular
+	// expression.
+	LabelRegexp LabelOp = "=~"
+	// LabelNotRegexp matches records whose label value does not match the
+	// regular expression, including records without the label.
+	LabelNotRegexp LabelOp = "!~"
+)
+
+// LabelMatcher matches log records on the value of one of their labels.
+type LabelMatcher struct {
+	Key   string
+	Op    LabelOp
+	Value string
+
+	re *regexp.Regexp
+}
+
+// ParseLabelMatcher parses a label matcher of the form key=value,
+// key!=value, key=~regexp or key!~regexp. Regular expressions must match
+// the whole label value.
+func ParseLabelMatcher(s string) (LabelMatcher, error) {
+	i := strings.IndexAny(s, "=!")
+	if i <= 0 {
+		return LabelMatcher{}, errors.NotValidf("label matcher %q", s)
+	}
+	m := LabelMatcher{Key: s[:i]}
+	rest := s[i:]
+	for _, op := range []LabelOp{LabelRegexp, LabelNotRegexp, LabelNotEqual, LabelEqual} {
+		if strings.HasPrefix(rest, string(op)) {
+			m.Op = op
+			m.Value = rest[len(op):]
+			break
+		}
+	}
+	if m.Op == "" {
+		return LabelMatcher{}, errors.NotValidf("label matcher %q", s)
+	}
+	if m.Op == LabelRegexp || m.Op == LabelNotRegexp {
+		re, err := regexp.Compile("^(?:" + m.Value + ")$")
+		if err != nil {
+			return LabelMatcher{}, errors.NotValidf("label matcher %q regexp", s)
+		}
+		m.re = re
+	}
+	return m, nil
+}
+
+// String returns the matcher in the form accepted by ParseLabelMatcher.
+func (m LabelMatcher) String() string {
+	return m.Key + string(m.Op) + m.Value
+}
+
+// Matches reports whether the labels satisfy the matcher.
+func (m LabelMatcher) Matches(labels map[string]string) bool {
+	value, ok := labels[m.Key]
+	switch m.Op {
+	case LabelEqual:
+		return ok && value == m.Value
+	case LabelNotEqual:
+		return !ok || value != m.Value
+	case LabelRegexp:
+		return ok && m.re.MatchString(value)
+	case LabelNotRegexp:
+		return !ok || !m.re.MatchString(value)
+	}
+	return false
+}
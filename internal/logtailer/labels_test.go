@@ -0,0 +1,73 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type LabelMatcherSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestLabelMatcherSuite(t *testing.T) {
+	tc.Run(t, &LabelMatcherSuite{})
+}
+
+func (s *LabelMatcherSuite) TestParse(c *tc.C) {
+	for _, test := range []struct {
+		in    string
+		key   string
+		op    logtailer.LabelOp
+		value string
+	}{
+		{in: "charm=mysql", key: "charm", op: logtailer.LabelEqual, value: "mysql"},
+		{in: "charm!=mysql", key: "charm", op: logtailer.LabelNotEqual, value: "mysql"},
+		{in: "charm=~my.*", key: "charm", op: logtailer.LabelRegexp, value: "my.*"},
+		{in: "charm!~my.*", key: "charm", op: logtailer.LabelNotRegexp, value: "my.*"},
+		{in: "http-request-id=", key: "http-request-id", op: logtailer.LabelEqual, value: ""},
+	} {
+		m, err := logtailer.ParseLabelMatcher(test.in)
+		c.Assert(err, tc.ErrorIsNil, tc.Commentf("matcher %q", test.in))
+		c.Check(m.Key, tc.Equals, test.key)
+		c.Check(m.Op, tc.Equals, test.op)
+		c.Check(m.Value, tc.Equals, test.value)
+		c.Check(m.String(), tc.Equals, test.in)
+	}
+}
+
+func (s *LabelMatcherSuite) TestParseInvalid(c *tc.C) {
+	for _, in := range []string{"", "charm", "=mysql", "charm!mysql", "charm=~("} {
+		_, err := logtailer.ParseLabelMatcher(in)
+		c.Check(err, tc.ErrorIs, errors.NotValid, tc.Commentf("matcher %q", in))
+	}
+}
+
+func (s *LabelMatcherSuite) TestMatches(c *tc.C) {
+	labels := map[string]string{"charm": "mysql"}
+	for _, test := range []struct {
+		in      string
+		matches bool
+	}{
+		{in: "charm=mysql", matches: true},
+		{in: "charm=postgresql", matches: false},
+		{in: "charm!=mysql", matches: false},
+		{in: "other!=mysql", matches: true},
+		{in: "charm=~my", matches: false},
+		{in: "charm=~my.*", matches: true},
+		{in: "charm!~my.*", matches: false},
+		{in: "other!~my.*", matches: true},
+		{in: "other=~.*", matches: false},
+	} {
+		m, err := logtailer.ParseLabelMatcher(test.in)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(m.Matches(labels), tc.Equals, test.matches, tc.Commentf("matcher %q", test.in))
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+
+	corelogger "github.com/juju/juju/core/logger"
+)
+
+// Offset identifies a log record, so that reading the logs can be resumed
+// from it. It is made of the record's timestamp and a hash of its content,
+// which makes it independent of the file the record is stored in; it stays
+// valid when the log file is rotated or compressed.
+type Offset struct {
+	Time time.Time
+	Hash uint32
+}
+
+// OffsetOf returns the offset of the log record.
+func OffsetOf(rec corelogger.LogRecord) Offset {
+	h := fnv.New32a()
+	for _, s := range []string{rec.ModelUUID, rec.Entity, rec.Module, rec.Location, rec.Message} {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{0})
+	}
+	return Offset{
+		Time: rec.Time,
+		Hash: h.Sum32(),
+	}
+}
+
+// ParseOffset parses an offset previously returned by Offset.String.
+func ParseOffset(s string) (Offset, error) {
+	nanos, hash, ok := strings.Cut(s, "-")
+	if !ok {
+		return Offset{}, errors.NotValidf("offset %q", s)
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return Offset{}, errors.NotValidf("offset %q", s)
+	}
+	h, err := strconv.ParseUint(hash, 16, 32)
+	if err != nil {
+		return Offset{}, errors.NotValidf("offset %q", s)
+	}
+	return Offset{
+		Time: time.Unix(0, n).UTC(),
+		Hash: uint32(h),
+	}, nil
+}
+
+// IsZero reports whether the offset is unset.
+func (o Offset) IsZero() bool {
+	return o.Time.IsZero() && o.Hash == 0
+}
+
+// String returns the offset in a form accepted by ParseOffset.
+func (o Offset) String() string {
+	if o.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%d-%08x", o.Time.UnixNano(), o.Hash)
+}
+
+// matches reports whether the offset identifies the log record.
+func (o Offset) matches(rec corelogger.LogRecord) bool {
+	return o.Time.Equal(rec.Time) && OffsetOf(rec).Hash == o.Hash
+}
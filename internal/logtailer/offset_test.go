@@ -0,0 +1,50 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/logtailer"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type OffsetSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestOffsetSuite(t *testing.T) {
+	tc.Run(t, &OffsetSuite{})
+}
+
+func (s *OffsetSuite) TestRoundTrip(c *tc.C) {
+	offset := logtailer.OffsetOf(logRecords[0])
+	c.Check(offset.Time, tc.Equals, logRecords[0].Time)
+
+	parsed, err := logtailer.ParseOffset(offset.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(parsed, tc.Equals, offset)
+}
+
+func (s *OffsetSuite) TestDistinctRecords(c *tc.C) {
+	rec := logRecords[0]
+	other := rec
+	other.Message = "something else"
+	c.Check(logtailer.OffsetOf(rec), tc.Not(tc.Equals), logtailer.OffsetOf(other))
+}
+
+func (s *OffsetSuite) TestZero(c *tc.C) {
+	c.Check(logtailer.Offset{}.IsZero(), tc.IsTrue)
+	c.Check(logtailer.Offset{}.String(), tc.Equals, "")
+}
+
+func (s *OffsetSuite) TestParseInvalid(c *tc.C) {
+	for _, in := range []string{"", "123", "abc-1f", "123-xyz", "123-1ffffffff"} {
+		_, err := logtailer.ParseOffset(in)
+		c.Check(err, tc.ErrorIs, errors.NotValid, tc.Commentf("offset %q", in))
+	}
+}
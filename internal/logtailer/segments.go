@@ -0,0 +1,133 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logtailer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+const (
+	// backupTimeFormat is the timestamp format used by the log sink when
+	// it rotates the log file, matching the naming used by lumberjack.
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+
+	// compressSuffix is appended to rotated log files once they have
+	// been compressed.
+	compressSuffix = ".gz"
+)
+
+// segment is a rotated log file. Rotated files are named after the log
+// file, with the time of the rotation inserted before the extension. All
+// the records in a segment were written before that time.
+type segment struct {
+	path       string
+	rotatedAt  time.Time
+	compressed bool
+}
+
+// rotatedSegments returns the rotated segments of the log file, ordered
+// from the oldest to the newest. A segment which exists both compressed
+// and uncompressed is still being compressed, so the uncompressed file
+// is preferred.
+func rotatedSegments(logFile string) ([]segment, error) {
+	dir := filepath.Dir(logFile)
+	base := filepath.Base(logFile)
+	ext := filepath.Ext(base)
+	prefix := strings.TrimSuffix(base, ext) + "-"
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "reading log directory %q", dir)
+	}
+
+	byTime := make(map[time.Time]segment)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		compressed := strings.HasSuffix(name, compressSuffix)
+		ts := strings.TrimSuffix(name, compressSuffix)
+		if !strings.HasPrefix(ts, prefix) || !strings.HasSuffix(ts, ext) {
+			continue
+		}
+		ts = strings.TrimSuffix(strings.TrimPrefix(ts, prefix), ext)
+		rotatedAt, err := time.Parse(backupTimeFormat, ts)
+		if err != nil {
+			continue
+		}
+		if existing, ok := byTime[rotatedAt]; ok && !existing.compressed {
+			continue
+		}
+		byTime[rotatedAt] = segment{
+			path:       filepath.Join(dir, name),
+			rotatedAt:  rotatedAt,
+			compressed: compressed,
+		}
+	}
+
+	segments := make([]segment, 0, len(byTime))
+	for _, seg := range byTime {
+		segments = append(segments, seg)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].rotatedAt.Before(segments[j].rotatedAt)
+	})
+	return segments, nil
+}
+
+// readSegment calls fn with each line of the segment, in order, until
+// fn returns false.
+func readSegment(seg segment, fn func(line string) bool) error {
+	f, err := os.Open(seg.path)
+	if errors.Is(err, os.ErrNotExist) {
+		// The segment has been pruned or compressed since it was listed.
+		return nil
+	} else if err != nil {
+		return errors.Annotatef(err, "opening log segment %q", seg.path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var r io.Reader = f
+	if seg.compressed {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return errors.Annotatef(err, "decompressing log segment %q", seg.path)
+		}
+		defer func() {
+			_ = gz.Close()
+		}()
+		r = gz
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) == 0 {
+			continue
+		}
+		if !fn(line) {
+			return nil
+		}
+	}
+	return errors.Annotatef(scanner.Err(), "reading log segment %q", seg.path)
+}
+
+// maxLineLength is the longest log line that will be read from a rotated
+// segment.
+const maxLineLength = 1024 * 1024
@@ -56,6 +56,28 @@ type LogTailerParams struct {
 	IncludeLabels map[string]string
 	ExcludeLabels map[string]string
 	FromTheStart  bool
+
+	// IncludeMessage holds regular expressions which a record's message
+	// must all match for the record to be returned.
+	IncludeMessage []string
+
+	// ExcludeMessage holds regular expressions, a record is not returned
+	// if its message matches any of them.
+	ExcludeMessage []string
+
+	// LabelMatchers must all match a record's labels for the record to be
+	// returned.
+	LabelMatchers []LabelMatcher
+
+	// EndTime, when set, excludes records written after it. The tailer
+	// stops once it reads past EndTime rather than following the log.
+	EndTime time.Time
+
+	// FromOffset, when set, positions the tailer at the record with that
+	// offset. Records after it are returned, unless InitialLines is also
+	// set, in which case the InitialLines records before it are returned
+	// and the tailer stops, so that the logs can be paged backwards.
+	FromOffset Offset
 }
 
 // maxInitialLines limits the number of documents we will load into memory
@@ -68,9 +90,14 @@ func NewLogTailer(
 	modelUUID string,
 	logFile string, params LogTailerParams,
 ) (LogTailer, error) {
+	filter, err := NewRecordFilter(modelUUID, params)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	t := &logTailer{
 		modelUUID:       modelUUID,
 		params:          params,
+		filter:          filter,
 		logCh:           make(chan corelogger.LogRecord),
 		maxInitialLines: maxInitialLines,
 		logFile:         logFile,
@@ -87,9 +114,11 @@ type logTailer struct {
 	tomb            tomb.Tomb
 	modelUUID       string
 	params          LogTailerParams
+	filter          *RecordFilter
 	logCh           chan corelogger.LogRecord
 	lastTime        time.Time
 	maxInitialLines int
+	offsetReached   bool
 
 	logFile string
 }
@@ -121,23 +150,107 @@ func (t *logTailer) loop() error {
 		if err != nil {
 			return err
 		}
+		if t.anchored() {
+			// The records before the anchor have been returned, there is
+			// nothing to follow.
+			return nil
+		}
 		seekTo = &tail.SeekInfo{
 			Offset: seekOffset,
 			Whence: io.SeekStart,
 		}
+	} else if t.params.FromTheStart || !t.params.StartTime.IsZero() || !t.params.FromOffset.IsZero() {
+		done, err := t.replaySegments()
+		if err != nil || done {
+			return err
+		}
 	}
 	return t.tailFile(seekTo)
 }
 
+// anchored reports whether the initial lines are counted back from an
+// offset or end time, rather than from the end of the log.
+func (t *logTailer) anchored() bool {
+	return !t.params.FromOffset.IsZero() || !t.params.EndTime.IsZero()
+}
+
+// anchorTime returns the time the initial lines are counted back from.
+func (t *logTailer) anchorTime() time.Time {
+	if t.params.FromOffset.IsZero() {
+		return t.params.EndTime
+	}
+	if !t.params.EndTime.IsZero() && t.params.EndTime.Before(t.params.FromOffset.Time) {
+		return t.params.EndTime
+	}
+	return t.params.FromOffset.Time
+}
+
 func (t *logTailer) processInitialLines() (int64, error) {
 	if t.params.InitialLines > t.maxInitialLines {
 		return -1, errors.Errorf("too many lines requested (%d) maximum is %d",
 			t.params.InitialLines, maxInitialLines)
 	}
 
+	var segments []segment
+	if t.anchored() {
+		var err error
+		if segments, err = rotatedSegments(t.logFile); err != nil {
+			return -1, errors.Trace(err)
+		}
+	}
+
+	queue := make([]corelogger.LogRecord, t.params.InitialLines)
+	cur := t.params.InitialLines
+
+	// Only scan the current log file if it can hold records before the
+	// anchor; it holds the records written since the last rotation.
+	var seekTo int64
+	var offsetSeen bool
+	if len(segments) == 0 || !segments[len(segments)-1].rotatedAt.After(t.anchorTime()) {
+		var err error
+		seekTo, offsetSeen, err = t.scanBackwards(queue, &cur)
+		if err != nil {
+			return -1, errors.Trace(err)
+		}
+	}
+
+	// Continue into the rotated segments, newest first, until the queue
+	// is full.
+	for i := len(segments) - 1; i >= 0 && cur > 0; i-- {
+		if i > 0 && segments[i-1].rotatedAt.After(t.anchorTime()) {
+			// All the records in this segment are after the anchor.
+			continue
+		}
+		if segments[i].rotatedAt.Before(t.params.StartTime) {
+			break
+		}
+		var err error
+		if offsetSeen, err = t.scanSegmentBackwards(segments[i], queue, &cur, offsetSeen); err != nil {
+			return -1, errors.Trace(err)
+		}
+	}
+
+	// We loaded the queue in reverse order, truncate it to just the actual
+	// contents, and then return them in the correct order.
+	queue = queue[cur:]
+	for _, rec := range queue {
+		select {
+		case <-t.tomb.Dying():
+			return -1, tomb.ErrDying
+		case t.logCh <- rec:
+			t.lastTime = rec.Time
+		}
+	}
+	return seekTo, nil
+}
+
+// scanBackwards fills the queue from its end with the records of the
+// current log file, reading it from the end. It returns the size of the
+// file, and whether the record at FromOffset was seen.
+func (t *logTailer) scanBackwards(queue []corelogger.LogRecord, cur *int) (int64, bool, error) {
 	f, err := os.Open(t.logFile)
 	if err != nil {
-		return -1, errors.Annotatef(err, "opening file %q", t.logFile)
+		return -1, false, errors.Annotatef(err, "opening file %q", t.logFile)
 	}
 	defer func() {
 		_ = f.Close()
@@ -145,19 +258,19 @@ func (t *logTailer) processInitialLines() (int64, error) {
 
 	seekTo, err := f.Seek(0, io.SeekEnd)
 	if err != nil {
-		return -1, errors.Trace(err)
+		return -1, false, errors.Trace(err)
 	}
 	fs, err := f.Stat()
 	if err != nil {
-		return -1, errors.Trace(err)
+		return -1, false, errors.Trace(err)
 	}
 	scanner := rscanner.NewScanner(f, fs.Size())
 
-	queue := make([]corelogger.LogRecord, t.params.InitialLines)
-	cur := t.params.InitialLines
-
-	var failures int
-	for scanner.Scan() {
+	var (
+		failures   int
+		offsetSeen bool
+	)
+	for *cur > 0 && scanner.Scan() {
 		line := scanner.Text()
 		if len(line) == 0 {
 			continue
@@ -176,43 +289,195 @@ func (t *logTailer) processInitialLines() (int64, error) {
 		}
 		failures = 0
 
+		var before bool
+		if before, offsetSeen = t.beforeAnchor(rec, offsetSeen); !before {
+			continue
+		}
 		if !t.includeRecord(rec) {
 			continue
 		}
 		select {
 		case <-t.tomb.Dying():
-			return -1, tomb.ErrDying
+			return -1, false, tomb.ErrDying
 		default:
 		}
-		cur--
-		queue[cur] = rec
-		if cur == 0 {
-			break
-		}
+		*cur--
+		queue[*cur] = rec
 	}
 	if failures > 1 {
 		logger.Debugf(context.Background(), "total of %d log serialisation errors", failures)
 	}
 	if err := scanner.Err(); err != nil {
-		return -1, errors.Trace(err)
+		return -1, false, errors.Trace(err)
 	}
+	return seekTo, offsetSeen, nil
+}
 
-	// We loaded the queue in reverse order, truncate it to just the actual
-	// contents, and then return them in the correct order.
-	queue = queue[cur:]
-	for _, rec := range queue {
+// scanSegmentBackwards fills the queue from its end with the last records
+// of a rotated segment. Segments can only be read forwards, so the last
+// records are kept in a ring until the whole segment has been read.
+func (t *logTailer) scanSegmentBackwards(
+	seg segment, queue []corelogger.LogRecord, cur *int, offsetSeen bool,
+) (bool, error) {
+	type entry struct {
+		rec corelogger.LogRecord
+		seq int
+	}
+	var (
+		ring     = make([]entry, *cur)
+		seq      int
+		matchSeq = -1
+	)
+	offset := t.params.FromOffset
+	err := readSegment(seg, func(line string) bool {
 		select {
 		case <-t.tomb.Dying():
-			return -1, tomb.ErrDying
-		case t.logCh <- rec:
-			t.lastTime = rec.Time
+			return false
+		default:
 		}
+		rec, err := logLineToRecord(t.modelUUID, line)
+		if err != nil {
+			return true
+		}
+		if !t.params.EndTime.IsZero() && rec.Time.After(t.params.EndTime) {
+			return true
+		}
+		if !offset.IsZero() {
+			if rec.Time.After(offset.Time) {
+				return true
+			} else if offset.matches(rec) {
+				matchSeq = seq
+				return true
+			}
+		}
+		if !t.includeRecord(rec) {
+			return true
+		}
+		ring[seq%len(ring)] = entry{rec: rec, seq: seq}
+		seq++
+		return true
+	})
+	if err != nil {
+		return offsetSeen, errors.Trace(err)
+	}
+	select {
+	case <-t.tomb.Dying():
+		return offsetSeen, tomb.ErrDying
+	default:
+	}
+
+	// Records with the same time as the offset are only before it if
+	// they were written before the record at the offset.
+	for i := seq - 1; i >= 0 && i >= seq-len(ring) && *cur > 0; i-- {
+		e := ring[i%len(ring)]
+		if !offset.IsZero() && e.rec.Time.Equal(offset.Time) &&
+			!offsetSeen && (matchSeq < 0 || e.seq >= matchSeq) {
+			continue
+		}
+		*cur--
+		queue[*cur] = e.rec
+	}
+	return offsetSeen || matchSeq >= 0, nil
+}
+
+// beforeAnchor reports whether a record, read backwards, is before the
+// anchor. Records with the same time as FromOffset are only before it
+// once the record at FromOffset has been seen, which is tracked by
+// offsetSeen.
+func (t *logTailer) beforeAnchor(rec corelogger.LogRecord, offsetSeen bool) (bool, bool) {
+	if !t.params.EndTime.IsZero() && rec.Time.After(t.params.EndTime) {
+		return false, offsetSeen
+	}
+	offset := t.params.FromOffset
+	switch {
+	case offset.IsZero(), rec.Time.Before(offset.Time):
+		return true, offsetSeen
+	case rec.Time.After(offset.Time):
+		return false, offsetSeen
+	case offset.matches(rec):
+		return false, true
+	default:
+		return offsetSeen, offsetSeen
+	}
+}
+
+// replaySegments sends the records of the rotated segments, oldest first.
+// It returns true if the end time has been passed, so there is nothing
+// more to read.
+func (t *logTailer) replaySegments() (bool, error) {
+	segments, err := rotatedSegments(t.logFile)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	var done bool
+	for _, seg := range segments {
+		// A segment only holds records written before it was rotated.
+		if seg.rotatedAt.Before(t.params.StartTime) || seg.rotatedAt.Before(t.params.FromOffset.Time) {
+			continue
+		}
+
+		var sendErr error
+		err := readSegment(seg, func(line string) bool {
+			rec, err := logLineToRecord(t.modelUUID, line)
+			if err != nil {
+				return true
+			}
+			var include bool
+			if include, done = t.afterOffset(rec); done {
+				return false
+			} else if !include || !t.includeRecord(rec) {
+				return true
+			}
+			select {
+			case <-t.tomb.Dying():
+				sendErr = tomb.ErrDying
+				return false
+			case t.logCh <- rec:
+				t.lastTime = rec.Time
+			}
+			return true
+		})
+		if sendErr != nil {
+			return false, sendErr
+		} else if err != nil {
+			return false, errors.Trace(err)
+		} else if done {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// afterOffset reports whether a record, read forwards, is after
+// FromOffset. It also reports whether the record is past the end time,
+// so reading should stop.
+func (t *logTailer) afterOffset(rec corelogger.LogRecord) (bool, bool) {
+	if !t.params.EndTime.IsZero() && rec.Time.After(t.params.EndTime) {
+		return false, true
+	}
+	if t.offsetReached || t.params.FromOffset.IsZero() {
+		return true, false
+	}
+	offset := t.params.FromOffset
+	switch {
+	case rec.Time.Before(offset.Time):
+		return false, false
+	case rec.Time.After(offset.Time):
+		t.offsetReached = true
+		return true, false
+	case offset.matches(rec):
+		t.offsetReached = true
+		return false, false
+	default:
+		return false, false
 	}
-	return seekTo, nil
 }
 
 func (t *logTailer) tailFile(seekTo *tail.SeekInfo) (err error) {
-	follow := !t.params.NoTail
+	// An end time bounds the records returned, so there is no need to
+	// follow the log beyond it.
+	follow := !t.params.NoTail && t.params.EndTime.IsZero()
 	tailer, err := tail.TailFile(t.logFile, tail.Config{
 		Location: seekTo,
 		ReOpen:   follow,
@@ -269,7 +534,9 @@ func (t *logTailer) tailFile(seekTo *tail.SeekInfo) (err error) {
 			}
 			failures = 0
 
-			if !t.includeRecord(rec) {
+			if include, done := t.afterOffset(rec); done {
+				return nil
+			} else if !include || !t.includeRecord(rec) {
 				continue
 			}
 			select {
@@ -282,43 +549,78 @@ func (t *logTailer) tailFile(seekTo *tail.SeekInfo) (err error) {
 }
 
 func (t *logTailer) includeRecord(rec corelogger.LogRecord) bool {
+	return t.filter.Include(rec)
+}
+
+// RecordFilter decides which log records are returned for the filtering
+// parameters of a LogTailerParams. It can be used to filter records read
+// from sources other than the log file a LogTailer reads.
+type RecordFilter struct {
+	modelUUID      string
+	params         LogTailerParams
+	includeMessage []*regexp.Regexp
+	excludeMessage []*regexp.Regexp
+}
+
+// NewRecordFilter returns a RecordFilter for the records of the model with
+// the given UUID. Records of any model are included when the parameters
+// ask for the firehose.
+func NewRecordFilter(modelUUID string, params LogTailerParams) (*RecordFilter, error) {
+	includeMessage, err := compilePatterns(params.IncludeMessage)
+	if err != nil {
+		return nil, errors.Annotate(err, "include message")
+	}
+	excludeMessage, err := compilePatterns(params.ExcludeMessage)
+	if err != nil {
+		return nil, errors.Annotate(err, "exclude message")
+	}
+	return &RecordFilter{
+		modelUUID:      modelUUID,
+		params:         params,
+		includeMessage: includeMessage,
+		excludeMessage: excludeMessage,
+	}, nil
+}
+
+// Include reports whether the record passes the filter.
+func (f *RecordFilter) Include(rec corelogger.LogRecord) bool {
 	// If it's not firehose we need to check the model UUID.
-	if !t.params.Firehose && rec.ModelUUID != t.modelUUID {
+	if !f.params.Firehose && rec.ModelUUID != f.modelUUID {
 		return false
 	}
-	if rec.Time.Before(t.params.StartTime) {
+	if rec.Time.Before(f.params.StartTime) {
 		return false
 	}
-	if rec.Level < t.params.MinLevel {
+	if rec.Level < f.params.MinLevel {
 		return false
 	}
-	if len(t.params.IncludeEntity) > 0 {
-		match, err := regexp.MatchString(makeEntityPattern(t.params.IncludeEntity), rec.Entity)
+	if len(f.params.IncludeEntity) > 0 {
+		match, err := regexp.MatchString(makeEntityPattern(f.params.IncludeEntity), rec.Entity)
 		if !match || err != nil {
 			return false
 		}
 	}
-	if len(t.params.ExcludeEntity) > 0 {
-		match, err := regexp.MatchString(makeEntityPattern(t.params.ExcludeEntity), rec.Entity)
+	if len(f.params.ExcludeEntity) > 0 {
+		match, err := regexp.MatchString(makeEntityPattern(f.params.ExcludeEntity), rec.Entity)
 		if match || err != nil {
 			return false
 		}
 	}
-	if len(t.params.IncludeModule) > 0 {
-		match, err := regexp.MatchString(makeModulePattern(t.params.IncludeModule), rec.Module)
+	if len(f.params.IncludeModule) > 0 {
+		match, err := regexp.MatchString(makeModulePattern(f.params.IncludeModule), rec.Module)
 		if !match || err != nil {
 			return false
 		}
 	}
-	if len(t.params.ExcludeModule) > 0 {
-		match, err := regexp.MatchString(makeModulePattern(t.params.ExcludeModule), rec.Module)
+	if len(f.params.ExcludeModule) > 0 {
+		match, err := regexp.MatchString(makeModulePattern(f.params.ExcludeModule), rec.Module)
 		if match || err != nil {
 			return false
 		}
 	}
-	if len(t.params.IncludeLabels) > 0 {
+	if len(f.params.IncludeLabels) > 0 {
 		anyMatch := false
-		for k, v := range t.params.IncludeLabels {
+		for k, v := range f.params.IncludeLabels {
 			if val, ok := rec.Labels[k]; ok && v == val {
 				anyMatch = true
 				break
@@ -328,9 +630,9 @@ func (t *logTailer) includeRecord(rec corelogger.LogRecord) bool {
 			return false
 		}
 	}
-	if len(t.params.ExcludeLabels) > 0 {
+	if len(f.params.ExcludeLabels) > 0 {
 		anyMatch := false
-		for k, v := range t.params.IncludeLabels {
+		for k, v := range f.params.ExcludeLabels {
 			if val, ok := rec.Labels[k]; ok && v == val {
 				anyMatch = true
 				break
@@ -340,9 +642,36 @@ func (t *logTailer) includeRecord(rec corelogger.LogRecord) bool {
 			return false
 		}
 	}
+	for _, m := range f.params.LabelMatchers {
+		if !m.Matches(rec.Labels) {
+			return false
+		}
+	}
+	for _, re := range f.includeMessage {
+		if !re.MatchString(rec.Message) {
+			return false
+		}
+	}
+	for _, re := range f.excludeMessage {
+		if re.MatchString(rec.Message) {
+			return false
+		}
+	}
 	return true
 }
 
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.NotValidf("regexp %q", pattern)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
 func makeEntityPattern(entities []string) string {
 	var patterns []string
 	for _, entity := range entities {
@@ -4,6 +4,8 @@
 package logtailer_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -188,6 +190,124 @@ func (s *TailerSuite) TestProcessReverseTail(c *tc.C) {
 	c.Assert(result, tc.DeepEquals, logRecords[1:])
 }
 
+func (s *TailerSuite) writeRotatedLogs(c *tc.C) string {
+	dir := c.MkDir()
+
+	buffer := new(bytes.Buffer)
+	gz := gzip.NewWriter(buffer)
+	jsonEncoder := json.NewEncoder(gz)
+	for _, record := range logRecords[:2] {
+		err := jsonEncoder.Encode(record)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	c.Assert(gz.Close(), tc.ErrorIsNil)
+	err := os.WriteFile(filepath.Join(dir, "test-2024-02-15T06-23-23.500.log.gz"), buffer.Bytes(), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Files which aren't segments of the log are ignored.
+	err = os.WriteFile(filepath.Join(dir, "other-2024-02-15T06-23-22.000.log"), []byte("garbage\n"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	lines := strings.Split(createLogFileContent(c), "\n")
+	testFileName := filepath.Join(dir, "test.log")
+	err = os.WriteFile(testFileName, []byte(strings.Join(lines[2:], "\n")), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	return testFileName
+}
+
+func (s *TailerSuite) TestReplayAcrossRotatedSegments(c *tc.C) {
+	testFileName := s.writeRotatedLogs(c)
+
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		FromTheStart: true,
+		NoTail:       true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords)
+}
+
+func (s *TailerSuite) TestReplaySinceSkipsOlderSegments(c *tc.C) {
+	testFileName := s.writeRotatedLogs(c)
+
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		StartTime: logRecords[1].Time,
+		NoTail:    true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords[1:])
+}
+
+func (s *TailerSuite) TestReplayUntil(c *tc.C) {
+	testFileName := s.writeRotatedLogs(c)
+
+	// The tailer stops at the end time, without following the log.
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		FromTheStart: true,
+		EndTime:      logRecords[2].Time,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords[:3])
+	workertest.CheckKilled(c, tailer)
+}
+
+func (s *TailerSuite) TestReplayFromOffset(c *tc.C) {
+	testFileName := s.writeRotatedLogs(c)
+
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		FromOffset: logtailer.OffsetOf(logRecords[0]),
+		NoTail:     true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords[1:])
+}
+
+func (s *TailerSuite) TestPageBackwardsFromOffset(c *tc.C) {
+	testFileName := s.writeRotatedLogs(c)
+
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		FromOffset:   logtailer.OffsetOf(logRecords[3]),
+		InitialLines: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords[1:3])
+	workertest.CheckKilled(c, tailer)
+
+	// The next page continues from the first record returned.
+	tailer, err = logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		FromOffset:   logtailer.OffsetOf(records[0]),
+		InitialLines: 2,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records = s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords[:1])
+	workertest.CheckKilled(c, tailer)
+}
+
+func (s *TailerSuite) TestInitialLinesUntil(c *tc.C) {
+	testFileName := s.writeRotatedLogs(c)
+
+	tailer, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), testFileName, logtailer.LogTailerParams{
+		EndTime:      logRecords[1].Time,
+		InitialLines: 1,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	records := s.fetchLogs(tailer, -1)
+	c.Assert(records, tc.DeepEquals, logRecords[1:2])
+	workertest.CheckKilled(c, tailer)
+}
+
 func createLogFileContent(c *tc.C) string {
 	buffer := new(strings.Builder)
 
@@ -444,6 +564,115 @@ func (s *LogFilterSuite) TestIncludeExcludeModule(c *tc.C) {
 	s.checkLogTailerFiltering(c, params, writeLogs, assert)
 }
 
+func (s *LogFilterSuite) TestExcludeLabels(c *tc.C) {
+	cmr := &corelogger.LogRecord{Labels: map[string]string{"logger-tags": "cmr"}}
+	http := &corelogger.LogRecord{Labels: map[string]string{"logger-tags": "http"}}
+	none := &corelogger.LogRecord{Message: "no labels"}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, cmr)
+		s.writeLogs(c, logFile, 1, http)
+		s.writeLogs(c, logFile, 1, none)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		ExcludeLabels: map[string]string{"logger-tags": "cmr"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, http, none)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestIncludeAndExcludeLabels(c *tc.C) {
+	// Excluding records must only consider the excluded labels. Records
+	// matching the included labels were previously excluded as well.
+	cmr := &corelogger.LogRecord{Labels: map[string]string{"logger-tags": "cmr"}}
+	cmrHTTP := &corelogger.LogRecord{Labels: map[string]string{"logger-tags": "cmr", "charm": "http"}}
+	http := &corelogger.LogRecord{Labels: map[string]string{"logger-tags": "http"}}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, cmr)
+		s.writeLogs(c, logFile, 1, cmrHTTP)
+		s.writeLogs(c, logFile, 1, http)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		IncludeLabels: map[string]string{"logger-tags": "cmr"},
+		ExcludeLabels: map[string]string{"charm": "http"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, cmr)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestLabelMatchers(c *tc.C) {
+	foo := &corelogger.LogRecord{Labels: map[string]string{"charm": "foo", "http-request-id": "1a"}}
+	fooTraced := &corelogger.LogRecord{Labels: map[string]string{"charm": "foo", "trace_id": "abc"}}
+	bar := &corelogger.LogRecord{Labels: map[string]string{"charm": "bar", "http-request-id": "2b"}}
+	none := &corelogger.LogRecord{Message: "no labels"}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, none)
+		s.writeLogs(c, logFile, 1, bar)
+		s.writeLogs(c, logFile, 1, foo)
+		s.writeLogs(c, logFile, 1, fooTraced)
+		s.writeLogs(c, logFile, 1, foo)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		LabelMatchers: []logtailer.LabelMatcher{
+			mustParseLabelMatcher("charm=~fo+"),
+			mustParseLabelMatcher("trace_id!=abc"),
+		},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, foo, foo)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestIncludeExcludeMessage(c *tc.C) {
+	started := &corelogger.LogRecord{Message: "worker started"}
+	stopped := &corelogger.LogRecord{Message: "worker stopped"}
+	failed := &corelogger.LogRecord{Message: "worker failed to start"}
+	other := &corelogger.LogRecord{Message: "something else"}
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	writeLogs := func() string {
+		s.writeLogs(c, logFile, 1, other)
+		s.writeLogs(c, logFile, 1, started)
+		s.writeLogs(c, logFile, 1, failed)
+		s.writeLogs(c, logFile, 1, stopped)
+		s.writeLogs(c, logFile, 1, started)
+		return logFile
+	}
+	params := logtailer.LogTailerParams{
+		IncludeMessage: []string{"^worker", "st[a-z]+"},
+		ExcludeMessage: []string{"failed"},
+	}
+	assert := func(tailer logtailer.LogTailer) {
+		s.assertTailer(c, tailer, started, stopped, started)
+	}
+	s.checkLogTailerFiltering(c, params, writeLogs, assert)
+}
+
+func (s *LogFilterSuite) TestInvalidMessagePattern(c *tc.C) {
+	logFile := filepath.Join(c.MkDir(), "logs.log")
+	_, err := logtailer.NewLogTailer(coretesting.ModelTag.Id(), logFile, logtailer.LogTailerParams{
+		ExcludeMessage: []string{"("},
+	})
+	c.Assert(err, tc.ErrorMatches, `exclude message: regexp "\(" not valid`)
+}
+
+func mustParseLabelMatcher(s string) logtailer.LabelMatcher {
+	m, err := logtailer.ParseLabelMatcher(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
 func (s *LogFilterSuite) checkLogTailerFiltering(
 	c *tc.C,
 	params logtailer.LogTailerParams,
@@ -0,0 +1,32 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package loki
+
+import "strings"
+
+const (
+	// TraceIDLabel is the Juju log label holding the OpenTelemetry trace ID.
+	TraceIDLabel = "trace_id"
+
+	// SpanIDLabel is the Juju log label holding the OpenTelemetry span ID.
+	SpanIDLabel = "span_id"
+)
+
+// LabelField returns the name of the structured metadata field a Juju log
+// label is pushed to Loki as. Field names may only contain letters, digits
+// and underscores, so any other character is replaced with an underscore.
+func LabelField(label string) string {
+	switch label {
+	case TraceIDLabel:
+		return "traceID"
+	case SpanIDLabel:
+		return "spanID"
+	}
+	return strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, label)
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+)
+
+const (
+	pushPath       = "/loki/api/v1/push"
+	queryRangePath = "/loki/api/v1/query_range"
+
+	// maxQueryResponseSize limits the size of a query response read from
+	// Loki.
+	maxQueryResponseSize = 64 << 20
+)
+
+// QueryArgs holds the arguments of a Loki range query.
+type QueryArgs struct {
+	// Query is the LogQL query to run.
+	Query string
+
+	// Start and End bound the time range of the query.
+	Start, End time.Time
+
+	// Limit is the maximum number of entries to return.
+	Limit int
+
+	// Forward returns the oldest entries in the range when true, and the
+	// newest entries otherwise.
+	Forward bool
+
+	// OrgID is the organization/tenant ID for multi-tenant Loki
+	// deployments. When empty, no X-Scope-OrgID header is sent.
+	OrgID string
+}
+
+// Entry is a log line returned by a Loki query.
+type Entry struct {
+	// Timestamp is when the log entry was produced.
+	Timestamp time.Time
+
+	// Line is the log message text.
+	Line string
+
+	// Labels holds the stream labels and the structured metadata of the
+	// entry.
+	Labels map[string]string
+}
+
+// QueryRange runs a range query against the Loki instance with the given
+// push API endpoint, returning the entries ordered by time.
+func QueryRange(ctx context.Context, client HTTPClient, pushEndpoint string, args QueryArgs) ([]Entry, error) {
+	endpoint, err := queryRangeEndpoint(pushEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	direction := "backward"
+	if args.Forward {
+		direction = "forward"
+	}
+	values := url.Values{
+		"query":     {args.Query},
+		"start":     {strconv.FormatInt(args.Start.UnixNano(), 10)},
+		"end":       {strconv.FormatInt(args.End.UnixNano(), 10)},
+		"limit":     {strconv.Itoa(args.Limit)},
+		"direction": {direction},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+values.Encode(), nil)
+	if err != nil {
+		return nil, internalerrors.Errorf("creating request: %w", err)
+	}
+	if args.OrgID != "" {
+		req.Header.Set("X-Scope-OrgID", args.OrgID)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, internalerrors.Errorf("sending request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, internalerrors.Errorf("loki returned status %d", resp.StatusCode)
+	}
+
+	var result queryResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxQueryResponseSize)).Decode(&result); err != nil {
+		return nil, internalerrors.Errorf("decoding response: %w", err)
+	}
+	if result.Data.ResultType != "streams" {
+		return nil, internalerrors.Errorf("unexpected loki result type %q", result.Data.ResultType)
+	}
+
+	var entries []Entry
+	for _, stream := range result.Data.Result {
+		for _, value := range stream.Values {
+			nanos, err := strconv.ParseInt(value.Timestamp, 10, 64)
+			if err != nil {
+				return nil, internalerrors.Errorf("parsing timestamp %q: %w", value.Timestamp, err)
+			}
+			labels := make(map[string]string, len(stream.Stream)+len(value.Fields))
+			maps.Copy(labels, stream.Stream)
+			maps.Copy(labels, value.Fields)
+			entries = append(entries, Entry{
+				Timestamp: time.Unix(0, nanos).UTC(),
+				Line:      value.Line,
+				Labels:    labels,
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	return entries, nil
+}
+
+// queryRangeEndpoint returns the range query endpoint of the Loki instance
+// with the given push API endpoint.
+func queryRangeEndpoint(pushEndpoint string) (string, error) {
+	u, err := url.Parse(pushEndpoint)
+	if err != nil || !strings.HasSuffix(u.Path, pushPath) {
+		return "", internalerrors.Errorf("loki push endpoint %q", pushEndpoint).Add(coreerrors.NotValid)
+	}
+	u.Path = strings.TrimSuffix(u.Path, pushPath) + queryRangePath
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+// queryResponse is the JSON structure of a Loki range query response.
+type queryResponse struct {
+	Data struct {
+		ResultType string       `json:"resultType"`
+		Result     []pushStream `json:"result"`
+	} `json:"data"`
+}
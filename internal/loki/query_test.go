@@ -0,0 +1,89 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package loki
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type querySuite struct{}
+
+func TestQuerySuite(t *testing.T) {
+	tc.Run(t, &querySuite{})
+}
+
+func (s *querySuite) TestQueryRange(c *tc.C) {
+	var req *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		_, _ = w.Write([]byte(`{
+  "status": "success",
+  "data": {
+    "resultType": "streams",
+    "result": [{
+      "stream": {"juju_model": "deadbeef", "juju_agent": "machine-1"},
+      "values": [["2000000000", "second", {"module": "juju.worker"}]]
+    }, {
+      "stream": {"juju_model": "deadbeef", "juju_agent": "machine-0"},
+      "values": [["1000000000", "first"]]
+    }]
+  }
+}`))
+	}))
+	defer srv.Close()
+
+	entries, err := QueryRange(c.Context(), srv.Client(), srv.URL+"/loki/api/v1/push", QueryArgs{
+		Query:   `{juju_model="deadbeef"}`,
+		Start:   time.Unix(0, 0),
+		End:     time.Unix(3, 0),
+		Limit:   10,
+		Forward: true,
+		OrgID:   "tenant",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entries, tc.DeepEquals, []Entry{{
+		Timestamp: time.Unix(1, 0).UTC(),
+		Line:      "first",
+		Labels:    map[string]string{"juju_model": "deadbeef", "juju_agent": "machine-0"},
+	}, {
+		Timestamp: time.Unix(2, 0).UTC(),
+		Line:      "second",
+		Labels:    map[string]string{"juju_model": "deadbeef", "juju_agent": "machine-1", "module": "juju.worker"},
+	}})
+
+	c.Assert(req, tc.NotNil)
+	c.Check(req.URL.Path, tc.Equals, "/loki/api/v1/query_range")
+	c.Check(req.URL.Query().Get("query"), tc.Equals, `{juju_model="deadbeef"}`)
+	c.Check(req.URL.Query().Get("start"), tc.Equals, "0")
+	c.Check(req.URL.Query().Get("end"), tc.Equals, "3000000000")
+	c.Check(req.URL.Query().Get("limit"), tc.Equals, "10")
+	c.Check(req.URL.Query().Get("direction"), tc.Equals, "forward")
+	c.Check(req.Header.Get("X-Scope-OrgID"), tc.Equals, "tenant")
+}
+
+func (s *querySuite) TestQueryRangeErrorStatus(c *tc.C) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	_, err := QueryRange(c.Context(), srv.Client(), srv.URL+"/loki/api/v1/push", QueryArgs{})
+	c.Assert(err, tc.ErrorMatches, "loki returned status 400")
+}
+
+func (s *querySuite) TestQueryRangeEndpoint(c *tc.C) {
+	endpoint, err := queryRangeEndpoint("https://loki:3100/prefix/loki/api/v1/push")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(endpoint, tc.Equals, "https://loki:3100/prefix/loki/api/v1/query_range")
+
+	_, err = queryRangeEndpoint("https://loki:3100/somewhere")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
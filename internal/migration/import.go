@@ -5,6 +5,7 @@ package migration
 
 import (
 	"context"
+	"slices"
 
 	"github.com/juju/clock"
 	"github.com/juju/collections/set"
@@ -223,6 +224,7 @@ func newImportCoordinator(
 			modelUUID:    modelUUID,
 			modelUUIDStr: modelUUIDStr,
 			perms:        info.Permissions,
+			groupPerms:   info.GroupPermissions,
 		},
 		&opImportAuthorizedKeys{
 			keymanager:   svc.keymanager,
@@ -399,6 +401,7 @@ type opImportPermissions struct {
 	modelUUID    coremodel.UUID
 	modelUUIDStr string
 	perms        []coremodelmigration.ModelPermission
+	groupPerms   []coremodelmigration.ModelGroupPermission
 }
 
 func (op *opImportPermissions) Name() string { return "import-permissions" }
@@ -408,6 +411,15 @@ func (op *opImportPermissions) Execute(ctx context.Context, st *importState) err
 	if err != nil {
 		return errors.Errorf("applying permissions for model %q import: %w", op.modelUUIDStr, err)
 	}
+	groupOfferUUIDs, err := op.access.ImportModelGroupPermissions(ctx, op.groupPerms, st.inactiveUsers)
+	if err != nil {
+		return errors.Errorf("applying group permissions for model %q import: %w", op.modelUUIDStr, err)
+	}
+	for _, offerUUID := range groupOfferUUIDs {
+		if !slices.Contains(offerUUIDs, offerUUID) {
+			offerUUIDs = append(offerUUIDs, offerUUID)
+		}
+	}
 	if err := op.claim.ImportOfferPermissions(
 		ctx, op.modelUUID, st.claimUUID, offerUUIDs,
 	); err != nil {
@@ -417,7 +429,9 @@ func (op *opImportPermissions) Execute(ctx context.Context, st *importState) err
 	return nil
 }
 
-// RemoveOnAbort deletes the model-scoped and offer-scoped permission rows. Offer
+// RemoveOnAbort deletes the model-scoped and offer-scoped permission rows,
+// including those granted to user groups. Groups created by the import are
+// left in place, as they may since have been granted other access. Offer
 // UUIDs are read back from the model_migration_import_offer companion table so
 // this method is stateless.
 func (op *opImportPermissions) RemoveOnAbort(ctx context.Context) error {
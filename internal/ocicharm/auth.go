@@ -0,0 +1,149 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ocicharm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// authorize answers a WWW-Authenticate challenge from a registry, returning
+// the Authorization header value to retry the request with. The value is
+// cached per registry and scope, so later requests skip the challenge.
+func (c *Client) authorize(ctx context.Context, registry, scope, challenge string) (string, error) {
+	creds, err := c.credentials(ctx, registry)
+	if err != nil {
+		return "", errors.Errorf("getting credentials: %w", err)
+	}
+
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if creds.IsZero() {
+			return "", errors.New("registry requires credentials")
+		}
+		authorization := "Basic " + basicAuth(creds)
+		c.tokens.set(registry, scope, authorization)
+		return authorization, nil
+
+	case "bearer":
+		realm := params["realm"]
+		if realm == "" {
+			return "", errors.Errorf("bearer challenge %q has no realm", challenge)
+		}
+		token, err := c.fetchToken(ctx, realm, params["service"], scope, creds)
+		if err != nil {
+			return "", errors.Capture(err)
+		}
+		authorization := "Bearer " + token
+		c.tokens.set(registry, scope, authorization)
+		return authorization, nil
+
+	default:
+		return "", errors.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+// fetchToken requests a bearer token from the token server of a registry,
+// using basic authentication when credentials are available, and anonymous
+// access otherwise.
+func (c *Client) fetchToken(ctx context.Context, realm, service, scope string, creds Credentials) (string, error) {
+	u, err := url.Parse(realm)
+	if err != nil {
+		return "", errors.Errorf("parsing token realm %q: %w", realm, err)
+	}
+	query := u.Query()
+	if service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", scope)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if !creds.IsZero() {
+		req.Header.Set("Authorization", "Basic "+basicAuth(creds))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", errors.Errorf("requesting token from %q: %w", realm, err)
+	}
+	defer drain(resp)
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("requesting token from %q: %s", realm, resp.Status)
+	}
+
+	var result struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", errors.Errorf("decoding token from %q: %w", realm, err)
+	}
+	if result.Token != "" {
+		return result.Token, nil
+	}
+	if result.AccessToken != "" {
+		return result.AccessToken, nil
+	}
+	return "", errors.Errorf("token server %q returned no token", realm)
+}
+
+func basicAuth(creds Credentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password))
+}
+
+// parseChallenge parses a WWW-Authenticate header of the form
+// `Bearer realm="...",service="...",scope="..."`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			params[key] = value
+		}
+	}
+	return scheme, params
+}
+
+// tokenCache holds the Authorization header values obtained for each
+// registry and scope.
+type tokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{
+		tokens: make(map[string]string),
+	}
+}
+
+func (t *tokenCache) get(registry, scope string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tokens[registry+" "+scope]
+}
+
+func (t *tokenCache) set(registry, scope, authorization string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tokens[registry+" "+scope] = authorization
+}
@@ -0,0 +1,595 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ocicharm
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/charmsignature"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// CharmArtifactType is the artifact type of a charm archive.
+	CharmArtifactType = "application/vnd.juju.charm.v1"
+
+	// BundleArtifactType is the artifact type of a bundle archive.
+	BundleArtifactType = "application/vnd.juju.bundle.v1"
+
+	// CharmLayerMediaType is the media type of the layer holding a charm
+	// archive.
+	CharmLayerMediaType = "application/vnd.juju.charm.layer.v1+zip"
+
+	// BundleLayerMediaType is the media type of the layer holding a bundle
+	// archive.
+	BundleLayerMediaType = "application/vnd.juju.bundle.layer.v1+zip"
+
+	// RevisionAnnotation is the manifest annotation holding the charm or
+	// bundle revision.
+	RevisionAnnotation = "io.juju.charm.revision"
+
+	// ManifestMediaType is the media type of an OCI image manifest.
+	ManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+
+	// CosignPayloadMediaType is the media type of the layers of a cosign
+	// signature manifest, each holding a signed payload.
+	CosignPayloadMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	// CosignSignatureAnnotation is the layer annotation holding the base64
+	// encoded cosign signature over the payload of the layer.
+	CosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// dockerManifestMediaType is the media type of a Docker image manifest,
+	// which older versions of cosign push signatures as.
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+const (
+	// ArtifactNotFound is returned when the requested manifest or blob does
+	// not exist in the registry.
+	ArtifactNotFound = errors.ConstError("oci artifact not found")
+
+	// InvalidArtifact is returned when a manifest does not describe a charm
+	// or bundle artifact.
+	InvalidArtifact = errors.ConstError("invalid oci artifact")
+
+	// DigestMismatch is returned when downloaded content does not match the
+	// digest it was requested by.
+	DigestMismatch = errors.ConstError("oci digest mismatch")
+)
+
+// ArtifactType describes the kind of archive an artifact holds.
+type ArtifactType string
+
+const (
+	// CharmArtifact is an artifact holding a charm archive.
+	CharmArtifact ArtifactType = "charm"
+
+	// BundleArtifact is an artifact holding a bundle archive.
+	BundleArtifact ArtifactType = "bundle"
+)
+
+// HTTPClient defines a type for making the actual request. It may be an
+// *http.Client.
+type HTTPClient interface {
+	// Do performs the *http.Request and returns an *http.Response or an error.
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Credentials holds the credentials used to authenticate with a registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// IsZero reports whether no credentials are set.
+func (c Credentials) IsZero() bool {
+	return c.Username == "" && c.Password == ""
+}
+
+// CredentialsFunc returns the credentials for the given registry. If the
+// registry requires no credentials, the zero value is returned.
+type CredentialsFunc func(ctx context.Context, registry string) (Credentials, error)
+
+// Config holds the configuration of a [Client].
+type Config struct {
+	// HTTPClient is used to make requests to the registries.
+	HTTPClient HTTPClient
+
+	// Credentials returns the credentials for a registry. It may be nil
+	// for anonymous access.
+	Credentials CredentialsFunc
+
+	// PlainHTTP uses http rather than https to talk to registries. It is
+	// only intended for testing.
+	PlainHTTP bool
+
+	Logger logger.Logger
+}
+
+// Validate returns an error if the config is not valid.
+func (c Config) Validate() error {
+	if c.HTTPClient == nil {
+		return errors.Errorf("nil HTTPClient").Add(coreerrors.NotValid)
+	}
+	if c.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// Descriptor describes content addressed by digest.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// Artifact describes a resolved charm or bundle artifact.
+type Artifact struct {
+	// Reference is the reference the artifact was resolved from, pinned to
+	// the manifest digest.
+	Reference Reference
+
+	// Type is the kind of archive the artifact holds.
+	Type ArtifactType
+
+	// Revision is the revision from the [RevisionAnnotation]. It is -1
+	// if the annotation is absent.
+	Revision int
+
+	// Archive describes the layer holding the archive. Its digest is the
+	// SHA256 of the archive itself.
+	Archive Descriptor
+
+	// Annotations are the manifest annotations.
+	Annotations map[string]string
+}
+
+// ArchiveReference returns a reference to the archive blob of the artifact.
+func (a Artifact) ArchiveReference() Reference {
+	return Reference{
+		Registry:   a.Reference.Registry,
+		Repository: a.Reference.Repository,
+		Digest:     a.Archive.Digest,
+	}
+}
+
+// ArchiveSHA256 returns the hex encoded SHA256 of the archive.
+func (a Artifact) ArchiveSHA256() string {
+	return strings.TrimPrefix(a.Archive.Digest, "sha256:")
+}
+
+// Client pulls charm and bundle artifacts from OCI registries.
+type Client struct {
+	httpClient  HTTPClient
+	credentials CredentialsFunc
+	scheme      string
+	tokens      *tokenCache
+	logger      logger.Logger
+}
+
+// NewClient returns a new client for the given config.
+func NewClient(config Config) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	credentials := config.Credentials
+	if credentials == nil {
+		credentials = func(context.Context, string) (Credentials, error) {
+			return Credentials{}, nil
+		}
+	}
+	scheme := "https"
+	if config.PlainHTTP {
+		scheme = "http"
+	}
+	return &Client{
+		httpClient:  config.HTTPClient,
+		credentials: credentials,
+		scheme:      scheme,
+		tokens:      newTokenCache(),
+		logger:      config.Logger,
+	}, nil
+}
+
+// Resolve resolves the reference to a charm or bundle artifact. If the
+// reference is pinned by digest, the manifest is verified against it.
+func (c *Client) Resolve(ctx context.Context, ref Reference) (_ Artifact, err error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc(), trace.WithAttributes(
+		trace.StringAttr("oci.reference", ref.String()),
+	))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	m, digest, err := c.fetchManifest(ctx, ref, ManifestMediaType)
+	if err != nil {
+		return Artifact{}, errors.Capture(err)
+	}
+	artifact, err := artifactFromManifest(m)
+	if err != nil {
+		return Artifact{}, errors.Errorf("manifest for %q: %w", ref, err)
+	}
+	artifact.Reference = ref.WithDigest(digest)
+	return artifact, nil
+}
+
+// Signatures returns the cosign signatures of the manifest the reference is
+// pinned to, as pushed by "cosign sign --key" to the "sha256-<hex>.sig" tag
+// of the repository. A manifest that isn't signed has no signatures. The
+// signatures aren't verified; that is left to the caller.
+func (c *Client) Signatures(ctx context.Context, ref Reference) (_ []charmsignature.CosignSignature, err error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc(), trace.WithAttributes(
+		trace.StringAttr("oci.reference", ref.String()),
+	))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	hexDigest, ok := strings.CutPrefix(ref.Digest, "sha256:")
+	if !ok {
+		return nil, errors.Errorf("signatures of %q: reference requires a sha256 digest", ref).Add(coreerrors.NotValid)
+	}
+
+	sigRef := ref.WithTag("sha256-" + hexDigest + ".sig")
+	m, _, err := c.fetchManifest(ctx, sigRef, ManifestMediaType+", "+dockerManifestMediaType)
+	if errors.Is(err, ArtifactNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var signatures []charmsignature.CosignSignature
+	for _, layer := range m.Layers {
+		encoded, ok := layer.Annotations[CosignSignatureAnnotation]
+		if !ok || layer.MediaType != CosignPayloadMediaType {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Errorf("decoding signature of %q: %w", ref, err).Add(InvalidArtifact)
+		}
+		payload, err := c.fetchBlob(ctx, sigRef.WithDigest(layer.Digest), maxSignaturePayloadSize)
+		if err != nil {
+			return nil, errors.Errorf("fetching signature payload of %q: %w", ref, err)
+		}
+		signatures = append(signatures, charmsignature.CosignSignature{
+			Payload:   payload,
+			Signature: signature,
+		})
+	}
+	return signatures, nil
+}
+
+// fetchManifest fetches and decodes the manifest the reference refers to,
+// returning it with its digest. If the reference is pinned by digest, the
+// manifest is verified against it.
+func (c *Client) fetchManifest(ctx context.Context, ref Reference, accept string) (manifest, string, error) {
+	body, err := c.get(ctx, ref, "manifests/"+ref.reference(), accept)
+	if err != nil {
+		return manifest{}, "", errors.Errorf("fetching manifest for %q: %w", ref, err)
+	}
+	defer func() { _ = body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxManifestSize+1))
+	if err != nil {
+		return manifest{}, "", errors.Errorf("reading manifest for %q: %w", ref, err)
+	}
+	if len(data) > maxManifestSize {
+		return manifest{}, "", errors.Errorf("manifest for %q exceeds %d bytes", ref, maxManifestSize).Add(InvalidArtifact)
+	}
+
+	digest := digestOf(data)
+	if ref.Digest != "" && ref.Digest != digest {
+		return manifest{}, "", errors.Errorf("manifest for %q has digest %q", ref, digest).Add(DigestMismatch)
+	}
+
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return manifest{}, "", errors.Errorf("decoding manifest for %q: %w", ref, err).Add(InvalidArtifact)
+	}
+	return m, digest, nil
+}
+
+// fetchBlob reads the small blob with the digest of the reference into
+// memory, verifying the content against the digest.
+func (c *Client) fetchBlob(ctx context.Context, ref Reference, maxSize int64) ([]byte, error) {
+	body, err := c.get(ctx, ref, "blobs/"+ref.Digest, "")
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.Errorf("blob %q exceeds %d bytes", ref, maxSize).Add(InvalidArtifact)
+	}
+	if digest := digestOf(data); digest != ref.Digest {
+		return nil, errors.Errorf("blob %q has digest %s", ref, digest).Add(DigestMismatch)
+	}
+	return data, nil
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func artifactFromManifest(m manifest) (Artifact, error) {
+	if m.MediaType != "" && m.MediaType != ManifestMediaType {
+		return Artifact{}, errors.Errorf("unsupported manifest media type %q", m.MediaType).Add(InvalidArtifact)
+	}
+
+	// Registries that predate artifact types carry the type in the config
+	// media type instead.
+	artifactType := m.ArtifactType
+	if artifactType == "" {
+		artifactType = m.Config.MediaType
+	}
+
+	var (
+		kind           ArtifactType
+		layerMediaType string
+	)
+	switch artifactType {
+	case CharmArtifactType:
+		kind, layerMediaType = CharmArtifact, CharmLayerMediaType
+	case BundleArtifactType:
+		kind, layerMediaType = BundleArtifact, BundleLayerMediaType
+	default:
+		return Artifact{}, errors.Errorf("unexpected artifact type %q", artifactType).Add(InvalidArtifact)
+	}
+
+	var layers []Descriptor
+	for _, layer := range m.Layers {
+		if layer.MediaType == layerMediaType {
+			layers = append(layers, layer)
+		}
+	}
+	if len(layers) != 1 {
+		return Artifact{}, errors.Errorf("expected one %q layer, found %d", layerMediaType, len(layers)).Add(InvalidArtifact)
+	}
+	if !digestRegexp.MatchString(layers[0].Digest) {
+		return Artifact{}, errors.Errorf("layer has unsupported digest %q", layers[0].Digest).Add(InvalidArtifact)
+	}
+
+	revision := -1
+	if value, ok := m.Annotations[RevisionAnnotation]; ok {
+		var err error
+		if revision, err = strconv.Atoi(value); err != nil || revision < 0 {
+			return Artifact{}, errors.Errorf("invalid %s annotation %q", RevisionAnnotation, value).Add(InvalidArtifact)
+		}
+	}
+
+	return Artifact{
+		Type:        kind,
+		Revision:    revision,
+		Archive:     layers[0],
+		Annotations: m.Annotations,
+	}, nil
+}
+
+// Tags returns the tags of the repository referred to by ref.
+func (c *Client) Tags(ctx context.Context, ref Reference) (_ []string, err error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc(), trace.WithAttributes(
+		trace.StringAttr("oci.repository", ref.Locator()),
+	))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	var tags []string
+	path := "tags/list"
+	for path != "" {
+		resp, err := c.do(ctx, ref, path, "application/json")
+		if err != nil {
+			return nil, errors.Errorf("listing tags for %q: %w", ref.Locator(), err)
+		}
+		var result struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, errors.Errorf("decoding tags for %q: %w", ref.Locator(), err)
+		}
+		tags = append(tags, result.Tags...)
+		path = nextPage(resp.Header.Get("Link"))
+	}
+	return tags, nil
+}
+
+// nextPage returns the tags/list path of the next page from an RFC 5988
+// Link header, or an empty string if there are no more pages.
+func nextPage(link string) string {
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end <= start {
+		return ""
+	}
+	next, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return ""
+	}
+	i := strings.Index(next.Path, "/tags/list")
+	if i < 0 {
+		return ""
+	}
+	path := strings.TrimPrefix(next.Path[i:], "/")
+	if next.RawQuery != "" {
+		path += "?" + next.RawQuery
+	}
+	return path
+}
+
+// Download downloads the blob referred to by an "oci://" URL pinned by
+// digest to the given path. It satisfies the download client used to fetch
+// charm archives, so that archives from registries are verified and stored
+// the same way as archives from Charmhub.
+func (c *Client) Download(ctx context.Context, resourceURL *url.URL, archivePath string, _ ...charmhub.DownloadOption) (*charmhub.Digest, error) {
+	ref, err := ParseReference(resourceURL.String())
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return c.DownloadBlob(ctx, ref, archivePath)
+}
+
+// DownloadBlob downloads the blob with the digest of the reference to the
+// given path, verifying the content against the digest.
+func (c *Client) DownloadBlob(ctx context.Context, ref Reference, archivePath string) (_ *charmhub.Digest, err error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc(), trace.WithAttributes(
+		trace.StringAttr("oci.reference", ref.String()),
+	))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	if ref.Digest == "" {
+		return nil, errors.Errorf("downloading %q: blob reference requires a digest", ref).Add(coreerrors.NotValid)
+	}
+
+	body, err := c.get(ctx, ref, "blobs/"+ref.Digest, "")
+	if err != nil {
+		return nil, errors.Errorf("fetching blob %q: %w", ref, err)
+	}
+	defer func() { _ = body.Close() }()
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	hasher256 := sha256.New()
+	hasher384 := sha512.New384()
+	size, err := io.Copy(f, io.TeeReader(body, io.MultiWriter(hasher256, hasher384)))
+	if err != nil {
+		return nil, errors.Errorf("downloading blob %q: %w", ref, err)
+	}
+
+	digest := &charmhub.Digest{
+		SHA256: hex.EncodeToString(hasher256.Sum(nil)),
+		SHA384: hex.EncodeToString(hasher384.Sum(nil)),
+		Size:   size,
+	}
+	if "sha256:"+digest.SHA256 != ref.Digest {
+		return nil, errors.Errorf("blob %q has digest sha256:%s", ref, digest.SHA256).Add(DigestMismatch)
+	}
+	return digest, nil
+}
+
+const (
+	// maxManifestSize is the largest manifest the client will read.
+	maxManifestSize = 4 << 20
+
+	// maxSignaturePayloadSize is the largest cosign signature payload the
+	// client will read.
+	maxSignaturePayloadSize = 64 << 10
+)
+
+func (c *Client) get(ctx context.Context, ref Reference, path, accept string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, ref, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// do performs a GET request against the distribution API of the repository,
+// authenticating as required by the registry.
+func (c *Client) do(ctx context.Context, ref Reference, path, accept string) (*http.Response, error) {
+	target := fmt.Sprintf("%s://%s/v2/%s/%s", c.scheme, ref.Registry, ref.Repository, path)
+	scope := "repository:" + ref.Repository + ":pull"
+
+	resp, err := c.send(ctx, target, accept, c.tokens.get(ref.Registry, scope))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		drain(resp)
+
+		authorization, err := c.authorize(ctx, ref.Registry, scope, challenge)
+		if err != nil {
+			return nil, errors.Errorf("authenticating with %q: %w", ref.Registry, err)
+		}
+		if resp, err = c.send(ctx, target, accept, authorization); err != nil {
+			return nil, err
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp, nil
+	case http.StatusNotFound:
+		drain(resp)
+		return nil, errors.Errorf("%s not found", target).Add(ArtifactNotFound)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		drain(resp)
+		return nil, errors.Errorf("access to %s denied: %s", target, resp.Status).Add(coreerrors.Unauthorized)
+	default:
+		drain(resp)
+		return nil, errors.Errorf("unexpected response from %s: %s", target, resp.Status)
+	}
+}
+
+func (c *Client) send(ctx context.Context, target, accept, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	c.logger.Tracef(ctx, "GET %s", target)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return resp, nil
+}
+
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
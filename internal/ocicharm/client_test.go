@@ -0,0 +1,216 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ocicharm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type clientSuite struct {
+	registry *fakeRegistry
+}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) SetUpTest(c *tc.C) {
+	s.registry = newFakeRegistry()
+}
+
+func (s *clientSuite) TearDownTest(c *tc.C) {
+	s.registry.Close()
+}
+
+func (s *clientSuite) TestResolveCharm(c *tc.C) {
+	manifestDigest, archiveDigest := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), map[string]string{
+		RevisionAnnotation: "42",
+	})
+
+	client := s.newClient(c, nil)
+	ref := s.ref(c, "1.0")
+
+	artifact, err := client.Resolve(c.Context(), ref)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(artifact.Type, tc.Equals, CharmArtifact)
+	c.Check(artifact.Revision, tc.Equals, 42)
+	c.Check(artifact.Reference, tc.DeepEquals, ref.WithDigest(manifestDigest))
+	c.Check(artifact.Archive.Digest, tc.Equals, archiveDigest)
+	c.Check(artifact.ArchiveReference().String(), tc.Equals, s.registry.Host()+"/charms/ubuntu@"+archiveDigest)
+}
+
+func (s *clientSuite) TestResolveBundleWithoutRevision(c *tc.C) {
+	s.registry.push("latest", BundleArtifactType, BundleLayerMediaType, []byte("bundle archive"), nil)
+
+	artifact, err := s.newClient(c, nil).Resolve(c.Context(), s.ref(c, "latest"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(artifact.Type, tc.Equals, BundleArtifact)
+	c.Check(artifact.Revision, tc.Equals, -1)
+}
+
+func (s *clientSuite) TestResolveByDigest(c *tc.C) {
+	manifestDigest, _ := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+
+	client := s.newClient(c, nil)
+	ref := s.ref(c, "1.0").WithDigest(manifestDigest)
+	ref.Tag = ""
+
+	artifact, err := client.Resolve(c.Context(), ref)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(artifact.Reference.Digest, tc.Equals, manifestDigest)
+}
+
+func (s *clientSuite) TestResolveDigestMismatch(c *tc.C) {
+	s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+	other, _ := s.registry.push("2.0", CharmArtifactType, CharmLayerMediaType, []byte("other archive"), nil)
+
+	// Point the tag of the pinned reference at different content.
+	s.registry.manifests[other] = s.registry.manifests["1.0"]
+
+	_, err := s.newClient(c, nil).Resolve(c.Context(), s.ref(c, "2.0").WithDigest(other))
+	c.Check(err, tc.ErrorIs, DigestMismatch)
+}
+
+func (s *clientSuite) TestResolveNotCharm(c *tc.C) {
+	s.registry.push("1.0", "application/vnd.oci.image.config.v1+json", "application/vnd.oci.image.layer.v1.tar+gzip", []byte("image"), nil)
+
+	_, err := s.newClient(c, nil).Resolve(c.Context(), s.ref(c, "1.0"))
+	c.Check(err, tc.ErrorIs, InvalidArtifact)
+}
+
+func (s *clientSuite) TestResolveNotFound(c *tc.C) {
+	_, err := s.newClient(c, nil).Resolve(c.Context(), s.ref(c, "missing"))
+	c.Check(err, tc.ErrorIs, ArtifactNotFound)
+}
+
+func (s *clientSuite) TestResolveWithCredentials(c *tc.C) {
+	s.registry.username = "user"
+	s.registry.password = "pass"
+	s.registry.token = "secret-token"
+	s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+
+	var requested []string
+	client := s.newClient(c, func(_ context.Context, registry string) (Credentials, error) {
+		requested = append(requested, registry)
+		return Credentials{Username: "user", Password: "pass"}, nil
+	})
+
+	_, err := client.Resolve(c.Context(), s.ref(c, "1.0"))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The token is cached, so credentials are only requested once.
+	_, err = client.Tags(c.Context(), s.ref(c, "1.0"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(requested, tc.DeepEquals, []string{s.registry.Host()})
+}
+
+func (s *clientSuite) TestResolveWithoutCredentials(c *tc.C) {
+	s.registry.username = "user"
+	s.registry.password = "pass"
+	s.registry.token = "secret-token"
+
+	_, err := s.newClient(c, nil).Resolve(c.Context(), s.ref(c, "1.0"))
+	c.Check(err, tc.ErrorMatches, `.*requesting token .*401 Unauthorized`)
+}
+
+func (s *clientSuite) TestTags(c *tc.C) {
+	s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("a"), nil)
+	s.registry.push("1.1", CharmArtifactType, CharmLayerMediaType, []byte("b"), nil)
+
+	tags, err := s.newClient(c, nil).Tags(c.Context(), s.ref(c, "1.0"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(tags, tc.DeepEquals, []string{"1.0", "1.1"})
+}
+
+func (s *clientSuite) TestDownload(c *tc.C) {
+	_, archiveDigest := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+
+	u, err := url.Parse("oci://" + s.registry.Host() + "/charms/ubuntu@" + archiveDigest)
+	c.Assert(err, tc.ErrorIsNil)
+
+	path := filepath.Join(c.MkDir(), "charm.zip")
+	digest, err := s.newClient(c, nil).Download(c.Context(), u, path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check("sha256:"+digest.SHA256, tc.Equals, archiveDigest)
+	c.Check(digest.SHA384, tc.Not(tc.Equals), "")
+	c.Check(digest.Size, tc.Equals, int64(len("charm archive")))
+
+	data, err := os.ReadFile(path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals, "charm archive")
+}
+
+func (s *clientSuite) TestDownloadDigestMismatch(c *tc.C) {
+	_, archiveDigest := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+	s.registry.blobs[archiveDigest] = []byte("tampered")
+
+	ref := s.ref(c, "1.0").WithDigest(archiveDigest)
+	_, err := s.newClient(c, nil).DownloadBlob(c.Context(), ref, filepath.Join(c.MkDir(), "charm.zip"))
+	c.Check(err, tc.ErrorIs, DigestMismatch)
+}
+
+func (s *clientSuite) TestDownloadRequiresDigest(c *tc.C) {
+	_, err := s.newClient(c, nil).DownloadBlob(c.Context(), s.ref(c, "1.0"), filepath.Join(c.MkDir(), "charm.zip"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *clientSuite) TestSignatures(c *tc.C) {
+	manifestDigest, _ := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+	s.registry.sign(manifestDigest, []byte(`{"critical":{}}`), []byte("signature"))
+
+	signatures, err := s.newClient(c, nil).Signatures(c.Context(), s.ref(c, "1.0").WithDigest(manifestDigest))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(signatures, tc.HasLen, 1)
+	c.Check(string(signatures[0].Payload), tc.Equals, `{"critical":{}}`)
+	c.Check(string(signatures[0].Signature), tc.Equals, "signature")
+}
+
+func (s *clientSuite) TestSignaturesUnsigned(c *tc.C) {
+	manifestDigest, _ := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+
+	signatures, err := s.newClient(c, nil).Signatures(c.Context(), s.ref(c, "1.0").WithDigest(manifestDigest))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(signatures, tc.HasLen, 0)
+}
+
+func (s *clientSuite) TestSignaturesPayloadDigestMismatch(c *tc.C) {
+	manifestDigest, _ := s.registry.push("1.0", CharmArtifactType, CharmLayerMediaType, []byte("charm archive"), nil)
+	payloadDigest := s.registry.sign(manifestDigest, []byte(`{"critical":{}}`), []byte("signature"))
+	s.registry.blobs[payloadDigest] = []byte(`{"critical":{"tampered":true}}`)
+
+	_, err := s.newClient(c, nil).Signatures(c.Context(), s.ref(c, "1.0").WithDigest(manifestDigest))
+	c.Assert(err, tc.ErrorIs, DigestMismatch)
+}
+
+func (s *clientSuite) TestSignaturesRequiresDigest(c *tc.C) {
+	_, err := s.newClient(c, nil).Signatures(c.Context(), s.ref(c, "1.0"))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *clientSuite) ref(c *tc.C, tag string) Reference {
+	ref, err := ParseReference("oci://" + s.registry.Host() + "/charms/ubuntu:" + tag)
+	c.Assert(err, tc.ErrorIsNil)
+	return ref
+}
+
+func (s *clientSuite) newClient(c *tc.C, credentials CredentialsFunc) *Client {
+	client, err := NewClient(Config{
+		HTTPClient:  http.DefaultClient,
+		Credentials: credentials,
+		PlainHTTP:   true,
+		Logger:      loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return client
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package ocicharm is a client for pulling charm and bundle archives that are
+// stored as OCI artifacts in a container registry, such as Harbor or Zot.
+//
+// A charm artifact is an OCI image manifest whose artifact type (or config
+// media type, for registries that predate artifact types) is
+// [CharmArtifactType], with a single layer holding the charm archive. Bundles
+// follow the same layout using [BundleArtifactType]. The charm revision is
+// carried in the [RevisionAnnotation] manifest annotation, so that a charm
+// pulled from a registry has the same identity as one pulled from Charmhub.
+//
+// All content is addressed by digest once resolved: tags are resolved to a
+// manifest digest, and the archive layer is downloaded by its digest, which
+// is verified against the downloaded content.
+//
+// Artifacts may be signed with "cosign sign --key", which pushes the
+// signature of a manifest to the "sha256-<hex>.sig" tag of the repository.
+// The client fetches these signatures, but verifying them against trusted
+// keys is left to the charmsignature package.
+package ocicharm
@@ -0,0 +1,151 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ocicharm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// fakeRegistry is a minimal implementation of the OCI distribution API,
+// serving manifests and blobs from memory.
+type fakeRegistry struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	tags      []string
+
+	// username and password, when set, are required via a bearer token
+	// flow.
+	username string
+	password string
+	token    string
+}
+
+func newFakeRegistry() *fakeRegistry {
+	r := &fakeRegistry{
+		manifests: make(map[string][]byte),
+		blobs:     make(map[string][]byte),
+	}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serve))
+	return r
+}
+
+// Host returns the host and port the registry is listening on.
+func (r *fakeRegistry) Host() string {
+	return strings.TrimPrefix(r.URL, "http://")
+}
+
+// push stores an archive as an artifact of the given type, tagged with tag,
+// returning the manifest and archive digests.
+func (r *fakeRegistry) push(tag, artifactType, layerMediaType string, archive []byte, annotations map[string]string) (string, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	archiveDigest := digestOf(archive)
+	r.blobs[archiveDigest] = archive
+
+	data, _ := json.Marshal(manifest{
+		SchemaVersion: 2,
+		MediaType:     ManifestMediaType,
+		ArtifactType:  artifactType,
+		Config: Descriptor{
+			MediaType: "application/vnd.oci.empty.v1+json",
+			Digest:    digestOf([]byte("{}")),
+			Size:      2,
+		},
+		Layers: []Descriptor{{
+			MediaType: layerMediaType,
+			Digest:    archiveDigest,
+			Size:      int64(len(archive)),
+		}},
+		Annotations: annotations,
+	})
+	manifestDigest := digestOf(data)
+	r.manifests[manifestDigest] = data
+	r.manifests[tag] = data
+	r.tags = append(r.tags, tag)
+	return manifestDigest, archiveDigest
+}
+
+// sign stores a cosign signature of the manifest with the given digest, in
+// the same layout as "cosign sign", returning the digest of the payload.
+func (r *fakeRegistry) sign(manifestDigest string, payload, signature []byte) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	payloadDigest := digestOf(payload)
+	r.blobs[payloadDigest] = payload
+
+	data, _ := json.Marshal(manifest{
+		SchemaVersion: 2,
+		MediaType:     ManifestMediaType,
+		Config: Descriptor{
+			MediaType: "application/vnd.oci.image.config.v1+json",
+			Digest:    digestOf([]byte("{}")),
+			Size:      2,
+		},
+		Layers: []Descriptor{{
+			MediaType: CosignPayloadMediaType,
+			Digest:    payloadDigest,
+			Size:      int64(len(payload)),
+			Annotations: map[string]string{
+				CosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+			},
+		}},
+	})
+	r.manifests["sha256-"+strings.TrimPrefix(manifestDigest, "sha256:")+".sig"] = data
+	return payloadDigest
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if req.URL.Path == "/token" {
+		if user, pass, ok := req.BasicAuth(); !ok || user != r.username || pass != r.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": r.token})
+		return
+	}
+
+	if r.username != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="`+r.URL+`/token",service="fake"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	_, rest, _ := strings.Cut(req.URL.Path, "/v2/")
+	switch {
+	case strings.HasSuffix(rest, "/tags/list"):
+		_ = json.NewEncoder(w).Encode(map[string]any{"tags": r.tags})
+	case strings.Contains(rest, "/manifests/"):
+		_, ref, _ := strings.Cut(rest, "/manifests/")
+		data, ok := r.manifests[ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", ManifestMediaType)
+		_, _ = w.Write(data)
+	case strings.Contains(rest, "/blobs/"):
+		_, digest, _ := strings.Cut(rest, "/blobs/")
+		data, ok := r.blobs[digest]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ocicharm
+
+import (
+	"regexp"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// Scheme is the URL scheme used to refer to charms stored in an OCI
+	// registry.
+	Scheme = "oci"
+
+	// DefaultTag is the tag used when a reference has neither a tag nor a
+	// digest.
+	DefaultTag = "latest"
+)
+
+var (
+	pathComponentRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	tagRegexp           = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	digestRegexp        = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Reference identifies an artifact in an OCI registry, in the form
+// registry/namespace/name[:tag][@digest].
+type Reference struct {
+	// Registry is the host, and optional port, of the registry.
+	Registry string
+
+	// Repository is the path of the repository within the registry.
+	Repository string
+
+	// Tag is the tag of the artifact. It is empty if the reference is
+	// pinned by digest only.
+	Tag string
+
+	// Digest is the digest of the artifact. When set, it takes precedence
+	// over the tag.
+	Digest string
+}
+
+// ParseReference parses an OCI reference, with or without the "oci://"
+// prefix. If neither a tag nor a digest is given, the [DefaultTag] is used.
+func ParseReference(s string) (Reference, error) {
+	raw := strings.TrimPrefix(s, Scheme+"://")
+
+	registry, remainder, ok := strings.Cut(raw, "/")
+	if !ok || registry == "" || remainder == "" {
+		return Reference{}, errors.Errorf("reference %q must include a registry and repository", s).Add(coreerrors.NotValid)
+	}
+	if !isRegistryHost(registry) {
+		return Reference{}, errors.Errorf("reference %q has invalid registry %q", s, registry).Add(coreerrors.NotValid)
+	}
+
+	ref := Reference{Registry: registry}
+	if repo, digest, ok := strings.Cut(remainder, "@"); ok {
+		if !digestRegexp.MatchString(digest) {
+			return Reference{}, errors.Errorf("reference %q has invalid digest %q", s, digest).Add(coreerrors.NotValid)
+		}
+		ref.Digest = digest
+		remainder = repo
+	}
+
+	// A colon in the last path component separates the tag.
+	if i := strings.LastIndex(remainder, ":"); i > strings.LastIndex(remainder, "/") {
+		ref.Tag = remainder[i+1:]
+		remainder = remainder[:i]
+		if !tagRegexp.MatchString(ref.Tag) {
+			return Reference{}, errors.Errorf("reference %q has invalid tag %q", s, ref.Tag).Add(coreerrors.NotValid)
+		}
+	}
+	for _, component := range strings.Split(remainder, "/") {
+		if !pathComponentRegexp.MatchString(component) {
+			return Reference{}, errors.Errorf("reference %q has invalid repository %q", s, remainder).Add(coreerrors.NotValid)
+		}
+	}
+	ref.Repository = remainder
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = DefaultTag
+	}
+	return ref, nil
+}
+
+// isRegistryHost reports whether the first path component of a reference is
+// a registry host. As with docker references, it must look like a domain
+// name, include a port, or be localhost.
+func isRegistryHost(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
+
+// Name returns the last component of the repository path, which is used as
+// the charm name.
+func (r Reference) Name() string {
+	return r.Repository[strings.LastIndex(r.Repository, "/")+1:]
+}
+
+// Locator returns the registry and repository, without tag or digest.
+func (r Reference) Locator() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// WithTag returns a copy of the reference using the given tag and no digest.
+func (r Reference) WithTag(tag string) Reference {
+	r.Tag = tag
+	r.Digest = ""
+	return r
+}
+
+// WithDigest returns a copy of the reference pinned to the given digest.
+func (r Reference) WithDigest(digest string) Reference {
+	r.Digest = digest
+	return r
+}
+
+// String returns the reference in the form
+// registry/repository[:tag][@digest].
+func (r Reference) String() string {
+	s := r.Locator()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// URL returns the reference prefixed with the "oci://" scheme.
+func (r Reference) URL() string {
+	return Scheme + "://" + r.String()
+}
+
+// reference returns the tag or digest used to address a manifest, with the
+// digest taking precedence.
+func (r Reference) reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ocicharm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type referenceSuite struct{}
+
+func TestReferenceSuite(t *testing.T) {
+	tc.Run(t, &referenceSuite{})
+}
+
+func (s *referenceSuite) TestParseReference(c *tc.C) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	tests := []struct {
+		input    string
+		expected Reference
+	}{{
+		input: "oci://registry.example.com/charms/ubuntu:22.04",
+		expected: Reference{
+			Registry:   "registry.example.com",
+			Repository: "charms/ubuntu",
+			Tag:        "22.04",
+		},
+	}, {
+		input: "registry.example.com:5000/ubuntu",
+		expected: Reference{
+			Registry:   "registry.example.com:5000",
+			Repository: "ubuntu",
+			Tag:        DefaultTag,
+		},
+	}, {
+		input: "oci://localhost/a/b/ubuntu@" + digest,
+		expected: Reference{
+			Registry:   "localhost",
+			Repository: "a/b/ubuntu",
+			Digest:     digest,
+		},
+	}, {
+		input: "oci://10.0.0.1:5000/ns/ubuntu:stable@" + digest,
+		expected: Reference{
+			Registry:   "10.0.0.1:5000",
+			Repository: "ns/ubuntu",
+			Tag:        "stable",
+			Digest:     digest,
+		},
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.input)
+		ref, err := ParseReference(test.input)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(ref, tc.DeepEquals, test.expected)
+	}
+}
+
+func (s *referenceSuite) TestParseReferenceInvalid(c *tc.C) {
+	for _, input := range []string{
+		"oci://ubuntu",
+		"oci://ns/ubuntu",
+		"oci://registry.example.com/",
+		"oci://registry.example.com/Ubuntu",
+		"oci://registry.example.com/ubuntu:bad/tag",
+		"oci://registry.example.com/ubuntu@sha256:abc",
+		"oci://registry.example.com/ubuntu:.bad",
+	} {
+		c.Logf("input: %s", input)
+		_, err := ParseReference(input)
+		c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+	}
+}
+
+func (s *referenceSuite) TestString(c *tc.C) {
+	ref := Reference{
+		Registry:   "registry.example.com",
+		Repository: "charms/ubuntu",
+		Tag:        "22.04",
+	}
+	c.Check(ref.Name(), tc.Equals, "ubuntu")
+	c.Check(ref.Locator(), tc.Equals, "registry.example.com/charms/ubuntu")
+	c.Check(ref.String(), tc.Equals, "registry.example.com/charms/ubuntu:22.04")
+	c.Check(ref.URL(), tc.Equals, "oci://registry.example.com/charms/ubuntu:22.04")
+
+	digest := "sha256:" + strings.Repeat("b", 64)
+	c.Check(ref.WithDigest(digest).String(), tc.Equals, "registry.example.com/charms/ubuntu:22.04@"+digest)
+	c.Check(ref.WithDigest(digest).WithTag("edge").String(), tc.Equals, "registry.example.com/charms/ubuntu:edge")
+
+	roundTrip, err := ParseReference(ref.WithDigest(digest).URL())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(roundTrip, tc.DeepEquals, ref.WithDigest(digest))
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// ErrDeviceAuthorizationExpired is returned when the user does not
+	// complete a device authorization before it expires.
+	ErrDeviceAuthorizationExpired = errors.ConstError("device authorization expired")
+
+	// ErrDeviceAuthorizationDenied is returned when the user denies a
+	// device authorization.
+	ErrDeviceAuthorizationDenied = errors.ConstError("device authorization denied")
+
+	// deviceCodeGrantType is the grant type used to exchange a device code
+	// for tokens.
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// deviceScope is the scope requested for device authorizations. The
+	// groups scope asks the identity provider to include the groups claim.
+	deviceScope = "openid profile groups"
+
+	// defaultPollInterval is the interval between token requests when the
+	// identity provider does not give one.
+	defaultPollInterval = 5 * time.Second
+
+	// slowDownInterval is added to the polling interval each time the
+	// identity provider asks for token requests to slow down.
+	slowDownInterval = 5 * time.Second
+)
+
+// DeviceAuthorization is a device authorization started with the identity
+// provider, which the user completes by visiting the verification URI and
+// entering the user code.
+type DeviceAuthorization struct {
+	// DeviceCode is the code the controller exchanges for the user's
+	// tokens once the authorization is complete.
+	DeviceCode string
+
+	// UserCode is the code the user enters at the verification URI.
+	UserCode string
+
+	// VerificationURI is the URI the user visits to complete the
+	// authorization.
+	VerificationURI string
+
+	// ExpiresAt is the time after which the authorization can no longer be
+	// completed.
+	ExpiresAt time.Time
+
+	// Interval is the minimum time between token requests.
+	Interval time.Duration
+}
+
+// deviceAuthorizationResponse is the response of the device authorization
+// endpoint, as defined by RFC 8628 section 3.2.
+type deviceAuthorizationResponse struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// tokenResponse is the response of the token endpoint, holding either the
+// tokens or, as defined by RFC 8628 section 3.5, the reason none were
+// issued.
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// StartDeviceAuthorization starts a device authorization with the identity
+// provider.
+// If the provider is not enabled an error satisfying
+// coreerrors.NotProvisioned is returned, and if the identity provider does
+// not support device authorizations an error satisfying
+// coreerrors.NotSupported is returned.
+func (p *Provider) StartDeviceAuthorization(ctx context.Context) (DeviceAuthorization, error) {
+	if !p.Enabled() {
+		return DeviceAuthorization{}, errors.Errorf("oidc identity provider %w", coreerrors.NotProvisioned)
+	}
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return DeviceAuthorization{}, errors.Capture(err)
+	}
+	if doc.DeviceAuthorizationEndpoint == "" {
+		return DeviceAuthorization{}, errors.Errorf("device authorization %w by identity provider", coreerrors.NotSupported)
+	}
+
+	req, err := p.formRequest(ctx, doc.DeviceAuthorizationEndpoint, url.Values{
+		"client_id": {p.config.ClientID},
+		"scope":     {deviceScope},
+	})
+	if err != nil {
+		return DeviceAuthorization{}, errors.Capture(err)
+	}
+	var resp deviceAuthorizationResponse
+	if err := p.do(req, &resp); err != nil {
+		return DeviceAuthorization{}, errors.Errorf("starting device authorization: %w", err)
+	}
+	if resp.DeviceCode == "" || resp.UserCode == "" || resp.VerificationURI == "" {
+		return DeviceAuthorization{}, errors.Errorf("starting device authorization: incomplete response")
+	}
+
+	interval := time.Duration(resp.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	return DeviceAuthorization{
+		DeviceCode:      resp.DeviceCode,
+		UserCode:        resp.UserCode,
+		VerificationURI: resp.VerificationURI,
+		ExpiresAt:       p.config.Clock.Now().Add(time.Duration(resp.ExpiresIn) * time.Second),
+		Interval:        interval,
+	}, nil
+}
+
+// WaitForDeviceToken polls the identity provider until the user completes
+// the device authorization, returning the ID token issued to them.
+// If the authorization expires ErrDeviceAuthorizationExpired is returned,
+// and if the user denies it ErrDeviceAuthorizationDenied is returned.
+func (p *Provider) WaitForDeviceToken(ctx context.Context, auth DeviceAuthorization) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	interval := auth.Interval
+	for {
+		if !p.config.Clock.Now().Before(auth.ExpiresAt) {
+			return "", ErrDeviceAuthorizationExpired
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-p.config.Clock.After(interval):
+		}
+
+		req, err := p.formRequest(ctx, doc.TokenEndpoint, url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {auth.DeviceCode},
+			"client_id":   {p.config.ClientID},
+		})
+		if err != nil {
+			return "", errors.Capture(err)
+		}
+		resp, err := p.requestToken(req)
+		if err != nil {
+			return "", errors.Capture(err)
+		}
+
+		switch resp.Error {
+		case "":
+			if resp.IDToken == "" {
+				return "", errors.Errorf("identity provider did not issue an ID token")
+			}
+			return resp.IDToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownInterval
+		case "expired_token":
+			return "", ErrDeviceAuthorizationExpired
+		case "access_denied":
+			return "", ErrDeviceAuthorizationDenied
+		default:
+			return "", errors.Errorf("requesting device token: %s %s", resp.Error, resp.ErrorDescription)
+		}
+	}
+}
+
+// requestToken makes the token request. Unlike other requests, an error
+// response from the token endpoint is decoded and returned, as it tells the
+// caller how to proceed.
+func (p *Provider) requestToken(req *http.Request) (tokenResponse, error) {
+	httpResp, err := p.config.HTTPClient.Do(req)
+	if err != nil {
+		return tokenResponse{}, errors.Errorf("requesting device token: %w", err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	var resp tokenResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return tokenResponse{}, errors.Errorf("requesting device token: unexpected status %q", httpResp.Status)
+	}
+	if httpResp.StatusCode != http.StatusOK && resp.Error == "" {
+		return tokenResponse{}, errors.Errorf("requesting device token: unexpected status %q", httpResp.Status)
+	}
+	return resp, nil
+}
+
+// formRequest returns a request posting the form values to the endpoint.
+func (p *Provider) formRequest(ctx context.Context, endpoint string, values url.Values) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type deviceSuite struct {
+	idp      *fakeIdentityProvider
+	clock    *testclock.Clock
+	provider *Provider
+}
+
+func TestDeviceSuite(t *testing.T) {
+	tc.Run(t, &deviceSuite{})
+}
+
+func (s *deviceSuite) SetUpTest(c *tc.C) {
+	s.idp = newFakeIdentityProvider(c)
+	s.clock = testclock.NewClock(time.Now())
+
+	var err error
+	s.provider, err = NewProvider(c.Context(), Config{
+		IssuerURL:  s.idp.server.URL,
+		ClientID:   "juju",
+		HTTPClient: http.DefaultClient,
+		Clock:      s.clock,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *deviceSuite) TestStartDeviceAuthorization(c *tc.C) {
+	auth, err := s.provider.StartDeviceAuthorization(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(auth, tc.DeepEquals, DeviceAuthorization{
+		DeviceCode:      "device-code",
+		UserCode:        "ABCD-EFGH",
+		VerificationURI: s.idp.server.URL + "/activate",
+		ExpiresAt:       s.clock.Now().Add(10 * time.Minute),
+		Interval:        time.Second,
+	})
+	c.Check(s.idp.deviceRequest["client_id"], tc.DeepEquals, []string{"juju"})
+	c.Check(s.idp.deviceRequest["scope"], tc.DeepEquals, []string{"openid profile groups"})
+}
+
+func (s *deviceSuite) TestStartDeviceAuthorizationNotSupported(c *tc.C) {
+	s.idp.noDeviceAuthorization = true
+
+	_, err := s.provider.StartDeviceAuthorization(c.Context())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *deviceSuite) TestWaitForDeviceToken(c *tc.C) {
+	s.idp.tokenResponses = []tokenResponse{
+		{Error: "authorization_pending"},
+		{Error: "slow_down"},
+		{IDToken: "id-token"},
+	}
+	auth, err := s.provider.StartDeviceAuthorization(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	result := s.waitForDeviceToken(c, auth)
+	// The first two requests are made at the interval given by the
+	// identity provider, the last after slowing down.
+	s.advance(c, time.Second)
+	s.advance(c, time.Second)
+	s.advance(c, 6*time.Second)
+
+	select {
+	case r := <-result:
+		c.Assert(r.err, tc.ErrorIsNil)
+		c.Check(r.token, tc.Equals, "id-token")
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for device token")
+	}
+	c.Check(s.idp.tokenRequests, tc.Equals, 3)
+}
+
+func (s *deviceSuite) TestWaitForDeviceTokenDenied(c *tc.C) {
+	s.idp.tokenResponses = []tokenResponse{{Error: "access_denied"}}
+	auth, err := s.provider.StartDeviceAuthorization(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	result := s.waitForDeviceToken(c, auth)
+	s.advance(c, time.Second)
+
+	select {
+	case r := <-result:
+		c.Assert(r.err, tc.ErrorIs, ErrDeviceAuthorizationDenied)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for device token")
+	}
+}
+
+func (s *deviceSuite) TestWaitForDeviceTokenExpired(c *tc.C) {
+	s.idp.tokenResponses = []tokenResponse{{Error: "authorization_pending"}}
+	auth, err := s.provider.StartDeviceAuthorization(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	auth.ExpiresAt = s.clock.Now().Add(2 * time.Second)
+
+	result := s.waitForDeviceToken(c, auth)
+	s.advance(c, time.Second)
+	s.advance(c, time.Second)
+
+	select {
+	case r := <-result:
+		c.Assert(r.err, tc.ErrorIs, ErrDeviceAuthorizationExpired)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for device token")
+	}
+	c.Check(s.idp.tokenRequests, tc.Equals, 2)
+}
+
+type tokenResult struct {
+	token string
+	err   error
+}
+
+func (s *deviceSuite) waitForDeviceToken(c *tc.C, auth DeviceAuthorization) <-chan tokenResult {
+	result := make(chan tokenResult, 1)
+	go func() {
+		token, err := s.provider.WaitForDeviceToken(c.Context(), auth)
+		result <- tokenResult{token: token, err: err}
+	}()
+	return result
+}
+
+// advance advances the clock once the provider is waiting to poll.
+func (s *deviceSuite) advance(c *tc.C, d time.Duration) {
+	err := s.clock.WaitAdvance(d, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package oidc provides the controller's view of an OpenID Connect
+// identity provider. It verifies the ID tokens users log in with, maps the
+// groups they hold at the identity provider to Juju user groups, and runs
+// the OAuth 2.0 device authorization grant (RFC 8628) on behalf of clients
+// which cannot open a browser themselves.
+package oidc
@@ -0,0 +1,120 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+)
+
+// fakeIdentityProvider is an OpenID Connect identity provider serving its
+// configuration, keys, and device authorization and token endpoints.
+type fakeIdentityProvider struct {
+	server     *httptest.Server
+	signingKey jwk.Key
+	publicKeys jwk.Set
+
+	noDeviceAuthorization bool
+
+	mu sync.Mutex
+	// tokenResponses are returned in turn by the token endpoint, the
+	// last being repeated.
+	tokenResponses []tokenResponse
+	tokenRequests  int
+	deviceRequest  map[string][]string
+}
+
+func newFakeIdentityProvider(c *tc.C) *fakeIdentityProvider {
+	rawKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	c.Assert(err, tc.ErrorIsNil)
+	signingKey, err := jwk.Import(rawKey)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(signingKey.Set(jwk.KeyIDKey, "test-key"), tc.ErrorIsNil)
+	c.Assert(signingKey.Set(jwk.AlgorithmKey, jwa.RS256()), tc.ErrorIsNil)
+	publicKey, err := signingKey.PublicKey()
+	c.Assert(err, tc.ErrorIsNil)
+	publicKeys := jwk.NewSet()
+	c.Assert(publicKeys.AddKey(publicKey), tc.ErrorIsNil)
+
+	idp := &fakeIdentityProvider{
+		signingKey: signingKey,
+		publicKeys: publicKeys,
+	}
+	idp.server = httptest.NewServer(http.HandlerFunc(idp.serveHTTP))
+	c.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *fakeIdentityProvider) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	switch r.URL.Path {
+	case discoveryPath:
+		doc := discoveryDocument{
+			Issuer:        idp.server.URL,
+			JWKSURI:       idp.server.URL + "/keys",
+			TokenEndpoint: idp.server.URL + "/token",
+		}
+		if !idp.noDeviceAuthorization {
+			doc.DeviceAuthorizationEndpoint = idp.server.URL + "/device"
+		}
+		_ = json.NewEncoder(w).Encode(doc)
+	case "/keys":
+		_ = json.NewEncoder(w).Encode(idp.publicKeys)
+	case "/device":
+		_ = r.ParseForm()
+		idp.deviceRequest = r.PostForm
+		_ = json.NewEncoder(w).Encode(deviceAuthorizationResponse{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: idp.server.URL + "/activate",
+			ExpiresIn:       600,
+			Interval:        1,
+		})
+	case "/token":
+		_ = r.ParseForm()
+		if r.PostForm.Get("device_code") != "device-code" {
+			http.Error(w, "unknown device code", http.StatusBadRequest)
+			return
+		}
+		resp := idp.tokenResponses[min(idp.tokenRequests, len(idp.tokenResponses)-1)]
+		idp.tokenRequests++
+		if resp.Error != "" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// token returns a signed token with the given claims in addition to those
+// of a valid ID token issued by the identity provider.
+func (idp *fakeIdentityProvider) token(c *tc.C, now time.Time, claims map[string]any) string {
+	builder := jwt.NewBuilder().
+		Issuer(idp.server.URL).
+		Audience([]string{"juju"}).
+		Subject("1234").
+		IssuedAt(now).
+		Expiration(now.Add(time.Hour))
+	for k, v := range claims {
+		builder = builder.Claim(k, v)
+	}
+	token, err := builder.Build()
+	c.Assert(err, tc.ErrorIsNil)
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.RS256(), idp.signingKey))
+	c.Assert(err, tc.ErrorIsNil)
+	return string(signed)
+}
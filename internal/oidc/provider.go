@@ -0,0 +1,335 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/juju/clock"
+	"github.com/juju/names/v6"
+	"github.com/lestrrat-go/httprc/v3"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// ErrNotIssued is returned when verifying a token which was not issued
+	// by the identity provider, so that it can be handed to other
+	// authenticators.
+	ErrNotIssued = errors.ConstError("token not issued by identity provider")
+
+	// ErrTokenInvalid is returned when a token issued by the identity
+	// provider cannot be verified, or does not identify a Juju user.
+	ErrTokenInvalid = errors.ConstError("identity provider token not valid")
+
+	discoveryPath = "/.well-known/openid-configuration"
+)
+
+// HTTPClient is the interface used to make requests to the identity
+// provider.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Config holds the configuration of the identity provider.
+type Config struct {
+	// IssuerURL is the URL of the identity provider, as it appears in the
+	// iss claim of the tokens it issues. The provider is not enabled if
+	// the URL is empty.
+	IssuerURL string
+
+	// ClientID is the client ID the controller is registered with at the
+	// identity provider.
+	ClientID string
+
+	// GroupsClaim is the name of the ID token claim holding the groups the
+	// user is a member of.
+	GroupsClaim string
+
+	// GroupMapping maps the groups of the identity provider to Juju user
+	// groups. Groups which are not mapped are ignored.
+	GroupMapping map[string]string
+
+	// HTTPClient is used to make requests to the identity provider.
+	HTTPClient HTTPClient
+
+	// Clock is used to validate token expiry and to pace the polling of
+	// device authorizations.
+	Clock clock.Clock
+}
+
+// Identity is the Juju identity of a user authenticated by the identity
+// provider.
+type Identity struct {
+	// User is the tag of the Juju user. The user is identified by the
+	// issuer and the subject of the token: the user name is the subject
+	// and the domain is derived from the issuer URL.
+	User names.UserTag
+
+	// Groups are the Juju user groups the user is a member of.
+	Groups []string
+}
+
+// discoveryDocument holds the fields of the identity provider's
+// configuration document used by the controller.
+type discoveryDocument struct {
+	Issuer                      string `json:"issuer"`
+	JWKSURI                     string `json:"jwks_uri"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// Provider verifies tokens issued by an OpenID Connect identity provider
+// and runs device authorizations against it.
+type Provider struct {
+	config Config
+	cache  *jwk.Cache
+
+	// userDomain is the domain of the Juju users which log in with an ID
+	// token of the identity provider.
+	userDomain string
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+}
+
+// NewProvider returns a new Provider for the identity provider with the
+// given configuration. The provider holds a key cache with routines that
+// terminate when the context is done.
+func NewProvider(ctx context.Context, config Config) (*Provider, error) {
+	if config.HTTPClient == nil {
+		return nil, errors.Errorf("nil HTTPClient").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return nil, errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	if config.IssuerURL != "" && config.ClientID == "" {
+		return nil, errors.Errorf("empty ClientID").Add(coreerrors.NotValid)
+	}
+	config.IssuerURL = strings.TrimSuffix(config.IssuerURL, "/")
+	var userDomain string
+	if config.IssuerURL != "" {
+		var err error
+		if userDomain, err = issuerUserDomain(config.IssuerURL); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+
+	cache, err := jwk.NewCache(ctx, httprc.NewClient(httprc.WithHTTPClient(config.HTTPClient)))
+	if err != nil {
+		return nil, errors.Errorf("creating key cache: %w", err)
+	}
+	return &Provider{
+		config:     config,
+		cache:      cache,
+		userDomain: userDomain,
+	}, nil
+}
+
+// issuerUserDomain returns the domain of the Juju users of the identity
+// provider with the given issuer URL. It is made of the host, port and
+// path of the URL, joined with "+", eg "idp.example.com+realms+juju" for
+// "https://idp.example.com/realms/juju".
+func issuerUserDomain(issuerURL string) (string, error) {
+	u, err := url.Parse(issuerURL)
+	if err != nil || u.Host == "" {
+		return "", errors.Errorf("issuer URL %q %w", issuerURL, coreerrors.NotValid)
+	}
+	parts := []string{u.Hostname()}
+	if port := u.Port(); port != "" {
+		parts = append(parts, port)
+	}
+	for _, segment := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		if segment != "" {
+			parts = append(parts, segment)
+		}
+	}
+	domain := strings.Join(parts, "+")
+	if !names.IsValidUserDomain(domain) {
+		return "", errors.Errorf("user domain %q of issuer URL %q %w", domain, issuerURL, coreerrors.NotValid)
+	}
+	return domain, nil
+}
+
+// Enabled returns whether users can log in with the identity provider.
+func (p *Provider) Enabled() bool {
+	return p != nil && p.config.IssuerURL != ""
+}
+
+// Verify verifies the given compact serialized ID token, returning the
+// identity of the user it was issued to.
+// If the provider is not enabled an error satisfying
+// coreerrors.NotProvisioned is returned. If the token was not issued by the
+// identity provider ErrNotIssued is returned, and if it cannot be verified
+// an error satisfying ErrTokenInvalid is returned.
+func (p *Provider) Verify(ctx context.Context, rawToken string) (Identity, error) {
+	if !p.Enabled() {
+		return Identity{}, errors.Errorf("oidc identity provider %w", coreerrors.NotProvisioned)
+	}
+
+	// Check who issued the token before fetching any keys, so that tokens
+	// meant for other authenticators are passed over cheaply.
+	unverified, err := jwt.ParseInsecure([]byte(rawToken))
+	if err != nil {
+		return Identity{}, ErrNotIssued
+	}
+	if issuer, _ := unverified.Issuer(); strings.TrimSuffix(issuer, "/") != p.config.IssuerURL {
+		return Identity{}, ErrNotIssued
+	}
+
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return Identity{}, errors.Capture(err)
+	}
+	keySet, err := p.cache.Lookup(ctx, doc.JWKSURI)
+	if err != nil {
+		return Identity{}, errors.Errorf("fetching identity provider keys: %w", err)
+	}
+
+	token, err := jwt.Parse([]byte(rawToken),
+		jwt.WithKeySet(keySet, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithClock(jwt.ClockFunc(p.config.Clock.Now)),
+	)
+	if err != nil {
+		return Identity{}, errors.Errorf("%w: %w", ErrTokenInvalid, err)
+	}
+	return p.identity(token)
+}
+
+// identity returns the Juju identity held in the verified token. The user
+// is identified by the issuer and subject of the token, which the identity
+// provider guarantees to be unique and never reassigned, rather than by
+// claims such as preferred_username which users may be able to change.
+// Only the groups mapped to Juju user groups are included.
+func (p *Provider) identity(token jwt.Token) (Identity, error) {
+	subject, _ := token.Subject()
+	if subject == "" {
+		return Identity{}, errors.Errorf("%w: no subject", ErrTokenInvalid)
+	}
+	userName := subject
+	if !names.IsValidUserName(userName) {
+		// The subject may hold characters which aren't valid in a user
+		// name, so such subjects are identified by their hash.
+		sum := sha256.Sum256([]byte(subject))
+		userName = "sub-" + hex.EncodeToString(sum[:20])
+	}
+
+	claimed, err := p.claimedGroups(token)
+	if err != nil {
+		return Identity{}, errors.Capture(err)
+	}
+	var groups []string
+	for _, group := range claimed {
+		if mapped, ok := p.config.GroupMapping[group]; ok {
+			groups = append(groups, mapped)
+		}
+	}
+
+	return Identity{
+		User:   names.NewUserTag(userName + "@" + p.userDomain),
+		Groups: groups,
+	}, nil
+}
+
+// claimedGroups returns the groups of the identity provider held in the
+// groups claim of the token, which may be a list of names or a single name.
+func (p *Provider) claimedGroups(token jwt.Token) ([]string, error) {
+	claim := p.groupsClaim()
+	if !token.Has(claim) {
+		return nil, nil
+	}
+	var value any
+	if err := token.Get(claim, &value); err != nil {
+		return nil, errors.Errorf("%w: reading %q claim: %w", ErrTokenInvalid, claim, err)
+	}
+	switch value := value.(type) {
+	case string:
+		return []string{value}, nil
+	case []any:
+		groups := make([]string, len(value))
+		for i, v := range value {
+			group, ok := v.(string)
+			if !ok {
+				return nil, errors.Errorf("%w: %q claim holds %T, expected string", ErrTokenInvalid, claim, v)
+			}
+			groups[i] = group
+		}
+		return groups, nil
+	default:
+		return nil, errors.Errorf("%w: %q claim holds %T, expected list of strings", ErrTokenInvalid, claim, value)
+	}
+}
+
+func (p *Provider) groupsClaim() string {
+	if p.config.GroupsClaim == "" {
+		return "groups"
+	}
+	return p.config.GroupsClaim
+}
+
+// discover returns the configuration document of the identity provider,
+// fetching it and registering its keys with the cache the first time it is
+// needed.
+func (p *Provider) discover(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.IssuerURL+discoveryPath, nil)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var doc discoveryDocument
+	if err := p.do(req, &doc); err != nil {
+		return nil, errors.Errorf("discovering identity provider configuration: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.config.IssuerURL {
+		return nil, errors.Errorf("identity provider issuer %q does not match %q", doc.Issuer, p.config.IssuerURL)
+	}
+	if doc.JWKSURI == "" {
+		return nil, errors.Errorf("identity provider configuration has no jwks_uri")
+	}
+
+	if !p.cache.IsRegistered(ctx, doc.JWKSURI) {
+		if err := p.cache.Register(ctx, doc.JWKSURI, jwk.WithHTTPClient(p.config.HTTPClient)); err != nil {
+			return nil, errors.Errorf("registering identity provider keys %q: %w", doc.JWKSURI, err)
+		}
+	}
+	p.discovery = &doc
+	return p.discovery, nil
+}
+
+// do makes the request to the identity provider, decoding the JSON
+// response into out.
+func (p *Provider) do(req *http.Request, out any) error {
+	resp, err := p.config.HTTPClient.Do(req)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("%s %s: unexpected status %q", req.Method, req.URL, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Errorf("decoding response from %s: %w", req.URL, err)
+	}
+	return nil
+}
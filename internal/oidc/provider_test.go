@@ -0,0 +1,194 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type providerSuite struct {
+	idp   *fakeIdentityProvider
+	clock *testclock.Clock
+}
+
+func TestProviderSuite(t *testing.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) SetUpTest(c *tc.C) {
+	s.idp = newFakeIdentityProvider(c)
+	s.clock = testclock.NewClock(time.Now())
+}
+
+func (s *providerSuite) newProvider(c *tc.C, issuerURL string) *Provider {
+	p, err := NewProvider(c.Context(), Config{
+		IssuerURL:    issuerURL,
+		ClientID:     "juju",
+		GroupsClaim:  "groups",
+		GroupMapping: map[string]string{"platform-admins": "admins"},
+		HTTPClient:   http.DefaultClient,
+		Clock:        s.clock,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return p
+}
+
+func (s *providerSuite) TestNewProviderConfigNotValid(c *tc.C) {
+	_, err := NewProvider(c.Context(), Config{
+		IssuerURL:  s.idp.server.URL,
+		HTTPClient: http.DefaultClient,
+		Clock:      s.clock,
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *providerSuite) TestNotEnabled(c *tc.C) {
+	p := s.newProvider(c, "")
+	c.Check(p.Enabled(), tc.IsFalse)
+
+	_, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), nil))
+	c.Assert(err, tc.ErrorIs, coreerrors.NotProvisioned)
+
+	_, err = p.StartDeviceAuthorization(c.Context())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotProvisioned)
+}
+
+// userDomain returns the user domain of the fake identity provider, made
+// of the host and port of its URL.
+func (s *providerSuite) userDomain(c *tc.C) string {
+	u, err := url.Parse(s.idp.server.URL)
+	c.Assert(err, tc.ErrorIsNil)
+	return u.Hostname() + "+" + u.Port()
+}
+
+func (s *providerSuite) TestVerify(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL+"/")
+	c.Check(p.Enabled(), tc.IsTrue)
+
+	identity, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"groups": []string{"platform-admins"},
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(identity.User, tc.Equals, names.NewUserTag("1234@"+s.userDomain(c)))
+	c.Check(identity.Groups, tc.DeepEquals, []string{"admins"})
+}
+
+func (s *providerSuite) TestVerifyIgnoresPreferredUserName(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+
+	// The preferred user name may be changed by the user, so it must not
+	// identify them.
+	identity, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"preferred_username": "alice",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(identity.User, tc.Equals, names.NewUserTag("1234@"+s.userDomain(c)))
+	c.Check(identity.Groups, tc.HasLen, 0)
+}
+
+func (s *providerSuite) TestVerifyIgnoresUnmappedGroups(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+
+	// Groups which aren't mapped grant nothing, even when a Juju group of
+	// the same name exists.
+	identity, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"groups": []string{"admins", "dev", "platform-admins"},
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(identity.Groups, tc.DeepEquals, []string{"admins"})
+}
+
+func (s *providerSuite) TestVerifyNotIssued(c *tc.C) {
+	p := s.newProvider(c, "https://other.example.com")
+
+	_, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), nil))
+	c.Assert(err, tc.ErrorIs, ErrNotIssued)
+
+	_, err = p.Verify(c.Context(), "not-a-token")
+	c.Assert(err, tc.ErrorIs, ErrNotIssued)
+}
+
+func (s *providerSuite) TestVerifyExpired(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+
+	_, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now().Add(-2*time.Hour), map[string]any{
+		"preferred_username": "alice",
+	}))
+	c.Assert(err, tc.ErrorIs, ErrTokenInvalid)
+}
+
+func (s *providerSuite) TestVerifyWrongAudience(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+
+	_, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"aud": []string{"someone-else"},
+	}))
+	c.Assert(err, tc.ErrorIs, ErrTokenInvalid)
+}
+
+func (s *providerSuite) TestVerifyBadSignature(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+	token := s.idp.token(c, s.clock.Now(), nil)
+
+	// Sign with a key the identity provider does not publish.
+	s.idp.signingKey = newFakeIdentityProvider(c).signingKey
+	_, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), nil))
+	c.Assert(err, tc.ErrorIs, ErrTokenInvalid)
+
+	// The original token is still accepted.
+	_, err = p.Verify(c.Context(), token)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestVerifySubjectNotValidUserName(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+
+	identity, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"sub": "AbC_dEf/123",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(identity.User.Name(), tc.Matches, "sub-[0-9a-f]{40}")
+	c.Check(identity.User.Domain(), tc.Equals, s.userDomain(c))
+
+	// The same subject is always the same user.
+	again, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"sub": "AbC_dEf/123",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(again.User, tc.Equals, identity.User)
+}
+
+func (s *providerSuite) TestIssuerUserDomain(c *tc.C) {
+	for issuer, expected := range map[string]string{
+		"https://idp.example.com":             "idp.example.com",
+		"https://idp.example.com:8443/":       "idp.example.com+8443",
+		"https://idp.example.com/realms/juju": "idp.example.com+realms+juju",
+	} {
+		domain, err := issuerUserDomain(issuer)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(domain, tc.Equals, expected, tc.Commentf("issuer %q", issuer))
+	}
+
+	_, err := issuerUserDomain("not a url")
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *providerSuite) TestVerifySingleGroup(c *tc.C) {
+	p := s.newProvider(c, s.idp.server.URL)
+
+	identity, err := p.Verify(c.Context(), s.idp.token(c, s.clock.Now(), map[string]any{
+		"groups": "platform-admins",
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(identity.Groups, tc.DeepEquals, []string{"admins"})
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	internalerrors "github.com/juju/juju/internal/errors"
+)
+
+const (
+	metricsPath = "/v1/metrics"
+	logsPath    = "/v1/logs"
+
+	protobufContentType = "application/x-protobuf"
+)
+
+// Client exports metrics and logs to an OTLP collector.
+type Client interface {
+	// ExportMetrics sends the metrics to the collector.
+	ExportMetrics(ctx context.Context, metrics []*metricsv1.ResourceMetrics) error
+
+	// ExportLogs sends the logs to the collector.
+	ExportLogs(ctx context.Context, logs []*logsv1.ResourceLogs) error
+
+	// Close releases any connections held by the client.
+	Close() error
+}
+
+// NewClient returns a client for the collector described by the config.
+// When both a gRPC and HTTP endpoint are provided, the gRPC endpoint is
+// preferred, matching the behaviour of the trace client.
+func NewClient(config Config) (Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	if config.GRPCEndpoint != "" {
+		return newGRPCClient(config)
+	}
+	return newHTTPClient(config)
+}
+
+type grpcClient struct {
+	conn    *grpc.ClientConn
+	metrics collectormetricsv1.MetricsServiceClient
+	logs    collectorlogsv1.LogsServiceClient
+	config  Config
+}
+
+// newGRPCClient returns a client for an OTLP/gRPC endpoint. An endpoint with
+// an http:// scheme is connected to without TLS.
+func newGRPCClient(config Config) (*grpcClient, error) {
+	target := config.GRPCEndpoint
+	creds := insecure.NewCredentials()
+	if after, ok := strings.CutPrefix(target, "http://"); ok {
+		target = after
+	} else {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, internalerrors.Capture(err)
+		}
+		target = strings.TrimPrefix(target, "https://")
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, internalerrors.Errorf("connecting to OTLP gRPC endpoint %q: %w", config.GRPCEndpoint, err)
+	}
+	return &grpcClient{
+		conn:    conn,
+		metrics: collectormetricsv1.NewMetricsServiceClient(conn),
+		logs:    collectorlogsv1.NewLogsServiceClient(conn),
+		config:  config,
+	}, nil
+}
+
+// ExportMetrics is part of the Client interface.
+func (c *grpcClient) ExportMetrics(ctx context.Context, metrics []*metricsv1.ResourceMetrics) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.timeout())
+	defer cancel()
+
+	_, err := c.metrics.Export(ctx, &collectormetricsv1.ExportMetricsServiceRequest{
+		ResourceMetrics: metrics,
+	})
+	if err != nil {
+		return internalerrors.Errorf("exporting metrics: %w", err)
+	}
+	return nil
+}
+
+// ExportLogs is part of the Client interface.
+func (c *grpcClient) ExportLogs(ctx context.Context, logs []*logsv1.ResourceLogs) error {
+	ctx, cancel := context.WithTimeout(ctx, c.config.timeout())
+	defer cancel()
+
+	_, err := c.logs.Export(ctx, &collectorlogsv1.ExportLogsServiceRequest{
+		ResourceLogs: logs,
+	})
+	if err != nil {
+		return internalerrors.Errorf("exporting logs: %w", err)
+	}
+	return nil
+}
+
+// Close is part of the Client interface.
+func (c *grpcClient) Close() error {
+	return c.conn.Close()
+}
+
+type httpClient struct {
+	client     *http.Client
+	metricsURL string
+	logsURL    string
+}
+
+// newHTTPClient returns a client that posts protobuf encoded requests to an
+// OTLP/HTTP endpoint.
+func newHTTPClient(config Config) (*httpClient, error) {
+	endpoint := config.HTTPEndpoint
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "https://" + endpoint
+	}
+	base, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, internalerrors.Errorf("parsing OTLP HTTP endpoint %q: %w", config.HTTPEndpoint, err)
+	}
+
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &httpClient{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.timeout(),
+		},
+		metricsURL: base.JoinPath(metricsPath).String(),
+		logsURL:    base.JoinPath(logsPath).String(),
+	}, nil
+}
+
+// ExportMetrics is part of the Client interface.
+func (c *httpClient) ExportMetrics(ctx context.Context, metrics []*metricsv1.ResourceMetrics) error {
+	err := c.post(ctx, c.metricsURL, &collectormetricsv1.ExportMetricsServiceRequest{
+		ResourceMetrics: metrics,
+	})
+	if err != nil {
+		return internalerrors.Errorf("exporting metrics: %w", err)
+	}
+	return nil
+}
+
+// ExportLogs is part of the Client interface.
+func (c *httpClient) ExportLogs(ctx context.Context, logs []*logsv1.ResourceLogs) error {
+	err := c.post(ctx, c.logsURL, &collectorlogsv1.ExportLogsServiceRequest{
+		ResourceLogs: logs,
+	})
+	if err != nil {
+		return internalerrors.Errorf("exporting logs: %w", err)
+	}
+	return nil
+}
+
+// Close is part of the Client interface.
+func (c *httpClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+func (c *httpClient) post(ctx context.Context, url string, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return internalerrors.Capture(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return internalerrors.Capture(err)
+	}
+	req.Header.Set("Content-Type", protobufContentType)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return internalerrors.Capture(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return internalerrors.Errorf("unexpected status %d from %s: %s", resp.StatusCode, url, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/juju/loggo/v3"
+	"github.com/juju/tc"
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type clientSuite struct{}
+
+func TestClientSuite(t *testing.T) {
+	tc.Run(t, &clientSuite{})
+}
+
+func (s *clientSuite) TestNewClientNoEndpoint(c *tc.C) {
+	_, err := NewClient(Config{})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *clientSuite) TestNewClientInvalidCACertificate(c *tc.C) {
+	_, err := NewClient(Config{
+		HTTPEndpoint:  "https://otel:4318",
+		CACertificate: "not a certificate",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *clientSuite) TestNewClientPrefersGRPC(c *tc.C) {
+	client, err := NewClient(Config{
+		HTTPEndpoint: "https://otel:4318",
+		GRPCEndpoint: "otel:4317",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = client.Close() }()
+
+	_, ok := client.(*grpcClient)
+	c.Check(ok, tc.IsTrue)
+}
+
+func (s *clientSuite) TestHTTPExportMetrics(c *tc.C) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(Config{HTTPEndpoint: srv.URL})
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = client.Close() }()
+
+	err = client.ExportMetrics(c.Context(), []*metricsv1.ResourceMetrics{{
+		Resource: NewResource("juju-controller", "machine-0"),
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	req := <-requests
+	c.Check(req.URL.Path, tc.Equals, "/v1/metrics")
+	c.Check(req.Header.Get("Content-Type"), tc.Equals, "application/x-protobuf")
+
+	var decoded collectormetricsv1.ExportMetricsServiceRequest
+	c.Assert(proto.Unmarshal(<-bodies, &decoded), tc.ErrorIsNil)
+	c.Assert(decoded.ResourceMetrics, tc.HasLen, 1)
+	c.Check(decoded.ResourceMetrics[0].Resource.Attributes[0].Value.GetStringValue(), tc.Equals, "juju-controller")
+}
+
+func (s *clientSuite) TestHTTPExportLogs(c *tc.C) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(Config{HTTPEndpoint: srv.URL + "/otlp"})
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = client.Close() }()
+
+	err = client.ExportLogs(c.Context(), []*logsv1.ResourceLogs{
+		NewResourceLogs(NewResource("juju-controller", "machine-0"), []*logsv1.LogRecord{
+			NewLogRecord(loggo.Entry{Level: loggo.INFO, Message: "hello"}),
+		}),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	req := <-requests
+	c.Check(req.URL.Path, tc.Equals, "/otlp/v1/logs")
+
+	var decoded collectorlogsv1.ExportLogsServiceRequest
+	c.Assert(proto.Unmarshal(<-bodies, &decoded), tc.ErrorIsNil)
+	c.Assert(decoded.ResourceLogs, tc.HasLen, 1)
+	records := decoded.ResourceLogs[0].ScopeLogs[0].LogRecords
+	c.Assert(records, tc.HasLen, 1)
+	c.Check(records[0].Body.GetStringValue(), tc.Equals, "hello")
+}
+
+func (s *clientSuite) TestHTTPExportError(c *tc.C) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(Config{HTTPEndpoint: srv.URL})
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = client.Close() }()
+
+	err = client.ExportMetrics(c.Context(), nil)
+	c.Assert(err, tc.ErrorMatches, `exporting metrics: unexpected status 400 from .*/v1/metrics: bad request`)
+}
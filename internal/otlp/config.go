@@ -0,0 +1,82 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+)
+
+// DefaultTimeout is the default time allowed for a single export request.
+const DefaultTimeout = 10 * time.Second
+
+// Config holds the endpoint and TLS configuration for an OTLP collector.
+// It is the same configuration used by the trace worker, so that every
+// signal is sent to the same collector.
+type Config struct {
+	// HTTPEndpoint is the OTLP/HTTP endpoint of the collector. It may
+	// either be a URL, or a host:port pair in which case https is used.
+	HTTPEndpoint string
+
+	// GRPCEndpoint is the host:port of the OTLP/gRPC endpoint of the
+	// collector. It is preferred over the HTTP endpoint when both are set.
+	GRPCEndpoint string
+
+	// CACertificate is an optional PEM encoded CA certificate used to
+	// verify the collector.
+	CACertificate string
+
+	// InsecureSkipVerify disables verification of the collector's
+	// certificate.
+	InsecureSkipVerify bool
+
+	// Timeout is the time allowed for a single export request.
+	// Default: 10s.
+	Timeout time.Duration
+}
+
+// Enabled returns true if an endpoint has been configured.
+func (c Config) Enabled() bool {
+	return c.HTTPEndpoint != "" || c.GRPCEndpoint != ""
+}
+
+// Validate checks that the configuration can be used to create a client.
+func (c Config) Validate() error {
+	if !c.Enabled() {
+		return internalerrors.New("no OTLP endpoint provided").Add(coreerrors.NotValid)
+	}
+	if c.Timeout < 0 {
+		return internalerrors.New("timeout must not be negative").Add(coreerrors.NotValid)
+	}
+	if _, err := c.tlsConfig(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// tlsConfig returns the TLS configuration for connecting to the collector.
+func (c Config) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CACertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(c.CACertificate)) {
+			return nil, internalerrors.New("failed to append OTLP CA cert to pool").Add(coreerrors.NotValid)
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
+
+func (c Config) timeout() time.Duration {
+	if c.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return c.Timeout
+}
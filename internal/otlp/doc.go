@@ -0,0 +1,16 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package otlp provides an OpenTelemetry protocol (OTLP) client for
+// exporting metrics and logs to an OTEL collector. It shares the endpoint
+// and TLS settings used for tracing, so that traces, metrics and logs can
+// all be ingested through a single collector.
+//
+// Metrics are converted from the families gathered from a Prometheus
+// registry, so that anything the controller already exposes on its
+// Prometheus endpoint is also exported over OTLP. Logs are converted from
+// loggo entries.
+//
+// The gRPC transport is preferred when a gRPC endpoint is configured,
+// otherwise protobuf encoded requests are posted to the HTTP endpoint.
+package otlp
@@ -0,0 +1,98 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"net/url"
+	"strings"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+)
+
+// Agents are given the collector that their logs are sent to as their
+// logging endpoint, which otherwise holds the Loki push endpoint. The scheme
+// of the logging endpoint selects an OTLP collector rather than Loki; the
+// endpoint is otherwise the address of the collector. The collector and its
+// TLS settings are always those of the workload tracing config; these
+// schemes are never configured by users.
+const (
+	// SchemeHTTP sends logs to an OTLP/HTTP endpoint without TLS.
+	SchemeHTTP = "otlp+http"
+
+	// SchemeHTTPS sends logs to an OTLP/HTTP endpoint over TLS.
+	SchemeHTTPS = "otlp+https"
+
+	// SchemeGRPC sends logs to an OTLP/gRPC endpoint over TLS.
+	SchemeGRPC = "otlp+grpc"
+)
+
+// LogEndpoint returns the logging endpoint given to agents to send their logs
+// to the collector of the config. As with the client, the gRPC endpoint is
+// preferred when both are set. An empty string is returned if the config has
+// no endpoint.
+func LogEndpoint(config Config) string {
+	switch {
+	case config.GRPCEndpoint != "":
+		return SchemeGRPC + "://" + config.GRPCEndpoint
+	case config.HTTPEndpoint == "":
+		return ""
+	}
+
+	// As with the client, an endpoint without a scheme is sent to over
+	// https.
+	endpoint := config.HTTPEndpoint
+	if rest, ok := strings.CutPrefix(endpoint, "http://"); ok {
+		return SchemeHTTP + "://" + rest
+	}
+	return SchemeHTTPS + "://" + strings.TrimPrefix(endpoint, "https://")
+}
+
+// IsLogEndpoint returns true if the logging endpoint is that of an OTLP
+// collector.
+func IsLogEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case SchemeHTTP, SchemeHTTPS, SchemeGRPC:
+		return true
+	}
+	return false
+}
+
+// LogEndpointConfig returns the configuration of the client for the OTLP
+// collector at the logging endpoint, verified with the given CA certificate.
+// It is the reverse of [LogEndpoint].
+func LogEndpointConfig(endpoint, caCert string, insecureSkipVerify bool) (Config, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return Config{}, internalerrors.Errorf("OTLP log endpoint %q: %w", endpoint, err).Add(coreerrors.NotValid)
+	}
+	if u.Host == "" {
+		return Config{}, internalerrors.Errorf("OTLP log endpoint %q missing host", endpoint).Add(coreerrors.NotValid)
+	}
+
+	config := Config{
+		CACertificate:      caCert,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+	switch u.Scheme {
+	case SchemeHTTP:
+		u.Scheme = "http"
+		config.HTTPEndpoint = u.String()
+	case SchemeHTTPS:
+		u.Scheme = "https"
+		config.HTTPEndpoint = u.String()
+	case SchemeGRPC:
+		config.GRPCEndpoint = u.Host
+	default:
+		return Config{}, internalerrors.Errorf("OTLP log endpoint %q has unknown scheme %q", endpoint, u.Scheme).Add(coreerrors.NotValid)
+	}
+	if err := config.Validate(); err != nil {
+		return Config{}, internalerrors.Capture(err)
+	}
+	return config, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type endpointSuite struct{}
+
+func TestEndpointSuite(t *testing.T) {
+	tc.Run(t, &endpointSuite{})
+}
+
+func (s *endpointSuite) TestIsLogEndpoint(c *tc.C) {
+	c.Check(IsLogEndpoint("otlp+http://collector:4318"), tc.IsTrue)
+	c.Check(IsLogEndpoint("otlp+https://collector:4318"), tc.IsTrue)
+	c.Check(IsLogEndpoint("otlp+grpc://collector:4317"), tc.IsTrue)
+	c.Check(IsLogEndpoint("https://loki:3100/loki/api/v1/push"), tc.IsFalse)
+	c.Check(IsLogEndpoint(""), tc.IsFalse)
+}
+
+func (s *endpointSuite) TestLogEndpointConfig(c *tc.C) {
+	config, err := LogEndpointConfig("otlp+http://collector:4318/otlp", "", true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config, tc.DeepEquals, Config{
+		HTTPEndpoint:       "http://collector:4318/otlp",
+		InsecureSkipVerify: true,
+	})
+
+	config, err = LogEndpointConfig("otlp+https://collector:4318", "", false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config.HTTPEndpoint, tc.Equals, "https://collector:4318")
+
+	config, err = LogEndpointConfig("otlp+grpc://collector:4317", "", false)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(config.GRPCEndpoint, tc.Equals, "collector:4317")
+	c.Check(config.HTTPEndpoint, tc.Equals, "")
+}
+
+func (s *endpointSuite) TestLogEndpointConfigNotValid(c *tc.C) {
+	_, err := LogEndpointConfig("https://loki:3100", "", false)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	_, err = LogEndpointConfig("otlp+grpc://", "", false)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+
+	_, err = LogEndpointConfig("otlp+https://collector:4318", "not a cert", false)
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *endpointSuite) TestLogEndpoint(c *tc.C) {
+	c.Check(LogEndpoint(Config{HTTPEndpoint: "http://collector:4318/otlp"}), tc.Equals, "otlp+http://collector:4318/otlp")
+	c.Check(LogEndpoint(Config{HTTPEndpoint: "https://collector:4318"}), tc.Equals, "otlp+https://collector:4318")
+	c.Check(LogEndpoint(Config{HTTPEndpoint: "collector:4318"}), tc.Equals, "otlp+https://collector:4318")
+	c.Check(LogEndpoint(Config{
+		HTTPEndpoint: "collector:4318",
+		GRPCEndpoint: "collector:4317",
+	}), tc.Equals, "otlp+grpc://collector:4317")
+	c.Check(LogEndpoint(Config{}), tc.Equals, "")
+}
+
+func (s *endpointSuite) TestLogEndpointRoundTrip(c *tc.C) {
+	config := Config{
+		HTTPEndpoint:       "http://collector:4318/otlp",
+		InsecureSkipVerify: true,
+	}
+	got, err := LogEndpointConfig(LogEndpoint(config), config.CACertificate, config.InsecureSkipVerify)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, config)
+}
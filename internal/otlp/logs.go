@@ -0,0 +1,109 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"github.com/juju/loggo/v3"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const (
+	// traceIDLabel and spanIDLabel are the log labels carrying the
+	// trace context of a log entry. They are sent as the trace context of
+	// the log record, rather than as attributes.
+	traceIDLabel = "trace_id"
+	spanIDLabel  = "span_id"
+)
+
+// NewResourceLogs returns the OTLP logs for the given resource.
+func NewResourceLogs(resource *resourcev1.Resource, records []*logsv1.LogRecord) *logsv1.ResourceLogs {
+	return &logsv1.ResourceLogs{
+		Resource: resource,
+		ScopeLogs: []*logsv1.ScopeLogs{{
+			Scope:      newScope(),
+			LogRecords: records,
+		}},
+	}
+}
+
+// NewLogRecord converts a loggo entry into an OTLP log record. The logging
+// module and source location are recorded as attributes, along with any
+// labels on the entry.
+func NewLogRecord(entry loggo.Entry) *logsv1.LogRecord {
+	severity, severityText := severity(entry.Level)
+
+	attributes := []*commonv1.KeyValue{
+		stringAttribute("log.logger", entry.Module),
+	}
+	if entry.Filename != "" {
+		attributes = append(attributes,
+			stringAttribute("code.filepath", entry.Filename),
+			stringAttribute("code.lineno", strconv.Itoa(entry.Line)),
+		)
+	}
+
+	labels := make([]string, 0, len(entry.Labels))
+	for key := range entry.Labels {
+		if key == traceIDLabel || key == spanIDLabel {
+			continue
+		}
+		labels = append(labels, key)
+	}
+	sort.Strings(labels)
+	for _, key := range labels {
+		attributes = append(attributes, stringAttribute(key, entry.Labels[key]))
+	}
+
+	timestamp := uint64(entry.Timestamp.UnixNano())
+	return &logsv1.LogRecord{
+		TimeUnixNano:         timestamp,
+		ObservedTimeUnixNano: timestamp,
+		SeverityNumber:       severity,
+		SeverityText:         severityText,
+		Body: &commonv1.AnyValue{
+			Value: &commonv1.AnyValue_StringValue{StringValue: entry.Message},
+		},
+		Attributes: attributes,
+		TraceId:    decodeID(entry.Labels[traceIDLabel], 16),
+		SpanId:     decodeID(entry.Labels[spanIDLabel], 8),
+	}
+}
+
+func severity(level loggo.Level) (logsv1.SeverityNumber, string) {
+	switch level {
+	case loggo.TRACE:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_TRACE, "TRACE"
+	case loggo.DEBUG:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_DEBUG, "DEBUG"
+	case loggo.INFO:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_INFO, "INFO"
+	case loggo.WARNING:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_WARN, "WARN"
+	case loggo.ERROR:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_ERROR, "ERROR"
+	case loggo.CRITICAL:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_FATAL, "FATAL"
+	default:
+		return logsv1.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED, ""
+	}
+}
+
+// decodeID decodes a hex encoded trace or span ID. Malformed IDs are
+// dropped, as the collector rejects IDs of the wrong length.
+func decodeID(id string, size int) []byte {
+	if id == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(id)
+	if err != nil || len(b) != size {
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/loggo/v3"
+	"github.com/juju/tc"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type logsSuite struct{}
+
+func TestLogsSuite(t *testing.T) {
+	tc.Run(t, &logsSuite{})
+}
+
+func (s *logsSuite) TestNewLogRecord(c *tc.C) {
+	now := time.Unix(100, 0)
+	record := NewLogRecord(loggo.Entry{
+		Level:     loggo.WARNING,
+		Module:    "juju.worker.foo",
+		Filename:  "foo.go",
+		Line:      42,
+		Timestamp: now,
+		Message:   "something happened",
+		Labels: loggo.Labels{
+			"domain":     "model",
+			traceIDLabel: "0102030405060708090a0b0c0d0e0f10",
+			spanIDLabel:  "0102030405060708",
+		},
+	})
+
+	c.Check(record.TimeUnixNano, tc.Equals, uint64(now.UnixNano()))
+	c.Check(record.SeverityNumber, tc.Equals, logsv1.SeverityNumber_SEVERITY_NUMBER_WARN)
+	c.Check(record.SeverityText, tc.Equals, "WARN")
+	c.Check(record.Body.GetStringValue(), tc.Equals, "something happened")
+	c.Check(record.TraceId, tc.HasLen, 16)
+	c.Check(record.SpanId, tc.HasLen, 8)
+
+	attributes := make(map[string]string)
+	for _, kv := range record.Attributes {
+		attributes[kv.Key] = kv.Value.GetStringValue()
+	}
+	c.Check(attributes, tc.DeepEquals, map[string]string{
+		"log.logger":    "juju.worker.foo",
+		"code.filepath": "foo.go",
+		"code.lineno":   "42",
+		"domain":        "model",
+	})
+}
+
+func (s *logsSuite) TestNewLogRecordMalformedTraceID(c *tc.C) {
+	record := NewLogRecord(loggo.Entry{
+		Level:   loggo.INFO,
+		Message: "message",
+		Labels: loggo.Labels{
+			traceIDLabel: "not-hex",
+		},
+	})
+	c.Check(record.TraceId, tc.IsNil)
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// NewResourceMetrics converts metric families gathered from a Prometheus
+// registry into OTLP metrics for the given resource.
+//
+// Counters become monotonic cumulative sums, gauges and untyped metrics
+// become gauges, and histograms and summaries keep their shape. Cumulative
+// metrics report start as their start time, which should be the time the
+// exporter started.
+func NewResourceMetrics(
+	resource *resourcev1.Resource,
+	families []*dto.MetricFamily,
+	start, now time.Time,
+) *metricsv1.ResourceMetrics {
+	metrics := make([]*metricsv1.Metric, 0, len(families))
+	for _, family := range families {
+		if metric := convertFamily(family, uint64(start.UnixNano()), uint64(now.UnixNano())); metric != nil {
+			metrics = append(metrics, metric)
+		}
+	}
+	return &metricsv1.ResourceMetrics{
+		Resource: resource,
+		ScopeMetrics: []*metricsv1.ScopeMetrics{{
+			Scope:   newScope(),
+			Metrics: metrics,
+		}},
+	}
+}
+
+func convertFamily(family *dto.MetricFamily, start, now uint64) *metricsv1.Metric {
+	if len(family.GetMetric()) == 0 {
+		return nil
+	}
+
+	metric := &metricsv1.Metric{
+		Name:        family.GetName(),
+		Description: family.GetHelp(),
+	}
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		sum := &metricsv1.Sum{
+			AggregationTemporality: metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		}
+		for _, m := range family.GetMetric() {
+			sum.DataPoints = append(sum.DataPoints,
+				numberDataPoint(m, m.GetCounter().GetValue(), start, now))
+		}
+		metric.Data = &metricsv1.Metric_Sum{Sum: sum}
+
+	case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+		gauge := &metricsv1.Gauge{}
+		for _, m := range family.GetMetric() {
+			value := m.GetGauge().GetValue()
+			if family.GetType() == dto.MetricType_UNTYPED {
+				value = m.GetUntyped().GetValue()
+			}
+			gauge.DataPoints = append(gauge.DataPoints,
+				numberDataPoint(m, value, 0, now))
+		}
+		metric.Data = &metricsv1.Metric_Gauge{Gauge: gauge}
+
+	case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+		histogram := &metricsv1.Histogram{
+			AggregationTemporality: metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}
+		for _, m := range family.GetMetric() {
+			histogram.DataPoints = append(histogram.DataPoints,
+				histogramDataPoint(m, start, now))
+		}
+		metric.Data = &metricsv1.Metric_Histogram{Histogram: histogram}
+
+	case dto.MetricType_SUMMARY:
+		summary := &metricsv1.Summary{}
+		for _, m := range family.GetMetric() {
+			summary.DataPoints = append(summary.DataPoints,
+				summaryDataPoint(m, start, now))
+		}
+		metric.Data = &metricsv1.Metric_Summary{Summary: summary}
+
+	default:
+		return nil
+	}
+	return metric
+}
+
+func numberDataPoint(m *dto.Metric, value float64, start, now uint64) *metricsv1.NumberDataPoint {
+	return &metricsv1.NumberDataPoint{
+		Attributes:        labelAttributes(m.GetLabel()),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      metricTime(m, now),
+		Value:             &metricsv1.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+// histogramDataPoint converts a Prometheus histogram, whose buckets are
+// cumulative, into an OTLP data point, whose buckets are not. The +Inf
+// bucket is implied by OTLP, so it is not included in the bounds.
+func histogramDataPoint(m *dto.Metric, start, now uint64) *metricsv1.HistogramDataPoint {
+	h := m.GetHistogram()
+
+	var (
+		bounds []float64
+		counts []uint64
+		prev   uint64
+	)
+	for _, bucket := range h.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), +1) {
+			continue
+		}
+		bounds = append(bounds, bucket.GetUpperBound())
+		counts = append(counts, bucket.GetCumulativeCount()-prev)
+		prev = bucket.GetCumulativeCount()
+	}
+	counts = append(counts, h.GetSampleCount()-prev)
+
+	sum := h.GetSampleSum()
+	return &metricsv1.HistogramDataPoint{
+		Attributes:        labelAttributes(m.GetLabel()),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      metricTime(m, now),
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+	}
+}
+
+func summaryDataPoint(m *dto.Metric, start, now uint64) *metricsv1.SummaryDataPoint {
+	s := m.GetSummary()
+
+	quantiles := make([]*metricsv1.SummaryDataPoint_ValueAtQuantile, 0, len(s.GetQuantile()))
+	for _, q := range s.GetQuantile() {
+		quantiles = append(quantiles, &metricsv1.SummaryDataPoint_ValueAtQuantile{
+			Quantile: q.GetQuantile(),
+			Value:    q.GetValue(),
+		})
+	}
+	return &metricsv1.SummaryDataPoint{
+		Attributes:        labelAttributes(m.GetLabel()),
+		StartTimeUnixNano: start,
+		TimeUnixNano:      metricTime(m, now),
+		Count:             s.GetSampleCount(),
+		Sum:               s.GetSampleSum(),
+		QuantileValues:    quantiles,
+	}
+}
+
+// metricTime returns the explicit timestamp of the metric if it has one,
+// otherwise the time the metrics were gathered.
+func metricTime(m *dto.Metric, now uint64) uint64 {
+	if m.TimestampMs != nil {
+		return uint64(m.GetTimestampMs()) * uint64(time.Millisecond)
+	}
+	return now
+}
+
+func labelAttributes(labels []*dto.LabelPair) []*commonv1.KeyValue {
+	if len(labels) == 0 {
+		return nil
+	}
+	attributes := make([]*commonv1.KeyValue, 0, len(labels))
+	for _, label := range labels {
+		attributes = append(attributes, stringAttribute(label.GetName(), label.GetValue()))
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+	return attributes
+}
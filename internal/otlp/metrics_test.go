@@ -0,0 +1,100 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+type metricsSuite struct{}
+
+func TestMetricsSuite(t *testing.T) {
+	tc.Run(t, &metricsSuite{})
+}
+
+func (s *metricsSuite) TestNewResourceMetrics(c *tc.C) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "requests_total",
+		Help: "Total requests.",
+	}, []string{"method"})
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "connections",
+		Help: "Open connections.",
+	})
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "latency_seconds",
+		Help:    "Request latency.",
+		Buckets: []float64{0.1, 1},
+	})
+	registry.MustRegister(counter, gauge, histogram)
+
+	counter.WithLabelValues("GET").Add(3)
+	gauge.Set(7)
+	histogram.Observe(0.05)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+
+	families, err := registry.Gather()
+	c.Assert(err, tc.ErrorIsNil)
+
+	start := time.Unix(100, 0)
+	now := time.Unix(200, 0)
+	resource := NewResource("juju-controller", "machine-0")
+	result := NewResourceMetrics(resource, families, start, now)
+
+	c.Check(result.Resource, tc.Equals, resource)
+	c.Assert(result.ScopeMetrics, tc.HasLen, 1)
+	metrics := result.ScopeMetrics[0].Metrics
+	c.Assert(metrics, tc.HasLen, 3)
+
+	byName := make(map[string]*metricsv1.Metric)
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+
+	sum := byName["requests_total"].GetSum()
+	c.Assert(sum, tc.NotNil)
+	c.Check(sum.IsMonotonic, tc.IsTrue)
+	c.Check(sum.AggregationTemporality, tc.Equals, metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE)
+	c.Assert(sum.DataPoints, tc.HasLen, 1)
+	c.Check(sum.DataPoints[0].GetAsDouble(), tc.Equals, 3.0)
+	c.Check(sum.DataPoints[0].StartTimeUnixNano, tc.Equals, uint64(start.UnixNano()))
+	c.Check(sum.DataPoints[0].TimeUnixNano, tc.Equals, uint64(now.UnixNano()))
+	c.Assert(sum.DataPoints[0].Attributes, tc.HasLen, 1)
+	c.Check(sum.DataPoints[0].Attributes[0].Key, tc.Equals, "method")
+	c.Check(sum.DataPoints[0].Attributes[0].Value.GetStringValue(), tc.Equals, "GET")
+
+	g := byName["connections"].GetGauge()
+	c.Assert(g, tc.NotNil)
+	c.Assert(g.DataPoints, tc.HasLen, 1)
+	c.Check(g.DataPoints[0].GetAsDouble(), tc.Equals, 7.0)
+	c.Check(byName["connections"].Description, tc.Equals, "Open connections.")
+
+	h := byName["latency_seconds"].GetHistogram()
+	c.Assert(h, tc.NotNil)
+	c.Assert(h.DataPoints, tc.HasLen, 1)
+	c.Check(h.DataPoints[0].Count, tc.Equals, uint64(3))
+	c.Check(h.DataPoints[0].ExplicitBounds, tc.DeepEquals, []float64{0.1, 1})
+	c.Check(h.DataPoints[0].BucketCounts, tc.DeepEquals, []uint64{1, 1, 1})
+	c.Check(*h.DataPoints[0].Sum, tc.Equals, 5.55)
+}
+
+func (s *metricsSuite) TestNewResourceMetricsSkipsEmptyFamilies(c *tc.C) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "unused_total",
+	}, []string{"label"}))
+
+	families, err := registry.Gather()
+	c.Assert(err, tc.ErrorIsNil)
+
+	result := NewResourceMetrics(NewResource("juju", "id"), families, time.Now(), time.Now())
+	c.Check(result.ScopeMetrics[0].Metrics, tc.HasLen, 0)
+}
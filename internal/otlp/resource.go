@@ -0,0 +1,57 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otlp
+
+import (
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/juju/juju/core/version"
+)
+
+// scopeName is the instrumentation scope reported with every signal.
+const scopeName = "github.com/juju/juju"
+
+// NewResource returns the OTLP resource identifying the exporting agent. The
+// attributes match those set on the resource of the trace provider, so that
+// signals from the same agent can be correlated.
+func NewResource(serviceName, instanceID string) *resourcev1.Resource {
+	return &resourcev1.Resource{
+		Attributes: []*commonv1.KeyValue{
+			stringAttribute("service.name", serviceName),
+			stringAttribute("service.version", version.Current.String()),
+			stringAttribute("service.instance.id", instanceID),
+		},
+	}
+}
+
+// NewAgentResource returns the OTLP resource identifying an agent which
+// writes logs on behalf of the given controller and model. Either UUID may be
+// empty when it is not known.
+func NewAgentResource(serviceName, instanceID, controllerUUID, modelUUID string) *resourcev1.Resource {
+	resource := NewResource(serviceName, instanceID)
+	if controllerUUID != "" {
+		resource.Attributes = append(resource.Attributes, stringAttribute("juju.controller.uuid", controllerUUID))
+	}
+	if modelUUID != "" {
+		resource.Attributes = append(resource.Attributes, stringAttribute("juju.model.uuid", modelUUID))
+	}
+	return resource
+}
+
+func newScope() *commonv1.InstrumentationScope {
+	return &commonv1.InstrumentationScope{
+		Name:    scopeName,
+		Version: version.Current.String(),
+	}
+}
+
+func stringAttribute(key, value string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{
+		Key: key,
+		Value: &commonv1.AnyValue{
+			Value: &commonv1.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/environs/models"
+)
+
+var _ models.ModelEgressFirewaller = (*environ)(nil)
+
+// defaultEgressPerms are the egress rules security groups are created
+// with, which allow all outgoing traffic.
+var defaultEgressPerms = []types.IpPermission{{
+	IpProtocol: aws.String("-1"),
+	IpRanges:   []types.IpRange{{CidrIp: aws.String(defaultRouteIpv4CIDRBlock)}},
+}, {
+	IpProtocol: aws.String("-1"),
+	Ipv6Ranges: []types.Ipv6Range{{CidrIpv6: aws.String(defaultRouteIPv6CIDRBlock)}},
+}}
+
+var internalEgressPermissionDescription = aws.String("juju internal model egress rule")
+
+// amazonDNSAddress is the link-local address of the Amazon DNS server,
+// which is reachable from the instances of every VPC.
+const amazonDNSAddress = "169.254.169.253"
+
+// OpenModelEgressPorts is part of the models.ModelEgressFirewaller
+// interface. The rules are added to the model security group.
+//
+// Security groups allow all outgoing traffic by default, and an instance is
+// allowed any traffic allowed by one of its groups. Once the rules are
+// added, the default egress rule is removed from all the security groups
+// of the model, so that outgoing traffic is limited to the rules, to
+// traffic between the machines of the model and to the DNS resolver of
+// the VPC. Rules allowing traffic to the controller API are added by the
+// firewaller along with the model egress rules.
+func (e *environ) OpenModelEgressPorts(ctx context.Context, rules firewall.EgressRules) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	g, err := e.groupByName(ctx, e.jujuGroupName())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ipPerms := egressRulesToIPPerms(rules)
+	authorize := func(perms []types.IpPermission) error {
+		_, err := e.ec2Client.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       g.GroupId,
+			IpPermissions: perms,
+		})
+		return err
+	}
+	err = authorize(ipPerms)
+	if err != nil && ec2ErrCode(err) == "InvalidPermission.Duplicate" {
+		// Authorize each rule individually, as the rules that were not
+		// duplicates have been ignored.
+		for i := range ipPerms {
+			if err := authorize(ipPerms[i : i+1]); err != nil && ec2ErrCode(err) != "InvalidPermission.Duplicate" {
+				return errors.Annotatef(e.HandleCredentialError(ctx, err), "cannot open egress port %v", ipPerms[i])
+			}
+		}
+	} else if err != nil {
+		return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot open egress ports")
+	}
+	return errors.Trace(e.restrictModelEgress(ctx, g))
+}
+
+// CloseModelEgressPorts is part of the models.ModelEgressFirewaller
+// interface.
+func (e *environ) CloseModelEgressPorts(ctx context.Context, rules firewall.EgressRules) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	// EC2 allows the revocation of permissions that aren't granted, so
+	// this is naturally idempotent.
+	g, err := e.groupByName(ctx, e.jujuGroupName())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = e.ec2Client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+		GroupId:       g.GroupId,
+		IpPermissions: egressRulesToIPPerms(rules),
+	})
+	if err != nil {
+		return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot close egress ports")
+	}
+
+	// Once the last rule is closed, all outgoing traffic is allowed
+	// again.
+	remaining, err := e.ModelEgressRules(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(remaining) == 0 {
+		return errors.Trace(e.allowAllModelEgress(ctx))
+	}
+	return nil
+}
+
+// ModelEgressRules is part of the models.ModelEgressFirewaller interface.
+// The default egress rule of the security group, which allows all
+// outgoing traffic, and the rules allowing traffic to the DNS resolver
+// are not included.
+func (e *environ) ModelEgressRules(ctx context.Context) (firewall.EgressRules, error) {
+	group, err := e.groupByName(ctx, e.jujuGroupName())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	resolverCIDRs, err := e.dnsResolverCIDRs(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return egressRulesFromGroup(group, set.NewStrings(resolverCIDRs...))
+}
+
+// egressRulesFromGroup returns the egress rules added to the security
+// group, leaving out its default egress rule, the rules allowing traffic
+// to other groups and the rules allowing DNS traffic to the given resolver
+// CIDRs.
+func egressRulesFromGroup(group types.SecurityGroup, resolverCIDRs set.Strings) (firewall.EgressRules, error) {
+	var rules firewall.EgressRules
+	for _, p := range group.IpPermissionsEgress {
+		protocol := aws.ToString(p.IpProtocol)
+		// The default egress rule allows all protocols, which is not a
+		// port range, and icmpv6 rules are not represented well in the
+		// juju model.
+		if protocol == "-1" || protocol == "icmpv6" || len(p.UserIdGroupPairs) > 0 {
+			continue
+		}
+		isDNS := (protocol == "tcp" || protocol == "udp") &&
+			aws.ToInt32(p.FromPort) == 53 && aws.ToInt32(p.ToPort) == 53
+		var destinationCIDRs []string
+		for _, r := range p.IpRanges {
+			if isDNS && resolverCIDRs.Contains(aws.ToString(r.CidrIp)) {
+				continue
+			}
+			destinationCIDRs = append(destinationCIDRs, aws.ToString(r.CidrIp))
+		}
+		for _, r := range p.Ipv6Ranges {
+			destinationCIDRs = append(destinationCIDRs, aws.ToString(r.CidrIpv6))
+		}
+		if len(destinationCIDRs) == 0 {
+			continue
+		}
+		portRange := network.PortRange{
+			Protocol: protocol,
+			FromPort: int(aws.ToInt32(p.FromPort)),
+			ToPort:   int(aws.ToInt32(p.ToPort)),
+		}
+		rules = append(rules, firewall.NewEgressRule(portRange, destinationCIDRs...))
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	rules.Sort()
+	return rules, nil
+}
+
+// restrictModelEgress removes the default egress rule from all the
+// security groups of the model, after allowing traffic from the model
+// security group to itself and DNS traffic to the resolver of the VPC, so
+// that the machines of the model can still reach each other and resolve
+// names.
+func (e *environ) restrictModelEgress(ctx context.Context, modelGroup types.SecurityGroup) error {
+	resolverCIDRs, err := e.dnsResolverCIDRs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	internal := make([]types.IpPermission, 0, 5)
+	for _, protocol := range []string{"tcp", "udp", "icmp"} {
+		perm := types.IpPermission{
+			IpProtocol: aws.String(protocol),
+			FromPort:   aws.Int32(0),
+			ToPort:     aws.Int32(65535),
+			UserIdGroupPairs: []types.UserIdGroupPair{{
+				GroupId:     modelGroup.GroupId,
+				Description: internalEgressPermissionDescription,
+			}},
+		}
+		if protocol == "icmp" {
+			perm.FromPort, perm.ToPort = aws.Int32(-1), aws.Int32(-1)
+		}
+		internal = append(internal, perm)
+	}
+	for _, protocol := range []string{"tcp", "udp"} {
+		perm := types.IpPermission{
+			IpProtocol: aws.String(protocol),
+			FromPort:   aws.Int32(53),
+			ToPort:     aws.Int32(53),
+		}
+		for _, cidr := range resolverCIDRs {
+			perm.IpRanges = append(perm.IpRanges, types.IpRange{
+				CidrIp:      aws.String(cidr),
+				Description: internalEgressPermissionDescription,
+			})
+		}
+		internal = append(internal, perm)
+	}
+	for _, perm := range internal {
+		_, err := e.ec2Client.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       modelGroup.GroupId,
+			IpPermissions: []types.IpPermission{perm},
+		})
+		if err != nil && ec2ErrCode(err) != "InvalidPermission.Duplicate" {
+			return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot allow internal model egress")
+		}
+	}
+
+	groups, err := e.modelSecurityGroups(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, g := range groups {
+		if err := e.revokeDefaultEgress(ctx, g.GroupId); err != nil {
+			return errors.Annotatef(err, "cannot restrict egress of security group %q", aws.ToString(g.GroupName))
+		}
+	}
+	return nil
+}
+
+// dnsResolverCIDRs returns the CIDRs of the DNS resolver of the VPC used
+// by the model: the Amazon DNS server's link-local address, and the
+// address two above the base of the VPC network.
+func (e *environ) dnsResolverCIDRs(ctx context.Context) ([]string, error) {
+	cidrs := []string{amazonDNSAddress + "/32"}
+
+	var vpc *types.Vpc
+	if vpcID := e.ecfg().vpcID(); isVPCIDSet(vpcID) {
+		var err error
+		if vpc, err = getVPCByID(ctx, e.ec2Client, vpcID); err != nil {
+			return nil, errors.Trace(e.HandleCredentialError(ctx, err))
+		}
+	} else {
+		hasDefaultVPC, err := e.hasDefaultVPC(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !hasDefaultVPC {
+			return cidrs, nil
+		}
+		vpc = e.defaultVPC
+	}
+
+	_, vpcNet, err := net.ParseCIDR(aws.ToString(vpc.CidrBlock))
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing CIDR of VPC %q", aws.ToString(vpc.VpcId))
+	}
+	resolver := vpcNet.IP.To4()
+	if resolver == nil {
+		return cidrs, nil
+	}
+	resolver[3] += 2
+	return append(cidrs, resolver.String()+"/32"), nil
+}
+
+// revokeDefaultEgress removes the default egress rule from the security
+// group with the given ID.
+func (e *environ) revokeDefaultEgress(ctx context.Context, groupID *string) error {
+	for _, perm := range defaultEgressPerms {
+		_, err := e.ec2Client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       groupID,
+			IpPermissions: []types.IpPermission{perm},
+		})
+		if err != nil && ec2ErrCode(err) != "InvalidPermission.NotFound" {
+			return e.HandleCredentialError(ctx, err)
+		}
+	}
+	return nil
+}
+
+// allowAllModelEgress restores the default egress rule of all the security
+// groups of the model.
+func (e *environ) allowAllModelEgress(ctx context.Context) error {
+	groups, err := e.modelSecurityGroups(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, g := range groups {
+		for _, perm := range defaultEgressPerms {
+			_, err := e.ec2Client.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       g.GroupId,
+				IpPermissions: []types.IpPermission{perm},
+			})
+			if err != nil && ec2ErrCode(err) != "InvalidPermission.Duplicate" {
+				return errors.Annotatef(e.HandleCredentialError(ctx, err), "cannot allow egress of security group %q", aws.ToString(g.GroupName))
+			}
+		}
+	}
+	return nil
+}
+
+func egressRulesToIPPerms(rules firewall.EgressRules) []types.IpPermission {
+	ipPerms := make([]types.IpPermission, len(rules))
+	for i, r := range rules {
+		ipPerms[i] = types.IpPermission{
+			IpProtocol: aws.String(r.PortRange.Protocol),
+			FromPort:   aws.Int32(int32(r.PortRange.FromPort)),
+			ToPort:     aws.Int32(int32(r.PortRange.ToPort)),
+		}
+		cidrs := r.DestinationCIDRs.SortedValues()
+		if len(cidrs) == 0 {
+			cidrs = []string{defaultRouteIpv4CIDRBlock, defaultRouteIPv6CIDRBlock}
+		}
+		for _, cidr := range cidrs {
+			description := aws.String(fmt.Sprintf("juju egress to %s on %s", cidr, r.PortRange))
+			// CIDRs are pre-validated; if an invalid CIDR
+			// reaches this loop, it will be skipped.
+			addrType, _ := network.CIDRAddressType(cidr)
+			if addrType == network.IPv4Address {
+				ipPerms[i].IpRanges = append(ipPerms[i].IpRanges, types.IpRange{CidrIp: aws.String(cidr), Description: description})
+			} else if addrType == network.IPv6Address {
+				ipPerms[i].Ipv6Ranges = append(ipPerms[i].Ipv6Ranges, types.Ipv6Range{CidrIpv6: aws.String(cidr), Description: description})
+			}
+		}
+	}
+	return ipPerms
+}
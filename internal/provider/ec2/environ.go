@@ -2330,6 +2330,18 @@ func (e *environ) setUpGroups(ctx context.Context, controllerUUID, machineId str
 	if err != nil {
 		return nil, err
 	}
+
+	// If outgoing traffic is restricted by model egress rules, the new
+	// group must not allow all outgoing traffic either.
+	egressRules, err := egressRulesFromGroup(jujuGroup, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(egressRules) > 0 {
+		if err := e.revokeDefaultEgress(ctx, machineGroup.GroupId); err != nil {
+			return nil, errors.Annotatef(err, "cannot restrict egress of security group %q", aws.ToString(machineGroup.GroupName))
+		}
+	}
 	return []string{aws.ToString(jujuGroup.GroupId), aws.ToString(machineGroup.GroupId)}, nil
 }
 
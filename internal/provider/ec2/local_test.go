@@ -3087,6 +3087,169 @@ func (t *localServerSuite) TestModelPorts(c *tc.C) {
 	})
 }
 
+func (t *localServerSuite) TestModelEgressPorts(c *tc.C) {
+	t.prepareAndBootstrap(c)
+
+	fwModelEnv, ok := t.Env.(models.ModelEgressFirewaller)
+	c.Assert(ok, tc.Equals, true)
+
+	rules, err := fwModelEnv.ModelEgressRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.HasLen, 0)
+
+	err = fwModelEnv.OpenModelEgressPorts(c.Context(),
+		firewall.EgressRules{
+			firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+			firewall.NewEgressRule(network.MustParsePortRange("53/udp")),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Opening existing rules again is not an error.
+	err = fwModelEnv.OpenModelEgressPorts(c.Context(),
+		firewall.EgressRules{
+			firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+			firewall.NewEgressRule(network.MustParsePortRange("123/udp"), "10.1.0.0/16"),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err = fwModelEnv.ModelEgressRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.SameContents, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("123/udp"), "10.1.0.0/16"),
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), firewall.AllNetworksIPV4CIDR),
+	})
+
+	err = fwModelEnv.CloseModelEgressPorts(c.Context(),
+		firewall.EgressRules{
+			firewall.NewEgressRule(network.MustParsePortRange("53/udp")),
+			firewall.NewEgressRule(network.MustParsePortRange("123/udp"), "10.1.0.0/16"),
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	rules, err = fwModelEnv.ModelEgressRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+	})
+}
+
+func (t *localServerSuite) TestModelEgressPortsRestrictDefaultEgress(c *tc.C) {
+	t.prepareAndBootstrap(c)
+
+	fwModelEnv, ok := t.Env.(models.ModelEgressFirewaller)
+	c.Assert(ok, tc.Equals, true)
+
+	ec2conn := ec2.EnvironEC2Client(t.Env)
+	describeGroups := func(names ...string) map[string]types.SecurityGroup {
+		resp, err := ec2conn.DescribeSecurityGroups(c.Context(), &awsec2.DescribeSecurityGroupsInput{
+			GroupNames: names,
+		})
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(resp.SecurityGroups, tc.HasLen, len(names))
+		groups := make(map[string]types.SecurityGroup)
+		for _, g := range resp.SecurityGroups {
+			groups[aws.ToString(g.GroupName)] = g
+		}
+		return groups
+	}
+	hasDefaultEgress := func(g types.SecurityGroup) bool {
+		for _, p := range g.IpPermissionsEgress {
+			if aws.ToString(p.IpProtocol) == "-1" {
+				return true
+			}
+		}
+		return false
+	}
+
+	modelGroup := ec2.JujuGroupName(t.Env)
+	machine0Group := ec2.MachineGroupName(t.Env, "0")
+	for name, g := range describeGroups(modelGroup, machine0Group) {
+		c.Check(hasDefaultEgress(g), tc.IsTrue, tc.Commentf("group %q", name))
+	}
+
+	rules := firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+	}
+	err := fwModelEnv.OpenModelEgressPorts(c.Context(), rules)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Groups created once the rules are set don't allow all outgoing
+	// traffic either.
+	inst1, _ := testing.AssertStartInstance(c, t.Env, t.ControllerUUID, "1")
+	defer t.Env.StopInstances(c.Context(), inst1.Id())
+	machine1Group := ec2.MachineGroupName(t.Env, "1")
+
+	groups := describeGroups(modelGroup, machine0Group, machine1Group)
+	for name, g := range groups {
+		c.Check(hasDefaultEgress(g), tc.IsFalse, tc.Commentf("group %q", name))
+	}
+
+	// Traffic between the machines of the model is still allowed.
+	var internal []string
+	for _, p := range groups[modelGroup].IpPermissionsEgress {
+		for _, pair := range p.UserIdGroupPairs {
+			if aws.ToString(pair.GroupId) == aws.ToString(groups[modelGroup].GroupId) {
+				internal = append(internal, aws.ToString(p.IpProtocol))
+			}
+		}
+	}
+	c.Check(internal, tc.SameContents, []string{"tcp", "udp", "icmp"})
+
+	// The rules reported don't include the internal rules.
+	got, err := fwModelEnv.ModelEgressRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, rules)
+
+	// Closing the last rule allows all outgoing traffic again.
+	err = fwModelEnv.CloseModelEgressPorts(c.Context(), rules)
+	c.Assert(err, tc.ErrorIsNil)
+	for name, g := range describeGroups(modelGroup, machine0Group, machine1Group) {
+		c.Check(hasDefaultEgress(g), tc.IsTrue, tc.Commentf("group %q", name))
+	}
+}
+
+func (t *localServerSuite) TestModelEgressPortsAllowDNSResolver(c *tc.C) {
+	t.prepareAndBootstrap(c)
+
+	fwModelEnv, ok := t.Env.(models.ModelEgressFirewaller)
+	c.Assert(ok, tc.Equals, true)
+
+	rules := firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("53/udp"), "10.0.0.0/8"),
+	}
+	err := fwModelEnv.OpenModelEgressPorts(c.Context(), rules)
+	c.Assert(err, tc.ErrorIsNil)
+
+	ec2conn := ec2.EnvironEC2Client(t.Env)
+	resp, err := ec2conn.DescribeSecurityGroups(c.Context(), &awsec2.DescribeSecurityGroupsInput{
+		GroupNames: []string{ec2.JujuGroupName(t.Env)},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(resp.SecurityGroups, tc.HasLen, 1)
+
+	// DNS traffic to the Amazon DNS server and to the resolver at the base
+	// of the default VPC network (10.10.0.0/16) plus two is allowed.
+	dns := make(map[string][]string)
+	for _, p := range resp.SecurityGroups[0].IpPermissionsEgress {
+		if aws.ToInt32(p.FromPort) != 53 {
+			continue
+		}
+		for _, r := range p.IpRanges {
+			dns[aws.ToString(p.IpProtocol)] = append(dns[aws.ToString(p.IpProtocol)], aws.ToString(r.CidrIp))
+		}
+	}
+	c.Check(dns["tcp"], tc.SameContents, []string{"169.254.169.253/32", "10.10.0.2/32"})
+	c.Check(dns["udp"], tc.SameContents, []string{"169.254.169.253/32", "10.10.0.2/32", "10.0.0.0/8"})
+
+	// The rules reported don't include the DNS resolver rules, even when
+	// they share a port range with a rule of the model.
+	got, err := fwModelEnv.ModelEgressRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, rules)
+}
+
 func (t *localServerSuite) TestBootstrapMultiple(c *tc.C) {
 	// bootstrap.Bootstrap no longer raises errors if the environment is
 	// already up, this has been moved into the bootstrap command.
@@ -13,12 +13,16 @@ import (
 	agentprovisionerservice "github.com/juju/juju/domain/agentprovisioner/service"
 	annotationService "github.com/juju/juju/domain/annotation/service"
 	applicationservice "github.com/juju/juju/domain/application/service"
+	auditlogservice "github.com/juju/juju/domain/auditlog/service"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
 	changestreamservice "github.com/juju/juju/domain/changestream/service"
+	charmmirrorservice "github.com/juju/juju/domain/charmmirror/service"
+	charmregistryservice "github.com/juju/juju/domain/charmregistry/service"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
 	cloudimagemetadataservice "github.com/juju/juju/domain/cloudimagemetadata/service"
+	configsnapshotservice "github.com/juju/juju/domain/configsnapshot/service"
 	controllerservice "github.com/juju/juju/domain/controller/service"
 	controllerconfigservice "github.com/juju/juju/domain/controllerconfig/service"
 	controllernodeservice "github.com/juju/juju/domain/controllernode/service"
@@ -107,6 +111,14 @@ type ControllerDomainServices interface {
 	Logging() *loggingservice.WatchableService
 	// SSHServerHostKey returns the service for controller SSH server host keys.
 	SSHServerHostKey() *sshcontrollerservice.Service
+	// AuditLog returns the service for recording and querying the audit log.
+	AuditLog() *auditlogservice.Service
+	// CharmRegistry returns the service holding the credentials used to
+	// pull charms from OCI registries.
+	CharmRegistry() *charmregistryservice.Service
+	// CharmMirror returns the service holding the charm and bundle
+	// revisions served by the controller's Charmhub mirror.
+	CharmMirror() *charmmirrorservice.Service
 }
 
 // ModelDomainServices provides access to the services required by the
@@ -122,8 +134,13 @@ type ModelDomainServices interface {
 	AgentBinaryStore() *agentbinaryservice.AgentBinaryStore
 	// Annotation returns the annotation service.
 	Annotation() *annotationService.Service
+	// ConfigSnapshot returns the config snapshot service.
+	ConfigSnapshot() *configsnapshotservice.Service
 	// Config returns the model config service.
 	Config() *modelconfigservice.WatchableService
+	// ControllerNodeCluster returns the service reporting the membership of
+	// the controller nodes in the Dqlite cluster.
+	ControllerNodeCluster() *controllernodeservice.ClusterService
 	// ControllerUpgrader returns a service for upgrading controllers.
 	ControllerUpgrader() *controllerupgraderservice.Service
 	// CrossModelRelation returns a service for managing cross model relations.
@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/common"
 	"github.com/juju/juju/internal/worker/gate"
@@ -322,6 +323,11 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		return nil, errors.Trace(err)
 	}
 
+	var oidcProvider *oidc.Provider
+	if err := getter.Get(config.JWTParserName, &oidcProvider); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	// Register the metrics collector against the prometheus register.
 	metricsCollector := config.NewMetricsCollector()
 	if err := config.PrometheusRegisterer.Register(metricsCollector); err != nil {
@@ -340,6 +346,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		UpgradeComplete:                   upgradeLock.IsUnlocked,
 		LocalMacaroonAuthenticator:        macaroonAuthenticator,
 		JWTParser:                         jwtParser,
+		OIDCProvider:                      oidcProvider,
 		GetAuditConfig:                    getAuditConfig,
 		NewServer:                         newServerShim,
 		MetricsCollector:                  metricsCollector,
@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	accessservice "github.com/juju/juju/domain/access/service"
 	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/testhelpers"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -69,6 +70,7 @@ type ManifoldSuite struct {
 	objectStoreGetter       stubObjectStoreGetter
 	watcherRegistryGetter   *stubWatcherRegistryGetter
 	jwtParser               *jwtparser.Parser
+	oidcProvider            *oidc.Provider
 	flightRecorder          flightrecorder.FlightRecorder
 	providerFactory         *MockProviderFactory
 	provider                *MockProvider
@@ -109,6 +111,7 @@ func (s *ManifoldSuite) setupMocks(c *tc.C) *gomock.Controller {
 		client: s.charmhubHTTPClient,
 	}
 	s.jwtParser = &jwtparser.Parser{}
+	s.oidcProvider = &oidc.Provider{}
 	s.stub.ResetCalls()
 	s.domainServicesGetter = &stubDomainServicesGetter{}
 	s.watcherRegistryGetter = &stubWatcherRegistryGetter{}
@@ -159,6 +162,7 @@ func (s *ManifoldSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.macaroonHTTPClient = nil
 		s.httpClientGetter = nil
 		s.jwtParser = nil
+		s.oidcProvider = nil
 		s.domainServicesGetter = nil
 		s.watcherRegistryGetter = nil
 		s.flightRecorder = flightrecorder.NoopRecorder{}
@@ -181,7 +185,7 @@ func (s *ManifoldSuite) newGetter(overlay map[string]any) dependency.Getter {
 		"domain-services":     s.domainServicesGetter,
 		"trace":               s.tracerGetter,
 		"object-store":        s.objectStoreGetter,
-		"jwt-parser":          s.jwtParser,
+		"jwt-parser":          []any{s.jwtParser, s.oidcProvider},
 		"watcher-registry":    s.watcherRegistryGetter,
 		"flight-recorder":     s.flightRecorder,
 		"provider-tracker":    s.providerFactory,
@@ -276,6 +280,7 @@ func (s *ManifoldSuite) TestStart(c *tc.C) {
 		ObjectStoreGetter:          s.objectStoreGetter,
 		ModelService:               s.modelService,
 		JWTParser:                  s.jwtParser,
+		OIDCProvider:               s.oidcProvider,
 		WatcherRegistryGetter:      s.watcherRegistryGetter,
 		FlightRecorder:             s.flightRecorder,
 		EphemeralProviderFactory:   s.providerFactory,
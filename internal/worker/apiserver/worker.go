@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/trace"
 	"github.com/juju/juju/internal/worker/watcherregistry"
@@ -44,6 +45,7 @@ type Config struct {
 	Mux                               *apiserverhttp.Mux
 	LocalMacaroonAuthenticator        macaroon.LocalMacaroonAuthenticator
 	JWTParser                         *jwtparser.Parser
+	OIDCProvider                      *oidc.Provider
 	LeaseManager                      lease.Manager
 	FlightRecorder                    flightrecorder.FlightRecorder
 	LogSink                           corelogger.ModelLogger
@@ -142,6 +144,9 @@ func (config Config) Validate() error {
 	if config.JWTParser == nil {
 		return errors.NotValidf("nil JWTParser")
 	}
+	if config.OIDCProvider == nil {
+		return errors.NotValidf("nil OIDCProvider")
+	}
 	if config.WatcherRegistryGetter == nil {
 		return errors.NotValidf("nil WatcherRegistryGetter")
 	}
@@ -186,6 +191,7 @@ func NewWorker(ctx context.Context, config Config) (worker.Worker, error) {
 		ControllerModelUUID:           controllerModel.UUID,
 		LocalMacaroonAuthenticator:    config.LocalMacaroonAuthenticator,
 		JWTAuthenticator:              jwt.NewAuthenticator(config.JWTParser),
+		OIDCProvider:                  config.OIDCProvider,
 		UpgradeComplete:               config.UpgradeComplete,
 		PublicDNSName:                 controllerConfig.AutocertDNSName(),
 		AllowModelAccess:              controllerConfig.AllowModelAccess(),
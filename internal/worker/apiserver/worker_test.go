@@ -23,6 +23,7 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/testhelpers"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -57,6 +58,7 @@ type workerFixture struct {
 	controllerUUID           string
 	controllerModelUUID      model.UUID
 	jwtParser                *jwtparser.Parser
+	oidcProvider             *oidc.Provider
 	flightRecorder           flightrecorder.FlightRecorder
 	ephemeralProviderFactory *MockProviderFactory
 }
@@ -86,6 +88,7 @@ func (s *workerFixture) setupMocks(c *tc.C) *gomock.Controller {
 	s.controllerModelUUID = tc.Must0(c, model.NewUUID)
 	s.stub.ResetCalls()
 	s.jwtParser = &jwtparser.Parser{}
+	s.oidcProvider = &oidc.Provider{}
 	s.watcherRegistryGetter = &stubWatcherRegistryGetter{}
 	s.flightRecorder = flightrecorder.NoopRecorder{}
 	s.ephemeralProviderFactory = NewMockProviderFactory(ctrl)
@@ -118,6 +121,7 @@ func (s *workerFixture) setupMocks(c *tc.C) *gomock.Controller {
 		TracerGetter:                      s.tracerGetter,
 		ObjectStoreGetter:                 s.objectStoreGetter,
 		JWTParser:                         s.jwtParser,
+		OIDCProvider:                      s.oidcProvider,
 		WatcherRegistryGetter:             s.watcherRegistryGetter,
 		FlightRecorder:                    s.flightRecorder,
 		EphemeralProviderFactory:          s.ephemeralProviderFactory,
@@ -134,6 +138,7 @@ func (s *workerFixture) setupMocks(c *tc.C) *gomock.Controller {
 		s.charmhubHTTPClient = nil
 		s.macaroonHTTPClient = nil
 		s.jwtParser = nil
+		s.oidcProvider = nil
 		s.domainServicesGetter = nil
 		s.watcherRegistryGetter = nil
 		s.flightRecorder = flightrecorder.NoopRecorder{}
@@ -233,6 +238,9 @@ func (s *WorkerValidationSuite) TestValidateErrors(c *tc.C) {
 	}, {
 		f:      func(cfg *apiserver.Config) { cfg.JWTParser = nil },
 		expect: "nil JWTParser not valid",
+	}, {
+		f:      func(cfg *apiserver.Config) { cfg.OIDCProvider = nil },
+		expect: "nil OIDCProvider not valid",
 	}, {
 		f:      func(cfg *apiserver.Config) { cfg.WatcherRegistryGetter = nil },
 		expect: "nil WatcherRegistryGetter not valid",
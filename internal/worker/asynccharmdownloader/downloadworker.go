@@ -18,6 +18,8 @@ import (
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/watcher"
 	domainapplication "github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/charmregistry"
+	"github.com/juju/juju/domain/deployment/charm/repository"
 	"github.com/juju/juju/internal/errors"
 	internalworker "github.com/juju/juju/internal/worker"
 )
@@ -51,9 +53,19 @@ type ApplicationService interface {
 	ResolveCharmDownload(ctx context.Context, appID application.UUID, resolve domainapplication.ResolveCharmDownload) error
 }
 
+// CharmRegistryService provides the credentials held by the controller for
+// OCI registries.
+type CharmRegistryService interface {
+	// GetCredential returns the credential for the registry. If there is no
+	// credential, an error satisfying [charmregistryerrors.CredentialNotFound]
+	// is returned.
+	GetCredential(ctx context.Context, registry string) (charmregistry.Credential, error)
+}
+
 // Config defines the operation of a Worker.
 type Config struct {
 	ApplicationService     ApplicationService
+	CharmRegistryService   CharmRegistryService
 	HTTPClientGetter       corehttp.HTTPClientGetter
 	NewHTTPClient          NewHTTPClientFunc
 	NewDownloader          NewDownloaderFunc
@@ -67,6 +79,9 @@ func (cfg Config) Validate() error {
 	if cfg.ApplicationService == nil {
 		return jujuerrors.NotValidf("nil ApplicationService")
 	}
+	if cfg.CharmRegistryService == nil {
+		return jujuerrors.NotValidf("nil CharmRegistryService")
+	}
 	if cfg.HTTPClientGetter == nil {
 		return jujuerrors.NotValidf("nil HTTPClientGetter")
 	}
@@ -220,7 +235,8 @@ func (w *Worker) loop() error {
 					if err != nil {
 						return errors.Capture(err)
 					}
-					downloader = w.config.NewDownloader(httpClient, logger)
+					credentials := repository.OCICredentials(w.config.CharmRegistryService)
+					downloader = w.config.NewDownloader(httpClient, credentials, logger)
 				}
 
 				// Kick off the async download worker for the application.
@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/internal/charmhub"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/ocicharm"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/testing"
 )
@@ -51,6 +52,10 @@ func (s *workerSuite) TestWorkerConfig(c *tc.C) {
 	cfg.ApplicationService = nil
 	c.Assert(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	cfg = s.newConfig(c)
+	cfg.CharmRegistryService = nil
+	c.Assert(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	cfg = s.newConfig(c)
 	cfg.HTTPClientGetter = nil
 	c.Assert(cfg.Validate(), tc.ErrorIs, errors.NotValid)
@@ -341,12 +346,13 @@ func (s *workerSuite) newWorker(c *tc.C) *Worker {
 
 func (s *workerSuite) newConfig(c *tc.C) Config {
 	return Config{
-		ApplicationService: s.applicationService,
-		HTTPClientGetter:   s.httpClientGetter,
+		ApplicationService:   s.applicationService,
+		CharmRegistryService: s.registryService,
+		HTTPClientGetter:     s.httpClientGetter,
 		NewHTTPClient: func(ctx context.Context, hg http.HTTPClientGetter) (http.HTTPClient, error) {
 			return hg.GetHTTPClient(ctx, http.CharmhubPurpose)
 		},
-		NewDownloader: func(charmhub.HTTPClient, logger.Logger) Downloader {
+		NewDownloader: func(charmhub.HTTPClient, ocicharm.CredentialsFunc, logger.Logger) Downloader {
 			return s.downloader
 		},
 		NewAsyncDownloadWorker: func(appUUID application.UUID, applicationService ApplicationService, downloader Downloader, clock clock.Clock, logger logger.Logger) worker.Worker {
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/domain/deployment/charm/charmdownloader"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/ocicharm"
 	"github.com/juju/juju/internal/services"
 	internalworker "github.com/juju/juju/internal/worker"
 )
@@ -36,8 +37,9 @@ type Downloader interface {
 	Download(ctx context.Context, curl *url.URL, hash string) (*charmdownloader.DownloadResult, error)
 }
 
-// NewDownloaderFunc is a function that creates a new Downloader.
-type NewDownloaderFunc func(charmhub.HTTPClient, logger.Logger) Downloader
+// NewDownloaderFunc is a function that creates a new Downloader. The
+// credentials are used to download charms from OCI registries.
+type NewDownloaderFunc func(charmhub.HTTPClient, ocicharm.CredentialsFunc, logger.Logger) Downloader
 
 // NewHTTPClientFunc is a function that creates a new HTTP client.
 type NewHTTPClientFunc func(context.Context, corehttp.HTTPClientGetter) (corehttp.HTTPClient, error)
@@ -118,6 +120,7 @@ func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (
 
 	w, err := NewWorker(Config{
 		ApplicationService:     domainServices.Application(),
+		CharmRegistryService:   domainServices.CharmRegistry(),
 		HTTPClientGetter:       httpClientGetter,
 		NewHTTPClient:          cfg.NewHTTPClient,
 		NewDownloader:          cfg.NewDownloader,
@@ -136,8 +139,46 @@ func NewHTTPClient(ctx context.Context, getter corehttp.HTTPClientGetter) (coreh
 	return getter.GetHTTPClient(ctx, corehttp.CharmhubPurpose)
 }
 
-// NewDownloader creates a new Downloader instance.
-func NewDownloader(httpClient charmhub.HTTPClient, logger logger.Logger) Downloader {
-	downloadClient := charmhub.NewDownloadClient(httpClient, charmhub.DefaultFileSystem(), logger)
-	return charmdownloader.NewCharmDownloader(downloadClient, logger)
+// NewDownloader creates a new Downloader instance. Charms with an "oci://"
+// download URL are pulled from the OCI registry, all others from Charmhub.
+// The registry credentials are also used for Charmhub mirrors served by
+// other controllers.
+func NewDownloader(httpClient charmhub.HTTPClient, credentials ocicharm.CredentialsFunc, logger logger.Logger) Downloader {
+	charmhubHTTPClient := httpClient
+	if credentials != nil {
+		charmhubHTTPClient = charmhub.NewCredentialsHTTPClient(httpClient,
+			func(ctx context.Context, host string) (charmhub.Credentials, error) {
+				cred, err := credentials(ctx, host)
+				return charmhub.Credentials(cred), err
+			})
+	}
+	client := downloadClient{
+		charmhub: charmhub.NewDownloadClient(charmhubHTTPClient, charmhub.DefaultFileSystem(), logger),
+	}
+	if ociClient, err := ocicharm.NewClient(ocicharm.Config{
+		HTTPClient:  httpClient,
+		Credentials: credentials,
+		Logger:      logger.Child("ocicharm"),
+	}); err == nil {
+		client.oci = ociClient
+	}
+	return charmdownloader.NewCharmDownloader(client, logger)
+}
+
+// downloadClient dispatches downloads on the scheme of the download URL.
+type downloadClient struct {
+	charmhub charmdownloader.DownloadClient
+	oci      charmdownloader.DownloadClient
+}
+
+// Download retrieves the charm from the store of the URL and saves its
+// contents to the specified path.
+func (c downloadClient) Download(ctx context.Context, url *url.URL, path string, options ...charmhub.DownloadOption) (*charmhub.Digest, error) {
+	if url.Scheme != ocicharm.Scheme {
+		return c.charmhub.Download(ctx, url, path, options...)
+	}
+	if c.oci == nil {
+		return nil, errors.Errorf("downloading %q: OCI registry client not available", url)
+	}
+	return c.oci.Download(ctx, url, path, options...)
 }
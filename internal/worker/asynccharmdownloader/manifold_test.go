@@ -4,12 +4,15 @@
 package asynccharmdownloader
 
 import (
+	"context"
+	"net/url"
 	"testing"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/internal/charmhub"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
 )
@@ -86,3 +89,36 @@ func (s *ManifoldConfigSuite) checkNotValid(c *tc.C, expect string) {
 	c.Check(err, tc.ErrorMatches, expect)
 	c.Check(err, tc.ErrorIs, errors.NotValid)
 }
+
+func (s *ManifoldConfigSuite) TestDownloadClientDispatchesOnScheme(c *tc.C) {
+	charmhubClient := &stubDownloadClient{digest: &charmhub.Digest{SHA256: "charmhub"}}
+	ociClient := &stubDownloadClient{digest: &charmhub.Digest{SHA256: "oci"}}
+	client := downloadClient{charmhub: charmhubClient, oci: ociClient}
+
+	digest, err := client.Download(c.Context(), mustParseURL(c, "https://api.charmhub.io/foo"), "/tmp/foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(digest.SHA256, tc.Equals, "charmhub")
+
+	digest, err = client.Download(c.Context(), mustParseURL(c, "oci://registry.example.com/charms/foo@sha256:abc"), "/tmp/foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(digest.SHA256, tc.Equals, "oci")
+
+	c.Check(charmhubClient.calls, tc.Equals, 1)
+	c.Check(ociClient.calls, tc.Equals, 1)
+}
+
+type stubDownloadClient struct {
+	digest *charmhub.Digest
+	calls  int
+}
+
+func (s *stubDownloadClient) Download(context.Context, *url.URL, string, ...charmhub.DownloadOption) (*charmhub.Digest, error) {
+	s.calls++
+	return s.digest, nil
+}
+
+func mustParseURL(c *tc.C, s string) *url.URL {
+	u, err := url.Parse(s)
+	c.Assert(err, tc.ErrorIsNil)
+	return u
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/asynccharmdownloader (interfaces: ApplicationService,Downloader)
+// Source: github.com/juju/juju/internal/worker/asynccharmdownloader (interfaces: ApplicationService,CharmRegistryService,Downloader)
 //
 // Generated by this command:
 //
-//	mockgen -package asynccharmdownloader -destination package_mocks_test.go github.com/juju/juju/internal/worker/asynccharmdownloader ApplicationService,Downloader
+//	mockgen -package asynccharmdownloader -destination package_mocks_test.go github.com/juju/juju/internal/worker/asynccharmdownloader ApplicationService,CharmRegistryService,Downloader
 //
 
 // Package asynccharmdownloader is a generated GoMock package.
@@ -17,6 +17,7 @@ import (
 	application "github.com/juju/juju/core/application"
 	watcher "github.com/juju/juju/core/watcher"
 	application0 "github.com/juju/juju/domain/application"
+	charmregistry "github.com/juju/juju/domain/charmregistry"
 	charmdownloader "github.com/juju/juju/domain/deployment/charm/charmdownloader"
 )
 
@@ -101,6 +102,49 @@ func (mr *MockApplicationServiceMockRecorder) WatchApplicationsWithPendingCharms
 // MockApplicationServiceWatchApplicationsWithPendingCharmsCall is the typed call wrapper for WatchApplicationsWithPendingCharms.
 type MockApplicationServiceWatchApplicationsWithPendingCharmsCall = gomock.Call1_2[context.Context, watcher.StringsWatcher, error]
 
+// MockCharmRegistryService is a mock of CharmRegistryService interface.
+type MockCharmRegistryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmRegistryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCharmRegistryServiceMockRecorder is the mock recorder for MockCharmRegistryService.
+type MockCharmRegistryServiceMockRecorder struct {
+	mock                 *MockCharmRegistryService
+	getCredentialExpects []*gomock.Call2_2[context.Context, string, charmregistry.Credential, error]
+}
+
+// NewMockCharmRegistryService creates a new mock instance.
+func NewMockCharmRegistryService(ctrl *gomock.Controller) *MockCharmRegistryService {
+	mock := &MockCharmRegistryService{ctrl: ctrl}
+	mock.recorder = &MockCharmRegistryServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmRegistryService) EXPECT() *MockCharmRegistryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCredential mocks base method.
+func (m *MockCharmRegistryService) GetCredential(ctx context.Context, registry string) (charmregistry.Credential, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getCredentialExpects, m.ctrl, m, "GetCredential", ctx, registry)
+}
+
+// GetCredential indicates an expected call of GetCredential.
+func (mr *MockCharmRegistryServiceMockRecorder) GetCredential(ctx, registry any) *MockCharmRegistryServiceGetCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, charmregistry.Credential, error](mr.mock.ctrl.T, mr.mock, "GetCredential", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(registry))
+	mr.getCredentialExpects = append(mr.getCredentialExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmRegistryServiceGetCredentialCall is the typed call wrapper for GetCredential.
+type MockCharmRegistryServiceGetCredentialCall = gomock.Call2_2[context.Context, string, charmregistry.Credential, error]
+
 // MockDownloader is a mock of Downloader interface.
 type MockDownloader struct {
 	ctrl     *gomock.Controller
@@ -12,7 +12,7 @@ import (
 	"github.com/juju/juju/internal/testhelpers"
 )
 
-//go:generate go run github.com/canonical/gomock/mockgen -package asynccharmdownloader -destination package_mocks_test.go github.com/juju/juju/internal/worker/asynccharmdownloader ApplicationService,Downloader
+//go:generate go run github.com/canonical/gomock/mockgen -package asynccharmdownloader -destination package_mocks_test.go github.com/juju/juju/internal/worker/asynccharmdownloader ApplicationService,CharmRegistryService,Downloader
 //go:generate go run github.com/canonical/gomock/mockgen -package asynccharmdownloader -destination clock_mocks_test.go github.com/juju/clock Clock
 //go:generate go run github.com/canonical/gomock/mockgen -package asynccharmdownloader -destination http_mocks_test.go github.com/juju/juju/core/http HTTPClientGetter,HTTPClient
 
@@ -20,6 +20,7 @@ type baseSuite struct {
 	testhelpers.IsolationSuite
 
 	applicationService *MockApplicationService
+	registryService    *MockCharmRegistryService
 	downloader         *MockDownloader
 	clock              *MockClock
 	httpClientGetter   *MockHTTPClientGetter
@@ -30,6 +31,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.applicationService = NewMockApplicationService(ctrl)
+	s.registryService = NewMockCharmRegistryService(ctrl)
 	s.downloader = NewMockDownloader(ctrl)
 	s.clock = NewMockClock(ctrl)
 
@@ -0,0 +1,179 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditconfigupdater
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/core/logger"
+)
+
+const (
+	// dbLogBufferSize is the maximum number of audit log records queued
+	// to be written to the controller database.
+	dbLogBufferSize = 10000
+
+	// dbWriteTimeout bounds how long writing a single audit log record to
+	// the controller database may take.
+	dbWriteTimeout = 30 * time.Second
+
+	// dbShutdownFlushTimeout bounds how long a stopping database log
+	// spends writing the records still queued.
+	dbShutdownFlushTimeout = 5 * time.Second
+)
+
+// AuditLogService records the audit log in the controller database.
+type AuditLogService interface {
+	// AddConversation records the start of a conversation.
+	AddConversation(ctx context.Context, c auditlog.Conversation) error
+
+	// AddRequest records a request made in a conversation.
+	AddRequest(ctx context.Context, r auditlog.Request) error
+
+	// AddResponse records the response to a request.
+	AddResponse(ctx context.Context, r auditlog.ResponseErrors) error
+}
+
+// dbRecord writes a single audit log record to the controller database.
+type dbRecord func(context.Context, AuditLogService) error
+
+// dbLog is an audit entry sink which writes to the controller database, so
+// that the audit log can be queried through the API.
+//
+// Records are queued in memory and written asynchronously, in the order
+// they were added, so that neither a slow nor a failing database holds up
+// or fails the API calls being audited. When the queue is full, the oldest
+// records are dropped. Records which can't be written are logged and
+// dropped.
+type dbLog struct {
+	tomb    tomb.Tomb
+	service AuditLogService
+	logger  logger.Logger
+	records chan dbRecord
+
+	dropped atomic.Uint64
+}
+
+// newDBLog returns an audit entry sink which records audit log records in
+// the controller database using the given service. It must be closed to
+// stop writing records.
+func newDBLog(service AuditLogService, logger logger.Logger) *dbLog {
+	l := &dbLog{
+		service: service,
+		logger:  logger,
+		records: make(chan dbRecord, dbLogBufferSize),
+	}
+	l.tomb.Go(l.loop)
+	return l
+}
+
+// AddConversation implements auditlog.AuditLog.
+func (l *dbLog) AddConversation(c auditlog.Conversation) error {
+	l.add(func(ctx context.Context, service AuditLogService) error {
+		return service.AddConversation(ctx, c)
+	})
+	return nil
+}
+
+// AddRequest implements auditlog.AuditLog.
+func (l *dbLog) AddRequest(r auditlog.Request) error {
+	l.add(func(ctx context.Context, service AuditLogService) error {
+		return service.AddRequest(ctx, r)
+	})
+	return nil
+}
+
+// AddResponse implements auditlog.AuditLog.
+func (l *dbLog) AddResponse(r auditlog.ResponseErrors) error {
+	l.add(func(ctx context.Context, service AuditLogService) error {
+		return service.AddResponse(ctx, r)
+	})
+	return nil
+}
+
+// Close implements auditlog.AuditLog. It stops the log once the records
+// still queued have been written, or the flush has timed out.
+func (l *dbLog) Close() error {
+	l.tomb.Kill(nil)
+	_ = l.tomb.Wait()
+	return nil
+}
+
+// add queues a record to be written. It never blocks; if the queue is full,
+// the oldest queued record is dropped.
+func (l *dbLog) add(r dbRecord) {
+	for {
+		select {
+		case l.records <- r:
+			return
+		case <-l.tomb.Dying():
+			l.dropped.Add(1)
+			return
+		default:
+		}
+
+		select {
+		case <-l.records:
+			l.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+func (l *dbLog) loop() error {
+	ctx := l.tomb.Context(context.Background())
+	for {
+		select {
+		case <-l.tomb.Dying():
+			l.flushRemaining()
+			return tomb.ErrDying
+		case r := <-l.records:
+			l.write(ctx, r)
+		}
+	}
+}
+
+// flushRemaining writes the records still queued once the log is
+// stopping.
+func (l *dbLog) flushRemaining() {
+	// The tomb context is already cancelled, so the records are written
+	// with a context of their own.
+	ctx, cancel := context.WithTimeout(context.Background(), dbShutdownFlushTimeout)
+	defer cancel()
+	for {
+		select {
+		case r := <-l.records:
+			if ctx.Err() != nil {
+				l.dropped.Add(1)
+				continue
+			}
+			l.write(ctx, r)
+		default:
+			l.reportDropped(ctx)
+			return
+		}
+	}
+}
+
+func (l *dbLog) write(ctx context.Context, r dbRecord) {
+	writeCtx, cancel := context.WithTimeout(ctx, dbWriteTimeout)
+	defer cancel()
+	if err := r(writeCtx, l.service); err != nil {
+		l.logger.Warningf(ctx, "cannot record audit log record in the controller database: %v", err)
+	}
+	l.reportDropped(ctx)
+}
+
+// reportDropped logs the number of records dropped since it was last
+// reported.
+func (l *dbLog) reportDropped(ctx context.Context) {
+	if dropped := l.dropped.Swap(0); dropped > 0 {
+		l.logger.Warningf(ctx, "dropped %d audit log records not recorded in the controller database", dropped)
+	}
+}
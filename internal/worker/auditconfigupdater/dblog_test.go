@@ -0,0 +1,104 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditconfigupdater
+
+import (
+	"context"
+	stdtesting "testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/auditlog"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+)
+
+type dbLogSuite struct {
+	baseSuite
+}
+
+func TestDBLogSuite(t *stdtesting.T) {
+	tc.Run(t, &dbLogSuite{})
+}
+
+func (s *dbLogSuite) TestWritesRecordsInOrder(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	conversation := auditlog.Conversation{ConversationID: "abc"}
+	request := auditlog.Request{ConversationID: "abc", RequestID: 1}
+	response := auditlog.ResponseErrors{ConversationID: "abc", RequestID: 1}
+	gomock.InOrder(
+		s.auditLogService.EXPECT().AddConversation(gomock.Any(), conversation).Return(nil),
+		s.auditLogService.EXPECT().AddRequest(gomock.Any(), request).Return(nil),
+		s.auditLogService.EXPECT().AddResponse(gomock.Any(), response).Return(nil),
+	)
+
+	l := newDBLog(s.auditLogService, loggertesting.WrapCheckLog(c))
+	c.Assert(l.AddConversation(conversation), tc.ErrorIsNil)
+	c.Assert(l.AddRequest(request), tc.ErrorIsNil)
+	c.Assert(l.AddResponse(response), tc.ErrorIsNil)
+
+	// Closing the log writes the records still queued.
+	c.Assert(l.Close(), tc.ErrorIsNil)
+}
+
+func (s *dbLogSuite) TestWriteErrorDoesNotFailRecord(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	written := make(chan struct{})
+	gomock.InOrder(
+		s.auditLogService.EXPECT().AddConversation(gomock.Any(), gomock.Any()).Return(errors.New("boom")),
+		s.auditLogService.EXPECT().AddRequest(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, auditlog.Request) error {
+				close(written)
+				return nil
+			}),
+	)
+
+	l := newDBLog(s.auditLogService, loggertesting.WrapCheckLog(c))
+	defer l.Close()
+
+	c.Assert(l.AddConversation(auditlog.Conversation{}), tc.ErrorIsNil)
+	c.Assert(l.AddRequest(auditlog.Request{}), tc.ErrorIsNil)
+
+	select {
+	case <-written:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("request not written after write error")
+	}
+}
+
+func (s *dbLogSuite) TestSlowDatabaseDoesNotBlock(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unblock := make(chan struct{})
+	s.auditLogService.EXPECT().AddRequest(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, auditlog.Request) error {
+			<-unblock
+			return nil
+		}).MinTimes(1).MaxTimes(dbLogBufferSize + 1)
+
+	l := newDBLog(s.auditLogService, loggertesting.WrapCheckLog(c))
+
+	// Adding more records than can be queued drops the oldest ones rather
+	// than blocking.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < dbLogBufferSize+10; i++ {
+			_ = l.AddRequest(auditlog.Request{RequestID: uint64(i)})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("adding records blocked")
+	}
+
+	close(unblock)
+	c.Assert(l.Close(), tc.ErrorIsNil)
+}
@@ -5,14 +5,19 @@ package auditconfigupdater
 
 import (
 	"context"
+	"os"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/auditlog"
 	coredependency "github.com/juju/juju/core/dependency"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/auditsink"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/common"
 )
@@ -21,14 +26,25 @@ import (
 // the manifold.
 type GetControllerConfigServiceFunc func(getter dependency.Getter, name string) (ControllerConfigService, error)
 
+// GetAuditLogServiceFunc is a helper function that gets the audit log
+// service from the manifold.
+type GetAuditLogServiceFunc func(getter dependency.Getter, name string) (AuditLogService, error)
+
 // ManifoldConfig holds the information needed to run an
 // auditconfigupdater in a dependency.Engine.
 type ManifoldConfig struct {
 	// LogDir is the controller log directory where audit logs are written.
 	LogDir                     string
 	DomainServicesName         string
-	NewWorker                  func(ControllerConfigService, auditlog.Config, AuditLogFactory) (worker.Worker, error)
+	NewWorker                  func(ControllerConfigService, auditlog.Config, AuditLogFactory, SinkUpdater) (worker.Worker, error)
 	GetControllerConfigService GetControllerConfigServiceFunc
+	GetAuditLogService         GetAuditLogServiceFunc
+
+	// PrometheusRegisterer is used to register the delivery metrics of
+	// the remote audit log sinks.
+	PrometheusRegisterer prometheus.Registerer
+	Clock                clock.Clock
+	Logger               logger.Logger
 }
 
 // Validate validates the manifold configuration.
@@ -45,6 +61,18 @@ func (config ManifoldConfig) Validate() error {
 	if config.GetControllerConfigService == nil {
 		return errors.NotValidf("nil GetControllerConfigService")
 	}
+	if config.GetAuditLogService == nil {
+		return errors.NotValidf("nil GetAuditLogService")
+	}
+	if config.PrometheusRegisterer == nil {
+		return errors.NotValidf("nil PrometheusRegisterer")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
 	return nil
 }
 
@@ -69,27 +97,68 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	auditLogService, err := config.GetAuditLogService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	controllerConfig, err := controllerConfigService.ControllerConfig(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
+	// The remote sinks are configured by the worker, as the controller
+	// config changes.
+	hostname, _ := os.Hostname()
+	sinks, err := auditsink.NewSet(auditsink.SetConfig{
+		ControllerUUID: controllerConfig.ControllerUUID(),
+		Hostname:       hostname,
+		Clock:          config.Clock,
+		Logger:         config.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Unregistering removes the metrics of a previous run of the worker,
+	// which share their descriptors with the new ones.
+	_ = config.PrometheusRegisterer.Unregister(sinks)
+	if err := config.PrometheusRegisterer.Register(sinks); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Records are written to the controller database asynchronously, so
+	// that the database doesn't hold up or fail the audited API calls.
+	dbLog := newDBLog(auditLogService, config.Logger)
+	cleanup := func() {
+		_ = sinks.Close()
+		_ = config.PrometheusRegisterer.Unregister(sinks)
+		_ = dbLog.Close()
+	}
+
+	// Audit records are written to the audit log file, to the controller
+	// database so that they can be queried, and to the remote sinks.
 	logFactory := func(cfg auditlog.Config) auditlog.AuditLog {
-		return auditlog.NewLogFile(config.LogDir, cfg.MaxSizeMB, cfg.MaxBackups)
+		return auditlog.NewTee(
+			auditlog.NewLogFile(config.LogDir, cfg.MaxSizeMB, cfg.MaxBackups),
+			dbLog,
+			sinks,
+		)
 	}
 	auditConfig, err := initialConfig(controllerConfig)
 	if err != nil {
+		cleanup()
 		return nil, errors.Trace(err)
 	}
 	if auditConfig.Enabled {
 		auditConfig.Target = logFactory(auditConfig)
 	}
 
-	w, err := config.NewWorker(controllerConfigService, auditConfig, logFactory)
+	w, err := config.NewWorker(controllerConfigService, auditConfig, logFactory, sinks)
 	if err != nil {
+		cleanup()
 		return nil, errors.Trace(err)
 	}
-	return w, nil
+	return common.NewCleanupWorker(w, cleanup), nil
 }
 
 type withCurrentConfig interface {
@@ -113,14 +182,26 @@ func output(in worker.Worker, out any) error {
 }
 
 func initialConfig(cfg controller.Config) (auditlog.Config, error) {
-	result := auditlog.Config{
+	return auditConfig(cfg), nil
+}
+
+// auditConfig returns the audit log config held in the controller config.
+// The config has no target.
+func auditConfig(cfg controller.Config) auditlog.Config {
+	return auditlog.Config{
 		Enabled:        cfg.AuditingEnabled(),
 		CaptureAPIArgs: cfg.AuditLogCaptureArgs(),
 		MaxSizeMB:      cfg.AuditLogMaxSizeMB(),
 		MaxBackups:     cfg.AuditLogMaxBackups(),
 		ExcludeMethods: cfg.AuditLogExcludeMethods(),
+		Sinks: auditlog.SinkConfig{
+			SyslogAddress: cfg.AuditLogSyslogAddress(),
+			SyslogTLS:     cfg.AuditLogSyslogTLS(),
+			WebhookURL:    cfg.AuditLogWebhookURL(),
+			LokiURL:       cfg.AuditLogLokiURL(),
+			CACert:        cfg.AuditLogSinkCACert(),
+		},
 	}
-	return result, nil
 }
 
 // GetControllerConfigService is a helper function that gets a service from the
@@ -130,3 +211,11 @@ func GetControllerConfigService(getter dependency.Getter, name string) (Controll
 		return factory.ControllerConfig()
 	})
 }
+
+// GetAuditLogService is a helper function that gets the audit log service
+// from the manifold.
+func GetAuditLogService(getter dependency.Getter, name string) (AuditLogService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) AuditLogService {
+		return factory.AuditLog()
+	})
+}
@@ -8,16 +8,20 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
 	dt "github.com/juju/worker/v5/dependency/testing"
 	"github.com/juju/worker/v5/workertest"
+	"github.com/prometheus/client_golang/prometheus"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/auditlog"
 	controllerconfigservice "github.com/juju/juju/domain/controllerconfig/service"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -32,7 +36,7 @@ func TestManifoldSuite(t *testing.T) {
 func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
-	cfg := s.getConfig()
+	cfg := s.getConfig(c)
 	c.Check(cfg.Validate(), tc.ErrorIsNil)
 
 	cfg.LogDir = ""
@@ -40,12 +44,28 @@ func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 
 	cfg.DomainServicesName = ""
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.GetAuditLogService = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.PrometheusRegisterer = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 }
 
 var expectedInputs = []string{"domain-services"}
 
 func (s *manifoldSuite) TestInputs(c *tc.C) {
-	c.Assert(Manifold(s.getConfig()).Inputs, tc.SameContents, expectedInputs)
+	c.Assert(Manifold(s.getConfig(c)).Inputs, tc.SameContents, expectedInputs)
 }
 
 func (s *manifoldSuite) TestStart(c *tc.C) {
@@ -53,7 +73,7 @@ func (s *manifoldSuite) TestStart(c *tc.C) {
 
 	s.expectControllerConfig()
 
-	w, err := Manifold(s.getConfig()).Start(c.Context(), s.newGetter())
+	w, err := Manifold(s.getConfig(c)).Start(c.Context(), s.newGetter())
 	c.Assert(err, tc.ErrorIsNil)
 	workertest.CleanKill(c, w)
 }
@@ -64,14 +84,23 @@ func (s *manifoldSuite) TestStartConfig(c *tc.C) {
 	s.expectControllerConfig()
 
 	logDir := c.MkDir()
-	cfg := s.getConfig()
+	cfg := s.getConfig(c)
 	cfg.LogDir = logDir
-	cfg.NewWorker = func(_ ControllerConfigService, _ auditlog.Config, logFactory AuditLogFactory) (worker.Worker, error) {
+	cfg.NewWorker = func(_ ControllerConfigService, _ auditlog.Config, logFactory AuditLogFactory, _ SinkUpdater) (worker.Worker, error) {
 		auditLog := logFactory(auditlog.Config{})
+		// Records are written to both the audit log file and the
+		// controller database.
+		conversation := auditlog.Conversation{
+			ConversationID: "0123456789abcdef",
+			When:           "2026-10-17T12:00:00Z",
+		}
+		s.auditLogService.EXPECT().AddConversation(gomock.Any(), conversation).Return(nil)
+		c.Assert(auditLog.AddConversation(conversation), tc.ErrorIsNil)
 		c.Assert(auditLog.Close(), tc.ErrorIsNil)
 		info, err := os.Stat(filepath.Join(logDir, "audit.log"))
 		c.Assert(err, tc.ErrorIsNil)
 		c.Check(info.IsDir(), tc.IsFalse)
+		c.Check(info.Size() > 0, tc.IsTrue)
 		return newStubWorker(), nil
 	}
 
@@ -80,16 +109,47 @@ func (s *manifoldSuite) TestStartConfig(c *tc.C) {
 	workertest.CleanKill(c, w)
 }
 
-func (s *manifoldSuite) getConfig() ManifoldConfig {
+func (s *manifoldSuite) TestStartRegistersSinkMetrics(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig()
+	s.expectControllerConfig()
+
+	registry := prometheus.NewRegistry()
+	cfg := s.getConfig(c)
+	cfg.PrometheusRegisterer = registry
+	var sinks SinkUpdater
+	cfg.NewWorker = func(_ ControllerConfigService, _ auditlog.Config, _ AuditLogFactory, s SinkUpdater) (worker.Worker, error) {
+		sinks = s
+		return newStubWorker(), nil
+	}
+
+	// Starting the manifold again replaces the metrics of the previous
+	// run.
+	for range 2 {
+		w, err := Manifold(cfg).Start(c.Context(), s.newGetter())
+		c.Assert(err, tc.ErrorIsNil)
+		defer workertest.CleanKill(c, w)
+	}
+	c.Check(registry.Unregister(sinks.(prometheus.Collector)), tc.IsTrue)
+}
+
+func (s *manifoldSuite) getConfig(c *tc.C) ManifoldConfig {
 	return ManifoldConfig{
 		LogDir:             "log-dir",
 		DomainServicesName: "domain-services",
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
-		NewWorker: func(ControllerConfigService, auditlog.Config, AuditLogFactory) (worker.Worker, error) {
+		GetAuditLogService: func(getter dependency.Getter, name string) (AuditLogService, error) {
+			return s.auditLogService, nil
+		},
+		NewWorker: func(ControllerConfigService, auditlog.Config, AuditLogFactory, SinkUpdater) (worker.Worker, error) {
 			return newStubWorker(), nil
 		},
+		PrometheusRegisterer: prometheus.NewRegistry(),
+		Clock:                clock.WallClock,
+		Logger:               loggertesting.WrapCheckLog(c),
 	}
 }
 
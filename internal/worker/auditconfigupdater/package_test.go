@@ -12,18 +12,22 @@ import (
 	"github.com/juju/juju/internal/testing"
 )
 
-//go:generate go run github.com/canonical/gomock/mockgen -package auditconfigupdater -destination servicefactory_mock_test.go github.com/juju/juju/internal/worker/auditconfigupdater ControllerConfigService
+//go:generate go run github.com/canonical/gomock/mockgen -package auditconfigupdater -destination servicefactory_mock_test.go github.com/juju/juju/internal/worker/auditconfigupdater ControllerConfigService,AuditLogService,SinkUpdater
 
 type baseSuite struct {
 	testhelpers.IsolationSuite
 
 	controllerConfigService *MockControllerConfigService
+	auditLogService         *MockAuditLogService
+	sinkUpdater             *MockSinkUpdater
 }
 
 func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.auditLogService = NewMockAuditLogService(ctrl)
+	s.sinkUpdater = NewMockSinkUpdater(ctrl)
 
 	return ctrl
 }
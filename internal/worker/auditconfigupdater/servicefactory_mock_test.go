@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/auditconfigupdater (interfaces: ControllerConfigService)
+// Source: github.com/juju/juju/internal/worker/auditconfigupdater (interfaces: ControllerConfigService,AuditLogService,SinkUpdater)
 //
 // Generated by this command:
 //
-//	mockgen -package auditconfigupdater -destination servicefactory_mock_test.go github.com/juju/juju/internal/worker/auditconfigupdater ControllerConfigService
+//	mockgen -package auditconfigupdater -destination servicefactory_mock_test.go github.com/juju/juju/internal/worker/auditconfigupdater ControllerConfigService,AuditLogService,SinkUpdater
 //
 
 // Package auditconfigupdater is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 
 	gomock "github.com/canonical/gomock/gomock"
 	controller "github.com/juju/juju/controller"
+	auditlog "github.com/juju/juju/core/auditlog"
 	watcher "github.com/juju/juju/core/watcher"
 )
 
@@ -78,3 +79,127 @@ func (mr *MockControllerConfigServiceMockRecorder) WatchControllerConfig(arg0 an
 
 // MockControllerConfigServiceWatchControllerConfigCall is the typed call wrapper for WatchControllerConfig.
 type MockControllerConfigServiceWatchControllerConfigCall = gomock.Call1_2[context.Context, watcher.StringsWatcher, error]
+
+// MockAuditLogService is a mock of AuditLogService interface.
+type MockAuditLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogServiceMockRecorder is the mock recorder for MockAuditLogService.
+type MockAuditLogServiceMockRecorder struct {
+	mock                   *MockAuditLogService
+	addConversationExpects []*gomock.Call2_1[context.Context, auditlog.Conversation, error]
+	addRequestExpects      []*gomock.Call2_1[context.Context, auditlog.Request, error]
+	addResponseExpects     []*gomock.Call2_1[context.Context, auditlog.ResponseErrors, error]
+}
+
+// NewMockAuditLogService creates a new mock instance.
+func NewMockAuditLogService(ctrl *gomock.Controller) *MockAuditLogService {
+	mock := &MockAuditLogService{ctrl: ctrl}
+	mock.recorder = &MockAuditLogServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogService) EXPECT() *MockAuditLogServiceMockRecorder {
+	return m.recorder
+}
+
+// AddConversation mocks base method.
+func (m *MockAuditLogService) AddConversation(ctx context.Context, c auditlog.Conversation) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addConversationExpects, m.ctrl, m, "AddConversation", ctx, c)
+}
+
+// AddConversation indicates an expected call of AddConversation.
+func (mr *MockAuditLogServiceMockRecorder) AddConversation(ctx, c any) *MockAuditLogServiceAddConversationCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, auditlog.Conversation, error](mr.mock.ctrl.T, mr.mock, "AddConversation", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(c))
+	mr.addConversationExpects = append(mr.addConversationExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuditLogServiceAddConversationCall is the typed call wrapper for AddConversation.
+type MockAuditLogServiceAddConversationCall = gomock.Call2_1[context.Context, auditlog.Conversation, error]
+
+// AddRequest mocks base method.
+func (m *MockAuditLogService) AddRequest(ctx context.Context, r auditlog.Request) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addRequestExpects, m.ctrl, m, "AddRequest", ctx, r)
+}
+
+// AddRequest indicates an expected call of AddRequest.
+func (mr *MockAuditLogServiceMockRecorder) AddRequest(ctx, r any) *MockAuditLogServiceAddRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, auditlog.Request, error](mr.mock.ctrl.T, mr.mock, "AddRequest", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(r))
+	mr.addRequestExpects = append(mr.addRequestExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuditLogServiceAddRequestCall is the typed call wrapper for AddRequest.
+type MockAuditLogServiceAddRequestCall = gomock.Call2_1[context.Context, auditlog.Request, error]
+
+// AddResponse mocks base method.
+func (m *MockAuditLogService) AddResponse(ctx context.Context, r auditlog.ResponseErrors) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.addResponseExpects, m.ctrl, m, "AddResponse", ctx, r)
+}
+
+// AddResponse indicates an expected call of AddResponse.
+func (mr *MockAuditLogServiceMockRecorder) AddResponse(ctx, r any) *MockAuditLogServiceAddResponseCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, auditlog.ResponseErrors, error](mr.mock.ctrl.T, mr.mock, "AddResponse", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(r))
+	mr.addResponseExpects = append(mr.addResponseExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuditLogServiceAddResponseCall is the typed call wrapper for AddResponse.
+type MockAuditLogServiceAddResponseCall = gomock.Call2_1[context.Context, auditlog.ResponseErrors, error]
+
+// MockSinkUpdater is a mock of SinkUpdater interface.
+type MockSinkUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockSinkUpdaterMockRecorder
+	isgomock struct{}
+}
+
+// MockSinkUpdaterMockRecorder is the mock recorder for MockSinkUpdater.
+type MockSinkUpdaterMockRecorder struct {
+	mock          *MockSinkUpdater
+	updateExpects []*gomock.Call1_1[auditlog.SinkConfig, error]
+}
+
+// NewMockSinkUpdater creates a new mock instance.
+func NewMockSinkUpdater(ctrl *gomock.Controller) *MockSinkUpdater {
+	mock := &MockSinkUpdater{ctrl: ctrl}
+	mock.recorder = &MockSinkUpdaterMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSinkUpdater) EXPECT() *MockSinkUpdaterMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockSinkUpdater) Update(arg0 auditlog.SinkConfig) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.updateExpects, m.ctrl, m, "Update", arg0)
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSinkUpdaterMockRecorder) Update(arg0 any) *MockSinkUpdaterUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[auditlog.SinkConfig, error](mr.mock.ctrl.T, mr.mock, "Update", gomock.EnsureMatcher(arg0))
+	mr.updateExpects = append(mr.updateExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSinkUpdaterUpdateCall is the typed call wrapper for Update.
+type MockSinkUpdaterUpdateCall = gomock.Call1_1[auditlog.SinkConfig, error]
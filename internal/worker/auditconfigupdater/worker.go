@@ -37,6 +37,14 @@ type ControllerConfigService interface {
 // config.
 type AuditLogFactory func(auditlog.Config) auditlog.AuditLog
 
+// SinkUpdater reconfigures the remote sinks audit records are forwarded
+// to.
+type SinkUpdater interface {
+	// Update replaces the running sinks with the ones enabled by the
+	// given config.
+	Update(auditlog.SinkConfig) error
+}
+
 type updater struct {
 	internalStates          chan string
 	catacomb                catacomb.Catacomb
@@ -45,17 +53,25 @@ type updater struct {
 	mu         sync.Mutex
 	current    auditlog.Config
 	logFactory AuditLogFactory
+	sinks      SinkUpdater
 }
 
-// NewWorker returns a worker that will keep an up-to-date audit log config.
-func NewWorker(controllerConfigService ControllerConfigService, initial auditlog.Config, logFactory AuditLogFactory) (worker.Worker, error) {
-	return newWorker(controllerConfigService, initial, logFactory, nil)
+// NewWorker returns a worker that will keep an up-to-date audit log config,
+// and keep the remote audit log sinks in line with it.
+func NewWorker(
+	controllerConfigService ControllerConfigService,
+	initial auditlog.Config,
+	logFactory AuditLogFactory,
+	sinks SinkUpdater,
+) (worker.Worker, error) {
+	return newWorker(controllerConfigService, initial, logFactory, sinks, nil)
 }
 
 func newWorker(
 	controllerConfigService ControllerConfigService,
 	initial auditlog.Config,
 	logFactory AuditLogFactory,
+	sinks SinkUpdater,
 	internalStates chan string,
 ) (*updater, error) {
 	u := &updater{
@@ -63,6 +79,7 @@ func newWorker(
 		controllerConfigService: controllerConfigService,
 		current:                 initial,
 		logFactory:              logFactory,
+		sinks:                   sinks,
 	}
 	err := catacomb.Invoke(catacomb.Plan{
 		Name: "audit-config-updater",
@@ -93,6 +110,9 @@ func (u *updater) loop() error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if err := u.updateSinks(u.CurrentConfig()); err != nil {
+		return errors.Trace(err)
+	}
 
 	// Report the initial started state.
 	u.reportInternalState(stateStarted)
@@ -110,6 +130,9 @@ func (u *updater) loop() error {
 			if err != nil {
 				return errors.Annotatef(err, "getting new config")
 			}
+			if err := u.updateSinks(newConfig); err != nil {
+				return errors.Trace(err)
+			}
 			u.update(newConfig)
 		}
 	}
@@ -120,14 +143,7 @@ func (u *updater) newConfig(ctx context.Context) (auditlog.Config, error) {
 	if err != nil {
 		return auditlog.Config{}, errors.Trace(err)
 	}
-	result := auditlog.Config{
-		Enabled:        cfg.AuditingEnabled(),
-		CaptureAPIArgs: cfg.AuditLogCaptureArgs(),
-		MaxSizeMB:      cfg.AuditLogMaxSizeMB(),
-		MaxBackups:     cfg.AuditLogMaxBackups(),
-		ExcludeMethods: cfg.AuditLogExcludeMethods(),
-	}
-
+	result := auditConfig(cfg)
 	if result.Enabled && u.current.Target == nil {
 		result.Target = u.logFactory(result)
 	} else {
@@ -139,6 +155,19 @@ func (u *updater) newConfig(ctx context.Context) (auditlog.Config, error) {
 	return result, nil
 }
 
+// updateSinks reconfigures the remote sinks for the given config. The sinks
+// are stopped while auditing is disabled.
+func (u *updater) updateSinks(cfg auditlog.Config) error {
+	sinks := cfg.Sinks
+	if !cfg.Enabled {
+		sinks = auditlog.SinkConfig{}
+	}
+	if err := u.sinks.Update(sinks); err != nil {
+		return errors.Annotate(err, "updating audit log sinks")
+	}
+	return nil
+}
+
 func (u *updater) update(newConfig auditlog.Config) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
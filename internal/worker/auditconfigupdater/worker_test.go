@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/canonical/gomock/gomock"
 	"github.com/juju/collections/set"
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5/workertest"
 
@@ -34,6 +35,7 @@ func (s *workerSuite) TestNewWorker(c *tc.C) {
 	cfg := auditlog.Config{}
 
 	s.expectControllerConfigWatcher(c)
+	s.sinkUpdater.EXPECT().Update(auditlog.SinkConfig{}).Return(nil)
 
 	worker, err := s.newWorker(cfg, nil)
 	c.Assert(err, tc.ErrorIsNil)
@@ -58,6 +60,7 @@ func (s *workerSuite) TestNewWorkerUpdatedCurrentConfig(c *tc.C) {
 	controllerConfig[controller.AuditLogMaxBackups] = 5
 	controllerConfig[controller.AuditLogExcludeMethods] = "foo,bar"
 	s.expectControllerConfigWithConfig(controllerConfig)
+	s.sinkUpdater.EXPECT().Update(auditlog.SinkConfig{}).Return(nil).Times(2)
 
 	worker, err := s.newWorker(cfg, func(c auditlog.Config) auditlog.AuditLog {
 		return nil
@@ -87,8 +90,69 @@ func (s *workerSuite) TestNewWorkerUpdatedCurrentConfig(c *tc.C) {
 	workertest.CleanKill(c, worker)
 }
 
+func (s *workerSuite) TestUpdatesSinks(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sinks := auditlog.SinkConfig{
+		SyslogAddress: "syslog.example.com:6514",
+		SyslogTLS:     true,
+		WebhookURL:    "https://audit.example.com/hook",
+	}
+	cfg := auditlog.Config{
+		Enabled: true,
+		Sinks:   sinks,
+	}
+
+	ch := s.expectControllerConfigWatcher(c)
+
+	// The sinks are stopped when auditing is disabled.
+	controllerConfig := testing.FakeControllerConfig()
+	controllerConfig[controller.AuditingEnabled] = false
+	controllerConfig[controller.AuditLogSyslogAddress] = sinks.SyslogAddress
+	controllerConfig[controller.AuditLogSyslogTLS] = true
+	controllerConfig[controller.AuditLogWebhookURL] = sinks.WebhookURL
+	s.expectControllerConfigWithConfig(controllerConfig)
+	gomock.InOrder(
+		s.sinkUpdater.EXPECT().Update(sinks).Return(nil),
+		s.sinkUpdater.EXPECT().Update(auditlog.SinkConfig{}).Return(nil),
+	)
+
+	worker, err := s.newWorker(cfg, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, worker)
+
+	s.ensureStartup(c)
+
+	select {
+	case ch <- []string{}:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out seeding initial event")
+	}
+
+	s.ensureChanged(c)
+
+	current := worker.CurrentConfig()
+	c.Check(current.Enabled, tc.IsFalse)
+	c.Check(current.Sinks, tc.DeepEquals, sinks)
+
+	workertest.CleanKill(c, worker)
+}
+
+func (s *workerSuite) TestUpdateSinksError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfigWatcher(c)
+	s.sinkUpdater.EXPECT().Update(auditlog.SinkConfig{}).Return(errors.New("boom"))
+
+	worker, err := s.newWorker(auditlog.Config{}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, worker)
+	c.Check(err, tc.ErrorMatches, "updating audit log sinks: boom")
+}
+
 func (s *workerSuite) newWorker(initial auditlog.Config, logFactory AuditLogFactory) (*updater, error) {
-	return newWorker(s.controllerConfigService, initial, logFactory, s.states)
+	return newWorker(s.controllerConfigService, initial, logFactory, s.sinkUpdater, s.states)
 }
 
 func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
@@ -0,0 +1,13 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package auditlogpruner provides a worker that periodically prunes the
+// audit log held in the controller database.
+//
+// The worker reads the audit-log-retention controller config value and, on
+// a jittered interval, asks the audit log service to remove records older
+// than it. When the retention is changed, the audit log is pruned straight
+// away with the new value.
+//
+// The worker is intended to run on the primary controller only.
+package auditlogpruner
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlogpruner
+
+//go:generate go run github.com/canonical/gomock/mockgen -package auditlogpruner -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher
+//go:generate go run github.com/canonical/gomock/mockgen -package auditlogpruner -destination services_mock_test.go github.com/juju/juju/internal/worker/auditlogpruner ControllerConfigService,AuditLogService
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/auditlogpruner (interfaces: ControllerConfigService,AuditLogService)
+//
+// Generated by this command:
+//
+//	mockgen -package auditlogpruner -destination services_mock_test.go github.com/juju/juju/internal/worker/auditlogpruner ControllerConfigService,AuditLogService
+//
+
+// Package auditlogpruner is a generated GoMock package.
+package auditlogpruner
+
+import (
+	context "context"
+	time "time"
+
+	gomock "github.com/canonical/gomock/gomock"
+	controller "github.com/juju/juju/controller"
+	watcher "github.com/juju/juju/core/watcher"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock                         *MockControllerConfigService
+	controllerConfigExpects      []*gomock.Call1_2[context.Context, controller.Config, error]
+	watchControllerConfigExpects []*gomock.Call1_2[context.Context, watcher.StringsWatcher, error]
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(ctx context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.controllerConfigExpects, m.ctrl, m, "ControllerConfig", ctx)
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(ctx any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, controller.Config, error](mr.mock.ctrl.T, mr.mock, "ControllerConfig", gomock.EnsureMatcher(ctx))
+	mr.controllerConfigExpects = append(mr.controllerConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerConfigServiceControllerConfigCall is the typed call wrapper for ControllerConfig.
+type MockControllerConfigServiceControllerConfigCall = gomock.Call1_2[context.Context, controller.Config, error]
+
+// WatchControllerConfig mocks base method.
+func (m *MockControllerConfigService) WatchControllerConfig(arg0 context.Context) (watcher.StringsWatcher, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.watchControllerConfigExpects, m.ctrl, m, "WatchControllerConfig", arg0)
+}
+
+// WatchControllerConfig indicates an expected call of WatchControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) WatchControllerConfig(arg0 any) *MockControllerConfigServiceWatchControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, watcher.StringsWatcher, error](mr.mock.ctrl.T, mr.mock, "WatchControllerConfig", gomock.EnsureMatcher(arg0))
+	mr.watchControllerConfigExpects = append(mr.watchControllerConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockControllerConfigServiceWatchControllerConfigCall is the typed call wrapper for WatchControllerConfig.
+type MockControllerConfigServiceWatchControllerConfigCall = gomock.Call1_2[context.Context, watcher.StringsWatcher, error]
+
+// MockAuditLogService is a mock of AuditLogService interface.
+type MockAuditLogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogServiceMockRecorder is the mock recorder for MockAuditLogService.
+type MockAuditLogServiceMockRecorder struct {
+	mock         *MockAuditLogService
+	pruneExpects []*gomock.Call2_2[context.Context, time.Duration, int64, error]
+}
+
+// NewMockAuditLogService creates a new mock instance.
+func NewMockAuditLogService(ctrl *gomock.Controller) *MockAuditLogService {
+	mock := &MockAuditLogService{ctrl: ctrl}
+	mock.recorder = &MockAuditLogServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogService) EXPECT() *MockAuditLogServiceMockRecorder {
+	return m.recorder
+}
+
+// Prune mocks base method.
+func (m *MockAuditLogService) Prune(ctx context.Context, maxAge time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.pruneExpects, m.ctrl, m, "Prune", ctx, maxAge)
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockAuditLogServiceMockRecorder) Prune(ctx, maxAge any) *MockAuditLogServicePruneCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, time.Duration, int64, error](mr.mock.ctrl.T, mr.mock, "Prune", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(maxAge))
+	mr.pruneExpects = append(mr.pruneExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAuditLogServicePruneCall is the typed call wrapper for Prune.
+type MockAuditLogServicePruneCall = gomock.Call2_2[context.Context, time.Duration, int64, error]
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/core/watcher (interfaces: StringsWatcher)
+//
+// Generated by this command:
+//
+//	mockgen -package auditlogpruner -destination watcher_mock_test.go github.com/juju/juju/core/watcher StringsWatcher
+//
+
+// Package auditlogpruner is a generated GoMock package.
+package auditlogpruner
+
+import (
+	gomock "github.com/canonical/gomock/gomock"
+)
+
+// MockStringsWatcher is a mock of StringsWatcher interface.
+type MockStringsWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockStringsWatcherMockRecorder
+	isgomock struct{}
+}
+
+// MockStringsWatcherMockRecorder is the mock recorder for MockStringsWatcher.
+type MockStringsWatcherMockRecorder struct {
+	mock           *MockStringsWatcher
+	changesExpects []*gomock.Call0_1[<-chan []string]
+	killExpects    []*gomock.Call0_0
+	waitExpects    []*gomock.Call0_1[error]
+}
+
+// NewMockStringsWatcher creates a new mock instance.
+func NewMockStringsWatcher(ctrl *gomock.Controller) *MockStringsWatcher {
+	mock := &MockStringsWatcher{ctrl: ctrl}
+	mock.recorder = &MockStringsWatcherMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStringsWatcher) EXPECT() *MockStringsWatcherMockRecorder {
+	return m.recorder
+}
+
+// Changes mocks base method.
+func (m *MockStringsWatcher) Changes() <-chan []string {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.changesExpects, m.ctrl, m, "Changes")
+}
+
+// Changes indicates an expected call of Changes.
+func (mr *MockStringsWatcherMockRecorder) Changes() *MockStringsWatcherChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[<-chan []string](mr.mock.ctrl.T, mr.mock, "Changes")
+	mr.changesExpects = append(mr.changesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStringsWatcherChangesCall is the typed call wrapper for Changes.
+type MockStringsWatcherChangesCall = gomock.Call0_1[<-chan []string]
+
+// Kill mocks base method.
+func (m *MockStringsWatcher) Kill() {
+	m.ctrl.T.Helper()
+	gomock.Dispatch0_0(&m.recorder.killExpects, m.ctrl, m, "Kill")
+}
+
+// Kill indicates an expected call of Kill.
+func (mr *MockStringsWatcherMockRecorder) Kill() *MockStringsWatcherKillCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_0(mr.mock.ctrl.T, mr.mock, "Kill")
+	mr.killExpects = append(mr.killExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStringsWatcherKillCall is the typed call wrapper for Kill.
+type MockStringsWatcherKillCall = gomock.Call0_0
+
+// Wait mocks base method.
+func (m *MockStringsWatcher) Wait() error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.waitExpects, m.ctrl, m, "Wait")
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockStringsWatcherMockRecorder) Wait() *MockStringsWatcherWaitCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[error](mr.mock.ctrl.T, mr.mock, "Wait")
+	mr.waitExpects = append(mr.waitExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStringsWatcherWaitCall is the typed call wrapper for Wait.
+type MockStringsWatcherWaitCall = gomock.Call0_1[error]
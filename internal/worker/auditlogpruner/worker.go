@@ -0,0 +1,214 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlogpruner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/retry"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ControllerConfigService provides access to the controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the config values for the controller.
+	ControllerConfig(ctx context.Context) (controller.Config, error)
+
+	// WatchControllerConfig returns a watcher that returns keys for any
+	// changes to controller config.
+	WatchControllerConfig(context.Context) (watcher.StringsWatcher, error)
+}
+
+// AuditLogService provides access to the audit log.
+type AuditLogService interface {
+	// Prune removes the audit log records older than maxAge, returning the
+	// number of requests removed.
+	Prune(ctx context.Context, maxAge time.Duration) (int64, error)
+}
+
+// Config is the configuration for the audit log pruner.
+type Config struct {
+	Clock                   clock.Clock
+	ControllerConfigService ControllerConfigService
+	AuditLogService         AuditLogService
+	Logger                  logger.Logger
+
+	// PruneInterval is the interval at which the pruner will run.
+	PruneInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.ControllerConfigService == nil {
+		return errors.Errorf("nil ControllerConfigService").Add(coreerrors.NotValid)
+	}
+	if config.AuditLogService == nil {
+		return errors.Errorf("nil AuditLogService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.PruneInterval <= 0 {
+		return errors.Errorf("prune interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// prunerWorker is a worker that prunes the audit log.
+type prunerWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	retention time.Duration
+	lastPrune time.Time
+	pruned    int64
+}
+
+// NewWorker returns a new audit log pruner worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &prunerWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "audit-log-pruner",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *prunerWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *prunerWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *prunerWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"retention":  w.retention,
+		"last-prune": w.lastPrune,
+		"pruned":     w.pruned,
+	}
+}
+
+// jitter returns a random duration around the given period, between 0.5 and
+// 1.5 times the period.
+func jitter(period time.Duration) time.Duration {
+	half := period / 2
+	return retry.ExpBackoff(half, period+half, 2, true)(0, 1)
+}
+
+// loop is the worker's main loop. It periodically prunes the audit log, and
+// prunes it straight away when the retention is changed.
+func (w *prunerWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	watch, err := w.config.ControllerConfigService.WatchControllerConfig(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := w.catacomb.Add(watch); err != nil {
+		return errors.Capture(err)
+	}
+
+	retention, err := w.getRetention(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	w.setRetention(retention)
+
+	pruneTimer := w.config.Clock.NewTimer(jitter(w.config.PruneInterval))
+	defer pruneTimer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-watch.Changes():
+			if !ok {
+				return errors.New("controller config watcher closed")
+			}
+			retention, err := w.getRetention(ctx)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			if retention == w.getCurrentRetention() {
+				continue
+			}
+			w.config.Logger.Debugf(ctx, "audit log retention changed to %v", retention)
+			w.setRetention(retention)
+			if err := w.prune(ctx, pruneTimer); err != nil {
+				return errors.Capture(err)
+			}
+		case <-pruneTimer.Chan():
+			if err := w.prune(ctx, pruneTimer); err != nil {
+				return errors.Capture(err)
+			}
+		}
+	}
+}
+
+// prune removes the audit log records older than the retention, and resets
+// the timer for the next prune.
+func (w *prunerWorker) prune(ctx context.Context, pruneTimer clock.Timer) error {
+	pruned, err := w.config.AuditLogService.Prune(ctx, w.getCurrentRetention())
+	if err != nil {
+		return errors.Errorf("pruning audit log: %w", err)
+	}
+	if pruned > 0 {
+		w.config.Logger.Debugf(ctx, "pruned %d audit log requests", pruned)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastPrune = w.config.Clock.Now()
+	w.pruned += pruned
+	pruneTimer.Reset(jitter(w.config.PruneInterval))
+	return nil
+}
+
+func (w *prunerWorker) getRetention(ctx context.Context) (time.Duration, error) {
+	cfg, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return 0, errors.Errorf("getting controller config: %w", err)
+	}
+	return cfg.AuditLogRetention(), nil
+}
+
+func (w *prunerWorker) getCurrentRetention() time.Duration {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.retention
+}
+
+func (w *prunerWorker) setRetention(retention time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.retention = retention
+}
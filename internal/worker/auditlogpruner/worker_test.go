@@ -0,0 +1,245 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlogpruner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/workertest"
+
+	"github.com/juju/juju/controller"
+	corewatcher "github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		Clock:                   testclock.NewClock(time.Now()),
+		ControllerConfigService: NewMockControllerConfigService(ctrl),
+		AuditLogService:         NewMockAuditLogService(ctrl),
+		Logger:                  loggertesting.WrapCheckLog(c),
+		PruneInterval:           time.Second,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.ControllerConfigService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil ControllerConfigService.*")
+
+	testCfg = origCfg
+	testCfg.AuditLogService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil AuditLogService.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.PruneInterval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "prune interval must be positive.*")
+}
+
+type workerSuite struct{}
+
+// TestPrunesOnInterval tests that the worker prunes the audit log with the
+// configured retention when the prune interval elapses.
+func (s *workerSuite) TestPrunesOnInterval(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+
+	wait := mocked.expectPrune(c, 42*time.Hour, 1)
+	defer wait()
+
+	mocked.advancePruneInterval(c)
+}
+
+// TestPrunesOnRetentionChange tests that the worker prunes the audit log
+// straight away when the retention is changed.
+func (s *workerSuite) TestPrunesOnRetentionChange(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+
+	mocked.expectControllerConfig(c, "1h")
+
+	wait := mocked.expectPrune(c, time.Hour, 1)
+	defer wait()
+
+	mocked.pushConfigChanges(c, controller.AuditLogRetention)
+}
+
+// TestIgnoresOtherConfigChanges tests that the worker does not prune the
+// audit log when controller config changes leave the retention unchanged.
+func (s *workerSuite) TestIgnoresOtherConfigChanges(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+
+	mocked.expectControllerConfig(c, "42h")
+	mocked.auditLogService.EXPECT().Prune(gomock.Any(), gomock.Any()).Times(0)
+
+	mocked.pushConfigChanges(c, controller.AuditingEnabled)
+	// A second change ensures the first has been handled.
+	mocked.expectControllerConfig(c, "42h")
+	mocked.pushConfigChanges(c, controller.AuditingEnabled)
+}
+
+// TestPruneError verifies that the worker dies when pruning fails.
+func (s *workerSuite) TestPruneError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("bang")
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer func() {
+		err := workertest.CheckKill(c, w)
+		c.Assert(err, tc.ErrorIs, expectedError)
+	}()
+
+	mocked.auditLogService.EXPECT().Prune(gomock.Any(), 42*time.Hour).Return(int64(0), expectedError)
+
+	mocked.advancePruneInterval(c)
+	mocked.shouldDie(c)
+}
+
+type workerMocks struct {
+	clock                   *testclock.Clock
+	controllerConfigService *MockControllerConfigService
+	auditLogService         *MockAuditLogService
+	pruneInterval           time.Duration
+	worker                  *prunerWorker
+	configChanges           chan []string
+}
+
+// startWorker starts a worker, with an initial retention of 42h, and returns
+// it and the mocks it uses.
+func (s *workerSuite) startWorker(c *tc.C, ctrl *gomock.Controller) (worker.Worker, workerMocks) {
+	mocked := workerMocks{
+		clock:                   testclock.NewClock(time.Now()),
+		controllerConfigService: NewMockControllerConfigService(ctrl),
+		auditLogService:         NewMockAuditLogService(ctrl),
+		pruneInterval:           time.Second,
+		configChanges:           make(chan []string),
+	}
+	c.Cleanup(func() {
+		close(mocked.configChanges)
+	})
+
+	workerMainLoopEnteredCh := make(chan struct{}, 1)
+	watcher := NewMockStringsWatcher(ctrl)
+	mocked.controllerConfigService.EXPECT().WatchControllerConfig(gomock.Any()).Return(watcher, nil)
+	mocked.expectControllerConfig(c, "42h")
+	watcher.EXPECT().Changes().DoAndReturn(func() corewatcher.StringsChannel {
+		select {
+		case workerMainLoopEnteredCh <- struct{}{}:
+		default:
+		}
+		return mocked.configChanges
+	}).AnyTimes()
+	watcher.EXPECT().Kill().AnyTimes()
+	watcher.EXPECT().Wait().AnyTimes()
+
+	w, err := NewWorker(Config{
+		Clock:                   mocked.clock,
+		ControllerConfigService: mocked.controllerConfigService,
+		AuditLogService:         mocked.auditLogService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		PruneInterval:           mocked.pruneInterval,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Wait for worker to reach main loop before we allow tests to
+	// manipulate the clock.
+	select {
+	case <-workerMainLoopEnteredCh:
+	case <-time.After(coretesting.ShortWait):
+		c.Fatal("timed out waiting for worker to enter main loop")
+	}
+
+	mocked.worker = w.(*prunerWorker)
+	return w, mocked
+}
+
+// expectControllerConfig expects a call to ControllerConfig returning the
+// given audit log retention.
+func (w *workerMocks) expectControllerConfig(c *tc.C, retention string) {
+	cfg := coretesting.FakeControllerConfig()
+	cfg[controller.AuditLogRetention] = retention
+	w.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(cfg, nil)
+}
+
+// expectPrune expects the given number of calls to Prune with the given
+// retention, and returns a function waiting for them.
+func (w *workerMocks) expectPrune(c *tc.C, retention time.Duration, times int) (waitForMe func()) {
+	waitForIt := make(chan struct{})
+	w.auditLogService.EXPECT().Prune(gomock.Any(), retention).DoAndReturn(
+		func(ctx context.Context, retention time.Duration) (int64, error) {
+			times--
+			if times == 0 {
+				close(waitForIt)
+			}
+			return 1, nil
+		}).Times(times)
+	return func() {
+		select {
+		case <-waitForIt:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("Prune should have been called")
+		}
+	}
+}
+
+// advancePruneInterval advances the clock at least by the prune interval.
+func (w *workerMocks) advancePruneInterval(c *tc.C) {
+	err := w.clock.WaitAdvance(w.pruneInterval*3/2, coretesting.LongWait, 1) // jitter can be up to 1/2 prune interval
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// pushConfigChanges emits the given changes to the controller config
+// watcher.
+func (w *workerMocks) pushConfigChanges(c *tc.C, changes ...string) {
+	select {
+	case w.configChanges <- changes:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out pushing config changes")
+	}
+}
+
+// shouldDie verifies if the worker has successfully terminated within a
+// timeout, failing the test if it hasn't.
+func (w *workerMocks) shouldDie(c *tc.C) {
+	select {
+	case <-w.worker.catacomb.Dead():
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("Undead worker")
+	}
+}
@@ -13,11 +13,14 @@ import (
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
 
+	corecharm "github.com/juju/juju/core/charm"
 	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/deployment/charm/repository"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/charmhub/transport"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/ocicharm"
 	"github.com/juju/juju/internal/services"
 	internalworker "github.com/juju/juju/internal/worker"
 )
@@ -35,7 +38,21 @@ type CharmhubClient interface {
 }
 
 // NewCharmhubClientFunc is a function that creates a new CharmhubClient.
-type NewCharmhubClientFunc func(charmhub.HTTPClient, string, logger.Logger) (CharmhubClient, error)
+type NewCharmhubClientFunc func(charmhub.HTTPClient, string, charmhub.CredentialsFunc, logger.Logger) (CharmhubClient, error)
+
+// OCIRepository resolves charms stored in OCI registries.
+type OCIRepository interface {
+	// ResolveRevision returns the revision of the charm currently addressed
+	// by the tag or digest of the origin.
+	ResolveRevision(context.Context, corecharm.Origin) (int, error)
+
+	// ResolveWithPreferredChannel resolves the charm addressed by the origin,
+	// returning its essential metadata.
+	ResolveWithPreferredChannel(context.Context, string, corecharm.Origin) (corecharm.ResolvedData, error)
+}
+
+// NewOCIRepositoryFunc is a function that creates a new OCIRepository.
+type NewOCIRepositoryFunc func(ocicharm.HTTPClient, ocicharm.CredentialsFunc, logger.Logger) (OCIRepository, error)
 
 // NewHTTPClientFunc is a function that creates a new HTTP client.
 type NewHTTPClientFunc func(context.Context, corehttp.HTTPClientGetter) (corehttp.HTTPClient, error)
@@ -50,6 +67,7 @@ type ManifoldConfig struct {
 	ModelTag           names.ModelTag
 	NewHTTPClient      NewHTTPClientFunc
 	NewCharmhubClient  NewCharmhubClientFunc
+	NewOCIRepository   NewOCIRepositoryFunc
 	Logger             logger.Logger
 	Clock              clock.Clock
 }
@@ -71,6 +89,9 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.NewCharmhubClient == nil {
 		return jujuerrors.NotValidf("nil NewCharmhubClient")
 	}
+	if cfg.NewOCIRepository == nil {
+		return jujuerrors.NotValidf("nil NewOCIRepository")
+	}
 	if cfg.Period <= 0 {
 		return jujuerrors.NotValidf("invalid Period")
 	}
@@ -110,17 +131,19 @@ func Manifold(cfg ManifoldConfig) dependency.Manifold {
 			}
 
 			worker, err := cfg.NewWorker(Config{
-				ModelConfigService: domainServices.Config(),
-				ApplicationService: domainServices.Application(),
-				ModelService:       domainServices.ModelInfo(),
-				ResourceService:    domainServices.Resource(),
-				ModelTag:           cfg.ModelTag,
-				HTTPClientGetter:   httpClientGetter,
-				NewHTTPClient:      cfg.NewHTTPClient,
-				NewCharmhubClient:  cfg.NewCharmhubClient,
-				Clock:              cfg.Clock,
-				Period:             cfg.Period,
-				Logger:             cfg.Logger,
+				ModelConfigService:   domainServices.Config(),
+				ApplicationService:   domainServices.Application(),
+				ModelService:         domainServices.ModelInfo(),
+				ResourceService:      domainServices.Resource(),
+				ModelTag:             cfg.ModelTag,
+				HTTPClientGetter:     httpClientGetter,
+				NewHTTPClient:        cfg.NewHTTPClient,
+				NewCharmhubClient:    cfg.NewCharmhubClient,
+				CharmRegistryService: domainServices.CharmRegistry(),
+				NewOCIRepository:     cfg.NewOCIRepository,
+				Clock:                cfg.Clock,
+				Period:               cfg.Period,
+				Logger:               cfg.Logger,
 			})
 			if err != nil {
 				return nil, errors.Errorf("creating worker: %w", err)
@@ -137,11 +160,21 @@ func NewHTTPClient(ctx context.Context, getter corehttp.HTTPClientGetter) (coreh
 }
 
 // NewCharmhubClient creates a new CharmhubClient.
-func NewCharmhubClient(httpClient charmhub.HTTPClient, url string, logger logger.Logger) (CharmhubClient, error) {
+func NewCharmhubClient(httpClient charmhub.HTTPClient, url string, credentials charmhub.CredentialsFunc, logger logger.Logger) (CharmhubClient, error) {
 	return charmhub.NewClient(charmhub.Config{
-		URL:        url,
-		Logger:     logger,
-		HTTPClient: httpClient,
-		FileSystem: charmhub.DefaultFileSystem(),
+		URL:         url,
+		Logger:      logger,
+		HTTPClient:  httpClient,
+		Credentials: credentials,
+		FileSystem:  charmhub.DefaultFileSystem(),
+	})
+}
+
+// NewOCIRepository creates a new OCIRepository.
+func NewOCIRepository(httpClient ocicharm.HTTPClient, credentials ocicharm.CredentialsFunc, logger logger.Logger) (OCIRepository, error) {
+	return repository.NewOCIRepository(repository.OCIRepositoryConfig{
+		HTTPClient:  httpClient,
+		Credentials: credentials,
+		Logger:      logger,
 	})
 }
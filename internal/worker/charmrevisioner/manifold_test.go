@@ -39,6 +39,7 @@ func validConfig(c *tc.C) ManifoldConfig {
 		HTTPClientName:     "http-client",
 		NewHTTPClient:      NewHTTPClient,
 		NewCharmhubClient:  NewCharmhubClient,
+		NewOCIRepository:   NewOCIRepository,
 		NewWorker:          NewWorker,
 		Period:             time.Second,
 		ModelTag:           names.NewModelTag(uuid.MustNewUUID().String()),
@@ -71,6 +72,11 @@ func (s *ManifoldConfigSuite) TestMissingNewCharmhubClient(c *tc.C) {
 	s.checkNotValid(c, "nil NewCharmhubClient not valid")
 }
 
+func (s *ManifoldConfigSuite) TestMissingNewOCIRepository(c *tc.C) {
+	s.config.NewOCIRepository = nil
+	s.checkNotValid(c, "nil NewOCIRepository not valid")
+}
+
 func (s *ManifoldConfigSuite) TestMissingNewWorker(c *tc.C) {
 	s.config.NewWorker = nil
 	s.checkNotValid(c, "nil NewWorker not valid")
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/charmrevisioner (interfaces: CharmhubClient,ModelConfigService,ApplicationService,ModelService,ResourceService)
+// Source: github.com/juju/juju/internal/worker/charmrevisioner (interfaces: CharmhubClient,ModelConfigService,ApplicationService,ModelService,ResourceService,CharmRegistryService,OCIRepository)
 //
 // Generated by this command:
 //
-//	mockgen -package charmrevisioner -destination package_mocks_test.go github.com/juju/juju/internal/worker/charmrevisioner CharmhubClient,ModelConfigService,ApplicationService,ModelService,ResourceService
+//	mockgen -package charmrevisioner -destination package_mocks_test.go github.com/juju/juju/internal/worker/charmrevisioner CharmhubClient,ModelConfigService,ApplicationService,ModelService,ResourceService,CharmRegistryService,OCIRepository
 //
 
 // Package charmrevisioner is a generated GoMock package.
@@ -19,6 +19,7 @@ import (
 	watcher "github.com/juju/juju/core/watcher"
 	application0 "github.com/juju/juju/domain/application"
 	charm0 "github.com/juju/juju/domain/application/charm"
+	charmregistry "github.com/juju/juju/domain/charmregistry"
 	resource "github.com/juju/juju/domain/resource"
 	config "github.com/juju/juju/environs/config"
 	charmhub "github.com/juju/juju/internal/charmhub"
@@ -315,3 +316,108 @@ func (mr *MockResourceServiceMockRecorder) SetRepositoryResources(ctx, args any)
 
 // MockResourceServiceSetRepositoryResourcesCall is the typed call wrapper for SetRepositoryResources.
 type MockResourceServiceSetRepositoryResourcesCall = gomock.Call2_1[context.Context, resource.SetRepositoryResourcesArgs, error]
+
+// MockCharmRegistryService is a mock of CharmRegistryService interface.
+type MockCharmRegistryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmRegistryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCharmRegistryServiceMockRecorder is the mock recorder for MockCharmRegistryService.
+type MockCharmRegistryServiceMockRecorder struct {
+	mock                 *MockCharmRegistryService
+	getCredentialExpects []*gomock.Call2_2[context.Context, string, charmregistry.Credential, error]
+}
+
+// NewMockCharmRegistryService creates a new mock instance.
+func NewMockCharmRegistryService(ctrl *gomock.Controller) *MockCharmRegistryService {
+	mock := &MockCharmRegistryService{ctrl: ctrl}
+	mock.recorder = &MockCharmRegistryServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmRegistryService) EXPECT() *MockCharmRegistryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCredential mocks base method.
+func (m *MockCharmRegistryService) GetCredential(ctx context.Context, registry string) (charmregistry.Credential, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.getCredentialExpects, m.ctrl, m, "GetCredential", ctx, registry)
+}
+
+// GetCredential indicates an expected call of GetCredential.
+func (mr *MockCharmRegistryServiceMockRecorder) GetCredential(ctx, registry any) *MockCharmRegistryServiceGetCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, string, charmregistry.Credential, error](mr.mock.ctrl.T, mr.mock, "GetCredential", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(registry))
+	mr.getCredentialExpects = append(mr.getCredentialExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmRegistryServiceGetCredentialCall is the typed call wrapper for GetCredential.
+type MockCharmRegistryServiceGetCredentialCall = gomock.Call2_2[context.Context, string, charmregistry.Credential, error]
+
+// MockOCIRepository is a mock of OCIRepository interface.
+type MockOCIRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOCIRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOCIRepositoryMockRecorder is the mock recorder for MockOCIRepository.
+type MockOCIRepositoryMockRecorder struct {
+	mock                               *MockOCIRepository
+	resolveRevisionExpects             []*gomock.Call2_2[context.Context, charm.Origin, int, error]
+	resolveWithPreferredChannelExpects []*gomock.Call3_2[context.Context, string, charm.Origin, charm.ResolvedData, error]
+}
+
+// NewMockOCIRepository creates a new mock instance.
+func NewMockOCIRepository(ctrl *gomock.Controller) *MockOCIRepository {
+	mock := &MockOCIRepository{ctrl: ctrl}
+	mock.recorder = &MockOCIRepositoryMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOCIRepository) EXPECT() *MockOCIRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ResolveRevision mocks base method.
+func (m *MockOCIRepository) ResolveRevision(arg0 context.Context, arg1 charm.Origin) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.resolveRevisionExpects, m.ctrl, m, "ResolveRevision", arg0, arg1)
+}
+
+// ResolveRevision indicates an expected call of ResolveRevision.
+func (mr *MockOCIRepositoryMockRecorder) ResolveRevision(arg0, arg1 any) *MockOCIRepositoryResolveRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, charm.Origin, int, error](mr.mock.ctrl.T, mr.mock, "ResolveRevision", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1))
+	mr.resolveRevisionExpects = append(mr.resolveRevisionExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOCIRepositoryResolveRevisionCall is the typed call wrapper for ResolveRevision.
+type MockOCIRepositoryResolveRevisionCall = gomock.Call2_2[context.Context, charm.Origin, int, error]
+
+// ResolveWithPreferredChannel mocks base method.
+func (m *MockOCIRepository) ResolveWithPreferredChannel(arg0 context.Context, arg1 string, arg2 charm.Origin) (charm.ResolvedData, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.resolveWithPreferredChannelExpects, m.ctrl, m, "ResolveWithPreferredChannel", arg0, arg1, arg2)
+}
+
+// ResolveWithPreferredChannel indicates an expected call of ResolveWithPreferredChannel.
+func (mr *MockOCIRepositoryMockRecorder) ResolveWithPreferredChannel(arg0, arg1, arg2 any) *MockOCIRepositoryResolveWithPreferredChannelCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, string, charm.Origin, charm.ResolvedData, error](mr.mock.ctrl.T, mr.mock, "ResolveWithPreferredChannel", gomock.EnsureMatcher(arg0), gomock.EnsureMatcher(arg1), gomock.EnsureMatcher(arg2))
+	mr.resolveWithPreferredChannelExpects = append(mr.resolveWithPreferredChannelExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOCIRepositoryResolveWithPreferredChannelCall is the typed call wrapper for ResolveWithPreferredChannel.
+type MockOCIRepositoryResolveWithPreferredChannelCall = gomock.Call3_2[context.Context, string, charm.Origin, charm.ResolvedData, error]
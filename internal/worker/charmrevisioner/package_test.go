@@ -3,5 +3,5 @@
 
 package charmrevisioner
 
-//go:generate go run github.com/canonical/gomock/mockgen -package charmrevisioner -destination package_mocks_test.go github.com/juju/juju/internal/worker/charmrevisioner CharmhubClient,ModelConfigService,ApplicationService,ModelService,ResourceService
+//go:generate go run github.com/canonical/gomock/mockgen -package charmrevisioner -destination package_mocks_test.go github.com/juju/juju/internal/worker/charmrevisioner CharmhubClient,ModelConfigService,ApplicationService,ModelService,ResourceService,CharmRegistryService,OCIRepository
 //go:generate go run github.com/canonical/gomock/mockgen -package charmrevisioner -destination http_mocks_test.go github.com/juju/juju/core/http HTTPClientGetter,HTTPClient
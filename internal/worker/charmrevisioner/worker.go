@@ -31,6 +31,7 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/charmregistry"
 	"github.com/juju/juju/domain/deployment"
 	"github.com/juju/juju/domain/deployment/charm"
 	"github.com/juju/juju/domain/deployment/charm/repository"
@@ -89,6 +90,13 @@ type ResourceService interface {
 	SetRepositoryResources(ctx context.Context, args domainresource.SetRepositoryResourcesArgs) error
 }
 
+// CharmRegistryService provides the credentials for OCI registries holding
+// charms.
+type CharmRegistryService interface {
+	// GetCredential returns the credential for the given registry.
+	GetCredential(ctx context.Context, registry string) (charmregistry.Credential, error)
+}
+
 // ModelService provides access to the model.
 type ModelService interface {
 	// GetModelMetrics returns the model metrics information set in the
@@ -122,6 +130,13 @@ type Config struct {
 	// NewCharmhubClient is the function used to create a new CharmhubClient.
 	NewCharmhubClient NewCharmhubClientFunc
 
+	// CharmRegistryService is the service used to look up the credentials
+	// of OCI registries.
+	CharmRegistryService CharmRegistryService
+
+	// NewOCIRepository is the function used to create a new OCIRepository.
+	NewOCIRepository NewOCIRepositoryFunc
+
 	// Clock is the worker's view of time.
 	Clock clock.Clock
 
@@ -156,6 +171,12 @@ func (config Config) Validate() error {
 	if config.NewCharmhubClient == nil {
 		return errors.NotValidf("nil NewCharmhubClient")
 	}
+	if config.CharmRegistryService == nil {
+		return errors.NotValidf("nil CharmRegistryService")
+	}
+	if config.NewOCIRepository == nil {
+		return errors.NotValidf("nil NewOCIRepository")
+	}
 	if config.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
@@ -336,10 +357,29 @@ func (w *revisionUpdateWorker) fetch(ctx context.Context, client CharmhubClient)
 		buildTelemetry = false
 	}
 
-	charmhubIDs := make([]charmhubID, len(applications))
-	charmhubApps := make([]appInfo, len(applications))
+	// Charms from OCI registries are tracked by following the tag of each
+	// application, rather than through the charmhub refresh API.
+	var charmhubApplications, ociApplications []application.RevisionUpdaterApplication
+	for _, app := range applications {
+		if app.CharmLocator.Source == applicationcharm.OCISource {
+			ociApplications = append(ociApplications, app)
+			continue
+		}
+		charmhubApplications = append(charmhubApplications, app)
+	}
 
-	for i, app := range applications {
+	ociLatest, err := w.fetchOCI(ctx, ociApplications)
+	if err != nil {
+		return nil, internalerrors.Errorf("fetching OCI revisions: %w", err)
+	}
+	if len(charmhubApplications) == 0 {
+		return ociLatest, nil
+	}
+
+	charmhubIDs := make([]charmhubID, len(charmhubApplications))
+	charmhubApps := make([]appInfo, len(charmhubApplications))
+
+	for i, app := range charmhubApplications {
 		charmhubID, err := encodeCharmhubID(app, w.config.ModelTag)
 		if err != nil {
 			w.config.Logger.Infof(ctx, "encoding charmhub ID for %q: %v", app.Name, err)
@@ -360,7 +400,72 @@ func (w *revisionUpdateWorker) fetch(ctx context.Context, client CharmhubClient)
 		}
 	}
 
-	return w.fetchInfo(ctx, client, buildTelemetry, charmhubIDs, charmhubApps)
+	latest, err := w.fetchInfo(ctx, client, buildTelemetry, charmhubIDs, charmhubApps)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return append(latest, ociLatest...), nil
+}
+
+// fetchOCI resolves the tag followed by each application deployed from an OCI
+// registry, returning the charms whose tag now points at a newer revision.
+// Applications pinned to a digest can never change, so they are skipped.
+func (w *revisionUpdateWorker) fetchOCI(ctx context.Context, applications []application.RevisionUpdaterApplication) ([]latestCharmInfo, error) {
+	if len(applications) == 0 {
+		return nil, nil
+	}
+
+	repo, err := w.getOCIRepository(ctx)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	var latest []latestCharmInfo
+	for _, app := range applications {
+		info, ok, err := w.fetchOCIApplication(ctx, repo, app)
+		if err != nil {
+			w.config.Logger.Infof(ctx, "resolving OCI charm for %q: %v", app.Name, err)
+			continue
+		} else if !ok {
+			continue
+		}
+		latest = append(latest, info)
+	}
+	return latest, nil
+}
+
+func (w *revisionUpdateWorker) fetchOCIApplication(
+	ctx context.Context, repo OCIRepository, app application.RevisionUpdaterApplication,
+) (latestCharmInfo, bool, error) {
+	origin, err := encodeOCIOrigin(app)
+	if err != nil {
+		return latestCharmInfo{}, false, internalerrors.Capture(err)
+	} else if origin.Channel == nil {
+		return latestCharmInfo{}, false, nil
+	}
+
+	// Resolving the revision only fetches the manifest, so check that first
+	// to avoid downloading the charm archive when nothing has changed.
+	revision, err := repo.ResolveRevision(ctx, origin)
+	if err != nil {
+		return latestCharmInfo{}, false, internalerrors.Capture(err)
+	} else if revision <= app.CharmLocator.Revision {
+		return latestCharmInfo{}, false, nil
+	}
+
+	resolved, err := repo.ResolveWithPreferredChannel(ctx, app.CharmLocator.Name, origin)
+	if err != nil {
+		return latestCharmInfo{}, false, internalerrors.Capture(err)
+	}
+
+	return latestCharmInfo{
+		source:            corecharm.OCI,
+		charmLocator:      app.CharmLocator,
+		essentialMetadata: resolved.EssentialMetadata,
+		timestamp:         w.config.Clock.Now(),
+		revision:          resolved.URL.Revision,
+		appName:           app.Name,
+	}, true, nil
 }
 
 func (w *revisionUpdateWorker) recordNoApplications(ctx context.Context, client CharmhubClient) error {
@@ -446,6 +551,7 @@ func (w *revisionUpdateWorker) fetchInfo(ctx context.Context, client CharmhubCli
 		}
 
 		latest = append(latest, latestCharmInfo{
+			source:            corecharm.CharmHub,
 			charmLocator:      apps[i].charmLocator,
 			essentialMetadata: essentialMetadata,
 			timestamp:         result.timestamp,
@@ -529,8 +635,7 @@ func (w *revisionUpdateWorker) storeNewCharmRevision(ctx context.Context, info l
 			essentialMetadata.Config,
 			essentialMetadata.Actions,
 		),
-		// This will always be a charmhub charm.
-		Source:        corecharm.CharmHub,
+		Source:        info.source,
 		ReferenceName: info.charmLocator.Name,
 		// This is the new revision located from the fetch.
 		Revision: info.revision,
@@ -589,7 +694,18 @@ func (w *revisionUpdateWorker) getCharmhubClient(ctx context.Context) (CharmhubC
 	}
 	charmhubURL, _ := config.CharmHubURL()
 
-	return w.config.NewCharmhubClient(httpClient, charmhubURL, w.config.Logger)
+	credentials := repository.CharmhubCredentials(w.config.CharmRegistryService)
+	return w.config.NewCharmhubClient(httpClient, charmhubURL, credentials, w.config.Logger)
+}
+
+func (w *revisionUpdateWorker) getOCIRepository(ctx context.Context) (OCIRepository, error) {
+	httpClient, err := w.config.NewHTTPClient(ctx, w.config.HTTPClientGetter)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	credentials := repository.OCICredentials(w.config.CharmRegistryService)
+	return w.config.NewOCIRepository(httpClient, credentials, w.config.Logger)
 }
 
 // buildMetricsMetadata returns a map containing metadata key/value pairs to
@@ -746,6 +862,39 @@ func encodeCharmhubID(app application.RevisionUpdaterApplication, modelTag names
 	}, nil
 }
 
+// encodeOCIOrigin returns the origin of an application deployed from an OCI
+// registry. The channel is only set when the application follows a tag.
+func encodeOCIOrigin(app application.RevisionUpdaterApplication) (corecharm.Origin, error) {
+	arch, err := encodeArchitecture(app.Origin.Platform.Architecture)
+	if err != nil {
+		return corecharm.Origin{}, internalerrors.Errorf("encoding architecture: %w", err)
+	}
+
+	osType, err := encodeOSType(app.Origin.Platform.OSType)
+	if err != nil {
+		return corecharm.Origin{}, internalerrors.Errorf("encoding os type: %w", err)
+	}
+
+	origin := corecharm.Origin{
+		Source: corecharm.OCI,
+		Type:   "charm",
+		ID:     app.Origin.ID,
+		Platform: corecharm.Platform{
+			Architecture: arch,
+			OS:           osType,
+			Channel:      app.Origin.Platform.Channel,
+		},
+	}
+	if strings.Contains(app.Origin.ID, "@") || app.Origin.Channel.Track == "" {
+		return origin, nil
+	}
+	origin.Channel = &charm.Channel{
+		Track: app.Origin.Channel.Track,
+		Risk:  charm.Stable,
+	}
+	return origin, nil
+}
+
 func encodeArchitecture(a architecture.Architecture) (string, error) {
 	switch a {
 	case architecture.AMD64:
@@ -810,6 +959,7 @@ type charmhubID struct {
 }
 
 type latestCharmInfo struct {
+	source            corecharm.Source
 	charmLocator      applicationcharm.CharmLocator
 	essentialMetadata corecharm.EssentialMetadata
 	timestamp         time.Time
@@ -35,6 +35,7 @@ import (
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/charmhub/transport"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/ocicharm"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -50,6 +51,8 @@ type WorkerSuite struct {
 	modelService       *MockModelService
 	resourceService    *MockResourceService
 	charmhubClient     *MockCharmhubClient
+	registryService    *MockCharmRegistryService
+	ociRepository      *MockOCIRepository
 	httpClient         *MockHTTPClient
 	httpClientGetter   *MockHTTPClientGetter
 	clock              *testclock.Clock
@@ -360,6 +363,7 @@ func (s *WorkerSuite) TestFetch(c *tc.C) {
 
 	channel := internalcharm.MakePermissiveChannel("latest", "stable", "")
 	c.Check(result, tc.DeepEquals, []latestCharmInfo{{
+		source: charm.CharmHub,
 		essentialMetadata: charm.EssentialMetadata{
 			ResolvedOrigin: charm.Origin{
 				Source:   charm.CharmHub,
@@ -379,6 +383,142 @@ func (s *WorkerSuite) TestFetch(c *tc.C) {
 	}})
 }
 
+func (s *WorkerSuite) TestFetchOCI(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectWatcher(c)
+	s.expectModelConfig(c)
+	s.expectModelConfig(c)
+
+	charmLocator := applicationcharm.CharmLocator{
+		Source:       applicationcharm.OCISource,
+		Name:         "foo",
+		Revision:     42,
+		Architecture: architecture.AMD64,
+	}
+
+	s.applicationService.EXPECT().GetApplicationsForRevisionUpdater(gomock.Any()).Return([]application.RevisionUpdaterApplication{{
+		Name:         "foo",
+		CharmLocator: charmLocator,
+		Origin: application.Origin{
+			ID:       "registry.example.com/charms/foo",
+			Revision: 42,
+			Channel: deployment.Channel{
+				Track: "stable",
+				Risk:  deployment.RiskStable,
+			},
+			Platform: deployment.Platform{
+				Architecture: architecture.AMD64,
+				Channel:      "22.04",
+				OSType:       deployment.Ubuntu,
+			},
+		},
+	}, {
+		// Applications pinned by digest are never refreshed.
+		Name: "bar",
+		CharmLocator: applicationcharm.CharmLocator{
+			Source:   applicationcharm.OCISource,
+			Name:     "bar",
+			Revision: 1,
+		},
+		Origin: application.Origin{
+			ID: "registry.example.com/charms/bar@sha256:1111",
+			Platform: deployment.Platform{
+				Architecture: architecture.AMD64,
+				OSType:       deployment.Ubuntu,
+			},
+		},
+	}}, nil)
+
+	origin := charm.Origin{
+		Source: charm.OCI,
+		Type:   "charm",
+		ID:     "registry.example.com/charms/foo",
+		Channel: &internalcharm.Channel{
+			Track: "stable",
+			Risk:  internalcharm.Stable,
+		},
+		Platform: charm.Platform{
+			Architecture: "amd64",
+			OS:           "ubuntu",
+			Channel:      "22.04",
+		},
+	}
+	resolvedOrigin := origin
+	resolvedOrigin.Revision = new(43)
+	essentialMetadata := charm.EssentialMetadata{
+		Meta:           &internalcharm.Meta{Name: "foo"},
+		ResolvedOrigin: resolvedOrigin,
+		DownloadInfo: charm.DownloadInfo{
+			CharmhubIdentifier: "registry.example.com/charms/foo",
+			DownloadURL:        "oci://registry.example.com/charms/foo@sha256:2222",
+		},
+	}
+
+	s.ociRepository.EXPECT().ResolveRevision(gomock.Any(), origin).Return(43, nil)
+	s.ociRepository.EXPECT().ResolveWithPreferredChannel(gomock.Any(), "foo", origin).Return(charm.ResolvedData{
+		URL:               &internalcharm.URL{Schema: "oci", Name: "foo", Revision: 43},
+		Origin:            resolvedOrigin,
+		EssentialMetadata: essentialMetadata,
+	}, nil)
+
+	w := s.newWorker(c)
+	defer workertest.DirtyKill(c, w)
+
+	s.ensureStartup(c)
+
+	result, err := w.fetch(c.Context(), s.charmhubClient)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []latestCharmInfo{{
+		source:            charm.OCI,
+		charmLocator:      charmLocator,
+		essentialMetadata: essentialMetadata,
+		timestamp:         s.now,
+		revision:          43,
+		appName:           "foo",
+	}})
+}
+
+func (s *WorkerSuite) TestFetchOCINoNewRevision(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectWatcher(c)
+	s.expectModelConfig(c)
+	s.expectModelConfig(c)
+
+	s.applicationService.EXPECT().GetApplicationsForRevisionUpdater(gomock.Any()).Return([]application.RevisionUpdaterApplication{{
+		Name: "foo",
+		CharmLocator: applicationcharm.CharmLocator{
+			Source:   applicationcharm.OCISource,
+			Name:     "foo",
+			Revision: 42,
+		},
+		Origin: application.Origin{
+			ID: "registry.example.com/charms/foo",
+			Channel: deployment.Channel{
+				Track: "stable",
+			},
+			Platform: deployment.Platform{
+				Architecture: architecture.AMD64,
+				OSType:       deployment.Ubuntu,
+			},
+		},
+	}}, nil)
+
+	// The archive is never downloaded if the tag still points at the
+	// current revision.
+	s.ociRepository.EXPECT().ResolveRevision(gomock.Any(), gomock.Any()).Return(42, nil)
+
+	w := s.newWorker(c)
+	defer workertest.DirtyKill(c, w)
+
+	s.ensureStartup(c)
+
+	result, err := w.fetch(c.Context(), s.charmhubClient)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.HasLen, 0)
+}
+
 func (s *WorkerSuite) TestFetchInfo(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -465,6 +605,7 @@ func (s *WorkerSuite) TestFetchInfo(c *tc.C) {
 
 	channel := internalcharm.MakePermissiveChannel("latest", "stable", "")
 	c.Check(result, tc.DeepEquals, []latestCharmInfo{{
+		source: charm.CharmHub,
 		essentialMetadata: charm.EssentialMetadata{
 			ResolvedOrigin: charm.Origin{
 				Source:   charm.CharmHub,
@@ -823,6 +964,7 @@ func (s *WorkerSuite) TestStoreNewCharmRevisionsNoResource(c *tc.C) {
 	s.expectModelConfig(c)
 
 	latestCharmInfos := []latestCharmInfo{{
+		source: charm.CharmHub,
 		charmLocator: applicationcharm.CharmLocator{
 			Source:       applicationcharm.CharmHubSource,
 			Name:         "foo",
@@ -889,6 +1031,7 @@ func (s *WorkerSuite) TestStoreNewCharmRevisionsError(c *tc.C) {
 	s.expectModelConfig(c)
 
 	latestCharmInfos := []latestCharmInfo{{
+		source: charm.CharmHub,
 		charmLocator: applicationcharm.CharmLocator{
 			Source:       applicationcharm.CharmHubSource,
 			Name:         "foo",
@@ -916,6 +1059,7 @@ func (s *WorkerSuite) TestStoreNewResourceRevisions(c *tc.C) {
 	s.expectModelConfig(c)
 
 	latestCharmInfos := []latestCharmInfo{{
+		source:  charm.CharmHub,
 		appName: "foo",
 		resources: []resource.Resource{{
 			Meta:     resource.Meta{Name: "foo"},
@@ -974,6 +1118,7 @@ func (s *WorkerSuite) TestStoreNewResourceRevisionsWithApplicationNotFound(c *tc
 
 	latestCharmInfos := []latestCharmInfo{
 		{
+			source:  charm.CharmHub,
 			appName: "not-found",
 			// Resources need to not be empty: if empty, SetRepositoryResources
 			// won't be triggered
@@ -1025,6 +1170,7 @@ func (s *WorkerSuite) TestStoreNewResourceRevisionsErrorGetApplicationID(c *tc.C
 
 	latestCharmInfos := []latestCharmInfo{
 		{
+			source:  charm.CharmHub,
 			appName: "foo",
 			// Resources need to not be empty: if empty, SetRepositoryResources
 			// won't be triggered
@@ -1059,6 +1205,7 @@ func (s *WorkerSuite) TestStoreNewResourceRevisionsErrorStoreRepositoryResources
 
 	latestCharmInfos := []latestCharmInfo{
 		{
+			source:  charm.CharmHub,
 			appName: "foo",
 			// Resources need to not be empty: if empty, SetRepositoryResources
 			// won't be triggered
@@ -1267,6 +1414,8 @@ func (s *WorkerSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.modelService = NewMockModelService(ctrl)
 	s.resourceService = NewMockResourceService(ctrl)
 	s.charmhubClient = NewMockCharmhubClient(ctrl)
+	s.registryService = NewMockCharmRegistryService(ctrl)
+	s.ociRepository = NewMockOCIRepository(ctrl)
 
 	s.now = time.Now()
 	s.clock = testclock.NewClock(s.now)
@@ -1287,9 +1436,13 @@ func (s *WorkerSuite) newWorker(c *tc.C) *revisionUpdateWorker {
 			return s.httpClient, nil
 		},
 		HTTPClientGetter: s.httpClientGetter,
-		NewCharmhubClient: func(charmhub.HTTPClient, string, logger.Logger) (CharmhubClient, error) {
+		NewCharmhubClient: func(charmhub.HTTPClient, string, charmhub.CredentialsFunc, logger.Logger) (CharmhubClient, error) {
 			return s.charmhubClient, nil
 		},
+		CharmRegistryService: s.registryService,
+		NewOCIRepository: func(ocicharm.HTTPClient, ocicharm.CredentialsFunc, logger.Logger) (OCIRepository, error) {
+			return s.ociRepository, nil
+		},
 		Period: time.Second,
 		Clock:  s.clock,
 		Logger: loggertesting.WrapCheckLog(c),
@@ -3,4 +3,4 @@
 
 package controlsocket
 
-//go:generate go run github.com/canonical/gomock/mockgen -package controlsocket -destination services_mock_test.go github.com/juju/juju/internal/worker/controlsocket AccessService,TracingService,LoggingService,ControllerObjectStoreService
+//go:generate go run github.com/canonical/gomock/mockgen -package controlsocket -destination services_mock_test.go github.com/juju/juju/internal/worker/controlsocket AccessService,TracingService,LoggingService,CharmRegistryService,CharmMirrorService,ControllerObjectStoreService
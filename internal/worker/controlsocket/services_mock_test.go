@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/controlsocket (interfaces: AccessService,TracingService,LoggingService,ControllerObjectStoreService)
+// Source: github.com/juju/juju/internal/worker/controlsocket (interfaces: AccessService,TracingService,LoggingService,CharmRegistryService,CharmMirrorService,ControllerObjectStoreService)
 //
 // Generated by this command:
 //
-//	mockgen -package controlsocket -destination services_mock_test.go github.com/juju/juju/internal/worker/controlsocket AccessService,TracingService,LoggingService,ControllerObjectStoreService
+//	mockgen -package controlsocket -destination services_mock_test.go github.com/juju/juju/internal/worker/controlsocket AccessService,TracingService,LoggingService,CharmRegistryService,CharmMirrorService,ControllerObjectStoreService
 //
 
 // Package controlsocket is a generated GoMock package.
@@ -11,11 +11,14 @@ package controlsocket
 
 import (
 	context "context"
+	io "io"
 
 	gomock "github.com/canonical/gomock/gomock"
 	permission "github.com/juju/juju/core/permission"
 	user "github.com/juju/juju/core/user"
 	service "github.com/juju/juju/domain/access/service"
+	charmmirror "github.com/juju/juju/domain/charmmirror"
+	charmregistry "github.com/juju/juju/domain/charmregistry"
 	logging "github.com/juju/juju/domain/logging"
 	objectstore "github.com/juju/juju/domain/objectstore"
 	service0 "github.com/juju/juju/domain/tracing/service"
@@ -265,6 +268,149 @@ func (mr *MockLoggingServiceMockRecorder) SetLokiConfig(ctx, config any) *MockLo
 // MockLoggingServiceSetLokiConfigCall is the typed call wrapper for SetLokiConfig.
 type MockLoggingServiceSetLokiConfigCall = gomock.Call2_1[context.Context, logging.LokiConfig, error]
 
+// MockCharmRegistryService is a mock of CharmRegistryService interface.
+type MockCharmRegistryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmRegistryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCharmRegistryServiceMockRecorder is the mock recorder for MockCharmRegistryService.
+type MockCharmRegistryServiceMockRecorder struct {
+	mock                    *MockCharmRegistryService
+	removeCredentialExpects []*gomock.Call2_1[context.Context, string, error]
+	setCredentialExpects    []*gomock.Call2_1[context.Context, charmregistry.Credential, error]
+}
+
+// NewMockCharmRegistryService creates a new mock instance.
+func NewMockCharmRegistryService(ctrl *gomock.Controller) *MockCharmRegistryService {
+	mock := &MockCharmRegistryService{ctrl: ctrl}
+	mock.recorder = &MockCharmRegistryServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmRegistryService) EXPECT() *MockCharmRegistryServiceMockRecorder {
+	return m.recorder
+}
+
+// RemoveCredential mocks base method.
+func (m *MockCharmRegistryService) RemoveCredential(ctx context.Context, registry string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.removeCredentialExpects, m.ctrl, m, "RemoveCredential", ctx, registry)
+}
+
+// RemoveCredential indicates an expected call of RemoveCredential.
+func (mr *MockCharmRegistryServiceMockRecorder) RemoveCredential(ctx, registry any) *MockCharmRegistryServiceRemoveCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "RemoveCredential", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(registry))
+	mr.removeCredentialExpects = append(mr.removeCredentialExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmRegistryServiceRemoveCredentialCall is the typed call wrapper for RemoveCredential.
+type MockCharmRegistryServiceRemoveCredentialCall = gomock.Call2_1[context.Context, string, error]
+
+// SetCredential mocks base method.
+func (m *MockCharmRegistryService) SetCredential(ctx context.Context, cred charmregistry.Credential) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.setCredentialExpects, m.ctrl, m, "SetCredential", ctx, cred)
+}
+
+// SetCredential indicates an expected call of SetCredential.
+func (mr *MockCharmRegistryServiceMockRecorder) SetCredential(ctx, cred any) *MockCharmRegistryServiceSetCredentialCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, charmregistry.Credential, error](mr.mock.ctrl.T, mr.mock, "SetCredential", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(cred))
+	mr.setCredentialExpects = append(mr.setCredentialExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmRegistryServiceSetCredentialCall is the typed call wrapper for SetCredential.
+type MockCharmRegistryServiceSetCredentialCall = gomock.Call2_1[context.Context, charmregistry.Credential, error]
+
+// MockCharmMirrorService is a mock of CharmMirrorService interface.
+type MockCharmMirrorService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmMirrorServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCharmMirrorServiceMockRecorder is the mock recorder for MockCharmMirrorService.
+type MockCharmMirrorServiceMockRecorder struct {
+	mock                *MockCharmMirrorService
+	importSeedExpects   []*gomock.Call2_2[context.Context, io.Reader, []charmmirror.Entity, error]
+	removeClientExpects []*gomock.Call2_1[context.Context, string, error]
+	setClientExpects    []*gomock.Call3_1[context.Context, string, auth.Password, error]
+}
+
+// NewMockCharmMirrorService creates a new mock instance.
+func NewMockCharmMirrorService(ctrl *gomock.Controller) *MockCharmMirrorService {
+	mock := &MockCharmMirrorService{ctrl: ctrl}
+	mock.recorder = &MockCharmMirrorServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmMirrorService) EXPECT() *MockCharmMirrorServiceMockRecorder {
+	return m.recorder
+}
+
+// ImportSeed mocks base method.
+func (m *MockCharmMirrorService) ImportSeed(ctx context.Context, r io.Reader) ([]charmmirror.Entity, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_2(&m.recorder.importSeedExpects, m.ctrl, m, "ImportSeed", ctx, r)
+}
+
+// ImportSeed indicates an expected call of ImportSeed.
+func (mr *MockCharmMirrorServiceMockRecorder) ImportSeed(ctx, r any) *MockCharmMirrorServiceImportSeedCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_2[context.Context, io.Reader, []charmmirror.Entity, error](mr.mock.ctrl.T, mr.mock, "ImportSeed", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(r))
+	mr.importSeedExpects = append(mr.importSeedExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmMirrorServiceImportSeedCall is the typed call wrapper for ImportSeed.
+type MockCharmMirrorServiceImportSeedCall = gomock.Call2_2[context.Context, io.Reader, []charmmirror.Entity, error]
+
+// RemoveClient mocks base method.
+func (m *MockCharmMirrorService) RemoveClient(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.removeClientExpects, m.ctrl, m, "RemoveClient", ctx, name)
+}
+
+// RemoveClient indicates an expected call of RemoveClient.
+func (mr *MockCharmMirrorServiceMockRecorder) RemoveClient(ctx, name any) *MockCharmMirrorServiceRemoveClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, string, error](mr.mock.ctrl.T, mr.mock, "RemoveClient", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name))
+	mr.removeClientExpects = append(mr.removeClientExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmMirrorServiceRemoveClientCall is the typed call wrapper for RemoveClient.
+type MockCharmMirrorServiceRemoveClientCall = gomock.Call2_1[context.Context, string, error]
+
+// SetClient mocks base method.
+func (m *MockCharmMirrorService) SetClient(ctx context.Context, name string, password auth.Password) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_1(&m.recorder.setClientExpects, m.ctrl, m, "SetClient", ctx, name, password)
+}
+
+// SetClient indicates an expected call of SetClient.
+func (mr *MockCharmMirrorServiceMockRecorder) SetClient(ctx, name, password any) *MockCharmMirrorServiceSetClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_1[context.Context, string, auth.Password, error](mr.mock.ctrl.T, mr.mock, "SetClient", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(password))
+	mr.setClientExpects = append(mr.setClientExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockCharmMirrorServiceSetClientCall is the typed call wrapper for SetClient.
+type MockCharmMirrorServiceSetClientCall = gomock.Call3_1[context.Context, string, auth.Password, error]
+
 // MockControllerObjectStoreService is a mock of ControllerObjectStoreService interface.
 type MockControllerObjectStoreService struct {
 	ctrl     *gomock.Controller
@@ -26,6 +26,10 @@ import (
 	"github.com/juju/juju/core/user"
 	usererrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/domain/access/service"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/domain/charmregistry"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
 	"github.com/juju/juju/domain/logging"
 	domainobjectstore "github.com/juju/juju/domain/objectstore"
 	tracingservice "github.com/juju/juju/domain/tracing/service"
@@ -119,6 +123,32 @@ type LoggingService interface {
 	DeleteLokiConfig(ctx context.Context) error
 }
 
+// CharmRegistryService is the interface for the charm registry service.
+type CharmRegistryService interface {
+	// SetCredential sets the credential for an OCI registry, replacing any
+	// existing credential for it.
+	SetCredential(ctx context.Context, cred charmregistry.Credential) error
+
+	// RemoveCredential removes the credential for an OCI registry.
+	RemoveCredential(ctx context.Context, registry string) error
+}
+
+// CharmMirrorService is the interface for the charm mirror service.
+type CharmMirrorService interface {
+	// ImportSeed adds every revision held in the seed read from r to the
+	// charm mirror, returning the revisions added.
+	ImportSeed(ctx context.Context, r io.Reader) ([]charmmirror.Entity, error)
+
+	// SetClient allows the client with the given name to use the charm
+	// mirror by authenticating with the password, replacing the password of
+	// an existing client.
+	SetClient(ctx context.Context, name string, password auth.Password) error
+
+	// RemoveClient stops the client with the given name from using the
+	// charm mirror.
+	RemoveClient(ctx context.Context, name string) error
+}
+
 // Config represents configuration for the controlsocket worker.
 type Config struct {
 	// AccessService is the user access service for the model.
@@ -129,6 +159,10 @@ type Config struct {
 	LoggingService LoggingService
 	// ObjectStoreService is the object store service for the controller.
 	ObjectStoreService ControllerObjectStoreService
+	// CharmRegistryService is the charm registry service for the controller.
+	CharmRegistryService CharmRegistryService
+	// CharmMirrorService is the charm mirror service for the controller.
+	CharmMirrorService CharmMirrorService
 	// SocketName is the socket file descriptor.
 	SocketName string
 	// NewSocketListener is the function that creates a new socket listener.
@@ -150,6 +184,12 @@ func (config Config) Validate() error {
 	if config.ObjectStoreService == nil {
 		return internalerrors.New("nil ObjectStoreService").Add(coreerrors.NotValid)
 	}
+	if config.CharmRegistryService == nil {
+		return internalerrors.New("nil CharmRegistryService").Add(coreerrors.NotValid)
+	}
+	if config.CharmMirrorService == nil {
+		return internalerrors.New("nil CharmMirrorService").Add(coreerrors.NotValid)
+	}
 	if config.ControllerModelUUID == "" {
 		return internalerrors.New("empty ControllerModelUUID").Add(coreerrors.NotValid)
 	}
@@ -176,6 +216,8 @@ type Worker struct {
 	tracingService     TracingService
 	loggingService     LoggingService
 	objectStoreService ControllerObjectStoreService
+	charmRegistry      CharmRegistryService
+	charmMirror        CharmMirrorService
 
 	controllerModelUUID model.UUID
 	userCreatorName     user.Name
@@ -200,6 +242,8 @@ func NewWorker(config Config) (worker.Worker, error) {
 		tracingService:      config.TracingService,
 		loggingService:      config.LoggingService,
 		objectStoreService:  config.ObjectStoreService,
+		charmRegistry:       config.CharmRegistryService,
+		charmMirror:         config.CharmMirrorService,
 		controllerModelUUID: config.ControllerModelUUID,
 		userCreatorName:     userCreatorName,
 
@@ -295,11 +339,16 @@ func (w *Worker) registerHandlers(r *mux.Router) {
 	//   "sample_ratio": <float>,
 	//   "tail_sampling_threshold": <string>,
 	//   "insecure_skip_verify": <bool>,
+	//   "export_metrics": <bool>,
+	//   "export_logs": <bool>,
 	// }
 	//
 	// The worker will update the workload tracing configuration with the
 	// provided values. Any field that are omitted or empty will be removed from
-	// the workload tracing configuration.
+	// the workload tracing configuration. The export_metrics field enables
+	// exporting the controller metrics to the same collector as the traces.
+	// The export_logs field sends the logs of every agent to that collector,
+	// using the same endpoint and TLS settings, instead of to Loki.
 	r.Handle("/workload-tracing-config", w.withMetrics("/workload-tracing-config", w.handleJSONPost(w.handleSetWorkloadTracingConfig))).
 		Methods(http.MethodPost)
 
@@ -335,6 +384,55 @@ func (w *Worker) registerHandlers(r *mux.Router) {
 		Methods(http.MethodPost)
 	r.Handle("/loki-endpoint", w.withMetrics("/loki-endpoint", http.HandlerFunc(w.handleRemoveLokiEndpoint))).
 		Methods(http.MethodDelete)
+
+	// charm-registries endpoint for managing the credentials used to pull
+	// charms from OCI registries, and from Charmhub mirrors served by other
+	// controllers. This is a POST endpoint that accepts a JSON
+	// body with the following format:
+	//
+	// {
+	//   "registry": <string>,
+	//   "username": <string>,
+	//   "password": <string>,
+	// }
+	//
+	// The registry is the host, and optional port, of the registry. The
+	// credential is held in a secret kept for the controller in the
+	// controller model, which its users can't see or edit, and any existing
+	// credential for the registry is replaced. A credential can be
+	// removed by sending a DELETE request to the
+	// /charm-registries/{registry} endpoint.
+	r.Handle("/charm-registries", w.withMetrics("/charm-registries", w.handleJSONPost(w.handleSetCharmRegistry))).
+		Methods(http.MethodPost)
+	r.Handle("/charm-registries/{registry}", w.withMetrics("/charm-registries/{registry}", http.HandlerFunc(w.handleRemoveCharmRegistry))).
+		Methods(http.MethodDelete)
+
+	// charm-mirror/seed endpoint for pre-seeding the charm mirror. This is a
+	// POST endpoint that accepts a seed tarball, as written by
+	// `juju download --seed`, as the request body. Seeds hold charm
+	// archives, so they are not subject to the payload limit of the JSON
+	// endpoints.
+	r.Handle("/charm-mirror/seed", w.withMetrics("/charm-mirror/seed", closeRequestBodyMiddleware(http.HandlerFunc(w.handleImportCharmMirrorSeed)))).
+		Methods(http.MethodPost)
+
+	// charm-mirror/clients endpoint for managing the clients, which are other
+	// controllers, allowed to use the charm mirror. This is a POST endpoint
+	// that accepts a JSON body with the following format:
+	//
+	// {
+	//   "username": <string>,
+	//   "password": <string>,
+	// }
+	//
+	// Clients authenticate with basic auth as the username and password,
+	// which the other controllers hold as the credential for this one in
+	// their /charm-registries endpoint. The password of an existing client is
+	// replaced. A client can be removed by sending a DELETE request to the
+	// /charm-mirror/clients/{username} endpoint.
+	r.Handle("/charm-mirror/clients", w.withMetrics("/charm-mirror/clients", w.handleJSONPost(w.handleSetCharmMirrorClient))).
+		Methods(http.MethodPost)
+	r.Handle("/charm-mirror/clients/{username}", w.withMetrics("/charm-mirror/clients/{username}", http.HandlerFunc(w.handleRemoveCharmMirrorClient))).
+		Methods(http.MethodDelete)
 }
 
 func (w *Worker) withMetrics(endpoint string, handler http.Handler) http.Handler {
@@ -525,6 +623,8 @@ type setWorkloadTracingConfig struct {
 	OpenTelemetryStackTraces           *bool    `json:"stack_traces"`
 	OpenTelemetrySampleRatio           *float64 `json:"sample_ratio"`
 	OpenTelemetryTailSamplingThreshold *string  `json:"tail_sampling_threshold"`
+	ExportMetrics                      *bool    `json:"export_metrics"`
+	ExportLogs                         *bool    `json:"export_logs"`
 }
 
 func (w *Worker) handleSetWorkloadTracingConfig(resp http.ResponseWriter, req *http.Request) {
@@ -555,6 +655,8 @@ func (w *Worker) handleSetWorkloadTracingConfig(resp http.ResponseWriter, req *h
 		OpenTelemetryStackTraces:           parsedBody.OpenTelemetryStackTraces,
 		OpenTelemetrySampleRatio:           parsedBody.OpenTelemetrySampleRatio,
 		OpenTelemetryTailSamplingThreshold: parsedBody.OpenTelemetryTailSamplingThreshold,
+		ExportMetrics:                      parsedBody.ExportMetrics,
+		ExportLogs:                         parsedBody.ExportLogs,
 	})
 	if internalerrors.Is(err, coreerrors.NotValid) {
 		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid workload tracing config: %w", err))
@@ -674,6 +776,151 @@ func (w *Worker) handleRemoveLokiEndpoint(resp http.ResponseWriter, req *http.Re
 	w.writeResponse(ctx, resp, http.StatusOK, infof("removed loki endpoint"))
 }
 
+type charmRegistryRequest struct {
+	Registry string `json:"registry"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func (w *Worker) handleSetCharmRegistry(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var parsedBody charmRegistryRequest
+	if err := json.NewDecoder(req.Body).Decode(&parsedBody); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		switch {
+		case internalerrors.Is(err, io.EOF):
+			w.writeErrorResponse(ctx, resp, http.StatusBadRequest,
+				internalerrors.New("missing request body"))
+		case internalerrors.As(err, &maxBytesErr):
+			w.writeErrorResponse(ctx, resp, http.StatusRequestEntityTooLarge,
+				internalerrors.Errorf("request body must not exceed %d bytes", maxPayloadBytes))
+		default:
+			w.writeErrorResponse(ctx, resp, http.StatusBadRequest,
+				internalerrors.Errorf("request body is not valid JSON: %w", err))
+		}
+		return
+	}
+
+	if err := w.charmRegistry.SetCredential(ctx, charmregistry.Credential{
+		Registry: parsedBody.Registry,
+		Username: parsedBody.Username,
+		Password: parsedBody.Password,
+	}); internalerrors.Is(err, coreerrors.NotValid) {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid charm registry credential: %w", err))
+		return
+	} else if err != nil {
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("saving charm registry credential: %w", err))
+		return
+	}
+
+	w.writeResponse(ctx, resp, http.StatusOK, infof("updated credential for registry %q", parsedBody.Registry))
+}
+
+func (w *Worker) handleRemoveCharmRegistry(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	registry, ok := mux.Vars(req)["registry"]
+	if !ok {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.New("missing registry"))
+		return
+	}
+
+	if err := w.charmRegistry.RemoveCredential(ctx, registry); internalerrors.Is(err, charmregistryerrors.CredentialNotFound) {
+		w.writeErrorResponse(ctx, resp, http.StatusNotFound, err)
+		return
+	} else if internalerrors.Is(err, coreerrors.NotValid) {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid registry: %w", err))
+		return
+	} else if err != nil {
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("removing charm registry credential: %w", err))
+		return
+	}
+
+	w.writeResponse(ctx, resp, http.StatusOK, infof("removed credential for registry %q", registry))
+}
+
+func (w *Worker) handleImportCharmMirrorSeed(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	entities, err := w.charmMirror.ImportSeed(ctx, req.Body)
+	if internalerrors.IsOneOf(err, coreerrors.NotValid, charmmirrorerrors.HashMismatch) {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid charm mirror seed: %w", err))
+		return
+	} else if internalerrors.Is(err, charmmirrorerrors.RevisionConflict) {
+		w.writeErrorResponse(ctx, resp, http.StatusConflict, internalerrors.Errorf("importing charm mirror seed: %w", err))
+		return
+	} else if err != nil {
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("importing charm mirror seed: %w", err))
+		return
+	}
+
+	revisions := make([]string, len(entities))
+	for i, entity := range entities {
+		revisions[i] = fmt.Sprintf("%s revision %d", entity.Name, entity.Revision)
+	}
+	w.writeResponse(ctx, resp, http.StatusOK, infof("imported %s", strings.Join(revisions, ", ")))
+}
+
+type charmMirrorClientRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func (w *Worker) handleSetCharmMirrorClient(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var parsedBody charmMirrorClientRequest
+	if err := json.NewDecoder(req.Body).Decode(&parsedBody); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		switch {
+		case internalerrors.Is(err, io.EOF):
+			w.writeErrorResponse(ctx, resp, http.StatusBadRequest,
+				internalerrors.New("missing request body"))
+		case internalerrors.As(err, &maxBytesErr):
+			w.writeErrorResponse(ctx, resp, http.StatusRequestEntityTooLarge,
+				internalerrors.Errorf("request body must not exceed %d bytes", maxPayloadBytes))
+		default:
+			w.writeErrorResponse(ctx, resp, http.StatusBadRequest,
+				internalerrors.Errorf("request body is not valid JSON: %w", err))
+		}
+		return
+	}
+
+	if err := w.charmMirror.SetClient(ctx, parsedBody.Username, auth.NewPassword(parsedBody.Password)); internalerrors.Is(err, coreerrors.NotValid) {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid charm mirror client: %w", err))
+		return
+	} else if err != nil {
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("saving charm mirror client: %w", err))
+		return
+	}
+
+	w.writeResponse(ctx, resp, http.StatusOK, infof("updated charm mirror client %q", parsedBody.Username))
+}
+
+func (w *Worker) handleRemoveCharmMirrorClient(resp http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	username, ok := mux.Vars(req)["username"]
+	if !ok {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.New("missing username"))
+		return
+	}
+
+	if err := w.charmMirror.RemoveClient(ctx, username); internalerrors.Is(err, charmmirrorerrors.ClientNotFound) {
+		w.writeErrorResponse(ctx, resp, http.StatusNotFound, err)
+		return
+	} else if internalerrors.Is(err, coreerrors.NotValid) {
+		w.writeErrorResponse(ctx, resp, http.StatusBadRequest, internalerrors.Errorf("invalid charm mirror client: %w", err))
+		return
+	} else if err != nil {
+		w.writeErrorResponse(ctx, resp, http.StatusInternalServerError, internalerrors.Errorf("removing charm mirror client: %w", err))
+		return
+	}
+
+	w.writeResponse(ctx, resp, http.StatusOK, infof("removed charm mirror client %q", username))
+}
+
 func (w *Worker) handleJSONPost(fn func(http.ResponseWriter, *http.Request)) http.Handler {
 	errorWriter := errorResponseWriter(w.writeErrorResponse)
 
@@ -26,6 +26,10 @@ import (
 	usertesting "github.com/juju/juju/core/user/testing"
 	usererrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/domain/access/service"
+	"github.com/juju/juju/domain/charmmirror"
+	charmmirrorerrors "github.com/juju/juju/domain/charmmirror/errors"
+	"github.com/juju/juju/domain/charmregistry"
+	charmregistryerrors "github.com/juju/juju/domain/charmregistry/errors"
 	"github.com/juju/juju/domain/logging"
 	domainobjectstore "github.com/juju/juju/domain/objectstore"
 	tracingservice "github.com/juju/juju/domain/tracing/service"
@@ -42,6 +46,8 @@ type workerSuite struct {
 	tracingService     *MockTracingService
 	loggingService     *MockLoggingService
 	objectStoreService *MockControllerObjectStoreService
+	charmRegistry      *MockCharmRegistryService
+	charmMirror        *MockCharmMirrorService
 
 	controllerModelID permission.ID
 	metricsUserName   coreuser.Name
@@ -84,6 +90,22 @@ func (s *workerSuite) TestConfigValidateNilObjectStoreService(c *tc.C) {
 	c.Check(cfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
 }
 
+func (s *workerSuite) TestConfigValidateNilCharmRegistryService(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newValidConfig(c)
+	cfg.CharmRegistryService = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *workerSuite) TestConfigValidateNilCharmMirrorService(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newValidConfig(c)
+	cfg.CharmMirrorService = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *workerSuite) TestConfigValidateEmptyControllerModelUUID(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -965,6 +987,32 @@ func (s *workerSuite) TestWorkloadTracingConfigSuccessWithOpenTelemetryOptions(c
 	})
 }
 
+func (s *workerSuite) TestWorkloadTracingConfigSuccessWithExportOptions(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	exportMetrics := true
+	exportLogs := false
+
+	s.tracingService.EXPECT().SetWorkloadTracingConfig(gomock.Any(), tracingservice.WorkloadTracingConfig{
+		GRPCEndpoint:  "localhost:4317",
+		ExportMetrics: &exportMetrics,
+		ExportLogs:    &exportLogs,
+	}).Return(nil)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/workload-tracing-config",
+		body:       `{"grpc_endpoint":"localhost:4317","export_metrics":true,"export_logs":false}`,
+		statusCode: http.StatusOK,
+		response:   `.*updated workload tracing config.*`,
+	})
+}
+
 func (s *workerSuite) TestWorkloadTracingConfigInvalid(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1332,18 +1380,264 @@ func (s *workerSuite) TestRemoveLokiEndpointError(c *tc.C) {
 	})
 }
 
+func (s *workerSuite) TestSetCharmRegistry(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmRegistry.EXPECT().SetCredential(gomock.Any(), charmregistry.Credential{
+		Registry: "registry.example.com",
+		Username: "user",
+		Password: "pass",
+	}).Return(nil)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/charm-registries",
+		body:       `{"registry":"registry.example.com","username":"user","password":"pass"}`,
+		statusCode: http.StatusOK,
+		response:   `.*updated credential for registry \\"registry.example.com\\".*`,
+	})
+}
+
+func (s *workerSuite) TestSetCharmRegistryInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmRegistry.EXPECT().SetCredential(gomock.Any(), charmregistry.Credential{
+		Registry: "registry.example.com",
+	}).Return(internalerrors.New("empty username").Add(coreerrors.NotValid))
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/charm-registries",
+		body:       `{"registry":"registry.example.com"}`,
+		statusCode: http.StatusBadRequest,
+		response:   ".*invalid charm registry credential.*",
+	})
+}
+
+func (s *workerSuite) TestRemoveCharmRegistry(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmRegistry.EXPECT().RemoveCredential(gomock.Any(), "registry.example.com:5000").Return(nil)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodDelete,
+		endpoint:   "/charm-registries/registry.example.com:5000",
+		statusCode: http.StatusOK,
+		response:   ".*removed credential for registry.*",
+	})
+}
+
+func (s *workerSuite) TestRemoveCharmRegistryNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmRegistry.EXPECT().RemoveCredential(gomock.Any(), "registry.example.com").Return(
+		charmregistryerrors.CredentialNotFound,
+	)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodDelete,
+		endpoint:   "/charm-registries/registry.example.com",
+		statusCode: http.StatusNotFound,
+		response:   ".*charm registry credential not found.*",
+	})
+}
+
+func (s *workerSuite) TestImportCharmMirrorSeed(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().ImportSeed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, r io.Reader) ([]charmmirror.Entity, error) {
+			data, err := io.ReadAll(r)
+			c.Check(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, "seed")
+			return []charmmirror.Entity{
+				{Name: "postgresql", Revision: 1},
+				{Name: "mysql", Revision: 3},
+			}, nil
+		})
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:      http.MethodPost,
+		endpoint:    "/charm-mirror/seed",
+		body:        "seed",
+		contentType: "application/gzip",
+		statusCode:  http.StatusOK,
+		response:    ".*imported postgresql revision 1, mysql revision 3.*",
+	})
+}
+
+func (s *workerSuite) TestImportCharmMirrorSeedInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().ImportSeed(gomock.Any(), gomock.Any()).Return(
+		nil, internalerrors.New("not gzip").Add(coreerrors.NotValid),
+	)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:      http.MethodPost,
+		endpoint:    "/charm-mirror/seed",
+		body:        "seed",
+		contentType: "application/gzip",
+		statusCode:  http.StatusBadRequest,
+		response:    ".*invalid charm mirror seed.*",
+	})
+}
+
+func (s *workerSuite) TestImportCharmMirrorSeedConflict(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().ImportSeed(gomock.Any(), gomock.Any()).Return(
+		nil, charmmirrorerrors.RevisionConflict,
+	)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:      http.MethodPost,
+		endpoint:    "/charm-mirror/seed",
+		body:        "seed",
+		contentType: "application/gzip",
+		statusCode:  http.StatusConflict,
+		response:    ".*importing charm mirror seed.*",
+	})
+}
+
+func (s *workerSuite) TestSetCharmMirrorClient(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().SetClient(gomock.Any(), "downstream", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, password auth.Password) error {
+			salt := []byte("salt")
+			expected, err := auth.HashPassword(auth.NewPassword("pass"), salt)
+			c.Assert(err, tc.ErrorIsNil)
+			got, err := auth.HashPassword(password, salt)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(got, tc.Equals, expected)
+			return nil
+		})
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/charm-mirror/clients",
+		body:       `{"username":"downstream","password":"pass"}`,
+		statusCode: http.StatusOK,
+		response:   `.*updated charm mirror client \\"downstream\\".*`,
+	})
+}
+
+func (s *workerSuite) TestSetCharmMirrorClientInvalid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().SetClient(gomock.Any(), "", gomock.Any()).Return(
+		internalerrors.New("empty charm mirror client name").Add(coreerrors.NotValid),
+	)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodPost,
+		endpoint:   "/charm-mirror/clients",
+		body:       `{"password":"pass"}`,
+		statusCode: http.StatusBadRequest,
+		response:   ".*invalid charm mirror client.*",
+	})
+}
+
+func (s *workerSuite) TestRemoveCharmMirrorClient(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().RemoveClient(gomock.Any(), "downstream").Return(nil)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodDelete,
+		endpoint:   "/charm-mirror/clients/downstream",
+		statusCode: http.StatusOK,
+		response:   ".*removed charm mirror client.*",
+	})
+}
+
+func (s *workerSuite) TestRemoveCharmMirrorClientNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmMirror.EXPECT().RemoveClient(gomock.Any(), "downstream").Return(
+		charmmirrorerrors.ClientNotFound,
+	)
+
+	socket := s.newSocket(c)
+
+	w := s.newWorker(c, socket)
+	defer workertest.CleanKill(c, w)
+
+	s.runHandlerTest(c, socket, handlerTest{
+		method:     http.MethodDelete,
+		endpoint:   "/charm-mirror/clients/downstream",
+		statusCode: http.StatusNotFound,
+		response:   ".*charm mirror client not found.*",
+	})
+}
+
 func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.accessService = NewMockAccessService(ctrl)
 	s.tracingService = NewMockTracingService(ctrl)
 	s.loggingService = NewMockLoggingService(ctrl)
 	s.objectStoreService = NewMockControllerObjectStoreService(ctrl)
+	s.charmRegistry = NewMockCharmRegistryService(ctrl)
+	s.charmMirror = NewMockCharmMirrorService(ctrl)
 
 	c.Cleanup(func() {
 		s.accessService = nil
 		s.tracingService = nil
 		s.loggingService = nil
 		s.objectStoreService = nil
+		s.charmRegistry = nil
+		s.charmMirror = nil
 	})
 
 	return ctrl
@@ -1365,15 +1659,17 @@ type handlerTest struct {
 
 func (s *workerSuite) newValidConfig(c *tc.C) Config {
 	return Config{
-		AccessService:       s.accessService,
-		TracingService:      s.tracingService,
-		LoggingService:      s.loggingService,
-		ObjectStoreService:  s.objectStoreService,
-		Logger:              loggertesting.WrapCheckLog(c),
-		MetricsCollector:    NewMetricsCollector(),
-		SocketName:          "/tmp/test.socket",
-		NewSocketListener:   NewSocketListener,
-		ControllerModelUUID: model.UUID(jujujujutesting.ModelTag.Id()),
+		AccessService:        s.accessService,
+		TracingService:       s.tracingService,
+		LoggingService:       s.loggingService,
+		ObjectStoreService:   s.objectStoreService,
+		CharmRegistryService: s.charmRegistry,
+		CharmMirrorService:   s.charmMirror,
+		Logger:               loggertesting.WrapCheckLog(c),
+		MetricsCollector:     NewMetricsCollector(),
+		SocketName:           "/tmp/test.socket",
+		NewSocketListener:    NewSocketListener,
+		ControllerModelUUID:  model.UUID(jujujujutesting.ModelTag.Id()),
 	}
 }
 
@@ -1386,15 +1682,17 @@ func (s *workerSuite) newSocket(c *tc.C) string {
 
 func (s *workerSuite) newWorker(c *tc.C, socket string) *Worker {
 	w, err := NewWorker(Config{
-		AccessService:       s.accessService,
-		TracingService:      s.tracingService,
-		LoggingService:      s.loggingService,
-		ObjectStoreService:  s.objectStoreService,
-		Logger:              loggertesting.WrapCheckLog(c),
-		MetricsCollector:    NewMetricsCollector(),
-		SocketName:          socket,
-		NewSocketListener:   NewSocketListener,
-		ControllerModelUUID: model.UUID(jujujujutesting.ModelTag.Id()),
+		AccessService:        s.accessService,
+		TracingService:       s.tracingService,
+		LoggingService:       s.loggingService,
+		ObjectStoreService:   s.objectStoreService,
+		CharmRegistryService: s.charmRegistry,
+		CharmMirrorService:   s.charmMirror,
+		Logger:               loggertesting.WrapCheckLog(c),
+		MetricsCollector:     NewMetricsCollector(),
+		SocketName:           socket,
+		NewSocketListener:    NewSocketListener,
+		ControllerModelUUID:  model.UUID(jujujujutesting.ModelTag.Id()),
 	})
 	c.Assert(err, tc.ErrorIsNil)
 
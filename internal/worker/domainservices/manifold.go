@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	secretstate "github.com/juju/juju/domain/secret/state"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
@@ -266,6 +267,7 @@ func NewControllerDomainServices(
 ) services.ControllerDomainServices {
 	return domainservices.NewControllerServices(
 		changestream.NewWatchableDBFactoryForNamespace(dbGetter.GetWatchableDB, coredatabase.ControllerNS),
+		secretstate.NewControllerModelDBFactory(dbGetter),
 		controllerObjectStoreGetter,
 		clock,
 		logger,
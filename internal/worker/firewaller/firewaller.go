@@ -53,17 +53,18 @@ type newCrossModelFacadeFunc func(context.Context, *api.Info) (CrossModelFirewal
 
 // Config defines the operation of a Worker.
 type Config struct {
-	ModelUUID                 string
-	Mode                      string
-	FirewallerAPI             FirewallerAPI
-	CrossModelRelationService CrossModelRelationService
-	PortsService              PortService
-	ApplicationService        ApplicationService
-	RelationService           RelationService
-	EnvironFirewaller         EnvironFirewaller
-	EnvironModelFirewaller    EnvironModelFirewaller
-	EnvironInstances          EnvironInstances
-	EnvironIPV6CIDRSupport    bool
+	ModelUUID                    string
+	Mode                         string
+	FirewallerAPI                FirewallerAPI
+	CrossModelRelationService    CrossModelRelationService
+	PortsService                 PortService
+	ApplicationService           ApplicationService
+	RelationService              RelationService
+	EnvironFirewaller            EnvironFirewaller
+	EnvironModelFirewaller       EnvironModelFirewaller
+	EnvironModelEgressFirewaller EnvironModelEgressFirewaller
+	EnvironInstances             EnvironInstances
+	EnvironIPV6CIDRSupport       bool
 
 	NewCrossModelFacadeFunc newCrossModelFacadeFunc
 
@@ -126,15 +127,16 @@ func (cfg Config) Validate() error {
 // machines and reflects those changes onto the backing environment.
 // Uses Firewaller API V1.
 type Firewaller struct {
-	catacomb                  catacomb.Catacomb
-	firewallerAPI             FirewallerAPI
-	crossModelRelationService CrossModelRelationService
-	portService               PortService
-	applicationService        ApplicationService
-	relationService           RelationService
-	environFirewaller         EnvironFirewaller
-	environModelFirewaller    EnvironModelFirewaller
-	environInstances          EnvironInstances
+	catacomb                     catacomb.Catacomb
+	firewallerAPI                FirewallerAPI
+	crossModelRelationService    CrossModelRelationService
+	portService                  PortService
+	applicationService           ApplicationService
+	relationService              RelationService
+	environFirewaller            EnvironFirewaller
+	environModelFirewaller       EnvironModelFirewaller
+	environModelEgressFirewaller EnvironModelEgressFirewaller
+	environInstances             EnvironInstances
 
 	machinesWatcher      watcher.StringsWatcher
 	portsWatcher         watcher.StringsWatcher
@@ -201,31 +203,32 @@ func NewFirewaller(cfg Config) (worker.Worker, error) {
 	}
 
 	fw := &Firewaller{
-		firewallerAPI:              cfg.FirewallerAPI,
-		crossModelRelationService:  cfg.CrossModelRelationService,
-		portService:                cfg.PortsService,
-		applicationService:         cfg.ApplicationService,
-		relationService:            cfg.RelationService,
-		environFirewaller:          cfg.EnvironFirewaller,
-		environModelFirewaller:     cfg.EnvironModelFirewaller,
-		environInstances:           cfg.EnvironInstances,
-		envIPV6CIDRSupport:         cfg.EnvironIPV6CIDRSupport,
-		newRemoteFirewallerAPIFunc: cfg.NewCrossModelFacadeFunc,
-		modelUUID:                  cfg.ModelUUID,
-		machineds:                  make(map[machine.Name]*machineData),
-		unitsChange:                make(chan *unitsChange),
-		unitds:                     make(map[coreunit.Name]*unitData),
-		applicationids:             make(map[names.ApplicationTag]*applicationData),
-		exposedChange:              make(chan *exposedChange),
-		relationIngress:            make(map[relation.UUID]*remoteRelationData),
-		localRelationsChange:       make(chan *remoteRelationNetworkChange),
-		clk:                        clk,
-		logger:                     cfg.Logger,
-		relationWorkerRunner:       runner,
-		watchMachineNotify:         cfg.WatchMachineNotify,
-		flushModelNotify:           cfg.FlushModelNotify,
-		skipFlushModelNotify:       cfg.SkipFlushModelNotify,
-		flushMachineNotify:         cfg.FlushMachineNotify,
+		firewallerAPI:                cfg.FirewallerAPI,
+		crossModelRelationService:    cfg.CrossModelRelationService,
+		portService:                  cfg.PortsService,
+		applicationService:           cfg.ApplicationService,
+		relationService:              cfg.RelationService,
+		environFirewaller:            cfg.EnvironFirewaller,
+		environModelFirewaller:       cfg.EnvironModelFirewaller,
+		environModelEgressFirewaller: cfg.EnvironModelEgressFirewaller,
+		environInstances:             cfg.EnvironInstances,
+		envIPV6CIDRSupport:           cfg.EnvironIPV6CIDRSupport,
+		newRemoteFirewallerAPIFunc:   cfg.NewCrossModelFacadeFunc,
+		modelUUID:                    cfg.ModelUUID,
+		machineds:                    make(map[machine.Name]*machineData),
+		unitsChange:                  make(chan *unitsChange),
+		unitds:                       make(map[coreunit.Name]*unitData),
+		applicationids:               make(map[names.ApplicationTag]*applicationData),
+		exposedChange:                make(chan *exposedChange),
+		relationIngress:              make(map[relation.UUID]*remoteRelationData),
+		localRelationsChange:         make(chan *remoteRelationNetworkChange),
+		clk:                          clk,
+		logger:                       cfg.Logger,
+		relationWorkerRunner:         runner,
+		watchMachineNotify:           cfg.WatchMachineNotify,
+		flushModelNotify:             cfg.FlushModelNotify,
+		skipFlushModelNotify:         cfg.SkipFlushModelNotify,
+		flushMachineNotify:           cfg.FlushMachineNotify,
 	}
 
 	switch cfg.Mode {
@@ -1205,12 +1208,53 @@ func (fw *Firewaller) flushModel(ctx context.Context) error {
 			return errors.Annotatef(err, "closing port ranges %v on model firewall", toOpen)
 		}
 	}
+	if err := fw.flushModelEgress(ctx); err != nil {
+		return errors.Trace(err)
+	}
 	if fw.flushModelNotify != nil {
 		fw.flushModelNotify()
 	}
 	return nil
 }
 
+// flushModelEgress opens and closes egress rules on the model firewall,
+// so that they match the egress rules of the model.
+func (fw *Firewaller) flushModelEgress(ctx context.Context) error {
+	want, err := fw.firewallerAPI.ModelEgressFirewallRules(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if fw.environModelEgressFirewaller == nil {
+		if len(want) > 0 {
+			fw.logger.Warningf(ctx, "ignoring egress rules %v, the provider does not support egress rules on the model firewall", want)
+		}
+		return nil
+	}
+
+	if !fw.envIPV6CIDRSupport {
+		want = want.RemoveCIDRsMatchingAddressType(network.IPv6Address)
+	}
+	curr, err := fw.environModelEgressFirewaller.ModelEgressRules(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	toOpen, toClose := curr.Diff(want)
+	if len(toOpen) > 0 {
+		fw.logger.Infof(ctx, "opening egress port ranges %v on model firewall", toOpen)
+		if err := fw.environModelEgressFirewaller.OpenModelEgressPorts(ctx, toOpen); err != nil {
+			return errors.Annotatef(err, "opening egress port ranges %v on model firewall", toOpen)
+		}
+	}
+	if len(toClose) > 0 {
+		fw.logger.Infof(ctx, "closing egress port ranges %v on model firewall", toClose)
+		if err := fw.environModelEgressFirewaller.CloseModelEgressPorts(ctx, toClose); err != nil {
+			return errors.Annotatef(err, "closing egress port ranges %v on model firewall", toClose)
+		}
+	}
+	return nil
+}
+
 // flushInstancePorts opens and closes ports global on the machine.
 func (fw *Firewaller) flushInstancePorts(ctx context.Context, machined *machineData, toOpen, toClose firewall.IngressRules) (err error) {
 	defer func() {
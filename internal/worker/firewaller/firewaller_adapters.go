@@ -51,6 +51,13 @@ type ControllerConfigDomainService interface {
 	ControllerConfig(ctx context.Context) (controller.Config, error)
 }
 
+// ControllerNodeDomainService provides access to the API addresses of the
+// controller.
+type ControllerNodeDomainService interface {
+	GetAPIHostPortsForAgents(ctx context.Context) ([]network.HostPorts, error)
+	WatchControllerAPIAddresses(ctx context.Context) (watcher.NotifyWatcher, error)
+}
+
 // NetworkDomainService provides access to network domain operations.
 type NetworkDomainService interface {
 	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
@@ -85,6 +92,7 @@ type firewallerAPIAdapter struct {
 	machineSvc       MachineDomainService
 	modelConfigSvc   ModelConfigDomainService
 	ctrlConfigSvc    ControllerConfigDomainService
+	ctrlNodeSvc      ControllerNodeDomainService
 	networkSvc       NetworkDomainService
 	relationSvc      RelationDomainService
 	extControllerSvc ExternalControllerDomainService
@@ -99,7 +107,7 @@ func (a *firewallerAPIAdapter) WatchModelMachines(ctx context.Context) (watcher.
 
 // WatchModelFirewallRules implements FirewallerAPI.
 func (a *firewallerAPIAdapter) WatchModelFirewallRules(ctx context.Context) (watcher.NotifyWatcher, error) {
-	return newModelFirewallRulesWatcher(a.modelConfigSvc)
+	return newModelFirewallRulesWatcher(a.modelConfigSvc, a.ctrlNodeSvc)
 }
 
 // ModelFirewallRules implements FirewallerAPI.
@@ -137,9 +145,63 @@ func (a *firewallerAPIAdapter) ModelFirewallRules(ctx context.Context) (firewall
 			"0.0.0.0/0", "::/0",
 		))
 	}
+	rules = append(rules, cfg.FirewallIngressRules()...)
 	return rules, nil
 }
 
+// ModelEgressFirewallRules implements FirewallerAPI. Once any egress rule is
+// set, rules allowing the machines to reach the API addresses of the
+// controller are added, so that the agents can still connect to it.
+func (a *firewallerAPIAdapter) ModelEgressFirewallRules(ctx context.Context) (firewall.EgressRules, error) {
+	cfg, err := a.modelConfigSvc.ModelConfig(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rules := cfg.FirewallEgressRules()
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	apiHostPorts, err := a.ctrlNodeSvc.GetAPIHostPortsForAgents(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting controller API addresses")
+	}
+	return append(rules, controllerAPIEgressRules(apiHostPorts)...), nil
+}
+
+// controllerAPIEgressRules returns the egress rules allowing traffic to the
+// given controller API addresses, one rule per port. Addresses which are
+// not IP addresses can't be used in firewall rules and are skipped.
+func controllerAPIEgressRules(apiHostPorts []network.HostPorts) firewall.EgressRules {
+	cidrsByPort := make(map[int]set.Strings)
+	for _, hps := range apiHostPorts {
+		for _, hp := range hps {
+			var cidr string
+			switch hp.AddressType() {
+			case network.IPv4Address:
+				cidr = hp.Host() + "/32"
+			case network.IPv6Address:
+				cidr = hp.Host() + "/128"
+			default:
+				continue
+			}
+			if cidrsByPort[hp.Port()] == nil {
+				cidrsByPort[hp.Port()] = set.NewStrings()
+			}
+			cidrsByPort[hp.Port()].Add(cidr)
+		}
+	}
+
+	rules := make(firewall.EgressRules, 0, len(cidrsByPort))
+	for port, cidrs := range cidrsByPort {
+		rules = append(rules, firewall.NewEgressRule(
+			network.MustParsePortRange(strconv.Itoa(port)+"/tcp"),
+			cidrs.SortedValues()...,
+		))
+	}
+	rules.Sort()
+	return rules
+}
+
 // ModelConfig implements FirewallerAPI.
 func (a *firewallerAPIAdapter) ModelConfig(ctx context.Context) (*config.Config, error) {
 	return a.modelConfigSvc.ModelConfig(ctx)
@@ -333,19 +395,39 @@ func setEquals(a, b set.Strings) bool {
 	return a.Intersection(b).Size() == a.Size()
 }
 
+// modelFirewallRules holds the model config values the model firewall
+// rules are derived from.
+type modelFirewallRules struct {
+	sshAllow     set.Strings
+	ingressRules string
+	egressRules  string
+}
+
+func (r modelFirewallRules) equals(other modelFirewallRules) bool {
+	return setEquals(r.sshAllow, other.sshAllow) &&
+		r.ingressRules == other.ingressRules &&
+		r.egressRules == other.egressRules
+}
+
 // modelFirewallRulesWatcher watches for changes to model firewall rules
-// (currently only ssh-allow config changes). This mirrors the server-side
-// implementation in apiserver/facades/controller/firewaller/modelfirewallruleswatcher.go.
+// (ssh-allow, firewall-ingress-rules and firewall-egress-rules config
+// changes, and changes to the controller API addresses the egress rules
+// allow). This mirrors the server-side implementation in
+// apiserver/facades/controller/firewaller/modelfirewallruleswatcher.go.
 type modelFirewallRulesWatcher struct {
 	catacomb       catacomb.Catacomb
 	modelConfigSvc ModelConfigDomainService
+	ctrlNodeSvc    ControllerNodeDomainService
 	out            chan struct{}
-	sshAllowCache  set.Strings
+	rulesCache     modelFirewallRules
 }
 
-func newModelFirewallRulesWatcher(modelConfigSvc ModelConfigDomainService) (watcher.NotifyWatcher, error) {
+func newModelFirewallRulesWatcher(
+	modelConfigSvc ModelConfigDomainService, ctrlNodeSvc ControllerNodeDomainService,
+) (watcher.NotifyWatcher, error) {
 	w := &modelFirewallRulesWatcher{
 		modelConfigSvc: modelConfigSvc,
+		ctrlNodeSvc:    ctrlNodeSvc,
 		out:            make(chan struct{}),
 	}
 	err := catacomb.Invoke(catacomb.Plan{
@@ -369,6 +451,13 @@ func (w *modelFirewallRulesWatcher) loop() error {
 	if err := w.catacomb.Add(configWatcher); err != nil {
 		return errors.Trace(err)
 	}
+	apiAddressWatcher, err := w.ctrlNodeSvc.WatchControllerAPIAddresses(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(apiAddressWatcher); err != nil {
+		return errors.Trace(err)
+	}
 
 	var out chan struct{}
 	for {
@@ -381,13 +470,22 @@ func (w *modelFirewallRulesWatcher) loop() error {
 			if !ok {
 				return w.catacomb.ErrDying()
 			}
-			sshAllow, err := w.getSSHAllow(ctx)
+			rules, err := w.getRules(ctx)
 			if err != nil {
 				return errors.Trace(err)
 			}
-			if !setEquals(sshAllow, w.sshAllowCache) {
+			if !rules.equals(w.rulesCache) {
+				out = w.out
+				w.rulesCache = rules
+			}
+		case _, ok := <-apiAddressWatcher.Changes():
+			if !ok {
+				return w.catacomb.ErrDying()
+			}
+			// The egress rules allow the controller API addresses, so
+			// they need to be flushed again if egress rules are set.
+			if w.rulesCache.egressRules != "" {
 				out = w.out
-				w.sshAllowCache = sshAllow
 			}
 		}
 	}
@@ -398,12 +496,19 @@ func (w *modelFirewallRulesWatcher) scopedContext() (context.Context, context.Ca
 	return w.catacomb.Context(ctx), cancel
 }
 
-func (w *modelFirewallRulesWatcher) getSSHAllow(ctx context.Context) (set.Strings, error) {
+func (w *modelFirewallRulesWatcher) getRules(ctx context.Context) (modelFirewallRules, error) {
 	cfg, err := w.modelConfigSvc.ModelConfig(ctx)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return modelFirewallRules{}, errors.Trace(err)
 	}
-	return set.NewStrings(cfg.SSHAllow()...), nil
+	attrs := cfg.AllAttrs()
+	ingressRules, _ := attrs[config.FirewallIngressRulesKey].(string)
+	egressRules, _ := attrs[config.FirewallEgressRulesKey].(string)
+	return modelFirewallRules{
+		sshAllow:     set.NewStrings(cfg.SSHAllow()...),
+		ingressRules: ingressRules,
+		egressRules:  egressRules,
+	}, nil
 }
 
 func (w *modelFirewallRulesWatcher) Changes() <-chan struct{} {
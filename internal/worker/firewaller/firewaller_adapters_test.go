@@ -5,7 +5,7 @@ package firewaller
 
 import (
 	"context"
-	"testing"
+	stdtesting "testing"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/names/v6"
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/core/life"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
 	corerelation "github.com/juju/juju/core/relation"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
@@ -25,12 +26,13 @@ import (
 	domainrelation "github.com/juju/juju/domain/relation"
 	relationerrors "github.com/juju/juju/domain/relation/errors"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
 type FirewallerAdaptersSuite struct{}
 
-func TestFirewallerAdaptersSuite(t *testing.T) {
+func TestFirewallerAdaptersSuite(t *stdtesting.T) {
 	tc.Run(t, &FirewallerAdaptersSuite{})
 }
 
@@ -61,6 +63,38 @@ func (s *FirewallerAdaptersSuite) TestRelationPreservesNotFoundCode(c *tc.C) {
 	c.Assert(params.IsCodeNotFound(err), tc.IsTrue)
 }
 
+func (s *FirewallerAdaptersSuite) TestModelEgressFirewallRulesAllowControllerAPI(c *tc.C) {
+	cfg := testing.CustomModelConfig(c, testing.Attrs{
+		config.FirewallEgressRulesKey: "443/tcp to 10.0.0.0/8",
+	})
+	adapter := &firewallerAPIAdapter{
+		modelConfigSvc: fakeModelConfigDomainService{cfg: cfg},
+		ctrlNodeSvc: fakeControllerNodeDomainService{apiHostPorts: []network.HostPorts{
+			network.NewMachineHostPorts(17070, "10.0.0.1", "2001:db8::1", "controller.example.com").HostPorts(),
+			network.NewMachineHostPorts(17070, "10.0.0.2").HostPorts(),
+		}},
+	}
+
+	rules, err := adapter.ModelEgressFirewallRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.DeepEquals, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		firewall.NewEgressRule(network.MustParsePortRange("17070/tcp"), "10.0.0.1/32", "10.0.0.2/32", "2001:db8::1/128"),
+	})
+}
+
+func (s *FirewallerAdaptersSuite) TestModelEgressFirewallRulesNoneSet(c *tc.C) {
+	// Without egress rules all outgoing traffic is allowed, so no rules
+	// for the controller are needed.
+	adapter := &firewallerAPIAdapter{
+		modelConfigSvc: fakeModelConfigDomainService{cfg: testing.ModelConfig(c)},
+	}
+
+	rules, err := adapter.ModelEgressFirewallRules(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(rules, tc.HasLen, 0)
+}
+
 type fakeMachineDomainService struct {
 	getMachineUUIDErr error
 	getMachineLifeErr error
@@ -111,10 +145,12 @@ func (f fakeRelationDomainService) SetRelationErrorStatus(context.Context, corer
 	return nil
 }
 
-type fakeModelConfigDomainService struct{}
+type fakeModelConfigDomainService struct {
+	cfg *config.Config
+}
 
-func (fakeModelConfigDomainService) ModelConfig(context.Context) (*config.Config, error) {
-	return nil, nil
+func (f fakeModelConfigDomainService) ModelConfig(context.Context) (*config.Config, error) {
+	return f.cfg, nil
 }
 
 func (fakeModelConfigDomainService) Watch(context.Context) (watcher.StringsWatcher, error) {
@@ -127,6 +163,18 @@ func (fakeControllerConfigDomainService) ControllerConfig(context.Context) (cont
 	return controller.Config{}, nil
 }
 
+type fakeControllerNodeDomainService struct {
+	apiHostPorts []network.HostPorts
+}
+
+func (f fakeControllerNodeDomainService) GetAPIHostPortsForAgents(context.Context) ([]network.HostPorts, error) {
+	return f.apiHostPorts, nil
+}
+
+func (fakeControllerNodeDomainService) WatchControllerAPIAddresses(context.Context) (watcher.NotifyWatcher, error) {
+	return nil, nil
+}
+
 type fakeNetworkDomainService struct{}
 
 func (fakeNetworkDomainService) GetAllSpaces(context.Context) (network.SpaceInfos, error) {
@@ -154,6 +202,7 @@ var _ ApplicationDomainService = fakeApplicationDomainService{}
 var _ RelationDomainService = fakeRelationDomainService{}
 var _ ModelConfigDomainService = fakeModelConfigDomainService{}
 var _ ControllerConfigDomainService = fakeControllerConfigDomainService{}
+var _ ControllerNodeDomainService = fakeControllerNodeDomainService{}
 var _ NetworkDomainService = fakeNetworkDomainService{}
 var _ ExternalControllerDomainService = fakeExternalControllerDomainService{}
 var _ ModelInfoDomainService = fakeModelInfoDomainService{}
@@ -64,6 +64,7 @@ type firewallerBaseSuite struct {
 	crossmodelFirewaller      *mocks.MockCrossModelFirewallerFacadeCloser
 	envFirewaller             *mocks.MockEnvironFirewaller
 	envModelFirewaller        *mocks.MockEnvironModelFirewaller
+	envModelEgressFirewaller  *mocks.MockEnvironModelEgressFirewaller
 	envInstances              *mocks.MockEnvironInstances
 
 	machinesCh     chan []string
@@ -85,8 +86,10 @@ type firewallerBaseSuite struct {
 	withIpv6            bool
 	withModelFirewaller bool
 
-	modelIngressRules firewall.IngressRules
-	envModelPorts     firewall.IngressRules
+	modelIngressRules   firewall.IngressRules
+	modelEgressRules    firewall.EgressRules
+	envModelPorts       firewall.IngressRules
+	envModelEgressPorts firewall.EgressRules
 
 	nextMachineId int
 	nextUnitId    map[string]int
@@ -116,7 +119,10 @@ func (s *firewallerBaseSuite) SetUpTest(c *tc.C) {
 	s.envPorts = firewall.IngressRules{}
 
 	s.modelIngressRules = firewall.IngressRules{}
+	s.modelEgressRules = nil
 	s.envModelPorts = firewall.IngressRules{}
+	s.envModelEgressPorts = nil
+	s.envModelEgressFirewaller = nil
 }
 
 var _ worker.Worker = (*firewaller.Firewaller)(nil)
@@ -164,6 +170,29 @@ func (s *firewallerBaseSuite) ensureMocks(c *tc.C, ctrl *gomock.Controller) {
 			defer s.mu.Unlock()
 			return s.modelIngressRules, nil
 		})
+		s.firewaller.EXPECT().ModelEgressFirewallRules(gomock.Any()).AnyTimes().DoAndReturn(func(context.Context) (firewall.EgressRules, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return s.modelEgressRules, nil
+		})
+		s.envModelEgressFirewaller = mocks.NewMockEnvironModelEgressFirewaller(ctrl)
+		s.envModelEgressFirewaller.EXPECT().ModelEgressRules(gomock.Any()).AnyTimes().DoAndReturn(func(context.Context) (firewall.EgressRules, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			return slices.Clone(s.envModelEgressPorts), nil
+		})
+		s.envModelEgressFirewaller.EXPECT().OpenModelEgressPorts(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(_ context.Context, rules firewall.EgressRules) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.envModelEgressPorts = openEgressPorts(s.envModelEgressPorts, rules)
+			return nil
+		})
+		s.envModelEgressFirewaller.EXPECT().CloseModelEgressPorts(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(_ context.Context, rules firewall.EgressRules) error {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.envModelEgressPorts = closeEgressPorts(s.envModelEgressPorts, rules)
+			return nil
+		})
 
 		s.envModelFirewaller.EXPECT().ModelIngressRules(gomock.Any()).AnyTimes().DoAndReturn(func(arg0 context.Context) (firewall.IngressRules, error) {
 			s.mu.Lock()
@@ -310,6 +339,24 @@ func (s *firewallerBaseSuite) assertModelIngressRules(c *tc.C, expected firewall
 	}
 }
 
+// assertModelEgressRules retrieves the egress rules from the model firewall
+// and compares them to the expected value.
+func (s *firewallerBaseSuite) assertModelEgressRules(c *tc.C, expected firewall.EgressRules) {
+	start := time.Now()
+	for {
+		s.mu.Lock()
+		got := slices.Clone(s.envModelEgressPorts)
+		s.mu.Unlock()
+		if toOpen, toClose := got.Diff(expected); len(toOpen) == 0 && len(toClose) == 0 {
+			return
+		}
+		if time.Since(start) > coretesting.LongWait {
+			c.Fatalf("timed out: expected %q; got %q", expected, got)
+		}
+		time.Sleep(coretesting.ShortWait)
+	}
+}
+
 func (s *firewallerBaseSuite) waitForMachineFlush(c *tc.C) {
 	select {
 	case <-s.machineFlushed:
@@ -519,6 +566,9 @@ func (s *firewallerBaseSuite) newFirewaller(c *tc.C, ctrl *gomock.Controller) wo
 	if s.withModelFirewaller {
 		cfg.EnvironModelFirewaller = s.envModelFirewaller
 	}
+	if s.envModelEgressFirewaller != nil {
+		cfg.EnvironModelEgressFirewaller = s.envModelEgressFirewaller
+	}
 
 	mWatcher := watchertest.NewMockStringsWatcher(s.machinesCh)
 	s.firewaller.EXPECT().WatchModelMachines(gomock.Any()).Return(mWatcher, nil)
@@ -618,6 +668,40 @@ func closePorts(existing, rules firewall.IngressRules) firewall.IngressRules {
 	return existing
 }
 
+func openEgressPorts(existing, rules firewall.EgressRules) firewall.EgressRules {
+	for _, o := range rules {
+		found := false
+		for i, up := range existing {
+			if up.PortRange.String() == o.PortRange.String() {
+				up.DestinationCIDRs = up.DestinationCIDRs.Union(o.DestinationCIDRs)
+				existing[i] = up
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, o)
+		}
+	}
+	return existing
+}
+
+func closeEgressPorts(existing, rules firewall.EgressRules) firewall.EgressRules {
+	for _, cl := range rules {
+		for i, up := range existing {
+			if up.PortRange.String() == cl.PortRange.String() {
+				up.DestinationCIDRs = up.DestinationCIDRs.Difference(cl.DestinationCIDRs)
+				existing[i] = up
+				if len(up.DestinationCIDRs) == 0 {
+					existing = append(existing[:i], existing[i+1:]...)
+				}
+				break
+			}
+		}
+	}
+	return existing
+}
+
 // setupInstanceMocks sets up EXPECT calls for the given machine instance.
 // It must be called before newFirewaller.
 func (s *firewallerBaseSuite) setupInstanceMocks(c *tc.C, ctrl *gomock.Controller, m *mocks.MockMachine) *mocks.MockEnvironInstance {
@@ -766,6 +850,7 @@ func (s *InstanceModeSuite) assertModelFlushRetriesOnParamsNotFound(c *tc.C, mod
 		}
 		return s.modelIngressRules, nil
 	})
+	s.firewaller.EXPECT().ModelEgressFirewallRules(gomock.Any()).AnyTimes().Return(nil, nil)
 	s.envModelFirewaller.EXPECT().ModelIngressRules(gomock.Any()).AnyTimes().DoAndReturn(func(context.Context) (firewall.IngressRules, error) {
 		s.mu.Lock()
 		defer s.mu.Unlock()
@@ -1157,6 +1242,7 @@ func (s *InstanceModeSuite) addMachineUnitAndEnsureMocks(c *tc.C, ctrl *gomock.C
 		defer s.mu.Unlock()
 		return s.modelIngressRules, nil
 	})
+	s.firewaller.EXPECT().ModelEgressFirewallRules(gomock.Any()).AnyTimes().Return(nil, nil)
 	s.envModelFirewaller.EXPECT().ModelIngressRules(gomock.Any()).MinTimes(1).MaxTimes(2).DoAndReturn(func(_ context.Context) (firewall.IngressRules, error) {
 		s.mu.Lock()
 		defer s.mu.Unlock()
@@ -1597,6 +1683,39 @@ func (s *InstanceModeSuite) TestConfigureModelFirewallIpV4Only(c *tc.C) {
 	s.assertModelIngressRules(c, want)
 }
 
+func (s *InstanceModeSuite) TestConfigureModelEgressFirewall(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.withIpv6 = false
+	s.modelEgressRules = firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443"), firewall.AllNetworksIPV4CIDR, firewall.AllNetworksIPV6CIDR),
+		firewall.NewEgressRule(network.MustParsePortRange("5432"), "10.0.0.0/8"),
+	}
+	s.envModelEgressPorts = firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("25"), "10.0.0.0/8"),
+	}
+
+	s.ensureMocks(c, ctrl)
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	s.assertModelEgressRules(c, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("443"), firewall.AllNetworksIPV4CIDR),
+		firewall.NewEgressRule(network.MustParsePortRange("5432"), "10.0.0.0/8"),
+	})
+
+	s.mu.Lock()
+	s.modelEgressRules = s.modelEgressRules[1:]
+	s.mu.Unlock()
+	s.modelFwRulesCh <- struct{}{}
+
+	s.assertModelEgressRules(c, firewall.EgressRules{
+		firewall.NewEgressRule(network.MustParsePortRange("5432"), "10.0.0.0/8"),
+	})
+}
+
 func (s *InstanceModeSuite) setupRemoteRelationRequirerRoleConsumingSide(c *tc.C) (chan []string, *macaroon.Macaroon) {
 	mac, err := jujutesting.NewMacaroon("id")
 	c.Assert(err, tc.ErrorIsNil)
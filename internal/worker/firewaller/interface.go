@@ -34,6 +34,7 @@ type FirewallerAPI interface {
 	WatchModelMachines(context.Context) (watcher.StringsWatcher, error)
 	WatchModelFirewallRules(context.Context) (watcher.NotifyWatcher, error)
 	ModelFirewallRules(context.Context) (firewall.IngressRules, error)
+	ModelEgressFirewallRules(context.Context) (firewall.EgressRules, error)
 	ModelConfig(context.Context) (*config.Config, error)
 	Machine(ctx context.Context, tag names.MachineTag) (Machine, error)
 	Unit(ctx context.Context, tag names.UnitTag) (Unit, error)
@@ -185,6 +186,12 @@ type EnvironModelFirewaller interface {
 	models.ModelFirewaller
 }
 
+// EnvironModelEgressFirewaller defines methods to allow the worker to
+// perform egress firewall operations on a Juju model firewall.
+type EnvironModelEgressFirewaller interface {
+	models.ModelEgressFirewaller
+}
+
 // EnvironInstances defines methods to allow the worker to perform
 // operations on instances in a Juju cloud environment.
 type EnvironInstances interface {
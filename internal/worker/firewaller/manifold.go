@@ -85,6 +85,7 @@ func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (
 	fwEnv, fwEnvOK := environ.(environs.Firewaller)
 
 	modelFw, _ := environ.(models.ModelFirewaller)
+	modelEgressFw, _ := environ.(models.ModelEgressFirewaller)
 
 	mode := environ.Config().FirewallMode()
 	if mode == config.FwNone {
@@ -101,6 +102,7 @@ func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (
 		machineSvc:       domainServices.Machine(),
 		modelConfigSvc:   domainServices.Config(),
 		ctrlConfigSvc:    domainServices.ControllerConfig(),
+		ctrlNodeSvc:      domainServices.ControllerNode(),
 		networkSvc:       domainServices.Network(),
 		relationSvc:      domainServices.Relation(),
 		extControllerSvc: domainServices.ExternalController(),
@@ -118,19 +120,20 @@ func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (
 	}
 
 	w, err := cfg.NewFirewallerWorker(Config{
-		ModelUUID:                 cfg.ModelUUID,
-		CrossModelRelationService: domainServices.CrossModelRelation(),
-		FirewallerAPI:             firewallerAPI,
-		PortsService:              domainServices.Port(),
-		ApplicationService:        domainServices.Application(),
-		RelationService:           domainServices.Relation(),
-		EnvironFirewaller:         fwEnv,
-		EnvironModelFirewaller:    modelFw,
-		EnvironInstances:          environ,
-		EnvironIPV6CIDRSupport:    envIPV6CIDRSupport,
-		Mode:                      mode,
-		NewCrossModelFacadeFunc:   crossmodelFirewallerFacadeFunc(cfg.NewControllerConnection),
-		Logger:                    cfg.Logger,
+		ModelUUID:                    cfg.ModelUUID,
+		CrossModelRelationService:    domainServices.CrossModelRelation(),
+		FirewallerAPI:                firewallerAPI,
+		PortsService:                 domainServices.Port(),
+		ApplicationService:           domainServices.Application(),
+		RelationService:              domainServices.Relation(),
+		EnvironFirewaller:            fwEnv,
+		EnvironModelFirewaller:       modelFw,
+		EnvironModelEgressFirewaller: modelEgressFw,
+		EnvironInstances:             environ,
+		EnvironIPV6CIDRSupport:       envIPV6CIDRSupport,
+		Mode:                         mode,
+		NewCrossModelFacadeFunc:      crossmodelFirewallerFacadeFunc(cfg.NewControllerConnection),
+		Logger:                       cfg.Logger,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/firewaller (interfaces: FirewallerAPI,CrossModelFirewallerFacadeCloser,EnvironFirewaller,EnvironModelFirewaller,EnvironInstances,EnvironInstance)
+// Source: github.com/juju/juju/internal/worker/firewaller (interfaces: FirewallerAPI,CrossModelFirewallerFacadeCloser,EnvironFirewaller,EnvironModelFirewaller,EnvironModelEgressFirewaller,EnvironInstances,EnvironInstance)
 //
 // Generated by this command:
 //
-//	mockgen -package mocks -destination mocks/facade_mocks.go github.com/juju/juju/internal/worker/firewaller FirewallerAPI,CrossModelFirewallerFacadeCloser,EnvironFirewaller,EnvironModelFirewaller,EnvironInstances,EnvironInstance
+//	mockgen -package mocks -destination mocks/facade_mocks.go github.com/juju/juju/internal/worker/firewaller FirewallerAPI,CrossModelFirewallerFacadeCloser,EnvironFirewaller,EnvironModelFirewaller,EnvironModelEgressFirewaller,EnvironInstances,EnvironInstance
 //
 
 // Package mocks is a generated GoMock package.
@@ -40,6 +40,7 @@ type MockFirewallerAPIMockRecorder struct {
 	controllerAPIInfoForModelExpects []*gomock.Call2_2[context.Context, string, *api.Info, error]
 	machineExpects                   []*gomock.Call2_2[context.Context, names.MachineTag, firewaller.Machine, error]
 	modelConfigExpects               []*gomock.Call1_2[context.Context, *config.Config, error]
+	modelEgressFirewallRulesExpects  []*gomock.Call1_2[context.Context, firewall.EgressRules, error]
 	modelFirewallRulesExpects        []*gomock.Call1_2[context.Context, firewall.IngressRules, error]
 	relationExpects                  []*gomock.Call2_2[context.Context, names.RelationTag, *firewaller.Relation, error]
 	setRelationStatusExpects         []*gomock.Call4_1[context.Context, string, relation.Status, string, error]
@@ -133,6 +134,24 @@ func (mr *MockFirewallerAPIMockRecorder) ModelConfig(arg0 any) *MockFirewallerAP
 // MockFirewallerAPIModelConfigCall is the typed call wrapper for ModelConfig.
 type MockFirewallerAPIModelConfigCall = gomock.Call1_2[context.Context, *config.Config, error]
 
+// ModelEgressFirewallRules mocks base method.
+func (m *MockFirewallerAPI) ModelEgressFirewallRules(arg0 context.Context) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.modelEgressFirewallRulesExpects, m.ctrl, m, "ModelEgressFirewallRules", arg0)
+}
+
+// ModelEgressFirewallRules indicates an expected call of ModelEgressFirewallRules.
+func (mr *MockFirewallerAPIMockRecorder) ModelEgressFirewallRules(arg0 any) *MockFirewallerAPIModelEgressFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, firewall.EgressRules, error](mr.mock.ctrl.T, mr.mock, "ModelEgressFirewallRules", gomock.EnsureMatcher(arg0))
+	mr.modelEgressFirewallRulesExpects = append(mr.modelEgressFirewallRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockFirewallerAPIModelEgressFirewallRulesCall is the typed call wrapper for ModelEgressFirewallRules.
+type MockFirewallerAPIModelEgressFirewallRulesCall = gomock.Call1_2[context.Context, firewall.EgressRules, error]
+
 // ModelFirewallRules mocks base method.
 func (m *MockFirewallerAPI) ModelFirewallRules(arg0 context.Context) (firewall.IngressRules, error) {
 	m.ctrl.T.Helper()
@@ -502,6 +521,87 @@ func (mr *MockEnvironModelFirewallerMockRecorder) OpenModelPorts(ctx, rules any)
 // MockEnvironModelFirewallerOpenModelPortsCall is the typed call wrapper for OpenModelPorts.
 type MockEnvironModelFirewallerOpenModelPortsCall = gomock.Call2_1[context.Context, firewall.IngressRules, error]
 
+// MockEnvironModelEgressFirewaller is a mock of EnvironModelEgressFirewaller interface.
+type MockEnvironModelEgressFirewaller struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironModelEgressFirewallerMockRecorder
+	isgomock struct{}
+}
+
+// MockEnvironModelEgressFirewallerMockRecorder is the mock recorder for MockEnvironModelEgressFirewaller.
+type MockEnvironModelEgressFirewallerMockRecorder struct {
+	mock                         *MockEnvironModelEgressFirewaller
+	closeModelEgressPortsExpects []*gomock.Call2_1[context.Context, firewall.EgressRules, error]
+	modelEgressRulesExpects      []*gomock.Call1_2[context.Context, firewall.EgressRules, error]
+	openModelEgressPortsExpects  []*gomock.Call2_1[context.Context, firewall.EgressRules, error]
+}
+
+// NewMockEnvironModelEgressFirewaller creates a new mock instance.
+func NewMockEnvironModelEgressFirewaller(ctrl *gomock.Controller) *MockEnvironModelEgressFirewaller {
+	mock := &MockEnvironModelEgressFirewaller{ctrl: ctrl}
+	mock.recorder = &MockEnvironModelEgressFirewallerMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvironModelEgressFirewaller) EXPECT() *MockEnvironModelEgressFirewallerMockRecorder {
+	return m.recorder
+}
+
+// CloseModelEgressPorts mocks base method.
+func (m *MockEnvironModelEgressFirewaller) CloseModelEgressPorts(ctx context.Context, rules firewall.EgressRules) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.closeModelEgressPortsExpects, m.ctrl, m, "CloseModelEgressPorts", ctx, rules)
+}
+
+// CloseModelEgressPorts indicates an expected call of CloseModelEgressPorts.
+func (mr *MockEnvironModelEgressFirewallerMockRecorder) CloseModelEgressPorts(ctx, rules any) *MockEnvironModelEgressFirewallerCloseModelEgressPortsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, firewall.EgressRules, error](mr.mock.ctrl.T, mr.mock, "CloseModelEgressPorts", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(rules))
+	mr.closeModelEgressPortsExpects = append(mr.closeModelEgressPortsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockEnvironModelEgressFirewallerCloseModelEgressPortsCall is the typed call wrapper for CloseModelEgressPorts.
+type MockEnvironModelEgressFirewallerCloseModelEgressPortsCall = gomock.Call2_1[context.Context, firewall.EgressRules, error]
+
+// ModelEgressRules mocks base method.
+func (m *MockEnvironModelEgressFirewaller) ModelEgressRules(ctx context.Context) (firewall.EgressRules, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.modelEgressRulesExpects, m.ctrl, m, "ModelEgressRules", ctx)
+}
+
+// ModelEgressRules indicates an expected call of ModelEgressRules.
+func (mr *MockEnvironModelEgressFirewallerMockRecorder) ModelEgressRules(ctx any) *MockEnvironModelEgressFirewallerModelEgressRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, firewall.EgressRules, error](mr.mock.ctrl.T, mr.mock, "ModelEgressRules", gomock.EnsureMatcher(ctx))
+	mr.modelEgressRulesExpects = append(mr.modelEgressRulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockEnvironModelEgressFirewallerModelEgressRulesCall is the typed call wrapper for ModelEgressRules.
+type MockEnvironModelEgressFirewallerModelEgressRulesCall = gomock.Call1_2[context.Context, firewall.EgressRules, error]
+
+// OpenModelEgressPorts mocks base method.
+func (m *MockEnvironModelEgressFirewaller) OpenModelEgressPorts(ctx context.Context, rules firewall.EgressRules) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.openModelEgressPortsExpects, m.ctrl, m, "OpenModelEgressPorts", ctx, rules)
+}
+
+// OpenModelEgressPorts indicates an expected call of OpenModelEgressPorts.
+func (mr *MockEnvironModelEgressFirewallerMockRecorder) OpenModelEgressPorts(ctx, rules any) *MockEnvironModelEgressFirewallerOpenModelEgressPortsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, firewall.EgressRules, error](mr.mock.ctrl.T, mr.mock, "OpenModelEgressPorts", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(rules))
+	mr.openModelEgressPortsExpects = append(mr.openModelEgressPortsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockEnvironModelEgressFirewallerOpenModelEgressPortsCall is the typed call wrapper for OpenModelEgressPorts.
+type MockEnvironModelEgressFirewallerOpenModelEgressPortsCall = gomock.Call2_1[context.Context, firewall.EgressRules, error]
+
 // MockEnvironInstances is a mock of EnvironInstances interface.
 type MockEnvironInstances struct {
 	ctrl     *gomock.Controller
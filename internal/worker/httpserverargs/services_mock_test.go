@@ -73,11 +73,12 @@ type MockAccessService struct {
 
 // MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
 type MockAccessServiceMockRecorder struct {
-	mock                                *MockAccessService
-	getUserByAuthExpects                []*gomock.Call3_2[context.Context, user.Name, auth.Password, user.User, error]
-	getUserByNameExpects                []*gomock.Call2_2[context.Context, user.Name, user.User, error]
-	readUserAccessLevelForTargetExpects []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
-	updateLastModelLoginExpects         []*gomock.Call3_1[context.Context, user.Name, model.UUID, error]
+	mock                                     *MockAccessService
+	getUserByAuthExpects                     []*gomock.Call3_2[context.Context, user.Name, auth.Password, user.User, error]
+	getUserByNameExpects                     []*gomock.Call2_2[context.Context, user.Name, user.User, error]
+	readUserAccessLevelForTargetExpects      []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+	readUserGroupAccessLevelForTargetExpects []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+	updateLastModelLoginExpects              []*gomock.Call3_1[context.Context, user.Name, model.UUID, error]
 }
 
 // NewMockAccessService creates a new mock instance.
@@ -146,6 +147,24 @@ func (mr *MockAccessServiceMockRecorder) ReadUserAccessLevelForTarget(ctx, subje
 // MockAccessServiceReadUserAccessLevelForTargetCall is the typed call wrapper for ReadUserAccessLevelForTarget.
 type MockAccessServiceReadUserAccessLevelForTargetCall = gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
 
+// ReadUserGroupAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadUserGroupAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readUserGroupAccessLevelForTargetExpects, m.ctrl, m, "ReadUserGroupAccessLevelForTarget", ctx, subject, target)
+}
+
+// ReadUserGroupAccessLevelForTarget indicates an expected call of ReadUserGroupAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadUserGroupAccessLevelForTarget(ctx, subject, target any) *MockAccessServiceReadUserGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, user.Name, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadUserGroupAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(subject), gomock.EnsureMatcher(target))
+	mr.readUserGroupAccessLevelForTargetExpects = append(mr.readUserGroupAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadUserGroupAccessLevelForTargetCall is the typed call wrapper for ReadUserGroupAccessLevelForTarget.
+type MockAccessServiceReadUserGroupAccessLevelForTargetCall = gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+
 // UpdateLastModelLogin mocks base method.
 func (m *MockAccessService) UpdateLastModelLogin(ctx context.Context, name user.Name, modelUUID model.UUID) error {
 	m.ctrl.T.Helper()
@@ -186,6 +186,15 @@ func (b *managedServices) ReadUserAccessLevelForTarget(
 	return b.accessService.ReadUserAccessLevelForTarget(b.tomb.Context(ctx), subject, target)
 }
 
+// ReadUserGroupAccessLevelForTarget returns the greatest access level any of
+// the groups the user is a member of has on the given target. If none of the
+// groups have access then [accesserrors.AccessNotFound] is returned.
+func (b *managedServices) ReadUserGroupAccessLevelForTarget(
+	ctx context.Context, subject coreuser.Name, target permission.ID,
+) (permission.Access, error) {
+	return b.accessService.ReadUserGroupAccessLevelForTarget(b.tomb.Context(ctx), subject, target)
+}
+
 // UpdateLastModelLogin updates the last login time for the user with the
 // given name.
 func (b *managedServices) UpdateLastModelLogin(ctx context.Context, name coreuser.Name, modelUUID coremodel.UUID) error {
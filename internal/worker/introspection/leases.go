@@ -0,0 +1,147 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package introspection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/core/lease"
+)
+
+// LeaseStore provides read access to the leases held in the controller
+// database.
+type LeaseStore interface {
+	// Leases returns a recent snapshot of lease state.
+	Leases(ctx context.Context, keys ...lease.Key) (map[lease.Key]lease.Info, error)
+
+	// Pinned returns a snapshot of pinned leases and the entities
+	// requiring their pinned behaviour.
+	Pinned(ctx context.Context) (map[lease.Key][]string, error)
+}
+
+// leaseDetail is the serialised form of a single lease in the leases
+// introspection report.
+type leaseDetail struct {
+	Namespace string    `json:"namespace" yaml:"namespace"`
+	ModelUUID string    `json:"model-uuid" yaml:"model-uuid"`
+	Lease     string    `json:"lease" yaml:"lease"`
+	Holder    string    `json:"holder" yaml:"holder"`
+	Expiry    time.Time `json:"expiry" yaml:"expiry"`
+	Pinned    bool      `json:"pinned" yaml:"pinned"`
+	PinnedBy  []string  `json:"pinned-by,omitempty" yaml:"pinned-by,omitempty"`
+}
+
+// leasesReport is the serialised form of the leases introspection report.
+type leasesReport struct {
+	Leases []leaseDetail `json:"leases" yaml:"leases"`
+}
+
+type leasesHandler struct {
+	store LeaseStore
+}
+
+// ServeHTTP is part of the http.Handler interface.
+//
+// The following query parameters are supported:
+//   - model: only report leases for the model with this UUID.
+//   - namespace: only report leases in this namespace, for example
+//     "application-leadership" or "singular-controller".
+//   - format: either "yaml" (the default) or "json".
+func (h leasesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.store == nil {
+		http.Error(w, "missing lease store", http.StatusNotFound)
+		return
+	}
+
+	q := r.URL.Query()
+	format := q.Get("format")
+	switch format {
+	case "", "yaml", "json":
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q", format), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.report(r.Context(), q.Get("model"), q.Get("namespace"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	bytes, err := yaml.Marshal(report)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write(bytes)
+}
+
+func (h leasesHandler) report(ctx context.Context, modelUUID, namespace string) (leasesReport, error) {
+	leases, err := h.store.Leases(ctx)
+	if err != nil {
+		return leasesReport{}, errors.Annotate(err, "retrieving leases")
+	}
+	pinned, err := h.store.Pinned(ctx)
+	if err != nil {
+		return leasesReport{}, errors.Annotate(err, "retrieving pinned leases")
+	}
+
+	matches := func(key lease.Key) bool {
+		if modelUUID != "" && key.ModelUUID != modelUUID {
+			return false
+		}
+		if namespace != "" && key.Namespace != namespace {
+			return false
+		}
+		return true
+	}
+
+	details := make([]leaseDetail, 0, len(leases))
+	for key, info := range leases {
+		if !matches(key) {
+			continue
+		}
+		entities := pinned[key]
+		sort.Strings(entities)
+		details = append(details, leaseDetail{
+			Namespace: key.Namespace,
+			ModelUUID: key.ModelUUID,
+			Lease:     key.Lease,
+			Holder:    info.Holder,
+			Expiry:    info.Expiry.UTC(),
+			Pinned:    len(entities) > 0,
+			PinnedBy:  entities,
+		})
+	}
+	sort.Slice(details, func(i, j int) bool {
+		a, b := details[i], details[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ModelUUID != b.ModelUUID {
+			return a.ModelUUID < b.ModelUUID
+		}
+		return a.Lease < b.Lease
+	})
+
+	return leasesReport{Leases: details}, nil
+}
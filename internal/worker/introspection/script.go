@@ -156,6 +156,14 @@ juju_machine_lock () {
   done
 }
 
+juju_leases () {
+  local query=""
+  for arg in "$@"; do
+    query="${query}&${arg}"
+  done
+  juju_agent "leases?${query#&}"
+}
+
 juju_unit_status () {
   juju_agent units?action=status
 }
@@ -251,6 +259,7 @@ if [ "$shell" = "bash" ]; then
   export -f juju_engine_report
   export -f juju_metrics
   export -f juju_machine_lock
+  export -f juju_leases
   export -f juju_unit_status
   export -f juju_db_repl
   export -f juju_api_connection_sources
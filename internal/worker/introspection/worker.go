@@ -48,6 +48,7 @@ type Config struct {
 	MachineLock        machinelock.Lock
 	PrometheusGatherer prometheus.Gatherer
 	FlightRecorder     flightrecorder.FlightRecorder
+	LeaseStore         LeaseStore
 }
 
 // Validate checks the config values to assert they are valid to create the worker.
@@ -74,6 +75,7 @@ type socketListener struct {
 
 	prometheusGatherer prometheus.Gatherer
 	flightRecorder     flightrecorder.FlightRecorder
+	leaseStore         LeaseStore
 
 	done chan struct{}
 }
@@ -104,6 +106,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 		machineLock:        config.MachineLock,
 		prometheusGatherer: config.PrometheusGatherer,
 		flightRecorder:     config.FlightRecorder,
+		leaseStore:         config.LeaseStore,
 		done:               make(chan struct{}),
 	}
 	w.tomb.Go(w.serve)
@@ -175,8 +178,7 @@ func (w *socketListener) RegisterHTTPHandlers(
 	// the introspection endpoint directly.
 	handle("/metrics/", promhttp.HandlerFor(w.prometheusGatherer, promhttp.HandlerOpts{}))
 
-	// TODO(leases) - add metrics
-	handle("/leases", notSupportedHandler{name: "Leases"})
+	handle("/leases", leasesHandler{store: w.leaseStore})
 
 	// Flight recorder.
 	handle("/flightrecorder/start", introspectionflightrecorder.StartHandler(w.flightRecorder))
@@ -184,15 +186,6 @@ func (w *socketListener) RegisterHTTPHandlers(
 	handle("/flightrecorder/capture", introspectionflightrecorder.CaptureHandler(w.flightRecorder))
 }
 
-type notSupportedHandler struct {
-	name string
-}
-
-// ServeHTTP is part of the http.Handler interface.
-func (h notSupportedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	http.Error(w, fmt.Sprintf("%q introspection not supported", h.name), http.StatusNotFound)
-}
-
 type depengineHandler struct {
 	reporter DependencyEngine
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/flightrecorder"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/worker/introspection"
 	"github.com/juju/juju/juju/sockets"
@@ -86,6 +87,7 @@ type introspectionSuite struct {
 	depEngine      introspection.DependencyEngine
 	gatherer       prometheus.Gatherer
 	flightRecorder flightrecorder.FlightRecorder
+	leaseStore     introspection.LeaseStore
 }
 
 func TestIntrospectionSuite(t *testing.T) {
@@ -98,6 +100,7 @@ func (s *introspectionSuite) SetUpTest(c *tc.C) {
 	}
 	s.IsolationSuite.SetUpTest(c)
 	s.depEngine = nil
+	s.leaseStore = nil
 	s.worker = nil
 	s.gatherer = newPrometheusGatherer()
 	s.flightRecorder = flightRecorder{}
@@ -111,6 +114,7 @@ func (s *introspectionSuite) startWorker(c *tc.C) {
 		DepEngine:          s.depEngine,
 		PrometheusGatherer: s.gatherer,
 		FlightRecorder:     s.flightRecorder,
+		LeaseStore:         s.leaseStore,
 	})
 	c.Assert(err, tc.ErrorIsNil)
 	s.worker = w
@@ -205,6 +209,69 @@ Dependency Engine Report
 working: true`[1:])
 }
 
+func (s *introspectionSuite) TestMissingLeaseStore(c *tc.C) {
+	response := s.call(c, "/leases")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusNotFound)
+	s.assertBody(c, response, "missing lease store")
+}
+
+func (s *introspectionSuite) TestLeases(c *tc.C) {
+	workertest.CleanKill(c, s.worker)
+	s.leaseStore = newLeaseStore()
+	s.startWorker(c)
+
+	response := s.call(c, "/leases")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+	c.Check(s.body(c, response), tc.Equals, `
+leases:
+- namespace: application-leadership
+  model-uuid: model-a
+  lease: mysql
+  holder: mysql/0
+  expiry: 2026-01-02T03:04:05Z
+  pinned: true
+  pinned-by:
+  - machine-0
+- namespace: application-leadership
+  model-uuid: model-b
+  lease: wordpress
+  holder: wordpress/1
+  expiry: 2026-01-02T03:04:05Z
+  pinned: false
+- namespace: singular-controller
+  model-uuid: model-a
+  lease: model-a
+  holder: controller-0
+  expiry: 2026-01-02T03:04:05Z
+  pinned: false
+`[1:])
+}
+
+func (s *introspectionSuite) TestLeasesFilteredJSON(c *tc.C) {
+	workertest.CleanKill(c, s.worker)
+	s.leaseStore = newLeaseStore()
+	s.startWorker(c)
+
+	response := s.call(c, "/leases?model=model-a&namespace=application-leadership&format=json")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusOK)
+	c.Assert(response.Header.Get("Content-Type"), tc.Equals, "application/json")
+	s.assertBody(c, response, `{"leases":[{"namespace":"application-leadership","model-uuid":"model-a","lease":"mysql","holder":"mysql/0","expiry":"2026-01-02T03:04:05Z","pinned":true,"pinned-by":["machine-0"]}]}`)
+}
+
+func (s *introspectionSuite) TestLeasesInvalidFormat(c *tc.C) {
+	workertest.CleanKill(c, s.worker)
+	s.leaseStore = newLeaseStore()
+	s.startWorker(c)
+
+	response := s.call(c, "/leases?format=xml")
+	defer response.Body.Close()
+	c.Assert(response.StatusCode, tc.Equals, http.StatusBadRequest)
+	s.assertBody(c, response, `unsupported format "xml"`)
+}
+
 func (s *introspectionSuite) TestPrometheusMetrics(c *tc.C) {
 	response := s.call(c, "/metrics")
 	defer response.Body.Close()
@@ -249,6 +316,46 @@ func unixSocketHTTPTransport(socketPath string) *http.Transport {
 	}
 }
 
+type leaseStore struct {
+	leases map[lease.Key]lease.Info
+	pinned map[lease.Key][]string
+}
+
+func newLeaseStore() *leaseStore {
+	expiry := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mysql := lease.Key{
+		Namespace: lease.ApplicationLeadershipNamespace,
+		ModelUUID: "model-a",
+		Lease:     "mysql",
+	}
+	return &leaseStore{
+		leases: map[lease.Key]lease.Info{
+			mysql: {Holder: "mysql/0", Expiry: expiry},
+			{
+				Namespace: lease.ApplicationLeadershipNamespace,
+				ModelUUID: "model-b",
+				Lease:     "wordpress",
+			}: {Holder: "wordpress/1", Expiry: expiry},
+			{
+				Namespace: lease.SingularControllerNamespace,
+				ModelUUID: "model-a",
+				Lease:     "model-a",
+			}: {Holder: "controller-0", Expiry: expiry},
+		},
+		pinned: map[lease.Key][]string{
+			mysql: {"machine-0"},
+		},
+	}
+}
+
+func (s *leaseStore) Leases(context.Context, ...lease.Key) (map[lease.Key]lease.Info, error) {
+	return s.leases, nil
+}
+
+func (s *leaseStore) Pinned(context.Context) (map[lease.Key][]string, error) {
+	return s.pinned, nil
+}
+
 type flightRecorder struct {
 	flightrecorder.FlightRecorder
 }
@@ -3,5 +3,7 @@
 
 // Package jwtparser provides a singleton JWTParser
 // that can be used as a dependency to any workers
-// that need to parse JWTs (JSON Web Token).
+// that need to parse JWTs (JSON Web Token), along
+// with the OpenID Connect identity provider whose
+// ID tokens users can log in with.
 package jwtparser
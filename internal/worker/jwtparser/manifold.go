@@ -8,12 +8,14 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/juju/worker/v5/dependency"
 
 	coredependency "github.com/juju/juju/core/dependency"
 	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -35,9 +37,12 @@ type ManifoldConfig struct {
 	DomainServicesName string
 	// GetControllerConfigService is used to get a service from the manifold.
 	GetControllerConfigService GetControllerConfigServiceFunc
+	// Clock is used by the OpenID Connect identity provider.
+	Clock clock.Clock
 }
 
-// Manifold returns a manifold whose worker wraps a JWT parser.
+// Manifold returns a manifold whose worker wraps a JWT parser and an
+// OpenID Connect identity provider.
 func Manifold(config ManifoldConfig) dependency.Manifold {
 	return dependency.Manifold{
 		Inputs: []string{
@@ -49,15 +54,18 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 }
 
 func (config ManifoldConfig) start(_ context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if config.Clock == nil {
+		return nil, errors.NotValidf("nil Clock")
+	}
 	controllerConfigService, err := config.GetControllerConfigService(getter, config.DomainServicesName)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
-	return NewWorker(controllerConfigService, defaultHTTPClient())
+	return NewWorker(controllerConfigService, defaultHTTPClient(), config.Clock)
 }
 
-// outputFunc extracts a jwtparser.Parser from a
+// outputFunc extracts a jwtparser.Parser or an oidc.Provider from a
 // jwtParserWorker contained within a CleanupWorker.
 func outputFunc(in worker.Worker, out any) error {
 	inWorker, _ := in.(*jwtParserWorker)
@@ -68,8 +76,10 @@ func outputFunc(in worker.Worker, out any) error {
 	switch outPointer := out.(type) {
 	case **jwtparser.Parser:
 		*outPointer = inWorker.jwtParser
+	case **oidc.Provider:
+		*outPointer = inWorker.oidcProvider
 	default:
-		return errors.Errorf("out should be jwtparser.Parser; got %T", out)
+		return errors.Errorf("out should be jwtparser.Parser or oidc.Provider; got %T", out)
 	}
 	return nil
 }
@@ -6,6 +6,7 @@ package jwtparser
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v5"
 	"github.com/lestrrat-go/jwx/v3/jwk"
@@ -13,11 +14,13 @@ import (
 
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 )
 
 type jwtParserWorker struct {
-	tomb      tomb.Tomb
-	jwtParser *jwtparser.Parser
+	tomb         tomb.Tomb
+	jwtParser    *jwtparser.Parser
+	oidcProvider *oidc.Provider
 }
 
 // ControllerConfigService defines an interface to retrieve controller config.
@@ -32,8 +35,10 @@ type HTTPClient interface {
 	jwk.HTTPClient
 }
 
-// NewWorker returns a worker that provides a JWTParser.
-func NewWorker(configService ControllerConfigService, httpClient HTTPClient) (worker.Worker, error) {
+// NewWorker returns a worker that provides a JWTParser and an OpenID
+// Connect identity provider. The provider is not enabled unless the
+// oidc-issuer-url controller config is set.
+func NewWorker(configService ControllerConfigService, httpClient HTTPClient, clock clock.Clock) (worker.Worker, error) {
 	controllerConfig, err := configService.ControllerConfig(context.Background())
 	if err != nil {
 		return nil, errors.Annotate(err, "cannot fetch the controller config")
@@ -53,7 +58,23 @@ func NewWorker(configService ControllerConfigService, httpClient HTTPClient) (wo
 		}
 	}
 
-	w := &jwtParserWorker{jwtParser: jwtParser}
+	oidcProvider, err := oidc.NewProvider(ctx, oidc.Config{
+		IssuerURL:    controllerConfig.OIDCIssuerURL(),
+		ClientID:     controllerConfig.OIDCClientID(),
+		GroupsClaim:  controllerConfig.OIDCGroupsClaim(),
+		GroupMapping: controllerConfig.OIDCGroupMapping(),
+		HTTPClient:   httpClient,
+		Clock:        clock,
+	})
+	if err != nil {
+		done()
+		return nil, errors.Annotate(err, "cannot create the oidc provider")
+	}
+
+	w := &jwtParserWorker{
+		jwtParser:    jwtParser,
+		oidcProvider: oidcProvider,
+	}
 	w.tomb.Go(func() error {
 		defer done()
 		return w.loop()
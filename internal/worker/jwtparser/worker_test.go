@@ -11,10 +11,13 @@ import (
 	"testing"
 
 	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5/workertest"
 
 	"github.com/juju/juju/controller"
+	"github.com/juju/juju/internal/jwtparser"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/testhelpers"
 )
 
@@ -42,13 +45,14 @@ func (s *workerSuite) TestJWTParserWorkerWithNoConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	s.controllerConfig.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil)
 
-	w, err := NewWorker(s.controllerConfig, s.client)
+	w, err := NewWorker(s.controllerConfig, s.client, clock.WallClock)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(workertest.CheckKill(c, w), tc.ErrorIsNil)
 
 	parserWorker, ok := w.(*jwtParserWorker)
 	c.Assert(ok, tc.IsTrue)
 	c.Assert(parserWorker.jwtParser, tc.Not(tc.IsNil))
+	c.Assert(parserWorker.oidcProvider.Enabled(), tc.IsFalse)
 }
 
 // TestJWTParserWorkerWithLoginRefreshURL tests that NewWorker function
@@ -70,7 +74,7 @@ func (s *workerSuite) TestJWTParserWorkerWithLoginRefreshURL(c *tc.C) {
 		"login-token-refresh-url": refreshURL,
 	}, nil)
 
-	w, err := NewWorker(s.controllerConfig, s.client)
+	w, err := NewWorker(s.controllerConfig, s.client, clock.WallClock)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(workertest.CheckKill(c, w), tc.ErrorIsNil)
 
@@ -78,3 +82,25 @@ func (s *workerSuite) TestJWTParserWorkerWithLoginRefreshURL(c *tc.C) {
 	c.Assert(ok, tc.IsTrue)
 	c.Assert(parserWorker.jwtParser, tc.Not(tc.IsNil))
 }
+
+// TestOIDCProviderWithIssuerURL tests that NewWorker function creates an
+// enabled OIDC provider when the oidc-issuer-url config option is set.
+func (s *workerSuite) TestOIDCProviderWithIssuerURL(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.controllerConfig.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.OIDCIssuerURL: "https://idp.example.com",
+		controller.OIDCClientID:  "juju",
+	}, nil)
+
+	w, err := NewWorker(s.controllerConfig, s.client, clock.WallClock)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	var provider *oidc.Provider
+	c.Assert(outputFunc(w, &provider), tc.ErrorIsNil)
+	c.Assert(provider.Enabled(), tc.IsTrue)
+
+	var parser *jwtparser.Parser
+	c.Assert(outputFunc(w, &parser), tc.ErrorIsNil)
+	c.Assert(parser, tc.Not(tc.IsNil))
+}
@@ -51,6 +51,12 @@ type ManifoldConfig struct {
 	NewWorker            func(ManagerConfig) (worker.Worker, error)
 	NewStore             func(database.DBGetter, logger.Logger) lease.Store
 	NewSecretaryFinder   func(string) lease.SecretaryFinder
+
+	// SetIntrospectionStore, if set, is called with the lease store when
+	// the manager is started, so the leases can be reported on by the
+	// agent introspection worker. It is called with nil once the manager
+	// has stopped.
+	SetIntrospectionStore func(lease.Store)
 }
 
 // Validate checks that the config has all the required values.
@@ -129,7 +135,19 @@ func (s *manifoldState) start(ctx context.Context, getter dependency.Getter) (wo
 		LogDir:               s.config.LogDir,
 		PrometheusRegisterer: s.config.PrometheusRegisterer,
 	})
-	return w, errors.Trace(err)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if s.config.SetIntrospectionStore == nil {
+		return w, nil
+	}
+
+	// The store is only valid for as long as the manager runs, so the
+	// introspection worker must stop using it once the manager stops.
+	s.config.SetIntrospectionStore(store)
+	return common.NewCleanupWorker(w, func() {
+		s.config.SetIntrospectionStore(nil)
+	}), nil
 }
 
 func (s *manifoldState) output(in worker.Worker, out any) error {
@@ -4,16 +4,20 @@
 package lease
 
 import (
+	"context"
 	"testing"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 	"github.com/juju/worker/v5"
+	dt "github.com/juju/worker/v5/dependency/testing"
+	"github.com/juju/worker/v5/workertest"
 
 	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/logger"
+	coretrace "github.com/juju/juju/core/trace"
 )
 
 type manifoldSuite struct {
@@ -74,6 +78,35 @@ func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 }
 
+func (s *manifoldSuite) TestStartSetsIntrospectionStore(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	store := &stubStore{}
+	var introspected lease.Store
+	cfg := s.getConfig()
+	cfg.NewStore = func(coredatabase.DBGetter, logger.Logger) lease.Store {
+		return store
+	}
+	cfg.NewWorker = func(mc ManagerConfig) (worker.Worker, error) {
+		return workertest.NewErrorWorker(nil), nil
+	}
+	cfg.SetIntrospectionStore = func(s lease.Store) {
+		introspected = s
+	}
+
+	getter := dt.StubGetter(map[string]any{
+		"dbaccessor": stubDBGetter{},
+		"trace":      stubTracerGetter{},
+	})
+	w, err := Manifold(cfg).Start(c.Context(), getter)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(introspected, tc.Equals, store)
+
+	// Once the manager stops, the store must no longer be reported on.
+	workertest.CleanKill(c, w)
+	c.Check(introspected, tc.IsNil)
+}
+
 func (s *manifoldSuite) getConfig() ManifoldConfig {
 	return ManifoldConfig{
 		ControllerUUID:       "ctrl-uuid-1234",
@@ -95,3 +128,17 @@ func (s *manifoldSuite) getConfig() ManifoldConfig {
 		},
 	}
 }
+
+type stubStore struct {
+	lease.Store
+}
+
+type stubDBGetter struct {
+	coredatabase.DBGetter
+}
+
+type stubTracerGetter struct{}
+
+func (stubTracerGetter) GetTracer(context.Context, coretrace.TracerNamespace) (coretrace.Tracer, error) {
+	return coretrace.NoopTracer{}, nil
+}
@@ -175,20 +175,28 @@ func (w *lokiBackend) loop() error {
 			if rec.Entity != "" {
 				agentID = rec.Entity
 			}
+			// Labels are forwarded as structured metadata, so that logs
+			// can be filtered on them in Loki as they are by debug-log.
+			fields := make(map[string]string, len(rec.Labels)+3)
+			for k, v := range rec.Labels {
+				if k == loki.TraceIDLabel || k == loki.SpanIDLabel {
+					continue
+				}
+				fields[loki.LabelField(k)] = v
+			}
+			fields["module"] = rec.Module
+			fields["location"] = rec.Location
+			fields["level"] = rec.Level.String()
 			if err := w.client.Push(loki.Record{
 				Timestamp:      rec.Time,
 				Line:           rec.Message,
 				ControllerUUID: w.cfg.ControllerUUID,
 				ModelUUID:      modelUUID,
 				AgentID:        agentID,
-				Fields: map[string]string{
-					"module":   rec.Module,
-					"location": rec.Location,
-					"level":    rec.Level.String(),
-				},
-				ServiceName: w.cfg.ServiceName,
-				TraceID:     rec.Labels["trace_id"],
-				SpanID:      rec.Labels["span_id"],
+				Fields:         fields,
+				ServiceName:    w.cfg.ServiceName,
+				TraceID:        rec.Labels[loki.TraceIDLabel],
+				SpanID:         rec.Labels[loki.SpanIDLabel],
 			}); err != nil {
 				return internalerrors.Capture(err)
 			}
@@ -113,6 +113,57 @@ func (s *lokiSuite) TestForwardsTraceAndSpanFromLabels(c *tc.C) {
 	c.Check(got.TraceID, tc.Equals, "0123456789abcdef0123456789abcdef")
 	c.Check(got.SpanID, tc.Equals, "0123456789abcdef")
 	c.Check(got.ServiceName, tc.Equals, "juju-unit")
+	c.Check(got.Fields, tc.DeepEquals, map[string]string{
+		"module":   "test.module",
+		"location": "worker.go:10",
+		"level":    "INFO",
+	})
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *lokiSuite) TestForwardsLabelsAsFields(c *tc.C) {
+	client := newRecordingLokiClient()
+
+	w, err := NewLoki(LokiConfig{
+		BackendBufferSize: 1,
+		ClientConfig: loki.Config{
+			HTTPClient: &http.Client{},
+		},
+		Endpoint:             "http://loki/loki/api/v1/push",
+		ControllerUUID:       "controller",
+		ModelUUID:            "model",
+		AgentID:              "machine-0",
+		ServiceName:          "juju-unit",
+		PrometheusRegisterer: prometheus.NewRegistry(),
+		NewClient: func(string, loki.Config) (LokiClient, error) {
+			return client, nil
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:     time.Now(),
+		Module:   "test.module",
+		Location: "worker.go:10",
+		Level:    loggo.INFO,
+		Message:  "labelled request",
+		Labels: map[string]string{
+			"charm":           "mysql",
+			"http-request-id": "42",
+			"module":          "overridden",
+		},
+	}
+
+	got := client.waitRecord(c)
+	c.Check(got.Fields, tc.DeepEquals, map[string]string{
+		"charm":           "mysql",
+		"http_request_id": "42",
+		"module":          "test.module",
+		"location":        "worker.go:10",
+		"level":           "INFO",
+	})
 
 	workertest.CleanKill(c, w)
 }
@@ -0,0 +1,261 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backends
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/loggo/v3"
+	"github.com/juju/worker/v5/catacomb"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	corelogger "github.com/juju/juju/core/logger"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/otlp"
+	"github.com/juju/juju/internal/worker/logsender"
+)
+
+// OTLPConfig contains the settings required by the OTLP backend.
+type OTLPConfig struct {
+	BackendBufferSize int
+	BatchSize         int
+	FlushInterval     time.Duration
+	ClientConfig      otlp.Config
+	ControllerUUID    string
+	ModelUUID         string
+	AgentID           string
+	ServiceName       string
+	Clock             clock.Clock
+	NewClient         NewOTLPClientFunc
+}
+
+// Validate checks that the OTLP backend config is usable.
+func (c OTLPConfig) Validate() error {
+	if c.BackendBufferSize <= 0 {
+		return errors.NotValidf("non-positive BackendBufferSize")
+	}
+	if c.BatchSize <= 0 {
+		return errors.NotValidf("non-positive BatchSize")
+	}
+	if c.FlushInterval <= 0 {
+		return errors.NotValidf("non-positive FlushInterval")
+	}
+	if c.ServiceName == "" {
+		return errors.NotValidf("empty ServiceName")
+	}
+	if c.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if c.NewClient == nil {
+		return errors.NotValidf("nil NewClient")
+	}
+	return nil
+}
+
+// NewOTLPClientFunc returns a client for an OTLP collector.
+type NewOTLPClientFunc func(otlp.Config) (otlp.Client, error)
+
+// otlpResourceKey identifies the agent, and the model it writes logs for,
+// that a log record is exported on behalf of.
+type otlpResourceKey struct {
+	modelUUID string
+	agentID   string
+}
+
+type otlpBackend struct {
+	catacomb catacomb.Catacomb
+	cfg      OTLPConfig
+	client   otlp.Client
+	records  logsender.LogRecordCh
+
+	mu            sync.Mutex
+	exported      int
+	failedExports int
+	lastError     string
+}
+
+// NewOTLP returns a backend that sends log records to an OTLP collector. It
+// is the alternative to the Loki backend for observability stacks which
+// ingest logs through an OTEL collector.
+func NewOTLP(cfg OTLPConfig) (Backend, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	client, err := cfg.NewClient(cfg.ClientConfig)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	w := &otlpBackend{
+		cfg:     cfg,
+		client:  client,
+		records: make(logsender.LogRecordCh, cfg.BackendBufferSize),
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "log-router-otlp",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		_ = client.Close()
+		return nil, internalerrors.Capture(err)
+	}
+	return w, nil
+}
+
+// Kill stops the backend.
+func (w *otlpBackend) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait waits for the backend to stop.
+func (w *otlpBackend) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// LogRecords returns the channel that the log router will send log records to.
+func (w *otlpBackend) LogRecords() logsender.LogRecordCh {
+	return w.records
+}
+
+// Log implements corelogger.LogSink by converting records to the internal
+// logsender format and submitting them to the backend's record channel.
+func (w *otlpBackend) Log(records []corelogger.LogRecord) error {
+	return sendRecords(w.records, records)
+}
+
+// WatchRefresh implements corelogger.LogSink. Individual backends never
+// change their underlying target; refresh signalling is handled by the log
+// router when switching backends.
+func (w *otlpBackend) WatchRefresh() <-chan struct{} {
+	return corelogger.NoRefresh()
+}
+
+// Report returns a report of the backend's current state.
+func (w *otlpBackend) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	report := map[string]any{
+		"name":           "otlp-backend",
+		"service_name":   w.cfg.ServiceName,
+		"exported":       w.exported,
+		"failed_exports": w.failedExports,
+	}
+	if w.lastError != "" {
+		report["last_error"] = w.lastError
+	}
+	return report
+}
+
+func (w *otlpBackend) loop() error {
+	defer func() {
+		_ = w.client.Close()
+	}()
+
+	ctx := w.catacomb.Context(context.Background())
+
+	pending := make(map[otlpResourceKey][]*logsv1.LogRecord)
+	count := 0
+	flush := func() {
+		if count == 0 {
+			return
+		}
+		w.export(ctx, pending, count)
+		pending = make(map[otlpResourceKey][]*logsv1.LogRecord)
+		count = 0
+	}
+
+	flushTimer := w.cfg.Clock.After(w.cfg.FlushInterval)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case rec, ok := <-w.records:
+			if !ok {
+				return nil
+			}
+			if rec == nil {
+				continue
+			}
+			key := otlpResourceKey{
+				modelUUID: w.cfg.ModelUUID,
+				agentID:   w.cfg.AgentID,
+			}
+			if rec.ModelUUID != "" {
+				key.modelUUID = rec.ModelUUID
+			}
+			if rec.Entity != "" {
+				key.agentID = rec.Entity
+			}
+			pending[key] = append(pending[key], otlp.NewLogRecord(otlpEntry(rec)))
+			count++
+			if count >= w.cfg.BatchSize {
+				flush()
+			}
+
+		case <-flushTimer:
+			flush()
+			flushTimer = w.cfg.Clock.After(w.cfg.FlushInterval)
+		}
+	}
+}
+
+// export sends the pending log records to the collector, grouped by the
+// agent they were written by. Records which fail to export are dropped, as
+// the log router does for a full backend, and the failure is reported.
+func (w *otlpBackend) export(ctx context.Context, pending map[otlpResourceKey][]*logsv1.LogRecord, count int) {
+	keys := make([]otlpResourceKey, 0, len(pending))
+	for key := range pending {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].modelUUID != keys[j].modelUUID {
+			return keys[i].modelUUID < keys[j].modelUUID
+		}
+		return keys[i].agentID < keys[j].agentID
+	})
+
+	logs := make([]*logsv1.ResourceLogs, 0, len(keys))
+	for _, key := range keys {
+		resource := otlp.NewAgentResource(w.cfg.ServiceName, key.agentID, w.cfg.ControllerUUID, key.modelUUID)
+		logs = append(logs, otlp.NewResourceLogs(resource, pending[key]))
+	}
+	err := w.client.ExportLogs(ctx, logs)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err != nil {
+		w.failedExports++
+		w.lastError = err.Error()
+		return
+	}
+	w.exported += count
+}
+
+// otlpEntry converts a log record into the loggo entry it was written as,
+// splitting its location back into a file name and line number.
+func otlpEntry(rec *logsender.LogRecord) loggo.Entry {
+	entry := loggo.Entry{
+		Level:     rec.Level,
+		Module:    rec.Module,
+		Timestamp: rec.Time,
+		Message:   rec.Message,
+		Labels:    rec.Labels,
+	}
+	entry.Filename = rec.Location
+	if i := strings.LastIndex(rec.Location, ":"); i >= 0 {
+		if line, err := strconv.Atoi(rec.Location[i+1:]); err == nil {
+			entry.Filename = rec.Location[:i]
+			entry.Line = line
+		}
+	}
+	return entry
+}
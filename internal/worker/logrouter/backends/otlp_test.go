@@ -0,0 +1,226 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backends
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/loggo/v3"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/juju/juju/internal/otlp"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/worker/logsender"
+)
+
+type otlpSuite struct{}
+
+func TestOTLPSuite(t *testing.T) {
+	tc.Run(t, &otlpSuite{})
+}
+
+func (s *otlpSuite) config(client otlp.Client, clock *testclock.Clock, batchSize int) OTLPConfig {
+	return OTLPConfig{
+		BackendBufferSize: 10,
+		BatchSize:         batchSize,
+		FlushInterval:     time.Second,
+		ClientConfig: otlp.Config{
+			HTTPEndpoint: "http://collector:4318",
+		},
+		ControllerUUID: "controller",
+		ModelUUID:      "model",
+		AgentID:        "machine-0",
+		ServiceName:    "juju-unit",
+		Clock:          clock,
+		NewClient: func(otlp.Config) (otlp.Client, error) {
+			return client, nil
+		},
+	}
+}
+
+func (s *otlpSuite) TestExportsBatchGroupedByAgent(c *tc.C) {
+	client := newRecordingOTLPClient()
+	cfg := s.config(client, testclock.NewClock(time.Now()), 2)
+	cfg.NewClient = func(config otlp.Config) (otlp.Client, error) {
+		c.Check(config.HTTPEndpoint, tc.Equals, "http://collector:4318")
+		return client, nil
+	}
+
+	w, err := NewOTLP(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	now := time.Now()
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:     now,
+		Module:   "juju.worker.uniter",
+		Location: "uniter.go:42",
+		Level:    loggo.INFO,
+		Message:  "hello from the unit",
+		Entity:   "unit-mysql-0",
+		Labels: map[string]string{
+			"trace_id": "0123456789abcdef0123456789abcdef",
+		},
+	}
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    now,
+		Module:  "juju.worker.machine",
+		Level:   loggo.WARNING,
+		Message: "hello from the machine",
+	}
+
+	logs := client.waitExport(c)
+	c.Assert(logs, tc.HasLen, 2)
+
+	machineResource := attributes(logs[0].Resource.Attributes)
+	delete(machineResource, "service.version")
+	c.Check(machineResource, tc.DeepEquals, map[string]string{
+		"service.name":         "juju-unit",
+		"service.instance.id":  "machine-0",
+		"juju.controller.uuid": "controller",
+		"juju.model.uuid":      "model",
+	})
+	c.Check(attributes(logs[1].Resource.Attributes)["service.instance.id"], tc.Equals, "unit-mysql-0")
+
+	unitRecords := logs[1].ScopeLogs[0].LogRecords
+	c.Assert(unitRecords, tc.HasLen, 1)
+	c.Check(unitRecords[0].Body.GetStringValue(), tc.Equals, "hello from the unit")
+	c.Check(unitRecords[0].SeverityText, tc.Equals, "INFO")
+	c.Check(unitRecords[0].TraceId, tc.HasLen, 16)
+	c.Check(attributes(unitRecords[0].Attributes), tc.DeepEquals, map[string]string{
+		"log.logger":    "juju.worker.uniter",
+		"code.filepath": "uniter.go",
+		"code.lineno":   "42",
+	})
+
+	workertest.CleanKill(c, w)
+	c.Check(client.closed.Load(), tc.IsTrue)
+	c.Check(w.Report(c.Context())["exported"], tc.Equals, 2)
+}
+
+func (s *otlpSuite) TestFlushesOnInterval(c *tc.C) {
+	client := newRecordingOTLPClient()
+	clock := testclock.NewClock(time.Now())
+
+	w, err := NewOTLP(s.config(client, clock, 100))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:      time.Now(),
+		Module:    "juju.apiserver",
+		Level:     loggo.ERROR,
+		Message:   "model log",
+		ModelUUID: "other-model",
+	}
+
+	// The record is held until the flush interval passes. The first
+	// interval may pass before the record is taken, so keep advancing the
+	// clock until it is exported.
+	var logs []*logsv1.ResourceLogs
+	for logs == nil {
+		c.Assert(clock.WaitAdvance(time.Second, testhelpers.LongWait, 1), tc.ErrorIsNil)
+		select {
+		case logs = <-client.exports:
+		case <-time.After(testhelpers.ShortWait):
+		}
+	}
+	c.Assert(logs, tc.HasLen, 1)
+	c.Check(attributes(logs[0].Resource.Attributes)["juju.model.uuid"], tc.Equals, "other-model")
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *otlpSuite) TestReportsFailedExports(c *tc.C) {
+	client := newRecordingOTLPClient()
+	client.err = errors.New("collector unavailable")
+
+	w, err := NewOTLP(s.config(client, testclock.NewClock(time.Now()), 1))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	w.LogRecords() <- &logsender.LogRecord{
+		Time:    time.Now(),
+		Level:   loggo.INFO,
+		Message: "lost",
+	}
+	client.waitExport(c)
+
+	// The backend keeps running, reporting the failure.
+	deadline := time.After(testhelpers.LongWait)
+	for w.Report(c.Context())["failed_exports"] != 1 {
+		select {
+		case <-deadline:
+			c.Fatalf("timed out waiting for the failed export to be reported")
+		case <-time.After(testhelpers.ShortWait):
+		}
+	}
+	report := w.Report(c.Context())
+	c.Check(report["name"], tc.Equals, "otlp-backend")
+	c.Check(report["failed_exports"], tc.Equals, 1)
+	c.Check(report["last_error"], tc.Equals, "collector unavailable")
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *otlpSuite) TestValidate(c *tc.C) {
+	cfg := s.config(newRecordingOTLPClient(), testclock.NewClock(time.Now()), 1)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	cfg.BatchSize = 0
+	c.Check(cfg.Validate(), tc.ErrorMatches, "non-positive BatchSize not valid")
+}
+
+func attributes(kvs []*commonv1.KeyValue) map[string]string {
+	result := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		result[kv.Key] = kv.Value.GetStringValue()
+	}
+	return result
+}
+
+type recordingOTLPClient struct {
+	exports chan []*logsv1.ResourceLogs
+	err     error
+	closed  atomic.Bool
+}
+
+func newRecordingOTLPClient() *recordingOTLPClient {
+	return &recordingOTLPClient{
+		exports: make(chan []*logsv1.ResourceLogs, 10),
+	}
+}
+
+func (c *recordingOTLPClient) ExportMetrics(context.Context, []*metricsv1.ResourceMetrics) error {
+	return nil
+}
+
+func (c *recordingOTLPClient) ExportLogs(_ context.Context, logs []*logsv1.ResourceLogs) error {
+	c.exports <- logs
+	return c.err
+}
+
+func (c *recordingOTLPClient) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func (c *recordingOTLPClient) waitExport(t *tc.C) []*logsv1.ResourceLogs {
+	select {
+	case logs := <-c.exports:
+		return logs
+	case <-t.Context().Done():
+		t.Fatalf("timed out waiting for otlp export")
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/loki"
+	"github.com/juju/juju/internal/otlp"
 	"github.com/juju/juju/internal/worker/logrouter/backends"
 	"github.com/juju/juju/internal/worker/logsender"
 )
@@ -318,6 +319,9 @@ func NewBackend(
 				},
 			})
 
+		case BackendTypeOTLP:
+			return newOTLPBackend(snapshot, clock, UnitServiceName)
+
 		case BackendTypeDrain:
 			return backends.NewDrain(defaultBackendBufferSize)
 
@@ -374,6 +378,9 @@ func NewControllerBackend(
 				},
 			})
 
+		case BackendTypeOTLP:
+			return newOTLPBackend(snapshot, clock, ControllerServiceName)
+
 		case BackendTypeDrain:
 			return backends.NewDrain(defaultBackendBufferSize)
 
@@ -382,3 +389,29 @@ func NewControllerBackend(
 		}
 	}
 }
+
+// newOTLPBackend returns a backend sending records to the OTLP collector at
+// the snapshot's logging endpoint.
+func newOTLPBackend(snapshot ConfigSnapshot, clock clock.Clock, serviceName string) (Backend, error) {
+	insecureSkipVerify := false
+	if snapshot.InsecureSkipVerify != nil {
+		insecureSkipVerify = *snapshot.InsecureSkipVerify
+	}
+	clientConfig, err := otlp.LogEndpointConfig(snapshot.Endpoint, snapshot.CACertificate, insecureSkipVerify)
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	return backends.NewOTLP(backends.OTLPConfig{
+		BackendBufferSize: defaultBackendBufferSize,
+		BatchSize:         defaultOTLPBatchSize,
+		FlushInterval:     defaultOTLPFlushInterval,
+		ClientConfig:      clientConfig,
+		ControllerUUID:    snapshot.ControllerUUID,
+		ModelUUID:         snapshot.ModelUUID,
+		AgentID:           snapshot.AgentID,
+		ServiceName:       serviceName,
+		Clock:             clock,
+		NewClient:         otlp.NewClient,
+	})
+}
@@ -136,6 +136,22 @@ func (s *manifoldSuite) TestNewBackendUsesLokiBackendForLokiMode(c *tc.C) {
 	c.Check(report["service_name"], tc.Equals, UnitServiceName)
 }
 
+func (s *manifoldSuite) TestNewBackendUsesOTLPBackendForOTLPMode(c *tc.C) {
+	client := &recordingCACertUpdaterClient{}
+	backendFunc := NewBackend(stubAPICaller{}, client, clock.WallClock, prometheus.NewRegistry())
+
+	backend, err := backendFunc(BackendTypeOTLP, ConfigSnapshot{
+		Mode:     BackendTypeOTLP,
+		Endpoint: "otlp+http://collector:4318",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, backend)
+
+	report := backend.Report(c.Context())
+	c.Check(report["name"], tc.Equals, "otlp-backend")
+	c.Check(report["service_name"], tc.Equals, UnitServiceName)
+}
+
 func (s *manifoldSuite) TestNewBackendReturnsCACertUpdateError(c *tc.C) {
 	expectErr := stderrors.New("boom")
 	client := &recordingCACertUpdaterClient{err: expectErr}
@@ -224,6 +240,43 @@ func (s *manifoldSuite) TestNewControllerBackendUsesDrainBackendForDrainMode(c *
 	c.Check(sink.records, tc.HasLen, 0)
 }
 
+func (s *manifoldSuite) TestNewControllerBackendUsesOTLPBackendForOTLPMode(c *tc.C) {
+	backendFunc := NewControllerBackend(
+		&recordingLogSink{done: make(chan struct{}, 1)},
+		stubHTTPClient{},
+		clock.WallClock,
+		prometheus.NewRegistry(),
+	)
+
+	backend, err := backendFunc(BackendTypeOTLP, ConfigSnapshot{
+		Mode:      BackendTypeOTLP,
+		Endpoint:  "otlp+grpc://collector:4317",
+		ModelUUID: "model-uuid",
+		AgentID:   "machine-0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, backend)
+
+	report := backend.Report(c.Context())
+	c.Check(report["name"], tc.Equals, "otlp-backend")
+	c.Check(report["service_name"], tc.Equals, ControllerServiceName)
+}
+
+func (s *manifoldSuite) TestNewControllerBackendRejectsInvalidOTLPEndpoint(c *tc.C) {
+	backendFunc := NewControllerBackend(
+		&recordingLogSink{done: make(chan struct{}, 1)},
+		stubHTTPClient{},
+		clock.WallClock,
+		prometheus.NewRegistry(),
+	)
+
+	_, err := backendFunc(BackendTypeOTLP, ConfigSnapshot{
+		Mode:     BackendTypeOTLP,
+		Endpoint: "otlp+ftp://collector:4317",
+	})
+	c.Assert(err, tc.NotNil)
+}
+
 func (s *manifoldSuite) TestNewControllerBackendUsesLokiBackendForLokiMode(c *tc.C) {
 	sink := &recordingLogSink{done: make(chan struct{}, 1)}
 	backendFunc := NewControllerBackend(
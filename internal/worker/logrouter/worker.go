@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/agent"
 	corelogger "github.com/juju/juju/core/logger"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/otlp"
 	internalworker "github.com/juju/juju/internal/worker"
 	"github.com/juju/juju/internal/worker/logsender"
 )
@@ -29,6 +30,13 @@ const (
 	defaultConvergeTimeout   = time.Second * 60
 	defaultRestartDelay      = time.Second * 1
 	backendDrainID           = "drain"
+
+	// defaultOTLPBatchSize is the number of records which triggers an
+	// export to an OTLP collector.
+	defaultOTLPBatchSize = 512
+	// defaultOTLPFlushInterval is the longest records are held before they
+	// are exported to an OTLP collector.
+	defaultOTLPFlushInterval = time.Second * 5
 )
 
 // BackendType identifies the active log delivery backend.
@@ -39,6 +47,10 @@ const (
 	BackendTypeLogSink BackendType = "logsink"
 	// BackendTypeLoki forwards records to a Loki push endpoint.
 	BackendTypeLoki BackendType = "loki"
+	// BackendTypeOTLP forwards records to an OTLP collector. It is selected
+	// when the controller enables log export in the workload tracing config,
+	// which gives the agent the collector as an OTLP logging endpoint.
+	BackendTypeOTLP BackendType = "otlp"
 	// BackendTypeDrain discards records locally.
 	BackendTypeDrain BackendType = "drain-only"
 )
@@ -68,7 +80,7 @@ type pendingRecordsExporter interface {
 type BackendFunc func(BackendType, ConfigSnapshot) (Backend, error)
 
 // LogRouter provides access to the log router's LogSink, which delegates to
-// the active backend (logsink, Loki, or drain) and fires a refresh channel
+// the active backend (logsink, Loki, OTLP, or drain) and fires a refresh channel
 // when the backend changes.
 type LogRouter interface {
 	// LogSink returns a sink that forwards records to the active
@@ -452,6 +464,8 @@ func (w *logRouter) currentSnapshot() (ConfigSnapshot, error) {
 	switch {
 	case w.config.DrainOnly:
 		snapshot.Mode = BackendTypeDrain
+	case otlp.IsLogEndpoint(snapshot.Endpoint):
+		snapshot.Mode = BackendTypeOTLP
 	case snapshot.Endpoint != "":
 		snapshot.Mode = BackendTypeLoki
 	default:
@@ -663,7 +677,7 @@ func (w *logRouter) replayPendingRecords(ctx context.Context, records logsender.
 
 func (w *logRouter) manageLegacyLogSinkWriter(ctx context.Context, next ConfigSnapshot) {
 	switch next.Mode {
-	case BackendTypeLoki:
+	case BackendTypeLoki, BackendTypeOTLP:
 		w.config.RemoveLegacyLogSinkWriter()
 	case BackendTypeLogSink, BackendTypeDrain:
 		if err := w.config.AddLegacyLogSinkWriter(); err != nil {
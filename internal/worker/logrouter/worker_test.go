@@ -62,6 +62,42 @@ func (s *workerSuite) TestStartsLogSinkWhenLokiEndpointEmpty(c *tc.C) {
 	})
 }
 
+func (s *workerSuite) TestStartsOTLPWhenEndpointHasOTLPScheme(c *tc.C) {
+	fixture := newFixture(c, "otlp+https://collector:4318")
+	events := make(chan backendEvent, 10)
+	removeCh := make(chan struct{}, 1)
+
+	w, err := NewWorker(WorkerConfig{
+		LokiConfigProvider:        fixture.agent,
+		LogSource:                 fixture.logs,
+		AgentConfigChanged:        fixture.configChanged,
+		Logger:                    internallogger.GetLogger("juju.worker.logrouter.test"),
+		Clock:                     clock.WallClock,
+		ConvergeTimeout:           defaultConvergeTimeout,
+		RestartDelay:              time.Millisecond * 10,
+		NewBackend:                recordingBackendFunc(events, defaultBackendBufferSize),
+		RemoveLegacyLogSinkWriter: func() { removeCh <- struct{}{} },
+		AddLegacyLogSinkWriter:    func() error { return nil },
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	waitForEvents(c, events, backendEvent{
+		backend: "drain-only",
+		kind:    "start",
+	}, backendEvent{
+		backend: "otlp",
+		kind:    "start",
+	})
+
+	// Like Loki, the OTLP backend replaces the legacy logsink writer.
+	select {
+	case <-removeCh:
+	case <-c.Context().Done():
+		c.Fatal("timed out waiting for RemoveLegacyLogSinkWriter callback")
+	}
+}
+
 func (s *workerSuite) TestSwitchStopsOldBackendAndStartsNew(c *tc.C) {
 	fixture := newFixture(c, "")
 	events := make(chan backendEvent, 20)
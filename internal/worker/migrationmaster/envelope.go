@@ -155,6 +155,15 @@ func envelopeFromControllerModelInfo(
 			Access:      perm.Access,
 		})
 	}
+	for _, perm := range info.GroupPermissions {
+		envelope.GroupPermissions = append(envelope.GroupPermissions, params.ModelGroupPermission{
+			ObjectType:     perm.ObjectType,
+			GrantOn:        perm.GrantOn,
+			GroupName:      perm.GroupName,
+			GroupCreatedBy: perm.GroupCreatedBy,
+			Access:         perm.Access,
+		})
+	}
 	for _, key := range info.AuthorizedKeys {
 		envelope.AuthorizedKeys = append(envelope.AuthorizedKeys, params.ModelAuthorizedKey{
 			Username:  key.Username,
@@ -249,6 +258,8 @@ func charmSchemaFromSource(source applicationcharm.CharmSource) (string, error)
 		return deploymentcharm.CharmHub.String(), nil
 	case applicationcharm.LocalSource:
 		return deploymentcharm.Local.String(), nil
+	case applicationcharm.OCISource:
+		return deploymentcharm.OCI.String(), nil
 	default:
 		return "", errors.Errorf("unsupported charm source %q", source)
 	}
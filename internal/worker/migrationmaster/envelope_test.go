@@ -75,6 +75,13 @@ func (*EnvelopeSuite) TestEnvelopeFromControllerModelInfo(c *tc.C) {
 			SubjectName: "fred",
 			Access:      "consume",
 		}},
+		GroupPermissions: []modelmigration.ModelGroupPermission{{
+			ObjectType:     "model",
+			GrantOn:        "model-uuid",
+			GroupName:      "ops",
+			GroupCreatedBy: "fred",
+			Access:         "write",
+		}},
 		AuthorizedKeys: []modelmigration.ModelAuthorizedKey{{
 			Username:  "fred",
 			PublicKey: "ssh-rsa AAAA",
@@ -156,6 +163,13 @@ func (*EnvelopeSuite) TestEnvelopeFromControllerModelInfo(c *tc.C) {
 			SubjectName: "fred",
 			Access:      "consume",
 		}},
+		GroupPermissions: []params.ModelGroupPermission{{
+			ObjectType:     "model",
+			GrantOn:        "model-uuid",
+			GroupName:      "ops",
+			GroupCreatedBy: "fred",
+			Access:         "write",
+		}},
 		AuthorizedKeys: []params.ModelAuthorizedKey{{
 			Username:  "fred",
 			PublicKey: "ssh-rsa AAAA",
@@ -0,0 +1,14 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package modelmetrics provides a worker that reports the workload state of
+// every model on the controller as Prometheus metrics.
+//
+// The worker periodically gathers, for each model, the number of
+// applications and secrets, the number of units by workload and agent
+// status, the number of machines by instance status and the number of
+// operation tasks by status. The snapshot is exposed through a collector
+// registered with the controller agent's Prometheus registry, so it is
+// served alongside the other controller metrics and can be scraped by the
+// metrics users managed through the control socket.
+package modelmetrics
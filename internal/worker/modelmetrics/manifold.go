@@ -0,0 +1,137 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+	"github.com/prometheus/client_golang/prometheus"
+
+	coredependency "github.com/juju/juju/core/dependency"
+	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/services"
+	"github.com/juju/juju/internal/worker/common"
+)
+
+// DefaultInterval is the time between gathering the model snapshots
+// reported by the collector.
+const DefaultInterval = time.Minute
+
+// ManifoldConfig describes the dependencies required by the model metrics
+// worker.
+type ManifoldConfig struct {
+	DomainServicesName string
+
+	Clock                   clock.Clock
+	Logger                  logger.Logger
+	Interval                time.Duration
+	PrometheusRegisterer    prometheus.Registerer
+	NewWorker               func(Config) (worker.Worker, error)
+	NewMetricsCollector     func() *Collector
+	GetDomainServicesGetter func(dependency.Getter, string) (services.DomainServicesGetter, error)
+}
+
+// Validate is called by start to check for bad configuration.
+func (cfg ManifoldConfig) Validate() error {
+	if cfg.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if cfg.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if cfg.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if cfg.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	if cfg.PrometheusRegisterer == nil {
+		return errors.NotValidf("nil PrometheusRegisterer")
+	}
+	if cfg.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	if cfg.NewMetricsCollector == nil {
+		return errors.NotValidf("nil NewMetricsCollector")
+	}
+	if cfg.GetDomainServicesGetter == nil {
+		return errors.NotValidf("nil GetDomainServicesGetter")
+	}
+	return nil
+}
+
+// Manifold returns a Manifold that encapsulates the model metrics worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+// start is a StartFunc for a Worker manifold.
+func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var controllerDomainServices services.ControllerDomainServices
+	if err := getter.Get(cfg.DomainServicesName, &controllerDomainServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+	domainServicesGetter, err := cfg.GetDomainServicesGetter(getter, cfg.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	collector := cfg.NewMetricsCollector()
+	if err := cfg.PrometheusRegisterer.Register(collector); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := cfg.NewWorker(Config{
+		ModelService:     controllerDomainServices.Model(),
+		GetModelServices: modelServicesGetter(domainServicesGetter),
+		Collector:        collector,
+		Interval:         cfg.Interval,
+		Clock:            cfg.Clock,
+		Logger:           cfg.Logger,
+	})
+	if err != nil {
+		cfg.PrometheusRegisterer.Unregister(collector)
+		return nil, errors.Trace(err)
+	}
+	return common.NewCleanupWorker(w, func() {
+		cfg.PrometheusRegisterer.Unregister(collector)
+	}), nil
+}
+
+// GetDomainServicesGetter retrieves the domain services getter from the
+// dependency getter.
+func GetDomainServicesGetter(getter dependency.Getter, name string) (services.DomainServicesGetter, error) {
+	return coredependency.GetDependencyByName(getter, name, func(s services.DomainServicesGetter) services.DomainServicesGetter {
+		return s
+	})
+}
+
+func modelServicesGetter(domainServicesGetter services.DomainServicesGetter) GetModelServicesFunc {
+	return func(ctx context.Context, modelUUID coremodel.UUID) (ModelServices, error) {
+		domainServices, err := domainServicesGetter.ServicesForModel(ctx, modelUUID)
+		if err != nil {
+			return ModelServices{}, errors.Trace(err)
+		}
+		return ModelServices{
+			Status:    domainServices.Status(),
+			Operation: domainServices.Operation(),
+			Secret:    domainServices.Secret(),
+		}, nil
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	modelMetricsNamespace   = "juju"
+	modelSubsystemNamespace = "model"
+)
+
+const (
+	// MetricLabelModelUUID defines a constant for the model uuid label.
+	// Note: prometheus doesn't allow hyphens only underscores
+	MetricLabelModelUUID = "model_uuid"
+
+	// MetricLabelModelName defines a constant for the model name label.
+	MetricLabelModelName = "model_name"
+
+	// MetricLabelStatus defines a constant for the status label.
+	MetricLabelStatus = "status"
+)
+
+var (
+	modelLabelNames       = []string{MetricLabelModelUUID, MetricLabelModelName}
+	modelStatusLabelNames = []string{MetricLabelModelUUID, MetricLabelModelName, MetricLabelStatus}
+)
+
+var (
+	applicationsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(modelMetricsNamespace, modelSubsystemNamespace, "applications"),
+		"Number of applications in the model.",
+		modelLabelNames, nil,
+	)
+	unitWorkloadStatusDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(modelMetricsNamespace, modelSubsystemNamespace, "units_by_workload_status"),
+		"Number of units in the model by workload status.",
+		modelStatusLabelNames, nil,
+	)
+	unitAgentStatusDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(modelMetricsNamespace, modelSubsystemNamespace, "units_by_agent_status"),
+		"Number of units in the model by agent status.",
+		modelStatusLabelNames, nil,
+	)
+	machineInstanceStatusDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(modelMetricsNamespace, modelSubsystemNamespace, "machines_by_instance_status"),
+		"Number of machines in the model by instance status.",
+		modelStatusLabelNames, nil,
+	)
+	operationTasksDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(modelMetricsNamespace, modelSubsystemNamespace, "operation_tasks"),
+		"Number of operation tasks in the model by status.",
+		modelStatusLabelNames, nil,
+	)
+	secretsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(modelMetricsNamespace, modelSubsystemNamespace, "secrets"),
+		"Number of secrets in the model.",
+		modelLabelNames, nil,
+	)
+)
+
+// ModelSnapshot holds the workload state of a single model at the time it
+// was last gathered.
+type ModelSnapshot struct {
+	UUID string
+	Name string
+
+	Applications int
+	Secrets      int
+
+	// The following map a status to the number of entities with it.
+	UnitWorkloadStatuses    map[string]int
+	UnitAgentStatuses       map[string]int
+	MachineInstanceStatuses map[string]int
+	OperationTasks          map[string]int
+}
+
+// Collector is a prometheus.Collector that reports the workload state of
+// every model on the controller. The values are read from the most recent
+// snapshots gathered by the worker, so that scrapes never hit the database.
+type Collector struct {
+	mu        sync.Mutex
+	snapshots []ModelSnapshot
+}
+
+// NewMetricsCollector returns a new Collector.
+func NewMetricsCollector() *Collector {
+	return &Collector{}
+}
+
+// setSnapshots replaces the snapshots reported by the collector. Models that
+// are not included are no longer reported.
+func (c *Collector) setSnapshots(snapshots []ModelSnapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.snapshots = snapshots
+}
+
+// Describe is part of the prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- applicationsDesc
+	ch <- unitWorkloadStatusDesc
+	ch <- unitAgentStatusDesc
+	ch <- machineInstanceStatusDesc
+	ch <- operationTasksDesc
+	ch <- secretsDesc
+}
+
+// Collect is part of the prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, snapshot := range c.snapshots {
+		ch <- prometheus.MustNewConstMetric(
+			applicationsDesc,
+			prometheus.GaugeValue,
+			float64(snapshot.Applications),
+			snapshot.UUID, snapshot.Name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			secretsDesc,
+			prometheus.GaugeValue,
+			float64(snapshot.Secrets),
+			snapshot.UUID, snapshot.Name,
+		)
+		collectStatusCounts(ch, unitWorkloadStatusDesc, snapshot, snapshot.UnitWorkloadStatuses)
+		collectStatusCounts(ch, unitAgentStatusDesc, snapshot, snapshot.UnitAgentStatuses)
+		collectStatusCounts(ch, machineInstanceStatusDesc, snapshot, snapshot.MachineInstanceStatuses)
+		collectStatusCounts(ch, operationTasksDesc, snapshot, snapshot.OperationTasks)
+	}
+}
+
+func collectStatusCounts(ch chan<- prometheus.Metric, desc *prometheus.Desc, snapshot ModelSnapshot, counts map[string]int) {
+	for status, count := range counts {
+		ch <- prometheus.MustNewConstMetric(
+			desc,
+			prometheus.GaugeValue,
+			float64(count),
+			snapshot.UUID, snapshot.Name, status,
+		)
+	}
+}
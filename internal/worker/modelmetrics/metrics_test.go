@@ -0,0 +1,64 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetrics
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/juju/tc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type metricsSuite struct{}
+
+func TestMetricsSuite(t *testing.T) { tc.Run(t, &metricsSuite{}) }
+
+func (s *metricsSuite) TestCollect(c *tc.C) {
+	collector := NewMetricsCollector()
+	collector.setSnapshots([]ModelSnapshot{{
+		UUID:                    "model-uuid",
+		Name:                    "prod",
+		Applications:            2,
+		Secrets:                 4,
+		UnitWorkloadStatuses:    map[string]int{"active": 2, "blocked": 1},
+		UnitAgentStatuses:       map[string]int{"idle": 3},
+		MachineInstanceStatuses: map[string]int{"running": 2},
+		OperationTasks:          map[string]int{"pending": 5},
+	}})
+
+	expected := bytes.NewBufferString(`
+# HELP juju_model_applications Number of applications in the model.
+# TYPE juju_model_applications gauge
+juju_model_applications{model_name="prod",model_uuid="model-uuid"} 2
+# HELP juju_model_machines_by_instance_status Number of machines in the model by instance status.
+# TYPE juju_model_machines_by_instance_status gauge
+juju_model_machines_by_instance_status{model_name="prod",model_uuid="model-uuid",status="running"} 2
+# HELP juju_model_operation_tasks Number of operation tasks in the model by status.
+# TYPE juju_model_operation_tasks gauge
+juju_model_operation_tasks{model_name="prod",model_uuid="model-uuid",status="pending"} 5
+# HELP juju_model_secrets Number of secrets in the model.
+# TYPE juju_model_secrets gauge
+juju_model_secrets{model_name="prod",model_uuid="model-uuid"} 4
+# HELP juju_model_units_by_agent_status Number of units in the model by agent status.
+# TYPE juju_model_units_by_agent_status gauge
+juju_model_units_by_agent_status{model_name="prod",model_uuid="model-uuid",status="idle"} 3
+# HELP juju_model_units_by_workload_status Number of units in the model by workload status.
+# TYPE juju_model_units_by_workload_status gauge
+juju_model_units_by_workload_status{model_name="prod",model_uuid="model-uuid",status="active"} 2
+juju_model_units_by_workload_status{model_name="prod",model_uuid="model-uuid",status="blocked"} 1
+`[1:])
+
+	err := testutil.CollectAndCompare(collector, expected)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *metricsSuite) TestCollectRemovedModel(c *tc.C) {
+	collector := NewMetricsCollector()
+	collector.setSnapshots([]ModelSnapshot{{UUID: "model-uuid", Name: "prod"}})
+	c.Check(testutil.CollectAndCount(collector), tc.Equals, 2)
+
+	collector.setSnapshots(nil)
+	c.Check(testutil.CollectAndCount(collector), tc.Equals, 0)
+}
@@ -0,0 +1,6 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetrics
+
+//go:generate go run github.com/canonical/gomock/mockgen -package modelmetrics -destination services_mock_test.go github.com/juju/juju/internal/worker/modelmetrics ModelService,StatusService,OperationService,SecretService
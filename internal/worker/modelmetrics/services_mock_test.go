@@ -0,0 +1,230 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/modelmetrics (interfaces: ModelService,StatusService,OperationService,SecretService)
+//
+// Generated by this command:
+//
+//	mockgen -package modelmetrics -destination services_mock_test.go github.com/juju/juju/internal/worker/modelmetrics ModelService,StatusService,OperationService,SecretService
+//
+
+// Package modelmetrics is a generated GoMock package.
+package modelmetrics
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	machine "github.com/juju/juju/core/machine"
+	model "github.com/juju/juju/core/model"
+	status "github.com/juju/juju/core/status"
+	unit "github.com/juju/juju/core/unit"
+)
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock                *MockModelService
+	getAllModelsExpects []*gomock.Call1_2[context.Context, []model.Model, error]
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllModels mocks base method.
+func (m *MockModelService) GetAllModels(ctx context.Context) ([]model.Model, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getAllModelsExpects, m.ctrl, m, "GetAllModels", ctx)
+}
+
+// GetAllModels indicates an expected call of GetAllModels.
+func (mr *MockModelServiceMockRecorder) GetAllModels(ctx any) *MockModelServiceGetAllModelsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []model.Model, error](mr.mock.ctrl.T, mr.mock, "GetAllModels", gomock.EnsureMatcher(ctx))
+	mr.getAllModelsExpects = append(mr.getAllModelsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockModelServiceGetAllModelsCall is the typed call wrapper for GetAllModels.
+type MockModelServiceGetAllModelsCall = gomock.Call1_2[context.Context, []model.Model, error]
+
+// MockStatusService is a mock of StatusService interface.
+type MockStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
+type MockStatusServiceMockRecorder struct {
+	mock                                      *MockStatusService
+	exportMachineStatusesExpects              []*gomock.Call1_3[context.Context, map[machine.Name]status.StatusInfo, map[machine.Name]status.StatusInfo, error]
+	exportUnitStatusesExpects                 []*gomock.Call1_3[context.Context, map[unit.Name]status.StatusInfo, map[unit.Name]status.StatusInfo, error]
+	getApplicationAndUnitModelStatusesExpects []*gomock.Call1_2[context.Context, map[string]int, error]
+}
+
+// NewMockStatusService creates a new mock instance.
+func NewMockStatusService(ctrl *gomock.Controller) *MockStatusService {
+	mock := &MockStatusService{ctrl: ctrl}
+	mock.recorder = &MockStatusServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusService) EXPECT() *MockStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportMachineStatuses mocks base method.
+func (m *MockStatusService) ExportMachineStatuses(ctx context.Context) (map[machine.Name]status.StatusInfo, map[machine.Name]status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_3(&m.recorder.exportMachineStatusesExpects, m.ctrl, m, "ExportMachineStatuses", ctx)
+}
+
+// ExportMachineStatuses indicates an expected call of ExportMachineStatuses.
+func (mr *MockStatusServiceMockRecorder) ExportMachineStatuses(ctx any) *MockStatusServiceExportMachineStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_3[context.Context, map[machine.Name]status.StatusInfo, map[machine.Name]status.StatusInfo, error](mr.mock.ctrl.T, mr.mock, "ExportMachineStatuses", gomock.EnsureMatcher(ctx))
+	mr.exportMachineStatusesExpects = append(mr.exportMachineStatusesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceExportMachineStatusesCall is the typed call wrapper for ExportMachineStatuses.
+type MockStatusServiceExportMachineStatusesCall = gomock.Call1_3[context.Context, map[machine.Name]status.StatusInfo, map[machine.Name]status.StatusInfo, error]
+
+// ExportUnitStatuses mocks base method.
+func (m *MockStatusService) ExportUnitStatuses(ctx context.Context) (map[unit.Name]status.StatusInfo, map[unit.Name]status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_3(&m.recorder.exportUnitStatusesExpects, m.ctrl, m, "ExportUnitStatuses", ctx)
+}
+
+// ExportUnitStatuses indicates an expected call of ExportUnitStatuses.
+func (mr *MockStatusServiceMockRecorder) ExportUnitStatuses(ctx any) *MockStatusServiceExportUnitStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_3[context.Context, map[unit.Name]status.StatusInfo, map[unit.Name]status.StatusInfo, error](mr.mock.ctrl.T, mr.mock, "ExportUnitStatuses", gomock.EnsureMatcher(ctx))
+	mr.exportUnitStatusesExpects = append(mr.exportUnitStatusesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceExportUnitStatusesCall is the typed call wrapper for ExportUnitStatuses.
+type MockStatusServiceExportUnitStatusesCall = gomock.Call1_3[context.Context, map[unit.Name]status.StatusInfo, map[unit.Name]status.StatusInfo, error]
+
+// GetApplicationAndUnitModelStatuses mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitModelStatuses(ctx context.Context) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getApplicationAndUnitModelStatusesExpects, m.ctrl, m, "GetApplicationAndUnitModelStatuses", ctx)
+}
+
+// GetApplicationAndUnitModelStatuses indicates an expected call of GetApplicationAndUnitModelStatuses.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitModelStatuses(ctx any) *MockStatusServiceGetApplicationAndUnitModelStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[string]int, error](mr.mock.ctrl.T, mr.mock, "GetApplicationAndUnitModelStatuses", gomock.EnsureMatcher(ctx))
+	mr.getApplicationAndUnitModelStatusesExpects = append(mr.getApplicationAndUnitModelStatusesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockStatusServiceGetApplicationAndUnitModelStatusesCall is the typed call wrapper for GetApplicationAndUnitModelStatuses.
+type MockStatusServiceGetApplicationAndUnitModelStatusesCall = gomock.Call1_2[context.Context, map[string]int, error]
+
+// MockOperationService is a mock of OperationService interface.
+type MockOperationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOperationServiceMockRecorder is the mock recorder for MockOperationService.
+type MockOperationServiceMockRecorder struct {
+	mock                         *MockOperationService
+	getTaskCountsByStatusExpects []*gomock.Call1_2[context.Context, map[status.Status]int, error]
+}
+
+// NewMockOperationService creates a new mock instance.
+func NewMockOperationService(ctrl *gomock.Controller) *MockOperationService {
+	mock := &MockOperationService{ctrl: ctrl}
+	mock.recorder = &MockOperationServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationService) EXPECT() *MockOperationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetTaskCountsByStatus mocks base method.
+func (m *MockOperationService) GetTaskCountsByStatus(ctx context.Context) (map[status.Status]int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getTaskCountsByStatusExpects, m.ctrl, m, "GetTaskCountsByStatus", ctx)
+}
+
+// GetTaskCountsByStatus indicates an expected call of GetTaskCountsByStatus.
+func (mr *MockOperationServiceMockRecorder) GetTaskCountsByStatus(ctx any) *MockOperationServiceGetTaskCountsByStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, map[status.Status]int, error](mr.mock.ctrl.T, mr.mock, "GetTaskCountsByStatus", gomock.EnsureMatcher(ctx))
+	mr.getTaskCountsByStatusExpects = append(mr.getTaskCountsByStatusExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOperationServiceGetTaskCountsByStatusCall is the typed call wrapper for GetTaskCountsByStatus.
+type MockOperationServiceGetTaskCountsByStatusCall = gomock.Call1_2[context.Context, map[status.Status]int, error]
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock                *MockSecretService
+	countSecretsExpects []*gomock.Call1_2[context.Context, int, error]
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// CountSecrets mocks base method.
+func (m *MockSecretService) CountSecrets(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.countSecretsExpects, m.ctrl, m, "CountSecrets", ctx)
+}
+
+// CountSecrets indicates an expected call of CountSecrets.
+func (mr *MockSecretServiceMockRecorder) CountSecrets(ctx any) *MockSecretServiceCountSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, int, error](mr.mock.ctrl.T, mr.mock, "CountSecrets", gomock.EnsureMatcher(ctx))
+	mr.countSecretsExpects = append(mr.countSecretsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockSecretServiceCountSecretsCall is the typed call wrapper for CountSecrets.
+type MockSecretServiceCountSecretsCall = gomock.Call1_2[context.Context, int, error]
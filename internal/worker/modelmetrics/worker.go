@@ -0,0 +1,234 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/core/logger"
+	coremachine "github.com/juju/juju/core/machine"
+	coremodel "github.com/juju/juju/core/model"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+)
+
+// ModelService provides access to the models on the controller.
+type ModelService interface {
+	// GetAllModels returns all the models on the controller.
+	GetAllModels(ctx context.Context) ([]coremodel.Model, error)
+}
+
+// StatusService provides access to the statuses of the entities in a model.
+type StatusService interface {
+	// GetApplicationAndUnitModelStatuses returns the application name and
+	// unit count for each application in the model.
+	GetApplicationAndUnitModelStatuses(ctx context.Context) (map[string]int, error)
+
+	// ExportUnitStatuses returns the workload and agent statuses of all the
+	// units in the model, indexed by unit name.
+	ExportUnitStatuses(ctx context.Context) (map[coreunit.Name]corestatus.StatusInfo, map[coreunit.Name]corestatus.StatusInfo, error)
+
+	// ExportMachineStatuses returns the statuses of all the machines and
+	// their instances in the model, indexed by machine name.
+	ExportMachineStatuses(ctx context.Context) (map[coremachine.Name]corestatus.StatusInfo, map[coremachine.Name]corestatus.StatusInfo, error)
+}
+
+// OperationService provides access to the operations in a model.
+type OperationService interface {
+	// GetTaskCountsByStatus returns the number of tasks in the model with
+	// each status.
+	GetTaskCountsByStatus(ctx context.Context) (map[corestatus.Status]int, error)
+}
+
+// SecretService provides access to the secrets in a model.
+type SecretService interface {
+	// CountSecrets returns the number of secrets in the model.
+	CountSecrets(ctx context.Context) (int, error)
+}
+
+// ModelServices holds the services used to gather the metrics of a model.
+type ModelServices struct {
+	Status    StatusService
+	Operation OperationService
+	Secret    SecretService
+}
+
+// GetModelServicesFunc returns the services used to gather the metrics of
+// the given model.
+type GetModelServicesFunc func(context.Context, coremodel.UUID) (ModelServices, error)
+
+// Config holds the configuration for the model metrics worker.
+type Config struct {
+	// ModelService lists the models on the controller.
+	ModelService ModelService
+	// GetModelServices returns the services for an individual model.
+	GetModelServices GetModelServicesFunc
+	// Collector is updated with the gathered model snapshots.
+	Collector *Collector
+	// Interval is the time between gathering snapshots.
+	Interval time.Duration
+	Clock    clock.Clock
+	Logger   logger.Logger
+}
+
+// Validate returns an error if the config cannot be used to start a worker.
+func (config Config) Validate() error {
+	if config.ModelService == nil {
+		return errors.NotValidf("nil ModelService")
+	}
+	if config.GetModelServices == nil {
+		return errors.NotValidf("nil GetModelServices")
+	}
+	if config.Collector == nil {
+		return errors.NotValidf("nil Collector")
+	}
+	if config.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+type modelMetricsWorker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// NewWorker returns a worker that periodically gathers the workload state of
+// every model on the controller and reports it through the collector.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &modelMetricsWorker{
+		config: config,
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "model-metrics",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *modelMetricsWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *modelMetricsWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *modelMetricsWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	// Gather the first snapshots straight away, so that the metrics are
+	// available as soon as the controller starts.
+	timer := w.config.Clock.After(0)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer:
+			snapshots, err := w.gatherSnapshots(ctx)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			w.config.Collector.setSnapshots(snapshots)
+			timer = w.config.Clock.After(w.config.Interval)
+		}
+	}
+}
+
+// gatherSnapshots returns a snapshot of every model on the controller.
+// Models whose state cannot be read are left out and retried on the next
+// pass, rather than stopping the metrics of every other model.
+func (w *modelMetricsWorker) gatherSnapshots(ctx context.Context) ([]ModelSnapshot, error) {
+	models, err := w.config.ModelService.GetAllModels(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting models")
+	}
+
+	snapshots := make([]ModelSnapshot, 0, len(models))
+	for _, model := range models {
+		snapshot, err := w.gatherSnapshot(ctx, model)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, w.catacomb.ErrDying()
+			}
+			w.config.Logger.Warningf(ctx, "gathering metrics for model %q: %v", model.UUID, err)
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (w *modelMetricsWorker) gatherSnapshot(ctx context.Context, model coremodel.Model) (ModelSnapshot, error) {
+	services, err := w.config.GetModelServices(ctx, model.UUID)
+	if err != nil {
+		return ModelSnapshot{}, errors.Trace(err)
+	}
+
+	applications, err := services.Status.GetApplicationAndUnitModelStatuses(ctx)
+	if err != nil {
+		return ModelSnapshot{}, errors.Annotate(err, "getting applications")
+	}
+	workloadStatuses, agentStatuses, err := services.Status.ExportUnitStatuses(ctx)
+	if err != nil {
+		return ModelSnapshot{}, errors.Annotate(err, "getting unit statuses")
+	}
+	_, instanceStatuses, err := services.Status.ExportMachineStatuses(ctx)
+	if err != nil {
+		return ModelSnapshot{}, errors.Annotate(err, "getting machine statuses")
+	}
+	taskCounts, err := services.Operation.GetTaskCountsByStatus(ctx)
+	if err != nil {
+		return ModelSnapshot{}, errors.Annotate(err, "getting operation tasks")
+	}
+	secrets, err := services.Secret.CountSecrets(ctx)
+	if err != nil {
+		return ModelSnapshot{}, errors.Annotate(err, "counting secrets")
+	}
+
+	operationTasks := make(map[string]int, len(taskCounts))
+	for status, count := range taskCounts {
+		operationTasks[status.String()] = count
+	}
+	return ModelSnapshot{
+		UUID:                    model.UUID.String(),
+		Name:                    model.Name,
+		Applications:            len(applications),
+		Secrets:                 secrets,
+		UnitWorkloadStatuses:    countStatuses(workloadStatuses),
+		UnitAgentStatuses:       countStatuses(agentStatuses),
+		MachineInstanceStatuses: countStatuses(instanceStatuses),
+		OperationTasks:          operationTasks,
+	}, nil
+}
+
+// countStatuses returns the number of entities with each status.
+func countStatuses[K comparable](statuses map[K]corestatus.StatusInfo) map[string]int {
+	counts := make(map[string]int)
+	for _, info := range statuses {
+		counts[info.Status.String()]++
+	}
+	return counts
+}
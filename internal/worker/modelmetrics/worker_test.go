@@ -0,0 +1,209 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmetrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	coremachine "github.com/juju/juju/core/machine"
+	coremodel "github.com/juju/juju/core/model"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type workerSuite struct {
+	modelService     *MockModelService
+	statusService    *MockStatusService
+	operationService *MockOperationService
+	secretService    *MockSecretService
+
+	clock     *testclock.Clock
+	collector *Collector
+}
+
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+func (s *workerSuite) TestConfigValidation(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.ModelService = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil ModelService not valid")
+
+	bad = cfg
+	bad.GetModelServices = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil GetModelServices not valid")
+
+	bad = cfg
+	bad.Collector = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Collector not valid")
+
+	bad = cfg
+	bad.Interval = 0
+	c.Check(bad.Validate(), tc.ErrorMatches, "non-positive Interval not valid")
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Clock not valid")
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Logger not valid")
+}
+
+func (s *workerSuite) TestGatherSnapshots(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := tc.Must0(c, coremodel.NewUUID)
+	s.modelService.EXPECT().GetAllModels(gomock.Any()).Return([]coremodel.Model{{
+		UUID: modelUUID,
+		Name: "prod",
+	}}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitModelStatuses(gomock.Any()).Return(map[string]int{
+		"foo": 2,
+		"bar": 1,
+	}, nil)
+	s.statusService.EXPECT().ExportUnitStatuses(gomock.Any()).Return(
+		map[coreunit.Name]corestatus.StatusInfo{
+			"foo/0": {Status: corestatus.Active},
+			"foo/1": {Status: corestatus.Blocked},
+			"bar/0": {Status: corestatus.Active},
+		},
+		map[coreunit.Name]corestatus.StatusInfo{
+			"foo/0": {Status: corestatus.Idle},
+			"foo/1": {Status: corestatus.Idle},
+			"bar/0": {Status: corestatus.Executing},
+		},
+		nil,
+	)
+	s.statusService.EXPECT().ExportMachineStatuses(gomock.Any()).Return(
+		map[coremachine.Name]corestatus.StatusInfo{
+			"0": {Status: corestatus.Started},
+		},
+		map[coremachine.Name]corestatus.StatusInfo{
+			"0": {Status: corestatus.Running},
+		},
+		nil,
+	)
+	s.operationService.EXPECT().GetTaskCountsByStatus(gomock.Any()).Return(map[corestatus.Status]int{
+		corestatus.Pending: 3,
+	}, nil)
+	s.secretService.EXPECT().CountSecrets(gomock.Any()).Return(4, nil)
+
+	w := s.newWorker(c)
+	snapshots, err := w.gatherSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshots, tc.DeepEquals, []ModelSnapshot{{
+		UUID:                    modelUUID.String(),
+		Name:                    "prod",
+		Applications:            2,
+		Secrets:                 4,
+		UnitWorkloadStatuses:    map[string]int{"active": 2, "blocked": 1},
+		UnitAgentStatuses:       map[string]int{"idle": 2, "executing": 1},
+		MachineInstanceStatuses: map[string]int{"running": 1},
+		OperationTasks:          map[string]int{"pending": 3},
+	}})
+}
+
+func (s *workerSuite) TestGatherSnapshotsSkipsFailedModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelService.EXPECT().GetAllModels(gomock.Any()).Return([]coremodel.Model{{
+		UUID: tc.Must0(c, coremodel.NewUUID),
+		Name: "prod",
+	}}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitModelStatuses(gomock.Any()).Return(nil, errors.New("boom"))
+
+	w := s.newWorker(c)
+	snapshots, err := w.gatherSnapshots(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(snapshots, tc.HasLen, 0)
+}
+
+func (s *workerSuite) TestGatherSnapshotsModelsError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelService.EXPECT().GetAllModels(gomock.Any()).Return(nil, errors.New("boom"))
+
+	w := s.newWorker(c)
+	_, err := w.gatherSnapshots(c.Context())
+	c.Assert(err, tc.ErrorMatches, "getting models: boom")
+}
+
+func (s *workerSuite) TestUpdatesCollectorOnInterval(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	gathered := make(chan struct{}, 2)
+	s.modelService.EXPECT().GetAllModels(gomock.Any()).DoAndReturn(func(context.Context) ([]coremodel.Model, error) {
+		gathered <- struct{}{}
+		return nil, nil
+	}).Times(2)
+
+	w, err := NewWorker(s.newConfig(c))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The first snapshot is gathered straight away.
+	s.waitGathered(c, gathered)
+
+	// The next one once the interval has elapsed.
+	err = s.clock.WaitAdvance(time.Minute, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	s.waitGathered(c, gathered)
+}
+
+func (s *workerSuite) waitGathered(c *tc.C, gathered <-chan struct{}) {
+	select {
+	case <-gathered:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for snapshots to be gathered")
+	}
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.modelService = NewMockModelService(ctrl)
+	s.statusService = NewMockStatusService(ctrl)
+	s.operationService = NewMockOperationService(ctrl)
+	s.secretService = NewMockSecretService(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	s.collector = NewMetricsCollector()
+	return ctrl
+}
+
+func (s *workerSuite) newConfig(c *tc.C) Config {
+	return Config{
+		ModelService: s.modelService,
+		GetModelServices: func(context.Context, coremodel.UUID) (ModelServices, error) {
+			return ModelServices{
+				Status:    s.statusService,
+				Operation: s.operationService,
+				Secret:    s.secretService,
+			}, nil
+		},
+		Collector: s.collector,
+		Interval:  time.Minute,
+		Clock:     s.clock,
+		Logger:    loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *workerSuite) newWorker(c *tc.C) *modelMetricsWorker {
+	return &modelMetricsWorker{
+		config: s.newConfig(c),
+	}
+}
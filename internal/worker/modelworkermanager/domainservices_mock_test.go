@@ -20,52 +20,56 @@ import (
 	service2 "github.com/juju/juju/domain/agentprovisioner/service"
 	service3 "github.com/juju/juju/domain/annotation/service"
 	service4 "github.com/juju/juju/domain/application/service"
-	service5 "github.com/juju/juju/domain/autocert/service"
-	service6 "github.com/juju/juju/domain/blockcommand/service"
-	service7 "github.com/juju/juju/domain/blockdevice/service"
-	service8 "github.com/juju/juju/domain/changestream/service"
-	service9 "github.com/juju/juju/domain/cloud/service"
-	service10 "github.com/juju/juju/domain/cloudimagemetadata/service"
-	service11 "github.com/juju/juju/domain/controller/service"
-	service12 "github.com/juju/juju/domain/controllerconfig/service"
-	service13 "github.com/juju/juju/domain/controllernode/service"
-	service14 "github.com/juju/juju/domain/controllerupgrader/service"
-	service15 "github.com/juju/juju/domain/credential/service"
-	service16 "github.com/juju/juju/domain/crossmodelrelation/service"
-	service17 "github.com/juju/juju/domain/export/service"
-	service18 "github.com/juju/juju/domain/externalcontroller/service"
-	service19 "github.com/juju/juju/domain/flag/service"
-	service20 "github.com/juju/juju/domain/keymanager/service"
-	service21 "github.com/juju/juju/domain/keyupdater/service"
-	service22 "github.com/juju/juju/domain/logging/service"
-	service23 "github.com/juju/juju/domain/macaroon/service"
-	service24 "github.com/juju/juju/domain/machine/service"
-	service25 "github.com/juju/juju/domain/model/service"
-	service26 "github.com/juju/juju/domain/modelagent/service"
-	service27 "github.com/juju/juju/domain/modelconfig/service"
-	service28 "github.com/juju/juju/domain/modeldefaults/service"
-	service29 "github.com/juju/juju/domain/modelmigration/service"
-	service30 "github.com/juju/juju/domain/modelprovider/service"
-	service31 "github.com/juju/juju/domain/network/service"
-	service32 "github.com/juju/juju/domain/operation/service"
-	service33 "github.com/juju/juju/domain/port/service"
-	service34 "github.com/juju/juju/domain/provisioner/service"
-	service35 "github.com/juju/juju/domain/proxy/service"
-	service36 "github.com/juju/juju/domain/relation/service"
-	service37 "github.com/juju/juju/domain/removal/service"
-	service38 "github.com/juju/juju/domain/resolve/service"
-	service39 "github.com/juju/juju/domain/resource/service"
-	service40 "github.com/juju/juju/domain/secret/service"
-	service41 "github.com/juju/juju/domain/secretbackend/service"
+	service5 "github.com/juju/juju/domain/auditlog/service"
+	service6 "github.com/juju/juju/domain/autocert/service"
+	service7 "github.com/juju/juju/domain/blockcommand/service"
+	service8 "github.com/juju/juju/domain/blockdevice/service"
+	service9 "github.com/juju/juju/domain/changestream/service"
+	service10 "github.com/juju/juju/domain/charmmirror/service"
+	service11 "github.com/juju/juju/domain/charmregistry/service"
+	service12 "github.com/juju/juju/domain/cloud/service"
+	service13 "github.com/juju/juju/domain/cloudimagemetadata/service"
+	service14 "github.com/juju/juju/domain/configsnapshot/service"
+	service15 "github.com/juju/juju/domain/controller/service"
+	service16 "github.com/juju/juju/domain/controllerconfig/service"
+	service17 "github.com/juju/juju/domain/controllernode/service"
+	service18 "github.com/juju/juju/domain/controllerupgrader/service"
+	service19 "github.com/juju/juju/domain/credential/service"
+	service20 "github.com/juju/juju/domain/crossmodelrelation/service"
+	service21 "github.com/juju/juju/domain/export/service"
+	service22 "github.com/juju/juju/domain/externalcontroller/service"
+	service23 "github.com/juju/juju/domain/flag/service"
+	service24 "github.com/juju/juju/domain/keymanager/service"
+	service25 "github.com/juju/juju/domain/keyupdater/service"
+	service26 "github.com/juju/juju/domain/logging/service"
+	service27 "github.com/juju/juju/domain/macaroon/service"
+	service28 "github.com/juju/juju/domain/machine/service"
+	service29 "github.com/juju/juju/domain/model/service"
+	service30 "github.com/juju/juju/domain/modelagent/service"
+	service31 "github.com/juju/juju/domain/modelconfig/service"
+	service32 "github.com/juju/juju/domain/modeldefaults/service"
+	service33 "github.com/juju/juju/domain/modelmigration/service"
+	service34 "github.com/juju/juju/domain/modelprovider/service"
+	service35 "github.com/juju/juju/domain/network/service"
+	service36 "github.com/juju/juju/domain/operation/service"
+	service37 "github.com/juju/juju/domain/port/service"
+	service38 "github.com/juju/juju/domain/provisioner/service"
+	service39 "github.com/juju/juju/domain/proxy/service"
+	service40 "github.com/juju/juju/domain/relation/service"
+	service41 "github.com/juju/juju/domain/removal/service"
+	service42 "github.com/juju/juju/domain/resolve/service"
+	service43 "github.com/juju/juju/domain/resource/service"
+	service44 "github.com/juju/juju/domain/secret/service"
+	service45 "github.com/juju/juju/domain/secretbackend/service"
 	controller "github.com/juju/juju/domain/ssh/service/controller"
 	model0 "github.com/juju/juju/domain/ssh/service/model"
-	service42 "github.com/juju/juju/domain/status/service"
-	service43 "github.com/juju/juju/domain/storage/service"
-	service44 "github.com/juju/juju/domain/storageprovisioning/service"
-	service45 "github.com/juju/juju/domain/tracing/service"
-	service46 "github.com/juju/juju/domain/unitless/service"
-	service47 "github.com/juju/juju/domain/unitstate/service"
-	service48 "github.com/juju/juju/domain/upgrade/service"
+	service46 "github.com/juju/juju/domain/status/service"
+	service47 "github.com/juju/juju/domain/storage/service"
+	service48 "github.com/juju/juju/domain/storageprovisioning/service"
+	service49 "github.com/juju/juju/domain/tracing/service"
+	service50 "github.com/juju/juju/domain/unitless/service"
+	service51 "github.com/juju/juju/domain/unitstate/service"
+	service52 "github.com/juju/juju/domain/upgrade/service"
 	services "github.com/juju/juju/internal/services"
 )
 
@@ -80,63 +84,68 @@ type MockDomainServices struct {
 type MockDomainServicesMockRecorder struct {
 	mock                              *MockDomainServices
 	accessExpects                     []*gomock.Call0_1[*service.Service]
-	agentExpects                      []*gomock.Call0_1[*service26.WatchableService]
+	agentExpects                      []*gomock.Call0_1[*service30.WatchableService]
 	agentBinaryExpects                []*gomock.Call0_1[*service0.AgentBinaryService]
 	agentBinaryStoreExpects           []*gomock.Call0_1[*service0.AgentBinaryStore]
 	agentPasswordExpects              []*gomock.Call0_1[*service1.Service]
 	agentProvisionerExpects           []*gomock.Call0_1[*service2.Service]
 	annotationExpects                 []*gomock.Call0_1[*service3.Service]
 	applicationExpects                []*gomock.Call0_1[*service4.WatchableService]
-	autocertCacheExpects              []*gomock.Call0_1[*service5.Service]
-	blockCommandExpects               []*gomock.Call0_1[*service6.Service]
-	blockDeviceExpects                []*gomock.Call0_1[*service7.WatchableService]
-	changeStreamExpects               []*gomock.Call0_1[*service8.Service]
-	cloudExpects                      []*gomock.Call0_1[*service9.WatchableService]
-	cloudImageMetadataExpects         []*gomock.Call0_1[*service10.Service]
-	configExpects                     []*gomock.Call0_1[*service27.WatchableService]
-	controllerExpects                 []*gomock.Call0_1[*service11.Service]
+	auditLogExpects                   []*gomock.Call0_1[*service5.Service]
+	autocertCacheExpects              []*gomock.Call0_1[*service6.Service]
+	blockCommandExpects               []*gomock.Call0_1[*service7.Service]
+	blockDeviceExpects                []*gomock.Call0_1[*service8.WatchableService]
+	changeStreamExpects               []*gomock.Call0_1[*service9.Service]
+	charmMirrorExpects                []*gomock.Call0_1[*service10.Service]
+	charmRegistryExpects              []*gomock.Call0_1[*service11.Service]
+	cloudExpects                      []*gomock.Call0_1[*service12.WatchableService]
+	cloudImageMetadataExpects         []*gomock.Call0_1[*service13.Service]
+	configExpects                     []*gomock.Call0_1[*service31.WatchableService]
+	configSnapshotExpects             []*gomock.Call0_1[*service14.Service]
+	controllerExpects                 []*gomock.Call0_1[*service15.Service]
 	controllerAgentBinaryStoreExpects []*gomock.Call0_1[*service0.AgentBinaryStore]
-	controllerChangeStreamExpects     []*gomock.Call0_1[*service8.Service]
-	controllerConfigExpects           []*gomock.Call0_1[*service12.WatchableService]
-	controllerNodeExpects             []*gomock.Call0_1[*service13.WatchableService]
-	controllerUpgraderExpects         []*gomock.Call0_1[*service14.Service]
-	credentialExpects                 []*gomock.Call0_1[*service15.WatchableService]
-	crossModelRelationExpects         []*gomock.Call0_1[*service16.WatchableService]
-	exportExpects                     []*gomock.Call0_1[*service17.Service]
-	externalControllerExpects         []*gomock.Call0_1[*service18.WatchableService]
-	flagExpects                       []*gomock.Call0_1[*service19.Service]
-	keyManagerExpects                 []*gomock.Call0_1[*service20.Service]
-	keyManagerWithImporterExpects     []*gomock.Call0_1[*service20.ImporterService]
-	keyUpdaterExpects                 []*gomock.Call0_1[*service21.WatchableService]
-	loggingExpects                    []*gomock.Call0_1[*service22.WatchableService]
-	macaroonExpects                   []*gomock.Call0_1[*service23.Service]
-	machineExpects                    []*gomock.Call0_1[*service24.WatchableService]
-	modelExpects                      []*gomock.Call0_1[*service25.WatchableService]
-	modelDefaultsExpects              []*gomock.Call0_1[*service28.Service]
-	modelInfoExpects                  []*gomock.Call0_1[*service25.ProviderModelService]
-	modelMigrationExpects             []*gomock.Call0_1[*service29.WatchableService]
-	modelProviderExpects              []*gomock.Call0_1[*service30.Service]
-	modelSecretBackendExpects         []*gomock.Call0_1[*service41.ModelSecretBackendService]
-	networkExpects                    []*gomock.Call0_1[*service31.WatchableService]
-	operationExpects                  []*gomock.Call0_1[*service32.WatchableService]
-	portExpects                       []*gomock.Call0_1[*service33.WatchableService]
-	provisioningExpects               []*gomock.Call0_1[*service34.Service]
-	proxyExpects                      []*gomock.Call0_1[*service35.Service]
-	relationExpects                   []*gomock.Call0_1[*service36.WatchableService]
-	removalExpects                    []*gomock.Call0_1[*service37.WatchableService]
-	resolveExpects                    []*gomock.Call0_1[*service38.WatchableService]
-	resourceExpects                   []*gomock.Call0_1[*service39.Service]
+	controllerChangeStreamExpects     []*gomock.Call0_1[*service9.Service]
+	controllerConfigExpects           []*gomock.Call0_1[*service16.WatchableService]
+	controllerNodeExpects             []*gomock.Call0_1[*service17.WatchableService]
+	controllerNodeClusterExpects      []*gomock.Call0_1[*service17.ClusterService]
+	controllerUpgraderExpects         []*gomock.Call0_1[*service18.Service]
+	credentialExpects                 []*gomock.Call0_1[*service19.WatchableService]
+	crossModelRelationExpects         []*gomock.Call0_1[*service20.WatchableService]
+	exportExpects                     []*gomock.Call0_1[*service21.Service]
+	externalControllerExpects         []*gomock.Call0_1[*service22.WatchableService]
+	flagExpects                       []*gomock.Call0_1[*service23.Service]
+	keyManagerExpects                 []*gomock.Call0_1[*service24.Service]
+	keyManagerWithImporterExpects     []*gomock.Call0_1[*service24.ImporterService]
+	keyUpdaterExpects                 []*gomock.Call0_1[*service25.WatchableService]
+	loggingExpects                    []*gomock.Call0_1[*service26.WatchableService]
+	macaroonExpects                   []*gomock.Call0_1[*service27.Service]
+	machineExpects                    []*gomock.Call0_1[*service28.WatchableService]
+	modelExpects                      []*gomock.Call0_1[*service29.WatchableService]
+	modelDefaultsExpects              []*gomock.Call0_1[*service32.Service]
+	modelInfoExpects                  []*gomock.Call0_1[*service29.ProviderModelService]
+	modelMigrationExpects             []*gomock.Call0_1[*service33.WatchableService]
+	modelProviderExpects              []*gomock.Call0_1[*service34.Service]
+	modelSecretBackendExpects         []*gomock.Call0_1[*service45.ModelSecretBackendService]
+	networkExpects                    []*gomock.Call0_1[*service35.WatchableService]
+	operationExpects                  []*gomock.Call0_1[*service36.WatchableService]
+	portExpects                       []*gomock.Call0_1[*service37.WatchableService]
+	provisioningExpects               []*gomock.Call0_1[*service38.Service]
+	proxyExpects                      []*gomock.Call0_1[*service39.Service]
+	relationExpects                   []*gomock.Call0_1[*service40.WatchableService]
+	removalExpects                    []*gomock.Call0_1[*service41.WatchableService]
+	resolveExpects                    []*gomock.Call0_1[*service42.WatchableService]
+	resourceExpects                   []*gomock.Call0_1[*service43.Service]
 	sSHExpects                        []*gomock.Call0_1[*model0.WatchableService]
 	sSHServerHostKeyExpects           []*gomock.Call0_1[*controller.Service]
-	secretExpects                     []*gomock.Call0_1[*service40.WatchableService]
-	secretBackendExpects              []*gomock.Call0_1[*service41.WatchableService]
-	statusExpects                     []*gomock.Call0_1[*service42.LeadershipService]
-	storageExpects                    []*gomock.Call0_1[*service43.Service]
-	storageProvisioningExpects        []*gomock.Call0_1[*service44.Service]
-	tracingExpects                    []*gomock.Call0_1[*service45.WatchableService]
-	unitStateExpects                  []*gomock.Call0_1[*service47.LeadershipService]
-	unitlessExpects                   []*gomock.Call0_1[*service46.WatchableService]
-	upgradeExpects                    []*gomock.Call0_1[*service48.WatchableService]
+	secretExpects                     []*gomock.Call0_1[*service44.WatchableService]
+	secretBackendExpects              []*gomock.Call0_1[*service45.WatchableService]
+	statusExpects                     []*gomock.Call0_1[*service46.LeadershipService]
+	storageExpects                    []*gomock.Call0_1[*service47.Service]
+	storageProvisioningExpects        []*gomock.Call0_1[*service48.Service]
+	tracingExpects                    []*gomock.Call0_1[*service49.WatchableService]
+	unitStateExpects                  []*gomock.Call0_1[*service51.LeadershipService]
+	unitlessExpects                   []*gomock.Call0_1[*service50.WatchableService]
+	upgradeExpects                    []*gomock.Call0_1[*service52.WatchableService]
 }
 
 // NewMockDomainServices creates a new mock instance.
@@ -170,7 +179,7 @@ func (mr *MockDomainServicesMockRecorder) Access() *MockDomainServicesAccessCall
 type MockDomainServicesAccessCall = gomock.Call0_1[*service.Service]
 
 // Agent mocks base method.
-func (m *MockDomainServices) Agent() *service26.WatchableService {
+func (m *MockDomainServices) Agent() *service30.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.agentExpects, m.ctrl, m, "Agent")
 }
@@ -178,14 +187,14 @@ func (m *MockDomainServices) Agent() *service26.WatchableService {
 // Agent indicates an expected call of Agent.
 func (mr *MockDomainServicesMockRecorder) Agent() *MockDomainServicesAgentCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service26.WatchableService](mr.mock.ctrl.T, mr.mock, "Agent")
+	call := gomock.NewCall0_1[*service30.WatchableService](mr.mock.ctrl.T, mr.mock, "Agent")
 	mr.agentExpects = append(mr.agentExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesAgentCall is the typed call wrapper for Agent.
-type MockDomainServicesAgentCall = gomock.Call0_1[*service26.WatchableService]
+type MockDomainServicesAgentCall = gomock.Call0_1[*service30.WatchableService]
 
 // AgentBinary mocks base method.
 func (m *MockDomainServices) AgentBinary() *service0.AgentBinaryService {
@@ -295,8 +304,26 @@ func (mr *MockDomainServicesMockRecorder) Application() *MockDomainServicesAppli
 // MockDomainServicesApplicationCall is the typed call wrapper for Application.
 type MockDomainServicesApplicationCall = gomock.Call0_1[*service4.WatchableService]
 
+// AuditLog mocks base method.
+func (m *MockDomainServices) AuditLog() *service5.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.auditLogExpects, m.ctrl, m, "AuditLog")
+}
+
+// AuditLog indicates an expected call of AuditLog.
+func (mr *MockDomainServicesMockRecorder) AuditLog() *MockDomainServicesAuditLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service5.Service](mr.mock.ctrl.T, mr.mock, "AuditLog")
+	mr.auditLogExpects = append(mr.auditLogExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockDomainServicesAuditLogCall is the typed call wrapper for AuditLog.
+type MockDomainServicesAuditLogCall = gomock.Call0_1[*service5.Service]
+
 // AutocertCache mocks base method.
-func (m *MockDomainServices) AutocertCache() *service5.Service {
+func (m *MockDomainServices) AutocertCache() *service6.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.autocertCacheExpects, m.ctrl, m, "AutocertCache")
 }
@@ -304,17 +331,17 @@ func (m *MockDomainServices) AutocertCache() *service5.Service {
 // AutocertCache indicates an expected call of AutocertCache.
 func (mr *MockDomainServicesMockRecorder) AutocertCache() *MockDomainServicesAutocertCacheCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service5.Service](mr.mock.ctrl.T, mr.mock, "AutocertCache")
+	call := gomock.NewCall0_1[*service6.Service](mr.mock.ctrl.T, mr.mock, "AutocertCache")
 	mr.autocertCacheExpects = append(mr.autocertCacheExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesAutocertCacheCall is the typed call wrapper for AutocertCache.
-type MockDomainServicesAutocertCacheCall = gomock.Call0_1[*service5.Service]
+type MockDomainServicesAutocertCacheCall = gomock.Call0_1[*service6.Service]
 
 // BlockCommand mocks base method.
-func (m *MockDomainServices) BlockCommand() *service6.Service {
+func (m *MockDomainServices) BlockCommand() *service7.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.blockCommandExpects, m.ctrl, m, "BlockCommand")
 }
@@ -322,17 +349,17 @@ func (m *MockDomainServices) BlockCommand() *service6.Service {
 // BlockCommand indicates an expected call of BlockCommand.
 func (mr *MockDomainServicesMockRecorder) BlockCommand() *MockDomainServicesBlockCommandCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service6.Service](mr.mock.ctrl.T, mr.mock, "BlockCommand")
+	call := gomock.NewCall0_1[*service7.Service](mr.mock.ctrl.T, mr.mock, "BlockCommand")
 	mr.blockCommandExpects = append(mr.blockCommandExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesBlockCommandCall is the typed call wrapper for BlockCommand.
-type MockDomainServicesBlockCommandCall = gomock.Call0_1[*service6.Service]
+type MockDomainServicesBlockCommandCall = gomock.Call0_1[*service7.Service]
 
 // BlockDevice mocks base method.
-func (m *MockDomainServices) BlockDevice() *service7.WatchableService {
+func (m *MockDomainServices) BlockDevice() *service8.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.blockDeviceExpects, m.ctrl, m, "BlockDevice")
 }
@@ -340,17 +367,17 @@ func (m *MockDomainServices) BlockDevice() *service7.WatchableService {
 // BlockDevice indicates an expected call of BlockDevice.
 func (mr *MockDomainServicesMockRecorder) BlockDevice() *MockDomainServicesBlockDeviceCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service7.WatchableService](mr.mock.ctrl.T, mr.mock, "BlockDevice")
+	call := gomock.NewCall0_1[*service8.WatchableService](mr.mock.ctrl.T, mr.mock, "BlockDevice")
 	mr.blockDeviceExpects = append(mr.blockDeviceExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesBlockDeviceCall is the typed call wrapper for BlockDevice.
-type MockDomainServicesBlockDeviceCall = gomock.Call0_1[*service7.WatchableService]
+type MockDomainServicesBlockDeviceCall = gomock.Call0_1[*service8.WatchableService]
 
 // ChangeStream mocks base method.
-func (m *MockDomainServices) ChangeStream() *service8.Service {
+func (m *MockDomainServices) ChangeStream() *service9.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.changeStreamExpects, m.ctrl, m, "ChangeStream")
 }
@@ -358,17 +385,53 @@ func (m *MockDomainServices) ChangeStream() *service8.Service {
 // ChangeStream indicates an expected call of ChangeStream.
 func (mr *MockDomainServicesMockRecorder) ChangeStream() *MockDomainServicesChangeStreamCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service8.Service](mr.mock.ctrl.T, mr.mock, "ChangeStream")
+	call := gomock.NewCall0_1[*service9.Service](mr.mock.ctrl.T, mr.mock, "ChangeStream")
 	mr.changeStreamExpects = append(mr.changeStreamExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesChangeStreamCall is the typed call wrapper for ChangeStream.
-type MockDomainServicesChangeStreamCall = gomock.Call0_1[*service8.Service]
+type MockDomainServicesChangeStreamCall = gomock.Call0_1[*service9.Service]
+
+// CharmMirror mocks base method.
+func (m *MockDomainServices) CharmMirror() *service10.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.charmMirrorExpects, m.ctrl, m, "CharmMirror")
+}
+
+// CharmMirror indicates an expected call of CharmMirror.
+func (mr *MockDomainServicesMockRecorder) CharmMirror() *MockDomainServicesCharmMirrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service10.Service](mr.mock.ctrl.T, mr.mock, "CharmMirror")
+	mr.charmMirrorExpects = append(mr.charmMirrorExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockDomainServicesCharmMirrorCall is the typed call wrapper for CharmMirror.
+type MockDomainServicesCharmMirrorCall = gomock.Call0_1[*service10.Service]
+
+// CharmRegistry mocks base method.
+func (m *MockDomainServices) CharmRegistry() *service11.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.charmRegistryExpects, m.ctrl, m, "CharmRegistry")
+}
+
+// CharmRegistry indicates an expected call of CharmRegistry.
+func (mr *MockDomainServicesMockRecorder) CharmRegistry() *MockDomainServicesCharmRegistryCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service11.Service](mr.mock.ctrl.T, mr.mock, "CharmRegistry")
+	mr.charmRegistryExpects = append(mr.charmRegistryExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockDomainServicesCharmRegistryCall is the typed call wrapper for CharmRegistry.
+type MockDomainServicesCharmRegistryCall = gomock.Call0_1[*service11.Service]
 
 // Cloud mocks base method.
-func (m *MockDomainServices) Cloud() *service9.WatchableService {
+func (m *MockDomainServices) Cloud() *service12.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.cloudExpects, m.ctrl, m, "Cloud")
 }
@@ -376,17 +439,17 @@ func (m *MockDomainServices) Cloud() *service9.WatchableService {
 // Cloud indicates an expected call of Cloud.
 func (mr *MockDomainServicesMockRecorder) Cloud() *MockDomainServicesCloudCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service9.WatchableService](mr.mock.ctrl.T, mr.mock, "Cloud")
+	call := gomock.NewCall0_1[*service12.WatchableService](mr.mock.ctrl.T, mr.mock, "Cloud")
 	mr.cloudExpects = append(mr.cloudExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesCloudCall is the typed call wrapper for Cloud.
-type MockDomainServicesCloudCall = gomock.Call0_1[*service9.WatchableService]
+type MockDomainServicesCloudCall = gomock.Call0_1[*service12.WatchableService]
 
 // CloudImageMetadata mocks base method.
-func (m *MockDomainServices) CloudImageMetadata() *service10.Service {
+func (m *MockDomainServices) CloudImageMetadata() *service13.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.cloudImageMetadataExpects, m.ctrl, m, "CloudImageMetadata")
 }
@@ -394,17 +457,17 @@ func (m *MockDomainServices) CloudImageMetadata() *service10.Service {
 // CloudImageMetadata indicates an expected call of CloudImageMetadata.
 func (mr *MockDomainServicesMockRecorder) CloudImageMetadata() *MockDomainServicesCloudImageMetadataCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service10.Service](mr.mock.ctrl.T, mr.mock, "CloudImageMetadata")
+	call := gomock.NewCall0_1[*service13.Service](mr.mock.ctrl.T, mr.mock, "CloudImageMetadata")
 	mr.cloudImageMetadataExpects = append(mr.cloudImageMetadataExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesCloudImageMetadataCall is the typed call wrapper for CloudImageMetadata.
-type MockDomainServicesCloudImageMetadataCall = gomock.Call0_1[*service10.Service]
+type MockDomainServicesCloudImageMetadataCall = gomock.Call0_1[*service13.Service]
 
 // Config mocks base method.
-func (m *MockDomainServices) Config() *service27.WatchableService {
+func (m *MockDomainServices) Config() *service31.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.configExpects, m.ctrl, m, "Config")
 }
@@ -412,17 +475,35 @@ func (m *MockDomainServices) Config() *service27.WatchableService {
 // Config indicates an expected call of Config.
 func (mr *MockDomainServicesMockRecorder) Config() *MockDomainServicesConfigCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service27.WatchableService](mr.mock.ctrl.T, mr.mock, "Config")
+	call := gomock.NewCall0_1[*service31.WatchableService](mr.mock.ctrl.T, mr.mock, "Config")
 	mr.configExpects = append(mr.configExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesConfigCall is the typed call wrapper for Config.
-type MockDomainServicesConfigCall = gomock.Call0_1[*service27.WatchableService]
+type MockDomainServicesConfigCall = gomock.Call0_1[*service31.WatchableService]
+
+// ConfigSnapshot mocks base method.
+func (m *MockDomainServices) ConfigSnapshot() *service14.Service {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.configSnapshotExpects, m.ctrl, m, "ConfigSnapshot")
+}
+
+// ConfigSnapshot indicates an expected call of ConfigSnapshot.
+func (mr *MockDomainServicesMockRecorder) ConfigSnapshot() *MockDomainServicesConfigSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service14.Service](mr.mock.ctrl.T, mr.mock, "ConfigSnapshot")
+	mr.configSnapshotExpects = append(mr.configSnapshotExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockDomainServicesConfigSnapshotCall is the typed call wrapper for ConfigSnapshot.
+type MockDomainServicesConfigSnapshotCall = gomock.Call0_1[*service14.Service]
 
 // Controller mocks base method.
-func (m *MockDomainServices) Controller() *service11.Service {
+func (m *MockDomainServices) Controller() *service15.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerExpects, m.ctrl, m, "Controller")
 }
@@ -430,14 +511,14 @@ func (m *MockDomainServices) Controller() *service11.Service {
 // Controller indicates an expected call of Controller.
 func (mr *MockDomainServicesMockRecorder) Controller() *MockDomainServicesControllerCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service11.Service](mr.mock.ctrl.T, mr.mock, "Controller")
+	call := gomock.NewCall0_1[*service15.Service](mr.mock.ctrl.T, mr.mock, "Controller")
 	mr.controllerExpects = append(mr.controllerExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesControllerCall is the typed call wrapper for Controller.
-type MockDomainServicesControllerCall = gomock.Call0_1[*service11.Service]
+type MockDomainServicesControllerCall = gomock.Call0_1[*service15.Service]
 
 // ControllerAgentBinaryStore mocks base method.
 func (m *MockDomainServices) ControllerAgentBinaryStore() *service0.AgentBinaryStore {
@@ -458,7 +539,7 @@ func (mr *MockDomainServicesMockRecorder) ControllerAgentBinaryStore() *MockDoma
 type MockDomainServicesControllerAgentBinaryStoreCall = gomock.Call0_1[*service0.AgentBinaryStore]
 
 // ControllerChangeStream mocks base method.
-func (m *MockDomainServices) ControllerChangeStream() *service8.Service {
+func (m *MockDomainServices) ControllerChangeStream() *service9.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerChangeStreamExpects, m.ctrl, m, "ControllerChangeStream")
 }
@@ -466,17 +547,17 @@ func (m *MockDomainServices) ControllerChangeStream() *service8.Service {
 // ControllerChangeStream indicates an expected call of ControllerChangeStream.
 func (mr *MockDomainServicesMockRecorder) ControllerChangeStream() *MockDomainServicesControllerChangeStreamCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service8.Service](mr.mock.ctrl.T, mr.mock, "ControllerChangeStream")
+	call := gomock.NewCall0_1[*service9.Service](mr.mock.ctrl.T, mr.mock, "ControllerChangeStream")
 	mr.controllerChangeStreamExpects = append(mr.controllerChangeStreamExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesControllerChangeStreamCall is the typed call wrapper for ControllerChangeStream.
-type MockDomainServicesControllerChangeStreamCall = gomock.Call0_1[*service8.Service]
+type MockDomainServicesControllerChangeStreamCall = gomock.Call0_1[*service9.Service]
 
 // ControllerConfig mocks base method.
-func (m *MockDomainServices) ControllerConfig() *service12.WatchableService {
+func (m *MockDomainServices) ControllerConfig() *service16.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerConfigExpects, m.ctrl, m, "ControllerConfig")
 }
@@ -484,17 +565,17 @@ func (m *MockDomainServices) ControllerConfig() *service12.WatchableService {
 // ControllerConfig indicates an expected call of ControllerConfig.
 func (mr *MockDomainServicesMockRecorder) ControllerConfig() *MockDomainServicesControllerConfigCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service12.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerConfig")
+	call := gomock.NewCall0_1[*service16.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerConfig")
 	mr.controllerConfigExpects = append(mr.controllerConfigExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesControllerConfigCall is the typed call wrapper for ControllerConfig.
-type MockDomainServicesControllerConfigCall = gomock.Call0_1[*service12.WatchableService]
+type MockDomainServicesControllerConfigCall = gomock.Call0_1[*service16.WatchableService]
 
 // ControllerNode mocks base method.
-func (m *MockDomainServices) ControllerNode() *service13.WatchableService {
+func (m *MockDomainServices) ControllerNode() *service17.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerNodeExpects, m.ctrl, m, "ControllerNode")
 }
@@ -502,17 +583,35 @@ func (m *MockDomainServices) ControllerNode() *service13.WatchableService {
 // ControllerNode indicates an expected call of ControllerNode.
 func (mr *MockDomainServicesMockRecorder) ControllerNode() *MockDomainServicesControllerNodeCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service13.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerNode")
+	call := gomock.NewCall0_1[*service17.WatchableService](mr.mock.ctrl.T, mr.mock, "ControllerNode")
 	mr.controllerNodeExpects = append(mr.controllerNodeExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesControllerNodeCall is the typed call wrapper for ControllerNode.
-type MockDomainServicesControllerNodeCall = gomock.Call0_1[*service13.WatchableService]
+type MockDomainServicesControllerNodeCall = gomock.Call0_1[*service17.WatchableService]
+
+// ControllerNodeCluster mocks base method.
+func (m *MockDomainServices) ControllerNodeCluster() *service17.ClusterService {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.controllerNodeClusterExpects, m.ctrl, m, "ControllerNodeCluster")
+}
+
+// ControllerNodeCluster indicates an expected call of ControllerNodeCluster.
+func (mr *MockDomainServicesMockRecorder) ControllerNodeCluster() *MockDomainServicesControllerNodeClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[*service17.ClusterService](mr.mock.ctrl.T, mr.mock, "ControllerNodeCluster")
+	mr.controllerNodeClusterExpects = append(mr.controllerNodeClusterExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockDomainServicesControllerNodeClusterCall is the typed call wrapper for ControllerNodeCluster.
+type MockDomainServicesControllerNodeClusterCall = gomock.Call0_1[*service17.ClusterService]
 
 // ControllerUpgrader mocks base method.
-func (m *MockDomainServices) ControllerUpgrader() *service14.Service {
+func (m *MockDomainServices) ControllerUpgrader() *service18.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.controllerUpgraderExpects, m.ctrl, m, "ControllerUpgrader")
 }
@@ -520,17 +619,17 @@ func (m *MockDomainServices) ControllerUpgrader() *service14.Service {
 // ControllerUpgrader indicates an expected call of ControllerUpgrader.
 func (mr *MockDomainServicesMockRecorder) ControllerUpgrader() *MockDomainServicesControllerUpgraderCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service14.Service](mr.mock.ctrl.T, mr.mock, "ControllerUpgrader")
+	call := gomock.NewCall0_1[*service18.Service](mr.mock.ctrl.T, mr.mock, "ControllerUpgrader")
 	mr.controllerUpgraderExpects = append(mr.controllerUpgraderExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesControllerUpgraderCall is the typed call wrapper for ControllerUpgrader.
-type MockDomainServicesControllerUpgraderCall = gomock.Call0_1[*service14.Service]
+type MockDomainServicesControllerUpgraderCall = gomock.Call0_1[*service18.Service]
 
 // Credential mocks base method.
-func (m *MockDomainServices) Credential() *service15.WatchableService {
+func (m *MockDomainServices) Credential() *service19.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.credentialExpects, m.ctrl, m, "Credential")
 }
@@ -538,17 +637,17 @@ func (m *MockDomainServices) Credential() *service15.WatchableService {
 // Credential indicates an expected call of Credential.
 func (mr *MockDomainServicesMockRecorder) Credential() *MockDomainServicesCredentialCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service15.WatchableService](mr.mock.ctrl.T, mr.mock, "Credential")
+	call := gomock.NewCall0_1[*service19.WatchableService](mr.mock.ctrl.T, mr.mock, "Credential")
 	mr.credentialExpects = append(mr.credentialExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesCredentialCall is the typed call wrapper for Credential.
-type MockDomainServicesCredentialCall = gomock.Call0_1[*service15.WatchableService]
+type MockDomainServicesCredentialCall = gomock.Call0_1[*service19.WatchableService]
 
 // CrossModelRelation mocks base method.
-func (m *MockDomainServices) CrossModelRelation() *service16.WatchableService {
+func (m *MockDomainServices) CrossModelRelation() *service20.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.crossModelRelationExpects, m.ctrl, m, "CrossModelRelation")
 }
@@ -556,17 +655,17 @@ func (m *MockDomainServices) CrossModelRelation() *service16.WatchableService {
 // CrossModelRelation indicates an expected call of CrossModelRelation.
 func (mr *MockDomainServicesMockRecorder) CrossModelRelation() *MockDomainServicesCrossModelRelationCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service16.WatchableService](mr.mock.ctrl.T, mr.mock, "CrossModelRelation")
+	call := gomock.NewCall0_1[*service20.WatchableService](mr.mock.ctrl.T, mr.mock, "CrossModelRelation")
 	mr.crossModelRelationExpects = append(mr.crossModelRelationExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesCrossModelRelationCall is the typed call wrapper for CrossModelRelation.
-type MockDomainServicesCrossModelRelationCall = gomock.Call0_1[*service16.WatchableService]
+type MockDomainServicesCrossModelRelationCall = gomock.Call0_1[*service20.WatchableService]
 
 // Export mocks base method.
-func (m *MockDomainServices) Export() *service17.Service {
+func (m *MockDomainServices) Export() *service21.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.exportExpects, m.ctrl, m, "Export")
 }
@@ -574,17 +673,17 @@ func (m *MockDomainServices) Export() *service17.Service {
 // Export indicates an expected call of Export.
 func (mr *MockDomainServicesMockRecorder) Export() *MockDomainServicesExportCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service17.Service](mr.mock.ctrl.T, mr.mock, "Export")
+	call := gomock.NewCall0_1[*service21.Service](mr.mock.ctrl.T, mr.mock, "Export")
 	mr.exportExpects = append(mr.exportExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesExportCall is the typed call wrapper for Export.
-type MockDomainServicesExportCall = gomock.Call0_1[*service17.Service]
+type MockDomainServicesExportCall = gomock.Call0_1[*service21.Service]
 
 // ExternalController mocks base method.
-func (m *MockDomainServices) ExternalController() *service18.WatchableService {
+func (m *MockDomainServices) ExternalController() *service22.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.externalControllerExpects, m.ctrl, m, "ExternalController")
 }
@@ -592,17 +691,17 @@ func (m *MockDomainServices) ExternalController() *service18.WatchableService {
 // ExternalController indicates an expected call of ExternalController.
 func (mr *MockDomainServicesMockRecorder) ExternalController() *MockDomainServicesExternalControllerCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service18.WatchableService](mr.mock.ctrl.T, mr.mock, "ExternalController")
+	call := gomock.NewCall0_1[*service22.WatchableService](mr.mock.ctrl.T, mr.mock, "ExternalController")
 	mr.externalControllerExpects = append(mr.externalControllerExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesExternalControllerCall is the typed call wrapper for ExternalController.
-type MockDomainServicesExternalControllerCall = gomock.Call0_1[*service18.WatchableService]
+type MockDomainServicesExternalControllerCall = gomock.Call0_1[*service22.WatchableService]
 
 // Flag mocks base method.
-func (m *MockDomainServices) Flag() *service19.Service {
+func (m *MockDomainServices) Flag() *service23.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.flagExpects, m.ctrl, m, "Flag")
 }
@@ -610,17 +709,17 @@ func (m *MockDomainServices) Flag() *service19.Service {
 // Flag indicates an expected call of Flag.
 func (mr *MockDomainServicesMockRecorder) Flag() *MockDomainServicesFlagCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service19.Service](mr.mock.ctrl.T, mr.mock, "Flag")
+	call := gomock.NewCall0_1[*service23.Service](mr.mock.ctrl.T, mr.mock, "Flag")
 	mr.flagExpects = append(mr.flagExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesFlagCall is the typed call wrapper for Flag.
-type MockDomainServicesFlagCall = gomock.Call0_1[*service19.Service]
+type MockDomainServicesFlagCall = gomock.Call0_1[*service23.Service]
 
 // KeyManager mocks base method.
-func (m *MockDomainServices) KeyManager() *service20.Service {
+func (m *MockDomainServices) KeyManager() *service24.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.keyManagerExpects, m.ctrl, m, "KeyManager")
 }
@@ -628,17 +727,17 @@ func (m *MockDomainServices) KeyManager() *service20.Service {
 // KeyManager indicates an expected call of KeyManager.
 func (mr *MockDomainServicesMockRecorder) KeyManager() *MockDomainServicesKeyManagerCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service20.Service](mr.mock.ctrl.T, mr.mock, "KeyManager")
+	call := gomock.NewCall0_1[*service24.Service](mr.mock.ctrl.T, mr.mock, "KeyManager")
 	mr.keyManagerExpects = append(mr.keyManagerExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesKeyManagerCall is the typed call wrapper for KeyManager.
-type MockDomainServicesKeyManagerCall = gomock.Call0_1[*service20.Service]
+type MockDomainServicesKeyManagerCall = gomock.Call0_1[*service24.Service]
 
 // KeyManagerWithImporter mocks base method.
-func (m *MockDomainServices) KeyManagerWithImporter() *service20.ImporterService {
+func (m *MockDomainServices) KeyManagerWithImporter() *service24.ImporterService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.keyManagerWithImporterExpects, m.ctrl, m, "KeyManagerWithImporter")
 }
@@ -646,17 +745,17 @@ func (m *MockDomainServices) KeyManagerWithImporter() *service20.ImporterService
 // KeyManagerWithImporter indicates an expected call of KeyManagerWithImporter.
 func (mr *MockDomainServicesMockRecorder) KeyManagerWithImporter() *MockDomainServicesKeyManagerWithImporterCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service20.ImporterService](mr.mock.ctrl.T, mr.mock, "KeyManagerWithImporter")
+	call := gomock.NewCall0_1[*service24.ImporterService](mr.mock.ctrl.T, mr.mock, "KeyManagerWithImporter")
 	mr.keyManagerWithImporterExpects = append(mr.keyManagerWithImporterExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesKeyManagerWithImporterCall is the typed call wrapper for KeyManagerWithImporter.
-type MockDomainServicesKeyManagerWithImporterCall = gomock.Call0_1[*service20.ImporterService]
+type MockDomainServicesKeyManagerWithImporterCall = gomock.Call0_1[*service24.ImporterService]
 
 // KeyUpdater mocks base method.
-func (m *MockDomainServices) KeyUpdater() *service21.WatchableService {
+func (m *MockDomainServices) KeyUpdater() *service25.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.keyUpdaterExpects, m.ctrl, m, "KeyUpdater")
 }
@@ -664,17 +763,17 @@ func (m *MockDomainServices) KeyUpdater() *service21.WatchableService {
 // KeyUpdater indicates an expected call of KeyUpdater.
 func (mr *MockDomainServicesMockRecorder) KeyUpdater() *MockDomainServicesKeyUpdaterCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service21.WatchableService](mr.mock.ctrl.T, mr.mock, "KeyUpdater")
+	call := gomock.NewCall0_1[*service25.WatchableService](mr.mock.ctrl.T, mr.mock, "KeyUpdater")
 	mr.keyUpdaterExpects = append(mr.keyUpdaterExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesKeyUpdaterCall is the typed call wrapper for KeyUpdater.
-type MockDomainServicesKeyUpdaterCall = gomock.Call0_1[*service21.WatchableService]
+type MockDomainServicesKeyUpdaterCall = gomock.Call0_1[*service25.WatchableService]
 
 // Logging mocks base method.
-func (m *MockDomainServices) Logging() *service22.WatchableService {
+func (m *MockDomainServices) Logging() *service26.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.loggingExpects, m.ctrl, m, "Logging")
 }
@@ -682,17 +781,17 @@ func (m *MockDomainServices) Logging() *service22.WatchableService {
 // Logging indicates an expected call of Logging.
 func (mr *MockDomainServicesMockRecorder) Logging() *MockDomainServicesLoggingCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service22.WatchableService](mr.mock.ctrl.T, mr.mock, "Logging")
+	call := gomock.NewCall0_1[*service26.WatchableService](mr.mock.ctrl.T, mr.mock, "Logging")
 	mr.loggingExpects = append(mr.loggingExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesLoggingCall is the typed call wrapper for Logging.
-type MockDomainServicesLoggingCall = gomock.Call0_1[*service22.WatchableService]
+type MockDomainServicesLoggingCall = gomock.Call0_1[*service26.WatchableService]
 
 // Macaroon mocks base method.
-func (m *MockDomainServices) Macaroon() *service23.Service {
+func (m *MockDomainServices) Macaroon() *service27.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.macaroonExpects, m.ctrl, m, "Macaroon")
 }
@@ -700,17 +799,17 @@ func (m *MockDomainServices) Macaroon() *service23.Service {
 // Macaroon indicates an expected call of Macaroon.
 func (mr *MockDomainServicesMockRecorder) Macaroon() *MockDomainServicesMacaroonCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service23.Service](mr.mock.ctrl.T, mr.mock, "Macaroon")
+	call := gomock.NewCall0_1[*service27.Service](mr.mock.ctrl.T, mr.mock, "Macaroon")
 	mr.macaroonExpects = append(mr.macaroonExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesMacaroonCall is the typed call wrapper for Macaroon.
-type MockDomainServicesMacaroonCall = gomock.Call0_1[*service23.Service]
+type MockDomainServicesMacaroonCall = gomock.Call0_1[*service27.Service]
 
 // Machine mocks base method.
-func (m *MockDomainServices) Machine() *service24.WatchableService {
+func (m *MockDomainServices) Machine() *service28.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.machineExpects, m.ctrl, m, "Machine")
 }
@@ -718,17 +817,17 @@ func (m *MockDomainServices) Machine() *service24.WatchableService {
 // Machine indicates an expected call of Machine.
 func (mr *MockDomainServicesMockRecorder) Machine() *MockDomainServicesMachineCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service24.WatchableService](mr.mock.ctrl.T, mr.mock, "Machine")
+	call := gomock.NewCall0_1[*service28.WatchableService](mr.mock.ctrl.T, mr.mock, "Machine")
 	mr.machineExpects = append(mr.machineExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesMachineCall is the typed call wrapper for Machine.
-type MockDomainServicesMachineCall = gomock.Call0_1[*service24.WatchableService]
+type MockDomainServicesMachineCall = gomock.Call0_1[*service28.WatchableService]
 
 // Model mocks base method.
-func (m *MockDomainServices) Model() *service25.WatchableService {
+func (m *MockDomainServices) Model() *service29.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelExpects, m.ctrl, m, "Model")
 }
@@ -736,17 +835,17 @@ func (m *MockDomainServices) Model() *service25.WatchableService {
 // Model indicates an expected call of Model.
 func (mr *MockDomainServicesMockRecorder) Model() *MockDomainServicesModelCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service25.WatchableService](mr.mock.ctrl.T, mr.mock, "Model")
+	call := gomock.NewCall0_1[*service29.WatchableService](mr.mock.ctrl.T, mr.mock, "Model")
 	mr.modelExpects = append(mr.modelExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesModelCall is the typed call wrapper for Model.
-type MockDomainServicesModelCall = gomock.Call0_1[*service25.WatchableService]
+type MockDomainServicesModelCall = gomock.Call0_1[*service29.WatchableService]
 
 // ModelDefaults mocks base method.
-func (m *MockDomainServices) ModelDefaults() *service28.Service {
+func (m *MockDomainServices) ModelDefaults() *service32.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelDefaultsExpects, m.ctrl, m, "ModelDefaults")
 }
@@ -754,17 +853,17 @@ func (m *MockDomainServices) ModelDefaults() *service28.Service {
 // ModelDefaults indicates an expected call of ModelDefaults.
 func (mr *MockDomainServicesMockRecorder) ModelDefaults() *MockDomainServicesModelDefaultsCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service28.Service](mr.mock.ctrl.T, mr.mock, "ModelDefaults")
+	call := gomock.NewCall0_1[*service32.Service](mr.mock.ctrl.T, mr.mock, "ModelDefaults")
 	mr.modelDefaultsExpects = append(mr.modelDefaultsExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesModelDefaultsCall is the typed call wrapper for ModelDefaults.
-type MockDomainServicesModelDefaultsCall = gomock.Call0_1[*service28.Service]
+type MockDomainServicesModelDefaultsCall = gomock.Call0_1[*service32.Service]
 
 // ModelInfo mocks base method.
-func (m *MockDomainServices) ModelInfo() *service25.ProviderModelService {
+func (m *MockDomainServices) ModelInfo() *service29.ProviderModelService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelInfoExpects, m.ctrl, m, "ModelInfo")
 }
@@ -772,17 +871,17 @@ func (m *MockDomainServices) ModelInfo() *service25.ProviderModelService {
 // ModelInfo indicates an expected call of ModelInfo.
 func (mr *MockDomainServicesMockRecorder) ModelInfo() *MockDomainServicesModelInfoCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service25.ProviderModelService](mr.mock.ctrl.T, mr.mock, "ModelInfo")
+	call := gomock.NewCall0_1[*service29.ProviderModelService](mr.mock.ctrl.T, mr.mock, "ModelInfo")
 	mr.modelInfoExpects = append(mr.modelInfoExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesModelInfoCall is the typed call wrapper for ModelInfo.
-type MockDomainServicesModelInfoCall = gomock.Call0_1[*service25.ProviderModelService]
+type MockDomainServicesModelInfoCall = gomock.Call0_1[*service29.ProviderModelService]
 
 // ModelMigration mocks base method.
-func (m *MockDomainServices) ModelMigration() *service29.WatchableService {
+func (m *MockDomainServices) ModelMigration() *service33.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelMigrationExpects, m.ctrl, m, "ModelMigration")
 }
@@ -790,17 +889,17 @@ func (m *MockDomainServices) ModelMigration() *service29.WatchableService {
 // ModelMigration indicates an expected call of ModelMigration.
 func (mr *MockDomainServicesMockRecorder) ModelMigration() *MockDomainServicesModelMigrationCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service29.WatchableService](mr.mock.ctrl.T, mr.mock, "ModelMigration")
+	call := gomock.NewCall0_1[*service33.WatchableService](mr.mock.ctrl.T, mr.mock, "ModelMigration")
 	mr.modelMigrationExpects = append(mr.modelMigrationExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesModelMigrationCall is the typed call wrapper for ModelMigration.
-type MockDomainServicesModelMigrationCall = gomock.Call0_1[*service29.WatchableService]
+type MockDomainServicesModelMigrationCall = gomock.Call0_1[*service33.WatchableService]
 
 // ModelProvider mocks base method.
-func (m *MockDomainServices) ModelProvider() *service30.Service {
+func (m *MockDomainServices) ModelProvider() *service34.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelProviderExpects, m.ctrl, m, "ModelProvider")
 }
@@ -808,17 +907,17 @@ func (m *MockDomainServices) ModelProvider() *service30.Service {
 // ModelProvider indicates an expected call of ModelProvider.
 func (mr *MockDomainServicesMockRecorder) ModelProvider() *MockDomainServicesModelProviderCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service30.Service](mr.mock.ctrl.T, mr.mock, "ModelProvider")
+	call := gomock.NewCall0_1[*service34.Service](mr.mock.ctrl.T, mr.mock, "ModelProvider")
 	mr.modelProviderExpects = append(mr.modelProviderExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesModelProviderCall is the typed call wrapper for ModelProvider.
-type MockDomainServicesModelProviderCall = gomock.Call0_1[*service30.Service]
+type MockDomainServicesModelProviderCall = gomock.Call0_1[*service34.Service]
 
 // ModelSecretBackend mocks base method.
-func (m *MockDomainServices) ModelSecretBackend() *service41.ModelSecretBackendService {
+func (m *MockDomainServices) ModelSecretBackend() *service45.ModelSecretBackendService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.modelSecretBackendExpects, m.ctrl, m, "ModelSecretBackend")
 }
@@ -826,17 +925,17 @@ func (m *MockDomainServices) ModelSecretBackend() *service41.ModelSecretBackendS
 // ModelSecretBackend indicates an expected call of ModelSecretBackend.
 func (mr *MockDomainServicesMockRecorder) ModelSecretBackend() *MockDomainServicesModelSecretBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service41.ModelSecretBackendService](mr.mock.ctrl.T, mr.mock, "ModelSecretBackend")
+	call := gomock.NewCall0_1[*service45.ModelSecretBackendService](mr.mock.ctrl.T, mr.mock, "ModelSecretBackend")
 	mr.modelSecretBackendExpects = append(mr.modelSecretBackendExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesModelSecretBackendCall is the typed call wrapper for ModelSecretBackend.
-type MockDomainServicesModelSecretBackendCall = gomock.Call0_1[*service41.ModelSecretBackendService]
+type MockDomainServicesModelSecretBackendCall = gomock.Call0_1[*service45.ModelSecretBackendService]
 
 // Network mocks base method.
-func (m *MockDomainServices) Network() *service31.WatchableService {
+func (m *MockDomainServices) Network() *service35.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.networkExpects, m.ctrl, m, "Network")
 }
@@ -844,17 +943,17 @@ func (m *MockDomainServices) Network() *service31.WatchableService {
 // Network indicates an expected call of Network.
 func (mr *MockDomainServicesMockRecorder) Network() *MockDomainServicesNetworkCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service31.WatchableService](mr.mock.ctrl.T, mr.mock, "Network")
+	call := gomock.NewCall0_1[*service35.WatchableService](mr.mock.ctrl.T, mr.mock, "Network")
 	mr.networkExpects = append(mr.networkExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesNetworkCall is the typed call wrapper for Network.
-type MockDomainServicesNetworkCall = gomock.Call0_1[*service31.WatchableService]
+type MockDomainServicesNetworkCall = gomock.Call0_1[*service35.WatchableService]
 
 // Operation mocks base method.
-func (m *MockDomainServices) Operation() *service32.WatchableService {
+func (m *MockDomainServices) Operation() *service36.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.operationExpects, m.ctrl, m, "Operation")
 }
@@ -862,17 +961,17 @@ func (m *MockDomainServices) Operation() *service32.WatchableService {
 // Operation indicates an expected call of Operation.
 func (mr *MockDomainServicesMockRecorder) Operation() *MockDomainServicesOperationCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service32.WatchableService](mr.mock.ctrl.T, mr.mock, "Operation")
+	call := gomock.NewCall0_1[*service36.WatchableService](mr.mock.ctrl.T, mr.mock, "Operation")
 	mr.operationExpects = append(mr.operationExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesOperationCall is the typed call wrapper for Operation.
-type MockDomainServicesOperationCall = gomock.Call0_1[*service32.WatchableService]
+type MockDomainServicesOperationCall = gomock.Call0_1[*service36.WatchableService]
 
 // Port mocks base method.
-func (m *MockDomainServices) Port() *service33.WatchableService {
+func (m *MockDomainServices) Port() *service37.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.portExpects, m.ctrl, m, "Port")
 }
@@ -880,17 +979,17 @@ func (m *MockDomainServices) Port() *service33.WatchableService {
 // Port indicates an expected call of Port.
 func (mr *MockDomainServicesMockRecorder) Port() *MockDomainServicesPortCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service33.WatchableService](mr.mock.ctrl.T, mr.mock, "Port")
+	call := gomock.NewCall0_1[*service37.WatchableService](mr.mock.ctrl.T, mr.mock, "Port")
 	mr.portExpects = append(mr.portExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesPortCall is the typed call wrapper for Port.
-type MockDomainServicesPortCall = gomock.Call0_1[*service33.WatchableService]
+type MockDomainServicesPortCall = gomock.Call0_1[*service37.WatchableService]
 
 // Provisioning mocks base method.
-func (m *MockDomainServices) Provisioning() *service34.Service {
+func (m *MockDomainServices) Provisioning() *service38.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.provisioningExpects, m.ctrl, m, "Provisioning")
 }
@@ -898,17 +997,17 @@ func (m *MockDomainServices) Provisioning() *service34.Service {
 // Provisioning indicates an expected call of Provisioning.
 func (mr *MockDomainServicesMockRecorder) Provisioning() *MockDomainServicesProvisioningCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service34.Service](mr.mock.ctrl.T, mr.mock, "Provisioning")
+	call := gomock.NewCall0_1[*service38.Service](mr.mock.ctrl.T, mr.mock, "Provisioning")
 	mr.provisioningExpects = append(mr.provisioningExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesProvisioningCall is the typed call wrapper for Provisioning.
-type MockDomainServicesProvisioningCall = gomock.Call0_1[*service34.Service]
+type MockDomainServicesProvisioningCall = gomock.Call0_1[*service38.Service]
 
 // Proxy mocks base method.
-func (m *MockDomainServices) Proxy() *service35.Service {
+func (m *MockDomainServices) Proxy() *service39.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.proxyExpects, m.ctrl, m, "Proxy")
 }
@@ -916,17 +1015,17 @@ func (m *MockDomainServices) Proxy() *service35.Service {
 // Proxy indicates an expected call of Proxy.
 func (mr *MockDomainServicesMockRecorder) Proxy() *MockDomainServicesProxyCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service35.Service](mr.mock.ctrl.T, mr.mock, "Proxy")
+	call := gomock.NewCall0_1[*service39.Service](mr.mock.ctrl.T, mr.mock, "Proxy")
 	mr.proxyExpects = append(mr.proxyExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesProxyCall is the typed call wrapper for Proxy.
-type MockDomainServicesProxyCall = gomock.Call0_1[*service35.Service]
+type MockDomainServicesProxyCall = gomock.Call0_1[*service39.Service]
 
 // Relation mocks base method.
-func (m *MockDomainServices) Relation() *service36.WatchableService {
+func (m *MockDomainServices) Relation() *service40.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.relationExpects, m.ctrl, m, "Relation")
 }
@@ -934,17 +1033,17 @@ func (m *MockDomainServices) Relation() *service36.WatchableService {
 // Relation indicates an expected call of Relation.
 func (mr *MockDomainServicesMockRecorder) Relation() *MockDomainServicesRelationCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service36.WatchableService](mr.mock.ctrl.T, mr.mock, "Relation")
+	call := gomock.NewCall0_1[*service40.WatchableService](mr.mock.ctrl.T, mr.mock, "Relation")
 	mr.relationExpects = append(mr.relationExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesRelationCall is the typed call wrapper for Relation.
-type MockDomainServicesRelationCall = gomock.Call0_1[*service36.WatchableService]
+type MockDomainServicesRelationCall = gomock.Call0_1[*service40.WatchableService]
 
 // Removal mocks base method.
-func (m *MockDomainServices) Removal() *service37.WatchableService {
+func (m *MockDomainServices) Removal() *service41.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.removalExpects, m.ctrl, m, "Removal")
 }
@@ -952,17 +1051,17 @@ func (m *MockDomainServices) Removal() *service37.WatchableService {
 // Removal indicates an expected call of Removal.
 func (mr *MockDomainServicesMockRecorder) Removal() *MockDomainServicesRemovalCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service37.WatchableService](mr.mock.ctrl.T, mr.mock, "Removal")
+	call := gomock.NewCall0_1[*service41.WatchableService](mr.mock.ctrl.T, mr.mock, "Removal")
 	mr.removalExpects = append(mr.removalExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesRemovalCall is the typed call wrapper for Removal.
-type MockDomainServicesRemovalCall = gomock.Call0_1[*service37.WatchableService]
+type MockDomainServicesRemovalCall = gomock.Call0_1[*service41.WatchableService]
 
 // Resolve mocks base method.
-func (m *MockDomainServices) Resolve() *service38.WatchableService {
+func (m *MockDomainServices) Resolve() *service42.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.resolveExpects, m.ctrl, m, "Resolve")
 }
@@ -970,17 +1069,17 @@ func (m *MockDomainServices) Resolve() *service38.WatchableService {
 // Resolve indicates an expected call of Resolve.
 func (mr *MockDomainServicesMockRecorder) Resolve() *MockDomainServicesResolveCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service38.WatchableService](mr.mock.ctrl.T, mr.mock, "Resolve")
+	call := gomock.NewCall0_1[*service42.WatchableService](mr.mock.ctrl.T, mr.mock, "Resolve")
 	mr.resolveExpects = append(mr.resolveExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesResolveCall is the typed call wrapper for Resolve.
-type MockDomainServicesResolveCall = gomock.Call0_1[*service38.WatchableService]
+type MockDomainServicesResolveCall = gomock.Call0_1[*service42.WatchableService]
 
 // Resource mocks base method.
-func (m *MockDomainServices) Resource() *service39.Service {
+func (m *MockDomainServices) Resource() *service43.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.resourceExpects, m.ctrl, m, "Resource")
 }
@@ -988,14 +1087,14 @@ func (m *MockDomainServices) Resource() *service39.Service {
 // Resource indicates an expected call of Resource.
 func (mr *MockDomainServicesMockRecorder) Resource() *MockDomainServicesResourceCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service39.Service](mr.mock.ctrl.T, mr.mock, "Resource")
+	call := gomock.NewCall0_1[*service43.Service](mr.mock.ctrl.T, mr.mock, "Resource")
 	mr.resourceExpects = append(mr.resourceExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesResourceCall is the typed call wrapper for Resource.
-type MockDomainServicesResourceCall = gomock.Call0_1[*service39.Service]
+type MockDomainServicesResourceCall = gomock.Call0_1[*service43.Service]
 
 // SSH mocks base method.
 func (m *MockDomainServices) SSH() *model0.WatchableService {
@@ -1034,7 +1133,7 @@ func (mr *MockDomainServicesMockRecorder) SSHServerHostKey() *MockDomainServices
 type MockDomainServicesSSHServerHostKeyCall = gomock.Call0_1[*controller.Service]
 
 // Secret mocks base method.
-func (m *MockDomainServices) Secret() *service40.WatchableService {
+func (m *MockDomainServices) Secret() *service44.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.secretExpects, m.ctrl, m, "Secret")
 }
@@ -1042,17 +1141,17 @@ func (m *MockDomainServices) Secret() *service40.WatchableService {
 // Secret indicates an expected call of Secret.
 func (mr *MockDomainServicesMockRecorder) Secret() *MockDomainServicesSecretCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service40.WatchableService](mr.mock.ctrl.T, mr.mock, "Secret")
+	call := gomock.NewCall0_1[*service44.WatchableService](mr.mock.ctrl.T, mr.mock, "Secret")
 	mr.secretExpects = append(mr.secretExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesSecretCall is the typed call wrapper for Secret.
-type MockDomainServicesSecretCall = gomock.Call0_1[*service40.WatchableService]
+type MockDomainServicesSecretCall = gomock.Call0_1[*service44.WatchableService]
 
 // SecretBackend mocks base method.
-func (m *MockDomainServices) SecretBackend() *service41.WatchableService {
+func (m *MockDomainServices) SecretBackend() *service45.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.secretBackendExpects, m.ctrl, m, "SecretBackend")
 }
@@ -1060,17 +1159,17 @@ func (m *MockDomainServices) SecretBackend() *service41.WatchableService {
 // SecretBackend indicates an expected call of SecretBackend.
 func (mr *MockDomainServicesMockRecorder) SecretBackend() *MockDomainServicesSecretBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service41.WatchableService](mr.mock.ctrl.T, mr.mock, "SecretBackend")
+	call := gomock.NewCall0_1[*service45.WatchableService](mr.mock.ctrl.T, mr.mock, "SecretBackend")
 	mr.secretBackendExpects = append(mr.secretBackendExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesSecretBackendCall is the typed call wrapper for SecretBackend.
-type MockDomainServicesSecretBackendCall = gomock.Call0_1[*service41.WatchableService]
+type MockDomainServicesSecretBackendCall = gomock.Call0_1[*service45.WatchableService]
 
 // Status mocks base method.
-func (m *MockDomainServices) Status() *service42.LeadershipService {
+func (m *MockDomainServices) Status() *service46.LeadershipService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.statusExpects, m.ctrl, m, "Status")
 }
@@ -1078,17 +1177,17 @@ func (m *MockDomainServices) Status() *service42.LeadershipService {
 // Status indicates an expected call of Status.
 func (mr *MockDomainServicesMockRecorder) Status() *MockDomainServicesStatusCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service42.LeadershipService](mr.mock.ctrl.T, mr.mock, "Status")
+	call := gomock.NewCall0_1[*service46.LeadershipService](mr.mock.ctrl.T, mr.mock, "Status")
 	mr.statusExpects = append(mr.statusExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesStatusCall is the typed call wrapper for Status.
-type MockDomainServicesStatusCall = gomock.Call0_1[*service42.LeadershipService]
+type MockDomainServicesStatusCall = gomock.Call0_1[*service46.LeadershipService]
 
 // Storage mocks base method.
-func (m *MockDomainServices) Storage() *service43.Service {
+func (m *MockDomainServices) Storage() *service47.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.storageExpects, m.ctrl, m, "Storage")
 }
@@ -1096,17 +1195,17 @@ func (m *MockDomainServices) Storage() *service43.Service {
 // Storage indicates an expected call of Storage.
 func (mr *MockDomainServicesMockRecorder) Storage() *MockDomainServicesStorageCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service43.Service](mr.mock.ctrl.T, mr.mock, "Storage")
+	call := gomock.NewCall0_1[*service47.Service](mr.mock.ctrl.T, mr.mock, "Storage")
 	mr.storageExpects = append(mr.storageExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesStorageCall is the typed call wrapper for Storage.
-type MockDomainServicesStorageCall = gomock.Call0_1[*service43.Service]
+type MockDomainServicesStorageCall = gomock.Call0_1[*service47.Service]
 
 // StorageProvisioning mocks base method.
-func (m *MockDomainServices) StorageProvisioning() *service44.Service {
+func (m *MockDomainServices) StorageProvisioning() *service48.Service {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.storageProvisioningExpects, m.ctrl, m, "StorageProvisioning")
 }
@@ -1114,17 +1213,17 @@ func (m *MockDomainServices) StorageProvisioning() *service44.Service {
 // StorageProvisioning indicates an expected call of StorageProvisioning.
 func (mr *MockDomainServicesMockRecorder) StorageProvisioning() *MockDomainServicesStorageProvisioningCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service44.Service](mr.mock.ctrl.T, mr.mock, "StorageProvisioning")
+	call := gomock.NewCall0_1[*service48.Service](mr.mock.ctrl.T, mr.mock, "StorageProvisioning")
 	mr.storageProvisioningExpects = append(mr.storageProvisioningExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesStorageProvisioningCall is the typed call wrapper for StorageProvisioning.
-type MockDomainServicesStorageProvisioningCall = gomock.Call0_1[*service44.Service]
+type MockDomainServicesStorageProvisioningCall = gomock.Call0_1[*service48.Service]
 
 // Tracing mocks base method.
-func (m *MockDomainServices) Tracing() *service45.WatchableService {
+func (m *MockDomainServices) Tracing() *service49.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.tracingExpects, m.ctrl, m, "Tracing")
 }
@@ -1132,17 +1231,17 @@ func (m *MockDomainServices) Tracing() *service45.WatchableService {
 // Tracing indicates an expected call of Tracing.
 func (mr *MockDomainServicesMockRecorder) Tracing() *MockDomainServicesTracingCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service45.WatchableService](mr.mock.ctrl.T, mr.mock, "Tracing")
+	call := gomock.NewCall0_1[*service49.WatchableService](mr.mock.ctrl.T, mr.mock, "Tracing")
 	mr.tracingExpects = append(mr.tracingExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesTracingCall is the typed call wrapper for Tracing.
-type MockDomainServicesTracingCall = gomock.Call0_1[*service45.WatchableService]
+type MockDomainServicesTracingCall = gomock.Call0_1[*service49.WatchableService]
 
 // UnitState mocks base method.
-func (m *MockDomainServices) UnitState() *service47.LeadershipService {
+func (m *MockDomainServices) UnitState() *service51.LeadershipService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.unitStateExpects, m.ctrl, m, "UnitState")
 }
@@ -1150,17 +1249,17 @@ func (m *MockDomainServices) UnitState() *service47.LeadershipService {
 // UnitState indicates an expected call of UnitState.
 func (mr *MockDomainServicesMockRecorder) UnitState() *MockDomainServicesUnitStateCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service47.LeadershipService](mr.mock.ctrl.T, mr.mock, "UnitState")
+	call := gomock.NewCall0_1[*service51.LeadershipService](mr.mock.ctrl.T, mr.mock, "UnitState")
 	mr.unitStateExpects = append(mr.unitStateExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesUnitStateCall is the typed call wrapper for UnitState.
-type MockDomainServicesUnitStateCall = gomock.Call0_1[*service47.LeadershipService]
+type MockDomainServicesUnitStateCall = gomock.Call0_1[*service51.LeadershipService]
 
 // Unitless mocks base method.
-func (m *MockDomainServices) Unitless() *service46.WatchableService {
+func (m *MockDomainServices) Unitless() *service50.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.unitlessExpects, m.ctrl, m, "Unitless")
 }
@@ -1168,17 +1267,17 @@ func (m *MockDomainServices) Unitless() *service46.WatchableService {
 // Unitless indicates an expected call of Unitless.
 func (mr *MockDomainServicesMockRecorder) Unitless() *MockDomainServicesUnitlessCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service46.WatchableService](mr.mock.ctrl.T, mr.mock, "Unitless")
+	call := gomock.NewCall0_1[*service50.WatchableService](mr.mock.ctrl.T, mr.mock, "Unitless")
 	mr.unitlessExpects = append(mr.unitlessExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesUnitlessCall is the typed call wrapper for Unitless.
-type MockDomainServicesUnitlessCall = gomock.Call0_1[*service46.WatchableService]
+type MockDomainServicesUnitlessCall = gomock.Call0_1[*service50.WatchableService]
 
 // Upgrade mocks base method.
-func (m *MockDomainServices) Upgrade() *service48.WatchableService {
+func (m *MockDomainServices) Upgrade() *service52.WatchableService {
 	m.ctrl.T.Helper()
 	return gomock.Dispatch0_1(&m.recorder.upgradeExpects, m.ctrl, m, "Upgrade")
 }
@@ -1186,14 +1285,14 @@ func (m *MockDomainServices) Upgrade() *service48.WatchableService {
 // Upgrade indicates an expected call of Upgrade.
 func (mr *MockDomainServicesMockRecorder) Upgrade() *MockDomainServicesUpgradeCall {
 	mr.mock.ctrl.T.Helper()
-	call := gomock.NewCall0_1[*service48.WatchableService](mr.mock.ctrl.T, mr.mock, "Upgrade")
+	call := gomock.NewCall0_1[*service52.WatchableService](mr.mock.ctrl.T, mr.mock, "Upgrade")
 	mr.upgradeExpects = append(mr.upgradeExpects, call)
 	mr.mock.ctrl.Track(call.Call)
 	return call
 }
 
 // MockDomainServicesUpgradeCall is the typed call wrapper for Upgrade.
-type MockDomainServicesUpgradeCall = gomock.Call0_1[*service48.WatchableService]
+type MockDomainServicesUpgradeCall = gomock.Call0_1[*service52.WatchableService]
 
 // MockDomainServicesGetter is a mock of DomainServicesGetter interface.
 type MockDomainServicesGetter struct {
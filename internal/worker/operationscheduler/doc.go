@@ -0,0 +1,13 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package operationscheduler provides a worker that runs scheduled actions,
+// for each model.
+//
+// An action is scheduled with `juju schedule-action`, which records a cron
+// expression against the action in the model database. On every tick of its
+// interval the worker asks the operation service to run the schedules which
+// have fallen due. Each run adds an action operation, just as `juju run`
+// does, which is linked to its schedule. Since schedules have a granularity of
+// a minute, the interval should be well under a minute.
+package operationscheduler
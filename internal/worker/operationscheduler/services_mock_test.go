@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/operationscheduler (interfaces: OperationService,AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -package operationscheduler -destination services_mock_test.go github.com/juju/juju/internal/worker/operationscheduler OperationService,AccessService
+//
+
+// Package operationscheduler is a generated GoMock package.
+package operationscheduler
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	permission "github.com/juju/juju/core/permission"
+	user "github.com/juju/juju/core/user"
+	service "github.com/juju/juju/domain/operation/service"
+)
+
+// MockOperationService is a mock of OperationService interface.
+type MockOperationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOperationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOperationServiceMockRecorder is the mock recorder for MockOperationService.
+type MockOperationServiceMockRecorder struct {
+	mock                   *MockOperationService
+	runDueSchedulesExpects []*gomock.Call2_1[context.Context, service.ScheduleAuthorizer, error]
+}
+
+// NewMockOperationService creates a new mock instance.
+func NewMockOperationService(ctrl *gomock.Controller) *MockOperationService {
+	mock := &MockOperationService{ctrl: ctrl}
+	mock.recorder = &MockOperationServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOperationService) EXPECT() *MockOperationServiceMockRecorder {
+	return m.recorder
+}
+
+// RunDueSchedules mocks base method.
+func (m *MockOperationService) RunDueSchedules(ctx context.Context, authorizer service.ScheduleAuthorizer) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.runDueSchedulesExpects, m.ctrl, m, "RunDueSchedules", ctx, authorizer)
+}
+
+// RunDueSchedules indicates an expected call of RunDueSchedules.
+func (mr *MockOperationServiceMockRecorder) RunDueSchedules(ctx, authorizer any) *MockOperationServiceRunDueSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, service.ScheduleAuthorizer, error](mr.mock.ctrl.T, mr.mock, "RunDueSchedules", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(authorizer))
+	mr.runDueSchedulesExpects = append(mr.runDueSchedulesExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockOperationServiceRunDueSchedulesCall is the typed call wrapper for RunDueSchedules.
+type MockOperationServiceRunDueSchedulesCall = gomock.Call2_1[context.Context, service.ScheduleAuthorizer, error]
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock                                     *MockAccessService
+	readGroupAccessLevelForTargetExpects     []*gomock.Call3_2[context.Context, []string, permission.ID, permission.Access, error]
+	readUserAccessLevelForTargetExpects      []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+	readUserGroupAccessLevelForTargetExpects []*gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// ReadGroupAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readGroupAccessLevelForTargetExpects, m.ctrl, m, "ReadGroupAccessLevelForTarget", ctx, groups, target)
+}
+
+// ReadGroupAccessLevelForTarget indicates an expected call of ReadGroupAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadGroupAccessLevelForTarget(ctx, groups, target any) *MockAccessServiceReadGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, []string, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadGroupAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(groups), gomock.EnsureMatcher(target))
+	mr.readGroupAccessLevelForTargetExpects = append(mr.readGroupAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadGroupAccessLevelForTargetCall is the typed call wrapper for ReadGroupAccessLevelForTarget.
+type MockAccessServiceReadGroupAccessLevelForTargetCall = gomock.Call3_2[context.Context, []string, permission.ID, permission.Access, error]
+
+// ReadUserAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readUserAccessLevelForTargetExpects, m.ctrl, m, "ReadUserAccessLevelForTarget", ctx, subject, target)
+}
+
+// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadUserAccessLevelForTarget(ctx, subject, target any) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, user.Name, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadUserAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(subject), gomock.EnsureMatcher(target))
+	mr.readUserAccessLevelForTargetExpects = append(mr.readUserAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadUserAccessLevelForTargetCall is the typed call wrapper for ReadUserAccessLevelForTarget.
+type MockAccessServiceReadUserAccessLevelForTargetCall = gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
+
+// ReadUserGroupAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadUserGroupAccessLevelForTarget(ctx context.Context, name user.Name, target permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch3_2(&m.recorder.readUserGroupAccessLevelForTargetExpects, m.ctrl, m, "ReadUserGroupAccessLevelForTarget", ctx, name, target)
+}
+
+// ReadUserGroupAccessLevelForTarget indicates an expected call of ReadUserGroupAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadUserGroupAccessLevelForTarget(ctx, name, target any) *MockAccessServiceReadUserGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall3_2[context.Context, user.Name, permission.ID, permission.Access, error](mr.mock.ctrl.T, mr.mock, "ReadUserGroupAccessLevelForTarget", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(name), gomock.EnsureMatcher(target))
+	mr.readUserGroupAccessLevelForTargetExpects = append(mr.readUserGroupAccessLevelForTargetExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceReadUserGroupAccessLevelForTargetCall is the typed call wrapper for ReadUserGroupAccessLevelForTarget.
+type MockAccessServiceReadUserGroupAccessLevelForTargetCall = gomock.Call3_2[context.Context, user.Name, permission.ID, permission.Access, error]
@@ -0,0 +1,232 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operationscheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	operationservice "github.com/juju/juju/domain/operation/service"
+	"github.com/juju/juju/internal/errors"
+)
+
+// OperationService provides access to the scheduled actions of a model.
+type OperationService interface {
+	// RunDueSchedules adds an action operation for each schedule which has
+	// fallen due, and records when the schedule next falls due. Each action
+	// is only run if the authorizer allows the creator of its schedule.
+	RunDueSchedules(ctx context.Context, authorizer operationservice.ScheduleAuthorizer) error
+}
+
+// AccessService provides access to the permissions of the controller's users.
+type AccessService interface {
+	// ReadUserAccessLevelForTarget returns the access level of the user on
+	// the target.
+	ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error)
+
+	// ReadUserGroupAccessLevelForTarget returns the greatest access level
+	// granted on the target to the groups the user is a member of.
+	ReadUserGroupAccessLevelForTarget(ctx context.Context, name user.Name, target permission.ID) (permission.Access, error)
+
+	// ReadGroupAccessLevelForTarget returns the greatest access level
+	// granted on the target to any of the given groups.
+	ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error)
+}
+
+// Config is the configuration for the operation scheduler worker.
+type Config struct {
+	ControllerUUID   string
+	ModelUUID        model.UUID
+	Clock            clock.Clock
+	OperationService OperationService
+	AccessService    AccessService
+	Logger           logger.Logger
+
+	// Interval is the interval at which the model is checked for scheduled
+	// actions which have fallen due.
+	Interval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ControllerUUID == "" {
+		return errors.Errorf("empty ControllerUUID").Add(coreerrors.NotValid)
+	}
+	if config.ModelUUID == "" {
+		return errors.Errorf("empty ModelUUID").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.OperationService == nil {
+		return errors.Errorf("nil OperationService").Add(coreerrors.NotValid)
+	}
+	if config.AccessService == nil {
+		return errors.Errorf("nil AccessService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.Interval <= 0 {
+		return errors.Errorf("interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// schedulerWorker is a worker that runs scheduled actions.
+type schedulerWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu      sync.Mutex
+	lastRun time.Time
+}
+
+// NewWorker returns a new operation scheduler worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	w := &schedulerWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "operation-scheduler",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *schedulerWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *schedulerWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *schedulerWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"interval": w.config.Interval,
+		"last-run": w.lastRun,
+	}
+}
+
+// loop is the worker's main loop. It runs the model's scheduled actions which
+// have fallen due on every tick of the interval.
+func (w *schedulerWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(w.config.Interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.config.OperationService.RunDueSchedules(ctx, w); err != nil {
+				return errors.Errorf("running scheduled actions: %w", err)
+			}
+			w.mu.Lock()
+			w.lastRun = w.config.Clock.Now()
+			w.mu.Unlock()
+			timer.Reset(w.config.Interval)
+		}
+	}
+}
+
+// CanRunActions reports whether the user has write access to the model, or
+// is a controller superuser, and so may still run the actions they scheduled.
+// As in the API server, the user's access is the greatest of that granted to
+// them directly, to the groups they are a member of in Juju, and to the given
+// groups the identity provider reported them to be a member of when the
+// schedule was added. Access is checked each time a schedule falls due, so
+// that a schedule stops running once its creator's access is revoked or
+// expires.
+func (w *schedulerWorker) CanRunActions(ctx context.Context, name user.Name, groups []string) (bool, error) {
+	modelAccess, err := w.accessLevel(ctx, name, groups, permission.ID{
+		ObjectType: permission.Model,
+		Key:        w.config.ModelUUID.String(),
+	})
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	if modelAccess.EqualOrGreaterModelAccessThan(permission.WriteAccess) {
+		return true, nil
+	}
+
+	controllerAccess, err := w.accessLevel(ctx, name, groups, permission.ID{
+		ObjectType: permission.Controller,
+		Key:        w.config.ControllerUUID,
+	})
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	return controllerAccess.EqualOrGreaterControllerAccessThan(permission.SuperuserAccess), nil
+}
+
+// accessLevel returns the greatest access on the target granted to the user,
+// to the groups they are a member of in Juju, and to the given groups.
+func (w *schedulerWorker) accessLevel(
+	ctx context.Context, name user.Name, groups []string, target permission.ID,
+) (permission.Access, error) {
+	notFound := []error{accesserrors.AccessNotFound, accesserrors.UserNotFound, accesserrors.PermissionNotFound}
+
+	access, err := w.config.AccessService.ReadUserAccessLevelForTarget(ctx, name, target)
+	if errors.IsOneOf(err, notFound...) {
+		access = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Capture(err)
+	}
+
+	groupAccess, err := w.config.AccessService.ReadUserGroupAccessLevelForTarget(ctx, name, target)
+	if errors.IsOneOf(err, notFound...) {
+		groupAccess = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Capture(err)
+	}
+	access = greaterAccess(target, access, groupAccess)
+
+	if len(groups) == 0 {
+		return access, nil
+	}
+	groupAccess, err = w.config.AccessService.ReadGroupAccessLevelForTarget(ctx, groups, target)
+	if errors.IsOneOf(err, notFound...) {
+		groupAccess = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Capture(err)
+	}
+	return greaterAccess(target, access, groupAccess), nil
+}
+
+// greaterAccess returns the greater of the two access levels on the target.
+func greaterAccess(target permission.ID, a, b permission.Access) permission.Access {
+	if b == permission.NoAccess {
+		return a
+	}
+	if a == permission.NoAccess || !(permission.AccessSpec{Target: target, Access: a}).EqualOrGreaterThan(b) {
+		return b
+	}
+	return a
+}
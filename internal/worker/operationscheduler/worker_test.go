@@ -0,0 +1,309 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operationscheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	coretesting "github.com/juju/juju/core/testing"
+	usertesting "github.com/juju/juju/core/user/testing"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	operationservice "github.com/juju/juju/domain/operation/service"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	jujutesting "github.com/juju/juju/internal/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+// TestConfigValidation tests that the config is validated correctly.
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		ControllerUUID:   jujutesting.ControllerTag.Id(),
+		ModelUUID:        tc.Must(c, model.NewUUID),
+		Clock:            testclock.NewClock(time.Now()),
+		OperationService: NewMockOperationService(ctrl),
+		AccessService:    NewMockAccessService(ctrl),
+		Logger:           loggertesting.WrapCheckLog(c),
+		Interval:         time.Second,
+	}
+
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.ControllerUUID = ""
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "empty ControllerUUID.*")
+
+	testCfg = origCfg
+	testCfg.ModelUUID = ""
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "empty ModelUUID.*")
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.OperationService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil OperationService.*")
+
+	testCfg = origCfg
+	testCfg.AccessService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil AccessService.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.Interval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "interval must be positive.*")
+}
+
+type workerSuite struct{}
+
+// TestRunsOnInterval tests that the worker runs due scheduled actions on
+// every tick of its interval.
+func (s *workerSuite) TestRunsOnInterval(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	clock := testclock.NewClock(time.Now())
+	service := NewMockOperationService(ctrl)
+
+	ran := make(chan struct{})
+	service.EXPECT().RunDueSchedules(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, operationservice.ScheduleAuthorizer) error {
+		ran <- struct{}{}
+		return nil
+	}).Times(2)
+
+	w, err := NewWorker(Config{
+		ControllerUUID:   jujutesting.ControllerTag.Id(),
+		ModelUUID:        tc.Must(c, model.NewUUID),
+		Clock:            clock,
+		OperationService: service,
+		AccessService:    NewMockAccessService(ctrl),
+		Logger:           loggertesting.WrapCheckLog(c),
+		Interval:         time.Minute,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	for range 2 {
+		err := clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+		c.Assert(err, tc.ErrorIsNil)
+		select {
+		case <-ran:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("scheduled actions not run")
+		}
+	}
+}
+
+// TestRunError tests that the worker dies if scheduled actions can't be
+// run.
+func (s *workerSuite) TestRunError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	clock := testclock.NewClock(time.Now())
+	service := NewMockOperationService(ctrl)
+	service.EXPECT().RunDueSchedules(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	w, err := NewWorker(Config{
+		ControllerUUID:   jujutesting.ControllerTag.Id(),
+		ModelUUID:        tc.Must(c, model.NewUUID),
+		Clock:            clock,
+		OperationService: service,
+		AccessService:    NewMockAccessService(ctrl),
+		Logger:           loggertesting.WrapCheckLog(c),
+		Interval:         time.Minute,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	err = clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, w)
+	c.Assert(err, tc.ErrorMatches, "running scheduled actions: boom")
+}
+
+// TestCanRunActions tests that a schedule's creator may only run actions
+// while they have write access to the model.
+func (s *workerSuite) TestCanRunActions(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must(c, model.NewUUID)
+	fred := usertesting.GenNewName(c, "fred")
+	modelTarget := permission.ID{ObjectType: permission.Model, Key: modelUUID.String()}
+	controllerTarget := permission.ID{ObjectType: permission.Controller, Key: jujutesting.ControllerTag.Id()}
+
+	access := NewMockAccessService(ctrl)
+	w := &schedulerWorker{config: Config{
+		ControllerUUID: jujutesting.ControllerTag.Id(),
+		ModelUUID:      modelUUID,
+		AccessService:  access,
+	}}
+
+	tests := []struct {
+		about   string
+		access  permission.Access
+		err     error
+		allowed bool
+	}{{
+		about:   "admin",
+		access:  permission.AdminAccess,
+		allowed: true,
+	}, {
+		about:   "write",
+		access:  permission.WriteAccess,
+		allowed: true,
+	}, {
+		about:  "read",
+		access: permission.ReadAccess,
+	}, {
+		about: "revoked or expired",
+		err:   accesserrors.AccessNotFound,
+	}, {
+		about: "user removed",
+		err:   accesserrors.UserNotFound,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.about)
+		access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), fred, modelTarget).Return(test.access, test.err)
+		access.EXPECT().ReadUserGroupAccessLevelForTarget(gomock.Any(), fred, modelTarget).Return(
+			permission.NoAccess, accesserrors.AccessNotFound)
+		if !test.allowed {
+			access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), fred, controllerTarget).Return(
+				permission.NoAccess, accesserrors.AccessNotFound)
+			access.EXPECT().ReadUserGroupAccessLevelForTarget(gomock.Any(), fred, controllerTarget).Return(
+				permission.NoAccess, accesserrors.AccessNotFound)
+		}
+
+		allowed, err := w.CanRunActions(c.Context(), fred, nil)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(allowed, tc.Equals, test.allowed)
+	}
+}
+
+// TestCanRunActionsGroupAccess tests that a schedule's creator may run
+// actions when their write access to the model comes only from a group they
+// are a member of in Juju.
+func (s *workerSuite) TestCanRunActionsGroupAccess(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must(c, model.NewUUID)
+	fred := usertesting.GenNewName(c, "fred")
+	target := permission.ID{ObjectType: permission.Model, Key: modelUUID.String()}
+
+	access := NewMockAccessService(ctrl)
+	access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), fred, target).Return(
+		permission.ReadAccess, nil)
+	access.EXPECT().ReadUserGroupAccessLevelForTarget(gomock.Any(), fred, target).Return(
+		permission.WriteAccess, nil)
+	w := &schedulerWorker{config: Config{
+		ControllerUUID: jujutesting.ControllerTag.Id(),
+		ModelUUID:      modelUUID,
+		AccessService:  access,
+	}}
+
+	allowed, err := w.CanRunActions(c.Context(), fred, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(allowed, tc.IsTrue)
+}
+
+// TestCanRunActionsCreatorGroupAccess tests that a schedule's creator may
+// run actions when their write access to the model comes only from the
+// groups the identity provider reported them to be a member of.
+func (s *workerSuite) TestCanRunActionsCreatorGroupAccess(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must(c, model.NewUUID)
+	fred := usertesting.GenNewName(c, "fred@external")
+	target := permission.ID{ObjectType: permission.Model, Key: modelUUID.String()}
+
+	access := NewMockAccessService(ctrl)
+	access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), fred, target).Return(
+		permission.NoAccess, accesserrors.UserNotFound)
+	access.EXPECT().ReadUserGroupAccessLevelForTarget(gomock.Any(), fred, target).Return(
+		permission.NoAccess, accesserrors.UserNotFound)
+	access.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), []string{"ops"}, target).Return(
+		permission.WriteAccess, nil)
+	w := &schedulerWorker{config: Config{
+		ControllerUUID: jujutesting.ControllerTag.Id(),
+		ModelUUID:      modelUUID,
+		AccessService:  access,
+	}}
+
+	allowed, err := w.CanRunActions(c.Context(), fred, []string{"ops"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(allowed, tc.IsTrue)
+}
+
+// TestCanRunActionsSuperuser tests that a controller superuser may run
+// actions without any access to the model.
+func (s *workerSuite) TestCanRunActionsSuperuser(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelUUID := tc.Must(c, model.NewUUID)
+	fred := usertesting.GenNewName(c, "fred")
+	modelTarget := permission.ID{ObjectType: permission.Model, Key: modelUUID.String()}
+	controllerTarget := permission.ID{ObjectType: permission.Controller, Key: jujutesting.ControllerTag.Id()}
+
+	access := NewMockAccessService(ctrl)
+	access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), fred, modelTarget).Return(
+		permission.NoAccess, accesserrors.AccessNotFound)
+	access.EXPECT().ReadUserGroupAccessLevelForTarget(gomock.Any(), fred, modelTarget).Return(
+		permission.NoAccess, accesserrors.AccessNotFound)
+	access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), fred, controllerTarget).Return(
+		permission.LoginAccess, nil)
+	access.EXPECT().ReadUserGroupAccessLevelForTarget(gomock.Any(), fred, controllerTarget).Return(
+		permission.SuperuserAccess, nil)
+	w := &schedulerWorker{config: Config{
+		ControllerUUID: jujutesting.ControllerTag.Id(),
+		ModelUUID:      modelUUID,
+		AccessService:  access,
+	}}
+
+	allowed, err := w.CanRunActions(c.Context(), fred, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(allowed, tc.IsTrue)
+}
+
+// TestCanRunActionsError tests that errors checking access are returned.
+func (s *workerSuite) TestCanRunActionsError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	access := NewMockAccessService(ctrl)
+	access.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		permission.NoAccess, errors.New("boom"))
+	w := &schedulerWorker{config: Config{
+		ControllerUUID: jujutesting.ControllerTag.Id(),
+		ModelUUID:      tc.Must(c, model.NewUUID),
+		AccessService:  access,
+	}}
+
+	_, err := w.CanRunActions(c.Context(), usertesting.GenNewName(c, "fred"), nil)
+	c.Check(err, tc.ErrorMatches, "boom")
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/otlp (interfaces: Client)
+//
+// Generated by this command:
+//
+//	mockgen -package otelexport -destination client_mock_test.go github.com/juju/juju/internal/otlp Client
+//
+
+// Package otelexport is a generated GoMock package.
+package otelexport
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	v1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	v10 "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+	isgomock struct{}
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock                 *MockClient
+	closeExpects         []*gomock.Call0_1[error]
+	exportLogsExpects    []*gomock.Call2_1[context.Context, []*v1.ResourceLogs, error]
+	exportMetricsExpects []*gomock.Call2_1[context.Context, []*v10.ResourceMetrics, error]
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockClient) Close() error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch0_1(&m.recorder.closeExpects, m.ctrl, m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockClientMockRecorder) Close() *MockClientCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall0_1[error](mr.mock.ctrl.T, mr.mock, "Close")
+	mr.closeExpects = append(mr.closeExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockClientCloseCall is the typed call wrapper for Close.
+type MockClientCloseCall = gomock.Call0_1[error]
+
+// ExportLogs mocks base method.
+func (m *MockClient) ExportLogs(ctx context.Context, logs []*v1.ResourceLogs) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.exportLogsExpects, m.ctrl, m, "ExportLogs", ctx, logs)
+}
+
+// ExportLogs indicates an expected call of ExportLogs.
+func (mr *MockClientMockRecorder) ExportLogs(ctx, logs any) *MockClientExportLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, []*v1.ResourceLogs, error](mr.mock.ctrl.T, mr.mock, "ExportLogs", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(logs))
+	mr.exportLogsExpects = append(mr.exportLogsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockClientExportLogsCall is the typed call wrapper for ExportLogs.
+type MockClientExportLogsCall = gomock.Call2_1[context.Context, []*v1.ResourceLogs, error]
+
+// ExportMetrics mocks base method.
+func (m *MockClient) ExportMetrics(ctx context.Context, metrics []*v10.ResourceMetrics) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch2_1(&m.recorder.exportMetricsExpects, m.ctrl, m, "ExportMetrics", ctx, metrics)
+}
+
+// ExportMetrics indicates an expected call of ExportMetrics.
+func (mr *MockClientMockRecorder) ExportMetrics(ctx, metrics any) *MockClientExportMetricsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall2_1[context.Context, []*v10.ResourceMetrics, error](mr.mock.ctrl.T, mr.mock, "ExportMetrics", gomock.EnsureMatcher(ctx), gomock.EnsureMatcher(metrics))
+	mr.exportMetricsExpects = append(mr.exportMetricsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockClientExportMetricsCall is the typed call wrapper for ExportMetrics.
+type MockClientExportMetricsCall = gomock.Call2_1[context.Context, []*v10.ResourceMetrics, error]
@@ -0,0 +1,18 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package otelexport provides a worker that exports the controller's
+// metrics to an OTEL collector over OTLP, alongside the traces sent by the
+// trace worker.
+//
+// The worker uses the workload tracing configuration held in the controller
+// database, so metrics share the collector endpoint and TLS settings with
+// tracing. Exporting is enabled through the export-metrics setting, and the
+// worker restarts its exporter whenever the configuration changes.
+//
+// Metrics are gathered from the controller agent's Prometheus registry, so
+// everything served on the Prometheus endpoint is also pushed to the
+// collector. Logs are not exported here: when the export-logs setting is
+// enabled, every agent is given the same collector and TLS settings, and
+// sends its logs there through the log router's OTLP backend instead.
+package otelexport
@@ -0,0 +1,102 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otelexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5/catacomb"
+	"github.com/prometheus/client_golang/prometheus"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/otlp"
+)
+
+type exporterConfig struct {
+	client   otlp.Client
+	resource *resourcev1.Resource
+	gatherer prometheus.Gatherer
+
+	metricsInterval time.Duration
+
+	clock  clock.Clock
+	logger logger.Logger
+}
+
+// exporter pushes metrics to a single collector. It owns the
+// client and closes it when it stops.
+type exporter struct {
+	catacomb catacomb.Catacomb
+	config   exporterConfig
+
+	started time.Time
+}
+
+func newExporter(config exporterConfig) (*exporter, error) {
+	e := &exporter{
+		config:  config,
+		started: config.clock.Now(),
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "otel-exporter",
+		Site: &e.catacomb,
+		Work: e.loop,
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return e, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (e *exporter) Kill() {
+	e.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (e *exporter) Wait() error {
+	return e.catacomb.Wait()
+}
+
+func (e *exporter) loop() error {
+	defer func() {
+		_ = e.config.client.Close()
+	}()
+
+	ctx := e.catacomb.Context(context.Background())
+
+	metricsTimer := e.config.clock.After(e.config.metricsInterval)
+	for {
+		select {
+		case <-e.catacomb.Dying():
+			return e.catacomb.ErrDying()
+
+		case <-metricsTimer:
+			e.exportMetrics(ctx)
+			metricsTimer = e.config.clock.After(e.config.metricsInterval)
+		}
+	}
+}
+
+// exportMetrics gathers and exports the current metrics. Failures are
+// logged, as the next interval exports the cumulative values again.
+func (e *exporter) exportMetrics(ctx context.Context) {
+	families, err := e.config.gatherer.Gather()
+	if err != nil {
+		// Gather returns as many families as it can alongside the error.
+		e.config.logger.Warningf(ctx, "gathering metrics: %v", err)
+	}
+	if len(families) == 0 {
+		return
+	}
+
+	resourceMetrics := otlp.NewResourceMetrics(e.config.resource, families, e.started, e.config.clock.Now())
+	if err := e.config.client.ExportMetrics(ctx, []*metricsv1.ResourceMetrics{resourceMetrics}); err != nil {
+		e.config.logger.Warningf(ctx, "exporting metrics to OTLP collector: %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otelexport
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/otlp"
+	"github.com/juju/juju/internal/services"
+)
+
+// serviceName identifies the controller in the exported resource, matching
+// the service name used for controller traces.
+const serviceName = "juju-controller"
+
+// GetTracingServiceFunc returns the controller tracing service from the
+// dependency getter.
+type GetTracingServiceFunc func(getter dependency.Getter, name string) (TracingService, error)
+
+// ManifoldConfig defines the configuration for the OTEL export manifold.
+type ManifoldConfig struct {
+	Tag               names.Tag
+	TraceServicesName string
+	Gatherer          prometheus.Gatherer
+	Clock             clock.Clock
+	Logger            logger.Logger
+	GetTracingService GetTracingServiceFunc
+	NewWorker         func(Config) (worker.Worker, error)
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.Tag == nil {
+		return errors.NotValidf("nil Tag")
+	}
+	if config.TraceServicesName == "" {
+		return errors.NotValidf("empty TraceServicesName")
+	}
+	if config.Gatherer == nil {
+		return errors.NotValidf("nil Gatherer")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.GetTracingService == nil {
+		return errors.NotValidf("nil GetTracingService")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// Manifold returns a dependency manifold that runs the OTEL export worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.TraceServicesName,
+		},
+		Start: func(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+			if err := config.Validate(); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			tracingService, err := config.GetTracingService(getter, config.TraceServicesName)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			w, err := config.NewWorker(Config{
+				TracingService:  tracingService,
+				Gatherer:        config.Gatherer,
+				NewClient:       otlp.NewClient,
+				ServiceName:     serviceName,
+				InstanceID:      config.Tag.String(),
+				MetricsInterval: DefaultMetricsInterval,
+				Clock:           config.Clock,
+				Logger:          config.Logger,
+			})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return w, nil
+		},
+	}
+}
+
+// GetTracingService returns the controller tracing service from the
+// dependency getter.
+func GetTracingService(getter dependency.Getter, name string) (TracingService, error) {
+	var traceServices services.TraceServices
+	if err := getter.Get(name, &traceServices); err != nil {
+		return nil, err
+	}
+	return traceServices.Tracing(), nil
+}
@@ -0,0 +1,7 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otelexport
+
+//go:generate go run github.com/canonical/gomock/mockgen -package otelexport -destination services_mock_test.go github.com/juju/juju/internal/worker/otelexport TracingService
+//go:generate go run github.com/canonical/gomock/mockgen -package otelexport -destination client_mock_test.go github.com/juju/juju/internal/otlp Client
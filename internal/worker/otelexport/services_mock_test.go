@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/otelexport (interfaces: TracingService)
+//
+// Generated by this command:
+//
+//	mockgen -package otelexport -destination services_mock_test.go github.com/juju/juju/internal/worker/otelexport TracingService
+//
+
+// Package otelexport is a generated GoMock package.
+package otelexport
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	watcher "github.com/juju/juju/core/watcher"
+	service "github.com/juju/juju/domain/tracing/service"
+)
+
+// MockTracingService is a mock of TracingService interface.
+type MockTracingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTracingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTracingServiceMockRecorder is the mock recorder for MockTracingService.
+type MockTracingServiceMockRecorder struct {
+	mock                              *MockTracingService
+	getWorkloadTracingConfigExpects   []*gomock.Call1_2[context.Context, service.WorkloadTracingConfig, error]
+	watchWorkloadTracingConfigExpects []*gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
+}
+
+// NewMockTracingService creates a new mock instance.
+func NewMockTracingService(ctrl *gomock.Controller) *MockTracingService {
+	mock := &MockTracingService{ctrl: ctrl}
+	mock.recorder = &MockTracingServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTracingService) EXPECT() *MockTracingServiceMockRecorder {
+	return m.recorder
+}
+
+// GetWorkloadTracingConfig mocks base method.
+func (m *MockTracingService) GetWorkloadTracingConfig(ctx context.Context) (service.WorkloadTracingConfig, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.getWorkloadTracingConfigExpects, m.ctrl, m, "GetWorkloadTracingConfig", ctx)
+}
+
+// GetWorkloadTracingConfig indicates an expected call of GetWorkloadTracingConfig.
+func (mr *MockTracingServiceMockRecorder) GetWorkloadTracingConfig(ctx any) *MockTracingServiceGetWorkloadTracingConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, service.WorkloadTracingConfig, error](mr.mock.ctrl.T, mr.mock, "GetWorkloadTracingConfig", gomock.EnsureMatcher(ctx))
+	mr.getWorkloadTracingConfigExpects = append(mr.getWorkloadTracingConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockTracingServiceGetWorkloadTracingConfigCall is the typed call wrapper for GetWorkloadTracingConfig.
+type MockTracingServiceGetWorkloadTracingConfigCall = gomock.Call1_2[context.Context, service.WorkloadTracingConfig, error]
+
+// WatchWorkloadTracingConfig mocks base method.
+func (m *MockTracingService) WatchWorkloadTracingConfig(ctx context.Context) (watcher.NotifyWatcher, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.watchWorkloadTracingConfigExpects, m.ctrl, m, "WatchWorkloadTracingConfig", ctx)
+}
+
+// WatchWorkloadTracingConfig indicates an expected call of WatchWorkloadTracingConfig.
+func (mr *MockTracingServiceMockRecorder) WatchWorkloadTracingConfig(ctx any) *MockTracingServiceWatchWorkloadTracingConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, watcher.NotifyWatcher, error](mr.mock.ctrl.T, mr.mock, "WatchWorkloadTracingConfig", gomock.EnsureMatcher(ctx))
+	mr.watchWorkloadTracingConfigExpects = append(mr.watchWorkloadTracingConfigExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockTracingServiceWatchWorkloadTracingConfigCall is the typed call wrapper for WatchWorkloadTracingConfig.
+type MockTracingServiceWatchWorkloadTracingConfigCall = gomock.Call1_2[context.Context, watcher.NotifyWatcher, error]
@@ -0,0 +1,237 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otelexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	tracingservice "github.com/juju/juju/domain/tracing/service"
+	"github.com/juju/juju/internal/otlp"
+)
+
+// DefaultMetricsInterval is the time between exporting metrics.
+const DefaultMetricsInterval = time.Minute
+
+// TracingService provides the workload tracing configuration, which holds
+// the collector endpoint and TLS settings shared by every signal.
+type TracingService interface {
+	// GetWorkloadTracingConfig returns the workload tracing config.
+	GetWorkloadTracingConfig(ctx context.Context) (tracingservice.WorkloadTracingConfig, error)
+
+	// WatchWorkloadTracingConfig returns a watcher that emits notifications
+	// when the workload tracing configuration changes.
+	WatchWorkloadTracingConfig(ctx context.Context) (watcher.NotifyWatcher, error)
+}
+
+// NewClientFunc returns an OTLP client for the given config.
+type NewClientFunc func(otlp.Config) (otlp.Client, error)
+
+// Config holds the configuration for the OTEL export worker.
+type Config struct {
+	// TracingService provides the collector configuration.
+	TracingService TracingService
+	// Gatherer is the source of the exported metrics.
+	Gatherer prometheus.Gatherer
+	// NewClient creates the OTLP client for the collector.
+	NewClient NewClientFunc
+
+	// ServiceName and InstanceID identify the controller agent in the
+	// exported resource.
+	ServiceName string
+	InstanceID  string
+
+	MetricsInterval time.Duration
+
+	Clock  clock.Clock
+	Logger logger.Logger
+}
+
+// Validate returns an error if the config cannot be used to start a worker.
+func (config Config) Validate() error {
+	if config.TracingService == nil {
+		return errors.NotValidf("nil TracingService")
+	}
+	if config.Gatherer == nil {
+		return errors.NotValidf("nil Gatherer")
+	}
+	if config.NewClient == nil {
+		return errors.NotValidf("nil NewClient")
+	}
+	if config.ServiceName == "" {
+		return errors.NotValidf("empty ServiceName")
+	}
+	if config.InstanceID == "" {
+		return errors.NotValidf("empty InstanceID")
+	}
+	if config.MetricsInterval <= 0 {
+		return errors.NotValidf("non-positive MetricsInterval")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// exportConfig is the part of the workload tracing config that determines
+// whether metrics are exported and where to.
+type exportConfig struct {
+	client  otlp.Config
+	metrics bool
+}
+
+func (c exportConfig) enabled() bool {
+	return c.client.Enabled() && c.metrics
+}
+
+func exportConfigFromTracingConfig(config tracingservice.WorkloadTracingConfig) exportConfig {
+	result := exportConfig{
+		client: otlp.Config{
+			HTTPEndpoint:  config.HTTPEndpoint,
+			GRPCEndpoint:  config.GRPCEndpoint,
+			CACertificate: config.CACertificate,
+		},
+	}
+	if config.InsecureSkipVerify != nil {
+		result.client.InsecureSkipVerify = *config.InsecureSkipVerify
+	}
+	if config.ExportMetrics != nil {
+		result.metrics = *config.ExportMetrics
+	}
+	return result
+}
+
+type exportWorker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+
+	current  exportConfig
+	exporter worker.Worker
+}
+
+// NewWorker returns a worker that exports metrics to the collector
+// configured in the workload tracing config.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &exportWorker{
+		config: config,
+	}
+	if err := catacomb.Invoke(catacomb.Plan{
+		Name: "otel-export",
+		Site: &w.catacomb,
+		Work: w.loop,
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *exportWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *exportWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report returns what is currently being exported.
+func (w *exportWorker) Report(context.Context) map[string]any {
+	return map[string]any{
+		"http-endpoint": w.current.client.HTTPEndpoint,
+		"grpc-endpoint": w.current.client.GRPCEndpoint,
+		"metrics":       w.current.metrics,
+	}
+}
+
+func (w *exportWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	configWatcher, err := w.config.TracingService.WatchWorkloadTracingConfig(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(configWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New("workload tracing config watcher closed")
+			}
+			tracingConfig, err := w.config.TracingService.GetWorkloadTracingConfig(ctx)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if err := w.applyConfig(ctx, exportConfigFromTracingConfig(tracingConfig)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// applyConfig restarts the exporter if the export config has changed. A
+// config that cannot be used is logged rather than stopping the worker, so
+// that it can be corrected without bouncing the controller.
+func (w *exportWorker) applyConfig(ctx context.Context, next exportConfig) error {
+	if w.exporter != nil && next == w.current {
+		return nil
+	}
+
+	if w.exporter != nil {
+		if err := worker.Stop(w.exporter); err != nil {
+			w.config.Logger.Warningf(ctx, "stopping OTLP exporter: %v", err)
+		}
+		w.exporter = nil
+	}
+	w.current = next
+	if !next.enabled() {
+		w.config.Logger.Infof(ctx, "OTLP metrics export disabled")
+		return nil
+	}
+
+	client, err := w.config.NewClient(next.client)
+	if err != nil {
+		w.config.Logger.Errorf(ctx, "creating OTLP client: %v", err)
+		return nil
+	}
+	exporter, err := newExporter(exporterConfig{
+		client:          client,
+		resource:        otlp.NewResource(w.config.ServiceName, w.config.InstanceID),
+		gatherer:        w.config.Gatherer,
+		metricsInterval: w.config.MetricsInterval,
+		clock:           w.config.Clock,
+		logger:          w.config.Logger,
+	})
+	if err != nil {
+		_ = client.Close()
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(exporter); err != nil {
+		return errors.Trace(err)
+	}
+	w.exporter = exporter
+
+	w.config.Logger.Infof(ctx, "exporting metrics to OTLP collector")
+	return nil
+}
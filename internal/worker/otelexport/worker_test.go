@@ -0,0 +1,246 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package otelexport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+	"github.com/prometheus/client_golang/prometheus"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/juju/juju/core/watcher/watchertest"
+	tracingservice "github.com/juju/juju/domain/tracing/service"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/otlp"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type workerSuite struct {
+	tracingService *MockTracingService
+	client         *MockClient
+
+	clock    *testclock.Clock
+	registry *prometheus.Registry
+	changes  chan struct{}
+}
+
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+func (s *workerSuite) TestConfigValidation(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c, nil)
+	c.Check(cfg.Validate(), tc.ErrorIsNil)
+
+	bad := cfg
+	bad.TracingService = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil TracingService not valid")
+
+	bad = cfg
+	bad.Gatherer = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Gatherer not valid")
+
+	bad = cfg
+	bad.NewClient = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil NewClient not valid")
+
+	bad = cfg
+	bad.ServiceName = ""
+	c.Check(bad.Validate(), tc.ErrorMatches, "empty ServiceName not valid")
+
+	bad = cfg
+	bad.InstanceID = ""
+	c.Check(bad.Validate(), tc.ErrorMatches, "empty InstanceID not valid")
+
+	bad = cfg
+	bad.MetricsInterval = 0
+	c.Check(bad.Validate(), tc.ErrorMatches, "non-positive MetricsInterval not valid")
+
+	bad = cfg
+	bad.Clock = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Clock not valid")
+
+	bad = cfg
+	bad.Logger = nil
+	c.Check(bad.Validate(), tc.ErrorMatches, "nil Logger not valid")
+}
+
+func (s *workerSuite) TestExportDisabled(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.tracingService.EXPECT().GetWorkloadTracingConfig(gomock.Any()).DoAndReturn(
+		func(context.Context) (tracingservice.WorkloadTracingConfig, error) {
+			defer close(done)
+			return tracingservice.WorkloadTracingConfig{
+				GRPCEndpoint:  "otel:4317",
+				ExportMetrics: ptr(false),
+			}, nil
+		})
+
+	w, err := NewWorker(s.newConfig(c, func(otlp.Config) (otlp.Client, error) {
+		c.Fatalf("unexpected client creation")
+		return nil, nil
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.changes <- struct{}{}
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for config to be read")
+	}
+}
+
+func (s *workerSuite) TestExportMetrics(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "requests_total",
+	})
+	counter.Add(3)
+	s.registry.MustRegister(counter)
+
+	s.tracingService.EXPECT().GetWorkloadTracingConfig(gomock.Any()).Return(tracingservice.WorkloadTracingConfig{
+		HTTPEndpoint:       "https://otel:4318",
+		InsecureSkipVerify: ptr(true),
+		ExportMetrics:      ptr(true),
+	}, nil)
+
+	exported := make(chan []*metricsv1.ResourceMetrics, 1)
+	s.client.EXPECT().ExportMetrics(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, metrics []*metricsv1.ResourceMetrics) error {
+			exported <- metrics
+			return nil
+		})
+	s.client.EXPECT().Close().Return(nil)
+
+	clientConfigs := make(chan otlp.Config, 1)
+	w, err := NewWorker(s.newConfig(c, func(config otlp.Config) (otlp.Client, error) {
+		clientConfigs <- config
+		return s.client, nil
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.changes <- struct{}{}
+
+	select {
+	case config := <-clientConfigs:
+		c.Check(config, tc.DeepEquals, otlp.Config{
+			HTTPEndpoint:       "https://otel:4318",
+			InsecureSkipVerify: true,
+		})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for client")
+	}
+
+	err = s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	select {
+	case metrics := <-exported:
+		c.Assert(metrics, tc.HasLen, 1)
+		c.Assert(metrics[0].ScopeMetrics, tc.HasLen, 1)
+		c.Assert(metrics[0].ScopeMetrics[0].Metrics, tc.HasLen, 1)
+		c.Check(metrics[0].ScopeMetrics[0].Metrics[0].Name, tc.Equals, "requests_total")
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for metrics export")
+	}
+}
+
+func (s *workerSuite) TestDisablingMetricsStopsExporter(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.tracingService.EXPECT().GetWorkloadTracingConfig(gomock.Any()).Return(tracingservice.WorkloadTracingConfig{
+		GRPCEndpoint:  "otel:4317",
+		ExportMetrics: ptr(true),
+	}, nil)
+	s.tracingService.EXPECT().GetWorkloadTracingConfig(gomock.Any()).Return(tracingservice.WorkloadTracingConfig{
+		GRPCEndpoint:  "otel:4317",
+		ExportMetrics: ptr(false),
+	}, nil)
+
+	created := make(chan struct{}, 1)
+	closed := make(chan struct{})
+	s.client.EXPECT().Close().DoAndReturn(func() error {
+		close(closed)
+		return nil
+	})
+
+	w, err := NewWorker(s.newConfig(c, func(otlp.Config) (otlp.Client, error) {
+		created <- struct{}{}
+		return s.client, nil
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.changes <- struct{}{}
+	select {
+	case <-created:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for client")
+	}
+
+	// Disabling metrics export stops the exporter, closing the client.
+	s.changes <- struct{}{}
+	select {
+	case <-closed:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for client close")
+	}
+}
+
+func (s *workerSuite) newConfig(c *tc.C, newClient NewClientFunc) Config {
+	if newClient == nil {
+		newClient = func(otlp.Config) (otlp.Client, error) {
+			return s.client, nil
+		}
+	}
+	return Config{
+		TracingService:  s.tracingService,
+		Gatherer:        s.registry,
+		NewClient:       newClient,
+		ServiceName:     "juju-controller",
+		InstanceID:      "machine-0",
+		MetricsInterval: time.Minute,
+		Clock:           s.clock,
+		Logger:          loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.tracingService = NewMockTracingService(ctrl)
+	s.client = NewMockClient(ctrl)
+
+	s.clock = testclock.NewClock(time.Now())
+	s.registry = prometheus.NewRegistry()
+	s.changes = make(chan struct{})
+	s.tracingService.EXPECT().WatchWorkloadTracingConfig(gomock.Any()).Return(
+		watchertest.NewMockNotifyWatcher(s.changes), nil,
+	).AnyTimes()
+
+	c.Cleanup(func() {
+		s.tracingService = nil
+		s.client = nil
+		s.clock = nil
+		s.registry = nil
+		s.changes = nil
+	})
+
+	return ctrl
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package permissionexpiry provides a worker that revokes time-limited
+// permission grants once they have expired.
+//
+// Expired grants are no longer honoured when checking a user's access, so
+// the worker does not enforce expiry itself. Instead it periodically asks
+// the access service to revert each expired grant to the access held before
+// it was made, and records every revocation in the audit log held in the
+// controller database, attributed to the controller.
+//
+// The worker is intended to run on the primary controller only.
+package permissionexpiry
@@ -0,0 +1,90 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package permissionexpiry
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/dependency"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig describes the resources used by the permission expiry
+// worker.
+type ManifoldConfig struct {
+	DomainServicesName     string
+	AuditConfigUpdaterName string
+	Clock                  clock.Clock
+	Logger                 logger.Logger
+	// CheckInterval specifies how often expired permissions are revoked.
+	CheckInterval time.Duration
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.AuditConfigUpdaterName == "" {
+		return errors.NotValidf("empty AuditConfigUpdaterName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.CheckInterval <= 0 {
+		return errors.NotValidf("non-positive CheckInterval")
+	}
+	return nil
+}
+
+// start starts the permission expiry worker.
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var domainServices services.ControllerDomainServices
+	if err := getter.Get(config.DomainServicesName, &domainServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var getAuditConfig func() auditlog.Config
+	if err := getter.Get(config.AuditConfigUpdaterName, &getAuditConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := NewWorker(Config{
+		Clock:          config.Clock,
+		AccessService:  domainServices.Access(),
+		GetAuditConfig: getAuditConfig,
+		Logger:         config.Logger,
+		CheckInterval:  config.CheckInterval,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Manifold returns a Manifold that encapsulates the permission expiry
+// worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+			config.AuditConfigUpdaterName,
+		},
+		Start: config.start,
+	}
+}
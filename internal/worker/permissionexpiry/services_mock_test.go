@@ -0,0 +1,60 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/permissionexpiry (interfaces: AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -package permissionexpiry -destination services_mock_test.go github.com/juju/juju/internal/worker/permissionexpiry AccessService
+//
+
+// Package permissionexpiry is a generated GoMock package.
+package permissionexpiry
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+	access "github.com/juju/juju/domain/access"
+)
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock                            *MockAccessService
+	revokeExpiredPermissionsExpects []*gomock.Call1_2[context.Context, []access.ExpiredPermission, error]
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// RevokeExpiredPermissions mocks base method.
+func (m *MockAccessService) RevokeExpiredPermissions(ctx context.Context) ([]access.ExpiredPermission, error) {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_2(&m.recorder.revokeExpiredPermissionsExpects, m.ctrl, m, "RevokeExpiredPermissions", ctx)
+}
+
+// RevokeExpiredPermissions indicates an expected call of RevokeExpiredPermissions.
+func (mr *MockAccessServiceMockRecorder) RevokeExpiredPermissions(ctx any) *MockAccessServiceRevokeExpiredPermissionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_2[context.Context, []access.ExpiredPermission, error](mr.mock.ctrl.T, mr.mock, "RevokeExpiredPermissions", gomock.EnsureMatcher(ctx))
+	mr.revokeExpiredPermissionsExpects = append(mr.revokeExpiredPermissionsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockAccessServiceRevokeExpiredPermissionsCall is the typed call wrapper for RevokeExpiredPermissions.
+type MockAccessServiceRevokeExpiredPermissionsCall = gomock.Call1_2[context.Context, []access.ExpiredPermission, error]
@@ -0,0 +1,263 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package permissionexpiry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	"github.com/juju/juju/core/auditlog"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/access"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// auditWho is recorded as the author of audit log conversations for
+	// expired permissions, which are revoked by the controller rather than
+	// by a user.
+	auditWho = "controller"
+
+	// auditWhat prefixes the description of the audit log conversations
+	// for expired permissions.
+	auditWhat = "revoke expired permission"
+
+	// auditFacade and auditMethod are recorded as the facade and method of
+	// the audit log requests for expired permissions, which are revoked by
+	// the controller rather than through the API.
+	auditFacade = "PermissionExpiry"
+	auditMethod = "RevokeExpiredPermission"
+)
+
+// AccessService revokes expired permissions.
+type AccessService interface {
+	// RevokeExpiredPermissions reverts all time-limited permissions which
+	// have expired to the access held before they were granted, returning
+	// the permissions which were changed.
+	RevokeExpiredPermissions(ctx context.Context) ([]access.ExpiredPermission, error)
+}
+
+// Config is the configuration for the permission expiry worker.
+type Config struct {
+	Clock         clock.Clock
+	AccessService AccessService
+	Logger        logger.Logger
+
+	// GetAuditConfig returns the current audit log config, whose target
+	// writes to the audit log file, the controller database and the remote
+	// audit log sinks.
+	GetAuditConfig func() auditlog.Config
+
+	// CheckInterval is the interval at which expired permissions are
+	// revoked.
+	CheckInterval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.AccessService == nil {
+		return errors.Errorf("nil AccessService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.GetAuditConfig == nil {
+		return errors.Errorf("nil GetAuditConfig").Add(coreerrors.NotValid)
+	}
+	if config.CheckInterval <= 0 {
+		return errors.Errorf("check interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// expiryWorker is a worker that revokes expired permissions.
+type expiryWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastCheck time.Time
+	revoked   int
+}
+
+// NewWorker returns a new permission expiry worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &expiryWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "permission-expiry",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *expiryWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *expiryWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *expiryWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"last-check": w.lastCheck,
+		"revoked":    w.revoked,
+	}
+}
+
+// loop is the worker's main loop. It revokes the permissions which expired
+// while the worker was not running, and then those which expire as it
+// runs, on every check interval.
+func (w *expiryWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	if err := w.revokeExpired(ctx); err != nil {
+		return errors.Capture(err)
+	}
+
+	timer := w.config.Clock.NewTimer(w.config.CheckInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.revokeExpired(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(w.config.CheckInterval)
+		}
+	}
+}
+
+// revokeExpired revokes the expired permissions and records each
+// revocation in the audit log.
+func (w *expiryWorker) revokeExpired(ctx context.Context) error {
+	expired, err := w.config.AccessService.RevokeExpiredPermissions(ctx)
+	if err != nil {
+		return errors.Errorf("revoking expired permissions: %w", err)
+	}
+	for _, p := range expired {
+		w.config.Logger.Infof(ctx, "%q access for user %q on %s %q expired at %s, access reverted to %q",
+			p.Access, p.Subject.Name(), p.Target.ObjectType, p.Target.Key,
+			p.ExpiresAt.Format(time.RFC3339), p.RevertedTo)
+		// The permission has already been revoked, so failing to record it
+		// is not a reason to stop revoking others.
+		if err := w.recordRevocation(ctx, p); err != nil {
+			w.config.Logger.Errorf(ctx, "recording revocation of expired permission in audit log: %v", err)
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastCheck = w.config.Clock.Now()
+	w.revoked += len(expired)
+	return nil
+}
+
+// recordRevocation records the revocation of an expired permission in the
+// audit log, when auditing is enabled. The revocation isn't made through the
+// API, so it is recorded as a conversation with the controller which
+// describes the revocation, holding a single request that made it.
+func (w *expiryWorker) recordRevocation(ctx context.Context, p access.ExpiredPermission) error {
+	cfg := w.config.GetAuditConfig()
+	if !cfg.Enabled || cfg.Target == nil || cfg.ExcludeMethods.Contains(auditFacade+"."+auditMethod) {
+		return nil
+	}
+
+	var modelUUID string
+	switch p.Target.ObjectType {
+	case permission.Model:
+		modelUUID = p.Target.Key
+	case permission.Application:
+		var err error
+		modelUUID, _, _, err = permission.ParseApplicationKey(p.Target.Key)
+		if err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	var args string
+	if cfg.CaptureAPIArgs {
+		data, err := json.Marshal(revocationArgs{
+			User:       p.Subject.Name(),
+			ObjectType: string(p.Target.ObjectType),
+			Target:     p.Target.Key,
+			Access:     string(p.Access),
+			RevertedTo: string(p.RevertedTo),
+			ExpiresAt:  p.ExpiresAt.UTC(),
+		})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		args = string(data)
+	}
+
+	recorder, err := auditlog.NewRecorder(cfg.Target, w.config.Clock, auditlog.ConversationArgs{
+		Who:       auditWho,
+		What:      revocationEvent(p),
+		ModelUUID: modelUUID,
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := recorder.AddRequest(auditlog.RequestArgs{
+		RequestID: 1,
+		Facade:    auditFacade,
+		Method:    auditMethod,
+		Args:      args,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(recorder.AddResponse(auditlog.ResponseErrorsArgs{
+		RequestID: 1,
+	}))
+}
+
+// revocationArgs are recorded as the arguments of the audit log request for
+// the revocation of an expired permission.
+type revocationArgs struct {
+	User       string    `json:"user"`
+	ObjectType string    `json:"object-type"`
+	Target     string    `json:"target"`
+	Access     string    `json:"access"`
+	RevertedTo string    `json:"reverted-to"`
+	ExpiresAt  time.Time `json:"expires-at"`
+}
+
+// revocationEvent describes the revocation of an expired permission.
+func revocationEvent(p access.ExpiredPermission) string {
+	revertedTo := p.RevertedTo
+	if revertedTo == permission.NoAccess {
+		revertedTo = "none"
+	}
+	return fmt.Sprintf("%s: %q access for user %q on %s %q expired at %s, reverted to %q",
+		auditWhat, p.Access, p.Subject.Name(), p.Target.ObjectType, p.Target.Key,
+		p.ExpiresAt.UTC().Format(time.RFC3339), revertedTo)
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package permissionexpiry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/workertest"
+
+	"github.com/juju/juju/core/auditlog"
+	"github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		Clock:          testclock.NewClock(time.Now()),
+		AccessService:  NewMockAccessService(ctrl),
+		GetAuditConfig: func() auditlog.Config { return auditlog.Config{} },
+		Logger:         loggertesting.WrapCheckLog(c),
+		CheckInterval:  time.Second,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.AccessService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil AccessService.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.GetAuditConfig = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil GetAuditConfig.*")
+
+	testCfg = origCfg
+	testCfg.CheckInterval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "check interval must be positive.*")
+}
+
+type workerSuite struct{}
+
+// TestRevokesOnInterval tests that the worker revokes expired permissions
+// when it starts and again when the check interval elapses.
+func (s *workerSuite) TestRevokesOnInterval(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+
+	wait := mocked.expectRevoke(c, nil)
+	mocked.advanceCheckInterval(c)
+	wait()
+}
+
+// TestRecordsRevocationInAuditLog tests that each revoked permission is
+// recorded in the audit log as a conversation of its own, holding the
+// request which revoked the permission and its response.
+func (s *workerSuite) TestRecordsRevocationInAuditLog(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	mocked.auditConfig.CaptureAPIArgs = true
+
+	modelUUID := "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+	appUUID := "f00dfeed-0bad-400d-8000-4b1d0d06f00d"
+	target := permission.ApplicationOperationID(modelUUID, appUUID, permission.RunActionAccess)
+	wait := mocked.expectRevoke(c, []access.ExpiredPermission{{
+		Subject:    usertesting.GenNewName(c, "bob"),
+		Target:     target,
+		Access:     permission.RunActionAccess,
+		RevertedTo: permission.NoAccess,
+		ExpiresAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, {
+		Subject:    usertesting.GenNewName(c, "alice"),
+		Target:     permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()},
+		Access:     permission.SuperuserAccess,
+		RevertedTo: permission.LoginAccess,
+		ExpiresAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}})
+	mocked.advanceCheckInterval(c)
+	wait()
+	workertest.CleanKill(c, w)
+
+	records := mocked.auditLog.records
+	c.Assert(records, tc.HasLen, 6)
+
+	c.Check(records[0].Conversation.Who, tc.Equals, "controller")
+	c.Check(records[0].Conversation.What, tc.Equals, `revoke expired permission: "run-action" access for user "bob" on application "`+target.Key+`" expired at 2026-01-02T03:04:05Z, reverted to "none"`)
+	c.Check(records[0].Conversation.ModelUUID, tc.Equals, modelUUID)
+	c.Check(records[1].Request.ConversationID, tc.Equals, records[0].Conversation.ConversationID)
+	c.Check(records[1].Request.Facade, tc.Equals, "PermissionExpiry")
+	c.Check(records[1].Request.Method, tc.Equals, "RevokeExpiredPermission")
+	c.Check(records[1].Request.Args, tc.Equals, `{"user":"bob","object-type":"application","target":"`+target.Key+`","access":"run-action","reverted-to":"","expires-at":"2026-01-02T03:04:05Z"}`)
+	c.Check(records[2].Errors.ConversationID, tc.Equals, records[0].Conversation.ConversationID)
+	c.Check(records[2].Errors.RequestID, tc.Equals, records[1].Request.RequestID)
+	c.Check(records[2].Errors.Errors, tc.HasLen, 0)
+
+	c.Check(records[3].Conversation.Who, tc.Equals, "controller")
+	c.Check(records[3].Conversation.What, tc.Equals, `revoke expired permission: "superuser" access for user "alice" on controller "`+coretesting.ControllerTag.Id()+`" expired at 2026-01-02T03:04:05Z, reverted to "login"`)
+	c.Check(records[3].Conversation.ModelUUID, tc.Equals, "")
+	c.Check(records[4].Request.ConversationID, tc.Equals, records[3].Conversation.ConversationID)
+	c.Check(records[5].Errors.ConversationID, tc.Equals, records[3].Conversation.ConversationID)
+}
+
+// TestAuditingDisabled tests that revocations are not recorded when
+// auditing is disabled.
+func (s *workerSuite) TestAuditingDisabled(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	mocked.auditConfig.Enabled = false
+
+	wait := mocked.expectRevoke(c, []access.ExpiredPermission{{
+		Subject:    usertesting.GenNewName(c, "bob"),
+		Target:     permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()},
+		Access:     permission.SuperuserAccess,
+		RevertedTo: permission.LoginAccess,
+		ExpiresAt:  mocked.clock.Now(),
+	}})
+	mocked.advanceCheckInterval(c)
+	wait()
+	workertest.CleanKill(c, w)
+
+	c.Check(mocked.auditLog.records, tc.HasLen, 0)
+}
+
+// TestAuditLogErrorIgnored tests that failing to record a revocation in the
+// audit log does not stop the worker.
+func (s *workerSuite) TestAuditLogErrorIgnored(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	mocked.auditLog.err = errors.New("bang")
+
+	wait := mocked.expectRevoke(c, []access.ExpiredPermission{{
+		Subject:    usertesting.GenNewName(c, "bob"),
+		Target:     permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()},
+		Access:     permission.SuperuserAccess,
+		RevertedTo: permission.LoginAccess,
+		ExpiresAt:  mocked.clock.Now(),
+	}})
+	mocked.advanceCheckInterval(c)
+	wait()
+
+	wait = mocked.expectRevoke(c, nil)
+	mocked.advanceCheckInterval(c)
+	wait()
+}
+
+// TestRevokeError verifies that the worker dies when revoking expired
+// permissions fails.
+func (s *workerSuite) TestRevokeError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expectedError := errors.New("bang")
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer func() {
+		err := workertest.CheckKill(c, w)
+		c.Assert(err, tc.ErrorIs, expectedError)
+	}()
+
+	mocked.accessService.EXPECT().RevokeExpiredPermissions(gomock.Any()).Return(nil, expectedError)
+
+	mocked.advanceCheckInterval(c)
+	mocked.shouldDie(c)
+}
+
+type workerMocks struct {
+	clock         *testclock.Clock
+	accessService *MockAccessService
+	auditLog      *fakeAuditLog
+	auditConfig   *auditlog.Config
+	checkInterval time.Duration
+	worker        *expiryWorker
+}
+
+// startWorker starts a worker, waiting for it to revoke the permissions
+// which expired before it started, and returns it and the mocks it uses.
+func (s *workerSuite) startWorker(c *tc.C, ctrl *gomock.Controller) (worker.Worker, workerMocks) {
+	auditLog := &fakeAuditLog{}
+	mocked := workerMocks{
+		clock:         testclock.NewClock(time.Now()),
+		accessService: NewMockAccessService(ctrl),
+		auditLog:      auditLog,
+		auditConfig: &auditlog.Config{
+			Enabled: true,
+			Target:  auditLog,
+		},
+		checkInterval: time.Second,
+	}
+
+	wait := mocked.expectRevoke(c, nil)
+	w, err := NewWorker(Config{
+		Clock:         mocked.clock,
+		AccessService: mocked.accessService,
+		// The audit config is read when a revocation is recorded, which
+		// the tests only change before the worker revokes any.
+		GetAuditConfig: func() auditlog.Config { return *mocked.auditConfig },
+		Logger:         loggertesting.WrapCheckLog(c),
+		CheckInterval:  mocked.checkInterval,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	wait()
+
+	mocked.worker = w.(*expiryWorker)
+	return w, mocked
+}
+
+// expectRevoke expects a call to RevokeExpiredPermissions returning the
+// given permissions, and returns a function waiting for it.
+func (w *workerMocks) expectRevoke(c *tc.C, expired []access.ExpiredPermission) (waitForMe func()) {
+	waitForIt := make(chan struct{})
+	w.accessService.EXPECT().RevokeExpiredPermissions(gomock.Any()).DoAndReturn(
+		func(ctx context.Context) ([]access.ExpiredPermission, error) {
+			close(waitForIt)
+			return expired, nil
+		})
+	return func() {
+		select {
+		case <-waitForIt:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("RevokeExpiredPermissions should have been called")
+		}
+	}
+}
+
+// advanceCheckInterval advances the clock by the check interval once the
+// worker is waiting on its timer.
+func (w *workerMocks) advanceCheckInterval(c *tc.C) {
+	err := w.clock.WaitAdvance(w.checkInterval, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// shouldDie verifies if the worker has successfully terminated within a
+// timeout, failing the test if it hasn't.
+func (w *workerMocks) shouldDie(c *tc.C) {
+	select {
+	case <-w.worker.catacomb.Dead():
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("Undead worker")
+	}
+}
+
+// fakeAuditLog is an audit log recording the records added to it.
+type fakeAuditLog struct {
+	records []auditlog.Record
+	err     error
+}
+
+// AddConversation is part of the auditlog.AuditLog interface.
+func (l *fakeAuditLog) AddConversation(c auditlog.Conversation) error {
+	l.records = append(l.records, auditlog.Record{Conversation: &c})
+	return l.err
+}
+
+// AddRequest is part of the auditlog.AuditLog interface.
+func (l *fakeAuditLog) AddRequest(r auditlog.Request) error {
+	l.records = append(l.records, auditlog.Record{Request: &r})
+	return l.err
+}
+
+// AddResponse is part of the auditlog.AuditLog interface.
+func (l *fakeAuditLog) AddResponse(r auditlog.ResponseErrors) error {
+	l.records = append(l.records, auditlog.Record{Errors: &r})
+	return l.err
+}
+
+// Close is part of the auditlog.AuditLog interface.
+func (l *fakeAuditLog) Close() error {
+	return nil
+}
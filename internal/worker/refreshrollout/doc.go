@@ -0,0 +1,15 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package refreshrollout provides a worker that drives staged refreshes of
+// application charms, for each model.
+//
+// A staged refresh, started with `juju refresh --batch-size`, moves the
+// application to the new charm straight away but only releases its units to
+// the new charm a batch at a time. On every tick of its interval the worker
+// asks the application service to advance each rolling refresh: a batch whose
+// units are all refreshed and active releases the next batch, or completes the
+// refresh when no units are left. A batch with a unit in error, or one that
+// isn't active before the batch timeout, pauses the refresh, or rolls the
+// application back to its previous charm if requested.
+package refreshrollout
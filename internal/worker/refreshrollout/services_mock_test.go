@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/refreshrollout (interfaces: ApplicationService)
+//
+// Generated by this command:
+//
+//	mockgen -package refreshrollout -destination services_mock_test.go github.com/juju/juju/internal/worker/refreshrollout ApplicationService
+//
+
+// Package refreshrollout is a generated GoMock package.
+package refreshrollout
+
+import (
+	context "context"
+
+	gomock "github.com/canonical/gomock/gomock"
+)
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock                          *MockApplicationService
+	advanceRefreshRolloutsExpects []*gomock.Call1_1[context.Context, error]
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock: mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// AdvanceRefreshRollouts mocks base method.
+func (m *MockApplicationService) AdvanceRefreshRollouts(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	return gomock.Dispatch1_1(&m.recorder.advanceRefreshRolloutsExpects, m.ctrl, m, "AdvanceRefreshRollouts", ctx)
+}
+
+// AdvanceRefreshRollouts indicates an expected call of AdvanceRefreshRollouts.
+func (mr *MockApplicationServiceMockRecorder) AdvanceRefreshRollouts(ctx any) *MockApplicationServiceAdvanceRefreshRolloutsCall {
+	mr.mock.ctrl.T.Helper()
+	call := gomock.NewCall1_1[context.Context, error](mr.mock.ctrl.T, mr.mock, "AdvanceRefreshRollouts", gomock.EnsureMatcher(ctx))
+	mr.advanceRefreshRolloutsExpects = append(mr.advanceRefreshRolloutsExpects, call)
+	mr.mock.ctrl.Track(call.Call)
+	return call
+}
+
+// MockApplicationServiceAdvanceRefreshRolloutsCall is the typed call wrapper for AdvanceRefreshRollouts.
+type MockApplicationServiceAdvanceRefreshRolloutsCall = gomock.Call1_1[context.Context, error]
@@ -0,0 +1,125 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package refreshrollout
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v5"
+	"github.com/juju/worker/v5/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ApplicationService provides access to the staged refreshes of applications.
+type ApplicationService interface {
+	// AdvanceRefreshRollouts moves every rolling staged refresh in the model
+	// forward, releasing the next batch of units, completing the refresh, or
+	// pausing or rolling it back when a batch fails.
+	AdvanceRefreshRollouts(ctx context.Context) error
+}
+
+// Config is the configuration for the refresh rollout worker.
+type Config struct {
+	Clock              clock.Clock
+	ApplicationService ApplicationService
+	Logger             logger.Logger
+
+	// Interval is the interval at which staged refreshes are advanced.
+	Interval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.ApplicationService == nil {
+		return errors.Errorf("nil ApplicationService").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.Interval <= 0 {
+		return errors.Errorf("interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// rolloutWorker is a worker that advances staged refreshes.
+type rolloutWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastAdvance time.Time
+}
+
+// NewWorker returns a new refresh rollout worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &rolloutWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "refresh-rollout",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *rolloutWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *rolloutWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *rolloutWorker) Report(ctx context.Context) map[string]any {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]any{
+		"interval":     w.config.Interval,
+		"last-advance": w.lastAdvance,
+	}
+}
+
+// loop is the worker's main loop. It advances the staged refreshes of the
+// model on every tick of the interval.
+func (w *rolloutWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(w.config.Interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.config.ApplicationService.AdvanceRefreshRollouts(ctx); err != nil {
+				return errors.Errorf("advancing refresh rollouts: %w", err)
+			}
+
+			w.mu.Lock()
+			w.lastAdvance = w.config.Clock.Now()
+			w.mu.Unlock()
+
+			timer.Reset(w.config.Interval)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package refreshrollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/canonical/gomock/gomock"
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v5/workertest"
+
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+// TestConfigValidation tests that the config is validated correctly.
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		Clock:              testclock.NewClock(time.Now()),
+		ApplicationService: NewMockApplicationService(ctrl),
+		Logger:             loggertesting.WrapCheckLog(c),
+		Interval:           time.Second,
+	}
+
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.ApplicationService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil ApplicationService.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.Interval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "interval must be positive.*")
+}
+
+type workerSuite struct{}
+
+// TestAdvancesOnInterval tests that the worker advances staged refreshes on
+// every tick of its interval.
+func (s *workerSuite) TestAdvancesOnInterval(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	clock := testclock.NewClock(time.Now())
+	service := NewMockApplicationService(ctrl)
+
+	advanced := make(chan struct{})
+	service.EXPECT().AdvanceRefreshRollouts(gomock.Any()).DoAndReturn(func(context.Context) error {
+		advanced <- struct{}{}
+		return nil
+	}).Times(2)
+
+	w, err := NewWorker(Config{
+		Clock:              clock,
+		ApplicationService: service,
+		Logger:             loggertesting.WrapCheckLog(c),
+		Interval:           time.Minute,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	for range 2 {
+		err := clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+		c.Assert(err, tc.ErrorIsNil)
+		select {
+		case <-advanced:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("staged refreshes not advanced")
+		}
+	}
+}
+
+// TestAdvanceError tests that the worker dies if staged refreshes can't be
+// advanced.
+func (s *workerSuite) TestAdvanceError(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	clock := testclock.NewClock(time.Now())
+	service := NewMockApplicationService(ctrl)
+	service.EXPECT().AdvanceRefreshRollouts(gomock.Any()).Return(errors.New("boom"))
+
+	w, err := NewWorker(Config{
+		Clock:              clock,
+		ApplicationService: service,
+		Logger:             loggertesting.WrapCheckLog(c),
+		Interval:           time.Minute,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	err = clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, w)
+	c.Assert(err, tc.ErrorMatches, "advancing refresh rollouts: boom")
+}
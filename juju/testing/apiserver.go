@@ -135,6 +135,7 @@ type ApiServerSuite struct {
 	WithAuditLogConfig *auditlog.Config
 	WithIntrospection  func(func(string, http.Handler))
 	WithJWTTokenParser jwtauth.TokenParser
+	WithOIDCProvider   apiserver.OIDCProvider
 
 	// AdminUserUUID is the root user for the controller.
 	AdminUserUUID coreuser.UUID
@@ -307,6 +308,7 @@ func (s *ApiServerSuite) setupAPIServer(c *tc.C, controllerCfg controller.Config
 	if s.WithJWTTokenParser != nil {
 		cfg.JWTAuthenticator = jwtauth.NewAuthenticator(s.WithJWTTokenParser)
 	}
+	cfg.OIDCProvider = s.WithOIDCProvider
 	err = authenticator.AddHandlers(s.mux)
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -146,6 +146,14 @@ type OperationResult struct {
 	Status       string         `json:"status,omitempty"`
 	Actions      []ActionResult `json:"actions,omitempty"`
 	Error        *Error         `json:"error,omitempty"`
+
+	// Schedule is the name of the scheduled action which started the
+	// operation, if any.
+	Schedule string `json:"schedule,omitempty"`
+
+	// ScheduledByTag is the tag of the user on whose behalf the scheduled
+	// action started the operation, if any.
+	ScheduledByTag string `json:"scheduled-by,omitempty"`
 }
 
 // ActionExecutionResults holds a slice of ActionExecutionResult for a
@@ -186,6 +194,57 @@ type ActionSpec struct {
 	Params      map[string]any `json:"params"`
 }
 
+// ScheduleActionsArgs holds the arguments for scheduling actions.
+type ScheduleActionsArgs struct {
+	Schedules []ScheduleActionArg `json:"schedules"`
+}
+
+// ScheduleActionArg describes an action to run repeatedly on a cron-style
+// schedule.
+type ScheduleActionArg struct {
+	// Name uniquely identifies the scheduled action within the model.
+	Name string `json:"name"`
+	// Schedule is the cron expression describing when the action runs.
+	Schedule string `json:"schedule"`
+	// Receivers are unit tags, or "<application>/leader", all of the same
+	// application.
+	Receivers      []string       `json:"receivers"`
+	Action         string         `json:"action"`
+	Parameters     map[string]any `json:"parameters,omitempty"`
+	Parallel       *bool          `json:"parallel,omitempty"`
+	ExecutionGroup *string        `json:"execution-group,omitempty"`
+}
+
+// ScheduledActionResults holds the scheduled actions of a model.
+type ScheduledActionResults struct {
+	Results []ScheduledAction `json:"results"`
+}
+
+// ScheduledAction describes an action which runs on a schedule.
+type ScheduledAction struct {
+	Name           string         `json:"name"`
+	Schedule       string         `json:"schedule"`
+	Receivers      []string       `json:"receivers"`
+	Action         string         `json:"action"`
+	Parameters     map[string]any `json:"parameters,omitempty"`
+	Parallel       bool           `json:"parallel,omitempty"`
+	ExecutionGroup string         `json:"execution-group,omitempty"`
+	// CreatedByTag is the tag of the user on whose behalf the action runs.
+	CreatedByTag string    `json:"created-by"`
+	Created      time.Time `json:"created"`
+	// NextRun is the zero time if the action will not run again.
+	NextRun time.Time `json:"next-run,omitempty"`
+	LastRun time.Time `json:"last-run,omitempty"`
+	// LastOperationTag is the tag of the operation started the last time
+	// the action ran, if any.
+	LastOperationTag string `json:"last-operation,omitempty"`
+}
+
+// RemoveScheduledActionsArgs holds the names of scheduled actions to remove.
+type RemoveScheduledActionsArgs struct {
+	Names []string `json:"names"`
+}
+
 type ActionPruneArgs struct {
 	MaxHistoryTime time.Duration `json:"max-history-time"`
 	MaxHistoryMB   int           `json:"max-history-mb"`
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
@@ -139,6 +140,19 @@ func (e RedirectErrorInfo) AsMap() map[string]any {
 	return serializeToMap(e)
 }
 
+// RateLimitExceededErrorInfo provides additional information for
+// RateLimitExceeded errors.
+type RateLimitExceededErrorInfo struct {
+	// RetryAfter holds the duration the client should wait before
+	// retrying the request.
+	RetryAfter time.Duration `json:"retry-after"`
+}
+
+// AsMap encodes the error info as a map that can be attached to an Error.
+func (e RateLimitExceededErrorInfo) AsMap() map[string]any {
+	return serializeToMap(e)
+}
+
 // serializeToMap is a convenience function for marshaling v into a
 // map[string]interface{}. It works by marshalling v into json and then
 // unmarshaling back to a map.
@@ -208,6 +222,7 @@ const (
 	CodeCloudRegionRequired        = "cloud region required"
 	CodeIncompatibleClouds         = "incompatible clouds"
 	CodeQuotaLimitExceeded         = "quota limit exceeded"
+	CodeRateLimitExceeded          = "rate limit exceeded"
 	CodeNotLeader                  = "not leader"
 	CodeDeadlineExceeded           = "deadline exceeded"
 	CodeNotYetAvailable            = "not yet available; try again later"
@@ -550,6 +565,31 @@ func IsCodeQuotaLimitExceeded(err error) bool {
 	return ErrCode(err) == CodeQuotaLimitExceeded
 }
 
+// IsCodeRateLimitExceeded returns true if err includes a RateLimitExceeded
+// error code.
+func IsCodeRateLimitExceeded(err error) bool {
+	return ErrCode(err) == CodeRateLimitExceeded
+}
+
+// RateLimitRetryAfter returns the duration the server asked the client to
+// wait before retrying a request that was rejected with a RateLimitExceeded
+// error. It returns false if err is not such an error or carries no
+// retry-after information.
+func RateLimitRetryAfter(err error) (time.Duration, bool) {
+	if !IsCodeRateLimitExceeded(err) {
+		return 0, false
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return 0, false
+	}
+	var info RateLimitExceededErrorInfo
+	if err := apiErr.UnmarshalInfo(&info); err != nil || info.RetryAfter <= 0 {
+		return 0, false
+	}
+	return info.RetryAfter, true
+}
+
 func IsCodeNotLeader(err error) bool {
 	return ErrCode(err) == CodeNotLeader
 }
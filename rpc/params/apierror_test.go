@@ -5,6 +5,7 @@ package params_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/tc"
@@ -69,3 +70,21 @@ func (*errorSuite) TestTranslateWellKnownError(c *tc.C) {
 		c.Assert(params.TranslateWellKnownError(v.err), tc.ErrorIs, v.errType, tc.Commentf("test %s: translated error is a juju/errors error", v.name))
 	}
 }
+
+func (*errorSuite) TestRateLimitRetryAfter(c *tc.C) {
+	err := errors.Trace(&params.Error{
+		Code:    params.CodeRateLimitExceeded,
+		Message: "rate limit exceeded",
+		Info:    params.RateLimitExceededErrorInfo{RetryAfter: 3 * time.Second}.AsMap(),
+	})
+	c.Check(params.IsCodeRateLimitExceeded(err), tc.IsTrue)
+	retryAfter, ok := params.RateLimitRetryAfter(err)
+	c.Check(ok, tc.IsTrue)
+	c.Check(retryAfter, tc.Equals, 3*time.Second)
+
+	_, ok = params.RateLimitRetryAfter(&params.Error{Code: params.CodeRateLimitExceeded})
+	c.Check(ok, tc.IsFalse)
+
+	_, ok = params.RateLimitRetryAfter(&params.Error{Code: params.CodeTryAgain})
+	c.Check(ok, tc.IsFalse)
+}
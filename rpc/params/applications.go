@@ -118,6 +118,30 @@ type ApplicationSetCharmV2 struct {
 	// space names to be merged with any existing endpoint bindings. This
 	// field is only understood by Application facade version 10 and greater.
 	EndpointBindings map[string]string `json:"endpoint-bindings,omitempty"`
+
+	// Rollout, if set, refreshes the units of the application in batches
+	// rather than all at once. This field is only understood by Application
+	// facade version 23 and greater.
+	Rollout *RefreshRolloutArgs `json:"rollout,omitempty"`
+}
+
+// RefreshRolloutArgs holds the parameters of a staged refresh, which
+// refreshes the units of an application a batch at a time.
+type RefreshRolloutArgs struct {
+	// BatchSize is the number of units refreshed in each batch.
+	BatchSize int `json:"batch-size,omitempty"`
+
+	// BatchPercent is the percentage of the application's units refreshed
+	// in each batch. It is only used if BatchSize is zero.
+	BatchPercent int `json:"batch-percent,omitempty"`
+
+	// BatchTimeout is how long the units of a batch have to become active
+	// before the refresh is considered failed.
+	BatchTimeout time.Duration `json:"batch-timeout"`
+
+	// RollbackOnError reverts the application to its previous charm when the
+	// refresh fails, rather than pausing it.
+	RollbackOnError bool `json:"rollback-on-error"`
 }
 
 // ApplicationSetCharmV1 sets the charm for a given application.
@@ -488,6 +512,7 @@ type ApplicationResult struct {
 	Life             string                     `json:"life"`
 	EndpointBindings map[string]string          `json:"endpoint-bindings,omitempty"`
 	ExposedEndpoints map[string]ExposedEndpoint `json:"exposed-endpoints,omitempty"`
+	CharmSigner      string                     `json:"charm-signer,omitempty"`
 }
 
 // ApplicationInfoResults holds an application info result or a retrieval error.
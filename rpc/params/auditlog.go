@@ -0,0 +1,62 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// AuditLogQueryArgs holds the filter used to query the audit log. Empty
+// fields match all entries.
+type AuditLogQueryArgs struct {
+	// User matches entries of conversations started by the user.
+	User string `json:"user,omitempty"`
+	// Model matches entries of conversations with the model, by
+	// qualified name or UUID.
+	Model string `json:"model,omitempty"`
+	// Facade matches entries of requests to the facade.
+	Facade string `json:"facade,omitempty"`
+	// Method matches entries of requests to the facade method.
+	Method string `json:"method,omitempty"`
+	// Since matches entries of requests made at or after the time.
+	Since *time.Time `json:"since,omitempty"`
+	// Until matches entries of requests made before the time.
+	Until *time.Time `json:"until,omitempty"`
+	// Outcome matches entries of requests with the outcome, one of
+	// "succeeded", "failed" or "unknown".
+	Outcome string `json:"outcome,omitempty"`
+	// Limit is the maximum number of entries returned, the most recent
+	// being kept. Zero means no limit.
+	Limit int `json:"limit,omitempty"`
+}
+
+// AuditLogQueryResult holds the audit log entries matching a query,
+// ordered by the time their request was made.
+type AuditLogQueryResult struct {
+	Entries []AuditLogEntry `json:"entries"`
+}
+
+// AuditLogEntry describes an API request recorded in the audit log.
+type AuditLogEntry struct {
+	ConversationID string          `json:"conversation-id"`
+	ConnectionID   string          `json:"connection-id"`
+	Who            string          `json:"who"`
+	What           string          `json:"what"`
+	ModelName      string          `json:"model-name"`
+	ModelUUID      string          `json:"model-uuid"`
+	RequestID      uint64          `json:"request-id"`
+	Facade         string          `json:"facade"`
+	Method         string          `json:"method"`
+	Version        int             `json:"version"`
+	Args           string          `json:"args,omitempty"`
+	When           time.Time       `json:"when"`
+	Outcome        string          `json:"outcome"`
+	RespondedAt    *time.Time      `json:"responded-at,omitempty"`
+	Errors         []AuditLogError `json:"errors,omitempty"`
+}
+
+// AuditLogError describes an error returned in response to an audited
+// API request.
+type AuditLogError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
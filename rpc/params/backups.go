@@ -21,6 +21,36 @@ type BackupsDownloadArgs struct {
 	ID string `json:"id"`
 }
 
+// BackupsInfoArgs holds the args for the API Info method.
+type BackupsInfoArgs struct {
+	ID string `json:"id"`
+}
+
+// BackupsListArgs holds the args for the API List method.
+type BackupsListArgs struct {
+}
+
+// BackupsRemoveArgs holds the args for the API Remove method.
+type BackupsRemoveArgs struct {
+	IDs []string `json:"ids"`
+}
+
+// RestoreArgs holds the args for the API Restore method.
+type RestoreArgs struct {
+	// BackupID holds the ID of the backup to restore.
+	BackupID string `json:"backup-id"`
+}
+
+// BackupsListResult holds the list of all stored backups.
+type BackupsListResult struct {
+	List []BackupsMetadataResult `json:"list"`
+}
+
+// BackupsUploadResult contains the result of a backup upload.
+type BackupsUploadResult struct {
+	ID string `json:"id"`
+}
+
 // BackupsMetadataResult holds the metadata for a backup as returned by
 // an API backups method (such as Create).
 type BackupsMetadataResult struct {
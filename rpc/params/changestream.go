@@ -0,0 +1,35 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// ChangeStreamEvent is a model change exported by the change stream HTTP
+// endpoint.
+type ChangeStreamEvent struct {
+	// ID is the stream ID of the change. IDs increase monotonically.
+	ID int64 `json:"id"`
+	// Namespace is the namespace of the change, typically the table that
+	// was changed.
+	Namespace string `json:"namespace"`
+	// Changed is the value that was changed, typically the primary key of
+	// the changed row.
+	Changed string `json:"changed"`
+	// ChangeType is one of create, update or delete.
+	ChangeType string `json:"change-type"`
+	// CreatedAt is the time the change was recorded.
+	CreatedAt time.Time `json:"created-at"`
+}
+
+// ChangeStreamPage is a page of model changes returned by the change
+// stream HTTP endpoint.
+type ChangeStreamPage struct {
+	// Events are the changes, ordered by ID.
+	Events []ChangeStreamEvent `json:"events"`
+	// Cursor is the value to pass as the "after" query parameter to read
+	// the next page of changes.
+	Cursor int64 `json:"cursor"`
+	// More is true if there are more changes after the cursor.
+	More bool `json:"more"`
+}
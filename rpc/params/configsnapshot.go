@@ -0,0 +1,60 @@
+// Copyright 2026 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// ConfigSnapshotArg holds the arguments for taking a config snapshot.
+type ConfigSnapshotArg struct {
+	// Name is the name of the snapshot.
+	Name string `json:"name"`
+	// Replace replaces an existing snapshot with the same name.
+	Replace bool `json:"replace,omitempty"`
+}
+
+// ConfigSnapshotInfo describes a config snapshot.
+type ConfigSnapshotInfo struct {
+	// Name is the name of the snapshot.
+	Name string `json:"name"`
+	// CreatedAt is the time the snapshot was taken.
+	CreatedAt time.Time `json:"created-at"`
+}
+
+// ConfigSnapshotsResult holds the config snapshots of a model.
+type ConfigSnapshotsResult struct {
+	Snapshots []ConfigSnapshotInfo `json:"snapshots"`
+}
+
+// ConfigRollbackArg holds the arguments for rolling the configuration of a
+// model back to a config snapshot.
+type ConfigRollbackArg struct {
+	// Name is the name of the snapshot to roll back to.
+	Name string `json:"name"`
+	// DryRun reports the changes without applying them.
+	DryRun bool `json:"dry-run,omitempty"`
+}
+
+// ConfigSnapshotChange is a change made, or to be made, when rolling back
+// to a config snapshot.
+type ConfigSnapshotChange struct {
+	// Kind is the part of the configuration that changed: model-config,
+	// application, charm, config, constraints, binding or expose.
+	Kind string `json:"kind"`
+	// Application is the application the change applies to, if any.
+	Application string `json:"application,omitempty"`
+	// Key is the config key or endpoint name the change applies to, if any.
+	Key string `json:"key,omitempty"`
+	// Current is the current value.
+	Current string `json:"current,omitempty"`
+	// Target is the value in the snapshot.
+	Target string `json:"target,omitempty"`
+	// Unset is true if rolling back unsets the value.
+	Unset bool `json:"unset,omitempty"`
+}
+
+// ConfigRollbackResult holds the changes made by rolling back to a config
+// snapshot.
+type ConfigRollbackResult struct {
+	Changes []ConfigSnapshotChange `json:"changes"`
+}
@@ -92,6 +92,10 @@ type ModifyControllerAccess struct {
 	UserTag string           `json:"user-tag"`
 	Action  ControllerAction `json:"action"`
 	Access  string           `json:"access"`
+
+	// Expiry, if set, is the time at which a granted access level
+	// expires and the user reverts to the access they held before.
+	Expiry *time.Time `json:"expiry,omitempty"`
 }
 
 // UserAccess holds the level of access a user
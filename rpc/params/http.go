@@ -12,6 +12,10 @@ const (
 	// JujuCharmURLHeader is the header used to specify the charm URL in a
 	// request.
 	JujuCharmURLHeader = "Juju-Curl"
+
+	// JujuSignatureHeader is the header used to supply the base64 encoded
+	// armored SSH signature of an uploaded charm or resource archive.
+	JujuSignatureHeader = "Juju-Signature"
 )
 
 // DigestAlgorithm is one of the values in the IANA registry. See
@@ -963,6 +963,7 @@ type LogMessage struct {
 	Location  string            `json:"loc"`
 	Message   string            `json:"msg"`
 	Labels    map[string]string `json:"lab,omitempty"`
+	Offset    string            `json:"offset,omitempty"`
 }
 
 // LogMessageV1 is a structured logging entry
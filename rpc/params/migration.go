@@ -155,6 +155,10 @@ type SerializedModelV2 struct {
 	// permission rows for this model, distinguished by ModelPermission.ObjectType.
 	Permissions []ModelPermission `json:"permissions,omitempty"`
 
+	// GroupPermissions carries the model, application and offer permission
+	// rows granted to user groups for this model.
+	GroupPermissions []ModelGroupPermission `json:"group-permissions,omitempty"`
+
 	// AuthorizedKeys are the SSH key authorisations for the model.
 	AuthorizedKeys []ModelAuthorizedKey `json:"authorized-keys,omitempty"`
 
@@ -282,6 +286,21 @@ type ModelPermission struct {
 	Access string `json:"access"`
 }
 
+// ModelGroupPermission is a single permission grant to a user group, carried
+// by group name.
+type ModelGroupPermission struct {
+	// ObjectType is "model", "application" or "offer".
+	ObjectType string `json:"object-type"`
+	// GrantOn is the key of the object the access is granted on.
+	GrantOn string `json:"grant-on"`
+	// GroupName is the name of the group the access is granted to.
+	GroupName string `json:"group-name"`
+	// GroupCreatedBy is the username of the group's creator.
+	GroupCreatedBy string `json:"group-created-by"`
+	// Access is the access level, e.g. "read", "admin", "consume".
+	Access string `json:"access"`
+}
+
 // ModelAuthorizedKey is an SSH public key authorised for the model, carried by
 // username and key material rather than the source-local key id.
 type ModelAuthorizedKey struct {
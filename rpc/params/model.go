@@ -376,6 +376,30 @@ type ModifyModelAccess struct {
 	Action   ModelAction          `json:"action"`
 	Access   UserAccessPermission `json:"access"`
 	ModelTag string               `json:"model-tag"`
+
+	// Expiry, if set, is the time at which a granted access level
+	// expires and the user reverts to the access they held before.
+	Expiry *time.Time `json:"expiry,omitempty"`
+}
+
+// ModifyApplicationAccessRequest holds the parameters for making grant and
+// revoke application calls.
+type ModifyApplicationAccessRequest struct {
+	Changes []ModifyApplicationAccess `json:"changes"`
+}
+
+// ModifyApplicationAccess holds a change of the access a user has to an
+// application in a model.
+type ModifyApplicationAccess struct {
+	UserTag         string               `json:"user-tag"`
+	Action          ModelAction          `json:"action"`
+	Access          UserAccessPermission `json:"access"`
+	ModelTag        string               `json:"model-tag"`
+	ApplicationName string               `json:"application-name"`
+
+	// Expiry, if set, is the time at which a granted access level
+	// expires and the user reverts to the access they held before.
+	Expiry *time.Time `json:"expiry,omitempty"`
 }
 
 // ModelAction is an action that can be performed on a model.
@@ -398,6 +422,15 @@ const (
 	ModelWriteAccess UserAccessPermission = "write"
 )
 
+// Application operation access permissions that may be set on a user, in
+// addition to the model access permissions.
+const (
+	ApplicationRunActionAccess UserAccessPermission = "run-action"
+	ApplicationConfigAccess    UserAccessPermission = "config"
+	ApplicationRefreshAccess   UserAccessPermission = "refresh"
+	ApplicationScaleAccess     UserAccessPermission = "scale"
+)
+
 // DestroyModelsParams holds the arguments for destroying models.
 type DestroyModelsParams struct {
 	Models []DestroyModelParams `json:"models"`
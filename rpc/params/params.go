@@ -168,6 +168,11 @@ type AddCharmWithOrigin struct {
 	URL    string      `json:"url"`
 	Origin CharmOrigin `json:"charm-origin"`
 	Force  bool        `json:"force"`
+
+	// Application, if set, is the application the charm is being added to
+	// refresh. Holders of a refresh grant on that application may add the
+	// charm without write access to the model.
+	Application string `json:"application,omitempty"`
 }
 
 // AddCharmWithAuthorization holds the arguments for making an
@@ -579,6 +584,25 @@ type LoginRequestCompat struct {
 	Creds        `json:"creds"`
 }
 
+// LoginDeviceResult holds the result of an Admin.LoginDevice call: the
+// code the user enters at the verification URI to complete the login.
+type LoginDeviceResult struct {
+	UserCode        string `json:"user-code"`
+	VerificationURI string `json:"verification-uri"`
+}
+
+// SessionTokenResult holds the result of an Admin.GetDeviceSessionToken
+// call.
+type SessionTokenResult struct {
+	SessionToken string `json:"session-token"`
+}
+
+// SessionTokenLoginRequest holds the session token used to log in with
+// Admin.LoginWithSessionToken.
+type SessionTokenLoginRequest struct {
+	SessionToken string `json:"session-token"`
+}
+
 // GetAnnotationsResults holds annotations associated with an entity.
 type GetAnnotationsResults struct {
 	Annotations map[string]string `json:"annotations"`
@@ -958,7 +982,19 @@ type ControllerDetailsResults struct {
 type ControllerDetails struct {
 	ControllerId string   `json:"controller-id"`
 	APIAddresses []string `json:"api-addresses"`
-	Error        *Error   `json:"error,omitempty"`
+
+	// DqliteNodeID is the ID of the controller's node in the Dqlite
+	// cluster, zero if the controller has not yet joined it.
+	DqliteNodeID uint64 `json:"dqlite-node-id,omitempty"`
+
+	// DqliteAddress is the address the controller's Dqlite node is bound to.
+	DqliteAddress string `json:"dqlite-address,omitempty"`
+
+	// DqliteRole is the role of the controller's node in the Dqlite cluster
+	// (voter, standby or spare), empty if it is not a member of the cluster.
+	DqliteRole string `json:"dqlite-role,omitempty"`
+
+	Error *Error `json:"error,omitempty"`
 }
 
 // ControllersChangeResult contains the results
@@ -160,12 +160,45 @@ type ApplicationStatus struct {
 	WorkloadVersion  string                     `json:"workload-version"`
 	EndpointBindings map[string]string          `json:"endpoint-bindings"`
 
+	// RefreshRollout is the progress of a staged refresh of the
+	// application, if one is unfinished.
+	RefreshRollout *RefreshRolloutStatus `json:"refresh-rollout,omitempty"`
+
 	// The following are for CAAS models.
 	Scale         int    `json:"int,omitempty"`
 	ProviderId    string `json:"provider-id,omitempty"`
 	PublicAddress string `json:"public-address"`
 }
 
+// RefreshRolloutStatus holds the progress of a staged refresh of an
+// application.
+type RefreshRolloutStatus struct {
+	// Status is one of "rolling", "paused" or "rolled-back".
+	Status string `json:"status"`
+
+	// Message describes why the refresh was paused or rolled back.
+	Message string `json:"message,omitempty"`
+
+	// Charm is the URL of the charm the units are being refreshed to.
+	Charm string `json:"charm"`
+
+	// PreviousCharm is the URL of the charm the application was running
+	// before the refresh started.
+	PreviousCharm string `json:"previous-charm"`
+
+	// UnitsRefreshed is the number of units running the new charm.
+	UnitsRefreshed int `json:"units-refreshed"`
+
+	// UnitsTotal is the number of units of the application.
+	UnitsTotal int `json:"units-total"`
+
+	// BatchSize is the number of units refreshed in each batch.
+	BatchSize int `json:"batch-size,omitempty"`
+
+	// BatchPercent is the percentage of units refreshed in each batch.
+	BatchPercent int `json:"batch-percent,omitempty"`
+}
+
 // RemoteApplicationStatus holds status info about a remote application.
 type RemoteApplicationStatus struct {
 	Err       *Error              `json:"err,omitempty"`
@@ -327,3 +360,60 @@ type BranchStatus struct {
 	Created       int64               `json:"created"`
 	CreatedBy     string              `json:"created-by"`
 }
+
+// WaitForStatus holds the life and status of the entities in a model which
+// can be waited on.
+type WaitForStatus struct {
+	Model        WaitForModelStatus                  `json:"model"`
+	Applications map[string]WaitForApplicationStatus `json:"applications"`
+	Units        map[string]WaitForUnitStatus        `json:"units"`
+	Machines     map[string]WaitForMachineStatus     `json:"machines"`
+}
+
+// WaitForModelStatus holds the status of a model.
+type WaitForModelStatus struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// WaitForApplicationStatus holds the life and status of an application.
+type WaitForApplicationStatus struct {
+	Name          string   `json:"name"`
+	Life          string   `json:"life"`
+	Status        string   `json:"status"`
+	Message       string   `json:"message,omitempty"`
+	Charm         string   `json:"charm"`
+	CharmRevision int      `json:"charm-revision"`
+	Exposed       bool     `json:"exposed"`
+	Subordinate   bool     `json:"subordinate"`
+	Scale         int      `json:"scale"`
+	Units         []string `json:"units"`
+}
+
+// WaitForUnitStatus holds the life and status of a unit.
+type WaitForUnitStatus struct {
+	Name            string `json:"name"`
+	Application     string `json:"application"`
+	Life            string `json:"life"`
+	WorkloadStatus  string `json:"workload-status"`
+	WorkloadMessage string `json:"workload-message,omitempty"`
+	AgentStatus     string `json:"agent-status"`
+	AgentMessage    string `json:"agent-message,omitempty"`
+	Machine         string `json:"machine,omitempty"`
+	Leader          bool   `json:"leader"`
+	Principal       string `json:"principal,omitempty"`
+}
+
+// WaitForMachineStatus holds the life and status of a machine.
+type WaitForMachineStatus struct {
+	Name            string `json:"name"`
+	Life            string `json:"life"`
+	Status          string `json:"status"`
+	Message         string `json:"message,omitempty"`
+	InstanceStatus  string `json:"instance-status"`
+	InstanceMessage string `json:"instance-message,omitempty"`
+	InstanceID      string `json:"instance-id,omitempty"`
+	Hostname        string `json:"hostname,omitempty"`
+}
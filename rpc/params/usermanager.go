@@ -16,6 +16,25 @@ type UserInfo struct {
 	DateCreated    time.Time  `json:"date-created"`
 	LastConnection *time.Time `json:"last-connection,omitempty"`
 	Disabled       bool       `json:"disabled"`
+
+	// TimeLimitedAccess lists the grants held by the user which
+	// expire automatically.
+	TimeLimitedAccess []TimeLimitedAccess `json:"time-limited-access,omitempty"`
+}
+
+// TimeLimitedAccess describes an access level a user holds on a target
+// until the given expiry time.
+type TimeLimitedAccess struct {
+	// ObjectType is the type of entity the access is granted on, for
+	// example model or application.
+	ObjectType string `json:"object-type"`
+	// Key identifies the entity the access is granted on. Applications
+	// are identified by their model UUID and name separated by a colon.
+	Key string `json:"key"`
+	// Access is the access level granted.
+	Access string `json:"access"`
+	// Expiry is the time at which the access is revoked.
+	Expiry time.Time `json:"expiry"`
 }
 
 // UserInfoResult holds the result of a UserInfo call.
@@ -67,3 +86,40 @@ type AddUserResult struct {
 	SecretKey []byte `json:"secret-key,omitempty"`
 	Error     *Error `json:"error,omitempty"`
 }
+
+// AddGroups holds the parameters for adding new groups.
+type AddGroups struct {
+	Groups []AddGroup `json:"groups"`
+}
+
+// AddGroup stores the parameters to add one group.
+type AddGroup struct {
+	Name string `json:"name"`
+}
+
+// ModifyGroupMembershipRequest holds the parameters for adding users to, or
+// removing users from, groups.
+type ModifyGroupMembershipRequest struct {
+	Changes []ModifyGroupMembership `json:"changes"`
+}
+
+// ModifyGroupMembership holds a change to the membership of a group.
+type ModifyGroupMembership struct {
+	Group   string `json:"group"`
+	UserTag string `json:"user-tag"`
+}
+
+// ModifyGroupAccessRequest holds the parameters for making grant and revoke
+// calls for groups.
+type ModifyGroupAccessRequest struct {
+	Changes []ModifyGroupAccess `json:"changes"`
+}
+
+// ModifyGroupAccess holds a change of the access a group has to a model or
+// controller.
+type ModifyGroupAccess struct {
+	Group     string      `json:"group"`
+	Action    ModelAction `json:"action"`
+	Access    string      `json:"access"`
+	TargetTag string      `json:"target-tag"`
+}
@@ -257,6 +257,7 @@ func fillFromAllowedUpdateConfigAttributes(data map[string]*keyInfo) {
 
 // keys for which a default value doesn't make sense
 var skipDefault = set.NewStrings(
+	controller.APIRateLimitFacades,    // "map[]" - not useful
 	controller.AuditLogExcludeMethods, // "[ReadOnlyMethods]" - not useful
 	controller.CACertKey,
 	controller.ControllerUUIDKey,